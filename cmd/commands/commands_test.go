@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -96,6 +97,66 @@ func Test_Commands(t *testing.T) {
 		assert.Equal(t, "unsupported isb service type", err.Error())
 	})
 
+	t.Run("ISBSvcSnapshot", func(t *testing.T) {
+		cmd := NewISBSvcSnapshotCommand()
+		assert.Equal(t, "isbsvc-snapshot", cmd.Use)
+		assert.Equal(t, "stringSlice", cmd.Flag("buffers").Value.Type())
+		assert.Equal(t, "stringSlice", cmd.Flag("buckets").Value.Type())
+		cmd.SetArgs([]string{"--isbsvc-type=jetstream"})
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Equal(t, "--dir and --prefix are required", err.Error())
+		cmd.SetArgs([]string{"--isbsvc-type=redis", "--dir=/tmp", "--prefix=snap", "--buffers=buffer1"})
+		err = cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "snapshot is not supported")
+	})
+
+	t.Run("ISBSvcRestore", func(t *testing.T) {
+		cmd := NewISBSvcRestoreCommand()
+		assert.Equal(t, "isbsvc-restore", cmd.Use)
+		assert.Equal(t, "string", cmd.Flag("dir").Value.Type())
+		cmd.SetArgs([]string{"--isbsvc-type=nonono", "--dir=/tmp", "--prefix=snap"})
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported isb service type")
+	})
+
+	t.Run("ISBInspect", func(t *testing.T) {
+		cmd := NewISBInspectCommand()
+		assert.Equal(t, "isb-inspect", cmd.Use)
+		assert.Equal(t, "nats-json", cmd.Flag("format").Value.String())
+		cmd.SetArgs([]string{})
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Equal(t, "exactly one of --file and --stream should be specified", err.Error())
+
+		export := filepath.Join(t.TempDir(), "export")
+		assert.NoError(t, os.WriteFile(export, []byte("not base64!\n"), 0600))
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetArgs([]string{"--file", export, "--format", "base64"})
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, b.String(), "#1\n  error: invalid base64")
+	})
+
+	t.Run("Simulate", func(t *testing.T) {
+		cmd := NewSimulateCommand()
+		assert.Equal(t, "simulate", cmd.Use)
+		assert.Equal(t, "f", cmd.Flag("file").Shorthand)
+		assert.Equal(t, "1m0s", cmd.Flag("duration").Value.String())
+		cmd.SetArgs([]string{})
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Equal(t, "--file is required", err.Error())
+
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		cmd.SetArgs([]string{"-f", "../../examples/1-simple-pipeline.yaml", "--duration", "3s"})
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, b.String(), `Pipeline "simple-pipeline" ran for`)
+	})
+
 	t.Run("Controller", func(t *testing.T) {
 		cmd := NewControllerCommand()
 		assert.Equal(t, "controller", cmd.Use)
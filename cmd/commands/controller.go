@@ -17,6 +17,7 @@ limitations under the License.
 package commands
 
 import (
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	ctrlcmd "github.com/numaproj/numaflow/pkg/reconciler/cmd"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 
@@ -37,7 +38,7 @@ func NewControllerCommand() *cobra.Command {
 			ctrlcmd.Start(namespaced, managedNamespace)
 		},
 	}
-	command.Flags().BoolVar(&namespaced, "namespaced", sharedutil.LookupEnvBoolOr("NUMAFLOW_CONTROLLER_NAMESPACED", false), "Whether to run in namespaced scope, defaults to false.")
+	command.Flags().BoolVar(&namespaced, "namespaced", sharedutil.LookupEnvBoolOr(dfv1.EnvControllerNamespaced, false), "Whether to run in namespaced scope, defaults to false.")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", sharedutil.LookupEnvStringOr("NUMAFLOW_CONTROLLER_MANAGED_NAMESPACE", sharedutil.LookupEnvStringOr("NAMESPACE", "numaflow-system")), "The namespace that the controller watches when \"--namespaced\" is \"true\".")
 	return command
 }
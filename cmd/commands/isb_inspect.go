@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/cobra"
+
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
+	"github.com/numaproj/numaflow/pkg/isb/inspect"
+)
+
+func NewISBInspectCommand() *cobra.Command {
+	var (
+		file               string
+		format             string
+		url                string
+		stream             string
+		user               string
+		creds              string
+		insecureSkipVerify bool
+		startSeq           uint64
+		count              int
+		previewBytes       int
+		keysDir            string
+		vaultAddress       string
+		vaultMountPath     string
+		vaultKeyName       string
+	)
+
+	command := &cobra.Command{
+		Use:   "isb-inspect",
+		Short: "Print the decoded messages of an Inter-Step Buffer from an export or a JetStream stream",
+		Example: `  # decode the messages exported with "nats stream get --json"
+  numaflow isb-inspect --file messages.json --format nats-json
+  # read the messages of a JetStream buffer without consuming them, the password is read from NATS_PASSWORD
+  numaflow isb-inspect --url nats://isbsvc-default-js-svc:4222 --user readonly --stream my-ns-my-pl-out-0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (file == "") == (stream == "") {
+				return fmt.Errorf("exactly one of --file and --stream should be specified")
+			}
+			var opts []inspect.PrinterOption
+			opts = append(opts, inspect.WithPreviewBytes(previewBytes))
+			if keysDir != "" {
+				var keyringOpts []encryption.KeyringOption
+				if vaultAddress != "" {
+					keyringOpts = append(keyringOpts, encryption.WithKeyUnwrapper(encryption.NewVaultTransitUnwrapper(vaultAddress, vaultMountPath, vaultKeyName, os.Getenv("VAULT_TOKEN"))))
+				}
+				keyring, err := encryption.LoadDecryptionKeyring(keysDir, keyringOpts...)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, inspect.WithDecrypter(keyring))
+			}
+			printer := inspect.NewPrinter(cmd.OutOrStdout(), opts...)
+
+			if file != "" {
+				f, err := os.Open(file)
+				if err != nil {
+					return fmt.Errorf("failed to open %q, %w", file, err)
+				}
+				defer f.Close()
+				return inspect.ReadExport(f, inspect.Format(format), printer.Print)
+			}
+
+			natsOpts := []nats.Option{nats.Name("numaflow-isb-inspect")}
+			if user != "" {
+				natsOpts = append(natsOpts, nats.UserInfo(user, os.Getenv("NATS_PASSWORD")))
+			}
+			if creds != "" {
+				natsOpts = append(natsOpts, nats.UserCredentials(creds))
+			}
+			if insecureSkipVerify {
+				natsOpts = append(natsOpts, nats.Secure(&tls.Config{InsecureSkipVerify: true}))
+			}
+			nc, err := nats.Connect(url, natsOpts...)
+			if err != nil {
+				return fmt.Errorf("failed to connect to %q, %w", url, err)
+			}
+			defer nc.Close()
+			js, err := nc.JetStream()
+			if err != nil {
+				return fmt.Errorf("failed to get the JetStream context, %w", err)
+			}
+			return inspect.ReadStream(context.Background(), js, stream, startSeq, count, printer.Print)
+		},
+	}
+	command.Flags().StringVar(&file, "file", "", "Path of the export of the buffer entries")
+	command.Flags().StringVar(&format, "format", string(inspect.FormatNATSJSON), fmt.Sprintf("Format of the export, one of %v", inspect.Formats))
+	command.Flags().StringVar(&url, "url", nats.DefaultURL, "URL of the JetStream server")
+	command.Flags().StringVar(&stream, "stream", "", "Name of the JetStream stream, i.e. the buffer name")
+	command.Flags().StringVar(&user, "user", "", "User to connect to the JetStream server, the password is read from the environment variable NATS_PASSWORD")
+	command.Flags().StringVar(&creds, "creds", "", "Path of the NATS credentials file")
+	command.Flags().BoolVar(&insecureSkipVerify, "insecure-skip-verify", false, "Connect with TLS without verifying the server certificate")
+	command.Flags().Uint64Var(&startSeq, "start-seq", 0, "Sequence of the first message to read, the first message in the stream by default")
+	command.Flags().IntVar(&count, "count", 100, "Max number of messages to read from the stream")
+	command.Flags().IntVar(&previewBytes, "preview-bytes", 64, "Max number of bytes of the payloads to print")
+	command.Flags().StringVar(&keysDir, "keys-dir", "", "Directory of the encryption keys to decrypt the payloads, e.g. a copy of the encryption secret")
+	command.Flags().StringVar(&vaultAddress, "vault-address", "", "Address of the Vault server to unwrap the keys in --keys-dir with, the token is read from the environment variable VAULT_TOKEN")
+	command.Flags().StringVar(&vaultMountPath, "vault-mount-path", "transit", "Mount path of the Vault transit secrets engine")
+	command.Flags().StringVar(&vaultKeyName, "vault-key-name", "", "Name of the Vault transit key the keys are wrapped with")
+	return command
+}
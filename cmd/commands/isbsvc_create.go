@@ -28,9 +28,11 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
 
 func NewISBSvcCreateCommand() *cobra.Command {
@@ -41,6 +43,8 @@ func NewISBSvcCreateCommand() *cobra.Command {
 		buckets              []string
 		sideInputsStore      string
 		servingSourceStreams []string
+		bufferConfigs        map[string]string
+		bufferRetentions     map[string]string
 	)
 
 	command := &cobra.Command{
@@ -52,24 +56,27 @@ func NewISBSvcCreateCommand() *cobra.Command {
 				return fmt.Errorf("required environment variable '%s' not defined", v1alpha1.EnvPipelineName)
 			}
 			logger := logging.NewLogger().Named("isbsvc-create").With("pipeline", pipelineName)
-			isbSvcConfig := &v1alpha1.BufferServiceConfig{}
-			encodedBufferServiceConfig := os.Getenv(v1alpha1.EnvISBSvcConfig)
-			if len(encodedBufferServiceConfig) > 0 {
-				isbSvcConfigStr, err := base64.StdEncoding.DecodeString(encodedBufferServiceConfig)
-				if err != nil {
-					return fmt.Errorf("failed to decode ISB Svc config string, %w", err)
-				}
-				if err := json.Unmarshal(isbSvcConfigStr, isbSvcConfig); err != nil {
-					return fmt.Errorf("failed to unmarshal ISB Svc config, %w", err)
-				}
+			isbSvcConfig, err := sharedutil.GetIsbSvcConfigFromEnv()
+			if err != nil {
+				return err
+			}
+			opts, err := bufferConfigOptions(bufferConfigs)
+			if err != nil {
+				return err
 			}
-			opts := []isbsvc.CreateOption{}
+			retentionOpts, err := bufferRetentionOptions(bufferRetentions)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, retentionOpts...)
 			var isbsClient isbsvc.ISBService
-			var err error
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				rsClient := redisclient.NewInClusterRedisClient()
+				rsClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster redis client, %w", err)
+				}
 				defer rsClient.Close()
 				isbsClient = isbsvc.NewISBRedisSvc(rsClient)
 			case v1alpha1.ISBSvcTypeJetStream:
@@ -78,12 +85,25 @@ func NewISBSvcCreateCommand() *cobra.Command {
 					return fmt.Errorf("failed to get an in-cluster nats connection, %w", err)
 				}
 				defer client.Close()
-				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, client)
+				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, client, isbsvc.WithPipelineUID(os.Getenv(v1alpha1.EnvPipelineUID)),
+					isbsvc.WithPipelineQuota(isbsvc.NewPipelineQuota(isbSvcConfig.JetStream.PipelineQuota)))
 				if err != nil {
 					logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
 					return err
 				}
 				opts = append(opts, isbsvc.WithConfig(isbSvcConfig.JetStream.StreamConfig))
+			case v1alpha1.ISBSvcTypeKafka:
+				client, err := kafkaclient.NewInClusterKafkaClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster kafka client, %w", err)
+				}
+				defer client.Close()
+				isbsClient, err = isbsvc.NewISBKafkaSvc(client)
+				if err != nil {
+					logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
+					return err
+				}
+				opts = append(opts, isbsvc.WithConfig(isbSvcConfig.Kafka.Config))
 			default:
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("unsupported isb service type %q", isbSvcType)
@@ -103,5 +123,40 @@ func NewISBSvcCreateCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to create") // --buckets=xxa,xxb --buckets=xxc
 	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
 	command.Flags().StringSliceVar(&servingSourceStreams, "serving-source-streams", []string{}, "Serving source streams to create") // --serving-source-streams=a,b, --serving-source-streams=c
+	// --buffer-configs=a=xxx,b=yyy
+	command.Flags().StringToStringVar(&bufferConfigs, "buffer-configs", map[string]string{}, "Base64 encoded configs of the buffers overriding the ISB Service config")
+	// --buffer-retentions=a=xxx,b=yyy
+	command.Flags().StringToStringVar(&bufferRetentions, "buffer-retentions", map[string]string{}, "Base64 encoded JSON retentions of the buffers")
 	return command
 }
+
+// bufferConfigOptions returns the options of the buffer configs, which are base64 encoded and keyed by the buffers.
+func bufferConfigOptions(bufferConfigs map[string]string) ([]isbsvc.CreateOption, error) {
+	opts := []isbsvc.CreateOption{}
+	for buffer, encoded := range bufferConfigs {
+		conf, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the config of buffer %q, %w", buffer, err)
+		}
+		opts = append(opts, isbsvc.WithBufferConfig(buffer, string(conf)))
+	}
+	return opts, nil
+}
+
+// bufferRetentionOptions returns the options of the buffer retentions, which are base64 encoded JSON and keyed by the
+// buffers.
+func bufferRetentionOptions(bufferRetentions map[string]string) ([]isbsvc.CreateOption, error) {
+	opts := []isbsvc.CreateOption{}
+	for buffer, encoded := range bufferRetentions {
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the retention of buffer %q, %w", buffer, err)
+		}
+		retention := v1alpha1.BufferRetention{}
+		if err := json.Unmarshal(data, &retention); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the retention of buffer %q, %w", buffer, err)
+		}
+		opts = append(opts, isbsvc.WithBufferRetention(buffer, retention))
+	}
+	return opts, nil
+}
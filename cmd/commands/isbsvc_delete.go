@@ -20,15 +20,19 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
 
 func NewISBSvcDeleteCommand() *cobra.Command {
@@ -38,6 +42,9 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 		buckets              []string
 		sideInputsStore      string
 		servingSourceStreams []string
+		force                bool
+		concurrency          int
+		itemTimeout          time.Duration
 	)
 
 	command := &cobra.Command{
@@ -54,7 +61,10 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				rsClient := redisclient.NewInClusterRedisClient()
+				rsClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster redis client, %w", err)
+				}
 				defer rsClient.Close()
 
 				isbsClient = isbsvc.NewISBRedisSvc(rsClient)
@@ -65,7 +75,18 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 				}
 				defer client.Close()
 
-				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, client)
+				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, client, isbsvc.WithPipelineUID(os.Getenv(v1alpha1.EnvPipelineUID)))
+				if err != nil {
+					logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
+					return err
+				}
+			case v1alpha1.ISBSvcTypeKafka:
+				client, err := kafkaclient.NewInClusterKafkaClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster kafka client, %w", err)
+				}
+				defer client.Close()
+				isbsClient, err = isbsvc.NewISBKafkaSvc(client)
 				if err != nil {
 					logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
 					return err
@@ -74,7 +95,29 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("unsupported isb service type %q", isbSvcType)
 			}
-			if err = isbsClient.DeleteBuffersAndBuckets(ctx, buffers, buckets, sideInputsStore, servingSourceStreams); err != nil {
+			_ = wait.ExponentialBackoffWithContext(ctx, sharedutil.DefaultRetryBackoff, func(_ context.Context) (bool, error) {
+				var report *isbsvc.DeleteReport
+				report, err = isbsClient.DeleteBuffersAndBuckets(ctx, buffers, buckets, sideInputsStore, servingSourceStreams,
+					isbsvc.WithForce(force), isbsvc.WithDeleteConcurrency(concurrency), isbsvc.WithDeleteItemTimeout(itemTimeout))
+				if report == nil {
+					// nothing is deleted, e.g. the buffers are owned by another pipeline, retrying does not help.
+					return false, err
+				}
+				logger.Infow("Processed buffers, buckets and side inputs store deletion", zap.Int("deleted", len(report.Deleted)), zap.Int("notFound", len(report.NotFound)), zap.Int("failed", len(report.Failed)))
+				if err == nil {
+					return true, nil
+				}
+				// only retry the failed items.
+				buffers = report.FailedItems(isbsvc.DeleteItemBuffer)
+				buckets = report.FailedItems(isbsvc.DeleteItemBucket)
+				if len(report.FailedItems(isbsvc.DeleteItemSideInputsStore)) == 0 {
+					sideInputsStore = ""
+				}
+				servingSourceStreams = report.FailedItems(isbsvc.DeleteItemServingSourceStream)
+				logger.Infow("Failed to delete some of the items, will retry them if the limit is not reached", zap.Error(err))
+				return false, nil
+			})
+			if err != nil {
 				logger.Errorw("Failed on buffers, buckets and side inputs store deletion.", zap.Error(err))
 				return err
 			}
@@ -87,5 +130,8 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to delete") // --buckets=xxa,xxb --buckets=xxc	return command
 	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
 	command.Flags().StringSliceVar(&servingSourceStreams, "serving-source-streams", []string{}, "Serving source streams to delete") // --serving-source-streams=a,b, --serving-source-streams=c
+	command.Flags().BoolVar(&force, "force", false, "Delete the buffers and buckets even if they are owned by another pipeline")
+	command.Flags().IntVar(&concurrency, "concurrency", 10, "Max number of buffers, buckets and streams deleted concurrently")
+	command.Flags().DurationVar(&itemTimeout, "item-timeout", 30*time.Second, "Timeout of deleting a single buffer, bucket or stream")
 	return command
 }
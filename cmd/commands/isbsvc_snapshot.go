@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+func NewISBSvcSnapshotCommand() *cobra.Command {
+	var (
+		isbSvcType string
+		buffers    []string
+		buckets    []string
+		dir        string
+		prefix     string
+	)
+
+	command := &cobra.Command{
+		Use:   "isbsvc-snapshot",
+		Short: "Snapshot ISB Service buffers and buckets to an object store",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dir == "" || prefix == "" {
+				return fmt.Errorf("--dir and --prefix are required")
+			}
+			return runWithSnapshotter(cmd, args, "isbsvc-snapshot", isbSvcType, func(ctx context.Context, snapshotter isbsvc.Snapshotter) error {
+				logger := logging.FromContext(ctx)
+				manifest, err := snapshotter.SnapshotBuffersAndBuckets(ctx, isbsvc.NewDirObjectStore(dir), prefix, buffers, buckets)
+				if err != nil {
+					logger.Errorw("Failed on buffers and buckets snapshot.", zap.Error(err))
+					return err
+				}
+				logger.Infow("Snapshotted buffers and buckets successfully", zap.Int("buffers", len(manifest.Buffers)), zap.Int("buckets", len(manifest.Buckets)))
+				return nil
+			})
+		},
+	}
+	command.Flags().StringVar(&isbSvcType, "isbsvc-type", "", "ISB Service type, e.g. jetstream")
+	command.Flags().StringSliceVar(&buffers, "buffers", []string{}, "Buffers to snapshot") // --buffers=a,b, --buffers=c
+	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to snapshot") // --buckets=xxa,xxb --buckets=xxc
+	command.Flags().StringVar(&dir, "dir", "", "Directory of the object store, e.g. the mount path of a bucket")
+	command.Flags().StringVar(&prefix, "prefix", "", "Prefix of the snapshot objects in the object store")
+	return command
+}
+
+func NewISBSvcRestoreCommand() *cobra.Command {
+	var (
+		isbSvcType string
+		dir        string
+		prefix     string
+	)
+
+	command := &cobra.Command{
+		Use:   "isbsvc-restore",
+		Short: "Restore ISB Service buffers and buckets from a snapshot in an object store",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dir == "" || prefix == "" {
+				return fmt.Errorf("--dir and --prefix are required")
+			}
+			return runWithSnapshotter(cmd, args, "isbsvc-restore", isbSvcType, func(ctx context.Context, snapshotter isbsvc.Snapshotter) error {
+				logger := logging.FromContext(ctx)
+				manifest, err := snapshotter.RestoreBuffersAndBuckets(ctx, isbsvc.NewDirObjectStore(dir), prefix)
+				if err != nil {
+					logger.Errorw("Failed on buffers and buckets restore.", zap.Error(err))
+					return err
+				}
+				logger.Infow("Restored buffers and buckets successfully", zap.Int("buffers", len(manifest.Buffers)), zap.Int("buckets", len(manifest.Buckets)), zap.Time("snapshotCreated", manifest.Created))
+				return nil
+			})
+		},
+	}
+	command.Flags().StringVar(&isbSvcType, "isbsvc-type", "", "ISB Service type, e.g. jetstream")
+	command.Flags().StringVar(&dir, "dir", "", "Directory of the object store, e.g. the mount path of a bucket")
+	command.Flags().StringVar(&prefix, "prefix", "", "Prefix of the snapshot objects in the object store")
+	return command
+}
+
+// runWithSnapshotter connects to the ISB Service of the pipeline and runs fn with it, only JetStream supports snapshots.
+func runWithSnapshotter(cmd *cobra.Command, args []string, name, isbSvcType string, fn func(context.Context, isbsvc.Snapshotter) error) error {
+	pipelineName, defined := os.LookupEnv(v1alpha1.EnvPipelineName)
+	if !defined {
+		return fmt.Errorf("required environment variable '%s' not defined", v1alpha1.EnvPipelineName)
+	}
+	logger := logging.NewLogger().Named(name).With("pipeline", pipelineName)
+	ctx := logging.WithLogger(context.Background(), logger)
+	switch v1alpha1.ISBSvcType(isbSvcType) {
+	case v1alpha1.ISBSvcTypeJetStream:
+		client, err := jsclient.NewNATSClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get an in-cluster nats connection, %w", err)
+		}
+		defer client.Close()
+
+		isbsClient, err := isbsvc.NewISBJetStreamSvc(pipelineName, client, isbsvc.WithPipelineUID(os.Getenv(v1alpha1.EnvPipelineUID)))
+		if err != nil {
+			logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
+			return err
+		}
+		return fn(ctx, isbsClient.(isbsvc.Snapshotter))
+	case v1alpha1.ISBSvcTypeRedis, v1alpha1.ISBSvcTypeKafka:
+		return fmt.Errorf("snapshot is not supported by isb service type %q", isbSvcType)
+	default:
+		cmd.HelpFunc()(cmd, args)
+		return fmt.Errorf("unsupported isb service type %q", isbSvcType)
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -41,6 +42,8 @@ func NewISBSvcValidateCommand() *cobra.Command {
 		buckets              []string
 		sideInputsStore      string
 		servingSourceStreams []string
+		bufferConfigs        map[string]string
+		bufferRetentions     map[string]string
 	)
 
 	command := &cobra.Command{
@@ -52,12 +55,23 @@ func NewISBSvcValidateCommand() *cobra.Command {
 				return fmt.Errorf("environment variable %q not existing", v1alpha1.EnvPipelineName)
 			}
 			logger := logging.NewLogger().Named("isbsvc-validate").With("pipeline", pipelineName)
+			opts, err := bufferConfigOptions(bufferConfigs)
+			if err != nil {
+				return err
+			}
+			retentionOpts, err := bufferRetentionOptions(bufferRetentions)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, retentionOpts...)
 			var isbsClient isbsvc.ISBService
-			var err error
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				rsClient := redisclient.NewInClusterRedisClient()
+				rsClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster redis client, %w", err)
+				}
 				defer rsClient.Close()
 
 				isbsClient = isbsvc.NewISBRedisSvc(rsClient)
@@ -67,7 +81,18 @@ func NewISBSvcValidateCommand() *cobra.Command {
 					return fmt.Errorf("failed to get an in-cluster nats connection, %w", err)
 				}
 				defer client.Close()
-				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, client)
+				isbsClient, err = isbsvc.NewISBJetStreamSvc(pipelineName, client, isbsvc.WithPipelineUID(os.Getenv(v1alpha1.EnvPipelineUID)))
+				if err != nil {
+					logger.Errorw("Failed to get an ISB Service client.", zap.Error(err))
+					return err
+				}
+			case v1alpha1.ISBSvcTypeKafka:
+				client, err := kafkaclient.NewInClusterKafkaClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster kafka client, %w", err)
+				}
+				defer client.Close()
+				isbsClient, err = isbsvc.NewISBKafkaSvc(client)
 				if err != nil {
 					logger.Errorw("Failed to get an ISB Service client.", zap.Error(err))
 					return err
@@ -77,7 +102,12 @@ func NewISBSvcValidateCommand() *cobra.Command {
 				return fmt.Errorf("unsupported isb service type")
 			}
 			_ = wait.ExponentialBackoffWithContext(ctx, sharedutil.DefaultRetryBackoff, func(_ context.Context) (bool, error) {
-				if err = isbsClient.ValidateBuffersAndBuckets(ctx, buffers, buckets, sideInputsStore, servingSourceStreams); err != nil {
+				if err = isbsClient.ValidateBuffersAndBuckets(ctx, buffers, buckets, sideInputsStore, servingSourceStreams, opts...); err != nil {
+					if isbsvc.IsOwnershipMismatch(err) || isbsvc.IsConfigMismatch(err) {
+						// retrying does not help if the buffers are owned by another pipeline, or not configured as
+						// their buffer configs expect.
+						return false, err
+					}
 					logger.Infow("Buffers, buckets and side inputs store might have not been created yet, will retry if the limit is not reached", zap.Error(err))
 					return false, nil
 				}
@@ -96,6 +126,10 @@ func NewISBSvcValidateCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&buckets, "buckets", []string{}, "Buckets to validate") // --buckets=xxa,xxb --buckets=xxc
 	command.Flags().StringVar(&sideInputsStore, "side-inputs-store", "", "Name of the side inputs store")
 	command.Flags().StringSliceVar(&servingSourceStreams, "serving-source-streams", []string{}, "Serving source streams to validate") // --serving-source-streams=a,b, --serving-source-streams=c
+	// --buffer-configs=a=xxx,b=yyy
+	command.Flags().StringToStringVar(&bufferConfigs, "buffer-configs", map[string]string{}, "Base64 encoded configs of the buffers to validate against")
+	// --buffer-retentions=a=xxx,b=yyy
+	command.Flags().StringToStringVar(&bufferRetentions, "buffer-retentions", map[string]string{}, "Base64 encoded JSON retentions of the buffers to validate against")
 
 	return command
 }
@@ -28,6 +28,7 @@ import (
 
 	"github.com/numaproj/numaflow"
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sinks"
 	"github.com/numaproj/numaflow/pkg/sources"
@@ -76,6 +77,7 @@ func NewProcessorCommand() *cobra.Command {
 				Hostname: hostname,
 				Replica:  int32(replica),
 			}
+			metrics.LogRuntimeConfig(log, metrics.NewRuntimeConfig(vertex, os.Environ()))
 			ctx := logging.WithLogger(signals.SetupSignalHandler(), log)
 			switch dfv1.VertexType(processorType) {
 			case dfv1.VertexTypeSource:
@@ -40,6 +40,9 @@ func init() {
 	rootCmd.AddCommand(NewISBSvcCreateCommand())
 	rootCmd.AddCommand(NewISBSvcDeleteCommand())
 	rootCmd.AddCommand(NewISBSvcValidateCommand())
+	rootCmd.AddCommand(NewISBSvcSnapshotCommand())
+	rootCmd.AddCommand(NewISBSvcRestoreCommand())
+	rootCmd.AddCommand(NewISBInspectCommand())
 	rootCmd.AddCommand(NewBuiltinUDFCommand())
 	rootCmd.AddCommand(NewBuiltinTransformerCommand())
 	rootCmd.AddCommand(NewDaemonServerCommand())
@@ -52,4 +55,5 @@ func init() {
 	rootCmd.AddCommand(NewSideInputsSynchronizerCommand())
 	rootCmd.AddCommand(NewDexServerInitCommand())
 	rootCmd.AddCommand(NewMonoVtxDaemonServerCommand())
+	rootCmd.AddCommand(NewSimulateCommand())
 }
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/simulator"
+)
+
+func NewSimulateCommand() *cobra.Command {
+	var (
+		file     string
+		duration time.Duration
+		verbose  bool
+	)
+
+	command := &cobra.Command{
+		Use:   "simulate",
+		Short: "Run a pipeline spec locally against the in-memory Inter-Step Buffers and print a summary",
+		Long: `Run a pipeline spec locally against the in-memory Inter-Step Buffers and print the read and write counts of
+the vertices and the watermark progression of the edges.
+
+Only the generator sources are supported. The map UDFs forward the messages as they are, the log sinks print the
+messages and the other sinks drop them. The reduce vertices and the cycles are not supported.`,
+		Example: `  numaflow simulate -f pipeline.yaml --duration 60s`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+			pl, err := simulator.LoadPipeline(file)
+			if err != nil {
+				return err
+			}
+			var opts []simulator.Option
+			if verbose {
+				opts = append(opts, simulator.WithLogger(logging.NewLogger().Named("simulator")))
+			}
+			sim, err := simulator.NewSimulator(pl, opts...)
+			if err != nil {
+				return err
+			}
+			// the pipeline is stopped early on an interrupt, the summary of the run is still printed.
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			summary, err := sim.Run(ctx, duration)
+			if summary != nil {
+				if printErr := summary.Print(cmd.OutOrStdout()); printErr != nil {
+					return printErr
+				}
+			}
+			return err
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "", "Path of the pipeline spec")
+	command.Flags().DurationVar(&duration, "duration", time.Minute, "How long to run the pipeline")
+	command.Flags().BoolVar(&verbose, "verbose", false, "Print the logs of the vertices")
+	return command
+}
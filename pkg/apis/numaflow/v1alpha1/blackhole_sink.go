@@ -18,4 +18,7 @@ package v1alpha1
 
 // Blackhole is a sink to emulate /dev/null
 type Blackhole struct {
+	// SequenceValidation enables the validation of the per-key ordering of the messages generated by a generator source.
+	// +optional
+	SequenceValidation *SequenceValidation `json:"sequenceValidation,omitempty" protobuf:"bytes,1,opt,name=sequenceValidation"`
 }
@@ -35,6 +35,7 @@ const (
 	KeyISBSvcName       = "numaflow.numaproj.io/isbsvc-name"
 	KeyISBSvcType       = "numaflow.numaproj.io/isbsvc-type"
 	KeyPipelineName     = "numaflow.numaproj.io/pipeline-name"
+	KeyPipelineUID      = "numaflow.numaproj.io/pipeline-uid"
 	KeyVertexName       = "numaflow.numaproj.io/vertex-name"
 	KeyMonoVertexName   = "numaflow.numaproj.io/mono-vertex-name"
 	KeyReplica          = "numaflow.numaproj.io/replica"
@@ -62,6 +63,7 @@ const (
 	JetStreamClusterCACertKey            = "cluster-ca-cert"      // key for server CA certificate
 	JetStreamClientAuthSecretUserKey     = "client-auth-user"     // key for client auth user secret
 	JetStreamClientAuthSecretPasswordKey = "client-auth-password" // key for client auth password secret
+	JetStreamClientCACertKey             = "client-ca-cert"       // key for the CA certificate for the clients to verify the server
 	JetStreamConfigMapKey                = "nats-js"              // key for nats-js.conf in the configmap
 
 	// container names.
@@ -106,11 +108,13 @@ const (
 	// ENV vars
 	EnvNamespace                        = "NUMAFLOW_NAMESPACE"
 	EnvPipelineName                     = "NUMAFLOW_PIPELINE_NAME"
+	EnvPipelineUID                      = "NUMAFLOW_PIPELINE_UID"
 	EnvVertexName                       = "NUMAFLOW_VERTEX_NAME"
 	EnvMonoVertexName                   = "NUMAFLOW_MONO_VERTEX_NAME"
 	EnvCallbackEnabled                  = "NUMAFLOW_CALLBACK_ENABLED"
 	EnvCallbackURL                      = "NUMAFLOW_CALLBACK_URL"
 	EnvPod                              = "NUMAFLOW_POD"
+	EnvPodIP                            = "NUMAFLOW_POD_IP"
 	EnvReplica                          = "NUMAFLOW_REPLICA"
 	EnvVertexObject                     = "NUMAFLOW_VERTEX_OBJECT"
 	EnvPipelineObject                   = "NUMAFLOW_PIPELINE_OBJECT"
@@ -125,11 +129,21 @@ const (
 	EnvISBSvcRedisPassword              = "NUMAFLOW_ISBSVC_REDIS_PASSWORD"
 	EnvISBSvcRedisSentinelPassword      = "NUMAFLOW_ISBSVC_REDIS_SENTINEL_PASSWORD"
 	EnvISBSvcRedisClusterMaxRedirects   = "NUMAFLOW_ISBSVC_REDIS_CLUSTER_MAX_REDIRECTS"
+	EnvISBSvcRedisTLS                   = "NUMAFLOW_ISBSVC_REDIS_TLS"
 	EnvISBSvcJetStreamUser              = "NUMAFLOW_ISBSVC_JETSTREAM_USER"
 	EnvISBSvcJetStreamPassword          = "NUMAFLOW_ISBSVC_JETSTREAM_PASSWORD"
 	EnvISBSvcJetStreamURL               = "NUMAFLOW_ISBSVC_JETSTREAM_URL"
 	EnvISBSvcJetStreamTLSEnabled        = "NUMAFLOW_ISBSVC_JETSTREAM_TLS_ENABLED"
+	EnvISBSvcJetStreamTLS               = "NUMAFLOW_ISBSVC_JETSTREAM_TLS"
+	EnvISBSvcKafkaBrokers               = "NUMAFLOW_ISBSVC_KAFKA_BROKERS"
+	EnvISBSvcKafkaTLSEnabled            = "NUMAFLOW_ISBSVC_KAFKA_TLS_ENABLED"
+	EnvISBSvcKafkaTLS                   = "NUMAFLOW_ISBSVC_KAFKA_TLS"
+	EnvISBSvcKafkaSASLMechanism         = "NUMAFLOW_ISBSVC_KAFKA_SASL_MECHANISM"
+	EnvISBSvcKafkaSASLUser              = "NUMAFLOW_ISBSVC_KAFKA_SASL_USER"
+	EnvISBSvcKafkaSASLPassword          = "NUMAFLOW_ISBSVC_KAFKA_SASL_PASSWORD"
+	EnvISBSvcKafkaConfig                = "NUMAFLOW_ISBSVC_KAFKA_CONFIG"
 	EnvISBSvcConfig                     = "NUMAFLOW_ISBSVC_CONFIG"
+	EnvISBEncryptionVaultToken          = "NUMAFLOW_ISB_ENCRYPTION_VAULT_TOKEN"
 	EnvLeaderElectionDisabled           = "NUMAFLOW_LEADER_ELECTION_DISABLED"
 	EnvLeaderElectionLeaseDuration      = "NUMAFLOW_LEADER_ELECTION_LEASE_DURATION"
 	EnvLeaderElectionLeaseRenewDeadline = "NUMAFLOW_LEADER_ELECTION_LEASE_RENEW_DEADLINE"
@@ -152,10 +166,25 @@ const (
 	EnvServingHostIP                    = "NUMAFLOW_SERVING_HOST_IP"
 	EnvServingStoreTTL                  = "NUMAFLOW_SERVING_STORE_TTL"
 	EnvExecuteRustBinary                = "NUMAFLOW_EXECUTE_RUST_BINARY"
+	EnvFutureEventTimeBound             = "NUMAFLOW_FUTURE_EVENT_TIME_BOUND"
+	EnvControllerNamespaced             = "NUMAFLOW_CONTROLLER_NAMESPACED"
+	EnvCleanupOrphanedBuffers           = "NUMAFLOW_CLEANUP_ORPHANED_BUFFERS"
+	EnvOrphanedBuffersGracePeriod       = "NUMAFLOW_ORPHANED_BUFFERS_GRACE_PERIOD"
+	EnvRepairBuffers                    = "NUMAFLOW_REPAIR_BUFFERS"
+	EnvSlowBatchThreshold               = "NUMAFLOW_SLOW_BATCH_THRESHOLD"
+	EnvWatermarkDecisionLogSize         = "NUMAFLOW_WATERMARK_DECISION_LOG_SIZE"
+	EnvWatermarkDecisionLogFile         = "NUMAFLOW_WATERMARK_DECISION_LOG_FILE"
+	EnvRaterCountWindow                 = "NUMAFLOW_RATER_COUNT_WINDOW"
+	EnvDaemonLeaderElection             = "NUMAFLOW_DAEMON_LEADER_ELECTION"
+	EnvPeekRedactHeaders                = "NUMAFLOW_PEEK_REDACT_HEADERS"
+	EnvPeekRedactPayload                = "NUMAFLOW_PEEK_REDACT_PAYLOAD"
 
 	EnvK8sServerVersion = "K8S_SERVER_VERSION"
 
 	PathVarRun                  = "/var/run/numaflow"
+	PathRuntimeLimits           = "/var/numaflow/runtime-limits"
+	KeyRuntimeLimits            = "limits.json"
+	PathISBEncryptionKeys       = "/var/numaflow/isb-encryption"
 	VertexMetricsPort           = 2469
 	VertexMetricsPortName       = "metrics"
 	VertexHTTPSPort             = 8443
@@ -168,12 +197,27 @@ const (
 	DefaultRequeueAfter = 10 * time.Second
 
 	PathSideInputsMount = "/var/numaflow/side-inputs"
+	// PathDaemonRaterState is where the daemon server persists the timestamped counts of the rater, so that the
+	// processing rates are restored when the daemon server container restarts.
+	PathDaemonRaterState = "/var/numaflow/rater"
+	// DaemonRoleName is the name of the ClusterRole, or the Role of a namespaced installation, the service account of
+	// the daemon server is bound to, for the leader election, the events and the status of the pipeline.
+	DaemonRoleName = "numaflow-daemon-role"
 
 	// ISB
 	DefaultBufferLength     = 30000
 	DefaultBufferUsageLimit = 0.8
 	DefaultReadBatchSize    = 500
 	DefaultReadTimeout      = 1 * time.Second
+	// BackfillReadBatchSize is the read batch size in the backfill mode, unless a larger one is configured
+	BackfillReadBatchSize = 5000
+
+	// DefaultFutureEventTimeBound is how far ahead of the wall clock an event time can be before it is counted as a future event time
+	DefaultFutureEventTimeBound = 1 * time.Minute
+	// DefaultSlowBatchThreshold is how long forwarding a batch can take before its stage breakdown is logged
+	DefaultSlowBatchThreshold = 10 * time.Second
+	// DefaultOrphanedBuffersGracePeriod is how long an orphaned buffer or bucket exists before it can be cleaned up
+	DefaultOrphanedBuffersGracePeriod = 1 * time.Hour
 
 	// Auto scaling
 	DefaultLookbackSeconds          = 120 // Default lookback seconds for calculating avg rate and pending
@@ -246,6 +290,19 @@ const (
 	CallbackEnabledKey = "numaflow.numaproj.io/callback"
 	CallbackURLKey     = "numaflow.numaproj.io/callback-url"
 
+	// KeyBackfill is the annotation of the pipeline to switch it to the backfill mode, it's copied to the vertices
+	KeyBackfill = "numaflow.numaproj.io/backfill"
+	// KeyLagSLO is the annotation of the pipeline to configure the consumer lag SLOs of the buffers in JSON
+	KeyLagSLO = "numaflow.numaproj.io/lag-slo"
+	// KeyStorageQuota is the annotation of the pipeline to configure the quota of the storage used by its buffers in JSON
+	KeyStorageQuota = "numaflow.numaproj.io/storage-quota"
+	// KeyStorageQuotaPaused is the annotation of a source vertex set by the controller when the reading is paused
+	// because the storage quota of the pipeline is exceeded
+	KeyStorageQuotaPaused = "numaflow.numaproj.io/storage-quota-paused"
+	// KeyPartitionPaused is the metadata of the consumer of a buffer partition, the readers of the partition do not
+	// receive new messages while it's "true"
+	KeyPartitionPaused = "numaflow.numaproj.io/partition-paused"
+
 	// Serving source
 	DefaultServingTTL = 24 * time.Hour
 
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import "fmt"
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type Edge struct {
 	From string `json:"from" protobuf:"bytes,1,opt,name=from"`
@@ -30,6 +34,44 @@ type Edge struct {
 	// +kubebuilder:validation:Enum=retryUntilSuccess;discardLatest
 	// +optional
 	OnFull *BufferFullWritingStrategy `json:"onFull,omitempty" protobuf:"bytes,4,opt,name=onFull"`
+	// BufferConfig overrides the config of the inter step buffers the edge writes to, e.g. a larger max length for
+	// the edge to a slow sink. It's in the same format as the buffer config of the ISB Service, e.g. the stream
+	// config of JetStream, and is merged on top of it. The edges to the same vertex must have the same buffer config.
+	// It only applies to JetStream.
+	// +optional
+	BufferConfig *string `json:"bufferConfig,omitempty" protobuf:"bytes,5,opt,name=bufferConfig"`
+	// Retention sets the retention of the inter step buffers the edge writes to, it takes precedence over the
+	// retention settings of the buffer config. The edges to the same vertex must have the same retention.
+	// +optional
+	Retention *BufferRetention `json:"retention,omitempty" protobuf:"bytes,6,opt,name=retention"`
+}
+
+// BufferDiscardPolicy is the policy of discarding the messages when a retention limit of a buffer is reached.
+type BufferDiscardPolicy string
+
+const (
+	// BufferDiscardOld discards the oldest messages to make room for the new ones.
+	BufferDiscardOld BufferDiscardPolicy = "old"
+	// BufferDiscardNew rejects the new messages, the writers retry until there is room.
+	BufferDiscardNew BufferDiscardPolicy = "new"
+)
+
+// BufferRetention describes the retention limits of the inter step buffers, the limits not set are not applied.
+type BufferRetention struct {
+	// MaxAge is the max age of the messages in the buffers, the older messages are discarded.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty" protobuf:"bytes,1,opt,name=maxAge"`
+	// MaxMessages is the max number of messages in the buffers.
+	// +optional
+	MaxMessages *int64 `json:"maxMessages,omitempty" protobuf:"varint,2,opt,name=maxMessages"`
+	// MaxBytes is the max size of the messages in the buffers, in bytes. It only applies to JetStream.
+	// +optional
+	MaxBytes *int64 `json:"maxBytes,omitempty" protobuf:"varint,3,opt,name=maxBytes"`
+	// Discard is the policy when MaxMessages or MaxBytes is reached, "old" discards the oldest messages, and "new"
+	// rejects the new messages. If not provided, the discard policy of the buffer is kept.
+	// +kubebuilder:validation:Enum=old;new
+	// +optional
+	Discard *BufferDiscardPolicy `json:"discard,omitempty" protobuf:"bytes,4,opt,name=discard"`
 }
 
 // CombinedEdge is a combination of Edge and some other properties such as vertex type, partitions, limits.
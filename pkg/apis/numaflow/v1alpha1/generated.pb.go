@@ -246,10 +246,38 @@ func (m *Blackhole) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Blackhole proto.InternalMessageInfo
 
+func (m *BufferRetention) Reset()      { *m = BufferRetention{} }
+func (*BufferRetention) ProtoMessage() {}
+func (*BufferRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{7}
+}
+func (m *BufferRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BufferRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BufferRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BufferRetention.Merge(m, src)
+}
+func (m *BufferRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *BufferRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_BufferRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BufferRetention proto.InternalMessageInfo
+
 func (m *BufferServiceConfig) Reset()      { *m = BufferServiceConfig{} }
 func (*BufferServiceConfig) ProtoMessage() {}
 func (*BufferServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{7}
+	return fileDescriptor_9d0d1b17d3865563, []int{8}
 }
 func (m *BufferServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +305,7 @@ var xxx_messageInfo_BufferServiceConfig proto.InternalMessageInfo
 func (m *CombinedEdge) Reset()      { *m = CombinedEdge{} }
 func (*CombinedEdge) ProtoMessage() {}
 func (*CombinedEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{8}
+	return fileDescriptor_9d0d1b17d3865563, []int{9}
 }
 func (m *CombinedEdge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +333,7 @@ var xxx_messageInfo_CombinedEdge proto.InternalMessageInfo
 func (m *Container) Reset()      { *m = Container{} }
 func (*Container) ProtoMessage() {}
 func (*Container) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{9}
+	return fileDescriptor_9d0d1b17d3865563, []int{10}
 }
 func (m *Container) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +361,7 @@ var xxx_messageInfo_Container proto.InternalMessageInfo
 func (m *ContainerTemplate) Reset()      { *m = ContainerTemplate{} }
 func (*ContainerTemplate) ProtoMessage() {}
 func (*ContainerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{10}
+	return fileDescriptor_9d0d1b17d3865563, []int{11}
 }
 func (m *ContainerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +389,7 @@ var xxx_messageInfo_ContainerTemplate proto.InternalMessageInfo
 func (m *DaemonTemplate) Reset()      { *m = DaemonTemplate{} }
 func (*DaemonTemplate) ProtoMessage() {}
 func (*DaemonTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{11}
+	return fileDescriptor_9d0d1b17d3865563, []int{12}
 }
 func (m *DaemonTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +417,7 @@ var xxx_messageInfo_DaemonTemplate proto.InternalMessageInfo
 func (m *Edge) Reset()      { *m = Edge{} }
 func (*Edge) ProtoMessage() {}
 func (*Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{12}
+	return fileDescriptor_9d0d1b17d3865563, []int{13}
 }
 func (m *Edge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,7 +445,7 @@ var xxx_messageInfo_Edge proto.InternalMessageInfo
 func (m *FixedWindow) Reset()      { *m = FixedWindow{} }
 func (*FixedWindow) ProtoMessage() {}
 func (*FixedWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{13}
+	return fileDescriptor_9d0d1b17d3865563, []int{14}
 }
 func (m *FixedWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +473,7 @@ var xxx_messageInfo_FixedWindow proto.InternalMessageInfo
 func (m *ForwardConditions) Reset()      { *m = ForwardConditions{} }
 func (*ForwardConditions) ProtoMessage() {}
 func (*ForwardConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{14}
+	return fileDescriptor_9d0d1b17d3865563, []int{15}
 }
 func (m *ForwardConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +501,7 @@ var xxx_messageInfo_ForwardConditions proto.InternalMessageInfo
 func (m *Function) Reset()      { *m = Function{} }
 func (*Function) ProtoMessage() {}
 func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{15}
+	return fileDescriptor_9d0d1b17d3865563, []int{16}
 }
 func (m *Function) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +529,7 @@ var xxx_messageInfo_Function proto.InternalMessageInfo
 func (m *GSSAPI) Reset()      { *m = GSSAPI{} }
 func (*GSSAPI) ProtoMessage() {}
 func (*GSSAPI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{16}
+	return fileDescriptor_9d0d1b17d3865563, []int{17}
 }
 func (m *GSSAPI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,10 +554,66 @@ func (m *GSSAPI) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GSSAPI proto.InternalMessageInfo
 
+func (m *GeneratorRateStep) Reset()      { *m = GeneratorRateStep{} }
+func (*GeneratorRateStep) ProtoMessage() {}
+func (*GeneratorRateStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{18}
+}
+func (m *GeneratorRateStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratorRateStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GeneratorRateStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratorRateStep.Merge(m, src)
+}
+func (m *GeneratorRateStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeneratorRateStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratorRateStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratorRateStep proto.InternalMessageInfo
+
+func (m *GeneratorRuntimeUpdate) Reset()      { *m = GeneratorRuntimeUpdate{} }
+func (*GeneratorRuntimeUpdate) ProtoMessage() {}
+func (*GeneratorRuntimeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{19}
+}
+func (m *GeneratorRuntimeUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratorRuntimeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GeneratorRuntimeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratorRuntimeUpdate.Merge(m, src)
+}
+func (m *GeneratorRuntimeUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeneratorRuntimeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratorRuntimeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratorRuntimeUpdate proto.InternalMessageInfo
+
 func (m *GeneratorSource) Reset()      { *m = GeneratorSource{} }
 func (*GeneratorSource) ProtoMessage() {}
 func (*GeneratorSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{17}
+	return fileDescriptor_9d0d1b17d3865563, []int{20}
 }
 func (m *GeneratorSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,7 +641,7 @@ var xxx_messageInfo_GeneratorSource proto.InternalMessageInfo
 func (m *GetDaemonDeploymentReq) Reset()      { *m = GetDaemonDeploymentReq{} }
 func (*GetDaemonDeploymentReq) ProtoMessage() {}
 func (*GetDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{18}
+	return fileDescriptor_9d0d1b17d3865563, []int{21}
 }
 func (m *GetDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +669,7 @@ var xxx_messageInfo_GetDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetJetStreamServiceSpecReq) Reset()      { *m = GetJetStreamServiceSpecReq{} }
 func (*GetJetStreamServiceSpecReq) ProtoMessage() {}
 func (*GetJetStreamServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{19}
+	return fileDescriptor_9d0d1b17d3865563, []int{22}
 }
 func (m *GetJetStreamServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,7 +697,7 @@ var xxx_messageInfo_GetJetStreamServiceSpecReq proto.InternalMessageInfo
 func (m *GetJetStreamStatefulSetSpecReq) Reset()      { *m = GetJetStreamStatefulSetSpecReq{} }
 func (*GetJetStreamStatefulSetSpecReq) ProtoMessage() {}
 func (*GetJetStreamStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{20}
+	return fileDescriptor_9d0d1b17d3865563, []int{23}
 }
 func (m *GetJetStreamStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +725,7 @@ var xxx_messageInfo_GetJetStreamStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetMonoVertexDaemonDeploymentReq) Reset()      { *m = GetMonoVertexDaemonDeploymentReq{} }
 func (*GetMonoVertexDaemonDeploymentReq) ProtoMessage() {}
 func (*GetMonoVertexDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{21}
+	return fileDescriptor_9d0d1b17d3865563, []int{24}
 }
 func (m *GetMonoVertexDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +753,7 @@ var xxx_messageInfo_GetMonoVertexDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetMonoVertexPodSpecReq) Reset()      { *m = GetMonoVertexPodSpecReq{} }
 func (*GetMonoVertexPodSpecReq) ProtoMessage() {}
 func (*GetMonoVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{22}
+	return fileDescriptor_9d0d1b17d3865563, []int{25}
 }
 func (m *GetMonoVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +781,7 @@ var xxx_messageInfo_GetMonoVertexPodSpecReq proto.InternalMessageInfo
 func (m *GetRedisServiceSpecReq) Reset()      { *m = GetRedisServiceSpecReq{} }
 func (*GetRedisServiceSpecReq) ProtoMessage() {}
 func (*GetRedisServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{23}
+	return fileDescriptor_9d0d1b17d3865563, []int{26}
 }
 func (m *GetRedisServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +809,7 @@ var xxx_messageInfo_GetRedisServiceSpecReq proto.InternalMessageInfo
 func (m *GetRedisStatefulSetSpecReq) Reset()      { *m = GetRedisStatefulSetSpecReq{} }
 func (*GetRedisStatefulSetSpecReq) ProtoMessage() {}
 func (*GetRedisStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{24}
+	return fileDescriptor_9d0d1b17d3865563, []int{27}
 }
 func (m *GetRedisStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +837,7 @@ var xxx_messageInfo_GetRedisStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetSideInputDeploymentReq) Reset()      { *m = GetSideInputDeploymentReq{} }
 func (*GetSideInputDeploymentReq) ProtoMessage() {}
 func (*GetSideInputDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{25}
+	return fileDescriptor_9d0d1b17d3865563, []int{28}
 }
 func (m *GetSideInputDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +865,7 @@ var xxx_messageInfo_GetSideInputDeploymentReq proto.InternalMessageInfo
 func (m *GetVertexPodSpecReq) Reset()      { *m = GetVertexPodSpecReq{} }
 func (*GetVertexPodSpecReq) ProtoMessage() {}
 func (*GetVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{26}
+	return fileDescriptor_9d0d1b17d3865563, []int{29}
 }
 func (m *GetVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,7 +893,7 @@ var xxx_messageInfo_GetVertexPodSpecReq proto.InternalMessageInfo
 func (m *GroupBy) Reset()      { *m = GroupBy{} }
 func (*GroupBy) ProtoMessage() {}
 func (*GroupBy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{27}
+	return fileDescriptor_9d0d1b17d3865563, []int{30}
 }
 func (m *GroupBy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +921,7 @@ var xxx_messageInfo_GroupBy proto.InternalMessageInfo
 func (m *HTTPSource) Reset()      { *m = HTTPSource{} }
 func (*HTTPSource) ProtoMessage() {}
 func (*HTTPSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{28}
+	return fileDescriptor_9d0d1b17d3865563, []int{31}
 }
 func (m *HTTPSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,10 +946,66 @@ func (m *HTTPSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPSource proto.InternalMessageInfo
 
+func (m *ISBEncryption) Reset()      { *m = ISBEncryption{} }
+func (*ISBEncryption) ProtoMessage() {}
+func (*ISBEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{32}
+}
+func (m *ISBEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ISBEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ISBEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ISBEncryption.Merge(m, src)
+}
+func (m *ISBEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ISBEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ISBEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ISBEncryption proto.InternalMessageInfo
+
+func (m *ISBPipelineQuota) Reset()      { *m = ISBPipelineQuota{} }
+func (*ISBPipelineQuota) ProtoMessage() {}
+func (*ISBPipelineQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{33}
+}
+func (m *ISBPipelineQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ISBPipelineQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ISBPipelineQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ISBPipelineQuota.Merge(m, src)
+}
+func (m *ISBPipelineQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ISBPipelineQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ISBPipelineQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ISBPipelineQuota proto.InternalMessageInfo
+
 func (m *IdleSource) Reset()      { *m = IdleSource{} }
 func (*IdleSource) ProtoMessage() {}
 func (*IdleSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{29}
+	return fileDescriptor_9d0d1b17d3865563, []int{34}
 }
 func (m *IdleSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +1033,7 @@ var xxx_messageInfo_IdleSource proto.InternalMessageInfo
 func (m *InterStepBufferService) Reset()      { *m = InterStepBufferService{} }
 func (*InterStepBufferService) ProtoMessage() {}
 func (*InterStepBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{30}
+	return fileDescriptor_9d0d1b17d3865563, []int{35}
 }
 func (m *InterStepBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,7 +1061,7 @@ var xxx_messageInfo_InterStepBufferService proto.InternalMessageInfo
 func (m *InterStepBufferServiceList) Reset()      { *m = InterStepBufferServiceList{} }
 func (*InterStepBufferServiceList) ProtoMessage() {}
 func (*InterStepBufferServiceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{31}
+	return fileDescriptor_9d0d1b17d3865563, []int{36}
 }
 func (m *InterStepBufferServiceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1089,7 @@ var xxx_messageInfo_InterStepBufferServiceList proto.InternalMessageInfo
 func (m *InterStepBufferServiceSpec) Reset()      { *m = InterStepBufferServiceSpec{} }
 func (*InterStepBufferServiceSpec) ProtoMessage() {}
 func (*InterStepBufferServiceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{32}
+	return fileDescriptor_9d0d1b17d3865563, []int{37}
 }
 func (m *InterStepBufferServiceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,7 +1117,7 @@ var xxx_messageInfo_InterStepBufferServiceSpec proto.InternalMessageInfo
 func (m *InterStepBufferServiceStatus) Reset()      { *m = InterStepBufferServiceStatus{} }
 func (*InterStepBufferServiceStatus) ProtoMessage() {}
 func (*InterStepBufferServiceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{33}
+	return fileDescriptor_9d0d1b17d3865563, []int{38}
 }
 func (m *InterStepBufferServiceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,7 +1145,7 @@ var xxx_messageInfo_InterStepBufferServiceStatus proto.InternalMessageInfo
 func (m *JetStreamBufferService) Reset()      { *m = JetStreamBufferService{} }
 func (*JetStreamBufferService) ProtoMessage() {}
 func (*JetStreamBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{34}
+	return fileDescriptor_9d0d1b17d3865563, []int{39}
 }
 func (m *JetStreamBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1033,7 +1173,7 @@ var xxx_messageInfo_JetStreamBufferService proto.InternalMessageInfo
 func (m *JetStreamConfig) Reset()      { *m = JetStreamConfig{} }
 func (*JetStreamConfig) ProtoMessage() {}
 func (*JetStreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{35}
+	return fileDescriptor_9d0d1b17d3865563, []int{40}
 }
 func (m *JetStreamConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,7 +1201,7 @@ var xxx_messageInfo_JetStreamConfig proto.InternalMessageInfo
 func (m *JetStreamSource) Reset()      { *m = JetStreamSource{} }
 func (*JetStreamSource) ProtoMessage() {}
 func (*JetStreamSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{36}
+	return fileDescriptor_9d0d1b17d3865563, []int{41}
 }
 func (m *JetStreamSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1229,7 @@ var xxx_messageInfo_JetStreamSource proto.InternalMessageInfo
 func (m *JobTemplate) Reset()      { *m = JobTemplate{} }
 func (*JobTemplate) ProtoMessage() {}
 func (*JobTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{37}
+	return fileDescriptor_9d0d1b17d3865563, []int{42}
 }
 func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,10 +1254,94 @@ func (m *JobTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
 
+func (m *KafkaBufferService) Reset()      { *m = KafkaBufferService{} }
+func (*KafkaBufferService) ProtoMessage() {}
+func (*KafkaBufferService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{43}
+}
+func (m *KafkaBufferService) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaBufferService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaBufferService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaBufferService.Merge(m, src)
+}
+func (m *KafkaBufferService) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaBufferService) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaBufferService.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaBufferService proto.InternalMessageInfo
+
+func (m *KafkaConfig) Reset()      { *m = KafkaConfig{} }
+func (*KafkaConfig) ProtoMessage() {}
+func (*KafkaConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{44}
+}
+func (m *KafkaConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaConfig.Merge(m, src)
+}
+func (m *KafkaConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaConfig proto.InternalMessageInfo
+
+func (m *KafkaSASLAuth) Reset()      { *m = KafkaSASLAuth{} }
+func (*KafkaSASLAuth) ProtoMessage() {}
+func (*KafkaSASLAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{45}
+}
+func (m *KafkaSASLAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaSASLAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaSASLAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaSASLAuth.Merge(m, src)
+}
+func (m *KafkaSASLAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaSASLAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaSASLAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaSASLAuth proto.InternalMessageInfo
+
 func (m *KafkaSink) Reset()      { *m = KafkaSink{} }
 func (*KafkaSink) ProtoMessage() {}
 func (*KafkaSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{38}
+	return fileDescriptor_9d0d1b17d3865563, []int{46}
 }
 func (m *KafkaSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1145,7 +1369,7 @@ var xxx_messageInfo_KafkaSink proto.InternalMessageInfo
 func (m *KafkaSource) Reset()      { *m = KafkaSource{} }
 func (*KafkaSource) ProtoMessage() {}
 func (*KafkaSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{39}
+	return fileDescriptor_9d0d1b17d3865563, []int{47}
 }
 func (m *KafkaSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,7 +1397,7 @@ var xxx_messageInfo_KafkaSource proto.InternalMessageInfo
 func (m *Lifecycle) Reset()      { *m = Lifecycle{} }
 func (*Lifecycle) ProtoMessage() {}
 func (*Lifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{40}
+	return fileDescriptor_9d0d1b17d3865563, []int{48}
 }
 func (m *Lifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1425,7 @@ var xxx_messageInfo_Lifecycle proto.InternalMessageInfo
 func (m *Log) Reset()      { *m = Log{} }
 func (*Log) ProtoMessage() {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{41}
+	return fileDescriptor_9d0d1b17d3865563, []int{49}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,7 +1453,7 @@ var xxx_messageInfo_Log proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{42}
+	return fileDescriptor_9d0d1b17d3865563, []int{50}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1257,7 +1481,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MonoVertex) Reset()      { *m = MonoVertex{} }
 func (*MonoVertex) ProtoMessage() {}
 func (*MonoVertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{43}
+	return fileDescriptor_9d0d1b17d3865563, []int{51}
 }
 func (m *MonoVertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1285,7 +1509,7 @@ var xxx_messageInfo_MonoVertex proto.InternalMessageInfo
 func (m *MonoVertexLifecycle) Reset()      { *m = MonoVertexLifecycle{} }
 func (*MonoVertexLifecycle) ProtoMessage() {}
 func (*MonoVertexLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{44}
+	return fileDescriptor_9d0d1b17d3865563, []int{52}
 }
 func (m *MonoVertexLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +1537,7 @@ var xxx_messageInfo_MonoVertexLifecycle proto.InternalMessageInfo
 func (m *MonoVertexLimits) Reset()      { *m = MonoVertexLimits{} }
 func (*MonoVertexLimits) ProtoMessage() {}
 func (*MonoVertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{45}
+	return fileDescriptor_9d0d1b17d3865563, []int{53}
 }
 func (m *MonoVertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1565,7 @@ var xxx_messageInfo_MonoVertexLimits proto.InternalMessageInfo
 func (m *MonoVertexList) Reset()      { *m = MonoVertexList{} }
 func (*MonoVertexList) ProtoMessage() {}
 func (*MonoVertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{46}
+	return fileDescriptor_9d0d1b17d3865563, []int{54}
 }
 func (m *MonoVertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1369,7 +1593,7 @@ var xxx_messageInfo_MonoVertexList proto.InternalMessageInfo
 func (m *MonoVertexSpec) Reset()      { *m = MonoVertexSpec{} }
 func (*MonoVertexSpec) ProtoMessage() {}
 func (*MonoVertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{47}
+	return fileDescriptor_9d0d1b17d3865563, []int{55}
 }
 func (m *MonoVertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1621,7 @@ var xxx_messageInfo_MonoVertexSpec proto.InternalMessageInfo
 func (m *MonoVertexStatus) Reset()      { *m = MonoVertexStatus{} }
 func (*MonoVertexStatus) ProtoMessage() {}
 func (*MonoVertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{48}
+	return fileDescriptor_9d0d1b17d3865563, []int{56}
 }
 func (m *MonoVertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,7 +1649,7 @@ var xxx_messageInfo_MonoVertexStatus proto.InternalMessageInfo
 func (m *NativeRedis) Reset()      { *m = NativeRedis{} }
 func (*NativeRedis) ProtoMessage() {}
 func (*NativeRedis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{49}
+	return fileDescriptor_9d0d1b17d3865563, []int{57}
 }
 func (m *NativeRedis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1677,7 @@ var xxx_messageInfo_NativeRedis proto.InternalMessageInfo
 func (m *NatsAuth) Reset()      { *m = NatsAuth{} }
 func (*NatsAuth) ProtoMessage() {}
 func (*NatsAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{50}
+	return fileDescriptor_9d0d1b17d3865563, []int{58}
 }
 func (m *NatsAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1481,7 +1705,7 @@ var xxx_messageInfo_NatsAuth proto.InternalMessageInfo
 func (m *NatsSource) Reset()      { *m = NatsSource{} }
 func (*NatsSource) ProtoMessage() {}
 func (*NatsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{51}
+	return fileDescriptor_9d0d1b17d3865563, []int{59}
 }
 func (m *NatsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1509,7 +1733,7 @@ var xxx_messageInfo_NatsSource proto.InternalMessageInfo
 func (m *NoStore) Reset()      { *m = NoStore{} }
 func (*NoStore) ProtoMessage() {}
 func (*NoStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{52}
+	return fileDescriptor_9d0d1b17d3865563, []int{60}
 }
 func (m *NoStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1537,7 +1761,7 @@ var xxx_messageInfo_NoStore proto.InternalMessageInfo
 func (m *PBQStorage) Reset()      { *m = PBQStorage{} }
 func (*PBQStorage) ProtoMessage() {}
 func (*PBQStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{53}
+	return fileDescriptor_9d0d1b17d3865563, []int{61}
 }
 func (m *PBQStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,10 +1786,38 @@ func (m *PBQStorage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PBQStorage proto.InternalMessageInfo
 
+func (m *PassThrough) Reset()      { *m = PassThrough{} }
+func (*PassThrough) ProtoMessage() {}
+func (*PassThrough) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{62}
+}
+func (m *PassThrough) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PassThrough) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PassThrough) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassThrough.Merge(m, src)
+}
+func (m *PassThrough) XXX_Size() int {
+	return m.Size()
+}
+func (m *PassThrough) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassThrough.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassThrough proto.InternalMessageInfo
+
 func (m *PersistenceStrategy) Reset()      { *m = PersistenceStrategy{} }
 func (*PersistenceStrategy) ProtoMessage() {}
 func (*PersistenceStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{54}
+	return fileDescriptor_9d0d1b17d3865563, []int{63}
 }
 func (m *PersistenceStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1845,7 @@ var xxx_messageInfo_PersistenceStrategy proto.InternalMessageInfo
 func (m *Pipeline) Reset()      { *m = Pipeline{} }
 func (*Pipeline) ProtoMessage() {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{55}
+	return fileDescriptor_9d0d1b17d3865563, []int{64}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1621,7 +1873,7 @@ var xxx_messageInfo_Pipeline proto.InternalMessageInfo
 func (m *PipelineLimits) Reset()      { *m = PipelineLimits{} }
 func (*PipelineLimits) ProtoMessage() {}
 func (*PipelineLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{56}
+	return fileDescriptor_9d0d1b17d3865563, []int{65}
 }
 func (m *PipelineLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1649,7 +1901,7 @@ var xxx_messageInfo_PipelineLimits proto.InternalMessageInfo
 func (m *PipelineList) Reset()      { *m = PipelineList{} }
 func (*PipelineList) ProtoMessage() {}
 func (*PipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{57}
+	return fileDescriptor_9d0d1b17d3865563, []int{66}
 }
 func (m *PipelineList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1677,7 +1929,7 @@ var xxx_messageInfo_PipelineList proto.InternalMessageInfo
 func (m *PipelineSpec) Reset()      { *m = PipelineSpec{} }
 func (*PipelineSpec) ProtoMessage() {}
 func (*PipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{58}
+	return fileDescriptor_9d0d1b17d3865563, []int{67}
 }
 func (m *PipelineSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1705,7 +1957,7 @@ var xxx_messageInfo_PipelineSpec proto.InternalMessageInfo
 func (m *PipelineStatus) Reset()      { *m = PipelineStatus{} }
 func (*PipelineStatus) ProtoMessage() {}
 func (*PipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{59}
+	return fileDescriptor_9d0d1b17d3865563, []int{68}
 }
 func (m *PipelineStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1733,7 +1985,7 @@ var xxx_messageInfo_PipelineStatus proto.InternalMessageInfo
 func (m *Probe) Reset()      { *m = Probe{} }
 func (*Probe) ProtoMessage() {}
 func (*Probe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{60}
+	return fileDescriptor_9d0d1b17d3865563, []int{69}
 }
 func (m *Probe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1761,7 +2013,7 @@ var xxx_messageInfo_Probe proto.InternalMessageInfo
 func (m *PulsarAuth) Reset()      { *m = PulsarAuth{} }
 func (*PulsarAuth) ProtoMessage() {}
 func (*PulsarAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{61}
+	return fileDescriptor_9d0d1b17d3865563, []int{70}
 }
 func (m *PulsarAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,7 +2041,7 @@ var xxx_messageInfo_PulsarAuth proto.InternalMessageInfo
 func (m *PulsarSource) Reset()      { *m = PulsarSource{} }
 func (*PulsarSource) ProtoMessage() {}
 func (*PulsarSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{62}
+	return fileDescriptor_9d0d1b17d3865563, []int{71}
 }
 func (m *PulsarSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1817,7 +2069,7 @@ var xxx_messageInfo_PulsarSource proto.InternalMessageInfo
 func (m *RedisBufferService) Reset()      { *m = RedisBufferService{} }
 func (*RedisBufferService) ProtoMessage() {}
 func (*RedisBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{63}
+	return fileDescriptor_9d0d1b17d3865563, []int{72}
 }
 func (m *RedisBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1845,7 +2097,7 @@ var xxx_messageInfo_RedisBufferService proto.InternalMessageInfo
 func (m *RedisConfig) Reset()      { *m = RedisConfig{} }
 func (*RedisConfig) ProtoMessage() {}
 func (*RedisConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{64}
+	return fileDescriptor_9d0d1b17d3865563, []int{73}
 }
 func (m *RedisConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1873,7 +2125,7 @@ var xxx_messageInfo_RedisConfig proto.InternalMessageInfo
 func (m *RedisSettings) Reset()      { *m = RedisSettings{} }
 func (*RedisSettings) ProtoMessage() {}
 func (*RedisSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{65}
+	return fileDescriptor_9d0d1b17d3865563, []int{74}
 }
 func (m *RedisSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1898,10 +2150,38 @@ func (m *RedisSettings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RedisSettings proto.InternalMessageInfo
 
+func (m *ReplaySource) Reset()      { *m = ReplaySource{} }
+func (*ReplaySource) ProtoMessage() {}
+func (*ReplaySource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{75}
+}
+func (m *ReplaySource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplaySource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReplaySource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplaySource.Merge(m, src)
+}
+func (m *ReplaySource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplaySource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplaySource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplaySource proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{66}
+	return fileDescriptor_9d0d1b17d3865563, []int{76}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1929,7 +2209,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RollingUpdateStrategy) Reset()      { *m = RollingUpdateStrategy{} }
 func (*RollingUpdateStrategy) ProtoMessage() {}
 func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{67}
+	return fileDescriptor_9d0d1b17d3865563, []int{77}
 }
 func (m *RollingUpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1957,7 +2237,7 @@ var xxx_messageInfo_RollingUpdateStrategy proto.InternalMessageInfo
 func (m *SASL) Reset()      { *m = SASL{} }
 func (*SASL) ProtoMessage() {}
 func (*SASL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{68}
+	return fileDescriptor_9d0d1b17d3865563, []int{78}
 }
 func (m *SASL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1985,7 +2265,7 @@ var xxx_messageInfo_SASL proto.InternalMessageInfo
 func (m *SASLOAuth) Reset()      { *m = SASLOAuth{} }
 func (*SASLOAuth) ProtoMessage() {}
 func (*SASLOAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{69}
+	return fileDescriptor_9d0d1b17d3865563, []int{79}
 }
 func (m *SASLOAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2013,7 +2293,7 @@ var xxx_messageInfo_SASLOAuth proto.InternalMessageInfo
 func (m *SASLPlain) Reset()      { *m = SASLPlain{} }
 func (*SASLPlain) ProtoMessage() {}
 func (*SASLPlain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{70}
+	return fileDescriptor_9d0d1b17d3865563, []int{80}
 }
 func (m *SASLPlain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2041,7 +2321,7 @@ var xxx_messageInfo_SASLPlain proto.InternalMessageInfo
 func (m *Scale) Reset()      { *m = Scale{} }
 func (*Scale) ProtoMessage() {}
 func (*Scale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{71}
+	return fileDescriptor_9d0d1b17d3865563, []int{81}
 }
 func (m *Scale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2066,10 +2346,38 @@ func (m *Scale) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Scale proto.InternalMessageInfo
 
+func (m *SequenceValidation) Reset()      { *m = SequenceValidation{} }
+func (*SequenceValidation) ProtoMessage() {}
+func (*SequenceValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{82}
+}
+func (m *SequenceValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SequenceValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceValidation.Merge(m, src)
+}
+func (m *SequenceValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceValidation proto.InternalMessageInfo
+
 func (m *ServingSource) Reset()      { *m = ServingSource{} }
 func (*ServingSource) ProtoMessage() {}
 func (*ServingSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{72}
+	return fileDescriptor_9d0d1b17d3865563, []int{83}
 }
 func (m *ServingSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2097,7 +2405,7 @@ var xxx_messageInfo_ServingSource proto.InternalMessageInfo
 func (m *ServingStore) Reset()      { *m = ServingStore{} }
 func (*ServingStore) ProtoMessage() {}
 func (*ServingStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{73}
+	return fileDescriptor_9d0d1b17d3865563, []int{84}
 }
 func (m *ServingStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2125,7 +2433,7 @@ var xxx_messageInfo_ServingStore proto.InternalMessageInfo
 func (m *SessionWindow) Reset()      { *m = SessionWindow{} }
 func (*SessionWindow) ProtoMessage() {}
 func (*SessionWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{74}
+	return fileDescriptor_9d0d1b17d3865563, []int{85}
 }
 func (m *SessionWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2153,7 +2461,7 @@ var xxx_messageInfo_SessionWindow proto.InternalMessageInfo
 func (m *SideInput) Reset()      { *m = SideInput{} }
 func (*SideInput) ProtoMessage() {}
 func (*SideInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{75}
+	return fileDescriptor_9d0d1b17d3865563, []int{86}
 }
 func (m *SideInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2181,7 +2489,7 @@ var xxx_messageInfo_SideInput proto.InternalMessageInfo
 func (m *SideInputTrigger) Reset()      { *m = SideInputTrigger{} }
 func (*SideInputTrigger) ProtoMessage() {}
 func (*SideInputTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{76}
+	return fileDescriptor_9d0d1b17d3865563, []int{87}
 }
 func (m *SideInputTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2209,7 +2517,7 @@ var xxx_messageInfo_SideInputTrigger proto.InternalMessageInfo
 func (m *SideInputsManagerTemplate) Reset()      { *m = SideInputsManagerTemplate{} }
 func (*SideInputsManagerTemplate) ProtoMessage() {}
 func (*SideInputsManagerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{77}
+	return fileDescriptor_9d0d1b17d3865563, []int{88}
 }
 func (m *SideInputsManagerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2237,7 +2545,7 @@ var xxx_messageInfo_SideInputsManagerTemplate proto.InternalMessageInfo
 func (m *Sink) Reset()      { *m = Sink{} }
 func (*Sink) ProtoMessage() {}
 func (*Sink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{78}
+	return fileDescriptor_9d0d1b17d3865563, []int{89}
 }
 func (m *Sink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2265,7 +2573,7 @@ var xxx_messageInfo_Sink proto.InternalMessageInfo
 func (m *SlidingWindow) Reset()      { *m = SlidingWindow{} }
 func (*SlidingWindow) ProtoMessage() {}
 func (*SlidingWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{79}
+	return fileDescriptor_9d0d1b17d3865563, []int{90}
 }
 func (m *SlidingWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2293,7 +2601,7 @@ var xxx_messageInfo_SlidingWindow proto.InternalMessageInfo
 func (m *Source) Reset()      { *m = Source{} }
 func (*Source) ProtoMessage() {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{80}
+	return fileDescriptor_9d0d1b17d3865563, []int{91}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2321,7 +2629,7 @@ var xxx_messageInfo_Source proto.InternalMessageInfo
 func (m *Status) Reset()      { *m = Status{} }
 func (*Status) ProtoMessage() {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{81}
+	return fileDescriptor_9d0d1b17d3865563, []int{92}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2349,7 +2657,7 @@ var xxx_messageInfo_Status proto.InternalMessageInfo
 func (m *TLS) Reset()      { *m = TLS{} }
 func (*TLS) ProtoMessage() {}
 func (*TLS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{82}
+	return fileDescriptor_9d0d1b17d3865563, []int{93}
 }
 func (m *TLS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2377,7 +2685,7 @@ var xxx_messageInfo_TLS proto.InternalMessageInfo
 func (m *TagConditions) Reset()      { *m = TagConditions{} }
 func (*TagConditions) ProtoMessage() {}
 func (*TagConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{83}
+	return fileDescriptor_9d0d1b17d3865563, []int{94}
 }
 func (m *TagConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2405,7 +2713,7 @@ var xxx_messageInfo_TagConditions proto.InternalMessageInfo
 func (m *Templates) Reset()      { *m = Templates{} }
 func (*Templates) ProtoMessage() {}
 func (*Templates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{84}
+	return fileDescriptor_9d0d1b17d3865563, []int{95}
 }
 func (m *Templates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2433,7 +2741,7 @@ var xxx_messageInfo_Templates proto.InternalMessageInfo
 func (m *Transformer) Reset()      { *m = Transformer{} }
 func (*Transformer) ProtoMessage() {}
 func (*Transformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{85}
+	return fileDescriptor_9d0d1b17d3865563, []int{96}
 }
 func (m *Transformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2461,7 +2769,7 @@ var xxx_messageInfo_Transformer proto.InternalMessageInfo
 func (m *UDF) Reset()      { *m = UDF{} }
 func (*UDF) ProtoMessage() {}
 func (*UDF) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{86}
+	return fileDescriptor_9d0d1b17d3865563, []int{97}
 }
 func (m *UDF) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2489,7 +2797,7 @@ var xxx_messageInfo_UDF proto.InternalMessageInfo
 func (m *UDSink) Reset()      { *m = UDSink{} }
 func (*UDSink) ProtoMessage() {}
 func (*UDSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{87}
+	return fileDescriptor_9d0d1b17d3865563, []int{98}
 }
 func (m *UDSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2517,7 +2825,7 @@ var xxx_messageInfo_UDSink proto.InternalMessageInfo
 func (m *UDSource) Reset()      { *m = UDSource{} }
 func (*UDSource) ProtoMessage() {}
 func (*UDSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{88}
+	return fileDescriptor_9d0d1b17d3865563, []int{99}
 }
 func (m *UDSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2545,7 +2853,7 @@ var xxx_messageInfo_UDSource proto.InternalMessageInfo
 func (m *UDTransformer) Reset()      { *m = UDTransformer{} }
 func (*UDTransformer) ProtoMessage() {}
 func (*UDTransformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{89}
+	return fileDescriptor_9d0d1b17d3865563, []int{100}
 }
 func (m *UDTransformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2573,7 +2881,7 @@ var xxx_messageInfo_UDTransformer proto.InternalMessageInfo
 func (m *UpdateStrategy) Reset()      { *m = UpdateStrategy{} }
 func (*UpdateStrategy) ProtoMessage() {}
 func (*UpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{90}
+	return fileDescriptor_9d0d1b17d3865563, []int{101}
 }
 func (m *UpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2598,10 +2906,38 @@ func (m *UpdateStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateStrategy proto.InternalMessageInfo
 
+func (m *VaultTransit) Reset()      { *m = VaultTransit{} }
+func (*VaultTransit) ProtoMessage() {}
+func (*VaultTransit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{102}
+}
+func (m *VaultTransit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultTransit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VaultTransit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultTransit.Merge(m, src)
+}
+func (m *VaultTransit) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultTransit) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultTransit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultTransit proto.InternalMessageInfo
+
 func (m *Vertex) Reset()      { *m = Vertex{} }
 func (*Vertex) ProtoMessage() {}
 func (*Vertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{91}
+	return fileDescriptor_9d0d1b17d3865563, []int{103}
 }
 func (m *Vertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2629,7 +2965,7 @@ var xxx_messageInfo_Vertex proto.InternalMessageInfo
 func (m *VertexInstance) Reset()      { *m = VertexInstance{} }
 func (*VertexInstance) ProtoMessage() {}
 func (*VertexInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{92}
+	return fileDescriptor_9d0d1b17d3865563, []int{104}
 }
 func (m *VertexInstance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2657,7 +2993,7 @@ var xxx_messageInfo_VertexInstance proto.InternalMessageInfo
 func (m *VertexLifecycle) Reset()      { *m = VertexLifecycle{} }
 func (*VertexLifecycle) ProtoMessage() {}
 func (*VertexLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{93}
+	return fileDescriptor_9d0d1b17d3865563, []int{105}
 }
 func (m *VertexLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2685,7 +3021,7 @@ var xxx_messageInfo_VertexLifecycle proto.InternalMessageInfo
 func (m *VertexLimits) Reset()      { *m = VertexLimits{} }
 func (*VertexLimits) ProtoMessage() {}
 func (*VertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{94}
+	return fileDescriptor_9d0d1b17d3865563, []int{106}
 }
 func (m *VertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2713,7 +3049,7 @@ var xxx_messageInfo_VertexLimits proto.InternalMessageInfo
 func (m *VertexList) Reset()      { *m = VertexList{} }
 func (*VertexList) ProtoMessage() {}
 func (*VertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{95}
+	return fileDescriptor_9d0d1b17d3865563, []int{107}
 }
 func (m *VertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2741,7 +3077,7 @@ var xxx_messageInfo_VertexList proto.InternalMessageInfo
 func (m *VertexSpec) Reset()      { *m = VertexSpec{} }
 func (*VertexSpec) ProtoMessage() {}
 func (*VertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{96}
+	return fileDescriptor_9d0d1b17d3865563, []int{108}
 }
 func (m *VertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2769,7 +3105,7 @@ var xxx_messageInfo_VertexSpec proto.InternalMessageInfo
 func (m *VertexStatus) Reset()      { *m = VertexStatus{} }
 func (*VertexStatus) ProtoMessage() {}
 func (*VertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{97}
+	return fileDescriptor_9d0d1b17d3865563, []int{109}
 }
 func (m *VertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2797,7 +3133,7 @@ var xxx_messageInfo_VertexStatus proto.InternalMessageInfo
 func (m *VertexTemplate) Reset()      { *m = VertexTemplate{} }
 func (*VertexTemplate) ProtoMessage() {}
 func (*VertexTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{98}
+	return fileDescriptor_9d0d1b17d3865563, []int{110}
 }
 func (m *VertexTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2825,7 +3161,7 @@ var xxx_messageInfo_VertexTemplate proto.InternalMessageInfo
 func (m *Watermark) Reset()      { *m = Watermark{} }
 func (*Watermark) ProtoMessage() {}
 func (*Watermark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{99}
+	return fileDescriptor_9d0d1b17d3865563, []int{111}
 }
 func (m *Watermark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2853,7 +3189,7 @@ var xxx_messageInfo_Watermark proto.InternalMessageInfo
 func (m *Window) Reset()      { *m = Window{} }
 func (*Window) ProtoMessage() {}
 func (*Window) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{100}
+	return fileDescriptor_9d0d1b17d3865563, []int{112}
 }
 func (m *Window) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2887,6 +3223,7 @@ func init() {
 	proto.RegisterType((*Backoff)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Blackhole)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Blackhole")
+	proto.RegisterType((*BufferRetention)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BufferRetention")
 	proto.RegisterType((*BufferServiceConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BufferServiceConfig")
 	proto.RegisterType((*CombinedEdge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CombinedEdge")
 	proto.RegisterType((*Container)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Container")
@@ -2898,7 +3235,10 @@ func init() {
 	proto.RegisterType((*Function)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function.KwargsEntry")
 	proto.RegisterType((*GSSAPI)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GSSAPI")
+	proto.RegisterType((*GeneratorRateStep)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorRateStep")
+	proto.RegisterType((*GeneratorRuntimeUpdate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorRuntimeUpdate")
 	proto.RegisterType((*GeneratorSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorSource.HeadersEntry")
 	proto.RegisterType((*GetDaemonDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetDaemonDeploymentReq")
 	proto.RegisterType((*GetJetStreamServiceSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetJetStreamServiceSpecReq")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetJetStreamServiceSpecReq.LabelsEntry")
@@ -2914,6 +3254,8 @@ func init() {
 	proto.RegisterType((*GetVertexPodSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetVertexPodSpecReq")
 	proto.RegisterType((*GroupBy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GroupBy")
 	proto.RegisterType((*HTTPSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSource")
+	proto.RegisterType((*ISBEncryption)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ISBEncryption")
+	proto.RegisterType((*ISBPipelineQuota)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ISBPipelineQuota")
 	proto.RegisterType((*IdleSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.IdleSource")
 	proto.RegisterType((*InterStepBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferService")
 	proto.RegisterType((*InterStepBufferServiceList)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferServiceList")
@@ -2923,6 +3265,9 @@ func init() {
 	proto.RegisterType((*JetStreamConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JetStreamConfig")
 	proto.RegisterType((*JetStreamSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JetStreamSource")
 	proto.RegisterType((*JobTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JobTemplate")
+	proto.RegisterType((*KafkaBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaBufferService")
+	proto.RegisterType((*KafkaConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaConfig")
+	proto.RegisterType((*KafkaSASLAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSASLAuth")
 	proto.RegisterType((*KafkaSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSink")
 	proto.RegisterType((*KafkaSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSource")
 	proto.RegisterType((*Lifecycle)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Lifecycle")
@@ -2941,6 +3286,7 @@ func init() {
 	proto.RegisterType((*NatsSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NatsSource")
 	proto.RegisterType((*NoStore)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.NoStore")
 	proto.RegisterType((*PBQStorage)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PBQStorage")
+	proto.RegisterType((*PassThrough)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PassThrough")
 	proto.RegisterType((*PersistenceStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PersistenceStrategy")
 	proto.RegisterType((*Pipeline)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Pipeline")
 	proto.RegisterType((*PipelineLimits)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.PipelineLimits")
@@ -2953,12 +3299,14 @@ func init() {
 	proto.RegisterType((*RedisBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisBufferService")
 	proto.RegisterType((*RedisConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisConfig")
 	proto.RegisterType((*RedisSettings)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RedisSettings")
+	proto.RegisterType((*ReplaySource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ReplaySource")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RollingUpdateStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.RollingUpdateStrategy")
 	proto.RegisterType((*SASL)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASL")
 	proto.RegisterType((*SASLOAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASLOAuth")
 	proto.RegisterType((*SASLPlain)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SASLPlain")
 	proto.RegisterType((*Scale)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Scale")
+	proto.RegisterType((*SequenceValidation)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SequenceValidation")
 	proto.RegisterType((*ServingSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ServingSource")
 	proto.RegisterType((*ServingStore)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ServingStore")
 	proto.RegisterType((*SessionWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.SessionWindow")
@@ -2979,6 +3327,7 @@ func init() {
 	proto.RegisterType((*UDSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.UDSource")
 	proto.RegisterType((*UDTransformer)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.UDTransformer")
 	proto.RegisterType((*UpdateStrategy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.UpdateStrategy")
+	proto.RegisterType((*VaultTransit)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.VaultTransit")
 	proto.RegisterType((*Vertex)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Vertex")
 	proto.RegisterType((*VertexInstance)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.VertexInstance")
 	proto.RegisterType((*VertexLifecycle)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.VertexLifecycle")
@@ -2996,532 +3345,617 @@ func init() {
 }
 
 var fileDescriptor_9d0d1b17d3865563 = []byte{
-	// 8397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6f, 0x6c, 0x24, 0x49,
-	0x96, 0xd7, 0xd4, 0xff, 0xaa, 0x57, 0xfe, 0x37, 0xd1, 0x3d, 0x3d, 0x6e, 0x6f, 0x4f, 0x57, 0x6f,
-	0xce, 0xed, 0x6c, 0x1f, 0xb7, 0x67, 0x33, 0xbe, 0x9d, 0xd9, 0xd9, 0xdb, 0xdb, 0x9d, 0x71, 0xd9,
-	0x6d, 0xb7, 0xbb, 0xed, 0x6e, 0xef, 0x2b, 0xbb, 0x67, 0x76, 0x87, 0xdb, 0x21, 0x5d, 0x19, 0x2e,
-	0xe7, 0x38, 0x2b, 0xb3, 0x36, 0x33, 0xcb, 0xdd, 0x9e, 0xe3, 0xb4, 0x77, 0xbb, 0xa0, 0x59, 0x04,
-	0x08, 0x74, 0x9f, 0x4e, 0x42, 0x07, 0x02, 0x21, 0xdd, 0x87, 0xd3, 0xf1, 0xe1, 0xc4, 0x22, 0xc4,
-	0x07, 0xe0, 0x10, 0x82, 0xe5, 0xff, 0x0a, 0x21, 0x31, 0x48, 0x60, 0xb1, 0x46, 0x08, 0x81, 0x04,
-	0x3a, 0x38, 0x01, 0x47, 0x0b, 0x71, 0x28, 0xfe, 0xe5, 0xbf, 0xca, 0x72, 0xdb, 0x95, 0xe5, 0x9e,
-	0x9e, 0x63, 0xbe, 0x55, 0xc5, 0x7b, 0xf1, 0x7b, 0x91, 0x91, 0x91, 0x11, 0x2f, 0xde, 0x7b, 0xf1,
-	0x02, 0xd6, 0x3a, 0xa6, 0xbf, 0xdf, 0xdf, 0x9d, 0x6f, 0x3b, 0xdd, 0x05, 0xbb, 0xdf, 0xd5, 0x7b,
-	0xae, 0xf3, 0x3e, 0xff, 0xb1, 0x67, 0x39, 0x0f, 0x17, 0x7a, 0x07, 0x9d, 0x05, 0xbd, 0x67, 0x7a,
-	0x61, 0xc9, 0xe1, 0xab, 0xba, 0xd5, 0xdb, 0xd7, 0x5f, 0x5d, 0xe8, 0x50, 0x9b, 0xba, 0xba, 0x4f,
-	0x8d, 0xf9, 0x9e, 0xeb, 0xf8, 0x0e, 0xf9, 0x52, 0x08, 0x34, 0xaf, 0x80, 0xe6, 0x55, 0xb5, 0xf9,
-	0xde, 0x41, 0x67, 0x9e, 0x01, 0x85, 0x25, 0x0a, 0x68, 0xee, 0xa7, 0x23, 0x2d, 0xe8, 0x38, 0x1d,
-	0x67, 0x81, 0xe3, 0xed, 0xf6, 0xf7, 0xf8, 0x3f, 0xfe, 0x87, 0xff, 0x12, 0x72, 0xe6, 0xb4, 0x83,
-	0x37, 0xbc, 0x79, 0xd3, 0x61, 0xcd, 0x5a, 0x68, 0x3b, 0x2e, 0x5d, 0x38, 0x1c, 0x68, 0xcb, 0xdc,
-	0x17, 0x43, 0x9e, 0xae, 0xde, 0xde, 0x37, 0x6d, 0xea, 0x1e, 0xa9, 0x67, 0x59, 0x70, 0xa9, 0xe7,
-	0xf4, 0xdd, 0x36, 0x3d, 0x57, 0x2d, 0x6f, 0xa1, 0x4b, 0x7d, 0x3d, 0x4d, 0xd6, 0xc2, 0xb0, 0x5a,
-	0x6e, 0xdf, 0xf6, 0xcd, 0xee, 0xa0, 0x98, 0xd7, 0x9f, 0x54, 0xc1, 0x6b, 0xef, 0xd3, 0xae, 0x3e,
-	0x50, 0xef, 0x67, 0x86, 0xd5, 0xeb, 0xfb, 0xa6, 0xb5, 0x60, 0xda, 0xbe, 0xe7, 0xbb, 0xc9, 0x4a,
-	0xda, 0x6f, 0x03, 0x5c, 0x5a, 0xda, 0xf5, 0x7c, 0x57, 0x6f, 0xfb, 0x5b, 0x8e, 0xb1, 0x4d, 0xbb,
-	0x3d, 0x4b, 0xf7, 0x29, 0x39, 0x80, 0x2a, 0x7b, 0x20, 0x43, 0xf7, 0xf5, 0xd9, 0xdc, 0x8d, 0xdc,
-	0xcd, 0xfa, 0xe2, 0xd2, 0xfc, 0x88, 0x2f, 0x70, 0x7e, 0x53, 0x02, 0x35, 0x27, 0x4e, 0x8e, 0x1b,
-	0x55, 0xf5, 0x0f, 0x03, 0x01, 0xe4, 0x57, 0x73, 0x30, 0x61, 0x3b, 0x06, 0x6d, 0x51, 0x8b, 0xb6,
-	0x7d, 0xc7, 0x9d, 0xcd, 0xdf, 0x28, 0xdc, 0xac, 0x2f, 0x7e, 0x6b, 0x64, 0x89, 0x29, 0x4f, 0x34,
-	0x7f, 0x2f, 0x22, 0xe0, 0x96, 0xed, 0xbb, 0x47, 0xcd, 0xcb, 0x3f, 0x3c, 0x6e, 0x3c, 0x77, 0x72,
-	0xdc, 0x98, 0x88, 0x92, 0x30, 0xd6, 0x12, 0xb2, 0x03, 0x75, 0xdf, 0xb1, 0x58, 0x97, 0x99, 0x8e,
-	0xed, 0xcd, 0x16, 0x78, 0xc3, 0xae, 0xcf, 0x8b, 0xae, 0x66, 0xe2, 0xe7, 0xd9, 0x18, 0x9b, 0x3f,
-	0x7c, 0x75, 0x7e, 0x3b, 0x60, 0x6b, 0x5e, 0x92, 0xc0, 0xf5, 0xb0, 0xcc, 0xc3, 0x28, 0x0e, 0xa1,
-	0x30, 0xed, 0xd1, 0x76, 0xdf, 0x35, 0xfd, 0xa3, 0x65, 0xc7, 0xf6, 0xe9, 0x23, 0x7f, 0xb6, 0xc8,
-	0x7b, 0xf9, 0x95, 0x34, 0xe8, 0x2d, 0xc7, 0x68, 0xc5, 0xb9, 0x9b, 0x97, 0x4e, 0x8e, 0x1b, 0xd3,
-	0x89, 0x42, 0x4c, 0x62, 0x12, 0x1b, 0x66, 0xcc, 0xae, 0xde, 0xa1, 0x5b, 0x7d, 0xcb, 0x6a, 0xd1,
-	0xb6, 0x4b, 0x7d, 0x6f, 0xb6, 0xc4, 0x1f, 0xe1, 0x66, 0x9a, 0x9c, 0x0d, 0xa7, 0xad, 0x5b, 0xf7,
-	0x77, 0xdf, 0xa7, 0x6d, 0x1f, 0xe9, 0x1e, 0x75, 0xa9, 0xdd, 0xa6, 0xcd, 0x59, 0xf9, 0x30, 0x33,
-	0xeb, 0x09, 0x24, 0x1c, 0xc0, 0x26, 0x6b, 0xf0, 0x7c, 0xcf, 0x35, 0x1d, 0xde, 0x04, 0x4b, 0xf7,
-	0xbc, 0x7b, 0x7a, 0x97, 0xce, 0x96, 0x6f, 0xe4, 0x6e, 0xd6, 0x9a, 0x57, 0x25, 0xcc, 0xf3, 0x5b,
-	0x49, 0x06, 0x1c, 0xac, 0x43, 0x6e, 0x42, 0x55, 0x15, 0xce, 0x56, 0x6e, 0xe4, 0x6e, 0x96, 0xc4,
-	0xd8, 0x51, 0x75, 0x31, 0xa0, 0x92, 0x55, 0xa8, 0xea, 0x7b, 0x7b, 0xa6, 0xcd, 0x38, 0xab, 0xbc,
-	0x0b, 0xaf, 0xa5, 0x3d, 0xda, 0x92, 0xe4, 0x11, 0x38, 0xea, 0x1f, 0x06, 0x75, 0xc9, 0x1d, 0x20,
-	0x1e, 0x75, 0x0f, 0xcd, 0x36, 0x5d, 0x6a, 0xb7, 0x9d, 0xbe, 0xed, 0xf3, 0xb6, 0xd7, 0x78, 0xdb,
-	0xe7, 0x64, 0xdb, 0x49, 0x6b, 0x80, 0x03, 0x53, 0x6a, 0x91, 0xb7, 0x60, 0x46, 0x7e, 0xab, 0x61,
-	0x2f, 0x00, 0x47, 0xba, 0xcc, 0x3a, 0x12, 0x13, 0x34, 0x1c, 0xe0, 0x26, 0x06, 0x5c, 0xd3, 0xfb,
-	0xbe, 0xd3, 0x65, 0x90, 0x71, 0xa1, 0xdb, 0xce, 0x01, 0xb5, 0x67, 0xeb, 0x37, 0x72, 0x37, 0xab,
-	0xcd, 0x1b, 0x27, 0xc7, 0x8d, 0x6b, 0x4b, 0xa7, 0xf0, 0xe1, 0xa9, 0x28, 0xe4, 0x3e, 0xd4, 0x0c,
-	0xdb, 0xdb, 0x72, 0x2c, 0xb3, 0x7d, 0x34, 0x3b, 0xc1, 0x1b, 0xf8, 0xaa, 0x7c, 0xd4, 0xda, 0xca,
-	0xbd, 0x96, 0x20, 0x3c, 0x3e, 0x6e, 0x5c, 0x1b, 0x9c, 0x52, 0xe7, 0x03, 0x3a, 0x86, 0x18, 0x64,
-	0x93, 0x03, 0x2e, 0x3b, 0xf6, 0x9e, 0xd9, 0x99, 0x9d, 0xe4, 0x6f, 0xe3, 0xc6, 0x90, 0x01, 0xbd,
-	0x72, 0xaf, 0x25, 0xf8, 0x9a, 0x93, 0x52, 0x9c, 0xf8, 0x8b, 0x21, 0x02, 0x31, 0x60, 0x4a, 0x4d,
-	0xc6, 0xcb, 0x96, 0x6e, 0x76, 0xbd, 0xd9, 0x29, 0x3e, 0x78, 0x7f, 0x62, 0x08, 0x26, 0x46, 0x99,
-	0x9b, 0x57, 0xe4, 0xa3, 0x4c, 0xc5, 0x8a, 0x3d, 0x4c, 0x60, 0xce, 0xbd, 0x09, 0xcf, 0x0f, 0xcc,
-	0x0d, 0x64, 0x06, 0x0a, 0x07, 0xf4, 0x88, 0x4f, 0x7d, 0x35, 0x64, 0x3f, 0xc9, 0x65, 0x28, 0x1d,
-	0xea, 0x56, 0x9f, 0xce, 0xe6, 0x79, 0x99, 0xf8, 0xf3, 0xb3, 0xf9, 0x37, 0x72, 0xda, 0x5f, 0x2e,
-	0xc0, 0x84, 0x9a, 0x71, 0x5a, 0xa6, 0x7d, 0x40, 0xde, 0x86, 0x82, 0xe5, 0x74, 0xe4, 0xbc, 0xf9,
-	0x73, 0x23, 0xcf, 0x62, 0x1b, 0x4e, 0xa7, 0x59, 0x39, 0x39, 0x6e, 0x14, 0x36, 0x9c, 0x0e, 0x32,
-	0x44, 0xd2, 0x86, 0xd2, 0x81, 0xbe, 0x77, 0xa0, 0xf3, 0x36, 0xd4, 0x17, 0x9b, 0x23, 0x43, 0xdf,
-	0x65, 0x28, 0xac, 0xad, 0xcd, 0xda, 0xc9, 0x71, 0xa3, 0xc4, 0xff, 0xa2, 0xc0, 0x26, 0x0e, 0xd4,
-	0x76, 0x2d, 0xbd, 0x7d, 0xb0, 0xef, 0x58, 0x74, 0xb6, 0x90, 0x51, 0x50, 0x53, 0x21, 0x89, 0xd7,
-	0x1c, 0xfc, 0xc5, 0x50, 0x06, 0x69, 0x43, 0xb9, 0x6f, 0x78, 0xa6, 0x7d, 0x20, 0xe7, 0xc0, 0x37,
-	0x47, 0x96, 0xb6, 0xb3, 0xc2, 0x9f, 0x09, 0x4e, 0x8e, 0x1b, 0x65, 0xf1, 0x1b, 0x25, 0xb4, 0xf6,
-	0x7b, 0x13, 0x30, 0xa5, 0x5e, 0xd2, 0x03, 0xea, 0xfa, 0xf4, 0x11, 0xb9, 0x01, 0x45, 0x9b, 0x7d,
-	0x9a, 0xfc, 0x25, 0x37, 0x27, 0xe4, 0x70, 0x29, 0xf2, 0x4f, 0x92, 0x53, 0x58, 0xcb, 0xc4, 0x50,
-	0x91, 0x1d, 0x3e, 0x7a, 0xcb, 0x5a, 0x1c, 0x46, 0xb4, 0x4c, 0xfc, 0x46, 0x09, 0x4d, 0xde, 0x85,
-	0x22, 0x7f, 0x78, 0xd1, 0xd5, 0x5f, 0x1d, 0x5d, 0x04, 0x7b, 0xf4, 0x2a, 0x7b, 0x02, 0xfe, 0xe0,
-	0x1c, 0x94, 0x0d, 0xc5, 0xbe, 0xb1, 0x27, 0x3b, 0xf6, 0xe7, 0x32, 0x74, 0xec, 0xaa, 0x18, 0x8a,
-	0x3b, 0x2b, 0xab, 0xc8, 0x10, 0xc9, 0x9f, 0xcd, 0xc1, 0xf3, 0x6d, 0xc7, 0xf6, 0x75, 0xa6, 0x67,
-	0xa8, 0x45, 0x76, 0xb6, 0xc4, 0xe5, 0xdc, 0x19, 0x59, 0xce, 0x72, 0x12, 0xb1, 0xf9, 0x02, 0x5b,
-	0x33, 0x06, 0x8a, 0x71, 0x50, 0x36, 0xf9, 0xf3, 0x39, 0x78, 0x81, 0xcd, 0xe5, 0x03, 0xcc, 0x7c,
-	0x05, 0x1a, 0x6f, 0xab, 0xae, 0x9e, 0x1c, 0x37, 0x5e, 0x58, 0x4f, 0x13, 0x86, 0xe9, 0x6d, 0x60,
-	0xad, 0xbb, 0xa4, 0x0f, 0xaa, 0x25, 0x7c, 0x75, 0xab, 0x2f, 0x6e, 0x8c, 0x53, 0xd5, 0x69, 0x7e,
-	0x46, 0x0e, 0xe5, 0x34, 0xcd, 0x0e, 0xd3, 0x5a, 0x41, 0x6e, 0x41, 0xe5, 0xd0, 0xb1, 0xfa, 0x5d,
-	0xea, 0xcd, 0x56, 0xf9, 0x14, 0x3b, 0x97, 0x36, 0xc5, 0x3e, 0xe0, 0x2c, 0xcd, 0x69, 0x09, 0x5f,
-	0x11, 0xff, 0x3d, 0x54, 0x75, 0x89, 0x09, 0x65, 0xcb, 0xec, 0x9a, 0xbe, 0xc7, 0x17, 0xce, 0xfa,
-	0xe2, 0xad, 0x91, 0x1f, 0x4b, 0x7c, 0xa2, 0x1b, 0x1c, 0x4c, 0x7c, 0x35, 0xe2, 0x37, 0x4a, 0x01,
-	0x6c, 0x2a, 0xf4, 0xda, 0xba, 0x25, 0x16, 0xd6, 0xfa, 0xe2, 0xd7, 0x46, 0xff, 0x6c, 0x18, 0x4a,
-	0x73, 0x52, 0x3e, 0x53, 0x89, 0xff, 0x45, 0x81, 0x4d, 0x7e, 0x1e, 0xa6, 0x62, 0x6f, 0xd3, 0x9b,
-	0xad, 0xf3, 0xde, 0x79, 0x29, 0xad, 0x77, 0x02, 0xae, 0x70, 0xe5, 0x89, 0x8d, 0x10, 0x0f, 0x13,
-	0x60, 0xe4, 0x2e, 0x54, 0x3d, 0xd3, 0xa0, 0x6d, 0xdd, 0xf5, 0x66, 0x27, 0xce, 0x02, 0x3c, 0x23,
-	0x81, 0xab, 0x2d, 0x59, 0x0d, 0x03, 0x00, 0x32, 0x0f, 0xd0, 0xd3, 0x5d, 0xdf, 0x14, 0x8a, 0xea,
-	0x24, 0x57, 0x9a, 0xa6, 0x4e, 0x8e, 0x1b, 0xb0, 0x15, 0x94, 0x62, 0x84, 0x83, 0xf1, 0xb3, 0xba,
-	0xeb, 0x76, 0xaf, 0xef, 0x8b, 0x85, 0xb5, 0x26, 0xf8, 0x5b, 0x41, 0x29, 0x46, 0x38, 0xc8, 0x6f,
-	0xe6, 0xe0, 0x33, 0xe1, 0xdf, 0xc1, 0x8f, 0x6c, 0x7a, 0xec, 0x1f, 0x59, 0xe3, 0xe4, 0xb8, 0xf1,
-	0x99, 0xd6, 0x70, 0x91, 0x78, 0x5a, 0x7b, 0xc8, 0x87, 0x39, 0x98, 0xea, 0xf7, 0x0c, 0xdd, 0xa7,
-	0x2d, 0x9f, 0xed, 0x78, 0x3a, 0x47, 0xb3, 0x33, 0xbc, 0x89, 0x6b, 0xa3, 0xcf, 0x82, 0x31, 0xb8,
-	0xf0, 0x35, 0xc7, 0xcb, 0x31, 0x21, 0x56, 0x7b, 0x1b, 0x26, 0x97, 0xfa, 0xfe, 0xbe, 0xe3, 0x9a,
-	0x1f, 0x70, 0xf5, 0x9f, 0xac, 0x42, 0xc9, 0xe7, 0x6a, 0x9c, 0xd0, 0x10, 0x3e, 0x97, 0xf6, 0xd2,
-	0x85, 0x4a, 0x7d, 0x97, 0x1e, 0x29, 0xbd, 0x44, 0xac, 0xd4, 0x42, 0xad, 0x13, 0xd5, 0xb5, 0x3f,
-	0x9e, 0x83, 0x4a, 0x53, 0x6f, 0x1f, 0x38, 0x7b, 0x7b, 0xe4, 0x1d, 0xa8, 0x9a, 0xb6, 0x4f, 0xdd,
-	0x43, 0xdd, 0x92, 0xb0, 0xf3, 0x11, 0xd8, 0x60, 0x43, 0x18, 0x3e, 0x1e, 0xdb, 0x7d, 0x31, 0x41,
-	0x2b, 0x7d, 0xb9, 0x6b, 0xe1, 0x9a, 0xf1, 0xba, 0xc4, 0xc0, 0x00, 0x8d, 0x34, 0xa0, 0xe4, 0xf9,
-	0xb4, 0xe7, 0xf1, 0x35, 0x70, 0x52, 0x34, 0xa3, 0xc5, 0x0a, 0x50, 0x94, 0x6b, 0x7f, 0x29, 0x07,
-	0xb5, 0xa6, 0xee, 0x99, 0x6d, 0xf6, 0x94, 0x64, 0x19, 0x8a, 0x7d, 0x8f, 0xba, 0xe7, 0x7b, 0x36,
-	0xbe, 0x6c, 0xed, 0x78, 0xd4, 0x45, 0x5e, 0x99, 0xdc, 0x87, 0x6a, 0x4f, 0xf7, 0xbc, 0x87, 0x8e,
-	0x6b, 0xc8, 0xa5, 0xf7, 0x8c, 0x40, 0x62, 0x9b, 0x20, 0xab, 0x62, 0x00, 0xa2, 0xd5, 0x21, 0xd4,
-	0x3d, 0xb4, 0xdf, 0xcd, 0xc1, 0xa5, 0x66, 0x7f, 0x6f, 0x8f, 0xba, 0x52, 0x2b, 0x96, 0xfa, 0x26,
-	0x85, 0x92, 0x4b, 0x0d, 0xd3, 0x93, 0x6d, 0x5f, 0x19, 0x79, 0xa0, 0x20, 0x43, 0x91, 0xea, 0x2d,
-	0xef, 0x2f, 0x5e, 0x80, 0x02, 0x9d, 0xf4, 0xa1, 0xf6, 0x3e, 0x65, 0xbb, 0x71, 0xaa, 0x77, 0xe5,
-	0xd3, 0xdd, 0x1e, 0x59, 0xd4, 0x1d, 0xea, 0xb7, 0x38, 0x52, 0x54, 0x9b, 0x0e, 0x0a, 0x31, 0x94,
-	0xa4, 0xfd, 0x76, 0x09, 0x26, 0x96, 0x9d, 0xee, 0xae, 0x69, 0x53, 0xe3, 0x96, 0xd1, 0xa1, 0xe4,
-	0x3d, 0x28, 0x52, 0xa3, 0x43, 0xe5, 0xd3, 0x8e, 0xae, 0x78, 0x30, 0xb0, 0x50, 0x7d, 0x62, 0xff,
-	0x90, 0x03, 0x93, 0x0d, 0x98, 0xda, 0x73, 0x9d, 0xae, 0x98, 0xcb, 0xb7, 0x8f, 0x7a, 0x52, 0x77,
-	0x6e, 0xfe, 0x84, 0xfa, 0x70, 0x56, 0x63, 0xd4, 0xc7, 0xc7, 0x0d, 0x08, 0xff, 0x61, 0xa2, 0x2e,
-	0x79, 0x07, 0x66, 0xc3, 0x92, 0x60, 0x52, 0x5b, 0x66, 0xdb, 0x19, 0xae, 0x3b, 0x95, 0x9a, 0xd7,
-	0x4e, 0x8e, 0x1b, 0xb3, 0xab, 0x43, 0x78, 0x70, 0x68, 0x6d, 0x36, 0x55, 0xcc, 0x84, 0x44, 0xb1,
-	0xd0, 0x48, 0x95, 0x69, 0x4c, 0x2b, 0x18, 0xdf, 0xf7, 0xad, 0x26, 0x44, 0xe0, 0x80, 0x50, 0xb2,
-	0x0a, 0x13, 0xbe, 0x13, 0xe9, 0xaf, 0x12, 0xef, 0x2f, 0x4d, 0x19, 0x2a, 0xb6, 0x9d, 0xa1, 0xbd,
-	0x15, 0xab, 0x47, 0x10, 0xae, 0xa8, 0xff, 0x89, 0x9e, 0x2a, 0xf3, 0x9e, 0x9a, 0x3b, 0x39, 0x6e,
-	0x5c, 0xd9, 0x4e, 0xe5, 0xc0, 0x21, 0x35, 0xc9, 0x2f, 0xe7, 0x60, 0x4a, 0x91, 0x64, 0x1f, 0x55,
-	0xc6, 0xd9, 0x47, 0x84, 0x8d, 0x88, 0xed, 0x98, 0x00, 0x4c, 0x08, 0xd4, 0x7e, 0x50, 0x81, 0x5a,
-	0x30, 0xd5, 0x93, 0x97, 0xa1, 0xc4, 0x4d, 0x10, 0x52, 0x83, 0x0f, 0xd6, 0x70, 0x6e, 0xa9, 0x40,
-	0x41, 0x23, 0x9f, 0x83, 0x4a, 0xdb, 0xe9, 0x76, 0x75, 0xdb, 0xe0, 0x66, 0xa5, 0x5a, 0xb3, 0xce,
-	0x54, 0x97, 0x65, 0x51, 0x84, 0x8a, 0x46, 0xae, 0x41, 0x51, 0x77, 0x3b, 0xc2, 0xc2, 0x53, 0x13,
-	0xf3, 0xd1, 0x92, 0xdb, 0xf1, 0x90, 0x97, 0x92, 0x2f, 0x43, 0x81, 0xda, 0x87, 0xb3, 0xc5, 0xe1,
-	0xba, 0xd1, 0x2d, 0xfb, 0xf0, 0x81, 0xee, 0x36, 0xeb, 0xb2, 0x0d, 0x85, 0x5b, 0xf6, 0x21, 0xb2,
-	0x3a, 0x64, 0x03, 0x2a, 0xd4, 0x3e, 0x64, 0xef, 0x5e, 0x9a, 0x5e, 0x3e, 0x3b, 0xa4, 0x3a, 0x63,
-	0x91, 0xdb, 0x84, 0x40, 0xc3, 0x92, 0xc5, 0xa8, 0x20, 0xc8, 0x37, 0x60, 0x42, 0x28, 0x5b, 0x9b,
-	0xec, 0x9d, 0x78, 0xb3, 0x65, 0x0e, 0xd9, 0x18, 0xae, 0xad, 0x71, 0xbe, 0xd0, 0xd4, 0x15, 0x29,
-	0xf4, 0x30, 0x06, 0x45, 0xbe, 0x01, 0x35, 0xb5, 0x33, 0x56, 0x6f, 0x36, 0xd5, 0x4a, 0xa4, 0xb6,
-	0xd3, 0x48, 0xbf, 0xdd, 0x37, 0x5d, 0xda, 0xa5, 0xb6, 0xef, 0x35, 0x9f, 0x57, 0x76, 0x03, 0x45,
-	0xf5, 0x30, 0x44, 0x23, 0xbb, 0x83, 0xe6, 0x2e, 0x61, 0xab, 0x79, 0x79, 0xc8, 0xac, 0x3e, 0x82,
-	0xad, 0xeb, 0x5b, 0x30, 0x1d, 0xd8, 0xa3, 0xa4, 0x49, 0x43, 0x58, 0x6f, 0xbe, 0xc8, 0xaa, 0xaf,
-	0xc7, 0x49, 0x8f, 0x8f, 0x1b, 0x2f, 0xa5, 0x18, 0x35, 0x42, 0x06, 0x4c, 0x82, 0x91, 0x0f, 0x60,
-	0xca, 0xa5, 0xba, 0x61, 0xda, 0xd4, 0xf3, 0xb6, 0x5c, 0x67, 0x37, 0xbb, 0xe6, 0xc9, 0x51, 0xc4,
-	0xb0, 0xc7, 0x18, 0x32, 0x26, 0x24, 0x91, 0x87, 0x30, 0x69, 0x99, 0x87, 0x34, 0x14, 0x5d, 0x1f,
-	0x8b, 0xe8, 0xe7, 0x4f, 0x8e, 0x1b, 0x93, 0x1b, 0x51, 0x60, 0x8c, 0xcb, 0x61, 0x9a, 0x4a, 0xcf,
-	0x71, 0x7d, 0xa5, 0x9e, 0x7e, 0xf6, 0x54, 0xf5, 0x74, 0xcb, 0x71, 0xfd, 0xf0, 0x23, 0x64, 0xff,
-	0x3c, 0x14, 0xd5, 0xb5, 0xbf, 0x56, 0x82, 0xc1, 0x4d, 0x5c, 0x7c, 0xc4, 0xe5, 0xc6, 0x3d, 0xe2,
-	0x92, 0xa3, 0x41, 0xac, 0x3d, 0x6f, 0xc8, 0x6a, 0x63, 0x18, 0x11, 0x29, 0xa3, 0xba, 0x30, 0xee,
-	0x51, 0xfd, 0xcc, 0x4c, 0x3c, 0x83, 0xc3, 0xbf, 0xfc, 0xf1, 0x0d, 0xff, 0xca, 0xd3, 0x19, 0xfe,
-	0xda, 0xf7, 0x8b, 0x30, 0xb5, 0xa2, 0xd3, 0xae, 0x63, 0x3f, 0x71, 0x1f, 0x9f, 0x7b, 0x26, 0xf6,
-	0xf1, 0x37, 0xa1, 0xea, 0xd2, 0x9e, 0x65, 0xb6, 0x75, 0xa1, 0xae, 0x4b, 0xbb, 0x39, 0xca, 0x32,
-	0x0c, 0xa8, 0x43, 0xec, 0x37, 0x85, 0x67, 0xd2, 0x7e, 0x53, 0xfc, 0xf8, 0xed, 0x37, 0xda, 0x2f,
-	0xe7, 0x81, 0xab, 0xb6, 0xe4, 0x06, 0x14, 0x99, 0xda, 0x96, 0xb4, 0x1a, 0xf2, 0xaf, 0x85, 0x53,
-	0xc8, 0x1c, 0xe4, 0x7d, 0x47, 0x4e, 0x37, 0x20, 0xe9, 0xf9, 0x6d, 0x07, 0xf3, 0xbe, 0x43, 0x3e,
-	0x00, 0x68, 0x3b, 0xb6, 0x61, 0x2a, 0x77, 0x52, 0xb6, 0x07, 0x5b, 0x75, 0xdc, 0x87, 0xba, 0x6b,
-	0x2c, 0x07, 0x88, 0x62, 0x07, 0x1f, 0xfe, 0xc7, 0x88, 0x34, 0xf2, 0x26, 0x94, 0x1d, 0x7b, 0xb5,
-	0x6f, 0x59, 0xbc, 0x43, 0x6b, 0xcd, 0xcf, 0x9f, 0x1c, 0x37, 0xca, 0xf7, 0x79, 0xc9, 0xe3, 0xe3,
-	0xc6, 0x55, 0xb1, 0x23, 0x62, 0xff, 0xde, 0x76, 0x4d, 0xdf, 0xb4, 0x3b, 0xc1, 0x86, 0x56, 0x56,
-	0xd3, 0x7e, 0x25, 0x07, 0xf5, 0x55, 0xf3, 0x11, 0x35, 0xde, 0x36, 0x6d, 0xc3, 0x79, 0x48, 0x10,
-	0xca, 0x16, 0xb5, 0x3b, 0xfe, 0xfe, 0x88, 0x3b, 0x4e, 0x61, 0xd7, 0xe1, 0x08, 0x28, 0x91, 0xc8,
-	0x02, 0xd4, 0xc4, 0x7e, 0xc5, 0xb4, 0x3b, 0xbc, 0x0f, 0xab, 0xe1, 0x4c, 0xdf, 0x52, 0x04, 0x0c,
-	0x79, 0xb4, 0x23, 0x78, 0x7e, 0xa0, 0x1b, 0x88, 0x01, 0x45, 0x5f, 0xef, 0xa8, 0x45, 0x65, 0x75,
-	0xe4, 0x0e, 0xde, 0xd6, 0x3b, 0x91, 0xce, 0xe5, 0x5a, 0xe1, 0xb6, 0xce, 0xb4, 0x42, 0x86, 0xae,
-	0xfd, 0x9f, 0x1c, 0x54, 0x57, 0xfb, 0x76, 0x9b, 0x6f, 0xea, 0x9f, 0x6c, 0x4d, 0x56, 0x2a, 0x66,
-	0x3e, 0x55, 0xc5, 0xec, 0x43, 0xf9, 0xe0, 0x61, 0xa0, 0x82, 0xd6, 0x17, 0x37, 0x47, 0x1f, 0x15,
-	0xb2, 0x49, 0xf3, 0x77, 0x39, 0x9e, 0x70, 0x76, 0x4e, 0xc9, 0x06, 0x95, 0xef, 0xbe, 0xcd, 0x85,
-	0x4a, 0x61, 0x73, 0x5f, 0x86, 0x7a, 0x84, 0xed, 0x5c, 0x7e, 0x8f, 0xbf, 0x5e, 0x84, 0xf2, 0x5a,
-	0xab, 0xb5, 0xb4, 0xb5, 0x4e, 0x5e, 0x83, 0xba, 0xf4, 0x83, 0xdd, 0x0b, 0xfb, 0x20, 0x70, 0x83,
-	0xb6, 0x42, 0x12, 0x46, 0xf9, 0x98, 0x02, 0xef, 0x52, 0xdd, 0xea, 0xca, 0x8f, 0x25, 0xd0, 0x1d,
-	0x90, 0x15, 0xa2, 0xa0, 0x11, 0x1d, 0xa6, 0xfa, 0x1e, 0x75, 0x59, 0x17, 0x8a, 0xfd, 0xbe, 0xfc,
-	0x6c, 0xce, 0x68, 0x11, 0xe0, 0x0b, 0xcc, 0x4e, 0x0c, 0x00, 0x13, 0x80, 0xe4, 0x0d, 0xa8, 0xea,
-	0x7d, 0x7f, 0x9f, 0x6f, 0xb9, 0xc4, 0xb7, 0x71, 0x8d, 0xbb, 0x09, 0x65, 0xd9, 0xe3, 0xe3, 0xc6,
-	0xc4, 0x5d, 0x6c, 0xbe, 0xa6, 0xfe, 0x63, 0xc0, 0xcd, 0x1a, 0xa7, 0x6c, 0x0c, 0xb2, 0x71, 0xa5,
-	0x73, 0x37, 0x6e, 0x2b, 0x06, 0x80, 0x09, 0x40, 0xf2, 0x2e, 0x4c, 0x1c, 0xd0, 0x23, 0x5f, 0xdf,
-	0x95, 0x02, 0xca, 0xe7, 0x11, 0x30, 0xc3, 0x94, 0xfe, 0xbb, 0x91, 0xea, 0x18, 0x03, 0x23, 0x1e,
-	0x5c, 0x3e, 0xa0, 0xee, 0x2e, 0x75, 0x1d, 0x69, 0xaf, 0x90, 0x42, 0x2a, 0xe7, 0x11, 0x32, 0x7b,
-	0x72, 0xdc, 0xb8, 0x7c, 0x37, 0x05, 0x06, 0x53, 0xc1, 0xb5, 0xff, 0x9d, 0x87, 0xe9, 0x35, 0x11,
-	0x88, 0xe0, 0xb8, 0x42, 0xf3, 0x20, 0x57, 0xa1, 0xe0, 0xf6, 0xfa, 0x7c, 0xe4, 0x14, 0x84, 0xab,
-	0x01, 0xb7, 0x76, 0x90, 0x95, 0x91, 0x77, 0xa0, 0x6a, 0xc8, 0x29, 0x43, 0x9a, 0x4b, 0x46, 0x32,
-	0x6d, 0xa9, 0x7f, 0x18, 0xa0, 0xb1, 0xbd, 0x61, 0xd7, 0xeb, 0xb4, 0xcc, 0x0f, 0xa8, 0xb4, 0x20,
-	0xf0, 0xbd, 0xe1, 0xa6, 0x28, 0x42, 0x45, 0x63, 0xab, 0xea, 0x01, 0x3d, 0x12, 0xfb, 0xe7, 0x62,
-	0xb8, 0xaa, 0xde, 0x95, 0x65, 0x18, 0x50, 0x49, 0x43, 0x7d, 0x2c, 0x6c, 0x14, 0x14, 0x85, 0xed,
-	0xe7, 0x01, 0x2b, 0x90, 0xdf, 0x0d, 0x9b, 0x32, 0xdf, 0x37, 0x7d, 0x9f, 0xba, 0xf2, 0x35, 0x8e,
-	0x34, 0x65, 0xde, 0xe1, 0x08, 0x28, 0x91, 0xc8, 0x4f, 0x41, 0x8d, 0x83, 0x37, 0x2d, 0x67, 0x97,
-	0xbf, 0xb8, 0x9a, 0xb0, 0x02, 0x3d, 0x50, 0x85, 0x18, 0xd2, 0xb5, 0xdf, 0xcf, 0xc3, 0x95, 0x35,
-	0xea, 0x0b, 0xad, 0x66, 0x85, 0xf6, 0x2c, 0xe7, 0x88, 0xe9, 0xd3, 0x48, 0xbf, 0x4d, 0xde, 0x02,
-	0x30, 0xbd, 0xdd, 0xd6, 0x61, 0x9b, 0x7f, 0x07, 0xe2, 0x1b, 0xbe, 0x21, 0x3f, 0x49, 0x58, 0x6f,
-	0x35, 0x25, 0xe5, 0x71, 0xec, 0x1f, 0x46, 0xea, 0x84, 0x1b, 0xf2, 0xfc, 0x29, 0x1b, 0xf2, 0x16,
-	0x40, 0x2f, 0xd4, 0xca, 0x0b, 0x9c, 0xf3, 0x67, 0x94, 0x98, 0xf3, 0x28, 0xe4, 0x11, 0x98, 0x2c,
-	0x7a, 0xb2, 0x0d, 0x33, 0x06, 0xdd, 0xd3, 0xfb, 0x96, 0x1f, 0xec, 0x24, 0xe4, 0x47, 0x7c, 0xf6,
-	0xcd, 0x48, 0x10, 0x24, 0xb1, 0x92, 0x40, 0xc2, 0x01, 0x6c, 0xed, 0x6f, 0x16, 0x60, 0x6e, 0x8d,
-	0xfa, 0x81, 0x8d, 0x4e, 0xce, 0x8e, 0xad, 0x1e, 0x6d, 0xb3, 0xb7, 0xf0, 0x61, 0x0e, 0xca, 0x96,
-	0xbe, 0x4b, 0x2d, 0xb6, 0x7a, 0xb1, 0xa7, 0x79, 0x6f, 0xe4, 0x85, 0x60, 0xb8, 0x94, 0xf9, 0x0d,
-	0x2e, 0x21, 0xb1, 0x34, 0x88, 0x42, 0x94, 0xe2, 0xd9, 0xa4, 0xde, 0xb6, 0xfa, 0x9e, 0x2f, 0x76,
-	0x76, 0x52, 0x9f, 0x0c, 0x26, 0xf5, 0xe5, 0x90, 0x84, 0x51, 0x3e, 0xb2, 0x08, 0xd0, 0xb6, 0x4c,
-	0x6a, 0xfb, 0xbc, 0x96, 0xf8, 0xae, 0x88, 0x7a, 0xbf, 0xcb, 0x01, 0x05, 0x23, 0x5c, 0x4c, 0x54,
-	0xd7, 0xb1, 0x4d, 0xdf, 0x11, 0xa2, 0x8a, 0x71, 0x51, 0x9b, 0x21, 0x09, 0xa3, 0x7c, 0xbc, 0x1a,
-	0xf5, 0x5d, 0xb3, 0xed, 0xf1, 0x6a, 0xa5, 0x44, 0xb5, 0x90, 0x84, 0x51, 0x3e, 0xb6, 0xe6, 0x45,
-	0x9e, 0xff, 0x5c, 0x6b, 0xde, 0x6f, 0xd4, 0xe0, 0x7a, 0xac, 0x5b, 0x7d, 0xdd, 0xa7, 0x7b, 0x7d,
-	0xab, 0x45, 0x7d, 0xf5, 0x02, 0x47, 0x5c, 0x0b, 0xff, 0x54, 0xf8, 0xde, 0x45, 0xf8, 0x53, 0x7b,
-	0x3c, 0xef, 0x7d, 0xa0, 0x81, 0x67, 0x7a, 0xf7, 0x0b, 0x50, 0xb3, 0x75, 0xdf, 0xe3, 0x1f, 0xae,
-	0xfc, 0x46, 0x03, 0x35, 0xec, 0x9e, 0x22, 0x60, 0xc8, 0x43, 0xb6, 0xe0, 0xb2, 0xec, 0xe2, 0x5b,
-	0x8f, 0xd8, 0x9e, 0x9f, 0xba, 0xa2, 0xae, 0x5c, 0x4e, 0x65, 0xdd, 0xcb, 0x9b, 0x29, 0x3c, 0x98,
-	0x5a, 0x93, 0x6c, 0xc2, 0xa5, 0xb6, 0x08, 0x09, 0xa1, 0x96, 0xa3, 0x1b, 0x0a, 0x50, 0x98, 0x44,
-	0x83, 0xad, 0xd1, 0xf2, 0x20, 0x0b, 0xa6, 0xd5, 0x4b, 0x8e, 0xe6, 0xf2, 0x48, 0xa3, 0xb9, 0x32,
-	0xca, 0x68, 0xae, 0x8e, 0x36, 0x9a, 0x6b, 0x67, 0x1b, 0xcd, 0xac, 0xe7, 0xd9, 0x38, 0xa2, 0x2e,
-	0x53, 0x4f, 0xc4, 0x0a, 0x1b, 0x89, 0x38, 0x0a, 0x7a, 0xbe, 0x95, 0xc2, 0x83, 0xa9, 0x35, 0xc9,
-	0x2e, 0xcc, 0x89, 0xf2, 0x5b, 0x76, 0xdb, 0x3d, 0xea, 0xb1, 0x85, 0x27, 0x82, 0x5b, 0x8f, 0xd9,
-	0xa4, 0xe7, 0x5a, 0x43, 0x39, 0xf1, 0x14, 0x14, 0xf2, 0x15, 0x98, 0x14, 0x6f, 0x69, 0x53, 0xef,
-	0x71, 0x58, 0x11, 0x7f, 0xf4, 0x82, 0x84, 0x9d, 0x5c, 0x8e, 0x12, 0x31, 0xce, 0x4b, 0x96, 0x60,
-	0xba, 0x77, 0xd8, 0x66, 0x3f, 0xd7, 0xf7, 0xee, 0x51, 0x6a, 0x50, 0x83, 0x3b, 0x3c, 0x6b, 0xcd,
-	0x17, 0x95, 0x75, 0x67, 0x2b, 0x4e, 0xc6, 0x24, 0x3f, 0x79, 0x03, 0x26, 0x3c, 0x5f, 0x77, 0x7d,
-	0x69, 0x08, 0x9e, 0x9d, 0x12, 0xf1, 0x59, 0xca, 0x4e, 0xda, 0x8a, 0xd0, 0x30, 0xc6, 0x99, 0xba,
-	0x5e, 0x4c, 0x5f, 0xdc, 0x7a, 0x91, 0x65, 0xb6, 0xfa, 0x07, 0x79, 0xb8, 0xb1, 0x46, 0xfd, 0x4d,
-	0xc7, 0x96, 0x66, 0xf4, 0xb4, 0x65, 0xff, 0x4c, 0x56, 0xf4, 0xf8, 0xa2, 0x9d, 0x1f, 0xeb, 0xa2,
-	0x5d, 0x18, 0xd3, 0xa2, 0x5d, 0xbc, 0xc0, 0x45, 0xfb, 0x6f, 0xe5, 0xe1, 0xc5, 0x58, 0x4f, 0x6e,
-	0x39, 0x86, 0x9a, 0xf0, 0x3f, 0xed, 0xc0, 0x33, 0x74, 0xe0, 0x63, 0xa1, 0x77, 0x72, 0x47, 0x68,
-	0x42, 0xe3, 0xf9, 0x5e, 0x52, 0xe3, 0x79, 0x37, 0xcb, 0xca, 0x97, 0x22, 0xe1, 0x4c, 0x2b, 0xde,
-	0x1d, 0x20, 0xae, 0x74, 0xdb, 0x86, 0xe6, 0x6c, 0xa9, 0xf4, 0x04, 0x01, 0xa0, 0x38, 0xc0, 0x81,
-	0x29, 0xb5, 0x48, 0x0b, 0x5e, 0xf0, 0xa8, 0xed, 0x9b, 0x36, 0xb5, 0xe2, 0x70, 0x42, 0x1b, 0x7a,
-	0x49, 0xc2, 0xbd, 0xd0, 0x4a, 0x63, 0xc2, 0xf4, 0xba, 0x59, 0xe6, 0x81, 0x7f, 0x02, 0x5c, 0xe5,
-	0x14, 0x5d, 0x33, 0x36, 0x8d, 0xe5, 0xc3, 0xa4, 0xc6, 0xf2, 0x5e, 0xf6, 0xf7, 0x36, 0x9a, 0xb6,
-	0xb2, 0x08, 0xc0, 0xdf, 0x42, 0x54, 0x5d, 0x09, 0x16, 0x69, 0x0c, 0x28, 0x18, 0xe1, 0x62, 0x0b,
-	0x90, 0xea, 0xe7, 0xa8, 0xa6, 0x12, 0x2c, 0x40, 0xad, 0x28, 0x11, 0xe3, 0xbc, 0x43, 0xb5, 0x9d,
-	0xd2, 0xc8, 0xda, 0xce, 0x1d, 0x20, 0x31, 0xc3, 0xa3, 0xc0, 0x2b, 0xc7, 0xe3, 0x8f, 0xd7, 0x07,
-	0x38, 0x30, 0xa5, 0xd6, 0x90, 0xa1, 0x5c, 0x19, 0xef, 0x50, 0xae, 0x8e, 0x3e, 0x94, 0xc9, 0x7b,
-	0x70, 0x95, 0x8b, 0x92, 0xfd, 0x13, 0x07, 0x16, 0x7a, 0xcf, 0x67, 0x25, 0xf0, 0x55, 0x1c, 0xc6,
-	0x88, 0xc3, 0x31, 0xd8, 0xfb, 0x69, 0xbb, 0xd4, 0x60, 0xc2, 0x75, 0x6b, 0xb8, 0x4e, 0xb4, 0x9c,
-	0xc2, 0x83, 0xa9, 0x35, 0xd9, 0x10, 0xf3, 0xd9, 0x30, 0xd4, 0x77, 0x2d, 0x6a, 0xc8, 0xf8, 0xeb,
-	0x60, 0x88, 0x6d, 0x6f, 0xb4, 0x24, 0x05, 0x23, 0x5c, 0x69, 0x6a, 0xca, 0xc4, 0x39, 0xd5, 0x94,
-	0x35, 0x6e, 0xa5, 0xdf, 0x8b, 0x69, 0x43, 0x52, 0xd7, 0x09, 0x22, 0xea, 0x97, 0x93, 0x0c, 0x38,
-	0x58, 0x87, 0x6b, 0x89, 0x6d, 0xd7, 0xec, 0xf9, 0x5e, 0x1c, 0x6b, 0x2a, 0xa1, 0x25, 0xa6, 0xf0,
-	0x60, 0x6a, 0x4d, 0xa6, 0x9f, 0xef, 0x53, 0xdd, 0xf2, 0xf7, 0xe3, 0x80, 0xd3, 0x71, 0xfd, 0xfc,
-	0xf6, 0x20, 0x0b, 0xa6, 0xd5, 0x4b, 0x5d, 0x90, 0x66, 0x9e, 0x4d, 0xb5, 0xea, 0xbb, 0x05, 0xb8,
-	0xba, 0x46, 0xfd, 0x20, 0x34, 0xed, 0x53, 0x33, 0xca, 0xc7, 0x60, 0x46, 0xf9, 0xf5, 0x12, 0x5c,
-	0x5a, 0xa3, 0xfe, 0x80, 0x36, 0xf6, 0xff, 0x69, 0xf7, 0x6f, 0xc2, 0xa5, 0x30, 0x1a, 0xb2, 0xe5,
-	0x3b, 0xae, 0x58, 0xcb, 0x13, 0xbb, 0xe5, 0xd6, 0x20, 0x0b, 0xa6, 0xd5, 0x23, 0xdf, 0x80, 0x17,
-	0xf9, 0x52, 0x6f, 0x77, 0x84, 0x7d, 0x56, 0x18, 0x13, 0x22, 0xe7, 0x79, 0x1a, 0x12, 0xf2, 0xc5,
-	0x56, 0x3a, 0x1b, 0x0e, 0xab, 0x4f, 0xbe, 0x03, 0x13, 0x3d, 0xb3, 0x47, 0x2d, 0xd3, 0xe6, 0xfa,
-	0x59, 0xe6, 0x20, 0xa2, 0xad, 0x08, 0x58, 0xb8, 0x81, 0x8b, 0x96, 0x62, 0x4c, 0x60, 0xea, 0x48,
-	0xad, 0x5e, 0xe0, 0x48, 0xfd, 0xef, 0x79, 0xa8, 0xac, 0xb9, 0x4e, 0xbf, 0xd7, 0x3c, 0x22, 0x1d,
-	0x28, 0x3f, 0xe4, 0xce, 0x33, 0xe9, 0x9a, 0x1a, 0xfd, 0x44, 0x81, 0xf0, 0xc1, 0x85, 0x2a, 0x91,
-	0xf8, 0x8f, 0x12, 0x9e, 0x0d, 0xe2, 0x03, 0x7a, 0x44, 0x0d, 0xe9, 0x43, 0x0b, 0x06, 0xf1, 0x5d,
-	0x56, 0x88, 0x82, 0x46, 0xba, 0x30, 0xad, 0x5b, 0x96, 0xf3, 0x90, 0x1a, 0x1b, 0xba, 0xcf, 0xfd,
-	0xde, 0xd2, 0xb7, 0x72, 0x5e, 0xb3, 0x34, 0x0f, 0x66, 0x58, 0x8a, 0x43, 0x61, 0x12, 0x9b, 0xbc,
-	0x0f, 0x15, 0xcf, 0x77, 0x5c, 0xa5, 0x6c, 0xd5, 0x17, 0x97, 0x47, 0x7f, 0xe9, 0xcd, 0xaf, 0xb7,
-	0x04, 0x94, 0xb0, 0xd9, 0xcb, 0x3f, 0xa8, 0x04, 0x68, 0xbf, 0x96, 0x03, 0xb8, 0xbd, 0xbd, 0xbd,
-	0x25, 0xdd, 0x0b, 0x06, 0x14, 0xf5, 0x7e, 0xe0, 0xa8, 0x1c, 0xdd, 0x21, 0x18, 0x0b, 0xe4, 0x95,
-	0x3e, 0xbc, 0xbe, 0xbf, 0x8f, 0x1c, 0x9d, 0xfc, 0x24, 0x54, 0xa4, 0x82, 0x2c, 0xbb, 0x3d, 0x88,
-	0xa7, 0x90, 0x4a, 0x34, 0x2a, 0xba, 0xf6, 0x5b, 0x79, 0x80, 0x75, 0xc3, 0xa2, 0x2d, 0x75, 0x08,
-	0xa4, 0xe6, 0xef, 0xbb, 0xd4, 0xdb, 0x77, 0x2c, 0x63, 0x44, 0x6f, 0x2a, 0xb7, 0xf9, 0x6f, 0x2b,
-	0x10, 0x0c, 0xf1, 0x88, 0x01, 0x13, 0x9e, 0x4f, 0x7b, 0x2a, 0xb6, 0x77, 0x44, 0x27, 0xca, 0x8c,
-	0xb0, 0x8b, 0x84, 0x38, 0x18, 0x43, 0x25, 0x3a, 0xd4, 0x4d, 0xbb, 0x2d, 0x3e, 0x90, 0xe6, 0xd1,
-	0x88, 0x03, 0x69, 0x9a, 0xed, 0x38, 0xd6, 0x43, 0x18, 0x8c, 0x62, 0x6a, 0xbf, 0x93, 0x87, 0x2b,
-	0x5c, 0x1e, 0x6b, 0x46, 0x2c, 0x82, 0x97, 0xfc, 0xd1, 0x81, 0x03, 0xab, 0x7f, 0xf8, 0x6c, 0xa2,
-	0xc5, 0x79, 0xc7, 0x4d, 0xea, 0xeb, 0xa1, 0x3e, 0x17, 0x96, 0x45, 0x4e, 0xa9, 0xf6, 0xa1, 0xe8,
-	0xb1, 0xf9, 0x4a, 0xf4, 0x5e, 0x6b, 0xe4, 0x21, 0x94, 0xfe, 0x00, 0x7c, 0xf6, 0x0a, 0xbc, 0xc6,
-	0x7c, 0xd6, 0xe2, 0xe2, 0xc8, 0x2f, 0x42, 0xd9, 0xf3, 0x75, 0xbf, 0xaf, 0x3e, 0xcd, 0x9d, 0x71,
-	0x0b, 0xe6, 0xe0, 0xe1, 0x3c, 0x22, 0xfe, 0xa3, 0x14, 0xaa, 0xfd, 0x4e, 0x0e, 0xe6, 0xd2, 0x2b,
-	0x6e, 0x98, 0x9e, 0x4f, 0xfe, 0xc8, 0x40, 0xb7, 0x9f, 0xf1, 0x8d, 0xb3, 0xda, 0xbc, 0xd3, 0x83,
-	0x33, 0x0d, 0xaa, 0x24, 0xd2, 0xe5, 0x3e, 0x94, 0x4c, 0x9f, 0x76, 0xd5, 0xfe, 0xf2, 0xfe, 0x98,
-	0x1f, 0x3d, 0xb2, 0xb4, 0x33, 0x29, 0x28, 0x84, 0x69, 0xdf, 0xcf, 0x0f, 0x7b, 0x64, 0xbe, 0x7c,
-	0x58, 0xf1, 0x28, 0xf1, 0xbb, 0xd9, 0xa2, 0xc4, 0xe3, 0x0d, 0x1a, 0x0c, 0x16, 0xff, 0x63, 0x83,
-	0xc1, 0xe2, 0xf7, 0xb3, 0x07, 0x8b, 0x27, 0xba, 0x61, 0x68, 0xcc, 0xf8, 0x47, 0x05, 0xb8, 0x76,
-	0xda, 0xb0, 0x61, 0xeb, 0x99, 0x1c, 0x9d, 0x59, 0xd7, 0xb3, 0xd3, 0xc7, 0x21, 0x59, 0x84, 0x52,
-	0x6f, 0x5f, 0xf7, 0x94, 0x52, 0x76, 0x2d, 0x08, 0x33, 0x64, 0x85, 0x8f, 0xd9, 0xa4, 0xc1, 0x95,
-	0x39, 0xfe, 0x17, 0x05, 0x2b, 0x9b, 0x8e, 0xbb, 0xd4, 0xf3, 0x42, 0x9b, 0x40, 0x30, 0x1d, 0x6f,
-	0x8a, 0x62, 0x54, 0x74, 0xe2, 0x43, 0x59, 0x98, 0x98, 0xe5, 0xca, 0x34, 0x7a, 0x20, 0x57, 0xca,
-	0xc1, 0x82, 0xf0, 0xa1, 0xa4, 0xb7, 0x42, 0xca, 0x22, 0xf3, 0x50, 0xf4, 0xc3, 0x30, 0x6f, 0xb5,
-	0x35, 0x2f, 0xa6, 0xe8, 0xa7, 0x9c, 0x8f, 0x6d, 0xec, 0x9d, 0x5d, 0x6e, 0x54, 0x37, 0xa4, 0xff,
-	0xdc, 0x74, 0x6c, 0xae, 0x90, 0x15, 0xc2, 0x8d, 0xfd, 0xfd, 0x01, 0x0e, 0x4c, 0xa9, 0xa5, 0xfd,
-	0xf3, 0x2a, 0x5c, 0x49, 0x1f, 0x0f, 0xac, 0xdf, 0x0e, 0xa9, 0xeb, 0x31, 0xec, 0x5c, 0xbc, 0xdf,
-	0x1e, 0x88, 0x62, 0x54, 0xf4, 0x4f, 0x74, 0xc0, 0xd9, 0xaf, 0xe7, 0xe0, 0xaa, 0x2b, 0x7d, 0x44,
-	0x4f, 0x23, 0xe8, 0xec, 0x25, 0x61, 0xce, 0x18, 0x22, 0x10, 0x87, 0xb7, 0x85, 0xfc, 0x95, 0x1c,
-	0xcc, 0x76, 0x13, 0x76, 0x8e, 0x0b, 0x3c, 0x73, 0xc9, 0xcf, 0x51, 0x6c, 0x0e, 0x91, 0x87, 0x43,
-	0x5b, 0x42, 0xbe, 0x03, 0xf5, 0x1e, 0x1b, 0x17, 0x9e, 0x4f, 0xed, 0xb6, 0x0a, 0x10, 0x1d, 0xfd,
-	0x4b, 0xda, 0x0a, 0xb1, 0x82, 0x33, 0x57, 0x5c, 0x3f, 0x88, 0x10, 0x30, 0x2a, 0xf1, 0x19, 0x3f,
-	0x64, 0x79, 0x13, 0xaa, 0x1e, 0xf5, 0x7d, 0xd3, 0xee, 0x88, 0xfd, 0x46, 0x4d, 0x7c, 0x2b, 0x2d,
-	0x59, 0x86, 0x01, 0x95, 0xfc, 0x14, 0xd4, 0xb8, 0xcb, 0x69, 0xc9, 0xed, 0x78, 0xb3, 0x35, 0x1e,
-	0x2e, 0x36, 0x29, 0x02, 0xe0, 0x64, 0x21, 0x86, 0x74, 0xf2, 0x45, 0x98, 0xd8, 0xe5, 0x9f, 0xaf,
-	0x3c, 0x77, 0x2f, 0x6c, 0x5c, 0x5c, 0x5b, 0x6b, 0x46, 0xca, 0x31, 0xc6, 0x45, 0x16, 0x01, 0x68,
-	0xe0, 0x97, 0x4b, 0xda, 0xb3, 0x42, 0x8f, 0x1d, 0x46, 0xb8, 0xc8, 0x4b, 0x50, 0xf0, 0x2d, 0x8f,
-	0xdb, 0xb0, 0xaa, 0xe1, 0x16, 0x74, 0x7b, 0xa3, 0x85, 0xac, 0x5c, 0xfb, 0xfd, 0x1c, 0x4c, 0x27,
-	0x8e, 0x23, 0xb1, 0x2a, 0x7d, 0xd7, 0x92, 0xd3, 0x48, 0x50, 0x65, 0x07, 0x37, 0x90, 0x95, 0x93,
-	0xf7, 0xa4, 0x5a, 0x9e, 0xcf, 0x98, 0x62, 0xe4, 0x9e, 0xee, 0x7b, 0x4c, 0x0f, 0x1f, 0xd0, 0xc8,
-	0xb9, 0x9b, 0x2f, 0x6c, 0x8f, 0x5c, 0x07, 0x22, 0x6e, 0xbe, 0x90, 0x86, 0x31, 0xce, 0x84, 0xc1,
-	0xaf, 0x78, 0x16, 0x83, 0x9f, 0xf6, 0x2b, 0xf9, 0x48, 0x0f, 0x48, 0xcd, 0xfe, 0x09, 0x3d, 0xf0,
-	0x0a, 0x5b, 0x40, 0x83, 0xc5, 0xbd, 0x16, 0x5d, 0xff, 0xf8, 0x62, 0x2c, 0xa9, 0xe4, 0x6d, 0xd1,
-	0xf7, 0x85, 0x8c, 0x07, 0xb9, 0xb7, 0x37, 0x5a, 0x22, 0xba, 0x4a, 0xbd, 0xb5, 0xe0, 0x15, 0x14,
-	0x2f, 0xe8, 0x15, 0x68, 0xff, 0xa8, 0x00, 0xf5, 0x3b, 0xce, 0xee, 0x27, 0x24, 0x82, 0x3a, 0x7d,
-	0x99, 0xca, 0x7f, 0x8c, 0xcb, 0xd4, 0x0e, 0xbc, 0xe8, 0xfb, 0x56, 0x8b, 0xb6, 0x1d, 0xdb, 0xf0,
-	0x96, 0xf6, 0x7c, 0xea, 0xae, 0x9a, 0xb6, 0xe9, 0xed, 0x53, 0x43, 0xba, 0x93, 0x3e, 0x73, 0x72,
-	0xdc, 0x78, 0x71, 0x7b, 0x7b, 0x23, 0x8d, 0x05, 0x87, 0xd5, 0xe5, 0xd3, 0x86, 0x38, 0x3b, 0xca,
-	0xcf, 0x56, 0xc9, 0x98, 0x1b, 0x31, 0x6d, 0x44, 0xca, 0x31, 0xc6, 0xa5, 0xfd, 0xdb, 0x3c, 0xd4,
-	0x82, 0xe4, 0x11, 0xe4, 0x73, 0x50, 0xd9, 0x75, 0x9d, 0x03, 0xea, 0x0a, 0xcf, 0x9d, 0x3c, 0x5b,
-	0xd5, 0x14, 0x45, 0xa8, 0x68, 0xe4, 0x65, 0x28, 0xf9, 0x4e, 0xcf, 0x6c, 0x27, 0x0d, 0x6a, 0xdb,
-	0xac, 0x10, 0x05, 0x8d, 0x7f, 0x08, 0x3c, 0xac, 0x90, 0x3f, 0x55, 0x35, 0xf2, 0x21, 0xf0, 0x52,
-	0x94, 0x54, 0xf5, 0x21, 0x14, 0xc7, 0xfe, 0x21, 0xbc, 0x12, 0xa8, 0x80, 0xa5, 0xf8, 0x97, 0x98,
-	0x50, 0xda, 0xde, 0x85, 0xa2, 0xa7, 0x7b, 0x96, 0x5c, 0xde, 0x32, 0xe4, 0x6b, 0x58, 0x6a, 0x6d,
-	0xc8, 0x7c, 0x0d, 0x4b, 0xad, 0x0d, 0xe4, 0xa0, 0xda, 0x6f, 0x15, 0xa0, 0x2e, 0xfa, 0x57, 0xcc,
-	0x1e, 0xe3, 0xec, 0xe1, 0x37, 0x79, 0xc8, 0x85, 0xd7, 0xef, 0x52, 0x97, 0x9b, 0xa3, 0xe4, 0x64,
-	0x18, 0xf5, 0x23, 0x84, 0xc4, 0x20, 0xec, 0x22, 0x2c, 0xfa, 0x83, 0xdd, 0xf5, 0x6c, 0xa9, 0xe0,
-	0x09, 0x50, 0xa4, 0x8e, 0x2b, 0x23, 0x29, 0x83, 0xa5, 0xe2, 0x6e, 0x84, 0x86, 0x31, 0x4e, 0xed,
-	0xbf, 0xe5, 0xa1, 0xb6, 0x61, 0xee, 0xd1, 0xf6, 0x51, 0xdb, 0xa2, 0xe4, 0x5b, 0x30, 0x67, 0x50,
-	0x8b, 0xb2, 0x15, 0x73, 0xcd, 0xd5, 0xdb, 0x74, 0x8b, 0xba, 0x26, 0x4f, 0xe0, 0xc4, 0xbe, 0x41,
-	0x19, 0xe0, 0x7a, 0xfd, 0xe4, 0xb8, 0x31, 0xb7, 0x32, 0x94, 0x0b, 0x4f, 0x41, 0x20, 0xeb, 0x30,
-	0x61, 0x50, 0xcf, 0x74, 0xa9, 0xb1, 0x15, 0xd9, 0x10, 0x7d, 0x4e, 0xb5, 0x73, 0x25, 0x42, 0x7b,
-	0x7c, 0xdc, 0x98, 0x54, 0x86, 0x50, 0xb1, 0x33, 0x8a, 0x55, 0x65, 0x53, 0x4b, 0x4f, 0xef, 0x7b,
-	0x34, 0xa5, 0x9d, 0x05, 0xde, 0x4e, 0x3e, 0xb5, 0x6c, 0xa5, 0xb3, 0xe0, 0xb0, 0xba, 0x64, 0x17,
-	0x66, 0x79, 0xfb, 0xd3, 0x70, 0x8b, 0x1c, 0xf7, 0x95, 0x93, 0xe3, 0x86, 0xb6, 0x42, 0x7b, 0x2e,
-	0x6d, 0xeb, 0x3e, 0x35, 0x56, 0x86, 0x70, 0xe3, 0x50, 0x1c, 0xad, 0x04, 0x85, 0x0d, 0xa7, 0xa3,
-	0x7d, 0xbf, 0x00, 0x41, 0x46, 0x31, 0xf2, 0x27, 0x73, 0x50, 0xd7, 0x6d, 0xdb, 0xf1, 0x65, 0xb6,
-	0x2e, 0x11, 0x4d, 0x80, 0x99, 0x13, 0x97, 0xcd, 0x2f, 0x85, 0xa0, 0xc2, 0x11, 0x1d, 0x38, 0xc7,
-	0x23, 0x14, 0x8c, 0xca, 0x26, 0xfd, 0x84, 0x6f, 0x7c, 0x33, 0x7b, 0x2b, 0xce, 0xe0, 0x09, 0x9f,
-	0xfb, 0x1a, 0xcc, 0x24, 0x1b, 0x7b, 0x1e, 0xd7, 0x56, 0xa6, 0x20, 0x83, 0x3c, 0x40, 0x18, 0x1f,
-	0xf3, 0x14, 0x0c, 0x72, 0x66, 0xcc, 0x20, 0x37, 0x7a, 0x5a, 0x87, 0xb0, 0xd1, 0x43, 0x8d, 0x70,
-	0xdf, 0x4e, 0x18, 0xe1, 0xd6, 0xc7, 0x21, 0xec, 0x74, 0xc3, 0xdb, 0x2e, 0x5c, 0x0a, 0x79, 0xc3,
-	0xd9, 0xe5, 0x6e, 0xe2, 0xeb, 0x17, 0x7a, 0xe5, 0xe7, 0x87, 0x7c, 0xfd, 0xd3, 0x91, 0x80, 0xa5,
-	0xc1, 0xef, 0x5f, 0xfb, 0xab, 0x39, 0x98, 0x89, 0x0a, 0xe1, 0x67, 0xd0, 0xbf, 0x04, 0x93, 0x2e,
-	0xd5, 0x8d, 0xa6, 0xee, 0xb7, 0xf7, 0x79, 0x68, 0x7c, 0x8e, 0xc7, 0xb2, 0xf3, 0xd3, 0x72, 0x18,
-	0x25, 0x60, 0x9c, 0x8f, 0xe8, 0x50, 0x67, 0x05, 0xdb, 0x66, 0x97, 0x3a, 0x7d, 0x7f, 0x44, 0x2b,
-	0x33, 0xdf, 0xe0, 0x61, 0x08, 0x83, 0x51, 0x4c, 0xed, 0xa3, 0x1c, 0x4c, 0x45, 0x1b, 0x7c, 0xe1,
-	0x16, 0xc8, 0xfd, 0xb8, 0x05, 0x72, 0x79, 0x0c, 0xef, 0x7d, 0x88, 0xd5, 0xf1, 0xbb, 0xf5, 0xe8,
-	0xa3, 0x71, 0x4b, 0x63, 0xd4, 0xb8, 0x92, 0x3b, 0xd5, 0xb8, 0xf2, 0xc9, 0x4f, 0x54, 0x35, 0x6c,
-	0x57, 0x50, 0x7c, 0x86, 0x77, 0x05, 0x1f, 0x67, 0xb6, 0xab, 0x48, 0xc6, 0xa6, 0x72, 0x86, 0x8c,
-	0x4d, 0xdd, 0x20, 0x63, 0x53, 0x65, 0x6c, 0x13, 0xdb, 0x59, 0xb2, 0x36, 0x55, 0x9f, 0x6a, 0xd6,
-	0xa6, 0xda, 0x45, 0x65, 0x6d, 0x82, 0xac, 0x59, 0x9b, 0xbe, 0x97, 0x83, 0x29, 0x23, 0x76, 0xc2,
-	0x58, 0x9e, 0xed, 0x1f, 0x7d, 0x39, 0x8b, 0x1f, 0x58, 0x16, 0x47, 0xcc, 0xe2, 0x65, 0x98, 0x10,
-	0x99, 0x96, 0x2b, 0x69, 0xe2, 0x63, 0xc9, 0x95, 0x44, 0x7e, 0x11, 0x6a, 0x96, 0x5a, 0xeb, 0x64,
-	0x06, 0xc9, 0x8d, 0xb1, 0x0c, 0x49, 0x89, 0x19, 0x9e, 0x62, 0x08, 0x8a, 0x30, 0x94, 0xa8, 0xfd,
-	0xaf, 0x4a, 0x74, 0x41, 0x7c, 0xda, 0x3e, 0x8e, 0xd7, 0xe3, 0x3e, 0x8e, 0x1b, 0x49, 0x1f, 0xc7,
-	0xc0, 0x6a, 0x2e, 0xfd, 0x1c, 0x5f, 0x88, 0xac, 0x13, 0x05, 0x9e, 0xa4, 0x29, 0x18, 0x72, 0x29,
-	0x6b, 0xc5, 0x12, 0x4c, 0x4b, 0x25, 0x40, 0x11, 0xf9, 0x24, 0x3b, 0x19, 0x46, 0xa5, 0xad, 0xc4,
-	0xc9, 0x98, 0xe4, 0x67, 0x02, 0x3d, 0x95, 0xab, 0x57, 0xec, 0xd8, 0xc2, 0x31, 0xae, 0xf2, 0xe8,
-	0x06, 0x1c, 0x6c, 0x77, 0xe7, 0x52, 0xdd, 0x93, 0x9e, 0x8a, 0xc8, 0xee, 0x0e, 0x79, 0x29, 0x4a,
-	0x6a, 0xd4, 0x5d, 0x53, 0x79, 0x82, 0xbb, 0x46, 0x87, 0xba, 0xa5, 0x7b, 0xbe, 0x18, 0x4c, 0x86,
-	0x9c, 0x4d, 0xfe, 0xd0, 0xd9, 0xd6, 0x7d, 0xa6, 0x4b, 0x84, 0x0a, 0xfc, 0x46, 0x08, 0x83, 0x51,
-	0x4c, 0x62, 0xc0, 0x04, 0xfb, 0xcb, 0x67, 0x16, 0x63, 0xc9, 0x97, 0x19, 0xed, 0xce, 0x23, 0x23,
-	0xd8, 0x3a, 0x6e, 0x44, 0x70, 0x30, 0x86, 0x3a, 0xc4, 0xa3, 0x03, 0xa3, 0x78, 0x74, 0xc8, 0x57,
-	0x84, 0xe2, 0x76, 0x14, 0xbc, 0xd6, 0x3a, 0x7f, 0xad, 0x41, 0x44, 0x2b, 0x46, 0x89, 0x18, 0xe7,
-	0x65, 0xa3, 0xa2, 0x2f, 0xbb, 0x41, 0x55, 0x9f, 0x88, 0x8f, 0x8a, 0x9d, 0x38, 0x19, 0x93, 0xfc,
-	0x64, 0x0b, 0x2e, 0x07, 0x45, 0xd1, 0x66, 0x4c, 0x72, 0x9c, 0x20, 0xc4, 0x70, 0x27, 0x85, 0x07,
-	0x53, 0x6b, 0xf2, 0x33, 0x3b, 0x7d, 0xd7, 0xa5, 0xb6, 0x7f, 0x5b, 0xf7, 0xf6, 0x65, 0xac, 0x62,
-	0x78, 0x66, 0x27, 0x24, 0x61, 0x94, 0x8f, 0x2c, 0x02, 0x08, 0x38, 0x5e, 0x6b, 0x3a, 0x1e, 0x0e,
-	0xbc, 0x13, 0x50, 0x30, 0xc2, 0xa5, 0x7d, 0xaf, 0x06, 0xf5, 0x7b, 0xba, 0x6f, 0x1e, 0x52, 0xee,
-	0x7e, 0xbd, 0x18, 0x1f, 0xd8, 0x5f, 0xc8, 0xc1, 0x95, 0x78, 0x8c, 0xed, 0x05, 0x3a, 0xc2, 0x78,
-	0x8e, 0x27, 0x4c, 0x95, 0x86, 0x43, 0x5a, 0xc1, 0x5d, 0x62, 0x03, 0x21, 0xbb, 0x17, 0xed, 0x12,
-	0x6b, 0x0d, 0x13, 0x88, 0xc3, 0xdb, 0xf2, 0x49, 0x71, 0x89, 0x3d, 0xdb, 0x49, 0x49, 0x13, 0x0e,
-	0xbb, 0xca, 0x33, 0xe3, 0xb0, 0xab, 0x3e, 0x13, 0x5a, 0x7f, 0x2f, 0xe2, 0xb0, 0xab, 0x65, 0x0c,
-	0x1c, 0x93, 0xc7, 0x52, 0x04, 0xda, 0x30, 0xc7, 0x1f, 0xcf, 0x28, 0xa1, 0x1c, 0x29, 0x4c, 0x59,
-	0xde, 0xd5, 0x3d, 0xb3, 0x2d, 0xd5, 0x8e, 0x0c, 0x49, 0x98, 0x55, 0x72, 0x46, 0x11, 0x5f, 0xc2,
-	0xff, 0xa2, 0xc0, 0x0e, 0x73, 0x51, 0xe6, 0x33, 0xe5, 0xa2, 0x24, 0xcb, 0x50, 0xb4, 0x0f, 0xe8,
-	0xd1, 0xf9, 0x72, 0x33, 0xf0, 0x4d, 0xe0, 0xbd, 0xbb, 0xf4, 0x08, 0x79, 0x65, 0xed, 0x07, 0x79,
-	0x00, 0xf6, 0xf8, 0x67, 0x73, 0x9d, 0xfd, 0x24, 0x54, 0xbc, 0x3e, 0x37, 0x0c, 0x49, 0x85, 0x29,
-	0x8c, 0xb6, 0x13, 0xc5, 0xa8, 0xe8, 0xe4, 0x65, 0x28, 0x7d, 0xbb, 0x4f, 0xfb, 0x2a, 0x0e, 0x24,
-	0xd8, 0x37, 0x7c, 0x9d, 0x15, 0xa2, 0xa0, 0x5d, 0x9c, 0x79, 0x5b, 0xb9, 0xd8, 0x4a, 0x17, 0xe5,
-	0x62, 0xab, 0x41, 0xe5, 0x9e, 0xc3, 0x83, 0x77, 0xb5, 0xff, 0x9c, 0x07, 0x08, 0x83, 0x23, 0xc9,
-	0xaf, 0xe5, 0xe0, 0x85, 0xe0, 0x83, 0xf3, 0xc5, 0xf6, 0x8f, 0xe7, 0x3d, 0xcf, 0xec, 0x6e, 0x4b,
-	0xfb, 0xd8, 0xf9, 0x0c, 0xb4, 0x95, 0x26, 0x0e, 0xd3, 0x5b, 0x41, 0x10, 0xaa, 0xb4, 0xdb, 0xf3,
-	0x8f, 0x56, 0x4c, 0x57, 0x8e, 0xc0, 0xd4, 0x18, 0xdc, 0x5b, 0x92, 0x47, 0x54, 0x95, 0x36, 0x0a,
-	0xfe, 0x11, 0x29, 0x0a, 0x06, 0x38, 0x64, 0x1f, 0xaa, 0xb6, 0xf3, 0x9e, 0xc7, 0xba, 0x43, 0x0e,
-	0xc7, 0xb7, 0x46, 0xef, 0x72, 0xd1, 0xad, 0xc2, 0xed, 0x22, 0xff, 0x60, 0xc5, 0x96, 0x9d, 0xfd,
-	0xab, 0x79, 0xb8, 0x94, 0xd2, 0x0f, 0xe4, 0x2d, 0x98, 0x91, 0x71, 0xa8, 0xe1, 0x05, 0x00, 0xb9,
-	0xf0, 0x02, 0x80, 0x56, 0x82, 0x86, 0x03, 0xdc, 0xe4, 0x3d, 0x00, 0xbd, 0xdd, 0xa6, 0x9e, 0xb7,
-	0xe9, 0x18, 0x6a, 0x3f, 0xf0, 0x26, 0x53, 0x5f, 0x96, 0x82, 0xd2, 0xc7, 0xc7, 0x8d, 0x9f, 0x4e,
-	0x0b, 0x2d, 0x4f, 0xf4, 0x73, 0x58, 0x01, 0x23, 0x90, 0xe4, 0x5b, 0x00, 0xc2, 0x06, 0x10, 0x64,
-	0xbf, 0x78, 0x82, 0xe1, 0x6c, 0x5e, 0x25, 0x57, 0x9b, 0xff, 0x7a, 0x5f, 0xb7, 0x7d, 0xd3, 0x3f,
-	0x12, 0xc9, 0x86, 0x1e, 0x04, 0x28, 0x18, 0x41, 0xd4, 0xfe, 0x7e, 0x1e, 0xaa, 0xca, 0xf5, 0xf0,
-	0x14, 0x6c, 0xc1, 0x9d, 0x98, 0x2d, 0x78, 0x4c, 0xc1, 0xe4, 0x69, 0x96, 0x60, 0x27, 0x61, 0x09,
-	0x5e, 0xcb, 0x2e, 0xea, 0x74, 0x3b, 0xf0, 0x6f, 0xe6, 0x61, 0x4a, 0xb1, 0x66, 0xb5, 0xd0, 0x7e,
-	0x15, 0xa6, 0x45, 0x10, 0xc8, 0xa6, 0xfe, 0x48, 0xe4, 0x5d, 0xe2, 0x1d, 0x56, 0x14, 0xf1, 0xdb,
-	0xcd, 0x38, 0x09, 0x93, 0xbc, 0x6c, 0x58, 0x8b, 0xa2, 0x1d, 0xb6, 0x09, 0x13, 0x6e, 0x63, 0xb1,
-	0xdf, 0xe4, 0xc3, 0xba, 0x99, 0xa0, 0xe1, 0x00, 0x77, 0xd2, 0x44, 0x5c, 0xbc, 0x00, 0x13, 0xf1,
-	0xbf, 0xcc, 0xc1, 0x44, 0xd8, 0x5f, 0x17, 0x6e, 0x20, 0xde, 0x8b, 0x1b, 0x88, 0x97, 0x32, 0x0f,
-	0x87, 0x21, 0xe6, 0xe1, 0x3f, 0x53, 0x81, 0xd8, 0x99, 0x06, 0xb2, 0x0b, 0x73, 0x66, 0x6a, 0x64,
-	0x66, 0x64, 0xb6, 0x09, 0x0e, 0xe9, 0xaf, 0x0f, 0xe5, 0xc4, 0x53, 0x50, 0x48, 0x1f, 0xaa, 0x87,
-	0xd4, 0xf5, 0xcd, 0x36, 0x55, 0xcf, 0xb7, 0x96, 0x59, 0x25, 0x93, 0x46, 0xf0, 0xa0, 0x4f, 0x1f,
-	0x48, 0x01, 0x18, 0x88, 0x22, 0xbb, 0x50, 0xa2, 0x46, 0x87, 0xaa, 0x4c, 0x58, 0x19, 0x33, 0x13,
-	0x07, 0xfd, 0xc9, 0xfe, 0x79, 0x28, 0xa0, 0x89, 0x17, 0x35, 0x34, 0x15, 0x33, 0x2a, 0x58, 0x67,
-	0x34, 0x2f, 0x91, 0x83, 0xc0, 0xda, 0x5a, 0x1a, 0xd3, 0xe4, 0x71, 0x8a, 0xad, 0xd5, 0x83, 0xda,
-	0x43, 0xdd, 0xa7, 0x6e, 0x57, 0x77, 0x0f, 0xe4, 0x6e, 0x63, 0xf4, 0x27, 0x7c, 0x5b, 0x21, 0x85,
-	0x4f, 0x18, 0x14, 0x61, 0x28, 0x87, 0x38, 0x50, 0xf3, 0xa5, 0xfa, 0xac, 0x4c, 0xca, 0xa3, 0x0b,
-	0x55, 0x8a, 0xb8, 0x27, 0xcf, 0x36, 0xa8, 0xbf, 0x18, 0xca, 0x20, 0x87, 0xb1, 0x34, 0xf6, 0xe2,
-	0xf2, 0x82, 0x66, 0x06, 0xd7, 0x84, 0x84, 0x0a, 0x97, 0x9b, 0xf4, 0x74, 0xf8, 0xda, 0xff, 0x28,
-	0x85, 0xd3, 0xf2, 0xd3, 0xb6, 0x13, 0x7e, 0x31, 0x6e, 0x27, 0xbc, 0x9e, 0xb4, 0x13, 0x26, 0x7c,
-	0xfe, 0xe7, 0x8f, 0x86, 0x4e, 0x98, 0xd7, 0x8a, 0x17, 0x60, 0x5e, 0x7b, 0x15, 0xea, 0x87, 0x7c,
-	0x26, 0x10, 0x69, 0xb5, 0x4a, 0x7c, 0x19, 0xe1, 0x33, 0xfb, 0x83, 0xb0, 0x18, 0xa3, 0x3c, 0xac,
-	0x8a, 0xbc, 0xb8, 0x27, 0xc8, 0x64, 0x2d, 0xab, 0xb4, 0xc2, 0x62, 0x8c, 0xf2, 0xf0, 0x40, 0x4a,
-	0xd3, 0x3e, 0x10, 0x15, 0x2a, 0xbc, 0x82, 0x08, 0xa4, 0x54, 0x85, 0x18, 0xd2, 0xc9, 0x4d, 0xa8,
-	0xf6, 0x8d, 0x3d, 0xc1, 0x5b, 0xe5, 0xbc, 0x5c, 0xc3, 0xdc, 0x59, 0x59, 0x95, 0x69, 0xbe, 0x14,
-	0x95, 0xb5, 0xa4, 0xab, 0xf7, 0x14, 0x81, 0xef, 0x0d, 0x65, 0x4b, 0x36, 0xc3, 0x62, 0x8c, 0xf2,
-	0x90, 0x9f, 0x85, 0x29, 0x97, 0x1a, 0xfd, 0x36, 0x0d, 0x6a, 0x01, 0xaf, 0x25, 0xf3, 0x9f, 0x46,
-	0x29, 0x98, 0xe0, 0x1c, 0x62, 0x24, 0xac, 0x8f, 0x64, 0x24, 0xfc, 0x1a, 0x4c, 0x19, 0xae, 0x6e,
-	0xda, 0xd4, 0xb8, 0x6f, 0xf3, 0xc0, 0x0e, 0x19, 0xce, 0x19, 0x18, 0xe8, 0x57, 0x62, 0x54, 0x4c,
-	0x70, 0x6b, 0xff, 0x38, 0x0f, 0x25, 0x91, 0x95, 0x75, 0x1d, 0x2e, 0x99, 0xb6, 0xe9, 0x9b, 0xba,
-	0xb5, 0x42, 0x2d, 0xfd, 0x28, 0x1a, 0xe0, 0x52, 0x6a, 0xbe, 0xc8, 0x36, 0xda, 0xeb, 0x83, 0x64,
-	0x4c, 0xab, 0xc3, 0x3a, 0xc7, 0x17, 0xcb, 0xb7, 0x42, 0x11, 0x76, 0x34, 0x91, 0x12, 0x3c, 0x46,
-	0xc1, 0x04, 0x27, 0x53, 0x86, 0x7a, 0x03, 0x91, 0x2b, 0x25, 0xa1, 0x0c, 0xc5, 0x83, 0x49, 0xe2,
-	0x7c, 0x5c, 0x49, 0xef, 0x73, 0x85, 0x38, 0x38, 0x34, 0x25, 0x83, 0xe0, 0x84, 0x92, 0x9e, 0xa0,
-	0xe1, 0x00, 0x37, 0x43, 0xd8, 0xd3, 0x4d, 0xab, 0xef, 0xd2, 0x10, 0xa1, 0x14, 0x22, 0xac, 0x26,
-	0x68, 0x38, 0xc0, 0xad, 0x6d, 0x03, 0x6c, 0xf5, 0x2d, 0x4f, 0xe7, 0x19, 0x78, 0xc6, 0x76, 0x2f,
-	0xc4, 0xef, 0xe5, 0x61, 0x42, 0xc0, 0xca, 0x8d, 0xf4, 0x22, 0x80, 0x4c, 0xf4, 0x63, 0x18, 0xae,
-	0xd4, 0x0d, 0xc2, 0x09, 0x2e, 0xa0, 0x60, 0x84, 0xeb, 0x6c, 0x21, 0x65, 0x6f, 0xc0, 0x84, 0x0a,
-	0x11, 0xe3, 0x6a, 0x47, 0x22, 0xbc, 0x76, 0x39, 0x42, 0xc3, 0x18, 0x27, 0x59, 0x61, 0xbd, 0xbf,
-	0x2b, 0x0e, 0x96, 0x9b, 0x8e, 0xcd, 0x6b, 0x8b, 0x0c, 0x0c, 0xc1, 0xd1, 0xca, 0x56, 0x82, 0x8e,
-	0x03, 0x35, 0xc8, 0x17, 0xa0, 0xda, 0xd5, 0x1f, 0xed, 0xd8, 0x7a, 0xfb, 0x40, 0x4e, 0x21, 0x81,
-	0x5e, 0xb1, 0x29, 0xcb, 0x31, 0xe0, 0x20, 0xba, 0xdc, 0x87, 0x97, 0xb3, 0x1e, 0x3e, 0x0c, 0x5e,
-	0xd9, 0xc0, 0x4e, 0xfc, 0xbf, 0xe6, 0x80, 0x0c, 0x9e, 0xeb, 0x21, 0xfb, 0x50, 0xb6, 0xb9, 0x71,
-	0x39, 0xf3, 0xd5, 0x12, 0x11, 0x1b, 0xb5, 0x58, 0xf5, 0x65, 0x81, 0xc4, 0x27, 0x36, 0x54, 0xe9,
-	0x23, 0x9f, 0xba, 0x76, 0x70, 0xce, 0x6f, 0x3c, 0xd7, 0x58, 0x88, 0xcd, 0xb6, 0x44, 0xc6, 0x40,
-	0x86, 0xf6, 0xbb, 0x79, 0xa8, 0x47, 0xf8, 0x9e, 0x64, 0xb3, 0xe1, 0xa9, 0x46, 0x84, 0x4d, 0x77,
-	0xc7, 0xb5, 0xe4, 0xd8, 0x8a, 0xa4, 0x1a, 0x91, 0x24, 0xdc, 0xc0, 0x28, 0x1f, 0x1b, 0xc0, 0x5d,
-	0xdd, 0xf3, 0x63, 0xa3, 0x2c, 0x18, 0xc0, 0x9b, 0x01, 0x05, 0x23, 0x5c, 0xe4, 0x86, 0xbc, 0x88,
-	0xa4, 0x18, 0x4f, 0xc8, 0x3a, 0xe4, 0x96, 0x91, 0xd2, 0x18, 0x6e, 0x19, 0x21, 0x1d, 0x98, 0x51,
-	0xad, 0x56, 0xd4, 0xf3, 0xa5, 0xeb, 0x14, 0x33, 0x4f, 0x02, 0x02, 0x07, 0x40, 0xb5, 0x1f, 0xe4,
-	0x60, 0x32, 0x66, 0x51, 0x14, 0xa9, 0x54, 0xd5, 0xa9, 0xb4, 0x58, 0x2a, 0xd5, 0xc8, 0x61, 0xb2,
-	0x57, 0xa0, 0x2c, 0x3a, 0x28, 0x19, 0x6c, 0x2e, 0xba, 0x10, 0x25, 0x95, 0xa9, 0x0a, 0xd2, 0x67,
-	0x91, 0x54, 0x15, 0xa4, 0x53, 0x03, 0x15, 0x5d, 0xb8, 0x02, 0x45, 0xeb, 0x64, 0x4f, 0x47, 0x5c,
-	0x81, 0xa2, 0x1c, 0x03, 0x0e, 0xed, 0x6f, 0xf3, 0x76, 0xfb, 0xee, 0x51, 0x60, 0x2a, 0xe9, 0x40,
-	0x45, 0x06, 0x18, 0xcb, 0x4f, 0xe3, 0xad, 0x0c, 0x66, 0x4e, 0x8e, 0x23, 0x43, 0x64, 0xf5, 0xf6,
-	0xc1, 0xfd, 0xbd, 0x3d, 0x54, 0xe8, 0xe4, 0x16, 0xd4, 0x1c, 0x5b, 0x4e, 0xc9, 0xf2, 0xf1, 0x3f,
-	0xcf, 0x54, 0x81, 0xfb, 0xaa, 0xf0, 0xf1, 0x71, 0xe3, 0x4a, 0xf0, 0x27, 0xd6, 0x48, 0x0c, 0x6b,
-	0x6a, 0x7f, 0x22, 0x07, 0x2f, 0xa0, 0x63, 0x59, 0xa6, 0xdd, 0x89, 0xbb, 0xb2, 0x89, 0x05, 0x53,
-	0x62, 0xa6, 0x39, 0xd4, 0x4d, 0x4b, 0xdf, 0xb5, 0xe8, 0x13, 0x4d, 0x1d, 0x7d, 0xdf, 0xb4, 0xe6,
-	0xc5, 0xc5, 0xac, 0xf3, 0xeb, 0xb6, 0x7f, 0xdf, 0x6d, 0xf9, 0xae, 0x69, 0x77, 0xc4, 0xb2, 0xb7,
-	0x19, 0xc3, 0xc2, 0x04, 0xb6, 0xf6, 0x6f, 0x8a, 0xc0, 0x83, 0x57, 0xc9, 0x97, 0xa0, 0xd6, 0xa5,
-	0xed, 0x7d, 0xdd, 0x36, 0x3d, 0x95, 0x94, 0xfa, 0x2a, 0x7b, 0xae, 0x4d, 0x55, 0xf8, 0x98, 0xbd,
-	0x8a, 0xa5, 0xd6, 0x06, 0x3f, 0x47, 0x16, 0xf2, 0x92, 0x36, 0x94, 0x3b, 0x9e, 0xa7, 0xf7, 0xcc,
-	0xcc, 0x31, 0x43, 0x22, 0x09, 0xb0, 0x98, 0x8e, 0xc4, 0x6f, 0x94, 0xd0, 0xa4, 0x0d, 0xa5, 0x9e,
-	0xa5, 0x9b, 0x76, 0xe6, 0x8b, 0x04, 0xd9, 0x13, 0x6c, 0x31, 0x24, 0xb1, 0xde, 0xf1, 0x9f, 0x28,
-	0xb0, 0x49, 0x1f, 0xea, 0x5e, 0xdb, 0xd5, 0xbb, 0xde, 0xbe, 0xbe, 0xf8, 0xda, 0xeb, 0x99, 0x77,
-	0x73, 0xa1, 0x28, 0xa1, 0x5c, 0x2e, 0xe3, 0xd2, 0x66, 0xeb, 0xf6, 0xd2, 0xe2, 0x6b, 0xaf, 0x63,
-	0x54, 0x4e, 0x54, 0xec, 0x6b, 0xaf, 0x2e, 0xca, 0x19, 0x64, 0xec, 0x62, 0x5f, 0x7b, 0x75, 0x11,
-	0xa3, 0x72, 0x58, 0x97, 0x3a, 0x91, 0x65, 0x2c, 0x9b, 0xc0, 0xfb, 0xa1, 0x5b, 0x80, 0xff, 0x44,
-	0x81, 0xad, 0xfd, 0xcf, 0x1c, 0xd4, 0x02, 0x3a, 0x9b, 0x28, 0x45, 0x7a, 0xc3, 0xf5, 0x95, 0xf3,
-	0xe9, 0x26, 0x7c, 0xa2, 0x5c, 0x96, 0x55, 0x31, 0x00, 0x21, 0xef, 0xc2, 0x84, 0xf8, 0x2d, 0xd3,
-	0x0d, 0xe7, 0xcf, 0x9d, 0xd3, 0x78, 0x39, 0x52, 0x1d, 0x63, 0x60, 0xe4, 0x2b, 0x30, 0xc9, 0xf5,
-	0xa0, 0x5b, 0xb6, 0xd1, 0x73, 0x4c, 0x79, 0x3b, 0x50, 0x24, 0xb3, 0xd3, 0x76, 0x94, 0x88, 0x71,
-	0xde, 0xe0, 0xc1, 0xf9, 0x9b, 0x20, 0x3b, 0x00, 0x6c, 0xa5, 0x90, 0xad, 0x3c, 0xd7, 0xa3, 0x73,
-	0xe3, 0xe8, 0x4e, 0x50, 0x19, 0x23, 0x40, 0x29, 0x59, 0xa3, 0xf3, 0xe3, 0xce, 0x1a, 0xbd, 0x00,
-	0xb5, 0x7d, 0xdd, 0x36, 0xbc, 0x7d, 0xfd, 0x80, 0xca, 0x13, 0x15, 0xc1, 0xce, 0xfd, 0xb6, 0x22,
-	0x60, 0xc8, 0xa3, 0xfd, 0xdd, 0x32, 0x88, 0x30, 0x2a, 0x36, 0xa5, 0x1b, 0xa6, 0x27, 0xce, 0x3d,
-	0xe5, 0x78, 0xcd, 0x60, 0x4a, 0x5f, 0x91, 0xe5, 0x18, 0x70, 0x90, 0xab, 0x50, 0xe8, 0x9a, 0xb6,
-	0x54, 0xd8, 0xb9, 0xdf, 0x63, 0xd3, 0xb4, 0x91, 0x95, 0x71, 0x92, 0xfe, 0x48, 0x2a, 0xe4, 0x82,
-	0xa4, 0x3f, 0x42, 0x56, 0x46, 0xbe, 0x0a, 0xd3, 0x96, 0xe3, 0x1c, 0xb0, 0xc9, 0x39, 0x1a, 0x19,
-	0x3e, 0x29, 0x2c, 0x91, 0x1b, 0x71, 0x12, 0x26, 0x79, 0xc9, 0x0e, 0xbc, 0xf8, 0x01, 0x75, 0x1d,
-	0xb9, 0x1a, 0xb5, 0x2c, 0x4a, 0x7b, 0x0a, 0x46, 0xa8, 0x81, 0x3c, 0x70, 0xfd, 0x9b, 0xe9, 0x2c,
-	0x38, 0xac, 0x2e, 0x3f, 0x6a, 0xa3, 0xbb, 0x1d, 0xea, 0x6f, 0xb9, 0x0e, 0x53, 0xf5, 0x4d, 0xbb,
-	0xa3, 0x60, 0xcb, 0x21, 0xec, 0x76, 0x3a, 0x0b, 0x0e, 0xab, 0x4b, 0xde, 0x81, 0x59, 0x41, 0x12,
-	0x4a, 0xe1, 0x92, 0x98, 0xc4, 0x4d, 0x4b, 0xdd, 0x6e, 0x3c, 0x29, 0xdc, 0xcb, 0xdb, 0x43, 0x78,
-	0x70, 0x68, 0x6d, 0x72, 0x07, 0x66, 0x54, 0x70, 0xc1, 0x16, 0x75, 0x5b, 0x41, 0x68, 0xdd, 0xa4,
-	0x3a, 0x61, 0xa0, 0x22, 0xec, 0x31, 0xc1, 0x85, 0x03, 0xf5, 0x08, 0xc2, 0x15, 0x1e, 0x3f, 0xb7,
-	0xd3, 0x5b, 0x76, 0x1c, 0xcb, 0x70, 0x1e, 0xda, 0xea, 0xd9, 0xc5, 0xfe, 0x96, 0xc7, 0x13, 0xb4,
-	0x52, 0x39, 0x70, 0x48, 0x4d, 0xf6, 0xe4, 0x9c, 0xb2, 0xe2, 0x3c, 0xb4, 0x93, 0xa8, 0x10, 0x3e,
-	0x79, 0x6b, 0x08, 0x0f, 0x0e, 0xad, 0x4d, 0x56, 0x81, 0x24, 0x9f, 0x60, 0xa7, 0x27, 0x23, 0x5e,
-	0xae, 0x88, 0xfc, 0x66, 0x49, 0x2a, 0xa6, 0xd4, 0x20, 0x1b, 0x70, 0x39, 0x59, 0xca, 0xc4, 0xc9,
-	0xe0, 0x17, 0x9e, 0xd9, 0x1c, 0x53, 0xe8, 0x98, 0x5a, 0x4b, 0xfb, 0x7b, 0x79, 0x98, 0x8c, 0x25,
-	0xc4, 0x79, 0xe6, 0x12, 0x8f, 0xb0, 0xbd, 0x76, 0xd7, 0xeb, 0xac, 0xaf, 0xdc, 0xa6, 0xba, 0x41,
-	0x5d, 0x75, 0xde, 0xaa, 0x26, 0x95, 0x8e, 0x18, 0x05, 0x13, 0x9c, 0x64, 0x0f, 0x4a, 0xc2, 0xad,
-	0x96, 0xf5, 0x72, 0x34, 0xd5, 0x47, 0xdc, 0xb7, 0x26, 0x6f, 0x14, 0x74, 0x5c, 0x8a, 0x02, 0x5e,
-	0xf3, 0x61, 0x22, 0xca, 0xc1, 0x26, 0x92, 0x70, 0x53, 0x51, 0x89, 0x6d, 0x28, 0xd6, 0xa1, 0xe0,
-	0xfb, 0xa3, 0xa6, 0x34, 0x11, 0x6e, 0xda, 0xed, 0x0d, 0x64, 0x18, 0xda, 0x1e, 0x7b, 0x77, 0x9e,
-	0x67, 0x3a, 0xb6, 0xbc, 0xdf, 0x62, 0x07, 0x2a, 0xd2, 0xd8, 0x30, 0x62, 0x4a, 0x16, 0xae, 0x89,
-	0x2a, 0x2f, 0x85, 0xc2, 0xd2, 0xfe, 0x55, 0x1e, 0x6a, 0x81, 0x55, 0xf1, 0x0c, 0xf7, 0x46, 0x38,
-	0x50, 0x0b, 0xe2, 0x7f, 0x33, 0xdf, 0xfc, 0x1c, 0x86, 0xa5, 0x72, 0x43, 0x58, 0xf0, 0x17, 0x43,
-	0x19, 0xd1, 0xd8, 0xe2, 0x42, 0x86, 0xd8, 0xe2, 0x1e, 0x54, 0x7c, 0xd7, 0xec, 0x74, 0xe4, 0x1e,
-	0x2c, 0x4b, 0x70, 0x71, 0xd0, 0x5d, 0xdb, 0x02, 0x50, 0xf6, 0xac, 0xf8, 0x83, 0x4a, 0x8c, 0xf6,
-	0x3e, 0xcc, 0x24, 0x39, 0xf9, 0x06, 0xa5, 0xbd, 0x4f, 0x8d, 0xbe, 0xa5, 0xfa, 0x38, 0xdc, 0xa0,
-	0xc8, 0x72, 0x0c, 0x38, 0xc8, 0x4d, 0xa8, 0xb2, 0xd7, 0xf4, 0x81, 0x63, 0xab, 0x4d, 0x02, 0x57,
-	0x61, 0xb6, 0x65, 0x19, 0x06, 0x54, 0xed, 0x3f, 0x15, 0xe0, 0x6a, 0x68, 0x1b, 0xde, 0xd4, 0x6d,
-	0xbd, 0x73, 0x86, 0xeb, 0x7e, 0x3f, 0x3d, 0xe4, 0x7a, 0xde, 0xcb, 0x7f, 0x0a, 0xcf, 0xc0, 0xe5,
-	0x3f, 0xff, 0x37, 0x0f, 0xfc, 0xac, 0x02, 0xf9, 0x0e, 0x4c, 0xe8, 0x91, 0x9b, 0xde, 0xe5, 0xeb,
-	0xbc, 0x95, 0xf9, 0x75, 0xf2, 0x23, 0x11, 0x81, 0xd9, 0x2c, 0x5a, 0x8a, 0x31, 0x81, 0xc4, 0x81,
-	0xea, 0x9e, 0x6e, 0x59, 0x4c, 0x17, 0xca, 0xec, 0xeb, 0x8e, 0x09, 0xe7, 0xc3, 0x7c, 0x55, 0x42,
-	0x63, 0x20, 0x84, 0x7c, 0x2f, 0x07, 0x93, 0x6e, 0x74, 0x33, 0x2c, 0x5f, 0x48, 0x96, 0x48, 0xa8,
-	0x08, 0x5a, 0x34, 0x3a, 0x35, 0xba, 0xe3, 0x8e, 0xcb, 0xd4, 0xfe, 0x43, 0x0e, 0x26, 0x5b, 0x96,
-	0x69, 0x98, 0x76, 0xe7, 0x02, 0xef, 0x1e, 0xba, 0x0f, 0x25, 0xcf, 0x32, 0x0d, 0x3a, 0xe2, 0x6a,
-	0x22, 0xd6, 0x31, 0x06, 0x80, 0x02, 0x27, 0x7e, 0x99, 0x51, 0xe1, 0x0c, 0x97, 0x19, 0xfd, 0xc7,
-	0x0a, 0xc8, 0x53, 0x37, 0xa4, 0x0f, 0xb5, 0x8e, 0xba, 0x23, 0x45, 0x3e, 0xe3, 0xed, 0x0c, 0xf9,
-	0x75, 0x63, 0xb7, 0xad, 0x88, 0xb9, 0x3f, 0x28, 0xc4, 0x50, 0x12, 0xa1, 0x50, 0xe2, 0x67, 0x5b,
-	0x33, 0x1b, 0x0f, 0x23, 0xa7, 0x98, 0x45, 0xcf, 0xf0, 0x02, 0x14, 0xe8, 0x44, 0x87, 0xe2, 0xbe,
-	0xef, 0xf7, 0xe4, 0x60, 0x1a, 0xdd, 0x14, 0x1b, 0xa6, 0x78, 0x13, 0x3a, 0x11, 0xfb, 0x8f, 0x1c,
-	0x9a, 0x89, 0xb0, 0xf5, 0xe0, 0x22, 0xd7, 0xe5, 0x4c, 0x51, 0x57, 0x51, 0x11, 0xec, 0x3f, 0x72,
-	0x68, 0xf2, 0x0b, 0x50, 0xf7, 0x5d, 0xdd, 0xf6, 0xf6, 0x1c, 0xb7, 0x4b, 0x5d, 0x69, 0x01, 0x58,
-	0xcd, 0x70, 0xcb, 0xfe, 0x76, 0x88, 0x26, 0x3c, 0x18, 0xb1, 0x22, 0x8c, 0x4a, 0x23, 0x07, 0x50,
-	0xed, 0x1b, 0xa2, 0x61, 0xd2, 0x14, 0xb0, 0x94, 0x41, 0x72, 0x34, 0xa6, 0x4a, 0xfd, 0xc3, 0x40,
-	0x40, 0xfc, 0xce, 0xe2, 0xca, 0xb8, 0xee, 0x2c, 0x8e, 0x8e, 0xc6, 0xb4, 0xfc, 0x53, 0xa4, 0x2b,
-	0xf5, 0x5a, 0xbb, 0x23, 0x43, 0x42, 0x57, 0x33, 0xab, 0x9c, 0x42, 0x64, 0x3d, 0xd0, 0x8d, 0xed,
-	0x0e, 0x2a, 0x19, 0xc4, 0x84, 0x72, 0x8f, 0xdb, 0xf6, 0x33, 0xdf, 0x5f, 0x1f, 0x75, 0xbf, 0x88,
-	0xb9, 0x46, 0x94, 0xa0, 0x14, 0xa0, 0x75, 0x41, 0x7a, 0x75, 0x49, 0x3b, 0x76, 0x25, 0x9c, 0x38,
-	0xb3, 0xbc, 0x70, 0xb6, 0xa9, 0x27, 0xb8, 0x9b, 0x2c, 0x72, 0x25, 0x45, 0xea, 0xdd, 0x6f, 0xda,
-	0xbf, 0xce, 0x43, 0x61, 0x7b, 0xa3, 0x25, 0xd2, 0x4c, 0xf3, 0x4b, 0x26, 0x69, 0xeb, 0xc0, 0xec,
-	0x3d, 0xa0, 0xae, 0xb9, 0x77, 0x24, 0x77, 0xf9, 0x91, 0x34, 0xd3, 0x49, 0x0e, 0x4c, 0xa9, 0xc5,
-	0x8d, 0x38, 0xfa, 0x32, 0x75, 0x33, 0x18, 0x71, 0x96, 0xc2, 0xea, 0x18, 0x03, 0x23, 0x3b, 0x00,
-	0xed, 0x10, 0xba, 0x70, 0x6e, 0xcb, 0x4b, 0x04, 0x38, 0x02, 0x44, 0x10, 0x6a, 0x07, 0x8c, 0x95,
-	0xa3, 0x16, 0xcf, 0x83, 0xca, 0x07, 0xe9, 0x5d, 0x55, 0x17, 0x43, 0x18, 0xcd, 0x86, 0xc9, 0xd8,
-	0x3d, 0x71, 0xe4, 0xcb, 0x50, 0x75, 0x7a, 0x91, 0x99, 0xbb, 0xc6, 0xe3, 0xdc, 0xab, 0xf7, 0x65,
-	0xd9, 0xe3, 0xe3, 0xc6, 0xe4, 0x86, 0xd3, 0x31, 0xdb, 0xaa, 0x00, 0x03, 0x76, 0xa2, 0x41, 0x99,
-	0x9f, 0xa8, 0x56, 0xb7, 0xc4, 0xf1, 0xa1, 0xc3, 0x2f, 0x72, 0xf2, 0x50, 0x52, 0xb4, 0x5f, 0x2a,
-	0x42, 0x18, 0x0b, 0x41, 0x3c, 0x28, 0x8b, 0xd3, 0x5c, 0x72, 0x91, 0xb8, 0xd0, 0x83, 0x63, 0x52,
-	0x14, 0xe9, 0x40, 0xe1, 0x7d, 0x67, 0x37, 0xf3, 0x1a, 0x11, 0x49, 0x0b, 0x23, 0x8c, 0x9e, 0x91,
-	0x02, 0x64, 0x12, 0xc8, 0x5f, 0xcc, 0xc1, 0xf3, 0x5e, 0x52, 0xcb, 0x96, 0xc3, 0x01, 0xb3, 0x6f,
-	0x27, 0x92, 0x7a, 0xbb, 0x3c, 0x90, 0x30, 0x8c, 0x8c, 0x83, 0x6d, 0x61, 0xfd, 0x2f, 0x82, 0x14,
-	0xe4, 0x70, 0x5a, 0xcb, 0x78, 0x1b, 0x76, 0xbc, 0xff, 0xe3, 0x65, 0x28, 0x45, 0x69, 0xdf, 0xcd,
-	0x43, 0x3d, 0xb2, 0x30, 0x64, 0xbe, 0x7c, 0xf0, 0x51, 0xe2, 0xf2, 0xc1, 0xad, 0xd1, 0x63, 0x76,
-	0xc2, 0x56, 0x5d, 0xf4, 0xfd, 0x83, 0xff, 0x30, 0x0f, 0x85, 0x9d, 0x95, 0xd5, 0xf8, 0xfe, 0x38,
-	0xf7, 0x14, 0xf6, 0xc7, 0xfb, 0x50, 0xd9, 0xed, 0x9b, 0x96, 0x6f, 0xda, 0x99, 0x13, 0x57, 0xa9,
-	0xbb, 0x1a, 0xa5, 0xd3, 0x4a, 0xa0, 0xa2, 0x82, 0x27, 0x1d, 0xa8, 0x74, 0x44, 0xe6, 0xe0, 0xcc,
-	0x91, 0xcc, 0x32, 0x03, 0xb1, 0x10, 0x24, 0xff, 0xa0, 0x42, 0xd7, 0x8e, 0xa0, 0xbc, 0xb3, 0x22,
-	0x77, 0x18, 0x4f, 0xb7, 0x37, 0xb5, 0x5f, 0x80, 0x40, 0xe1, 0x78, 0xfa, 0xc2, 0xff, 0x4b, 0x0e,
-	0xe2, 0x3a, 0xd6, 0xd3, 0x1f, 0x4d, 0x07, 0xc9, 0xd1, 0xb4, 0x32, 0x8e, 0x8f, 0x2f, 0x7d, 0x40,
-	0x69, 0xff, 0x22, 0x07, 0x89, 0x23, 0xb8, 0xe4, 0x75, 0x99, 0x84, 0x32, 0x1e, 0x32, 0xaa, 0x92,
-	0x50, 0x92, 0x38, 0x77, 0x24, 0x19, 0xe5, 0x87, 0x6c, 0x67, 0x18, 0xf5, 0x84, 0xca, 0xe6, 0xdf,
-	0x1b, 0x7d, 0x67, 0x98, 0xe6, 0x57, 0x95, 0x61, 0xcd, 0x51, 0x12, 0xc6, 0xe5, 0x6a, 0x7f, 0x27,
-	0x0f, 0xe5, 0xa7, 0x96, 0x75, 0x84, 0xc6, 0x22, 0xcd, 0x97, 0x33, 0xce, 0xf6, 0x43, 0xe3, 0xcc,
-	0xbb, 0x89, 0x38, 0xf3, 0x5b, 0x59, 0x05, 0x9d, 0x1e, 0x65, 0xfe, 0xcf, 0x72, 0x20, 0xd7, 0x9a,
-	0x75, 0xdb, 0xf3, 0x75, 0xbb, 0x4d, 0x49, 0x3b, 0x58, 0xd8, 0xb2, 0x86, 0x33, 0xca, 0x90, 0x5f,
-	0xa1, 0xcb, 0xf0, 0xdf, 0x6a, 0x21, 0x23, 0x5f, 0x80, 0xea, 0xbe, 0xe3, 0xf9, 0x7c, 0xf1, 0xca,
-	0xc7, 0xad, 0x73, 0xb7, 0x65, 0x39, 0x06, 0x1c, 0xc9, 0xb8, 0x84, 0xd2, 0xf0, 0xb8, 0x04, 0xed,
-	0x9b, 0x30, 0x9d, 0x4c, 0x9d, 0xb2, 0x96, 0x9a, 0x3a, 0xe5, 0xe5, 0x21, 0xa9, 0x53, 0xea, 0xc3,
-	0xd3, 0xa6, 0xfc, 0x46, 0x1e, 0x26, 0x3e, 0x29, 0x29, 0x53, 0xd2, 0x62, 0xfe, 0x0b, 0x19, 0x63,
-	0xfe, 0x8b, 0xe7, 0x89, 0xf9, 0xd7, 0x7e, 0x94, 0x03, 0x78, 0x6a, 0xf9, 0x5a, 0x8c, 0x78, 0x38,
-	0x7e, 0xe6, 0x31, 0x9b, 0x1e, 0x8c, 0xff, 0x37, 0xca, 0xea, 0x91, 0x78, 0x28, 0xfe, 0x87, 0x39,
-	0x98, 0xd2, 0x63, 0xe1, 0xed, 0x99, 0x75, 0xf1, 0x44, 0xb4, 0x7c, 0x10, 0x9d, 0x19, 0x2f, 0xc7,
-	0x84, 0x58, 0xf2, 0x46, 0x78, 0xb7, 0xc2, 0xbd, 0xf0, 0x93, 0x1a, 0xb8, 0x14, 0x41, 0xc4, 0xe3,
-	0x45, 0x39, 0x9f, 0x70, 0x9c, 0xa0, 0x30, 0x96, 0xe3, 0x04, 0xd1, 0x83, 0xd2, 0xc5, 0x53, 0x0f,
-	0x4a, 0x1f, 0x42, 0x6d, 0xcf, 0x75, 0xba, 0x3c, 0x62, 0x7f, 0xb6, 0xc4, 0x5f, 0xe5, 0xad, 0x0c,
-	0x8b, 0x70, 0x77, 0xd7, 0xb4, 0xa9, 0xc1, 0x4f, 0x03, 0x04, 0xf6, 0xb7, 0x55, 0x85, 0x8f, 0xa1,
-	0x28, 0xee, 0xb2, 0x70, 0x84, 0xd4, 0xf2, 0x38, 0xa5, 0x06, 0xf3, 0xd4, 0xb6, 0x40, 0x47, 0x25,
-	0x26, 0x1e, 0xa5, 0x5f, 0x79, 0x4a, 0x51, 0xfa, 0x47, 0xd1, 0xc3, 0x0f, 0xd5, 0x8c, 0xd6, 0x9c,
-	0xf3, 0x65, 0xd8, 0xf8, 0xd3, 0x15, 0x35, 0x77, 0x3e, 0x73, 0x19, 0xc4, 0x3f, 0xcd, 0xac, 0xd1,
-	0xa1, 0x03, 0x69, 0x2f, 0xaa, 0x4f, 0x31, 0xed, 0x45, 0x6d, 0x3c, 0x69, 0x2f, 0x20, 0x5b, 0xda,
-	0x8b, 0xfa, 0x98, 0xd2, 0x5e, 0x4c, 0x8c, 0x2b, 0xed, 0xc5, 0xe4, 0x48, 0x69, 0x2f, 0xa6, 0xce,
-	0x94, 0xf6, 0xe2, 0xb8, 0x00, 0x09, 0x1b, 0xc3, 0xa7, 0xae, 0xcb, 0x3f, 0x50, 0xae, 0xcb, 0xef,
-	0xe7, 0x21, 0x5c, 0x03, 0xce, 0x19, 0xda, 0xf5, 0x0e, 0x8f, 0xae, 0xe7, 0x27, 0x35, 0xb2, 0x5c,
-	0xbc, 0xbf, 0x29, 0x31, 0x30, 0x40, 0x23, 0x1e, 0x80, 0x19, 0x5c, 0x7e, 0x93, 0xd9, 0x09, 0x14,
-	0xde, 0xa3, 0x23, 0x6c, 0xbf, 0xe1, 0x7f, 0x8c, 0x88, 0xd1, 0xfe, 0x69, 0x1e, 0xe4, 0x2d, 0x49,
-	0x84, 0x42, 0x69, 0xcf, 0x7c, 0x44, 0x8d, 0xcc, 0xe1, 0xf8, 0xab, 0x0c, 0x45, 0x5e, 0xc5, 0xc4,
-	0xbd, 0x5c, 0xbc, 0x00, 0x05, 0x3a, 0x77, 0x5f, 0x08, 0xaf, 0xa5, 0xec, 0xbf, 0x0c, 0xee, 0x8b,
-	0xa8, 0xf7, 0x53, 0xba, 0x2f, 0x44, 0x11, 0x2a, 0x19, 0xc2, 0x5b, 0xc2, 0x03, 0x58, 0x32, 0x3b,
-	0x69, 0x63, 0x81, 0x30, 0xca, 0x5b, 0xe2, 0x89, 0xbc, 0x37, 0x52, 0x46, 0xf3, 0xe7, 0x7f, 0xf8,
-	0xe3, 0xeb, 0xcf, 0xfd, 0xe8, 0xc7, 0xd7, 0x9f, 0xfb, 0xe8, 0xc7, 0xd7, 0x9f, 0xfb, 0xa5, 0x93,
-	0xeb, 0xb9, 0x1f, 0x9e, 0x5c, 0xcf, 0xfd, 0xe8, 0xe4, 0x7a, 0xee, 0xa3, 0x93, 0xeb, 0xb9, 0x7f,
-	0x77, 0x72, 0x3d, 0xf7, 0xe7, 0xfe, 0xfd, 0xf5, 0xe7, 0xbe, 0xf9, 0xa5, 0xb0, 0x09, 0x0b, 0xaa,
-	0x09, 0x0b, 0x4a, 0xe0, 0x42, 0xef, 0xa0, 0xb3, 0xc0, 0x9a, 0x10, 0x96, 0xa8, 0x26, 0xfc, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x4d, 0x89, 0x14, 0x7a, 0xa4, 0x00, 0x00,
+	// 9758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x8c, 0x24, 0x59,
+	0x76, 0x10, 0xbc, 0xf9, 0xaa, 0xcc, 0x3c, 0x59, 0x8f, 0xee, 0xdb, 0x3d, 0x3d, 0xd9, 0x35, 0x3d,
+	0x5d, 0xbd, 0x39, 0xde, 0xd9, 0xde, 0xcf, 0xeb, 0x6a, 0x4f, 0x7b, 0xe7, 0xb1, 0xcf, 0x99, 0xca,
+	0x7a, 0x74, 0xd7, 0x54, 0x55, 0x77, 0xed, 0xc9, 0xaa, 0x9e, 0xd9, 0x9d, 0xcf, 0x3b, 0x8e, 0xca,
+	0xb8, 0x95, 0x15, 0x53, 0x91, 0x11, 0x39, 0x11, 0x91, 0xd5, 0x5d, 0x63, 0xac, 0x5d, 0x76, 0xd7,
+	0x9a, 0x45, 0x58, 0x02, 0x99, 0x3f, 0x46, 0xc6, 0x20, 0x10, 0x92, 0x7f, 0x58, 0xb6, 0x90, 0xc5,
+	0x22, 0xc4, 0x0f, 0xc0, 0x08, 0xc1, 0x0a, 0x0c, 0xac, 0x2c, 0x4b, 0x2c, 0x08, 0x97, 0xd8, 0x32,
+	0xfc, 0x00, 0x09, 0x30, 0x48, 0x18, 0xab, 0x85, 0x04, 0xba, 0xaf, 0x88, 0x1b, 0x91, 0x91, 0x35,
+	0x55, 0x19, 0xd9, 0x3d, 0x3d, 0x66, 0xfe, 0x65, 0xde, 0x73, 0xee, 0x39, 0xf7, 0x15, 0xf7, 0x9e,
+	0x73, 0xee, 0x39, 0xe7, 0xc2, 0xad, 0x8e, 0x15, 0xec, 0xf5, 0x77, 0xe6, 0xdb, 0x6e, 0xf7, 0x86,
+	0xd3, 0xef, 0x1a, 0x3d, 0xcf, 0x7d, 0x87, 0xff, 0xd8, 0xb5, 0xdd, 0xfb, 0x37, 0x7a, 0xfb, 0x9d,
+	0x1b, 0x46, 0xcf, 0xf2, 0xa3, 0x92, 0x83, 0x17, 0x0c, 0xbb, 0xb7, 0x67, 0xbc, 0x70, 0xa3, 0x43,
+	0x1d, 0xea, 0x19, 0x01, 0x35, 0xe7, 0x7b, 0x9e, 0x1b, 0xb8, 0xe4, 0xe5, 0x88, 0xd0, 0xbc, 0x22,
+	0x34, 0xaf, 0xaa, 0xcd, 0xf7, 0xf6, 0x3b, 0xf3, 0x8c, 0x50, 0x54, 0xa2, 0x08, 0xcd, 0xfe, 0x94,
+	0xd6, 0x82, 0x8e, 0xdb, 0x71, 0x6f, 0x70, 0x7a, 0x3b, 0xfd, 0x5d, 0xfe, 0x8f, 0xff, 0xe1, 0xbf,
+	0x04, 0x9f, 0xd9, 0xc6, 0xfe, 0x2b, 0xfe, 0xbc, 0xe5, 0xb2, 0x66, 0xdd, 0x68, 0xbb, 0x1e, 0xbd,
+	0x71, 0x30, 0xd0, 0x96, 0xd9, 0xcf, 0x45, 0x38, 0x5d, 0xa3, 0xbd, 0x67, 0x39, 0xd4, 0x3b, 0x54,
+	0x7d, 0xb9, 0xe1, 0x51, 0xdf, 0xed, 0x7b, 0x6d, 0x7a, 0xa6, 0x5a, 0xfe, 0x8d, 0x2e, 0x0d, 0x8c,
+	0x34, 0x5e, 0x37, 0x86, 0xd5, 0xf2, 0xfa, 0x4e, 0x60, 0x75, 0x07, 0xd9, 0xbc, 0xf4, 0x41, 0x15,
+	0xfc, 0xf6, 0x1e, 0xed, 0x1a, 0x03, 0xf5, 0x7e, 0x66, 0x58, 0xbd, 0x7e, 0x60, 0xd9, 0x37, 0x2c,
+	0x27, 0xf0, 0x03, 0x2f, 0x59, 0xa9, 0xf1, 0x3b, 0x00, 0x17, 0x16, 0x76, 0xfc, 0xc0, 0x33, 0xda,
+	0xc1, 0xa6, 0x6b, 0x6e, 0xd1, 0x6e, 0xcf, 0x36, 0x02, 0x4a, 0xf6, 0xa1, 0xc2, 0x3a, 0x64, 0x1a,
+	0x81, 0x51, 0xcf, 0x5d, 0xcb, 0x5d, 0xaf, 0xdd, 0x5c, 0x98, 0x1f, 0x71, 0x02, 0xe7, 0x37, 0x24,
+	0xa1, 0xe6, 0xe4, 0xf1, 0xd1, 0x5c, 0x45, 0xfd, 0xc3, 0x90, 0x01, 0xf9, 0x95, 0x1c, 0x4c, 0x3a,
+	0xae, 0x49, 0x5b, 0xd4, 0xa6, 0xed, 0xc0, 0xf5, 0xea, 0xf9, 0x6b, 0x85, 0xeb, 0xb5, 0x9b, 0xdf,
+	0x18, 0x99, 0x63, 0x4a, 0x8f, 0xe6, 0xef, 0x68, 0x0c, 0x96, 0x9d, 0xc0, 0x3b, 0x6c, 0x5e, 0xfc,
+	0xc1, 0xd1, 0xdc, 0x27, 0x8e, 0x8f, 0xe6, 0x26, 0x75, 0x10, 0xc6, 0x5a, 0x42, 0xb6, 0xa1, 0x16,
+	0xb8, 0x36, 0x1b, 0x32, 0xcb, 0x75, 0xfc, 0x7a, 0x81, 0x37, 0xec, 0xea, 0xbc, 0x18, 0x6a, 0xc6,
+	0x7e, 0x9e, 0xad, 0xb1, 0xf9, 0x83, 0x17, 0xe6, 0xb7, 0x42, 0xb4, 0xe6, 0x05, 0x49, 0xb8, 0x16,
+	0x95, 0xf9, 0xa8, 0xd3, 0x21, 0x14, 0x66, 0x7c, 0xda, 0xee, 0x7b, 0x56, 0x70, 0xb8, 0xe8, 0x3a,
+	0x01, 0x7d, 0x10, 0xd4, 0x8b, 0x7c, 0x94, 0x9f, 0x4f, 0x23, 0xbd, 0xe9, 0x9a, 0xad, 0x38, 0x76,
+	0xf3, 0xc2, 0xf1, 0xd1, 0xdc, 0x4c, 0xa2, 0x10, 0x93, 0x34, 0x89, 0x03, 0xe7, 0xac, 0xae, 0xd1,
+	0xa1, 0x9b, 0x7d, 0xdb, 0x6e, 0xd1, 0xb6, 0x47, 0x03, 0xbf, 0x5e, 0xe2, 0x5d, 0xb8, 0x9e, 0xc6,
+	0x67, 0xdd, 0x6d, 0x1b, 0xf6, 0xdd, 0x9d, 0x77, 0x68, 0x3b, 0x40, 0xba, 0x4b, 0x3d, 0xea, 0xb4,
+	0x69, 0xb3, 0x2e, 0x3b, 0x73, 0x6e, 0x35, 0x41, 0x09, 0x07, 0x68, 0x93, 0x5b, 0x70, 0xbe, 0xe7,
+	0x59, 0x2e, 0x6f, 0x82, 0x6d, 0xf8, 0xfe, 0x1d, 0xa3, 0x4b, 0xeb, 0x13, 0xd7, 0x72, 0xd7, 0xab,
+	0xcd, 0xcb, 0x92, 0xcc, 0xf9, 0xcd, 0x24, 0x02, 0x0e, 0xd6, 0x21, 0xd7, 0xa1, 0xa2, 0x0a, 0xeb,
+	0xe5, 0x6b, 0xb9, 0xeb, 0x25, 0xb1, 0x76, 0x54, 0x5d, 0x0c, 0xa1, 0x64, 0x05, 0x2a, 0xc6, 0xee,
+	0xae, 0xe5, 0x30, 0xcc, 0x0a, 0x1f, 0xc2, 0x2b, 0x69, 0x5d, 0x5b, 0x90, 0x38, 0x82, 0x8e, 0xfa,
+	0x87, 0x61, 0x5d, 0xf2, 0x3a, 0x10, 0x9f, 0x7a, 0x07, 0x56, 0x9b, 0x2e, 0xb4, 0xdb, 0x6e, 0xdf,
+	0x09, 0x78, 0xdb, 0xab, 0xbc, 0xed, 0xb3, 0xb2, 0xed, 0xa4, 0x35, 0x80, 0x81, 0x29, 0xb5, 0xc8,
+	0x6b, 0x70, 0x4e, 0x7e, 0xab, 0xd1, 0x28, 0x00, 0xa7, 0x74, 0x91, 0x0d, 0x24, 0x26, 0x60, 0x38,
+	0x80, 0x4d, 0x4c, 0xb8, 0x62, 0xf4, 0x03, 0xb7, 0xcb, 0x48, 0xc6, 0x99, 0x6e, 0xb9, 0xfb, 0xd4,
+	0xa9, 0xd7, 0xae, 0xe5, 0xae, 0x57, 0x9a, 0xd7, 0x8e, 0x8f, 0xe6, 0xae, 0x2c, 0x9c, 0x80, 0x87,
+	0x27, 0x52, 0x21, 0x77, 0xa1, 0x6a, 0x3a, 0xfe, 0xa6, 0x6b, 0x5b, 0xed, 0xc3, 0xfa, 0x24, 0x6f,
+	0xe0, 0x0b, 0xb2, 0xab, 0xd5, 0xa5, 0x3b, 0x2d, 0x01, 0x78, 0x78, 0x34, 0x77, 0x65, 0x70, 0x4b,
+	0x9d, 0x0f, 0xe1, 0x18, 0xd1, 0x20, 0x1b, 0x9c, 0xe0, 0xa2, 0xeb, 0xec, 0x5a, 0x9d, 0xfa, 0x14,
+	0x9f, 0x8d, 0x6b, 0x43, 0x16, 0xf4, 0xd2, 0x9d, 0x96, 0xc0, 0x6b, 0x4e, 0x49, 0x76, 0xe2, 0x2f,
+	0x46, 0x14, 0x88, 0x09, 0xd3, 0x6a, 0x33, 0x5e, 0xb4, 0x0d, 0xab, 0xeb, 0xd7, 0xa7, 0xf9, 0xe2,
+	0xfd, 0x89, 0x21, 0x34, 0x51, 0x47, 0x6e, 0x5e, 0x92, 0x5d, 0x99, 0x8e, 0x15, 0xfb, 0x98, 0xa0,
+	0x39, 0xfb, 0x2a, 0x9c, 0x1f, 0xd8, 0x1b, 0xc8, 0x39, 0x28, 0xec, 0xd3, 0x43, 0xbe, 0xf5, 0x55,
+	0x91, 0xfd, 0x24, 0x17, 0xa1, 0x74, 0x60, 0xd8, 0x7d, 0x5a, 0xcf, 0xf3, 0x32, 0xf1, 0xe7, 0x0b,
+	0xf9, 0x57, 0x72, 0x8d, 0xbf, 0x51, 0x80, 0x49, 0xb5, 0xe3, 0xb4, 0x2c, 0x67, 0x9f, 0xbc, 0x01,
+	0x05, 0xdb, 0xed, 0xc8, 0x7d, 0xf3, 0x4b, 0x23, 0xef, 0x62, 0xeb, 0x6e, 0xa7, 0x59, 0x3e, 0x3e,
+	0x9a, 0x2b, 0xac, 0xbb, 0x1d, 0x64, 0x14, 0x49, 0x1b, 0x4a, 0xfb, 0xc6, 0xee, 0xbe, 0xc1, 0xdb,
+	0x50, 0xbb, 0xd9, 0x1c, 0x99, 0xf4, 0x1a, 0xa3, 0xc2, 0xda, 0xda, 0xac, 0x1e, 0x1f, 0xcd, 0x95,
+	0xf8, 0x5f, 0x14, 0xb4, 0x89, 0x0b, 0xd5, 0x1d, 0xdb, 0x68, 0xef, 0xef, 0xb9, 0x36, 0xad, 0x17,
+	0x32, 0x32, 0x6a, 0x2a, 0x4a, 0x62, 0x9a, 0xc3, 0xbf, 0x18, 0xf1, 0x20, 0x6d, 0x98, 0xe8, 0x9b,
+	0xbe, 0xe5, 0xec, 0xcb, 0x3d, 0xf0, 0xd5, 0x91, 0xb9, 0x6d, 0x2f, 0xf1, 0x3e, 0xc1, 0xf1, 0xd1,
+	0xdc, 0x84, 0xf8, 0x8d, 0x92, 0x74, 0xe3, 0x4f, 0x26, 0x61, 0x5a, 0x4d, 0xd2, 0x3d, 0xea, 0x05,
+	0xf4, 0x01, 0xb9, 0x06, 0x45, 0x87, 0x7d, 0x9a, 0x7c, 0x92, 0x9b, 0x93, 0x72, 0xb9, 0x14, 0xf9,
+	0x27, 0xc9, 0x21, 0xac, 0x65, 0x62, 0xa9, 0xc8, 0x01, 0x1f, 0xbd, 0x65, 0x2d, 0x4e, 0x46, 0xb4,
+	0x4c, 0xfc, 0x46, 0x49, 0x9a, 0xbc, 0x05, 0x45, 0xde, 0x79, 0x31, 0xd4, 0x5f, 0x1e, 0x9d, 0x05,
+	0xeb, 0x7a, 0x85, 0xf5, 0x80, 0x77, 0x9c, 0x13, 0x65, 0x4b, 0xb1, 0x6f, 0xee, 0xca, 0x81, 0xfd,
+	0x52, 0x86, 0x81, 0x5d, 0x11, 0x4b, 0x71, 0x7b, 0x69, 0x05, 0x19, 0x45, 0xf2, 0x17, 0x72, 0x70,
+	0xbe, 0xed, 0x3a, 0x81, 0xc1, 0xe4, 0x0c, 0x75, 0xc8, 0xd6, 0x4b, 0x9c, 0xcf, 0xeb, 0x23, 0xf3,
+	0x59, 0x4c, 0x52, 0x6c, 0x3e, 0xc5, 0xce, 0x8c, 0x81, 0x62, 0x1c, 0xe4, 0x4d, 0x7e, 0x35, 0x07,
+	0x4f, 0xb1, 0xbd, 0x7c, 0x00, 0x99, 0x9f, 0x40, 0xe3, 0x6d, 0xd5, 0xe5, 0xe3, 0xa3, 0xb9, 0xa7,
+	0x56, 0xd3, 0x98, 0x61, 0x7a, 0x1b, 0x58, 0xeb, 0x2e, 0x18, 0x83, 0x62, 0x09, 0x3f, 0xdd, 0x6a,
+	0x37, 0xd7, 0xc7, 0x29, 0xea, 0x34, 0x9f, 0x91, 0x4b, 0x39, 0x4d, 0xb2, 0xc3, 0xb4, 0x56, 0x90,
+	0x65, 0x28, 0x1f, 0xb8, 0x76, 0xbf, 0x4b, 0xfd, 0x7a, 0x85, 0x6f, 0xb1, 0xb3, 0x69, 0x5b, 0xec,
+	0x3d, 0x8e, 0xd2, 0x9c, 0x91, 0xe4, 0xcb, 0xe2, 0xbf, 0x8f, 0xaa, 0x2e, 0xb1, 0x60, 0xc2, 0xb6,
+	0xba, 0x56, 0xe0, 0xf3, 0x83, 0xb3, 0x76, 0x73, 0x79, 0xe4, 0x6e, 0x89, 0x4f, 0x74, 0x9d, 0x13,
+	0x13, 0x5f, 0x8d, 0xf8, 0x8d, 0x92, 0x01, 0xdb, 0x0a, 0xfd, 0xb6, 0x61, 0x8b, 0x83, 0xb5, 0x76,
+	0xf3, 0x2b, 0xa3, 0x7f, 0x36, 0x8c, 0x4a, 0x73, 0x4a, 0xf6, 0xa9, 0xc4, 0xff, 0xa2, 0xa0, 0x4d,
+	0x7e, 0x16, 0xa6, 0x63, 0xb3, 0xe9, 0xd7, 0x6b, 0x7c, 0x74, 0x9e, 0x4d, 0x1b, 0x9d, 0x10, 0x2b,
+	0x3a, 0x79, 0x62, 0x2b, 0xc4, 0xc7, 0x04, 0x31, 0xb2, 0x06, 0x15, 0xdf, 0x32, 0x69, 0xdb, 0xf0,
+	0xfc, 0xfa, 0xe4, 0x69, 0x08, 0x9f, 0x93, 0x84, 0x2b, 0x2d, 0x59, 0x0d, 0x43, 0x02, 0x64, 0x1e,
+	0xa0, 0x67, 0x78, 0x81, 0x25, 0x04, 0xd5, 0x29, 0x2e, 0x34, 0x4d, 0x1f, 0x1f, 0xcd, 0xc1, 0x66,
+	0x58, 0x8a, 0x1a, 0x06, 0xc3, 0x67, 0x75, 0x57, 0x9d, 0x5e, 0x3f, 0x10, 0x07, 0x6b, 0x55, 0xe0,
+	0xb7, 0xc2, 0x52, 0xd4, 0x30, 0xc8, 0x6f, 0xe6, 0xe0, 0x99, 0xe8, 0xef, 0xe0, 0x47, 0x36, 0x33,
+	0xf6, 0x8f, 0x6c, 0xee, 0xf8, 0x68, 0xee, 0x99, 0xd6, 0x70, 0x96, 0x78, 0x52, 0x7b, 0xc8, 0xfb,
+	0x39, 0x98, 0xee, 0xf7, 0x4c, 0x23, 0xa0, 0xad, 0x80, 0x69, 0x3c, 0x9d, 0xc3, 0xfa, 0x39, 0xde,
+	0xc4, 0x5b, 0xa3, 0xef, 0x82, 0x31, 0x72, 0xd1, 0x34, 0xc7, 0xcb, 0x31, 0xc1, 0xb6, 0xf1, 0x06,
+	0x4c, 0x2d, 0xf4, 0x83, 0x3d, 0xd7, 0xb3, 0xde, 0xe3, 0xe2, 0x3f, 0x59, 0x81, 0x52, 0xc0, 0xc5,
+	0x38, 0x21, 0x21, 0x7c, 0x2a, 0x6d, 0xd2, 0x85, 0x48, 0xbd, 0x46, 0x0f, 0x95, 0x5c, 0x22, 0x4e,
+	0x6a, 0x21, 0xd6, 0x89, 0xea, 0x8d, 0xef, 0xe6, 0xa0, 0xdc, 0x34, 0xda, 0xfb, 0xee, 0xee, 0x2e,
+	0x79, 0x13, 0x2a, 0x96, 0x13, 0x50, 0xef, 0xc0, 0xb0, 0x25, 0xd9, 0x79, 0x8d, 0x6c, 0xa8, 0x10,
+	0x46, 0xdd, 0x63, 0xda, 0x17, 0x63, 0xb4, 0xd4, 0x97, 0x5a, 0x0b, 0x97, 0x8c, 0x57, 0x25, 0x0d,
+	0x0c, 0xa9, 0x91, 0x39, 0x28, 0xf9, 0x01, 0xed, 0xf9, 0xfc, 0x0c, 0x9c, 0x12, 0xcd, 0x68, 0xb1,
+	0x02, 0x14, 0xe5, 0x8d, 0xbf, 0x9e, 0x83, 0x6a, 0xd3, 0xf0, 0xad, 0x36, 0xeb, 0x25, 0x59, 0x84,
+	0x62, 0xdf, 0xa7, 0xde, 0xd9, 0xfa, 0xc6, 0x8f, 0xad, 0x6d, 0x9f, 0x7a, 0xc8, 0x2b, 0x93, 0xbb,
+	0x50, 0xe9, 0x19, 0xbe, 0x7f, 0xdf, 0xf5, 0x4c, 0x79, 0xf4, 0x9e, 0x92, 0x90, 0x50, 0x13, 0x64,
+	0x55, 0x0c, 0x89, 0x88, 0x36, 0x86, 0x12, 0xc7, 0x5f, 0xca, 0x31, 0x69, 0xff, 0xdd, 0x3e, 0x53,
+	0x70, 0xee, 0x19, 0xb6, 0x65, 0xf2, 0x11, 0x90, 0x4d, 0x5e, 0x1b, 0x7d, 0x2b, 0x19, 0x20, 0xd9,
+	0xbc, 0x24, 0xd4, 0x86, 0x64, 0x39, 0xa6, 0xb0, 0x6f, 0x7c, 0x2b, 0x0f, 0x33, 0xcd, 0xfe, 0xee,
+	0x2e, 0xf5, 0x90, 0x06, 0xd4, 0xe1, 0x4b, 0x05, 0x61, 0xa2, 0x6b, 0x3c, 0x58, 0xe8, 0xd0, 0x11,
+	0x27, 0x95, 0x6f, 0x9d, 0x1b, 0x9c, 0x02, 0x4a, 0x4a, 0xe4, 0x05, 0xa8, 0x75, 0x8d, 0x07, 0x1b,
+	0xd4, 0xf7, 0x8d, 0x0e, 0x15, 0xd3, 0x5a, 0x68, 0xce, 0x30, 0x7d, 0x75, 0x23, 0x2a, 0x46, 0x1d,
+	0x87, 0xe9, 0x63, 0x5d, 0xe3, 0x41, 0xf3, 0x30, 0xa0, 0x3e, 0x97, 0x53, 0x0a, 0x52, 0x97, 0x97,
+	0x65, 0x18, 0x42, 0xc9, 0x97, 0xa0, 0x6c, 0x5a, 0x7e, 0xdb, 0xf0, 0x4c, 0x2e, 0x74, 0x54, 0x9b,
+	0x0d, 0x76, 0x52, 0x2c, 0x89, 0xa2, 0x87, 0x47, 0x73, 0x17, 0x44, 0x0f, 0x65, 0x81, 0x54, 0x21,
+	0x54, 0x95, 0xc6, 0xbf, 0xcd, 0x83, 0x44, 0x90, 0xfa, 0x8a, 0xd4, 0x04, 0x28, 0x94, 0x3c, 0x6a,
+	0x5a, 0xbe, 0x1c, 0x85, 0xa5, 0x91, 0xa7, 0x08, 0x19, 0x15, 0xa9, 0x78, 0xf0, 0x95, 0xcc, 0x0b,
+	0x50, 0x50, 0x27, 0x7d, 0xa8, 0xbe, 0x43, 0x03, 0x3f, 0xf0, 0xa8, 0xd1, 0x95, 0xeb, 0xee, 0xf6,
+	0xc8, 0xac, 0x5e, 0xa7, 0x41, 0x8b, 0x53, 0xd2, 0xf5, 0x9c, 0xb0, 0x10, 0x23, 0x4e, 0xac, 0x77,
+	0x42, 0xac, 0x2f, 0x64, 0xec, 0x1d, 0x97, 0xe3, 0xf5, 0xde, 0xe9, 0x82, 0x7d, 0xe3, 0x77, 0x4a,
+	0x30, 0xb9, 0xe8, 0x76, 0x77, 0x2c, 0x87, 0x9a, 0xcb, 0x66, 0x87, 0x92, 0xb7, 0xa1, 0x48, 0xcd,
+	0x70, 0x69, 0x8d, 0x2e, 0x79, 0x32, 0x62, 0x91, 0xfc, 0xcc, 0xfe, 0x21, 0x27, 0x4c, 0xd6, 0x61,
+	0x7a, 0xd7, 0x73, 0xbb, 0xe2, 0x30, 0xdf, 0x3a, 0xec, 0x49, 0xe5, 0xa9, 0xf9, 0x13, 0x6a, 0xe7,
+	0x5c, 0x89, 0x41, 0x1f, 0x1e, 0xcd, 0x41, 0xf4, 0x0f, 0x13, 0x75, 0xc9, 0x9b, 0x50, 0x8f, 0x4a,
+	0xc2, 0x53, 0x6d, 0x91, 0xe9, 0xb3, 0x7c, 0xe4, 0x4a, 0xcd, 0x2b, 0xc7, 0x47, 0x73, 0xf5, 0x95,
+	0x21, 0x38, 0x38, 0xb4, 0x36, 0x3b, 0x2b, 0xce, 0x45, 0x40, 0x21, 0x69, 0x48, 0x99, 0x79, 0x4c,
+	0x22, 0x0c, 0x57, 0xfc, 0x57, 0x12, 0x2c, 0x70, 0x80, 0x29, 0x59, 0x81, 0xc9, 0xc0, 0xd5, 0xc6,
+	0xab, 0x24, 0xbe, 0x21, 0x65, 0xa9, 0xda, 0x72, 0x87, 0x8e, 0x56, 0xac, 0x1e, 0x41, 0xb8, 0xa4,
+	0xfe, 0x27, 0x46, 0x6a, 0x82, 0x8f, 0xd4, 0xec, 0xf1, 0xd1, 0xdc, 0xa5, 0xad, 0x54, 0x0c, 0x1c,
+	0x52, 0x93, 0xfc, 0xd9, 0x1c, 0x4c, 0x2b, 0x90, 0x1c, 0xa3, 0xf2, 0x38, 0xc7, 0x88, 0xb0, 0x15,
+	0xb1, 0x15, 0x63, 0x80, 0x09, 0x86, 0x8d, 0xef, 0x97, 0xa1, 0x1a, 0x9e, 0xf5, 0xe4, 0x39, 0x28,
+	0x71, 0x1b, 0x94, 0x54, 0xe1, 0x42, 0x21, 0x8e, 0x9b, 0xaa, 0x50, 0xc0, 0xc8, 0xa7, 0xa0, 0xdc,
+	0x76, 0xbb, 0x5d, 0xc3, 0x31, 0xb9, 0x5d, 0xb1, 0xda, 0xac, 0xb1, 0x1d, 0x69, 0x51, 0x14, 0xa1,
+	0x82, 0x91, 0x2b, 0x50, 0x34, 0xbc, 0x8e, 0x30, 0xf1, 0x55, 0xc5, 0x81, 0xb4, 0xe0, 0x75, 0x7c,
+	0xe4, 0xa5, 0xe4, 0xf3, 0x50, 0xa0, 0xce, 0x41, 0xbd, 0x38, 0x5c, 0x38, 0x5e, 0x76, 0x0e, 0xee,
+	0x19, 0x5e, 0xb3, 0x26, 0xdb, 0x50, 0x58, 0x76, 0x0e, 0x90, 0xd5, 0x21, 0xeb, 0x50, 0xa6, 0xce,
+	0x01, 0x9b, 0x7b, 0x69, 0x7b, 0xfb, 0xe4, 0x90, 0xea, 0x0c, 0x45, 0xea, 0x89, 0xa1, 0x88, 0x2d,
+	0x8b, 0x51, 0x91, 0x20, 0x5f, 0x83, 0x49, 0x21, 0x6d, 0x6f, 0xb0, 0x39, 0xf1, 0xeb, 0x13, 0x9c,
+	0xe4, 0xdc, 0x70, 0x71, 0x9d, 0xe3, 0x45, 0xb6, 0x4e, 0xad, 0xd0, 0xc7, 0x18, 0x29, 0xf2, 0x35,
+	0xa8, 0x2a, 0xd3, 0x88, 0x9a, 0xd9, 0x54, 0x33, 0xa1, 0xb2, 0xa7, 0x20, 0x7d, 0xb7, 0x6f, 0x79,
+	0xb4, 0x4b, 0x9d, 0xc0, 0x6f, 0x9e, 0x57, 0x86, 0x23, 0x05, 0xf5, 0x31, 0xa2, 0x46, 0x76, 0x06,
+	0xed, 0x9d, 0xc2, 0x58, 0xf7, 0xdc, 0x90, 0x63, 0x7d, 0x04, 0x63, 0xe7, 0x37, 0x60, 0x26, 0x34,
+	0x48, 0x4a, 0x9b, 0x96, 0x30, 0xdf, 0x7d, 0x8e, 0x55, 0x5f, 0x8d, 0x83, 0x1e, 0x1e, 0xcd, 0x3d,
+	0x9b, 0x62, 0xd5, 0x8a, 0x10, 0x30, 0x49, 0x8c, 0xbc, 0x07, 0xd3, 0x1e, 0x35, 0x4c, 0xcb, 0xa1,
+	0xbe, 0xbf, 0xe9, 0xb9, 0x3b, 0xd9, 0x55, 0x0f, 0x4e, 0x45, 0x2c, 0x7b, 0x8c, 0x51, 0xc6, 0x04,
+	0x27, 0x72, 0x1f, 0xa6, 0x6c, 0xeb, 0x80, 0x46, 0xac, 0x6b, 0x63, 0x61, 0x7d, 0xfe, 0xf8, 0x68,
+	0x6e, 0x6a, 0x5d, 0x27, 0x8c, 0x71, 0x3e, 0x4c, 0x54, 0xed, 0xb9, 0x5e, 0xa0, 0xf4, 0x93, 0x4f,
+	0x9e, 0xa8, 0x9f, 0x6c, 0xba, 0x5e, 0x10, 0x7d, 0x84, 0xec, 0x9f, 0x8f, 0xa2, 0x7a, 0xe3, 0x6f,
+	0x97, 0x60, 0x50, 0x8b, 0x8f, 0xaf, 0xb8, 0xdc, 0xb8, 0x57, 0x5c, 0x72, 0x35, 0x88, 0xb3, 0xe7,
+	0x15, 0x59, 0x6d, 0x0c, 0x2b, 0x22, 0x65, 0x55, 0x17, 0xc6, 0xbd, 0xaa, 0x9f, 0x98, 0x8d, 0x67,
+	0x70, 0xf9, 0x4f, 0x7c, 0x78, 0xcb, 0xbf, 0xfc, 0x78, 0x96, 0x7f, 0xe3, 0x7b, 0x45, 0x98, 0x5e,
+	0x32, 0x68, 0xd7, 0x75, 0x3e, 0xd0, 0x90, 0x93, 0x7b, 0x22, 0x0c, 0x39, 0xd7, 0xa1, 0xe2, 0xd1,
+	0x9e, 0x6d, 0xb5, 0x0d, 0x21, 0xd8, 0xcb, 0x8b, 0x13, 0x94, 0x65, 0x18, 0x42, 0x87, 0x18, 0xf0,
+	0x0a, 0x4f, 0xa4, 0x01, 0xaf, 0xf8, 0xe1, 0x1b, 0xf0, 0x1a, 0xbf, 0x55, 0x00, 0x2e, 0xda, 0x92,
+	0x6b, 0x50, 0x64, 0x62, 0x5b, 0xd2, 0x6c, 0xcc, 0xbf, 0x16, 0x0e, 0x21, 0xb3, 0x90, 0x0f, 0x5c,
+	0xb9, 0xdd, 0x80, 0x84, 0xe7, 0xb7, 0x5c, 0xcc, 0x07, 0x2e, 0x79, 0x0f, 0xa0, 0xed, 0x3a, 0xa6,
+	0xa5, 0xee, 0x13, 0xb3, 0x75, 0x6c, 0xc5, 0xf5, 0xee, 0x1b, 0x9e, 0xb9, 0x18, 0x52, 0x14, 0x26,
+	0x9c, 0xe8, 0x3f, 0x6a, 0xdc, 0xc8, 0xab, 0x30, 0xe1, 0x3a, 0x2b, 0x7d, 0xdb, 0x96, 0xaa, 0xd9,
+	0xa7, 0x99, 0x72, 0x78, 0x97, 0x97, 0x3c, 0x3c, 0x9a, 0xbb, 0x2c, 0x14, 0x2f, 0xf6, 0xef, 0x0d,
+	0xcf, 0x0a, 0x2c, 0xa7, 0x13, 0x5a, 0x34, 0x64, 0x35, 0xf2, 0x39, 0x98, 0xdc, 0xe1, 0x48, 0xf2,
+	0x8a, 0x47, 0x48, 0xa7, 0xe7, 0x98, 0x5c, 0xd1, 0xd4, 0xca, 0x31, 0x86, 0xc5, 0xb4, 0x2a, 0x4f,
+	0x29, 0xb4, 0x72, 0xd3, 0x18, 0x5d, 0xab, 0x4a, 0x28, 0xc8, 0x42, 0xab, 0x0a, 0xff, 0x62, 0xc4,
+	0xa9, 0xf1, 0xcb, 0x39, 0xa8, 0xad, 0x58, 0x0f, 0xa8, 0xf9, 0x86, 0xe5, 0x98, 0xee, 0x7d, 0xa6,
+	0x4a, 0xdb, 0xd4, 0xe9, 0x04, 0x7b, 0x59, 0x54, 0xe9, 0x75, 0x4e, 0x01, 0x25, 0x25, 0x72, 0x03,
+	0xaa, 0x42, 0x87, 0xb3, 0x9c, 0x0e, 0x9f, 0xf0, 0x4a, 0x74, 0x2c, 0xb5, 0x14, 0x00, 0x23, 0x9c,
+	0xc6, 0x21, 0x9c, 0x1f, 0x98, 0x33, 0x62, 0x42, 0x31, 0x30, 0x3a, 0xea, 0x04, 0x5c, 0x19, 0x79,
+	0x6c, 0xb6, 0x8c, 0x8e, 0xb6, 0x12, 0xb8, 0x08, 0xbb, 0x65, 0x30, 0x11, 0x96, 0x51, 0x6f, 0xfc,
+	0xef, 0x1c, 0x54, 0x56, 0xfa, 0x4e, 0x9b, 0xdb, 0x15, 0x3e, 0xf8, 0xee, 0x43, 0xc9, 0xc3, 0xf9,
+	0x54, 0x79, 0xb8, 0x0f, 0x13, 0xfb, 0xf7, 0x43, 0x79, 0xb9, 0x76, 0x73, 0x63, 0xf4, 0x25, 0x2c,
+	0x9b, 0x34, 0xbf, 0xc6, 0xe9, 0x89, 0xab, 0xf9, 0x69, 0xd9, 0xa0, 0x89, 0xb5, 0x37, 0x38, 0x53,
+	0xc9, 0x6c, 0xf6, 0xf3, 0x50, 0xd3, 0xd0, 0xce, 0x74, 0x4b, 0xf7, 0x77, 0x8a, 0x30, 0x71, 0xab,
+	0xd5, 0x5a, 0xd8, 0x5c, 0x25, 0x2f, 0x42, 0x4d, 0xde, 0xda, 0xde, 0x89, 0xc6, 0x20, 0xbc, 0xb4,
+	0x6f, 0x45, 0x20, 0xd4, 0xf1, 0x98, 0xb6, 0xe1, 0x51, 0xc3, 0xee, 0xca, 0x2f, 0x3b, 0x14, 0x74,
+	0x90, 0x15, 0xa2, 0x80, 0x11, 0x03, 0xa6, 0xfb, 0x3e, 0xf5, 0xd8, 0x10, 0x0a, 0xeb, 0x94, 0xfc,
+	0xc6, 0x4f, 0x69, 0xbf, 0xe2, 0xa7, 0xe1, 0x76, 0x8c, 0x00, 0x26, 0x08, 0x92, 0x57, 0xa0, 0x62,
+	0xf4, 0x83, 0x3d, 0xae, 0x1f, 0x8a, 0x0f, 0xf9, 0x0a, 0xbf, 0xd4, 0x96, 0x65, 0x0f, 0x8f, 0xe6,
+	0x26, 0xd7, 0xb0, 0xf9, 0xa2, 0xfa, 0x8f, 0x21, 0x36, 0x6b, 0x9c, 0xb2, 0x88, 0xc9, 0xc6, 0x95,
+	0xce, 0xdc, 0xb8, 0xcd, 0x18, 0x01, 0x4c, 0x10, 0x24, 0x6f, 0xc1, 0xe4, 0x3e, 0x3d, 0x0c, 0x8c,
+	0x1d, 0xc9, 0x60, 0xe2, 0x2c, 0x0c, 0xf8, 0x4e, 0xb2, 0xa6, 0x55, 0xc7, 0x18, 0x31, 0xe2, 0xc3,
+	0xc5, 0x7d, 0xea, 0xed, 0x50, 0xcf, 0x95, 0x36, 0x1c, 0xc9, 0xa4, 0x7c, 0x16, 0x26, 0xf5, 0xe3,
+	0xa3, 0xb9, 0x8b, 0x6b, 0x29, 0x64, 0x30, 0x95, 0x78, 0xe3, 0x97, 0x72, 0x70, 0xfe, 0x96, 0x70,
+	0x9b, 0x71, 0x3d, 0xe4, 0x86, 0x5d, 0xda, 0x23, 0xcf, 0x42, 0xc1, 0xeb, 0xf5, 0xf9, 0xda, 0x29,
+	0x44, 0xb2, 0x17, 0x6e, 0x6e, 0x23, 0x2b, 0x27, 0x6f, 0x42, 0xc5, 0x94, 0x1b, 0x87, 0x34, 0x24,
+	0x8d, 0x64, 0x8e, 0x55, 0xff, 0x30, 0xa4, 0xd6, 0x70, 0xe1, 0x52, 0xd4, 0x1a, 0xe1, 0x37, 0x20,
+	0xec, 0xcf, 0xa4, 0xc1, 0x6d, 0x85, 0x18, 0xb6, 0x4a, 0xd9, 0xfe, 0x58, 0xa3, 0x24, 0x44, 0xda,
+	0xfe, 0xd6, 0xe8, 0xa1, 0x30, 0x06, 0x08, 0x11, 0x41, 0xd9, 0xfe, 0x54, 0x31, 0xea, 0x38, 0x8d,
+	0xbf, 0x7b, 0x1e, 0x66, 0x42, 0x8e, 0x42, 0x4c, 0x24, 0x97, 0xf5, 0xde, 0x97, 0x1f, 0x4f, 0xcf,
+	0x99, 0x22, 0xdf, 0xf5, 0x3b, 0x2d, 0xeb, 0x3d, 0x2a, 0xcd, 0x3d, 0x5c, 0x91, 0xdf, 0x10, 0x45,
+	0xa8, 0x60, 0x4c, 0x04, 0xda, 0x57, 0xfd, 0x2b, 0x46, 0x22, 0x50, 0xd8, 0xb9, 0x10, 0x4a, 0xe6,
+	0xd4, 0x66, 0xc1, 0xbe, 0x82, 0xa2, 0xb0, 0x98, 0xdd, 0x63, 0x05, 0x72, 0xdf, 0x60, 0x47, 0xc6,
+	0x3b, 0x56, 0x10, 0x50, 0x4f, 0x2e, 0xe3, 0x91, 0x8e, 0x8c, 0xd7, 0x39, 0x05, 0x94, 0x94, 0xc8,
+	0x4f, 0x42, 0x95, 0x13, 0x6f, 0xda, 0xee, 0x0e, 0x5f, 0xb8, 0x55, 0x71, 0x86, 0xdd, 0x53, 0x85,
+	0x18, 0xc1, 0x19, 0x32, 0xed, 0x5a, 0xc1, 0xf2, 0x01, 0xf5, 0x84, 0x7b, 0x4b, 0x49, 0x20, 0x2f,
+	0xab, 0x42, 0x8c, 0xe0, 0x64, 0x15, 0x2e, 0x04, 0x6e, 0x77, 0xc7, 0x0f, 0x5c, 0x87, 0x6e, 0x52,
+	0xaf, 0x4d, 0x9d, 0xc0, 0xe8, 0x08, 0x1f, 0x96, 0x52, 0xf3, 0x69, 0x26, 0x46, 0x6e, 0x0d, 0x82,
+	0x31, 0xad, 0x0e, 0xf9, 0x39, 0x20, 0xae, 0xb3, 0xea, 0x1c, 0x18, 0xb6, 0x65, 0x2e, 0x1f, 0x50,
+	0x27, 0xd8, 0xb2, 0x42, 0x1f, 0x96, 0x9f, 0x3e, 0x3e, 0x9a, 0x23, 0x77, 0x07, 0xa0, 0x0f, 0x8f,
+	0xe6, 0x2e, 0x25, 0xcb, 0xa4, 0xe2, 0x94, 0x42, 0x8b, 0xbc, 0x0c, 0x53, 0xbc, 0x9b, 0xa1, 0x8c,
+	0x57, 0xe3, 0xc4, 0xb9, 0x48, 0x7e, 0x4f, 0x07, 0x60, 0x1c, 0x8f, 0xcd, 0x89, 0x67, 0x74, 0x7b,
+	0xdb, 0x3d, 0xee, 0xb1, 0x32, 0xe2, 0x9c, 0x20, 0xa7, 0x80, 0x92, 0x12, 0x59, 0x87, 0x8b, 0x4c,
+	0xd2, 0x11, 0x33, 0xa5, 0x0d, 0x9d, 0xb8, 0x45, 0xe3, 0x1b, 0x06, 0xa6, 0xc0, 0x31, 0xb5, 0x16,
+	0xf9, 0x02, 0x4c, 0x53, 0xd5, 0xcf, 0x15, 0x8b, 0xda, 0x66, 0x7d, 0x9a, 0xf7, 0x8d, 0x6f, 0x9f,
+	0xcb, 0x31, 0x08, 0x26, 0x30, 0xc9, 0x6d, 0x98, 0x0a, 0x4b, 0xb6, 0x1d, 0x2b, 0xe0, 0xd7, 0x6a,
+	0xc2, 0x88, 0x3e, 0xb5, 0xac, 0x03, 0x1e, 0x26, 0x0b, 0x30, 0x5e, 0x91, 0x74, 0x60, 0xca, 0x32,
+	0x6d, 0xba, 0xb5, 0xe7, 0x51, 0x7f, 0xcf, 0xb5, 0x4d, 0x79, 0xfb, 0x75, 0xd6, 0xe1, 0xe2, 0x13,
+	0xb2, 0xaa, 0x13, 0xc2, 0x38, 0x5d, 0xf2, 0xdd, 0x1c, 0x4c, 0xb2, 0x71, 0x68, 0xb5, 0xf7, 0xa8,
+	0xd9, 0xb7, 0x69, 0xfd, 0x3c, 0x97, 0x08, 0x46, 0x17, 0x6a, 0x07, 0x36, 0xdb, 0xc8, 0x7a, 0x85,
+	0x1a, 0x1f, 0x8c, 0x71, 0x65, 0xa3, 0xce, 0xd6, 0x87, 0x36, 0x7b, 0x84, 0xcf, 0x1e, 0x1f, 0xf5,
+	0xf5, 0x18, 0x04, 0x13, 0x98, 0x5c, 0x34, 0x64, 0x5a, 0xc1, 0x61, 0xfd, 0x42, 0x06, 0xd1, 0x90,
+	0x53, 0x40, 0x49, 0x89, 0x6c, 0xc2, 0xcc, 0x3e, 0x3d, 0x5c, 0xb2, 0xfc, 0xc0, 0xb3, 0x76, 0xfa,
+	0x7c, 0x3b, 0xbc, 0xc8, 0xe7, 0xf2, 0x79, 0xa6, 0xf7, 0xaf, 0xc5, 0x41, 0x0f, 0x07, 0x8b, 0x30,
+	0x59, 0x9d, 0x49, 0xdf, 0xef, 0x59, 0xbd, 0xdd, 0xe5, 0x07, 0x3d, 0xd7, 0xa1, 0x4e, 0x50, 0x7f,
+	0x2a, 0x92, 0xbe, 0xbf, 0xae, 0x95, 0x63, 0x0c, 0x8b, 0xbc, 0x06, 0xe7, 0xf6, 0x5c, 0x76, 0x00,
+	0x6a, 0x23, 0x73, 0x89, 0x8f, 0x0c, 0xb7, 0x49, 0xdf, 0x4e, 0xc0, 0x70, 0x00, 0x9b, 0xad, 0xc9,
+	0x9e, 0x71, 0x68, 0xbb, 0x86, 0xb9, 0xe2, 0x7a, 0x5d, 0x23, 0xa8, 0x3f, 0x1d, 0xad, 0xc9, 0x4d,
+	0x1d, 0xf0, 0x30, 0x59, 0x80, 0xf1, 0x8a, 0xec, 0xa3, 0x97, 0x05, 0x2d, 0xee, 0xc3, 0x5a, 0xaf,
+	0x47, 0x1f, 0xfd, 0xa6, 0x0e, 0xc0, 0x38, 0x1e, 0x9b, 0x5c, 0x59, 0x20, 0xaf, 0xa4, 0xea, 0x97,
+	0xa3, 0x4f, 0x6a, 0x33, 0x06, 0xc1, 0x04, 0x26, 0xdb, 0x16, 0xcd, 0x3e, 0x57, 0x7a, 0x63, 0xab,
+	0x63, 0x36, 0xda, 0x16, 0x97, 0x06, 0xc1, 0x98, 0x56, 0x87, 0x7c, 0x2b, 0x07, 0xe5, 0x3d, 0x6a,
+	0x98, 0xd4, 0xf3, 0xeb, 0xcf, 0xf0, 0x55, 0xbe, 0x9d, 0x7d, 0x95, 0x8b, 0x23, 0x75, 0xfe, 0xb6,
+	0xa0, 0x2b, 0xe4, 0xdf, 0xd0, 0x0c, 0x23, 0x4b, 0x51, 0xb1, 0x25, 0x26, 0x4c, 0xee, 0xf6, 0x83,
+	0xbe, 0x27, 0xb7, 0x9d, 0xfa, 0x95, 0x91, 0x16, 0x2c, 0x5f, 0x34, 0x2b, 0x1a, 0x1d, 0x8c, 0x51,
+	0x25, 0xdf, 0xcb, 0xc1, 0x94, 0xa7, 0x0b, 0x17, 0xf5, 0x67, 0x39, 0x9f, 0xbb, 0x63, 0xf8, 0xa8,
+	0x75, 0xb2, 0x62, 0xea, 0x63, 0x45, 0x18, 0x67, 0x3c, 0xfb, 0x05, 0x98, 0xd4, 0x87, 0xe6, 0x4c,
+	0x32, 0xff, 0xff, 0xc9, 0x33, 0x61, 0x29, 0x10, 0x16, 0x9c, 0x25, 0xda, 0xb3, 0xdd, 0xc3, 0x2e,
+	0xfb, 0x42, 0xe8, 0xbb, 0xe4, 0x35, 0x00, 0xcb, 0xdf, 0x69, 0x1d, 0xb4, 0xb9, 0x18, 0x2d, 0x54,
+	0x80, 0x6b, 0x72, 0xd4, 0x61, 0xb5, 0xd5, 0x94, 0x90, 0x87, 0xb1, 0x7f, 0xa8, 0xd5, 0x89, 0x2e,
+	0x1f, 0xf2, 0x27, 0x5c, 0x3e, 0xb4, 0x00, 0x7a, 0x91, 0x05, 0xb2, 0xc0, 0x31, 0x7f, 0x46, 0xb1,
+	0x39, 0x8b, 0xf1, 0x51, 0x23, 0x93, 0xc5, 0x26, 0xe8, 0xc0, 0x39, 0x93, 0xee, 0x1a, 0x7d, 0x3b,
+	0x08, 0xad, 0xa6, 0x52, 0x07, 0x38, 0xbd, 0xe1, 0x35, 0xf4, 0x08, 0x5e, 0x4a, 0x50, 0xc2, 0x01,
+	0xda, 0x8d, 0xbf, 0x57, 0x80, 0xd9, 0x5b, 0x34, 0x08, 0xaf, 0x3d, 0xa5, 0x72, 0xd5, 0xea, 0xd1,
+	0x36, 0x9b, 0x85, 0xf7, 0x73, 0x6c, 0xe7, 0xdd, 0xa1, 0x36, 0x53, 0x7e, 0x59, 0x6f, 0xde, 0xce,
+	0xb0, 0xc0, 0x86, 0x71, 0x99, 0x5f, 0xe7, 0x1c, 0x12, 0x9a, 0xa5, 0x28, 0x44, 0xc9, 0x9e, 0xe9,
+	0x84, 0x6d, 0xbb, 0xef, 0x07, 0xc2, 0x8a, 0x2d, 0x05, 0xe3, 0x50, 0x27, 0x5c, 0x8c, 0x40, 0xa8,
+	0xe3, 0x91, 0x9b, 0x00, 0x6d, 0xdb, 0xa2, 0x4e, 0xc0, 0x6b, 0x09, 0xb1, 0x94, 0xa8, 0xf9, 0x5d,
+	0x0c, 0x21, 0xa8, 0x61, 0x31, 0x56, 0x5d, 0xd7, 0xb1, 0x02, 0x57, 0xb0, 0x2a, 0xc6, 0x59, 0x6d,
+	0x44, 0x20, 0xd4, 0xf1, 0x78, 0x35, 0x1a, 0x78, 0x56, 0xdb, 0xe7, 0xd5, 0x4a, 0x89, 0x6a, 0x11,
+	0x08, 0x75, 0x3c, 0xa6, 0x32, 0x6b, 0xfd, 0x3f, 0xd3, 0xe7, 0xf3, 0x1b, 0x55, 0xb8, 0x1a, 0x1b,
+	0xd6, 0xc0, 0x08, 0xe8, 0x6e, 0xdf, 0x6e, 0xd1, 0x40, 0x4d, 0xe0, 0x88, 0xaa, 0xf4, 0x9f, 0x8f,
+	0xe6, 0x5d, 0xf8, 0xfa, 0xb7, 0xc7, 0x33, 0xef, 0x03, 0x0d, 0x3c, 0xd5, 0xdc, 0xdf, 0x80, 0xaa,
+	0x63, 0x04, 0x3e, 0xff, 0x70, 0xe5, 0x37, 0x1a, 0x5a, 0x71, 0xee, 0x28, 0x00, 0x46, 0x38, 0x64,
+	0x13, 0x2e, 0xca, 0x21, 0x66, 0xc7, 0xac, 0x17, 0x50, 0x4f, 0xd4, 0x95, 0xda, 0xb8, 0xac, 0x7b,
+	0x71, 0x23, 0x05, 0x07, 0x53, 0x6b, 0x92, 0x0d, 0xb8, 0xd0, 0x16, 0xb6, 0x33, 0xca, 0xce, 0x2e,
+	0x45, 0x50, 0x18, 0xd8, 0x42, 0x33, 0xf0, 0xe2, 0x20, 0x0a, 0xa6, 0xd5, 0x4b, 0xae, 0xe6, 0x89,
+	0x91, 0x56, 0x73, 0x79, 0x94, 0xd5, 0x5c, 0x19, 0x6d, 0x35, 0x57, 0x4f, 0xb7, 0x9a, 0xd9, 0xc8,
+	0xb3, 0x75, 0x44, 0xbd, 0x85, 0x7e, 0xb0, 0x27, 0x14, 0x74, 0xcd, 0xbd, 0x3e, 0x1c, 0xf9, 0x56,
+	0x0a, 0x0e, 0xa6, 0xd6, 0x24, 0x3b, 0x30, 0x2b, 0xca, 0x97, 0x9d, 0xb6, 0x77, 0xd8, 0x63, 0xc7,
+	0xa3, 0x46, 0xb7, 0x16, 0xbb, 0x7f, 0x9f, 0x6d, 0x0d, 0xc5, 0xc4, 0x13, 0xa8, 0x90, 0x2f, 0xc2,
+	0x94, 0x98, 0xa5, 0x0d, 0xa3, 0xc7, 0xc9, 0x0a, 0x67, 0xfb, 0xa7, 0x24, 0xd9, 0xa9, 0x45, 0x1d,
+	0x88, 0x71, 0x5c, 0xb2, 0x00, 0x33, 0xbd, 0x83, 0x36, 0xfb, 0xb9, 0xba, 0x7b, 0x87, 0x52, 0x93,
+	0x9a, 0x5c, 0x2f, 0xa9, 0x36, 0x9f, 0x56, 0x37, 0x59, 0x9b, 0x71, 0x30, 0x26, 0xf1, 0xc9, 0x2b,
+	0x30, 0xe9, 0x07, 0x86, 0x17, 0xc8, 0x4b, 0x6f, 0xa9, 0x8f, 0x84, 0x52, 0x75, 0x4b, 0x83, 0x61,
+	0x0c, 0x33, 0xf5, 0xbc, 0x98, 0x79, 0x74, 0xe7, 0x45, 0x96, 0xdd, 0xea, 0x9f, 0xe6, 0xe1, 0xda,
+	0x2d, 0x1a, 0x6c, 0xb8, 0x8e, 0x74, 0x19, 0x48, 0x3b, 0xf6, 0x4f, 0xe5, 0x31, 0x10, 0x3f, 0xb4,
+	0xf3, 0x63, 0x3d, 0xb4, 0x0b, 0x63, 0x3a, 0xb4, 0x8b, 0x8f, 0xf0, 0xd0, 0xfe, 0xfb, 0x79, 0x78,
+	0x3a, 0x36, 0x92, 0x9b, 0xae, 0xa9, 0x36, 0xfc, 0x8f, 0x07, 0xf0, 0x14, 0x03, 0xf8, 0x50, 0xc8,
+	0x9d, 0xdc, 0xb7, 0x2c, 0x21, 0xf1, 0x7c, 0x27, 0x29, 0xf1, 0xbc, 0x95, 0xe5, 0xe4, 0x4b, 0xe1,
+	0x70, 0xaa, 0x13, 0xef, 0x75, 0x20, 0x9e, 0xf4, 0x84, 0x8b, 0xae, 0xee, 0xa5, 0xd0, 0x13, 0x46,
+	0x3b, 0xe1, 0x00, 0x06, 0xa6, 0xd4, 0x22, 0x2d, 0x78, 0xca, 0xa7, 0x4e, 0x60, 0x39, 0xd4, 0x8e,
+	0x93, 0x13, 0xd2, 0xd0, 0xb3, 0x92, 0xdc, 0x53, 0xad, 0x34, 0x24, 0x4c, 0xaf, 0x9b, 0x65, 0x1f,
+	0xf8, 0x5d, 0xe0, 0x22, 0xa7, 0x18, 0x9a, 0xb1, 0x49, 0x2c, 0xef, 0x27, 0x25, 0x96, 0xb7, 0xb3,
+	0xcf, 0xdb, 0x68, 0xd2, 0xca, 0x4d, 0x00, 0x3e, 0x0b, 0xba, 0xb8, 0x12, 0x1e, 0xd2, 0x18, 0x42,
+	0x50, 0xc3, 0x62, 0x07, 0x90, 0x1a, 0x67, 0x5d, 0x52, 0x09, 0x0f, 0xa0, 0x96, 0x0e, 0xc4, 0x38,
+	0xee, 0x50, 0x69, 0xa7, 0x34, 0xb2, 0xb4, 0xf3, 0x3a, 0x90, 0xd8, 0x25, 0xab, 0xa0, 0x37, 0x11,
+	0x0f, 0xb6, 0x5b, 0x1d, 0xc0, 0xc0, 0x94, 0x5a, 0x43, 0x96, 0x72, 0x79, 0xbc, 0x4b, 0xb9, 0x32,
+	0xfa, 0x52, 0x26, 0x6f, 0xc3, 0x65, 0xce, 0x4a, 0x8e, 0x4f, 0x9c, 0xb0, 0x90, 0x7b, 0x3e, 0x29,
+	0x09, 0x5f, 0xc6, 0x61, 0x88, 0x38, 0x9c, 0x06, 0x9b, 0x9f, 0xb6, 0x47, 0x4d, 0xc6, 0xdc, 0xb0,
+	0x87, 0xcb, 0x44, 0x8b, 0x29, 0x38, 0x98, 0x5a, 0x93, 0x2d, 0xb1, 0x80, 0x2d, 0x43, 0x63, 0xc7,
+	0xa6, 0xa6, 0x0c, 0x36, 0x0c, 0x97, 0xd8, 0xd6, 0x7a, 0x4b, 0x42, 0x50, 0xc3, 0x4a, 0x13, 0x53,
+	0x26, 0xcf, 0x28, 0xa6, 0xdc, 0xe2, 0x1e, 0x09, 0xbb, 0x31, 0x69, 0x48, 0xca, 0x3a, 0x61, 0xf8,
+	0xe8, 0x62, 0x12, 0x01, 0x07, 0xeb, 0x70, 0x29, 0xb1, 0xed, 0x59, 0xbd, 0xc0, 0x8f, 0xd3, 0x9a,
+	0x4e, 0x48, 0x89, 0x29, 0x38, 0x98, 0x5a, 0x93, 0xc9, 0xe7, 0x7b, 0xd4, 0xb0, 0x83, 0xbd, 0x38,
+	0xc1, 0x99, 0xb8, 0x7c, 0x7e, 0x7b, 0x10, 0x05, 0xd3, 0xea, 0xa5, 0x1e, 0x48, 0xe7, 0x9e, 0x4c,
+	0xb1, 0xea, 0xdb, 0x05, 0xb8, 0x7c, 0x8b, 0x06, 0x61, 0x1c, 0xc6, 0xc7, 0x66, 0x94, 0x0f, 0xc1,
+	0x8c, 0xf2, 0xeb, 0x25, 0xb8, 0x70, 0x8b, 0x06, 0x03, 0xd2, 0xd8, 0xff, 0xa3, 0xc3, 0xbf, 0x01,
+	0x17, 0xa2, 0xd0, 0x9f, 0x56, 0xe0, 0x7a, 0xe2, 0x2c, 0x4f, 0x68, 0xcb, 0xad, 0x41, 0x14, 0x4c,
+	0xab, 0x47, 0xbe, 0x06, 0x4f, 0xf3, 0xa3, 0xde, 0xe9, 0x08, 0x5b, 0xac, 0x30, 0x26, 0x68, 0xc1,
+	0xeb, 0x73, 0x92, 0xe4, 0xd3, 0xad, 0x74, 0x34, 0x1c, 0x56, 0x9f, 0x7c, 0x13, 0x26, 0x7b, 0x56,
+	0x8f, 0xda, 0x96, 0xc3, 0xe5, 0xb3, 0xcc, 0x0e, 0xd3, 0x9b, 0x1a, 0xb1, 0x48, 0x81, 0xd3, 0x4b,
+	0x31, 0xc6, 0x30, 0x75, 0xa5, 0x56, 0x1e, 0xe1, 0x4a, 0xfd, 0x1f, 0x79, 0x28, 0xdf, 0xf2, 0xdc,
+	0x7e, 0xaf, 0x79, 0x48, 0x3a, 0x30, 0x71, 0x9f, 0xfb, 0xde, 0x48, 0xcf, 0x96, 0xd1, 0xc3, 0x67,
+	0x85, 0x0b, 0x4f, 0x24, 0x12, 0x89, 0xff, 0x28, 0xc9, 0xb3, 0x45, 0xbc, 0x4f, 0x0f, 0xa9, 0x29,
+	0x5d, 0x70, 0xc2, 0x45, 0xbc, 0xc6, 0x0a, 0x51, 0xc0, 0x48, 0x17, 0x66, 0x0c, 0xdb, 0x76, 0xef,
+	0x53, 0x73, 0xdd, 0x08, 0xb8, 0x8f, 0x9f, 0x74, 0xcd, 0x38, 0xab, 0xf1, 0x9c, 0x3b, 0x6e, 0x2e,
+	0xc4, 0x49, 0x61, 0x92, 0x36, 0x79, 0x07, 0xca, 0x7e, 0xe0, 0x7a, 0x4a, 0xd8, 0xaa, 0xdd, 0x5c,
+	0x1c, 0x7d, 0xd2, 0x9b, 0x5f, 0x6d, 0x09, 0x52, 0xe2, 0xca, 0x5b, 0xfe, 0x41, 0xc5, 0xa0, 0xf1,
+	0x6b, 0x39, 0x80, 0xdb, 0x5b, 0x5b, 0x9b, 0xf2, 0x76, 0xde, 0x84, 0xa2, 0xd1, 0x0f, 0xfd, 0x9c,
+	0x46, 0xf7, 0x27, 0x8a, 0x45, 0xad, 0x49, 0x17, 0xa0, 0x7e, 0xb0, 0x87, 0x9c, 0x3a, 0xf9, 0x0c,
+	0x94, 0xa5, 0x80, 0x2c, 0x87, 0x3d, 0xbc, 0xb4, 0x90, 0x42, 0x34, 0x2a, 0x78, 0xe3, 0x3f, 0xe6,
+	0x60, 0x6a, 0xb5, 0xd5, 0x8c, 0x6c, 0x23, 0x4c, 0xc2, 0xf0, 0x23, 0x49, 0x25, 0x17, 0x17, 0x62,
+	0x35, 0xf9, 0x44, 0xc3, 0x22, 0xaf, 0xc0, 0x64, 0xcf, 0xb3, 0xba, 0x86, 0x77, 0xb8, 0x46, 0x0f,
+	0x57, 0x97, 0xe4, 0x8e, 0x15, 0x7d, 0x04, 0x1a, 0x0c, 0x63, 0x98, 0x64, 0x97, 0x9d, 0x6e, 0x7d,
+	0x5b, 0xf9, 0xe2, 0x64, 0x88, 0x57, 0x60, 0x54, 0xb6, 0x3c, 0xc3, 0xf1, 0xad, 0x40, 0xf9, 0x0b,
+	0xb0, 0xe5, 0x2f, 0xc8, 0x37, 0xfe, 0x4a, 0x0e, 0xce, 0xad, 0xb6, 0x9a, 0xea, 0x73, 0xfc, 0x6a,
+	0xdf, 0x0d, 0x0c, 0xf2, 0xa6, 0x16, 0x3b, 0x75, 0x0a, 0xcf, 0xb3, 0x79, 0xe5, 0xc3, 0x3c, 0xff,
+	0xd5, 0xbe, 0xe1, 0x04, 0x61, 0xce, 0x8a, 0x94, 0x58, 0xab, 0x79, 0x80, 0xae, 0xf1, 0x40, 0xec,
+	0x36, 0xca, 0xdd, 0x93, 0xfb, 0xff, 0x6d, 0x84, 0xa5, 0xa8, 0x61, 0x34, 0x7e, 0x3b, 0x0f, 0xb0,
+	0x6a, 0xda, 0xb4, 0xa5, 0x02, 0xcf, 0xab, 0x41, 0x78, 0x3b, 0x3c, 0x9a, 0x4f, 0x1c, 0x77, 0x46,
+	0x88, 0x6e, 0x86, 0x23, 0x7a, 0xc4, 0x84, 0x49, 0x3f, 0xa0, 0x3d, 0x15, 0x4f, 0x38, 0xa2, 0x2b,
+	0xc8, 0x39, 0x61, 0x9e, 0x8a, 0xe8, 0x60, 0x8c, 0x2a, 0x31, 0xa0, 0x66, 0x39, 0x6d, 0xb1, 0x4f,
+	0x35, 0x0f, 0x47, 0xfc, 0x9e, 0xb9, 0xfb, 0xcb, 0x6a, 0x44, 0x06, 0x75, 0x9a, 0x8d, 0x3f, 0xca,
+	0xc3, 0x25, 0xce, 0x8f, 0xdf, 0x44, 0xeb, 0xb1, 0x69, 0xe4, 0xe7, 0x06, 0x92, 0xe4, 0xfc, 0xf4,
+	0xe9, 0x58, 0x8b, 0x1c, 0x2b, 0x1b, 0x34, 0x30, 0xa2, 0x45, 0x1f, 0x95, 0x69, 0x99, 0x71, 0xfa,
+	0x50, 0xf4, 0xd9, 0xb1, 0x21, 0x46, 0xaf, 0x35, 0xf2, 0xba, 0x4d, 0xef, 0x00, 0x3f, 0x44, 0x42,
+	0xdf, 0x3f, 0x7e, 0x78, 0x70, 0x76, 0xe4, 0x17, 0x60, 0xc2, 0x0f, 0x8c, 0xa0, 0xaf, 0x76, 0xc8,
+	0xed, 0x71, 0x33, 0xe6, 0xc4, 0xa3, 0xed, 0x5c, 0xfc, 0x47, 0xc9, 0xb4, 0xf1, 0x47, 0x39, 0x98,
+	0x4d, 0xaf, 0xb8, 0x6e, 0xf9, 0x01, 0xf9, 0xff, 0x07, 0x86, 0xfd, 0x94, 0x33, 0xce, 0x6a, 0xf3,
+	0x41, 0x0f, 0xe3, 0xa8, 0x55, 0x89, 0x36, 0xe4, 0x01, 0x94, 0xac, 0x80, 0x76, 0x95, 0x9a, 0x7f,
+	0x77, 0xcc, 0x5d, 0xd7, 0x24, 0x2c, 0xc6, 0x05, 0x05, 0xb3, 0xc6, 0x1f, 0xe7, 0x87, 0x75, 0x99,
+	0x9f, 0xe2, 0x76, 0x3c, 0xfe, 0x71, 0x2d, 0x5b, 0xfc, 0x63, 0xbc, 0x41, 0x83, 0x61, 0x90, 0x7f,
+	0x66, 0x30, 0x0c, 0xf2, 0x6e, 0xf6, 0x30, 0xc8, 0xc4, 0x30, 0x0c, 0x8d, 0x86, 0xb4, 0xe3, 0xd1,
+	0x90, 0x6b, 0xd9, 0xa2, 0x21, 0x53, 0xfa, 0x1a, 0x0b, 0x8a, 0xfc, 0x51, 0x01, 0xae, 0x9c, 0xb4,
+	0x48, 0x99, 0x10, 0x23, 0xbf, 0x85, 0xac, 0x42, 0xcc, 0xc9, 0xab, 0x9e, 0xdc, 0x84, 0x52, 0x6f,
+	0xcf, 0xf0, 0x95, 0x24, 0x7e, 0x25, 0x8c, 0xa3, 0x61, 0x85, 0x0f, 0xd9, 0x16, 0xc5, 0x25, 0x78,
+	0xfe, 0x17, 0x05, 0x2a, 0x3b, 0x83, 0xbb, 0xd2, 0x21, 0x42, 0x48, 0xe5, 0xe1, 0x19, 0xac, 0xbc,
+	0x21, 0x14, 0x9c, 0x04, 0x30, 0x21, 0xee, 0x15, 0xa4, 0x38, 0xb2, 0x9e, 0xd1, 0x05, 0x3b, 0x16,
+	0xa0, 0x1b, 0x75, 0x4a, 0x5e, 0x51, 0x49, 0x5e, 0x64, 0x1e, 0x8a, 0x41, 0x14, 0xc7, 0xa8, 0xec,
+	0x31, 0xc5, 0x14, 0xa5, 0x84, 0xe3, 0x91, 0xd7, 0x81, 0xb8, 0x3b, 0xfc, 0x26, 0xc5, 0x94, 0x1e,
+	0x03, 0xca, 0x69, 0xbc, 0x10, 0x59, 0x73, 0xee, 0x0e, 0x60, 0x60, 0x4a, 0xad, 0xc6, 0xef, 0x57,
+	0xe1, 0x52, 0xfa, 0xea, 0x63, 0xe3, 0x76, 0x40, 0x3d, 0x5f, 0x05, 0x7d, 0x6b, 0xe3, 0x76, 0x4f,
+	0x14, 0xa3, 0x82, 0x7f, 0xa4, 0x23, 0x2a, 0x7e, 0x3d, 0x07, 0x97, 0x3d, 0x79, 0x31, 0xf8, 0x38,
+	0xa2, 0x2a, 0x9e, 0x15, 0x36, 0xac, 0x21, 0x0c, 0x71, 0x78, 0x5b, 0xc8, 0xdf, 0xcc, 0x41, 0xbd,
+	0x9b, 0x30, 0x6e, 0x3d, 0xc2, 0xac, 0x32, 0x3c, 0x50, 0x78, 0x63, 0x08, 0x3f, 0x1c, 0xda, 0x12,
+	0xf2, 0x4d, 0xa8, 0xf5, 0xd8, 0xba, 0xf0, 0x03, 0xea, 0xb4, 0x55, 0x04, 0xd4, 0xe8, 0x5f, 0xd2,
+	0x66, 0x44, 0x2b, 0xcc, 0x2a, 0xc1, 0xa5, 0x11, 0x0d, 0x80, 0x3a, 0xc7, 0x27, 0x3c, 0x8d, 0xcc,
+	0x75, 0xa8, 0xf8, 0x34, 0x08, 0x2c, 0xa7, 0x23, 0x94, 0xcc, 0xaa, 0xf8, 0x56, 0x5a, 0xb2, 0x0c,
+	0x43, 0x28, 0xf9, 0x49, 0xa8, 0xf2, 0x7b, 0xc6, 0x05, 0xaf, 0xe3, 0xd7, 0xab, 0x3c, 0xc4, 0x60,
+	0x4a, 0x04, 0x4d, 0xc8, 0x42, 0x8c, 0xe0, 0x03, 0x61, 0x27, 0x70, 0xaa, 0xb0, 0x93, 0x9b, 0x00,
+	0x34, 0x54, 0x38, 0x92, 0x46, 0xcc, 0x48, 0x15, 0x41, 0x0d, 0x8b, 0x3c, 0x0b, 0x85, 0xc0, 0xf6,
+	0xb9, 0xe1, 0xb2, 0x12, 0xd9, 0x1d, 0xb6, 0xd6, 0x5b, 0xc8, 0xca, 0xc9, 0xb7, 0x73, 0x30, 0xd5,
+	0xd3, 0x85, 0x7b, 0x99, 0xe4, 0x6c, 0x75, 0x74, 0x21, 0x21, 0xa1, 0x2d, 0x48, 0x5f, 0x38, 0xbd,
+	0x08, 0xe3, 0x2c, 0x1b, 0x7f, 0x50, 0x80, 0x99, 0x44, 0x6e, 0x01, 0xd6, 0xee, 0xbe, 0x67, 0xcb,
+	0xbd, 0x2c, 0x6c, 0xf7, 0x36, 0xae, 0x23, 0x2b, 0x27, 0x6f, 0x4b, 0x85, 0x30, 0x9f, 0x31, 0x93,
+	0xe3, 0x1d, 0x23, 0xf0, 0x99, 0x06, 0x38, 0xa0, 0x0b, 0xf2, 0x0b, 0xe6, 0xa8, 0x3d, 0xf2, 0x30,
+	0xd2, 0x2e, 0x98, 0x23, 0x18, 0xc6, 0x30, 0x13, 0xa6, 0xe6, 0xe2, 0xa9, 0x4c, 0xcd, 0x6f, 0x88,
+	0x59, 0x2a, 0x65, 0x4c, 0x6a, 0xb5, 0xb5, 0xde, 0x12, 0xbe, 0xeb, 0x27, 0xcc, 0xef, 0xc4, 0xe3,
+	0x9f, 0xdf, 0x5f, 0xce, 0x6b, 0xf3, 0x2b, 0x55, 0xb5, 0x0f, 0x98, 0xdf, 0xe7, 0x99, 0x8c, 0x12,
+	0x4a, 0x6b, 0x55, 0x5d, 0xc4, 0xe0, 0xd2, 0x95, 0x84, 0xaa, 0x81, 0x2b, 0x8c, 0x7d, 0xe0, 0xd4,
+	0x02, 0x2b, 0x3e, 0xa2, 0x05, 0xd6, 0xf8, 0x67, 0x05, 0xa8, 0xbd, 0xee, 0xee, 0x7c, 0x44, 0xa2,
+	0x30, 0xd3, 0x25, 0x81, 0xfc, 0x87, 0x28, 0x09, 0x6c, 0xc3, 0xd3, 0x41, 0x60, 0xb7, 0x68, 0xdb,
+	0x75, 0x4c, 0x7f, 0x61, 0x37, 0xa0, 0xde, 0x8a, 0xe5, 0x58, 0xfe, 0x1e, 0x35, 0xe5, 0x35, 0xed,
+	0x33, 0xc7, 0x47, 0x73, 0x4f, 0x6f, 0x6d, 0xad, 0xa7, 0xa1, 0xe0, 0xb0, 0xba, 0x7c, 0x67, 0x16,
+	0x09, 0x88, 0x78, 0x7e, 0x06, 0xe9, 0xcb, 0x26, 0x76, 0x66, 0xad, 0x1c, 0x63, 0x58, 0x8d, 0xef,
+	0xe6, 0x80, 0x0c, 0x0a, 0xe7, 0xc4, 0x81, 0x0a, 0x7d, 0x10, 0x50, 0xcf, 0x09, 0x53, 0x18, 0x8d,
+	0x27, 0x13, 0x0a, 0x3f, 0x83, 0x96, 0x25, 0x65, 0x0c, 0x79, 0x34, 0xfe, 0x55, 0x1e, 0x6a, 0x1a,
+	0x1e, 0xf9, 0x14, 0x94, 0x77, 0x3c, 0x77, 0x9f, 0x7a, 0xe2, 0x6a, 0x5e, 0x26, 0x8a, 0x68, 0x8a,
+	0x22, 0x54, 0xb0, 0xc4, 0x8e, 0x95, 0x3f, 0xd5, 0x8e, 0x65, 0x42, 0xd1, 0x37, 0x7c, 0x5b, 0x7e,
+	0x79, 0x2b, 0x19, 0xf3, 0x36, 0x2e, 0xb4, 0xd6, 0xa3, 0x8f, 0x84, 0xfd, 0x43, 0x4e, 0x9d, 0x6d,
+	0x03, 0x9a, 0x88, 0x5f, 0x1d, 0x2a, 0x94, 0x3f, 0xaa, 0xfd, 0xb3, 0x71, 0x94, 0x83, 0xa9, 0x58,
+	0x13, 0xc9, 0xcb, 0x50, 0xed, 0xd2, 0xf6, 0x9e, 0xe1, 0x58, 0xbe, 0x8a, 0x98, 0xbd, 0xcc, 0xce,
+	0xf9, 0x0d, 0x55, 0xf8, 0x90, 0xc9, 0x07, 0x0b, 0xad, 0x75, 0xae, 0x03, 0x44, 0xb8, 0x61, 0x1a,
+	0xa9, 0xfc, 0xb8, 0xd2, 0x48, 0x15, 0xc6, 0x91, 0x46, 0xea, 0x0f, 0xf2, 0x50, 0x0d, 0x73, 0x67,
+	0x9e, 0x76, 0xc1, 0x3c, 0x07, 0xa5, 0xc0, 0xed, 0x59, 0xed, 0xe4, 0x15, 0xcb, 0x16, 0x2b, 0x44,
+	0x01, 0xe3, 0x5b, 0x38, 0x6f, 0x03, 0x6f, 0x68, 0x45, 0xdb, 0xc2, 0x79, 0x29, 0x4a, 0xa8, 0x9a,
+	0xbb, 0xe2, 0xd8, 0xb7, 0xf0, 0x68, 0xf1, 0x94, 0x4e, 0x5c, 0x3c, 0x6f, 0xc9, 0xa5, 0x3c, 0x91,
+	0x35, 0x5d, 0xe5, 0x42, 0x6b, 0x3d, 0xb9, 0x82, 0x1b, 0xbf, 0x5d, 0x90, 0x9f, 0xa4, 0x3c, 0xf7,
+	0xc6, 0x39, 0xc2, 0xaf, 0x72, 0x27, 0x3c, 0xbf, 0xdf, 0xa5, 0x1e, 0xbf, 0xa0, 0x90, 0x42, 0x8a,
+	0x7e, 0xb3, 0x1c, 0x01, 0x43, 0x47, 0xbc, 0xa8, 0xe8, 0x4f, 0xf7, 0xd0, 0x33, 0x11, 0x8e, 0x5b,
+	0x44, 0xa4, 0x02, 0x2c, 0x43, 0xd3, 0x42, 0x11, 0x6e, 0x4d, 0x83, 0x61, 0x0c, 0xb3, 0xf1, 0xdf,
+	0xf3, 0x50, 0x5d, 0xb7, 0x76, 0x69, 0xfb, 0xb0, 0x6d, 0x53, 0xf2, 0x0d, 0x98, 0x35, 0xa9, 0x4d,
+	0x99, 0x38, 0x7d, 0xcb, 0x33, 0xda, 0x74, 0x93, 0x7a, 0x16, 0xcf, 0x5f, 0xcd, 0x4e, 0x0f, 0x19,
+	0x31, 0x78, 0xf5, 0xf8, 0x68, 0x6e, 0x76, 0x69, 0x28, 0x16, 0x9e, 0x40, 0x81, 0xac, 0xc2, 0xa4,
+	0x49, 0x7d, 0xcb, 0xa3, 0xe6, 0xa6, 0x66, 0x2d, 0xf9, 0x94, 0x6a, 0xe7, 0x92, 0x06, 0x7b, 0xa8,
+	0x89, 0x5a, 0xc2, 0x6c, 0x12, 0xab, 0xca, 0x0e, 0xc5, 0x9e, 0xd1, 0xf7, 0x69, 0x4a, 0x3b, 0x45,
+	0x92, 0x33, 0x7e, 0x28, 0x6e, 0xa6, 0xa3, 0xe0, 0xb0, 0xba, 0x64, 0x07, 0xea, 0xbc, 0xfd, 0x69,
+	0x74, 0x8b, 0x9c, 0xee, 0xf3, 0xc7, 0x47, 0x73, 0x8d, 0x25, 0xda, 0xf3, 0x68, 0xdb, 0x08, 0xa8,
+	0xb9, 0x34, 0x04, 0x1b, 0x87, 0xd2, 0x69, 0xfc, 0x6a, 0x0e, 0x0a, 0xeb, 0x6e, 0xe7, 0x09, 0xcd,
+	0x64, 0xf7, 0xbd, 0x02, 0x84, 0x79, 0xde, 0xc9, 0x9f, 0xcb, 0x41, 0xcd, 0x70, 0x1c, 0x37, 0x90,
+	0x39, 0xd4, 0x85, 0xdb, 0x1b, 0x66, 0x4e, 0x27, 0x3f, 0xbf, 0x10, 0x11, 0x15, 0x1e, 0x53, 0xa1,
+	0x17, 0x97, 0x06, 0x41, 0x9d, 0x37, 0xe9, 0x27, 0x9c, 0xb8, 0x36, 0xb2, 0xb7, 0xe2, 0x14, 0x2e,
+	0x5b, 0xb3, 0x5f, 0x81, 0x73, 0xc9, 0xc6, 0x9e, 0xc5, 0x07, 0x23, 0x93, 0x37, 0x5c, 0x1e, 0x20,
+	0x72, 0xe4, 0x7c, 0x0c, 0x57, 0x16, 0x56, 0xec, 0xca, 0x62, 0xf4, 0x64, 0x9b, 0x51, 0xa3, 0x87,
+	0x5e, 0x53, 0xbc, 0x9b, 0xb8, 0xa6, 0x58, 0x1d, 0x07, 0xb3, 0x93, 0xaf, 0x26, 0x76, 0xe0, 0x42,
+	0x84, 0x1b, 0x6d, 0x7a, 0x6b, 0x89, 0x4d, 0x49, 0x48, 0x3a, 0x9f, 0x1e, 0xb2, 0x29, 0xcd, 0x68,
+	0x9e, 0xb5, 0x83, 0xdb, 0x52, 0xe3, 0xb7, 0x72, 0x70, 0x4e, 0x67, 0xc2, 0x13, 0xc3, 0xbd, 0x0c,
+	0x53, 0x1e, 0x35, 0xcc, 0xa6, 0x11, 0xb4, 0xf7, 0x78, 0x08, 0x74, 0x8e, 0xc7, 0x2c, 0x8b, 0xf8,
+	0x29, 0x1d, 0x80, 0x71, 0x3c, 0x62, 0x40, 0x8d, 0x15, 0x6c, 0x59, 0x5d, 0xea, 0xf6, 0x83, 0x11,
+	0xef, 0xe1, 0xb8, 0x51, 0x0a, 0x23, 0x32, 0xa8, 0xd3, 0x6c, 0xfc, 0x28, 0x07, 0xd3, 0x7a, 0x83,
+	0x1f, 0xf9, 0x1d, 0xcd, 0x5e, 0xfc, 0x8e, 0x66, 0x71, 0x0c, 0xf3, 0x3e, 0xe4, 0x5e, 0xe6, 0xdb,
+	0x35, 0xbd, 0x6b, 0xfc, 0x2e, 0x46, 0x37, 0x08, 0xe7, 0x4e, 0x34, 0x08, 0x7f, 0xf4, 0xd3, 0x87,
+	0x0f, 0x53, 0xb3, 0x8b, 0x4f, 0xb0, 0x9a, 0xfd, 0x61, 0xe6, 0x20, 0xd7, 0xf2, 0x68, 0x4f, 0x64,
+	0xc8, 0xa3, 0xdd, 0x0d, 0xf3, 0x68, 0x97, 0xc7, 0xb6, 0xb1, 0x9d, 0x26, 0x97, 0x76, 0xe5, 0xb1,
+	0xe6, 0xd2, 0xae, 0x3e, 0xaa, 0x5c, 0xda, 0x90, 0x35, 0x97, 0xf6, 0x77, 0x72, 0x30, 0x6d, 0xc6,
+	0xd2, 0x7e, 0xc9, 0x84, 0x7b, 0xa3, 0x1f, 0x67, 0xf1, 0x2c, 0x62, 0x22, 0x70, 0x39, 0x5e, 0x86,
+	0x09, 0x96, 0x69, 0x19, 0xac, 0x27, 0x3f, 0x94, 0x0c, 0xd6, 0xe4, 0x17, 0xa0, 0x6a, 0xab, 0xb3,
+	0x4e, 0x9a, 0xbc, 0xd7, 0xc7, 0xb2, 0x24, 0x25, 0xcd, 0x28, 0xdc, 0x2e, 0x2c, 0xc2, 0x88, 0x63,
+	0xe3, 0x7f, 0x95, 0xf5, 0x03, 0xf1, 0x71, 0xdf, 0xcb, 0xbe, 0x14, 0xbf, 0x97, 0xbd, 0x96, 0xbc,
+	0x97, 0x1d, 0x38, 0xcd, 0xe5, 0xdd, 0xec, 0x67, 0xb5, 0x73, 0xa2, 0xc0, 0x53, 0x67, 0x87, 0x4b,
+	0x2e, 0xe5, 0xac, 0x58, 0x80, 0x19, 0x29, 0x04, 0x28, 0x20, 0xdf, 0x64, 0xa7, 0x22, 0xf7, 0xe9,
+	0xa5, 0x38, 0x18, 0x93, 0xf8, 0x8c, 0xa1, 0xaf, 0x5e, 0x50, 0x92, 0x99, 0xb9, 0xc2, 0x35, 0xae,
+	0x5e, 0x37, 0x0a, 0x31, 0x98, 0xd2, 0xe9, 0x51, 0xc3, 0x97, 0xb7, 0xab, 0x9a, 0xd2, 0x89, 0xbc,
+	0x14, 0x25, 0x54, 0xbf, 0x62, 0x2e, 0x7f, 0xc0, 0x15, 0xb3, 0x01, 0x35, 0xdb, 0xf0, 0x03, 0xb1,
+	0x98, 0x4c, 0xb9, 0x9b, 0xfc, 0x7f, 0xa7, 0x3b, 0xf7, 0x99, 0x2c, 0x11, 0x09, 0xf0, 0xeb, 0x11,
+	0x19, 0xd4, 0x69, 0x12, 0x13, 0x26, 0xd9, 0x5f, 0xbe, 0xb3, 0x98, 0x0b, 0x81, 0x7c, 0x67, 0xe0,
+	0x2c, 0x3c, 0x42, 0x8d, 0x76, 0x5d, 0xa3, 0x83, 0x31, 0xaa, 0x43, 0x6e, 0xa1, 0x61, 0x94, 0x5b,
+	0x68, 0xf2, 0x45, 0x21, 0xb8, 0x1d, 0x86, 0xd3, 0x5a, 0xe3, 0xd3, 0x1a, 0x86, 0x5e, 0xa0, 0x0e,
+	0xc4, 0x38, 0x2e, 0x5b, 0x15, 0x7d, 0x39, 0x0c, 0xaa, 0xfa, 0x64, 0x7c, 0x55, 0x6c, 0xc7, 0xc1,
+	0x98, 0xc4, 0x27, 0x9b, 0x70, 0x31, 0x2c, 0xd2, 0x9b, 0x31, 0xc5, 0xe9, 0x84, 0xbe, 0xf0, 0xdb,
+	0x29, 0x38, 0x98, 0x5a, 0x93, 0x07, 0x97, 0xf6, 0x3d, 0x8f, 0x3a, 0xc1, 0x6d, 0xc3, 0xdf, 0x93,
+	0x4e, 0xf5, 0x51, 0x70, 0x69, 0x04, 0x42, 0x1d, 0x8f, 0xdc, 0x04, 0x10, 0xe4, 0x78, 0xad, 0x99,
+	0xb8, 0xcb, 0xdf, 0x76, 0x08, 0x41, 0x0d, 0xab, 0xf1, 0x9d, 0x2a, 0xd4, 0xee, 0x18, 0x81, 0x75,
+	0x40, 0xb9, 0x83, 0xca, 0xa3, 0xb9, 0xb7, 0xff, 0xab, 0x39, 0xb8, 0x14, 0x0f, 0x06, 0x79, 0x84,
+	0x97, 0xf7, 0x3c, 0xf1, 0x32, 0xa6, 0x72, 0xc3, 0x21, 0xad, 0xe0, 0xd7, 0xf8, 0x03, 0xb1, 0x25,
+	0x8f, 0xfa, 0x1a, 0xbf, 0x35, 0x8c, 0x21, 0x0e, 0x6f, 0xcb, 0x47, 0xe5, 0x1a, 0xff, 0xc9, 0x7e,
+	0x2a, 0x26, 0xe1, 0x64, 0x50, 0x7e, 0x62, 0x9c, 0x0c, 0x2a, 0x4f, 0x84, 0xd4, 0xdf, 0xd3, 0x9c,
+	0x0c, 0xaa, 0x19, 0xef, 0x53, 0x64, 0xfc, 0xa4, 0xa0, 0x36, 0xcc, 0x59, 0x81, 0x67, 0x4e, 0x54,
+	0x37, 0x93, 0x4c, 0x58, 0xde, 0x31, 0x7c, 0xab, 0x2d, 0xc5, 0x8e, 0x0c, 0x4f, 0x63, 0xa9, 0x27,
+	0x33, 0x84, 0x57, 0x1a, 0xff, 0x8b, 0x82, 0x76, 0xf4, 0x42, 0x48, 0x3e, 0xd3, 0x0b, 0x21, 0x64,
+	0x11, 0x8a, 0xce, 0x3e, 0x3d, 0x3c, 0xdb, 0xe5, 0x07, 0x57, 0x02, 0xef, 0xac, 0xd1, 0x43, 0xe4,
+	0x95, 0x1b, 0xdf, 0xcf, 0x03, 0xb0, 0xee, 0x9f, 0xee, 0x2e, 0xfa, 0x33, 0x50, 0xf6, 0xfb, 0xdc,
+	0x30, 0x24, 0x05, 0xa6, 0xc8, 0x2d, 0x5c, 0x14, 0xa3, 0x82, 0x93, 0xe7, 0xa0, 0xf4, 0x6e, 0x9f,
+	0xf6, 0x95, 0xef, 0x5a, 0xa8, 0x37, 0x7c, 0x95, 0x15, 0xa2, 0x80, 0x3d, 0x3a, 0xab, 0xbb, 0xba,
+	0xb3, 0x2e, 0x3d, 0xaa, 0x3b, 0xeb, 0x2a, 0x94, 0xef, 0xb8, 0x3c, 0xca, 0xa4, 0xf1, 0x9f, 0xf3,
+	0x00, 0x91, 0x17, 0x3f, 0xf9, 0xb5, 0x1c, 0x3c, 0x15, 0x7e, 0x70, 0x81, 0x50, 0xff, 0xf8, 0x6b,
+	0x74, 0x99, 0xef, 0xaf, 0xd3, 0x3e, 0x76, 0xbe, 0x03, 0x6d, 0xa6, 0xb1, 0xc3, 0xf4, 0x56, 0x10,
+	0x84, 0x0a, 0xed, 0xf6, 0x82, 0xc3, 0x25, 0x4b, 0x5d, 0xc0, 0xa5, 0x06, 0x8b, 0x2c, 0x4b, 0x1c,
+	0x51, 0x55, 0xda, 0x28, 0xc4, 0x6d, 0xab, 0x84, 0x60, 0x48, 0x87, 0xec, 0x41, 0xc5, 0x71, 0xdf,
+	0xf6, 0xd9, 0x70, 0xc8, 0xe5, 0xf8, 0xda, 0xe8, 0x43, 0x2e, 0x86, 0x55, 0xdc, 0x06, 0xc9, 0x3f,
+	0x58, 0x76, 0xe4, 0x60, 0x2f, 0x40, 0x6d, 0xd3, 0xf0, 0xfd, 0xad, 0x3d, 0xcf, 0xed, 0x77, 0xb8,
+	0xdc, 0x11, 0x18, 0x1d, 0x5f, 0x24, 0x11, 0x4a, 0x86, 0x1a, 0x6c, 0x85, 0x10, 0xd4, 0xb0, 0x1a,
+	0xbf, 0x92, 0x87, 0x0b, 0x29, 0x43, 0x49, 0x5e, 0x83, 0x73, 0x32, 0xe6, 0x22, 0x7a, 0xd9, 0x31,
+	0x17, 0xbd, 0xec, 0xd8, 0x4a, 0xc0, 0x70, 0x00, 0x9b, 0xbc, 0x0d, 0x60, 0xb4, 0xdb, 0xd4, 0xf7,
+	0x37, 0x5c, 0x53, 0xa9, 0x14, 0xaf, 0xb2, 0x96, 0x2c, 0x84, 0xa5, 0x0f, 0x8f, 0xe6, 0x7e, 0x2a,
+	0x2d, 0x8c, 0x2a, 0x31, 0x55, 0x51, 0x05, 0xd4, 0x48, 0x92, 0x6f, 0x00, 0x08, 0x33, 0x42, 0x98,
+	0x28, 0xf1, 0xec, 0x01, 0x07, 0x3c, 0x88, 0xe0, 0x5e, 0x48, 0x05, 0x35, 0x8a, 0x8d, 0x7f, 0x92,
+	0x87, 0x8a, 0xba, 0x54, 0x79, 0x0c, 0xe6, 0xe4, 0x4e, 0xcc, 0x9c, 0x3c, 0xa6, 0xc0, 0xa9, 0x34,
+	0x63, 0xb2, 0x9b, 0x30, 0x26, 0xdf, 0xca, 0xce, 0xea, 0x64, 0x53, 0xf2, 0x6f, 0xe6, 0x61, 0x5a,
+	0xa1, 0x66, 0x35, 0xf2, 0x7e, 0x19, 0x66, 0x84, 0xef, 0xdb, 0x86, 0xf1, 0x40, 0xa4, 0x28, 0xe6,
+	0x03, 0x56, 0x14, 0xb1, 0x4a, 0xcd, 0x38, 0x08, 0x93, 0xb8, 0x6c, 0x59, 0x8b, 0xa2, 0x6d, 0xa6,
+	0xc7, 0x09, 0x57, 0x0e, 0xa1, 0xb2, 0xf2, 0x65, 0xdd, 0x4c, 0xc0, 0x70, 0x00, 0x3b, 0x69, 0x65,
+	0x2e, 0x3e, 0x02, 0x2b, 0xf3, 0xef, 0xe7, 0x60, 0x32, 0x1a, 0xaf, 0x47, 0x6e, 0x63, 0xde, 0x8d,
+	0xdb, 0x98, 0x17, 0x32, 0x2f, 0x87, 0x21, 0x16, 0xe6, 0x5f, 0xac, 0x40, 0x2c, 0x7e, 0x8f, 0xec,
+	0xc0, 0xac, 0x95, 0xea, 0x90, 0xae, 0xed, 0x36, 0x61, 0x42, 0x9a, 0xd5, 0xa1, 0x98, 0x78, 0x02,
+	0x15, 0xd2, 0x87, 0xca, 0x01, 0xf5, 0x02, 0xab, 0x4d, 0x55, 0xff, 0x6e, 0x65, 0x96, 0xea, 0xa4,
+	0x1d, 0x3d, 0x1c, 0xd3, 0x7b, 0x92, 0x01, 0x86, 0xac, 0xc8, 0x0e, 0x94, 0xa8, 0xd9, 0xa1, 0x2a,
+	0x69, 0x74, 0xc6, 0x17, 0x87, 0xc2, 0xf1, 0x64, 0xff, 0x7c, 0x14, 0xa4, 0x89, 0xaf, 0xdb, 0xaa,
+	0x8a, 0x19, 0x65, 0xb4, 0x53, 0x5a, 0xa8, 0xc8, 0x7e, 0x68, 0xb0, 0x2d, 0x8d, 0x69, 0xf3, 0x38,
+	0xc1, 0x5c, 0xeb, 0x43, 0xf5, 0xbe, 0x11, 0x50, 0xaf, 0x6b, 0x78, 0xfb, 0x52, 0x61, 0x19, 0xbd,
+	0x87, 0x6f, 0x28, 0x4a, 0x51, 0x0f, 0xc3, 0x22, 0x8c, 0xf8, 0x10, 0x17, 0xaa, 0x81, 0x94, 0xc0,
+	0x95, 0x55, 0x7a, 0x74, 0xa6, 0x4a, 0x96, 0xf7, 0x65, 0x00, 0x99, 0xfa, 0x8b, 0x11, 0x0f, 0x72,
+	0x10, 0x7b, 0x9f, 0x50, 0xbc, 0x4a, 0xd9, 0xcc, 0x70, 0xbb, 0x21, 0x49, 0x69, 0x51, 0x86, 0xe9,
+	0xef, 0x1c, 0x1e, 0xc4, 0xdc, 0x86, 0xb3, 0x2a, 0x18, 0xb1, 0xa8, 0x47, 0x71, 0xae, 0xa6, 0xbb,
+	0x1e, 0x37, 0xfe, 0x67, 0x29, 0x3a, 0x0e, 0x1e, 0xb7, 0x89, 0xf3, 0x73, 0x71, 0x13, 0xe7, 0xd5,
+	0xa4, 0x89, 0x33, 0xe1, 0x45, 0x71, 0xf6, 0xe0, 0x93, 0x84, 0x65, 0xb0, 0xf8, 0x08, 0x2c, 0x83,
+	0x2f, 0x40, 0xed, 0x80, 0xef, 0x40, 0x22, 0xf3, 0x73, 0x89, 0x1f, 0x5f, 0xfc, 0x44, 0xb9, 0x17,
+	0x15, 0xa3, 0x8e, 0xc3, 0xaa, 0xc8, 0x97, 0xa0, 0xc3, 0x97, 0xb1, 0x64, 0x95, 0x56, 0x54, 0x8c,
+	0x3a, 0x0e, 0xf7, 0x5b, 0xb7, 0x9c, 0x7d, 0x51, 0xa1, 0xcc, 0x2b, 0x08, 0xbf, 0x75, 0x55, 0x88,
+	0x11, 0x9c, 0x5c, 0x87, 0x4a, 0xdf, 0xdc, 0x15, 0xb8, 0x15, 0x8e, 0xcb, 0x85, 0xe3, 0xed, 0xa5,
+	0x15, 0x99, 0x89, 0x5a, 0x41, 0x45, 0x5a, 0xee, 0x9e, 0x02, 0xf0, 0x55, 0x37, 0xa5, 0xd2, 0x72,
+	0x87, 0xc5, 0xa8, 0xe3, 0x90, 0x2f, 0xc0, 0xb4, 0x47, 0xcd, 0x7e, 0x9b, 0x86, 0xb5, 0x80, 0xd7,
+	0x92, 0xef, 0xa9, 0xe8, 0x10, 0x4c, 0x60, 0x0e, 0xb1, 0x6f, 0xd6, 0x46, 0xb2, 0x6f, 0x7e, 0x05,
+	0xa6, 0x4d, 0xcf, 0xb0, 0x1c, 0x6a, 0xde, 0x75, 0xb8, 0xab, 0x8c, 0xf4, 0x9e, 0x0f, 0xef, 0x16,
+	0x96, 0x62, 0x50, 0x4c, 0x60, 0x37, 0xfe, 0x79, 0x1e, 0x4a, 0xe2, 0x95, 0x97, 0x55, 0xb8, 0x60,
+	0x39, 0x56, 0x60, 0x19, 0xf6, 0x12, 0xb5, 0x8d, 0x43, 0xdd, 0x65, 0x48, 0x26, 0x6a, 0x5d, 0x1d,
+	0x04, 0x63, 0x5a, 0x1d, 0x36, 0x38, 0x81, 0x10, 0x1b, 0x14, 0x95, 0x7c, 0x94, 0x0c, 0x78, 0x2b,
+	0x06, 0xc1, 0x04, 0x26, 0x4f, 0x52, 0x3b, 0xe0, 0x0b, 0x54, 0x92, 0x8e, 0xdb, 0x31, 0xf7, 0x9c,
+	0x38, 0x1e, 0x57, 0x0e, 0xfa, 0x5c, 0x10, 0x8f, 0x92, 0x2e, 0x17, 0xa3, 0x4c, 0xbb, 0xad, 0x04,
+	0x0c, 0x07, 0xb0, 0x19, 0x85, 0x5d, 0xc3, 0xb2, 0xfb, 0x9e, 0x96, 0xb6, 0xb9, 0x14, 0x51, 0x58,
+	0x49, 0xc0, 0x70, 0x00, 0xbb, 0xb1, 0x05, 0xb0, 0xd9, 0xb7, 0x7d, 0x83, 0x67, 0xb9, 0x1b, 0xdb,
+	0x43, 0xa3, 0x7f, 0x92, 0x87, 0x49, 0x41, 0x56, 0xda, 0x00, 0x78, 0xf8, 0x36, 0x4f, 0xa6, 0x67,
+	0x9a, 0xde, 0x60, 0xf8, 0xb6, 0x82, 0xa0, 0x86, 0x75, 0x3a, 0x27, 0xbd, 0x57, 0x60, 0x52, 0x39,
+	0xdd, 0x71, 0x71, 0x27, 0x11, 0x48, 0xb0, 0xa8, 0xc1, 0x30, 0x86, 0x49, 0x96, 0xd8, 0xe8, 0xef,
+	0x88, 0xe4, 0x2d, 0x96, 0xeb, 0xf0, 0xda, 0xc2, 0x0d, 0x36, 0x4c, 0x5f, 0xd0, 0x4a, 0xc0, 0x71,
+	0xa0, 0x06, 0xf9, 0x2c, 0x0f, 0xd6, 0xde, 0x76, 0x8c, 0xf6, 0xbe, 0xdc, 0x42, 0x42, 0x79, 0x66,
+	0x43, 0x96, 0x63, 0x88, 0x41, 0x0c, 0x69, 0x42, 0x98, 0xc8, 0x1a, 0xe0, 0x1f, 0x4e, 0xd9, 0x80,
+	0x11, 0xe1, 0xbf, 0xe6, 0x80, 0x0c, 0x06, 0x6d, 0x92, 0x3d, 0x98, 0x70, 0xb8, 0x5d, 0x3c, 0xb3,
+	0xa7, 0xb4, 0x66, 0x5e, 0x17, 0xd2, 0x86, 0x2c, 0x90, 0xf4, 0x63, 0x5e, 0xd9, 0xf9, 0x31, 0xbe,
+	0xbe, 0x39, 0xcc, 0x2b, 0xfb, 0x77, 0x0b, 0x50, 0xd3, 0xf0, 0x3e, 0xc8, 0xdc, 0xc4, 0xd3, 0x79,
+	0x09, 0x73, 0xf4, 0xb6, 0x67, 0xcb, 0xb5, 0xa5, 0xa5, 0xf3, 0x92, 0x20, 0x5c, 0x47, 0x1d, 0x8f,
+	0x2d, 0xe0, 0xae, 0xe1, 0x07, 0xb1, 0x55, 0x16, 0x2e, 0xe0, 0x8d, 0x10, 0x82, 0x1a, 0x16, 0xb9,
+	0x26, 0x5d, 0x92, 0x8b, 0xf1, 0x37, 0x53, 0x86, 0xf8, 0x1b, 0x97, 0xc6, 0xe0, 0x6f, 0x4c, 0x3a,
+	0x70, 0x4e, 0xb5, 0x5a, 0x41, 0xcf, 0xf6, 0xa2, 0x86, 0xd8, 0x79, 0x12, 0x24, 0x70, 0x80, 0xa8,
+	0xb2, 0xb2, 0x95, 0xc7, 0xee, 0x12, 0xfe, 0xfd, 0x1c, 0x4c, 0xc5, 0xac, 0xac, 0xe2, 0x19, 0x15,
+	0x15, 0xcb, 0x1c, 0x7b, 0x46, 0x45, 0x0b, 0x41, 0x7e, 0x1e, 0x26, 0xc4, 0xc8, 0x27, 0x23, 0x5a,
+	0xc4, 0xdc, 0xa0, 0x84, 0x32, 0x19, 0x44, 0xde, 0xe3, 0x24, 0x65, 0x10, 0x79, 0xd1, 0x83, 0x0a,
+	0x2e, 0xae, 0x47, 0x45, 0xb7, 0xe5, 0x14, 0x6a, 0xd7, 0xa3, 0xa2, 0x1c, 0x43, 0x8c, 0xc6, 0x1f,
+	0x16, 0x60, 0x92, 0x91, 0x30, 0x0e, 0xe5, 0x96, 0xb7, 0x0d, 0xd5, 0x30, 0x2f, 0xe7, 0x49, 0x6f,
+	0xd5, 0x85, 0x89, 0x9e, 0xf4, 0x69, 0xe0, 0x32, 0x42, 0x08, 0xc1, 0x88, 0x12, 0xb9, 0x02, 0xc5,
+	0x9e, 0x21, 0xd5, 0x75, 0xf9, 0xcc, 0xce, 0xa6, 0xc1, 0xbe, 0x7e, 0x56, 0x3a, 0xf0, 0xda, 0x63,
+	0x61, 0x7c, 0xaf, 0x3d, 0xde, 0x84, 0x89, 0x5d, 0x91, 0xce, 0x5d, 0x0c, 0xc6, 0x2c, 0x1b, 0xdd,
+	0x30, 0x8f, 0xbb, 0xec, 0xbb, 0x4c, 0xe3, 0x2e, 0x31, 0x53, 0x5e, 0x36, 0x28, 0x8d, 0xfe, 0xb2,
+	0xc1, 0xc4, 0xa8, 0x2f, 0x1b, 0x88, 0x07, 0x3e, 0x04, 0xff, 0x72, 0x14, 0x65, 0xb8, 0x26, 0xcb,
+	0x30, 0x84, 0xf2, 0xa7, 0xab, 0x7b, 0x54, 0x5e, 0x45, 0x57, 0xe5, 0xd3, 0xd5, 0xac, 0x00, 0x45,
+	0x79, 0xe3, 0x1f, 0xf0, 0xd5, 0x19, 0x78, 0x87, 0xa1, 0x85, 0xaf, 0x03, 0x65, 0x19, 0xab, 0x22,
+	0x27, 0xf9, 0xb5, 0x0c, 0x06, 0x7e, 0x4e, 0x47, 0xfa, 0xac, 0x1b, 0xed, 0xfd, 0xbb, 0xbb, 0xbb,
+	0xa8, 0xa8, 0x93, 0x65, 0xa8, 0xba, 0x8e, 0x3c, 0xd1, 0xe5, 0xec, 0x7f, 0x9a, 0xad, 0x92, 0xbb,
+	0xaa, 0xf0, 0xe1, 0xd1, 0xdc, 0xa5, 0xf0, 0x4f, 0xac, 0x91, 0x18, 0xd5, 0x6c, 0xfc, 0x62, 0x0e,
+	0x9e, 0x42, 0xd7, 0xb6, 0x2d, 0xa7, 0x13, 0x77, 0xe2, 0x20, 0x36, 0x4c, 0x8b, 0x83, 0xea, 0xc0,
+	0xb0, 0x6c, 0x63, 0xc7, 0xa6, 0x1f, 0x68, 0xa1, 0xeb, 0x07, 0x96, 0x3d, 0x6f, 0x39, 0x81, 0x1f,
+	0x78, 0xf3, 0xab, 0x4e, 0x70, 0xd7, 0x6b, 0x05, 0x9e, 0xe5, 0x74, 0xc4, 0xf4, 0x6e, 0xc4, 0x68,
+	0x61, 0x82, 0x76, 0xe3, 0xdf, 0x15, 0x81, 0x7b, 0x93, 0x8f, 0x1e, 0xf1, 0xd1, 0x86, 0x89, 0x8e,
+	0xef, 0x1b, 0x3d, 0x2b, 0xb3, 0xb7, 0x9c, 0x78, 0xe6, 0x49, 0x9c, 0x66, 0xe2, 0x37, 0x4a, 0xd2,
+	0xa4, 0x0d, 0xa5, 0x9e, 0x6d, 0x58, 0x8e, 0x34, 0xf2, 0x35, 0x33, 0xf9, 0xd0, 0x6f, 0x32, 0x4a,
+	0x62, 0x55, 0xf1, 0x9f, 0x28, 0x68, 0x93, 0x3e, 0xd4, 0xfc, 0xb6, 0x67, 0x74, 0xfd, 0x3d, 0xe3,
+	0xe6, 0x8b, 0x2f, 0x65, 0x36, 0x42, 0x44, 0xac, 0x84, 0x6e, 0xb2, 0x88, 0x0b, 0x1b, 0xad, 0xdb,
+	0x0b, 0x37, 0x5f, 0x7c, 0x09, 0x75, 0x3e, 0x3a, 0xdb, 0x17, 0x5f, 0xb8, 0x29, 0x0f, 0xa0, 0xb1,
+	0xb3, 0x7d, 0xf1, 0x85, 0x9b, 0xa8, 0xf3, 0x61, 0x43, 0xea, 0x6a, 0x52, 0x50, 0x36, 0x86, 0x77,
+	0xa3, 0x0b, 0x31, 0xfe, 0x13, 0x05, 0xed, 0xc6, 0x1f, 0xe7, 0xa0, 0x1a, 0xc2, 0xd9, 0x39, 0x2b,
+	0x32, 0x50, 0xaf, 0x2e, 0x9d, 0x4d, 0xb4, 0xe5, 0x1b, 0xc5, 0xa2, 0xac, 0x8a, 0x21, 0x11, 0xf2,
+	0x16, 0x4c, 0x8a, 0xdf, 0xf2, 0x41, 0xa9, 0xfc, 0x99, 0x5f, 0xad, 0x5a, 0xd4, 0xaa, 0x63, 0x8c,
+	0x18, 0xf9, 0x22, 0x4c, 0x71, 0x31, 0x7a, 0xd9, 0x31, 0x7b, 0xae, 0x25, 0x1f, 0xab, 0xd6, 0x92,
+	0x6f, 0x6e, 0xe9, 0x40, 0x8c, 0xe3, 0x86, 0x1d, 0xe7, 0x33, 0x41, 0xb6, 0x01, 0x98, 0xa0, 0x21,
+	0x5b, 0x79, 0xa6, 0xae, 0x73, 0xdb, 0xc3, 0x76, 0x58, 0x19, 0x35, 0x42, 0x29, 0xef, 0x82, 0xe5,
+	0xc7, 0xfd, 0x2e, 0xd8, 0x0d, 0xa8, 0xee, 0x19, 0x8e, 0xe9, 0xef, 0x19, 0xfb, 0x54, 0x86, 0x38,
+	0x85, 0x06, 0xa7, 0xdb, 0x0a, 0x80, 0x11, 0x4e, 0xe3, 0x2f, 0x97, 0x41, 0x38, 0x10, 0xb2, 0x83,
+	0xdb, 0xb4, 0x7c, 0x11, 0x6e, 0x97, 0xe3, 0x35, 0xc3, 0x83, 0x7b, 0x49, 0x96, 0x63, 0x88, 0x41,
+	0x2e, 0x43, 0xa1, 0x6b, 0x39, 0x52, 0xdf, 0xe3, 0xb2, 0xc8, 0x86, 0xe5, 0x20, 0x2b, 0xe3, 0x20,
+	0xe3, 0x81, 0xd4, 0xe7, 0x04, 0xc8, 0x78, 0x80, 0xac, 0x8c, 0x7c, 0x19, 0x66, 0x6c, 0xd7, 0xdd,
+	0x67, 0x9b, 0xb3, 0x1e, 0xaa, 0x31, 0x25, 0x0c, 0xe8, 0xeb, 0x71, 0x10, 0x26, 0x71, 0xc9, 0x36,
+	0x3c, 0xfd, 0x1e, 0xf5, 0x5c, 0x29, 0x73, 0xb4, 0x6c, 0x4a, 0x7b, 0x8a, 0x8c, 0xd0, 0x22, 0x78,
+	0x24, 0xc9, 0xd7, 0xd3, 0x51, 0x70, 0x58, 0x5d, 0x1e, 0xb5, 0x69, 0x78, 0x1d, 0x1a, 0x6c, 0x7a,
+	0x2e, 0xd3, 0x14, 0x2d, 0xa7, 0xa3, 0xc8, 0x4e, 0x44, 0x64, 0xb7, 0xd2, 0x51, 0x70, 0x58, 0x5d,
+	0xf2, 0x26, 0xd4, 0x05, 0x48, 0xe8, 0x14, 0x0b, 0x62, 0x13, 0xb7, 0x6c, 0x2b, 0x38, 0x94, 0x36,
+	0x0d, 0xee, 0x58, 0xb1, 0x35, 0x04, 0x07, 0x87, 0xd6, 0x26, 0xaf, 0xc3, 0x39, 0xe5, 0x56, 0xb3,
+	0x49, 0xbd, 0x56, 0xe8, 0x54, 0x3a, 0xa5, 0x42, 0x7e, 0x54, 0xc8, 0x0b, 0x26, 0xb0, 0x70, 0xa0,
+	0x1e, 0x41, 0xb8, 0xc4, 0x3d, 0x47, 0xb7, 0x7b, 0x8b, 0xae, 0x6b, 0x9b, 0xee, 0x7d, 0x47, 0xf5,
+	0x5d, 0x98, 0x47, 0xb8, 0x27, 0x4d, 0x2b, 0x15, 0x03, 0x87, 0xd4, 0x64, 0x3d, 0xe7, 0x90, 0x25,
+	0xf7, 0xbe, 0x93, 0xa4, 0x0a, 0x51, 0xcf, 0x5b, 0x43, 0x70, 0x70, 0x68, 0x6d, 0xb2, 0x02, 0x24,
+	0xd9, 0x83, 0xed, 0x9e, 0xf4, 0xf5, 0xba, 0x24, 0x52, 0xd0, 0x26, 0xa1, 0x98, 0x52, 0x83, 0x3f,
+	0x45, 0x95, 0x28, 0x65, 0xec, 0xa4, 0xdb, 0x97, 0x78, 0x8a, 0x2a, 0x05, 0x8e, 0xa9, 0xb5, 0xb4,
+	0x05, 0x44, 0x1d, 0xd3, 0x72, 0x3a, 0x0b, 0x1d, 0xaa, 0xba, 0x3b, 0x35, 0xb0, 0x80, 0x92, 0x28,
+	0x38, 0xac, 0x6e, 0x63, 0x03, 0x52, 0x22, 0x81, 0xc8, 0xcb, 0x30, 0xd5, 0x35, 0x1e, 0xdc, 0xb3,
+	0x5c, 0x3b, 0x8c, 0xf4, 0xc9, 0x5d, 0x2f, 0x08, 0xc3, 0xc9, 0x86, 0x0e, 0xc0, 0x38, 0x5e, 0xe3,
+	0x1f, 0xe7, 0x61, 0x2a, 0x96, 0x59, 0xf1, 0x89, 0xcb, 0x60, 0xc7, 0x24, 0xdf, 0xae, 0xdf, 0x59,
+	0x5d, 0x12, 0xf7, 0xc3, 0x2a, 0x4c, 0x53, 0x4a, 0xbe, 0x1b, 0x31, 0x08, 0x26, 0x30, 0xc9, 0x2e,
+	0x94, 0xc4, 0xb5, 0x77, 0x31, 0xe3, 0x1d, 0xa6, 0x1a, 0x23, 0x7e, 0xf7, 0x2d, 0x84, 0x59, 0x7e,
+	0xf3, 0x2d, 0xc8, 0x37, 0x02, 0x98, 0xd4, 0x31, 0xd8, 0x76, 0x17, 0x69, 0xce, 0xe5, 0x98, 0xd6,
+	0xbc, 0x0a, 0x85, 0x20, 0x18, 0x35, 0x29, 0x9b, 0x50, 0xf0, 0xb6, 0xd6, 0x91, 0xd1, 0x68, 0xec,
+	0xb2, 0xb9, 0xf3, 0x7d, 0xcb, 0x75, 0xe4, 0x3b, 0xab, 0xdb, 0x50, 0x96, 0x16, 0xb5, 0x11, 0x93,
+	0xca, 0x71, 0x79, 0x59, 0x5d, 0x01, 0x2a, 0x5a, 0x8d, 0x7f, 0x9d, 0x87, 0x6a, 0x68, 0xb2, 0x3f,
+	0xc5, 0xfb, 0xa5, 0x2e, 0xd7, 0xd7, 0x84, 0x6b, 0x95, 0xec, 0x68, 0x33, 0xbb, 0x5b, 0x57, 0xa8,
+	0xc9, 0x89, 0xbf, 0x18, 0xf1, 0xd0, 0x7d, 0xff, 0x0b, 0x19, 0x7c, 0xff, 0x7b, 0x50, 0x0e, 0x3c,
+	0xab, 0xd3, 0x91, 0x86, 0x86, 0x2c, 0xce, 0xff, 0xe1, 0x70, 0x6d, 0x09, 0x82, 0x72, 0x64, 0xc5,
+	0x1f, 0x54, 0x6c, 0x1a, 0xef, 0xc0, 0xb9, 0x24, 0x26, 0x57, 0x96, 0xd5, 0x7b, 0x6e, 0xb9, 0x84,
+	0xb2, 0xac, 0xde, 0x5f, 0x0b, 0x31, 0x98, 0x46, 0xc6, 0xa6, 0xe9, 0x3d, 0xd7, 0x51, 0xaa, 0x0c,
+	0x17, 0xb4, 0xb6, 0x64, 0x19, 0x86, 0xd0, 0xc6, 0x7f, 0x2a, 0xc0, 0xe5, 0xe8, 0xe2, 0x65, 0xc3,
+	0x70, 0x8c, 0x4e, 0xdc, 0x2f, 0xef, 0xe3, 0xac, 0x0e, 0x63, 0x79, 0x31, 0xbb, 0xf0, 0x04, 0xbc,
+	0x98, 0xfd, 0x1f, 0x0a, 0xc0, 0x63, 0x89, 0xc8, 0x37, 0x61, 0x52, 0x8d, 0x27, 0xfb, 0x2f, 0xa7,
+	0x73, 0x39, 0xf3, 0x74, 0xf2, 0x90, 0xa5, 0xd0, 0xd6, 0xa1, 0x97, 0x62, 0x8c, 0x21, 0x71, 0xa1,
+	0xb2, 0x6b, 0xd8, 0x36, 0x93, 0xd8, 0x32, 0x3b, 0x92, 0xc4, 0x98, 0xf3, 0x65, 0xbe, 0x22, 0x49,
+	0x63, 0xc8, 0x84, 0x7c, 0x27, 0x07, 0x53, 0x9e, 0xae, 0xb2, 0x67, 0xce, 0xfc, 0x10, 0x33, 0x00,
+	0xe8, 0xde, 0xe3, 0xba, 0x5d, 0x20, 0xce, 0x93, 0x98, 0x30, 0x79, 0xdf, 0xb3, 0x02, 0x9a, 0xcd,
+	0x2b, 0x83, 0xab, 0x37, 0x6f, 0x68, 0x74, 0x30, 0x46, 0x95, 0x27, 0x77, 0x6d, 0xd9, 0x16, 0x13,
+	0x11, 0x1e, 0xe1, 0x4b, 0xdb, 0x77, 0xa1, 0xe4, 0xdb, 0x96, 0x49, 0x47, 0x3c, 0xb3, 0xc4, 0x69,
+	0xc9, 0x08, 0xa0, 0xa0, 0x13, 0x7f, 0xba, 0xbb, 0x70, 0x8a, 0xa7, 0xbb, 0x7f, 0xaf, 0x02, 0x32,
+	0xf6, 0x8e, 0xf4, 0xa1, 0xda, 0x51, 0xef, 0xd9, 0xc9, 0x3e, 0xde, 0x1e, 0xd7, 0x43, 0x80, 0xe2,
+	0x84, 0x89, 0x9e, 0xcb, 0x8b, 0x38, 0x11, 0xaa, 0x32, 0x23, 0xe6, 0xc7, 0x91, 0x1d, 0x45, 0xb2,
+	0x1b, 0x48, 0x89, 0x48, 0x0c, 0x28, 0xee, 0x05, 0x41, 0x4f, 0x2e, 0xd9, 0xd1, 0x6f, 0x35, 0xa2,
+	0x8c, 0xc4, 0x42, 0xf2, 0x62, 0xff, 0x91, 0x93, 0x66, 0x2c, 0x1c, 0x23, 0xf0, 0x33, 0x67, 0x46,
+	0x8e, 0xdc, 0x52, 0xa5, 0xd7, 0xaa, 0x11, 0xf8, 0xc8, 0x49, 0x93, 0x9f, 0x87, 0x5a, 0xe0, 0x19,
+	0x8e, 0xbf, 0xeb, 0x7a, 0x5d, 0xea, 0x49, 0x6b, 0xc8, 0xe8, 0xdf, 0xdf, 0xf6, 0xd2, 0x56, 0x44,
+	0x4d, 0xc8, 0xb4, 0xb1, 0x22, 0xd4, 0xb9, 0x91, 0x7d, 0xa8, 0xf4, 0x4d, 0xd1, 0x30, 0x69, 0x16,
+	0x59, 0xc8, 0xc0, 0x59, 0xf7, 0xac, 0x54, 0xff, 0x30, 0x64, 0xc0, 0x56, 0x63, 0x94, 0xae, 0xb3,
+	0x9c, 0x71, 0x35, 0x26, 0x32, 0x4f, 0x9d, 0x90, 0xa7, 0xb3, 0x2b, 0xa5, 0x67, 0xa7, 0x23, 0x1d,
+	0xc3, 0x57, 0x32, 0x0b, 0xb6, 0x82, 0x65, 0x2d, 0x94, 0xc0, 0x9d, 0x0e, 0x2a, 0x1e, 0xc4, 0x82,
+	0x89, 0x1e, 0xbf, 0x26, 0x93, 0x3e, 0x19, 0xcb, 0x19, 0x6f, 0xdb, 0xf4, 0x90, 0x5a, 0x51, 0x82,
+	0x92, 0x01, 0x63, 0xe5, 0x71, 0xf3, 0x37, 0xd7, 0x09, 0xb3, 0xb0, 0xd2, 0x6f, 0x10, 0xe4, 0xcb,
+	0xc3, 0xbc, 0x04, 0x25, 0x83, 0x46, 0x17, 0xa4, 0x2f, 0x06, 0x69, 0x03, 0xb4, 0xc3, 0xc7, 0xfb,
+	0x65, 0x92, 0x84, 0x1b, 0xa7, 0xdb, 0xe5, 0xc2, 0x47, 0xff, 0xb5, 0xc7, 0xda, 0x42, 0x52, 0xa8,
+	0x91, 0x6d, 0xfc, 0x9b, 0x3c, 0x14, 0xb6, 0xd6, 0x5b, 0xe2, 0x01, 0x16, 0x9f, 0xb6, 0xfb, 0x1e,
+	0x6d, 0xed, 0x5b, 0xbd, 0x7b, 0xd4, 0xb3, 0x76, 0x0f, 0xa5, 0x71, 0x45, 0x7b, 0x80, 0x25, 0x89,
+	0x81, 0x29, 0xb5, 0xb8, 0xed, 0xcc, 0x58, 0xa4, 0x5e, 0x06, 0xdb, 0xd9, 0x42, 0x54, 0x1d, 0x63,
+	0xc4, 0xc8, 0x36, 0x40, 0x3b, 0x22, 0x5d, 0x38, 0xb3, 0xc1, 0x4b, 0x23, 0xac, 0x11, 0x22, 0x08,
+	0xd5, 0x7d, 0x86, 0xca, 0xa9, 0x16, 0xcf, 0x42, 0x95, 0x7f, 0x0f, 0x6b, 0xaa, 0x2e, 0x46, 0x64,
+	0x1a, 0x0e, 0x4c, 0x6d, 0x19, 0x9d, 0x68, 0xe0, 0xc9, 0xe7, 0xa1, 0xe2, 0xf6, 0xb4, 0x43, 0xa2,
+	0xca, 0x03, 0x6b, 0x2a, 0x77, 0x65, 0xd9, 0xc3, 0xa3, 0xb9, 0xa9, 0x75, 0xb7, 0x63, 0xb5, 0x55,
+	0x01, 0x86, 0xe8, 0xa4, 0x01, 0x13, 0x3c, 0x85, 0x83, 0xf0, 0xce, 0xab, 0x8a, 0xa5, 0xc3, 0x9f,
+	0xc5, 0xf6, 0x51, 0x42, 0x1a, 0xdf, 0x2a, 0x42, 0xe4, 0x39, 0x45, 0x7c, 0x98, 0x10, 0xe1, 0xa3,
+	0xf2, 0x3c, 0x7a, 0xa4, 0x91, 0xaa, 0x92, 0x15, 0xe9, 0x40, 0xe1, 0x1d, 0x77, 0x27, 0xf3, 0x71,
+	0xa4, 0x25, 0x76, 0x13, 0xb6, 0x66, 0xad, 0x00, 0x19, 0x07, 0xf2, 0xd7, 0x72, 0x70, 0xde, 0x4f,
+	0xaa, 0x0d, 0x72, 0x39, 0x60, 0x76, 0xfd, 0x28, 0xa9, 0x88, 0xc8, 0x08, 0xa8, 0x61, 0x60, 0x1c,
+	0x6c, 0x0b, 0x1b, 0x7f, 0xe1, 0x5a, 0x24, 0x97, 0xd3, 0xe8, 0xe3, 0x2f, 0xdc, 0x95, 0xe2, 0xe3,
+	0x1f, 0x2f, 0x43, 0xc9, 0xaa, 0xf1, 0xed, 0x3c, 0xd4, 0xb4, 0x33, 0xe8, 0x14, 0x5a, 0xf1, 0x15,
+	0x28, 0x1a, 0x5e, 0x47, 0x2d, 0x2b, 0x61, 0x10, 0xf1, 0x3a, 0x3e, 0xf2, 0x52, 0xf2, 0x00, 0x26,
+	0xf6, 0xef, 0x73, 0xb8, 0xd0, 0x60, 0x37, 0x47, 0xbf, 0x08, 0x8e, 0x5a, 0x35, 0xbf, 0xc6, 0x49,
+	0x26, 0x32, 0xa4, 0xac, 0xbd, 0xc1, 0xf9, 0x4a, 0x7e, 0xb3, 0x9f, 0x87, 0x9a, 0x86, 0x76, 0xa6,
+	0x0c, 0x27, 0xff, 0xa8, 0x00, 0x85, 0xed, 0xa5, 0x95, 0xb8, 0xc2, 0x9f, 0x7b, 0x0c, 0x0a, 0xff,
+	0x1e, 0x94, 0x77, 0xfa, 0x96, 0x1d, 0x58, 0x4e, 0xe6, 0xc4, 0x9a, 0x2b, 0x7d, 0xa7, 0x1d, 0xd9,
+	0x3e, 0x9a, 0x82, 0x2a, 0x2a, 0xf2, 0xa4, 0x03, 0xe5, 0x8e, 0x78, 0x53, 0x23, 0x73, 0xe8, 0x84,
+	0x7c, 0x9b, 0x43, 0x30, 0x92, 0x7f, 0x50, 0x51, 0x27, 0xf7, 0xa1, 0xd6, 0x8b, 0x42, 0x27, 0xe4,
+	0x52, 0x1e, 0xfd, 0xc3, 0xd6, 0xc2, 0x30, 0x64, 0xc8, 0x59, 0x54, 0x80, 0x3a, 0xa7, 0xc6, 0x21,
+	0x4c, 0x6c, 0x2f, 0x49, 0x5d, 0xed, 0xf1, 0x4e, 0x63, 0xe3, 0xe7, 0x21, 0x14, 0xaa, 0x1e, 0x3f,
+	0xf3, 0xff, 0x92, 0x83, 0xb8, 0x1c, 0xf9, 0xf8, 0x97, 0xf1, 0x7e, 0x72, 0x19, 0x2f, 0x8d, 0xe3,
+	0xab, 0x4f, 0x5f, 0xc9, 0x8d, 0xdf, 0xcb, 0x41, 0x22, 0xd9, 0x00, 0x79, 0x49, 0xa6, 0x08, 0x8f,
+	0x7b, 0xb6, 0xab, 0x14, 0xe1, 0x24, 0x8e, 0xad, 0xa5, 0x0a, 0x7f, 0x9f, 0xe9, 0xd8, 0xfa, 0xcd,
+	0xb7, 0x6c, 0xfe, 0x9d, 0xd1, 0xa5, 0xb5, 0xb4, 0x7b, 0x74, 0x19, 0x7d, 0xa1, 0x83, 0x30, 0xce,
+	0xb7, 0xf1, 0xdf, 0x72, 0x30, 0xa9, 0xbf, 0x0c, 0x42, 0x3e, 0x03, 0x65, 0xc3, 0x34, 0x3d, 0xea,
+	0xfb, 0xc9, 0x30, 0xe5, 0x05, 0x51, 0x8c, 0x0a, 0xce, 0xd4, 0xd0, 0xae, 0xdb, 0x77, 0x82, 0xcd,
+	0xc8, 0x09, 0x24, 0x54, 0x43, 0x37, 0x14, 0x00, 0x23, 0x1c, 0x46, 0x7b, 0x9f, 0x1e, 0x6a, 0x6e,
+	0x4b, 0x21, 0xed, 0x35, 0x51, 0x8c, 0x0a, 0x4e, 0xde, 0x84, 0x1a, 0xbf, 0x4c, 0x1c, 0x45, 0xce,
+	0xe1, 0x9f, 0xeb, 0x56, 0x54, 0x1b, 0x75, 0x52, 0x8d, 0x7f, 0x98, 0x87, 0x89, 0xc7, 0x96, 0x51,
+	0x8a, 0xc6, 0x42, 0x80, 0x16, 0x33, 0x1e, 0xac, 0x43, 0x03, 0x80, 0xba, 0x89, 0x00, 0xa0, 0xe5,
+	0xac, 0x8c, 0x4e, 0x0e, 0xff, 0xf9, 0x97, 0x39, 0x90, 0xc7, 0xfa, 0xaa, 0xe3, 0x07, 0x86, 0xd3,
+	0xa6, 0xa4, 0x1d, 0xca, 0x10, 0x59, 0xfd, 0xbd, 0x65, 0x2c, 0x86, 0x10, 0x1b, 0xf9, 0x6f, 0x25,
+	0x33, 0x90, 0xcf, 0x42, 0x65, 0xcf, 0xf5, 0x03, 0x2e, 0x27, 0xe4, 0xe3, 0x96, 0xdd, 0xdb, 0xb2,
+	0x1c, 0x43, 0x8c, 0xa4, 0x7f, 0x55, 0x69, 0xb8, 0x7f, 0x55, 0xe3, 0xeb, 0x30, 0x93, 0x4c, 0x8b,
+	0x75, 0x2b, 0x35, 0x2d, 0xd6, 0x73, 0x43, 0xd2, 0x62, 0xd5, 0x86, 0xa7, 0xc4, 0xfa, 0x8d, 0x3c,
+	0x4c, 0x7e, 0x54, 0xd2, 0x61, 0xa5, 0x05, 0x63, 0x15, 0x32, 0x06, 0x63, 0x15, 0xcf, 0x12, 0x8c,
+	0xd5, 0xf8, 0x61, 0x0e, 0xe0, 0xb1, 0xe5, 0xe2, 0x32, 0xe3, 0x71, 0x52, 0x99, 0xd7, 0x6c, 0x7a,
+	0x94, 0xd4, 0xdf, 0x2a, 0xab, 0x2e, 0xf1, 0x18, 0xa9, 0xf7, 0x73, 0x30, 0x6d, 0xc4, 0xe2, 0x8e,
+	0x32, 0xab, 0x3d, 0x89, 0x30, 0xa6, 0xd0, 0x7d, 0x3d, 0x5e, 0x8e, 0x09, 0xb6, 0xfc, 0x51, 0x2a,
+	0x19, 0x1c, 0x71, 0x27, 0xfa, 0xa4, 0x06, 0x5e, 0x66, 0x13, 0x0e, 0xcb, 0x3a, 0xe6, 0x07, 0xc4,
+	0x79, 0x15, 0xc6, 0x12, 0xe7, 0xa5, 0x27, 0xc1, 0x28, 0x9e, 0x98, 0x04, 0xe3, 0x00, 0xaa, 0xbb,
+	0x9e, 0xdb, 0xe5, 0xa1, 0x54, 0xf5, 0x12, 0x9f, 0xca, 0xe5, 0x0c, 0x62, 0x47, 0x77, 0xc7, 0x72,
+	0xa8, 0xc9, 0xc3, 0xb4, 0xc2, 0xe3, 0x6c, 0x45, 0xd1, 0xc7, 0x88, 0x15, 0xbf, 0xee, 0x72, 0x05,
+	0xd7, 0x89, 0x71, 0x72, 0x0d, 0xf7, 0xa9, 0x2d, 0x41, 0x1d, 0x15, 0x9b, 0x78, 0xf8, 0x54, 0xf9,
+	0x31, 0x85, 0x4f, 0x1d, 0xea, 0x51, 0x69, 0x95, 0x8c, 0x36, 0xba, 0x33, 0x65, 0x4f, 0xfa, 0xd0,
+	0x02, 0x9a, 0x7e, 0xa9, 0xac, 0xf6, 0xec, 0x27, 0xee, 0x25, 0x9d, 0x8f, 0xb3, 0x35, 0x75, 0xe8,
+	0x40, 0x2a, 0xa5, 0xca, 0x63, 0x4c, 0xa5, 0x54, 0x1d, 0x4f, 0x2a, 0x25, 0xc8, 0x96, 0x4a, 0xa9,
+	0x36, 0xa6, 0x54, 0x4a, 0x93, 0xe3, 0x4a, 0xa5, 0x34, 0x35, 0x52, 0x2a, 0xa5, 0xe9, 0x53, 0xa5,
+	0x52, 0x3a, 0x2a, 0x40, 0xc2, 0x8c, 0xf4, 0xf1, 0x75, 0xfb, 0x9f, 0xaa, 0xeb, 0xf6, 0xef, 0xe5,
+	0x21, 0x3a, 0x7b, 0xce, 0xe8, 0x34, 0x29, 0xde, 0xa8, 0xe4, 0x21, 0x74, 0x23, 0x8a, 0xc4, 0xea,
+	0x8d, 0x4a, 0x4e, 0x03, 0x43, 0x6a, 0xc4, 0x07, 0xb0, 0xc2, 0x27, 0x27, 0x33, 0x5f, 0x29, 0x46,
+	0xaf, 0x57, 0x8a, 0xa3, 0x27, 0xfa, 0x8f, 0x1a, 0x9b, 0xc6, 0xbf, 0xc8, 0x83, 0x7c, 0x22, 0x96,
+	0x50, 0x28, 0xed, 0x5a, 0x0f, 0xa8, 0x99, 0x39, 0x4e, 0x6a, 0x85, 0x51, 0x91, 0xef, 0xd0, 0xf2,
+	0x3b, 0x53, 0x5e, 0x80, 0x82, 0x3a, 0xbf, 0x0c, 0x13, 0x77, 0xe0, 0x72, 0xfc, 0x32, 0x5c, 0x86,
+	0xe9, 0x77, 0xe9, 0xf2, 0x32, 0x4c, 0x14, 0xa1, 0xe2, 0x21, 0xee, 0xde, 0xb8, 0xd3, 0x55, 0x66,
+	0xc7, 0x82, 0x98, 0xf3, 0x96, 0xba, 0x7b, 0xf3, 0x45, 0x2e, 0x35, 0xc9, 0xa3, 0xf9, 0xb3, 0x3f,
+	0xf8, 0xf1, 0xd5, 0x4f, 0xfc, 0xf0, 0xc7, 0x57, 0x3f, 0xf1, 0xa3, 0x1f, 0x5f, 0xfd, 0xc4, 0xb7,
+	0x8e, 0xaf, 0xe6, 0x7e, 0x70, 0x7c, 0x35, 0xf7, 0xc3, 0xe3, 0xab, 0xb9, 0x1f, 0x1d, 0x5f, 0xcd,
+	0xfd, 0xfb, 0xe3, 0xab, 0xb9, 0xbf, 0xf8, 0x87, 0x57, 0x3f, 0xf1, 0xf5, 0x97, 0xa3, 0x26, 0xdc,
+	0x50, 0x4d, 0xb8, 0xa1, 0x18, 0xde, 0xe8, 0xed, 0x77, 0x6e, 0xb0, 0x26, 0x44, 0x25, 0xaa, 0x09,
+	0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x19, 0x64, 0x78, 0x7c, 0x64, 0xbc, 0x00, 0x00,
 }
 
 func (m *AbstractPodTemplate) Marshal() (dAtA []byte, err error) {
@@ -4111,6 +4545,70 @@ func (m *Blackhole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceValidation != nil {
+		{
+			size, err := m.SequenceValidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BufferRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BufferRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BufferRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Discard != nil {
+		i -= len(*m.Discard)
+		copy(dAtA[i:], *m.Discard)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Discard)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxBytes != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMessages != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxMessages))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAge != nil {
+		{
+			size, err := m.MaxAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -4134,6 +4632,18 @@ func (m *BufferServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -4577,6 +5087,25 @@ func (m *Edge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Retention != nil {
+		{
+			size, err := m.Retention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BufferConfig != nil {
+		i -= len(*m.BufferConfig)
+		copy(dAtA[i:], *m.BufferConfig)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.BufferConfig)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.OnFull != nil {
 		i -= len(*m.OnFull)
 		copy(dAtA[i:], *m.OnFull)
@@ -4836,6 +5365,77 @@ func (m *GSSAPI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GeneratorRateStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratorRateStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratorRateStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != nil {
+		{
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RPU))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *GeneratorRuntimeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratorRuntimeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratorRuntimeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxKeyCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxKeyCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRPU != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRPU))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GeneratorSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4856,6 +5456,225 @@ func (m *GeneratorSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RuntimeUpdate != nil {
+		{
+			size, err := m.RuntimeUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.FutureJitter != nil {
+		{
+			size, err := m.FutureJitter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.DuplicatePercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.DuplicatePercentage))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.PayloadMessage != nil {
+		i -= len(*m.PayloadMessage)
+		copy(dAtA[i:], *m.PayloadMessage)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.PayloadMessage)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.PayloadSchema != nil {
+		i -= len(*m.PayloadSchema)
+		copy(dAtA[i:], *m.PayloadSchema)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.PayloadSchema)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.PayloadFormat != nil {
+		i -= len(*m.PayloadFormat)
+		copy(dAtA[i:], *m.PayloadFormat)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.PayloadFormat)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.HotKeyPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.HotKeyPercentage))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ZipfExponent != nil {
+		i -= len(*m.ZipfExponent)
+		copy(dAtA[i:], *m.ZipfExponent)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ZipfExponent)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.KeyDistribution != nil {
+		i -= len(*m.KeyDistribution)
+		copy(dAtA[i:], *m.KeyDistribution)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.KeyDistribution)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.LateBy != nil {
+		{
+			size, err := m.LateBy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.LatePercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.LatePercentage))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.RateSchedule) > 0 {
+		for iNdEx := len(m.RateSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.IdleThreshold != nil {
+		{
+			size, err := m.IdleThreshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.EventTimeUnit != nil {
+		i -= len(*m.EventTimeUnit)
+		copy(dAtA[i:], *m.EventTimeUnit)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EventTimeUnit)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.EventTimeField != nil {
+		i -= len(*m.EventTimeField)
+		copy(dAtA[i:], *m.EventTimeField)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EventTimeField)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RateJitterPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RateJitterPercentage))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.RampUp != nil {
+		{
+			size, err := m.RampUp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ValueTemplate != nil {
+		i -= len(*m.ValueTemplate)
+		copy(dAtA[i:], *m.ValueTemplate)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ValueTemplate)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.OnInvalidEventTime != nil {
+		i -= len(*m.OnInvalidEventTime)
+		copy(dAtA[i:], *m.OnInvalidEventTime)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.OnInvalidEventTime)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TombstonePercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TombstonePercentage))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EmitEvery != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.EmitEvery))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.ValueBlob != nil {
 		i -= len(*m.ValueBlob)
 		copy(dAtA[i:], *m.ValueBlob)
@@ -5690,6 +6509,91 @@ func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ISBEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ISBEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ISBEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vault != nil {
+		{
+			size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.PrimaryKeyID)
+	copy(dAtA[i:], m.PrimaryKeyID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PrimaryKeyID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SecretName)
+	copy(dAtA[i:], m.SecretName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SecretName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ISBPipelineQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ISBPipelineQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ISBPipelineQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStreams != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxStreams))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBytes != nil {
+		{
+			size, err := m.MaxBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IdleSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5869,6 +6773,18 @@ func (m *InterStepBufferServiceSpec) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -5977,6 +6893,18 @@ func (m *JetStreamBufferService) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.PipelineQuota != nil {
+		{
+			size, err := m.PipelineQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i--
 	if m.TLS {
 		dAtA[i] = 1
@@ -6107,6 +7035,30 @@ func (m *JetStreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PipelineQuota != nil {
+		{
+			size, err := m.PipelineQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	i--
 	if m.TLSEnabled {
 		dAtA[i] = 1
@@ -6252,6 +7204,164 @@ func (m *JobTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaBufferService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaBufferService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaBufferService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.External != nil {
+		{
+			size, err := m.External.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KafkaConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Config)
+	copy(dAtA[i:], m.Config)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Config)))
+	i--
+	dAtA[i] = 0x22
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i--
+	if m.TLSEnabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KafkaSASLAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaSASLAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaSASLAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.User != nil {
+		{
+			size, err := m.User.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mechanism != nil {
+		i -= len(*m.Mechanism)
+		copy(dAtA[i:], *m.Mechanism)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Mechanism)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *KafkaSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6465,6 +7575,18 @@ func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SequenceValidation != nil {
+		{
+			size, err := m.SequenceValidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -7296,6 +8418,34 @@ func (m *PBQStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PassThrough) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PassThrough) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PassThrough) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TagsHeader)
+	copy(dAtA[i:], m.TagsHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TagsHeader)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PersistenceStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7515,6 +8665,18 @@ func (m *PipelineSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.SideInputs) > 0 {
 		for iNdEx := len(m.SideInputs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7911,6 +9073,18 @@ func (m *RedisConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.SentinelPassword != nil {
 		{
 			size, err := m.SentinelPassword.MarshalToSizedBuffer(dAtA[:i])
@@ -8001,6 +9175,97 @@ func (m *RedisSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReplaySource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplaySource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplaySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Speed != nil {
+		i -= len(*m.Speed)
+		copy(dAtA[i:], *m.Speed)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Speed)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.KeyField != nil {
+		i -= len(*m.KeyField)
+		copy(dAtA[i:], *m.KeyField)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.KeyField)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EventTimeUnit != nil {
+		i -= len(*m.EventTimeUnit)
+		copy(dAtA[i:], *m.EventTimeUnit)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EventTimeUnit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EventTimeField != nil {
+		i -= len(*m.EventTimeField)
+		copy(dAtA[i:], *m.EventTimeField)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EventTimeField)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Format != nil {
+		i -= len(*m.Format)
+		copy(dAtA[i:], *m.Format)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Format)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VolumeMounts) > 0 {
+		for iNdEx := len(m.VolumeMounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeMounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Path != nil {
+		i -= len(*m.Path)
+		copy(dAtA[i:], *m.Path)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConfigMap != nil {
+		{
+			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8295,6 +9560,11 @@ func (m *Scale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TargetPendingAgeSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TargetPendingAgeSeconds))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.ReplicasPerScaleDown != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ReplicasPerScaleDown))
 		i--
@@ -8361,6 +9631,34 @@ func (m *Scale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SequenceValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequenceValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequenceValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxViolations != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxViolations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ServingSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8678,6 +9976,18 @@ func (m *Sink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WriteTimeout != nil {
+		{
+			size, err := m.WriteTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8788,6 +10098,18 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Replay != nil {
+		{
+			size, err := m.Replay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Pulsar != nil {
 		{
 			size, err := m.Pulsar.MarshalToSizedBuffer(dAtA[:i])
@@ -9194,6 +10516,18 @@ func (m *UDF) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PassThrough != nil {
+		{
+			size, err := m.PassThrough.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.GroupBy != nil {
 		{
 			size, err := m.GroupBy.MarshalToSizedBuffer(dAtA[:i])
@@ -9390,6 +10724,56 @@ func (m *UpdateStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VaultTransit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultTransit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultTransit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenSecret != nil {
+		{
+			size, err := m.TokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.KeyName)
+	copy(dAtA[i:], m.KeyName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.MountPath)
+	copy(dAtA[i:], m.MountPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MountPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Vertex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9631,6 +11015,18 @@ func (m *VertexSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	{
 		size, err := m.Lifecycle.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10196,6 +11592,33 @@ func (m *Blackhole) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.SequenceValidation != nil {
+		l = m.SequenceValidation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *BufferRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAge != nil {
+		l = m.MaxAge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxMessages != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxMessages))
+	}
+	if m.MaxBytes != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxBytes))
+	}
+	if m.Discard != nil {
+		l = len(*m.Discard)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10213,6 +11636,10 @@ func (m *BufferServiceConfig) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10387,6 +11814,14 @@ func (m *Edge) Size() (n int) {
 		l = len(*m.OnFull)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.BufferConfig != nil {
+		l = len(*m.BufferConfig)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10475,6 +11910,35 @@ func (m *GSSAPI) Size() (n int) {
 	return n
 }
 
+func (m *GeneratorRateStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.RPU))
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *GeneratorRuntimeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRPU != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxRPU))
+	}
+	if m.MaxKeyCount != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxKeyCount))
+	}
+	return n
+}
+
 func (m *GeneratorSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10505,6 +11969,94 @@ func (m *GeneratorSource) Size() (n int) {
 		l = len(*m.ValueBlob)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.EmitEvery != nil {
+		n += 1 + sovGenerated(uint64(*m.EmitEvery))
+	}
+	if m.TombstonePercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.TombstonePercentage))
+	}
+	if m.OnInvalidEventTime != nil {
+		l = len(*m.OnInvalidEventTime)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ValueTemplate != nil {
+		l = len(*m.ValueTemplate)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RampUp != nil {
+		l = m.RampUp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateJitterPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.RateJitterPercentage))
+	}
+	if m.EventTimeField != nil {
+		l = len(*m.EventTimeField)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.EventTimeUnit != nil {
+		l = len(*m.EventTimeUnit)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.IdleThreshold != nil {
+		l = m.IdleThreshold.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.RateSchedule) > 0 {
+		for _, e := range m.RateSchedule {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LatePercentage != nil {
+		n += 2 + sovGenerated(uint64(*m.LatePercentage))
+	}
+	if m.LateBy != nil {
+		l = m.LateBy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.KeyDistribution != nil {
+		l = len(*m.KeyDistribution)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ZipfExponent != nil {
+		l = len(*m.ZipfExponent)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.HotKeyPercentage != nil {
+		n += 2 + sovGenerated(uint64(*m.HotKeyPercentage))
+	}
+	if m.PayloadFormat != nil {
+		l = len(*m.PayloadFormat)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.PayloadSchema != nil {
+		l = len(*m.PayloadSchema)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.PayloadMessage != nil {
+		l = len(*m.PayloadMessage)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.DuplicatePercentage != nil {
+		n += 2 + sovGenerated(uint64(*m.DuplicatePercentage))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.FutureJitter != nil {
+		l = m.FutureJitter.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.RuntimeUpdate != nil {
+		l = m.RuntimeUpdate.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10783,6 +12335,39 @@ func (m *HTTPSource) Size() (n int) {
 	return n
 }
 
+func (m *ISBEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PrimaryKeyID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Vault != nil {
+		l = m.Vault.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ISBPipelineQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBytes != nil {
+		l = m.MaxBytes.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxStreams != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxStreams))
+	}
+	return n
+}
+
 func (m *IdleSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10850,6 +12435,10 @@ func (m *InterStepBufferServiceSpec) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10918,6 +12507,10 @@ func (m *JetStreamBufferService) Size() (n int) {
 	}
 	n += 2
 	n += 2
+	if m.PipelineQuota != nil {
+		l = m.PipelineQuota.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10936,6 +12529,14 @@ func (m *JetStreamConfig) Size() (n int) {
 	l = len(m.StreamConfig)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PipelineQuota != nil {
+		l = m.PipelineQuota.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10981,6 +12582,66 @@ func (m *JobTemplate) Size() (n int) {
 	return n
 }
 
+func (m *KafkaBufferService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.External != nil {
+		l = m.External.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *KafkaConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Config)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *KafkaSASLAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mechanism != nil {
+		l = len(*m.Mechanism)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *KafkaSink) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11066,6 +12727,10 @@ func (m *Log) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.SequenceValidation != nil {
+		l = m.SequenceValidation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11358,6 +13023,17 @@ func (m *PBQStorage) Size() (n int) {
 	return n
 }
 
+func (m *PassThrough) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TagsHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *PersistenceStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11471,6 +13147,10 @@ func (m *PipelineSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11609,6 +13289,10 @@ func (m *RedisConfig) Size() (n int) {
 		l = m.SentinelPassword.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11629,6 +13313,49 @@ func (m *RedisSettings) Size() (n int) {
 	return n
 }
 
+func (m *ReplaySource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigMap != nil {
+		l = m.ConfigMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Path != nil {
+		l = len(*m.Path)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.VolumeMounts) > 0 {
+		for _, e := range m.VolumeMounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Format != nil {
+		l = len(*m.Format)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.EventTimeField != nil {
+		l = len(*m.EventTimeField)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.EventTimeUnit != nil {
+		l = len(*m.EventTimeUnit)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KeyField != nil {
+		l = len(*m.KeyField)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Speed != nil {
+		l = len(*m.Speed)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11769,6 +13496,21 @@ func (m *Scale) Size() (n int) {
 	if m.ReplicasPerScaleDown != nil {
 		n += 1 + sovGenerated(uint64(*m.ReplicasPerScaleDown))
 	}
+	if m.TargetPendingAgeSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TargetPendingAgeSeconds))
+	}
+	return n
+}
+
+func (m *SequenceValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxViolations != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxViolations))
+	}
 	return n
 }
 
@@ -11897,6 +13639,10 @@ func (m *Sink) Size() (n int) {
 	}
 	l = m.RetryStrategy.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.WriteTimeout != nil {
+		l = m.WriteTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11960,6 +13706,10 @@ func (m *Source) Size() (n int) {
 		l = m.Pulsar.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Replay != nil {
+		l = m.Replay.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12087,6 +13837,10 @@ func (m *UDF) Size() (n int) {
 		l = m.GroupBy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PassThrough != nil {
+		l = m.PassThrough.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12148,6 +13902,25 @@ func (m *UpdateStrategy) Size() (n int) {
 	return n
 }
 
+func (m *VaultTransit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MountPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.KeyName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TokenSecret != nil {
+		l = m.TokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Vertex) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12260,6 +14033,10 @@ func (m *VertexSpec) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Lifecycle.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12496,6 +14273,20 @@ func (this *Blackhole) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Blackhole{`,
+		`SequenceValidation:` + strings.Replace(this.SequenceValidation.String(), "SequenceValidation", "SequenceValidation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BufferRetention) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BufferRetention{`,
+		`MaxAge:` + strings.Replace(fmt.Sprintf("%v", this.MaxAge), "Duration", "v11.Duration", 1) + `,`,
+		`MaxMessages:` + valueToStringGenerated(this.MaxMessages) + `,`,
+		`MaxBytes:` + valueToStringGenerated(this.MaxBytes) + `,`,
+		`Discard:` + valueToStringGenerated(this.Discard) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12507,6 +14298,7 @@ func (this *BufferServiceConfig) String() string {
 	s := strings.Join([]string{`&BufferServiceConfig{`,
 		`Redis:` + strings.Replace(this.Redis.String(), "RedisConfig", "RedisConfig", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamConfig", "JetStreamConfig", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaConfig", "KafkaConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12616,6 +14408,8 @@ func (this *Edge) String() string {
 		`To:` + fmt.Sprintf("%v", this.To) + `,`,
 		`Conditions:` + strings.Replace(this.Conditions.String(), "ForwardConditions", "ForwardConditions", 1) + `,`,
 		`OnFull:` + valueToStringGenerated(this.OnFull) + `,`,
+		`BufferConfig:` + valueToStringGenerated(this.BufferConfig) + `,`,
+		`Retention:` + strings.Replace(this.Retention.String(), "BufferRetention", "BufferRetention", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12679,10 +14473,47 @@ func (this *GSSAPI) String() string {
 	}, "")
 	return s
 }
+func (this *GeneratorRateStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeneratorRateStep{`,
+		`RPU:` + fmt.Sprintf("%v", this.RPU) + `,`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeneratorRuntimeUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeneratorRuntimeUpdate{`,
+		`MaxRPU:` + valueToStringGenerated(this.MaxRPU) + `,`,
+		`MaxKeyCount:` + valueToStringGenerated(this.MaxKeyCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GeneratorSource) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRateSchedule := "[]GeneratorRateStep{"
+	for _, f := range this.RateSchedule {
+		repeatedStringForRateSchedule += strings.Replace(strings.Replace(f.String(), "GeneratorRateStep", "GeneratorRateStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRateSchedule += "}"
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
 	s := strings.Join([]string{`&GeneratorSource{`,
 		`RPU:` + valueToStringGenerated(this.RPU) + `,`,
 		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v11.Duration", 1) + `,`,
@@ -12691,6 +14522,28 @@ func (this *GeneratorSource) String() string {
 		`Value:` + valueToStringGenerated(this.Value) + `,`,
 		`Jitter:` + strings.Replace(fmt.Sprintf("%v", this.Jitter), "Duration", "v11.Duration", 1) + `,`,
 		`ValueBlob:` + valueToStringGenerated(this.ValueBlob) + `,`,
+		`EmitEvery:` + valueToStringGenerated(this.EmitEvery) + `,`,
+		`TombstonePercentage:` + valueToStringGenerated(this.TombstonePercentage) + `,`,
+		`OnInvalidEventTime:` + valueToStringGenerated(this.OnInvalidEventTime) + `,`,
+		`ValueTemplate:` + valueToStringGenerated(this.ValueTemplate) + `,`,
+		`RampUp:` + strings.Replace(fmt.Sprintf("%v", this.RampUp), "Duration", "v11.Duration", 1) + `,`,
+		`RateJitterPercentage:` + valueToStringGenerated(this.RateJitterPercentage) + `,`,
+		`EventTimeField:` + valueToStringGenerated(this.EventTimeField) + `,`,
+		`EventTimeUnit:` + valueToStringGenerated(this.EventTimeUnit) + `,`,
+		`IdleThreshold:` + strings.Replace(fmt.Sprintf("%v", this.IdleThreshold), "Duration", "v11.Duration", 1) + `,`,
+		`RateSchedule:` + repeatedStringForRateSchedule + `,`,
+		`LatePercentage:` + valueToStringGenerated(this.LatePercentage) + `,`,
+		`LateBy:` + strings.Replace(fmt.Sprintf("%v", this.LateBy), "Duration", "v11.Duration", 1) + `,`,
+		`KeyDistribution:` + valueToStringGenerated(this.KeyDistribution) + `,`,
+		`ZipfExponent:` + valueToStringGenerated(this.ZipfExponent) + `,`,
+		`HotKeyPercentage:` + valueToStringGenerated(this.HotKeyPercentage) + `,`,
+		`PayloadFormat:` + valueToStringGenerated(this.PayloadFormat) + `,`,
+		`PayloadSchema:` + valueToStringGenerated(this.PayloadSchema) + `,`,
+		`PayloadMessage:` + valueToStringGenerated(this.PayloadMessage) + `,`,
+		`DuplicatePercentage:` + valueToStringGenerated(this.DuplicatePercentage) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`FutureJitter:` + strings.Replace(fmt.Sprintf("%v", this.FutureJitter), "Duration", "v11.Duration", 1) + `,`,
+		`RuntimeUpdate:` + strings.Replace(this.RuntimeUpdate.String(), "GeneratorRuntimeUpdate", "GeneratorRuntimeUpdate", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12930,6 +14783,29 @@ func (this *HTTPSource) String() string {
 	}, "")
 	return s
 }
+func (this *ISBEncryption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ISBEncryption{`,
+		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
+		`PrimaryKeyID:` + fmt.Sprintf("%v", this.PrimaryKeyID) + `,`,
+		`Vault:` + strings.Replace(this.Vault.String(), "VaultTransit", "VaultTransit", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ISBPipelineQuota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ISBPipelineQuota{`,
+		`MaxBytes:` + strings.Replace(fmt.Sprintf("%v", this.MaxBytes), "Quantity", "resource.Quantity", 1) + `,`,
+		`MaxStreams:` + valueToStringGenerated(this.MaxStreams) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *IdleSource) String() string {
 	if this == nil {
 		return "nil"
@@ -12977,6 +14853,7 @@ func (this *InterStepBufferServiceSpec) String() string {
 	s := strings.Join([]string{`&InterStepBufferServiceSpec{`,
 		`Redis:` + strings.Replace(this.Redis.String(), "RedisBufferService", "RedisBufferService", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamBufferService", "JetStreamBufferService", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaBufferService", "KafkaBufferService", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13013,6 +14890,7 @@ func (this *JetStreamBufferService) String() string {
 		`BufferConfig:` + valueToStringGenerated(this.BufferConfig) + `,`,
 		`Encryption:` + fmt.Sprintf("%v", this.Encryption) + `,`,
 		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`PipelineQuota:` + strings.Replace(this.PipelineQuota.String(), "ISBPipelineQuota", "ISBPipelineQuota", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13026,6 +14904,8 @@ func (this *JetStreamConfig) String() string {
 		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
 		`StreamConfig:` + fmt.Sprintf("%v", this.StreamConfig) + `,`,
 		`TLSEnabled:` + fmt.Sprintf("%v", this.TLSEnabled) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`PipelineQuota:` + strings.Replace(this.PipelineQuota.String(), "ISBPipelineQuota", "ISBPipelineQuota", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13056,6 +14936,42 @@ func (this *JobTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *KafkaBufferService) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaBufferService{`,
+		`External:` + strings.Replace(this.External.String(), "KafkaConfig", "KafkaConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaConfig{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`TLSEnabled:` + fmt.Sprintf("%v", this.TLSEnabled) + `,`,
+		`SASL:` + strings.Replace(this.SASL.String(), "KafkaSASLAuth", "KafkaSASLAuth", 1) + `,`,
+		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaSASLAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaSASLAuth{`,
+		`Mechanism:` + valueToStringGenerated(this.Mechanism) + `,`,
+		`User:` + strings.Replace(fmt.Sprintf("%v", this.User), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KafkaSink) String() string {
 	if this == nil {
 		return "nil"
@@ -13105,6 +15021,7 @@ func (this *Log) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Log{`,
+		`SequenceValidation:` + strings.Replace(this.SequenceValidation.String(), "SequenceValidation", "SequenceValidation", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13315,6 +15232,16 @@ func (this *PBQStorage) String() string {
 	}, "")
 	return s
 }
+func (this *PassThrough) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PassThrough{`,
+		`TagsHeader:` + fmt.Sprintf("%v", this.TagsHeader) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PersistenceStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -13396,6 +15323,7 @@ func (this *PipelineSpec) String() string {
 		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
 		`Templates:` + strings.Replace(this.Templates.String(), "Templates", "Templates", 1) + `,`,
 		`SideInputs:` + repeatedStringForSideInputs + `,`,
+		`Encryption:` + strings.Replace(this.Encryption.String(), "ISBEncryption", "ISBEncryption", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13482,6 +15410,7 @@ func (this *RedisConfig) String() string {
 		`User:` + fmt.Sprintf("%v", this.User) + `,`,
 		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`SentinelPassword:` + strings.Replace(fmt.Sprintf("%v", this.SentinelPassword), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13499,6 +15428,28 @@ func (this *RedisSettings) String() string {
 	}, "")
 	return s
 }
+func (this *ReplaySource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVolumeMounts := "[]VolumeMount{"
+	for _, f := range this.VolumeMounts {
+		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeMounts += "}"
+	s := strings.Join([]string{`&ReplaySource{`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Path:` + valueToStringGenerated(this.Path) + `,`,
+		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
+		`Format:` + valueToStringGenerated(this.Format) + `,`,
+		`EventTimeField:` + valueToStringGenerated(this.EventTimeField) + `,`,
+		`EventTimeUnit:` + valueToStringGenerated(this.EventTimeUnit) + `,`,
+		`KeyField:` + valueToStringGenerated(this.KeyField) + `,`,
+		`Speed:` + valueToStringGenerated(this.Speed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -13576,6 +15527,17 @@ func (this *Scale) String() string {
 		`ScaleDownCooldownSeconds:` + valueToStringGenerated(this.ScaleDownCooldownSeconds) + `,`,
 		`ReplicasPerScaleUp:` + valueToStringGenerated(this.ReplicasPerScaleUp) + `,`,
 		`ReplicasPerScaleDown:` + valueToStringGenerated(this.ReplicasPerScaleDown) + `,`,
+		`TargetPendingAgeSeconds:` + valueToStringGenerated(this.TargetPendingAgeSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SequenceValidation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SequenceValidation{`,
+		`MaxViolations:` + valueToStringGenerated(this.MaxViolations) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13663,6 +15625,7 @@ func (this *Sink) String() string {
 		`AbstractSink:` + strings.Replace(strings.Replace(this.AbstractSink.String(), "AbstractSink", "AbstractSink", 1), `&`, ``, 1) + `,`,
 		`Fallback:` + strings.Replace(this.Fallback.String(), "AbstractSink", "AbstractSink", 1) + `,`,
 		`RetryStrategy:` + strings.Replace(strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1), `&`, ``, 1) + `,`,
+		`WriteTimeout:` + strings.Replace(fmt.Sprintf("%v", this.WriteTimeout), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13693,6 +15656,7 @@ func (this *Source) String() string {
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamSource", "JetStreamSource", 1) + `,`,
 		`Serving:` + strings.Replace(this.Serving.String(), "ServingSource", "ServingSource", 1) + `,`,
 		`Pulsar:` + strings.Replace(this.Pulsar.String(), "PulsarSource", "PulsarSource", 1) + `,`,
+		`Replay:` + strings.Replace(this.Replay.String(), "ReplaySource", "ReplaySource", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13779,6 +15743,7 @@ func (this *UDF) String() string {
 		`Container:` + strings.Replace(this.Container.String(), "Container", "Container", 1) + `,`,
 		`Builtin:` + strings.Replace(this.Builtin.String(), "Function", "Function", 1) + `,`,
 		`GroupBy:` + strings.Replace(this.GroupBy.String(), "GroupBy", "GroupBy", 1) + `,`,
+		`PassThrough:` + strings.Replace(this.PassThrough.String(), "PassThrough", "PassThrough", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13825,6 +15790,19 @@ func (this *UpdateStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *VaultTransit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VaultTransit{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`MountPath:` + fmt.Sprintf("%v", this.MountPath) + `,`,
+		`KeyName:` + fmt.Sprintf("%v", this.KeyName) + `,`,
+		`TokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.TokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Vertex) String() string {
 	if this == nil {
 		return "nil"
@@ -13911,6 +15889,7 @@ func (this *VertexSpec) String() string {
 		`ToEdges:` + repeatedStringForToEdges + `,`,
 		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
 		`Lifecycle:` + strings.Replace(strings.Replace(this.Lifecycle.String(), "VertexLifecycle", "VertexLifecycle", 1), `&`, ``, 1) + `,`,
+		`Encryption:` + strings.Replace(this.Encryption.String(), "ISBEncryption", "ISBEncryption", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15699,6 +17678,42 @@ func (m *Blackhole) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Blackhole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceValidation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SequenceValidation == nil {
+				m.SequenceValidation = &SequenceValidation{}
+			}
+			if err := m.SequenceValidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15720,7 +17735,7 @@ func (m *Blackhole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
+func (m *BufferRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15743,15 +17758,15 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BufferServiceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: BufferRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BufferServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BufferRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15778,18 +17793,58 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = &RedisConfig{}
+			if m.MaxAge == nil {
+				m.MaxAge = &v11.Duration{}
 			}
-			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxMessages = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxBytes = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discard", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15799,27 +17854,24 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamConfig{}
-			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := BufferDiscardPolicy(dAtA[iNdEx:postIndex])
+			m.Discard = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15842,7 +17894,7 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
+func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15865,15 +17917,15 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CombinedEdge: wiretype end group for non-group")
+			return fmt.Errorf("proto: BufferServiceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CombinedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BufferServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15900,15 +17952,18 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Edge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Redis == nil {
+				m.Redis = &RedisConfig{}
+			}
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15918,29 +17973,33 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromVertexType = VertexType(dAtA[iNdEx:postIndex])
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamConfig{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexPartitionCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15950,15 +18009,81 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FromVertexPartitionCount = &v
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaConfig{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CombinedEdge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CombinedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15985,16 +18110,13 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FromVertexLimits == nil {
-				m.FromVertexLimits = &VertexLimits{}
-			}
-			if err := m.FromVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Edge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16022,11 +18144,11 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToVertexType = VertexType(dAtA[iNdEx:postIndex])
+			m.FromVertexType = VertexType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexPartitionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexPartitionCount", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -16043,10 +18165,98 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ToVertexPartitionCount = &v
-		case 7:
+			m.FromVertexPartitionCount = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FromVertexLimits == nil {
+				m.FromVertexLimits = &VertexLimits{}
+			}
+			if err := m.FromVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVertexType = VertexType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexPartitionCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToVertexPartitionCount = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17185,6 +19395,75 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 			s := BufferFullWritingStrategy(dAtA[iNdEx:postIndex])
 			m.OnFull = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BufferConfig = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retention == nil {
+				m.Retention = &BufferRetention{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17930,7 +20209,7 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+func (m *GeneratorRateStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17953,17 +20232,17 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeneratorRateStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeneratorRateStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
 			}
-			var v int64
+			m.RPU = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17973,12 +20252,11 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.RPU |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RPU = &v
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
@@ -18015,11 +20293,61 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorRuntimeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorRuntimeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorRuntimeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRPU", wireType)
 			}
-			var v int32
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18029,15 +20357,15 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MsgSize = &v
-		case 4:
+			m.MaxRPU = &v
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeyCount", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -18054,12 +20382,62 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.KeyCount = &v
-		case 5:
+			m.MaxKeyCount = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
 			}
-			var v uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18069,15 +20447,111 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Value = &v
-		case 6:
+			m.RPU = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &v11.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MsgSize = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyCount = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18144,6 +20618,764 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.ValueBlob = &s
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitEvery", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitEvery = &v
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstonePercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TombstonePercentage = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnInvalidEventTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := InvalidEventTimePolicy(dAtA[iNdEx:postIndex])
+			m.OnInvalidEventTime = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ValueTemplate = &s
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampUp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RampUp == nil {
+				m.RampUp = &v11.Duration{}
+			}
+			if err := m.RampUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateJitterPercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RateJitterPercentage = &v
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.EventTimeField = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := EventTimeUnit(dAtA[iNdEx:postIndex])
+			m.EventTimeUnit = &s
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdleThreshold == nil {
+				m.IdleThreshold = &v11.Duration{}
+			}
+			if err := m.IdleThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateSchedule = append(m.RateSchedule, GeneratorRateStep{})
+			if err := m.RateSchedule[len(m.RateSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatePercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatePercentage = &v
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LateBy == nil {
+				m.LateBy = &v11.Duration{}
+			}
+			if err := m.LateBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyDistribution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := KeyDistribution(dAtA[iNdEx:postIndex])
+			m.KeyDistribution = &s
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZipfExponent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ZipfExponent = &s
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotKeyPercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HotKeyPercentage = &v
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := PayloadFormat(dAtA[iNdEx:postIndex])
+			m.PayloadFormat = &s
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PayloadSchema = &s
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PayloadMessage = &s
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicatePercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DuplicatePercentage = &v
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureJitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FutureJitter == nil {
+				m.FutureJitter = &v11.Duration{}
+			}
+			if err := m.FutureJitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RuntimeUpdate == nil {
+				m.RuntimeUpdate = &GeneratorRuntimeUpdate{}
+			}
+			if err := m.RuntimeUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20823,180 +24055,472 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServingSourceStreamName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PipelineSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupBy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServingSourceStreamName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PipelineSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupBy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Keyed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowedLateness == nil {
+				m.AllowedLateness = &v11.Duration{}
+			}
+			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage == nil {
+				m.Storage = &PBQStorage{}
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Service = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ISBEncryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ISBEncryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ISBEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeyID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Keyed = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21023,46 +24547,10 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowedLateness == nil {
-				m.AllowedLateness = &v11.Duration{}
+			if m.Vault == nil {
+				m.Vault = &VaultTransit{}
 			}
-			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Storage == nil {
-				m.Storage = &PBQStorage{}
-			}
-			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21087,7 +24575,7 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+func (m *ISBPipelineQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21110,15 +24598,15 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ISBPipelineQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ISBPipelineQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21145,18 +24633,18 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &Authorization{}
+			if m.MaxBytes == nil {
+				m.MaxBytes = &resource.Quantity{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreams", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21166,12 +24654,12 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Service = bool(v != 0)
+			m.MaxStreams = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21718,6 +25206,42 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaBufferService{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22366,6 +25890,42 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.TLS = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PipelineQuota == nil {
+				m.PipelineQuota = &ISBPipelineQuota{}
+			}
+			if err := m.PipelineQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22536,6 +26096,78 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.TLSEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PipelineQuota == nil {
+				m.PipelineQuota = &ISBPipelineQuota{}
+			}
+			if err := m.PipelineQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22801,15 +26433,433 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TTLSecondsAfterFinished = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackoffLimit = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaBufferService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaBufferService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.External == nil {
+				m.External = &KafkaConfig{}
+			}
+			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &KafkaSASLAuth{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaSASLAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaSASLAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaSASLAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22819,33 +26869,30 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := SASLType(dAtA[iNdEx:postIndex])
+			m.Mechanism = &s
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22855,17 +26902,33 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TTLSecondsAfterFinished = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &v1.SecretKeySelector{}
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22875,12 +26938,28 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BackoffLimit = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23593,6 +27672,42 @@ func (m *Log) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceValidation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SequenceValidation == nil {
+				m.SequenceValidation = &SequenceValidation{}
+			}
+			if err := m.SequenceValidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26246,6 +30361,88 @@ func (m *PBQStorage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PassThrough) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PassThrough: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PassThrough: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagsHeader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagsHeader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PersistenceStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27111,6 +31308,42 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Encryption == nil {
+				m.Encryption = &ISBEncryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28248,16 +32481,385 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentinelPassword == nil {
+				m.SentinelPassword = &v1.SecretKeySelector{}
+			}
+			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedisSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Master = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replica = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sentinel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplaySource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplaySource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplaySource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Path = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28284,66 +32886,47 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelPassword == nil {
-				m.SentinelPassword = &v1.SecretKeySelector{}
-			}
-			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedisSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedisSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := ReplayFormat(dAtA[iNdEx:postIndex])
+			m.Format = &s
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28371,11 +32954,12 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Redis = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.EventTimeField = &s
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeUnit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28403,11 +32987,12 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Master = string(dAtA[iNdEx:postIndex])
+			s := EventTimeUnit(dAtA[iNdEx:postIndex])
+			m.EventTimeUnit = &s
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28435,11 +33020,12 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replica = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.KeyField = &s
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sentinel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Speed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28467,7 +33053,8 @@ func (m *RedisSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sentinel = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Speed = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29523,6 +34110,96 @@ func (m *Scale) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReplicasPerScaleDown = &v
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPendingAgeSeconds", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetPendingAgeSeconds = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SequenceValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxViolations", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxViolations = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30473,43 +35150,79 @@ func (m *Sink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fallback == nil {
-				m.Fallback = &AbstractSink{}
+			if m.Fallback == nil {
+				m.Fallback = &AbstractSink{}
+			}
+			if err := m.Fallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WriteTimeout == nil {
+				m.WriteTimeout = &v11.Duration{}
 			}
-			if err := m.Fallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WriteTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31029,6 +35742,42 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Replay == nil {
+				m.Replay = &ReplaySource{}
+			}
+			if err := m.Replay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31885,10 +36634,204 @@ func (m *UDF) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UDF: wiretype end group for non-group")
+			return fmt.Errorf("proto: UDF: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UDF: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Builtin == nil {
+				m.Builtin = &Function{}
+			}
+			if err := m.Builtin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupBy == nil {
+				m.GroupBy = &GroupBy{}
+			}
+			if err := m.GroupBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassThrough", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PassThrough == nil {
+				m.PassThrough = &PassThrough{}
+			}
+			if err := m.PassThrough.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UDSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UDSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UDF: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UDSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -31927,78 +36870,6 @@ func (m *UDF) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Builtin == nil {
-				m.Builtin = &Function{}
-			}
-			if err := m.Builtin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GroupBy == nil {
-				m.GroupBy = &GroupBy{}
-			}
-			if err := m.GroupBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32020,7 +36891,7 @@ func (m *UDF) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UDSink) Unmarshal(dAtA []byte) error {
+func (m *UDSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32043,10 +36914,10 @@ func (m *UDSink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UDSink: wiretype end group for non-group")
+			return fmt.Errorf("proto: UDSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UDSink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UDSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32106,7 +36977,7 @@ func (m *UDSink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UDSource) Unmarshal(dAtA []byte) error {
+func (m *UDTransformer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32129,10 +37000,10 @@ func (m *UDSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UDSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: UDTransformer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UDSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UDTransformer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32171,6 +37042,42 @@ func (m *UDSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Builtin == nil {
+				m.Builtin = &Transformer{}
+			}
+			if err := m.Builtin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32192,7 +37099,7 @@ func (m *UDSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UDTransformer) Unmarshal(dAtA []byte) error {
+func (m *UpdateStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32215,17 +37122,17 @@ func (m *UDTransformer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UDTransformer: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UDTransformer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32235,31 +37142,27 @@ func (m *UDTransformer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = UpdateStrategyType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builtin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32286,10 +37189,10 @@ func (m *UDTransformer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Builtin == nil {
-				m.Builtin = &Transformer{}
+			if m.RollingUpdate == nil {
+				m.RollingUpdate = &RollingUpdateStrategy{}
 			}
-			if err := m.Builtin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32314,7 +37217,7 @@ func (m *UDTransformer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateStrategy) Unmarshal(dAtA []byte) error {
+func (m *VaultTransit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32337,15 +37240,15 @@ func (m *UpdateStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultTransit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultTransit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32373,11 +37276,75 @@ func (m *UpdateStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = UpdateStrategyType(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollingUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32404,10 +37371,10 @@ func (m *UpdateStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollingUpdate == nil {
-				m.RollingUpdate = &RollingUpdateStrategy{}
+			if m.TokenSecret == nil {
+				m.TokenSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.RollingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -33343,6 +38310,42 @@ func (m *VertexSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Encryption == nil {
+				m.Encryption = &ISBEncryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strconv"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,7 +41,8 @@ type GeneratorSource struct {
 	Value *uint64 `json:"value,omitempty" protobuf:"bytes,5,opt,name=value"`
 	// Jitter is the jitter for the message generation, used to simulate out of order messages
 	// for example if the jitter is 10s, then the message's event time will be delayed by a random
-	// time between 0 and 10s which will result in the message being out of order by 0 to 10s
+	// time between 0 and 10s which will result in the message being out of order by 0 to 10s.
+	// It should not be negative, see FutureJitter to move the event time into the future.
 	// +kubebuilder:default="0s"
 	// +optional
 	Jitter *metav1.Duration `json:"jitter,omitempty" protobuf:"bytes,6,opt,name=jitter"`
@@ -50,4 +53,262 @@ type GeneratorSource struct {
 	// if present, the Value and MsgSize fields will be ignored.
 	// +optional
 	ValueBlob *string `json:"valueBlob,omitempty" protobuf:"bytes,7,opt,name=valueBlob"`
+	// EmitEvery generates the RPU records on every EmitEvery-th time unit instead of every time unit, which allows rates
+	// lower than one record per time unit without changing the tick granularity. For example, rpu 1, duration 1s and
+	// emitEvery 30 generate one record every 30 seconds. Configure the idle source watermark to keep the watermark
+	// progressing between the records.
+	// +kubebuilder:default=1
+	// +optional
+	EmitEvery *int32 `json:"emitEvery,omitempty" protobuf:"varint,8,opt,name=emitEvery"`
+	// TombstonePercentage is the percentage of the generated messages emitted with an empty payload, which simulates the
+	// deletion events of the keys. The tombstones keep the keys and the event time of the messages, they are spread
+	// evenly, for example 10 generates a tombstone for every 10th message.
+	// +optional
+	TombstonePercentage *int32 `json:"tombstonePercentage,omitempty" protobuf:"varint,9,opt,name=tombstonePercentage"`
+	// OnInvalidEventTime specifies the behaviour when the event time of a generated message is missing or invalid.
+	// There are currently four options, fallbackToNow, fallbackToIngestionTime, drop and error.
+	// if not provided, the default value is set to "fallbackToNow"
+	// +kubebuilder:validation:Enum=fallbackToNow;fallbackToIngestionTime;drop;error
+	// +optional
+	OnInvalidEventTime *InvalidEventTimePolicy `json:"onInvalidEventTime,omitempty" protobuf:"bytes,10,opt,name=onInvalidEventTime"`
+	// ValueTemplate is an optional Go template of the payload to send, which is rendered for every generated message.
+	// The template can refer to {{.Timestamp}}, the generation time in nanoseconds, {{.Sequence}}, the per-key sequence
+	// number, and {{.RandString n}}, a random alphanumeric string of length n. The functions uuid, randInt min max and
+	// now return a random UUID, a random integer between min and max inclusive, and the current time. The functions
+	// sine period amplitude offset, sawtooth period min max and randomWalk start step return the values of a synthetic
+	// metric following a sine wave, a sawtooth wave or a random walk over time. If the rendered payload is a JSON
+	// object with a Createdts field in nanoseconds, it is used as the event time, otherwise the generation time is.
+	// If present, the Value and MsgSize fields will be ignored. It can not be set together with ValueBlob.
+	// +optional
+	ValueTemplate *string `json:"valueTemplate,omitempty" protobuf:"bytes,11,opt,name=valueTemplate"`
+	// RampUp linearly increases the number of the records generated on every time unit from 0 to RPU over the given
+	// duration after the generator starts, which is useful to test the autoscaling of the pipeline.
+	// +optional
+	RampUp *metav1.Duration `json:"rampUp,omitempty" protobuf:"bytes,12,opt,name=rampUp"`
+	// RateJitterPercentage randomizes the number of the records generated on every time unit within the given percentage
+	// of RPU, for example 20 generates between 80% and 120% of RPU on every time unit. It is applied on top of RampUp.
+	// It should be between 0 and 100.
+	// +optional
+	RateJitterPercentage *int32 `json:"rateJitterPercentage,omitempty" protobuf:"varint,13,opt,name=rateJitterPercentage"`
+	// EventTimeField is the field of the JSON payload the event time is read from, the nested fields are separated by
+	// dots, e.g. meta.ts. If the field is missing or can not be parsed, OnInvalidEventTime is applied. If not set, the
+	// event time is the generation time, or the Createdts field of the payload rendered from ValueTemplate.
+	// +optional
+	EventTimeField *string `json:"eventTimeField,omitempty" protobuf:"bytes,14,opt,name=eventTimeField"`
+	// EventTimeUnit is the unit of the EventTimeField, one of nanos, millis, seconds and RFC3339.
+	// if not provided, the default value is set to "nanos"
+	// +kubebuilder:validation:Enum=nanos;millis;seconds;RFC3339
+	// +optional
+	EventTimeUnit *EventTimeUnit `json:"eventTimeUnit,omitempty" protobuf:"bytes,15,opt,name=eventTimeUnit"`
+	// IdleThreshold is the duration without any generated record after which the generator is marked as idle, and an
+	// idle watermark of the current time minus the max delay of the watermark is published, so that the watermark keeps
+	// progressing and the windows of the downstream reduce vertices are closed while the generator has no data.
+	// It is ignored if the idle source watermark of the pipeline is configured. The idle watermark is not published
+	// if it is not set.
+	// +optional
+	IdleThreshold *metav1.Duration `json:"idleThreshold,omitempty" protobuf:"bytes,16,opt,name=idleThreshold"`
+	// RateSchedule changes the number of the records generated on every time unit over time after the generator starts,
+	// e.g. from 100 to 10000 over 10 minutes, then hold for 30 minutes, then back to 100 over 10 minutes. It starts from
+	// RPU, and the RPU of the last step is held after the schedule. It can not be set together with RampUp, and
+	// RateJitterPercentage is applied on top of it.
+	// +optional
+	RateSchedule []GeneratorRateStep `json:"rateSchedule,omitempty" protobuf:"bytes,17,rep,name=rateSchedule"`
+	// LatePercentage is the percentage of the generated messages whose event time is moved back by LateBy, which
+	// simulates the out of order and late data. The late messages are spread evenly, for example 5 moves every 20th message
+	// back, and they do not hold the source watermark back, so they are behind the watermark unless LateBy is within the max
+	// delay of the watermark. It should be between 0 and 100.
+	// +optional
+	LatePercentage *int32 `json:"latePercentage,omitempty" protobuf:"varint,18,opt,name=latePercentage"`
+	// LateBy is the duration the event time of the late messages is moved back by, for example 2m. It should be greater
+	// than 0 if LatePercentage is set.
+	// +optional
+	LateBy *metav1.Duration `json:"lateBy,omitempty" protobuf:"bytes,19,opt,name=lateBy"`
+	// KeyDistribution is the distribution the keys of the generated messages are drawn from, one of roundRobin, uniform,
+	// zipf and hotKey. roundRobin generates RPU messages for every one of the KeyCount keys on every time unit, the others
+	// generate the same number of messages but draw the key of every message: uniform with the same probability for all
+	// the keys, zipf with the probability of the i-th key proportional to 1/i^ZipfExponent, and hotKey with
+	// HotKeyPercentage of the messages on the first key and the others spread uniformly on the other keys.
+	// if not provided, the default value is set to "roundRobin"
+	// +kubebuilder:validation:Enum=roundRobin;uniform;zipf;hotKey
+	// +optional
+	KeyDistribution *KeyDistribution `json:"keyDistribution,omitempty" protobuf:"bytes,20,opt,name=keyDistribution"`
+	// ZipfExponent is the exponent of the zipf key distribution, a decimal greater than 1, the larger it is, the more the
+	// messages are skewed to the first keys. if not provided, the default value is set to "1.1"
+	// +optional
+	ZipfExponent *string `json:"zipfExponent,omitempty" protobuf:"bytes,21,opt,name=zipfExponent"`
+	// HotKeyPercentage is the percentage of the messages generated with the first key in the hotKey key distribution.
+	// It should be between 0 and 100. if not provided, the default value is set to 50
+	// +optional
+	HotKeyPercentage *int32 `json:"hotKeyPercentage,omitempty" protobuf:"varint,22,opt,name=hotKeyPercentage"`
+	// PayloadFormat is the encoding of the generated payloads, one of json, protobuf, avro and raw-bytes. json generates
+	// the JSON payloads, or the ValueBlob or ValueTemplate ones. protobuf and avro generate a message of the PayloadSchema:
+	// an integer field named createdts is the generation time in nanoseconds, an integer field named seq is the per-key
+	// sequence number, a bytes field has MsgSize random bytes and the other fields are random. raw-bytes generates MsgSize
+	// random bytes. if not provided, the default value is set to "json"
+	// +kubebuilder:validation:Enum=json;protobuf;avro;raw-bytes
+	// +optional
+	PayloadFormat *PayloadFormat `json:"payloadFormat,omitempty" protobuf:"bytes,23,opt,name=payloadFormat"`
+	// PayloadSchema is the schema of the payloads in the protobuf and avro formats. It is the JSON of an Avro schema for
+	// avro, the payloads are the binary encoding of the Avro datum without the schema. It is the base64 encoding of a
+	// serialized protobuf FileDescriptorSet including the imports, e.g. generated by protoc --include_imports
+	// --descriptor_set_out, for protobuf.
+	// +optional
+	PayloadSchema *string `json:"payloadSchema,omitempty" protobuf:"bytes,24,opt,name=payloadSchema"`
+	// PayloadMessage is the full name of the protobuf message of the payloads in the PayloadSchema, e.g. example.v1.Order.
+	// It is required for the protobuf format.
+	// +optional
+	PayloadMessage *string `json:"payloadMessage,omitempty" protobuf:"bytes,25,opt,name=payloadMessage"`
+	// DuplicatePercentage is the percentage of the generated messages emitted again right after them, with the same
+	// offsets, i.e. the same message IDs, payloads, keys and event times, which simulates the duplicates of an at-least-once
+	// upstream to validate the deduplication and the exactly-once forwarding end to end. The duplicates are spread evenly,
+	// for example 10 emits every 10th message twice. It should be between 0 and 100.
+	// +optional
+	DuplicatePercentage *int32 `json:"duplicatePercentage,omitempty" protobuf:"varint,26,opt,name=duplicatePercentage"`
+	// Headers are the headers of the generated messages, which can be used to test the header based routing and the
+	// propagation of the headers by the UDFs. The values are Go templates rendered for every message with the data and the
+	// functions of ValueTemplate, e.g. {{uuid}}, a value without any action is static.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,27,rep,name=headers"`
+	// FutureJitter moves the event time of the messages into the future by a random time between 0 and FutureJitter,
+	// which simulates the upstream clock issues, e.g. to test the detection of the future event times. Like Jitter, it's
+	// applied in whole seconds, and it should not be negative. Both can be set, the event time is then moved by a random
+	// time between -Jitter and FutureJitter.
+	// +optional
+	FutureJitter *metav1.Duration `json:"futureJitter,omitempty" protobuf:"bytes,28,opt,name=futureJitter"`
+	// RuntimeUpdate enables the changes of the rpu, msgSize and keyCount of the running generator through the daemon
+	// service of the pipeline, within the maxima it sets. The changes are rejected if it's not set.
+	// +optional
+	RuntimeUpdate *GeneratorRuntimeUpdate `json:"runtimeUpdate,omitempty" protobuf:"bytes,29,opt,name=runtimeUpdate"`
+}
+
+// GeneratorRuntimeUpdate is the limits of the changes of a running generator source. The pending messages are sized
+// for the maxima when the generator starts.
+type GeneratorRuntimeUpdate struct {
+	// MaxRPU is the highest rpu the generator can be changed to, it defaults to the rpu of the generator.
+	// +optional
+	MaxRPU *int64 `json:"maxRpu,omitempty" protobuf:"varint,1,opt,name=maxRpu"`
+	// MaxKeyCount is the highest keyCount the generator can be changed to, it defaults to the keyCount of the
+	// generator.
+	// +optional
+	MaxKeyCount *int32 `json:"maxKeyCount,omitempty" protobuf:"varint,2,opt,name=maxKeyCount"`
 }
+
+// GeneratorRateStep is a step of the rate schedule of a generator source.
+type GeneratorRateStep struct {
+	// RPU is the number of the records generated on every time unit at the end of the step.
+	RPU int64 `json:"rpu" protobuf:"varint,1,opt,name=rpu"`
+	// Duration is the duration of the step, over which the number of the records generated on every time unit changes
+	// linearly from the RPU of the previous step, or the RPU of the generator for the first step, to the RPU of the
+	// step. A step with an unchanged RPU holds the rate.
+	Duration *metav1.Duration `json:"duration" protobuf:"bytes,2,opt,name=duration"`
+}
+
+// GetOnInvalidEventTime returns the policy applied when the event time of a generated message is missing or invalid.
+func (g GeneratorSource) GetOnInvalidEventTime() InvalidEventTimePolicy {
+	if g.OnInvalidEventTime == nil {
+		return InvalidEventTimeFallbackToNow
+	}
+	switch *g.OnInvalidEventTime {
+	case InvalidEventTimeFallbackToNow, InvalidEventTimeFallbackToIngestionTime, InvalidEventTimeDrop, InvalidEventTimeError:
+		return *g.OnInvalidEventTime
+	default:
+		return InvalidEventTimeFallbackToNow
+	}
+}
+
+// GetEventTimeUnit returns the unit of the event time field.
+func (g GeneratorSource) GetEventTimeUnit() EventTimeUnit {
+	if g.EventTimeUnit == nil {
+		return EventTimeUnitNanos
+	}
+	return *g.EventTimeUnit
+}
+
+// GetKeyDistribution returns the distribution the keys of the generated messages are drawn from.
+func (g GeneratorSource) GetKeyDistribution() KeyDistribution {
+	if g.KeyDistribution == nil {
+		return KeyDistributionRoundRobin
+	}
+	return *g.KeyDistribution
+}
+
+// GetZipfExponent returns the exponent of the zipf key distribution.
+func (g GeneratorSource) GetZipfExponent() (float64, error) {
+	if g.ZipfExponent == nil {
+		return 1.1, nil
+	}
+	return strconv.ParseFloat(*g.ZipfExponent, 64)
+}
+
+// GetHotKeyPercentage returns the percentage of the messages generated with the first key in the hotKey key
+// distribution.
+func (g GeneratorSource) GetHotKeyPercentage() int32 {
+	if g.HotKeyPercentage == nil {
+		return 50
+	}
+	return *g.HotKeyPercentage
+}
+
+// KeyDistribution is the distribution the keys of the generated messages are drawn from.
+type KeyDistribution string
+
+const (
+	// KeyDistributionRoundRobin generates the messages for every key in turn.
+	KeyDistributionRoundRobin KeyDistribution = "roundRobin"
+	// KeyDistributionUniform draws the key of every message with the same probability for all the keys.
+	KeyDistributionUniform KeyDistribution = "uniform"
+	// KeyDistributionZipf draws the key of every message from a zipf distribution, the first keys are the most frequent.
+	KeyDistributionZipf KeyDistribution = "zipf"
+	// KeyDistributionHotKey draws the first key for a percentage of the messages, and the other keys uniformly.
+	KeyDistributionHotKey KeyDistribution = "hotKey"
+)
+
+// GetPayloadFormat returns the encoding of the generated payloads.
+func (g GeneratorSource) GetPayloadFormat() PayloadFormat {
+	if g.PayloadFormat == nil {
+		return PayloadFormatJSON
+	}
+	return *g.PayloadFormat
+}
+
+// PayloadFormat is the encoding of the payloads generated by a generator source.
+type PayloadFormat string
+
+const (
+	// PayloadFormatJSON generates the JSON payloads.
+	PayloadFormatJSON PayloadFormat = "json"
+	// PayloadFormatProtobuf generates the payloads as a protobuf message of the payload schema.
+	PayloadFormatProtobuf PayloadFormat = "protobuf"
+	// PayloadFormatAvro generates the payloads as an Avro datum of the payload schema.
+	PayloadFormatAvro PayloadFormat = "avro"
+	// PayloadFormatRawBytes generates the payloads of random bytes.
+	PayloadFormatRawBytes PayloadFormat = "raw-bytes"
+)
+
+// EventTimeUnit is the unit of an event time read from a payload.
+type EventTimeUnit string
+
+const (
+	// EventTimeUnitNanos is an integer of the nanoseconds since the epoch.
+	EventTimeUnitNanos EventTimeUnit = "nanos"
+	// EventTimeUnitMillis is an integer of the milliseconds since the epoch.
+	EventTimeUnitMillis EventTimeUnit = "millis"
+	// EventTimeUnitSeconds is a number of the seconds since the epoch, it can have a fraction.
+	EventTimeUnitSeconds EventTimeUnit = "seconds"
+	// EventTimeUnitRFC3339 is a string in the RFC3339 format, it can have a fraction of the seconds.
+	EventTimeUnitRFC3339 EventTimeUnit = "RFC3339"
+)
+
+// InvalidEventTimePolicy is the behaviour when the event time of a message is missing or can not be parsed.
+type InvalidEventTimePolicy string
+
+const (
+	// InvalidEventTimeFallbackToNow uses the current time as the event time.
+	InvalidEventTimeFallbackToNow InvalidEventTimePolicy = "fallbackToNow"
+	// InvalidEventTimeFallbackToIngestionTime uses the time the message was read by the source as the event time.
+	InvalidEventTimeFallbackToIngestionTime InvalidEventTimePolicy = "fallbackToIngestionTime"
+	// InvalidEventTimeDrop drops the message.
+	InvalidEventTimeDrop InvalidEventTimePolicy = "drop"
+	// InvalidEventTimeError fails the read batch, the builtin source transformers drop the message as they can not fail.
+	InvalidEventTimeError InvalidEventTimePolicy = "error"
+)
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import corev1 "k8s.io/api/core/v1"
+
+// ISBEncryption enables envelope encryption of the message payloads written to the inter-step buffers.
+type ISBEncryption struct {
+	// SecretName is the name of the secret holding the encryption keys. Each key of the secret is a key ID, and
+	// the value is a base64 encoded 32 bytes AES-256 key.
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
+	// PrimaryKeyID is the ID of the key used to encrypt the messages, the other keys in the secret are only used
+	// to decrypt the messages written before a key rotation.
+	PrimaryKeyID string `json:"primaryKeyID" protobuf:"bytes,2,opt,name=primaryKeyID"`
+	// Vault is the HashiCorp Vault transit secrets engine the keys are wrapped with. When it's set, the values of the
+	// secret are the keys encrypted by Vault, e.g. "vault:v1:...", instead of the plain keys, and the vertex pods ask
+	// Vault to decrypt them when the keys are loaded.
+	// +optional
+	Vault *VaultTransit `json:"vault,omitempty" protobuf:"bytes,3,opt,name=vault"`
+}
+
+// VaultTransit is a key of the HashiCorp Vault transit secrets engine, used as a key encryption key.
+type VaultTransit struct {
+	// Address is the address of the Vault server, e.g. https://vault.vault.svc:8200.
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// MountPath is the path the transit secrets engine is mounted at, defaults to "transit".
+	// +optional
+	MountPath string `json:"mountPath,omitempty" protobuf:"bytes,2,opt,name=mountPath"`
+	// KeyName is the name of the transit key the encryption keys are wrapped with.
+	KeyName string `json:"keyName" protobuf:"bytes,3,opt,name=keyName"`
+	// TokenSecret refers to the secret key holding the Vault token, which should be allowed to decrypt with the
+	// transit key.
+	TokenSecret *corev1.SecretKeySelector `json:"tokenSecret" protobuf:"bytes,4,opt,name=tokenSecret"`
+}
+
+// GetMountPath returns the mount path of the transit secrets engine.
+func (v VaultTransit) GetMountPath() string {
+	if v.MountPath == "" {
+		return "transit"
+	}
+	return v.MountPath
+}
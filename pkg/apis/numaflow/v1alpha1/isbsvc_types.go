@@ -47,6 +47,7 @@ const (
 	ISBSvcTypeUnknown   ISBSvcType = ""
 	ISBSvcTypeRedis     ISBSvcType = "redis"
 	ISBSvcTypeJetStream ISBSvcType = "jetstream"
+	ISBSvcTypeKafka     ISBSvcType = "kafka"
 )
 
 // +genclient
@@ -73,6 +74,8 @@ func (isbs InterStepBufferService) GetType() ISBSvcType {
 		return ISBSvcTypeRedis
 	} else if isbs.Spec.JetStream != nil {
 		return ISBSvcTypeJetStream
+	} else if isbs.Spec.Kafka != nil {
+		return ISBSvcTypeKafka
 	}
 	return ISBSvcTypeUnknown
 }
@@ -89,11 +92,13 @@ type InterStepBufferServiceList struct {
 type InterStepBufferServiceSpec struct {
 	Redis     *RedisBufferService     `json:"redis,omitempty" protobuf:"bytes,1,opt,name=redis"`
 	JetStream *JetStreamBufferService `json:"jetstream,omitempty" protobuf:"bytes,2,opt,name=jetstream"`
+	Kafka     *KafkaBufferService     `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
 }
 
 type BufferServiceConfig struct {
 	Redis     *RedisConfig     `json:"redis,omitempty" protobuf:"bytes,1,opt,name=redis"`
 	JetStream *JetStreamConfig `json:"jetstream,omitempty" protobuf:"bytes,2,opt,name=jetstream"`
+	Kafka     *KafkaConfig     `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
 }
 
 type InterStepBufferServiceStatus struct {
@@ -188,6 +188,15 @@ func TestInterStepBufferService_GetType(t *testing.T) {
 			},
 			want: ISBSvcTypeJetStream,
 		},
+		{
+			name: "Kafka type",
+			isbs: InterStepBufferService{
+				Spec: InterStepBufferServiceSpec{
+					Kafka: &KafkaBufferService{},
+				},
+			},
+			want: ISBSvcTypeKafka,
+		},
 		{
 			name: "Unknown type",
 			isbs: InterStepBufferService{
@@ -22,6 +22,7 @@ import (
 
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -73,6 +74,11 @@ type JetStreamBufferService struct {
 	// Enabling TLS might impact the performance
 	// +optional
 	TLS bool `json:"tls,omitempty" protobuf:"bytes,12,opt,name=tls"`
+	// PipelineQuota is the quota of the streams of each pipeline using the ISB Service, so that a pipeline can not
+	// exhaust the JetStream cluster shared with the other pipelines. It's enforced when the buffers and the buckets of
+	// a pipeline are created.
+	// +optional
+	PipelineQuota *ISBPipelineQuota `json:"pipelineQuota,omitempty" protobuf:"bytes,13,opt,name=pipelineQuota"`
 }
 
 func (j JetStreamBufferService) GetReplicas() int {
@@ -313,4 +319,39 @@ type JetStreamConfig struct {
 	StreamConfig string `json:"streamConfig,omitempty" protobuf:"bytes,3,opt,name=streamConfig"`
 	// TLS enabled or not
 	TLSEnabled bool `json:"tlsEnabled,omitempty" protobuf:"bytes,4,opt,name=tlsEnabled"`
+	// TLS config of the clients when TLS is enabled, e.g. the CA cert to verify the server certificate, the clients
+	// skip the verification if it's not provided.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+	// PipelineQuota is the quota of the streams of each pipeline using the ISB Service.
+	// +optional
+	PipelineQuota *ISBPipelineQuota `json:"pipelineQuota,omitempty" protobuf:"bytes,6,opt,name=pipelineQuota"`
+}
+
+// ISBPipelineQuota is the quota of the streams a pipeline can create in a shared ISB Service.
+type ISBPipelineQuota struct {
+	// MaxBytes is the max sum of the max bytes of the buffers of a pipeline, the buffers of a pipeline with the quota
+	// should have "stream.maxBytes" set in the buffer config.
+	// +optional
+	MaxBytes *apiresource.Quantity `json:"maxBytes,omitempty" protobuf:"bytes,1,opt,name=maxBytes"`
+	// MaxStreams is the max number of the streams of a pipeline, including the streams backing the watermark buckets,
+	// each bucket is backed by 2 streams.
+	// +optional
+	MaxStreams *int32 `json:"maxStreams,omitempty" protobuf:"varint,2,opt,name=maxStreams"`
+}
+
+// GetMaxBytes returns the max bytes of the quota, 0 means no limit.
+func (q ISBPipelineQuota) GetMaxBytes() int64 {
+	if q.MaxBytes == nil {
+		return 0
+	}
+	return q.MaxBytes.Value()
+}
+
+// GetMaxStreams returns the max number of the streams of the quota, 0 means no limit.
+func (q ISBPipelineQuota) GetMaxStreams() int {
+	if q.MaxStreams == nil {
+		return 0
+	}
+	return int(*q.MaxStreams)
 }
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+type KafkaBufferService struct {
+	// External holds an External Kafka config, the buffers are the topics of the Kafka cluster
+	External *KafkaConfig `json:"external,omitempty" protobuf:"bytes,1,opt,name=external"`
+}
+
+type KafkaConfig struct {
+	// Brokers of the Kafka cluster
+	Brokers []string `json:"brokers,omitempty" protobuf:"bytes,1,rep,name=brokers"`
+	// TLS enabled or not, the certificates of the brokers are verified with the system CAs, unless a CA cert is
+	// given in TLS
+	// +optional
+	TLSEnabled bool `json:"tlsEnabled,omitempty" protobuf:"varint,2,opt,name=tlsEnabled"`
+	// SASL user and password to authenticate with
+	// +optional
+	SASL *KafkaSASLAuth `json:"sasl,omitempty" protobuf:"bytes,3,opt,name=sasl"`
+	// Config is the Sarama client config in yaml, plus the "topic" settings of the topics created for the buffers,
+	// i.e. "partitions", "replicationFactor" and the topic configs in "config".
+	// +optional
+	Config string `json:"config,omitempty" protobuf:"bytes,4,opt,name=config"`
+	// TLS config of the clients when TLS is enabled, e.g. the CA cert to verify the broker certificates, and the
+	// client cert and key for mutual TLS.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+}
+
+type KafkaSASLAuth struct {
+	// SASL mechanism to use, one of PLAIN, SCRAM-SHA-256 and SCRAM-SHA-512, defaults to PLAIN
+	// +optional
+	Mechanism *SASLType `json:"mechanism,omitempty" protobuf:"bytes,1,opt,name=mechanism,casttype=SASLType"`
+	// User is the secret selector of the SASL user
+	User *corev1.SecretKeySelector `json:"user,omitempty" protobuf:"bytes,2,opt,name=user"`
+	// Password is the secret selector of the SASL password
+	Password *corev1.SecretKeySelector `json:"password,omitempty" protobuf:"bytes,3,opt,name=password"`
+}
+
+// GetMechanism returns the SASL mechanism, PLAIN if it's not specified.
+func (k KafkaSASLAuth) GetMechanism() SASLType {
+	if k.Mechanism == nil || *k.Mechanism == "" {
+		return SASLTypePlaintext
+	}
+	return *k.Mechanism
+}
@@ -17,4 +17,7 @@ limitations under the License.
 package v1alpha1
 
 type Log struct {
+	// SequenceValidation enables the validation of the per-key ordering of the messages generated by a generator source.
+	// +optional
+	SequenceValidation *SequenceValidation `json:"sequenceValidation,omitempty" protobuf:"bytes,1,opt,name=sequenceValidation"`
 }
@@ -21,11 +21,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -52,6 +54,23 @@ const (
 	PipelineConditionDaemonServiceHealthy      ConditionType = "DaemonServiceHealthy"
 	PipelineConditionSideInputsManagersHealthy ConditionType = "SideInputsManagersHealthy"
 	PipelineConditionVerticesHealthy           ConditionType = "VerticesHealthy"
+	// PipelineConditionBuffersConsistent has the status True when the buffers and buckets in the ISB Service
+	// match the edges of the Pipeline, it's set by the daemon service.
+	PipelineConditionBuffersConsistent ConditionType = "BuffersConsistent"
+	// PipelineConditionConsumerLagSLO has the status False when the error budget of the consumer lag SLO of any buffer
+	// is exhausted, it's only set by the daemon service when the SLOs are configured.
+	PipelineConditionConsumerLagSLO ConditionType = "ConsumerLagSLO"
+	// PipelineConditionStorageQuota has the status False when the storage used by the buffers of the Pipeline exceeds
+	// the quota, it's only set by the daemon service when the quota is configured.
+	PipelineConditionStorageQuota ConditionType = "StorageQuota"
+)
+
+const (
+	// StorageQuotaReasonExceeded is the reason of the StorageQuota condition when the quota is exceeded.
+	StorageQuotaReasonExceeded = "QuotaExceeded"
+	// StorageQuotaReasonSourcesPaused is the reason of the StorageQuota condition when the quota is exceeded and the
+	// source vertices are paused until the usage drops below the resume threshold.
+	StorageQuotaReasonSourcesPaused = "SourcesPaused"
 )
 
 // +genclient
@@ -150,6 +169,61 @@ func (p Pipeline) GetAllBuffers() []string {
 	return r
 }
 
+// GetBufferConfigs returns the buffer configs of the edges, keyed by the names of the buffers the edges write to.
+func (p Pipeline) GetBufferConfigs() map[string]string {
+	r := make(map[string]string)
+	for _, e := range p.Spec.Edges {
+		if e.BufferConfig == nil {
+			continue
+		}
+		if v := p.GetVertex(e.To); v != nil {
+			for _, b := range v.OwnedBufferNames(p.Namespace, p.Name) {
+				r[b] = *e.BufferConfig
+			}
+		}
+	}
+	return r
+}
+
+// GetBufferConfigsArg returns the buffer configs as the value of the --buffer-configs argument of the ISB Service
+// commands, the configs are base64 encoded as they are multi-line YAML.
+func (p Pipeline) GetBufferConfigsArg() string {
+	var r []string
+	for b, conf := range p.GetBufferConfigs() {
+		r = append(r, b+"="+base64.StdEncoding.EncodeToString([]byte(conf)))
+	}
+	sort.Strings(r)
+	return strings.Join(r, ",")
+}
+
+// GetBufferRetentions returns the buffer retentions of the edges, keyed by the names of the buffers the edges write to.
+func (p Pipeline) GetBufferRetentions() map[string]BufferRetention {
+	r := make(map[string]BufferRetention)
+	for _, e := range p.Spec.Edges {
+		if e.Retention == nil {
+			continue
+		}
+		if v := p.GetVertex(e.To); v != nil {
+			for _, b := range v.OwnedBufferNames(p.Namespace, p.Name) {
+				r[b] = *e.Retention
+			}
+		}
+	}
+	return r
+}
+
+// GetBufferRetentionsArg returns the buffer retentions as the value of the --buffer-retentions argument of the ISB
+// Service commands, the retentions are base64 encoded JSON.
+func (p Pipeline) GetBufferRetentionsArg() string {
+	var r []string
+	for b, retention := range p.GetBufferRetentions() {
+		data, _ := json.Marshal(retention)
+		r = append(r, b+"="+base64.StdEncoding.EncodeToString(data))
+	}
+	sort.Strings(r)
+	return strings.Join(r, ",")
+}
+
 func (p Pipeline) GetAllBuckets() []string {
 	r := []string{}
 	for _, e := range p.ListAllEdges() {
@@ -244,6 +318,10 @@ func (p Pipeline) GetSideInputsManagerDeployments(req GetSideInputDeploymentReq)
 	return deployments, nil
 }
 
+// raterStateVolumeName is the name of the volume of the daemon pod persisting the timestamped counts of the rater,
+// it is an emptyDir so that the counts outlive the container restarts, but not the pod.
+const raterStateVolumeName = "rater-state"
+
 func (p Pipeline) GetDaemonDeploymentObj(req GetDaemonDeploymentReq) (*appv1.Deployment, error) {
 	pipelineCopy := &Pipeline{
 		ObjectMeta: metav1.ObjectMeta{
@@ -262,6 +340,7 @@ func (p Pipeline) GetDaemonDeploymentObj(req GetDaemonDeploymentReq) (*appv1.Dep
 		{Name: EnvNamespace, ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
 		{Name: EnvPod, ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
 		{Name: EnvPipelineObject, Value: encodedPipeline},
+		{Name: EnvPipelineUID, Value: string(p.UID)},
 		{Name: EnvGoDebug, Value: os.Getenv(EnvGoDebug)},
 	}
 	envVars = append(envVars, req.Env...)
@@ -273,6 +352,7 @@ func (p Pipeline) GetDaemonDeploymentObj(req GetDaemonDeploymentReq) (*appv1.Dep
 		Resources:       req.DefaultResources,
 		Env:             envVars,
 		Args:            []string{"daemon-server", "--isbsvc-type=" + string(req.ISBSvcType)},
+		VolumeMounts:    []corev1.VolumeMount{{Name: raterStateVolumeName, MountPath: PathDaemonRaterState}},
 	}
 
 	c.ReadinessProbe = &corev1.Probe{
@@ -319,12 +399,23 @@ func (p Pipeline) GetDaemonDeploymentObj(req GetDaemonDeploymentReq) (*appv1.Dep
 			Spec: corev1.PodSpec{
 				Containers:     []corev1.Container{c},
 				InitContainers: []corev1.Container{p.getDaemonPodInitContainer(req)},
+				Volumes: []corev1.Volume{
+					{Name: raterStateVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				},
 			},
 		},
 	}
 	if p.Spec.Templates != nil && p.Spec.Templates.DaemonTemplate != nil {
 		dt := p.Spec.Templates.DaemonTemplate
 		spec.Replicas = dt.Replicas
+		if dt.Replicas != nil && *dt.Replicas > 1 {
+			// the replicas elect a leader running the rater and the background jobs, the others forward the API
+			// requests to the leader at its pod IP
+			spec.Template.Spec.Containers[0].Env = append(spec.Template.Spec.Containers[0].Env,
+				corev1.EnvVar{Name: EnvDaemonLeaderElection, Value: "true"},
+				corev1.EnvVar{Name: EnvPodIP, ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+			)
+		}
 		dt.AbstractPodTemplate.ApplyToPodTemplateSpec(&spec.Template)
 		if dt.ContainerTemplate != nil {
 			dt.ContainerTemplate.ApplyToNumaflowContainers(spec.Template.Spec.Containers)
@@ -345,6 +436,7 @@ func (p Pipeline) GetDaemonDeploymentObj(req GetDaemonDeploymentReq) (*appv1.Dep
 
 func (p Pipeline) getDaemonPodInitContainer(req GetDaemonDeploymentReq) corev1.Container {
 	envVars := []corev1.EnvVar{
+		{Name: EnvPipelineUID, Value: string(p.UID)},
 		{Name: EnvGoDebug, Value: os.Getenv(EnvGoDebug)},
 	}
 	envVars = append(envVars, req.Env...)
@@ -358,6 +450,12 @@ func (p Pipeline) getDaemonPodInitContainer(req GetDaemonDeploymentReq) corev1.C
 	}
 	c.Args = append(c.Args, "--buffers="+strings.Join(p.GetAllBuffers(), ","))
 	c.Args = append(c.Args, "--buckets="+strings.Join(p.GetAllBuckets(), ","))
+	if bufferConfigs := p.GetBufferConfigsArg(); bufferConfigs != "" {
+		c.Args = append(c.Args, "--buffer-configs="+bufferConfigs)
+	}
+	if bufferRetentions := p.GetBufferRetentionsArg(); bufferRetentions != "" {
+		c.Args = append(c.Args, "--buffer-retentions="+bufferRetentions)
+	}
 	if p.Spec.Templates != nil && p.Spec.Templates.DaemonTemplate != nil && p.Spec.Templates.DaemonTemplate.InitContainerTemplate != nil {
 		p.Spec.Templates.DaemonTemplate.InitContainerTemplate.ApplyToContainer(&c)
 	}
@@ -389,6 +487,38 @@ func (p Pipeline) GetDaemonServiceObj() *corev1.Service {
 	}
 }
 
+// GetDaemonRoleBindingObj returns the RoleBinding of the service account of the daemon pods to the daemon role, which is
+// a Role with a namespaced installation, otherwise a ClusterRole.
+func (p Pipeline) GetDaemonRoleBindingObj(namespaced bool) *rbacv1.RoleBinding {
+	serviceAccountName := "default"
+	if p.Spec.Templates != nil && p.Spec.Templates.DaemonTemplate != nil && p.Spec.Templates.DaemonTemplate.ServiceAccountName != "" {
+		serviceAccountName = p.Spec.Templates.DaemonTemplate.ServiceAccountName
+	}
+	roleKind := "ClusterRole"
+	if namespaced {
+		roleKind = "Role"
+	}
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: p.Namespace,
+			Name:      p.GetDaemonDeploymentName(),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(p.GetObjectMeta(), PipelineGroupVersionKind),
+			},
+			Labels: map[string]string{
+				KeyPartOf:       Project,
+				KeyManagedBy:    ControllerPipeline,
+				KeyComponent:    ComponentDaemon,
+				KeyPipelineName: p.Name,
+			},
+		},
+		Subjects: []rbacv1.Subject{
+			{Kind: rbacv1.ServiceAccountKind, Name: serviceAccountName, Namespace: p.Namespace},
+		},
+		RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: roleKind, Name: DaemonRoleName},
+	}
+}
+
 // GetPipelineLimits returns the pipeline limits with default values
 func (p Pipeline) GetPipelineLimits() PipelineLimits {
 	defaultReadBatchSize := uint64(DefaultReadBatchSize)
@@ -459,6 +589,18 @@ func (p Pipeline) GetDesiredPhase() PipelinePhase {
 	return PipelinePhaseRunning
 }
 
+// IsBackfilling returns whether the pipeline is in the backfill mode, which relaxes the latency oriented behaviors.
+func (p Pipeline) IsBackfilling() bool {
+	return p.GetAnnotations()[KeyBackfill] == "true"
+}
+
+// IsSourcesPausedByStorageQuota returns whether the daemon requires the source vertices to pause reading, because the
+// storage used by the buffers exceeds the quota.
+func (p Pipeline) IsSourcesPausedByStorageQuota() bool {
+	c := p.Status.GetCondition(PipelineConditionStorageQuota)
+	return c != nil && c.Status == metav1.ConditionFalse && c.Reason == StorageQuotaReasonSourcesPaused
+}
+
 // return PauseGracePeriodSeconds if set
 func (p Pipeline) GetPauseGracePeriodSeconds() int64 {
 	if p.Spec.Lifecycle.PauseGracePeriodSeconds != nil {
@@ -493,6 +635,9 @@ type PipelineSpec struct {
 	// SideInputs defines the Side Inputs of a pipeline.
 	// +optional
 	SideInputs []SideInput `json:"sideInputs,omitempty" protobuf:"bytes,8,rep,name=sideInputs"`
+	// Encryption enables the encryption of the message payloads in the inter-step buffers of the pipeline.
+	// +optional
+	Encryption *ISBEncryption `json:"encryption,omitempty" protobuf:"bytes,9,opt,name=encryption"`
 }
 
 func (pipeline PipelineSpec) GetMatchingVertices(f func(AbstractVertex) bool) map[string]*AbstractVertex {
@@ -754,6 +899,41 @@ func (pls *PipelineStatus) MarkVerticesUnHealthy(reason, message string) {
 	pls.Message = "Degraded: " + message
 }
 
+// MarkBuffersConsistent set the buffers and buckets of the pipeline are consistent with the spec.
+func (pls *PipelineStatus) MarkBuffersConsistent() {
+	pls.MarkTrue(PipelineConditionBuffersConsistent)
+}
+
+// MarkBuffersConsistentWithReason set the buffers and buckets required by the pipeline exist, with the given reason.
+func (pls *PipelineStatus) MarkBuffersConsistentWithReason(reason, message string) {
+	pls.MarkTrueWithReason(PipelineConditionBuffersConsistent, reason, message)
+}
+
+// MarkBuffersInconsistent set some buffers or buckets required by the pipeline are missing.
+func (pls *PipelineStatus) MarkBuffersInconsistent(reason, message string) {
+	pls.MarkFalse(PipelineConditionBuffersConsistent, reason, message)
+}
+
+// MarkConsumerLagSLOMet set the consumer lag SLOs of the buffers are within the error budgets.
+func (pls *PipelineStatus) MarkConsumerLagSLOMet() {
+	pls.MarkTrue(PipelineConditionConsumerLagSLO)
+}
+
+// MarkConsumerLagSLOBreached set the error budget of the consumer lag SLO of some buffers is exhausted.
+func (pls *PipelineStatus) MarkConsumerLagSLOBreached(reason, message string) {
+	pls.MarkFalse(PipelineConditionConsumerLagSLO, reason, message)
+}
+
+// MarkStorageQuotaWithin set the storage used by the buffers of the pipeline is within the quota.
+func (pls *PipelineStatus) MarkStorageQuotaWithin() {
+	pls.MarkTrue(PipelineConditionStorageQuota)
+}
+
+// MarkStorageQuotaExceeded set the storage used by the buffers of the pipeline exceeds the quota.
+func (pls *PipelineStatus) MarkStorageQuotaExceeded(reason, message string) {
+	pls.MarkFalse(PipelineConditionStorageQuota, reason, message)
+}
+
 // MarkPhaseRunning set the Pipeline has been running.
 func (pls *PipelineStatus) MarkPhaseRunning() {
 	pls.SetPhase(PipelinePhaseRunning, "")
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/base64"
 	"testing"
 	"time"
 
@@ -83,6 +84,25 @@ func Test_GetAllBuffers(t *testing.T) {
 	assert.Contains(t, s, testPipeline.Namespace+"-"+testPipeline.Name+"-output-0")
 }
 
+func Test_GetBufferConfigs(t *testing.T) {
+	assert.Empty(t, testPipeline.GetBufferConfigs())
+	assert.Empty(t, testPipeline.GetBufferConfigsArg())
+	pl := testPipeline.DeepCopy()
+	pl.Spec.Edges[1].BufferConfig = ptr.To("stream:\n  maxMsgs: 1000000\n")
+	assert.Equal(t, map[string]string{pl.Namespace + "-" + pl.Name + "-output-0": "stream:\n  maxMsgs: 1000000\n"}, pl.GetBufferConfigs())
+	assert.Equal(t, pl.Namespace+"-"+pl.Name+"-output-0="+base64.StdEncoding.EncodeToString([]byte("stream:\n  maxMsgs: 1000000\n")), pl.GetBufferConfigsArg())
+}
+
+func Test_GetBufferRetentions(t *testing.T) {
+	assert.Empty(t, testPipeline.GetBufferRetentions())
+	assert.Empty(t, testPipeline.GetBufferRetentionsArg())
+	pl := testPipeline.DeepCopy()
+	retention := BufferRetention{MaxMessages: ptr.To[int64](1000), Discard: ptr.To(BufferDiscardOld)}
+	pl.Spec.Edges[1].Retention = &retention
+	assert.Equal(t, map[string]BufferRetention{pl.Namespace + "-" + pl.Name + "-output-0": retention}, pl.GetBufferRetentions())
+	assert.Equal(t, pl.Namespace+"-"+pl.Name+"-output-0="+base64.StdEncoding.EncodeToString([]byte(`{"maxMessages":1000,"discard":"old"}`)), pl.GetBufferRetentionsArg())
+}
+
 func Test_GetVertex(t *testing.T) {
 	v := testPipeline.GetVertex("abc")
 	assert.Nil(t, v)
@@ -110,6 +130,22 @@ func TestGetDaemonSvcObj(t *testing.T) {
 
 }
 
+func TestGetDaemonRoleBindingObj(t *testing.T) {
+	rb := testPipeline.GetDaemonRoleBindingObj(false)
+	assert.Equal(t, testPipeline.GetDaemonDeploymentName(), rb.Name)
+	assert.Equal(t, testPipeline.Namespace, rb.Namespace)
+	assert.Equal(t, "ClusterRole", rb.RoleRef.Kind)
+	assert.Equal(t, DaemonRoleName, rb.RoleRef.Name)
+	assert.Equal(t, "default", rb.Subjects[0].Name)
+	assert.Equal(t, testPipeline.Namespace, rb.Subjects[0].Namespace)
+
+	pl := testPipeline.DeepCopy()
+	pl.Spec.Templates = &Templates{DaemonTemplate: &DaemonTemplate{AbstractPodTemplate: AbstractPodTemplate{ServiceAccountName: "daemon-sa"}}}
+	rb = pl.GetDaemonRoleBindingObj(true)
+	assert.Equal(t, "Role", rb.RoleRef.Kind)
+	assert.Equal(t, "daemon-sa", rb.Subjects[0].Name)
+}
+
 func TestGetDaemonDeploy(t *testing.T) {
 	req := GetDaemonDeploymentReq{
 		ISBSvcType: ISBSvcTypeRedis,
@@ -133,6 +169,10 @@ func TestGetDaemonDeploy(t *testing.T) {
 			envNames = append(envNames, e.Name)
 		}
 		assert.Contains(t, envNames, "test-env")
+		assert.Contains(t, s.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: EnvPipelineUID, Value: string(testPipeline.UID)})
+		assert.Equal(t, []corev1.VolumeMount{{Name: raterStateVolumeName, MountPath: PathDaemonRaterState}}, s.Spec.Template.Spec.Containers[0].VolumeMounts)
+		assert.Equal(t, 1, len(s.Spec.Template.Spec.Volumes))
+		assert.NotNil(t, s.Spec.Template.Spec.Volumes[0].EmptyDir)
 	})
 
 	t.Run("test liveness and readiness probe", func(t *testing.T) {
@@ -147,6 +187,7 @@ func TestGetDaemonDeploy(t *testing.T) {
 		assert.Equal(t, CtrInit, c.Name)
 		assert.Equal(t, req.Image, c.Image)
 		assert.Contains(t, c.Args, "isbsvc-validate")
+		assert.Contains(t, c.Env, corev1.EnvVar{Name: EnvPipelineUID, Value: string(testPipeline.UID)})
 	})
 
 	t.Run("test get deployment obj with pipeline overrides", func(t *testing.T) {
@@ -207,6 +248,7 @@ func TestGetDaemonDeploy(t *testing.T) {
 		assert.Equal(t, s.Spec.Template.Annotations["my-annotation-name"], podAnnotations["my-annotation-name"])
 		assert.NotNil(t, s.Spec.Replicas)
 		assert.Equal(t, *s.Spec.Replicas, replicas)
+		assert.Contains(t, s.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: EnvDaemonLeaderElection, Value: "true"})
 		assert.Equal(t, s.Spec.Template.Spec.NodeSelector["my-node-selector-name"], nodeSelector["my-node-selector-name"])
 		assert.NotNil(t, s.Spec.Template.Spec.Priority)
 		assert.Equal(t, *s.Spec.Template.Spec.Priority, priority)
@@ -323,6 +365,20 @@ func Test_PipelineMarkStatus(t *testing.T) {
 			assert.Equal(t, metav1.ConditionTrue, c.Status)
 		}
 	}
+	s.MarkBuffersInconsistent("reason", "message")
+	assert.Equal(t, metav1.ConditionFalse, s.GetCondition(PipelineConditionBuffersConsistent).Status)
+	assert.Equal(t, "message", s.GetCondition(PipelineConditionBuffersConsistent).Message)
+	assert.False(t, s.IsReady())
+	s.MarkBuffersConsistentWithReason("reason", "message")
+	assert.Equal(t, metav1.ConditionTrue, s.GetCondition(PipelineConditionBuffersConsistent).Status)
+	assert.Equal(t, "reason", s.GetCondition(PipelineConditionBuffersConsistent).Reason)
+	s.MarkBuffersConsistent()
+	assert.Equal(t, metav1.ConditionTrue, s.GetCondition(PipelineConditionBuffersConsistent).Status)
+	s.MarkConsumerLagSLOBreached("ErrorBudgetExhausted", "message")
+	assert.Equal(t, metav1.ConditionFalse, s.GetCondition(PipelineConditionConsumerLagSLO).Status)
+	assert.False(t, s.IsReady())
+	s.MarkConsumerLagSLOMet()
+	assert.Equal(t, metav1.ConditionTrue, s.GetCondition(PipelineConditionConsumerLagSLO).Status)
 
 	assert.True(t, s.IsReady())
 }
@@ -420,6 +476,13 @@ func Test_GetDesiredPhase(t *testing.T) {
 	assert.Equal(t, PipelinePhasePaused, p.GetDesiredPhase())
 }
 
+func Test_IsBackfilling(t *testing.T) {
+	lc := testPipeline.DeepCopy()
+	assert.False(t, lc.IsBackfilling())
+	lc.Annotations = map[string]string{KeyBackfill: "true"}
+	assert.True(t, lc.IsBackfilling())
+}
+
 func Test_GetPauseGracePeriodSeconds(t *testing.T) {
 	p := Pipeline{
 		Spec: PipelineSpec{
@@ -51,6 +51,10 @@ type RedisConfig struct {
 	// Sentinel password secret selector
 	// +optional
 	SentinelPassword *corev1.SecretKeySelector `json:"sentinelPassword,omitempty" protobuf:"bytes,6,opt,name=sentinelPassword"`
+	// TLS config to connect to Redis, e.g. the CA cert to verify the server certificate, and the client cert and key
+	// for mutual TLS.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
 }
 
 type NativeRedis struct {
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ReplaySource replays the records captured in a file, in the order of the file, pacing them by their event times.
+type ReplaySource struct {
+	// ConfigMap is the key of the ConfigMap holding the records, the ConfigMap is mounted in the vertex pods.
+	// One of ConfigMap and Path should be specified.
+	// +optional
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// Path is the path of the file holding the records, e.g. on a volume of the vertex mounted with VolumeMounts.
+	// One of ConfigMap and Path should be specified.
+	// +optional
+	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// VolumeMounts are the volumes of the vertex mounted in the container reading the records.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty" protobuf:"bytes,3,rep,name=volumeMounts"`
+	// Format is the format of the file, one of jsonl and csv. A jsonl file has a JSON record on every line, which is
+	// the payload of the message. A csv file has a header line, the payload of the message is a JSON object of the
+	// columns of the record by their names.
+	// if not provided, it is csv for a file with the .csv extension, and jsonl otherwise.
+	// +kubebuilder:validation:Enum=jsonl;csv
+	// +optional
+	Format *ReplayFormat `json:"format,omitempty" protobuf:"bytes,4,opt,name=format,casttype=ReplayFormat"`
+	// EventTimeField is the field the event time of a record is read from, a dot separated path in a jsonl record or
+	// the name of a column in a csv record. The records are replayed with the gaps of their event times, and the event
+	// times of the messages are the event times of the records. If not provided, the records are replayed as fast as
+	// possible and the event times of the messages are the times they are read.
+	// +optional
+	EventTimeField *string `json:"eventTimeField,omitempty" protobuf:"bytes,5,opt,name=eventTimeField"`
+	// EventTimeUnit is the unit of the EventTimeField, one of nanos, millis, seconds and RFC3339.
+	// if not provided, the default value is set to "RFC3339"
+	// +kubebuilder:validation:Enum=nanos;millis;seconds;RFC3339
+	// +optional
+	EventTimeUnit *EventTimeUnit `json:"eventTimeUnit,omitempty" protobuf:"bytes,6,opt,name=eventTimeUnit,casttype=EventTimeUnit"`
+	// KeyField is the field the key of a record is read from, like the EventTimeField. The messages do not have any
+	// key if it is not provided.
+	// +optional
+	KeyField *string `json:"keyField,omitempty" protobuf:"bytes,7,opt,name=keyField"`
+	// Speed is the factor the gaps of the event times of the records are divided by, a decimal, e.g. "10" replays the
+	// records 10 times faster than they are captured, and "0" replays them as fast as possible.
+	// if not provided, the default value is set to "1"
+	// +optional
+	Speed *string `json:"speed,omitempty" protobuf:"bytes,8,opt,name=speed"`
+}
+
+// GetFormat returns the format of the file holding the records.
+func (r ReplaySource) GetFormat() ReplayFormat {
+	if r.Format != nil {
+		return *r.Format
+	}
+	name := ""
+	if r.Path != nil {
+		name = *r.Path
+	} else if r.ConfigMap != nil {
+		name = r.ConfigMap.Key
+	}
+	if strings.EqualFold(filepath.Ext(name), ".csv") {
+		return ReplayFormatCSV
+	}
+	return ReplayFormatJSONL
+}
+
+// GetEventTimeUnit returns the unit of the event time field.
+func (r ReplaySource) GetEventTimeUnit() EventTimeUnit {
+	if r.EventTimeUnit == nil {
+		return EventTimeUnitRFC3339
+	}
+	return *r.EventTimeUnit
+}
+
+// GetSpeed returns the factor the gaps of the event times of the records are divided by, 0 means as fast as possible.
+func (r ReplaySource) GetSpeed() (float64, error) {
+	if r.Speed == nil {
+		return 1, nil
+	}
+	return strconv.ParseFloat(*r.Speed, 64)
+}
+
+// ReplayFormat is the format of the file a replay source reads the records from.
+type ReplayFormat string
+
+const (
+	// ReplayFormatJSONL is a JSON record on every line.
+	ReplayFormatJSONL ReplayFormat = "jsonl"
+	// ReplayFormatCSV is a header line, followed by a record on every line.
+	ReplayFormatCSV ReplayFormat = "csv"
+)
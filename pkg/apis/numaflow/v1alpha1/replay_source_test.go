@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestReplaySource_GetFormat(t *testing.T) {
+	assert.Equal(t, ReplayFormatJSONL, ReplaySource{}.GetFormat())
+	assert.Equal(t, ReplayFormatJSONL, ReplaySource{Path: ptr.To("/data/records.jsonl")}.GetFormat())
+	assert.Equal(t, ReplayFormatCSV, ReplaySource{Path: ptr.To("/data/records.CSV")}.GetFormat())
+	assert.Equal(t, ReplayFormatCSV, ReplaySource{ConfigMap: &corev1.ConfigMapKeySelector{Key: "records.csv"}}.GetFormat())
+	assert.Equal(t, ReplayFormatJSONL, ReplaySource{Path: ptr.To("/data/records.csv"), Format: ptr.To(ReplayFormatJSONL)}.GetFormat())
+}
+
+func TestReplaySource_GetEventTimeUnit(t *testing.T) {
+	assert.Equal(t, EventTimeUnitRFC3339, ReplaySource{}.GetEventTimeUnit())
+	assert.Equal(t, EventTimeUnitMillis, ReplaySource{EventTimeUnit: ptr.To(EventTimeUnitMillis)}.GetEventTimeUnit())
+}
+
+func TestReplaySource_GetSpeed(t *testing.T) {
+	speed, err := ReplaySource{}.GetSpeed()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, speed)
+	speed, err = ReplaySource{Speed: ptr.To("2.5")}.GetSpeed()
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, speed)
+	_, err = ReplaySource{Speed: ptr.To("fast")}.GetSpeed()
+	assert.Error(t, err)
+}
@@ -65,6 +65,12 @@ type Scale struct {
 	// The is use to prevent from too aggressive scaling down operations
 	// +optional
 	ReplicasPerScaleDown *uint32 `json:"replicasPerScaleDown,omitempty" protobuf:"varint,12,opt,name=replicasPerScaleDown"`
+	// TargetPendingAgeSeconds defines the target maximum age of the oldest pending message in seconds. When it's set,
+	// the desired replicas are weighted by how far the age of the oldest pending message is from the target, so that a
+	// small but old backlog scales up, while a big but fresh backlog does not scale up too aggressively.
+	// It only applies to UDF and Sink vertices because only they have buffers to read. It's disabled if not set or set to 0.
+	// +optional
+	TargetPendingAgeSeconds *uint32 `json:"targetPendingAgeSeconds,omitempty" protobuf:"varint,13,opt,name=targetPendingAgeSeconds"`
 }
 
 func (s Scale) GetLookbackSeconds() int {
@@ -110,6 +116,14 @@ func (s Scale) GetTargetBufferAvailability() int {
 	return DefaultTargetBufferAvailability
 }
 
+// GetTargetPendingAgeSeconds returns the target maximum age of the oldest pending message, 0 means disabled.
+func (s Scale) GetTargetPendingAgeSeconds() int {
+	if s.TargetPendingAgeSeconds != nil {
+		return int(*s.TargetPendingAgeSeconds)
+	}
+	return 0
+}
+
 func (s Scale) GetReplicasPerScaleUp() int {
 	if s.ReplicasPerScaleUp != nil {
 		return int(*s.ReplicasPerScaleUp)
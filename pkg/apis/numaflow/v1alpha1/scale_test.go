@@ -35,6 +35,7 @@ func Test_Scale_Parameters(t *testing.T) {
 	assert.Equal(t, DefaultTargetBufferAvailability, s.GetTargetBufferAvailability())
 	assert.Equal(t, DefaultTargetProcessingSeconds, s.GetTargetProcessingSeconds())
 	assert.Equal(t, DefaultZeroReplicaSleepSeconds, s.GetZeroReplicaSleepSeconds())
+	assert.Equal(t, 0, s.GetTargetPendingAgeSeconds())
 	upcds := uint32(100)
 	downcds := uint32(99)
 	lbs := uint32(101)
@@ -43,6 +44,7 @@ func Test_Scale_Parameters(t *testing.T) {
 	tps := uint32(102)
 	tbu := uint32(33)
 	zrss := uint32(44)
+	tpas := uint32(55)
 	s = Scale{
 		Min:                      ptr.To[int32](2),
 		Max:                      ptr.To[int32](4),
@@ -54,6 +56,7 @@ func Test_Scale_Parameters(t *testing.T) {
 		TargetProcessingSeconds:  &tps,
 		TargetBufferAvailability: &tbu,
 		ZeroReplicaSleepSeconds:  &zrss,
+		TargetPendingAgeSeconds:  &tpas,
 	}
 	assert.Equal(t, int32(2), s.GetMinReplicas())
 	assert.Equal(t, int32(4), s.GetMaxReplicas())
@@ -65,6 +68,7 @@ func Test_Scale_Parameters(t *testing.T) {
 	assert.Equal(t, int(tbu), s.GetTargetBufferAvailability())
 	assert.Equal(t, int(tps), s.GetTargetProcessingSeconds())
 	assert.Equal(t, int(zrss), s.GetZeroReplicaSleepSeconds())
+	assert.Equal(t, int(tpas), s.GetTargetPendingAgeSeconds())
 	s.Max = ptr.To[int32](500)
 	assert.Equal(t, int32(500), s.GetMaxReplicas())
 }
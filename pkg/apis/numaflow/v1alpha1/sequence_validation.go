@@ -0,0 +1,34 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// SequenceValidation validates the per-key ordering of the messages produced by a generator source, it tracks the
+// sequence number embedded by the generator and counts the out-of-order and missing observations. It is meant to be
+// used in end-to-end ordering tests.
+type SequenceValidation struct {
+	// MaxViolations is the number of out-of-order and missing observations tolerated before the sink
+	// reports itself as not ready. Defaults to 0.
+	// +optional
+	MaxViolations *int64 `json:"maxViolations,omitempty" protobuf:"varint,1,opt,name=maxViolations"`
+}
+
+func (sv *SequenceValidation) GetMaxViolations() int64 {
+	if sv == nil || sv.MaxViolations == nil || *sv.MaxViolations < 0 {
+		return 0
+	}
+	return *sv.MaxViolations
+}
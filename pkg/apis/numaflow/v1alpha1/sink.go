@@ -17,7 +17,10 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -30,6 +33,10 @@ type Sink struct {
 	// RetryStrategy struct encapsulates the settings for retrying operations in the event of failures.
 	// +optional
 	RetryStrategy RetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,3,opt,name=retryStrategy"`
+	// WriteTimeout is the deadline of writing a batch of messages to the sink. If the write does not finish in time,
+	// it is treated as a failure and handled by the retry strategy. There is no deadline by default.
+	// +optional
+	WriteTimeout *metav1.Duration `json:"writeTimeout,omitempty" protobuf:"bytes,4,opt,name=writeTimeout"`
 }
 
 type AbstractSink struct {
@@ -48,6 +55,14 @@ type AbstractSink struct {
 	UDSink *UDSink `json:"udsink,omitempty" protobuf:"bytes,4,opt,name=udsink"`
 }
 
+// GetWriteTimeout returns the deadline of writing a batch of messages to the sink, 0 means no deadline.
+func (s Sink) GetWriteTimeout() time.Duration {
+	if s.WriteTimeout == nil || s.WriteTimeout.Duration < 0 {
+		return 0
+	}
+	return s.WriteTimeout.Duration
+}
+
 func (s Sink) getContainers(req getContainerReq) ([]corev1.Container, []corev1.Container, error) {
 	containers := []corev1.Container{
 		s.getMainContainer(req),
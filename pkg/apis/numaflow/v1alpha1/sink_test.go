@@ -18,10 +18,12 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -155,3 +157,12 @@ func Test_Sink_getFallbackUDSinkContainer(t *testing.T) {
 	assert.True(t, c.LivenessProbe != nil)
 	assert.Equal(t, ptr.To[corev1.ContainerRestartPolicy](corev1.ContainerRestartPolicyAlways), c.RestartPolicy)
 }
+
+func Test_Sink_GetWriteTimeout(t *testing.T) {
+	s := Sink{}
+	assert.Equal(t, time.Duration(0), s.GetWriteTimeout())
+	s.WriteTimeout = &metav1.Duration{Duration: -time.Second}
+	assert.Equal(t, time.Duration(0), s.GetWriteTimeout())
+	s.WriteTimeout = &metav1.Duration{Duration: 5 * time.Second}
+	assert.Equal(t, 5*time.Second, s.GetWriteTimeout())
+}
@@ -45,6 +45,8 @@ type Source struct {
 	Serving *ServingSource `json:"serving,omitempty" protobuf:"bytes,8,opt,name=serving"`
 	// +optional
 	Pulsar *PulsarSource `json:"pulsar,omitempty" protobuf:"bytes,9,opt,name=pulsar"`
+	// +optional
+	Replay *ReplaySource `json:"replay,omitempty" protobuf:"bytes,10,opt,name=replay"`
 }
 
 func (s Source) getContainers(req getContainerReq) ([]corev1.Container, []corev1.Container, error) {
@@ -65,7 +67,12 @@ func (s Source) getMainContainer(req getContainerReq) corev1.Container {
 	if req.executeRustBinary {
 		return containerBuilder{}.init(req).command(NumaflowRustBinary).args("processor", "--type="+string(VertexTypeSink), "--isbsvc-type="+string(req.isbSvcType), "--rust").build()
 	}
-	return containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSource), "--isbsvc-type="+string(req.isbSvcType)).build()
+	b := containerBuilder{}.init(req).args("processor", "--type="+string(VertexTypeSource), "--isbsvc-type="+string(req.isbSvcType))
+	if s.Replay != nil {
+		// the file holding the records is read from the volumes mounted in the main container.
+		b = b.appendVolumeMounts(s.Replay.VolumeMounts...)
+	}
+	return b.build()
 }
 
 func (s Source) getUDTransformerContainer(mainContainerReq getContainerReq) corev1.Container {
@@ -137,6 +137,22 @@ func TestSource_getContainers(t *testing.T) {
 	assert.Equal(t, testImagePullPolicy, sc[0].ImagePullPolicy)
 }
 
+func TestSource_getContainers_Replay(t *testing.T) {
+	x := Source{
+		Replay: &ReplaySource{
+			Path:         ptr.To("/data/records.jsonl"),
+			VolumeMounts: []corev1.VolumeMount{{Name: "records", MountPath: "/data"}},
+		},
+	}
+	sc, c, err := x.getContainers(getContainerReq{
+		image:        "main-image",
+		volumeMounts: []corev1.VolumeMount{{Name: "var-run", MountPath: PathVarRun}},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, sc)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "var-run", MountPath: PathVarRun}, {Name: "records", MountPath: "/data"}}, c[0].VolumeMounts)
+}
+
 func Test_getTransformerContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := Source{
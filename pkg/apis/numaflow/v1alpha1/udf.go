@@ -43,15 +43,37 @@ type UDF struct {
 	Builtin *Function `json:"builtin" protobuf:"bytes,2,opt,name=builtin"`
 	// +optional
 	GroupBy *GroupBy `json:"groupBy" protobuf:"bytes,3,opt,name=groupBy"`
+	// PassThrough forwards the messages as they are without a UDF container, e.g. for a vertex only routing the
+	// messages to the conditional edges.
+	// +optional
+	PassThrough *PassThrough `json:"passThrough,omitempty" protobuf:"bytes,4,opt,name=passThrough"`
+}
+
+// PassThrough is a map vertex without a UDF container, it forwards the messages to the next vertices as they are.
+type PassThrough struct {
+	// TagsHeader is the name of the message header carrying the comma separated tags of the message for conditional
+	// forwarding, the message doesn't have any tags if the header is not set.
+	// +optional
+	TagsHeader string `json:"tagsHeader,omitempty" protobuf:"bytes,1,opt,name=tagsHeader"`
 }
 
 func (in UDF) getContainers(req getContainerReq) ([]corev1.Container, []corev1.Container, error) {
+	if in.IsPassThrough() {
+		// no UDF container for a pass-through vertex
+		return nil, []corev1.Container{in.getMainContainer(req)}, nil
+	}
 	return []corev1.Container{in.getUDFContainer(req)}, []corev1.Container{in.getMainContainer(req)}, nil
 }
 
+// IsPassThrough returns true if it is a map vertex forwarding the messages without a UDF container.
+func (in UDF) IsPassThrough() bool {
+	return in.GroupBy == nil && in.PassThrough != nil
+}
+
 func (in UDF) getMainContainer(req getContainerReq) corev1.Container {
 	if in.GroupBy == nil {
-		if req.executeRustBinary {
+		// the pass-through mode is only implemented by the Go processor
+		if req.executeRustBinary && !in.IsPassThrough() {
 			return containerBuilder{}.init(req).command(NumaflowRustBinary).args("processor", "--type="+string(VertexTypeMapUDF), "--isbsvc-type="+string(req.isbSvcType), "--rust").build()
 		}
 		args := []string{"processor", "--type=" + string(VertexTypeMapUDF), "--isbsvc-type=" + string(req.isbSvcType)}
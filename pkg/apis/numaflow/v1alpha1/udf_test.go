@@ -84,6 +84,25 @@ func TestUDF_getContainers(t *testing.T) {
 	assert.Equal(t, int32(5), sc[0].LivenessProbe.FailureThreshold)
 }
 
+func TestUDF_getContainers_PassThrough(t *testing.T) {
+	x := UDF{PassThrough: &PassThrough{TagsHeader: "X-Tags"}}
+	assert.True(t, x.IsPassThrough())
+	sc, c, err := x.getContainers(getContainerReq{
+		image:             "main-image",
+		imagePullPolicy:   corev1.PullAlways,
+		executeRustBinary: true,
+	})
+	assert.NoError(t, err)
+	// no udf container, and the main container runs the Go processor
+	assert.Empty(t, sc)
+	assert.Equal(t, 1, len(c))
+	assert.Equal(t, "main-image", c[0].Image)
+	assert.Contains(t, c[0].Args, "--type="+string(VertexTypeMapUDF))
+	assert.NotContains(t, c[0].Command, NumaflowRustBinary)
+	x.GroupBy = &GroupBy{}
+	assert.False(t, x.IsPassThrough())
+}
+
 func Test_getUDFContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := UDF{
@@ -111,10 +111,24 @@ func (v Vertex) IsMapUDF() bool {
 	return v.Spec.IsMapUDF()
 }
 
+func (v Vertex) IsPassThroughUDF() bool {
+	return v.Spec.IsPassThroughUDF()
+}
+
 func (v Vertex) IsReduceUDF() bool {
 	return v.Spec.IsReduceUDF()
 }
 
+// IsBackfilling returns whether the pipeline of the vertex is in the backfill mode.
+func (v Vertex) IsBackfilling() bool {
+	return v.GetAnnotations()[KeyBackfill] == "true"
+}
+
+// IsPausedByStorageQuota returns whether the reading of the source vertex is paused by the storage quota of the pipeline.
+func (v Vertex) IsPausedByStorageQuota() bool {
+	return v.GetAnnotations()[KeyStorageQuotaPaused] == "true"
+}
+
 func (v Vertex) GetVertexType() VertexType {
 	return v.Spec.GetVertexType()
 }
@@ -123,6 +137,10 @@ func (v Vertex) Scalable() bool {
 	if v.Spec.Scale.Disabled || v.IsReduceUDF() {
 		return false
 	}
+	if v.Spec.Source != nil && v.Spec.Source.Replay != nil {
+		// the records are replayed by the first replica only.
+		return false
+	}
 	if v.IsASink() || v.IsMapUDF() || v.IsASource() {
 		return true
 	}
@@ -137,6 +155,26 @@ func (v Vertex) GetHeadlessServiceName() string {
 	return v.Name + "-headless"
 }
 
+// GetRuntimeLimitsConfigMapName returns the name of the ConfigMap holding the resolved limits of the vertex,
+// which is mounted in the vertex pods so that the limits can be reloaded without restarting them.
+func (v Vertex) GetRuntimeLimitsConfigMapName() string {
+	return v.Name + "-runtime-limits"
+}
+
+// WithoutReloadableLimits returns a copy of the vertex without the settings which are reloaded by the running pods,
+// it is used to calculate the hash of the pod spec, so that changing these settings does not restart the pods.
+// The read batch size of a reduce vertex is not reloadable.
+func (v Vertex) WithoutReloadableLimits() *Vertex {
+	vCopy := v.DeepCopy()
+	if vCopy.Spec.Limits != nil && !vCopy.IsReduceUDF() {
+		vCopy.Spec.Limits.ReadBatchSize = nil
+	}
+	if vCopy.Spec.Sink != nil {
+		vCopy.Spec.Sink.WriteTimeout = nil
+	}
+	return vCopy
+}
+
 func (v Vertex) GetServiceObjs() []*corev1.Service {
 	svcs := []*corev1.Service{v.getServiceObj(v.GetHeadlessServiceName(), true, VertexMetricsPort, VertexMetricsPortName)}
 	if x := v.Spec.Source; x != nil && x.HTTP != nil && x.HTTP.Service {
@@ -327,6 +365,31 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 		{Name: VertexMetricsPortName, ContainerPort: VertexMetricsPort},
 	}
 
+	// the runtime limits are reloaded from the ConfigMap written by the controller, it is optional since the
+	// ConfigMap might not be created yet when the pod starts.
+	runtimeLimitsVolName := "runtime-limits"
+	volumes = append(volumes, corev1.Volume{
+		Name: runtimeLimitsVolName,
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: v.GetRuntimeLimitsConfigMapName()},
+			Optional:             ptr.To[bool](true),
+		}},
+	})
+	containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: runtimeLimitsVolName, MountPath: PathRuntimeLimits, ReadOnly: true})
+
+	if x := v.Spec.Encryption; x != nil {
+		// the keys are reloaded from the mounted secret, so rotated keys are picked up without restarting the pods.
+		encryptionVolName := "isb-encryption-keys"
+		volumes = append(volumes, corev1.Volume{
+			Name:         encryptionVolName,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: x.SecretName}},
+		})
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: encryptionVolName, MountPath: PathISBEncryptionKeys, ReadOnly: true})
+		if x.Vault != nil && x.Vault.TokenSecret != nil {
+			containers[0].Env = append(containers[0].Env, corev1.EnvVar{Name: EnvISBEncryptionVaultToken, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: x.Vault.TokenSecret}})
+		}
+	}
+
 	for i := 0; i < len(sidecarContainers); i++ { // udf, udsink, udsource, or source vertex specifies a udtransformer
 		sidecarContainers[i].Env = append(sidecarContainers[i].Env, v.commonEnvs()...)
 		sidecarContainers[i].Env = append(sidecarContainers[i].Env, v.sidecarEnvs()...)
@@ -418,6 +481,10 @@ func (v Vertex) getInitContainers(req GetVertexPodSpecReq) []corev1.Container {
 		{Name: EnvPipelineName, Value: v.Spec.PipelineName},
 		{Name: EnvGoDebug, Value: os.Getenv(EnvGoDebug)},
 	}
+	// the pipeline UID is used to verify the ownership of the buffers and buckets.
+	if ref := metav1.GetControllerOf(&v); ref != nil && ref.Kind == PipelineGroupVersionKind.Kind {
+		envVars = append(envVars, corev1.EnvVar{Name: EnvPipelineUID, Value: string(ref.UID)})
+	}
 	envVars = append(envVars, req.Env...)
 	initContainers := []corev1.Container{
 		{
@@ -546,6 +613,10 @@ type VertexSpec struct {
 	// +kubebuilder:default={"desiredPhase": Running}
 	// +optional
 	Lifecycle VertexLifecycle `json:"lifecycle,omitempty" protobuf:"bytes,8,opt,name=lifecycle"`
+	// Encryption indicates the encryption of the message payloads in the inter-step buffers, it's populated from the
+	// pipeline encryption settings.
+	// +optional
+	Encryption *ISBEncryption `json:"encryption,omitempty" protobuf:"bytes,9,opt,name=encryption"`
 }
 
 type AbstractVertex struct {
@@ -666,6 +737,11 @@ func (av AbstractVertex) IsMapUDF() bool {
 	return av.UDF != nil && av.UDF.GroupBy == nil
 }
 
+// IsPassThroughUDF returns true if it is a map vertex forwarding the messages without a UDF container.
+func (av AbstractVertex) IsPassThroughUDF() bool {
+	return av.UDF != nil && av.UDF.IsPassThrough()
+}
+
 func (av AbstractVertex) IsReduceUDF() bool {
 	return av.UDF != nil && av.UDF.GroupBy != nil
 }
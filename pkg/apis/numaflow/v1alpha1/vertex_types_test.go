@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -205,6 +206,43 @@ func TestGetHeadlessServiceName(t *testing.T) {
 	assert.True(t, strings.HasSuffix(n, "-headless"))
 }
 
+func TestGetRuntimeLimitsConfigMapName(t *testing.T) {
+	n := testVertex.GetRuntimeLimitsConfigMapName()
+	assert.Equal(t, testVertex.Name+"-runtime-limits", n)
+}
+
+func TestVertexIsBackfilling(t *testing.T) {
+	v := testVertex.DeepCopy()
+	assert.False(t, v.IsBackfilling())
+	v.Annotations = map[string]string{KeyBackfill: "true"}
+	assert.True(t, v.IsBackfilling())
+	v.Annotations[KeyBackfill] = "false"
+	assert.False(t, v.IsBackfilling())
+}
+
+func TestWithoutReloadableLimits(t *testing.T) {
+	t.Run("test sink", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Sink = &Sink{WriteTimeout: &metav1.Duration{Duration: time.Second}}
+		testObj.Spec.Limits = &VertexLimits{ReadBatchSize: ptr.To[uint64](10), BufferMaxLength: ptr.To[uint64](100)}
+		v := testObj.WithoutReloadableLimits()
+		assert.Nil(t, v.Spec.Sink.WriteTimeout)
+		assert.Nil(t, v.Spec.Limits.ReadBatchSize)
+		assert.Equal(t, uint64(100), *v.Spec.Limits.BufferMaxLength)
+		// the original vertex is not changed
+		assert.Equal(t, time.Second, testObj.Spec.Sink.WriteTimeout.Duration)
+		assert.Equal(t, uint64(10), *testObj.Spec.Limits.ReadBatchSize)
+	})
+
+	t.Run("test reduce udf", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &UDF{GroupBy: &GroupBy{}}
+		testObj.Spec.Limits = &VertexLimits{ReadBatchSize: ptr.To[uint64](10)}
+		v := testObj.WithoutReloadableLimits()
+		assert.Equal(t, uint64(10), *v.Spec.Limits.ReadBatchSize)
+	})
+}
+
 func TestGetPodSpec(t *testing.T) {
 	req := GetVertexPodSpecReq{
 		ISBSvcType: ISBSvcTypeRedis,
@@ -253,6 +291,15 @@ func TestGetPodSpec(t *testing.T) {
 		}
 		s, err := testObj.GetPodSpec(req)
 		assert.NoError(t, err)
+		var runtimeLimitsVol *corev1.Volume
+		for i, v := range s.Volumes {
+			if v.Name == "runtime-limits" {
+				runtimeLimitsVol = &s.Volumes[i]
+			}
+		}
+		assert.NotNil(t, runtimeLimitsVol)
+		assert.Equal(t, testObj.GetRuntimeLimitsConfigMapName(), runtimeLimitsVol.ConfigMap.Name)
+		assert.Contains(t, s.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "runtime-limits", MountPath: PathRuntimeLimits, ReadOnly: true})
 		assert.NotNil(t, s.NodeSelector)
 		assert.Contains(t, s.NodeSelector, "a")
 		assert.NotNil(t, s.Tolerations)
@@ -299,6 +346,34 @@ func TestGetPodSpec(t *testing.T) {
 		assert.Equal(t, "0", s.InitContainers[0].Resources.Limits.Memory().String())
 	})
 
+	t.Run("test encryption", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Source = &Source{}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		for _, v := range s.Volumes {
+			assert.NotEqual(t, "isb-encryption-keys", v.Name)
+		}
+
+		testObj.Spec.Encryption = &ISBEncryption{SecretName: "isb-keys", PrimaryKeyID: "key-1"}
+		s, err = testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		assert.Contains(t, s.Volumes, corev1.Volume{
+			Name:         "isb-encryption-keys",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "isb-keys"}},
+		})
+		assert.Contains(t, s.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "isb-encryption-keys", MountPath: PathISBEncryptionKeys, ReadOnly: true})
+		for _, e := range s.Containers[0].Env {
+			assert.NotEqual(t, EnvISBEncryptionVaultToken, e.Name)
+		}
+
+		tokenSecret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "vault"}, Key: "token"}
+		testObj.Spec.Encryption.Vault = &VaultTransit{Address: "https://vault:8200", KeyName: "isb", TokenSecret: tokenSecret}
+		s, err = testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		assert.Contains(t, s.Containers[0].Env, corev1.EnvVar{Name: EnvISBEncryptionVaultToken, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: tokenSecret}})
+	})
+
 	// When the pipeline has a Serving source vertex, the Numaflow container of all vertices
 	// should have the environment variable `EnvCallbackEnabled` set to true
 	t.Run("test Serving source", func(t *testing.T) {
@@ -818,6 +893,25 @@ func Test_VertexGetInitContainers(t *testing.T) {
 	}
 }
 
+func Test_VertexGetInitContainers_PipelineUID(t *testing.T) {
+	req := GetVertexPodSpecReq{
+		ISBSvcType: ISBSvcTypeJetStream,
+		Image:      testFlowImage,
+		PullPolicy: corev1.PullIfNotPresent,
+	}
+	o := testVertex.DeepCopy()
+	o.Spec.Sink = &Sink{}
+	s := o.getInitContainers(req)
+	for _, env := range s[0].Env {
+		assert.NotEqual(t, EnvPipelineUID, env.Name)
+	}
+
+	pl := &Pipeline{ObjectMeta: metav1.ObjectMeta{Name: "test-pl", UID: "test-uid"}}
+	o.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(pl.GetObjectMeta(), PipelineGroupVersionKind)}
+	s = o.getInitContainers(req)
+	assert.Contains(t, s[0].Env, corev1.EnvVar{Name: EnvPipelineUID, Value: "test-uid"})
+}
+
 func TestScalable(t *testing.T) {
 	v := Vertex{}
 	v.Spec.Scale.Disabled = true
@@ -845,6 +939,10 @@ func TestScalable(t *testing.T) {
 		UDSource: &UDSource{},
 	}
 	assert.True(t, v.Scalable())
+	v.Spec.Source = &Source{
+		Replay: &ReplaySource{},
+	}
+	assert.False(t, v.Scalable())
 }
 
 func Test_GetVertexType(t *testing.T) {
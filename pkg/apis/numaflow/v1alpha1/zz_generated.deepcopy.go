@@ -111,7 +111,7 @@ func (in *AbstractSink) DeepCopyInto(out *AbstractSink) {
 	if in.Log != nil {
 		in, out := &in.Log, &out.Log
 		*out = new(Log)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Kafka != nil {
 		in, out := &in.Kafka, &out.Kafka
@@ -121,7 +121,7 @@ func (in *AbstractSink) DeepCopyInto(out *AbstractSink) {
 	if in.Blackhole != nil {
 		in, out := &in.Blackhole, &out.Blackhole
 		*out = new(Blackhole)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.UDSink != nil {
 		in, out := &in.UDSink, &out.UDSink
@@ -302,6 +302,11 @@ func (in *BasicAuth) DeepCopy() *BasicAuth {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Blackhole) DeepCopyInto(out *Blackhole) {
 	*out = *in
+	if in.SequenceValidation != nil {
+		in, out := &in.SequenceValidation, &out.SequenceValidation
+		*out = new(SequenceValidation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -315,6 +320,42 @@ func (in *Blackhole) DeepCopy() *Blackhole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BufferRetention) DeepCopyInto(out *BufferRetention) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Discard != nil {
+		in, out := &in.Discard, &out.Discard
+		*out = new(BufferDiscardPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BufferRetention.
+func (in *BufferRetention) DeepCopy() *BufferRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BufferRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BufferServiceConfig) DeepCopyInto(out *BufferServiceConfig) {
 	*out = *in
@@ -328,6 +369,11 @@ func (in *BufferServiceConfig) DeepCopyInto(out *BufferServiceConfig) {
 		*out = new(JetStreamConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -542,6 +588,16 @@ func (in *Edge) DeepCopyInto(out *Edge) {
 		*out = new(BufferFullWritingStrategy)
 		**out = **in
 	}
+	if in.BufferConfig != nil {
+		in, out := &in.BufferConfig, &out.BufferConfig
+		*out = new(string)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BufferRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -666,6 +722,53 @@ func (in *GSSAPI) DeepCopy() *GSSAPI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorRateStep) DeepCopyInto(out *GeneratorRateStep) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorRateStep.
+func (in *GeneratorRateStep) DeepCopy() *GeneratorRateStep {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorRateStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorRuntimeUpdate) DeepCopyInto(out *GeneratorRuntimeUpdate) {
+	*out = *in
+	if in.MaxRPU != nil {
+		in, out := &in.MaxRPU, &out.MaxRPU
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxKeyCount != nil {
+		in, out := &in.MaxKeyCount, &out.MaxKeyCount
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorRuntimeUpdate.
+func (in *GeneratorRuntimeUpdate) DeepCopy() *GeneratorRuntimeUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorRuntimeUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GeneratorSource) DeepCopyInto(out *GeneratorSource) {
 	*out = *in
@@ -704,6 +807,120 @@ func (in *GeneratorSource) DeepCopyInto(out *GeneratorSource) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EmitEvery != nil {
+		in, out := &in.EmitEvery, &out.EmitEvery
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TombstonePercentage != nil {
+		in, out := &in.TombstonePercentage, &out.TombstonePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OnInvalidEventTime != nil {
+		in, out := &in.OnInvalidEventTime, &out.OnInvalidEventTime
+		*out = new(InvalidEventTimePolicy)
+		**out = **in
+	}
+	if in.ValueTemplate != nil {
+		in, out := &in.ValueTemplate, &out.ValueTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.RampUp != nil {
+		in, out := &in.RampUp, &out.RampUp
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RateJitterPercentage != nil {
+		in, out := &in.RateJitterPercentage, &out.RateJitterPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EventTimeField != nil {
+		in, out := &in.EventTimeField, &out.EventTimeField
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventTimeUnit != nil {
+		in, out := &in.EventTimeUnit, &out.EventTimeUnit
+		*out = new(EventTimeUnit)
+		**out = **in
+	}
+	if in.IdleThreshold != nil {
+		in, out := &in.IdleThreshold, &out.IdleThreshold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RateSchedule != nil {
+		in, out := &in.RateSchedule, &out.RateSchedule
+		*out = make([]GeneratorRateStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LatePercentage != nil {
+		in, out := &in.LatePercentage, &out.LatePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LateBy != nil {
+		in, out := &in.LateBy, &out.LateBy
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.KeyDistribution != nil {
+		in, out := &in.KeyDistribution, &out.KeyDistribution
+		*out = new(KeyDistribution)
+		**out = **in
+	}
+	if in.ZipfExponent != nil {
+		in, out := &in.ZipfExponent, &out.ZipfExponent
+		*out = new(string)
+		**out = **in
+	}
+	if in.HotKeyPercentage != nil {
+		in, out := &in.HotKeyPercentage, &out.HotKeyPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PayloadFormat != nil {
+		in, out := &in.PayloadFormat, &out.PayloadFormat
+		*out = new(PayloadFormat)
+		**out = **in
+	}
+	if in.PayloadSchema != nil {
+		in, out := &in.PayloadSchema, &out.PayloadSchema
+		*out = new(string)
+		**out = **in
+	}
+	if in.PayloadMessage != nil {
+		in, out := &in.PayloadMessage, &out.PayloadMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.DuplicatePercentage != nil {
+		in, out := &in.DuplicatePercentage, &out.DuplicatePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FutureJitter != nil {
+		in, out := &in.FutureJitter, &out.FutureJitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RuntimeUpdate != nil {
+		in, out := &in.RuntimeUpdate, &out.RuntimeUpdate
+		*out = new(GeneratorRuntimeUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -980,6 +1197,53 @@ func (in *HTTPSource) DeepCopy() *HTTPSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ISBEncryption) DeepCopyInto(out *ISBEncryption) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultTransit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ISBEncryption.
+func (in *ISBEncryption) DeepCopy() *ISBEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ISBEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ISBPipelineQuota) DeepCopyInto(out *ISBPipelineQuota) {
+	*out = *in
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxStreams != nil {
+		in, out := &in.MaxStreams, &out.MaxStreams
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ISBPipelineQuota.
+func (in *ISBPipelineQuota) DeepCopy() *ISBPipelineQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ISBPipelineQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdleSource) DeepCopyInto(out *IdleSource) {
 	*out = *in
@@ -1085,6 +1349,11 @@ func (in *InterStepBufferServiceSpec) DeepCopyInto(out *InterStepBufferServiceSp
 		*out = new(JetStreamBufferService)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaBufferService)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1160,6 +1429,11 @@ func (in *JetStreamBufferService) DeepCopyInto(out *JetStreamBufferService) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PipelineQuota != nil {
+		in, out := &in.PipelineQuota, &out.PipelineQuota
+		*out = new(ISBPipelineQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1181,6 +1455,16 @@ func (in *JetStreamConfig) DeepCopyInto(out *JetStreamConfig) {
 		*out = new(NatsAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PipelineQuota != nil {
+		in, out := &in.PipelineQuota, &out.PipelineQuota
+		*out = new(ISBPipelineQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1252,6 +1536,89 @@ func (in *JobTemplate) DeepCopy() *JobTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBufferService) DeepCopyInto(out *KafkaBufferService) {
+	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(KafkaConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBufferService.
+func (in *KafkaBufferService) DeepCopy() *KafkaBufferService {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBufferService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConfig) DeepCopyInto(out *KafkaConfig) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(KafkaSASLAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConfig.
+func (in *KafkaConfig) DeepCopy() *KafkaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSASLAuth) DeepCopyInto(out *KafkaSASLAuth) {
+	*out = *in
+	if in.Mechanism != nil {
+		in, out := &in.Mechanism, &out.Mechanism
+		*out = new(SASLType)
+		**out = **in
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSASLAuth.
+func (in *KafkaSASLAuth) DeepCopy() *KafkaSASLAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSASLAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaSink) DeepCopyInto(out *KafkaSink) {
 	*out = *in
@@ -1348,6 +1715,11 @@ func (in *Lifecycle) DeepCopy() *Lifecycle {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Log) DeepCopyInto(out *Log) {
 	*out = *in
+	if in.SequenceValidation != nil {
+		in, out := &in.SequenceValidation, &out.SequenceValidation
+		*out = new(SequenceValidation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1740,6 +2112,22 @@ func (in *PBQStorage) DeepCopy() *PBQStorage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PassThrough) DeepCopyInto(out *PassThrough) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PassThrough.
+func (in *PassThrough) DeepCopy() *PassThrough {
+	if in == nil {
+		return nil
+	}
+	out := new(PassThrough)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistenceStrategy) DeepCopyInto(out *PersistenceStrategy) {
 	*out = *in
@@ -1904,6 +2292,11 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(ISBEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2087,6 +2480,11 @@ func (in *RedisConfig) DeepCopyInto(out *RedisConfig) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2116,6 +2514,64 @@ func (in *RedisSettings) DeepCopy() *RedisSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplaySource) DeepCopyInto(out *ReplaySource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(ReplayFormat)
+		**out = **in
+	}
+	if in.EventTimeField != nil {
+		in, out := &in.EventTimeField, &out.EventTimeField
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventTimeUnit != nil {
+		in, out := &in.EventTimeUnit, &out.EventTimeUnit
+		*out = new(EventTimeUnit)
+		**out = **in
+	}
+	if in.KeyField != nil {
+		in, out := &in.KeyField, &out.KeyField
+		*out = new(string)
+		**out = **in
+	}
+	if in.Speed != nil {
+		in, out := &in.Speed, &out.Speed
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplaySource.
+func (in *ReplaySource) DeepCopy() *ReplaySource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplaySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -2319,6 +2775,11 @@ func (in *Scale) DeepCopyInto(out *Scale) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.TargetPendingAgeSeconds != nil {
+		in, out := &in.TargetPendingAgeSeconds, &out.TargetPendingAgeSeconds
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -2332,6 +2793,27 @@ func (in *Scale) DeepCopy() *Scale {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SequenceValidation) DeepCopyInto(out *SequenceValidation) {
+	*out = *in
+	if in.MaxViolations != nil {
+		in, out := &in.MaxViolations, &out.MaxViolations
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SequenceValidation.
+func (in *SequenceValidation) DeepCopy() *SequenceValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(SequenceValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServingSource) DeepCopyInto(out *ServingSource) {
 	*out = *in
@@ -2501,6 +2983,11 @@ func (in *Sink) DeepCopyInto(out *Sink) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.RetryStrategy.DeepCopyInto(&out.RetryStrategy)
+	if in.WriteTimeout != nil {
+		in, out := &in.WriteTimeout, &out.WriteTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2588,6 +3075,11 @@ func (in *Source) DeepCopyInto(out *Source) {
 		*out = new(PulsarSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Replay != nil {
+		in, out := &in.Replay, &out.Replay
+		*out = new(ReplaySource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2763,6 +3255,11 @@ func (in *UDF) DeepCopyInto(out *UDF) {
 		*out = new(GroupBy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PassThrough != nil {
+		in, out := &in.PassThrough, &out.PassThrough
+		*out = new(PassThrough)
+		**out = **in
+	}
 	return
 }
 
@@ -2865,6 +3362,27 @@ func (in *UpdateStrategy) DeepCopy() *UpdateStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTransit) DeepCopyInto(out *VaultTransit) {
+	*out = *in
+	if in.TokenSecret != nil {
+		in, out := &in.TokenSecret, &out.TokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTransit.
+func (in *VaultTransit) DeepCopy() *VaultTransit {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTransit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Vertex) DeepCopyInto(out *Vertex) {
 	*out = *in
@@ -3024,6 +3542,11 @@ func (in *VertexSpec) DeepCopyInto(out *VertexSpec) {
 	}
 	in.Watermark.DeepCopyInto(&out.Watermark)
 	out.Lifecycle = in.Lifecycle
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(ISBEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
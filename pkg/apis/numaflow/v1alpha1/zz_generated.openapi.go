@@ -35,6 +35,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Backoff":                          schema_pkg_apis_numaflow_v1alpha1_Backoff(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth":                        schema_pkg_apis_numaflow_v1alpha1_BasicAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole":                        schema_pkg_apis_numaflow_v1alpha1_Blackhole(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferRetention":                  schema_pkg_apis_numaflow_v1alpha1_BufferRetention(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig":              schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge":                     schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container":                        schema_pkg_apis_numaflow_v1alpha1_Container(ref),
@@ -45,6 +46,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions":                schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function":                         schema_pkg_apis_numaflow_v1alpha1_Function(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GSSAPI":                           schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorRateStep":                schema_pkg_apis_numaflow_v1alpha1_GeneratorRateStep(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorRuntimeUpdate":           schema_pkg_apis_numaflow_v1alpha1_GeneratorRuntimeUpdate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource":                  schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetDaemonDeploymentReq":           schema_pkg_apis_numaflow_v1alpha1_GetDaemonDeploymentReq(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetJetStreamServiceSpecReq":       schema_pkg_apis_numaflow_v1alpha1_GetJetStreamServiceSpecReq(ref),
@@ -57,6 +60,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetVertexPodSpecReq":              schema_pkg_apis_numaflow_v1alpha1_GetVertexPodSpecReq(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy":                          schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource":                       schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBEncryption":                    schema_pkg_apis_numaflow_v1alpha1_ISBEncryption(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBPipelineQuota":                 schema_pkg_apis_numaflow_v1alpha1_ISBPipelineQuota(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.IdleSource":                       schema_pkg_apis_numaflow_v1alpha1_IdleSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferService":           schema_pkg_apis_numaflow_v1alpha1_InterStepBufferService(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceList":       schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceList(ref),
@@ -66,6 +71,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig":                  schema_pkg_apis_numaflow_v1alpha1_JetStreamConfig(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamSource":                  schema_pkg_apis_numaflow_v1alpha1_JetStreamSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JobTemplate":                      schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaBufferService":               schema_pkg_apis_numaflow_v1alpha1_KafkaBufferService(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaConfig":                      schema_pkg_apis_numaflow_v1alpha1_KafkaConfig(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSASLAuth":                    schema_pkg_apis_numaflow_v1alpha1_KafkaSASLAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink":                        schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource":                      schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle":                        schema_pkg_apis_numaflow_v1alpha1_Lifecycle(ref),
@@ -82,6 +90,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource":                       schema_pkg_apis_numaflow_v1alpha1_NatsSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NoStore":                          schema_pkg_apis_numaflow_v1alpha1_NoStore(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage":                       schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PassThrough":                      schema_pkg_apis_numaflow_v1alpha1_PassThrough(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy":              schema_pkg_apis_numaflow_v1alpha1_PersistenceStrategy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Pipeline":                         schema_pkg_apis_numaflow_v1alpha1_Pipeline(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits":                   schema_pkg_apis_numaflow_v1alpha1_PipelineLimits(ref),
@@ -94,12 +103,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService":               schema_pkg_apis_numaflow_v1alpha1_RedisBufferService(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig":                      schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisSettings":                    schema_pkg_apis_numaflow_v1alpha1_RedisSettings(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ReplaySource":                     schema_pkg_apis_numaflow_v1alpha1_ReplaySource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy":                    schema_pkg_apis_numaflow_v1alpha1_RetryStrategy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RollingUpdateStrategy":            schema_pkg_apis_numaflow_v1alpha1_RollingUpdateStrategy(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL":                             schema_pkg_apis_numaflow_v1alpha1_SASL(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASLOAuth":                        schema_pkg_apis_numaflow_v1alpha1_SASLOAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASLPlain":                        schema_pkg_apis_numaflow_v1alpha1_SASLPlain(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale":                            schema_pkg_apis_numaflow_v1alpha1_Scale(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SequenceValidation":               schema_pkg_apis_numaflow_v1alpha1_SequenceValidation(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingSource":                    schema_pkg_apis_numaflow_v1alpha1_ServingSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingStore":                     schema_pkg_apis_numaflow_v1alpha1_ServingStore(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SessionWindow":                    schema_pkg_apis_numaflow_v1alpha1_SessionWindow(ref),
@@ -119,6 +130,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource":                         schema_pkg_apis_numaflow_v1alpha1_UDSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDTransformer":                    schema_pkg_apis_numaflow_v1alpha1_UDTransformer(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy":                   schema_pkg_apis_numaflow_v1alpha1_UpdateStrategy(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VaultTransit":                     schema_pkg_apis_numaflow_v1alpha1_VaultTransit(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Vertex":                           schema_pkg_apis_numaflow_v1alpha1_Vertex(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexInstance":                   schema_pkg_apis_numaflow_v1alpha1_VertexInstance(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLifecycle":                  schema_pkg_apis_numaflow_v1alpha1_VertexLifecycle(ref),
@@ -684,8 +696,60 @@ func schema_pkg_apis_numaflow_v1alpha1_Blackhole(ref common.ReferenceCallback) c
 			SchemaProps: spec.SchemaProps{
 				Description: "Blackhole is a sink to emulate /dev/null",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sequenceValidation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SequenceValidation enables the validation of the per-key ordering of the messages generated by a generator source.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SequenceValidation"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SequenceValidation"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_BufferRetention(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BufferRetention describes the retention limits of the inter step buffers, the limits not set are not applied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the max age of the messages in the buffers, the older messages are discarded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxMessages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxMessages is the max number of messages in the buffers.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBytes is the max size of the messages in the buffers, in bytes. It only applies to JetStream.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"discard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Discard is the policy when MaxMessages or MaxBytes is reached, \"old\" discards the oldest messages, and \"new\" rejects the new messages. If not provided, the discard policy of the buffer is kept.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -705,11 +769,16 @@ func schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref common.ReferenceC
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig"),
 						},
 					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaConfig", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"},
 	}
 }
 
@@ -747,6 +816,19 @@ func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"bufferConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferConfig overrides the config of the inter step buffers the edge writes to, e.g. a larger max length for the edge to a slow sink. It's in the same format as the buffer config of the ISB Service, e.g. the stream config of JetStream, and is merged on top of it. The edges to the same vertex must have the same buffer config. It only applies to JetStream.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention sets the retention of the inter step buffers the edge writes to, it takes precedence over the retention settings of the buffer config. The edges to the same vertex must have the same retention.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferRetention"),
+						},
+					},
 					"fromVertexType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "From vertex type.",
@@ -792,7 +874,7 @@ func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferRetention", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
 	}
 }
 
@@ -1204,12 +1286,25 @@ func schema_pkg_apis_numaflow_v1alpha1_Edge(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"bufferConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BufferConfig overrides the config of the inter step buffers the edge writes to, e.g. a larger max length for the edge to a slow sink. It's in the same format as the buffer config of the ISB Service, e.g. the stream config of JetStream, and is merged on top of it. The edges to the same vertex must have the same buffer config. It only applies to JetStream.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention sets the retention of the inter step buffers the edge writes to, it takes precedence over the retention settings of the buffer config. The edges to the same vertex must have the same retention.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferRetention"),
+						},
+					},
 				},
 				Required: []string{"from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferRetention", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"},
 	}
 }
 
@@ -1373,6 +1468,63 @@ func schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_GeneratorRateStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorRateStep is a step of the rate schedule of a generator source.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RPU is the number of the records generated on every time unit at the end of the step.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the duration of the step, over which the number of the records generated on every time unit changes linearly from the RPU of the previous step, or the RPU of the generator for the first step, to the RPU of the step. A step with an unchanged RPU holds the rate.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"rpu", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GeneratorRuntimeUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorRuntimeUpdate is the limits of the changes of a running generator source. The pending messages are sized for the maxima when the generator starts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRPU is the highest rpu the generator can be changed to, it defaults to the rpu of the generator.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxKeyCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxKeyCount is the highest keyCount the generator can be changed to, it defaults to the keyCount of the generator.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1413,7 +1565,7 @@ func schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref common.ReferenceCallb
 					},
 					"jitter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Jitter is the jitter for the message generation, used to simulate out of order messages for example if the jitter is 10s, then the message's event time will be delayed by a random time between 0 and 10s which will result in the message being out of order by 0 to 10s",
+							Description: "Jitter is the jitter for the message generation, used to simulate out of order messages for example if the jitter is 10s, then the message's event time will be delayed by a random time between 0 and 10s which will result in the message being out of order by 0 to 10s. It should not be negative, see FutureJitter to move the event time into the future.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
@@ -1424,11 +1576,176 @@ func schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"emitEvery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmitEvery generates the RPU records on every EmitEvery-th time unit instead of every time unit, which allows rates lower than one record per time unit without changing the tick granularity. For example, rpu 1, duration 1s and emitEvery 30 generate one record every 30 seconds. Configure the idle source watermark to keep the watermark progressing between the records.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"tombstonePercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TombstonePercentage is the percentage of the generated messages emitted with an empty payload, which simulates the deletion events of the keys. The tombstones keep the keys and the event time of the messages, they are spread evenly, for example 10 generates a tombstone for every 10th message.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"onInvalidEventTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnInvalidEventTime specifies the behaviour when the event time of a generated message is missing or invalid. There are currently four options, fallbackToNow, fallbackToIngestionTime, drop and error. if not provided, the default value is set to \"fallbackToNow\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"valueTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueTemplate is an optional Go template of the payload to send, which is rendered for every generated message. The template can refer to {{.Timestamp}}, the generation time in nanoseconds, {{.Sequence}}, the per-key sequence number, and {{.RandString n}}, a random alphanumeric string of length n. The functions uuid, randInt min max and now return a random UUID, a random integer between min and max inclusive, and the current time. The functions sine period amplitude offset, sawtooth period min max and randomWalk start step return the values of a synthetic metric following a sine wave, a sawtooth wave or a random walk over time. If the rendered payload is a JSON object with a Createdts field in nanoseconds, it is used as the event time, otherwise the generation time is. If present, the Value and MsgSize fields will be ignored. It can not be set together with ValueBlob.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rampUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RampUp linearly increases the number of the records generated on every time unit from 0 to RPU over the given duration after the generator starts, which is useful to test the autoscaling of the pipeline.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"rateJitterPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateJitterPercentage randomizes the number of the records generated on every time unit within the given percentage of RPU, for example 20 generates between 80% and 120% of RPU on every time unit. It is applied on top of RampUp. It should be between 0 and 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"eventTimeField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeField is the field of the JSON payload the event time is read from, the nested fields are separated by dots, e.g. meta.ts. If the field is missing or can not be parsed, OnInvalidEventTime is applied. If not set, the event time is the generation time, or the Createdts field of the payload rendered from ValueTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTimeUnit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeUnit is the unit of the EventTimeField, one of nanos, millis, seconds and RFC3339. if not provided, the default value is set to \"nanos\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"idleThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleThreshold is the duration without any generated record after which the generator is marked as idle, and an idle watermark of the current time minus the max delay of the watermark is published, so that the watermark keeps progressing and the windows of the downstream reduce vertices are closed while the generator has no data. It is ignored if the idle source watermark of the pipeline is configured. The idle watermark is not published if it is not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"rateSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateSchedule changes the number of the records generated on every time unit over time after the generator starts, e.g. from 100 to 10000 over 10 minutes, then hold for 30 minutes, then back to 100 over 10 minutes. It starts from RPU, and the RPU of the last step is held after the schedule. It can not be set together with RampUp, and RateJitterPercentage is applied on top of it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorRateStep"),
+									},
+								},
+							},
+						},
+					},
+					"latePercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatePercentage is the percentage of the generated messages whose event time is moved back by LateBy, which simulates the out of order and late data. The late messages are spread evenly, for example 5 moves every 20th message back, and they do not hold the source watermark back, so they are behind the watermark unless LateBy is within the max delay of the watermark. It should be between 0 and 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lateBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LateBy is the duration the event time of the late messages is moved back by, for example 2m. It should be greater than 0 if LatePercentage is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"keyDistribution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyDistribution is the distribution the keys of the generated messages are drawn from, one of roundRobin, uniform, zipf and hotKey. roundRobin generates RPU messages for every one of the KeyCount keys on every time unit, the others generate the same number of messages but draw the key of every message: uniform with the same probability for all the keys, zipf with the probability of the i-th key proportional to 1/i^ZipfExponent, and hotKey with HotKeyPercentage of the messages on the first key and the others spread uniformly on the other keys. if not provided, the default value is set to \"roundRobin\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zipfExponent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZipfExponent is the exponent of the zipf key distribution, a decimal greater than 1, the larger it is, the more the messages are skewed to the first keys. if not provided, the default value is set to \"1.1\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hotKeyPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HotKeyPercentage is the percentage of the messages generated with the first key in the hotKey key distribution. It should be between 0 and 100. if not provided, the default value is set to 50",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"payloadFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PayloadFormat is the encoding of the generated payloads, one of json, protobuf, avro and raw-bytes. json generates the JSON payloads, or the ValueBlob or ValueTemplate ones. protobuf and avro generate a message of the PayloadSchema: an integer field named createdts is the generation time in nanoseconds, an integer field named seq is the per-key sequence number, a bytes field has MsgSize random bytes and the other fields are random. raw-bytes generates MsgSize random bytes. if not provided, the default value is set to \"json\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payloadSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PayloadSchema is the schema of the payloads in the protobuf and avro formats. It is the JSON of an Avro schema for avro, the payloads are the binary encoding of the Avro datum without the schema. It is the base64 encoding of a serialized protobuf FileDescriptorSet including the imports, e.g. generated by protoc --include_imports --descriptor_set_out, for protobuf.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payloadMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PayloadMessage is the full name of the protobuf message of the payloads in the PayloadSchema, e.g. example.v1.Order. It is required for the protobuf format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duplicatePercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DuplicatePercentage is the percentage of the generated messages emitted again right after them, with the same offsets, i.e. the same message IDs, payloads, keys and event times, which simulates the duplicates of an at-least-once upstream to validate the deduplication and the exactly-once forwarding end to end. The duplicates are spread evenly, for example 10 emits every 10th message twice. It should be between 0 and 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are the headers of the generated messages, which can be used to test the header based routing and the propagation of the headers by the UDFs. The values are Go templates rendered for every message with the data and the functions of ValueTemplate, e.g. {{uuid}}, a value without any action is static.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"futureJitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FutureJitter moves the event time of the messages into the future by a random time between 0 and FutureJitter, which simulates the upstream clock issues, e.g. to test the detection of the future event times. Like Jitter, it's applied in whole seconds, and it should not be negative. Both can be set, the event time is then moved by a random time between -Jitter and FutureJitter.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"runtimeUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeUpdate enables the changes of the rpu, msgSize and keyCount of the running generator through the daemon service of the pipeline, within the maxima it sets. The changes are rejected if it's not set.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorRuntimeUpdate"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorRateStep", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorRuntimeUpdate", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2141,6 +2458,72 @@ func schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_ISBEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ISBEncryption enables envelope encryption of the message payloads written to the inter-step buffers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the secret holding the encryption keys. Each key of the secret is a key ID, and the value is a base64 encoded 32 bytes AES-256 key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"primaryKeyID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrimaryKeyID is the ID of the key used to encrypt the messages, the other keys in the secret are only used to decrypt the messages written before a key rotation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vault": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vault is the HashiCorp Vault transit secrets engine the keys are wrapped with. When it's set, the values of the secret are the keys encrypted by Vault, e.g. \"vault:v1:...\", instead of the plain keys, and the vertex pods ask Vault to decrypt them when the keys are loaded.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VaultTransit"),
+						},
+					},
+				},
+				Required: []string{"secretName", "primaryKeyID"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VaultTransit"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ISBPipelineQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ISBPipelineQuota is the quota of the streams a pipeline can create in a shared ISB Service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBytes is the max sum of the max bytes of the buffers of a pipeline, the buffers of a pipeline with the quota should have \"stream.maxBytes\" set in the buffer config.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"maxStreams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxStreams is the max number of the streams of a pipeline, including the streams backing the watermark buckets, each bucket is backed by 2 streams.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_IdleSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2285,11 +2668,16 @@ func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceSpec(ref common.Ref
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService"),
 						},
 					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaBufferService"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"},
 	}
 }
 
@@ -2579,11 +2967,17 @@ func schema_pkg_apis_numaflow_v1alpha1_JetStreamBufferService(ref common.Referen
 							Format:      "",
 						},
 					},
+					"pipelineQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PipelineQuota is the quota of the streams of each pipeline using the ISB Service, so that a pipeline can not exhaust the JetStream cluster shared with the other pipelines. It's enforced when the buffers and the buckets of a pipeline are created.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBPipelineQuota"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBPipelineQuota", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PersistenceStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -2611,18 +3005,30 @@ func schema_pkg_apis_numaflow_v1alpha1_JetStreamConfig(ref common.ReferenceCallb
 							Format: "",
 						},
 					},
-					"tlsEnabled": {
+					"tlsEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS enabled or not",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS config of the clients when TLS is enabled, e.g. the CA cert to verify the server certificate, the clients skip the verification if it's not provided.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"pipelineQuota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TLS enabled or not",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "PipelineQuota is the quota of the streams of each pipeline using the ISB Service.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBPipelineQuota"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBPipelineQuota", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
 	}
 }
 
@@ -2838,6 +3244,114 @@ func schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_KafkaBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Description: "External holds an External Kafka config, the buffers are the topics of the Kafka cluster",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaConfig"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_KafkaConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"brokers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Brokers of the Kafka cluster",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tlsEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS enabled or not, the certificates of the brokers are verified with the system CAs, unless a CA cert is given in TLS",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sasl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL user and password to authenticate with",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSASLAuth"),
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the Sarama client config in yaml, plus the \"topic\" settings of the topics created for the buffers, i.e. \"partitions\", \"replicationFactor\" and the topic configs in \"config\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS config of the clients when TLS is enabled, e.g. the CA cert to verify the broker certificates, and the client cert and key for mutual TLS.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSASLAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_KafkaSASLAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mechanism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL mechanism to use, one of PLAIN, SCRAM-SHA-256 and SCRAM-SHA-512, defaults to PLAIN",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the secret selector of the SASL user",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password is the secret selector of the SASL password",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3011,8 +3525,18 @@ func schema_pkg_apis_numaflow_v1alpha1_Log(ref common.ReferenceCallback) common.
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sequenceValidation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SequenceValidation enables the validation of the per-key ordering of the messages generated by a generator source.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SequenceValidation"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SequenceValidation"},
 	}
 }
 
@@ -3901,6 +4425,26 @@ func schema_pkg_apis_numaflow_v1alpha1_PBQStorage(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_PassThrough(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PassThrough is a map vertex without a UDF container, it forwards the messages to the next vertices as they are.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tagsHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TagsHeader is the name of the message header carrying the comma separated tags of the message for conditional forwarding, the message doesn't have any tags if the header is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_PersistenceStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4157,11 +4701,17 @@ func schema_pkg_apis_numaflow_v1alpha1_PipelineSpec(ref common.ReferenceCallback
 							},
 						},
 					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption enables the encryption of the message payloads in the inter-step buffers of the pipeline.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBEncryption"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBEncryption", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
 	}
 }
 
@@ -4463,11 +5013,17 @@ func schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS config to connect to Redis, e.g. the CA cert to verify the server certificate, and the client cert and key for mutual TLS.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -4511,6 +5067,83 @@ func schema_pkg_apis_numaflow_v1alpha1_RedisSettings(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_ReplaySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplaySource replays the records captured in a file, in the order of the file, pacing them by their event times.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap is the key of the ConfigMap holding the records, the ConfigMap is mounted in the vertex pods. One of ConfigMap and Path should be specified.",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the file holding the records, e.g. on a volume of the vertex mounted with VolumeMounts. One of ConfigMap and Path should be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts are the volumes of the vertex mounted in the container reading the records.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the file, one of jsonl and csv. A jsonl file has a JSON record on every line, which is the payload of the message. A csv file has a header line, the payload of the message is a JSON object of the columns of the record by their names. if not provided, it is csv for a file with the .csv extension, and jsonl otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTimeField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeField is the field the event time of a record is read from, a dot separated path in a jsonl record or the name of a column in a csv record. The records are replayed with the gaps of their event times, and the event times of the messages are the event times of the records. If not provided, the records are replayed as fast as possible and the event times of the messages are the times they are read.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTimeUnit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeUnit is the unit of the EventTimeField, one of nanos, millis, seconds and RFC3339. if not provided, the default value is set to \"RFC3339\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyField is the field the key of a record is read from, like the EventTimeField. The messages do not have any key if it is not provided.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"speed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Speed is the factor the gaps of the event times of the records are divided by, a decimal, e.g. \"10\" replays the records 10 times faster than they are captured, and \"0\" replays them as fast as possible. if not provided, the default value is set to \"1\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_RetryStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4772,6 +5405,33 @@ func schema_pkg_apis_numaflow_v1alpha1_Scale(ref common.ReferenceCallback) commo
 							Format:      "int64",
 						},
 					},
+					"targetPendingAgeSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetPendingAgeSeconds defines the target maximum age of the oldest pending message in seconds. When it's set, the desired replicas are weighted by how far the age of the oldest pending message is from the target, so that a small but old backlog scales up, while a big but fresh backlog does not scale up too aggressively. It only applies to UDF and Sink vertices because only they have buffers to read. It's disabled if not set or set to 0.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_SequenceValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SequenceValidation validates the per-key ordering of the messages produced by a generator source, it tracks the sequence number embedded by the generator and counts the out-of-order and missing observations. It is meant to be used in end-to-end ordering tests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxViolations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxViolations is the number of out-of-order and missing observations tolerated before the sink reports itself as not ready. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -5154,11 +5814,17 @@ func schema_pkg_apis_numaflow_v1alpha1_Sink(ref common.ReferenceCallback) common
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy"),
 						},
 					},
+					"writeTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteTimeout is the deadline of writing a batch of messages to the sink. If the write does not finish in time, it is treated as a failure and handled by the retry strategy. There is no deadline by default.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5247,11 +5913,16 @@ func schema_pkg_apis_numaflow_v1alpha1_Source(ref common.ReferenceCallback) comm
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource"),
 						},
 					},
+					"replay": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ReplaySource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDTransformer"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ReplaySource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServingSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSource", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDTransformer"},
 	}
 }
 
@@ -5471,11 +6142,17 @@ func schema_pkg_apis_numaflow_v1alpha1_UDF(ref common.ReferenceCallback) common.
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy"),
 						},
 					},
+					"passThrough": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PassThrough forwards the messages as they are without a UDF container, e.g. for a vertex only routing the messages to the conditional edges.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PassThrough"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PassThrough"},
 	}
 }
 
@@ -5572,6 +6249,51 @@ func schema_pkg_apis_numaflow_v1alpha1_UpdateStrategy(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_VaultTransit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VaultTransit is a key of the HashiCorp Vault transit secrets engine, used as a key encryption key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address is the address of the Vault server, e.g. https://vault.vault.svc:8200.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountPath is the path the transit secrets engine is mounted at, defaults to \"transit\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyName is the name of the transit key the encryption keys are wrapped with.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSecret refers to the secret key holding the Vault token, which should be allowed to decrypt with the transit key.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"address", "keyName", "tokenSecret"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_Vertex(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6090,12 +6812,18 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLifecycle"),
 						},
 					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption indicates the encryption of the message payloads in the inter-step buffers, it's populated from the pipeline encryption settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBEncryption"),
+						},
+					},
 				},
 				Required: []string{"name", "pipelineName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ISBEncryption", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
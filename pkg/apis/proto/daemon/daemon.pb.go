@@ -56,6 +56,15 @@ type BufferInfo struct {
 	BufferUsageLimit *wrapperspb.DoubleValue `protobuf:"bytes,7,opt,name=bufferUsageLimit,proto3" json:"bufferUsageLimit,omitempty"`
 	BufferUsage      *wrapperspb.DoubleValue `protobuf:"bytes,8,opt,name=bufferUsage,proto3" json:"bufferUsage,omitempty"`
 	IsFull           *wrapperspb.BoolValue   `protobuf:"bytes,9,opt,name=isFull,proto3" json:"isFull,omitempty"`
+	// The max length of the buffer configured in the ISB Service, -1 if it can not be determined.
+	MaxLength *wrapperspb.Int64Value `protobuf:"bytes,10,opt,name=maxLength,proto3" json:"maxLength,omitempty"`
+	// The percentage of the max length used by the buffer, -1 if the max length can not be determined.
+	UsagePercentage *wrapperspb.DoubleValue `protobuf:"bytes,11,opt,name=usagePercentage,proto3" json:"usagePercentage,omitempty"`
+	// The time in milliseconds since the epoch when the oldest message in the buffer was written, -1 if the buffer is empty or it can not be determined.
+	OldestMessageTimestamp *wrapperspb.Int64Value `protobuf:"bytes,12,opt,name=oldestMessageTimestamp,proto3" json:"oldestMessageTimestamp,omitempty"`
+	// Seconds to drain the pending messages of the buffer at the default processing rate of the vertex reading from it,
+	// absent if it can not be derived, e.g. the rate is not available yet or nothing is processed.
+	SecondsToDrain *wrapperspb.DoubleValue `protobuf:"bytes,13,opt,name=secondsToDrain,proto3" json:"secondsToDrain,omitempty"`
 }
 
 func (x *BufferInfo) Reset() {
@@ -153,6 +162,34 @@ func (x *BufferInfo) GetIsFull() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *BufferInfo) GetMaxLength() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MaxLength
+	}
+	return nil
+}
+
+func (x *BufferInfo) GetUsagePercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.UsagePercentage
+	}
+	return nil
+}
+
+func (x *BufferInfo) GetOldestMessageTimestamp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OldestMessageTimestamp
+	}
+	return nil
+}
+
+func (x *BufferInfo) GetSecondsToDrain() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.SecondsToDrain
+	}
+	return nil
+}
+
 // VertexMetrics is used to provide information about the vertex including processing rate.
 type VertexMetrics struct {
 	state         protoimpl.MessageState
@@ -163,6 +200,29 @@ type VertexMetrics struct {
 	Vertex          string                             `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
 	ProcessingRates map[string]*wrapperspb.DoubleValue `protobuf:"bytes,3,rep,name=processingRates,proto3" json:"processingRates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Pendings        map[string]*wrapperspb.Int64Value  `protobuf:"bytes,4,rep,name=pendings,proto3" json:"pendings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Age of the oldest pending message of the partition in milliseconds, absent if not available.
+	OldestPendingAge *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=oldestPendingAge,proto3" json:"oldestPendingAge,omitempty"`
+	// Processing rates of the pods reading from the partition over the default lookback seconds, keyed by the pod name.
+	PodProcessingRates map[string]*wrapperspb.DoubleValue `protobuf:"bytes,6,rep,name=podProcessingRates,proto3" json:"podProcessingRates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Seconds to drain the pending messages of the partition at the processing rate of the same lookback seconds,
+	// a lookback seconds is absent if it can not be derived, e.g. the pending is not available or nothing is processed.
+	SecondsToDrain map[string]*wrapperspb.DoubleValue `protobuf:"bytes,7,rep,name=secondsToDrain,proto3" json:"secondsToDrain,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Rates of the partition reacting to the bursts, derived from the rates of the 10-second windows: "ema" is the
+	// exponentially weighted moving average with the default lookback seconds as the time constant, "p50" and "p95" are
+	// the percentiles of the window rates within the default lookback seconds.
+	BurstRates map[string]*wrapperspb.DoubleValue `protobuf:"bytes,8,rep,name=burstRates,proto3" json:"burstRates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Rates of the errors and the dropped messages of the vertex across all the partitions, keyed by the lookback seconds
+	// the same as the processing rates, they are the same in all the partitions of the vertex.
+	ErrorRates map[string]*wrapperspb.DoubleValue `protobuf:"bytes,9,rep,name=errorRates,proto3" json:"errorRates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Error rates of the vertex over the processing rates of all the partitions of the vertex of the same lookback
+	// seconds, a lookback seconds is absent if either rate is not available or nothing is processed.
+	ErrorRatios map[string]*wrapperspb.DoubleValue `protobuf:"bytes,10,rep,name=errorRatios,proto3" json:"errorRatios,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Rates of the messages acknowledged by the partition, keyed by the lookback seconds the same as the processing rates.
+	AckRates map[string]*wrapperspb.DoubleValue `protobuf:"bytes,11,rep,name=ackRates,proto3" json:"ackRates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Share of the processing rate not matched by the ack rate of the same lookback seconds, from 0 when all the messages
+	// read are acknowledged, to 1 when none is, a lookback seconds is absent if either rate is not available or nothing
+	// is read. Reads far ahead of the acks indicate the processing is stuck.
+	ReadAckDivergences map[string]*wrapperspb.DoubleValue `protobuf:"bytes,12,rep,name=readAckDivergences,proto3" json:"readAckDivergences,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *VertexMetrics) Reset() {
@@ -225,6 +285,62 @@ func (x *VertexMetrics) GetPendings() map[string]*wrapperspb.Int64Value {
 	return nil
 }
 
+func (x *VertexMetrics) GetOldestPendingAge() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OldestPendingAge
+	}
+	return nil
+}
+
+func (x *VertexMetrics) GetPodProcessingRates() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.PodProcessingRates
+	}
+	return nil
+}
+
+func (x *VertexMetrics) GetSecondsToDrain() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.SecondsToDrain
+	}
+	return nil
+}
+
+func (x *VertexMetrics) GetBurstRates() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.BurstRates
+	}
+	return nil
+}
+
+func (x *VertexMetrics) GetErrorRates() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ErrorRates
+	}
+	return nil
+}
+
+func (x *VertexMetrics) GetErrorRatios() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ErrorRatios
+	}
+	return nil
+}
+
+func (x *VertexMetrics) GetAckRates() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.AckRates
+	}
+	return nil
+}
+
+func (x *VertexMetrics) GetReadAckDivergences() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ReadAckDivergences
+	}
+	return nil
+}
+
 // PipelineStatus
 type PipelineStatus struct {
 	state         protoimpl.MessageState
@@ -532,16 +648,24 @@ func (x *GetPipelineStatusRequest) GetPipeline() string {
 	return ""
 }
 
-type GetPipelineStatusResponse struct {
+// StreamHealth describes the leader presence and the replica health of a stream of the ISB Service.
+type StreamHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *PipelineStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the server leading the stream, empty if the stream has no leader.
+	Leader    string `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	HasLeader bool   `protobuf:"varint,3,opt,name=hasLeader,proto3" json:"hasLeader,omitempty"`
+	// The configured number of replicas of the stream.
+	Replicas int32 `protobuf:"varint,4,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// The number of replicas, including the leader, which are online and up to date.
+	CurrentReplicas int32 `protobuf:"varint,5,opt,name=currentReplicas,proto3" json:"currentReplicas,omitempty"`
 }
 
-func (x *GetPipelineStatusResponse) Reset() {
-	*x = GetPipelineStatusResponse{}
+func (x *StreamHealth) Reset() {
+	*x = StreamHealth{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,13 +673,13 @@ func (x *GetPipelineStatusResponse) Reset() {
 	}
 }
 
-func (x *GetPipelineStatusResponse) String() string {
+func (x *StreamHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPipelineStatusResponse) ProtoMessage() {}
+func (*StreamHealth) ProtoMessage() {}
 
-func (x *GetPipelineStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamHealth) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,29 +691,62 @@ func (x *GetPipelineStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPipelineStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetPipelineStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamHealth.ProtoReflect.Descriptor instead.
+func (*StreamHealth) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetPipelineStatusResponse) GetStatus() *PipelineStatus {
+func (x *StreamHealth) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetVertexMetricsRequest struct {
+func (x *StreamHealth) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (x *StreamHealth) GetHasLeader() bool {
+	if x != nil {
+		return x.HasLeader
+	}
+	return false
+}
+
+func (x *StreamHealth) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *StreamHealth) GetCurrentReplicas() int32 {
+	if x != nil {
+		return x.CurrentReplicas
+	}
+	return 0
+}
+
+// ISBSvcClusterHealth describes the health of the cluster backing the ISB Service for the streams of the pipeline.
+type ISBSvcClusterHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pipeline string `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	Vertex   string `protobuf:"bytes,3,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	// False if the cluster can not serve the management requests, e.g. it has no meta leader.
+	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	// Explains why the cluster is not available.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The health of the streams of the pipeline, empty if the cluster is not available.
+	Streams []*StreamHealth `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty"`
 }
 
-func (x *GetVertexMetricsRequest) Reset() {
-	*x = GetVertexMetricsRequest{}
+func (x *ISBSvcClusterHealth) Reset() {
+	*x = ISBSvcClusterHealth{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,13 +754,13 @@ func (x *GetVertexMetricsRequest) Reset() {
 	}
 }
 
-func (x *GetVertexMetricsRequest) String() string {
+func (x *ISBSvcClusterHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVertexMetricsRequest) ProtoMessage() {}
+func (*ISBSvcClusterHealth) ProtoMessage() {}
 
-func (x *GetVertexMetricsRequest) ProtoReflect() protoreflect.Message {
+func (x *ISBSvcClusterHealth) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,35 +772,44 @@ func (x *GetVertexMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVertexMetricsRequest.ProtoReflect.Descriptor instead.
-func (*GetVertexMetricsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ISBSvcClusterHealth.ProtoReflect.Descriptor instead.
+func (*ISBSvcClusterHealth) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetVertexMetricsRequest) GetPipeline() string {
+func (x *ISBSvcClusterHealth) GetAvailable() bool {
 	if x != nil {
-		return x.Pipeline
+		return x.Available
 	}
-	return ""
+	return false
 }
 
-func (x *GetVertexMetricsRequest) GetVertex() string {
+func (x *ISBSvcClusterHealth) GetMessage() string {
 	if x != nil {
-		return x.Vertex
+		return x.Message
 	}
 	return ""
 }
 
-type GetVertexMetricsResponse struct {
+func (x *ISBSvcClusterHealth) GetStreams() []*StreamHealth {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+type GetPipelineStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VertexMetrics []*VertexMetrics `protobuf:"bytes,1,rep,name=vertexMetrics,proto3" json:"vertexMetrics,omitempty"`
+	Status *PipelineStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The health of the ISB Service cluster in the last health check, absent if the ISB Service doesn't report it.
+	IsbSvcClusterHealth *ISBSvcClusterHealth `protobuf:"bytes,2,opt,name=isbSvcClusterHealth,proto3" json:"isbSvcClusterHealth,omitempty"`
 }
 
-func (x *GetVertexMetricsResponse) Reset() {
-	*x = GetVertexMetricsResponse{}
+func (x *GetPipelineStatusResponse) Reset() {
+	*x = GetPipelineStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,13 +817,13 @@ func (x *GetVertexMetricsResponse) Reset() {
 	}
 }
 
-func (x *GetVertexMetricsResponse) String() string {
+func (x *GetPipelineStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVertexMetricsResponse) ProtoMessage() {}
+func (*GetPipelineStatusResponse) ProtoMessage() {}
 
-func (x *GetVertexMetricsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPipelineStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -669,34 +835,36 @@ func (x *GetVertexMetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVertexMetricsResponse.ProtoReflect.Descriptor instead.
-func (*GetVertexMetricsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPipelineStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineStatusResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetVertexMetricsResponse) GetVertexMetrics() []*VertexMetrics {
+func (x *GetPipelineStatusResponse) GetStatus() *PipelineStatus {
 	if x != nil {
-		return x.VertexMetrics
+		return x.Status
 	}
 	return nil
 }
 
-// EdgeWatermark has edge to watermark mapping.
-type EdgeWatermark struct {
+func (x *GetPipelineStatusResponse) GetIsbSvcClusterHealth() *ISBSvcClusterHealth {
+	if x != nil {
+		return x.IsbSvcClusterHealth
+	}
+	return nil
+}
+
+type GetVertexMetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pipeline           string                   `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	Edge               string                   `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
-	Watermarks         []*wrapperspb.Int64Value `protobuf:"bytes,3,rep,name=watermarks,proto3" json:"watermarks,omitempty"`
-	IsWatermarkEnabled *wrapperspb.BoolValue    `protobuf:"bytes,4,opt,name=isWatermarkEnabled,proto3" json:"isWatermarkEnabled,omitempty"`
-	From               string                   `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
-	To                 string                   `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Pipeline string `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Vertex   string `protobuf:"bytes,3,opt,name=vertex,proto3" json:"vertex,omitempty"`
 }
 
-func (x *EdgeWatermark) Reset() {
-	*x = EdgeWatermark{}
+func (x *GetVertexMetricsRequest) Reset() {
+	*x = GetVertexMetricsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,13 +872,13 @@ func (x *EdgeWatermark) Reset() {
 	}
 }
 
-func (x *EdgeWatermark) String() string {
+func (x *GetVertexMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EdgeWatermark) ProtoMessage() {}
+func (*GetVertexMetricsRequest) ProtoMessage() {}
 
-func (x *EdgeWatermark) ProtoReflect() protoreflect.Message {
+func (x *GetVertexMetricsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,63 +890,35 @@ func (x *EdgeWatermark) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EdgeWatermark.ProtoReflect.Descriptor instead.
-func (*EdgeWatermark) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVertexMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetVertexMetricsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EdgeWatermark) GetPipeline() string {
+func (x *GetVertexMetricsRequest) GetPipeline() string {
 	if x != nil {
 		return x.Pipeline
 	}
 	return ""
 }
 
-func (x *EdgeWatermark) GetEdge() string {
-	if x != nil {
-		return x.Edge
-	}
-	return ""
-}
-
-func (x *EdgeWatermark) GetWatermarks() []*wrapperspb.Int64Value {
-	if x != nil {
-		return x.Watermarks
-	}
-	return nil
-}
-
-func (x *EdgeWatermark) GetIsWatermarkEnabled() *wrapperspb.BoolValue {
-	if x != nil {
-		return x.IsWatermarkEnabled
-	}
-	return nil
-}
-
-func (x *EdgeWatermark) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
-}
-
-func (x *EdgeWatermark) GetTo() string {
+func (x *GetVertexMetricsRequest) GetVertex() string {
 	if x != nil {
-		return x.To
+		return x.Vertex
 	}
 	return ""
 }
 
-type GetPipelineWatermarksResponse struct {
+type GetVertexMetricsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PipelineWatermarks []*EdgeWatermark `protobuf:"bytes,1,rep,name=pipelineWatermarks,proto3" json:"pipelineWatermarks,omitempty"`
+	VertexMetrics []*VertexMetrics `protobuf:"bytes,1,rep,name=vertexMetrics,proto3" json:"vertexMetrics,omitempty"`
 }
 
-func (x *GetPipelineWatermarksResponse) Reset() {
-	*x = GetPipelineWatermarksResponse{}
+func (x *GetVertexMetricsResponse) Reset() {
+	*x = GetVertexMetricsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -786,13 +926,13 @@ func (x *GetPipelineWatermarksResponse) Reset() {
 	}
 }
 
-func (x *GetPipelineWatermarksResponse) String() string {
+func (x *GetVertexMetricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPipelineWatermarksResponse) ProtoMessage() {}
+func (*GetVertexMetricsResponse) ProtoMessage() {}
 
-func (x *GetPipelineWatermarksResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVertexMetricsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -804,29 +944,32 @@ func (x *GetPipelineWatermarksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPipelineWatermarksResponse.ProtoReflect.Descriptor instead.
-func (*GetPipelineWatermarksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVertexMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetVertexMetricsResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetPipelineWatermarksResponse) GetPipelineWatermarks() []*EdgeWatermark {
+func (x *GetVertexMetricsResponse) GetVertexMetrics() []*VertexMetrics {
 	if x != nil {
-		return x.PipelineWatermarks
+		return x.VertexMetrics
 	}
 	return nil
 }
 
-// GetPipelineWatermarksRequest requests for the watermark for a pipeline.
-type GetPipelineWatermarksRequest struct {
+type WatchVertexMetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// The vertex to watch, all the vertices of the pipeline are watched if it's empty.
+	Vertex string `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	// Seconds between the updates, defaults to 10, the window the processing rates are calculated in.
+	IntervalSeconds int32 `protobuf:"varint,3,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"`
 }
 
-func (x *GetPipelineWatermarksRequest) Reset() {
-	*x = GetPipelineWatermarksRequest{}
+func (x *WatchVertexMetricsRequest) Reset() {
+	*x = WatchVertexMetricsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -834,13 +977,13 @@ func (x *GetPipelineWatermarksRequest) Reset() {
 	}
 }
 
-func (x *GetPipelineWatermarksRequest) String() string {
+func (x *WatchVertexMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPipelineWatermarksRequest) ProtoMessage() {}
+func (*WatchVertexMetricsRequest) ProtoMessage() {}
 
-func (x *GetPipelineWatermarksRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchVertexMetricsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -852,41 +995,2826 @@ func (x *GetPipelineWatermarksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPipelineWatermarksRequest.ProtoReflect.Descriptor instead.
-func (*GetPipelineWatermarksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchVertexMetricsRequest.ProtoReflect.Descriptor instead.
+func (*WatchVertexMetricsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetPipelineWatermarksRequest) GetPipeline() string {
+func (x *WatchVertexMetricsRequest) GetPipeline() string {
 	if x != nil {
 		return x.Pipeline
 	}
 	return ""
 }
 
-var File_pkg_apis_proto_daemon_daemon_proto protoreflect.FileDescriptor
+func (x *WatchVertexMetricsRequest) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
 
-var file_pkg_apis_proto_daemon_daemon_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x04, 0x0a, 0x0a, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+func (x *WatchVertexMetricsRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+// WatchVertexMetricsResponse is an update of the metrics of a vertex.
+type WatchVertexMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Vertex   string `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	// The time in milliseconds since the epoch when the metrics are collected.
+	Timestamp *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Processing rates of the vertex summed up across the partitions, keyed by the lookback seconds.
+	ProcessingRates map[string]*wrapperspb.DoubleValue `protobuf:"bytes,4,rep,name=processingRates,proto3" json:"processingRates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Pendings of the vertex summed up across the partitions, keyed by the lookback seconds, a lookback seconds is
+	// absent if the pending of any partition is not available.
+	Pendings map[string]*wrapperspb.Int64Value `protobuf:"bytes,5,rep,name=pendings,proto3" json:"pendings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Metrics of each partition of the vertex, including the processing rates of the pods reading from it.
+	Partitions []*VertexMetrics `protobuf:"bytes,6,rep,name=partitions,proto3" json:"partitions,omitempty"`
+}
+
+func (x *WatchVertexMetricsResponse) Reset() {
+	*x = WatchVertexMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchVertexMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVertexMetricsResponse) ProtoMessage() {}
+
+func (x *WatchVertexMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVertexMetricsResponse.ProtoReflect.Descriptor instead.
+func (*WatchVertexMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchVertexMetricsResponse) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *WatchVertexMetricsResponse) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *WatchVertexMetricsResponse) GetTimestamp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WatchVertexMetricsResponse) GetProcessingRates() map[string]*wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ProcessingRates
+	}
+	return nil
+}
+
+func (x *WatchVertexMetricsResponse) GetPendings() map[string]*wrapperspb.Int64Value {
+	if x != nil {
+		return x.Pendings
+	}
+	return nil
+}
+
+func (x *WatchVertexMetricsResponse) GetPartitions() []*VertexMetrics {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+// PodRate is the processing rate of a pod, summed up across the partitions it reads from.
+type PodRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pod  string                  `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
+	Rate *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// "Normal" if the pod processes at least half as fast as the median pod, "Slow" if it processes less than half as
+	// fast, or "Stuck" if it does not process anything while the median pod does.
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *PodRate) Reset() {
+	*x = PodRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodRate) ProtoMessage() {}
+
+func (x *PodRate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodRate.ProtoReflect.Descriptor instead.
+func (*PodRate) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PodRate) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *PodRate) GetRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *PodRate) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// VertexPodRates is the processing rates of the pods of a vertex over its lookback seconds.
+type VertexPodRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline        string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Vertex          string `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	LookbackSeconds int32  `protobuf:"varint,3,opt,name=lookbackSeconds,proto3" json:"lookbackSeconds,omitempty"`
+	// The median of the rates of the pods, the pods are compared with it.
+	MedianRate *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=medianRate,proto3" json:"medianRate,omitempty"`
+	// The pods sorted by the rate, the slowest first.
+	Pods []*PodRate `protobuf:"bytes,5,rep,name=pods,proto3" json:"pods,omitempty"`
+}
+
+func (x *VertexPodRates) Reset() {
+	*x = VertexPodRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VertexPodRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VertexPodRates) ProtoMessage() {}
+
+func (x *VertexPodRates) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VertexPodRates.ProtoReflect.Descriptor instead.
+func (*VertexPodRates) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VertexPodRates) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *VertexPodRates) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *VertexPodRates) GetLookbackSeconds() int32 {
+	if x != nil {
+		return x.LookbackSeconds
+	}
+	return 0
+}
+
+func (x *VertexPodRates) GetMedianRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.MedianRate
+	}
+	return nil
+}
+
+func (x *VertexPodRates) GetPods() []*PodRate {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+type GetVertexPodRatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Vertex   string `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+}
+
+func (x *GetVertexPodRatesRequest) Reset() {
+	*x = GetVertexPodRatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVertexPodRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVertexPodRatesRequest) ProtoMessage() {}
+
+func (x *GetVertexPodRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVertexPodRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetVertexPodRatesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetVertexPodRatesRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *GetVertexPodRatesRequest) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+type GetVertexPodRatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PodRates *VertexPodRates `protobuf:"bytes,1,opt,name=podRates,proto3" json:"podRates,omitempty"`
+}
+
+func (x *GetVertexPodRatesResponse) Reset() {
+	*x = GetVertexPodRatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVertexPodRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVertexPodRatesResponse) ProtoMessage() {}
+
+func (x *GetVertexPodRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVertexPodRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetVertexPodRatesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetVertexPodRatesResponse) GetPodRates() *VertexPodRates {
+	if x != nil {
+		return x.PodRates
+	}
+	return nil
+}
+
+// DrainEstimate is the estimated time it takes to clear a backlog if the sources stop producing now.
+type DrainEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "Known" if the backlog is expected to drain in the seconds, "Unknown" if the processing rate is not available yet,
+	// or "Infinite" if there is a backlog, but it is not being processed at all.
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Seconds to drain the backlog, only set when the state is "Known".
+	Seconds *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (x *DrainEstimate) Reset() {
+	*x = DrainEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainEstimate) ProtoMessage() {}
+
+func (x *DrainEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainEstimate.ProtoReflect.Descriptor instead.
+func (*DrainEstimate) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DrainEstimate) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DrainEstimate) GetSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Seconds
+	}
+	return nil
+}
+
+// BufferDrainEstimate is the drain estimate of a single buffer partition, derived from its own pending count.
+type BufferDrainEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buffer string `protobuf:"bytes,1,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	Vertex string `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	// The pending count of the buffer, -1 if it's not available.
+	PendingCount *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=pendingCount,proto3" json:"pendingCount,omitempty"`
+	// The processing rate of the buffer, -1 if it's not available.
+	ProcessingRate *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=processingRate,proto3" json:"processingRate,omitempty"`
+	Estimate       *DrainEstimate          `protobuf:"bytes,5,opt,name=estimate,proto3" json:"estimate,omitempty"`
+}
+
+func (x *BufferDrainEstimate) Reset() {
+	*x = BufferDrainEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferDrainEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferDrainEstimate) ProtoMessage() {}
+
+func (x *BufferDrainEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferDrainEstimate.ProtoReflect.Descriptor instead.
+func (*BufferDrainEstimate) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BufferDrainEstimate) GetBuffer() string {
+	if x != nil {
+		return x.Buffer
+	}
+	return ""
+}
+
+func (x *BufferDrainEstimate) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *BufferDrainEstimate) GetPendingCount() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.PendingCount
+	}
+	return nil
+}
+
+func (x *BufferDrainEstimate) GetProcessingRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ProcessingRate
+	}
+	return nil
+}
+
+func (x *BufferDrainEstimate) GetEstimate() *DrainEstimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+// VertexDrainEstimate is the estimated time until a vertex has processed its own backlog and everything still pending
+// upstream of it.
+type VertexDrainEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vertex   string         `protobuf:"bytes,1,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	Estimate *DrainEstimate `protobuf:"bytes,2,opt,name=estimate,proto3" json:"estimate,omitempty"`
+}
+
+func (x *VertexDrainEstimate) Reset() {
+	*x = VertexDrainEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VertexDrainEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VertexDrainEstimate) ProtoMessage() {}
+
+func (x *VertexDrainEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VertexDrainEstimate.ProtoReflect.Descriptor instead.
+func (*VertexDrainEstimate) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VertexDrainEstimate) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *VertexDrainEstimate) GetEstimate() *DrainEstimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+// PipelineDrainEstimate is the drain estimate of the whole pipeline, it is the maximum of all the vertex estimates.
+type PipelineDrainEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string                 `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Buffers  []*BufferDrainEstimate `protobuf:"bytes,2,rep,name=buffers,proto3" json:"buffers,omitempty"`
+	Vertices []*VertexDrainEstimate `protobuf:"bytes,3,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	Estimate *DrainEstimate         `protobuf:"bytes,4,opt,name=estimate,proto3" json:"estimate,omitempty"`
+}
+
+func (x *PipelineDrainEstimate) Reset() {
+	*x = PipelineDrainEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineDrainEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineDrainEstimate) ProtoMessage() {}
+
+func (x *PipelineDrainEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineDrainEstimate.ProtoReflect.Descriptor instead.
+func (*PipelineDrainEstimate) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PipelineDrainEstimate) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *PipelineDrainEstimate) GetBuffers() []*BufferDrainEstimate {
+	if x != nil {
+		return x.Buffers
+	}
+	return nil
+}
+
+func (x *PipelineDrainEstimate) GetVertices() []*VertexDrainEstimate {
+	if x != nil {
+		return x.Vertices
+	}
+	return nil
+}
+
+func (x *PipelineDrainEstimate) GetEstimate() *DrainEstimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+type GetPipelineDrainEstimateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+}
+
+func (x *GetPipelineDrainEstimateRequest) Reset() {
+	*x = GetPipelineDrainEstimateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineDrainEstimateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineDrainEstimateRequest) ProtoMessage() {}
+
+func (x *GetPipelineDrainEstimateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineDrainEstimateRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineDrainEstimateRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPipelineDrainEstimateRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+type GetPipelineDrainEstimateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Estimate *PipelineDrainEstimate `protobuf:"bytes,1,opt,name=estimate,proto3" json:"estimate,omitempty"`
+}
+
+func (x *GetPipelineDrainEstimateResponse) Reset() {
+	*x = GetPipelineDrainEstimateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineDrainEstimateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineDrainEstimateResponse) ProtoMessage() {}
+
+func (x *GetPipelineDrainEstimateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineDrainEstimateResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineDrainEstimateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPipelineDrainEstimateResponse) GetEstimate() *PipelineDrainEstimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+// EdgeCapacity compares the observed throughput of an edge with its configured ceiling.
+type EdgeCapacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// The rate at which the to vertex reads the buffers of the edge, -1 if it's not available.
+	ObservedRate *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=observedRate,proto3" json:"observedRate,omitempty"`
+	// The usable length of the buffers of the edge, divided by the target processing seconds of the to vertex.
+	CapacityRate *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=capacityRate,proto3" json:"capacityRate,omitempty"`
+	// The percentage of the capacity not used by the observed rate, negative if the edge is running above its capacity.
+	HeadroomPercentage *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=headroomPercentage,proto3" json:"headroomPercentage,omitempty"`
+	// Whether the edge is running above 80% of its capacity.
+	Hot bool `protobuf:"varint,6,opt,name=hot,proto3" json:"hot,omitempty"`
+}
+
+func (x *EdgeCapacity) Reset() {
+	*x = EdgeCapacity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeCapacity) ProtoMessage() {}
+
+func (x *EdgeCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeCapacity.ProtoReflect.Descriptor instead.
+func (*EdgeCapacity) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EdgeCapacity) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EdgeCapacity) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *EdgeCapacity) GetObservedRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ObservedRate
+	}
+	return nil
+}
+
+func (x *EdgeCapacity) GetCapacityRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.CapacityRate
+	}
+	return nil
+}
+
+func (x *EdgeCapacity) GetHeadroomPercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.HeadroomPercentage
+	}
+	return nil
+}
+
+func (x *EdgeCapacity) GetHot() bool {
+	if x != nil {
+		return x.Hot
+	}
+	return false
+}
+
+// PipelineEdgeCapacity is the capacity comparison of all the edges of the pipeline.
+type PipelineEdgeCapacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string          `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Edges    []*EdgeCapacity `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *PipelineEdgeCapacity) Reset() {
+	*x = PipelineEdgeCapacity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PipelineEdgeCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineEdgeCapacity) ProtoMessage() {}
+
+func (x *PipelineEdgeCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineEdgeCapacity.ProtoReflect.Descriptor instead.
+func (*PipelineEdgeCapacity) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PipelineEdgeCapacity) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *PipelineEdgeCapacity) GetEdges() []*EdgeCapacity {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type GetPipelineEdgeCapacityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+}
+
+func (x *GetPipelineEdgeCapacityRequest) Reset() {
+	*x = GetPipelineEdgeCapacityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineEdgeCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineEdgeCapacityRequest) ProtoMessage() {}
+
+func (x *GetPipelineEdgeCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineEdgeCapacityRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineEdgeCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPipelineEdgeCapacityRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+type GetPipelineEdgeCapacityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Capacity *PipelineEdgeCapacity `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *GetPipelineEdgeCapacityResponse) Reset() {
+	*x = GetPipelineEdgeCapacityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineEdgeCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineEdgeCapacityResponse) ProtoMessage() {}
+
+func (x *GetPipelineEdgeCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineEdgeCapacityResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineEdgeCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPipelineEdgeCapacityResponse) GetCapacity() *PipelineEdgeCapacity {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+// PartitionMove is a source partition which moved from one replica of a vertex to another.
+type PartitionMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partition   int32 `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	FromReplica int32 `protobuf:"varint,2,opt,name=fromReplica,proto3" json:"fromReplica,omitempty"`
+	ToReplica   int32 `protobuf:"varint,3,opt,name=toReplica,proto3" json:"toReplica,omitempty"`
+	// The time in milliseconds since the epoch of the first heartbeat of the watermark publisher of the partition after
+	// the move, absent if the publisher has not resumed yet.
+	PublisherResumedAt *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=publisherResumedAt,proto3" json:"publisherResumedAt,omitempty"`
+}
+
+func (x *PartitionMove) Reset() {
+	*x = PartitionMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionMove) ProtoMessage() {}
+
+func (x *PartitionMove) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionMove.ProtoReflect.Descriptor instead.
+func (*PartitionMove) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PartitionMove) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *PartitionMove) GetFromReplica() int32 {
+	if x != nil {
+		return x.FromReplica
+	}
+	return 0
+}
+
+func (x *PartitionMove) GetToReplica() int32 {
+	if x != nil {
+		return x.ToReplica
+	}
+	return 0
+}
+
+func (x *PartitionMove) GetPublisherResumedAt() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.PublisherResumedAt
+	}
+	return nil
+}
+
+// RebalanceReport describes the partitions of a source vertex which moved when its replica count changed.
+type RebalanceReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vertex       string `protobuf:"bytes,1,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	FromReplicas int32  `protobuf:"varint,2,opt,name=fromReplicas,proto3" json:"fromReplicas,omitempty"`
+	ToReplicas   int32  `protobuf:"varint,3,opt,name=toReplicas,proto3" json:"toReplicas,omitempty"`
+	// The time in milliseconds since the epoch when the rebalancing is detected.
+	Time  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Moves []*PartitionMove       `protobuf:"bytes,5,rep,name=moves,proto3" json:"moves,omitempty"`
+	// Whether the watermark publishers of all the moved partitions have resumed.
+	Resumed bool `protobuf:"varint,6,opt,name=resumed,proto3" json:"resumed,omitempty"`
+}
+
+func (x *RebalanceReport) Reset() {
+	*x = RebalanceReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceReport) ProtoMessage() {}
+
+func (x *RebalanceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceReport.ProtoReflect.Descriptor instead.
+func (*RebalanceReport) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RebalanceReport) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *RebalanceReport) GetFromReplicas() int32 {
+	if x != nil {
+		return x.FromReplicas
+	}
+	return 0
+}
+
+func (x *RebalanceReport) GetToReplicas() int32 {
+	if x != nil {
+		return x.ToReplicas
+	}
+	return 0
+}
+
+func (x *RebalanceReport) GetTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RebalanceReport) GetMoves() []*PartitionMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *RebalanceReport) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+type ListRebalanceReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+}
+
+func (x *ListRebalanceReportsRequest) Reset() {
+	*x = ListRebalanceReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRebalanceReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRebalanceReportsRequest) ProtoMessage() {}
+
+func (x *ListRebalanceReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRebalanceReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListRebalanceReportsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListRebalanceReportsRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+type ListRebalanceReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The most recent rebalancing reports, ordered by time.
+	Reports []*RebalanceReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *ListRebalanceReportsResponse) Reset() {
+	*x = ListRebalanceReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRebalanceReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRebalanceReportsResponse) ProtoMessage() {}
+
+func (x *ListRebalanceReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRebalanceReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListRebalanceReportsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListRebalanceReportsResponse) GetReports() []*RebalanceReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// BufferMessage is a message of a buffer, decoded for debugging.
+type BufferMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sequence of the message in the buffer, the stream sequence of JetStream, or the entry ID of Redis.
+	Sequence string `protobuf:"bytes,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The time in milliseconds since the epoch when the message was written to the buffer.
+	WriteTime *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=writeTime,proto3" json:"writeTime,omitempty"`
+	Kind      string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id        string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// The event time of the message in milliseconds since the epoch.
+	EventTime *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=eventTime,proto3" json:"eventTime,omitempty"`
+	Keys      []string               `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
+	Headers   map[string]string      `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The ID of the key the payload is encrypted with, empty if the payload is not encrypted.
+	EncryptionKeyID string `protobuf:"bytes,8,opt,name=encryptionKeyID,proto3" json:"encryptionKeyID,omitempty"`
+	// The payload of the message, it's the ciphertext if the payload is encrypted in the buffer.
+	Payload []byte `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *BufferMessage) Reset() {
+	*x = BufferMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferMessage) ProtoMessage() {}
+
+func (x *BufferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferMessage.ProtoReflect.Descriptor instead.
+func (*BufferMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BufferMessage) GetSequence() string {
+	if x != nil {
+		return x.Sequence
+	}
+	return ""
+}
+
+func (x *BufferMessage) GetWriteTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.WriteTime
+	}
+	return nil
+}
+
+func (x *BufferMessage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BufferMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BufferMessage) GetEventTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *BufferMessage) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *BufferMessage) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *BufferMessage) GetEncryptionKeyID() string {
+	if x != nil {
+		return x.EncryptionKeyID
+	}
+	return ""
+}
+
+func (x *BufferMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ReadBufferHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Buffer   string `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// Starts the read from the first message written at or after the time in RFC3339 format, it can not be set together
+	// with the startSequence. The read starts from the oldest message if neither is set.
+	StartTime string `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// Starts the read from the message with the sequence, the stream sequence of JetStream or the entry ID of Redis.
+	StartSequence string `protobuf:"bytes,4,opt,name=startSequence,proto3" json:"startSequence,omitempty"`
+	// The max number of messages to read, defaults to 100, at most 10000.
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The max number of messages to stream per second, defaults to 10, at most 100.
+	Rate int32 `protobuf:"varint,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	// The max duration of the session, e.g. "30s", defaults to 30 seconds, at most 5 minutes.
+	Duration string `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *ReadBufferHistoryRequest) Reset() {
+	*x = ReadBufferHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadBufferHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadBufferHistoryRequest) ProtoMessage() {}
+
+func (x *ReadBufferHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadBufferHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadBufferHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReadBufferHistoryRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *ReadBufferHistoryRequest) GetBuffer() string {
+	if x != nil {
+		return x.Buffer
+	}
+	return ""
+}
+
+func (x *ReadBufferHistoryRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ReadBufferHistoryRequest) GetStartSequence() string {
+	if x != nil {
+		return x.StartSequence
+	}
+	return ""
+}
+
+func (x *ReadBufferHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadBufferHistoryRequest) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ReadBufferHistoryRequest) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+type ReadBufferHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *BufferMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ReadBufferHistoryResponse) Reset() {
+	*x = ReadBufferHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadBufferHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadBufferHistoryResponse) ProtoMessage() {}
+
+func (x *ReadBufferHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadBufferHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadBufferHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReadBufferHistoryResponse) GetMessage() *BufferMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type PurgeBufferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Buffer   string `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// The name of the buffer, the purge is rejected unless it's confirmed with the buffer name.
+	Confirm string `protobuf:"bytes,3,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	// Only purges the messages written before the time in RFC3339 format.
+	Before string `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	// Keeps the number of the most recent messages.
+	KeepRecent *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=keepRecent,proto3" json:"keepRecent,omitempty"`
+	// Purges the messages up to, and including, the offset, the partition must be paused first. It can not be set
+	// together with before or keepRecent.
+	UpTo string `protobuf:"bytes,6,opt,name=upTo,proto3" json:"upTo,omitempty"`
+}
+
+func (x *PurgeBufferRequest) Reset() {
+	*x = PurgeBufferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeBufferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeBufferRequest) ProtoMessage() {}
+
+func (x *PurgeBufferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeBufferRequest.ProtoReflect.Descriptor instead.
+func (*PurgeBufferRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PurgeBufferRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *PurgeBufferRequest) GetBuffer() string {
+	if x != nil {
+		return x.Buffer
+	}
+	return ""
+}
+
+func (x *PurgeBufferRequest) GetConfirm() string {
+	if x != nil {
+		return x.Confirm
+	}
+	return ""
+}
+
+func (x *PurgeBufferRequest) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *PurgeBufferRequest) GetKeepRecent() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.KeepRecent
+	}
+	return nil
+}
+
+func (x *PurgeBufferRequest) GetUpTo() string {
+	if x != nil {
+		return x.UpTo
+	}
+	return ""
+}
+
+// PurgeBufferResponse is the state of the buffer after the purge.
+type PurgeBufferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buffer        string `protobuf:"bytes,1,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	PendingCount  int64  `protobuf:"varint,2,opt,name=pendingCount,proto3" json:"pendingCount,omitempty"`
+	TotalMessages int64  `protobuf:"varint,3,opt,name=totalMessages,proto3" json:"totalMessages,omitempty"`
+	// The watermark buckets whose offset timelines are reset.
+	ResetBuckets []string `protobuf:"bytes,4,rep,name=resetBuckets,proto3" json:"resetBuckets,omitempty"`
+}
+
+func (x *PurgeBufferResponse) Reset() {
+	*x = PurgeBufferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeBufferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeBufferResponse) ProtoMessage() {}
+
+func (x *PurgeBufferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeBufferResponse.ProtoReflect.Descriptor instead.
+func (*PurgeBufferResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PurgeBufferResponse) GetBuffer() string {
+	if x != nil {
+		return x.Buffer
+	}
+	return ""
+}
+
+func (x *PurgeBufferResponse) GetPendingCount() int64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *PurgeBufferResponse) GetTotalMessages() int64 {
+	if x != nil {
+		return x.TotalMessages
+	}
+	return 0
+}
+
+func (x *PurgeBufferResponse) GetResetBuckets() []string {
+	if x != nil {
+		return x.ResetBuckets
+	}
+	return nil
+}
+
+// PeekedMessage is a message peeked from a buffer, truncated and redacted by the daemon server.
+type PeekedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *BufferMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// The size of the payload in the buffer, before it's truncated or redacted.
+	PayloadSize int64 `protobuf:"varint,2,opt,name=payloadSize,proto3" json:"payloadSize,omitempty"`
+	// Whether the payload is truncated to the max payload bytes of the request.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Whether the payload is dropped by a redactor.
+	Redacted bool `protobuf:"varint,4,opt,name=redacted,proto3" json:"redacted,omitempty"`
+}
+
+func (x *PeekedMessage) Reset() {
+	*x = PeekedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeekedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeekedMessage) ProtoMessage() {}
+
+func (x *PeekedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeekedMessage.ProtoReflect.Descriptor instead.
+func (*PeekedMessage) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PeekedMessage) GetMessage() *BufferMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *PeekedMessage) GetPayloadSize() int64 {
+	if x != nil {
+		return x.PayloadSize
+	}
+	return 0
+}
+
+func (x *PeekedMessage) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *PeekedMessage) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+type PeekMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Buffer   string `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// The sequence of the first message, the stream sequence of JetStream or the entry ID of Redis, the oldest message is
+	// used if it's not set.
+	Offset string `protobuf:"bytes,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The max number of messages to return, defaults to 10, at most 100.
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// The max bytes of each payload returned, defaults to 4096, at most 65536.
+	MaxPayloadBytes int32 `protobuf:"varint,5,opt,name=maxPayloadBytes,proto3" json:"maxPayloadBytes,omitempty"`
+}
+
+func (x *PeekMessagesRequest) Reset() {
+	*x = PeekMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeekMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeekMessagesRequest) ProtoMessage() {}
+
+func (x *PeekMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeekMessagesRequest.ProtoReflect.Descriptor instead.
+func (*PeekMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PeekMessagesRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *PeekMessagesRequest) GetBuffer() string {
+	if x != nil {
+		return x.Buffer
+	}
+	return ""
+}
+
+func (x *PeekMessagesRequest) GetOffset() string {
+	if x != nil {
+		return x.Offset
+	}
+	return ""
+}
+
+func (x *PeekMessagesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PeekMessagesRequest) GetMaxPayloadBytes() int32 {
+	if x != nil {
+		return x.MaxPayloadBytes
+	}
+	return 0
+}
+
+type PeekMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*PeekedMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *PeekMessagesResponse) Reset() {
+	*x = PeekMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeekMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeekMessagesResponse) ProtoMessage() {}
+
+func (x *PeekMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeekMessagesResponse.ProtoReflect.Descriptor instead.
+func (*PeekMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PeekMessagesResponse) GetMessages() []*PeekedMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// ConsumerLag is the lag of a consumer reading a buffer, the offsets are sequences as the one of a BufferMessage, empty
+// if they are not available.
+type ConsumerLag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The offset of the last message written to the buffer.
+	ProducedOffset string `protobuf:"bytes,2,opt,name=producedOffset,proto3" json:"producedOffset,omitempty"`
+	// The offset of the last message delivered to the consumer.
+	DeliveredOffset string `protobuf:"bytes,3,opt,name=deliveredOffset,proto3" json:"deliveredOffset,omitempty"`
+	// The offset up to which all the messages delivered to the consumer are acknowledged.
+	AckedOffset string `protobuf:"bytes,4,opt,name=ackedOffset,proto3" json:"ackedOffset,omitempty"`
+	// The number of messages not delivered to the consumer yet.
+	PendingCount int64 `protobuf:"varint,5,opt,name=pendingCount,proto3" json:"pendingCount,omitempty"`
+	// The number of messages delivered to the consumer and not acknowledged yet.
+	AckPendingCount int64 `protobuf:"varint,6,opt,name=ackPendingCount,proto3" json:"ackPendingCount,omitempty"`
+	// The time in milliseconds since the epoch when the consumer was last active, absent if it's not available.
+	LastActive *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=lastActive,proto3" json:"lastActive,omitempty"`
+}
+
+func (x *ConsumerLag) Reset() {
+	*x = ConsumerLag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerLag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerLag) ProtoMessage() {}
+
+func (x *ConsumerLag) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerLag.ProtoReflect.Descriptor instead.
+func (*ConsumerLag) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ConsumerLag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConsumerLag) GetProducedOffset() string {
+	if x != nil {
+		return x.ProducedOffset
+	}
+	return ""
+}
+
+func (x *ConsumerLag) GetDeliveredOffset() string {
+	if x != nil {
+		return x.DeliveredOffset
+	}
+	return ""
+}
+
+func (x *ConsumerLag) GetAckedOffset() string {
+	if x != nil {
+		return x.AckedOffset
+	}
+	return ""
+}
+
+func (x *ConsumerLag) GetPendingCount() int64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *ConsumerLag) GetAckPendingCount() int64 {
+	if x != nil {
+		return x.AckPendingCount
+	}
+	return 0
+}
+
+func (x *ConsumerLag) GetLastActive() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.LastActive
+	}
+	return nil
+}
+
+// QuotaUsage is the quota of the pipeline on the ISB Service with the usage of it, a zero limit means no limit.
+type QuotaUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxBytes   int64 `protobuf:"varint,1,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
+	MaxStreams int32 `protobuf:"varint,2,opt,name=maxStreams,proto3" json:"maxStreams,omitempty"`
+	Bytes      int64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Streams    int32 `protobuf:"varint,4,opt,name=streams,proto3" json:"streams,omitempty"`
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *QuotaUsage) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMaxStreams() int32 {
+	if x != nil {
+		return x.MaxStreams
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetStreams() int32 {
+	if x != nil {
+		return x.Streams
+	}
+	return 0
+}
+
+type WatchBufferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Buffer   string `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// The max duration of the session, e.g. "10m", defaults to 5 minutes, at most 1 hour.
+	Duration string `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *WatchBufferRequest) Reset() {
+	*x = WatchBufferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBufferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBufferRequest) ProtoMessage() {}
+
+func (x *WatchBufferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBufferRequest.ProtoReflect.Descriptor instead.
+func (*WatchBufferRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WatchBufferRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *WatchBufferRequest) GetBuffer() string {
+	if x != nil {
+		return x.Buffer
+	}
+	return ""
+}
+
+func (x *WatchBufferRequest) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+// WatchBufferResponse is an update of the info of a buffer.
+type WatchBufferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time in milliseconds since the epoch when the info is sent.
+	Timestamp       *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Buffer          string                 `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	PendingCount    int64                  `protobuf:"varint,3,opt,name=pendingCount,proto3" json:"pendingCount,omitempty"`
+	AckPendingCount int64                  `protobuf:"varint,4,opt,name=ackPendingCount,proto3" json:"ackPendingCount,omitempty"`
+	TotalMessages   int64                  `protobuf:"varint,5,opt,name=totalMessages,proto3" json:"totalMessages,omitempty"`
+	TotalBytes      int64                  `protobuf:"varint,6,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	// The max length of the buffer, -1 if it's unknown.
+	MaxLength int64 `protobuf:"varint,7,opt,name=maxLength,proto3" json:"maxLength,omitempty"`
+	// The percentage of the max length used by the buffer, -1 if the max length is unknown.
+	UsagePercentage float64 `protobuf:"fixed64,8,opt,name=usagePercentage,proto3" json:"usagePercentage,omitempty"`
+	// The time in milliseconds since the epoch when the oldest message not acknowledged yet was written, absent if the
+	// buffer is empty or it's not available.
+	OldestPendingTime *wrapperspb.Int64Value `protobuf:"bytes,9,opt,name=oldestPendingTime,proto3" json:"oldestPendingTime,omitempty"`
+	// The time in milliseconds since the epoch when the oldest message existing in the buffer was written, absent if the
+	// buffer is empty or it's not available.
+	OldestMessageTime *wrapperspb.Int64Value `protobuf:"bytes,10,opt,name=oldestMessageTime,proto3" json:"oldestMessageTime,omitempty"`
+	// The quota usage of the pipeline on the ISB Service, absent if no quota is enforced.
+	Quota *QuotaUsage `protobuf:"bytes,11,opt,name=quota,proto3" json:"quota,omitempty"`
+	// The lag of each consumer reading the buffer, empty if it's not available.
+	Consumers []*ConsumerLag `protobuf:"bytes,12,rep,name=consumers,proto3" json:"consumers,omitempty"`
+}
+
+func (x *WatchBufferResponse) Reset() {
+	*x = WatchBufferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBufferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBufferResponse) ProtoMessage() {}
+
+func (x *WatchBufferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBufferResponse.ProtoReflect.Descriptor instead.
+func (*WatchBufferResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WatchBufferResponse) GetTimestamp() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WatchBufferResponse) GetBuffer() string {
+	if x != nil {
+		return x.Buffer
+	}
+	return ""
+}
+
+func (x *WatchBufferResponse) GetPendingCount() int64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *WatchBufferResponse) GetAckPendingCount() int64 {
+	if x != nil {
+		return x.AckPendingCount
+	}
+	return 0
+}
+
+func (x *WatchBufferResponse) GetTotalMessages() int64 {
+	if x != nil {
+		return x.TotalMessages
+	}
+	return 0
+}
+
+func (x *WatchBufferResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *WatchBufferResponse) GetMaxLength() int64 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *WatchBufferResponse) GetUsagePercentage() float64 {
+	if x != nil {
+		return x.UsagePercentage
+	}
+	return 0
+}
+
+func (x *WatchBufferResponse) GetOldestPendingTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OldestPendingTime
+	}
+	return nil
+}
+
+func (x *WatchBufferResponse) GetOldestMessageTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.OldestMessageTime
+	}
+	return nil
+}
+
+func (x *WatchBufferResponse) GetQuota() *QuotaUsage {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *WatchBufferResponse) GetConsumers() []*ConsumerLag {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+// GeneratorConfig is the runtime config of a generator source, a field is absent if it's not set.
+type GeneratorConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of the records generated for every key on a tick.
+	Rpu *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=rpu,proto3" json:"rpu,omitempty"`
+	// The size of each generated message.
+	MsgSize *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=msgSize,proto3" json:"msgSize,omitempty"`
+	// The number of the unique keys.
+	KeyCount *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=keyCount,proto3" json:"keyCount,omitempty"`
+}
+
+func (x *GeneratorConfig) Reset() {
+	*x = GeneratorConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratorConfig) ProtoMessage() {}
+
+func (x *GeneratorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratorConfig.ProtoReflect.Descriptor instead.
+func (*GeneratorConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GeneratorConfig) GetRpu() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Rpu
+	}
+	return nil
+}
+
+func (x *GeneratorConfig) GetMsgSize() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MsgSize
+	}
+	return nil
+}
+
+func (x *GeneratorConfig) GetKeyCount() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.KeyCount
+	}
+	return nil
+}
+
+// ReplicaGeneratorConfig is the runtime config of the generator of a replica, or the error getting or updating it.
+type ReplicaGeneratorConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replica int32            `protobuf:"varint,1,opt,name=replica,proto3" json:"replica,omitempty"`
+	Config  *GeneratorConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// The error getting or updating the config of the replica, empty if it succeeded.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ReplicaGeneratorConfig) Reset() {
+	*x = ReplicaGeneratorConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaGeneratorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaGeneratorConfig) ProtoMessage() {}
+
+func (x *ReplicaGeneratorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaGeneratorConfig.ProtoReflect.Descriptor instead.
+func (*ReplicaGeneratorConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReplicaGeneratorConfig) GetReplica() int32 {
+	if x != nil {
+		return x.Replica
+	}
+	return 0
+}
+
+func (x *ReplicaGeneratorConfig) GetConfig() *GeneratorConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ReplicaGeneratorConfig) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetGeneratorConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Vertex   string `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+}
+
+func (x *GetGeneratorConfigRequest) Reset() {
+	*x = GetGeneratorConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGeneratorConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneratorConfigRequest) ProtoMessage() {}
+
+func (x *GetGeneratorConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneratorConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetGeneratorConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetGeneratorConfigRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *GetGeneratorConfigRequest) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+type GetGeneratorConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vertex   string                    `protobuf:"bytes,1,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	Replicas []*ReplicaGeneratorConfig `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *GetGeneratorConfigResponse) Reset() {
+	*x = GetGeneratorConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGeneratorConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeneratorConfigResponse) ProtoMessage() {}
+
+func (x *GetGeneratorConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeneratorConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetGeneratorConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetGeneratorConfigResponse) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *GetGeneratorConfigResponse) GetReplicas() []*ReplicaGeneratorConfig {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+type UpdateGeneratorConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Vertex   string `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	// The fields to update, the fields not set are left unchanged.
+	Config *GeneratorConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *UpdateGeneratorConfigRequest) Reset() {
+	*x = UpdateGeneratorConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGeneratorConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGeneratorConfigRequest) ProtoMessage() {}
+
+func (x *UpdateGeneratorConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGeneratorConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGeneratorConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateGeneratorConfigRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *UpdateGeneratorConfigRequest) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *UpdateGeneratorConfigRequest) GetConfig() *GeneratorConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateGeneratorConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vertex   string                    `protobuf:"bytes,1,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	Replicas []*ReplicaGeneratorConfig `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *UpdateGeneratorConfigResponse) Reset() {
+	*x = UpdateGeneratorConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGeneratorConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGeneratorConfigResponse) ProtoMessage() {}
+
+func (x *UpdateGeneratorConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGeneratorConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGeneratorConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateGeneratorConfigResponse) GetVertex() string {
+	if x != nil {
+		return x.Vertex
+	}
+	return ""
+}
+
+func (x *UpdateGeneratorConfigResponse) GetReplicas() []*ReplicaGeneratorConfig {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+// EdgeWatermark has edge to watermark mapping.
+type EdgeWatermark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline           string                   `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Edge               string                   `protobuf:"bytes,2,opt,name=edge,proto3" json:"edge,omitempty"`
+	Watermarks         []*wrapperspb.Int64Value `protobuf:"bytes,3,rep,name=watermarks,proto3" json:"watermarks,omitempty"`
+	IsWatermarkEnabled *wrapperspb.BoolValue    `protobuf:"bytes,4,opt,name=isWatermarkEnabled,proto3" json:"isWatermarkEnabled,omitempty"`
+	From               string                   `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To                 string                   `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *EdgeWatermark) Reset() {
+	*x = EdgeWatermark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeWatermark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeWatermark) ProtoMessage() {}
+
+func (x *EdgeWatermark) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeWatermark.ProtoReflect.Descriptor instead.
+func (*EdgeWatermark) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *EdgeWatermark) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+func (x *EdgeWatermark) GetEdge() string {
+	if x != nil {
+		return x.Edge
+	}
+	return ""
+}
+
+func (x *EdgeWatermark) GetWatermarks() []*wrapperspb.Int64Value {
+	if x != nil {
+		return x.Watermarks
+	}
+	return nil
+}
+
+func (x *EdgeWatermark) GetIsWatermarkEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsWatermarkEnabled
+	}
+	return nil
+}
+
+func (x *EdgeWatermark) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EdgeWatermark) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetPipelineWatermarksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineWatermarks []*EdgeWatermark `protobuf:"bytes,1,rep,name=pipelineWatermarks,proto3" json:"pipelineWatermarks,omitempty"`
+}
+
+func (x *GetPipelineWatermarksResponse) Reset() {
+	*x = GetPipelineWatermarksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineWatermarksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineWatermarksResponse) ProtoMessage() {}
+
+func (x *GetPipelineWatermarksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineWatermarksResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineWatermarksResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPipelineWatermarksResponse) GetPipelineWatermarks() []*EdgeWatermark {
+	if x != nil {
+		return x.PipelineWatermarks
+	}
+	return nil
+}
+
+// GetPipelineWatermarksRequest requests for the watermark for a pipeline.
+type GetPipelineWatermarksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pipeline string `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+}
+
+func (x *GetPipelineWatermarksRequest) Reset() {
+	*x = GetPipelineWatermarksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPipelineWatermarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineWatermarksRequest) ProtoMessage() {}
+
+func (x *GetPipelineWatermarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_apis_proto_daemon_daemon_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineWatermarksRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineWatermarksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetPipelineWatermarksRequest) GetPipeline() string {
+	if x != nil {
+		return x.Pipeline
+	}
+	return ""
+}
+
+var File_pkg_apis_proto_daemon_daemon_proto protoreflect.FileDescriptor
+
+var file_pkg_apis_proto_daemon_daemon_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x06, 0x0a, 0x0a, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
 	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x61, 0x63, 0x6b, 0x50, 0x65,
 	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x74, 0x6f,
 	0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
@@ -908,146 +3836,772 @@ var file_pkg_apis_proto_daemon_daemon_proto_rawDesc = []byte{
 	0x66, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x69, 0x73, 0x46, 0x75,
 	0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x22, 0x96, 0x03, 0x0a,
-	0x0d, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65,
-	0x72, 0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74,
-	0x65, 0x78, 0x12, 0x54, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
-	0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x60, 0x0a, 0x14, 0x50, 0x72, 0x6f,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x39, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x46, 0x0a, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x53, 0x0a, 0x16, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x6f, 0x6c,
+	0x64, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x44, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x54,
+	0x6f, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x54, 0x6f, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x22, 0xa7, 0x0d, 0x0a, 0x0d, 0x56,
+	0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x12, 0x54, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x65, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10,
+	0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x67, 0x65,
+	0x12, 0x5d, 0x0a, 0x12, 0x70, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x2e, 0x50, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x70, 0x6f, 0x64,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x51, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x44, 0x72, 0x61,
+	0x69, 0x6e, 0x12, 0x45, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x75,
+	0x72, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x62,
+	0x75, 0x72, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x48, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56,
+	0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x63,
+	0x6b, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x72,
+	0x65, 0x61, 0x64, 0x41, 0x63, 0x6b, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x41, 0x63, 0x6b, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x72, 0x65, 0x61, 0x64, 0x41, 0x63, 0x6b, 0x44,
+	0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0d,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a, 0x17, 0x50, 0x6f, 0x64, 0x50, 0x72, 0x6f,
 	0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
 	0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0d, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5f, 0x0a, 0x13, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x54, 0x6f, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x0f,
+	0x42, 0x75, 0x72, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x0f, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x56, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x30, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22,
-	0x43, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x0d, 0x41, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x63, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x6b, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x56, 0x0a, 0x0e, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x30, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x43,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x22, 0x36, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65,
 	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x22, 0x36, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70,
-	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x4b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x7d, 0x0a, 0x13, 0x49, 0x53, 0x42, 0x53, 0x76, 0x63, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x07, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65,
 	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x69, 0x70, 0x65,
 	0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x4d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72,
-	0x74, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65,
-	0x78, 0x22, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
-	0x0d, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65,
-	0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0d, 0x76, 0x65, 0x72,
-	0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0d, 0x45,
-	0x64, 0x67, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0a,
-	0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x77,
-	0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x69, 0x73, 0x57,
-	0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x12, 0x69, 0x73, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x1d, 0x47, 0x65, 0x74,
-	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x70, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x45, 0x64, 0x67, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x12, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b,
-	0x73, 0x22, 0x3a, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x75, 0x73, 0x12, 0x4d, 0x0a, 0x13, 0x69, 0x73, 0x62, 0x53, 0x76, 0x63, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x53, 0x42, 0x53, 0x76, 0x63, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x13, 0x69, 0x73,
+	0x62, 0x53, 0x76, 0x63, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x22, 0x4d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x22, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72,
+	0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x79, 0x0a, 0x19, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0xaf, 0x04, 0x0a, 0x1a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65,
+	0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x61, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x60, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x0d,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63, 0x0a, 0x07, 0x50, 0x6f, 0x64, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x70, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0e,
+	0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x50, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0a,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x50, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x52, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x22,
+	0x4e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x50, 0x6f, 0x64, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x22,
+	0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x50, 0x6f, 0x64, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x70, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x50, 0x6f,
+	0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0x5d, 0x0a, 0x0d, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0xff, 0x01, 0x0a, 0x13, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x3f, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x31,
+	0x0a, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x22, 0x60, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x44, 0x72, 0x61, 0x69, 0x6e,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x12, 0x31, 0x0a, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x15, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x12, 0x37, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x5d, 0x0a, 0x20, 0x47,
+	0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x0c, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x40, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x68,
+	0x65, 0x61, 0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x68, 0x6f, 0x74, 0x22, 0x5e, 0x0a, 0x14, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x05, 0x65, 0x64,
+	0x67, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x22, 0x5b, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0xba,
+	0x01, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x76, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x4b,
+	0x0a, 0x12, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x05,
+	0x6d, 0x6f, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x51,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x22, 0x97, 0x03, 0x0a, 0x0d, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x39, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x01, 0x0a, 0x18,
+	0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x70, 0x54, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x70,
+	0x54, 0x6f, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x22, 0x9c, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x22,
+	0xa1, 0x01, 0x0a, 0x13, 0x50, 0x65, 0x65, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x14, 0x50, 0x65, 0x65, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa0,
+	0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4c, 0x61, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63,
+	0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x22, 0x78, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x64, 0x0a, 0x12, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x32, 0xb6, 0x05,
-	0x0a, 0x0d, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x74, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1a,
-	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12,
-	0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x62, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x77, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x12, 0x18, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12,
-	0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x62, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x7d, 0x12, 0x95,
-	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
-	0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72,
-	0x74, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x7d, 0x2f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x69,
-	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73,
-	0x12, 0x24, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xb7, 0x04, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x63, 0x6b, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28,
+	0x0a, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x6f, 0x6c, 0x64, 0x65,
+	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x11, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x6f, 0x6c, 0x64,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4c, 0x61, 0x67,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x2d, 0x0a, 0x03, 0x72, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x72, 0x70, 0x75, 0x12, 0x35,
+	0x0a, 0x07, 0x6d, 0x73, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x6d, 0x73,
+	0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x79,
+	0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x22, 0x70, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x83, 0x01, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x78, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x73, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0d, 0x45, 0x64, 0x67, 0x65,
+	0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x64, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x77, 0x61, 0x74,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x77, 0x61, 0x74, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x69, 0x73, 0x57, 0x61, 0x74, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12,
+	0x69, 0x73, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70,
 	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72,
-	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
-	0x6e, 0x65, 0x7d, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x85,
-	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x64, 0x67,
+	0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x12, 0x70, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x3a,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x32, 0xca, 0x12, 0x0a, 0x0d, 0x44,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x74, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f,
+	0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x12, 0x77, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12,
+	0x18, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f,
+	0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x1f, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72,
+	0x74, 0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x74, 0x65, 0x78, 0x50, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x50, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x50, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x7d, 0x2f, 0x70, 0x6f, 0x64, 0x2d, 0x72,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x24,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x7d, 0x2f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x85, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
 	0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x6d, 0x61, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6e, 0x75,
-	0x6d, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12,
+	0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f,
+	0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x69, 0x6e,
+	0x2d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x65, 0x64, 0x67,
+	0x65, 0x2d, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x12, 0x99, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x7d, 0x2f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x30, 0x01, 0x12, 0x83, 0x01, 0x0a,
+	0x0b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x33, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x7d, 0x2f, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65,
+	0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x6b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x7d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x85,
+	0x01, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1a,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12,
+	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x7d, 0x2f, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x9d, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x2f,
+	0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x7d, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0xae, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x24, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x42, 0x3a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x38, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x2f, 0x7b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x7d, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x6d, 0x61, 0x70, 0x72, 0x6f, 0x6a, 0x2f, 0x6e,
+	0x75, 0x6d, 0x61, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1062,62 +4616,204 @@ func file_pkg_apis_proto_daemon_daemon_proto_rawDescGZIP() []byte {
 	return file_pkg_apis_proto_daemon_daemon_proto_rawDescData
 }
 
-var file_pkg_apis_proto_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_pkg_apis_proto_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_pkg_apis_proto_daemon_daemon_proto_goTypes = []any{
-	(*BufferInfo)(nil),                    // 0: daemon.BufferInfo
-	(*VertexMetrics)(nil),                 // 1: daemon.VertexMetrics
-	(*PipelineStatus)(nil),                // 2: daemon.PipelineStatus
-	(*ListBuffersRequest)(nil),            // 3: daemon.ListBuffersRequest
-	(*ListBuffersResponse)(nil),           // 4: daemon.ListBuffersResponse
-	(*GetBufferRequest)(nil),              // 5: daemon.GetBufferRequest
-	(*GetBufferResponse)(nil),             // 6: daemon.GetBufferResponse
-	(*GetPipelineStatusRequest)(nil),      // 7: daemon.GetPipelineStatusRequest
-	(*GetPipelineStatusResponse)(nil),     // 8: daemon.GetPipelineStatusResponse
-	(*GetVertexMetricsRequest)(nil),       // 9: daemon.GetVertexMetricsRequest
-	(*GetVertexMetricsResponse)(nil),      // 10: daemon.GetVertexMetricsResponse
-	(*EdgeWatermark)(nil),                 // 11: daemon.EdgeWatermark
-	(*GetPipelineWatermarksResponse)(nil), // 12: daemon.GetPipelineWatermarksResponse
-	(*GetPipelineWatermarksRequest)(nil),  // 13: daemon.GetPipelineWatermarksRequest
-	nil,                                   // 14: daemon.VertexMetrics.ProcessingRatesEntry
-	nil,                                   // 15: daemon.VertexMetrics.PendingsEntry
-	(*wrapperspb.Int64Value)(nil),         // 16: google.protobuf.Int64Value
-	(*wrapperspb.DoubleValue)(nil),        // 17: google.protobuf.DoubleValue
-	(*wrapperspb.BoolValue)(nil),          // 18: google.protobuf.BoolValue
+	(*BufferInfo)(nil),                       // 0: daemon.BufferInfo
+	(*VertexMetrics)(nil),                    // 1: daemon.VertexMetrics
+	(*PipelineStatus)(nil),                   // 2: daemon.PipelineStatus
+	(*ListBuffersRequest)(nil),               // 3: daemon.ListBuffersRequest
+	(*ListBuffersResponse)(nil),              // 4: daemon.ListBuffersResponse
+	(*GetBufferRequest)(nil),                 // 5: daemon.GetBufferRequest
+	(*GetBufferResponse)(nil),                // 6: daemon.GetBufferResponse
+	(*GetPipelineStatusRequest)(nil),         // 7: daemon.GetPipelineStatusRequest
+	(*StreamHealth)(nil),                     // 8: daemon.StreamHealth
+	(*ISBSvcClusterHealth)(nil),              // 9: daemon.ISBSvcClusterHealth
+	(*GetPipelineStatusResponse)(nil),        // 10: daemon.GetPipelineStatusResponse
+	(*GetVertexMetricsRequest)(nil),          // 11: daemon.GetVertexMetricsRequest
+	(*GetVertexMetricsResponse)(nil),         // 12: daemon.GetVertexMetricsResponse
+	(*WatchVertexMetricsRequest)(nil),        // 13: daemon.WatchVertexMetricsRequest
+	(*WatchVertexMetricsResponse)(nil),       // 14: daemon.WatchVertexMetricsResponse
+	(*PodRate)(nil),                          // 15: daemon.PodRate
+	(*VertexPodRates)(nil),                   // 16: daemon.VertexPodRates
+	(*GetVertexPodRatesRequest)(nil),         // 17: daemon.GetVertexPodRatesRequest
+	(*GetVertexPodRatesResponse)(nil),        // 18: daemon.GetVertexPodRatesResponse
+	(*DrainEstimate)(nil),                    // 19: daemon.DrainEstimate
+	(*BufferDrainEstimate)(nil),              // 20: daemon.BufferDrainEstimate
+	(*VertexDrainEstimate)(nil),              // 21: daemon.VertexDrainEstimate
+	(*PipelineDrainEstimate)(nil),            // 22: daemon.PipelineDrainEstimate
+	(*GetPipelineDrainEstimateRequest)(nil),  // 23: daemon.GetPipelineDrainEstimateRequest
+	(*GetPipelineDrainEstimateResponse)(nil), // 24: daemon.GetPipelineDrainEstimateResponse
+	(*EdgeCapacity)(nil),                     // 25: daemon.EdgeCapacity
+	(*PipelineEdgeCapacity)(nil),             // 26: daemon.PipelineEdgeCapacity
+	(*GetPipelineEdgeCapacityRequest)(nil),   // 27: daemon.GetPipelineEdgeCapacityRequest
+	(*GetPipelineEdgeCapacityResponse)(nil),  // 28: daemon.GetPipelineEdgeCapacityResponse
+	(*PartitionMove)(nil),                    // 29: daemon.PartitionMove
+	(*RebalanceReport)(nil),                  // 30: daemon.RebalanceReport
+	(*ListRebalanceReportsRequest)(nil),      // 31: daemon.ListRebalanceReportsRequest
+	(*ListRebalanceReportsResponse)(nil),     // 32: daemon.ListRebalanceReportsResponse
+	(*BufferMessage)(nil),                    // 33: daemon.BufferMessage
+	(*ReadBufferHistoryRequest)(nil),         // 34: daemon.ReadBufferHistoryRequest
+	(*ReadBufferHistoryResponse)(nil),        // 35: daemon.ReadBufferHistoryResponse
+	(*PurgeBufferRequest)(nil),               // 36: daemon.PurgeBufferRequest
+	(*PurgeBufferResponse)(nil),              // 37: daemon.PurgeBufferResponse
+	(*PeekedMessage)(nil),                    // 38: daemon.PeekedMessage
+	(*PeekMessagesRequest)(nil),              // 39: daemon.PeekMessagesRequest
+	(*PeekMessagesResponse)(nil),             // 40: daemon.PeekMessagesResponse
+	(*ConsumerLag)(nil),                      // 41: daemon.ConsumerLag
+	(*QuotaUsage)(nil),                       // 42: daemon.QuotaUsage
+	(*WatchBufferRequest)(nil),               // 43: daemon.WatchBufferRequest
+	(*WatchBufferResponse)(nil),              // 44: daemon.WatchBufferResponse
+	(*GeneratorConfig)(nil),                  // 45: daemon.GeneratorConfig
+	(*ReplicaGeneratorConfig)(nil),           // 46: daemon.ReplicaGeneratorConfig
+	(*GetGeneratorConfigRequest)(nil),        // 47: daemon.GetGeneratorConfigRequest
+	(*GetGeneratorConfigResponse)(nil),       // 48: daemon.GetGeneratorConfigResponse
+	(*UpdateGeneratorConfigRequest)(nil),     // 49: daemon.UpdateGeneratorConfigRequest
+	(*UpdateGeneratorConfigResponse)(nil),    // 50: daemon.UpdateGeneratorConfigResponse
+	(*EdgeWatermark)(nil),                    // 51: daemon.EdgeWatermark
+	(*GetPipelineWatermarksResponse)(nil),    // 52: daemon.GetPipelineWatermarksResponse
+	(*GetPipelineWatermarksRequest)(nil),     // 53: daemon.GetPipelineWatermarksRequest
+	nil,                                      // 54: daemon.VertexMetrics.ProcessingRatesEntry
+	nil,                                      // 55: daemon.VertexMetrics.PendingsEntry
+	nil,                                      // 56: daemon.VertexMetrics.PodProcessingRatesEntry
+	nil,                                      // 57: daemon.VertexMetrics.SecondsToDrainEntry
+	nil,                                      // 58: daemon.VertexMetrics.BurstRatesEntry
+	nil,                                      // 59: daemon.VertexMetrics.ErrorRatesEntry
+	nil,                                      // 60: daemon.VertexMetrics.ErrorRatiosEntry
+	nil,                                      // 61: daemon.VertexMetrics.AckRatesEntry
+	nil,                                      // 62: daemon.VertexMetrics.ReadAckDivergencesEntry
+	nil,                                      // 63: daemon.WatchVertexMetricsResponse.ProcessingRatesEntry
+	nil,                                      // 64: daemon.WatchVertexMetricsResponse.PendingsEntry
+	nil,                                      // 65: daemon.BufferMessage.HeadersEntry
+	(*wrapperspb.Int64Value)(nil),            // 66: google.protobuf.Int64Value
+	(*wrapperspb.DoubleValue)(nil),           // 67: google.protobuf.DoubleValue
+	(*wrapperspb.BoolValue)(nil),             // 68: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),            // 69: google.protobuf.Int32Value
 }
 var file_pkg_apis_proto_daemon_daemon_proto_depIdxs = []int32{
-	16, // 0: daemon.BufferInfo.pendingCount:type_name -> google.protobuf.Int64Value
-	16, // 1: daemon.BufferInfo.ackPendingCount:type_name -> google.protobuf.Int64Value
-	16, // 2: daemon.BufferInfo.totalMessages:type_name -> google.protobuf.Int64Value
-	16, // 3: daemon.BufferInfo.bufferLength:type_name -> google.protobuf.Int64Value
-	17, // 4: daemon.BufferInfo.bufferUsageLimit:type_name -> google.protobuf.DoubleValue
-	17, // 5: daemon.BufferInfo.bufferUsage:type_name -> google.protobuf.DoubleValue
-	18, // 6: daemon.BufferInfo.isFull:type_name -> google.protobuf.BoolValue
-	14, // 7: daemon.VertexMetrics.processingRates:type_name -> daemon.VertexMetrics.ProcessingRatesEntry
-	15, // 8: daemon.VertexMetrics.pendings:type_name -> daemon.VertexMetrics.PendingsEntry
-	0,  // 9: daemon.ListBuffersResponse.buffers:type_name -> daemon.BufferInfo
-	0,  // 10: daemon.GetBufferResponse.buffer:type_name -> daemon.BufferInfo
-	2,  // 11: daemon.GetPipelineStatusResponse.status:type_name -> daemon.PipelineStatus
-	1,  // 12: daemon.GetVertexMetricsResponse.vertexMetrics:type_name -> daemon.VertexMetrics
-	16, // 13: daemon.EdgeWatermark.watermarks:type_name -> google.protobuf.Int64Value
-	18, // 14: daemon.EdgeWatermark.isWatermarkEnabled:type_name -> google.protobuf.BoolValue
-	11, // 15: daemon.GetPipelineWatermarksResponse.pipelineWatermarks:type_name -> daemon.EdgeWatermark
-	17, // 16: daemon.VertexMetrics.ProcessingRatesEntry.value:type_name -> google.protobuf.DoubleValue
-	16, // 17: daemon.VertexMetrics.PendingsEntry.value:type_name -> google.protobuf.Int64Value
-	3,  // 18: daemon.DaemonService.ListBuffers:input_type -> daemon.ListBuffersRequest
-	5,  // 19: daemon.DaemonService.GetBuffer:input_type -> daemon.GetBufferRequest
-	9,  // 20: daemon.DaemonService.GetVertexMetrics:input_type -> daemon.GetVertexMetricsRequest
-	13, // 21: daemon.DaemonService.GetPipelineWatermarks:input_type -> daemon.GetPipelineWatermarksRequest
-	7,  // 22: daemon.DaemonService.GetPipelineStatus:input_type -> daemon.GetPipelineStatusRequest
-	4,  // 23: daemon.DaemonService.ListBuffers:output_type -> daemon.ListBuffersResponse
-	6,  // 24: daemon.DaemonService.GetBuffer:output_type -> daemon.GetBufferResponse
-	10, // 25: daemon.DaemonService.GetVertexMetrics:output_type -> daemon.GetVertexMetricsResponse
-	12, // 26: daemon.DaemonService.GetPipelineWatermarks:output_type -> daemon.GetPipelineWatermarksResponse
-	8,  // 27: daemon.DaemonService.GetPipelineStatus:output_type -> daemon.GetPipelineStatusResponse
-	23, // [23:28] is the sub-list for method output_type
-	18, // [18:23] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	66,  // 0: daemon.BufferInfo.pendingCount:type_name -> google.protobuf.Int64Value
+	66,  // 1: daemon.BufferInfo.ackPendingCount:type_name -> google.protobuf.Int64Value
+	66,  // 2: daemon.BufferInfo.totalMessages:type_name -> google.protobuf.Int64Value
+	66,  // 3: daemon.BufferInfo.bufferLength:type_name -> google.protobuf.Int64Value
+	67,  // 4: daemon.BufferInfo.bufferUsageLimit:type_name -> google.protobuf.DoubleValue
+	67,  // 5: daemon.BufferInfo.bufferUsage:type_name -> google.protobuf.DoubleValue
+	68,  // 6: daemon.BufferInfo.isFull:type_name -> google.protobuf.BoolValue
+	66,  // 7: daemon.BufferInfo.maxLength:type_name -> google.protobuf.Int64Value
+	67,  // 8: daemon.BufferInfo.usagePercentage:type_name -> google.protobuf.DoubleValue
+	66,  // 9: daemon.BufferInfo.oldestMessageTimestamp:type_name -> google.protobuf.Int64Value
+	67,  // 10: daemon.BufferInfo.secondsToDrain:type_name -> google.protobuf.DoubleValue
+	54,  // 11: daemon.VertexMetrics.processingRates:type_name -> daemon.VertexMetrics.ProcessingRatesEntry
+	55,  // 12: daemon.VertexMetrics.pendings:type_name -> daemon.VertexMetrics.PendingsEntry
+	66,  // 13: daemon.VertexMetrics.oldestPendingAge:type_name -> google.protobuf.Int64Value
+	56,  // 14: daemon.VertexMetrics.podProcessingRates:type_name -> daemon.VertexMetrics.PodProcessingRatesEntry
+	57,  // 15: daemon.VertexMetrics.secondsToDrain:type_name -> daemon.VertexMetrics.SecondsToDrainEntry
+	58,  // 16: daemon.VertexMetrics.burstRates:type_name -> daemon.VertexMetrics.BurstRatesEntry
+	59,  // 17: daemon.VertexMetrics.errorRates:type_name -> daemon.VertexMetrics.ErrorRatesEntry
+	60,  // 18: daemon.VertexMetrics.errorRatios:type_name -> daemon.VertexMetrics.ErrorRatiosEntry
+	61,  // 19: daemon.VertexMetrics.ackRates:type_name -> daemon.VertexMetrics.AckRatesEntry
+	62,  // 20: daemon.VertexMetrics.readAckDivergences:type_name -> daemon.VertexMetrics.ReadAckDivergencesEntry
+	0,   // 21: daemon.ListBuffersResponse.buffers:type_name -> daemon.BufferInfo
+	0,   // 22: daemon.GetBufferResponse.buffer:type_name -> daemon.BufferInfo
+	8,   // 23: daemon.ISBSvcClusterHealth.streams:type_name -> daemon.StreamHealth
+	2,   // 24: daemon.GetPipelineStatusResponse.status:type_name -> daemon.PipelineStatus
+	9,   // 25: daemon.GetPipelineStatusResponse.isbSvcClusterHealth:type_name -> daemon.ISBSvcClusterHealth
+	1,   // 26: daemon.GetVertexMetricsResponse.vertexMetrics:type_name -> daemon.VertexMetrics
+	66,  // 27: daemon.WatchVertexMetricsResponse.timestamp:type_name -> google.protobuf.Int64Value
+	63,  // 28: daemon.WatchVertexMetricsResponse.processingRates:type_name -> daemon.WatchVertexMetricsResponse.ProcessingRatesEntry
+	64,  // 29: daemon.WatchVertexMetricsResponse.pendings:type_name -> daemon.WatchVertexMetricsResponse.PendingsEntry
+	1,   // 30: daemon.WatchVertexMetricsResponse.partitions:type_name -> daemon.VertexMetrics
+	67,  // 31: daemon.PodRate.rate:type_name -> google.protobuf.DoubleValue
+	67,  // 32: daemon.VertexPodRates.medianRate:type_name -> google.protobuf.DoubleValue
+	15,  // 33: daemon.VertexPodRates.pods:type_name -> daemon.PodRate
+	16,  // 34: daemon.GetVertexPodRatesResponse.podRates:type_name -> daemon.VertexPodRates
+	67,  // 35: daemon.DrainEstimate.seconds:type_name -> google.protobuf.DoubleValue
+	66,  // 36: daemon.BufferDrainEstimate.pendingCount:type_name -> google.protobuf.Int64Value
+	67,  // 37: daemon.BufferDrainEstimate.processingRate:type_name -> google.protobuf.DoubleValue
+	19,  // 38: daemon.BufferDrainEstimate.estimate:type_name -> daemon.DrainEstimate
+	19,  // 39: daemon.VertexDrainEstimate.estimate:type_name -> daemon.DrainEstimate
+	20,  // 40: daemon.PipelineDrainEstimate.buffers:type_name -> daemon.BufferDrainEstimate
+	21,  // 41: daemon.PipelineDrainEstimate.vertices:type_name -> daemon.VertexDrainEstimate
+	19,  // 42: daemon.PipelineDrainEstimate.estimate:type_name -> daemon.DrainEstimate
+	22,  // 43: daemon.GetPipelineDrainEstimateResponse.estimate:type_name -> daemon.PipelineDrainEstimate
+	67,  // 44: daemon.EdgeCapacity.observedRate:type_name -> google.protobuf.DoubleValue
+	67,  // 45: daemon.EdgeCapacity.capacityRate:type_name -> google.protobuf.DoubleValue
+	67,  // 46: daemon.EdgeCapacity.headroomPercentage:type_name -> google.protobuf.DoubleValue
+	25,  // 47: daemon.PipelineEdgeCapacity.edges:type_name -> daemon.EdgeCapacity
+	26,  // 48: daemon.GetPipelineEdgeCapacityResponse.capacity:type_name -> daemon.PipelineEdgeCapacity
+	66,  // 49: daemon.PartitionMove.publisherResumedAt:type_name -> google.protobuf.Int64Value
+	66,  // 50: daemon.RebalanceReport.time:type_name -> google.protobuf.Int64Value
+	29,  // 51: daemon.RebalanceReport.moves:type_name -> daemon.PartitionMove
+	30,  // 52: daemon.ListRebalanceReportsResponse.reports:type_name -> daemon.RebalanceReport
+	66,  // 53: daemon.BufferMessage.writeTime:type_name -> google.protobuf.Int64Value
+	66,  // 54: daemon.BufferMessage.eventTime:type_name -> google.protobuf.Int64Value
+	65,  // 55: daemon.BufferMessage.headers:type_name -> daemon.BufferMessage.HeadersEntry
+	33,  // 56: daemon.ReadBufferHistoryResponse.message:type_name -> daemon.BufferMessage
+	66,  // 57: daemon.PurgeBufferRequest.keepRecent:type_name -> google.protobuf.Int64Value
+	33,  // 58: daemon.PeekedMessage.message:type_name -> daemon.BufferMessage
+	38,  // 59: daemon.PeekMessagesResponse.messages:type_name -> daemon.PeekedMessage
+	66,  // 60: daemon.ConsumerLag.lastActive:type_name -> google.protobuf.Int64Value
+	66,  // 61: daemon.WatchBufferResponse.timestamp:type_name -> google.protobuf.Int64Value
+	66,  // 62: daemon.WatchBufferResponse.oldestPendingTime:type_name -> google.protobuf.Int64Value
+	66,  // 63: daemon.WatchBufferResponse.oldestMessageTime:type_name -> google.protobuf.Int64Value
+	42,  // 64: daemon.WatchBufferResponse.quota:type_name -> daemon.QuotaUsage
+	41,  // 65: daemon.WatchBufferResponse.consumers:type_name -> daemon.ConsumerLag
+	66,  // 66: daemon.GeneratorConfig.rpu:type_name -> google.protobuf.Int64Value
+	69,  // 67: daemon.GeneratorConfig.msgSize:type_name -> google.protobuf.Int32Value
+	69,  // 68: daemon.GeneratorConfig.keyCount:type_name -> google.protobuf.Int32Value
+	45,  // 69: daemon.ReplicaGeneratorConfig.config:type_name -> daemon.GeneratorConfig
+	46,  // 70: daemon.GetGeneratorConfigResponse.replicas:type_name -> daemon.ReplicaGeneratorConfig
+	45,  // 71: daemon.UpdateGeneratorConfigRequest.config:type_name -> daemon.GeneratorConfig
+	46,  // 72: daemon.UpdateGeneratorConfigResponse.replicas:type_name -> daemon.ReplicaGeneratorConfig
+	66,  // 73: daemon.EdgeWatermark.watermarks:type_name -> google.protobuf.Int64Value
+	68,  // 74: daemon.EdgeWatermark.isWatermarkEnabled:type_name -> google.protobuf.BoolValue
+	51,  // 75: daemon.GetPipelineWatermarksResponse.pipelineWatermarks:type_name -> daemon.EdgeWatermark
+	67,  // 76: daemon.VertexMetrics.ProcessingRatesEntry.value:type_name -> google.protobuf.DoubleValue
+	66,  // 77: daemon.VertexMetrics.PendingsEntry.value:type_name -> google.protobuf.Int64Value
+	67,  // 78: daemon.VertexMetrics.PodProcessingRatesEntry.value:type_name -> google.protobuf.DoubleValue
+	67,  // 79: daemon.VertexMetrics.SecondsToDrainEntry.value:type_name -> google.protobuf.DoubleValue
+	67,  // 80: daemon.VertexMetrics.BurstRatesEntry.value:type_name -> google.protobuf.DoubleValue
+	67,  // 81: daemon.VertexMetrics.ErrorRatesEntry.value:type_name -> google.protobuf.DoubleValue
+	67,  // 82: daemon.VertexMetrics.ErrorRatiosEntry.value:type_name -> google.protobuf.DoubleValue
+	67,  // 83: daemon.VertexMetrics.AckRatesEntry.value:type_name -> google.protobuf.DoubleValue
+	67,  // 84: daemon.VertexMetrics.ReadAckDivergencesEntry.value:type_name -> google.protobuf.DoubleValue
+	67,  // 85: daemon.WatchVertexMetricsResponse.ProcessingRatesEntry.value:type_name -> google.protobuf.DoubleValue
+	66,  // 86: daemon.WatchVertexMetricsResponse.PendingsEntry.value:type_name -> google.protobuf.Int64Value
+	3,   // 87: daemon.DaemonService.ListBuffers:input_type -> daemon.ListBuffersRequest
+	5,   // 88: daemon.DaemonService.GetBuffer:input_type -> daemon.GetBufferRequest
+	11,  // 89: daemon.DaemonService.GetVertexMetrics:input_type -> daemon.GetVertexMetricsRequest
+	13,  // 90: daemon.DaemonService.WatchVertexMetrics:input_type -> daemon.WatchVertexMetricsRequest
+	17,  // 91: daemon.DaemonService.GetVertexPodRates:input_type -> daemon.GetVertexPodRatesRequest
+	53,  // 92: daemon.DaemonService.GetPipelineWatermarks:input_type -> daemon.GetPipelineWatermarksRequest
+	7,   // 93: daemon.DaemonService.GetPipelineStatus:input_type -> daemon.GetPipelineStatusRequest
+	23,  // 94: daemon.DaemonService.GetPipelineDrainEstimate:input_type -> daemon.GetPipelineDrainEstimateRequest
+	27,  // 95: daemon.DaemonService.GetPipelineEdgeCapacity:input_type -> daemon.GetPipelineEdgeCapacityRequest
+	31,  // 96: daemon.DaemonService.ListRebalanceReports:input_type -> daemon.ListRebalanceReportsRequest
+	34,  // 97: daemon.DaemonService.ReadBufferHistory:input_type -> daemon.ReadBufferHistoryRequest
+	36,  // 98: daemon.DaemonService.PurgeBuffer:input_type -> daemon.PurgeBufferRequest
+	39,  // 99: daemon.DaemonService.PeekMessages:input_type -> daemon.PeekMessagesRequest
+	43,  // 100: daemon.DaemonService.WatchBuffer:input_type -> daemon.WatchBufferRequest
+	47,  // 101: daemon.DaemonService.GetGeneratorConfig:input_type -> daemon.GetGeneratorConfigRequest
+	49,  // 102: daemon.DaemonService.UpdateGeneratorConfig:input_type -> daemon.UpdateGeneratorConfigRequest
+	4,   // 103: daemon.DaemonService.ListBuffers:output_type -> daemon.ListBuffersResponse
+	6,   // 104: daemon.DaemonService.GetBuffer:output_type -> daemon.GetBufferResponse
+	12,  // 105: daemon.DaemonService.GetVertexMetrics:output_type -> daemon.GetVertexMetricsResponse
+	14,  // 106: daemon.DaemonService.WatchVertexMetrics:output_type -> daemon.WatchVertexMetricsResponse
+	18,  // 107: daemon.DaemonService.GetVertexPodRates:output_type -> daemon.GetVertexPodRatesResponse
+	52,  // 108: daemon.DaemonService.GetPipelineWatermarks:output_type -> daemon.GetPipelineWatermarksResponse
+	10,  // 109: daemon.DaemonService.GetPipelineStatus:output_type -> daemon.GetPipelineStatusResponse
+	24,  // 110: daemon.DaemonService.GetPipelineDrainEstimate:output_type -> daemon.GetPipelineDrainEstimateResponse
+	28,  // 111: daemon.DaemonService.GetPipelineEdgeCapacity:output_type -> daemon.GetPipelineEdgeCapacityResponse
+	32,  // 112: daemon.DaemonService.ListRebalanceReports:output_type -> daemon.ListRebalanceReportsResponse
+	35,  // 113: daemon.DaemonService.ReadBufferHistory:output_type -> daemon.ReadBufferHistoryResponse
+	37,  // 114: daemon.DaemonService.PurgeBuffer:output_type -> daemon.PurgeBufferResponse
+	40,  // 115: daemon.DaemonService.PeekMessages:output_type -> daemon.PeekMessagesResponse
+	44,  // 116: daemon.DaemonService.WatchBuffer:output_type -> daemon.WatchBufferResponse
+	48,  // 117: daemon.DaemonService.GetGeneratorConfig:output_type -> daemon.GetGeneratorConfigResponse
+	50,  // 118: daemon.DaemonService.UpdateGeneratorConfig:output_type -> daemon.UpdateGeneratorConfigResponse
+	103, // [103:119] is the sub-list for method output_type
+	87,  // [87:103] is the sub-list for method input_type
+	87,  // [87:87] is the sub-list for extension type_name
+	87,  // [87:87] is the sub-list for extension extendee
+	0,   // [0:87] is the sub-list for field type_name
 }
 
 func init() { file_pkg_apis_proto_daemon_daemon_proto_init() }
@@ -1138,8 +4834,212 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*VertexMetrics); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*VertexMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PipelineStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBuffersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBuffersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBufferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBufferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPipelineStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ISBSvcClusterHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPipelineStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVertexMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVertexMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchVertexMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchVertexMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*PodRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*VertexPodRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVertexPodRatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetVertexPodRatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1150,8 +5050,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*PipelineStatus); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*DrainEstimate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1162,8 +5062,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ListBuffersRequest); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*BufferDrainEstimate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1174,8 +5074,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ListBuffersResponse); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*VertexDrainEstimate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1186,8 +5086,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetBufferRequest); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*PipelineDrainEstimate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1198,8 +5098,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GetBufferResponse); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPipelineDrainEstimateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1210,8 +5110,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPipelineStatusRequest); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPipelineDrainEstimateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1222,8 +5122,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPipelineStatusResponse); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*EdgeCapacity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1234,8 +5134,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GetVertexMetricsRequest); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*PipelineEdgeCapacity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1246,8 +5146,8 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetVertexMetricsResponse); i {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPipelineEdgeCapacityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,7 +5158,283 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPipelineEdgeCapacityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*PartitionMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*RebalanceReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRebalanceReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRebalanceReportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*BufferMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadBufferHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadBufferHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeBufferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeBufferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*PeekedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*PeekMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*PeekMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsumerLag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*QuotaUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchBufferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchBufferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GeneratorConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplicaGeneratorConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGeneratorConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGeneratorConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateGeneratorConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateGeneratorConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*EdgeWatermark); i {
 			case 0:
 				return &v.state
@@ -1270,7 +5446,7 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*GetPipelineWatermarksResponse); i {
 			case 0:
 				return &v.state
@@ -1282,7 +5458,7 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_pkg_apis_proto_daemon_daemon_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*GetPipelineWatermarksRequest); i {
 			case 0:
 				return &v.state
@@ -1301,7 +5477,7 @@ func file_pkg_apis_proto_daemon_daemon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_apis_proto_daemon_daemon_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
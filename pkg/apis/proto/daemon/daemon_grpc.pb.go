@@ -34,11 +34,22 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	DaemonService_ListBuffers_FullMethodName           = "/daemon.DaemonService/ListBuffers"
-	DaemonService_GetBuffer_FullMethodName             = "/daemon.DaemonService/GetBuffer"
-	DaemonService_GetVertexMetrics_FullMethodName      = "/daemon.DaemonService/GetVertexMetrics"
-	DaemonService_GetPipelineWatermarks_FullMethodName = "/daemon.DaemonService/GetPipelineWatermarks"
-	DaemonService_GetPipelineStatus_FullMethodName     = "/daemon.DaemonService/GetPipelineStatus"
+	DaemonService_ListBuffers_FullMethodName              = "/daemon.DaemonService/ListBuffers"
+	DaemonService_GetBuffer_FullMethodName                = "/daemon.DaemonService/GetBuffer"
+	DaemonService_GetVertexMetrics_FullMethodName         = "/daemon.DaemonService/GetVertexMetrics"
+	DaemonService_WatchVertexMetrics_FullMethodName       = "/daemon.DaemonService/WatchVertexMetrics"
+	DaemonService_GetVertexPodRates_FullMethodName        = "/daemon.DaemonService/GetVertexPodRates"
+	DaemonService_GetPipelineWatermarks_FullMethodName    = "/daemon.DaemonService/GetPipelineWatermarks"
+	DaemonService_GetPipelineStatus_FullMethodName        = "/daemon.DaemonService/GetPipelineStatus"
+	DaemonService_GetPipelineDrainEstimate_FullMethodName = "/daemon.DaemonService/GetPipelineDrainEstimate"
+	DaemonService_GetPipelineEdgeCapacity_FullMethodName  = "/daemon.DaemonService/GetPipelineEdgeCapacity"
+	DaemonService_ListRebalanceReports_FullMethodName     = "/daemon.DaemonService/ListRebalanceReports"
+	DaemonService_ReadBufferHistory_FullMethodName        = "/daemon.DaemonService/ReadBufferHistory"
+	DaemonService_PurgeBuffer_FullMethodName              = "/daemon.DaemonService/PurgeBuffer"
+	DaemonService_PeekMessages_FullMethodName             = "/daemon.DaemonService/PeekMessages"
+	DaemonService_WatchBuffer_FullMethodName              = "/daemon.DaemonService/WatchBuffer"
+	DaemonService_GetGeneratorConfig_FullMethodName       = "/daemon.DaemonService/GetGeneratorConfig"
+	DaemonService_UpdateGeneratorConfig_FullMethodName    = "/daemon.DaemonService/UpdateGeneratorConfig"
 )
 
 // DaemonServiceClient is the client API for DaemonService service.
@@ -50,9 +61,37 @@ type DaemonServiceClient interface {
 	ListBuffers(ctx context.Context, in *ListBuffersRequest, opts ...grpc.CallOption) (*ListBuffersResponse, error)
 	GetBuffer(ctx context.Context, in *GetBufferRequest, opts ...grpc.CallOption) (*GetBufferResponse, error)
 	GetVertexMetrics(ctx context.Context, in *GetVertexMetricsRequest, opts ...grpc.CallOption) (*GetVertexMetricsResponse, error)
+	// WatchVertexMetrics pushes the metrics of the vertices every interval, rather than having the clients poll them.
+	WatchVertexMetrics(ctx context.Context, in *WatchVertexMetricsRequest, opts ...grpc.CallOption) (DaemonService_WatchVertexMetricsClient, error)
+	// GetVertexPodRates returns the processing rates of the pods of a vertex, so that the slow or stuck pods can be spotted.
+	GetVertexPodRates(ctx context.Context, in *GetVertexPodRatesRequest, opts ...grpc.CallOption) (*GetVertexPodRatesResponse, error)
 	// GetPipelineWatermarks return the watermark of the given pipeline
 	GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error)
 	GetPipelineStatus(ctx context.Context, in *GetPipelineStatusRequest, opts ...grpc.CallOption) (*GetPipelineStatusResponse, error)
+	// GetPipelineDrainEstimate projects the time to clear the current backlog of the pipeline, assuming the sources stop
+	// producing now and every vertex keeps its current processing rate.
+	GetPipelineDrainEstimate(ctx context.Context, in *GetPipelineDrainEstimateRequest, opts ...grpc.CallOption) (*GetPipelineDrainEstimateResponse, error)
+	// GetPipelineEdgeCapacity compares the observed throughput of each edge of the pipeline with its configured ceiling.
+	GetPipelineEdgeCapacity(ctx context.Context, in *GetPipelineEdgeCapacityRequest, opts ...grpc.CallOption) (*GetPipelineEdgeCapacityResponse, error)
+	// ListRebalanceReports returns the recent reports of the source partitions moving between replicas.
+	ListRebalanceReports(ctx context.Context, in *ListRebalanceReportsRequest, opts ...grpc.CallOption) (*ListRebalanceReportsResponse, error)
+	// ReadBufferHistory streams the messages of a buffer from a past position, for debugging. The messages are read by an
+	// ephemeral reader detached from the pipeline, the offsets and the acks of the pipeline are not affected.
+	ReadBufferHistory(ctx context.Context, in *ReadBufferHistoryRequest, opts ...grpc.CallOption) (DaemonService_ReadBufferHistoryClient, error)
+	// PurgeBuffer drops the messages of a stuck or poisoned buffer, the purged messages are not recoverable. It's an
+	// explicit operator action, the purge must be confirmed with the buffer name.
+	PurgeBuffer(ctx context.Context, in *PurgeBufferRequest, opts ...grpc.CallOption) (*PurgeBufferResponse, error)
+	// PeekMessages returns the messages in a buffer from an offset, without the consumer of the pipeline, the offsets and
+	// the acks of the pipeline are not affected.
+	PeekMessages(ctx context.Context, in *PeekMessagesRequest, opts ...grpc.CallOption) (*PeekMessagesResponse, error)
+	// WatchBuffer streams the info of a buffer, the current one first, then the info every time it changes, rather than
+	// having the clients poll it.
+	WatchBuffer(ctx context.Context, in *WatchBufferRequest, opts ...grpc.CallOption) (DaemonService_WatchBufferClient, error)
+	// GetGeneratorConfig returns the runtime config of the generator source of every active replica of a vertex.
+	GetGeneratorConfig(ctx context.Context, in *GetGeneratorConfigRequest, opts ...grpc.CallOption) (*GetGeneratorConfigResponse, error)
+	// UpdateGeneratorConfig applies the fields set in the config to the generator source of every active replica of a
+	// vertex, without restarting the vertex.
+	UpdateGeneratorConfig(ctx context.Context, in *UpdateGeneratorConfigRequest, opts ...grpc.CallOption) (*UpdateGeneratorConfigResponse, error)
 }
 
 type daemonServiceClient struct {
@@ -93,6 +132,49 @@ func (c *daemonServiceClient) GetVertexMetrics(ctx context.Context, in *GetVerte
 	return out, nil
 }
 
+func (c *daemonServiceClient) WatchVertexMetrics(ctx context.Context, in *WatchVertexMetricsRequest, opts ...grpc.CallOption) (DaemonService_WatchVertexMetricsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DaemonService_ServiceDesc.Streams[0], DaemonService_WatchVertexMetrics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceWatchVertexMetricsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_WatchVertexMetricsClient interface {
+	Recv() (*WatchVertexMetricsResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceWatchVertexMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceWatchVertexMetricsClient) Recv() (*WatchVertexMetricsResponse, error) {
+	m := new(WatchVertexMetricsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonServiceClient) GetVertexPodRates(ctx context.Context, in *GetVertexPodRatesRequest, opts ...grpc.CallOption) (*GetVertexPodRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVertexPodRatesResponse)
+	err := c.cc.Invoke(ctx, DaemonService_GetVertexPodRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonServiceClient) GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPipelineWatermarksResponse)
@@ -113,6 +195,142 @@ func (c *daemonServiceClient) GetPipelineStatus(ctx context.Context, in *GetPipe
 	return out, nil
 }
 
+func (c *daemonServiceClient) GetPipelineDrainEstimate(ctx context.Context, in *GetPipelineDrainEstimateRequest, opts ...grpc.CallOption) (*GetPipelineDrainEstimateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPipelineDrainEstimateResponse)
+	err := c.cc.Invoke(ctx, DaemonService_GetPipelineDrainEstimate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetPipelineEdgeCapacity(ctx context.Context, in *GetPipelineEdgeCapacityRequest, opts ...grpc.CallOption) (*GetPipelineEdgeCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPipelineEdgeCapacityResponse)
+	err := c.cc.Invoke(ctx, DaemonService_GetPipelineEdgeCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ListRebalanceReports(ctx context.Context, in *ListRebalanceReportsRequest, opts ...grpc.CallOption) (*ListRebalanceReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRebalanceReportsResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ListRebalanceReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ReadBufferHistory(ctx context.Context, in *ReadBufferHistoryRequest, opts ...grpc.CallOption) (DaemonService_ReadBufferHistoryClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DaemonService_ServiceDesc.Streams[1], DaemonService_ReadBufferHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceReadBufferHistoryClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_ReadBufferHistoryClient interface {
+	Recv() (*ReadBufferHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceReadBufferHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceReadBufferHistoryClient) Recv() (*ReadBufferHistoryResponse, error) {
+	m := new(ReadBufferHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonServiceClient) PurgeBuffer(ctx context.Context, in *PurgeBufferRequest, opts ...grpc.CallOption) (*PurgeBufferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeBufferResponse)
+	err := c.cc.Invoke(ctx, DaemonService_PurgeBuffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) PeekMessages(ctx context.Context, in *PeekMessagesRequest, opts ...grpc.CallOption) (*PeekMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeekMessagesResponse)
+	err := c.cc.Invoke(ctx, DaemonService_PeekMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) WatchBuffer(ctx context.Context, in *WatchBufferRequest, opts ...grpc.CallOption) (DaemonService_WatchBufferClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DaemonService_ServiceDesc.Streams[2], DaemonService_WatchBuffer_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonServiceWatchBufferClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DaemonService_WatchBufferClient interface {
+	Recv() (*WatchBufferResponse, error)
+	grpc.ClientStream
+}
+
+type daemonServiceWatchBufferClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonServiceWatchBufferClient) Recv() (*WatchBufferResponse, error) {
+	m := new(WatchBufferResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonServiceClient) GetGeneratorConfig(ctx context.Context, in *GetGeneratorConfigRequest, opts ...grpc.CallOption) (*GetGeneratorConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGeneratorConfigResponse)
+	err := c.cc.Invoke(ctx, DaemonService_GetGeneratorConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) UpdateGeneratorConfig(ctx context.Context, in *UpdateGeneratorConfigRequest, opts ...grpc.CallOption) (*UpdateGeneratorConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGeneratorConfigResponse)
+	err := c.cc.Invoke(ctx, DaemonService_UpdateGeneratorConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility
@@ -122,9 +340,37 @@ type DaemonServiceServer interface {
 	ListBuffers(context.Context, *ListBuffersRequest) (*ListBuffersResponse, error)
 	GetBuffer(context.Context, *GetBufferRequest) (*GetBufferResponse, error)
 	GetVertexMetrics(context.Context, *GetVertexMetricsRequest) (*GetVertexMetricsResponse, error)
+	// WatchVertexMetrics pushes the metrics of the vertices every interval, rather than having the clients poll them.
+	WatchVertexMetrics(*WatchVertexMetricsRequest, DaemonService_WatchVertexMetricsServer) error
+	// GetVertexPodRates returns the processing rates of the pods of a vertex, so that the slow or stuck pods can be spotted.
+	GetVertexPodRates(context.Context, *GetVertexPodRatesRequest) (*GetVertexPodRatesResponse, error)
 	// GetPipelineWatermarks return the watermark of the given pipeline
 	GetPipelineWatermarks(context.Context, *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error)
 	GetPipelineStatus(context.Context, *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error)
+	// GetPipelineDrainEstimate projects the time to clear the current backlog of the pipeline, assuming the sources stop
+	// producing now and every vertex keeps its current processing rate.
+	GetPipelineDrainEstimate(context.Context, *GetPipelineDrainEstimateRequest) (*GetPipelineDrainEstimateResponse, error)
+	// GetPipelineEdgeCapacity compares the observed throughput of each edge of the pipeline with its configured ceiling.
+	GetPipelineEdgeCapacity(context.Context, *GetPipelineEdgeCapacityRequest) (*GetPipelineEdgeCapacityResponse, error)
+	// ListRebalanceReports returns the recent reports of the source partitions moving between replicas.
+	ListRebalanceReports(context.Context, *ListRebalanceReportsRequest) (*ListRebalanceReportsResponse, error)
+	// ReadBufferHistory streams the messages of a buffer from a past position, for debugging. The messages are read by an
+	// ephemeral reader detached from the pipeline, the offsets and the acks of the pipeline are not affected.
+	ReadBufferHistory(*ReadBufferHistoryRequest, DaemonService_ReadBufferHistoryServer) error
+	// PurgeBuffer drops the messages of a stuck or poisoned buffer, the purged messages are not recoverable. It's an
+	// explicit operator action, the purge must be confirmed with the buffer name.
+	PurgeBuffer(context.Context, *PurgeBufferRequest) (*PurgeBufferResponse, error)
+	// PeekMessages returns the messages in a buffer from an offset, without the consumer of the pipeline, the offsets and
+	// the acks of the pipeline are not affected.
+	PeekMessages(context.Context, *PeekMessagesRequest) (*PeekMessagesResponse, error)
+	// WatchBuffer streams the info of a buffer, the current one first, then the info every time it changes, rather than
+	// having the clients poll it.
+	WatchBuffer(*WatchBufferRequest, DaemonService_WatchBufferServer) error
+	// GetGeneratorConfig returns the runtime config of the generator source of every active replica of a vertex.
+	GetGeneratorConfig(context.Context, *GetGeneratorConfigRequest) (*GetGeneratorConfigResponse, error)
+	// UpdateGeneratorConfig applies the fields set in the config to the generator source of every active replica of a
+	// vertex, without restarting the vertex.
+	UpdateGeneratorConfig(context.Context, *UpdateGeneratorConfigRequest) (*UpdateGeneratorConfigResponse, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -141,12 +387,45 @@ func (UnimplementedDaemonServiceServer) GetBuffer(context.Context, *GetBufferReq
 func (UnimplementedDaemonServiceServer) GetVertexMetrics(context.Context, *GetVertexMetricsRequest) (*GetVertexMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVertexMetrics not implemented")
 }
+func (UnimplementedDaemonServiceServer) WatchVertexMetrics(*WatchVertexMetricsRequest, DaemonService_WatchVertexMetricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVertexMetrics not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetVertexPodRates(context.Context, *GetVertexPodRatesRequest) (*GetVertexPodRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVertexPodRates not implemented")
+}
 func (UnimplementedDaemonServiceServer) GetPipelineWatermarks(context.Context, *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineWatermarks not implemented")
 }
 func (UnimplementedDaemonServiceServer) GetPipelineStatus(context.Context, *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineStatus not implemented")
 }
+func (UnimplementedDaemonServiceServer) GetPipelineDrainEstimate(context.Context, *GetPipelineDrainEstimateRequest) (*GetPipelineDrainEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineDrainEstimate not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetPipelineEdgeCapacity(context.Context, *GetPipelineEdgeCapacityRequest) (*GetPipelineEdgeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipelineEdgeCapacity not implemented")
+}
+func (UnimplementedDaemonServiceServer) ListRebalanceReports(context.Context, *ListRebalanceReportsRequest) (*ListRebalanceReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRebalanceReports not implemented")
+}
+func (UnimplementedDaemonServiceServer) ReadBufferHistory(*ReadBufferHistoryRequest, DaemonService_ReadBufferHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadBufferHistory not implemented")
+}
+func (UnimplementedDaemonServiceServer) PurgeBuffer(context.Context, *PurgeBufferRequest) (*PurgeBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeBuffer not implemented")
+}
+func (UnimplementedDaemonServiceServer) PeekMessages(context.Context, *PeekMessagesRequest) (*PeekMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeekMessages not implemented")
+}
+func (UnimplementedDaemonServiceServer) WatchBuffer(*WatchBufferRequest, DaemonService_WatchBufferServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBuffer not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetGeneratorConfig(context.Context, *GetGeneratorConfigRequest) (*GetGeneratorConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGeneratorConfig not implemented")
+}
+func (UnimplementedDaemonServiceServer) UpdateGeneratorConfig(context.Context, *UpdateGeneratorConfigRequest) (*UpdateGeneratorConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGeneratorConfig not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 
 // UnsafeDaemonServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -214,6 +493,45 @@ func _DaemonService_GetVertexMetrics_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_WatchVertexMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVertexMetricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).WatchVertexMetrics(m, &daemonServiceWatchVertexMetricsServer{ServerStream: stream})
+}
+
+type DaemonService_WatchVertexMetricsServer interface {
+	Send(*WatchVertexMetricsResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceWatchVertexMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceWatchVertexMetricsServer) Send(m *WatchVertexMetricsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DaemonService_GetVertexPodRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVertexPodRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetVertexPodRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_GetVertexPodRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetVertexPodRates(ctx, req.(*GetVertexPodRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DaemonService_GetPipelineWatermarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPipelineWatermarksRequest)
 	if err := dec(in); err != nil {
@@ -250,6 +568,174 @@ func _DaemonService_GetPipelineStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_GetPipelineDrainEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineDrainEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineDrainEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_GetPipelineDrainEstimate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineDrainEstimate(ctx, req.(*GetPipelineDrainEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetPipelineEdgeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineEdgeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetPipelineEdgeCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_GetPipelineEdgeCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetPipelineEdgeCapacity(ctx, req.(*GetPipelineEdgeCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ListRebalanceReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRebalanceReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListRebalanceReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ListRebalanceReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListRebalanceReports(ctx, req.(*ListRebalanceReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ReadBufferHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadBufferHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).ReadBufferHistory(m, &daemonServiceReadBufferHistoryServer{ServerStream: stream})
+}
+
+type DaemonService_ReadBufferHistoryServer interface {
+	Send(*ReadBufferHistoryResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceReadBufferHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceReadBufferHistoryServer) Send(m *ReadBufferHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DaemonService_PurgeBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).PurgeBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_PurgeBuffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).PurgeBuffer(ctx, req.(*PurgeBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_PeekMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeekMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).PeekMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_PeekMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).PeekMessages(ctx, req.(*PeekMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_WatchBuffer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBufferRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServiceServer).WatchBuffer(m, &daemonServiceWatchBufferServer{ServerStream: stream})
+}
+
+type DaemonService_WatchBufferServer interface {
+	Send(*WatchBufferResponse) error
+	grpc.ServerStream
+}
+
+type daemonServiceWatchBufferServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonServiceWatchBufferServer) Send(m *WatchBufferResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DaemonService_GetGeneratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeneratorConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetGeneratorConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_GetGeneratorConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetGeneratorConfig(ctx, req.(*GetGeneratorConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_UpdateGeneratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGeneratorConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).UpdateGeneratorConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_UpdateGeneratorConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).UpdateGeneratorConfig(ctx, req.(*UpdateGeneratorConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +755,10 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVertexMetrics",
 			Handler:    _DaemonService_GetVertexMetrics_Handler,
 		},
+		{
+			MethodName: "GetVertexPodRates",
+			Handler:    _DaemonService_GetVertexPodRates_Handler,
+		},
 		{
 			MethodName: "GetPipelineWatermarks",
 			Handler:    _DaemonService_GetPipelineWatermarks_Handler,
@@ -277,7 +767,51 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPipelineStatus",
 			Handler:    _DaemonService_GetPipelineStatus_Handler,
 		},
+		{
+			MethodName: "GetPipelineDrainEstimate",
+			Handler:    _DaemonService_GetPipelineDrainEstimate_Handler,
+		},
+		{
+			MethodName: "GetPipelineEdgeCapacity",
+			Handler:    _DaemonService_GetPipelineEdgeCapacity_Handler,
+		},
+		{
+			MethodName: "ListRebalanceReports",
+			Handler:    _DaemonService_ListRebalanceReports_Handler,
+		},
+		{
+			MethodName: "PurgeBuffer",
+			Handler:    _DaemonService_PurgeBuffer_Handler,
+		},
+		{
+			MethodName: "PeekMessages",
+			Handler:    _DaemonService_PeekMessages_Handler,
+		},
+		{
+			MethodName: "GetGeneratorConfig",
+			Handler:    _DaemonService_GetGeneratorConfig_Handler,
+		},
+		{
+			MethodName: "UpdateGeneratorConfig",
+			Handler:    _DaemonService_UpdateGeneratorConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchVertexMetrics",
+			Handler:       _DaemonService_WatchVertexMetrics_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadBufferHistory",
+			Handler:       _DaemonService_ReadBufferHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBuffer",
+			Handler:       _DaemonService_WatchBuffer_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apis/proto/daemon/daemon.proto",
 }
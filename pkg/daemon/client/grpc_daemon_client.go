@@ -19,6 +19,9 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	"io"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -100,6 +103,32 @@ func (dc *grpcDaemonClient) GetVertexMetrics(ctx context.Context, pipeline, vert
 	}
 }
 
+// WatchVertexMetrics calls fn with the metrics of the vertices streamed by the daemon server
+func (dc *grpcDaemonClient) WatchVertexMetrics(ctx context.Context, pipeline, vertex string, interval time.Duration, fn func(*daemon.WatchVertexMetricsResponse) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := dc.client.WatchVertexMetrics(ctx, &daemon.WatchVertexMetricsRequest{
+		Pipeline:        pipeline,
+		Vertex:          vertex,
+		IntervalSeconds: int32(interval.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		rspn, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if !fn(rspn) {
+			return nil
+		}
+	}
+}
+
 // GetPipelineWatermarks returns the []EdgeWatermark response instance for GetPipelineWatermarksRequest
 func (dc *grpcDaemonClient) GetPipelineWatermarks(ctx context.Context, pipeline string) ([]*daemon.EdgeWatermark, error) {
 	if rspn, err := dc.client.GetPipelineWatermarks(ctx, &daemon.GetPipelineWatermarksRequest{
@@ -19,7 +19,9 @@ package client
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -48,6 +50,11 @@ func (m *mockDaemonServiceClient) GetVertexMetrics(ctx context.Context, in *daem
 	return args.Get(0).(*daemon.GetVertexMetricsResponse), args.Error(1)
 }
 
+func (m *mockDaemonServiceClient) GetVertexPodRates(ctx context.Context, in *daemon.GetVertexPodRatesRequest, opts ...grpc.CallOption) (*daemon.GetVertexPodRatesResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.GetVertexPodRatesResponse), args.Error(1)
+}
+
 func (m *mockDaemonServiceClient) GetPipelineWatermarks(ctx context.Context, in *daemon.GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*daemon.GetPipelineWatermarksResponse, error) {
 	args := m.Called(ctx, in, opts)
 	return args.Get(0).(*daemon.GetPipelineWatermarksResponse), args.Error(1)
@@ -58,6 +65,84 @@ func (m *mockDaemonServiceClient) GetPipelineStatus(ctx context.Context, in *dae
 	return args.Get(0).(*daemon.GetPipelineStatusResponse), args.Error(1)
 }
 
+func (m *mockDaemonServiceClient) GetPipelineDrainEstimate(ctx context.Context, in *daemon.GetPipelineDrainEstimateRequest, opts ...grpc.CallOption) (*daemon.GetPipelineDrainEstimateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.GetPipelineDrainEstimateResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) GetPipelineEdgeCapacity(ctx context.Context, in *daemon.GetPipelineEdgeCapacityRequest, opts ...grpc.CallOption) (*daemon.GetPipelineEdgeCapacityResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.GetPipelineEdgeCapacityResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) ListRebalanceReports(ctx context.Context, in *daemon.ListRebalanceReportsRequest, opts ...grpc.CallOption) (*daemon.ListRebalanceReportsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.ListRebalanceReportsResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) PeekMessages(ctx context.Context, in *daemon.PeekMessagesRequest, opts ...grpc.CallOption) (*daemon.PeekMessagesResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.PeekMessagesResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) WatchBuffer(ctx context.Context, in *daemon.WatchBufferRequest, opts ...grpc.CallOption) (daemon.DaemonService_WatchBufferClient, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(daemon.DaemonService_WatchBufferClient), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) GetGeneratorConfig(ctx context.Context, in *daemon.GetGeneratorConfigRequest, opts ...grpc.CallOption) (*daemon.GetGeneratorConfigResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.GetGeneratorConfigResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) UpdateGeneratorConfig(ctx context.Context, in *daemon.UpdateGeneratorConfigRequest, opts ...grpc.CallOption) (*daemon.UpdateGeneratorConfigResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.UpdateGeneratorConfigResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) ReadBufferHistory(ctx context.Context, in *daemon.ReadBufferHistoryRequest, opts ...grpc.CallOption) (daemon.DaemonService_ReadBufferHistoryClient, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(daemon.DaemonService_ReadBufferHistoryClient), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) PurgeBuffer(ctx context.Context, in *daemon.PurgeBufferRequest, opts ...grpc.CallOption) (*daemon.PurgeBufferResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.PurgeBufferResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) WatchVertexMetrics(ctx context.Context, in *daemon.WatchVertexMetricsRequest, opts ...grpc.CallOption) (daemon.DaemonService_WatchVertexMetricsClient, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(daemon.DaemonService_WatchVertexMetricsClient), args.Error(1)
+}
+
+// mockWatchVertexMetricsClient streams the responses, then the error, io.EOF if it's nil.
+type mockWatchVertexMetricsClient struct {
+	grpc.ClientStream
+	responses []*daemon.WatchVertexMetricsResponse
+	err       error
+}
+
+func (m *mockWatchVertexMetricsClient) Recv() (*daemon.WatchVertexMetricsResponse, error) {
+	if len(m.responses) == 0 {
+		if m.err != nil {
+			return nil, m.err
+		}
+		return nil, io.EOF
+	}
+	r := m.responses[0]
+	m.responses = m.responses[1:]
+	return r, nil
+}
+
 func TestGrpcDaemonClient_ListPipelineBuffers(t *testing.T) {
 	t.Run("successful listing", func(t *testing.T) {
 		mockClient := new(mockDaemonServiceClient)
@@ -468,3 +553,51 @@ func TestNewGRPCDaemonServiceClient(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestGrpcDaemonClient_WatchVertexMetrics(t *testing.T) {
+	responses := []*daemon.WatchVertexMetricsResponse{
+		{Pipeline: "test-pipeline", Vertex: "in"},
+		{Pipeline: "test-pipeline", Vertex: "out"},
+		{Pipeline: "test-pipeline", Vertex: "in"},
+	}
+	req := &daemon.WatchVertexMetricsRequest{Pipeline: "test-pipeline", IntervalSeconds: 5}
+
+	t.Run("until the end of the stream", func(t *testing.T) {
+		mockClient := new(mockDaemonServiceClient)
+		dc := &grpcDaemonClient{client: mockClient}
+		mockClient.On("WatchVertexMetrics", mock.Anything, req, mock.Anything).
+			Return(&mockWatchVertexMetricsClient{responses: responses}, nil)
+		var got []*daemon.WatchVertexMetricsResponse
+		err := dc.WatchVertexMetrics(context.Background(), "test-pipeline", "", 5*time.Second, func(r *daemon.WatchVertexMetricsResponse) bool {
+			got = append(got, r)
+			return true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, responses, got)
+	})
+
+	t.Run("stopped by the callback", func(t *testing.T) {
+		mockClient := new(mockDaemonServiceClient)
+		dc := &grpcDaemonClient{client: mockClient}
+		mockClient.On("WatchVertexMetrics", mock.Anything, req, mock.Anything).
+			Return(&mockWatchVertexMetricsClient{responses: responses, err: errors.New("unreachable")}, nil)
+		count := 0
+		err := dc.WatchVertexMetrics(context.Background(), "test-pipeline", "", 5*time.Second, func(r *daemon.WatchVertexMetricsResponse) bool {
+			count++
+			return count < 2
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		mockClient := new(mockDaemonServiceClient)
+		dc := &grpcDaemonClient{client: mockClient}
+		mockClient.On("WatchVertexMetrics", mock.Anything, req, mock.Anything).
+			Return(&mockWatchVertexMetricsClient{err: errors.New("stream broken")}, nil)
+		err := dc.WatchVertexMetrics(context.Background(), "test-pipeline", "", 5*time.Second, func(r *daemon.WatchVertexMetricsResponse) bool {
+			return true
+		})
+		assert.EqualError(t, err, "stream broken")
+	})
+}
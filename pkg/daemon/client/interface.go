@@ -19,6 +19,7 @@ package client
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
 )
@@ -29,6 +30,9 @@ type DaemonClient interface {
 	ListPipelineBuffers(ctx context.Context, pipeline string) ([]*daemon.BufferInfo, error)
 	GetPipelineBuffer(ctx context.Context, pipeline, buffer string) (*daemon.BufferInfo, error)
 	GetVertexMetrics(ctx context.Context, pipeline, vertex string) ([]*daemon.VertexMetrics, error)
+	// WatchVertexMetrics calls fn with the metrics of the vertex every interval, or of all the vertices if vertex is empty,
+	// until fn returns false, the context is canceled or the stream fails. The interval defaults to 10 seconds if it's 0.
+	WatchVertexMetrics(ctx context.Context, pipeline, vertex string, interval time.Duration, fn func(*daemon.WatchVertexMetricsResponse) bool) error
 	GetPipelineWatermarks(ctx context.Context, pipeline string) ([]*daemon.EdgeWatermark, error)
 	GetPipelineStatus(ctx context.Context, pipeline string) (*daemon.PipelineStatus, error)
 }
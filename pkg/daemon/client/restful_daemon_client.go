@@ -19,9 +19,13 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -134,6 +138,57 @@ func (rc *restfulDaemonClient) GetVertexMetrics(ctx context.Context, pipeline, v
 	}
 }
 
+// WatchVertexMetrics calls fn with the metrics of the vertices streamed by the daemon server, the stream is a
+// newline delimited JSON, each line holds either a result or an error
+func (rc *restfulDaemonClient) WatchVertexMetrics(ctx context.Context, pipeline, vertex string, interval time.Duration, fn func(*daemon.WatchVertexMetricsResponse) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	query := url.Values{}
+	if vertex != "" {
+		query.Set("vertex", vertex)
+	}
+	if interval > 0 {
+		query.Set("intervalSeconds", strconv.Itoa(int(interval.Seconds())))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v1/pipelines/%s/metrics/watch?%s", rc.hostURL, pipeline, query.Encode()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create the watch vertex metrics request, %w", err)
+	}
+	// the timeout of the client would cut the stream off
+	streamClient := &http.Client{Transport: rc.httpClient.Transport}
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call watch vertex metrics RESTful API, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response %v: %s", resp.StatusCode, resp.Status)
+	}
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var chunk struct {
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		}
+		if err := decoder.Decode(&chunk); err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to decode the watch vertex metrics stream, %w", err)
+		}
+		if len(chunk.Error) > 0 {
+			return fmt.Errorf("watch vertex metrics stream failed: %s", chunk.Error)
+		}
+		var rspn daemon.WatchVertexMetricsResponse
+		if err := jsonMarshaller.Unmarshal(chunk.Result, &rspn); err != nil {
+			return fmt.Errorf("failed to unmarshal the vertex metrics, %w", err)
+		}
+		if !fn(&rspn) {
+			return nil
+		}
+	}
+}
+
 // GetPipelineWatermarks returns the []EdgeWatermark response instance for GetPipelineWatermarksRequest
 func (rc *restfulDaemonClient) GetPipelineWatermarks(ctx context.Context, pipeline string) ([]*daemon.EdgeWatermark, error) {
 	resp, err := rc.httpClient.Get(fmt.Sprintf("%s/api/v1/pipelines/%s/watermarks", rc.hostURL, pipeline))
@@ -27,6 +27,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
 )
 
 func TestRestfulDaemonClient_IsDrained(t *testing.T) {
@@ -313,3 +315,43 @@ func TestNewRESTfulDaemonServiceClient(t *testing.T) {
 		assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
 	})
 }
+
+func TestRestfulDaemonClient_WatchVertexMetrics(t *testing.T) {
+	t.Run("okay", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/pipelines/simple-pipeline/metrics/watch", r.URL.Path)
+			assert.Equal(t, "cat", r.URL.Query().Get("vertex"))
+			assert.Equal(t, "5", r.URL.Query().Get("intervalSeconds"))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"result":{"pipeline":"simple-pipeline","vertex":"cat","timestamp":"1620000000000","processingRates":{"default":1.5},"pendings":{"default":"10"}}}
+{"result":{"pipeline":"simple-pipeline","vertex":"cat","timestamp":"1620000005000","processingRates":{"default":2},"pendings":{"default":"0"}}}
+`))
+		}))
+		defer server.Close()
+
+		client, _ := NewRESTfulDaemonServiceClient(server.URL)
+		var timestamps []int64
+		err := client.WatchVertexMetrics(context.Background(), "simple-pipeline", "cat", 5*time.Second, func(r *daemon.WatchVertexMetricsResponse) bool {
+			timestamps = append(timestamps, r.GetTimestamp().GetValue())
+			assert.Equal(t, "cat", r.GetVertex())
+			return true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1620000000000, 1620000005000}, timestamps)
+	})
+
+	t.Run("error in the stream", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"error":{"code":5,"message":"vertex \"dog\" not found"}}
+`))
+		}))
+		defer server.Close()
+
+		client, _ := NewRESTfulDaemonServiceClient(server.URL)
+		err := client.WatchVertexMetrics(context.Background(), "simple-pipeline", "dog", 0, func(r *daemon.WatchVertexMetricsResponse) bool {
+			return true
+		})
+		assert.ErrorContains(t, err, "not found")
+	})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// The server side limits of a buffer watch session, the requested duration can not exceed the max one.
+const (
+	defaultBufferWatchDuration = 5 * time.Minute
+	maxBufferWatchDuration     = time.Hour
+)
+
+// newWatchBufferResponse returns the info of a buffer in the response of the daemon service.
+func newWatchBufferResponse(now time.Time, info *isbsvc.BufferInfo) *daemon.WatchBufferResponse {
+	resp := &daemon.WatchBufferResponse{
+		Timestamp:       wrapperspb.Int64(now.UnixMilli()),
+		Buffer:          info.Name,
+		PendingCount:    info.PendingCount,
+		AckPendingCount: info.AckPendingCount,
+		TotalMessages:   info.TotalMessages,
+		TotalBytes:      info.TotalBytes,
+		MaxLength:       info.MaxLength,
+		UsagePercentage: info.UsagePercentage,
+	}
+	if !info.OldestPendingTime.IsZero() {
+		resp.OldestPendingTime = wrapperspb.Int64(info.OldestPendingTime.UnixMilli())
+	}
+	if !info.OldestMessageTime.IsZero() {
+		resp.OldestMessageTime = wrapperspb.Int64(info.OldestMessageTime.UnixMilli())
+	}
+	if q := info.Quota; q != nil {
+		resp.Quota = &daemon.QuotaUsage{MaxBytes: q.MaxBytes, MaxStreams: int32(q.MaxStreams), Bytes: q.Bytes, Streams: int32(q.Streams)}
+	}
+	for _, c := range info.Consumers {
+		lag := &daemon.ConsumerLag{
+			Name:            c.Name,
+			ProducedOffset:  c.ProducedOffset,
+			DeliveredOffset: c.DeliveredOffset,
+			AckedOffset:     c.AckedOffset,
+			PendingCount:    c.PendingCount,
+			AckPendingCount: c.AckPendingCount,
+		}
+		if !c.LastActive.IsZero() {
+			lag.LastActive = wrapperspb.Int64(c.LastActive.UnixMilli())
+		}
+		resp.Consumers = append(resp.Consumers, lag)
+	}
+	return resp
+}
+
+// WatchBuffer streams the info of a buffer, the current one first, then the info every time it changes, so that the
+// clients, e.g. the autoscaler, react to the changes of the buffer pressure without polling. The session ends when the
+// duration is reached, or when the buffer is deleted.
+func (ds *daemonServer) WatchBuffer(req *daemon.WatchBufferRequest, stream daemon.DaemonService_WatchBufferServer) error {
+	buffer := req.GetBuffer()
+	if err := ds.findBuffer(req.GetPipeline(), buffer); err != nil {
+		return err
+	}
+	duration := defaultBufferWatchDuration
+	if v := req.GetDuration(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > maxBufferWatchDuration {
+			return status.Errorf(codes.InvalidArgument, "invalid duration %q, it should be greater than 0 and at most %v", v, maxBufferWatchDuration)
+		}
+		duration = d
+	}
+	log := logging.FromContext(stream.Context()).With(zap.String("buffer", buffer))
+	ctx, cancel := context.WithTimeout(stream.Context(), duration)
+	defer cancel()
+	infos, err := ds.isbSvcClient.WatchPartitionInfo(ctx, buffer)
+	if err != nil {
+		if errors.Is(err, isbsvc.ErrBufferNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		log.Errorw("Failed to watch the buffer info", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to watch the buffer info, %v", err)
+	}
+	count := 0
+	for info := range infos {
+		if err := stream.Send(newWatchBufferResponse(time.Now(), info)); err != nil {
+			log.Warnw("Failed to stream the buffer info", zap.Error(err))
+			return fmt.Errorf("failed to stream the buffer info, %w", err)
+		}
+		count++
+	}
+	log.Infow("Buffer watch session ended", zap.Int("updates", count))
+	return nil
+}
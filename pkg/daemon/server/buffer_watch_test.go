@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+// watchTestISBSvc sends the infos and closes the channel, or keeps it open until the context is done if block is set.
+type watchTestISBSvc struct {
+	isbsvc.ISBService
+	infos []*isbsvc.BufferInfo
+	block bool
+}
+
+func (s *watchTestISBSvc) WatchPartitionInfo(ctx context.Context, partition string) (<-chan *isbsvc.BufferInfo, error) {
+	if len(s.infos) == 0 {
+		return nil, fmt.Errorf("partition %q, %w", partition, isbsvc.ErrBufferNotFound)
+	}
+	ch := make(chan *isbsvc.BufferInfo, len(s.infos))
+	for _, info := range s.infos {
+		ch <- info
+	}
+	go func() {
+		if s.block {
+			<-ctx.Done()
+		}
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// mockWatchBufferServer collects the infos streamed.
+type mockWatchBufferServer struct {
+	grpc.ServerStream
+	infos []*daemon.WatchBufferResponse
+}
+
+func (m *mockWatchBufferServer) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockWatchBufferServer) Send(resp *daemon.WatchBufferResponse) error {
+	m.infos = append(m.infos, resp)
+	return nil
+}
+
+func newWatchTestServer(isbSvc isbsvc.ISBService) *daemonServer {
+	return &daemonServer{
+		pipeline: &v1alpha1.Pipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"},
+			Spec: v1alpha1.PipelineSpec{
+				Vertices: []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "out"}},
+				Edges:    []v1alpha1.Edge{{From: "in", To: "out"}},
+			},
+		},
+		isbSvcClient: isbSvc,
+	}
+}
+
+func TestWatchBuffer(t *testing.T) {
+	buffer := "test-ns-test-pl-out-0"
+	isbSvc := &watchTestISBSvc{infos: []*isbsvc.BufferInfo{
+		{Name: buffer, PendingCount: 1, MaxLength: isbsvc.UnknownMaxLength, UsagePercentage: isbsvc.UnknownUsagePercentage},
+		{Name: buffer, PendingCount: 5, AckPendingCount: 2, TotalMessages: 7, MaxLength: 70, UsagePercentage: 10, OldestPendingTime: time.Unix(100, 0).UTC(),
+			Quota:     &isbsvc.QuotaUsage{PipelineQuota: isbsvc.PipelineQuota{MaxStreams: 10}, Streams: 4},
+			Consumers: []isbsvc.ConsumerLag{{Name: buffer, ProducedOffset: "9", DeliveredOffset: "7", AckedOffset: "2", PendingCount: 2, AckPendingCount: 5}}},
+	}}
+	ds := newWatchTestServer(isbSvc)
+
+	// the session ends when the watch channel is closed, i.e. the buffer is deleted
+	stream := &mockWatchBufferServer{}
+	require.NoError(t, ds.WatchBuffer(&daemon.WatchBufferRequest{Pipeline: "test-pl", Buffer: buffer}, stream))
+	infos := stream.infos
+	require.Len(t, infos, 2)
+	assert.Equal(t, int64(1), infos[0].GetPendingCount())
+	assert.Equal(t, int64(-1), infos[0].GetMaxLength())
+	assert.Nil(t, infos[0].GetOldestPendingTime())
+	assert.Equal(t, int64(7), infos[1].GetTotalMessages())
+	assert.Equal(t, 10.0, infos[1].GetUsagePercentage())
+	assert.Equal(t, int64(100000), infos[1].GetOldestPendingTime().GetValue())
+	assert.Nil(t, infos[1].GetOldestMessageTime())
+	assert.Nil(t, infos[0].GetQuota())
+	assert.Equal(t, int32(10), infos[1].GetQuota().GetMaxStreams())
+	assert.Equal(t, int32(4), infos[1].GetQuota().GetStreams())
+	assert.Empty(t, infos[0].GetConsumers())
+	require.Len(t, infos[1].GetConsumers(), 1)
+	lag := infos[1].GetConsumers()[0]
+	assert.Equal(t, buffer, lag.GetName())
+	assert.Equal(t, "9", lag.GetProducedOffset())
+	assert.Equal(t, "7", lag.GetDeliveredOffset())
+	assert.Equal(t, "2", lag.GetAckedOffset())
+	assert.Equal(t, int64(2), lag.GetPendingCount())
+	assert.Equal(t, int64(5), lag.GetAckPendingCount())
+	assert.Nil(t, lag.GetLastActive())
+
+	// the session stops at the duration
+	isbSvc.block = true
+	start := time.Now()
+	stream = &mockWatchBufferServer{}
+	require.NoError(t, ds.WatchBuffer(&daemon.WatchBufferRequest{Pipeline: "test-pl", Buffer: buffer, Duration: "100ms"}, stream))
+	assert.Len(t, stream.infos, 2)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestWatchBuffer_InvalidRequests(t *testing.T) {
+	ds := newWatchTestServer(&watchTestISBSvc{})
+
+	tests := []struct {
+		name string
+		req  *daemon.WatchBufferRequest
+		code codes.Code
+	}{
+		{name: "unknown pipeline", req: &daemon.WatchBufferRequest{Pipeline: "other-pl", Buffer: "test-ns-test-pl-out-0"}, code: codes.NotFound},
+		{name: "unknown buffer", req: &daemon.WatchBufferRequest{Pipeline: "test-pl", Buffer: "unknown"}, code: codes.NotFound},
+		{name: "too long duration", req: &daemon.WatchBufferRequest{Pipeline: "test-pl", Buffer: "test-ns-test-pl-out-0", Duration: "2h"}, code: codes.InvalidArgument},
+		{name: "invalid duration", req: &daemon.WatchBufferRequest{Pipeline: "test-pl", Buffer: "test-ns-test-pl-out-0", Duration: "xx"}, code: codes.InvalidArgument},
+		// the buffer of the pipeline is missing in the ISB Service
+		{name: "missing buffer", req: &daemon.WatchBufferRequest{Pipeline: "test-pl", Buffer: "test-ns-test-pl-out-0"}, code: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ds.WatchBuffer(tt.req, &mockWatchBufferServer{})
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"math"
 	"net"
 	"net/http"
@@ -35,25 +36,50 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/numaproj/numaflow"
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/client/clientset/versioned"
+	numaflowv1alpha1 "github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
 	"github.com/numaproj/numaflow/pkg/daemon/server/service"
 	server "github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
 type daemonServer struct {
 	pipeline      *v1alpha1.Pipeline
 	isbSvcType    v1alpha1.ISBSvcType
 	metaDataQuery *service.PipelineMetadataQuery
+	isbSvcClient  isbsvc.ISBService
+	// incidents records the incidents detected by the daemon as events, it is nil if the events can not be recorded.
+	incidents *incidentDetector
+	// rebalancing tracks the source partitions moving between the replicas of the source vertices.
+	rebalancing *service.RebalanceTracker
+	// recorder records the events of the pipeline, it is nil if the events can not be recorded.
+	recorder *events.Recorder
+	// activeReplicas returns the number of the active replicas of a vertex.
+	activeReplicas func(vertex string) int
+	// podHTTPClient sends the requests to the metrics servers of the vertex pods.
+	podHTTPClient *http.Client
+	// proxy forwards the API requests to the leader of the replicas, it is nil if the leader election is disabled.
+	proxy *leaderProxy
+	// redactors redact the messages peeked from the buffers before they are returned.
+	redactors []messageRedactor
 }
 
 func NewDaemonServer(pl *v1alpha1.Pipeline, isbSvcType v1alpha1.ISBSvcType) *daemonServer {
@@ -61,6 +87,13 @@ func NewDaemonServer(pl *v1alpha1.Pipeline, isbSvcType v1alpha1.ISBSvcType) *dae
 		pipeline:      pl,
 		isbSvcType:    isbSvcType,
 		metaDataQuery: nil,
+		podHTTPClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+			Timeout: time.Second * 5,
+		},
+		redactors: messageRedactorsFromEnv(),
 	}
 }
 
@@ -74,7 +107,12 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 
 	switch ds.isbSvcType {
 	case v1alpha1.ISBSvcTypeRedis:
-		isbSvcClient = isbsvc.NewISBRedisSvc(redisclient.NewInClusterRedisClient())
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			log.Errorw("Failed to get a Redis client.", zap.Error(err))
+			return err
+		}
+		isbSvcClient = isbsvc.NewISBRedisSvc(redisClient)
 	case v1alpha1.ISBSvcTypeJetStream:
 		natsClientPool, err = jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(1))
 		if err != nil {
@@ -82,7 +120,28 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 			return err
 		}
 		defer natsClientPool.CloseAll()
-		isbSvcClient, err = isbsvc.NewISBJetStreamSvc(ds.pipeline.Name, natsClientPool.NextAvailableClient())
+		isbSvcConfig, err := sharedutil.GetIsbSvcConfigFromEnv()
+		if err != nil {
+			return err
+		}
+		var quota isbsvc.PipelineQuota
+		if isbSvcConfig.JetStream != nil {
+			quota = isbsvc.NewPipelineQuota(isbSvcConfig.JetStream.PipelineQuota)
+		}
+		isbSvcClient, err = isbsvc.NewISBJetStreamSvc(ds.pipeline.Name, natsClientPool.NextAvailableClient(), isbsvc.WithPipelineUID(os.Getenv(v1alpha1.EnvPipelineUID)),
+			isbsvc.WithPipelineQuota(quota))
+		if err != nil {
+			log.Errorw("Failed to get an ISB Service client.", zap.Error(err))
+			return err
+		}
+	case v1alpha1.ISBSvcTypeKafka:
+		kafkaClient, err := kafkaclient.NewInClusterKafkaClient()
+		if err != nil {
+			log.Errorw("Failed to get a Kafka client.", zap.Error(err))
+			return err
+		}
+		defer func() { _ = kafkaClient.Close() }()
+		isbSvcClient, err = isbsvc.NewISBKafkaSvc(kafkaClient)
 		if err != nil {
 			log.Errorw("Failed to get an ISB Service client.", zap.Error(err))
 			return err
@@ -90,6 +149,7 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unsupported isbsvc buffer type %q", ds.isbSvcType)
 	}
+	ds.isbSvcClient = isbSvcClient
 	wmStores, err := service.BuildWatermarkStores(ctx, ds.pipeline, isbSvcClient)
 	if err != nil {
 		return fmt.Errorf("failed to get watermark stores, %w", err)
@@ -99,8 +159,15 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to get watermark fetchers, %w", err)
 	}
 
-	// Close all the watermark stores when the daemon server exits
+	// Close all the watermark fetchers and stores when the daemon server exits
 	defer func() {
+		for _, edgeFetchers := range wmFetchers {
+			for _, fetcher := range edgeFetchers {
+				if closer, ok := fetcher.(io.Closer); ok {
+					_ = closer.Close()
+				}
+			}
+		}
 		for _, edgeStores := range wmStores {
 			for _, store := range edgeStores {
 				_ = store.Close()
@@ -108,8 +175,50 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 		}
 	}()
 
+	// the incidents and the partition rebalancing are recorded as events of the pipeline
+	if eventRecorder, stop, err := newEventRecorder(ds.pipeline.Namespace); err != nil {
+		log.Warnw("Failed to create an event recorder, the incidents will not be recorded as events", zap.Error(err))
+	} else {
+		defer stop()
+		ds.recorder = events.NewRecorder(eventRecorder, events.PipelineReference(ds.pipeline))
+		ds.incidents = newIncidentDetector(ds.recorder, clock.RealClock())
+	}
+	ds.rebalancing = service.NewRebalanceTracker(ds.recorder, clock.RealClock())
+
 	// rater is used to calculate the processing rate for each of the vertices
-	rater := server.NewRater(ctx, ds.pipeline)
+	var raterOpts []server.Option
+	if _, err := os.Stat(v1alpha1.PathDaemonRaterState); err == nil {
+		// the counts are persisted to survive the restarts of the daemon server container
+		raterOpts = append(raterOpts, server.WithStateDir(v1alpha1.PathDaemonRaterState))
+	} else {
+		log.Warnw("The rater state directory is not available, the processing rates will restart from zero when the daemon server restarts", zap.Error(err))
+	}
+	if countWindow := sharedutil.LookupEnvDurationOr(v1alpha1.EnvRaterCountWindow, server.CountWindow); countWindow != server.CountWindow {
+		// the counts are timestamped in seconds, and the shortest lookback seconds is 1 minute
+		if countWindow < time.Second || countWindow > time.Minute || countWindow%time.Second != 0 {
+			return fmt.Errorf("invalid count window %q of the rater, it must be whole seconds between 1s and 1m", countWindow)
+		}
+		raterOpts = append(raterOpts, server.WithCountWindow(countWindow))
+	}
+	rater := server.NewRater(ctx, ds.pipeline, raterOpts...)
+	ds.activeReplicas = rater.GetActiveReplicas
+
+	cer, err := sharedtls.GenerateX509KeyPair()
+	if err != nil {
+		return fmt.Errorf("failed to generate cert: %w", err)
+	}
+
+	// with multiple replicas, the leader elected among them runs the rater and the background jobs, the others forward
+	// the API requests to it
+	var election *leaderElection
+	if os.Getenv(v1alpha1.EnvDaemonLeaderElection) == "true" {
+		election, err = newLeaderElection(ds.pipeline, os.Getenv(v1alpha1.EnvPod), os.Getenv(v1alpha1.EnvPodIP), certFingerprint(cer.Certificate[0]))
+		if err != nil {
+			return fmt.Errorf("failed to create the leader election, %w", err)
+		}
+		ds.proxy = newLeaderProxy(election.leaderPeer)
+		defer ds.proxy.close()
+	}
 
 	// Start listener
 	var conn net.Listener
@@ -120,11 +229,6 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to listen: %v", listerErr)
 	}
 
-	cer, err := sharedtls.GenerateX509KeyPair()
-	if err != nil {
-		return fmt.Errorf("failed to generate cert: %w", err)
-	}
-
 	tlsConfig := &tls.Config{Certificates: []tls.Certificate{*cer}, MinVersion: tls.VersionTLS12}
 	grpcServer, err := ds.newGRPCServer(isbSvcClient, wmFetchers, rater)
 	if err != nil {
@@ -142,19 +246,34 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 	go func() { _ = httpServer.Serve(httpL) }()
 	go func() { _ = tcpm.Serve() }()
 
-	// Start the Data flow health status updater
-	go func() {
-		ds.metaDataQuery.StartHealthCheck(ctx)
-	}()
+	lead := func(ctx context.Context) {
+		// Start the Data flow health status updater
+		go func() {
+			ds.metaDataQuery.StartHealthCheck(ctx)
+		}()
 
-	// Start the rater
-	go func() {
-		if err := rater.Start(ctx); err != nil {
-			log.Panic(fmt.Errorf("failed to start the rater: %w", err))
-		}
-	}()
+		// Start the rater
+		go func() {
+			if err := rater.Start(ctx); err != nil {
+				log.Panic(fmt.Errorf("failed to start the rater: %w", err))
+			}
+		}()
 
-	go ds.exposeMetrics(ctx)
+		go ds.exposeMetrics(ctx)
+		for _, job := range ds.backgroundJobs(ctx, isbSvcClient, rater, wmStores) {
+			go job.Start(ctx)
+		}
+	}
+	// electionErr receives the error of the election, the daemon server shuts down if the leader loses the lease
+	var electionErr chan error
+	if election != nil {
+		electionErr = make(chan error, 1)
+		go func() {
+			electionErr <- election.run(ctx, lead, log)
+		}()
+	} else {
+		lead(ctx)
+	}
 
 	version := numaflow.GetVersion()
 	// TODO: clean it up in v1.6
@@ -162,10 +281,43 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 	metrics.BuildInfo.WithLabelValues(v1alpha1.ComponentDaemon, ds.pipeline.Name, version.Version, version.Platform).Set(1)
 
 	log.Infof("Daemon server started successfully on %s", address)
-	<-ctx.Done()
+	select {
+	case <-ctx.Done():
+	case err := <-electionErr:
+		if err != nil {
+			return fmt.Errorf("failed to elect the leader: %w", err)
+		}
+	}
 	return nil
 }
 
+// newEventRecorder returns an EventRecorder writing the events to the API server, and a function to stop it.
+func newEventRecorder(namespace string) (record.EventRecorder, func(), error) {
+	restConfig, err := sharedutil.K8sRestConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the kubernetes config, %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create a kubernetes client, %w", err)
+	}
+	eventRecorder, stop := events.NewKubeEventRecorder(kubeClient, namespace, v1alpha1.ComponentDaemon)
+	return eventRecorder, stop, nil
+}
+
+// newPipelineClient returns a client of the pipelines in the namespace.
+func newPipelineClient(namespace string) (numaflowv1alpha1.PipelineInterface, error) {
+	restConfig, err := sharedutil.K8sRestConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the kubernetes config, %w", err)
+	}
+	numaflowClient, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a numaflow client, %w", err)
+	}
+	return numaflowClient.NumaflowV1alpha1().Pipelines(namespace), nil
+}
+
 func (ds *daemonServer) newGRPCServer(
 	isbSvcClient isbsvc.ISBService,
 	wmFetchers map[v1alpha1.Edge][]fetch.HeadFetcher,
@@ -175,11 +327,16 @@ func (ds *daemonServer) newGRPCServer(
 	// To enable them please call the following in your server initialization code:"
 	grpc_prometheus.EnableHandlingTimeHistogram()
 
+	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
+	if ds.proxy != nil {
+		unaryInterceptors = append(unaryInterceptors, ds.proxy.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, ds.proxy.streamInterceptor)
+	}
 	sOpts := []grpc.ServerOption{
 		grpc.ConnectionTimeout(300 * time.Second),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_prometheus.UnaryServerInterceptor,
-		)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
 	}
 	grpcServer := grpc.NewServer(sOpts...)
 	grpc_prometheus.Register(grpcServer)
@@ -187,11 +344,18 @@ func (ds *daemonServer) newGRPCServer(
 	if err != nil {
 		return nil, err
 	}
-	daemon.RegisterDaemonServiceServer(grpcServer, pipelineMetadataQuery)
+	daemon.RegisterDaemonServiceServer(grpcServer, &daemonService{PipelineMetadataQuery: pipelineMetadataQuery, daemonServer: ds})
 	ds.metaDataQuery = pipelineMetadataQuery
 	return grpcServer, nil
 }
 
+// daemonService serves the DaemonService, the metadata of the pipeline is queried by the PipelineMetadataQuery, and the
+// APIs depending on the state of the daemon server, e.g. the rebalancing reports, are served by the daemon server.
+type daemonService struct {
+	*service.PipelineMetadataQuery
+	*daemonServer
+}
+
 // newHTTPServer returns the HTTP server to serve HTTP/HTTPS requests. This is implemented
 // using grpc-gateway as a proxy to the gRPC server.
 func (ds *daemonServer) newHTTPServer(ctx context.Context, port int, tlsConfig *tls.Config) *http.Server {
@@ -220,6 +384,9 @@ func (ds *daemonServer) newHTTPServer(ctx context.Context, port int, tlsConfig *
 		Handler:   mux,
 		TLSConfig: tlsConfig,
 	}
+	if ds.proxy != nil {
+		httpServer.Handler = ds.proxy.httpHandler(mux)
+	}
 	mux.Handle("/api/", gwmux)
 	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
@@ -242,6 +409,118 @@ func (ds *daemonServer) newHTTPServer(ctx context.Context, port int, tlsConfig *
 	return &httpServer
 }
 
+// ListRebalanceReports returns the recent reports of the source partitions moving between replicas.
+func (ds *daemonServer) ListRebalanceReports(ctx context.Context, req *daemon.ListRebalanceReportsRequest) (*daemon.ListRebalanceReportsResponse, error) {
+	resp := &daemon.ListRebalanceReportsResponse{}
+	for _, r := range ds.rebalancing.GetReports() {
+		report := &daemon.RebalanceReport{
+			Vertex:       r.Vertex,
+			FromReplicas: int32(r.FromReplicas),
+			ToReplicas:   int32(r.ToReplicas),
+			Time:         wrapperspb.Int64(r.Time.UnixMilli()),
+			Resumed:      r.Resumed,
+		}
+		for _, m := range r.Moves {
+			move := &daemon.PartitionMove{Partition: m.Partition, FromReplica: int32(m.FromReplica), ToReplica: int32(m.ToReplica)}
+			if m.PublisherResumedAt != nil {
+				move.PublisherResumedAt = wrapperspb.Int64(m.PublisherResumedAt.UnixMilli())
+			}
+			report.Moves = append(report.Moves, move)
+		}
+		resp.Reports = append(resp.Reports, report)
+	}
+	return resp, nil
+}
+
+// bufferRepairOptions returns the options the buffers and buckets of the pipeline are created with, i.e. the config
+// of the ISB Service, and the buffer configs and retentions of the edges.
+func (ds *daemonServer) bufferRepairOptions() ([]isbsvc.CreateOption, error) {
+	isbSvcConfig, err := sharedutil.GetIsbSvcConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	var opts []isbsvc.CreateOption
+	if isbSvcConfig.JetStream != nil {
+		opts = append(opts, isbsvc.WithConfig(isbSvcConfig.JetStream.StreamConfig))
+	}
+	for buffer, conf := range ds.pipeline.GetBufferConfigs() {
+		opts = append(opts, isbsvc.WithBufferConfig(buffer, conf))
+	}
+	for buffer, retention := range ds.pipeline.GetBufferRetentions() {
+		opts = append(opts, isbsvc.WithBufferRetention(buffer, retention))
+	}
+	return opts, nil
+}
+
+// bufferConsistencyChecker returns the checker of the consistency of the buffers and buckets of the pipeline, the
+// orphans are cleaned up and the drifted items are repaired if it's enabled.
+func (ds *daemonServer) bufferConsistencyChecker(ctx context.Context, isbSvcClient isbsvc.ISBService) *service.BufferConsistencyChecker {
+	opts := []service.BufferConsistencyOption{service.WithPipelineUID(os.Getenv(v1alpha1.EnvPipelineUID))}
+	if sharedutil.LookupEnvBoolOr(v1alpha1.EnvCleanupOrphanedBuffers, false) {
+		opts = append(opts, service.WithOrphanCleanup(sharedutil.LookupEnvDurationOr(v1alpha1.EnvOrphanedBuffersGracePeriod, v1alpha1.DefaultOrphanedBuffersGracePeriod)))
+	}
+	if sharedutil.LookupEnvBoolOr(v1alpha1.EnvRepairBuffers, false) {
+		repairOpts, err := ds.bufferRepairOptions()
+		if err != nil {
+			logging.FromContext(ctx).Errorw("Failed to get the options to repair the buffers, the drifted buffers will not be repaired", zap.Error(err))
+		} else {
+			opts = append(opts, service.WithRepair(repairOpts...))
+		}
+	}
+	return service.NewBufferConsistencyChecker(ds.pipeline, isbSvcClient, opts...)
+}
+
+// backgroundJobs returns the jobs the leader runs in the background, besides the rater and the health check.
+func (ds *daemonServer) backgroundJobs(ctx context.Context, isbSvcClient isbsvc.ISBService, rater *server.Rater, wmStores map[v1alpha1.Edge][]store.WatermarkStore) []service.BackgroundJob {
+	log := logging.FromContext(ctx)
+	var onAnomalies func([]server.Anomaly)
+	if ds.incidents != nil {
+		onAnomalies = ds.incidents.observeAnomalies
+	}
+	jobs := []service.BackgroundJob{
+		service.NewRebalanceWatcher(ds.pipeline, ds.rebalancing, rater.GetActiveReplicas, wmStores),
+		service.NewAnomalyWatcher(ds.pipeline.Name, rater, onAnomalies),
+		service.NewWindowRateWatcher(ds.pipeline.Name, rater),
+	}
+	pipelines, err := newPipelineClient(ds.pipeline.Namespace)
+	if err != nil {
+		log.Warnw("Failed to create a pipeline client, the conditions, the backfill mode and the pause of the pipeline will not be watched", zap.Error(err))
+		return append(jobs, service.NewBufferConsistencyWatcher(ds.pipeline.Name, ds.bufferConsistencyChecker(ctx, isbSvcClient), nil))
+	}
+	onBackfillChange := func(backfilling bool) {
+		ds.metaDataQuery.SetBackfilling(backfilling)
+		if ds.incidents != nil {
+			ds.incidents.setBackfilling(backfilling)
+		}
+	}
+	return append(jobs,
+		service.NewBufferConsistencyWatcher(ds.pipeline.Name, ds.bufferConsistencyChecker(ctx, isbSvcClient), pipelines),
+		service.NewLagSLOWatcher(ds.pipeline, isbSvcClient, pipelines),
+		service.NewStorageQuotaWatcher(ds.pipeline, isbSvcClient, pipelines, ds.recorder),
+		service.NewBackfillWatcher(ds.pipeline, pipelines, ds.recorder, onBackfillChange),
+		service.NewPauseWatcher(ds.pipeline, isbSvcClient, pipelines),
+	)
+}
+
+// exposeBufferMetrics exposes the capacity and the usage of the buffers reported by the ISB Service.
+func (ds *daemonServer) exposeBufferMetrics(ctx context.Context) {
+	resp, err := ds.metaDataQuery.ListBuffers(ctx, &daemon.ListBuffersRequest{Pipeline: ds.pipeline.Name})
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Failed to list the buffers for the buffer metrics", zap.Error(err))
+		return
+	}
+	now := time.Now().UnixMilli()
+	for _, b := range resp.Buffers {
+		bufferMaxLength.WithLabelValues(ds.pipeline.Name, b.GetBufferName()).Set(float64(b.GetMaxLength().GetValue()))
+		bufferUsagePercentage.WithLabelValues(ds.pipeline.Name, b.GetBufferName()).Set(b.GetUsagePercentage().GetValue())
+		age := int64(-1)
+		if ts := b.GetOldestMessageTimestamp().GetValue(); ts >= 0 {
+			age = now - ts
+		}
+		bufferOldestMessageAge.WithLabelValues(ds.pipeline.Name, b.GetBufferName()).Set(float64(age))
+	}
+}
+
 // calculate processing lag and watermark_delay to current time using watermark values.
 func (ds *daemonServer) exposeMetrics(ctx context.Context) {
 	log := logging.FromContext(ctx)
@@ -257,17 +536,24 @@ func (ds *daemonServer) exposeMetrics(ctx context.Context) {
 		}
 	}
 
+	futureEventTimeBound := sharedutil.LookupEnvDurationOr(v1alpha1.EnvFutureEventTimeBound, v1alpha1.DefaultFutureEventTimeBound)
+
 	ticker := time.NewTicker(10 * time.Second)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
+			ds.exposeBufferMetrics(ctx)
+
 			resp, err := ds.metaDataQuery.GetPipelineWatermarks(ctx, &daemon.GetPipelineWatermarksRequest{Pipeline: ds.pipeline.Name})
 			if err != nil {
 				log.Errorw("Failed to calculate processing lag for pipeline", zap.Error(err))
 				continue
 			}
 			watermarks := resp.PipelineWatermarks
+			if ds.incidents != nil {
+				ds.incidents.observeWatermarks(watermarks)
+			}
 			var (
 				minWM int64 = math.MaxInt64
 				maxWM int64 = math.MinInt64
@@ -310,6 +596,20 @@ func (ds *daemonServer) exposeMetrics(ctx context.Context) {
 				watermarkCmpNow.WithLabelValues(ds.pipeline.Name).Set(float64(time.Now().UnixMilli() - maxWM))
 			}
 
+			// flag the vertices reading messages with event times in the future, usually caused by upstream clock issues.
+			futureEventTime.Reset()
+			for vertex, ahead := range ds.metaDataQuery.GetFutureEventTimeVertices(ctx, time.Now(), futureEventTimeBound) {
+				log.Warnw("Vertex is reading messages with event time in the future", zap.String("vertex", vertex), zap.Duration("ahead", ahead))
+				futureEventTime.WithLabelValues(ds.pipeline.Name, vertex).Set(float64(ahead.Milliseconds()))
+				if ds.incidents != nil {
+					ds.incidents.observeFutureEventTime(vertex, ahead)
+				}
+			}
+
+			if ds.incidents != nil {
+				ds.incidents.observeCriticalBuffers(ds.metaDataQuery.GetCriticalBuffers())
+			}
+
 			//exposing Pipeline data processing health metric.
 			pipelineDataHealth, err := ds.metaDataQuery.GetPipelineStatus(ctx, &daemon.GetPipelineStatusRequest{Pipeline: ds.pipeline.Name})
 
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/daemon/server/service"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+func TestListRebalanceReports(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Unix(1000, 0))
+	ds := &daemonServer{rebalancing: service.NewRebalanceTracker(nil, fakeClock)}
+	resp, err := ds.ListRebalanceReports(context.Background(), &daemon.ListRebalanceReportsRequest{Pipeline: "test-pl"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetReports())
+
+	heartbeats := map[int32]time.Time{0: fakeClock.Now(), 1: fakeClock.Now()}
+	ds.rebalancing.Observe("in", 1, heartbeats)
+	ds.rebalancing.Observe("in", 1, heartbeats)
+	// a new replica count is only taken once it's observed twice in a row.
+	ds.rebalancing.Observe("in", 2, heartbeats)
+	fakeClock.Step(10 * time.Second)
+	ds.rebalancing.Observe("in", 2, heartbeats)
+
+	resp, err = ds.ListRebalanceReports(context.Background(), &daemon.ListRebalanceReportsRequest{Pipeline: "test-pl"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetReports(), 1)
+	report := resp.GetReports()[0]
+	assert.Equal(t, "in", report.GetVertex())
+	assert.Equal(t, int32(1), report.GetFromReplicas())
+	assert.Equal(t, int32(2), report.GetToReplicas())
+	assert.Equal(t, fakeClock.Now().UnixMilli(), report.GetTime().GetValue())
+	assert.False(t, report.GetResumed())
+	require.Len(t, report.GetMoves(), 1)
+	assert.Equal(t, int32(1), report.GetMoves()[0].GetPartition())
+	assert.Nil(t, report.GetMoves()[0].GetPublisherResumedAt())
+}
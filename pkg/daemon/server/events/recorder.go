@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events records the incidents of a pipeline detected by the daemon as Kubernetes events, so that they are
+// shown by `kubectl describe pipeline`.
+//
+// The incidents are detected repeatedly while they last, so the events are deduplicated by their reason and key
+// within a window, and rate limited to protect the API server from bursts.
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+// The reasons of the events recorded by the daemon.
+const (
+	// ReasonBufferFull is recorded when the usage of a buffer stays critical.
+	ReasonBufferFull = "BufferFull"
+	// ReasonWatermarkStalled is recorded when the watermark of an edge does not progress.
+	ReasonWatermarkStalled = "WatermarkStalled"
+	// ReasonFutureEventTime is recorded when a vertex reads messages with event times in the future.
+	ReasonFutureEventTime = "FutureEventTime"
+	// ReasonPartitionsRebalanced is recorded when the source partitions move between the replicas of a vertex.
+	ReasonPartitionsRebalanced = "PartitionsRebalanced"
+	// ReasonBackfillStarted is recorded when the backfill mode of the pipeline is switched on.
+	ReasonBackfillStarted = "BackfillStarted"
+	// ReasonBackfillStopped is recorded when the backfill mode of the pipeline is switched off.
+	ReasonBackfillStopped = "BackfillStopped"
+	// ReasonStorageQuotaExceeded is recorded when the storage used by the buffers of the pipeline exceeds the quota.
+	ReasonStorageQuotaExceeded = "StorageQuotaExceeded"
+	// ReasonStorageQuotaRecovered is recorded when the storage used by the buffers drops below the resume threshold.
+	ReasonStorageQuotaRecovered = "StorageQuotaRecovered"
+	// ReasonRateDropped is recorded when the processing rate of a vertex partition drops suddenly.
+	ReasonRateDropped = "RateDropped"
+	// ReasonBacklogGrowing is recorded when the pending messages of a vertex partition keep growing.
+	ReasonBacklogGrowing = "BacklogGrowing"
+)
+
+const (
+	defaultDedupWindow       = 10 * time.Minute
+	defaultRateLimitEvents   = 10
+	defaultRateLimitInterval = time.Minute
+)
+
+// NewKubeEventRecorder returns an EventRecorder writing the events of the given namespace to the API server, and a
+// function to stop it.
+func NewKubeEventRecorder(kubeClient kubernetes.Interface, namespace, component string) (record.EventRecorder, func()) {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events(namespace)})
+	return broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component}), broadcaster.Shutdown
+}
+
+// PipelineReference returns the reference of a pipeline, which is the object of the events recorded by the daemon.
+func PipelineReference(pl *v1alpha1.Pipeline) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: v1alpha1.SchemeGroupVersion.String(),
+		Kind:       v1alpha1.PipelineGroupVersionKind.Kind,
+		Namespace:  pl.Namespace,
+		Name:       pl.Name,
+		UID:        pl.UID,
+	}
+}
+
+// Recorder records deduplicated and rate limited events for an object.
+type Recorder struct {
+	recorder record.EventRecorder
+	object   runtime.Object
+	opts     *options
+	lock     sync.Mutex
+	// lastRecorded is the last time an event is recorded for a reason and key.
+	lastRecorded map[string]time.Time
+	// recent is the time of the events recorded within the rate limit interval.
+	recent []time.Time
+}
+
+type options struct {
+	dedupWindow       time.Duration
+	rateLimitEvents   int
+	rateLimitInterval time.Duration
+	clock             clock.Clock
+}
+
+type Option func(*options)
+
+// WithDedupWindow sets the window within which the events of the same reason and key are recorded once.
+func WithDedupWindow(d time.Duration) Option {
+	return func(o *options) {
+		o.dedupWindow = d
+	}
+}
+
+// WithRateLimit sets the max number of events recorded within an interval.
+func WithRateLimit(events int, interval time.Duration) Option {
+	return func(o *options) {
+		o.rateLimitEvents = events
+		o.rateLimitInterval = interval
+	}
+}
+
+// WithClock sets the clock of the Recorder.
+func WithClock(c clock.Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// NewRecorder returns a Recorder recording the events for the given object.
+func NewRecorder(recorder record.EventRecorder, object runtime.Object, opts ...Option) *Recorder {
+	o := &options{
+		dedupWindow:       defaultDedupWindow,
+		rateLimitEvents:   defaultRateLimitEvents,
+		rateLimitInterval: defaultRateLimitInterval,
+		clock:             clock.RealClock(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &Recorder{
+		recorder:     recorder,
+		object:       object,
+		opts:         o,
+		lastRecorded: make(map[string]time.Time),
+	}
+}
+
+// Warningf records a warning event of the given reason, the key identifies the subject of the incident, such as a
+// buffer or a vertex. The event is dropped if an event of the same reason and key is recorded within the dedup
+// window, or if the rate limit is exceeded. It returns whether the event is recorded.
+func (r *Recorder) Warningf(reason, key, messageFmt string, args ...interface{}) bool {
+	return r.eventf(corev1.EventTypeWarning, reason, key, messageFmt, args...)
+}
+
+// Normalf records a normal event of the given reason, it is deduplicated and rate limited the same way as Warningf.
+func (r *Recorder) Normalf(reason, key, messageFmt string, args ...interface{}) bool {
+	return r.eventf(corev1.EventTypeNormal, reason, key, messageFmt, args...)
+}
+
+func (r *Recorder) eventf(eventType, reason, key, messageFmt string, args ...interface{}) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := r.opts.clock.Now()
+	dedupKey := fmt.Sprintf("%s/%s", reason, key)
+	if last, ok := r.lastRecorded[dedupKey]; ok && now.Sub(last) < r.opts.dedupWindow {
+		return false
+	}
+	// drop the events out of the rate limit interval
+	i := 0
+	for i < len(r.recent) && now.Sub(r.recent[i]) >= r.opts.rateLimitInterval {
+		i++
+	}
+	r.recent = r.recent[i:]
+	if len(r.recent) >= r.opts.rateLimitEvents {
+		return false
+	}
+	r.recent = append(r.recent, now)
+	r.lastRecorded[dedupKey] = now
+	r.recorder.Eventf(r.object, eventType, reason, messageFmt, args...)
+	return true
+}
+
+// Forget clears the dedup state of a reason and key once the incident is resolved, so that a recurrence is recorded
+// right away.
+func (r *Recorder) Forget(reason, key string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.lastRecorded, fmt.Sprintf("%s/%s", reason, key))
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+var testPipeline = &v1alpha1.Pipeline{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "test-pl",
+		Namespace: "test-ns",
+		UID:       "test-uid",
+	},
+}
+
+func TestPipelineReference(t *testing.T) {
+	ref := PipelineReference(testPipeline)
+	assert.Equal(t, "numaflow.numaproj.io/v1alpha1", ref.APIVersion)
+	assert.Equal(t, "Pipeline", ref.Kind)
+	assert.Equal(t, "test-ns", ref.Namespace)
+	assert.Equal(t, "test-pl", ref.Name)
+	assert.Equal(t, "test-uid", string(ref.UID))
+}
+
+func TestRecorder_Dedup(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	fakeClock := clock.NewFakeClock(time.Unix(1000, 0))
+	r := NewRecorder(fakeRecorder, PipelineReference(testPipeline), WithDedupWindow(time.Minute), WithClock(fakeClock))
+
+	assert.True(t, r.Warningf(ReasonBufferFull, "buffer-0", "Buffer %s is full", "buffer-0"))
+	assert.Equal(t, "Warning BufferFull Buffer buffer-0 is full", <-fakeRecorder.Events)
+
+	// the same incident is deduplicated within the window, a different key is not
+	assert.False(t, r.Warningf(ReasonBufferFull, "buffer-0", "Buffer %s is full", "buffer-0"))
+	assert.True(t, r.Warningf(ReasonBufferFull, "buffer-1", "Buffer %s is full", "buffer-1"))
+	assert.Equal(t, "Warning BufferFull Buffer buffer-1 is full", <-fakeRecorder.Events)
+
+	// it is recorded again after the window
+	fakeClock.Step(time.Minute)
+	assert.True(t, r.Warningf(ReasonBufferFull, "buffer-0", "Buffer %s is full", "buffer-0"))
+	assert.Equal(t, "Warning BufferFull Buffer buffer-0 is full", <-fakeRecorder.Events)
+
+	// it is recorded right away once forgotten
+	r.Forget(ReasonBufferFull, "buffer-0")
+	assert.True(t, r.Warningf(ReasonBufferFull, "buffer-0", "Buffer %s is full", "buffer-0"))
+	assert.Len(t, fakeRecorder.Events, 1)
+}
+
+func TestRecorder_RateLimit(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	fakeClock := clock.NewFakeClock(time.Unix(1000, 0))
+	r := NewRecorder(fakeRecorder, PipelineReference(testPipeline), WithRateLimit(2, time.Minute), WithClock(fakeClock))
+
+	assert.True(t, r.Warningf(ReasonWatermarkStalled, "a-b", "stalled"))
+	fakeClock.Step(30 * time.Second)
+	assert.True(t, r.Warningf(ReasonWatermarkStalled, "b-c", "stalled"))
+	assert.False(t, r.Warningf(ReasonWatermarkStalled, "c-d", "stalled"))
+	assert.Len(t, fakeRecorder.Events, 2)
+
+	// the first event falls out of the interval
+	fakeClock.Step(30 * time.Second)
+	assert.True(t, r.Warningf(ReasonWatermarkStalled, "c-d", "stalled"))
+	assert.False(t, r.Warningf(ReasonWatermarkStalled, "d-e", "stalled"))
+	assert.Len(t, fakeRecorder.Events, 3)
+}
+
+func TestRecorder_Normalf(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	r := NewRecorder(fakeRecorder, PipelineReference(testPipeline))
+
+	assert.True(t, r.Normalf(ReasonPartitionsRebalanced, "in", "Partitions of vertex %s moved", "in"))
+	assert.Equal(t, "Normal PartitionsRebalanced Partitions of vertex in moved", <-fakeRecorder.Events)
+	assert.False(t, r.Normalf(ReasonPartitionsRebalanced, "in", "Partitions of vertex %s moved", "in"))
+}
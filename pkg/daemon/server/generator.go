@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/generator"
+)
+
+// GetGeneratorConfig returns the runtime config of the generator source of every active replica of a vertex, i.e. its
+// rpu, message size and key count. A replica failing to return it is reported with the error in the response.
+func (ds *daemonServer) GetGeneratorConfig(ctx context.Context, req *daemon.GetGeneratorConfigRequest) (*daemon.GetGeneratorConfigResponse, error) {
+	replicas, err := ds.forwardGeneratorConfig(ctx, req.GetPipeline(), req.GetVertex(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &daemon.GetGeneratorConfigResponse{Vertex: req.GetVertex(), Replicas: replicas}, nil
+}
+
+// UpdateGeneratorConfig applies the fields set in the config to the generator source of every active replica of a
+// vertex, without restarting the vertex, if the runtime updates are enabled in its spec. A replica failing to apply it is reported with the error in the response, in
+// which case the update is only applied to a part of the replicas.
+func (ds *daemonServer) UpdateGeneratorConfig(ctx context.Context, req *daemon.UpdateGeneratorConfigRequest) (*daemon.UpdateGeneratorConfigResponse, error) {
+	update := generator.RuntimeConfig{}
+	if c := req.GetConfig(); c != nil {
+		if c.Rpu != nil {
+			update.RPU = &c.Rpu.Value
+		}
+		if c.MsgSize != nil {
+			update.MsgSize = &c.MsgSize.Value
+		}
+		if c.KeyCount != nil {
+			update.KeyCount = &c.KeyCount.Value
+		}
+	}
+	if err := update.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	body, _ := json.Marshal(update)
+	replicas, err := ds.forwardGeneratorConfig(ctx, req.GetPipeline(), req.GetVertex(), body)
+	if err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Infow("Generator runtime config updated by the operator", zap.String("vertex", req.GetVertex()), zap.ByteString("update", body))
+	return &daemon.UpdateGeneratorConfigResponse{Vertex: req.GetVertex(), Replicas: replicas}, nil
+}
+
+// forwardGeneratorConfig forwards the runtime config request to the metrics server of every active replica of the
+// vertex, it's an update if the body is set.
+func (ds *daemonServer) forwardGeneratorConfig(ctx context.Context, pipeline, vertexName string, body []byte) ([]*daemon.ReplicaGeneratorConfig, error) {
+	if pipeline != ds.pipeline.Name {
+		return nil, status.Errorf(codes.NotFound, "pipeline %q not found", pipeline)
+	}
+	abstractVertex := ds.pipeline.GetVertex(vertexName)
+	if abstractVertex == nil {
+		return nil, status.Errorf(codes.NotFound, "vertex %q not found", vertexName)
+	}
+	if abstractVertex.Source == nil || abstractVertex.Source.Generator == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "vertex %q does not have a generator source", vertexName)
+	}
+	if body != nil && abstractVertex.Source.Generator.RuntimeUpdate == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the runtime updates are not enabled in the generator source of vertex %q", vertexName)
+	}
+	replicas := ds.activeReplicas(vertexName)
+	if replicas == 0 {
+		return nil, status.Errorf(codes.Unavailable, "vertex %q does not have any active replica", vertexName)
+	}
+	log := logging.FromContext(ctx).With(zap.String("vertex", vertexName))
+	vertex := &v1alpha1.Vertex{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-%s", ds.pipeline.Name, vertexName)}}
+	var results []*daemon.ReplicaGeneratorConfig
+	for idx := 0; idx < replicas; idx++ {
+		url := fmt.Sprintf("https://%s-%v.%s.%s.svc:%v/generator/config", vertex.Name, idx, vertex.GetHeadlessServiceName(), ds.pipeline.Namespace, v1alpha1.VertexMetricsPort)
+		result := &daemon.ReplicaGeneratorConfig{Replica: int32(idx)}
+		if config, err := ds.forwardReplicaGeneratorConfig(ctx, url, body); err != nil {
+			log.Errorw("Failed to forward the generator runtime config request", zap.Int("replica", idx), zap.Error(err))
+			result.Error = err.Error()
+		} else {
+			result.Config = newGeneratorConfig(config)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// forwardReplicaGeneratorConfig forwards the runtime config request to the metrics server of a replica, it's a POST
+// request with the body for an update, or a GET request otherwise.
+func (ds *daemonServer) forwardReplicaGeneratorConfig(ctx context.Context, url string, body []byte) (*generator.RuntimeConfig, error) {
+	method := http.MethodGet
+	if body != nil {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the request, %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := ds.podHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("%s, %s", res.Status, strings.TrimSpace(string(msg)))
+	}
+	config := &generator.RuntimeConfig{}
+	if err := json.NewDecoder(res.Body).Decode(config); err != nil {
+		return nil, fmt.Errorf("failed to decode the runtime config, %w", err)
+	}
+	return config, nil
+}
+
+// newGeneratorConfig returns the runtime config of a generator in the response of the daemon service.
+func newGeneratorConfig(c *generator.RuntimeConfig) *daemon.GeneratorConfig {
+	result := &daemon.GeneratorConfig{}
+	if c.RPU != nil {
+		result.Rpu = wrapperspb.Int64(*c.RPU)
+	}
+	if c.MsgSize != nil {
+		result.MsgSize = wrapperspb.Int32(*c.MsgSize)
+	}
+	if c.KeyCount != nil {
+		result.KeyCount = wrapperspb.Int32(*c.KeyCount)
+	}
+	return result
+}
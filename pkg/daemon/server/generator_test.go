@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestGeneratorConfig(t *testing.T) {
+	// the pods of the vertex, the second replica fails.
+	var (
+		lock    sync.Mutex
+		hosts   []string
+		updates []string
+	)
+	pods := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		hosts = append(hosts, r.Host)
+		if r.URL.Path != "/generator/config" || strings.HasPrefix(r.Host, "test-pl-in-1.") {
+			http.Error(w, "invalid rpu", http.StatusBadRequest)
+			return
+		}
+		if r.Method == http.MethodPost {
+			b, _ := io.ReadAll(r.Body)
+			updates = append(updates, string(b))
+		}
+		_, _ = w.Write([]byte(`{"rpu":5,"msgSize":8,"keyCount":1}`))
+	}))
+	defer pods.Close()
+	replicas := 1
+	ds := &daemonServer{
+		pipeline: &v1alpha1.Pipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"},
+			Spec: v1alpha1.PipelineSpec{
+				Vertices: []v1alpha1.AbstractVertex{
+					{Name: "in", Source: &v1alpha1.Source{Generator: &v1alpha1.GeneratorSource{RuntimeUpdate: &v1alpha1.GeneratorRuntimeUpdate{}}}},
+					{Name: "fixed", Source: &v1alpha1.Source{Generator: &v1alpha1.GeneratorSource{}}},
+					{Name: "out"},
+				},
+				Edges: []v1alpha1.Edge{{From: "in", To: "out"}, {From: "fixed", To: "out"}},
+			},
+		},
+		activeReplicas: func(string) int { return replicas },
+		podHTTPClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+				// every pod is served by the test server.
+				DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, network, pods.Listener.Addr().String())
+				},
+			},
+		},
+	}
+	ctx := context.Background()
+	update := func(pipeline, vertex string, config *daemon.GeneratorConfig) (*daemon.UpdateGeneratorConfigResponse, error) {
+		return ds.UpdateGeneratorConfig(ctx, &daemon.UpdateGeneratorConfigRequest{Pipeline: pipeline, Vertex: vertex, Config: config})
+	}
+
+	_, err := update("other-pl", "in", nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = update("test-pl", "unknown", nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = update("test-pl", "out", nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = update("test-pl", "in", &daemon.GeneratorConfig{KeyCount: wrapperspb.Int32(0)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	// the runtime updates are not enabled in the spec.
+	_, err = update("test-pl", "fixed", &daemon.GeneratorConfig{Rpu: wrapperspb.Int64(10)})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, hosts)
+
+	resp, err := ds.GetGeneratorConfig(ctx, &daemon.GetGeneratorConfigRequest{Pipeline: "test-pl", Vertex: "in"})
+	require.NoError(t, err)
+	assert.Equal(t, "in", resp.GetVertex())
+	require.Len(t, resp.GetReplicas(), 1)
+	assert.Equal(t, int64(5), resp.GetReplicas()[0].GetConfig().GetRpu().GetValue())
+	assert.Equal(t, int32(8), resp.GetReplicas()[0].GetConfig().GetMsgSize().GetValue())
+	assert.Equal(t, []string{fmt.Sprintf("test-pl-in-0.test-pl-in-headless.test-ns.svc:%d", v1alpha1.VertexMetricsPort)}, hosts)
+
+	// the update is forwarded to every active replica, the failed one is reported.
+	replicas = 2
+	updateResp, err := update("test-pl", "in", &daemon.GeneratorConfig{Rpu: wrapperspb.Int64(10)})
+	require.NoError(t, err)
+	require.Len(t, updateResp.GetReplicas(), 2)
+	assert.NotNil(t, updateResp.GetReplicas()[0].GetConfig())
+	assert.Empty(t, updateResp.GetReplicas()[0].GetError())
+	assert.Nil(t, updateResp.GetReplicas()[1].GetConfig())
+	assert.Contains(t, updateResp.GetReplicas()[1].GetError(), "invalid rpu")
+	assert.Equal(t, []string{`{"rpu":10}`}, updates)
+
+	replicas = 0
+	_, err = update("test-pl", "in", &daemon.GeneratorConfig{Rpu: wrapperspb.Int64(10)})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
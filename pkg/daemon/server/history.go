@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// The server side limits of a buffer history session, the requested values can not exceed the max ones.
+const (
+	defaultHistoryLimit    = 100
+	maxHistoryLimit        = 10000
+	defaultHistoryRate     = 10
+	maxHistoryRate         = 100
+	defaultHistoryDuration = 30 * time.Second
+	maxHistoryDuration     = 5 * time.Minute
+)
+
+// newBufferMessage returns a message of a buffer in the response of the daemon service.
+func newBufferMessage(msg *isbsvc.HistoryMessage) *daemon.BufferMessage {
+	bm := &daemon.BufferMessage{
+		Sequence:        msg.Sequence,
+		WriteTime:       wrapperspb.Int64(msg.WriteTime.UnixMilli()),
+		Kind:            msg.Kind.String(),
+		Id:              msg.ID.String(),
+		Keys:            msg.Keys,
+		Headers:         maps.Clone(msg.Headers),
+		EncryptionKeyID: msg.EncryptionKeyID,
+		Payload:         msg.Payload,
+	}
+	if !msg.EventTime.IsZero() {
+		bm.EventTime = wrapperspb.Int64(msg.EventTime.UnixMilli())
+	}
+	return bm
+}
+
+// historyRequest is the parsed request of a buffer history session.
+type historyRequest struct {
+	from     isbsvc.HistoryPosition
+	limit    int
+	rate     int
+	duration time.Duration
+}
+
+// parseHistoryRequest parses the request of a buffer history session, the missing values are set to the defaults.
+func parseHistoryRequest(r *daemon.ReadBufferHistoryRequest) (*historyRequest, error) {
+	req := &historyRequest{duration: defaultHistoryDuration}
+	if v := r.GetStartTime(); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid startTime %q, it should be in RFC3339 format", v)
+		}
+		req.from.StartTime = t
+	}
+	req.from.StartSequence = r.GetStartSequence()
+	if !req.from.StartTime.IsZero() && req.from.StartSequence != "" {
+		return nil, fmt.Errorf("only one of startTime and startSequence can be set")
+	}
+	var err error
+	if req.limit, err = boundedValue(r.GetLimit(), "limit", defaultHistoryLimit, maxHistoryLimit); err != nil {
+		return nil, err
+	}
+	if req.rate, err = boundedValue(r.GetRate(), "rate", defaultHistoryRate, maxHistoryRate); err != nil {
+		return nil, err
+	}
+	if v := r.GetDuration(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > maxHistoryDuration {
+			return nil, fmt.Errorf("invalid duration %q, it should be greater than 0 and at most %v", v, maxHistoryDuration)
+		}
+		req.duration = d
+	}
+	return req, nil
+}
+
+// boundedValue returns the requested value, or the default one if it's not set.
+func boundedValue(v int32, name string, defaultValue, maxValue int) (int, error) {
+	if v == 0 {
+		return defaultValue, nil
+	}
+	if v < 0 || int(v) > maxValue {
+		return 0, fmt.Errorf("invalid %s %d, it should be between 1 and %d", name, v, maxValue)
+	}
+	return int(v), nil
+}
+
+// findBuffer returns an error with the NotFound code if the buffer does not belong to the pipeline.
+func (ds *daemonServer) findBuffer(pipeline, buffer string) error {
+	if pipeline != ds.pipeline.Name {
+		return status.Errorf(codes.NotFound, "pipeline %q not found", pipeline)
+	}
+	if !slices.Contains(ds.pipeline.GetAllBuffers(), buffer) {
+		return status.Errorf(codes.NotFound, "buffer %q not found", buffer)
+	}
+	return nil
+}
+
+// ReadBufferHistory streams the messages of a buffer from a past position, for debugging. The messages are read by an
+// ephemeral reader detached from the pipeline, the offsets and the acks of the pipeline are not affected. The session
+// ends when the limit of messages or the duration is reached, and the messages are streamed at most at the rate.
+func (ds *daemonServer) ReadBufferHistory(r *daemon.ReadBufferHistoryRequest, stream daemon.DaemonService_ReadBufferHistoryServer) error {
+	buffer := r.GetBuffer()
+	if err := ds.findBuffer(r.GetPipeline(), buffer); err != nil {
+		return err
+	}
+	req, err := parseHistoryRequest(r)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	log := logging.FromContext(stream.Context()).With(zap.String("buffer", buffer))
+	ctx, cancel := context.WithTimeout(stream.Context(), req.duration)
+	defer cancel()
+
+	interval := time.Second / time.Duration(req.rate)
+	var next time.Time
+	count := 0
+	err = ds.isbSvcClient.ReadBufferHistory(ctx, buffer, req.from, func(msg *isbsvc.HistoryMessage) bool {
+		if wait := time.Until(next); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return false
+			}
+		}
+		next = time.Now().Add(interval)
+		if err := stream.Send(&daemon.ReadBufferHistoryResponse{Message: newBufferMessage(msg)}); err != nil {
+			log.Warnw("Failed to stream the buffer history", zap.Error(err))
+			return false
+		}
+		count++
+		return count < req.limit
+	})
+	// reaching the duration is an expected end of the session
+	if err != nil && ctx.Err() == nil {
+		log.Errorw("Failed to read the buffer history", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to read the buffer history, %v", err)
+	}
+	log.Infow("Buffer history session ended", zap.Int("messages", count))
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+// historyTestISBSvc serves a history of count messages, or blocks until the context is done if count is negative.
+type historyTestISBSvc struct {
+	isbsvc.ISBService
+	count int
+	from  isbsvc.HistoryPosition
+}
+
+func (s *historyTestISBSvc) ReadBufferHistory(ctx context.Context, buffer string, from isbsvc.HistoryPosition, fn func(*isbsvc.HistoryMessage) bool) error {
+	s.from = from
+	if s.count < 0 {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	for i := 1; i <= s.count; i++ {
+		msg := &isbsvc.HistoryMessage{Sequence: strconv.Itoa(i), WriteTime: time.Unix(int64(i), 0).UTC()}
+		msg.ID = isb.MessageID{VertexName: "in", Offset: strconv.Itoa(i)}
+		msg.Keys = []string{"key"}
+		msg.Payload = []byte(fmt.Sprintf("payload-%d", i))
+		if !fn(msg) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// mockReadBufferHistoryServer collects the messages streamed.
+type mockReadBufferHistoryServer struct {
+	grpc.ServerStream
+	messages []*daemon.BufferMessage
+}
+
+func (m *mockReadBufferHistoryServer) Context() context.Context {
+	return context.Background()
+}
+
+func (m *mockReadBufferHistoryServer) Send(resp *daemon.ReadBufferHistoryResponse) error {
+	m.messages = append(m.messages, resp.GetMessage())
+	return nil
+}
+
+func newHistoryTestServer(isbSvc isbsvc.ISBService) *daemonServer {
+	return &daemonServer{
+		pipeline: &v1alpha1.Pipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"},
+			Spec: v1alpha1.PipelineSpec{
+				Vertices: []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "out"}},
+				Edges:    []v1alpha1.Edge{{From: "in", To: "out"}},
+			},
+		},
+		isbSvcClient: isbSvc,
+	}
+}
+
+func TestReadBufferHistory(t *testing.T) {
+	isbSvc := &historyTestISBSvc{count: 5}
+	ds := newHistoryTestServer(isbSvc)
+	req := &daemon.ReadBufferHistoryRequest{Pipeline: "test-pl", Buffer: "test-ns-test-pl-out-0"}
+
+	start := time.Now()
+	stream := &mockReadBufferHistoryServer{}
+	req.StartSequence, req.Rate = "1", 50
+	require.NoError(t, ds.ReadBufferHistory(req, stream))
+	assert.Equal(t, isbsvc.HistoryPosition{StartSequence: "1"}, isbSvc.from)
+	require.Len(t, stream.messages, 5)
+	first := stream.messages[0]
+	assert.Equal(t, "1", first.GetSequence())
+	assert.Equal(t, int64(1000), first.GetWriteTime().GetValue())
+	assert.Equal(t, "Data", first.GetKind())
+	assert.Equal(t, "in-1-0", first.GetId())
+	assert.Nil(t, first.GetEventTime())
+	assert.Equal(t, []string{"key"}, first.GetKeys())
+	assert.Equal(t, []byte("payload-1"), first.GetPayload())
+	assert.Equal(t, "5", stream.messages[4].GetSequence())
+	// 5 messages at 50 per second take at least 80ms
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+
+	// the session stops at the limit
+	stream = &mockReadBufferHistoryServer{}
+	req.StartSequence, req.StartTime, req.Limit, req.Rate = "", "2024-01-01T00:00:00Z", 2, 100
+	require.NoError(t, ds.ReadBufferHistory(req, stream))
+	assert.Equal(t, isbsvc.HistoryPosition{StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, isbSvc.from)
+	assert.Len(t, stream.messages, 2)
+
+	// the session stops at the duration
+	isbSvc.count = -1
+	start = time.Now()
+	stream = &mockReadBufferHistoryServer{}
+	require.NoError(t, ds.ReadBufferHistory(&daemon.ReadBufferHistoryRequest{Pipeline: "test-pl", Buffer: "test-ns-test-pl-out-0", Duration: "100ms"}, stream))
+	assert.Empty(t, stream.messages)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestReadBufferHistory_InvalidRequests(t *testing.T) {
+	ds := newHistoryTestServer(&historyTestISBSvc{})
+	tests := []struct {
+		req  *daemon.ReadBufferHistoryRequest
+		code codes.Code
+	}{
+		{req: &daemon.ReadBufferHistoryRequest{Pipeline: "other-pl", Buffer: "test-ns-test-pl-out-0"}, code: codes.NotFound},
+		{req: &daemon.ReadBufferHistoryRequest{Pipeline: "test-pl", Buffer: "test-ns-other-pl-out-0"}, code: codes.NotFound},
+		{req: &daemon.ReadBufferHistoryRequest{StartTime: "yesterday"}, code: codes.InvalidArgument},
+		{req: &daemon.ReadBufferHistoryRequest{StartTime: "2024-01-01T00:00:00Z", StartSequence: "1"}, code: codes.InvalidArgument},
+		{req: &daemon.ReadBufferHistoryRequest{Limit: -1}, code: codes.InvalidArgument},
+		{req: &daemon.ReadBufferHistoryRequest{Limit: maxHistoryLimit + 1}, code: codes.InvalidArgument},
+		{req: &daemon.ReadBufferHistoryRequest{Rate: maxHistoryRate + 1}, code: codes.InvalidArgument},
+		{req: &daemon.ReadBufferHistoryRequest{Duration: "10m"}, code: codes.InvalidArgument},
+		{req: &daemon.ReadBufferHistoryRequest{Duration: "-1s"}, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		if tt.req.Pipeline == "" {
+			tt.req.Pipeline, tt.req.Buffer = "test-pl", "test-ns-test-pl-out-0"
+		}
+		err := ds.ReadBufferHistory(tt.req, &mockReadBufferHistoryServer{})
+		assert.Equal(t, tt.code, status.Code(err), tt.req.String())
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
+	server "github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+const (
+	// bufferFullSustainDuration is how long the usage of a buffer stays critical before an event is recorded.
+	bufferFullSustainDuration = 3 * time.Minute
+	// watermarkStallDuration is how long the watermark of an edge does not progress before an event is recorded.
+	watermarkStallDuration = 10 * time.Minute
+)
+
+// watermarkProgress tracks the progress of the watermark of an edge.
+type watermarkProgress struct {
+	value int64
+	since time.Time
+}
+
+// anomalyEvent identifies the event recorded for an anomaly of a vertex partition.
+type anomalyEvent struct {
+	reason string
+	key    string
+}
+
+// incidentDetector turns the conditions observed by the daemon into events, it is not thread safe except for
+// setBackfilling.
+type incidentDetector struct {
+	recorder *events.Recorder
+	clock    clock.Clock
+	// backfilling is whether the pipeline is in backfill mode, the watermark stalls are expected and not recorded.
+	backfilling atomic.Bool
+	// bufferFullSince is the time since when the usage of a buffer is critical.
+	bufferFullSince map[string]time.Time
+	// watermarks is the progress of the watermark of each edge.
+	watermarks map[string]watermarkProgress
+	// anomalies is the events recorded for the anomalies of the vertex partitions that are still lasting.
+	anomalies map[anomalyEvent]bool
+}
+
+func newIncidentDetector(recorder *events.Recorder, c clock.Clock) *incidentDetector {
+	return &incidentDetector{
+		recorder:        recorder,
+		clock:           c,
+		bufferFullSince: make(map[string]time.Time),
+		watermarks:      make(map[string]watermarkProgress),
+		anomalies:       make(map[anomalyEvent]bool),
+	}
+}
+
+// observeCriticalBuffers records an event for the buffers whose usage has been critical for longer than
+// bufferFullSustainDuration.
+func (d *incidentDetector) observeCriticalBuffers(buffers []string) {
+	now := d.clock.Now()
+	critical := make(map[string]bool, len(buffers))
+	for _, b := range buffers {
+		critical[b] = true
+		since, ok := d.bufferFullSince[b]
+		if !ok {
+			d.bufferFullSince[b] = now
+			continue
+		}
+		if duration := now.Sub(since); duration >= bufferFullSustainDuration {
+			d.recorder.Warningf(events.ReasonBufferFull, b, "Buffer %s has been full for %s, the downstream vertex is not keeping up", b, duration.Truncate(time.Second))
+		}
+	}
+	for b := range d.bufferFullSince {
+		if !critical[b] {
+			delete(d.bufferFullSince, b)
+			d.recorder.Forget(events.ReasonBufferFull, b)
+		}
+	}
+}
+
+// setBackfilling sets whether the pipeline is in backfill mode.
+func (d *incidentDetector) setBackfilling(backfilling bool) {
+	d.backfilling.Store(backfilling)
+}
+
+// observeWatermarks records an event for the edges whose watermark has not progressed for longer than
+// watermarkStallDuration. Nothing is recorded in backfill mode, and the stall duration starts over when the backfill
+// mode is switched off.
+func (d *incidentDetector) observeWatermarks(watermarks []*daemon.EdgeWatermark) {
+	now := d.clock.Now()
+	backfilling := d.backfilling.Load()
+	for _, ew := range watermarks {
+		if !ew.GetIsWatermarkEnabled().GetValue() {
+			continue
+		}
+		edge := fmt.Sprintf("%s-%s", ew.GetFrom(), ew.GetTo())
+		// the watermark of an edge is the smallest one of its partitions
+		var wm int64 = -1
+		for _, w := range ew.GetWatermarks() {
+			if v := w.GetValue(); v >= 0 && (wm < 0 || v < wm) {
+				wm = v
+			}
+		}
+		if wm < 0 {
+			// the watermark is not available yet
+			continue
+		}
+		last, ok := d.watermarks[edge]
+		if !ok || wm != last.value || backfilling {
+			d.watermarks[edge] = watermarkProgress{value: wm, since: now}
+			if ok {
+				d.recorder.Forget(events.ReasonWatermarkStalled, edge)
+			}
+			continue
+		}
+		if duration := now.Sub(last.since); duration >= watermarkStallDuration {
+			d.recorder.Warningf(events.ReasonWatermarkStalled, edge, "Watermark of edge %s has not progressed for %s, stuck at %s", edge, duration.Truncate(time.Second), time.UnixMilli(wm).UTC().Format(time.RFC3339))
+		}
+	}
+}
+
+// observeFutureEventTime records an event for a vertex reading messages with event times in the future.
+func (d *incidentDetector) observeFutureEventTime(vertex string, ahead time.Duration) {
+	d.recorder.Warningf(events.ReasonFutureEventTime, vertex, "Vertex %s is reading messages with event times %s in the future, check the clocks of the upstream producers", vertex, ahead.Truncate(time.Millisecond))
+}
+
+// observeAnomalies records an event for each anomaly in the processing of the vertex partitions detected by the rater.
+// The anomalies no longer detected are forgotten, so that a recurrence is recorded right away.
+func (d *incidentDetector) observeAnomalies(anomalies []server.Anomaly) {
+	lasting := make(map[anomalyEvent]bool, len(anomalies))
+	for _, a := range anomalies {
+		e := anomalyEvent{key: fmt.Sprintf("%s/%s", a.Vertex, a.Partition)}
+		switch a.Kind {
+		case server.AnomalyRateDrop:
+			e.reason = events.ReasonRateDropped
+			d.recorder.Warningf(e.reason, e.key, "Processing rate of vertex %s partition %s has dropped to %.2f/s for %s, the trailing average is %.2f/s", a.Vertex, a.Partition, a.Current, a.Duration, a.Baseline)
+		case server.AnomalyBacklogGrowth:
+			e.reason = events.ReasonBacklogGrowing
+			d.recorder.Warningf(e.reason, e.key, "Pending messages of vertex %s partition %s have grown from %.0f to %.0f in %s", a.Vertex, a.Partition, a.Baseline, a.Current, a.Duration)
+		default:
+			continue
+		}
+		lasting[e] = true
+		d.anomalies[e] = true
+	}
+	for e := range d.anomalies {
+		if !lasting[e] {
+			delete(d.anomalies, e)
+			d.recorder.Forget(e.reason, e.key)
+		}
+	}
+}
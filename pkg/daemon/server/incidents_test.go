@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
+	server "github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+func newTestIncidentDetector() (*incidentDetector, *record.FakeRecorder, *clock.FakeClock) {
+	pl := &v1alpha1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"}}
+	fakeRecorder := record.NewFakeRecorder(10)
+	fakeClock := clock.NewFakeClock(time.Unix(1000, 0))
+	recorder := events.NewRecorder(fakeRecorder, events.PipelineReference(pl), events.WithClock(fakeClock))
+	return newIncidentDetector(recorder, fakeClock), fakeRecorder, fakeClock
+}
+
+func TestIncidentDetector_BufferFull(t *testing.T) {
+	d, fakeRecorder, fakeClock := newTestIncidentDetector()
+
+	d.observeCriticalBuffers([]string{"buffer-0"})
+	fakeClock.Step(time.Minute)
+	d.observeCriticalBuffers([]string{"buffer-0"})
+	assert.Empty(t, fakeRecorder.Events)
+
+	// the usage stays critical
+	fakeClock.Step(bufferFullSustainDuration)
+	d.observeCriticalBuffers([]string{"buffer-0"})
+	assert.Equal(t, "Warning BufferFull Buffer buffer-0 has been full for 4m0s, the downstream vertex is not keeping up", <-fakeRecorder.Events)
+
+	// the incident is deduplicated while it lasts
+	fakeClock.Step(time.Minute)
+	d.observeCriticalBuffers([]string{"buffer-0"})
+	assert.Empty(t, fakeRecorder.Events)
+
+	// the usage recovers, a recurrence is tracked from the start
+	d.observeCriticalBuffers(nil)
+	d.observeCriticalBuffers([]string{"buffer-0"})
+	fakeClock.Step(bufferFullSustainDuration)
+	d.observeCriticalBuffers([]string{"buffer-0"})
+	assert.Equal(t, "Warning BufferFull Buffer buffer-0 has been full for 3m0s, the downstream vertex is not keeping up", <-fakeRecorder.Events)
+}
+
+func TestIncidentDetector_WatermarkStalled(t *testing.T) {
+	d, fakeRecorder, fakeClock := newTestIncidentDetector()
+	edgeWatermark := func(watermarks ...int64) []*daemon.EdgeWatermark {
+		ew := &daemon.EdgeWatermark{
+			From:               "in",
+			To:                 "out",
+			IsWatermarkEnabled: wrapperspb.Bool(true),
+		}
+		for _, w := range watermarks {
+			ew.Watermarks = append(ew.Watermarks, wrapperspb.Int64(w))
+		}
+		return []*daemon.EdgeWatermark{ew}
+	}
+
+	// the watermark is not available yet
+	d.observeWatermarks(edgeWatermark(-1, -1))
+	fakeClock.Step(watermarkStallDuration)
+	d.observeWatermarks(edgeWatermark(-1, -1))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// the watermark progresses
+	d.observeWatermarks(edgeWatermark(1000, 2000))
+	fakeClock.Step(time.Minute)
+	d.observeWatermarks(edgeWatermark(3000, 2000))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// the watermark of the slowest partition is stuck
+	fakeClock.Step(watermarkStallDuration)
+	d.observeWatermarks(edgeWatermark(5000, 2000))
+	assert.Equal(t, "Warning WatermarkStalled Watermark of edge in-out has not progressed for 10m0s, stuck at 1970-01-01T00:00:02Z", <-fakeRecorder.Events)
+
+	// the watermarks are not tracked if watermark is disabled
+	disabled := edgeWatermark(0)
+	disabled[0].IsWatermarkEnabled = wrapperspb.Bool(false)
+	d.observeWatermarks(disabled)
+	fakeClock.Step(watermarkStallDuration)
+	d.observeWatermarks(disabled)
+	assert.Empty(t, fakeRecorder.Events)
+}
+
+func TestIncidentDetector_WatermarkStalledBackfilling(t *testing.T) {
+	d, fakeRecorder, fakeClock := newTestIncidentDetector()
+	edgeWatermark := []*daemon.EdgeWatermark{{
+		From:               "in",
+		To:                 "out",
+		IsWatermarkEnabled: wrapperspb.Bool(true),
+		Watermarks:         []*wrapperspb.Int64Value{wrapperspb.Int64(1000)},
+	}}
+
+	d.setBackfilling(true)
+	d.observeWatermarks(edgeWatermark)
+	fakeClock.Step(2 * watermarkStallDuration)
+	d.observeWatermarks(edgeWatermark)
+	assert.Empty(t, fakeRecorder.Events)
+
+	// the stall duration starts over after the backfill
+	d.setBackfilling(false)
+	fakeClock.Step(time.Minute)
+	d.observeWatermarks(edgeWatermark)
+	assert.Empty(t, fakeRecorder.Events)
+	fakeClock.Step(watermarkStallDuration)
+	d.observeWatermarks(edgeWatermark)
+	assert.Equal(t, "Warning WatermarkStalled Watermark of edge in-out has not progressed for 11m0s, stuck at 1970-01-01T00:00:01Z", <-fakeRecorder.Events)
+}
+
+func TestIncidentDetector_FutureEventTime(t *testing.T) {
+	d, fakeRecorder, _ := newTestIncidentDetector()
+	d.observeFutureEventTime("in", 90*time.Second)
+	assert.Equal(t, "Warning FutureEventTime Vertex in is reading messages with event times 1m30s in the future, check the clocks of the upstream producers", <-fakeRecorder.Events)
+	d.observeFutureEventTime("in", 90*time.Second)
+	assert.Empty(t, fakeRecorder.Events)
+}
+
+func TestIncidentDetector_Anomalies(t *testing.T) {
+	d, fakeRecorder, _ := newTestIncidentDetector()
+	rateDrop := server.Anomaly{Kind: server.AnomalyRateDrop, Vertex: "cat", Partition: "cat-0", Current: 2, Baseline: 10, Duration: time.Minute}
+	backlogGrowth := server.Anomaly{Kind: server.AnomalyBacklogGrowth, Vertex: "out", Partition: "out-0", Current: 250, Baseline: 50, Duration: time.Minute}
+
+	d.observeAnomalies([]server.Anomaly{rateDrop, backlogGrowth})
+	assert.Equal(t, "Warning RateDropped Processing rate of vertex cat partition cat-0 has dropped to 2.00/s for 1m0s, the trailing average is 10.00/s", <-fakeRecorder.Events)
+	assert.Equal(t, "Warning BacklogGrowing Pending messages of vertex out partition out-0 have grown from 50 to 250 in 1m0s", <-fakeRecorder.Events)
+
+	// the anomalies are deduplicated while they last
+	d.observeAnomalies([]server.Anomaly{rateDrop, backlogGrowth})
+	assert.Empty(t, fakeRecorder.Events)
+
+	// the rate recovers, a recurrence is recorded right away
+	d.observeAnomalies([]server.Anomaly{backlogGrowth})
+	d.observeAnomalies([]server.Anomaly{rateDrop, backlogGrowth})
+	assert.Equal(t, "Warning RateDropped Processing rate of vertex cat partition cat-0 has dropped to 2.00/s for 1m0s, the trailing average is 10.00/s", <-fakeRecorder.Events)
+	assert.Empty(t, fakeRecorder.Events)
+}
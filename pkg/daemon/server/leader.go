@@ -0,0 +1,377 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+const (
+	// the lease settings are the same as the defaults of the controller
+	leaseDuration      = 15 * time.Second
+	leaseRenewDeadline = 10 * time.Second
+	leaseRetryPeriod   = 2 * time.Second
+)
+
+var (
+	// errLeaderUnknown is returned to the API requests received by a follower while there is no leader, e.g., during a
+	// failover.
+	errLeaderUnknown = errors.New("the leader of the daemon server replicas is unknown")
+	// errLeadershipLost is returned by the election when the leader loses the lease.
+	errLeadershipLost = errors.New("lost the leadership of the daemon server replicas")
+	// errLeaderUnverified is returned when the certificate of the leader does not match the fingerprint in its identity.
+	errLeaderUnverified = errors.New("the certificate of the leader does not match its identity")
+)
+
+// leaderElection elects the leader among the replicas of the daemon server with a lease. The identity of a replica is
+// "<pod name>_<pod IP>:<port>_<fingerprint>", so that the followers know the address of the leader from the lease, and
+// verify it with the fingerprint of its certificate. An IPv6 address is in brackets, e.g., "<pod name>_[fd00::1]:<port>_<fingerprint>".
+type leaderElection struct {
+	lock     resourcelock.Interface
+	identity string
+	mu       sync.RWMutex
+	// leader is the identity of the observed leader, it's empty if the leader is unknown.
+	leader string
+}
+
+// newLeaderElection returns the election of the replica, the fingerprint is the one of the certificate it serves.
+func newLeaderElection(pl *v1alpha1.Pipeline, podName, podIP, fingerprint string) (*leaderElection, error) {
+	if podName == "" || podIP == "" {
+		return nil, fmt.Errorf("the pod name and the pod IP are required to elect the leader")
+	}
+	restConfig, err := sharedutil.K8sRestConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the kubernetes config, %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a kubernetes client, %w", err)
+	}
+	identity := leaderIdentity(podName, podIP, fingerprint)
+	return &leaderElection{
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Namespace: pl.Namespace, Name: pl.GetDaemonDeploymentName() + "-lock"},
+			Client:     kubeClient.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		identity: identity,
+	}, nil
+}
+
+// leaderIdentity returns the identity of a replica in the election.
+func leaderIdentity(podName, podIP, fingerprint string) string {
+	return podName + "_" + net.JoinHostPort(podIP, strconv.Itoa(v1alpha1.DaemonServicePort)) + "_" + fingerprint
+}
+
+// certFingerprint returns the SHA-256 fingerprint of a DER encoded certificate.
+func certFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// run takes part in the election until the context is canceled, and calls lead once this replica becomes the leader.
+// It returns errLeadershipLost if the leader loses the lease, so that the daemon server shuts down, and restarts as a
+// follower.
+func (le *leaderElection) run(ctx context.Context, lead func(ctx context.Context), log *zap.SugaredLogger) error {
+	var lost bool
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            le.lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   leaseRenewDeadline,
+		RetryPeriod:     leaseRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            le.identity,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infow("Became the leader of the daemon server replicas", zap.String("identity", le.identity))
+				lead(ctx)
+			},
+			OnStoppedLeading: func() {
+				if ctx.Err() == nil {
+					log.Errorw("Lost the leadership of the daemon server replicas", zap.String("identity", le.identity))
+					lost = true
+				}
+			},
+			OnNewLeader: func(identity string) {
+				log.Infow("Observed a new leader of the daemon server replicas", zap.String("leader", identity))
+				le.setLeader(identity)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the leader elector, %w", err)
+	}
+	// the jobs of the leader are stopped by the elector before Run returns
+	elector.Run(ctx)
+	if lost {
+		return errLeadershipLost
+	}
+	return nil
+}
+
+func (le *leaderElection) setLeader(identity string) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+	le.leader = identity
+}
+
+// leaderPeer returns the leader, and whether the API requests should be forwarded to it, i.e., this replica is not the
+// leader. The address of the leader is empty if the leader is unknown.
+func (le *leaderElection) leaderPeer() (peer, bool) {
+	le.mu.RLock()
+	defer le.mu.RUnlock()
+	if le.leader == le.identity {
+		return peer{}, false
+	}
+	parts := strings.SplitN(le.leader, "_", 3)
+	if len(parts) < 2 {
+		return peer{}, true
+	}
+	p := peer{address: parts[1]}
+	if len(parts) == 3 {
+		p.fingerprint = parts[2]
+	}
+	return p, true
+}
+
+// peer is a replica of the daemon server the API requests are forwarded to.
+type peer struct {
+	address string
+	// fingerprint is the fingerprint of the certificate served by the replica. The certificates of the daemon server are
+	// self-signed, the replica is verified by pinning its certificate instead.
+	fingerprint string
+}
+
+// tlsConfig returns the TLS config of the connections to the peer, which verifies the certificate of the peer with its
+// fingerprint, so that the API requests, e.g., purging the buffers, are only forwarded to the replica in the lease.
+func (p peer) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the self-signed certificate can not be verified with a CA, it's verified with the fingerprint below
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || certFingerprint(rawCerts[0]) != p.fingerprint {
+				return errLeaderUnverified
+			}
+			return nil
+		},
+	}
+}
+
+// leaderProxy forwards the API requests received by a follower replica of the daemon server to the leader.
+type leaderProxy struct {
+	// leader returns the leader, and whether the requests should be forwarded to it.
+	leader func() (peer, bool)
+	mu     sync.Mutex
+	// conn and transport are the gRPC connection and the HTTP transport to the current leader.
+	conn      *grpc.ClientConn
+	transport *http.Transport
+	current   peer
+}
+
+func newLeaderProxy(leader func() (peer, bool)) *leaderProxy {
+	return &leaderProxy{leader: leader}
+}
+
+// connect returns the gRPC connection and the HTTP transport to the leader, the ones to the previous leader are closed.
+func (lp *leaderProxy) connect(leader peer) (*grpc.ClientConn, http.RoundTripper, error) {
+	if leader.address == "" {
+		return nil, nil, status.Error(codes.Unavailable, errLeaderUnknown.Error())
+	}
+	if leader.fingerprint == "" {
+		return nil, nil, status.Errorf(codes.Unavailable, "the leader %q can not be verified without the fingerprint of its certificate", leader.address)
+	}
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if lp.conn != nil && lp.current == leader {
+		return lp.conn, lp.transport, nil
+	}
+	lp.closeLocked()
+	conn, err := grpc.NewClient(leader.address, grpc.WithTransportCredentials(credentials.NewTLS(leader.tlsConfig())))
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "failed to connect to the leader %q, %v", leader.address, err)
+	}
+	lp.conn, lp.transport, lp.current = conn, &http.Transport{TLSClientConfig: leader.tlsConfig()}, leader
+	return lp.conn, lp.transport, nil
+}
+
+// close closes the connection to the leader.
+func (lp *leaderProxy) close() {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	lp.closeLocked()
+}
+
+func (lp *leaderProxy) closeLocked() {
+	if lp.conn != nil {
+		_ = lp.conn.Close()
+		lp.conn = nil
+	}
+	if lp.transport != nil {
+		lp.transport.CloseIdleConnections()
+		lp.transport = nil
+	}
+}
+
+// unaryInterceptor forwards the unary gRPC requests to the leader.
+func (lp *leaderProxy) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	leader, ok := lp.leader()
+	if !ok {
+		return handler(ctx, req)
+	}
+	conn, _, err := lp.connect(leader)
+	if err != nil {
+		return nil, err
+	}
+	_, output, err := methodMessageTypes(info.FullMethod)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	reply := output.New().Interface()
+	if err := conn.Invoke(ctx, info.FullMethod, req, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// streamInterceptor forwards the server streaming gRPC requests to the leader, and streams the responses back.
+func (lp *leaderProxy) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	leader, ok := lp.leader()
+	if !ok || info.IsClientStream {
+		return handler(srv, ss)
+	}
+	conn, _, err := lp.connect(leader)
+	if err != nil {
+		return err
+	}
+	input, output, err := methodMessageTypes(info.FullMethod)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	req := input.New().Interface()
+	if err := ss.RecvMsg(req); err != nil {
+		return err
+	}
+	cs, err := conn.NewStream(ss.Context(), &grpc.StreamDesc{ServerStreams: true}, info.FullMethod)
+	if err != nil {
+		return err
+	}
+	if err := cs.SendMsg(req); err != nil {
+		return err
+	}
+	if err := cs.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		resp := output.New().Interface()
+		if err := cs.RecvMsg(resp); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := ss.SendMsg(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// methodMessageTypes returns the types of the request and the response messages of a gRPC method, the full method is
+// in the format of "/<service>/<method>".
+func methodMessageTypes(fullMethod string) (protoreflect.MessageType, protoreflect.MessageType, error) {
+	serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid method %q", fullMethod)
+	}
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find the service of the method %q, %w", fullMethod, err)
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%q is not a service", serviceName)
+	}
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(methodName))
+	if methodDesc == nil {
+		return nil, nil, fmt.Errorf("method %q not found", fullMethod)
+	}
+	input, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Input().FullName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find the request type of the method %q, %w", fullMethod, err)
+	}
+	output, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Output().FullName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find the response type of the method %q, %w", fullMethod, err)
+	}
+	return input, output, nil
+}
+
+// httpHandler forwards the HTTP API requests to the leader, the others, e.g., the probes and the metrics, are served
+// by next.
+func (lp *leaderProxy) httpHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leader, ok := lp.leader()
+		if !ok || !strings.HasPrefix(r.URL.Path, "/api/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		_, transport, err := lp.connect(leader)
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusServiceUnavailable)
+			return
+		}
+		proxy := &httputil.ReverseProxy{
+			Rewrite: func(pr *httputil.ProxyRequest) {
+				pr.SetURL(&url.URL{Scheme: "https", Host: leader.address})
+			},
+			Transport: transport,
+			// flush immediately for the streaming APIs
+			FlushInterval: -1,
+		}
+		proxy.ServeHTTP(w, r)
+	})
+}
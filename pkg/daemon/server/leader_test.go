@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	sharedtls "github.com/numaproj/numaflow/pkg/shared/tls"
+)
+
+// fakeDaemonService responds with the buffers and the vertex metrics of its pipeline.
+type fakeDaemonService struct {
+	daemon.UnimplementedDaemonServiceServer
+	pipeline string
+}
+
+func (f *fakeDaemonService) ListBuffers(_ context.Context, _ *daemon.ListBuffersRequest) (*daemon.ListBuffersResponse, error) {
+	return &daemon.ListBuffersResponse{Buffers: []*daemon.BufferInfo{{Pipeline: f.pipeline}}}, nil
+}
+
+func (f *fakeDaemonService) WatchVertexMetrics(req *daemon.WatchVertexMetricsRequest, stream daemon.DaemonService_WatchVertexMetricsServer) error {
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&daemon.WatchVertexMetricsResponse{Pipeline: f.pipeline, Vertex: req.GetVertex()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startDaemonService starts a gRPC server of the fake daemon service with the interceptors, and returns it as a peer.
+func startDaemonService(t *testing.T, svc *fakeDaemonService, opts ...grpc.ServerOption) peer {
+	t.Helper()
+	cer, err := sharedtls.GenerateX509KeyPair()
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{*cer}})))
+	grpcServer := grpc.NewServer(opts...)
+	daemon.RegisterDaemonServiceServer(grpcServer, svc)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+	return peer{address: lis.Addr().String(), fingerprint: certFingerprint(cer.Certificate[0])}
+}
+
+func newDaemonServiceClient(t *testing.T, address string) daemon.DaemonServiceClient {
+	t.Helper()
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return daemon.NewDaemonServiceClient(conn)
+}
+
+func TestLeaderElection_LeaderPeer(t *testing.T) {
+	le := &leaderElection{identity: "pl-daemon-0_10.0.0.1:4327_aa"}
+	leader, proxied := le.leaderPeer()
+	assert.True(t, proxied)
+	assert.Empty(t, leader.address)
+
+	le.setLeader("pl-daemon-0_10.0.0.1:4327_aa")
+	_, proxied = le.leaderPeer()
+	assert.False(t, proxied)
+
+	le.setLeader("pl-daemon-1_10.0.0.2:4327_bb")
+	leader, proxied = le.leaderPeer()
+	assert.True(t, proxied)
+	assert.Equal(t, peer{address: "10.0.0.2:4327", fingerprint: "bb"}, leader)
+
+	le.setLeader(leaderIdentity("pl-daemon-2", "fd00::2", "cc"))
+	leader, proxied = le.leaderPeer()
+	assert.True(t, proxied)
+	assert.Equal(t, peer{address: "[fd00::2]:4327", fingerprint: "cc"}, leader)
+
+	// the identity of a replica without the fingerprint
+	le.setLeader("pl-daemon-3_10.0.0.3:4327")
+	leader, proxied = le.leaderPeer()
+	assert.True(t, proxied)
+	assert.Equal(t, peer{address: "10.0.0.3:4327"}, leader)
+}
+
+func TestLeaderIdentity(t *testing.T) {
+	assert.Equal(t, "pl-daemon-0_10.0.0.1:4327_aa", leaderIdentity("pl-daemon-0", "10.0.0.1", "aa"))
+	assert.Equal(t, "pl-daemon-0_[fd00::1]:4327_aa", leaderIdentity("pl-daemon-0", "fd00::1", "aa"))
+}
+
+func TestMethodMessageTypes(t *testing.T) {
+	input, output, err := methodMessageTypes("/daemon.DaemonService/WatchVertexMetrics")
+	require.NoError(t, err)
+	assert.IsType(t, &daemon.WatchVertexMetricsRequest{}, input.New().Interface())
+	assert.IsType(t, &daemon.WatchVertexMetricsResponse{}, output.New().Interface())
+
+	_, _, err = methodMessageTypes("/daemon.DaemonService/Unknown")
+	assert.Error(t, err)
+	_, _, err = methodMessageTypes("invalid")
+	assert.Error(t, err)
+}
+
+func TestLeaderProxy_GRPC(t *testing.T) {
+	leaderPeer := startDaemonService(t, &fakeDaemonService{pipeline: "leader"})
+	var leader peer
+	proxied := true
+	proxy := newLeaderProxy(func() (peer, bool) { return leader, proxied })
+	t.Cleanup(proxy.close)
+	follower := startDaemonService(t, &fakeDaemonService{pipeline: "follower"},
+		grpc.UnaryInterceptor(proxy.unaryInterceptor), grpc.StreamInterceptor(proxy.streamInterceptor))
+	client := newDaemonServiceClient(t, follower.address)
+	ctx := context.Background()
+
+	t.Run("leader unknown", func(t *testing.T) {
+		_, err := client.ListBuffers(ctx, &daemon.ListBuffersRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("leader not verified", func(t *testing.T) {
+		leader = peer{address: leaderPeer.address}
+		_, err := client.ListBuffers(ctx, &daemon.ListBuffersRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		// another replica at the address of the leader
+		leader = peer{address: leaderPeer.address, fingerprint: follower.fingerprint}
+		_, err = client.ListBuffers(ctx, &daemon.ListBuffersRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("forwarded to the leader", func(t *testing.T) {
+		leader = leaderPeer
+		resp, err := client.ListBuffers(ctx, &daemon.ListBuffersRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "leader", resp.GetBuffers()[0].GetPipeline())
+
+		stream, err := client.WatchVertexMetrics(ctx, &daemon.WatchVertexMetricsRequest{Vertex: "in"})
+		require.NoError(t, err)
+		var received []*daemon.WatchVertexMetricsResponse
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			received = append(received, resp)
+		}
+		require.Len(t, received, 2)
+		assert.Equal(t, "leader", received[0].GetPipeline())
+		assert.Equal(t, "in", received[1].GetVertex())
+	})
+
+	t.Run("served by the leader", func(t *testing.T) {
+		proxied = false
+		resp, err := client.ListBuffers(ctx, &daemon.ListBuffersRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "follower", resp.GetBuffers()[0].GetPipeline())
+	})
+}
+
+func TestLeaderProxy_HTTP(t *testing.T) {
+	leaderServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("leader " + r.URL.Path))
+	}))
+	defer leaderServer.Close()
+	var leader peer
+	proxy := newLeaderProxy(func() (peer, bool) { return leader, true })
+	defer proxy.close()
+	handler := proxy.httpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("follower " + r.URL.Path))
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/pipelines/pl/buffers", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	// the certificate of the server does not match the fingerprint
+	leader = peer{address: leaderServer.Listener.Addr().String(), fingerprint: "aa"}
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/pipelines/pl/buffers", nil))
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+
+	leader = peer{address: leaderServer.Listener.Addr().String(), fingerprint: certFingerprint(leaderServer.Certificate().Raw)}
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/pipelines/pl/buffers", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "leader /api/v1/pipelines/pl/buffers", w.Body.String())
+
+	// the probes are served locally
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, "follower /readyz", w.Body.String())
+}
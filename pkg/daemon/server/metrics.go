@@ -49,4 +49,32 @@ var (
 		Name:      "data_processing_health",
 		Help:      "Pipeline data processing health status. 1: Healthy, 0: Unknown, -1: Warning, -2: Critical",
 	}, []string{metrics.LabelPipeline})
+
+	// Vertices reading messages with event time too far ahead of the current time
+	futureEventTime = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "future_event_time",
+		Help:      "How far in milliseconds the max event time read by a vertex is ahead of current time, only exposed when it exceeds the future event time bound",
+	}, []string{metrics.LabelPipeline, metrics.LabelVertex})
+
+	// Max length of a buffer configured in the ISB Service
+	bufferMaxLength = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "buffer_max_length",
+		Help:      "Max length of a buffer configured in the ISB Service, -1 if it can not be determined",
+	}, []string{metrics.LabelPipeline, metrics.LabelPartitionName})
+
+	// Percentage of the max length used by a buffer
+	bufferUsagePercentage = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "buffer_usage_percentage",
+		Help:      "Percentage of the max length configured in the ISB Service used by a buffer, -1 if the max length can not be determined",
+	}, []string{metrics.LabelPipeline, metrics.LabelPartitionName})
+
+	// Age of the oldest message in a buffer
+	bufferOldestMessageAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "buffer_oldest_message_age",
+		Help:      "Age of the oldest message in a buffer in milliseconds, -1 if the buffer is empty or it can not be determined",
+	}, []string{metrics.LabelPipeline, metrics.LabelPartitionName})
 )
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// The server side limits of peeking the messages of a buffer, the requested values can not exceed the max ones.
+const (
+	defaultPeekCount           = 10
+	maxPeekCount               = 100
+	defaultPeekMaxPayloadBytes = 4096
+	maxPeekMaxPayloadBytes     = 64 * 1024
+	peekTimeout                = 10 * time.Second
+)
+
+// redactedValue replaces the values redacted from the peeked messages.
+const redactedValue = "REDACTED"
+
+// messageRedactor redacts the sensitive data of a peeked message in place.
+type messageRedactor func(*daemon.PeekedMessage)
+
+// redactHeaders returns a redactor replacing the values of the headers with the names, case-insensitively.
+func redactHeaders(names ...string) messageRedactor {
+	return func(msg *daemon.PeekedMessage) {
+		for k := range msg.Message.Headers {
+			if slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(name, k) }) {
+				msg.Message.Headers[k] = redactedValue
+			}
+		}
+	}
+}
+
+// redactPayload is a redactor dropping the payload.
+func redactPayload(msg *daemon.PeekedMessage) {
+	msg.Message.Payload = nil
+	msg.Truncated = false
+	msg.Redacted = true
+}
+
+// messageRedactorsFromEnv returns the redactors configured by the environment variables of the daemon.
+func messageRedactorsFromEnv() []messageRedactor {
+	var redactors []messageRedactor
+	var headers []string
+	for _, h := range strings.Split(os.Getenv(v1alpha1.EnvPeekRedactHeaders), ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			headers = append(headers, h)
+		}
+	}
+	if len(headers) > 0 {
+		redactors = append(redactors, redactHeaders(headers...))
+	}
+	if sharedutil.LookupEnvBoolOr(v1alpha1.EnvPeekRedactPayload, false) {
+		redactors = append(redactors, redactPayload)
+	}
+	return redactors
+}
+
+// PeekMessages returns the messages in a buffer from an offset, for inspecting the in-flight messages of a stuck
+// pipeline. The messages are peeked without the consumer of the pipeline, the offsets and the acks of the pipeline are
+// not affected. The payloads are truncated to the max payload bytes, and the messages are redacted by the redactors of
+// the daemon before they are returned.
+func (ds *daemonServer) PeekMessages(ctx context.Context, req *daemon.PeekMessagesRequest) (*daemon.PeekMessagesResponse, error) {
+	buffer := req.GetBuffer()
+	if err := ds.findBuffer(req.GetPipeline(), buffer); err != nil {
+		return nil, err
+	}
+	count, err := boundedValue(req.GetCount(), "count", defaultPeekCount, maxPeekCount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	maxPayloadBytes, err := boundedValue(req.GetMaxPayloadBytes(), "maxPayloadBytes", defaultPeekMaxPayloadBytes, maxPeekMaxPayloadBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log := logging.FromContext(ctx).With(zap.String("buffer", buffer))
+	ctx, cancel := context.WithTimeout(ctx, peekTimeout)
+	defer cancel()
+	msgs, err := ds.isbSvcClient.PeekMessages(ctx, buffer, req.GetOffset(), count)
+	if err != nil {
+		if errors.Is(err, isbsvc.ErrBufferNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Errorw("Failed to peek the messages of the buffer", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to peek the messages of the buffer, %v", err)
+	}
+	resp := &daemon.PeekMessagesResponse{}
+	for _, msg := range msgs {
+		pm := &daemon.PeekedMessage{Message: newBufferMessage(msg), PayloadSize: int64(len(msg.Payload))}
+		if len(pm.Message.Payload) > maxPayloadBytes {
+			pm.Message.Payload = pm.Message.Payload[:maxPayloadBytes]
+			pm.Truncated = true
+		}
+		for _, redact := range ds.redactors {
+			redact(pm)
+		}
+		resp.Messages = append(resp.Messages, pm)
+	}
+	return resp, nil
+}
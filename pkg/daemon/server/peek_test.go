@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+// peekTestISBSvc serves at most 3 messages from the offset, the payloads of which are 10 bytes long.
+type peekTestISBSvc struct {
+	isbsvc.ISBService
+	offset  string
+	count   int
+	missing bool
+}
+
+func (s *peekTestISBSvc) PeekMessages(_ context.Context, partition, offset string, count int) ([]*isbsvc.HistoryMessage, error) {
+	if s.missing {
+		return nil, fmt.Errorf("stream %q, %w", partition, isbsvc.ErrBufferNotFound)
+	}
+	s.offset, s.count = offset, count
+	var msgs []*isbsvc.HistoryMessage
+	for i := 1; i <= min(count, 3); i++ {
+		msg := &isbsvc.HistoryMessage{Sequence: strconv.Itoa(i)}
+		msg.ID = isb.MessageID{VertexName: "in", Offset: strconv.Itoa(i)}
+		msg.Headers = map[string]string{"Authorization": "secret", "trace": "t"}
+		msg.Payload = []byte(fmt.Sprintf("payload-%02d", i))
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func newPeekTestServer(isbSvc isbsvc.ISBService, redactors ...messageRedactor) *daemonServer {
+	return &daemonServer{
+		pipeline: &v1alpha1.Pipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"},
+			Spec: v1alpha1.PipelineSpec{
+				Vertices: []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "out"}},
+				Edges:    []v1alpha1.Edge{{From: "in", To: "out"}},
+			},
+		},
+		isbSvcClient: isbSvc,
+		redactors:    redactors,
+	}
+}
+
+func TestPeekMessages(t *testing.T) {
+	isbSvc := &peekTestISBSvc{}
+	ds := newPeekTestServer(isbSvc)
+	ctx := context.Background()
+	const buffer = "test-ns-test-pl-out-0"
+
+	resp, err := ds.PeekMessages(ctx, &daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: buffer})
+	require.NoError(t, err)
+	assert.Equal(t, "", isbSvc.offset)
+	assert.Equal(t, defaultPeekCount, isbSvc.count)
+	msgs := resp.GetMessages()
+	require.Len(t, msgs, 3)
+	assert.Equal(t, "in-1-0", msgs[0].GetMessage().GetId())
+	assert.Equal(t, []byte("payload-01"), msgs[0].GetMessage().GetPayload())
+	assert.Equal(t, int64(10), msgs[0].GetPayloadSize())
+	assert.False(t, msgs[0].GetTruncated())
+
+	resp, err = ds.PeekMessages(ctx, &daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: buffer, Offset: "2", Count: 1, MaxPayloadBytes: 7})
+	require.NoError(t, err)
+	assert.Equal(t, "2", isbSvc.offset)
+	msgs = resp.GetMessages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, []byte("payload"), msgs[0].GetMessage().GetPayload())
+	assert.Equal(t, int64(10), msgs[0].GetPayloadSize())
+	assert.True(t, msgs[0].GetTruncated())
+
+	tests := []struct {
+		req  *daemon.PeekMessagesRequest
+		code codes.Code
+	}{
+		{&daemon.PeekMessagesRequest{Pipeline: "other-pl", Buffer: buffer}, codes.NotFound},
+		{&daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: "other-buffer"}, codes.NotFound},
+		{&daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: buffer, Count: -1}, codes.InvalidArgument},
+		{&daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: buffer, Count: 101}, codes.InvalidArgument},
+		{&daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: buffer, MaxPayloadBytes: maxPeekMaxPayloadBytes + 1}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		_, err := ds.PeekMessages(ctx, tt.req)
+		assert.Equal(t, tt.code, status.Code(err), tt.req.String())
+	}
+	isbSvc.missing = true
+	_, err = ds.PeekMessages(ctx, &daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: buffer})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPeekMessages_Redaction(t *testing.T) {
+	ds := newPeekTestServer(&peekTestISBSvc{}, redactHeaders("authorization"), redactPayload)
+
+	resp, err := ds.PeekMessages(context.Background(), &daemon.PeekMessagesRequest{Pipeline: "test-pl", Buffer: "test-ns-test-pl-out-0", MaxPayloadBytes: 7})
+	require.NoError(t, err)
+	msgs := resp.GetMessages()
+	require.Len(t, msgs, 3)
+	assert.Equal(t, map[string]string{"Authorization": redactedValue, "trace": "t"}, msgs[0].GetMessage().GetHeaders())
+	assert.Nil(t, msgs[0].GetMessage().GetPayload())
+	assert.True(t, msgs[0].GetRedacted())
+	assert.False(t, msgs[0].GetTruncated())
+	assert.Equal(t, int64(10), msgs[0].GetPayloadSize())
+}
+
+func TestMessageRedactorsFromEnv(t *testing.T) {
+	assert.Empty(t, messageRedactorsFromEnv())
+	t.Setenv(v1alpha1.EnvPeekRedactHeaders, " authorization, ,x-token")
+	t.Setenv(v1alpha1.EnvPeekRedactPayload, "true")
+	redactors := messageRedactorsFromEnv()
+	require.Len(t, redactors, 2)
+	msg := &daemon.PeekedMessage{Message: &daemon.BufferMessage{
+		Headers: map[string]string{"X-Token": "t", "other": "o"},
+		Payload: []byte("payload"),
+	}}
+	for _, redact := range redactors {
+		redact(msg)
+	}
+	assert.Equal(t, map[string]string{"X-Token": redactedValue, "other": "o"}, msg.GetMessage().GetHeaders())
+	assert.Nil(t, msg.GetMessage().GetPayload())
+}
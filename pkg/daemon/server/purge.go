@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// parsePurgeOptions parses the options of a buffer purge request, the purge must be confirmed with the buffer name.
+// A purge up to an offset can not be bounded otherwise.
+func parsePurgeOptions(req *daemon.PurgeBufferRequest) ([]isbsvc.PurgeOption, error) {
+	buffer := req.GetBuffer()
+	if req.GetConfirm() != buffer {
+		return nil, fmt.Errorf("the purge of buffer %q must be confirmed with confirm=%s", buffer, buffer)
+	}
+	if req.GetUpTo() != "" && (req.GetBefore() != "" || req.GetKeepRecent() != nil) {
+		return nil, fmt.Errorf("upTo can not be combined with before or keepRecent")
+	}
+	var opts []isbsvc.PurgeOption
+	if v := req.GetBefore(); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid before %q, it should be in RFC3339 format", v)
+		}
+		opts = append(opts, isbsvc.WithPurgeBefore(t))
+	}
+	if v := req.GetKeepRecent(); v != nil {
+		if v.GetValue() < 0 {
+			return nil, fmt.Errorf("invalid keepRecent %d, it should be a non-negative integer", v.GetValue())
+		}
+		opts = append(opts, isbsvc.WithKeepRecent(v.GetValue()))
+	}
+	return opts, nil
+}
+
+// purgeWatermarkBuckets returns the buckets of the edges writing to the buffer, the offset timelines of which
+// refer to the purged messages. A bucket is shared by the partitions of the buffer.
+func (ds *daemonServer) purgeWatermarkBuckets(buffer string) []string {
+	var buckets []string
+	if v := ds.pipeline.FindVertexWithBuffer(buffer); v != nil {
+		for _, e := range ds.pipeline.GetFromEdges(v.Name) {
+			buckets = append(buckets, v1alpha1.GenerateEdgeBucketName(ds.pipeline.Namespace, ds.pipeline.Name, e.From, e.To))
+		}
+	}
+	return buckets
+}
+
+// PurgeBuffer drops the messages of a stuck or poisoned buffer, and resets the offset timelines of its watermark
+// buckets so the watermark progression is not wedged. It's an explicit operator action, only a request confirming the
+// buffer name is accepted, the purged messages are not recoverable. A purge up to an offset drops a poison backlog of
+// the buffer partition, which must be paused with the pipeline first.
+func (ds *daemonServer) PurgeBuffer(ctx context.Context, req *daemon.PurgeBufferRequest) (*daemon.PurgeBufferResponse, error) {
+	buffer := req.GetBuffer()
+	if err := ds.findBuffer(req.GetPipeline(), buffer); err != nil {
+		return nil, err
+	}
+	opts, err := parsePurgeOptions(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	log := logging.FromContext(ctx).With(zap.String("buffer", buffer), zap.String("request", req.String()))
+	buckets := ds.purgeWatermarkBuckets(buffer)
+	opts = append(opts, isbsvc.WithPurgeWatermarkBuckets(buckets...))
+	if upTo := req.GetUpTo(); upTo != "" {
+		err = ds.isbSvcClient.PurgePartition(ctx, buffer, upTo, opts...)
+	} else {
+		err = ds.isbSvcClient.PurgeBuffers(ctx, []string{buffer}, opts...)
+	}
+	if err != nil {
+		log.Errorw("Failed to purge the buffer", zap.Error(err))
+		if errors.Is(err, isbsvc.ErrBufferNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, isbsvc.ErrPartitionNotPaused) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v, pause the pipeline before purging the buffer up to an offset", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to purge the buffer, %v", err)
+	}
+	log.Infow("Buffer purged by the operator", zap.Strings("resetBuckets", buckets))
+	info, err := ds.isbSvcClient.GetBufferInfo(ctx, buffer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "buffer %q is purged, but failed to get its information, %v", buffer, err)
+	}
+	return &daemon.PurgeBufferResponse{
+		Buffer:        buffer,
+		PendingCount:  info.PendingCount,
+		TotalMessages: info.TotalMessages,
+		ResetBuckets:  buckets,
+	}, nil
+}
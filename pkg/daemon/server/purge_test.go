@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+// purgeTestISBSvc records the purged buffers, a buffer missing in the ISB Service is not found.
+type purgeTestISBSvc struct {
+	isbsvc.ISBService
+	missing string
+	purged  []string
+	opts    int
+	paused  bool
+	upTo    string
+}
+
+func (s *purgeTestISBSvc) PurgeBuffers(ctx context.Context, buffers []string, opts ...isbsvc.PurgeOption) error {
+	for _, buffer := range buffers {
+		if buffer == s.missing {
+			return fmt.Errorf("failed to purge buffer %q, %w", buffer, isbsvc.ErrBufferNotFound)
+		}
+	}
+	s.purged = append(s.purged, buffers...)
+	s.opts = len(opts)
+	return nil
+}
+
+func (s *purgeTestISBSvc) PurgePartition(ctx context.Context, partition, upToOffset string, opts ...isbsvc.PurgeOption) error {
+	if !s.paused {
+		return fmt.Errorf("partition %q, %w", partition, isbsvc.ErrPartitionNotPaused)
+	}
+	s.upTo = upToOffset
+	return s.PurgeBuffers(ctx, []string{partition}, opts...)
+}
+
+func (s *purgeTestISBSvc) GetBufferInfo(ctx context.Context, buffer string) (*isbsvc.BufferInfo, error) {
+	return &isbsvc.BufferInfo{Name: buffer}, nil
+}
+
+func TestPurgeBuffer(t *testing.T) {
+	isbSvc := &purgeTestISBSvc{}
+	ds := &daemonServer{
+		pipeline: &v1alpha1.Pipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"},
+			Spec: v1alpha1.PipelineSpec{
+				Vertices: []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "out"}},
+				Edges:    []v1alpha1.Edge{{From: "in", To: "out"}},
+			},
+		},
+		isbSvcClient: isbSvc,
+	}
+	ctx := context.Background()
+	buffer := "test-ns-test-pl-out-0"
+	purge := func(req *daemon.PurgeBufferRequest) codes.Code {
+		_, err := ds.PurgeBuffer(ctx, req)
+		return status.Code(err)
+	}
+
+	assert.Equal(t, codes.InvalidArgument, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer}))
+	assert.Equal(t, codes.InvalidArgument, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: "other"}))
+	assert.Equal(t, codes.InvalidArgument, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: buffer, Before: "yesterday"}))
+	assert.Equal(t, codes.InvalidArgument, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: buffer, KeepRecent: wrapperspb.Int64(-1)}))
+	assert.Equal(t, codes.NotFound, purge(&daemon.PurgeBufferRequest{Pipeline: "other-pl", Buffer: buffer, Confirm: buffer}))
+	assert.Equal(t, codes.NotFound, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: "test-ns-test-pl-unknown-0", Confirm: "test-ns-test-pl-unknown-0"}))
+	assert.Empty(t, isbSvc.purged)
+
+	resp, err := ds.PurgeBuffer(ctx, &daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: buffer, Before: "2024-01-01T00:00:00Z", KeepRecent: wrapperspb.Int64(10)})
+	require.NoError(t, err)
+	assert.Equal(t, buffer, resp.GetBuffer())
+	assert.Equal(t, []string{"test-ns-test-pl-in-out"}, resp.GetResetBuckets())
+	assert.Equal(t, []string{buffer}, isbSvc.purged)
+	// before, keepRecent and the watermark buckets
+	assert.Equal(t, 3, isbSvc.opts)
+
+	// a purge up to an offset requires the partition to be paused
+	isbSvc.purged = nil
+	assert.Equal(t, codes.InvalidArgument, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: buffer, UpTo: "5", KeepRecent: wrapperspb.Int64(1)}))
+	assert.Equal(t, codes.FailedPrecondition, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: buffer, UpTo: "5"}))
+	assert.Empty(t, isbSvc.purged)
+	isbSvc.paused = true
+	assert.Equal(t, codes.OK, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: buffer, UpTo: "5"}))
+	assert.Equal(t, "5", isbSvc.upTo)
+	assert.Equal(t, []string{buffer}, isbSvc.purged)
+	assert.Equal(t, 1, isbSvc.opts)
+
+	// the buffer is missing in the ISB Service
+	isbSvc.missing = buffer
+	assert.Equal(t, codes.NotFound, purge(&daemon.PurgeBufferRequest{Pipeline: "test-pl", Buffer: buffer, Confirm: buffer}))
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	numaflowv1alpha1 "github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// BackfillWatcher polls the backfill mode of the live pipeline object, and reflects it in the metrics and the events
+// of the pipeline. The vertices pick up the backfill mode through their runtime limits.
+type BackfillWatcher struct {
+	pipeline  *v1alpha1.Pipeline
+	pipelines numaflowv1alpha1.PipelineInterface
+	// recorder records the backfill mode switching on and off as events, it can be nil.
+	recorder *events.Recorder
+	// onChange is called when the backfill mode is switched on or off, it can be nil.
+	onChange func(backfilling bool)
+	interval time.Duration
+}
+
+var _ BackgroundJob = (*BackfillWatcher)(nil)
+
+// NewBackfillWatcher returns a BackfillWatcher. The recorder can be nil if the events are not recorded, and onChange
+// is called when the backfill mode is switched on or off, it can be nil.
+func NewBackfillWatcher(pl *v1alpha1.Pipeline, pipelines numaflowv1alpha1.PipelineInterface, recorder *events.Recorder, onChange func(backfilling bool)) *BackfillWatcher {
+	return &BackfillWatcher{pipeline: pl, pipelines: pipelines, recorder: recorder, onChange: onChange, interval: 10 * time.Second}
+}
+
+// Start polls the backfill mode right away and then every 10 seconds until the context is done.
+func (w *BackfillWatcher) Start(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	backfilling := false
+	pipelineBackfilling.WithLabelValues(w.pipeline.Name).Set(0)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		pl, err := withLiveAnnotations(ctx, w.pipelines, w.pipeline)
+		if err != nil {
+			log.Warnw("Failed to get the pipeline to check the backfill mode", zap.Error(err))
+		} else if pl.IsBackfilling() != backfilling {
+			backfilling = pl.IsBackfilling()
+			if w.onChange != nil {
+				w.onChange(backfilling)
+			}
+			if backfilling {
+				pipelineBackfilling.WithLabelValues(w.pipeline.Name).Set(1)
+				log.Infow("Backfill mode is switched on")
+				if w.recorder != nil {
+					w.recorder.Forget(events.ReasonBackfillStopped, w.pipeline.Name)
+					w.recorder.Normalf(events.ReasonBackfillStarted, w.pipeline.Name, "Backfill mode is switched on, the latency oriented behaviors are relaxed")
+				}
+			} else {
+				pipelineBackfilling.WithLabelValues(w.pipeline.Name).Set(0)
+				log.Infow("Backfill mode is switched off")
+				if w.recorder != nil {
+					w.recorder.Forget(events.ReasonBackfillStarted, w.pipeline.Name)
+					w.recorder.Normalf(events.ReasonBackfillStopped, w.pipeline.Name, "Backfill mode is switched off")
+				}
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/client/clientset/versioned/fake"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
+)
+
+func TestBackfillWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pl := &v1alpha1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"}}
+	pipelines := fake.NewSimpleClientset(pl).NumaflowV1alpha1().Pipelines(pl.Namespace)
+	fakeRecorder := record.NewFakeRecorder(10)
+	changes := make(chan bool, 10)
+	w := NewBackfillWatcher(pl, pipelines, events.NewRecorder(fakeRecorder, nil), func(backfilling bool) { changes <- backfilling })
+	w.interval = 10 * time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.Start(ctx)
+	}()
+
+	setBackfill := func(value string) {
+		live, err := pipelines.Get(ctx, pl.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		live.Annotations = map[string]string{v1alpha1.KeyBackfill: value}
+		_, err = pipelines.Update(ctx, live, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+	expectChange := func(want bool, reason string) {
+		select {
+		case got := <-changes:
+			assert.Equal(t, want, got)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the backfill mode to be %v", want)
+		}
+		assert.Contains(t, <-fakeRecorder.Events, reason)
+	}
+
+	// the backfill mode is off initially, which is not a change.
+	setBackfill("true")
+	expectChange(true, events.ReasonBackfillStarted)
+	setBackfill("false")
+	expectChange(false, events.ReasonBackfillStopped)
+
+	cancel()
+	<-done
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	numaflowv1alpha1 "github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+)
+
+// BackgroundJob is a job run in the background by the leader of the daemon server replicas. Start blocks until the
+// context is done, or returns early if the job has nothing to do.
+type BackgroundJob interface {
+	Start(ctx context.Context)
+}
+
+// withLiveAnnotations returns a copy of the pipeline with the annotations of the live pipeline object, the pipeline
+// passed to the daemon server only has the spec.
+func withLiveAnnotations(ctx context.Context, pipelines numaflowv1alpha1.PipelineInterface, pl *v1alpha1.Pipeline) (*v1alpha1.Pipeline, error) {
+	live, err := pipelines.Get(ctx, pl.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pl = pl.DeepCopy()
+	pl.Annotations = live.GetAnnotations()
+	return pl, nil
+}
+
+// updatePipelineStatus applies the change to the latest status of the pipeline, nothing is updated if the status
+// does not change.
+func updatePipelineStatus(ctx context.Context, pipelines numaflowv1alpha1.PipelineInterface, name string, apply func(*v1alpha1.PipelineStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pl, err := pipelines.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		status := pl.Status.DeepCopy()
+		apply(status)
+		if equality.Semantic.DeepEqual(status, &pl.Status) {
+			return nil
+		}
+		pl.Status = *status
+		_, err = pipelines.UpdateStatus(ctx, pl, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+// maxItemsInConsistencyMessage is the number of the items listed in the message of the condition.
+const maxItemsInConsistencyMessage = 5
+
+// MissingItem is a buffer or bucket required by the pipeline which failed the validation.
+type MissingItem struct {
+	isbsvc.DeleteItem
+	Reason string
+}
+
+// ConsistencyReport is the drift between the buffers and buckets derived from the pipeline spec, and the ones
+// existing in the ISB Service.
+type ConsistencyReport struct {
+	// Missing are the buffers and buckets of the spec which do not exist, or are owned by another pipeline.
+	Missing []MissingItem
+	// Orphaned are the buffers and buckets of the pipeline which are not in the spec.
+	Orphaned []isbsvc.ItemInfo
+	// Deleted are the orphans cleaned up by the check, they are not in Orphaned.
+	Deleted []isbsvc.DeleteItem
+	// Repaired are the buffers and buckets of the spec which drifted and were repaired by the check, they are not
+	// in Missing.
+	Repaired []isbsvc.DeleteItem
+}
+
+// ApplyTo sets the BuffersConsistent condition of the pipeline status. The condition is false if any buffer or
+// bucket is missing, the orphans and the repaired items do not break the pipeline so they are only reported in the
+// reason.
+func (r *ConsistencyReport) ApplyTo(status *v1alpha1.PipelineStatus) {
+	switch {
+	case len(r.Missing) > 0:
+		status.MarkBuffersInconsistent("MissingBuffers", r.Message())
+	case len(r.Orphaned) > 0:
+		status.MarkBuffersConsistentWithReason("OrphanedBuffers", r.Message())
+	case len(r.Repaired) > 0:
+		status.MarkBuffersConsistentWithReason("RepairedBuffers", r.Message())
+	default:
+		status.MarkBuffersConsistent()
+	}
+}
+
+// Message returns a summary of the missing and the orphaned items, empty if there is no drift.
+func (r *ConsistencyReport) Message() string {
+	var parts []string
+	if len(r.Missing) > 0 {
+		items := make([]string, 0, len(r.Missing))
+		for _, m := range r.Missing {
+			items = append(items, m.String())
+		}
+		parts = append(parts, fmt.Sprintf("%d missing: %s", len(r.Missing), summarizeItems(items)))
+	}
+	if len(r.Orphaned) > 0 {
+		items := make([]string, 0, len(r.Orphaned))
+		for _, o := range r.Orphaned {
+			items = append(items, o.String())
+		}
+		parts = append(parts, fmt.Sprintf("%d orphaned: %s", len(r.Orphaned), summarizeItems(items)))
+	}
+	if len(r.Repaired) > 0 {
+		items := make([]string, 0, len(r.Repaired))
+		for _, item := range r.Repaired {
+			items = append(items, item.String())
+		}
+		parts = append(parts, fmt.Sprintf("%d repaired: %s", len(r.Repaired), summarizeItems(items)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func summarizeItems(items []string) string {
+	if len(items) <= maxItemsInConsistencyMessage {
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(items[:maxItemsInConsistencyMessage], ", "), len(items)-maxItemsInConsistencyMessage)
+}
+
+// BufferConsistencyChecker compares the buffers and buckets derived from the edges of a pipeline with the ones
+// existing in the ISB Service, to detect the drift caused by manual edits or failed reconciliations.
+type BufferConsistencyChecker struct {
+	pipeline *v1alpha1.Pipeline
+	isbSvc   isbsvc.ISBService
+	clock    clock.Clock
+	// pipelineUID is the UID of the pipeline, the items owned by other pipelines are not orphans of this pipeline.
+	pipelineUID string
+	// cleanupGracePeriod is how long an orphan exists before it's deleted, the orphans are not deleted if it's 0.
+	cleanupGracePeriod time.Duration
+	// repair enables repairing the drifted buffers and buckets of the spec with the repairOpts.
+	repair     bool
+	repairOpts []isbsvc.CreateOption
+}
+
+type BufferConsistencyOption func(*BufferConsistencyChecker)
+
+// WithPipelineUID sets the UID of the pipeline
+func WithPipelineUID(uid string) BufferConsistencyOption {
+	return func(c *BufferConsistencyChecker) {
+		c.pipelineUID = uid
+	}
+}
+
+// WithOrphanCleanup enables deleting the orphans older than the grace period. Only the orphans recorded as owned
+// by the pipeline are deleted, so it requires the pipeline UID.
+func WithOrphanCleanup(gracePeriod time.Duration) BufferConsistencyOption {
+	return func(c *BufferConsistencyChecker) {
+		c.cleanupGracePeriod = gracePeriod
+	}
+}
+
+// WithRepair enables repairing the buffers and buckets of the spec which drifted, if the ISB Service supports it, see
+// isbsvc.Repairer. They are validated and repaired with the options, e.g. the config of the ISB Service and the buffer
+// configs of the pipeline, so that the drift of their settings is repaired as well.
+func WithRepair(opts ...isbsvc.CreateOption) BufferConsistencyOption {
+	return func(c *BufferConsistencyChecker) {
+		c.repair = true
+		c.repairOpts = opts
+	}
+}
+
+// WithConsistencyClock sets the clock used to calculate the age of the orphans
+func WithConsistencyClock(clk clock.Clock) BufferConsistencyOption {
+	return func(c *BufferConsistencyChecker) {
+		c.clock = clk
+	}
+}
+
+func NewBufferConsistencyChecker(pl *v1alpha1.Pipeline, isbSvc isbsvc.ISBService, opts ...BufferConsistencyOption) *BufferConsistencyChecker {
+	c := &BufferConsistencyChecker{
+		pipeline: pl,
+		isbSvc:   isbSvc,
+		clock:    clock.RealClock(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Check validates each buffer and bucket of the spec, and lists the ones existing in the ISB Service to find the
+// orphans, which are deleted if they are old enough and the cleanup is enabled. The report is returned along with
+// the error if some orphans fail to be deleted, they are kept in the orphans of the report.
+func (c *BufferConsistencyChecker) Check(ctx context.Context) (*ConsistencyReport, error) {
+	report := &ConsistencyReport{}
+	required := make(map[isbsvc.DeleteItem]bool)
+	var items []isbsvc.DeleteItem
+	for _, buffer := range c.pipeline.GetAllBuffers() {
+		items = append(items, isbsvc.DeleteItem{Kind: isbsvc.DeleteItemBuffer, Name: buffer})
+	}
+	for _, bucket := range c.pipeline.GetAllBuckets() {
+		items = append(items, isbsvc.DeleteItem{Kind: isbsvc.DeleteItemBucket, Name: bucket})
+	}
+	for _, item := range items {
+		required[item] = true
+		repaired, err := c.validate(ctx, item)
+		if err != nil {
+			report.Missing = append(report.Missing, MissingItem{DeleteItem: item, Reason: err.Error()})
+		} else if repaired {
+			report.Repaired = append(report.Repaired, item)
+		}
+	}
+
+	// the buffers and buckets of a pipeline are prefixed with "{namespace}-{pipeline}-"
+	existing, err := c.isbSvc.ListBuffersAndBuckets(ctx, fmt.Sprintf("%s-%s-", c.pipeline.Namespace, c.pipeline.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the buffers and buckets, %w", err)
+	}
+	var buffers, buckets []string
+	for _, item := range existing {
+		if required[item.DeleteItem] {
+			continue
+		}
+		// the prefix is shared by the pipelines named "{pipeline}-xxx", their items are recognized by the owner.
+		if item.Owner != "" && c.pipelineUID != "" && item.Owner != c.pipelineUID {
+			continue
+		}
+		report.Orphaned = append(report.Orphaned, item)
+		if c.expired(item) {
+			switch item.Kind {
+			case isbsvc.DeleteItemBuffer:
+				buffers = append(buffers, item.Name)
+			case isbsvc.DeleteItemBucket:
+				buckets = append(buckets, item.Name)
+			}
+		}
+	}
+	if len(buffers) == 0 && len(buckets) == 0 {
+		return report, nil
+	}
+
+	deleteReport, err := c.isbSvc.DeleteBuffersAndBuckets(ctx, buffers, buckets, "", nil)
+	if deleteReport != nil {
+		report.Deleted = append(append(report.Deleted, deleteReport.Deleted...), deleteReport.NotFound...)
+		deleted := make(map[isbsvc.DeleteItem]bool, len(report.Deleted))
+		for _, item := range report.Deleted {
+			deleted[item] = true
+		}
+		var remaining []isbsvc.ItemInfo
+		for _, item := range report.Orphaned {
+			if !deleted[item.DeleteItem] {
+				remaining = append(remaining, item)
+			}
+		}
+		report.Orphaned = remaining
+	}
+	if err != nil {
+		return report, fmt.Errorf("failed to clean up the orphaned buffers and buckets, %w", err)
+	}
+	return report, nil
+}
+
+// validate validates the buffer or the bucket, and repairs it if it drifted and the repair is enabled. It returns
+// whether the item is repaired, with the problems remaining if any.
+func (c *BufferConsistencyChecker) validate(ctx context.Context, item isbsvc.DeleteItem) (bool, error) {
+	var buffers, buckets []string
+	if item.Kind == isbsvc.DeleteItemBucket {
+		buckets = []string{item.Name}
+	} else {
+		buffers = []string{item.Name}
+	}
+	err := c.isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil, c.repairOpts...)
+	if err == nil || !c.repair {
+		return false, err
+	}
+	repairer, ok := c.isbSvc.(isbsvc.Repairer)
+	if !ok {
+		return false, err
+	}
+	if _, err := repairer.RepairBuffersAndBuckets(ctx, buffers, buckets, "", nil, c.repairOpts...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// expired returns whether the orphan is owned by the pipeline and is older than the grace period.
+func (c *BufferConsistencyChecker) expired(item isbsvc.ItemInfo) bool {
+	if c.cleanupGracePeriod <= 0 || c.pipelineUID == "" || item.Owner != c.pipelineUID || item.Created.IsZero() {
+		return false
+	}
+	return c.clock.Now().Sub(item.Created) >= c.cleanupGracePeriod
+}
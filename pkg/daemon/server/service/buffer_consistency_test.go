@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+var consistencyTestPipeline = &v1alpha1.Pipeline{
+	ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pl"},
+	Spec: v1alpha1.PipelineSpec{
+		Vertices: []v1alpha1.AbstractVertex{
+			{Name: "in", Source: &v1alpha1.Source{}},
+			{Name: "out", Sink: &v1alpha1.Sink{}},
+		},
+		Edges: []v1alpha1.Edge{{From: "in", To: "out"}},
+	},
+}
+
+// newConsistencyTestISBSvcs returns the ISB Service clients of the pipelines with the given UIDs, connected to the
+// same in-memory JetStream server.
+func newConsistencyTestISBSvcs(t *testing.T, uids ...string) []isbsvc.ISBService {
+	s := test.RunJetStreamServer(t)
+	t.Cleanup(func() { test.ShutdownJetStreamServer(t, s) })
+	client := natsclient.NewTestClient(t, s.ClientURL())
+	t.Cleanup(client.Close)
+	var isbSvcs []isbsvc.ISBService
+	for _, uid := range uids {
+		isbSvc, err := isbsvc.NewISBJetStreamSvc(consistencyTestPipeline.Name, client, isbsvc.WithPipelineUID(uid))
+		require.NoError(t, err)
+		isbSvcs = append(isbSvcs, isbSvc)
+	}
+	return isbSvcs
+}
+
+func TestBufferConsistencyChecker_Consistent(t *testing.T) {
+	ctx := context.Background()
+	isbSvc := newConsistencyTestISBSvcs(t, "uid-1")[0]
+	pl := consistencyTestPipeline
+	require.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, pl.GetAllBuffers(), pl.GetAllBuckets(), "", nil))
+
+	report, err := NewBufferConsistencyChecker(pl, isbSvc, WithPipelineUID("uid-1")).Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Missing)
+	assert.Empty(t, report.Orphaned)
+	assert.Empty(t, report.Message())
+
+	status := &v1alpha1.PipelineStatus{}
+	report.ApplyTo(status)
+	assert.Equal(t, metav1.ConditionTrue, status.GetCondition(v1alpha1.PipelineConditionBuffersConsistent).Status)
+}
+
+func TestBufferConsistencyChecker_Missing(t *testing.T) {
+	ctx := context.Background()
+	isbSvc := newConsistencyTestISBSvcs(t, "uid-1")[0]
+	pl := consistencyTestPipeline
+	// the buffer and the sink bucket are missing
+	require.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, nil, []string{"ns-pl-in-out", "ns-pl-in_SOURCE"}, "", nil))
+
+	report, err := NewBufferConsistencyChecker(pl, isbSvc, WithPipelineUID("uid-1")).Check(ctx)
+	require.NoError(t, err)
+	require.Len(t, report.Missing, 2)
+	assert.Equal(t, isbsvc.DeleteItem{Kind: isbsvc.DeleteItemBuffer, Name: "ns-pl-out-0"}, report.Missing[0].DeleteItem)
+	assert.Equal(t, isbsvc.DeleteItem{Kind: isbsvc.DeleteItemBucket, Name: "ns-pl-out_SINK"}, report.Missing[1].DeleteItem)
+	assert.NotEmpty(t, report.Missing[0].Reason)
+	assert.Empty(t, report.Orphaned)
+	assert.Equal(t, `2 missing: buffer "ns-pl-out-0", bucket "ns-pl-out_SINK"`, report.Message())
+
+	status := &v1alpha1.PipelineStatus{}
+	report.ApplyTo(status)
+	condition := status.GetCondition(v1alpha1.PipelineConditionBuffersConsistent)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, "MissingBuffers", condition.Reason)
+	assert.Equal(t, report.Message(), condition.Message)
+}
+
+func TestBufferConsistencyChecker_OwnedByAnotherPipeline(t *testing.T) {
+	ctx := context.Background()
+	isbSvcs := newConsistencyTestISBSvcs(t, "uid-0", "uid-1")
+	pl := consistencyTestPipeline
+	// the buffers are left by a deleted pipeline with the same name
+	require.NoError(t, isbSvcs[0].CreateBuffersAndBuckets(ctx, pl.GetAllBuffers(), pl.GetAllBuckets(), "", nil))
+
+	report, err := NewBufferConsistencyChecker(pl, isbSvcs[1], WithPipelineUID("uid-1")).Check(ctx)
+	require.NoError(t, err)
+	assert.Len(t, report.Missing, 4)
+	assert.Contains(t, report.Missing[0].Reason, `owned by pipeline "uid-0"`)
+	assert.Empty(t, report.Orphaned)
+}
+
+func TestBufferConsistencyChecker_Orphaned(t *testing.T) {
+	ctx := context.Background()
+	isbSvcs := newConsistencyTestISBSvcs(t, "uid-1", "uid-2", "")
+	pl := consistencyTestPipeline
+	require.NoError(t, isbSvcs[0].CreateBuffersAndBuckets(ctx, pl.GetAllBuffers(), pl.GetAllBuckets(), "", nil))
+	// the buffer and the bucket of a vertex removed from the spec
+	require.NoError(t, isbSvcs[0].CreateBuffersAndBuckets(ctx, []string{"ns-pl-map-0"}, []string{"ns-pl-map-out"}, "", nil))
+	// the buffer of the pipeline "pl-2" shares the prefix
+	require.NoError(t, isbSvcs[1].CreateBuffersAndBuckets(ctx, []string{"ns-pl-2-in-0"}, nil, "", nil))
+	// the buffer created before the ownership is recorded
+	require.NoError(t, isbSvcs[2].CreateBuffersAndBuckets(ctx, []string{"ns-pl-legacy-0"}, nil, "", nil))
+
+	report, err := NewBufferConsistencyChecker(pl, isbSvcs[0], WithPipelineUID("uid-1")).Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Missing)
+	require.Len(t, report.Orphaned, 3)
+	assert.Equal(t, isbsvc.DeleteItem{Kind: isbsvc.DeleteItemBucket, Name: "ns-pl-map-out"}, report.Orphaned[0].DeleteItem)
+	assert.Equal(t, isbsvc.DeleteItem{Kind: isbsvc.DeleteItemBuffer, Name: "ns-pl-legacy-0"}, report.Orphaned[1].DeleteItem)
+	assert.Equal(t, isbsvc.DeleteItem{Kind: isbsvc.DeleteItemBuffer, Name: "ns-pl-map-0"}, report.Orphaned[2].DeleteItem)
+	assert.Empty(t, report.Deleted)
+	assert.Equal(t, `3 orphaned: bucket "ns-pl-map-out", buffer "ns-pl-legacy-0", buffer "ns-pl-map-0"`, report.Message())
+
+	status := &v1alpha1.PipelineStatus{}
+	report.ApplyTo(status)
+	condition := status.GetCondition(v1alpha1.PipelineConditionBuffersConsistent)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "OrphanedBuffers", condition.Reason)
+}
+
+func TestBufferConsistencyChecker_CleanupOrphans(t *testing.T) {
+	ctx := context.Background()
+	isbSvcs := newConsistencyTestISBSvcs(t, "uid-1", "")
+	pl := consistencyTestPipeline
+	require.NoError(t, isbSvcs[0].CreateBuffersAndBuckets(ctx, pl.GetAllBuffers(), pl.GetAllBuckets(), "", nil))
+	require.NoError(t, isbSvcs[0].CreateBuffersAndBuckets(ctx, []string{"ns-pl-map-0"}, []string{"ns-pl-map-out"}, "", nil))
+	require.NoError(t, isbSvcs[1].CreateBuffersAndBuckets(ctx, []string{"ns-pl-legacy-0"}, nil, "", nil))
+
+	fakeClock := clock.NewFakeClock(time.Now())
+	checker := NewBufferConsistencyChecker(pl, isbSvcs[0], WithPipelineUID("uid-1"), WithOrphanCleanup(time.Hour), WithConsistencyClock(fakeClock))
+
+	// the orphans are kept within the grace period
+	report, err := checker.Check(ctx)
+	require.NoError(t, err)
+	assert.Len(t, report.Orphaned, 3)
+	assert.Empty(t, report.Deleted)
+
+	// only the orphans owned by the pipeline are deleted
+	fakeClock.Step(time.Hour)
+	report, err = checker.Check(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []isbsvc.DeleteItem{
+		{Kind: isbsvc.DeleteItemBuffer, Name: "ns-pl-map-0"},
+		{Kind: isbsvc.DeleteItemBucket, Name: "ns-pl-map-out"},
+	}, report.Deleted)
+	require.Len(t, report.Orphaned, 1)
+	assert.Equal(t, "ns-pl-legacy-0", report.Orphaned[0].Name)
+
+	// the buffers of the spec are not touched
+	report, err = checker.Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Missing)
+	assert.Empty(t, report.Deleted)
+	assert.Len(t, report.Orphaned, 1)
+}
+
+func TestBufferConsistencyChecker_Repair(t *testing.T) {
+	ctx := context.Background()
+	isbSvc := newConsistencyTestISBSvcs(t, "uid-1")[0]
+	pl := consistencyTestPipeline
+	// the buffer and the sink bucket are missing
+	require.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, nil, []string{"ns-pl-in-out", "ns-pl-in_SOURCE"}, "", nil))
+
+	report, err := NewBufferConsistencyChecker(pl, isbSvc, WithPipelineUID("uid-1"), WithRepair()).Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Missing)
+	assert.Equal(t, []isbsvc.DeleteItem{
+		{Kind: isbsvc.DeleteItemBuffer, Name: "ns-pl-out-0"},
+		{Kind: isbsvc.DeleteItemBucket, Name: "ns-pl-out_SINK"},
+	}, report.Repaired)
+	assert.Equal(t, `2 repaired: buffer "ns-pl-out-0", bucket "ns-pl-out_SINK"`, report.Message())
+	require.NoError(t, isbSvc.ValidateBuffersAndBuckets(ctx, pl.GetAllBuffers(), pl.GetAllBuckets(), "", nil))
+
+	status := &v1alpha1.PipelineStatus{}
+	report.ApplyTo(status)
+	condition := status.GetCondition(v1alpha1.PipelineConditionBuffersConsistent)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "RepairedBuffers", condition.Reason)
+
+	// the buffers owned by another pipeline are not repaired
+	other := newConsistencyTestISBSvcs(t, "uid-0", "uid-1")
+	require.NoError(t, other[0].CreateBuffersAndBuckets(ctx, pl.GetAllBuffers(), pl.GetAllBuckets(), "", nil))
+	report, err = NewBufferConsistencyChecker(pl, other[1], WithPipelineUID("uid-1"), WithRepair()).Check(ctx)
+	require.NoError(t, err)
+	assert.Len(t, report.Missing, 4)
+	assert.Empty(t, report.Repaired)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	numaflowv1alpha1 "github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// BufferConsistencyWatcher checks the buffers and buckets of a pipeline on start and then periodically, and sets the
+// result as the BuffersConsistent condition of the pipeline.
+type BufferConsistencyWatcher struct {
+	pipelineName string
+	checker      *BufferConsistencyChecker
+	// pipelines is the client the condition is set with, it's nil if the condition is not set.
+	pipelines numaflowv1alpha1.PipelineInterface
+}
+
+var _ BackgroundJob = (*BufferConsistencyWatcher)(nil)
+
+// NewBufferConsistencyWatcher returns a BufferConsistencyWatcher, the pipelines can be nil if the condition is not set.
+func NewBufferConsistencyWatcher(pipelineName string, checker *BufferConsistencyChecker, pipelines numaflowv1alpha1.PipelineInterface) *BufferConsistencyWatcher {
+	return &BufferConsistencyWatcher{pipelineName: pipelineName, checker: checker, pipelines: pipelines}
+}
+
+// Start checks the consistency right away and then every 5 minutes until the context is done.
+func (w *BufferConsistencyWatcher) Start(ctx context.Context) {
+	w.check(ctx)
+	ticker := time.NewTicker(5 * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.check(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *BufferConsistencyWatcher) check(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	report, err := w.checker.Check(ctx)
+	if err != nil {
+		log.Errorw("Failed to check the consistency of the buffers and buckets", zap.Error(err))
+	}
+	if report == nil {
+		return
+	}
+	for _, item := range report.Deleted {
+		log.Infow("Cleaned up an orphaned item", zap.Stringer("item", item))
+	}
+	for _, item := range report.Repaired {
+		log.Infow("Repaired a drifted item", zap.Stringer("item", item))
+	}
+	if msg := report.Message(); msg != "" {
+		log.Warnw("The buffers and buckets drifted from the pipeline spec", zap.String("drift", msg))
+	}
+	if w.pipelines == nil {
+		return
+	}
+	if err := updatePipelineStatus(ctx, w.pipelines, w.pipelineName, report.ApplyTo); err != nil {
+		log.Errorw("Failed to set the buffers consistency condition of the pipeline", zap.Error(err))
+	}
+}
@@ -18,6 +18,7 @@ package service
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/ewma"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
 const (
@@ -123,6 +125,11 @@ type HealthChecker struct {
 	pipeline          *v1alpha1.Pipeline
 	timelineData      map[string]*sharedqueue.OverflowQueue[*timelineEntry]
 	statusLock        *sync.RWMutex
+	// vertexStates are the states of the buffers computed by the last health check.
+	vertexStates []*vertexState
+	// clusterHealth is the health of the ISB Service cluster for the streams of the pipeline in the last health check,
+	// it's nil if the ISB Service doesn't report the cluster health.
+	clusterHealth *isbsvc.ClusterHealth
 }
 
 // NewHealthChecker creates a new object HealthChecker struct type.
@@ -173,6 +180,44 @@ func (hc *HealthChecker) setCurrentHealth(status *dataHealthResponse) {
 	hc.currentDataStatus = status
 }
 
+// getCriticalBuffers returns the buffers in the critical state in the last health check.
+// It is thread safe to ensure concurrent access.
+func (hc *HealthChecker) getCriticalBuffers() []string {
+	hc.statusLock.RLock()
+	defer hc.statusLock.RUnlock()
+	var buffers []string
+	for _, s := range hc.vertexStates {
+		if s.State == criticalState {
+			buffers = append(buffers, s.Name)
+		}
+	}
+	return buffers
+}
+
+// setVertexStates sets the states of the buffers computed by the health check.
+// It is thread safe to ensure concurrent access.
+func (hc *HealthChecker) setVertexStates(states []*vertexState) {
+	hc.statusLock.Lock()
+	defer hc.statusLock.Unlock()
+	hc.vertexStates = states
+}
+
+// getClusterHealth returns the health of the ISB Service cluster in the last health check.
+// It is thread safe to ensure concurrent access.
+func (hc *HealthChecker) getClusterHealth() *isbsvc.ClusterHealth {
+	hc.statusLock.RLock()
+	defer hc.statusLock.RUnlock()
+	return hc.clusterHealth
+}
+
+// setClusterHealth sets the health of the ISB Service cluster.
+// It is thread safe to ensure concurrent access.
+func (hc *HealthChecker) setClusterHealth(health *isbsvc.ClusterHealth) {
+	hc.statusLock.Lock()
+	defer hc.statusLock.Unlock()
+	hc.clusterHealth = health
+}
+
 // startHealthCheck starts the health check for the pipeline.
 // The ticks are generated at the interval of healthTimeStep.
 func (hc *HealthChecker) startHealthCheck(ctx context.Context) {
@@ -195,12 +240,19 @@ func (hc *HealthChecker) startHealthCheck(ctx context.Context) {
 				// as we are not able to determine the health of the pipeline.
 				logger.Errorw("Failed to vertex data criticality", zap.Error(err))
 				hc.setCurrentHealth(defaultDataHealthResponse)
+				hc.setVertexStates(nil)
 			} else {
+				hc.setVertexStates(criticality)
 				// convert the vertex state to pipeline state
 				pipelineState := convertVertexStateToPipelineState(criticality)
 				// update the current health status of the pipeline
 				hc.setCurrentHealth(pipelineState)
 			}
+			// an unhealthy ISB Service cluster overrides the status computed from the buffers, as the buffer
+			// information is either not available or misleading when the streams have no leader.
+			if clusterState := hc.checkClusterHealth(ctx); clusterState != nil {
+				hc.setCurrentHealth(clusterState)
+			}
 		// If the context is done, return.
 		case <-ctx.Done():
 			return
@@ -208,6 +260,47 @@ func (hc *HealthChecker) startHealthCheck(ctx context.Context) {
 	}
 }
 
+// checkClusterHealth checks the health of the ISB Service cluster for the streams of the pipeline, it returns a
+// critical response if the cluster is not available or any of the streams has no leader, otherwise nil.
+func (hc *HealthChecker) checkClusterHealth(ctx context.Context) *dataHealthResponse {
+	checker, ok := hc.isbSvcClient.(isbsvc.ClusterHealthChecker)
+	if !ok {
+		return nil
+	}
+	health, err := checker.ClusterHealth(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Failed to get the ISB Service cluster health", zap.Error(err))
+		hc.setClusterHealth(nil)
+		return nil
+	}
+	health = health.Filter(hc.pipelineStreams()...)
+	hc.setClusterHealth(health)
+	if !health.Available {
+		return newDataHealthResponse(v1alpha1.PipelineStatusCritical,
+			fmt.Sprintf("ISB Service cluster is not available: %s", health.Message),
+			"D3")
+	}
+	if leaderless := health.LeaderlessStreams(); len(leaderless) > 0 {
+		return newDataHealthResponse(v1alpha1.PipelineStatusCritical,
+			fmt.Sprintf("ISB Service cluster has no leader for %s", strings.Join(leaderless, ", ")),
+			"D3")
+	}
+	return nil
+}
+
+// pipelineStreams returns the names of the streams backing the buffers and the buckets of the pipeline.
+func (hc *HealthChecker) pipelineStreams() []string {
+	var names []string
+	for _, b := range hc.pipeline.GetAllBuffers() {
+		names = append(names, isbsvc.JetStreamName(b))
+	}
+	// the KV stream names of JetStream are prefixed with "KV_"
+	for _, b := range hc.pipeline.GetAllBuckets() {
+		names = append(names, "KV_"+wmstore.JetStreamOTKVName(b), "KV_"+wmstore.JetStreamProcessorKVName(b))
+	}
+	return names
+}
+
 // getPipelineVertexDataCriticality is used to provide the data criticality of the pipeline
 // They can be of the following types:
 // 1. Ok: The pipeline is working as expected
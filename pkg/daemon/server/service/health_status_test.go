@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
@@ -438,6 +440,90 @@ func TestSetCurrentHealthWithGetCurrentHealth(t *testing.T) {
 	assert.NotEqual(t, "", finalStatus.Code)
 }
 
+func TestGetCriticalBuffers(t *testing.T) {
+	hc := &HealthChecker{
+		statusLock: &sync.RWMutex{},
+	}
+	assert.Empty(t, hc.getCriticalBuffers())
+
+	hc.setVertexStates([]*vertexState{
+		newVertexState("buffer-0", criticalState),
+		newVertexState("buffer-1", warningState),
+		newVertexState("buffer-2", criticalState),
+		newVertexState("buffer-3", healthyState),
+	})
+	assert.Equal(t, []string{"buffer-0", "buffer-2"}, hc.getCriticalBuffers())
+}
+
+// mockClusterISBService is an ISB Service reporting the cluster health
+type mockClusterISBService struct {
+	isbsvc.ISBService
+	health *isbsvc.ClusterHealth
+	err    error
+}
+
+func (m *mockClusterISBService) ClusterHealth(context.Context) (*isbsvc.ClusterHealth, error) {
+	return m.health, m.err
+}
+
+func TestCheckClusterHealth(t *testing.T) {
+	ctx := context.Background()
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "simple-pipeline", Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "out"}},
+			Edges:    []v1alpha1.Edge{{From: "in", To: "out"}},
+		},
+	}
+	buffer := pipeline.GetAllBuffers()[0]
+	bucket := pipeline.GetAllBuckets()[0]
+	streams := func(leader string) []isbsvc.StreamHealth {
+		return []isbsvc.StreamHealth{
+			{Name: buffer, Leader: leader, HasLeader: leader != "", Replicas: 3, CurrentReplicas: 3},
+			{Name: "KV_" + bucket + "_OT", Leader: "js-0", HasLeader: true, Replicas: 3, CurrentReplicas: 3},
+			{Name: "another-pipeline-buffer", Replicas: 3},
+		}
+	}
+
+	t.Run("not reported", func(t *testing.T) {
+		hc := NewHealthChecker(pipeline, &mockISBService{})
+		assert.Nil(t, hc.checkClusterHealth(ctx))
+		assert.Nil(t, hc.getClusterHealth())
+	})
+
+	t.Run("healthy", func(t *testing.T) {
+		hc := NewHealthChecker(pipeline, &mockClusterISBService{health: &isbsvc.ClusterHealth{Available: true, Streams: streams("js-1")}})
+		assert.Nil(t, hc.checkClusterHealth(ctx))
+		health := hc.getClusterHealth()
+		assert.True(t, health.Available)
+		// the streams of the other pipelines are filtered out
+		assert.Len(t, health.Streams, 2)
+	})
+
+	t.Run("leaderless", func(t *testing.T) {
+		hc := NewHealthChecker(pipeline, &mockClusterISBService{health: &isbsvc.ClusterHealth{Available: true, Streams: streams("")}})
+		resp := hc.checkClusterHealth(ctx)
+		assert.Equal(t, v1alpha1.PipelineStatusCritical, resp.Status)
+		assert.Equal(t, "D3", resp.Code)
+		assert.Equal(t, fmt.Sprintf("ISB Service cluster has no leader for %s", buffer), resp.Message)
+		assert.Equal(t, []string{buffer}, hc.getClusterHealth().LeaderlessStreams())
+	})
+
+	t.Run("not available", func(t *testing.T) {
+		hc := NewHealthChecker(pipeline, &mockClusterISBService{health: &isbsvc.ClusterHealth{Available: false, Message: "no responders"}})
+		resp := hc.checkClusterHealth(ctx)
+		assert.Equal(t, v1alpha1.PipelineStatusCritical, resp.Status)
+		assert.Equal(t, "ISB Service cluster is not available: no responders", resp.Message)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		hc := NewHealthChecker(pipeline, &mockClusterISBService{err: fmt.Errorf("context canceled")})
+		hc.setClusterHealth(&isbsvc.ClusterHealth{Available: true})
+		assert.Nil(t, hc.checkClusterHealth(ctx))
+		assert.Nil(t, hc.getClusterHealth())
+	})
+}
+
 func TestUpdateUsageTimeline(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,292 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
+)
+
+const (
+	// defaultLagSLOObjective is the default percentage of the windows in which the pending age must meet the target.
+	defaultLagSLOObjective = 99.0
+	// defaultLagSLOWindow is the default length of the windows the pending age is evaluated in.
+	defaultLagSLOWindow = 5 * time.Minute
+	// defaultLagSLOPeriod is the default compliance period of the error budget.
+	defaultLagSLOPeriod = 24 * time.Hour
+	// lagSLOSampleInterval is the frequency at which the pending age of the buffers is sampled.
+	lagSLOSampleInterval = 10 * time.Second
+)
+
+// lagSLOSpec is the SLO configured in the lag SLO annotation of the pipeline, the unset fields are inherited from the
+// pipeline level SLO or defaulted.
+type lagSLOSpec struct {
+	// MaxPendingAge is the target of the age of the oldest pending message of a buffer.
+	MaxPendingAge *metav1.Duration `json:"maxPendingAge,omitempty"`
+	// Objective is the percentage of the windows in which the pending age must stay below the target.
+	Objective *float64 `json:"objective,omitempty"`
+	// Window is the length of the windows.
+	Window *metav1.Duration `json:"window,omitempty"`
+	// Period is the compliance period, the error budget is the windows allowed to miss the target in the period.
+	Period *metav1.Duration `json:"period,omitempty"`
+}
+
+// lagSLOAnnotation is the value of the lag SLO annotation of the pipeline. The pipeline level SLO applies to all the
+// buffers if it has the target, the SLOs of the buffers are keyed by the buffer name or the name of the vertex
+// reading the buffer.
+type lagSLOAnnotation struct {
+	lagSLOSpec
+	Buffers map[string]lagSLOSpec `json:"buffers,omitempty"`
+}
+
+// LagSLO is the consumer lag SLO of a buffer, e.g. the pending age is less than 60s in 99% of the 5 minute windows.
+type LagSLO struct {
+	MaxPendingAge time.Duration
+	Objective     float64
+	Window        time.Duration
+	Period        time.Duration
+}
+
+// merge returns the SLO with the fields set in the spec overridden.
+func (s LagSLO) merge(spec lagSLOSpec) LagSLO {
+	if spec.MaxPendingAge != nil {
+		s.MaxPendingAge = spec.MaxPendingAge.Duration
+	}
+	if spec.Objective != nil {
+		s.Objective = *spec.Objective
+	}
+	if spec.Window != nil {
+		s.Window = spec.Window.Duration
+	}
+	if spec.Period != nil {
+		s.Period = spec.Period.Duration
+	}
+	return s
+}
+
+func (s LagSLO) validate() error {
+	if s.MaxPendingAge <= 0 {
+		return fmt.Errorf("maxPendingAge must be greater than 0")
+	}
+	if s.Objective <= 0 || s.Objective >= 100 {
+		return fmt.Errorf("objective must be between 0 and 100, exclusive")
+	}
+	if s.Window < lagSLOSampleInterval {
+		return fmt.Errorf("window must not be less than %v", lagSLOSampleInterval)
+	}
+	if s.Period < s.Window {
+		return fmt.Errorf("period must not be less than the window")
+	}
+	return nil
+}
+
+// ParseLagSLOs returns the consumer lag SLOs of the buffers of the pipeline configured in the lag SLO annotation,
+// nil if it's not configured.
+func ParseLagSLOs(pl *v1alpha1.Pipeline) (map[string]LagSLO, error) {
+	value, ok := pl.GetAnnotations()[v1alpha1.KeyLagSLO]
+	if !ok {
+		return nil, nil
+	}
+	var annotation lagSLOAnnotation
+	if err := json.Unmarshal([]byte(value), &annotation); err != nil {
+		return nil, fmt.Errorf("invalid annotation %q, %w", v1alpha1.KeyLagSLO, err)
+	}
+	defaults := LagSLO{Objective: defaultLagSLOObjective, Window: defaultLagSLOWindow, Period: defaultLagSLOPeriod}
+	pipelineSLO := defaults.merge(annotation.lagSLOSpec)
+	known := make(map[string]bool)
+	slos := make(map[string]LagSLO)
+	for _, buffer := range pl.GetAllBuffers() {
+		known[buffer] = true
+		spec, ok := annotation.Buffers[buffer]
+		if !ok {
+			if v := pl.FindVertexWithBuffer(buffer); v != nil {
+				known[v.Name] = true
+				spec, ok = annotation.Buffers[v.Name]
+			}
+		}
+		if !ok && annotation.MaxPendingAge == nil {
+			// the buffer has no SLO.
+			continue
+		}
+		slo := pipelineSLO.merge(spec)
+		if err := slo.validate(); err != nil {
+			return nil, fmt.Errorf("invalid consumer lag SLO of buffer %q, %w", buffer, err)
+		}
+		slos[buffer] = slo
+	}
+	for name := range annotation.Buffers {
+		if !known[name] {
+			return nil, fmt.Errorf("invalid annotation %q, %q is neither a buffer nor a vertex reading a buffer", v1alpha1.KeyLagSLO, name)
+		}
+	}
+	return slos, nil
+}
+
+// pendingAgeSample is the age of the oldest pending message of a buffer at a time.
+type pendingAgeSample struct {
+	Time time.Time
+	Age  time.Duration
+}
+
+// LagSLOStatus is the evaluation of the consumer lag SLO of a buffer.
+type LagSLOStatus struct {
+	Buffer string
+	// Windows is the number of the windows in the compliance period which have samples.
+	Windows int
+	// BadWindows is the number of the windows in which the pending age reached the target.
+	BadWindows int
+	// BurnRate is how fast the error budget is consumed, 1 means the budget is used up exactly at the end of the
+	// period if the ratio of the bad windows stays the same.
+	BurnRate float64
+	// BudgetRemaining is the ratio of the error budget left in the period, it's negative when overspent.
+	BudgetRemaining float64
+}
+
+// Exhausted returns whether the error budget of the period is used up.
+func (s LagSLOStatus) Exhausted() bool {
+	return s.BudgetRemaining <= 0
+}
+
+// evaluateLagSLO evaluates the SLO with the pending age samples of a buffer. The compliance period before now is split
+// into windows backwards from now, a window is bad if any sample in it reaches the target, and the windows without
+// samples are not counted. The error budget is the number of the windows in the period allowed to be bad.
+func evaluateLagSLO(samples []pendingAgeSample, slo LagSLO, now time.Time) LagSLOStatus {
+	var status LagSLOStatus
+	totalWindows := int(slo.Period / slo.Window)
+	windows := make(map[int]bool)
+	for _, s := range samples {
+		if s.Time.After(now) {
+			continue
+		}
+		idx := int(now.Sub(s.Time) / slo.Window)
+		if idx >= totalWindows {
+			continue
+		}
+		windows[idx] = windows[idx] || s.Age >= slo.MaxPendingAge
+	}
+	status.Windows = len(windows)
+	for _, bad := range windows {
+		if bad {
+			status.BadWindows++
+		}
+	}
+	errorRate := 1 - slo.Objective/100
+	budget := errorRate * float64(totalWindows)
+	status.BudgetRemaining = 1 - float64(status.BadWindows)/budget
+	if status.Windows > 0 {
+		status.BurnRate = float64(status.BadWindows) / float64(status.Windows) / errorRate
+	}
+	return status
+}
+
+// LagSLOTracker samples the age of the oldest pending message of the buffers with a consumer lag SLO, and evaluates
+// their SLOs.
+type LagSLOTracker struct {
+	isbSvc isbsvc.ISBService
+	slos   map[string]LagSLO
+	clock  clock.Clock
+	// history is the pending age samples of each buffer in its compliance period.
+	history map[string]*sharedqueue.OverflowQueue[pendingAgeSample]
+}
+
+// NewLagSLOTracker returns a tracker of the SLOs of the buffers.
+func NewLagSLOTracker(isbSvc isbsvc.ISBService, slos map[string]LagSLO, clk clock.Clock) *LagSLOTracker {
+	t := &LagSLOTracker{
+		isbSvc:  isbSvc,
+		slos:    slos,
+		clock:   clk,
+		history: make(map[string]*sharedqueue.OverflowQueue[pendingAgeSample]),
+	}
+	for buffer, slo := range slos {
+		t.history[buffer] = sharedqueue.New[pendingAgeSample](int(slo.Period/lagSLOSampleInterval) + 1)
+	}
+	return t
+}
+
+// SampleInterval returns the interval the buffers should be sampled at.
+func (t *LagSLOTracker) SampleInterval() time.Duration {
+	return lagSLOSampleInterval
+}
+
+// Sample records the pending age of each buffer, the age is 0 if the buffer has no pending messages. The information
+// of the buffers is fetched in one batch, the buffers whose information is not available are not sampled, the errors
+// are returned together.
+func (t *LagSLOTracker) Sample(ctx context.Context) error {
+	buffers := make([]string, 0, len(t.slos))
+	for buffer := range t.slos {
+		buffers = append(buffers, buffer)
+	}
+	sort.Strings(buffers)
+	infos, err := getBuffersInfo(ctx, t.isbSvc, buffers)
+	for _, buffer := range buffers {
+		info, ok := infos[buffer]
+		if !ok {
+			continue
+		}
+		now := t.clock.Now()
+		var age time.Duration
+		if !info.OldestPendingTime.IsZero() && now.After(info.OldestPendingTime) {
+			age = now.Sub(info.OldestPendingTime)
+		}
+		t.history[buffer].Append(pendingAgeSample{Time: now, Age: age})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get the information of the buffers, %w", err)
+	}
+	return nil
+}
+
+// Evaluate returns the status of the SLO of each buffer, sorted by the buffer name.
+func (t *LagSLOTracker) Evaluate() []LagSLOStatus {
+	now := t.clock.Now()
+	statuses := make([]LagSLOStatus, 0, len(t.slos))
+	for buffer, slo := range t.slos {
+		status := evaluateLagSLO(t.history[buffer].Items(), slo, now)
+		status.Buffer = buffer
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Buffer < statuses[j].Buffer })
+	return statuses
+}
+
+// LagSLOConditionApplier returns a function to set the ConsumerLagSLO condition of the pipeline status with the
+// statuses of the SLOs, the condition is false if the error budget of any buffer is exhausted.
+func LagSLOConditionApplier(statuses []LagSLOStatus) func(*v1alpha1.PipelineStatus) {
+	var exhausted []string
+	for _, s := range statuses {
+		if s.Exhausted() {
+			exhausted = append(exhausted, s.Buffer)
+		}
+	}
+	return func(status *v1alpha1.PipelineStatus) {
+		if len(exhausted) > 0 {
+			status.MarkConsumerLagSLOBreached("ErrorBudgetExhausted", "Error budget exhausted: "+summarizeItems(exhausted))
+			return
+		}
+		status.MarkConsumerLagSLOMet()
+	}
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+func TestEvaluateLagSLO(t *testing.T) {
+	now := time.Unix(100000, 0)
+	// 10 windows of 1 minute in the period, with the objective of 80% the budget is 2 bad windows.
+	slo := LagSLO{MaxPendingAge: time.Minute, Objective: 80, Window: time.Minute, Period: 10 * time.Minute}
+	sample := func(ago, age time.Duration) pendingAgeSample {
+		return pendingAgeSample{Time: now.Add(-ago), Age: age}
+	}
+	tests := []struct {
+		name            string
+		samples         []pendingAgeSample
+		windows         int
+		badWindows      int
+		burnRate        float64
+		budgetRemaining float64
+		exhausted       bool
+	}{
+		{
+			name:            "no samples",
+			budgetRemaining: 1,
+		},
+		{
+			name:            "all good",
+			samples:         []pendingAgeSample{sample(0, 0), sample(90*time.Second, 59*time.Second), sample(5*time.Minute, time.Second)},
+			windows:         3,
+			budgetRemaining: 1,
+		},
+		{
+			name:            "one bad window",
+			samples:         []pendingAgeSample{sample(10*time.Second, 0), sample(20*time.Second, time.Minute), sample(2*time.Minute, 0)},
+			windows:         2,
+			badWindows:      1,
+			burnRate:        2.5,
+			budgetRemaining: 0.5,
+		},
+		{
+			name: "budget exhausted",
+			samples: []pendingAgeSample{
+				sample(0, 2*time.Minute), sample(time.Minute, 2*time.Minute), sample(2*time.Minute, 0), sample(3*time.Minute, 0),
+			},
+			windows:         4,
+			badWindows:      2,
+			burnRate:        2.5,
+			budgetRemaining: 0,
+			exhausted:       true,
+		},
+		{
+			name: "samples out of the period are ignored",
+			samples: []pendingAgeSample{
+				sample(10*time.Minute, 2*time.Minute), sample(time.Hour, 2*time.Minute), sample(-time.Minute, 2*time.Minute), sample(0, 0),
+			},
+			windows:         1,
+			budgetRemaining: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := evaluateLagSLO(tt.samples, slo, now)
+			assert.Equal(t, tt.windows, s.Windows)
+			assert.Equal(t, tt.badWindows, s.BadWindows)
+			assert.InDelta(t, tt.burnRate, s.BurnRate, 1e-9)
+			assert.InDelta(t, tt.budgetRemaining, s.BudgetRemaining, 1e-9)
+			assert.Equal(t, tt.exhausted, s.Exhausted())
+		})
+	}
+}
+
+var lagSLOTestPipeline = v1alpha1.Pipeline{
+	ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pl"},
+	Spec: v1alpha1.PipelineSpec{
+		Vertices: []v1alpha1.AbstractVertex{
+			{Name: "in", Source: &v1alpha1.Source{}},
+			{Name: "cat", UDF: &v1alpha1.UDF{}},
+			{Name: "out", Sink: &v1alpha1.Sink{}},
+		},
+		Edges: []v1alpha1.Edge{{From: "in", To: "cat"}, {From: "cat", To: "out"}},
+	},
+}
+
+func lagSLOTestPipelineWith(annotation string) *v1alpha1.Pipeline {
+	pl := lagSLOTestPipeline.DeepCopy()
+	if annotation != "" {
+		pl.Annotations = map[string]string{v1alpha1.KeyLagSLO: annotation}
+	}
+	return pl
+}
+
+func TestParseLagSLOs(t *testing.T) {
+	catBuffer := lagSLOTestPipeline.GetVertex("cat").OwnedBufferNames("ns", "pl")[0]
+	outBuffer := lagSLOTestPipeline.GetVertex("out").OwnedBufferNames("ns", "pl")[0]
+	tests := []struct {
+		name       string
+		annotation string
+		expected   map[string]LagSLO
+		errMsg     string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:       "pipeline level",
+			annotation: `{"maxPendingAge": "60s"}`,
+			expected: map[string]LagSLO{
+				catBuffer: {MaxPendingAge: time.Minute, Objective: 99, Window: 5 * time.Minute, Period: 24 * time.Hour},
+				outBuffer: {MaxPendingAge: time.Minute, Objective: 99, Window: 5 * time.Minute, Period: 24 * time.Hour},
+			},
+		},
+		{
+			name:       "buffer overrides",
+			annotation: fmt.Sprintf(`{"maxPendingAge": "60s", "objective": 99.9, "buffers": {"out": {"maxPendingAge": "2m"}, %q: {"window": "1m", "period": "1h"}}}`, catBuffer),
+			expected: map[string]LagSLO{
+				catBuffer: {MaxPendingAge: time.Minute, Objective: 99.9, Window: time.Minute, Period: time.Hour},
+				outBuffer: {MaxPendingAge: 2 * time.Minute, Objective: 99.9, Window: 5 * time.Minute, Period: 24 * time.Hour},
+			},
+		},
+		{
+			name:       "only buffers",
+			annotation: `{"objective": 95, "buffers": {"out": {"maxPendingAge": "30s"}}}`,
+			expected: map[string]LagSLO{
+				outBuffer: {MaxPendingAge: 30 * time.Second, Objective: 95, Window: 5 * time.Minute, Period: 24 * time.Hour},
+			},
+		},
+		{
+			name:       "invalid json",
+			annotation: `maxPendingAge: 60s`,
+			errMsg:     "invalid annotation",
+		},
+		{
+			name:       "invalid objective",
+			annotation: `{"maxPendingAge": "60s", "objective": 100}`,
+			errMsg:     "objective must be between 0 and 100",
+		},
+		{
+			name:       "period less than window",
+			annotation: `{"maxPendingAge": "60s", "period": "1m"}`,
+			errMsg:     "period must not be less than the window",
+		},
+		{
+			name:       "missing target",
+			annotation: `{"buffers": {"out": {"objective": 90}}}`,
+			errMsg:     "maxPendingAge must be greater than 0",
+		},
+		{
+			name:       "unknown buffer",
+			annotation: `{"maxPendingAge": "60s", "buffers": {"in": {}}}`,
+			errMsg:     `"in" is neither a buffer nor a vertex reading a buffer`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slos, err := ParseLagSLOs(lagSLOTestPipelineWith(tt.annotation))
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, slos)
+		})
+	}
+}
+
+// lagSLOTestISBSvc returns the oldest pending time of the buffers set in the map, it counts the batches the information
+// is fetched in.
+type lagSLOTestISBSvc struct {
+	mockIsbSvcClient
+	oldestPendingTimes map[string]time.Time
+	batches            int
+}
+
+func (s *lagSLOTestISBSvc) GetBuffersInfo(_ context.Context, buffers []string) ([]*isbsvc.BufferInfo, error) {
+	s.batches++
+	infos := make([]*isbsvc.BufferInfo, 0, len(buffers))
+	for _, buffer := range buffers {
+		infos = append(infos, &isbsvc.BufferInfo{Name: buffer, OldestPendingTime: s.oldestPendingTimes[buffer]})
+	}
+	return infos, nil
+}
+
+func TestLagSLOTracker(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(100000, 0)
+	clk := clock.NewFakeClock(start)
+	isbSvc := &lagSLOTestISBSvc{oldestPendingTimes: map[string]time.Time{}}
+	slo := LagSLO{MaxPendingAge: time.Minute, Objective: 90, Window: time.Minute, Period: 10 * time.Minute}
+	tracker := NewLagSLOTracker(isbSvc, map[string]LagSLO{"b1": slo, "b2": slo}, clk)
+
+	// b1 is lagging for 2 minutes, b2 has nothing pending.
+	isbSvc.oldestPendingTimes["b1"] = start.Add(-2 * time.Minute)
+	for i := 0; i < 12; i++ {
+		require.NoError(t, tracker.Sample(ctx))
+		clk.Step(tracker.SampleInterval())
+	}
+	assert.Equal(t, 12, isbSvc.batches)
+	statuses := tracker.Evaluate()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "b1", statuses[0].Buffer)
+	assert.Equal(t, 3, statuses[0].BadWindows)
+	assert.True(t, statuses[0].Exhausted())
+	assert.Equal(t, "b2", statuses[1].Buffer)
+	assert.Equal(t, 0, statuses[1].BadWindows)
+	assert.False(t, statuses[1].Exhausted())
+
+	status := &v1alpha1.PipelineStatus{}
+	LagSLOConditionApplier(statuses)(status)
+	c := status.GetCondition(v1alpha1.PipelineConditionConsumerLagSLO)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, "ErrorBudgetExhausted", c.Reason)
+	assert.Contains(t, c.Message, "b1")
+	assert.NotContains(t, c.Message, "b2")
+
+	LagSLOConditionApplier(statuses[1:])(status)
+	assert.Equal(t, metav1.ConditionTrue, status.GetCondition(v1alpha1.PipelineConditionConsumerLagSLO).Status)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	numaflowv1alpha1 "github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// LagSLOWatcher samples the pending age of the buffers with a consumer lag SLO, exposes the burn rates and remaining
+// error budgets as metrics, and sets the ConsumerLagSLO condition of the pipeline.
+type LagSLOWatcher struct {
+	pipeline  *v1alpha1.Pipeline
+	isbSvc    isbsvc.ISBService
+	pipelines numaflowv1alpha1.PipelineInterface
+}
+
+var _ BackgroundJob = (*LagSLOWatcher)(nil)
+
+// NewLagSLOWatcher returns a LagSLOWatcher, the SLOs are read from the annotations of the live pipeline object when
+// it starts.
+func NewLagSLOWatcher(pl *v1alpha1.Pipeline, isbSvc isbsvc.ISBService, pipelines numaflowv1alpha1.PipelineInterface) *LagSLOWatcher {
+	return &LagSLOWatcher{pipeline: pl, isbSvc: isbSvc, pipelines: pipelines}
+}
+
+// Start tracks the SLOs until the context is done, it returns immediately if no SLO is configured.
+func (w *LagSLOWatcher) Start(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	pl, err := withLiveAnnotations(ctx, w.pipelines, w.pipeline)
+	if err != nil {
+		log.Warnw("Failed to get the pipeline, the consumer lag SLOs will not be tracked", zap.Error(err))
+		return
+	}
+	slos, err := ParseLagSLOs(pl)
+	if err != nil {
+		log.Errorw("Failed to parse the consumer lag SLOs, they will not be tracked", zap.Error(err))
+		return
+	}
+	if len(slos) == 0 {
+		return
+	}
+	tracker := NewLagSLOTracker(w.isbSvc, slos, clock.RealClock())
+
+	ticker := time.NewTicker(tracker.SampleInterval())
+	defer ticker.Stop()
+	// the buffers with the error budget exhausted in the condition last set, nil if it's not set yet.
+	var lastExhausted *string
+	for {
+		select {
+		case <-ticker.C:
+			if err := tracker.Sample(ctx); err != nil {
+				log.Warnw("Failed to sample the pending age of the buffers", zap.Error(err))
+			}
+			statuses := tracker.Evaluate()
+			var names []string
+			for _, s := range statuses {
+				lagSLOBurnRate.WithLabelValues(w.pipeline.Name, s.Buffer).Set(s.BurnRate)
+				lagSLOErrorBudgetRemaining.WithLabelValues(w.pipeline.Name, s.Buffer).Set(s.BudgetRemaining)
+				if s.Exhausted() {
+					names = append(names, s.Buffer)
+				}
+			}
+			exhausted := strings.Join(names, ",")
+			// only update the status when the exhausted buffers change.
+			if lastExhausted != nil && *lastExhausted == exhausted {
+				continue
+			}
+			if err := updatePipelineStatus(ctx, w.pipelines, w.pipeline.Name, LagSLOConditionApplier(statuses)); err != nil {
+				log.Errorw("Failed to set the consumer lag SLO condition of the pipeline", zap.Error(err))
+				continue
+			}
+			lastExhausted = &exhausted
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+var (
+	// Burn rate of the error budget of the consumer lag SLO of a buffer
+	lagSLOBurnRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "lag_slo_burn_rate",
+		Help:      "Burn rate of the error budget of the consumer lag SLO of a buffer, 1 means the budget is used up exactly at the end of the compliance period",
+	}, []string{metrics.LabelPipeline, metrics.LabelPartitionName})
+
+	// Remaining error budget of the consumer lag SLO of a buffer
+	lagSLOErrorBudgetRemaining = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "lag_slo_error_budget_remaining",
+		Help:      "Ratio of the error budget of the consumer lag SLO of a buffer left in the compliance period, negative when overspent",
+	}, []string{metrics.LabelPipeline, metrics.LabelPartitionName})
+
+	// Whether the pipeline is in backfill mode
+	pipelineBackfilling = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "backfilling",
+		Help:      "Whether the pipeline is in backfill mode. 1: Backfilling, 0: Not backfilling",
+	}, []string{metrics.LabelPipeline})
+
+	// Storage used by the buffers of the pipeline
+	storageUsedBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "storage_used_bytes",
+		Help:      "Storage used by the buffers of the pipeline in bytes, only exposed when the storage quota is configured",
+	}, []string{metrics.LabelPipeline})
+
+	// Whether the storage quota of the pipeline is exceeded
+	storageQuotaExceeded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "storage_quota_exceeded",
+		Help:      "Whether the storage used by the buffers of the pipeline exceeds the quota. 1: Exceeded, 0: Within the quota",
+	}, []string{metrics.LabelPipeline})
+
+	// Anomalies in the processing of a vertex partition detected by the rater
+	processingAnomaly = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "pipeline",
+		Name:      "processing_anomaly",
+		Help:      "Anomaly in the processing of a vertex partition, the reason is RateDrop or BacklogGrowth, only exposed while the anomaly lasts",
+	}, []string{metrics.LabelPipeline, metrics.LabelVertex, metrics.LabelPartitionName, metrics.LabelReason})
+
+	// Processing rates of a vertex partition in the count windows of the rater
+	windowProcessingRate = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: "pipeline",
+		Name:      "vertex_window_processing_rate",
+		Help:      "Processing rate of a vertex partition in messages per second, observed once for every count window of the rater",
+		Buckets:   prometheus.ExponentialBucketsRange(1, 100000, 11),
+	}, []string{metrics.LabelPipeline, metrics.LabelVertex, metrics.LabelPartitionName})
+)
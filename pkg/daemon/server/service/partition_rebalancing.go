@@ -0,0 +1,230 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	wmbpb "github.com/numaproj/numaflow/pkg/apis/proto/watermark"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+// maxRebalanceReports is the number of the most recent rebalancing reports kept by the tracker.
+const maxRebalanceReports = 10
+
+// PartitionMove is a source partition which moved from one replica of a vertex to another.
+type PartitionMove struct {
+	Partition   int32 `json:"partition"`
+	FromReplica int   `json:"fromReplica"`
+	ToReplica   int   `json:"toReplica"`
+	// PublisherResumedAt is the time of the first heartbeat of the watermark publisher of the partition after the
+	// move, it is nil if the publisher has not resumed yet.
+	PublisherResumedAt *time.Time `json:"publisherResumedAt,omitempty"`
+}
+
+// RebalanceReport describes the partitions of a source vertex which moved when its replica count changed.
+type RebalanceReport struct {
+	Vertex       string          `json:"vertex"`
+	FromReplicas int             `json:"fromReplicas"`
+	ToReplicas   int             `json:"toReplicas"`
+	Time         time.Time       `json:"time"`
+	Moves        []PartitionMove `json:"moves"`
+	// Resumed indicates whether the watermark publishers of all the moved partitions have resumed.
+	Resumed bool `json:"resumed"`
+}
+
+// AssignPartitions returns the replica each partition is assigned to. The partitions are sorted and assigned to
+// the replicas in a round-robin fashion, so the assignment only depends on the partitions and the replica count.
+func AssignPartitions(partitions []int32, replicas int) map[int32]int {
+	sorted := append([]int32{}, partitions...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	assignment := make(map[int32]int, len(sorted))
+	if replicas <= 0 {
+		return assignment
+	}
+	for i, p := range sorted {
+		assignment[p] = i % replicas
+	}
+	return assignment
+}
+
+// SourcePartitionHeartbeats returns the last heartbeat of the watermark publisher of each partition of a source
+// vertex, read from the heartbeat store of one of its out edges. The publishers are named
+// "{pipeline}-{vertex}-{partition}".
+func SourcePartitionHeartbeats(ctx context.Context, pipelineName, vertexName string, hbStore kvs.KVStorer) (map[int32]time.Time, error) {
+	keys, err := hbStore.GetAllKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the heartbeats, %w", err)
+	}
+	prefix := fmt.Sprintf("%s-%s-", pipelineName, vertexName)
+	heartbeats := make(map[int32]time.Time)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		// a vertex whose name starts with the name of this vertex has the same prefix, it's not a partition number.
+		partition, err := strconv.ParseInt(strings.TrimPrefix(key, prefix), 10, 32)
+		if err != nil {
+			continue
+		}
+		value, err := hbStore.GetValue(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the heartbeat of %q, %w", key, err)
+		}
+		var hb wmbpb.Heartbeat
+		if err := proto.Unmarshal(value, &hb); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the heartbeat of %q, %w", key, err)
+		}
+		heartbeats[int32(partition)] = time.Unix(hb.Heartbeat, 0)
+	}
+	return heartbeats, nil
+}
+
+// replicaObservation is the replica count observed for a vertex.
+type replicaObservation struct {
+	replicas int
+	// pending is a different replica count observed in the last observation, it is only taken as the new replica
+	// count if it is observed twice in a row, so that a pod missing a single check is not reported as a rebalance.
+	pending int
+}
+
+// RebalanceTracker detects the source partitions moving between the replicas of a vertex when its replica count
+// changes, and keeps a report of the moves.
+type RebalanceTracker struct {
+	lock sync.RWMutex
+	// recorder records the events of the moves, it is nil if the events are not recorded.
+	recorder     *events.Recorder
+	clock        clock.Clock
+	observations map[string]*replicaObservation
+	reports      []*RebalanceReport
+}
+
+// NewRebalanceTracker returns a RebalanceTracker, the recorder can be nil if the events are not recorded.
+func NewRebalanceTracker(recorder *events.Recorder, c clock.Clock) *RebalanceTracker {
+	return &RebalanceTracker{
+		recorder:     recorder,
+		clock:        c,
+		observations: make(map[string]*replicaObservation),
+	}
+}
+
+// Observe records the replica count of a source vertex, and the last heartbeats of the watermark publishers of its
+// partitions. It returns the report of the moved partitions if the replica count changed, nil otherwise.
+func (rt *RebalanceTracker) Observe(vertex string, replicas int, heartbeats map[int32]time.Time) *RebalanceReport {
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+	rt.updateResumed(vertex, heartbeats)
+	// the partitions are not assigned when the vertex is scaled down to 0, e.g. the pipeline is paused.
+	if replicas <= 0 {
+		return nil
+	}
+	o, ok := rt.observations[vertex]
+	if !ok {
+		rt.observations[vertex] = &replicaObservation{replicas: replicas}
+		return nil
+	}
+	if replicas == o.replicas {
+		o.pending = 0
+		return nil
+	}
+	if replicas != o.pending {
+		o.pending = replicas
+		return nil
+	}
+	from := o.replicas
+	o.replicas, o.pending = replicas, 0
+
+	partitions := make([]int32, 0, len(heartbeats))
+	for p := range heartbeats {
+		partitions = append(partitions, p)
+	}
+	before, after := AssignPartitions(partitions, from), AssignPartitions(partitions, replicas)
+	report := &RebalanceReport{Vertex: vertex, FromReplicas: from, ToReplicas: replicas, Time: rt.clock.Now()}
+	for p, r := range after {
+		if before[p] != r {
+			report.Moves = append(report.Moves, PartitionMove{Partition: p, FromReplica: before[p], ToReplica: r})
+		}
+	}
+	if len(report.Moves) == 0 {
+		return nil
+	}
+	sort.Slice(report.Moves, func(i, j int) bool { return report.Moves[i].Partition < report.Moves[j].Partition })
+	rt.reports = append(rt.reports, report)
+	if len(rt.reports) > maxRebalanceReports {
+		rt.reports = rt.reports[len(rt.reports)-maxRebalanceReports:]
+	}
+	if rt.recorder != nil {
+		rt.recorder.Normalf(events.ReasonPartitionsRebalanced, fmt.Sprintf("%s/%d/%d", vertex, from, replicas), "Vertex %s scaled from %d to %d replicas, %d partition(s) moved: %s", vertex, from, replicas, len(report.Moves), formatMoves(report.Moves))
+	}
+	return copyReport(report)
+}
+
+// updateResumed marks the moved partitions whose watermark publishers have a heartbeat after the move as resumed.
+func (rt *RebalanceTracker) updateResumed(vertex string, heartbeats map[int32]time.Time) {
+	for _, report := range rt.reports {
+		if report.Vertex != vertex || report.Resumed {
+			continue
+		}
+		resumed := true
+		for i := range report.Moves {
+			m := &report.Moves[i]
+			if m.PublisherResumedAt == nil {
+				// the heartbeats are in seconds, so only the heartbeats in the following seconds count.
+				if hb, ok := heartbeats[m.Partition]; ok && hb.Unix() > report.Time.Unix() {
+					m.PublisherResumedAt = &hb
+				}
+			}
+			resumed = resumed && m.PublisherResumedAt != nil
+		}
+		report.Resumed = resumed
+	}
+}
+
+// GetReports returns the most recent rebalancing reports, ordered by time.
+func (rt *RebalanceTracker) GetReports() []RebalanceReport {
+	rt.lock.RLock()
+	defer rt.lock.RUnlock()
+	reports := make([]RebalanceReport, 0, len(rt.reports))
+	for _, r := range rt.reports {
+		reports = append(reports, *copyReport(r))
+	}
+	return reports
+}
+
+func copyReport(r *RebalanceReport) *RebalanceReport {
+	c := *r
+	c.Moves = append([]PartitionMove{}, r.Moves...)
+	return &c
+}
+
+func formatMoves(moves []PartitionMove) string {
+	parts := make([]string, 0, len(moves))
+	for _, m := range moves {
+		parts = append(parts, fmt.Sprintf("%d (replica %d -> %d)", m.Partition, m.FromReplica, m.ToReplica))
+	}
+	return strings.Join(parts, ", ")
+}
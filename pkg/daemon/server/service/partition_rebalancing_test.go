@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/client-go/tools/record"
+
+	wmbpb "github.com/numaproj/numaflow/pkg/apis/proto/watermark"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
+)
+
+func TestAssignPartitions(t *testing.T) {
+	assert.Equal(t, map[int32]int{0: 0, 1: 1, 2: 0, 3: 1}, AssignPartitions([]int32{3, 1, 0, 2}, 2))
+	assert.Equal(t, map[int32]int{0: 0, 1: 1, 2: 2, 3: 0}, AssignPartitions([]int32{0, 1, 2, 3}, 3))
+	// the assignment only depends on the partitions, not on their order.
+	assert.Equal(t, AssignPartitions([]int32{5, 7, 9}, 2), AssignPartitions([]int32{9, 5, 7}, 2))
+	assert.Empty(t, AssignPartitions([]int32{0, 1}, 0))
+}
+
+func heartbeatsAt(ts time.Time, partitions ...int32) map[int32]time.Time {
+	heartbeats := make(map[int32]time.Time)
+	for _, p := range partitions {
+		heartbeats[p] = ts
+	}
+	return heartbeats
+}
+
+func TestRebalanceTracker_Observe(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	fakeClock := clock.NewFakeClock(time.Unix(1000, 0))
+	tracker := NewRebalanceTracker(events.NewRecorder(fakeRecorder, nil, events.WithClock(fakeClock)), fakeClock)
+	partitions := []int32{0, 1, 2, 3}
+
+	// the first observation is the baseline.
+	assert.Nil(t, tracker.Observe("in", 2, heartbeatsAt(fakeClock.Now(), partitions...)))
+	assert.Nil(t, tracker.Observe("in", 2, heartbeatsAt(fakeClock.Now(), partitions...)))
+
+	// a new replica count is only taken once it's observed twice in a row.
+	fakeClock.Step(10 * time.Second)
+	assert.Nil(t, tracker.Observe("in", 3, heartbeatsAt(fakeClock.Now(), partitions...)))
+	fakeClock.Step(10 * time.Second)
+	movedAt := fakeClock.Now()
+	report := tracker.Observe("in", 3, heartbeatsAt(movedAt, partitions...))
+	require.NotNil(t, report)
+	assert.Equal(t, "in", report.Vertex)
+	assert.Equal(t, 2, report.FromReplicas)
+	assert.Equal(t, 3, report.ToReplicas)
+	assert.Equal(t, movedAt, report.Time)
+	assert.False(t, report.Resumed)
+	// partition 2 moves from replica 0 to 2, and partition 3 moves from replica 1 to 0.
+	assert.Equal(t, []PartitionMove{
+		{Partition: 2, FromReplica: 0, ToReplica: 2},
+		{Partition: 3, FromReplica: 1, ToReplica: 0},
+	}, report.Moves)
+	assert.Equal(t, "Normal PartitionsRebalanced Vertex in scaled from 2 to 3 replicas, 2 partition(s) moved: 2 (replica 0 -> 2), 3 (replica 1 -> 0)", <-fakeRecorder.Events)
+
+	// the publisher of partition 2 resumes first.
+	fakeClock.Step(10 * time.Second)
+	resumedAt := fakeClock.Now()
+	heartbeats := heartbeatsAt(movedAt, partitions...)
+	heartbeats[2] = resumedAt
+	assert.Nil(t, tracker.Observe("in", 3, heartbeats))
+	reports := tracker.GetReports()
+	require.Len(t, reports, 1)
+	require.NotNil(t, reports[0].Moves[0].PublisherResumedAt)
+	assert.Equal(t, resumedAt, *reports[0].Moves[0].PublisherResumedAt)
+	assert.Nil(t, reports[0].Moves[1].PublisherResumedAt)
+	assert.False(t, reports[0].Resumed)
+
+	// and then the publisher of partition 3.
+	fakeClock.Step(10 * time.Second)
+	assert.Nil(t, tracker.Observe("in", 3, heartbeatsAt(fakeClock.Now(), partitions...)))
+	reports = tracker.GetReports()
+	assert.True(t, reports[0].Resumed)
+	assert.Equal(t, resumedAt, *reports[0].Moves[0].PublisherResumedAt)
+	assert.Equal(t, fakeClock.Now(), *reports[0].Moves[1].PublisherResumedAt)
+}
+
+func TestRebalanceTracker_NoMoves(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Unix(1000, 0))
+	tracker := NewRebalanceTracker(nil, fakeClock)
+
+	// the generator has one partition per replica, a new replica only adds a partition.
+	assert.Nil(t, tracker.Observe("in", 2, heartbeatsAt(fakeClock.Now(), 0, 1)))
+	assert.Nil(t, tracker.Observe("in", 3, heartbeatsAt(fakeClock.Now(), 0, 1)))
+	assert.Nil(t, tracker.Observe("in", 3, heartbeatsAt(fakeClock.Now(), 0, 1)))
+	assert.Empty(t, tracker.GetReports())
+
+	// scaling down to 0 does not reassign the partitions.
+	assert.Nil(t, tracker.Observe("in", 0, heartbeatsAt(fakeClock.Now(), 0, 1, 2)))
+	assert.Nil(t, tracker.Observe("in", 0, heartbeatsAt(fakeClock.Now(), 0, 1, 2)))
+	assert.Nil(t, tracker.Observe("in", 3, heartbeatsAt(fakeClock.Now(), 0, 1, 2)))
+	assert.Empty(t, tracker.GetReports())
+
+	// a replica missing a single check is not a rebalance.
+	assert.Nil(t, tracker.Observe("in", 2, heartbeatsAt(fakeClock.Now(), 0, 1, 2)))
+	assert.Nil(t, tracker.Observe("in", 3, heartbeatsAt(fakeClock.Now(), 0, 1, 2)))
+	assert.Nil(t, tracker.Observe("in", 2, heartbeatsAt(fakeClock.Now(), 0, 1, 2)))
+	assert.Empty(t, tracker.GetReports())
+}
+
+func TestRebalanceTracker_MaxReports(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Unix(1000, 0))
+	tracker := NewRebalanceTracker(nil, fakeClock)
+	heartbeats := heartbeatsAt(fakeClock.Now(), 0, 1, 2, 3, 4, 5)
+	assert.Nil(t, tracker.Observe("in", 1, heartbeats))
+	for i := 0; i < maxRebalanceReports+2; i++ {
+		replicas := 2 + i%2
+		tracker.Observe("in", replicas, heartbeats)
+		assert.NotNil(t, tracker.Observe("in", replicas, heartbeats))
+	}
+	reports := tracker.GetReports()
+	assert.Len(t, reports, maxRebalanceReports)
+	assert.Equal(t, 3, reports[len(reports)-1].ToReplicas)
+}
+
+func TestSourcePartitionHeartbeats(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	hbStore, err := inmem.NewKVInMemKVStore(ctx, "test-hb")
+	require.NoError(t, err)
+	defer hbStore.Close()
+
+	put := func(key string, ts int64) {
+		value, err := proto.Marshal(&wmbpb.Heartbeat{Heartbeat: ts})
+		require.NoError(t, err)
+		require.NoError(t, hbStore.PutKV(ctx, key, value))
+	}
+	put("pl-in-0", 1000)
+	put("pl-in-1", 1001)
+	// the processors of other vertices are skipped.
+	put("pl-in-x-0", 1002)
+	put("pl-map-0", 1003)
+
+	heartbeats, err := SourcePartitionHeartbeats(ctx, "pl", "in", hbStore)
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]time.Time{0: time.Unix(1000, 0), 1: time.Unix(1001, 0)}, heartbeats)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	numaflowv1alpha1 "github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// partitionsPaused returns whether the buffer partitions of the pipeline should be paused, they are paused once the
+// pipeline is paused, and resumed as soon as the pipeline is desired to run again.
+func partitionsPaused(pl *v1alpha1.Pipeline) bool {
+	return pl.GetDesiredPhase() == v1alpha1.PipelinePhasePaused && pl.Status.Phase == v1alpha1.PipelinePhasePaused
+}
+
+// setPartitionsPaused pauses or resumes the partitions, the partitions which do not exist are skipped.
+func setPartitionsPaused(ctx context.Context, isbSvc isbsvc.ISBService, partitions []string, paused bool) error {
+	var errs []error
+	for _, partition := range partitions {
+		var err error
+		if paused {
+			err = isbSvc.PausePartition(ctx, partition)
+		} else {
+			err = isbSvc.ResumePartition(ctx, partition)
+		}
+		if err != nil && !errors.Is(err, isbsvc.ErrBufferNotFound) {
+			errs = append(errs, fmt.Errorf("partition %q, %w", partition, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PauseWatcher polls the phase of the live pipeline object, and pauses the buffer partitions of the pipeline when it's
+// paused, so the messages left in the buffers after the pause grace period are retained and not read until the
+// pipeline is resumed.
+type PauseWatcher struct {
+	pipeline  *v1alpha1.Pipeline
+	isbSvc    isbsvc.ISBService
+	pipelines numaflowv1alpha1.PipelineInterface
+	interval  time.Duration
+}
+
+var _ BackgroundJob = (*PauseWatcher)(nil)
+
+// NewPauseWatcher returns a PauseWatcher of the buffer partitions of the pipeline.
+func NewPauseWatcher(pl *v1alpha1.Pipeline, isbSvc isbsvc.ISBService, pipelines numaflowv1alpha1.PipelineInterface) *PauseWatcher {
+	return &PauseWatcher{pipeline: pl, isbSvc: isbSvc, pipelines: pipelines, interval: 10 * time.Second}
+}
+
+// Start polls the phase right away and then every 10 seconds until the context is done.
+func (w *PauseWatcher) Start(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	// whether the partitions are paused, nil until it's set, the state is persisted in the ISB Service so the first
+	// observation is always applied.
+	var paused *bool
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		pl, err := w.pipelines.Get(ctx, w.pipeline.Name, metav1.GetOptions{})
+		if err != nil {
+			log.Warnw("Failed to get the pipeline to check the pause", zap.Error(err))
+		} else if want := partitionsPaused(pl); paused == nil || *paused != want {
+			if err := setPartitionsPaused(ctx, w.isbSvc, w.pipeline.GetAllBuffers(), want); err != nil {
+				log.Errorw("Failed to update the pause of the buffer partitions", zap.Bool("paused", want), zap.Error(err))
+			} else {
+				log.Infow("Updated the pause of the buffer partitions", zap.Bool("paused", want))
+				paused = &want
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
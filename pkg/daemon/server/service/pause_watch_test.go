@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/client/clientset/versioned/fake"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+// pauseTestISBSvc records the paused partitions, a partition missing in the ISB Service is not found.
+type pauseTestISBSvc struct {
+	isbsvc.ISBService
+	missing string
+	failing string
+	lock    sync.Mutex
+	paused  map[string]bool
+}
+
+func (s *pauseTestISBSvc) setPaused(partition string, paused bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	switch partition {
+	case s.missing:
+		return fmt.Errorf("partition %q, %w", partition, isbsvc.ErrBufferNotFound)
+	case s.failing:
+		return fmt.Errorf("failed to update partition %q", partition)
+	}
+	s.paused[partition] = paused
+	return nil
+}
+
+func (s *pauseTestISBSvc) getPaused() map[string]bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return maps.Clone(s.paused)
+}
+
+func (s *pauseTestISBSvc) PausePartition(_ context.Context, partition string) error {
+	return s.setPaused(partition, true)
+}
+
+func (s *pauseTestISBSvc) ResumePartition(_ context.Context, partition string) error {
+	return s.setPaused(partition, false)
+}
+
+func TestPartitionsPaused(t *testing.T) {
+	pl := &v1alpha1.Pipeline{}
+	assert.False(t, partitionsPaused(pl))
+	pl.Spec.Lifecycle.DesiredPhase = v1alpha1.PipelinePhasePaused
+	pl.Status.Phase = v1alpha1.PipelinePhasePausing
+	assert.False(t, partitionsPaused(pl))
+	pl.Status.Phase = v1alpha1.PipelinePhasePaused
+	assert.True(t, partitionsPaused(pl))
+	// resuming
+	pl.Spec.Lifecycle.DesiredPhase = v1alpha1.PipelinePhaseRunning
+	assert.False(t, partitionsPaused(pl))
+}
+
+func TestSetPartitionsPaused(t *testing.T) {
+	ctx := context.Background()
+	isbSvc := &pauseTestISBSvc{missing: "p1", paused: map[string]bool{}}
+	assert.NoError(t, setPartitionsPaused(ctx, isbSvc, []string{"p0", "p1", "p2"}, true))
+	assert.Equal(t, map[string]bool{"p0": true, "p2": true}, isbSvc.paused)
+
+	isbSvc.failing = "p0"
+	err := setPartitionsPaused(ctx, isbSvc, []string{"p0", "p1", "p2"}, false)
+	assert.ErrorContains(t, err, `partition "p0"`)
+	assert.Equal(t, map[string]bool{"p0": true, "p2": false}, isbSvc.paused)
+}
+
+func TestPauseWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pl := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pl", Namespace: "test-ns"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices:  []v1alpha1.AbstractVertex{{Name: "in"}, {Name: "out"}},
+			Edges:     []v1alpha1.Edge{{From: "in", To: "out"}},
+			Lifecycle: v1alpha1.Lifecycle{DesiredPhase: v1alpha1.PipelinePhasePaused},
+		},
+		Status: v1alpha1.PipelineStatus{Phase: v1alpha1.PipelinePhasePaused},
+	}
+	pipelines := fake.NewSimpleClientset(pl).NumaflowV1alpha1().Pipelines(pl.Namespace)
+	isbSvc := &pauseTestISBSvc{paused: map[string]bool{}}
+	w := NewPauseWatcher(pl, isbSvc, pipelines)
+	w.interval = 10 * time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.Start(ctx)
+	}()
+
+	want := map[string]bool{}
+	for _, b := range pl.GetAllBuffers() {
+		want[b] = true
+	}
+	assert.Eventually(t, func() bool { return maps.Equal(want, isbSvc.getPaused()) }, 5*time.Second, 10*time.Millisecond)
+
+	// the partitions are resumed as soon as the pipeline is desired to run again.
+	live, err := pipelines.Get(ctx, pl.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	live.Spec.Lifecycle.DesiredPhase = v1alpha1.PipelinePhaseRunning
+	_, err = pipelines.Update(ctx, live, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	for b := range want {
+		want[b] = false
+	}
+	assert.Eventually(t, func() bool { return maps.Equal(want, isbSvc.getPaused()) }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
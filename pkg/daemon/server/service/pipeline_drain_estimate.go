@@ -0,0 +1,256 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// DrainEstimateState describes whether a drain estimate could be derived.
+type DrainEstimateState string
+
+const (
+	// DrainEstimateKnown means the backlog is expected to drain in DrainEstimate.Seconds.
+	DrainEstimateKnown DrainEstimateState = "Known"
+	// DrainEstimateUnknown means the processing rate is not available yet, e.g. the rater has not collected enough data.
+	DrainEstimateUnknown DrainEstimateState = "Unknown"
+	// DrainEstimateInfinite means there is a backlog, but it is not being processed at all.
+	DrainEstimateInfinite DrainEstimateState = "Infinite"
+)
+
+// DrainEstimate is the estimated time it takes to clear a backlog if the sources stop producing now.
+type DrainEstimate struct {
+	State DrainEstimateState `json:"state"`
+	// Seconds is only meaningful when State is DrainEstimateKnown.
+	Seconds float64 `json:"seconds"`
+}
+
+// BufferDrainEstimate is the drain estimate of a single buffer partition, derived from its own pending count.
+type BufferDrainEstimate struct {
+	Buffer         string  `json:"buffer"`
+	Vertex         string  `json:"vertex"`
+	PendingCount   int64   `json:"pendingCount"`
+	ProcessingRate float64 `json:"processingRate"`
+	DrainEstimate
+}
+
+// VertexDrainEstimate is the estimated time until a vertex has processed its own backlog and
+// everything still pending upstream of it.
+type VertexDrainEstimate struct {
+	Vertex string `json:"vertex"`
+	DrainEstimate
+}
+
+// PipelineDrainEstimate is the drain estimate of the whole pipeline, it is the maximum of all the vertex estimates.
+type PipelineDrainEstimate struct {
+	Pipeline string                `json:"pipeline"`
+	Buffers  []BufferDrainEstimate `json:"buffers"`
+	Vertices []VertexDrainEstimate `json:"vertices"`
+	DrainEstimate
+}
+
+// GetPipelineDrainEstimate projects how long it takes to clear the current backlog of the pipeline,
+// assuming the sources stop producing now and every vertex keeps its current processing rate.
+func (ps *PipelineMetadataQuery) GetPipelineDrainEstimate(ctx context.Context, req *daemon.GetPipelineDrainEstimateRequest) (*daemon.GetPipelineDrainEstimateResponse, error) {
+	log := logging.FromContext(ctx)
+	pendings := make(map[string]int64)
+	rates := make(map[string]float64)
+	buffers := ps.pipeline.GetAllBuffers()
+	bufferInfos, err := getBuffersInfo(ctx, ps.isbSvcClient, buffers)
+	if err != nil {
+		return nil, err
+	}
+	for _, buffer := range buffers {
+		bufferInfo := bufferInfos[buffer]
+		v := ps.pipeline.FindVertexWithBuffer(buffer)
+		if v == nil {
+			return nil, fmt.Errorf("unexpected error, buffer %q not found from the pipeline", buffer)
+		}
+		pendings[buffer] = bufferInfo.PendingCount
+		rates[buffer] = rateNotAvailable
+		if r, ok := ps.rater.GetRates(v.Name, buffer)["default"]; ok && r != nil {
+			rates[buffer] = r.GetValue()
+		}
+	}
+	log.Debugf("Estimating drain time with pendings %v and rates %v", pendings, rates)
+	return &daemon.GetPipelineDrainEstimateResponse{Estimate: estimatePipelineDrainTime(ps.pipeline, pendings, rates).toProto()}, nil
+}
+
+// rateNotAvailable mirrors the value returned by the rater when the rate cannot be derived, any negative rate
+// is treated as not available.
+const rateNotAvailable = float64(-1)
+
+// estimatePipelineDrainTime calculates the drain estimates of each buffer, each vertex and the whole pipeline.
+// pendings and rates are keyed by buffer name, a negative or missing rate means the rate is not available.
+//
+// A vertex has to process its own pending messages as well as everything still pending upstream of it,
+// so the backlog of a vertex is accumulated through the DAG, and the vertex estimate is the accumulated
+// backlog divided by the vertex processing rate, but never earlier than the estimates of its upstream vertices.
+func estimatePipelineDrainTime(pl *v1alpha1.Pipeline, pendings map[string]int64, rates map[string]float64) *PipelineDrainEstimate {
+	result := &PipelineDrainEstimate{
+		Pipeline:      pl.Name,
+		Buffers:       []BufferDrainEstimate{},
+		Vertices:      []VertexDrainEstimate{},
+		DrainEstimate: DrainEstimate{State: DrainEstimateKnown},
+	}
+
+	type vertexState struct {
+		// backlog is the number of messages the vertex has to process, -1 if unknown.
+		backlog  int64
+		estimate DrainEstimate
+	}
+	states := make(map[string]*vertexState)
+	inProgress := make(map[string]bool)
+
+	var visit func(vertexName string) *vertexState
+	visit = func(vertexName string) *vertexState {
+		if s, ok := states[vertexName]; ok {
+			return s
+		}
+		vertex := pl.GetVertex(vertexName)
+		if vertex == nil || inProgress[vertexName] {
+			// unknown vertex or a cycle, it contributes nothing to the backlog.
+			return &vertexState{estimate: DrainEstimate{State: DrainEstimateKnown}}
+		}
+		inProgress[vertexName] = true
+		defer delete(inProgress, vertexName)
+
+		s := &vertexState{estimate: DrainEstimate{State: DrainEstimateKnown}}
+		var upstream []DrainEstimate
+		for _, e := range pl.GetFromEdges(vertexName) {
+			u := visit(e.From)
+			upstream = append(upstream, u.estimate)
+			if s.backlog >= 0 {
+				if u.backlog < 0 {
+					s.backlog = -1
+				} else {
+					s.backlog += u.backlog
+				}
+			}
+		}
+
+		rate := float64(0)
+		for _, buffer := range vertex.OwnedBufferNames(pl.Namespace, pl.Name) {
+			pending, ok := pendings[buffer]
+			if !ok || pending < 0 {
+				pending = -1
+				s.backlog = -1
+			} else if s.backlog >= 0 {
+				s.backlog += pending
+			}
+			r, ok := rates[buffer]
+			if !ok || r < 0 {
+				r = rateNotAvailable
+			}
+			if r < 0 || rate < 0 {
+				rate = rateNotAvailable
+			} else {
+				rate += r
+			}
+			be := BufferDrainEstimate{
+				Buffer:         buffer,
+				Vertex:         vertexName,
+				PendingCount:   pending,
+				ProcessingRate: r,
+				DrainEstimate:  drainTime(pending, r),
+			}
+			result.Buffers = append(result.Buffers, be)
+		}
+
+		if !vertex.IsASource() {
+			s.estimate = drainTime(s.backlog, rate)
+		}
+		s.estimate = maxDrainEstimate(append(upstream, s.estimate)...)
+		states[vertexName] = s
+		return s
+	}
+
+	var all []DrainEstimate
+	for _, v := range pl.Spec.Vertices {
+		s := visit(v.Name)
+		result.Vertices = append(result.Vertices, VertexDrainEstimate{Vertex: v.Name, DrainEstimate: s.estimate})
+		all = append(all, s.estimate)
+	}
+	result.DrainEstimate = maxDrainEstimate(all...)
+	return result
+}
+
+// drainTime returns the estimated time to process the given number of pending messages at the given rate.
+// A negative pending count or rate means it is not available.
+func drainTime(pending int64, rate float64) DrainEstimate {
+	switch {
+	case pending == 0:
+		return DrainEstimate{State: DrainEstimateKnown}
+	case pending < 0 || rate < 0:
+		return DrainEstimate{State: DrainEstimateUnknown}
+	case rate == 0:
+		return DrainEstimate{State: DrainEstimateInfinite}
+	default:
+		return DrainEstimate{State: DrainEstimateKnown, Seconds: float64(pending) / rate}
+	}
+}
+
+// toProto returns the drain estimate of the pipeline in the response of the daemon service.
+func (e *PipelineDrainEstimate) toProto() *daemon.PipelineDrainEstimate {
+	result := &daemon.PipelineDrainEstimate{Pipeline: e.Pipeline, Estimate: e.DrainEstimate.toProto()}
+	for _, b := range e.Buffers {
+		result.Buffers = append(result.Buffers, &daemon.BufferDrainEstimate{
+			Buffer:         b.Buffer,
+			Vertex:         b.Vertex,
+			PendingCount:   wrapperspb.Int64(b.PendingCount),
+			ProcessingRate: wrapperspb.Double(b.ProcessingRate),
+			Estimate:       b.DrainEstimate.toProto(),
+		})
+	}
+	for _, v := range e.Vertices {
+		result.Vertices = append(result.Vertices, &daemon.VertexDrainEstimate{Vertex: v.Vertex, Estimate: v.DrainEstimate.toProto()})
+	}
+	return result
+}
+
+// toProto returns the drain estimate in the response of the daemon service, the seconds are only set if it's known.
+func (e DrainEstimate) toProto() *daemon.DrainEstimate {
+	result := &daemon.DrainEstimate{State: string(e.State)}
+	if e.State == DrainEstimateKnown {
+		result.Seconds = wrapperspb.Double(e.Seconds)
+	}
+	return result
+}
+
+// maxDrainEstimate returns the latest of the given estimates, an infinite estimate wins over an unknown one,
+// and an unknown one wins over any known duration.
+func maxDrainEstimate(estimates ...DrainEstimate) DrainEstimate {
+	result := DrainEstimate{State: DrainEstimateKnown}
+	for _, e := range estimates {
+		switch {
+		case e.State == DrainEstimateInfinite:
+			return e
+		case e.State == DrainEstimateUnknown:
+			result = e
+		case result.State == DrainEstimateKnown && e.Seconds > result.Seconds:
+			result = e
+		}
+	}
+	return result
+}
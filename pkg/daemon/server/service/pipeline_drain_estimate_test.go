@@ -0,0 +1,267 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+const (
+	catBuffer = "ns-pl-cat-0"
+	outBuffer = "ns-pl-out-0"
+)
+
+func threeVertexPipeline() *v1alpha1.Pipeline {
+	return &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "pl", Namespace: "ns"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "cat", UDF: &v1alpha1.UDF{}},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{
+				{From: "in", To: "cat"},
+				{From: "cat", To: "out"},
+			},
+		},
+	}
+}
+
+func findVertexEstimate(t *testing.T, e *PipelineDrainEstimate, vertex string) DrainEstimate {
+	t.Helper()
+	for _, v := range e.Vertices {
+		if v.Vertex == vertex {
+			return v.DrainEstimate
+		}
+	}
+	t.Fatalf("vertex %q not found in drain estimate", vertex)
+	return DrainEstimate{}
+}
+
+func TestEstimatePipelineDrainTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		pendings   map[string]int64
+		rates      map[string]float64
+		wantBuffer map[string]DrainEstimate
+		wantVertex map[string]DrainEstimate
+		want       DrainEstimate
+	}{
+		{
+			name:     "backlog propagates downstream",
+			pendings: map[string]int64{catBuffer: 100, outBuffer: 50},
+			rates:    map[string]float64{catBuffer: 10, outBuffer: 5},
+			wantBuffer: map[string]DrainEstimate{
+				catBuffer: {State: DrainEstimateKnown, Seconds: 10},
+				outBuffer: {State: DrainEstimateKnown, Seconds: 10},
+			},
+			wantVertex: map[string]DrainEstimate{
+				"in":  {State: DrainEstimateKnown},
+				"cat": {State: DrainEstimateKnown, Seconds: 10},
+				// out has to process its own 50 and the 100 pending in cat, at 5 per second.
+				"out": {State: DrainEstimateKnown, Seconds: 30},
+			},
+			want: DrainEstimate{State: DrainEstimateKnown, Seconds: 30},
+		},
+		{
+			name:     "slow upstream dominates",
+			pendings: map[string]int64{catBuffer: 600, outBuffer: 0},
+			rates:    map[string]float64{catBuffer: 2, outBuffer: 100},
+			wantBuffer: map[string]DrainEstimate{
+				catBuffer: {State: DrainEstimateKnown, Seconds: 300},
+				outBuffer: {State: DrainEstimateKnown},
+			},
+			wantVertex: map[string]DrainEstimate{
+				"cat": {State: DrainEstimateKnown, Seconds: 300},
+				"out": {State: DrainEstimateKnown, Seconds: 300},
+			},
+			want: DrainEstimate{State: DrainEstimateKnown, Seconds: 300},
+		},
+		{
+			name:     "empty buffers drain immediately even without rates",
+			pendings: map[string]int64{catBuffer: 0, outBuffer: 0},
+			rates:    map[string]float64{catBuffer: rateNotAvailable, outBuffer: 0},
+			wantBuffer: map[string]DrainEstimate{
+				catBuffer: {State: DrainEstimateKnown},
+				outBuffer: {State: DrainEstimateKnown},
+			},
+			want: DrainEstimate{State: DrainEstimateKnown},
+		},
+		{
+			name:     "unknown rate",
+			pendings: map[string]int64{catBuffer: 100, outBuffer: 10},
+			rates:    map[string]float64{catBuffer: 10, outBuffer: rateNotAvailable},
+			wantBuffer: map[string]DrainEstimate{
+				catBuffer: {State: DrainEstimateKnown, Seconds: 10},
+				outBuffer: {State: DrainEstimateUnknown},
+			},
+			wantVertex: map[string]DrainEstimate{
+				"cat": {State: DrainEstimateKnown, Seconds: 10},
+				"out": {State: DrainEstimateUnknown},
+			},
+			want: DrainEstimate{State: DrainEstimateUnknown},
+		},
+		{
+			name:     "zero rate with backlog",
+			pendings: map[string]int64{catBuffer: 100, outBuffer: 10},
+			rates:    map[string]float64{catBuffer: 0, outBuffer: rateNotAvailable},
+			wantBuffer: map[string]DrainEstimate{
+				catBuffer: {State: DrainEstimateInfinite},
+				outBuffer: {State: DrainEstimateUnknown},
+			},
+			wantVertex: map[string]DrainEstimate{
+				"cat": {State: DrainEstimateInfinite},
+				"out": {State: DrainEstimateInfinite},
+			},
+			want: DrainEstimate{State: DrainEstimateInfinite},
+		},
+		{
+			name:     "missing pending",
+			pendings: map[string]int64{outBuffer: 10},
+			rates:    map[string]float64{catBuffer: 10, outBuffer: 10},
+			wantBuffer: map[string]DrainEstimate{
+				catBuffer: {State: DrainEstimateUnknown},
+				outBuffer: {State: DrainEstimateKnown, Seconds: 1},
+			},
+			wantVertex: map[string]DrainEstimate{
+				"out": {State: DrainEstimateUnknown},
+			},
+			want: DrainEstimate{State: DrainEstimateUnknown},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := estimatePipelineDrainTime(threeVertexPipeline(), tt.pendings, tt.rates)
+			assert.Equal(t, "pl", got.Pipeline)
+			assert.Len(t, got.Buffers, 2)
+			for _, b := range got.Buffers {
+				assert.Equal(t, tt.wantBuffer[b.Buffer], b.DrainEstimate, b.Buffer)
+			}
+			assert.Len(t, got.Vertices, 3)
+			for v, want := range tt.wantVertex {
+				assert.Equal(t, want, findVertexEstimate(t, got, v), v)
+			}
+			assert.Equal(t, tt.want, got.DrainEstimate)
+		})
+	}
+}
+
+type mockRater_TestGetPipelineDrainEstimate struct {
+	rates map[string]float64
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) Start(ctx context.Context) error {
+	return nil
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) GetRates(vertexName string, partitionName string) map[string]*wrapperspb.DoubleValue {
+	res := make(map[string]*wrapperspb.DoubleValue)
+	if r, ok := mr.rates[partitionName]; ok {
+		res["default"] = wrapperspb.Double(r)
+	}
+	return res
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) GetPodRates(vertexName string, partitionName string) map[string]*wrapperspb.DoubleValue {
+	return nil
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) GetBurstRates(vertexName string, partitionName string) map[string]*wrapperspb.DoubleValue {
+	return nil
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) GetVertexPodRates(vertexName string) map[string]*wrapperspb.DoubleValue {
+	return nil
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) GetPendings(vertexName string, partitionName string) map[string]*wrapperspb.Int64Value {
+	return nil
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) GetErrorRates(vertexName string) map[string]*wrapperspb.DoubleValue {
+	return nil
+}
+
+func (mr *mockRater_TestGetPipelineDrainEstimate) GetAckRates(vertexName string, partitionName string) map[string]*wrapperspb.DoubleValue {
+	return nil
+}
+
+func TestGetPipelineDrainEstimate(t *testing.T) {
+	// mockIsbSvcClient reports a pending count of 10 for every buffer.
+	ms := &mockIsbSvcClient{}
+	mr := &mockRater_TestGetPipelineDrainEstimate{rates: map[string]float64{catBuffer: 2, outBuffer: 4}}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, threeVertexPipeline(), nil, mr)
+	assert.NoError(t, err)
+
+	req := &daemon.GetPipelineDrainEstimateRequest{Pipeline: "pl"}
+	resp, err := pipelineMetricsQueryService.GetPipelineDrainEstimate(context.Background(), req)
+	assert.NoError(t, err)
+	estimate := resp.GetEstimate()
+	assert.Len(t, estimate.GetVertices(), 3)
+	for _, v := range estimate.GetVertices() {
+		if v.GetVertex() == "cat" || v.GetVertex() == "out" {
+			assert.Equal(t, string(DrainEstimateKnown), v.GetEstimate().GetState())
+			assert.Equal(t, 5.0, v.GetEstimate().GetSeconds().GetValue())
+		}
+	}
+	assert.Equal(t, string(DrainEstimateKnown), estimate.GetEstimate().GetState())
+	assert.Equal(t, 5.0, estimate.GetEstimate().GetSeconds().GetValue())
+	for _, b := range estimate.GetBuffers() {
+		assert.Equal(t, int64(10), b.GetPendingCount().GetValue())
+	}
+
+	// the rate of out is not available yet.
+	mr.rates = map[string]float64{catBuffer: 2}
+	resp, err = pipelineMetricsQueryService.GetPipelineDrainEstimate(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, string(DrainEstimateUnknown), resp.GetEstimate().GetEstimate().GetState())
+	assert.Nil(t, resp.GetEstimate().GetEstimate().GetSeconds())
+}
+
+func TestGetBufferSecondsToDrain(t *testing.T) {
+	// mockIsbSvcClient reports a pending count of 10 for every buffer.
+	ms := &mockIsbSvcClient{}
+	mr := &mockRater_TestGetPipelineDrainEstimate{rates: map[string]float64{catBuffer: 2, outBuffer: 0}}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, threeVertexPipeline(), nil, mr)
+	assert.NoError(t, err)
+
+	resp, err := pipelineMetricsQueryService.GetBuffer(context.Background(), &daemon.GetBufferRequest{Pipeline: "pl", Buffer: catBuffer})
+	assert.NoError(t, err)
+	assert.Equal(t, 5.0, resp.Buffer.GetSecondsToDrain().GetValue())
+
+	listResp, err := pipelineMetricsQueryService.ListBuffers(context.Background(), &daemon.ListBuffersRequest{Pipeline: "pl"})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.Buffers, 2)
+	for _, b := range listResp.Buffers {
+		switch b.GetBufferName() {
+		case catBuffer:
+			assert.Equal(t, 5.0, b.GetSecondsToDrain().GetValue())
+		case outBuffer:
+			// nothing is processed, the backlog never drains.
+			assert.Nil(t, b.GetSecondsToDrain())
+		}
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+// hotEdgeUsageRatio is the ratio of the capacity of an edge above which the edge is considered hot.
+const hotEdgeUsageRatio = 0.8
+
+// EdgeCapacity compares the observed throughput of an edge with its configured ceiling.
+type EdgeCapacity struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// ObservedRate is the rate at which the to vertex reads the buffers of the edge, it's -1 if not available.
+	// The edges to the same vertex share its buffers, so they have the same observed rate.
+	ObservedRate float64 `json:"observedRate"`
+	// CapacityRate is the usable length of the buffers of the edge, divided by the target processing seconds of the
+	// to vertex, it's the rate above which the buffers can not be drained in the target time.
+	CapacityRate float64 `json:"capacityRate"`
+	// HeadroomPercentage is the percentage of the capacity not used by the observed rate, negative if the edge is
+	// running above its capacity, it's only meaningful when the observed rate is available.
+	HeadroomPercentage float64 `json:"headroomPercentage"`
+	// Hot tells if the edge is running above 80% of its capacity.
+	Hot bool `json:"hot"`
+}
+
+// PipelineEdgeCapacity is the capacity comparison of all the edges of the pipeline.
+type PipelineEdgeCapacity struct {
+	Pipeline string         `json:"pipeline"`
+	Edges    []EdgeCapacity `json:"edges"`
+}
+
+// GetPipelineEdgeCapacity compares the observed throughput of each edge of the pipeline with its configured ceiling,
+// for capacity reviews, the edges running above 80% of their capacity are flagged hot.
+func (ps *PipelineMetadataQuery) GetPipelineEdgeCapacity(ctx context.Context, req *daemon.GetPipelineEdgeCapacityRequest) (*daemon.GetPipelineEdgeCapacityResponse, error) {
+	rates := make(map[string]float64)
+	for _, buffer := range ps.pipeline.GetAllBuffers() {
+		v := ps.pipeline.FindVertexWithBuffer(buffer)
+		if v == nil {
+			continue
+		}
+		if r, ok := ps.rater.GetRates(v.Name, buffer)["default"]; ok && r != nil {
+			rates[buffer] = r.GetValue()
+		}
+	}
+	return &daemon.GetPipelineEdgeCapacityResponse{Capacity: estimatePipelineEdgeCapacity(ps.pipeline, rates).toProto()}, nil
+}
+
+// estimatePipelineEdgeCapacity calculates the capacity of each edge of the pipeline, rates are keyed by buffer name,
+// a negative or missing rate means the rate is not available.
+func estimatePipelineEdgeCapacity(pl *v1alpha1.Pipeline, rates map[string]float64) *PipelineEdgeCapacity {
+	result := &PipelineEdgeCapacity{Pipeline: pl.Name, Edges: []EdgeCapacity{}}
+	for _, e := range pl.ListAllEdges() {
+		ec := EdgeCapacity{From: e.From, To: e.To, ObservedRate: rateNotAvailable}
+		to := pl.GetVertex(e.To)
+		if to == nil {
+			result.Edges = append(result.Edges, ec)
+			continue
+		}
+		bufferLength, bufferUsageLimit := getBufferLimits(pl, *to)
+		targetSeconds := float64(max(to.Scale.GetTargetProcessingSeconds(), 1))
+		observed := float64(0)
+		for _, buffer := range to.OwnedBufferNames(pl.Namespace, pl.Name) {
+			ec.CapacityRate += float64(bufferLength) * bufferUsageLimit / targetSeconds
+			if r, ok := rates[buffer]; !ok || r < 0 || observed < 0 {
+				observed = rateNotAvailable
+			} else {
+				observed += r
+			}
+		}
+		ec.ObservedRate = observed
+		ec.HeadroomPercentage, ec.Hot = edgeHeadroom(observed, ec.CapacityRate)
+		result.Edges = append(result.Edges, ec)
+	}
+	return result
+}
+
+// toProto returns the capacity of the edges in the response of the daemon service.
+func (c *PipelineEdgeCapacity) toProto() *daemon.PipelineEdgeCapacity {
+	result := &daemon.PipelineEdgeCapacity{Pipeline: c.Pipeline}
+	for _, e := range c.Edges {
+		result.Edges = append(result.Edges, &daemon.EdgeCapacity{
+			From:               e.From,
+			To:                 e.To,
+			ObservedRate:       wrapperspb.Double(e.ObservedRate),
+			CapacityRate:       wrapperspb.Double(e.CapacityRate),
+			HeadroomPercentage: wrapperspb.Double(e.HeadroomPercentage),
+			Hot:                e.Hot,
+		})
+	}
+	return result
+}
+
+// edgeHeadroom returns the percentage of the capacity not used by the rate, and whether the rate is above 80% of
+// the capacity. An edge without an available rate is not hot, and one without capacity is hot as soon as it has traffic.
+func edgeHeadroom(rate, capacity float64) (float64, bool) {
+	switch {
+	case rate < 0:
+		return 0, false
+	case capacity <= 0:
+		return 0, rate > 0
+	default:
+		return (1 - rate/capacity) * 100, rate > capacity*hotEdgeUsageRatio
+	}
+}
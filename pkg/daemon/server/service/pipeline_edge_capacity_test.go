@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utilptr "k8s.io/utils/ptr"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestEstimatePipelineEdgeCapacity(t *testing.T) {
+	tests := []struct {
+		name string
+		// customize updates the buffer config of the three vertex pipeline
+		customize func(pl *v1alpha1.Pipeline)
+		rates     map[string]float64
+		// want is keyed by the to vertex of the edges
+		want map[string]EdgeCapacity
+	}{
+		{
+			name:  "default buffer config",
+			rates: map[string]float64{catBuffer: 600, outBuffer: 1000},
+			want: map[string]EdgeCapacity{
+				// 30000 * 80% / 20 seconds
+				"cat": {From: "in", To: "cat", ObservedRate: 600, CapacityRate: 1200, HeadroomPercentage: 50},
+				"out": {From: "cat", To: "out", ObservedRate: 1000, CapacityRate: 1200, HeadroomPercentage: 100.0 / 6, Hot: true},
+			},
+		},
+		{
+			name: "pipeline limits and vertex limits",
+			customize: func(pl *v1alpha1.Pipeline) {
+				pl.Spec.Limits = &v1alpha1.PipelineLimits{BufferMaxLength: utilptr.To[uint64](10000), BufferUsageLimit: utilptr.To[uint32](50)}
+				pl.Spec.Vertices[2].Limits = &v1alpha1.VertexLimits{BufferMaxLength: utilptr.To[uint64](1000)}
+			},
+			rates: map[string]float64{catBuffer: 100, outBuffer: 25},
+			want: map[string]EdgeCapacity{
+				"cat": {From: "in", To: "cat", ObservedRate: 100, CapacityRate: 250, HeadroomPercentage: 60},
+				"out": {From: "cat", To: "out", ObservedRate: 25, CapacityRate: 25, HeadroomPercentage: 0, Hot: true},
+			},
+		},
+		{
+			name: "target processing seconds",
+			customize: func(pl *v1alpha1.Pipeline) {
+				pl.Spec.Vertices[1].Scale.TargetProcessingSeconds = utilptr.To[uint32](60)
+			},
+			rates: map[string]float64{catBuffer: 500, outBuffer: 0},
+			want: map[string]EdgeCapacity{
+				"cat": {From: "in", To: "cat", ObservedRate: 500, CapacityRate: 400, HeadroomPercentage: -25, Hot: true},
+				"out": {From: "cat", To: "out", ObservedRate: 0, CapacityRate: 1200, HeadroomPercentage: 100},
+			},
+		},
+		{
+			name: "partitioned buffers",
+			customize: func(pl *v1alpha1.Pipeline) {
+				pl.Spec.Vertices[1].Partitions = utilptr.To[int32](2)
+			},
+			rates: map[string]float64{catBuffer: 900, "ns-pl-cat-1": 900, outBuffer: 100},
+			want: map[string]EdgeCapacity{
+				"cat": {From: "in", To: "cat", ObservedRate: 1800, CapacityRate: 2400, HeadroomPercentage: 25},
+				"out": {From: "cat", To: "out", ObservedRate: 100, CapacityRate: 1200, HeadroomPercentage: 100 - 100.0/12},
+			},
+		},
+		{
+			name: "rates not available",
+			customize: func(pl *v1alpha1.Pipeline) {
+				pl.Spec.Vertices[1].Partitions = utilptr.To[int32](2)
+			},
+			rates: map[string]float64{catBuffer: 900, outBuffer: rateNotAvailable},
+			want: map[string]EdgeCapacity{
+				"cat": {From: "in", To: "cat", ObservedRate: rateNotAvailable, CapacityRate: 2400},
+				"out": {From: "cat", To: "out", ObservedRate: rateNotAvailable, CapacityRate: 1200},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pl := threeVertexPipeline()
+			if tt.customize != nil {
+				tt.customize(pl)
+			}
+			got := estimatePipelineEdgeCapacity(pl, tt.rates)
+			assert.Equal(t, "pl", got.Pipeline)
+			assert.Len(t, got.Edges, 2)
+			for _, e := range got.Edges {
+				want := tt.want[e.To]
+				assert.Equal(t, want.From, e.From)
+				assert.InDelta(t, want.ObservedRate, e.ObservedRate, 1e-9, e.To)
+				assert.InDelta(t, want.CapacityRate, e.CapacityRate, 1e-9, e.To)
+				assert.InDelta(t, want.HeadroomPercentage, e.HeadroomPercentage, 1e-9, e.To)
+				assert.Equal(t, want.Hot, e.Hot, e.To)
+			}
+		})
+	}
+}
+
+func TestEdgeHeadroom(t *testing.T) {
+	headroom, hot := edgeHeadroom(80, 100)
+	assert.InDelta(t, 20, headroom, 1e-9)
+	assert.False(t, hot)
+	_, hot = edgeHeadroom(81, 100)
+	assert.True(t, hot)
+	_, hot = edgeHeadroom(rateNotAvailable, 100)
+	assert.False(t, hot)
+	_, hot = edgeHeadroom(1, 0)
+	assert.True(t, hot)
+	_, hot = edgeHeadroom(0, 0)
+	assert.False(t, hot)
+}
+
+func TestGetPipelineEdgeCapacity(t *testing.T) {
+	mr := &mockRater_TestGetPipelineDrainEstimate{rates: map[string]float64{catBuffer: 1100, outBuffer: 10}}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, threeVertexPipeline(), nil, mr)
+	assert.NoError(t, err)
+
+	resp, err := pipelineMetricsQueryService.GetPipelineEdgeCapacity(context.Background(), &daemon.GetPipelineEdgeCapacityRequest{Pipeline: "pl"})
+	assert.NoError(t, err)
+	edges := resp.GetCapacity().GetEdges()
+	assert.Len(t, edges, 2)
+	assert.True(t, edges[0].GetHot())
+	assert.Equal(t, float64(1100), edges[0].GetObservedRate().GetValue())
+	assert.Positive(t, edges[0].GetCapacityRate().GetValue())
+	assert.False(t, edges[1].GetHot())
+}
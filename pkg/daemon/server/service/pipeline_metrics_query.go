@@ -22,6 +22,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/common/expfmt"
@@ -53,6 +54,8 @@ type PipelineMetadataQuery struct {
 	watermarkFetchers map[v1alpha1.Edge][]fetch.HeadFetcher
 	rater             rater.Ratable
 	healthChecker     *HealthChecker
+	// backfilling is whether the pipeline is in backfill mode.
+	backfilling atomic.Bool
 }
 
 // NewPipelineMetadataQuery returns a new instance of pipelineMetadataQuery
@@ -83,6 +86,9 @@ func (ps *PipelineMetadataQuery) ListBuffers(ctx context.Context, req *daemon.Li
 	if err != nil {
 		return nil, err
 	}
+	for _, b := range resp.Buffers {
+		b.SecondsToDrain = ps.getBufferSecondsToDrain(b.GetBufferName(), b.GetPendingCount().GetValue())
+	}
 	return resp, nil
 }
 
@@ -102,15 +108,19 @@ func (ps *PipelineMetadataQuery) GetBuffer(ctx context.Context, req *daemon.GetB
 		usage = x
 	}
 	b := &daemon.BufferInfo{
-		Pipeline:         ps.pipeline.Name,
-		BufferName:       req.Buffer,
-		PendingCount:     wrapperspb.Int64(bufferInfo.PendingCount),
-		AckPendingCount:  wrapperspb.Int64(bufferInfo.AckPendingCount),
-		TotalMessages:    wrapperspb.Int64(bufferInfo.TotalMessages),
-		BufferLength:     wrapperspb.Int64(bufferLength),
-		BufferUsageLimit: wrapperspb.Double(bufferUsageLimit),
-		BufferUsage:      wrapperspb.Double(usage),
-		IsFull:           wrapperspb.Bool(usage >= bufferUsageLimit),
+		Pipeline:               ps.pipeline.Name,
+		BufferName:             req.Buffer,
+		PendingCount:           wrapperspb.Int64(bufferInfo.PendingCount),
+		AckPendingCount:        wrapperspb.Int64(bufferInfo.AckPendingCount),
+		TotalMessages:          wrapperspb.Int64(bufferInfo.TotalMessages),
+		BufferLength:           wrapperspb.Int64(bufferLength),
+		BufferUsageLimit:       wrapperspb.Double(bufferUsageLimit),
+		BufferUsage:            wrapperspb.Double(usage),
+		IsFull:                 wrapperspb.Bool(usage >= bufferUsageLimit),
+		MaxLength:              wrapperspb.Int64(bufferInfo.MaxLength),
+		UsagePercentage:        wrapperspb.Double(bufferInfo.UsagePercentage),
+		OldestMessageTimestamp: wrapperspb.Int64(oldestMessageTimestamp(bufferInfo)),
+		SecondsToDrain:         ps.getBufferSecondsToDrain(req.Buffer, bufferInfo.PendingCount),
 	}
 	resp := new(daemon.GetBufferResponse)
 	resp.Buffer = b
@@ -133,6 +143,17 @@ func (ps *PipelineMetadataQuery) GetVertexMetrics(ctx context.Context, req *daem
 	}
 	partitionPendingInfo := ps.getPending(ctx, req)
 	metricsArr := make([]*daemon.VertexMetrics, len(bufferList))
+	// the error rates are of the vertex, not of a partition
+	errorRates := ps.rater.GetErrorRates(req.GetVertex())
+	partitionRates := make([]map[string]*wrapperspb.DoubleValue, 0, len(bufferList))
+	var partitionInfos map[string]*isbsvc.BufferInfo
+	if !abstractVertex.IsASource() {
+		var err error
+		// the information of the partitions is fetched in one batch, the ones failed have no oldest pending age
+		if partitionInfos, err = getBuffersInfo(ctx, ps.isbSvcClient, bufferList); err != nil {
+			logging.FromContext(ctx).Debugw("Failed to get the buffer information", zap.Strings("buffers", bufferList), zap.Error(err))
+		}
+	}
 
 	for idx, partitionName := range bufferList {
 		vm := &daemon.VertexMetrics{
@@ -141,15 +162,94 @@ func (ps *PipelineMetadataQuery) GetVertexMetrics(ctx context.Context, req *daem
 		}
 		// get the processing rate for each partition
 		vm.ProcessingRates = ps.rater.GetRates(req.GetVertex(), partitionName)
+		partitionRates = append(partitionRates, vm.ProcessingRates)
+		vm.ErrorRates = errorRates
+		vm.AckRates = ps.rater.GetAckRates(req.GetVertex(), partitionName)
+		vm.ReadAckDivergences = getReadAckDivergences(vm.ProcessingRates, vm.AckRates)
+		vm.PodProcessingRates = ps.rater.GetPodRates(req.GetVertex(), partitionName)
+		vm.BurstRates = ps.rater.GetBurstRates(req.GetVertex(), partitionName)
 		partitionPending := partitionPendingInfo[partitionName]
+		// the pendings tracked by the rater are averaged over the same lookback seconds as the processing rates
+		averagePending := ps.rater.GetPendings(req.GetVertex(), partitionName)
+		if partitionPending == nil {
+			// fall back to the pendings tracked by the rater if the metrics endpoint of the vertex is not available
+			partitionPending = averagePending
+		}
 		vm.Pendings = partitionPending
+		// the lookback seconds whose seconds to drain are unknown or infinite are left out
+		vm.SecondsToDrain = make(map[string]*wrapperspb.DoubleValue)
+		for n, pending := range averagePending {
+			rate := rateNotAvailable
+			if r, ok := vm.ProcessingRates[n]; ok && r != nil {
+				rate = r.GetValue()
+			}
+			if e := drainTime(pending.GetValue(), rate); e.State == DrainEstimateKnown {
+				vm.SecondsToDrain[n] = wrapperspb.Double(e.Seconds)
+			}
+		}
+		if !abstractVertex.IsASource() {
+			vm.OldestPendingAge = getOldestPendingAge(partitionInfos[partitionName])
+		}
 		metricsArr[idx] = vm
 	}
+	errorRatios := getErrorRatios(errorRates, partitionRates)
+	for _, vm := range metricsArr {
+		vm.ErrorRatios = errorRatios
+	}
 
 	resp.VertexMetrics = metricsArr
 	return resp, nil
 }
 
+// getReadAckDivergences returns the share of the processing rates not matched by the ack rates of the same lookback
+// seconds, clamped to [0, 1] since the acks may include the control messages not counted by the processing rates. The
+// lookback seconds whose rate is not available, or whose processing rate is not positive, are left out.
+func getReadAckDivergences(rates map[string]*wrapperspb.DoubleValue, ackRates map[string]*wrapperspb.DoubleValue) map[string]*wrapperspb.DoubleValue {
+	result := make(map[string]*wrapperspb.DoubleValue)
+	for n, rate := range rates {
+		ackRate, ok := ackRates[n]
+		if !ok || ackRate.GetValue() < 0 || rate.GetValue() <= 0 {
+			continue
+		}
+		result[n] = wrapperspb.Double(min(max(1-ackRate.GetValue()/rate.GetValue(), 0), 1))
+	}
+	return result
+}
+
+// getErrorRatios returns the error rates over the processing rates summed up across the partitions of the same lookback
+// seconds, the lookback seconds whose error rate or any partition rate is not available, or whose total rate is not
+// positive, are left out.
+func getErrorRatios(errorRates map[string]*wrapperspb.DoubleValue, partitionRates []map[string]*wrapperspb.DoubleValue) map[string]*wrapperspb.DoubleValue {
+	result := make(map[string]*wrapperspb.DoubleValue)
+ratios:
+	for n, errorRate := range errorRates {
+		if errorRate.GetValue() < 0 {
+			continue
+		}
+		total := float64(0)
+		for _, rates := range partitionRates {
+			rate, ok := rates[n]
+			if !ok || rate.GetValue() < 0 {
+				continue ratios
+			}
+			total += rate.GetValue()
+		}
+		if total > 0 {
+			result[n] = wrapperspb.Double(errorRate.GetValue() / total)
+		}
+	}
+	return result
+}
+
+// getOldestPendingAge returns the age of the oldest pending message of the partition in milliseconds,
+// nil if the partition is empty or the information is not available.
+func getOldestPendingAge(bufferInfo *isbsvc.BufferInfo) *wrapperspb.Int64Value {
+	if bufferInfo == nil || bufferInfo.OldestPendingTime.IsZero() {
+		return nil
+	}
+	return wrapperspb.Int64(time.Since(bufferInfo.OldestPendingTime).Milliseconds())
+}
+
 // getPending returns the pending count for each partition of the vertex
 func (ps *PipelineMetadataQuery) getPending(ctx context.Context, req *daemon.GetVertexMetricsRequest) map[string]map[string]*wrapperspb.Int64Value {
 	vertexName := fmt.Sprintf("%s-%s", ps.pipeline.Name, req.GetVertex())
@@ -220,15 +320,25 @@ func (ps *PipelineMetadataQuery) getPending(ctx context.Context, req *daemon.Get
 
 func (ps *PipelineMetadataQuery) GetPipelineStatus(ctx context.Context, req *daemon.GetPipelineStatusRequest) (*daemon.GetPipelineStatusResponse, error) {
 	status := ps.healthChecker.getCurrentHealth()
+	message := status.Message
+	if ps.backfilling.Load() {
+		message = "Backfilling: " + message
+	}
 	resp := new(daemon.GetPipelineStatusResponse)
 	resp.Status = &daemon.PipelineStatus{
 		Status:  status.Status,
-		Message: status.Message,
+		Message: message,
 		Code:    status.Code,
 	}
+	resp.IsbSvcClusterHealth = newISBSvcClusterHealth(ps.healthChecker.getClusterHealth())
 	return resp, nil
 }
 
+// SetBackfilling sets whether the pipeline is in backfill mode, which is shown in the status of the pipeline.
+func (ps *PipelineMetadataQuery) SetBackfilling(backfilling bool) {
+	ps.backfilling.Store(backfilling)
+}
+
 func getBufferLimits(pl *v1alpha1.Pipeline, v v1alpha1.AbstractVertex) (bufferLength int64, bufferUsageLimit float64) {
 	plLimits := pl.GetPipelineLimits()
 	bufferLength = int64(*plLimits.BufferMaxLength)
@@ -244,6 +354,17 @@ func getBufferLimits(pl *v1alpha1.Pipeline, v v1alpha1.AbstractVertex) (bufferLe
 	return bufferLength, bufferUsageLimit
 }
 
+// getBuffersInfo gets the information of the buffers in one batch, keyed by the buffer names. On partial failures, the
+// information of the succeeded buffers is returned with the error.
+func getBuffersInfo(ctx context.Context, isbSvcClient isbsvc.ISBService, buffers []string) (map[string]*isbsvc.BufferInfo, error) {
+	infos, err := isbSvcClient.GetBuffersInfo(ctx, buffers)
+	result := make(map[string]*isbsvc.BufferInfo, len(infos))
+	for _, info := range infos {
+		result[info.Name] = info
+	}
+	return result, err
+}
+
 // listBuffers returns the list of ISB buffers for the pipeline and their information
 // We use the isbSvcClient to get the buffer information
 func listBuffers(ctx context.Context, pipeline *v1alpha1.Pipeline, isbSvcClient isbsvc.ISBService) (*daemon.ListBuffersResponse, error) {
@@ -251,11 +372,13 @@ func listBuffers(ctx context.Context, pipeline *v1alpha1.Pipeline, isbSvcClient
 	resp := new(daemon.ListBuffersResponse)
 
 	buffers := []*daemon.BufferInfo{}
-	for _, buffer := range pipeline.GetAllBuffers() {
-		bufferInfo, err := isbSvcClient.GetBufferInfo(ctx, buffer)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get information of buffer %q", buffer)
-		}
+	// the information of all the buffers is fetched in one batch, rather than one round trip per buffer
+	bufferInfos, err := isbSvcClient.GetBuffersInfo(ctx, pipeline.GetAllBuffers())
+	if err != nil {
+		return nil, err
+	}
+	for _, bufferInfo := range bufferInfos {
+		buffer := bufferInfo.Name
 		log.Debugf("Buffer %s has bufferInfo %+v", buffer, bufferInfo)
 		v := pipeline.FindVertexWithBuffer(buffer)
 		if v == nil {
@@ -267,15 +390,18 @@ func listBuffers(ctx context.Context, pipeline *v1alpha1.Pipeline, isbSvcClient
 			usage = x
 		}
 		b := &daemon.BufferInfo{
-			Pipeline:         pipeline.Name,
-			BufferName:       buffer,
-			PendingCount:     wrapperspb.Int64(bufferInfo.PendingCount),
-			AckPendingCount:  wrapperspb.Int64(bufferInfo.AckPendingCount),
-			TotalMessages:    wrapperspb.Int64(bufferInfo.TotalMessages),
-			BufferLength:     wrapperspb.Int64(bufferLength),
-			BufferUsageLimit: wrapperspb.Double(bufferUsageLimit),
-			BufferUsage:      wrapperspb.Double(usage),
-			IsFull:           wrapperspb.Bool(usage >= bufferUsageLimit),
+			Pipeline:               pipeline.Name,
+			BufferName:             buffer,
+			PendingCount:           wrapperspb.Int64(bufferInfo.PendingCount),
+			AckPendingCount:        wrapperspb.Int64(bufferInfo.AckPendingCount),
+			TotalMessages:          wrapperspb.Int64(bufferInfo.TotalMessages),
+			BufferLength:           wrapperspb.Int64(bufferLength),
+			BufferUsageLimit:       wrapperspb.Double(bufferUsageLimit),
+			BufferUsage:            wrapperspb.Double(usage),
+			IsFull:                 wrapperspb.Bool(usage >= bufferUsageLimit),
+			MaxLength:              wrapperspb.Int64(bufferInfo.MaxLength),
+			UsagePercentage:        wrapperspb.Double(bufferInfo.UsagePercentage),
+			OldestMessageTimestamp: wrapperspb.Int64(oldestMessageTimestamp(bufferInfo)),
 		}
 		buffers = append(buffers, b)
 	}
@@ -283,6 +409,58 @@ func listBuffers(ctx context.Context, pipeline *v1alpha1.Pipeline, isbSvcClient
 	return resp, nil
 }
 
+// getBufferSecondsToDrain returns the seconds to drain the pending messages of the buffer at the default processing
+// rate of the vertex reading from it, nil if it can not be derived.
+func (ps *PipelineMetadataQuery) getBufferSecondsToDrain(buffer string, pending int64) *wrapperspb.DoubleValue {
+	v := ps.pipeline.FindVertexWithBuffer(buffer)
+	if v == nil || ps.rater == nil {
+		return nil
+	}
+	rate := rateNotAvailable
+	if r, ok := ps.rater.GetRates(v.Name, buffer)["default"]; ok && r != nil {
+		rate = r.GetValue()
+	}
+	if e := drainTime(pending, rate); e.State == DrainEstimateKnown {
+		return wrapperspb.Double(e.Seconds)
+	}
+	return nil
+}
+
+// oldestMessageTimestamp returns the write time of the oldest message of the buffer in milliseconds, -1 if it's unknown.
+func oldestMessageTimestamp(bufferInfo *isbsvc.BufferInfo) int64 {
+	if bufferInfo.OldestMessageTime.IsZero() {
+		return -1
+	}
+	return bufferInfo.OldestMessageTime.UnixMilli()
+}
+
+// GetCriticalBuffers returns the buffers whose usage is in the critical state.
+func (ps *PipelineMetadataQuery) GetCriticalBuffers() []string {
+	return ps.healthChecker.getCriticalBuffers()
+}
+
+// newISBSvcClusterHealth converts the health of the ISB Service cluster to its protobuf representation, it's nil if
+// the ISB Service doesn't report the cluster health.
+func newISBSvcClusterHealth(health *isbsvc.ClusterHealth) *daemon.ISBSvcClusterHealth {
+	if health == nil {
+		return nil
+	}
+	resp := &daemon.ISBSvcClusterHealth{
+		Available: health.Available,
+		Message:   health.Message,
+	}
+	for _, s := range health.Streams {
+		resp.Streams = append(resp.Streams, &daemon.StreamHealth{
+			Name:            s.Name,
+			Leader:          s.Leader,
+			HasLeader:       s.HasLeader,
+			Replicas:        int32(s.Replicas),
+			CurrentReplicas: int32(s.CurrentReplicas),
+		})
+	}
+	return resp
+}
+
 // StartHealthCheck starts the health check for the pipeline using the health checker
 func (ps *PipelineMetadataQuery) StartHealthCheck(ctx context.Context) {
 	ps.healthChecker.startHealthCheck(ctx)
@@ -22,6 +22,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
@@ -55,21 +56,72 @@ func (ms *mockIsbSvcClient) GetBufferInfo(ctx context.Context, buffer string) (*
 		PendingCount:    10,
 		AckPendingCount: 15,
 		TotalMessages:   20,
+		MaxLength:       100,
+		UsagePercentage: 20,
 	}, nil
 }
 
-func (ms *mockIsbSvcClient) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...isbsvc.CreateOption) error {
+func (ms *mockIsbSvcClient) GetBuffersInfo(ctx context.Context, buffers []string) ([]*isbsvc.BufferInfo, error) {
+	var infos []*isbsvc.BufferInfo
+	for _, buffer := range buffers {
+		info, _ := ms.GetBufferInfo(ctx, buffer)
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (ms *mockIsbSvcClient) WatchPartitionInfo(ctx context.Context, partition string) (<-chan *isbsvc.BufferInfo, error) {
+	info, _ := ms.GetBufferInfo(ctx, partition)
+	ch := make(chan *isbsvc.BufferInfo, 1)
+	ch <- info
+	close(ch)
+	return ch, nil
+}
+
+func (ms *mockIsbSvcClient) ReadBufferHistory(ctx context.Context, buffer string, from isbsvc.HistoryPosition, fn func(*isbsvc.HistoryMessage) bool) error {
+	return nil
+}
+
+func (ms *mockIsbSvcClient) PeekMessages(ctx context.Context, partition, offset string, count int) ([]*isbsvc.HistoryMessage, error) {
+	return nil, nil
+}
+
+func (ms *mockIsbSvcClient) PurgePartition(ctx context.Context, partition, upToOffset string, opts ...isbsvc.PurgeOption) error {
+	return nil
+}
+
+func (ms *mockIsbSvcClient) PurgeBuffers(ctx context.Context, buffers []string, opts ...isbsvc.PurgeOption) error {
+	return nil
+}
+
+func (ms *mockIsbSvcClient) ResizePartition(ctx context.Context, partition string, newBufferLength int64) error {
+	return nil
+}
+
+func (ms *mockIsbSvcClient) PausePartition(ctx context.Context, partition string) error {
+	return nil
+}
+
+func (ms *mockIsbSvcClient) ResumePartition(ctx context.Context, partition string) error {
 	return nil
 }
 
-func (ms *mockIsbSvcClient) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string) error {
+func (ms *mockIsbSvcClient) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...isbsvc.CreateOption) error {
 	return nil
 }
 
-func (ms *mockIsbSvcClient) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string) error {
+func (ms *mockIsbSvcClient) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...isbsvc.DeleteOption) (*isbsvc.DeleteReport, error) {
+	return &isbsvc.DeleteReport{}, nil
+}
+
+func (ms *mockIsbSvcClient) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...isbsvc.CreateOption) error {
 	return nil
 }
 
+func (ms *mockIsbSvcClient) ListBuffersAndBuckets(ctx context.Context, prefix string) ([]isbsvc.ItemInfo, error) {
+	return nil, nil
+}
+
 func (ms *mockIsbSvcClient) CreateWatermarkStores(ctx context.Context, bucketName string, partitions int, isReduce bool) ([]store.WatermarkStore, error) {
 	return nil, nil
 }
@@ -91,6 +143,50 @@ func (mr *mockRater_TestGetVertexMetrics) GetRates(vertexName string, partitionN
 	return res
 }
 
+func (mr *mockRater_TestGetVertexMetrics) GetPodRates(vertexName string, partitionName string) map[string]*wrapperspb.DoubleValue {
+	res := make(map[string]*wrapperspb.DoubleValue)
+	res[vertexName+"-0"] = wrapperspb.Double(2.5)
+	res[vertexName+"-1"] = wrapperspb.Double(2.394736842105263)
+	return res
+}
+
+func (mr *mockRater_TestGetVertexMetrics) GetBurstRates(vertexName string, partitionName string) map[string]*wrapperspb.DoubleValue {
+	res := make(map[string]*wrapperspb.DoubleValue)
+	res["ema"] = wrapperspb.Double(5.2)
+	res["p50"] = wrapperspb.Double(4.8)
+	res["p95"] = wrapperspb.Double(9.5)
+	return res
+}
+
+func (mr *mockRater_TestGetVertexMetrics) GetVertexPodRates(vertexName string) map[string]*wrapperspb.DoubleValue {
+	return nil
+}
+
+func (mr *mockRater_TestGetVertexMetrics) GetPendings(vertexName string, partitionName string) map[string]*wrapperspb.Int64Value {
+	res := make(map[string]*wrapperspb.Int64Value)
+	res["default"] = wrapperspb.Int64(0)
+	res["1m"] = wrapperspb.Int64(61)
+	res["5m"] = wrapperspb.Int64(-1)
+	res["15m"] = wrapperspb.Int64(49)
+	return res
+}
+
+func (mr *mockRater_TestGetVertexMetrics) GetErrorRates(vertexName string) map[string]*wrapperspb.DoubleValue {
+	res := make(map[string]*wrapperspb.DoubleValue)
+	res["default"] = wrapperspb.Double(0.4894736842105263)
+	res["1m"] = wrapperspb.Double(0)
+	res["5m"] = wrapperspb.Double(-1)
+	return res
+}
+
+func (mr *mockRater_TestGetVertexMetrics) GetAckRates(vertexName string, partitionName string) map[string]*wrapperspb.DoubleValue {
+	res := make(map[string]*wrapperspb.DoubleValue)
+	res["default"] = wrapperspb.Double(4.894736842105263)
+	res["1m"] = wrapperspb.Double(0)
+	res["5m"] = wrapperspb.Double(-1)
+	return res
+}
+
 func TestGetVertexMetrics(t *testing.T) {
 	s := test.RunJetStreamServer(t)
 	defer test.ShutdownJetStreamServer(t, s)
@@ -146,12 +242,81 @@ vertex_pending_messages{period="default",partition_name="-simple-pipeline-cat-0"
 	processingRates["default"] = wrapperspb.Double(4.894736842105263)
 	assert.Equal(t, resp.VertexMetrics[0].GetProcessingRates(), processingRates)
 
+	podProcessingRates := make(map[string]*wrapperspb.DoubleValue)
+	podProcessingRates["cat-0"] = wrapperspb.Double(2.5)
+	podProcessingRates["cat-1"] = wrapperspb.Double(2.394736842105263)
+	assert.Equal(t, resp.VertexMetrics[0].GetPodProcessingRates(), podProcessingRates)
+
+	burstRates := make(map[string]*wrapperspb.DoubleValue)
+	burstRates["ema"] = wrapperspb.Double(5.2)
+	burstRates["p50"] = wrapperspb.Double(4.8)
+	burstRates["p95"] = wrapperspb.Double(9.5)
+	assert.Equal(t, resp.VertexMetrics[0].GetBurstRates(), burstRates)
+
 	pendings := make(map[string]*wrapperspb.Int64Value)
 	pendings["15m"] = wrapperspb.Int64(4)
 	pendings["1m"] = wrapperspb.Int64(5)
 	pendings["5m"] = wrapperspb.Int64(6)
 	pendings["default"] = wrapperspb.Int64(7)
 	assert.Equal(t, resp.VertexMetrics[0].GetPendings(), pendings)
+	// the seconds to drain are derived from the pendings and rates of the rater, the 5m pending is not available.
+	secondsToDrain := resp.VertexMetrics[0].GetSecondsToDrain()
+	assert.Len(t, secondsToDrain, 3)
+	assert.Equal(t, float64(0), secondsToDrain["default"].GetValue())
+	assert.InDelta(t, 11.9967, secondsToDrain["1m"].GetValue(), 0.0001)
+	assert.InDelta(t, 10.0108, secondsToDrain["15m"].GetValue(), 0.0001)
+	// the buffer does not exist, so the age of the oldest pending message is not available.
+	assert.Nil(t, resp.VertexMetrics[0].GetOldestPendingAge())
+	assert.Len(t, resp.VertexMetrics[0].GetErrorRates(), 3)
+	// the 5m error rate is not available, and there is no 15m error rate.
+	errorRatios := resp.VertexMetrics[0].GetErrorRatios()
+	assert.Len(t, errorRatios, 2)
+	assert.InDelta(t, 0.1, errorRatios["default"].GetValue(), 1e-9)
+	assert.Equal(t, float64(0), errorRatios["1m"].GetValue())
+	assert.Len(t, resp.VertexMetrics[0].GetAckRates(), 3)
+	// everything read is acknowledged in the default lookback seconds, nothing in 1m.
+	readAckDivergences := resp.VertexMetrics[0].GetReadAckDivergences()
+	assert.Len(t, readAckDivergences, 2)
+	assert.Equal(t, float64(0), readAckDivergences["default"].GetValue())
+	assert.Equal(t, float64(1), readAckDivergences["1m"].GetValue())
+}
+
+func TestGetReadAckDivergences(t *testing.T) {
+	rates := map[string]*wrapperspb.DoubleValue{
+		"default": wrapperspb.Double(10),
+		"1m":      wrapperspb.Double(10),
+		"5m":      wrapperspb.Double(0),
+		"15m":     wrapperspb.Double(-1),
+		"10m":     wrapperspb.Double(10),
+	}
+	ackRates := map[string]*wrapperspb.DoubleValue{
+		"default": wrapperspb.Double(2.5),
+		"1m":      wrapperspb.Double(10.5),
+		"5m":      wrapperspb.Double(0),
+		"15m":     wrapperspb.Double(1),
+	}
+	divergences := getReadAckDivergences(rates, ackRates)
+	assert.Len(t, divergences, 2)
+	assert.InDelta(t, 0.75, divergences["default"].GetValue(), 1e-9)
+	// the acks of the control messages are ahead of the reads
+	assert.Equal(t, float64(0), divergences["1m"].GetValue())
+}
+
+func TestGetErrorRatios(t *testing.T) {
+	errorRates := map[string]*wrapperspb.DoubleValue{
+		"default": wrapperspb.Double(3),
+		"1m":      wrapperspb.Double(3),
+		"5m":      wrapperspb.Double(-1),
+		"15m":     wrapperspb.Double(1),
+	}
+	partitionRates := []map[string]*wrapperspb.DoubleValue{
+		{"default": wrapperspb.Double(10), "1m": wrapperspb.Double(0), "5m": wrapperspb.Double(10), "15m": wrapperspb.Double(-1)},
+		{"default": wrapperspb.Double(20), "1m": wrapperspb.Double(0), "5m": wrapperspb.Double(10), "15m": wrapperspb.Double(10)},
+	}
+	// nothing is processed in 1m, the 5m error rate and a 15m partition rate are not available.
+	ratios := getErrorRatios(errorRates, partitionRates)
+	assert.Len(t, ratios, 1)
+	assert.InDelta(t, 0.1, ratios["default"].GetValue(), 1e-9)
 }
 
 func TestGetBuffer(t *testing.T) {
@@ -188,6 +353,10 @@ func TestGetBuffer(t *testing.T) {
 	resp, err := pipelineMetricsQueryService.GetBuffer(context.Background(), req)
 	assert.NoError(t, err)
 	assert.Equal(t, resp.Buffer.BufferUsage.GetValue(), 0.0006666666666666666)
+	assert.Equal(t, int64(100), resp.Buffer.GetMaxLength().GetValue())
+	assert.Equal(t, 20.0, resp.Buffer.GetUsagePercentage().GetValue())
+	// the buffer has no oldest message time
+	assert.Equal(t, int64(-1), resp.Buffer.GetOldestMessageTimestamp().GetValue())
 }
 
 func TestListBuffers(t *testing.T) {
@@ -227,4 +396,66 @@ func TestListBuffers(t *testing.T) {
 	resp, err := pipelineMetricsQueryService.ListBuffers(context.Background(), req)
 	assert.NoError(t, err)
 	assert.Equal(t, len(resp.Buffers), 2)
+	for _, b := range resp.Buffers {
+		assert.Equal(t, int64(100), b.GetMaxLength().GetValue())
+		assert.Equal(t, 20.0, b.GetUsagePercentage().GetValue())
+	}
+}
+
+func TestOldestMessageTimestamp(t *testing.T) {
+	assert.Equal(t, int64(-1), oldestMessageTimestamp(&isbsvc.BufferInfo{}))
+	assert.Equal(t, int64(1700000000000), oldestMessageTimestamp(&isbsvc.BufferInfo{OldestMessageTime: time.UnixMilli(1700000000000)}))
+}
+
+func TestGetPipelineStatus_Backfilling(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simple-pipeline",
+			Namespace: "numaflow-system",
+		},
+	}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil)
+	assert.NoError(t, err)
+
+	req := &daemon.GetPipelineStatusRequest{Pipeline: "simple-pipeline"}
+	resp, err := pipelineMetricsQueryService.GetPipelineStatus(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultDataHealthResponse.Message, resp.Status.Message)
+
+	pipelineMetricsQueryService.SetBackfilling(true)
+	resp, err = pipelineMetricsQueryService.GetPipelineStatus(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Backfilling: "+defaultDataHealthResponse.Message, resp.Status.Message)
+	assert.Equal(t, defaultDataHealthResponse.Status, resp.Status.Status)
+}
+
+func TestGetPipelineStatus_ISBSvcClusterHealth(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simple-pipeline",
+			Namespace: "numaflow-system",
+		},
+	}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, pipeline, nil, nil)
+	assert.NoError(t, err)
+
+	req := &daemon.GetPipelineStatusRequest{Pipeline: "simple-pipeline"}
+	resp, err := pipelineMetricsQueryService.GetPipelineStatus(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Nil(t, resp.IsbSvcClusterHealth)
+
+	pipelineMetricsQueryService.healthChecker.setClusterHealth(&isbsvc.ClusterHealth{
+		Available: true,
+		Streams:   []isbsvc.StreamHealth{{Name: "buffer-0", Leader: "js-1", HasLeader: true, Replicas: 3, CurrentReplicas: 2}},
+	})
+	resp, err = pipelineMetricsQueryService.GetPipelineStatus(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.IsbSvcClusterHealth.GetAvailable())
+	assert.Len(t, resp.IsbSvcClusterHealth.GetStreams(), 1)
+	s := resp.IsbSvcClusterHealth.GetStreams()[0]
+	assert.Equal(t, "buffer-0", s.GetName())
+	assert.Equal(t, "js-1", s.GetLeader())
+	assert.True(t, s.GetHasLeader())
+	assert.Equal(t, int32(3), s.GetReplicas())
+	assert.Equal(t, int32(2), s.GetCurrentReplicas())
 }
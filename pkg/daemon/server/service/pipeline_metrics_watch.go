@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
+)
+
+// defaultWatchInterval is the interval between the updates of WatchVertexMetrics, it's the window the processing rates
+// are calculated in, so a shorter interval mostly repeats the same rates.
+const defaultWatchInterval = rater.CountWindow
+
+// WatchVertexMetrics sends the metrics of the watched vertices right away, then every interval until the client goes away.
+func (ps *PipelineMetadataQuery) WatchVertexMetrics(req *daemon.WatchVertexMetricsRequest, stream daemon.DaemonService_WatchVertexMetricsServer) error {
+	var vertices []string
+	if req.GetVertex() != "" {
+		if ps.pipeline.GetVertex(req.GetVertex()) == nil {
+			return fmt.Errorf("vertex %q not found in pipeline %q", req.GetVertex(), ps.pipeline.Name)
+		}
+		vertices = append(vertices, req.GetVertex())
+	} else {
+		for _, v := range ps.pipeline.Spec.Vertices {
+			vertices = append(vertices, v.Name)
+		}
+	}
+	interval := defaultWatchInterval
+	if req.GetIntervalSeconds() > 0 {
+		interval = time.Duration(req.GetIntervalSeconds()) * time.Second
+	}
+	ctx := stream.Context()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, vertex := range vertices {
+			resp, err := ps.GetVertexMetrics(ctx, &daemon.GetVertexMetricsRequest{Pipeline: ps.pipeline.Name, Vertex: vertex})
+			if err != nil {
+				return fmt.Errorf("failed to get the metrics of vertex %q, %w", vertex, err)
+			}
+			if err := stream.Send(summarizeVertexMetrics(ps.pipeline.Name, vertex, time.Now(), resp.VertexMetrics)); err != nil {
+				return fmt.Errorf("failed to send the metrics of vertex %q, %w", vertex, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// summarizeVertexMetrics sums up the processing rates and the pendings of the partitions of a vertex. A lookback seconds
+// is left out if the rate or the pending of any partition is not available, i.e. absent or negative.
+func summarizeVertexMetrics(pipeline, vertex string, now time.Time, partitions []*daemon.VertexMetrics) *daemon.WatchVertexMetricsResponse {
+	resp := &daemon.WatchVertexMetricsResponse{
+		Pipeline:        pipeline,
+		Vertex:          vertex,
+		Timestamp:       wrapperspb.Int64(now.UnixMilli()),
+		ProcessingRates: make(map[string]*wrapperspb.DoubleValue),
+		Pendings:        make(map[string]*wrapperspb.Int64Value),
+		Partitions:      partitions,
+	}
+	if len(partitions) == 0 {
+		return resp
+	}
+rates:
+	for n := range partitions[0].GetProcessingRates() {
+		var total float64
+		for _, p := range partitions {
+			r, ok := p.GetProcessingRates()[n]
+			if !ok || r.GetValue() < 0 {
+				continue rates
+			}
+			total += r.GetValue()
+		}
+		resp.ProcessingRates[n] = wrapperspb.Double(total)
+	}
+pendings:
+	for n := range partitions[0].GetPendings() {
+		var total int64
+		for _, p := range partitions {
+			pending, ok := p.GetPendings()[n]
+			if !ok || pending.GetValue() < 0 {
+				continue pendings
+			}
+			total += pending.GetValue()
+		}
+		resp.Pendings[n] = wrapperspb.Int64(total)
+	}
+	return resp
+}
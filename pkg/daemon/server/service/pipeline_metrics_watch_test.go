@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+// mockWatchVertexMetricsServer collects the responses sent, and cancels the stream after the given number of them.
+type mockWatchVertexMetricsServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	cancel    context.CancelFunc
+	limit     int
+	responses []*daemon.WatchVertexMetricsResponse
+}
+
+func (m *mockWatchVertexMetricsServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchVertexMetricsServer) Send(resp *daemon.WatchVertexMetricsResponse) error {
+	m.responses = append(m.responses, resp)
+	if len(m.responses) >= m.limit {
+		m.cancel()
+	}
+	return nil
+}
+
+func TestWatchVertexMetrics(t *testing.T) {
+	ms := &mockIsbSvcClient{}
+	mr := &mockRater_TestGetPipelineDrainEstimate{rates: map[string]float64{catBuffer: 2, outBuffer: 4}}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, threeVertexPipeline(), nil, mr)
+	assert.NoError(t, err)
+	pipelineMetricsQueryService.httpClient = &mockHttpClient{
+		MockGet: func(url string) (*http.Response, error) {
+			return nil, errors.New("not reachable")
+		},
+	}
+
+	t.Run("all the vertices", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := &mockWatchVertexMetricsServer{ctx: ctx, cancel: cancel, limit: 3}
+		assert.NoError(t, pipelineMetricsQueryService.WatchVertexMetrics(&daemon.WatchVertexMetricsRequest{Pipeline: "pl"}, stream))
+		assert.Len(t, stream.responses, 3)
+		for i, v := range []string{"in", "cat", "out"} {
+			assert.Equal(t, v, stream.responses[i].GetVertex())
+			assert.Positive(t, stream.responses[i].GetTimestamp().GetValue())
+		}
+		assert.Equal(t, 2.0, stream.responses[1].GetProcessingRates()["default"].GetValue())
+		assert.Equal(t, 4.0, stream.responses[2].GetProcessingRates()["default"].GetValue())
+	})
+
+	t.Run("one vertex every interval", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := &mockWatchVertexMetricsServer{ctx: ctx, cancel: cancel, limit: 2}
+		start := time.Now()
+		assert.NoError(t, pipelineMetricsQueryService.WatchVertexMetrics(&daemon.WatchVertexMetricsRequest{Pipeline: "pl", Vertex: "cat", IntervalSeconds: 1}, stream))
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Len(t, stream.responses, 2)
+		assert.Equal(t, "cat", stream.responses[1].GetVertex())
+	})
+
+	t.Run("unknown vertex", func(t *testing.T) {
+		stream := &mockWatchVertexMetricsServer{ctx: context.Background(), cancel: func() {}, limit: 1}
+		assert.Error(t, pipelineMetricsQueryService.WatchVertexMetrics(&daemon.WatchVertexMetricsRequest{Pipeline: "pl", Vertex: "dog"}, stream))
+		assert.Empty(t, stream.responses)
+	})
+}
+
+func TestSummarizeVertexMetrics(t *testing.T) {
+	now := time.UnixMilli(1620000000000)
+	partitions := []*daemon.VertexMetrics{
+		{
+			ProcessingRates: map[string]*wrapperspb.DoubleValue{"default": wrapperspb.Double(1.5), "1m": wrapperspb.Double(2), "5m": wrapperspb.Double(-1)},
+			Pendings:        map[string]*wrapperspb.Int64Value{"default": wrapperspb.Int64(10), "1m": wrapperspb.Int64(-1)},
+		},
+		{
+			ProcessingRates: map[string]*wrapperspb.DoubleValue{"default": wrapperspb.Double(2.5), "5m": wrapperspb.Double(3)},
+			Pendings:        map[string]*wrapperspb.Int64Value{"default": wrapperspb.Int64(5), "1m": wrapperspb.Int64(7)},
+		},
+	}
+	resp := summarizeVertexMetrics("pl", "cat", now, partitions)
+	assert.Equal(t, int64(1620000000000), resp.GetTimestamp().GetValue())
+	// 1m is absent in the second partition, and 5m is not available in the first one
+	assert.Equal(t, map[string]*wrapperspb.DoubleValue{"default": wrapperspb.Double(4)}, resp.GetProcessingRates())
+	assert.Equal(t, map[string]*wrapperspb.Int64Value{"default": wrapperspb.Int64(15)}, resp.GetPendings())
+	assert.Equal(t, partitions, resp.GetPartitions())
+
+	empty := summarizeVertexMetrics("pl", "cat", now, nil)
+	assert.Empty(t, empty.GetProcessingRates())
+	assert.Empty(t, empty.GetPendings())
+}
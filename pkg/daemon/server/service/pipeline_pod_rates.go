@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+// slowPodRateRatio is the ratio of the median rate of the pods of a vertex below which a pod is considered slow.
+const slowPodRateRatio = 0.5
+
+// PodRateState tells how the processing rate of a pod compares with the other pods of the vertex.
+type PodRateState string
+
+const (
+	// PodRateNormal means the pod processes at least half as fast as the median pod.
+	PodRateNormal PodRateState = "Normal"
+	// PodRateSlow means the pod processes less than half as fast as the median pod.
+	PodRateSlow PodRateState = "Slow"
+	// PodRateStuck means the pod does not process anything while the median pod does.
+	PodRateStuck PodRateState = "Stuck"
+)
+
+// PodRate is the processing rate of a pod, summed up across the partitions it reads from.
+type PodRate struct {
+	Pod   string       `json:"pod"`
+	Rate  float64      `json:"rate"`
+	State PodRateState `json:"state"`
+}
+
+// VertexPodRates is the processing rates of the pods of a vertex over its lookback seconds.
+type VertexPodRates struct {
+	Pipeline        string `json:"pipeline"`
+	Vertex          string `json:"vertex"`
+	LookbackSeconds int    `json:"lookbackSeconds"`
+	// MedianRate is the median of the rates of the pods, the pods are compared with it.
+	MedianRate float64   `json:"medianRate"`
+	Pods       []PodRate `json:"pods"`
+}
+
+// GetVertexPodRates returns the processing rates of the pods of a vertex over its lookback seconds, so that the slow or
+// stuck pods can be spotted, a pod is slow if its rate is less than half of the median rate of the pods, and stuck if
+// it's zero while the median rate is not. The pods are sorted by the rate, the slowest first.
+func (ps *PipelineMetadataQuery) GetVertexPodRates(ctx context.Context, req *daemon.GetVertexPodRatesRequest) (*daemon.GetVertexPodRatesResponse, error) {
+	vertexName := req.GetVertex()
+	vertex := ps.pipeline.GetVertex(vertexName)
+	if vertex == nil {
+		return nil, fmt.Errorf("vertex %q not found", vertexName)
+	}
+	rates := make(map[string]float64)
+	for podName, rate := range ps.rater.GetVertexPodRates(vertexName) {
+		rates[podName] = rate.GetValue()
+	}
+	result := classifyPodRates(rates)
+	result.Pipeline = ps.pipeline.Name
+	result.Vertex = vertexName
+	result.LookbackSeconds = vertex.Scale.GetLookbackSeconds()
+	return &daemon.GetVertexPodRatesResponse{PodRates: result.toProto()}, nil
+}
+
+// classifyPodRates compares the rates of the pods, keyed by the pod name, with their median rate.
+func classifyPodRates(rates map[string]float64) *VertexPodRates {
+	result := &VertexPodRates{Pods: []PodRate{}}
+	if len(rates) == 0 {
+		return result
+	}
+	for podName, rate := range rates {
+		result.Pods = append(result.Pods, PodRate{Pod: podName, Rate: rate, State: PodRateNormal})
+	}
+	slices.SortFunc(result.Pods, func(a, b PodRate) int {
+		return cmp.Or(cmp.Compare(a.Rate, b.Rate), strings.Compare(a.Pod, b.Pod))
+	})
+	n := len(result.Pods)
+	result.MedianRate = (result.Pods[(n-1)/2].Rate + result.Pods[n/2].Rate) / 2
+	for i := range result.Pods {
+		switch {
+		case result.MedianRate <= 0:
+		case result.Pods[i].Rate <= 0:
+			result.Pods[i].State = PodRateStuck
+		case result.Pods[i].Rate < result.MedianRate*slowPodRateRatio:
+			result.Pods[i].State = PodRateSlow
+		}
+	}
+	return result
+}
+
+// toProto returns the processing rates of the pods in the response of the daemon service.
+func (r *VertexPodRates) toProto() *daemon.VertexPodRates {
+	result := &daemon.VertexPodRates{
+		Pipeline:        r.Pipeline,
+		Vertex:          r.Vertex,
+		LookbackSeconds: int32(r.LookbackSeconds),
+		MedianRate:      wrapperspb.Double(r.MedianRate),
+	}
+	for _, p := range r.Pods {
+		result.Pods = append(result.Pods, &daemon.PodRate{Pod: p.Pod, Rate: wrapperspb.Double(p.Rate), State: string(p.State)})
+	}
+	return result
+}
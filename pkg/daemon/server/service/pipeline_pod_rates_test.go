@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+)
+
+func TestClassifyPodRates(t *testing.T) {
+	tests := []struct {
+		name       string
+		rates      map[string]float64
+		wantMedian float64
+		want       []PodRate
+	}{
+		{
+			name:  "no pods",
+			rates: map[string]float64{},
+			want:  []PodRate{},
+		},
+		{
+			name:       "single idle pod",
+			rates:      map[string]float64{"v-0": 0},
+			wantMedian: 0,
+			want:       []PodRate{{Pod: "v-0", Rate: 0, State: PodRateNormal}},
+		},
+		{
+			name:       "slow and stuck pods",
+			rates:      map[string]float64{"v-0": 100, "v-1": 90, "v-2": 40, "v-3": 0, "v-4": 110},
+			wantMedian: 90,
+			want: []PodRate{
+				{Pod: "v-3", Rate: 0, State: PodRateStuck},
+				{Pod: "v-2", Rate: 40, State: PodRateSlow},
+				{Pod: "v-1", Rate: 90, State: PodRateNormal},
+				{Pod: "v-0", Rate: 100, State: PodRateNormal},
+				{Pod: "v-4", Rate: 110, State: PodRateNormal},
+			},
+		},
+		{
+			name:       "even number of pods",
+			rates:      map[string]float64{"v-0": 10, "v-1": 30, "v-2": 10, "v-3": 4},
+			wantMedian: 10,
+			want: []PodRate{
+				{Pod: "v-3", Rate: 4, State: PodRateSlow},
+				{Pod: "v-0", Rate: 10, State: PodRateNormal},
+				{Pod: "v-2", Rate: 10, State: PodRateNormal},
+				{Pod: "v-1", Rate: 30, State: PodRateNormal},
+			},
+		},
+		{
+			name:       "most pods idle",
+			rates:      map[string]float64{"v-0": 0, "v-1": 0, "v-2": 5},
+			wantMedian: 0,
+			want: []PodRate{
+				{Pod: "v-0", Rate: 0, State: PodRateNormal},
+				{Pod: "v-1", Rate: 0, State: PodRateNormal},
+				{Pod: "v-2", Rate: 5, State: PodRateNormal},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyPodRates(tt.rates)
+			assert.Equal(t, tt.wantMedian, got.MedianRate)
+			assert.Equal(t, tt.want, got.Pods)
+		})
+	}
+}
+
+func TestGetVertexPodRates(t *testing.T) {
+	ms := &mockIsbSvcClient{}
+	mr := &mockRater_TestGetPipelineDrainEstimate{}
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(ms, threeVertexPipeline(), nil, mr)
+	assert.NoError(t, err)
+	resp, err := pipelineMetricsQueryService.GetVertexPodRates(context.Background(), &daemon.GetVertexPodRatesRequest{Pipeline: "pl", Vertex: "cat"})
+	assert.NoError(t, err)
+	assert.Equal(t, "cat", resp.GetPodRates().GetVertex())
+	assert.Equal(t, int32(120), resp.GetPodRates().GetLookbackSeconds())
+	assert.Empty(t, resp.GetPodRates().GetPods())
+	_, err = pipelineMetricsQueryService.GetVertexPodRates(context.Background(), &daemon.GetVertexPodRatesRequest{Pipeline: "pl", Vertex: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestVertexPodRatesToProto(t *testing.T) {
+	rates := classifyPodRates(map[string]float64{"p-0": 0, "p-1": 10, "p-2": 12})
+	rates.Pipeline, rates.Vertex, rates.LookbackSeconds = "pl", "cat", 60
+	got := rates.toProto()
+	assert.Equal(t, "pl", got.GetPipeline())
+	assert.Equal(t, int32(60), got.GetLookbackSeconds())
+	assert.Equal(t, 10.0, got.GetMedianRate().GetValue())
+	assert.Len(t, got.GetPods(), 3)
+	assert.Equal(t, "p-0", got.GetPods()[0].GetPod())
+	assert.Equal(t, 0.0, got.GetPods()[0].GetRate().GetValue())
+	assert.Equal(t, string(PodRateStuck), got.GetPods()[0].GetState())
+}
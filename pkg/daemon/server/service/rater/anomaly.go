@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rater
+
+import (
+	"time"
+
+	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
+)
+
+// AnomalyKind is the kind of anomaly in the processing of a vertex partition.
+type AnomalyKind string
+
+const (
+	// AnomalyRateDrop is the processing rate dropping suddenly below its trailing average.
+	AnomalyRateDrop AnomalyKind = "RateDrop"
+	// AnomalyBacklogGrowth is the pending messages growing window after window.
+	AnomalyBacklogGrowth AnomalyKind = "BacklogGrowth"
+)
+
+const (
+	// anomalyDuration is how long an anomaly lasts before it's reported, i.e., the consecutive count windows in it.
+	anomalyDuration = time.Minute
+	// trailingDuration is the time right before the anomaly windows, the trailing average rate is calculated over.
+	trailingDuration = 5 * time.Minute
+	// rateDropRatio is the ratio of the trailing average rate, the rate of a window below which is a drop.
+	rateDropRatio = 0.5
+	// minTrailingRate is the trailing average rate below which the drops are not reported, the rate of a vertex barely
+	// processing anything is not expected to be steady.
+	minTrailingRate = 1.0
+	// backlogGrowthRatio is the ratio the pending grows by across the anomaly windows for it to be reported.
+	backlogGrowthRatio = 2.0
+	// minBacklog is the pending below which the growth is not reported.
+	minBacklog = 100
+)
+
+// Anomaly is an anomaly detected in the processing of a vertex partition.
+type Anomaly struct {
+	Kind      AnomalyKind
+	Vertex    string
+	Partition string
+	// Current is the rate of the last complete window for a rate drop, or the latest pending for a backlog growth.
+	Current float64
+	// Baseline is the trailing average rate for a rate drop, or the pending before it starts growing for a backlog
+	// growth.
+	Baseline float64
+	// Duration is how long the anomaly has lasted.
+	Duration time.Duration
+}
+
+// DetectAnomalies checks the processing rates and the pendings of all the vertex partitions for the anomalies, i.e.,
+// the rate staying below rateDropRatio of its trailing average, or the pending growing, in each of the complete count
+// windows of the last anomalyDuration.
+func (r *Rater) DetectAnomalies() []Anomaly {
+	var result []Anomaly
+	now := r.now()
+	for _, v := range r.pipeline.Spec.Vertices {
+		partitions := v.OwnedBufferNames(r.pipeline.Namespace, r.pipeline.Name)
+		// source vertex will have a single partition, which is the vertex name itself
+		if v.IsASource() {
+			partitions = append(partitions, v.Name)
+		}
+		for _, partitionName := range partitions {
+			if a, ok := DetectRateDrop(r.timestampedPodCounts[v.Name], partitionName, r.options.countWindow, now); ok {
+				a.Vertex = v.Name
+				result = append(result, a)
+			}
+			if a, ok := DetectBacklogGrowth(r.timestampedPodPendings[v.Name], partitionName, r.options.countWindow, now); ok {
+				a.Vertex = v.Name
+				result = append(result, a)
+			}
+		}
+	}
+	return result
+}
+
+// DetectRateDrop detects the rate of the vertex partition dropping below rateDropRatio of the trailing average rate in
+// each of the complete windows of the last anomalyDuration. The trailing average is over the windows of the
+// trailingDuration before them, it is not detected if the partition does not exist for all the windows, or the
+// trailing average is below minTrailingRate.
+func DetectRateDrop(q *sharedqueue.OverflowQueue[*TimestampedCounts], partitionName string, countWindow time.Duration, now time.Time) (Anomaly, bool) {
+	anomalyWindows, trailingWindows := int(anomalyDuration/countWindow), int(trailingDuration/countWindow)
+	counts := q.Items()
+	// the last element might be incomplete
+	endIndex := len(counts) - 2
+	beginIndex := endIndex - anomalyWindows - trailingWindows
+	if beginIndex < 0 || !isRecentWindow(counts[endIndex].timestamp, now) || findPartitionFirstIndex(counts, beginIndex, beginIndex, partitionName) == indexNotFound {
+		return Anomaly{}, false
+	}
+	lastCounts := make(map[string]float64)
+	for podName, partitionReadCounts := range counts[beginIndex].PodPartitionCountSnapshot() {
+		if count, ok := partitionReadCounts[partitionName]; ok {
+			lastCounts[podName] = count
+		}
+	}
+	anomalyIndex := endIndex - anomalyWindows
+	trailingDelta := float64(0)
+	for i := beginIndex; i < anomalyIndex; i++ {
+		trailingDelta += calculatePartitionDelta(lastCounts, counts[i+1], partitionName)
+	}
+	baseline := trailingDelta / float64(counts[anomalyIndex].timestamp-counts[beginIndex].timestamp)
+	if baseline < minTrailingRate {
+		return Anomaly{}, false
+	}
+	var rate float64
+	for i := anomalyIndex; i < endIndex; i++ {
+		rate = calculatePartitionDelta(lastCounts, counts[i+1], partitionName) / float64(counts[i+1].timestamp-counts[i].timestamp)
+		if rate >= rateDropRatio*baseline {
+			return Anomaly{}, false
+		}
+	}
+	return Anomaly{
+		Kind:      AnomalyRateDrop,
+		Partition: partitionName,
+		Current:   rate,
+		Baseline:  baseline,
+		Duration:  time.Duration(counts[endIndex].timestamp-counts[anomalyIndex].timestamp) * time.Second,
+	}, true
+}
+
+// DetectBacklogGrowth detects the pending of the vertex partition growing in each of the complete windows of the last
+// anomalyDuration, to at least backlogGrowthRatio times of the pending before them, and no less than minBacklog.
+func DetectBacklogGrowth(q *sharedqueue.OverflowQueue[*TimestampedPendings], partitionName string, countWindow time.Duration, now time.Time) (Anomaly, bool) {
+	anomalyWindows := int(anomalyDuration / countWindow)
+	pendings := q.Items()
+	// the last element might be incomplete
+	endIndex := len(pendings) - 2
+	beginIndex := endIndex - anomalyWindows
+	if beginIndex < 0 || !isRecentWindow(pendings[endIndex].timestamp, now) {
+		return Anomaly{}, false
+	}
+	last := windowPending(pendings[beginIndex], partitionName)
+	if last < 0 {
+		return Anomaly{}, false
+	}
+	baseline := last
+	for i := beginIndex + 1; i <= endIndex; i++ {
+		p := windowPending(pendings[i], partitionName)
+		if p <= last {
+			return Anomaly{}, false
+		}
+		last = p
+	}
+	if float64(last) < backlogGrowthRatio*float64(baseline) || last < minBacklog {
+		return Anomaly{}, false
+	}
+	return Anomaly{
+		Kind:      AnomalyBacklogGrowth,
+		Partition: partitionName,
+		Current:   float64(last),
+		Baseline:  float64(baseline),
+		Duration:  time.Duration(pendings[endIndex].timestamp-pendings[beginIndex].timestamp) * time.Second,
+	}, true
+}
+
+// isRecentWindow returns whether the window of the timestamp is the last complete one, with the missed windows
+// tolerated, so that the anomalies are not reported from the stale windows, e.g. when the pods are not scraped.
+func isRecentWindow(timestamp int64, now time.Time) bool {
+	return now.Unix()-timestamp <= maxMissedSeconds
+}
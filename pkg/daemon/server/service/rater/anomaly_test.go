@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rater
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
+)
+
+// newRateCounts returns the timestamped counts of a partition processed at the given rates in the windows up to now,
+// the last window is incomplete.
+func newRateCounts(partitionName string, now time.Time, rates ...float64) *sharedqueue.OverflowQueue[*TimestampedCounts] {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	base := now.Truncate(CountWindow).Unix() - int64(len(rates)-1)*10
+	count := float64(0)
+	for i, rate := range rates {
+		count += rate * 10
+		UpdateCount(q, base+int64(i)*10, &PodReadCount{"pod1", map[string]float64{partitionName: count}, 0, nil})
+	}
+	return q
+}
+
+// newPendings returns the timestamped pendings of a partition in the windows up to now, the last window is incomplete.
+func newPendings(partitionName string, now time.Time, pendings ...int64) *sharedqueue.OverflowQueue[*TimestampedPendings] {
+	q := sharedqueue.New[*TimestampedPendings](1800)
+	base := now.Truncate(CountWindow).Unix() - int64(len(pendings)-1)*10
+	for i, p := range pendings {
+		UpdatePending(q, base+int64(i)*10, &PodPending{"pod1", map[string]int64{partitionName: p}})
+	}
+	return q
+}
+
+// repeat returns the rate repeated n times.
+func repeat(rate float64, n int) []float64 {
+	result := make([]float64, n)
+	for i := range result {
+		result[i] = rate
+	}
+	return result
+}
+
+func TestDetectRateDrop(t *testing.T) {
+	now := time.Unix(TestTime, 0)
+	anomalyWindows, trailingWindows := int(anomalyDuration/CountWindow), int(trailingDuration/CountWindow)
+	// the first window, 30 trailing windows at 10 per second, the anomaly windows, and the incomplete window
+	rates := func(anomalyRates ...float64) []float64 {
+		return append(append(repeat(10, trailingWindows+1), anomalyRates...), 0)
+	}
+
+	a, ok := DetectRateDrop(newRateCounts("p1", now, rates(repeat(2, anomalyWindows)...)...), "p1", CountWindow, now)
+	assert.True(t, ok)
+	assert.Equal(t, Anomaly{Kind: AnomalyRateDrop, Partition: "p1", Current: 2, Baseline: 10, Duration: time.Minute}, a)
+
+	// the rate recovers in the last window
+	_, ok = DetectRateDrop(newRateCounts("p1", now, rates(2, 2, 2, 2, 2, 6)...), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// the rate does not drop below half of the trailing average
+	_, ok = DetectRateDrop(newRateCounts("p1", now, rates(repeat(5, anomalyWindows)...)...), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// the trailing average is too low
+	slow := append(append(repeat(0.5, trailingWindows+1), repeat(0, anomalyWindows)...), 0)
+	_, ok = DetectRateDrop(newRateCounts("p1", now, slow...), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// not enough windows
+	_, ok = DetectRateDrop(newRateCounts("p1", now, rates(repeat(2, anomalyWindows-1)...)[1:]...), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// the windows are stale
+	_, ok = DetectRateDrop(newRateCounts("p1", now, rates(repeat(2, anomalyWindows)...)...), "p1", CountWindow, now.Add(time.Minute))
+	assert.False(t, ok)
+	// the partition does not exist
+	_, ok = DetectRateDrop(newRateCounts("p1", now, rates(repeat(2, anomalyWindows)...)...), "p2", CountWindow, now)
+	assert.False(t, ok)
+}
+
+func TestDetectBacklogGrowth(t *testing.T) {
+	now := time.Unix(TestTime, 0)
+
+	a, ok := DetectBacklogGrowth(newPendings("p1", now, 10, 50, 60, 80, 120, 160, 200, 250, 0), "p1", CountWindow, now)
+	assert.True(t, ok)
+	assert.Equal(t, Anomaly{Kind: AnomalyBacklogGrowth, Partition: "p1", Current: 250, Baseline: 50, Duration: time.Minute}, a)
+
+	// the pending does not grow in one of the windows
+	_, ok = DetectBacklogGrowth(newPendings("p1", now, 10, 50, 60, 80, 80, 160, 200, 250, 0), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// the pending does not double
+	_, ok = DetectBacklogGrowth(newPendings("p1", now, 10, 150, 160, 180, 200, 220, 240, 250, 0), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// the pending is too small
+	_, ok = DetectBacklogGrowth(newPendings("p1", now, 1, 5, 6, 8, 12, 16, 20, 25, 0), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// the pending is not available
+	_, ok = DetectBacklogGrowth(newPendings("p1", now, 10, -1, 60, 80, 120, 160, 200, 250, 0), "p1", CountWindow, now)
+	assert.False(t, ok)
+	// the windows are stale
+	_, ok = DetectBacklogGrowth(newPendings("p1", now, 10, 50, 60, 80, 120, 160, 200, 250, 0), "p1", CountWindow, now.Add(time.Minute))
+	assert.False(t, ok)
+}
+
+func TestRater_DetectAnomalies(t *testing.T) {
+	anomalyWindows, trailingWindows := int(anomalyDuration/CountWindow), int(trailingDuration/CountWindow)
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{{Name: "v1"}, {Name: "v2"}},
+		},
+	}
+	now := time.Unix(TestTime, 0)
+	r := NewRater(context.Background(), pipeline, WithClock(clock.NewFakeClock(now)))
+	r.timestampedPodCounts["v1"] = newRateCounts("default-p-v1-0", now, append(append(repeat(10, trailingWindows+1), repeat(2, anomalyWindows)...), 0)...)
+	r.timestampedPodPendings["v2"] = newPendings("default-p-v2-0", now, 10, 50, 60, 80, 120, 160, 200, 250, 0)
+	assert.Equal(t, []Anomaly{
+		{Kind: AnomalyRateDrop, Vertex: "v1", Partition: "default-p-v1-0", Current: 2, Baseline: 10, Duration: time.Minute},
+		{Kind: AnomalyBacklogGrowth, Vertex: "v2", Partition: "default-p-v2-0", Current: 250, Baseline: 50, Duration: time.Minute},
+	}, r.DetectAnomalies())
+}
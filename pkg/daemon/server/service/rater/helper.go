@@ -17,7 +17,9 @@ limitations under the License.
 package rater
 
 import (
+	"cmp"
 	"math"
+	"slices"
 	"time"
 
 	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
@@ -28,90 +30,485 @@ const (
 	// rateNotAvailable is returned when the processing rate cannot be derived from the currently
 	// available pod data, a negative min is returned to indicate this.
 	rateNotAvailable = float64(math.MinInt)
+	// maxMissedSeconds is how long the count windows can be missed, e.g. when the pods are restarting or their metrics
+	// can not be scraped in time, before the rate is considered not available, i.e., two windows of the default count
+	// window. It's independent of the count window, so that a shorter one does not make the rate flap.
+	maxMissedSeconds = 2 * int64(CountWindow/time.Second)
+	// pendingNotAvailable is returned when none of the pods reports a pending count within the lookback seconds.
+	pendingNotAvailable = int64(-1)
 )
 
 // UpdateCount updates the count of processed messages for a pod at a given time
 func UpdateCount(q *sharedqueue.OverflowQueue[*TimestampedCounts], time int64, podReadCounts *PodReadCount) {
-	items := q.Items()
+	// the timestamps can arrive out of order, e.g. when the scrapes fall behind, the timestamped counts are kept sorted
+	// by the timestamps so that the rate is calculated between the right windows.
+	tc, ok := q.Upsert(func(tc *TimestampedCounts) int {
+		return cmp.Compare(tc.timestamp, time)
+	}, func() *TimestampedCounts {
+		return NewTimestampedCounts(time)
+	})
+	if !ok {
+		// the timestamp is older than all the timestamped counts kept
+		return
+	}
+	tc.Update(podReadCounts)
+}
 
-	// find the element matching the input timestamp and update it
-	for _, i := range items {
-		if i.timestamp == time {
-			i.Update(podReadCounts)
-			return
-		}
+// UpdatePending updates the pending counts reported by a pod at a given time
+func UpdatePending(q *sharedqueue.OverflowQueue[*TimestampedPendings], time int64, podPending *PodPending) {
+	tp, ok := q.Upsert(func(tp *TimestampedPendings) int {
+		return cmp.Compare(tp.timestamp, time)
+	}, func() *TimestampedPendings {
+		return NewTimestampedPendings(time)
+	})
+	if !ok {
+		// the timestamp is older than all the timestamped pendings kept
+		return
 	}
+	tp.Update(podPending)
+}
 
-	// if we cannot find a matching element, it means we need to add a new timestamped count to the queue
-	tc := NewTimestampedCounts(time)
-	tc.Update(podReadCounts)
-	q.Append(tc)
+// PartitionRate is the processing rate of a vertex partition.
+type PartitionRate struct {
+	// Rate is the number of messages processed per second.
+	Rate float64
+	// Partial is true if the partition first appears within the lookback seconds, such as an edge being repartitioned or
+	// a new Kafka partition. The rate is calculated over the time the partition exists instead of the whole lookback
+	// seconds then.
+	Partial bool
 }
 
 // CalculateRate calculates the rate of the vertex partition in the last lookback seconds
-func CalculateRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, partitionName string) float64 {
+func CalculateRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, partitionName string, now time.Time) float64 {
+	return CalculatePartitionRate(q, lookbackSeconds, partitionName, now).Rate
+}
+
+// CalculatePartitionRate calculates the rate of the vertex partition in the last lookback seconds, and whether the
+// partition exists for the whole lookback seconds. The rate is the slope between the first and the last complete
+// windows within the lookback seconds, i.e., the total count delta over the actual elapsed time, so that the missed
+// windows in between do not make it oscillate. The count delta is summed up window by window to detect the counter
+// resets of the restarted pods.
+func CalculatePartitionRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, partitionName string, now time.Time) PartitionRate {
+	return CalculatePartitionRates(q, map[string]int64{"": lookbackSeconds}, partitionName, now)[""]
+}
+
+// CalculatePartitionRates calculates the rates of the vertex partition for multiple lookback seconds, keyed the same as
+// the lookback seconds map. All the rates are calculated against the same snapshot of the queue in one pass, the count
+// delta is accumulated window by window from the start of the longest lookback seconds, the delta of each lookback
+// seconds is then the difference of the accumulated deltas of its first and last windows.
+func CalculatePartitionRates(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds map[string]int64, partitionName string, now time.Time) map[string]PartitionRate {
+	result := make(map[string]PartitionRate, len(lookbackSeconds))
 	counts := q.Items()
-	if len(counts) <= 1 {
-		return rateNotAvailable
+	startIndexes := make(map[string]int, len(lookbackSeconds))
+	firstStartIndex, endIndex := indexNotFound, indexNotFound
+	for key, seconds := range lookbackSeconds {
+		var startIndex int
+		startIndex, endIndex = findRateIndexes(seconds, counts, now)
+		if startIndex == indexNotFound {
+			result[key] = PartitionRate{Rate: rateNotAvailable}
+			continue
+		}
+		startIndexes[key] = startIndex
+		if firstStartIndex == indexNotFound || startIndex < firstStartIndex {
+			firstStartIndex = startIndex
+		}
 	}
-	startIndex := findStartIndex(lookbackSeconds, counts)
-	// we consider the last but one element as the end index because the last element might be incomplete
-	// we can be sure that the last but one element in the queue is complete.
-	endIndex := len(counts) - 2
+	if firstStartIndex == indexNotFound {
+		return result
+	}
+
+	// the rate of a partition appearing within the lookback seconds is calculated from the window right before it
+	// appears, the count of the partition starts from zero in that window.
+	firstIndex := findPartitionFirstIndex(counts, firstStartIndex, endIndex, partitionName)
+	if firstIndex == indexNotFound {
+		// the partition does not exist in the complete windows yet
+		for key := range startIndexes {
+			result[key] = PartitionRate{Rate: 0}
+		}
+		return result
+	}
+
+	// lastCounts tracks the last count of the partition of each pod across the windows, so that a pod or a partition
+	// missing in a window, e.g. a missed scrape, contributes zero, instead of contributing its whole count when it
+	// shows up again. A count going backwards means the pod is restarted, its count starts from zero then.
+	lastCounts := make(map[string]float64)
+	for podName, partitionReadCounts := range counts[firstStartIndex].PodPartitionCountSnapshot() {
+		if count, ok := partitionReadCounts[partitionName]; ok {
+			lastCounts[podName] = count
+		}
+	}
+	// accumulatedDeltas[i] is the count delta of the partition from the first start window to the ith window
+	accumulatedDeltas := make([]float64, endIndex+1)
+	for i := firstStartIndex; i < endIndex; i++ {
+		accumulatedDeltas[i+1] = accumulatedDeltas[i] + calculatePartitionDelta(lastCounts, counts[i+1], partitionName)
+	}
+	for key, startIndex := range startIndexes {
+		partial := false
+		if firstIndex > startIndex {
+			startIndex = firstIndex - 1
+			partial = true
+		}
+		// time diff in seconds.
+		timeDiff := counts[endIndex].timestamp - counts[startIndex].timestamp
+		result[key] = PartitionRate{Rate: (accumulatedDeltas[endIndex] - accumulatedDeltas[startIndex]) / float64(timeDiff), Partial: partial}
+	}
+	return result
+}
+
+// CalculateErrorRate calculates the rate of the errors and the dropped messages of the vertex in the last lookback
+// seconds, across all the partitions. The rate is calculated over the same windows as CalculateRate, the error count
+// delta of each pod is summed up window by window in the same way, so that a restarted pod does not contribute its
+// whole count again. rateNotAvailable is returned if the processing rate is not available either.
+func CalculateErrorRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, now time.Time) float64 {
+	counts := q.Items()
+	startIndex, endIndex := findRateIndexes(lookbackSeconds, counts, now)
 	if startIndex == indexNotFound {
 		return rateNotAvailable
 	}
+	lastCounts := counts[startIndex].PodErrorCountSnapshot()
+	delta := float64(0)
+	for i := startIndex; i < endIndex; i++ {
+		for podName, currCount := range counts[i+1].PodErrorCountSnapshot() {
+			// pod delta will be equal to current count in case of a counter reset, or when the pod joins
+			podDelta := currCount
+			if prevCount, ok := lastCounts[podName]; ok && currCount >= prevCount {
+				podDelta = currCount - prevCount
+			}
+			lastCounts[podName] = currCount
+			delta += podDelta
+		}
+	}
+	return delta / float64(counts[endIndex].timestamp-counts[startIndex].timestamp)
+}
+
+// CalculateAckRates calculates the rates of the messages acknowledged by the vertex partition for multiple lookback
+// seconds, keyed the same as the lookback seconds map. The rates are calculated over the same windows as the processing
+// rates of CalculatePartitionRates, so that they are comparable, and the ack count delta is summed up window by window
+// in the same way.
+func CalculateAckRates(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds map[string]int64, partitionName string, now time.Time) map[string]float64 {
+	result := make(map[string]float64, len(lookbackSeconds))
+	counts := q.Items()
+	for key, seconds := range lookbackSeconds {
+		startIndex, endIndex := findRateIndexes(seconds, counts, now)
+		if startIndex == indexNotFound {
+			result[key] = rateNotAvailable
+			continue
+		}
+		firstIndex := findPartitionFirstIndex(counts, startIndex, endIndex, partitionName)
+		if firstIndex == indexNotFound {
+			// the partition does not exist in the complete windows yet
+			result[key] = 0
+			continue
+		} else if firstIndex > startIndex {
+			startIndex = firstIndex - 1
+		}
+		lastCounts := make(map[string]float64)
+		for podName, partitionAckCounts := range counts[startIndex].PodPartitionAckCountSnapshot() {
+			if count, ok := partitionAckCounts[partitionName]; ok {
+				lastCounts[podName] = count
+			}
+		}
+		delta := float64(0)
+		for i := startIndex; i < endIndex; i++ {
+			delta += calculateCountsDelta(lastCounts, counts[i+1].PodPartitionAckCountSnapshot(), nil, partitionName)
+		}
+		result[key] = delta / float64(counts[endIndex].timestamp-counts[startIndex].timestamp)
+	}
+	return result
+}
+
+// BurstRates are the rates of the vertex partition derived from the rates of the individual count windows, instead
+// of the average over the lookback seconds, so that the bursts are not smoothed out.
+type BurstRates struct {
+	// EMA is the exponentially weighted moving average of the window rates, with the lookback seconds as the time
+	// constant, i.e., the weight of a window decays by e every lookback seconds.
+	EMA float64
+	// P50 is the median of the window rates within the lookback seconds.
+	P50 float64
+	// P95 is the 95th percentile of the window rates within the lookback seconds.
+	P95 float64
+}
+
+// CalculateBurstRates calculates the burst rates of the vertex partition in the last lookback seconds. The rate of a
+// window is the count delta since the previous window over the elapsed time, the same as the average rate. The EMA
+// starts from the first window kept, or the window right before the partition appears, and the percentiles are of the
+// windows within the lookback seconds. The rates are rateNotAvailable if the average rate is not available.
+func CalculateBurstRates(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, partitionName string, now time.Time) BurstRates {
+	counts := q.Items()
+	startIndex, endIndex := findRateIndexes(lookbackSeconds, counts, now)
+	if startIndex == indexNotFound {
+		return BurstRates{EMA: rateNotAvailable, P50: rateNotAvailable, P95: rateNotAvailable}
+	}
+	firstIndex := findPartitionFirstIndex(counts, 0, endIndex, partitionName)
+	if firstIndex == indexNotFound {
+		// the partition does not exist in the complete windows yet
+		return BurstRates{}
+	}
+	beginIndex := max(firstIndex-1, 0)
+	lastCounts := make(map[string]float64)
+	for podName, partitionReadCounts := range counts[beginIndex].PodPartitionCountSnapshot() {
+		if count, ok := partitionReadCounts[partitionName]; ok {
+			lastCounts[podName] = count
+		}
+	}
+	var ema float64
+	windowRates := make([]float64, 0, endIndex-startIndex)
+	for i := beginIndex; i < endIndex; i++ {
+		timeDiff := float64(counts[i+1].timestamp - counts[i].timestamp)
+		rate := calculatePartitionDelta(lastCounts, counts[i+1], partitionName) / timeDiff
+		if i == beginIndex || lookbackSeconds <= 0 {
+			ema = rate
+		} else {
+			// the weight depends on the elapsed time, so that a missed window does not weigh the next one less.
+			ema += (1 - math.Exp(-timeDiff/float64(lookbackSeconds))) * (rate - ema)
+		}
+		if i >= startIndex {
+			windowRates = append(windowRates, rate)
+		}
+	}
+	slices.Sort(windowRates)
+	return BurstRates{EMA: ema, P50: percentile(windowRates, 50), P95: percentile(windowRates, 95)}
+}
+
+// WindowRate is the processing rate of a vertex partition in a complete count window.
+type WindowRate struct {
+	Vertex    string
+	Partition string
+	// Timestamp is the end of the window, in seconds since the epoch.
+	Timestamp int64
+	Rate      float64
+}
 
-	// time diff in seconds.
-	timeDiff := counts[endIndex].timestamp - counts[startIndex].timestamp
-	if timeDiff == 0 {
-		// if the time difference is 0, we return 0 to avoid division by 0
-		// this should not happen in practice because we are using a 10s interval
+// CalculateWindowRates calculates the rates of the partition in the complete windows ending after the since timestamp,
+// in the order of the windows. The rate of a window is the count delta since the previous window over the elapsed time,
+// the same as the burst rates, and the windows without the partition are skipped.
+func CalculateWindowRates(q *sharedqueue.OverflowQueue[*TimestampedCounts], since int64, partitionName string) []WindowRate {
+	counts := q.Items()
+	var result []WindowRate
+	// the last element might be incomplete
+	for i := 0; i < len(counts)-2; i++ {
+		timestamp := counts[i+1].timestamp
+		if timestamp <= since || timestamp <= counts[i].timestamp {
+			continue
+		}
+		if findPartitionFirstIndex(counts, i+1, i+1, partitionName) == indexNotFound {
+			continue
+		}
+		lastCounts := make(map[string]float64)
+		for podName, partitionReadCounts := range counts[i].PodPartitionCountSnapshot() {
+			if count, ok := partitionReadCounts[partitionName]; ok {
+				lastCounts[podName] = count
+			}
+		}
+		rate := calculatePartitionDelta(lastCounts, counts[i+1], partitionName) / float64(timestamp-counts[i].timestamp)
+		result = append(result, WindowRate{Partition: partitionName, Timestamp: timestamp, Rate: rate})
+	}
+	return result
+}
+
+// percentile returns the pth percentile of the sorted values, interpolated linearly between the closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower, upper := int(math.Floor(rank)), int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// CalculatePodRate calculates the processing rate of a pod in the last lookback seconds, summed up across the partitions
+// it reads from. A pod joining within the lookback seconds contributes the counts since it shows up, and a pod leaving,
+// e.g. being scaled down, contributes the counts of the windows it exists in, and zero afterward.
+func CalculatePodRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, podName string, now time.Time) float64 {
+	counts := q.Items()
+	startIndex, endIndex := findRateIndexes(lookbackSeconds, counts, now)
+	if startIndex == indexNotFound {
 		return rateNotAvailable
 	}
+	return calculatePodRate(counts, startIndex, endIndex, podName, func(string) bool { return true })
+}
+
+// CalculateVertexPodRates calculates the processing rates of all the pods of the vertex in the last lookback seconds,
+// summed up across the partitions they read from and keyed by the pod name. The pods having any count within the
+// lookback seconds are included, with the same rate as CalculatePodRate.
+func CalculateVertexPodRates(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, now time.Time) map[string]float64 {
+	result := make(map[string]float64)
+	counts := q.Items()
+	startIndex, endIndex := findRateIndexes(lookbackSeconds, counts, now)
+	if startIndex == indexNotFound {
+		return result
+	}
+	allPartitions := func(string) bool { return true }
+	for i := startIndex; i <= endIndex; i++ {
+		for podName := range counts[i].PodPartitionCountSnapshot() {
+			if _, ok := result[podName]; !ok {
+				result[podName] = calculatePodRate(counts, startIndex, endIndex, podName, allPartitions)
+			}
+		}
+	}
+	return result
+}
+
+// CalculatePodRates calculates the processing rates of the pods reading from the vertex partition in the last lookback
+// seconds, keyed by the pod name. Only the pods having the count of the partition within the lookback seconds are
+// included, the rates are calculated over the same windows as CalculatePartitionRate, so that they add up to the rate
+// of the partition.
+func CalculatePodRates(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, partitionName string, now time.Time) map[string]float64 {
+	result := make(map[string]float64)
+	counts := q.Items()
+	startIndex, endIndex := findRateIndexes(lookbackSeconds, counts, now)
+	if startIndex == indexNotFound {
+		return result
+	}
+	firstIndex := findPartitionFirstIndex(counts, startIndex, endIndex, partitionName)
+	if firstIndex == indexNotFound {
+		return result
+	} else if firstIndex > startIndex {
+		startIndex = firstIndex - 1
+	}
+	inPartition := func(name string) bool { return name == partitionName }
+	for i := startIndex; i <= endIndex; i++ {
+		for podName, partitionReadCounts := range counts[i].PodPartitionCountSnapshot() {
+			if _, ok := result[podName]; ok {
+				continue
+			}
+			if _, ok := partitionReadCounts[partitionName]; ok {
+				result[podName] = calculatePodRate(counts, startIndex, endIndex, podName, inPartition)
+			}
+		}
+	}
+	return result
+}
 
+// calculatePodRate calculates the rate of a pod between the start and the end windows, counting the partitions matched
+// by inPartition.
+func calculatePodRate(counts []*TimestampedCounts, startIndex, endIndex int, podName string, inPartition func(string) bool) float64 {
+	// lastCounts tracks the last count of each partition of the pod across the windows, the same as the partition rate.
+	lastCounts := make(map[string]float64)
+	for partitionName, count := range counts[startIndex].PodPartitionCountSnapshot()[podName] {
+		if inPartition(partitionName) {
+			lastCounts[partitionName] = count
+		}
+	}
 	delta := float64(0)
 	for i := startIndex; i < endIndex; i++ {
-		delta += calculatePartitionDelta(counts[i], counts[i+1], partitionName)
+		delta += calculatePodDelta(lastCounts, counts[i+1], podName, inPartition)
 	}
-	return delta / float64(timeDiff)
+	return delta / float64(counts[endIndex].timestamp-counts[startIndex].timestamp)
 }
 
-// calculatePartitionDelta calculates the difference of the metric count between two timestamped counts for a given partition.
-func calculatePartitionDelta(tc1, tc2 *TimestampedCounts, partitionName string) float64 {
+// calculatePodDelta calculates the difference of the metric count of a pod between its last counts and a timestamped
+// count, and updates the last counts. A pod absent in the timestamped count, e.g. being scaled down, contributes zero.
+func calculatePodDelta(lastCounts map[string]float64, tc *TimestampedCounts, podName string, inPartition func(string) bool) float64 {
 	delta := float64(0)
-	if tc1 == nil || tc2 == nil {
-		// we calculate delta only when both input timestamped counts are non-nil
+	if tc == nil {
 		return delta
 	}
-	prevPodReadCount := tc1.PodPartitionCountSnapshot()
-	currPodReadCount := tc2.PodPartitionCountSnapshot()
-	for podName, partitionReadCounts := range currPodReadCount {
-		currCount := partitionReadCounts[partitionName]
-		prevCount := prevPodReadCount[podName][partitionName]
-		// pod delta will be equal to current count in case of restart
-		podDelta := currCount
-		if currCount >= prevCount {
-			podDelta = currCount - prevCount
+	restarts := tc.podPartitionRestartSnapshot()[podName]
+	for partitionName, currCount := range tc.PodPartitionCountSnapshot()[podName] {
+		if !inPartition(partitionName) {
+			continue
 		}
-		delta += podDelta
+		prevCount, ok := lastCounts[partitionName]
+		lastCounts[partitionName] = currCount
+		delta += countDelta(prevCount, ok, currCount, restarts[partitionName])
 	}
 	return delta
 }
 
-// findStartIndex finds the index of the first element in the queue that is within the lookback seconds
-func findStartIndex(lookbackSeconds int64, counts []*TimestampedCounts) int {
+// calculatePartitionDelta calculates the difference of the metric count of a partition between the last counts of the
+// pods and a timestamped count, and updates the last counts.
+func calculatePartitionDelta(lastCounts map[string]float64, tc *TimestampedCounts, partitionName string) float64 {
+	if tc == nil {
+		return 0
+	}
+	return calculateCountsDelta(lastCounts, tc.PodPartitionCountSnapshot(), tc.podPartitionRestartSnapshot(), partitionName)
+}
+
+// calculateCountsDelta calculates the difference of the counts of a partition between the last counts of the pods and
+// the counts of the pods in a window, and updates the last counts. The restarts of the pods within the window can be nil.
+func calculateCountsDelta(lastCounts map[string]float64, podPartitionCounts map[string]map[string]float64, restarts map[string]map[string]partitionRestart, partitionName string) float64 {
+	delta := float64(0)
+	for podName, partitionCounts := range podPartitionCounts {
+		currCount, ok := partitionCounts[partitionName]
+		if !ok {
+			// the partition is absent from the pod in this window, it contributes zero
+			continue
+		}
+		prevCount, ok := lastCounts[podName]
+		lastCounts[podName] = currCount
+		delta += countDelta(prevCount, ok, currCount, restarts[podName][partitionName])
+	}
+	return delta
+}
+
+// countDelta calculates the difference between the previous count and the current count of a partition of a pod, the
+// previous count is absent when the partition is new to the pod. The delta is equal to the current count in case of a
+// counter reset, and when the pod restarted within the window, the counts reached before the restarts are added to it.
+func countDelta(prevCount float64, hasPrev bool, currCount float64, restart partitionRestart) float64 {
+	if restart == (partitionRestart{}) {
+		if hasPrev && currCount >= prevCount {
+			return currCount - prevCount
+		}
+		return currCount
+	}
+	beforeRestart := restart.firstCount
+	if hasPrev && beforeRestart >= prevCount {
+		beforeRestart -= prevCount
+	}
+	return beforeRestart + restart.laterCounts + currCount
+}
+
+// findPartitionFirstIndex finds the index of the first element within [startIndex, endIndex] that has the count of the
+// partition from any pod.
+func findPartitionFirstIndex(counts []*TimestampedCounts, startIndex, endIndex int, partitionName string) int {
+	for i := startIndex; i <= endIndex; i++ {
+		for _, partitionReadCounts := range counts[i].PodPartitionCountSnapshot() {
+			if _, ok := partitionReadCounts[partitionName]; ok {
+				return i
+			}
+		}
+	}
+	return indexNotFound
+}
+
+// findRateIndexes finds the indexes of the first and the last complete windows to calculate the rate in the last lookback
+// seconds, the start index is indexNotFound if the rate is not available.
+func findRateIndexes(lookbackSeconds int64, counts []*TimestampedCounts, now time.Time) (int, int) {
+	if len(counts) <= 1 {
+		return indexNotFound, indexNotFound
+	}
+	startIndex := findStartIndex(lookbackSeconds, counts, now)
+	// we consider the last but one element as the end index because the last element might be incomplete
+	// we can be sure that the last but one element in the queue is complete.
+	endIndex := len(counts) - 2
+	if startIndex == indexNotFound {
+		return indexNotFound, endIndex
+	}
+	if counts[endIndex].timestamp <= counts[startIndex].timestamp {
+		// if the time difference is not positive, the rate is not available to avoid division by 0 or a negative
+		// rate, this should not happen in practice because the timestamped counts are sorted with the count window as the interval
+		return indexNotFound, endIndex
+	}
+	return startIndex, endIndex
+}
+
+// findStartIndex finds the index of the first element in the queue that is within the lookback seconds before now.
+// The rate needs two complete windows, if there are fewer within the lookback seconds, e.g. some windows are missed,
+// the last complete window before the lookback seconds is used as the start. The callers truncate now to the count
+// window, so that the lookback seconds are aligned with the windows.
+func findStartIndex(lookbackSeconds int64, counts []*TimestampedCounts, now time.Time) int {
 	n := len(counts)
-	now := time.Now().Truncate(CountWindow).Unix()
-	if n < 2 || now-counts[n-2].timestamp > lookbackSeconds {
-		// if the second last element is already outside the lookback window, we return indexNotFound
+	nowSeconds := now.Unix()
+	// the last but one element is the last complete window.
+	endIndex := n - 2
+	if endIndex < 1 || nowSeconds-counts[endIndex].timestamp > lookbackSeconds+maxMissedSeconds {
+		// if there are no two complete windows, or the last complete window is too old even with the missed windows
+		// tolerated, we return indexNotFound
 		return indexNotFound
 	}
 
-	startIndex := n - 2
+	startIndex := endIndex
 	left := 0
-	right := n - 2
-	lastTimestamp := now - lookbackSeconds
+	right := endIndex
+	lastTimestamp := nowSeconds - lookbackSeconds
 	for left <= right {
 		mid := left + (right-left)/2
 		if counts[mid].timestamp >= lastTimestamp {
@@ -121,5 +518,44 @@ func findStartIndex(lookbackSeconds int64, counts []*TimestampedCounts) int {
 			left = mid + 1
 		}
 	}
+	if startIndex == endIndex {
+		startIndex--
+	}
 	return startIndex
 }
+
+// CalculateAveragePending calculates the average pending count of the vertex partition in the last lookback seconds.
+// The pending is the pending of the partition, not of a pod, so the pending of a window is the largest one reported by
+// the pods in the window. The pods reporting a negative pending, e.g. -1 when it is not available yet, are excluded.
+// pendingNotAvailable is returned if no window in the lookback seconds has a pending count.
+func CalculateAveragePending(q *sharedqueue.OverflowQueue[*TimestampedPendings], lookbackSeconds int64, partitionName string, now time.Time) int64 {
+	startTimestamp := now.Unix() - lookbackSeconds
+	total, num := int64(0), int64(0)
+	for _, tp := range q.Items() {
+		if tp.timestamp < startTimestamp {
+			continue
+		}
+		p := windowPending(tp, partitionName)
+		if p < 0 {
+			continue
+		}
+		total += p
+		num++
+	}
+	if num == 0 {
+		return pendingNotAvailable
+	}
+	return total / num
+}
+
+// windowPending returns the pending of the vertex partition in a window, the largest one reported by the pods, or
+// pendingNotAvailable if none of the pods reports a non-negative pending.
+func windowPending(tp *TimestampedPendings, partitionName string) int64 {
+	result := pendingNotAvailable
+	for _, partitionPendings := range tp.PodPartitionPendingSnapshot() {
+		if p, ok := partitionPendings[partitionName]; ok && p > result {
+			result = p
+		}
+	}
+	return result
+}
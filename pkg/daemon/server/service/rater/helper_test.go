@@ -17,6 +17,7 @@ limitations under the License.
 package rater
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -31,10 +32,10 @@ func TestUpdateCount(t *testing.T) {
 	t.Run("givenTimeExistsPodExistsPartitionExistsCountAvailable_whenUpdate_thenUpdatePodPartitionCount", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
 		tc := NewTimestampedCounts(TestTime)
-		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 		q.Append(tc)
 
-		UpdateCount(q, TestTime, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0}})
+		UpdateCount(q, TestTime, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0}, 0, nil})
 
 		assert.Equal(t, 1, q.Length())
 		assert.Equal(t, 20.0, q.Items()[0].podPartitionCount["pod1"]["partition1"])
@@ -43,10 +44,10 @@ func TestUpdateCount(t *testing.T) {
 	t.Run("givenTimeExistsPodExistsPartitionNotExistsCountAvailable_whenUpdate_thenAddPodPartitionCount", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
 		tc := NewTimestampedCounts(TestTime)
-		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 		q.Append(tc)
 
-		UpdateCount(q, TestTime, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0, "partition2": 30.0}})
+		UpdateCount(q, TestTime, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0, "partition2": 30.0}, 0, nil})
 
 		assert.Equal(t, 1, q.Length())
 		assert.Equal(t, 20.0, q.Items()[0].podPartitionCount["pod1"]["partition1"])
@@ -56,10 +57,10 @@ func TestUpdateCount(t *testing.T) {
 	t.Run("givenTimeExistsPodNotExistsCountAvailable_whenUpdate_thenAddPodCount", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
 		tc := NewTimestampedCounts(TestTime)
-		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0}})
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0}, 0, nil})
 		q.Append(tc)
 
-		UpdateCount(q, TestTime, &PodReadCount{"pod2", map[string]float64{"partition1": 10.0}})
+		UpdateCount(q, TestTime, &PodReadCount{"pod2", map[string]float64{"partition1": 10.0}, 0, nil})
 
 		assert.Equal(t, 1, q.Length())
 		assert.Equal(t, 20.0, q.Items()[0].podPartitionCount["pod1"]["partition1"])
@@ -69,7 +70,7 @@ func TestUpdateCount(t *testing.T) {
 	t.Run("givenTimeExistsPodExistsCountNotAvailable_whenUpdate_thenNotUpdatePod", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
 		tc := NewTimestampedCounts(TestTime)
-		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 		q.Append(tc)
 
 		UpdateCount(q, TestTime, nil)
@@ -82,7 +83,7 @@ func TestUpdateCount(t *testing.T) {
 	t.Run("givenTimeExistsPodNotExistsCountNotAvailable_whenUpdate_thenNoUpdate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
 		tc := NewTimestampedCounts(TestTime)
-		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 		q.Append(tc)
 
 		UpdateCount(q, TestTime, nil)
@@ -94,10 +95,10 @@ func TestUpdateCount(t *testing.T) {
 	t.Run("givenTimeNotExistsCountAvailable_whenUpdate_thenAddNewItem", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
 		tc := NewTimestampedCounts(TestTime)
-		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 		q.Append(tc)
 
-		UpdateCount(q, TestTime+1, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0}})
+		UpdateCount(q, TestTime+1, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0}, 0, nil})
 
 		assert.Equal(t, 2, q.Length())
 		assert.Equal(t, 10.0, q.Items()[0].podPartitionCount["pod1"]["partition1"])
@@ -107,7 +108,7 @@ func TestUpdateCount(t *testing.T) {
 	t.Run("givenTimeNotExistsCountNotAvailable_whenUpdate_thenAddEmptyItem", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
 		tc := NewTimestampedCounts(TestTime)
-		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 		q.Append(tc)
 
 		UpdateCount(q, TestTime+1, nil)
@@ -116,264 +117,968 @@ func TestUpdateCount(t *testing.T) {
 		assert.Equal(t, 10.0, q.Items()[0].podPartitionCount["pod1"]["partition1"])
 		assert.Equal(t, 0, len(q.Items()[1].podPartitionCount))
 	})
+
+	t.Run("givenTimeOlderThanLast_whenUpdate_thenInsertInOrder", func(t *testing.T) {
+		q := sharedqueue.New[*TimestampedCounts](1800)
+		UpdateCount(q, TestTime+20, &PodReadCount{"pod1", map[string]float64{"partition1": 30.0}, 0, nil})
+		UpdateCount(q, TestTime, &PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
+		UpdateCount(q, TestTime+10, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0}, 0, nil})
+		UpdateCount(q, TestTime+10, &PodReadCount{"pod2", map[string]float64{"partition1": 5.0}, 0, nil})
+
+		assert.Equal(t, 3, q.Length())
+		for i, tc := range q.Items() {
+			assert.Equal(t, int64(TestTime+10*i), tc.timestamp)
+			assert.Equal(t, float64(10*(i+1)), tc.podPartitionCount["pod1"]["partition1"])
+		}
+		assert.Equal(t, 5.0, q.Items()[1].podPartitionCount["pod2"]["partition1"])
+	})
+
+	t.Run("givenTimeOlderThanAllOfFullQueue_whenUpdate_thenNoUpdate", func(t *testing.T) {
+		q := sharedqueue.New[*TimestampedCounts](2)
+		UpdateCount(q, TestTime+10, &PodReadCount{"pod1", map[string]float64{"partition1": 20.0}, 0, nil})
+		UpdateCount(q, TestTime+20, &PodReadCount{"pod1", map[string]float64{"partition1": 30.0}, 0, nil})
+		UpdateCount(q, TestTime, &PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
+
+		assert.Equal(t, 2, q.Length())
+		assert.Equal(t, int64(TestTime+10), q.Items()[0].timestamp)
+		assert.Equal(t, int64(TestTime+20), q.Items()[1].timestamp)
+	})
 }
 
 func TestCalculateRate(t *testing.T) {
 	t.Run("givenCollectedTimeLessThanTwo_whenCalculateRate_thenReturnRateNotAvailable", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
+		now := time.Unix(TestTime, 0)
 		// no data
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10, "partition1"))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10, "partition1", now))
 
 		// only one data
-		now := time.Now()
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 5.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 5.0}, 0, nil})
 		q.Append(tc1)
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10, "partition1"))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10, "partition1", now))
 	})
 
 	t.Run("singlePod_givenCountIncreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 5.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 5.0}, 0, nil})
 		q.Append(tc1)
 		tc2 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 10)
-		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 		q.Append(tc2)
 		tc3 := NewTimestampedCounts(now.Truncate(CountWindow).Unix())
-		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0}})
+		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0}, 0, nil})
 		q.Append(tc3)
 
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition1"))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 0.5, CalculateRate(q, 5, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 0.5, CalculateRate(q, 15, "partition1", now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 0.5, CalculateRate(q, 25, "partition1"))
+		assert.Equal(t, 0.5, CalculateRate(q, 25, "partition1", now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 0.5, CalculateRate(q, 100, "partition1"))
+		assert.Equal(t, 0.5, CalculateRate(q, 100, "partition1", now))
 	})
 
 	t.Run("singlePod_givenCountDecreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}, 0, nil})
 		q.Append(tc1)
 		tc2 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
-		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}})
+		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}, 0, nil})
 		q.Append(tc2)
 		tc3 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 10)
-		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}})
+		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}, 0, nil})
 		q.Append(tc3)
 		tc4 := NewTimestampedCounts(now.Truncate(CountWindow).Unix())
-		tc4.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 80.0}})
+		tc4.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 80.0}, 0, nil})
 		q.Append(tc4)
 
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition1"))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 5.0, CalculateRate(q, 5, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 5.0, CalculateRate(q, 15, "partition1", now))
 		// tc2 and tc3 are used to calculate the rate
-		assert.Equal(t, 5.0, CalculateRate(q, 25, "partition1"))
+		assert.Equal(t, 5.0, CalculateRate(q, 25, "partition1", now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 7.5, CalculateRate(q, 35, "partition1"))
+		assert.Equal(t, 7.5, CalculateRate(q, 35, "partition1", now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 7.5, CalculateRate(q, 100, "partition1"))
+		assert.Equal(t, 7.5, CalculateRate(q, 100, "partition1", now))
 	})
 
 	t.Run("multiplePods_givenCountIncreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}})
-		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 100.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}, 0, nil})
+		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 100.0}, 0, nil})
 		q.Append(tc1)
 		tc2 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
-		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}})
-		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 200.0}})
+		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}, 0, nil})
+		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 200.0}, 0, nil})
 		q.Append(tc2)
 		tc3 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 10)
-		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}})
-		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 300.0}})
+		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 300.0}, 0, nil})
 		q.Append(tc3)
 
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25, "partition1"))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 15.0, CalculateRate(q, 5, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 15.0, CalculateRate(q, 15, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 15.0, CalculateRate(q, 25, "partition1", now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 15.0, CalculateRate(q, 35, "partition1"))
+		assert.Equal(t, 15.0, CalculateRate(q, 35, "partition1", now))
 	})
 
 	t.Run("multiplePods_givenCountDecreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}})
-		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 300.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}, 0, nil})
+		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 300.0}, 0, nil})
 		q.Append(tc1)
 		tc2 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
-		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}})
-		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 200.0}})
+		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}, 0, nil})
+		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 200.0}, 0, nil})
 		q.Append(tc2)
 		tc3 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 10)
-		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}})
-		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 100.0}})
+		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 100.0}, 0, nil})
 		q.Append(tc3)
 
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25, "partition1"))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 30.0, CalculateRate(q, 5, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 30.0, CalculateRate(q, 15, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 30.0, CalculateRate(q, 25, "partition1", now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 30.0, CalculateRate(q, 35, "partition1"))
+		assert.Equal(t, 30.0, CalculateRate(q, 35, "partition1", now))
 	})
 
 	t.Run("multiplePods_givenOnePodRestarts_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}})
-		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 300.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}, 0, nil})
+		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 300.0}, 0, nil})
 		q.Append(tc1)
 		tc2 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
-		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}})
-		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 200.0}})
+		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}, 0, nil})
+		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 200.0}, 0, nil})
 		q.Append(tc2)
 		tc3 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 10)
-		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}})
-		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 100.0}})
+		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 100.0}, 0, nil})
 		q.Append(tc3)
 
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25, "partition1"))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 25.0, CalculateRate(q, 5, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 25.0, CalculateRate(q, 15, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 25.0, CalculateRate(q, 25, "partition1", now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 25.0, CalculateRate(q, 35, "partition1"))
+		assert.Equal(t, 25.0, CalculateRate(q, 35, "partition1", now))
 	})
 
 	t.Run("multiplePods_givenPodsComeAndGo_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(time.Second*10).Unix() - 30)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}})
-		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 90.0}})
-		tc1.Update(&PodReadCount{"pod3", map[string]float64{"partition3": 50.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 200.0}, 0, nil})
+		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 90.0}, 0, nil})
+		tc1.Update(&PodReadCount{"pod3", map[string]float64{"partition3": 50.0}, 0, nil})
 		q.Append(tc1)
 		tc2 := NewTimestampedCounts(now.Truncate(time.Second*10).Unix() - 20)
-		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}})
-		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 200.0}})
+		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0}, 0, nil})
+		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 200.0}, 0, nil})
 		q.Append(tc2)
 		tc3 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 10)
-		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}})
-		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 300.0}})
-		tc3.Update(&PodReadCount{"pod4", map[string]float64{"partition4": 100.0}})
-		tc3.Update(&PodReadCount{"pod3", map[string]float64{"partition3": 200.0}})
+		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 50.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 300.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod4", map[string]float64{"partition4": 100.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod3", map[string]float64{"partition3": 200.0}, 0, nil})
 		q.Append(tc3)
 		tc4 := NewTimestampedCounts(now.Truncate(CountWindow).Unix())
-		tc4.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 400.0}})
-		tc4.Update(&PodReadCount{"pod100", map[string]float64{"partition100": 200.0}})
+		tc4.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 400.0}, 0, nil})
+		tc4.Update(&PodReadCount{"pod100", map[string]float64{"partition100": 200.0}, 0, nil})
 		q.Append(tc4)
 
 		// partition1 rate
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition1"))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 5.0, CalculateRate(q, 5, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 5.0, CalculateRate(q, 15, "partition1", now))
 		// tc2 and tc3 are used to calculate the rate
-		assert.Equal(t, 5.0, CalculateRate(q, 25, "partition1"))
+		assert.Equal(t, 5.0, CalculateRate(q, 25, "partition1", now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 7.5, CalculateRate(q, 35, "partition1"))
+		assert.Equal(t, 7.5, CalculateRate(q, 35, "partition1", now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 7.5, CalculateRate(q, 100, "partition1"))
+		assert.Equal(t, 7.5, CalculateRate(q, 100, "partition1", now))
 
 		// partition2 rate
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition2"))
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition2"))
-		assert.Equal(t, 10.0, CalculateRate(q, 25, "partition2"))
-		assert.Equal(t, 10.5, CalculateRate(q, 35, "partition2"))
-		assert.Equal(t, 10.5, CalculateRate(q, 100, "partition2"))
+		assert.Equal(t, 10.0, CalculateRate(q, 5, "partition2", now))
+		assert.Equal(t, 10.0, CalculateRate(q, 15, "partition2", now))
+		assert.Equal(t, 10.0, CalculateRate(q, 25, "partition2", now))
+		assert.Equal(t, 10.5, CalculateRate(q, 35, "partition2", now))
+		assert.Equal(t, 10.5, CalculateRate(q, 100, "partition2", now))
 
 		// partition3 rate
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition3"))
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition3"))
-		assert.Equal(t, 20.0, CalculateRate(q, 25, "partition3"))
-		assert.Equal(t, 10.0, CalculateRate(q, 35, "partition3"))
-		assert.Equal(t, 10.0, CalculateRate(q, 100, "partition3"))
+		assert.Equal(t, 20.0, CalculateRate(q, 5, "partition3", now))
+		assert.Equal(t, 20.0, CalculateRate(q, 15, "partition3", now))
+		assert.Equal(t, 20.0, CalculateRate(q, 25, "partition3", now))
+		// pod3 misses tc2, it contributes its count delta instead of its whole count when it shows up again
+		assert.Equal(t, 7.5, CalculateRate(q, 35, "partition3", now))
+		assert.Equal(t, 7.5, CalculateRate(q, 100, "partition3", now))
 
 		// partition4 rate
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition4"))
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition4"))
-		assert.Equal(t, 10.0, CalculateRate(q, 25, "partition4"))
-		assert.Equal(t, 5.0, CalculateRate(q, 35, "partition4"))
-		assert.Equal(t, 5.0, CalculateRate(q, 100, "partition4"))
+		assert.Equal(t, 10.0, CalculateRate(q, 5, "partition4", now))
+		assert.Equal(t, 10.0, CalculateRate(q, 15, "partition4", now))
+		assert.Equal(t, 10.0, CalculateRate(q, 25, "partition4", now))
+		// partition4 first appears in tc3, the rate is calculated from tc2 instead of the whole lookback seconds
+		assert.Equal(t, 10.0, CalculateRate(q, 35, "partition4", now))
+		assert.Equal(t, 10.0, CalculateRate(q, 100, "partition4", now))
 
 		// partition100 rate
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition100"))
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition100"))
-		assert.Equal(t, 0.0, CalculateRate(q, 25, "partition100"))
-		assert.Equal(t, 0.0, CalculateRate(q, 35, "partition100"))
-		assert.Equal(t, 0.0, CalculateRate(q, 100, "partition100"))
+		assert.Equal(t, 0.0, CalculateRate(q, 5, "partition100", now))
+		assert.Equal(t, 0.0, CalculateRate(q, 15, "partition100", now))
+		assert.Equal(t, 0.0, CalculateRate(q, 25, "partition100", now))
+		assert.Equal(t, 0.0, CalculateRate(q, 35, "partition100", now))
+		assert.Equal(t, 0.0, CalculateRate(q, 100, "partition100", now))
 	})
 
 	t.Run("multiplePods_givenOnePodHandleMultiplePartitions_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		// this test uses an extreme case where pod1 handle3 10 messages at a time for each partition, and pod 2 100, pod 3 1000
 		tc1 := NewTimestampedCounts(now.Truncate(time.Second*10).Unix() - 30)
-		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0, "partition2": 20.0}})
-		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 10.0, "partition2": 20.0}})
-		tc1.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 10.0, "partition2": 20.0}})
+		tc1.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0, "partition2": 20.0}, 0, nil})
+		tc1.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 10.0, "partition2": 20.0}, 0, nil})
+		tc1.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 10.0, "partition2": 20.0}, 0, nil})
 		q.Append(tc1)
 		tc2 := NewTimestampedCounts(now.Truncate(time.Second*10).Unix() - 20)
-		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0, "partition2": 30.0}})
-		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 110.0, "partition2": 120.0}})
-		tc2.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 1010.0, "partition2": 1020.0}})
+		tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0, "partition2": 30.0}, 0, nil})
+		tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 110.0, "partition2": 120.0}, 0, nil})
+		tc2.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 1010.0, "partition2": 1020.0}, 0, nil})
 		q.Append(tc2)
 		tc3 := NewTimestampedCounts(now.Truncate(time.Second*10).Unix() - 10)
-		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 30.0, "partition2": 40.0}})
-		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 210.0, "partition2": 220.0}})
-		tc3.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 2010.0, "partition2": 2020.0}})
+		tc3.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 30.0, "partition2": 40.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 210.0, "partition2": 220.0}, 0, nil})
+		tc3.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 2010.0, "partition2": 2020.0}, 0, nil})
 		q.Append(tc3)
 		tc4 := NewTimestampedCounts(now.Truncate(time.Second * 10).Unix())
-		tc4.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 40.0, "partition2": 50.0}})
-		tc4.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 310.0, "partition2": 320.0}})
-		tc4.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 3010.0, "partition2": 3020.0}})
+		tc4.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 40.0, "partition2": 50.0}, 0, nil})
+		tc4.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 310.0, "partition2": 320.0}, 0, nil})
+		tc4.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 3010.0, "partition2": 3020.0}, 0, nil})
 		q.Append(tc4)
 
 		// partition1 rate
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition1"))
-		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition1"))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 111.0, CalculateRate(q, 5, "partition1", now))
+		// fewer than two complete windows within lookback seconds, the last two complete windows are used
+		assert.Equal(t, 111.0, CalculateRate(q, 15, "partition1", now))
 		// tc2 and tc3 are used to calculate the rate
-		assert.Equal(t, 111.0, CalculateRate(q, 25, "partition1"))
+		assert.Equal(t, 111.0, CalculateRate(q, 25, "partition1", now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 111.0, CalculateRate(q, 35, "partition1"))
+		assert.Equal(t, 111.0, CalculateRate(q, 35, "partition1", now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 111.0, CalculateRate(q, 100, "partition1"))
+		assert.Equal(t, 111.0, CalculateRate(q, 100, "partition1", now))
 
 		// partition2 rate
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, "partition2"))
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, "partition2"))
-		assert.Equal(t, 111.0, CalculateRate(q, 25, "partition2"))
-		assert.Equal(t, 111.0, CalculateRate(q, 35, "partition2"))
-		assert.Equal(t, 111.0, CalculateRate(q, 100, "partition2"))
+		assert.Equal(t, 111.0, CalculateRate(q, 5, "partition2", now))
+		assert.Equal(t, 111.0, CalculateRate(q, 15, "partition2", now))
+		assert.Equal(t, 111.0, CalculateRate(q, 25, "partition2", now))
+		assert.Equal(t, 111.0, CalculateRate(q, 35, "partition2", now))
+		assert.Equal(t, 111.0, CalculateRate(q, 100, "partition2", now))
 	})
+
+	t.Run("singlePod_givenPartitionsAddedAndRemoved_whenCalculateRate_thenReturnRate", func(t *testing.T) {
+		q := sharedqueue.New[*TimestampedCounts](1800)
+		now := time.Unix(TestTime, 0)
+		base := now.Truncate(CountWindow).Unix()
+
+		// partition1 reads 10 messages per second all the time, partition2 is added at -30 and reads 5 messages per
+		// second, partition3 reads 20 messages per second and is removed at -30.
+		counts := []map[string]float64{
+			{"partition1": 100, "partition3": 200},
+			{"partition1": 200, "partition3": 400},
+			{"partition1": 300, "partition3": 600},
+			{"partition1": 400, "partition2": 50},
+			{"partition1": 500, "partition2": 100},
+			{"partition1": 600, "partition2": 150},
+			{"partition1": 700, "partition2": 200},
+		}
+		for i, c := range counts {
+			tc := NewTimestampedCounts(base - int64(60-10*i))
+			tc.Update(&PodReadCount{"pod1", c, 0, nil})
+			q.Append(tc)
+		}
+
+		assert.Equal(t, 10.0, CalculateRate(q, 60, "partition1", now))
+		// the windows before partition2 is added are not counted
+		assert.Equal(t, 5.0, CalculateRate(q, 60, "partition2", now))
+		assert.Equal(t, 5.0, CalculateRate(q, 25, "partition2", now))
+		// the windows after partition3 is removed contribute zero
+		assert.Equal(t, 8.0, CalculateRate(q, 60, "partition3", now))
+		assert.Equal(t, 0.0, CalculateRate(q, 25, "partition3", now))
+	})
+
+	t.Run("singlePod_givenPartitionReappears_whenCalculateRate_thenReturnRate", func(t *testing.T) {
+		q := sharedqueue.New[*TimestampedCounts](1800)
+		now := time.Unix(TestTime, 0)
+		base := now.Truncate(CountWindow).Unix()
+
+		// the partition is missing from the running pod in the middle windows, its count keeps increasing
+		counts := []map[string]float64{
+			{"partition1": 100},
+			{"partition1": 200},
+			{},
+			{},
+			{"partition1": 500},
+			{"partition1": 600},
+		}
+		for i, c := range counts {
+			tc := NewTimestampedCounts(base - int64(50-10*i))
+			tc.Update(&PodReadCount{"pod1", c, 0, nil})
+			q.Append(tc)
+		}
+		// the count of the partition is not counted as a whole when it reappears, which would be 15
+		assert.Equal(t, 10.0, CalculateRate(q, 50, "partition1", now))
+	})
+}
+
+func TestCalculateRate_OutOfOrderTimestamps(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+
+	// the scrapes arrive out of order and some are duplicated
+	UpdateCount(q, base-20, &PodReadCount{"pod1", map[string]float64{"partition1": 100.0}, 0, nil})
+	UpdateCount(q, base-20, &PodReadCount{"pod2", map[string]float64{"partition1": 200.0}, 0, nil})
+	UpdateCount(q, base-30, &PodReadCount{"pod1", map[string]float64{"partition1": 50.0}, 0, nil})
+	UpdateCount(q, base-30, &PodReadCount{"pod2", map[string]float64{"partition1": 100.0}, 0, nil})
+	UpdateCount(q, base, &PodReadCount{"pod1", map[string]float64{"partition1": 300.0}, 0, nil})
+	UpdateCount(q, base-10, &PodReadCount{"pod1", map[string]float64{"partition1": 200.0}, 0, nil})
+	UpdateCount(q, base-10, &PodReadCount{"pod2", map[string]float64{"partition1": 400.0}, 0, nil})
+	UpdateCount(q, base-20, &PodReadCount{"pod1", map[string]float64{"partition1": 100.0}, 0, nil})
+
+	// tc1, 2 and 3 are used to calculate the rate, pod1 processes 150 and pod2 processes 300 messages in 20 seconds
+	assert.Equal(t, 22.5, CalculateRate(q, 100, "partition1", now))
+	assert.Equal(t, 7.5, CalculatePodRate(q, 100, "pod1", now))
+	assert.Equal(t, 15.0, CalculatePodRate(q, 100, "pod2", now))
+}
+
+func TestCalculatePartitionRate(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+
+	// partition2 is added at -20
+	counts := []map[string]float64{
+		{"partition1": 100},
+		{"partition1": 200},
+		{"partition1": 300},
+		{"partition1": 400, "partition2": 100},
+		{"partition1": 500, "partition2": 200},
+		{"partition1": 600, "partition2": 300},
+	}
+	for i, c := range counts {
+		tc := NewTimestampedCounts(base - int64(50-10*i))
+		tc.Update(&PodReadCount{"pod1", c, 0, nil})
+		q.Append(tc)
+	}
+
+	assert.Equal(t, PartitionRate{Rate: 10.0}, CalculatePartitionRate(q, 50, "partition1", now))
+	// partition2 has not existed for the whole lookback seconds
+	assert.Equal(t, PartitionRate{Rate: 10.0, Partial: true}, CalculatePartitionRate(q, 50, "partition2", now))
+	assert.Equal(t, PartitionRate{Rate: 10.0, Partial: true}, CalculatePartitionRate(q, 30, "partition2", now))
+	// partition2 exists for the whole lookback seconds
+	assert.Equal(t, PartitionRate{Rate: 10.0}, CalculatePartitionRate(q, 20, "partition2", now))
+	// the partition does not exist yet
+	assert.Equal(t, PartitionRate{Rate: 0}, CalculatePartitionRate(q, 50, "partition3", now))
+}
+
+func TestCalculatePartitionRates(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+
+	// pod1 reads 10 messages per second in the first 5 minutes, and 20 afterward, pod2 joins at -100 reading 30
+	// messages per second, and partition2 is added at -30
+	for ago := int64(600); ago >= 0; ago -= 10 {
+		elapsed := 600 - ago
+		tc := NewTimestampedCounts(base - ago)
+		pod1 := map[string]float64{"partition1": float64(10 * elapsed)}
+		if ago < 300 {
+			pod1["partition1"] = float64(3000 + 20*(300-ago))
+		}
+		if ago <= 30 {
+			pod1["partition2"] = float64(5 * (40 - ago))
+		}
+		tc.Update(&PodReadCount{"pod1", pod1, 0, nil})
+		if ago <= 100 {
+			tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": float64(30 * (110 - ago))}, 0, nil})
+		}
+		q.Append(tc)
+	}
+
+	lookbackSeconds := map[string]int64{"default": 120, "1m": 60, "5m": 300, "10m": 600, "15m": 900}
+	rates := CalculatePartitionRates(q, lookbackSeconds, "partition1", now)
+	assert.Len(t, rates, len(lookbackSeconds))
+	// the windows from -60 to -10, pod2 reads 300 messages in the first window it shows up.
+	assert.InDelta(t, 50, rates["1m"].Rate, 1e-9)
+	assert.InDelta(t, 20+(300+30*90)/110.0, rates["default"].Rate, 1e-9)
+	assert.InDelta(t, (20*290+300+30*90)/290.0, rates["5m"].Rate, 1e-9)
+	assert.InDelta(t, (10*300+20*290+300+30*90)/590.0, rates["10m"].Rate, 1e-9)
+	// the queue is shorter than the lookback seconds, the rate is calculated from the first window
+	assert.InDelta(t, rates["10m"].Rate, rates["15m"].Rate, 1e-9)
+	for key, seconds := range lookbackSeconds {
+		// the rates are the same as calculating the lookback seconds one by one
+		assert.Equal(t, CalculatePartitionRate(q, seconds, "partition1", now), rates[key], key)
+		assert.False(t, rates[key].Partial)
+	}
+
+	// partition2 has not existed for the whole lookback seconds of any window
+	rates = CalculatePartitionRates(q, lookbackSeconds, "partition2", now)
+	for key, seconds := range lookbackSeconds {
+		assert.Equal(t, PartitionRate{Rate: 5, Partial: true}, rates[key], key)
+		assert.Equal(t, CalculatePartitionRate(q, seconds, "partition2", now), rates[key], key)
+	}
+
+	rates = CalculatePartitionRates(q, lookbackSeconds, "partition3", now)
+	assert.Equal(t, PartitionRate{Rate: 0}, rates["15m"])
+	// the last complete window is too old
+	rates = CalculatePartitionRates(q, lookbackSeconds, "partition1", now.Add(time.Hour))
+	assert.Equal(t, PartitionRate{Rate: rateNotAvailable}, rates["default"])
+	assert.Equal(t, PartitionRate{Rate: rateNotAvailable}, rates["15m"])
+}
+
+func TestCalculateAckRates(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+	// pod1 acks 8 messages per second, pod2 acks 2 messages per second and restarts at -30, and partition2 is added to
+	// pod1 at -30 acking 3 messages per second
+	for ago := int64(120); ago >= 0; ago -= 10 {
+		elapsed := float64(120 - ago)
+		tc := NewTimestampedCounts(base - ago)
+		pod1Reads := map[string]float64{"partition1": 10 * elapsed}
+		pod1Acks := map[string]float64{"partition1": 8 * elapsed}
+		if ago <= 30 {
+			pod1Reads["partition2"] = float64(5 * (40 - ago))
+			pod1Acks["partition2"] = float64(3 * (40 - ago))
+		}
+		tc.Update(&PodReadCount{"pod1", pod1Reads, 0, pod1Acks})
+		pod2Acks := 2 * elapsed
+		if ago <= 30 {
+			pod2Acks = float64(2 * (40 - ago))
+		}
+		tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 10 * elapsed}, 0, map[string]float64{"partition1": pod2Acks}})
+		q.Append(tc)
+	}
+
+	lookbackSeconds := map[string]int64{"1m": 60, "2m": 120}
+	rates := CalculateAckRates(q, lookbackSeconds, "partition1", now)
+	// the windows from -60 to -10, pod2 acks 100 messages, 60 of which after the restart
+	assert.InDelta(t, 10, rates["1m"], 1e-9)
+	assert.InDelta(t, (8*110+2*80+60)/110.0, rates["2m"], 1e-9)
+	// partition2 acks since the window before it's added, the same as the processing rate
+	rates = CalculateAckRates(q, lookbackSeconds, "partition2", now)
+	assert.InDelta(t, 3, rates["1m"], 1e-9)
+	assert.InDelta(t, 3, rates["2m"], 1e-9)
+	assert.Equal(t, float64(0), CalculateAckRates(q, lookbackSeconds, "partition3", now)["1m"])
+	// the last complete window is too old
+	assert.Equal(t, rateNotAvailable, CalculateAckRates(q, lookbackSeconds, "partition1", now.Add(time.Hour))["1m"])
+}
+
+func TestCalculateVertexPodRates(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+	// pod1 reads 10 messages per second from each of two partitions, pod2 is stuck, and pod3 leaves after -40.
+	for ago := int64(120); ago >= 0; ago -= 10 {
+		tc := NewTimestampedCounts(base - ago)
+		elapsed := float64(120 - ago)
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10 * elapsed, "partition2": 10 * elapsed}, 0, nil})
+		tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 50}, 0, nil})
+		if ago >= 40 {
+			tc.Update(&PodReadCount{"pod3", map[string]float64{"partition2": 5 * elapsed}, 0, nil})
+		}
+		q.Append(tc)
+	}
+	rates := CalculateVertexPodRates(q, 60, now)
+	assert.Len(t, rates, 3)
+	assert.InDelta(t, 20, rates["pod1"], 1e-9)
+	assert.InDelta(t, 0, rates["pod2"], 1e-9)
+	// pod3 reads 100 messages in the windows from -60 to -40
+	assert.InDelta(t, 2, rates["pod3"], 1e-9)
+	assert.Equal(t, CalculatePodRate(q, 60, "pod3", now), rates["pod3"])
+	// the pods without any count within the lookback seconds are excluded
+	assert.NotContains(t, CalculateVertexPodRates(q, 20, now), "pod3")
+	assert.Empty(t, CalculateVertexPodRates(q, 60, now.Add(time.Hour)))
+}
+
+func TestCalculateErrorRate(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+	// pod1 has 1 error per second, pod2 has 2 errors per second and restarts at -30, pod3 joins at -20.
+	for ago := int64(120); ago >= 0; ago -= 10 {
+		tc := NewTimestampedCounts(base - ago)
+		elapsed := float64(120 - ago)
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10 * elapsed}, elapsed, nil})
+		pod2Errors := 2 * elapsed
+		if ago <= 30 {
+			pod2Errors = 2 * float64(40-ago)
+		}
+		tc.Update(&PodReadCount{"pod2", map[string]float64{"partition2": 10 * elapsed}, pod2Errors, nil})
+		if ago <= 20 {
+			tc.Update(&PodReadCount{"pod3", map[string]float64{"partition1": 10 * elapsed}, float64(5 * (30 - ago) / 10), nil})
+		}
+		q.Append(tc)
+	}
+	// the windows from -60 to -10: pod1 has 50 errors, pod2 has 100 errors, 60 of which after the restart, and pod3 has
+	// 10 errors since it joins
+	assert.InDelta(t, 3.2, CalculateErrorRate(q, 60, now), 1e-9)
+	assert.Equal(t, rateNotAvailable, CalculateErrorRate(sharedqueue.New[*TimestampedCounts](1800), 60, now))
+	assert.Equal(t, rateNotAvailable, CalculateErrorRate(q, 60, now.Add(time.Hour)))
+}
+
+func TestCalculateBurstRates(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+
+	// pod1 reads 10 messages per second, except a burst of 100 messages per second in the window ending at -20, and
+	// partition2 is added at -30 reading 5 messages per second.
+	count := float64(0)
+	for ago := int64(120); ago >= 0; ago -= 10 {
+		if ago == 20 {
+			count += 1000
+		} else if ago < 120 {
+			count += 100
+		}
+		tc := NewTimestampedCounts(base - ago)
+		pod1 := map[string]float64{"partition1": count}
+		if ago <= 30 {
+			pod1["partition2"] = float64(5 * (40 - ago))
+		}
+		tc.Update(&PodReadCount{"pod1", pod1, 0, nil})
+		q.Append(tc)
+	}
+
+	br := CalculateBurstRates(q, 60, "partition1", now)
+	// the window rates within the lookback seconds are 10, 10, 10, 100, 10.
+	assert.InDelta(t, 10, br.P50, 1e-9)
+	assert.InDelta(t, 10+90*0.8, br.P95, 1e-9)
+	alpha := 1 - math.Exp(-10.0/60)
+	ema := 10 + alpha*90
+	ema += alpha * (10 - ema)
+	assert.InDelta(t, ema, br.EMA, 1e-9)
+	// the average is smoothed out by the lookback seconds
+	assert.InDelta(t, 28, CalculateRate(q, 60, "partition1", now), 1e-9)
+
+	// the rates of partition2 start from the window right before it appears
+	assert.Equal(t, BurstRates{EMA: 5, P50: 5, P95: 5}, CalculateBurstRates(q, 60, "partition2", now))
+	assert.Equal(t, BurstRates{}, CalculateBurstRates(q, 60, "partition3", now))
+	// the last complete window is too old
+	assert.Equal(t, BurstRates{EMA: rateNotAvailable, P50: rateNotAvailable, P95: rateNotAvailable}, CalculateBurstRates(q, 60, "partition1", now.Add(time.Hour)))
+}
+
+func TestCalculateWindowRates(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	base := time.Unix(TestTime, 0).Truncate(CountWindow).Unix()
+
+	// pod1 reads 10 messages per second, except a burst of 100 messages per second in the window ending at -20, pod2
+	// joins at -30 reading 5 messages per second, and the window ending at -40 is missed.
+	count := float64(0)
+	for ago := int64(60); ago >= 0; ago -= 10 {
+		if ago == 20 {
+			count += 1000
+		} else if ago < 60 {
+			count += 100
+		}
+		if ago == 40 {
+			continue
+		}
+		tc := NewTimestampedCounts(base - ago)
+		tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": count}, 0, nil})
+		if ago <= 30 {
+			tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": float64(5 * (40 - ago))}, 0, nil})
+		}
+		q.Append(tc)
+	}
+
+	// the last window is incomplete
+	assert.Equal(t, []WindowRate{
+		{Partition: "partition1", Timestamp: base - 50, Rate: 10},
+		{Partition: "partition1", Timestamp: base - 30, Rate: 12.5},
+		{Partition: "partition1", Timestamp: base - 20, Rate: 105},
+		{Partition: "partition1", Timestamp: base - 10, Rate: 15},
+	}, CalculateWindowRates(q, 0, "partition1"))
+	assert.Equal(t, []WindowRate{
+		{Partition: "partition1", Timestamp: base - 10, Rate: 15},
+	}, CalculateWindowRates(q, base-20, "partition1"))
+	assert.Empty(t, CalculateWindowRates(q, base-10, "partition1"))
+	assert.Empty(t, CalculateWindowRates(q, 0, "partition2"))
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, float64(3), percentile([]float64{3}, 95))
+	assert.Equal(t, float64(2), percentile([]float64{1, 2, 3}, 50))
+	assert.InDelta(t, 2.5, percentile([]float64{1, 2, 3, 4}, 50), 1e-9)
+	assert.InDelta(t, 3.85, percentile([]float64{1, 2, 3, 4}, 95), 1e-9)
+	assert.Equal(t, float64(4), percentile([]float64{1, 2, 3, 4}, 100))
+}
+
+func TestCalculateRate_MissedWindows(t *testing.T) {
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+	tests := []struct {
+		name string
+		// windows is the pod counts of partition1 in every window, keyed by the seconds before now, the last window
+		// is the incomplete one.
+		windows         map[int64]map[string]float64
+		lookbackSeconds int64
+		want            float64
+	}{
+		{
+			name: "no missed windows",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 50: {"pod1": 200}, 40: {"pod1": 300}, 30: {"pod1": 400}, 20: {"pod1": 500}, 10: {"pod1": 600}, 0: {"pod1": 700},
+			},
+			lookbackSeconds: 60,
+			want:            10,
+		},
+		{
+			name: "missed windows in between",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 30: {"pod1": 400}, 10: {"pod1": 600}, 0: {"pod1": 700},
+			},
+			lookbackSeconds: 60,
+			want:            10,
+		},
+		{
+			name: "missed scrapes of a pod",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100, "pod2": 100}, 50: {"pod1": 200}, 40: {"pod1": 300}, 30: {"pod1": 400, "pod2": 400}, 20: {"pod1": 500, "pod2": 500}, 10: {"pod1": 600, "pod2": 600}, 0: {"pod1": 700, "pod2": 700},
+			},
+			lookbackSeconds: 60,
+			want:            20,
+		},
+		{
+			name: "last complete window older than lookback",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 50: {"pod1": 200}, 40: {"pod1": 300}, 30: {"pod1": 400}, 0: {"pod1": 700},
+			},
+			lookbackSeconds: 20,
+			want:            10,
+		},
+		{
+			name: "last complete window too old",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 50: {"pod1": 200}, 0: {"pod1": 700},
+			},
+			lookbackSeconds: 20,
+			want:            rateNotAvailable,
+		},
+		{
+			name: "counter reset",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 50: {"pod1": 200}, 40: {"pod1": 300}, 30: {"pod1": 50}, 20: {"pod1": 150}, 10: {"pod1": 250}, 0: {"pod1": 350},
+			},
+			lookbackSeconds: 60,
+			// the count after the reset is the delta of the window, 100 + 100 + 50 + 100 + 100 in 50 seconds.
+			want: 9,
+		},
+		{
+			name: "counter reset with a missed scrape",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 50: {"pod1": 200}, 30: {"pod1": 100}, 20: {"pod1": 200}, 10: {"pod1": 300}, 0: {"pod1": 400},
+			},
+			lookbackSeconds: 60,
+			// 100 + 100 + 100 + 100 in 50 seconds.
+			want: 8,
+		},
+		{
+			name: "scaling from 1 to 3 pods",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 50: {"pod1": 200}, 40: {"pod1": 300, "pod2": 50, "pod3": 50}, 30: {"pod1": 400, "pod2": 150, "pod3": 150}, 20: {"pod1": 500, "pod2": 250, "pod3": 250}, 10: {"pod1": 600, "pod2": 350, "pod3": 350}, 0: {"pod1": 700, "pod2": 450, "pod3": 450},
+			},
+			lookbackSeconds: 60,
+			// pod1 reads 500, pod2 and pod3 read 350 each since they start in 50 seconds.
+			want: 24,
+		},
+		{
+			name: "scaling from 1 to 3 pods with missed windows",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 40: {"pod1": 300, "pod2": 50, "pod3": 50}, 10: {"pod1": 600, "pod2": 350, "pod3": 350}, 0: {"pod1": 700, "pod2": 450, "pod3": 450},
+			},
+			lookbackSeconds: 60,
+			want:            24,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := sharedqueue.New[*TimestampedCounts](1800)
+			for ago := int64(60); ago >= 0; ago -= 10 {
+				counts, ok := tt.windows[ago]
+				if !ok {
+					continue
+				}
+				tc := NewTimestampedCounts(base - ago)
+				for pod, count := range counts {
+					tc.Update(&PodReadCount{pod, map[string]float64{"partition1": count}, 0, nil})
+				}
+				q.Append(tc)
+			}
+			assert.InDelta(t, tt.want, CalculateRate(q, tt.lookbackSeconds, "partition1", now), 1e-9)
+		})
+	}
+}
+
+func TestCalculatePodRate(t *testing.T) {
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+	tests := []struct {
+		name string
+		// windows is the pod counts of partition1 in every window, keyed by the seconds before now, the last window
+		// is the incomplete one.
+		windows map[int64]map[string]float64
+		want    map[string]float64
+	}{
+		{
+			name: "stable pods",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100, "pod2": 100}, 50: {"pod1": 200, "pod2": 150}, 40: {"pod1": 300, "pod2": 200}, 30: {"pod1": 400, "pod2": 250}, 20: {"pod1": 500, "pod2": 300}, 10: {"pod1": 600, "pod2": 350}, 0: {"pod1": 700, "pod2": 400},
+			},
+			want: map[string]float64{"pod1": 10, "pod2": 5},
+		},
+		{
+			name: "pods joining",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100}, 50: {"pod1": 200}, 40: {"pod1": 300, "pod2": 50}, 30: {"pod1": 400, "pod2": 150}, 20: {"pod1": 500, "pod2": 250}, 10: {"pod1": 600, "pod2": 350}, 0: {"pod1": 700, "pod2": 450},
+			},
+			// pod2 reads 350 since it joins.
+			want: map[string]float64{"pod1": 10, "pod2": 7},
+		},
+		{
+			name: "pods leaving",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100, "pod2": 100}, 50: {"pod1": 200, "pod2": 200}, 40: {"pod1": 300, "pod2": 300}, 30: {"pod1": 400}, 20: {"pod1": 500}, 10: {"pod1": 600}, 0: {"pod1": 700},
+			},
+			// pod2 reads 200 before it is scaled down, and does not produce a negative delta afterward.
+			want: map[string]float64{"pod1": 10, "pod2": 4},
+		},
+		{
+			name: "pods joining and leaving with a counter reset",
+			windows: map[int64]map[string]float64{
+				60: {"pod1": 100, "pod2": 500}, 50: {"pod1": 200, "pod2": 600}, 40: {"pod1": 300, "pod3": 100}, 30: {"pod1": 50, "pod3": 200}, 20: {"pod1": 150, "pod3": 300}, 10: {"pod1": 250, "pod3": 400}, 0: {"pod1": 350, "pod3": 500},
+			},
+			want: map[string]float64{"pod1": 9, "pod2": 2, "pod3": 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := sharedqueue.New[*TimestampedCounts](1800)
+			for ago := int64(60); ago >= 0; ago -= 10 {
+				tc := NewTimestampedCounts(base - ago)
+				for pod, count := range tt.windows[ago] {
+					tc.Update(&PodReadCount{pod, map[string]float64{"partition1": count}, 0, nil})
+				}
+				q.Append(tc)
+			}
+			podRates := CalculatePodRates(q, 60, "partition1", now)
+			assert.Len(t, podRates, len(tt.want))
+			sum := float64(0)
+			for pod, want := range tt.want {
+				assert.InDelta(t, want, CalculatePodRate(q, 60, pod, now), 1e-9)
+				assert.InDelta(t, want, podRates[pod], 1e-9)
+				sum += podRates[pod]
+			}
+			// the rates of the pods add up to the rate of the partition
+			assert.InDelta(t, CalculateRate(q, 60, "partition1", now), sum, 1e-9)
+		})
+	}
+}
+
+func TestCalculateRate_PodRestarts(t *testing.T) {
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+	tests := []struct {
+		name string
+		// windows is the successive scrapes of the pod counts of partition1 in every window, keyed by the seconds
+		// before now, the last window is the incomplete one.
+		windows map[int64][]map[string]float64
+		want    map[string]float64
+	}{
+		{
+			name: "counter reset between windows",
+			windows: map[int64][]map[string]float64{
+				60: {{"pod1": 100}}, 50: {{"pod1": 200}}, 40: {{"pod1": 300}}, 30: {{"pod1": 50}}, 20: {{"pod1": 150}}, 10: {{"pod1": 250}}, 0: {{"pod1": 350}},
+			},
+			// pod1 reads 50 since the restart.
+			want: map[string]float64{"pod1": 9},
+		},
+		{
+			name: "restart within a window",
+			windows: map[int64][]map[string]float64{
+				60: {{"pod1": 100}}, 50: {{"pod1": 200}}, 40: {{"pod1": 300}, {"pod1": 20}}, 30: {{"pod1": 120}}, 20: {{"pod1": 220}}, 10: {{"pod1": 320}}, 0: {{"pod1": 420}},
+			},
+			// pod1 reads 100 before the restart and 20 after it in the window.
+			want: map[string]float64{"pod1": 10.4},
+		},
+		{
+			name: "restart within a window overtaken by the new count",
+			windows: map[int64][]map[string]float64{
+				60: {{"pod1": 100}}, 50: {{"pod1": 200}}, 40: {{"pod1": 250}, {"pod1": 10}, {"pod1": 260}}, 30: {{"pod1": 360}}, 20: {{"pod1": 460}}, 10: {{"pod1": 560}}, 0: {{"pod1": 660}},
+			},
+			// pod1 reads 50 before the restart and 260 after it in the window.
+			want: map[string]float64{"pod1": 14.2},
+		},
+		{
+			name: "multiple restarts within a window",
+			windows: map[int64][]map[string]float64{
+				60: {{"pod1": 100}}, 50: {{"pod1": 200}}, 40: {{"pod1": 300}, {"pod1": 50}, {"pod1": 10}}, 30: {{"pod1": 110}}, 20: {{"pod1": 210}}, 10: {{"pod1": 310}}, 0: {{"pod1": 410}},
+			},
+			// pod1 reads 100, 50 and 10 in the window.
+			want: map[string]float64{"pod1": 11.2},
+		},
+		{
+			name: "pod replacement",
+			windows: map[int64][]map[string]float64{
+				60: {{"pod1": 100, "pod2": 100}}, 50: {{"pod1": 200, "pod2": 200}}, 40: {{"pod1": 300, "pod2": 300}}, 30: {{"pod1": 400, "pod3": 100}}, 20: {{"pod1": 500, "pod3": 200}}, 10: {{"pod1": 600, "pod3": 300}}, 0: {{"pod1": 700, "pod3": 400}},
+			},
+			// pod2 is replaced by pod3, which reads 300 since it joins.
+			want: map[string]float64{"pod1": 10, "pod2": 4, "pod3": 6},
+		},
+		{
+			name: "pod name reuse",
+			windows: map[int64][]map[string]float64{
+				60: {{"pod1": 100, "pod2": 1000}}, 50: {{"pod1": 200, "pod2": 1100}}, 40: {{"pod1": 300, "pod2": 1200}, {"pod1": 300, "pod2": 30}}, 30: {{"pod1": 400, "pod2": 130}}, 20: {{"pod1": 500, "pod2": 230}}, 10: {{"pod1": 600, "pod2": 330}}, 0: {{"pod1": 700, "pod2": 430}},
+			},
+			// pod2 is replaced by a new pod with the same name in the window, which reads 330 since it joins.
+			want: map[string]float64{"pod1": 10, "pod2": 10.6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := sharedqueue.New[*TimestampedCounts](1800)
+			for ago := int64(60); ago >= 0; ago -= 10 {
+				tc := NewTimestampedCounts(base - ago)
+				for _, scrape := range tt.windows[ago] {
+					for pod, count := range scrape {
+						tc.Update(&PodReadCount{pod, map[string]float64{"partition1": count}, 0, nil})
+					}
+				}
+				q.Append(tc)
+			}
+			sum := float64(0)
+			podRates := CalculatePodRates(q, 60, "partition1", now)
+			for pod, want := range tt.want {
+				assert.InDelta(t, want, CalculatePodRate(q, 60, pod, now), 1e-9)
+				assert.InDelta(t, want, podRates[pod], 1e-9)
+				sum += want
+			}
+			assert.InDelta(t, sum, CalculateRate(q, 60, "partition1", now), 1e-9)
+		})
+	}
+}
+
+func TestCalculatePodRates(t *testing.T) {
+	q := sharedqueue.New[*TimestampedCounts](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+
+	// both pods read from partition1 and partition2, partition2 is added at -20, pod2 is scaled down at -30
+	windows := []map[string]map[string]float64{
+		{"pod1": {"partition1": 100}, "pod2": {"partition1": 100}},
+		{"pod1": {"partition1": 200}, "pod2": {"partition1": 200}},
+		{"pod1": {"partition1": 300}},
+		{"pod1": {"partition1": 400, "partition2": 100}},
+		{"pod1": {"partition1": 500, "partition2": 300}},
+		{"pod1": {"partition1": 600, "partition2": 500}},
+	}
+	for i, w := range windows {
+		tc := NewTimestampedCounts(base - int64(50-10*i))
+		for pod, c := range w {
+			tc.Update(&PodReadCount{pod, c, 0, nil})
+		}
+		q.Append(tc)
+	}
+
+	assert.Equal(t, map[string]float64{"pod1": 10, "pod2": 2.5}, CalculatePodRates(q, 50, "partition1", now))
+	// the pod rates of a partition added within the lookback seconds are calculated since it's added
+	assert.Equal(t, map[string]float64{"pod1": 15}, CalculatePodRates(q, 50, "partition2", now))
+	assert.Equal(t, CalculateRate(q, 50, "partition2", now), CalculatePodRates(q, 50, "partition2", now)["pod1"])
+	// the pod rate is summed up across the partitions
+	assert.InDelta(t, 17.5, CalculatePodRate(q, 50, "pod1", now), 1e-9)
+	assert.Equal(t, 2.5, CalculatePodRate(q, 50, "pod2", now))
+	assert.Empty(t, CalculatePodRates(q, 50, "partition3", now))
+	// not enough windows
+	assert.Equal(t, rateNotAvailable, CalculatePodRate(q, 50, "pod1", time.Unix(TestTime+3600, 0)))
+	assert.Empty(t, CalculatePodRates(q, 50, "partition1", time.Unix(TestTime+3600, 0)))
+}
+
+func TestUpdatePending(t *testing.T) {
+	q := sharedqueue.New[*TimestampedPendings](1800)
+	UpdatePending(q, TestTime, &PodPending{"pod1", map[string]int64{"partition1": 10}})
+	UpdatePending(q, TestTime, &PodPending{"pod2", map[string]int64{"partition1": 20}})
+	UpdatePending(q, TestTime+10, nil)
+	assert.Equal(t, 2, q.Length())
+	assert.Equal(t, map[string]map[string]int64{"pod1": {"partition1": 10}, "pod2": {"partition1": 20}}, q.Items()[0].PodPartitionPendingSnapshot())
+	assert.Empty(t, q.Items()[1].PodPartitionPendingSnapshot())
+}
+
+func TestCalculateAveragePending(t *testing.T) {
+	q := sharedqueue.New[*TimestampedPendings](1800)
+	now := time.Unix(TestTime, 0)
+	base := now.Truncate(CountWindow).Unix()
+
+	// an empty lookback window returns -1 instead of 0
+	assert.Equal(t, int64(-1), CalculateAveragePending(q, 60, "partition1", now))
+
+	// pod2 reports -1 before it gets the pending of partition1, pod3 never does
+	windows := []map[string]map[string]int64{
+		{"pod1": {"partition1": 100}},
+		{"pod1": {"partition1": 40}, "pod2": {"partition1": -1}, "pod3": {"partition1": -1}},
+		{"pod1": {"partition1": -1}, "pod2": {"partition1": -1}, "pod3": {"partition1": -1}},
+		{"pod1": {"partition1": 20}, "pod2": {"partition1": 30}, "pod3": {"partition1": -1}},
+		{"pod1": {"partition1": 50, "partition2": 0}, "pod2": {"partition1": 50}, "pod3": {"partition1": -1}},
+	}
+	for i, w := range windows {
+		tp := NewTimestampedPendings(base - int64(40-10*i))
+		for pod, p := range w {
+			tp.Update(&PodPending{pod, p})
+		}
+		q.Append(tp)
+	}
+
+	// the window where all the pods report -1 is excluded, (40 + 30 + 50) / 3
+	assert.Equal(t, int64(40), CalculateAveragePending(q, 30, "partition1", now))
+	// (100 + 40 + 30 + 50) / 4
+	assert.Equal(t, int64(55), CalculateAveragePending(q, 60, "partition1", now))
+	assert.Equal(t, int64(50), CalculateAveragePending(q, 0, "partition1", now))
+	// a zero pending is not excluded
+	assert.Equal(t, int64(0), CalculateAveragePending(q, 60, "partition2", now))
+	assert.Equal(t, int64(-1), CalculateAveragePending(q, 60, "partition3", now))
+	// all the pods report -1 within the lookback seconds
+	q = sharedqueue.New[*TimestampedPendings](1800)
+	UpdatePending(q, base, &PodPending{"pod1", map[string]int64{"partition1": -1}})
+	UpdatePending(q, base, &PodPending{"pod2", map[string]int64{"partition1": -1}})
+	assert.Equal(t, int64(-1), CalculateAveragePending(q, 60, "partition1", now))
 }
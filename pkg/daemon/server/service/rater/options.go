@@ -16,11 +16,23 @@ limitations under the License.
 
 package rater
 
+import (
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
 type options struct {
 	// Number of workers working on collecting counts of processed messages.
 	workers int
 	// Time in milliseconds, each element in the work queue will be picked up in an interval of this period of time.
 	taskInterval int
+	// clock is used to timestamp the counts, calculate the rates and pace the workers.
+	clock clock.Clock
+	// countWindow is the time window for which the timestamped counts are maintained.
+	countWindow time.Duration
+	// stateDir is the directory to persist the timestamped counts in, they are only kept in memory if it's empty.
+	stateDir string
 }
 
 type Option func(*options)
@@ -37,6 +49,8 @@ func defaultOptions() *options {
 		// if a CountWindow misses one pod, when calculating the delta with the next window, for that specific pod,
 		// we will count the total processed count as delta, which is wrong and eventually leads to incorrect high processing rate.
 		taskInterval: int(CountWindow.Milliseconds() / 2),
+		clock:        clock.RealClock(),
+		countWindow:  CountWindow,
 	}
 }
 
@@ -51,3 +65,27 @@ func WithTaskInterval(n int) Option {
 		o.taskInterval = n
 	}
 }
+
+// WithClock sets the clock of the rater, it is meant for the tests.
+func WithClock(c clock.Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// WithCountWindow sets the time window for which the timestamped counts are maintained, e.g. a shorter one for the
+// low-latency pipelines, the rates are calculated on the granularity of it. It must be whole seconds, since the counts
+// are timestamped in seconds. The task interval is set to half of it, the same as the default one.
+func WithCountWindow(window time.Duration) Option {
+	return func(o *options) {
+		o.countWindow = window
+		o.taskInterval = int(window.Milliseconds() / 2)
+	}
+}
+
+// WithStateDir sets the directory to persist the timestamped counts in, so that they are restored when the rater restarts.
+func WithStateDir(dir string) Option {
+	return func(o *options) {
+		o.stateDir = dir
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rater
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// countsStateFile is the name of the file in the state directory persisting the timestamped counts.
+const countsStateFile = "timestamped-counts.json"
+
+// persistedCounts is the persisted form of a TimestampedCounts.
+type persistedCounts struct {
+	Timestamp            int64                         `json:"timestamp"`
+	PodPartitionCount    map[string]map[string]float64 `json:"podPartitionCount"`
+	PodErrorCount        map[string]float64            `json:"podErrorCount,omitempty"`
+	PodPartitionAckCount map[string]map[string]float64 `json:"podPartitionAckCount,omitempty"`
+}
+
+// persistCounts saves the timestamped counts to the state directory every count window, and once more when the
+// context is canceled, so that a restarted rater can pick up where it left off.
+func (r *Rater) persistCounts(ctx context.Context) {
+	for {
+		sleep(ctx, r.options.clock, r.options.countWindow)
+		if err := r.saveCounts(); err != nil {
+			r.log.Warnw("Failed to persist the timestamped counts", zap.Error(err))
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// saveCounts writes the timestamped counts of all the vertices to the state directory. The file is written to a
+// temporary file first and then renamed, so a crash while saving never leaves a truncated file behind.
+func (r *Rater) saveCounts() error {
+	state := make(map[string][]persistedCounts, len(r.timestampedPodCounts))
+	for vertexName, q := range r.timestampedPodCounts {
+		items := q.Items()
+		counts := make([]persistedCounts, 0, len(items))
+		for _, tc := range items {
+			counts = append(counts, persistedCounts{Timestamp: tc.timestamp, PodPartitionCount: tc.PodPartitionCountSnapshot(), PodErrorCount: tc.PodErrorCountSnapshot(), PodPartitionAckCount: tc.PodPartitionAckCountSnapshot()})
+		}
+		state[vertexName] = counts
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the timestamped counts, %w", err)
+	}
+	path := filepath.Join(r.options.stateDir, countsStateFile)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write the timestamped counts to %q, %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to rename %q to %q, %w", tmpPath, path, err)
+	}
+	return nil
+}
+
+// restoreCounts loads the timestamped counts persisted by a previous run of the rater. The counts older than the
+// retention, and the ones of the vertices no longer in the pipeline are left out. It's a no-op if nothing is persisted.
+func (r *Rater) restoreCounts() error {
+	path := filepath.Join(r.options.stateDir, countsStateFile)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the timestamped counts from %q, %w", path, err)
+	}
+	state := make(map[string][]persistedCounts)
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to unmarshal the timestamped counts from %q, %w", path, err)
+	}
+	oldest := r.options.clock.Now().Add(-countsRetention).Unix()
+	restored := 0
+	for vertexName, counts := range state {
+		q, ok := r.timestampedPodCounts[vertexName]
+		if !ok {
+			continue
+		}
+		for _, c := range counts {
+			if c.Timestamp < oldest {
+				continue
+			}
+			// the restarts of the pods within the windows are not persisted, the counts reached before them are
+			// not restored, which only lowers the rates of the windows the pods restarted in.
+			for podName, partitionCounts := range c.PodPartitionCount {
+				UpdateCount(q, c.Timestamp, &PodReadCount{podName, partitionCounts, c.PodErrorCount[podName], c.PodPartitionAckCount[podName]})
+			}
+			restored++
+		}
+	}
+	r.log.Infow("Restored the timestamped counts", zap.String("path", path), zap.Int("windows", restored))
+	return nil
+}
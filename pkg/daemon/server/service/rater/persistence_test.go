@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rater
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+func TestRater_PersistCounts(t *testing.T) {
+	dir := t.TempDir()
+	newPipeline := func(vertices ...string) *v1alpha1.Pipeline {
+		pl := &v1alpha1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "default"}}
+		for _, v := range vertices {
+			pl.Spec.Vertices = append(pl.Spec.Vertices, v1alpha1.AbstractVertex{Name: v})
+		}
+		return pl
+	}
+	now := time.Unix(TestTime, 0)
+	r := NewRater(context.Background(), newPipeline("v", "removed"), WithClock(clock.NewFakeClock(now)), WithStateDir(dir))
+	base := now.Truncate(CountWindow).Unix()
+	// 10 messages per second since 40 minutes ago, the windows older than 30 minutes have been dropped by the queue
+	for ago := int64(2400); ago >= 0; ago -= 10 {
+		count := float64(10 * (2400 - ago))
+		UpdateCount(r.timestampedPodCounts["v"], base-ago, &PodReadCount{"pod", map[string]float64{"partition": count}, 0, nil})
+		UpdateCount(r.timestampedPodCounts["removed"], base-ago, &PodReadCount{"pod", map[string]float64{"partition": count}, 0, nil})
+	}
+	assert.NoError(t, r.saveCounts())
+	_, err := os.Stat(filepath.Join(dir, countsStateFile+".tmp"))
+	assert.True(t, os.IsNotExist(err))
+
+	t.Run("restore after a restart", func(t *testing.T) {
+		// the rater restarts a minute later, the counts older than 30 minutes by then are left out, i.e. the last 1740 seconds are kept
+		restarted := NewRater(context.Background(), newPipeline("v", "added"), WithClock(clock.NewFakeClock(now.Add(time.Minute))), WithStateDir(dir))
+		assert.NoError(t, restarted.restoreCounts())
+		assert.Equal(t, 175, restarted.timestampedPodCounts["v"].Length())
+		assert.Equal(t, 0, restarted.timestampedPodCounts["added"].Length())
+		assert.NotContains(t, restarted.timestampedPodCounts, "removed")
+		// the new counts are calculated against the restored ones
+		nowCount := float64(10 * (2400 + 60))
+		UpdateCount(restarted.timestampedPodCounts["v"], base+60, &PodReadCount{"pod", map[string]float64{"partition": nowCount}, 0, nil})
+		assert.InDelta(t, 10, restarted.GetRates("v", "partition")["15m"].GetValue(), 1e-9)
+	})
+
+	t.Run("nothing persisted", func(t *testing.T) {
+		fresh := NewRater(context.Background(), newPipeline("v"), WithClock(clock.NewFakeClock(now)), WithStateDir(t.TempDir()))
+		assert.NoError(t, fresh.restoreCounts())
+		assert.Equal(t, 0, fresh.timestampedPodCounts["v"].Length())
+	})
+
+	t.Run("corrupted file", func(t *testing.T) {
+		corruptedDir := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(corruptedDir, countsStateFile), []byte("{"), 0o644))
+		corrupted := NewRater(context.Background(), newPipeline("v"), WithClock(clock.NewFakeClock(now)), WithStateDir(corruptedDir))
+		assert.Error(t, corrupted.restoreCounts())
+		assert.Equal(t, 0, corrupted.timestampedPodCounts["v"].Length())
+	})
+}
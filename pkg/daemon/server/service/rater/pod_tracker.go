@@ -27,6 +27,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 )
@@ -44,6 +45,7 @@ type PodTracker struct {
 	httpClient      metricsHttpClient
 	activePods      *util.UniqueStringList
 	refreshInterval time.Duration
+	clock           clock.Clock
 }
 
 func NewPodTracker(ctx context.Context, p *v1alpha1.Pipeline, opts ...PodTrackerOption) *PodTracker {
@@ -58,6 +60,7 @@ func NewPodTracker(ctx context.Context, p *v1alpha1.Pipeline, opts ...PodTracker
 		},
 		activePods:      util.NewUniqueStringList(),
 		refreshInterval: 30 * time.Second, // Default refresh interval for updating the active pod set
+		clock:           clock.RealClock(),
 	}
 
 	for _, opt := range opts {
@@ -77,6 +80,13 @@ func WithRefreshInterval(d time.Duration) PodTrackerOption {
 	}
 }
 
+// WithPodTrackerClock sets the clock driving the refreshes of the active pod set.
+func WithPodTrackerClock(c clock.Clock) PodTrackerOption {
+	return func(r *PodTracker) {
+		r.clock = c
+	}
+}
+
 func (pt *PodTracker) Start(ctx context.Context) error {
 	pt.log.Debugf("Starting tracking active pods for pipeline %s...", pt.pipeline.Name)
 	go pt.trackActivePods(ctx)
@@ -84,14 +94,14 @@ func (pt *PodTracker) Start(ctx context.Context) error {
 }
 
 func (pt *PodTracker) trackActivePods(ctx context.Context) {
-	ticker := time.NewTicker(pt.refreshInterval)
+	ticker := pt.clock.NewTicker(pt.refreshInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			pt.log.Infof("Context is cancelled. Stopping tracking active pods for pipeline %s...", pt.pipeline.Name)
 			return
-		case <-ticker.C:
+		case <-ticker.C():
 			pt.updateActivePods()
 		}
 	}
@@ -132,6 +142,22 @@ func (pt *PodTracker) GetActivePodsCount() int {
 	return pt.activePods.Length()
 }
 
+// GetActiveReplicas returns the number of active pods of a vertex.
+func (pt *PodTracker) GetActiveReplicas(vertexName string) int {
+	count := 0
+	for _, v := range pt.pipeline.Spec.Vertices {
+		if v.Name != vertexName {
+			continue
+		}
+		for i := 0; i < int(v.Scale.GetMaxReplicas()); i++ {
+			if pt.activePods.Contains(pt.getPodKey(i, vertexName)) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
 // podInfo represents the information of a pod that is used for tracking the processing rate
 type podInfo struct {
 	pipelineName string
@@ -23,12 +23,14 @@ import (
 	"net/http"
 	"time"
 
+	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
 )
@@ -36,15 +38,24 @@ import (
 type Ratable interface {
 	Start(ctx context.Context) error
 	GetRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue
+	GetPodRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue
+	GetBurstRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue
+	GetVertexPodRates(vertexName string) map[string]*wrapperspb.DoubleValue
+	GetPendings(vertexName, partitionName string) map[string]*wrapperspb.Int64Value
+	GetErrorRates(vertexName string) map[string]*wrapperspb.DoubleValue
+	GetAckRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue
 }
 
 var _ Ratable = (*Rater)(nil)
 
-// CountWindow is the time window for which we maintain the timestamped counts, currently 10 seconds
+// CountWindow is the default time window for which we maintain the timestamped counts, currently 10 seconds
 // e.g., if the current time is 12:00:07,
 // the retrieved count will be tracked in the 12:00:00-12:00:10 time window using 12:00:10 as the timestamp
 const CountWindow = time.Second * 10
 
+// countsRetention is how long the timestamped counts are kept, since we support 1m, 5m, 15m lookback seconds.
+const countsRetention = time.Minute * 30
+
 // metricsHttpClient interface for the GET/HEAD call to metrics endpoint.
 // Had to add this an interface for testing
 type metricsHttpClient interface {
@@ -52,6 +63,21 @@ type metricsHttpClient interface {
 	Head(url string) (*http.Response, error)
 }
 
+// ackTotalMetricName is the counter of the messages acknowledged by a vertex pod, the same for the vertices in Go and
+// in Rust.
+const ackTotalMetricName = "forwarder_ack_total"
+
+// errorMetricNames are the counters of the errors and the dropped messages of a vertex pod, summed up as the error count
+// of the pod. The vertices in Rust report the dropped messages as forwarder_dropped_total, the others are reported by
+// the vertices in Go.
+var errorMetricNames = []string{
+	"forwarder_read_error_total",
+	"forwarder_write_error_total",
+	"forwarder_ack_error_total",
+	"forwarder_drop_total",
+	"forwarder_dropped_total",
+}
+
 // fixedLookbackSeconds always maintain rate metrics for the following lookback seconds (1m, 5m, 15m)
 var fixedLookbackSeconds = map[string]int64{"1m": 60, "5m": 300, "15m": 900}
 
@@ -64,6 +90,8 @@ type Rater struct {
 	podTracker *PodTracker
 	// timestampedPodCounts is a map between vertex name and a queue of timestamped counts for that vertex
 	timestampedPodCounts map[string]*sharedqueue.OverflowQueue[*TimestampedCounts]
+	// timestampedPodPendings is a map between vertex name and a queue of timestamped pending counts for that vertex
+	timestampedPodPendings map[string]*sharedqueue.OverflowQueue[*TimestampedPendings]
 	// userSpecifiedLookBackSeconds is a map between vertex name and the user-specified lookback seconds for that vertex
 	userSpecifiedLookBackSeconds map[string]int64
 	options                      *options
@@ -75,6 +103,10 @@ type PodReadCount struct {
 	name string
 	// key represents partition name, value represents the count of messages read by the corresponding partition
 	partitionReadCounts map[string]float64
+	// the total count of the errors and the dropped messages of the pod, across all the partitions
+	errorCount float64
+	// key represents partition name, value represents the count of messages acknowledged by the corresponding partition
+	partitionAckCounts map[string]float64
 }
 
 func (p *PodReadCount) Name() string {
@@ -85,6 +117,14 @@ func (p *PodReadCount) PartitionReadCounts() map[string]float64 {
 	return p.partitionReadCounts
 }
 
+func (p *PodReadCount) ErrorCount() float64 {
+	return p.errorCount
+}
+
+func (p *PodReadCount) PartitionAckCounts() map[string]float64 {
+	return p.partitionAckCounts
+}
+
 func NewRater(ctx context.Context, p *v1alpha1.Pipeline, opts ...Option) *Rater {
 	rater := Rater{
 		pipeline: p,
@@ -96,22 +136,23 @@ func NewRater(ctx context.Context, p *v1alpha1.Pipeline, opts ...Option) *Rater
 		},
 		log:                          logging.FromContext(ctx).Named("Rater"),
 		timestampedPodCounts:         make(map[string]*sharedqueue.OverflowQueue[*TimestampedCounts]),
+		timestampedPodPendings:       make(map[string]*sharedqueue.OverflowQueue[*TimestampedPendings]),
 		userSpecifiedLookBackSeconds: make(map[string]int64),
 		options:                      defaultOptions(),
 	}
 
-	rater.podTracker = NewPodTracker(ctx, p)
-	for _, v := range p.Spec.Vertices {
-		// maintain the total counts of the last 30 minutes(1800 seconds) since we support 1m, 5m, 15m lookback seconds.
-		rater.timestampedPodCounts[v.Name] = sharedqueue.New[*TimestampedCounts](int(1800 / CountWindow.Seconds()))
-		rater.userSpecifiedLookBackSeconds[v.Name] = int64(v.Scale.GetLookbackSeconds())
-	}
-
 	for _, opt := range opts {
 		if opt != nil {
 			opt(rater.options)
 		}
 	}
+
+	for _, v := range p.Spec.Vertices {
+		rater.timestampedPodCounts[v.Name] = sharedqueue.New[*TimestampedCounts](int(countsRetention / rater.options.countWindow))
+		rater.timestampedPodPendings[v.Name] = sharedqueue.New[*TimestampedPendings](int(countsRetention / rater.options.countWindow))
+		rater.userSpecifiedLookBackSeconds[v.Name] = int64(v.Scale.GetLookbackSeconds())
+	}
+	rater.podTracker = NewPodTracker(ctx, p, WithPodTrackerClock(rater.options.clock))
 	return &rater
 }
 
@@ -140,8 +181,9 @@ func (r *Rater) monitorOnePod(ctx context.Context, key string, worker int) error
 		return err
 	}
 	var podReadCount *PodReadCount
+	var podPending *PodPending
 	if r.podTracker.IsActive(key) {
-		podReadCount = r.getPodReadCounts(podInfo.vertexName, podInfo.podName)
+		podReadCount, podPending = r.getPodMetrics(podInfo.vertexName, podInfo.podName)
 		if podReadCount == nil {
 			log.Debugf("Failed retrieving total podReadCount for pod %s", podInfo.podName)
 		}
@@ -149,8 +191,9 @@ func (r *Rater) monitorOnePod(ctx context.Context, key string, worker int) error
 		log.Debugf("Pod %s does not exist, updating it with nil...", podInfo.podName)
 		podReadCount = nil
 	}
-	now := time.Now().Add(CountWindow).Truncate(CountWindow).Unix()
+	now := r.options.clock.Now().Add(r.options.countWindow).Truncate(r.options.countWindow).Unix()
 	UpdateCount(r.timestampedPodCounts[podInfo.vertexName], now, podReadCount)
+	UpdatePending(r.timestampedPodPendings[podInfo.vertexName], now, podPending)
 	return nil
 }
 
@@ -160,6 +203,14 @@ func (r *Rater) Start(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(logging.WithLogger(ctx, r.log))
 	defer cancel()
 
+	if r.options.stateDir != "" {
+		// restore the counts before any new count is collected, so that the rates don't restart from zero
+		if err := r.restoreCounts(); err != nil {
+			r.log.Warnw("Failed to restore the timestamped counts, calculating the rates from scratch", zap.Error(err))
+		}
+		go r.persistCounts(ctx)
+	}
+
 	go func() {
 		err := r.podTracker.Start(ctx)
 		if err != nil {
@@ -190,7 +241,7 @@ func (r *Rater) Start(ctx context.Context) error {
 		default:
 			assign()
 			// Make sure each of the key will be assigned at least every taskInterval milliseconds.
-			sleep(ctx, time.Millisecond*time.Duration(func() int {
+			sleep(ctx, r.options.clock, time.Millisecond*time.Duration(func() int {
 				l := r.podTracker.GetActivePodsCount()
 				if l == 0 {
 					return r.options.taskInterval
@@ -207,23 +258,37 @@ func (r *Rater) Start(ctx context.Context) error {
 
 // sleep function uses a select statement to check if the context is canceled before sleeping for the given duration
 // it helps ensure the sleep will be released when the context is canceled, allowing the goroutine to exit gracefully
-func sleep(ctx context.Context, duration time.Duration) {
+func sleep(ctx context.Context, c clock.Clock, duration time.Duration) {
 	select {
 	case <-ctx.Done():
-	case <-time.After(duration):
+	case <-c.After(duration):
 	}
 }
 
-// getPodReadCounts returns the total number of messages read by the pod
-// since a pod can read from multiple partitions, we will return a map of partition to read count.
-func (r *Rater) getPodReadCounts(vertexName, podName string) *PodReadCount {
-	readTotalMetricName := "forwarder_data_read_total"
-	// scrape the read total metric from pod metric port
+// GetCountWindow returns the time window for which the timestamped counts are maintained.
+func (r *Rater) GetCountWindow() time.Duration {
+	return r.options.countWindow
+}
+
+// now returns the current time truncated to the count window, i.e., the timestamp of the last complete window.
+func (r *Rater) now() time.Time {
+	return r.options.clock.Now().Truncate(r.options.countWindow)
+}
+
+// GetActiveReplicas returns the number of active pods of a vertex, as observed by the pod tracker.
+func (r *Rater) GetActiveReplicas(vertexName string) int {
+	return r.podTracker.GetActiveReplicas(vertexName)
+}
+
+// getPodMetrics scrapes the metrics endpoint of the pod once and returns both the read counts and the pending counts
+// reported by the pod, either of them is nil if it's not available.
+func (r *Rater) getPodMetrics(vertexName, podName string) (*PodReadCount, *PodPending) {
+	// scrape the metrics from pod metric port
 	url := fmt.Sprintf("https://%s.%s.%s.svc:%v/metrics", podName, r.pipeline.Name+"-"+vertexName+"-headless", r.pipeline.Namespace, v1alpha1.VertexMetricsPort)
 	resp, err := r.httpClient.Get(url)
 	if err != nil {
 		r.log.Warnf("[vertex name %s, pod name %s]: failed reading the metrics endpoint, the pod might have been scaled down: %v", vertexName, podName, err.Error())
-		return nil
+		return nil, nil
 	}
 	defer resp.Body.Close()
 
@@ -231,9 +296,15 @@ func (r *Rater) getPodReadCounts(vertexName, podName string) *PodReadCount {
 	result, err := textParser.TextToMetricFamilies(resp.Body)
 	if err != nil {
 		r.log.Errorf("[vertex name %s, pod name %s]: failed parsing to prometheus metric families, %v", vertexName, podName, err.Error())
-		return nil
+		return nil, nil
 	}
+	return r.getPodReadCounts(vertexName, podName, result), r.getPodPendings(vertexName, podName, result)
+}
 
+// getPodReadCounts returns the total number of messages read by the pod
+// since a pod can read from multiple partitions, we will return a map of partition to read count.
+func (r *Rater) getPodReadCounts(vertexName, podName string, result map[string]*dto.MetricFamily) *PodReadCount {
+	readTotalMetricName := "forwarder_data_read_total"
 	if value, ok := result[readTotalMetricName]; ok && value != nil && len(value.GetMetric()) > 0 {
 		metricsList := value.GetMetric()
 		partitionReadCount := make(map[string]float64)
@@ -257,7 +328,7 @@ func (r *Rater) getPodReadCounts(vertexName, podName string) *PodReadCount {
 				partitionReadCount[partitionName] = counterVal
 			}
 		}
-		podReadCount := &PodReadCount{podName, partitionReadCount}
+		podReadCount := &PodReadCount{podName, partitionReadCount, getPodErrorCount(result), getPodAckCounts(result)}
 		return podReadCount
 	} else {
 		r.log.Infof("[vertex name %s, pod name %s]: Metric %q is unavailable, the pod might haven't started processing data", vertexName, podName, readTotalMetricName)
@@ -265,20 +336,197 @@ func (r *Rater) getPodReadCounts(vertexName, podName string) *PodReadCount {
 	}
 }
 
+// getPodAckCounts returns the number of messages acknowledged by the pod, keyed by the partition name, it's nil if the
+// pod has not acknowledged any message yet.
+func getPodAckCounts(result map[string]*dto.MetricFamily) map[string]float64 {
+	value, ok := result[ackTotalMetricName]
+	if !ok || value == nil || len(value.GetMetric()) == 0 {
+		return nil
+	}
+	partitionAckCounts := make(map[string]float64)
+	for _, ele := range value.GetMetric() {
+		var partitionName string
+		for _, label := range ele.Label {
+			if label.GetName() == metrics.LabelPartitionName {
+				partitionName = label.GetValue()
+				break
+			}
+		}
+		if partitionName == "" {
+			continue
+		}
+		counterVal := ele.Counter.GetValue()
+		untypedVal := ele.Untyped.GetValue()
+		if counterVal == 0 && untypedVal != 0 {
+			counterVal = untypedVal
+		}
+		partitionAckCounts[partitionName] = counterVal
+	}
+	return partitionAckCounts
+}
+
+// getPodErrorCount returns the sum of the error and the dropped message counters of the pod, across all the labels.
+// A counter not reported yet, e.g. no error has happened, counts as zero.
+func getPodErrorCount(result map[string]*dto.MetricFamily) float64 {
+	total := float64(0)
+	for _, name := range errorMetricNames {
+		value, ok := result[name]
+		if !ok || value == nil {
+			continue
+		}
+		for _, ele := range value.GetMetric() {
+			counterVal := ele.Counter.GetValue()
+			untypedVal := ele.Untyped.GetValue()
+			if counterVal == 0 && untypedVal != 0 {
+				counterVal = untypedVal
+			}
+			total += counterVal
+		}
+	}
+	return total
+}
+
+// getPodPendings returns the pending counts of the partitions reported by the pod over the default lookback seconds.
+func (r *Rater) getPodPendings(vertexName, podName string, result map[string]*dto.MetricFamily) *PodPending {
+	value, ok := result[metrics.VertexPendingMessages]
+	if !ok || value == nil || len(value.GetMetric()) == 0 {
+		r.log.Debugf("[vertex name %s, pod name %s]: Metric %q is unavailable", vertexName, podName, metrics.VertexPendingMessages)
+		return nil
+	}
+	partitionPendings := make(map[string]int64)
+	for _, ele := range value.GetMetric() {
+		var partitionName, period string
+		for _, label := range ele.Label {
+			switch label.GetName() {
+			case metrics.LabelPartitionName:
+				partitionName = label.GetValue()
+			case metrics.LabelPeriod:
+				period = label.GetValue()
+			}
+		}
+		// only the pending over the default lookback seconds is tracked, the rater averages it over the other ones
+		if partitionName == "" || period != "default" {
+			continue
+		}
+		gaugeVal := ele.Gauge.GetValue()
+		untypedVal := ele.Untyped.GetValue()
+		if gaugeVal == 0 && untypedVal != 0 {
+			gaugeVal = untypedVal
+		}
+		partitionPendings[partitionName] = int64(gaugeVal)
+	}
+	return &PodPending{podName, partitionPendings}
+}
+
 // GetRates returns the processing rates of the vertex partition in the format of lookback second to rate mappings
 func (r *Rater) GetRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue {
 	r.log.Debugf("Getting rates for vertex %s, partition %s", vertexName, partitionName)
 	r.log.Debugf("Current timestampedPodCounts for vertex %s is: %v", vertexName, r.timestampedPodCounts[vertexName])
 	var result = make(map[string]*wrapperspb.DoubleValue)
-	// calculate rates for each lookback seconds
-	for n, i := range r.buildLookbackSecondsMap(vertexName) {
-		r := CalculateRate(r.timestampedPodCounts[vertexName], i, partitionName)
-		result[n] = wrapperspb.Double(r)
+	now := r.now()
+	// calculate rates for all the lookback seconds at once
+	lookbackSecondsMap := r.buildLookbackSecondsMap(vertexName)
+	for n, pr := range CalculatePartitionRates(r.timestampedPodCounts[vertexName], lookbackSecondsMap, partitionName, now) {
+		if pr.Partial {
+			r.log.Debugf("Partition %s of vertex %s is added within the lookback of %d seconds, the %s rate is calculated since it's added", partitionName, vertexName, lookbackSecondsMap[n], n)
+		}
+		result[n] = wrapperspb.Double(pr.Rate)
 	}
 	r.log.Debugf("Got rates for vertex %s, partition %s: %v", vertexName, partitionName, result)
 	return result
 }
 
+// GetPodRates returns the processing rates of the pods reading from the vertex partition over the default lookback
+// seconds, in the format of pod name to rate mappings
+func (r *Rater) GetPodRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue {
+	var result = make(map[string]*wrapperspb.DoubleValue)
+	podRates := CalculatePodRates(r.timestampedPodCounts[vertexName], r.userSpecifiedLookBackSeconds[vertexName], partitionName, r.now())
+	for podName, rate := range podRates {
+		result[podName] = wrapperspb.Double(rate)
+	}
+	r.log.Debugf("Got pod rates for vertex %s, partition %s: %v", vertexName, partitionName, result)
+	return result
+}
+
+// GetVertexPodRates returns the processing rates of all the pods of the vertex over the default lookback seconds, summed
+// up across the partitions they read from, in the format of pod name to rate mappings
+func (r *Rater) GetVertexPodRates(vertexName string) map[string]*wrapperspb.DoubleValue {
+	var result = make(map[string]*wrapperspb.DoubleValue)
+	for podName, rate := range CalculateVertexPodRates(r.timestampedPodCounts[vertexName], r.userSpecifiedLookBackSeconds[vertexName], r.now()) {
+		result[podName] = wrapperspb.Double(rate)
+	}
+	r.log.Debugf("Got pod rates for vertex %s: %v", vertexName, result)
+	return result
+}
+
+// GetBurstRates returns the burst rates of the vertex partition over the default lookback seconds, in the format of
+// "ema", "p50" and "p95" to rate mappings
+func (r *Rater) GetBurstRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue {
+	br := CalculateBurstRates(r.timestampedPodCounts[vertexName], r.userSpecifiedLookBackSeconds[vertexName], partitionName, r.now())
+	result := map[string]*wrapperspb.DoubleValue{
+		"ema": wrapperspb.Double(br.EMA),
+		"p50": wrapperspb.Double(br.P50),
+		"p95": wrapperspb.Double(br.P95),
+	}
+	r.log.Debugf("Got burst rates for vertex %s, partition %s: %v", vertexName, partitionName, result)
+	return result
+}
+
+// GetWindowRates returns the rates of all the vertex partitions in the complete windows ending after the since
+// timestamp, in seconds since the epoch.
+func (r *Rater) GetWindowRates(since int64) []WindowRate {
+	var result []WindowRate
+	for _, v := range r.pipeline.Spec.Vertices {
+		partitions := v.OwnedBufferNames(r.pipeline.Namespace, r.pipeline.Name)
+		// source vertex will have a single partition, which is the vertex name itself
+		if v.IsASource() {
+			partitions = append(partitions, v.Name)
+		}
+		for _, partitionName := range partitions {
+			for _, wr := range CalculateWindowRates(r.timestampedPodCounts[v.Name], since, partitionName) {
+				wr.Vertex = v.Name
+				result = append(result, wr)
+			}
+		}
+	}
+	return result
+}
+
+// GetPendings returns the average pending counts of the vertex partition in the format of lookback second to pending
+// mappings, the pending is -1 if it's not available within the lookback seconds
+func (r *Rater) GetPendings(vertexName, partitionName string) map[string]*wrapperspb.Int64Value {
+	var result = make(map[string]*wrapperspb.Int64Value)
+	now := r.now()
+	for n, i := range r.buildLookbackSecondsMap(vertexName) {
+		result[n] = wrapperspb.Int64(CalculateAveragePending(r.timestampedPodPendings[vertexName], i, partitionName, now))
+	}
+	r.log.Debugf("Got pendings for vertex %s, partition %s: %v", vertexName, partitionName, result)
+	return result
+}
+
+// GetErrorRates returns the rates of the errors and the dropped messages of the vertex, across all the partitions, in
+// the format of lookback second to rate mappings
+func (r *Rater) GetErrorRates(vertexName string) map[string]*wrapperspb.DoubleValue {
+	var result = make(map[string]*wrapperspb.DoubleValue)
+	now := r.now()
+	for n, i := range r.buildLookbackSecondsMap(vertexName) {
+		result[n] = wrapperspb.Double(CalculateErrorRate(r.timestampedPodCounts[vertexName], i, now))
+	}
+	r.log.Debugf("Got error rates for vertex %s: %v", vertexName, result)
+	return result
+}
+
+// GetAckRates returns the rates of the messages acknowledged by the vertex partition in the format of lookback second
+// to rate mappings
+func (r *Rater) GetAckRates(vertexName, partitionName string) map[string]*wrapperspb.DoubleValue {
+	var result = make(map[string]*wrapperspb.DoubleValue)
+	for n, rate := range CalculateAckRates(r.timestampedPodCounts[vertexName], r.buildLookbackSecondsMap(vertexName), partitionName, r.now()) {
+		result[n] = wrapperspb.Double(rate)
+	}
+	r.log.Debugf("Got ack rates for vertex %s, partition %s: %v", vertexName, partitionName, result)
+	return result
+}
+
 func (r *Rater) buildLookbackSecondsMap(vertexName string) map[string]int64 {
 	lookbackSecondsMap := map[string]int64{"default": r.userSpecifiedLookBackSeconds[vertexName]}
 	for k, v := range fixedLookbackSeconds {
@@ -23,13 +23,19 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 )
 
 type raterMockHttpClient struct {
@@ -51,6 +57,10 @@ func (m *raterMockHttpClient) Get(url string) (*http.Response, error) {
 # HELP forwarder_data_read_total Total number of Messages Read
 # TYPE forwarder_data_read_total counter
 forwarder_data_read_total{buffer="input",pipeline="simple-pipeline",vertex="input",replica="0",partition_name="p-v-0"} %d
+# HELP vertex_pending_messages Average pending messages in the last period of seconds. It is the pending messages of a vertex, not a pod.
+# TYPE vertex_pending_messages gauge
+vertex_pending_messages{partition_name="p-v-0",period="1m",pipeline="simple-pipeline",vertex="input"} 100
+vertex_pending_messages{partition_name="p-v-0",period="default",pipeline="simple-pipeline",vertex="input"} 30
 `, m.podOneCount))))}
 		return resp, nil
 	} else if url == "https://p-v-1.p-v-headless.default.svc:2469/metrics" {
@@ -106,35 +116,135 @@ func TestRater_Start(t *testing.T) {
 			},
 		},
 	}
-	r := NewRater(ctx, pipeline, WithTaskInterval(1000))
-	podTracker := NewPodTracker(ctx, pipeline, WithRefreshInterval(time.Second*1))
+	fakeClock := clock.NewFakeClock(time.Unix(TestTime, 0))
+	r := NewRater(ctx, pipeline, WithTaskInterval(1000), WithClock(fakeClock))
+	podTracker := NewPodTracker(ctx, pipeline, WithRefreshInterval(time.Second*1), WithPodTrackerClock(fakeClock))
 	podTracker.httpClient = &raterMockHttpClient{podOneCount: 0, podTwoCount: 0, lock: &sync.RWMutex{}}
 	r.httpClient = &raterMockHttpClient{podOneCount: 0, podTwoCount: 0, lock: &sync.RWMutex{}}
 	r.podTracker = podTracker
 
-	timer := time.NewTimer(60 * time.Second)
-	succeedChan := make(chan struct{})
 	go func() {
 		if err := r.Start(ctx); err != nil {
 			log.Fatalf("failed to start rater: %v", err)
 		}
 	}()
-	go func() {
-		for {
-			if r.GetRates("v", "p-v-0")["default"].GetValue() <= 0 || r.GetRates("v", "p-v-1")["default"].GetValue() <= 0 {
-				time.Sleep(time.Second)
+	// move the clock forward until the counts of enough windows are collected to calculate the rate.
+	assert.Eventually(t, func() bool {
+		fakeClock.Step(100 * time.Millisecond)
+		return r.GetRates("v", "p-v-0")["default"].GetValue() > 0 && r.GetRates("v", "p-v-1")["default"].GetValue() > 0
+	}, 20*time.Second, time.Millisecond, "timed out waiting for rate to be calculated")
+	// the rates of the vertex specific and the fixed lookback seconds are returned
+	rates := r.GetRates("v", "p-v-0")
+	for _, key := range []string{"default", "1m", "5m", "15m"} {
+		assert.Contains(t, rates, key)
+	}
+	// each partition is read by a single pod
+	assert.Contains(t, r.GetPodRates("v", "p-v-0"), "p-v-0")
+	assert.Contains(t, r.GetPodRates("v", "p-v-1"), "p-v-1")
+	burstRates := r.GetBurstRates("v", "p-v-0")
+	for _, key := range []string{"ema", "p50", "p95"} {
+		assert.Greater(t, burstRates[key].GetValue(), float64(0))
+	}
+	// only the pending of the default period is tracked, and the pod of p-v-1 doesn't report any pending
+	assert.Equal(t, int64(30), r.GetPendings("v", "p-v-0")["default"].GetValue())
+	assert.Equal(t, int64(-1), r.GetPendings("v", "p-v-1")["default"].GetValue())
+}
+
+// TestRater_LookbackSecondsPerVertex tests that the default rates of a vertex are calculated over its own lookback seconds
+func TestRater_LookbackSecondsPerVertex(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "slow", Scale: v1alpha1.Scale{LookbackSeconds: ptr.To[uint32](300)}},
+				{Name: "bursty", Scale: v1alpha1.Scale{LookbackSeconds: ptr.To[uint32](30)}},
+				// the lookback seconds larger than the max one are capped
+				{Name: "capped", Scale: v1alpha1.Scale{LookbackSeconds: ptr.To[uint32](3600)}},
+			},
+		},
+	}
+	now := time.Unix(TestTime, 0)
+	r := NewRater(context.Background(), pipeline, WithClock(clock.NewFakeClock(now)))
+	base := now.Truncate(CountWindow).Unix()
+	for _, v := range pipeline.Spec.Vertices {
+		// 10 messages per second in the first 10 minutes, and 100 messages per second in the last minute
+		count := float64(0)
+		for ago := int64(720); ago >= 0; ago -= 10 {
+			if ago < 60 {
+				count += 1000
 			} else {
-				succeedChan <- struct{}{}
-				break
+				count += 100
 			}
+			UpdateCount(r.timestampedPodCounts[v.Name], base-ago, &PodReadCount{"pod", map[string]float64{v.Name: count}, 0, nil})
 		}
-	}()
-	select {
-	case <-succeedChan:
-		time.Sleep(time.Second)
-		break
-	case <-timer.C:
-		t.Fatalf("timed out waiting for rate to be calculated")
 	}
-	timer.Stop()
+	// the windows from -300 or -30 to -10
+	assert.InDelta(t, (100*24+1000*5)/290.0, r.GetRates("slow", "slow")["default"].GetValue(), 1e-9)
+	assert.InDelta(t, 100, r.GetRates("bursty", "bursty")["default"].GetValue(), 1e-9)
+	assert.InDelta(t, (100*54+1000*5)/590.0, r.GetRates("capped", "capped")["default"].GetValue(), 1e-9)
+	// the fixed lookback seconds are the same for all the vertices
+	for _, v := range []string{"slow", "bursty", "capped"} {
+		assert.InDelta(t, 100, r.GetRates(v, v)["1m"].GetValue(), 1e-9)
+	}
+}
+
+// TestRater_CountWindow tests that the rates are calculated on the granularity of the configured count window
+func TestRater_CountWindow(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{{Name: "v"}},
+		},
+	}
+	// the current time is not aligned with the default count window
+	now := time.Unix(TestTime+7, 0)
+	r := NewRater(context.Background(), pipeline, WithClock(clock.NewFakeClock(now)), WithCountWindow(time.Second))
+	assert.Equal(t, time.Second, r.GetCountWindow())
+	assert.Equal(t, 500, r.options.taskInterval)
+	// 10 messages per second, and 50 messages per second in the last 20 seconds
+	count := float64(0)
+	for ago := int64(300); ago >= 0; ago-- {
+		if ago < 20 {
+			count += 50
+		} else {
+			count += 10
+		}
+		UpdateCount(r.timestampedPodCounts["v"], now.Unix()-ago, &PodReadCount{"pod", map[string]float64{"v": count}, 0, nil})
+	}
+	// the windows from -60 to -1
+	assert.InDelta(t, (10*40+50*19)/59.0, r.GetRates("v", "v")["1m"].GetValue(), 1e-9)
+	// the last complete window is older than the missed windows tolerated
+	r.options.clock.(*clock.FakeClock).Step(time.Minute + 22*time.Second)
+	assert.Equal(t, rateNotAvailable, r.GetRates("v", "v")["1m"].GetValue())
+}
+
+func TestGetPodErrorCount(t *testing.T) {
+	textParser := expfmt.TextParser{}
+	result, err := textParser.TextToMetricFamilies(strings.NewReader(`
+# TYPE forwarder_data_read_total counter
+forwarder_data_read_total{partition_name="p-v-0"} 100
+# TYPE forwarder_read_error_total counter
+forwarder_read_error_total{partition_name="p-v-0"} 3
+# TYPE forwarder_drop_total counter
+forwarder_drop_total{partition_name="p-out-0",reason="buffer full"} 5
+forwarder_drop_total{partition_name="p-out-1",reason="buffer full"} 2
+# TYPE forwarder_dropped_total untyped
+forwarder_dropped_total{partition_name="p-out-0"} 1
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, float64(11), getPodErrorCount(result))
+	assert.Equal(t, float64(0), getPodErrorCount(map[string]*dto.MetricFamily{}))
+}
+
+func TestGetPodAckCounts(t *testing.T) {
+	textParser := expfmt.TextParser{}
+	result, err := textParser.TextToMetricFamilies(strings.NewReader(`
+# TYPE forwarder_ack_total counter
+forwarder_ack_total{partition_name="p-v-0"} 100
+forwarder_ack_total{partition_name="p-v-1"} 20
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"p-v-0": 100, "p-v-1": 20}, getPodAckCounts(result))
+	// nothing is acknowledged yet
+	assert.Nil(t, getPodAckCounts(map[string]*dto.MetricFamily{}))
 }
@@ -21,6 +21,16 @@ import (
 	"sync"
 )
 
+// partitionRestart is the counts of a partition of a pod restarted within a window, i.e., the count went backwards
+// between two updates of the window.
+type partitionRestart struct {
+	// firstCount is the count reached before the first restart within the window.
+	firstCount float64
+	// laterCounts is the sum of the counts reached before the later restarts within the window, each of them counted
+	// from zero.
+	laterCounts float64
+}
+
 // TimestampedCounts track the total count of processed messages for a list of pods at a given timestamp
 type TimestampedCounts struct {
 	// timestamp in seconds is the time when the count is recorded
@@ -28,18 +38,30 @@ type TimestampedCounts struct {
 	// the key of podPartitionCount represents the pod name, the value represents a partition counts map for the pod
 	// partition counts map holds mappings between partition name and the count of messages processed by the partition
 	podPartitionCount map[string]map[string]float64
-	lock              *sync.RWMutex
+	// the key of podErrorCount represents the pod name, the value represents the total count of the errors and the
+	// dropped messages of the pod
+	podErrorCount map[string]float64
+	// the key of podPartitionAckCount represents the pod name, the value represents a partition counts map for the pod
+	// holding mappings between partition name and the count of messages acknowledged by the partition
+	podPartitionAckCount map[string]map[string]float64
+	// the key of podPartitionRestart represents the pod name, the value represents the counts of the partitions of the
+	// pod before they restarted within the window, it's absent if the pod didn't restart
+	podPartitionRestart map[string]map[string]partitionRestart
+	lock                *sync.RWMutex
 }
 
 func NewTimestampedCounts(t int64) *TimestampedCounts {
 	return &TimestampedCounts{
-		timestamp:         t,
-		podPartitionCount: make(map[string]map[string]float64),
-		lock:              new(sync.RWMutex),
+		timestamp:            t,
+		podPartitionCount:    make(map[string]map[string]float64),
+		podErrorCount:        make(map[string]float64),
+		podPartitionAckCount: make(map[string]map[string]float64),
+		podPartitionRestart:  make(map[string]map[string]partitionRestart),
+		lock:                 new(sync.RWMutex),
 	}
 }
 
-// Update updates the count of processed messages for a pod
+// Update updates the count of processed messages, the error count and the count of acknowledged messages for a pod
 func (tc *TimestampedCounts) Update(podReadCount *PodReadCount) {
 	tc.lock.Lock()
 	defer tc.lock.Unlock()
@@ -54,7 +76,49 @@ func (tc *TimestampedCounts) Update(podReadCount *PodReadCount) {
 		// hence we'd rather keep the partitionReadCounts as it is to avoid wrong rate calculation.
 		return
 	}
+	tc.detectRestart(podReadCount.Name(), podReadCount.PartitionReadCounts())
 	tc.podPartitionCount[podReadCount.Name()] = podReadCount.PartitionReadCounts()
+	tc.podErrorCount[podReadCount.Name()] = podReadCount.ErrorCount()
+	if podReadCount.PartitionAckCounts() != nil {
+		tc.podPartitionAckCount[podReadCount.Name()] = podReadCount.PartitionAckCounts()
+	}
+}
+
+// detectRestart detects the counts of the pod going backwards since the last update of the window, i.e., the pod
+// restarted and its counters reset, or the pod is replaced by a new one with the same name. The counts reached before
+// the restart are kept, so that the messages processed before it are not lost when the counts are overwritten.
+func (tc *TimestampedCounts) detectRestart(podName string, partitionReadCounts map[string]float64) {
+	for partitionName, lastCount := range tc.podPartitionCount[podName] {
+		if count, ok := partitionReadCounts[partitionName]; !ok || count >= lastCount {
+			continue
+		}
+		restarts, ok := tc.podPartitionRestart[podName]
+		if !ok {
+			restarts = make(map[string]partitionRestart)
+			tc.podPartitionRestart[podName] = restarts
+		}
+		if r, ok := restarts[partitionName]; ok {
+			r.laterCounts += lastCount
+			restarts[partitionName] = r
+		} else {
+			restarts[partitionName] = partitionRestart{firstCount: lastCount}
+		}
+	}
+}
+
+// podPartitionRestartSnapshot returns a copy of podPartitionRestart
+func (tc *TimestampedCounts) podPartitionRestartSnapshot() map[string]map[string]partitionRestart {
+	tc.lock.RLock()
+	defer tc.lock.RUnlock()
+	restarts := make(map[string]map[string]partitionRestart, len(tc.podPartitionRestart))
+	for podName, partitionRestarts := range tc.podPartitionRestart {
+		copied := make(map[string]partitionRestart, len(partitionRestarts))
+		for k, v := range partitionRestarts {
+			copied[k] = v
+		}
+		restarts[podName] = copied
+	}
+	return restarts
 }
 
 // PodPartitionCountSnapshot returns a copy of podPartitionCount
@@ -69,10 +133,32 @@ func (tc *TimestampedCounts) PodPartitionCountSnapshot() map[string]map[string]f
 	return counts
 }
 
+// PodErrorCountSnapshot returns a copy of podErrorCount
+func (tc *TimestampedCounts) PodErrorCountSnapshot() map[string]float64 {
+	tc.lock.RLock()
+	defer tc.lock.RUnlock()
+	counts := make(map[string]float64, len(tc.podErrorCount))
+	for k, v := range tc.podErrorCount {
+		counts[k] = v
+	}
+	return counts
+}
+
+// PodPartitionAckCountSnapshot returns a copy of podPartitionAckCount
+func (tc *TimestampedCounts) PodPartitionAckCountSnapshot() map[string]map[string]float64 {
+	tc.lock.RLock()
+	defer tc.lock.RUnlock()
+	counts := make(map[string]map[string]float64, len(tc.podPartitionAckCount))
+	for k, v := range tc.podPartitionAckCount {
+		counts[k] = v
+	}
+	return counts
+}
+
 // String returns a string representation of the TimestampedCounts
 // it's used for debugging purpose
 func (tc *TimestampedCounts) String() string {
 	tc.lock.RLock()
 	defer tc.lock.RUnlock()
-	return fmt.Sprintf("{timestamp: %d, podPartitionCount: %v}", tc.timestamp, tc.podPartitionCount)
+	return fmt.Sprintf("{timestamp: %d, podPartitionCount: %v, podErrorCount: %v}", tc.timestamp, tc.podPartitionCount, tc.podErrorCount)
 }
@@ -24,19 +24,19 @@ import (
 
 func TestNewTimestampedCounts(t *testing.T) {
 	tc := NewTimestampedCounts(TestTime)
-	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 	assert.Equal(t, int64(TestTime), tc.timestamp)
 	assert.Equal(t, 1, len(tc.podPartitionCount))
-	assert.Equal(t, "{timestamp: 1620000000, podPartitionCount: map[pod1:map[partition1:10]]}", tc.String())
+	assert.Equal(t, "{timestamp: 1620000000, podPartitionCount: map[pod1:map[partition1:10]], podErrorCount: map[pod1:0]}", tc.String())
 }
 
 func TestTimestampedCounts_Update(t *testing.T) {
 	tc := NewTimestampedCounts(TestTime)
-	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 	assert.Equal(t, 10.0, tc.podPartitionCount["pod1"]["partition1"])
-	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0}})
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 20.0}, 0, nil})
 	assert.Equal(t, 20.0, tc.podPartitionCount["pod1"]["partition1"])
-	tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 30.0}})
+	tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 30.0}, 0, nil})
 	assert.Equal(t, 30.0, tc.podPartitionCount["pod2"]["partition1"])
 	assert.Equal(t, 2, len(tc.podPartitionCount))
 	tc.Update(nil)
@@ -44,21 +44,42 @@ func TestTimestampedCounts_Update(t *testing.T) {
 	assert.Equal(t, 20, int(tc.podPartitionCount["pod1"]["partition1"]))
 	assert.Equal(t, 30, int(tc.podPartitionCount["pod2"]["partition1"]))
 
-	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
 	assert.Equal(t, 10, int(tc.podPartitionCount["pod1"]["partition1"]))
-	tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 20.0}})
+	tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 20.0}, 0, nil})
 	assert.Equal(t, 20, int(tc.podPartitionCount["pod2"]["partition1"]))
 
 	tc2 := NewTimestampedCounts(TestTime + 1)
-	tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 40.0}})
+	tc2.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 40.0}, 0, nil})
 	assert.Equal(t, 40.0, tc2.podPartitionCount["pod1"]["partition1"])
-	tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 10.0}})
+	tc2.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 10.0}, 0, nil})
 	assert.Equal(t, 10.0, tc2.podPartitionCount["pod2"]["partition1"])
 }
 
+func TestTimestampedCounts_DetectRestart(t *testing.T) {
+	tc := NewTimestampedCounts(TestTime)
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 100.0, "partition2": 50.0}, 0, nil})
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 150.0, "partition2": 60.0}, 0, nil})
+	assert.Empty(t, tc.podPartitionRestartSnapshot())
+
+	// the counter of partition1 went backwards, the pod restarted
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0, "partition2": 70.0}, 0, nil})
+	assert.Equal(t, map[string]map[string]partitionRestart{"pod1": {"partition1": {firstCount: 150}}}, tc.podPartitionRestartSnapshot())
+	assert.Equal(t, 10.0, tc.podPartitionCount["pod1"]["partition1"])
+
+	// the pod restarted again, and it hasn't read from partition2 since
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 40.0}, 0, nil})
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 5.0}, 0, nil})
+	assert.Equal(t, map[string]map[string]partitionRestart{"pod1": {"partition1": {firstCount: 150, laterCounts: 40}}}, tc.podPartitionRestartSnapshot())
+
+	// the other pods are not affected
+	tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 20.0}, 0, nil})
+	assert.NotContains(t, tc.podPartitionRestartSnapshot(), "pod2")
+}
+
 func TestTimestampedPodCounts_Snapshot(t *testing.T) {
 	tc := NewTimestampedCounts(TestTime)
-	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}})
-	tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 20.0}})
+	tc.Update(&PodReadCount{"pod1", map[string]float64{"partition1": 10.0}, 0, nil})
+	tc.Update(&PodReadCount{"pod2", map[string]float64{"partition1": 20.0}, 0, nil})
 	assert.Equal(t, map[string]map[string]float64{"pod1": {"partition1": 10.0}, "pod2": {"partition1": 20.0}}, tc.PodPartitionCountSnapshot())
 }
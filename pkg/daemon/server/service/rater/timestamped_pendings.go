@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rater
+
+import (
+	"fmt"
+	"sync"
+)
+
+// PodPending is a struct to maintain the pending count of each partition reported by a pod
+type PodPending struct {
+	// pod name
+	name string
+	// key represents partition name, value represents the pending count of the partition reported by the pod
+	partitionPendings map[string]int64
+}
+
+func (p *PodPending) Name() string {
+	return p.name
+}
+
+func (p *PodPending) PartitionPendings() map[string]int64 {
+	return p.partitionPendings
+}
+
+// TimestampedPendings track the pending counts reported by a list of pods at a given timestamp
+type TimestampedPendings struct {
+	// timestamp in seconds is the time when the pending counts are recorded
+	timestamp int64
+	// the key of podPartitionPending represents the pod name, the value represents a partition pending map for the pod
+	podPartitionPending map[string]map[string]int64
+	lock                *sync.RWMutex
+}
+
+func NewTimestampedPendings(t int64) *TimestampedPendings {
+	return &TimestampedPendings{
+		timestamp:           t,
+		podPartitionPending: make(map[string]map[string]int64),
+		lock:                new(sync.RWMutex),
+	}
+}
+
+// Update updates the pending counts reported by a pod, a nil podPending is skipped the same as TimestampedCounts.Update
+func (tp *TimestampedPendings) Update(podPending *PodPending) {
+	tp.lock.Lock()
+	defer tp.lock.Unlock()
+	if podPending == nil {
+		return
+	}
+	tp.podPartitionPending[podPending.Name()] = podPending.PartitionPendings()
+}
+
+// PodPartitionPendingSnapshot returns a copy of podPartitionPending
+func (tp *TimestampedPendings) PodPartitionPendingSnapshot() map[string]map[string]int64 {
+	tp.lock.RLock()
+	defer tp.lock.RUnlock()
+	pendings := make(map[string]map[string]int64)
+	for k, v := range tp.podPartitionPending {
+		pendings[k] = v
+	}
+	return pendings
+}
+
+// String returns a string representation of the TimestampedPendings
+// it's used for debugging purpose
+func (tp *TimestampedPendings) String() string {
+	tp.lock.RLock()
+	defer tp.lock.RUnlock()
+	return fmt.Sprintf("{timestamp: %d, podPartitionPending: %v}", tp.timestamp, tp.podPartitionPending)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTimestampedPendings(t *testing.T) {
+	tp := NewTimestampedPendings(TestTime)
+	tp.Update(&PodPending{"pod1", map[string]int64{"partition1": 10}})
+	assert.Equal(t, int64(TestTime), tp.timestamp)
+	assert.Equal(t, 1, len(tp.podPartitionPending))
+	assert.Equal(t, "{timestamp: 1620000000, podPartitionPending: map[pod1:map[partition1:10]]}", tp.String())
+}
+
+func TestTimestampedPendings_Update(t *testing.T) {
+	tp := NewTimestampedPendings(TestTime)
+	tp.Update(&PodPending{"pod1", map[string]int64{"partition1": 10}})
+	assert.Equal(t, int64(10), tp.podPartitionPending["pod1"]["partition1"])
+	tp.Update(&PodPending{"pod1", map[string]int64{"partition1": 20}})
+	assert.Equal(t, int64(20), tp.podPartitionPending["pod1"]["partition1"])
+	tp.Update(&PodPending{"pod2", map[string]int64{"partition1": -1}})
+	assert.Equal(t, int64(-1), tp.podPartitionPending["pod2"]["partition1"])
+	tp.Update(nil)
+	assert.Equal(t, 2, len(tp.podPartitionPending))
+}
+
+func TestTimestampedPendings_Snapshot(t *testing.T) {
+	tp := NewTimestampedPendings(TestTime)
+	tp.Update(&PodPending{"pod1", map[string]int64{"partition1": 10}})
+	tp.Update(&PodPending{"pod2", map[string]int64{"partition1": 20}})
+	assert.Equal(t, map[string]map[string]int64{"pod1": {"partition1": 10}, "pod2": {"partition1": 20}}, tp.PodPartitionPendingSnapshot())
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/daemon/server/service/rater"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// AnomalyWatcher periodically checks the processing rates and the pendings tracked by the rater for the anomalies,
+// i.e., the sudden rate drops and the growing backlogs, and exposes them as metrics.
+type AnomalyWatcher struct {
+	pipelineName string
+	rater        *rater.Rater
+	// onAnomalies is called with the anomalies detected on every check, it can be nil.
+	onAnomalies func([]rater.Anomaly)
+}
+
+var _ BackgroundJob = (*AnomalyWatcher)(nil)
+
+// NewAnomalyWatcher returns an AnomalyWatcher, onAnomalies is called with the anomalies detected on every check and
+// can be nil.
+func NewAnomalyWatcher(pipelineName string, r *rater.Rater, onAnomalies func([]rater.Anomaly)) *AnomalyWatcher {
+	return &AnomalyWatcher{pipelineName: pipelineName, rater: r, onAnomalies: onAnomalies}
+}
+
+// Start checks the anomalies once every count window of the rater until the context is done.
+func (w *AnomalyWatcher) Start(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	ticker := time.NewTicker(w.rater.GetCountWindow())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			anomalies := w.rater.DetectAnomalies()
+			processingAnomaly.Reset()
+			for _, a := range anomalies {
+				log.Warnw("Detected an anomaly in the processing", zap.String("vertex", a.Vertex), zap.String("partition", a.Partition), zap.String("kind", string(a.Kind)), zap.Float64("current", a.Current), zap.Float64("baseline", a.Baseline))
+				processingAnomaly.WithLabelValues(w.pipelineName, a.Vertex, a.Partition, string(a.Kind)).Set(1)
+			}
+			if w.onAnomalies != nil {
+				w.onAnomalies(anomalies)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// WindowRateWatcher observes the rates of the vertex partitions in every complete count window of the rater, so that
+// the percentiles and the trends of the rates can be computed from the metrics.
+type WindowRateWatcher struct {
+	pipelineName string
+	rater        *rater.Rater
+}
+
+var _ BackgroundJob = (*WindowRateWatcher)(nil)
+
+// NewWindowRateWatcher returns a WindowRateWatcher.
+func NewWindowRateWatcher(pipelineName string, r *rater.Rater) *WindowRateWatcher {
+	return &WindowRateWatcher{pipelineName: pipelineName, rater: r}
+}
+
+// Start observes the windows completed after it starts until the context is done. The windows restored by the rater
+// are not observed, they might have been observed before the daemon server container restarted.
+func (w *WindowRateWatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.rater.GetCountWindow())
+	defer ticker.Stop()
+	since := time.Now().Unix()
+	for {
+		select {
+		case <-ticker.C:
+			for _, wr := range w.rater.GetWindowRates(since) {
+				windowProcessingRate.WithLabelValues(w.pipelineName, wr.Vertex, wr.Partition).Observe(wr.Rate)
+				since = max(since, wr.Timestamp)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+// RebalanceWatcher periodically observes the active replicas of the source vertices and the heartbeats of the
+// watermark publishers of their partitions, and feeds them to a RebalanceTracker to detect the partitions moving
+// between replicas.
+type RebalanceWatcher struct {
+	pipelineName string
+	tracker      *RebalanceTracker
+	// activeReplicas returns the number of the active replicas of a vertex.
+	activeReplicas func(vertex string) int
+	// hbStores is the heartbeat store of the first out edge of each source vertex.
+	hbStores map[string]kvs.KVStorer
+}
+
+var _ BackgroundJob = (*RebalanceWatcher)(nil)
+
+// NewRebalanceWatcher returns a RebalanceWatcher of the source vertices of the pipeline. The partitions are learned
+// from the heartbeats in the watermark stores, so nothing is tracked if the watermark is disabled.
+func NewRebalanceWatcher(pl *v1alpha1.Pipeline, tracker *RebalanceTracker, activeReplicas func(vertex string) int, wmStores map[v1alpha1.Edge][]store.WatermarkStore) *RebalanceWatcher {
+	hbStores := make(map[string]kvs.KVStorer)
+	for edge, stores := range wmStores {
+		if _, ok := hbStores[edge.From]; ok || len(stores) == 0 {
+			continue
+		}
+		if v := pl.GetVertex(edge.From); v != nil && v.IsASource() {
+			hbStores[edge.From] = stores[0].HeartbeatStore()
+		}
+	}
+	return &RebalanceWatcher{pipelineName: pl.Name, tracker: tracker, activeReplicas: activeReplicas, hbStores: hbStores}
+}
+
+// Start observes the source vertices every 10 seconds until the context is done, it returns immediately if there is
+// no heartbeat store.
+func (w *RebalanceWatcher) Start(ctx context.Context) {
+	if len(w.hbStores) == 0 {
+		return
+	}
+	log := logging.FromContext(ctx)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for vertex, hbStore := range w.hbStores {
+				heartbeats, err := SourcePartitionHeartbeats(ctx, w.pipelineName, vertex, hbStore)
+				if err != nil {
+					log.Errorw("Failed to get the heartbeats of the source partitions", zap.String("vertex", vertex), zap.Error(err))
+					continue
+				}
+				if report := w.tracker.Observe(vertex, w.activeReplicas(vertex), heartbeats); report != nil {
+					log.Infow("Source partitions moved between replicas", zap.String("vertex", vertex), zap.Int("fromReplicas", report.FromReplicas), zap.Int("toReplicas", report.ToReplicas), zap.Int("moved", len(report.Moves)))
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+const (
+	// defaultStorageQuotaResumeRatio is the default resume threshold relative to the quota.
+	defaultStorageQuotaResumeRatio = 0.8
+	// storageQuotaSampleInterval is the frequency at which the storage used by the buffers is sampled.
+	storageQuotaSampleInterval = 30 * time.Second
+)
+
+// storageQuotaAnnotation is the value of the storage quota annotation of the pipeline.
+type storageQuotaAnnotation struct {
+	// MaxBytes is the quota of the storage used by all the buffers of the pipeline.
+	MaxBytes *resource.Quantity `json:"maxBytes,omitempty"`
+	// ResumeBytes is the usage the sources resume reading below once the quota is exceeded.
+	ResumeBytes *resource.Quantity `json:"resumeBytes,omitempty"`
+	// PauseSources is whether to pause the source vertices while the quota is exceeded.
+	PauseSources bool `json:"pauseSources,omitempty"`
+}
+
+// StorageQuota is the quota of the storage used by the buffers of a pipeline. Once the usage exceeds MaxBytes, the
+// quota stays exceeded until the usage drops below ResumeBytes, so that the sources don't flap around the quota.
+type StorageQuota struct {
+	MaxBytes     int64
+	ResumeBytes  int64
+	PauseSources bool
+}
+
+// ParseStorageQuota returns the storage quota configured in the storage quota annotation of the pipeline, nil if it's
+// not configured.
+func ParseStorageQuota(pl *v1alpha1.Pipeline) (*StorageQuota, error) {
+	value, ok := pl.GetAnnotations()[v1alpha1.KeyStorageQuota]
+	if !ok {
+		return nil, nil
+	}
+	var annotation storageQuotaAnnotation
+	if err := json.Unmarshal([]byte(value), &annotation); err != nil {
+		return nil, fmt.Errorf("invalid annotation %q, %w", v1alpha1.KeyStorageQuota, err)
+	}
+	if annotation.MaxBytes == nil || annotation.MaxBytes.Value() <= 0 {
+		return nil, fmt.Errorf("invalid annotation %q, maxBytes must be greater than 0", v1alpha1.KeyStorageQuota)
+	}
+	quota := &StorageQuota{
+		MaxBytes:     annotation.MaxBytes.Value(),
+		ResumeBytes:  int64(float64(annotation.MaxBytes.Value()) * defaultStorageQuotaResumeRatio),
+		PauseSources: annotation.PauseSources,
+	}
+	if annotation.ResumeBytes != nil {
+		quota.ResumeBytes = annotation.ResumeBytes.Value()
+	}
+	if quota.ResumeBytes <= 0 || quota.ResumeBytes >= quota.MaxBytes {
+		return nil, fmt.Errorf("invalid annotation %q, resumeBytes must be greater than 0 and less than maxBytes", v1alpha1.KeyStorageQuota)
+	}
+	return quota, nil
+}
+
+// StorageUsage is a sample of the storage used by the buffers of a pipeline.
+type StorageUsage struct {
+	UsedBytes int64
+	// Exceeded is whether the quota is exceeded, taking the resume threshold into account.
+	Exceeded bool
+}
+
+// StorageQuotaTracker samples the storage used by the buffers of a pipeline, and tracks whether the quota is exceeded.
+type StorageQuotaTracker struct {
+	isbSvc   isbsvc.ISBService
+	buffers  []string
+	quota    StorageQuota
+	exceeded bool
+}
+
+// NewStorageQuotaTracker returns a tracker of the storage quota of the buffers of the pipeline.
+func NewStorageQuotaTracker(isbSvc isbsvc.ISBService, pl *v1alpha1.Pipeline, quota StorageQuota) *StorageQuotaTracker {
+	return &StorageQuotaTracker{
+		isbSvc:  isbSvc,
+		buffers: pl.GetAllBuffers(),
+		quota:   quota,
+	}
+}
+
+// SampleInterval returns the interval the buffers should be sampled at.
+func (t *StorageQuotaTracker) SampleInterval() time.Duration {
+	return storageQuotaSampleInterval
+}
+
+// Sample sums up the storage used by the buffers, the information of which is fetched in one batch. The quota becomes
+// exceeded when the usage goes above the quota, and it stays exceeded until the usage drops below the resume threshold.
+// The state is not changed if the information of any buffer is not available, the errors are returned together.
+func (t *StorageQuotaTracker) Sample(ctx context.Context) (StorageUsage, error) {
+	infos, err := t.isbSvc.GetBuffersInfo(ctx, t.buffers)
+	var used int64
+	for _, info := range infos {
+		used += info.TotalBytes
+	}
+	if err != nil {
+		return StorageUsage{UsedBytes: used, Exceeded: t.exceeded}, fmt.Errorf("failed to get the information of the buffers, %w", err)
+	}
+	if t.exceeded {
+		t.exceeded = used >= t.quota.ResumeBytes
+	} else {
+		t.exceeded = used > t.quota.MaxBytes
+	}
+	return StorageUsage{UsedBytes: used, Exceeded: t.exceeded}, nil
+}
+
+// StorageQuotaConditionApplier returns a function to set the StorageQuota condition of the pipeline status with the
+// usage, the condition is false if the quota is exceeded, with the reason telling the sources to pause if enabled.
+func StorageQuotaConditionApplier(quota StorageQuota, usage StorageUsage) func(*v1alpha1.PipelineStatus) {
+	return func(status *v1alpha1.PipelineStatus) {
+		switch {
+		case !usage.Exceeded:
+			status.MarkStorageQuotaWithin()
+		case quota.PauseSources:
+			status.MarkStorageQuotaExceeded(v1alpha1.StorageQuotaReasonSourcesPaused, fmt.Sprintf("Storage used by the buffers %s exceeds the quota %s, the sources are paused until it drops below %s",
+				formatBytes(usage.UsedBytes), formatBytes(quota.MaxBytes), formatBytes(quota.ResumeBytes)))
+		default:
+			status.MarkStorageQuotaExceeded(v1alpha1.StorageQuotaReasonExceeded, fmt.Sprintf("Storage used by the buffers %s exceeds the quota %s",
+				formatBytes(usage.UsedBytes), formatBytes(quota.MaxBytes)))
+		}
+	}
+}
+
+// formatBytes returns the bytes in the largest binary unit not greater than them, e.g. 1.5Gi.
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit && exp < 4; n /= unit {
+		div *= unit
+		exp++
+	}
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", float64(b)/float64(div)), ".0") + []string{"Ki", "Mi", "Gi", "Ti", "Pi"}[exp]
+}
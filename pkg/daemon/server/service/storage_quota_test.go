@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+func TestParseStorageQuota(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation *string
+		expected   *StorageQuota
+		errMsg     string
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:       "default resume threshold",
+			annotation: ptr(`{"maxBytes": "10Gi"}`),
+			expected:   &StorageQuota{MaxBytes: 10 << 30, ResumeBytes: 8 << 30},
+		},
+		{
+			name:       "all fields",
+			annotation: ptr(`{"maxBytes": "10Gi", "resumeBytes": "5Gi", "pauseSources": true}`),
+			expected:   &StorageQuota{MaxBytes: 10 << 30, ResumeBytes: 5 << 30, PauseSources: true},
+		},
+		{
+			name:       "invalid json",
+			annotation: ptr(`maxBytes: 10Gi`),
+			errMsg:     "invalid annotation",
+		},
+		{
+			name:       "missing quota",
+			annotation: ptr(`{"pauseSources": true}`),
+			errMsg:     "maxBytes must be greater than 0",
+		},
+		{
+			name:       "resume threshold above the quota",
+			annotation: ptr(`{"maxBytes": "10Gi", "resumeBytes": "10Gi"}`),
+			errMsg:     "resumeBytes must be greater than 0 and less than maxBytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pl := lagSLOTestPipeline.DeepCopy()
+			if tt.annotation != nil {
+				pl.Annotations = map[string]string{v1alpha1.KeyStorageQuota: *tt.annotation}
+			}
+			quota, err := ParseStorageQuota(pl)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, quota)
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+// storageQuotaTestISBSvc returns the total bytes of the buffers set in the map, and fails if err is set. It counts the
+// batches the information is fetched in.
+type storageQuotaTestISBSvc struct {
+	mockIsbSvcClient
+	totalBytes map[string]int64
+	err        error
+	batches    int
+}
+
+func (s *storageQuotaTestISBSvc) GetBuffersInfo(ctx context.Context, buffers []string) ([]*isbsvc.BufferInfo, error) {
+	s.batches++
+	var infos []*isbsvc.BufferInfo
+	for _, buffer := range buffers {
+		info, err := s.GetBufferInfo(ctx, buffer)
+		if err != nil {
+			return infos, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func (s *storageQuotaTestISBSvc) GetBufferInfo(_ context.Context, buffer string) (*isbsvc.BufferInfo, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &isbsvc.BufferInfo{Name: buffer, TotalBytes: s.totalBytes[buffer]}, nil
+}
+
+func TestStorageQuotaTracker(t *testing.T) {
+	ctx := context.Background()
+	pl := lagSLOTestPipeline.DeepCopy()
+	catBuffer := pl.GetVertex("cat").OwnedBufferNames("ns", "pl")[0]
+	outBuffer := pl.GetVertex("out").OwnedBufferNames("ns", "pl")[0]
+	isbSvc := &storageQuotaTestISBSvc{totalBytes: map[string]int64{}}
+	quota := StorageQuota{MaxBytes: 1000, ResumeBytes: 800, PauseSources: true}
+	tracker := NewStorageQuotaTracker(isbSvc, pl, quota)
+
+	// sample sets the usage of the buffers, and returns whether the sources are paused after applying the condition.
+	status := &v1alpha1.PipelineStatus{}
+	sample := func(cat, out int64) (StorageUsage, bool) {
+		isbSvc.totalBytes[catBuffer] = cat
+		isbSvc.totalBytes[outBuffer] = out
+		usage, err := tracker.Sample(ctx)
+		require.NoError(t, err)
+		StorageQuotaConditionApplier(quota, usage)(status)
+		return usage, (&v1alpha1.Pipeline{Status: *status}).IsSourcesPausedByStorageQuota()
+	}
+
+	usage, paused := sample(300, 400)
+	assert.Equal(t, StorageUsage{UsedBytes: 700}, usage)
+	assert.False(t, paused)
+	// the buffers are fetched in one batch
+	assert.Equal(t, 1, isbSvc.batches)
+	assert.Equal(t, "True", string(status.GetCondition(v1alpha1.PipelineConditionStorageQuota).Status))
+
+	// reaching the quota is not exceeding it
+	usage, paused = sample(500, 500)
+	assert.Equal(t, StorageUsage{UsedBytes: 1000}, usage)
+	assert.False(t, paused)
+
+	// the quota is exceeded, the sources are paused
+	usage, paused = sample(600, 500)
+	assert.Equal(t, StorageUsage{UsedBytes: 1100, Exceeded: true}, usage)
+	assert.True(t, paused)
+	assert.Equal(t, "Storage used by the buffers 1.1Ki exceeds the quota 1000B, the sources are paused until it drops below 800B",
+		status.GetCondition(v1alpha1.PipelineConditionStorageQuota).Message)
+
+	// the sources stay paused between the resume threshold and the quota
+	usage, paused = sample(400, 500)
+	assert.Equal(t, StorageUsage{UsedBytes: 900, Exceeded: true}, usage)
+	assert.True(t, paused)
+	usage, paused = sample(400, 400)
+	assert.Equal(t, StorageUsage{UsedBytes: 800, Exceeded: true}, usage)
+	assert.True(t, paused)
+
+	// the state is not changed when the usage is not available
+	isbSvc.err = errors.New("unavailable")
+	usage, err := tracker.Sample(ctx)
+	assert.ErrorContains(t, err, "failed to get the information of the buffers")
+	assert.True(t, usage.Exceeded)
+	isbSvc.err = nil
+
+	// the sources resume below the resume threshold
+	usage, paused = sample(400, 399)
+	assert.Equal(t, StorageUsage{UsedBytes: 799}, usage)
+	assert.False(t, paused)
+
+	// and are not paused again until the quota is exceeded
+	usage, paused = sample(500, 400)
+	assert.Equal(t, StorageUsage{UsedBytes: 900}, usage)
+	assert.False(t, paused)
+}
+
+func TestStorageQuotaConditionApplier_WithoutPause(t *testing.T) {
+	quota := StorageQuota{MaxBytes: 10 << 30, ResumeBytes: 8 << 30}
+	status := &v1alpha1.PipelineStatus{}
+	StorageQuotaConditionApplier(quota, StorageUsage{UsedBytes: 12 << 30, Exceeded: true})(status)
+	c := status.GetCondition(v1alpha1.PipelineConditionStorageQuota)
+	require.NotNil(t, c)
+	assert.Equal(t, "False", string(c.Status))
+	assert.Equal(t, v1alpha1.StorageQuotaReasonExceeded, c.Reason)
+	assert.Equal(t, "Storage used by the buffers 12Gi exceeds the quota 10Gi", c.Message)
+	assert.False(t, (&v1alpha1.Pipeline{Status: *status}).IsSourcesPausedByStorageQuota())
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "0B", formatBytes(0))
+	assert.Equal(t, "1023B", formatBytes(1023))
+	assert.Equal(t, "1Ki", formatBytes(1024))
+	assert.Equal(t, "1.5Mi", formatBytes(3<<19))
+	assert.Equal(t, "10Gi", formatBytes(10<<30))
+	assert.Equal(t, "2048Pi", formatBytes(1<<61))
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	numaflowv1alpha1 "github.com/numaproj/numaflow/pkg/client/clientset/versioned/typed/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/daemon/server/events"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// StorageQuotaWatcher samples the storage used by the buffers of a pipeline, and sets the StorageQuota condition of
+// the pipeline when the quota becomes exceeded or recovered. The condition tells the controller to pause the source
+// vertices if it's enabled.
+type StorageQuotaWatcher struct {
+	pipeline  *v1alpha1.Pipeline
+	isbSvc    isbsvc.ISBService
+	pipelines numaflowv1alpha1.PipelineInterface
+	// recorder records the quota becoming exceeded or recovered as events, it can be nil.
+	recorder *events.Recorder
+}
+
+var _ BackgroundJob = (*StorageQuotaWatcher)(nil)
+
+// NewStorageQuotaWatcher returns a StorageQuotaWatcher, the quota is read from the annotations of the live pipeline
+// object when it starts. The recorder can be nil if the events are not recorded.
+func NewStorageQuotaWatcher(pl *v1alpha1.Pipeline, isbSvc isbsvc.ISBService, pipelines numaflowv1alpha1.PipelineInterface, recorder *events.Recorder) *StorageQuotaWatcher {
+	return &StorageQuotaWatcher{pipeline: pl, isbSvc: isbSvc, pipelines: pipelines, recorder: recorder}
+}
+
+// Start tracks the quota until the context is done, it returns immediately if no quota is configured.
+func (w *StorageQuotaWatcher) Start(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	pl, err := withLiveAnnotations(ctx, w.pipelines, w.pipeline)
+	if err != nil {
+		log.Warnw("Failed to get the pipeline, the storage quota will not be tracked", zap.Error(err))
+		return
+	}
+	quota, err := ParseStorageQuota(pl)
+	if err != nil {
+		log.Errorw("Failed to parse the storage quota, it will not be tracked", zap.Error(err))
+		return
+	}
+	if quota == nil {
+		return
+	}
+	tracker := NewStorageQuotaTracker(w.isbSvc, w.pipeline, *quota)
+
+	ticker := time.NewTicker(tracker.SampleInterval())
+	defer ticker.Stop()
+	// whether the quota is exceeded in the condition last set, nil if it's not set yet.
+	var lastExceeded *bool
+	for {
+		usage, err := tracker.Sample(ctx)
+		if err != nil {
+			log.Warnw("Failed to sample the storage used by the buffers", zap.Error(err))
+		} else {
+			storageUsedBytes.WithLabelValues(w.pipeline.Name).Set(float64(usage.UsedBytes))
+			if usage.Exceeded {
+				storageQuotaExceeded.WithLabelValues(w.pipeline.Name).Set(1)
+			} else {
+				storageQuotaExceeded.WithLabelValues(w.pipeline.Name).Set(0)
+			}
+		}
+		// only update the status when the quota becomes exceeded or recovered.
+		if err == nil && (lastExceeded == nil || *lastExceeded != usage.Exceeded) {
+			if err := updatePipelineStatus(ctx, w.pipelines, w.pipeline.Name, StorageQuotaConditionApplier(*quota, usage)); err != nil {
+				log.Errorw("Failed to set the storage quota condition of the pipeline", zap.Error(err))
+			} else {
+				if usage.Exceeded {
+					log.Warnw("Storage quota is exceeded", zap.Int64("usedBytes", usage.UsedBytes), zap.Int64("maxBytes", quota.MaxBytes), zap.Bool("pauseSources", quota.PauseSources))
+				} else if lastExceeded != nil {
+					log.Infow("Storage quota is recovered", zap.Int64("usedBytes", usage.UsedBytes), zap.Int64("resumeBytes", quota.ResumeBytes))
+				}
+				w.recordEvent(*quota, usage, lastExceeded != nil)
+				lastExceeded = &usage.Exceeded
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// recordEvent records the event of the storage quota becoming exceeded, or recovered if it was exceeded.
+func (w *StorageQuotaWatcher) recordEvent(quota StorageQuota, usage StorageUsage, changed bool) {
+	if w.recorder == nil {
+		return
+	}
+	if usage.Exceeded {
+		action := "the sources keep reading"
+		if quota.PauseSources {
+			action = "the sources are paused"
+		}
+		w.recorder.Forget(events.ReasonStorageQuotaRecovered, w.pipeline.Name)
+		w.recorder.Warningf(events.ReasonStorageQuotaExceeded, w.pipeline.Name, "Storage used by the buffers %d bytes exceeds the quota %d bytes, %s", usage.UsedBytes, quota.MaxBytes, action)
+	} else if changed {
+		w.recorder.Forget(events.ReasonStorageQuotaExceeded, w.pipeline.Name)
+		w.recorder.Normalf(events.ReasonStorageQuotaRecovered, w.pipeline.Name, "Storage used by the buffers %d bytes dropped below %d bytes", usage.UsedBytes, quota.ResumeBytes)
+	}
+}
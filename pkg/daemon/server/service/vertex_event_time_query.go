@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// highWaterEventTimeMetricName is the name of the metric exposed by the forwarders for the max event time read so far.
+const highWaterEventTimeMetricName = "forwarder_high_water_event_time"
+
+// GetVertexHighWaterEventTime returns the max event time in milliseconds observed by the given vertex, -1 if it is not available.
+// Same as the pending count, only the metrics of the first pod (or the first pod of each partition for reduce vertices) are queried.
+func (ps *PipelineMetadataQuery) GetVertexHighWaterEventTime(ctx context.Context, vertexName string) (int64, error) {
+	abstractVertex := ps.pipeline.GetVertex(vertexName)
+	if abstractVertex == nil {
+		return -1, fmt.Errorf("vertex %q not found in the pipeline", vertexName)
+	}
+	vertex := &v1alpha1.Vertex{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-%s", ps.pipeline.Name, vertexName),
+		},
+	}
+	metricsCount := 1
+	if abstractVertex.IsReduceUDF() {
+		metricsCount = abstractVertex.GetPartitionCount()
+	}
+	result := int64(-1)
+	for idx := 0; idx < metricsCount; idx++ {
+		url := fmt.Sprintf("https://%s-%v.%s.%s.svc:%v/metrics", vertex.Name, idx, vertex.GetHeadlessServiceName(), ps.pipeline.Namespace, v1alpha1.VertexMetricsPort)
+		res, err := ps.httpClient.Get(url)
+		if err != nil {
+			return -1, fmt.Errorf("failed reading the metrics endpoint of vertex %q, %w", vertexName, err)
+		}
+		textParser := expfmt.TextParser{}
+		families, err := textParser.TextToMetricFamilies(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return -1, fmt.Errorf("failed parsing to prometheus metric families, %w", err)
+		}
+		if value, ok := families[highWaterEventTimeMetricName]; ok && value != nil {
+			for _, m := range value.GetMetric() {
+				if v := int64(m.GetGauge().GetValue()); v > result {
+					result = v
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// isFutureEventTime returns true if the high-water event time in milliseconds is ahead of now by more than the threshold.
+func isFutureEventTime(highWaterEventTime int64, now time.Time, threshold time.Duration) bool {
+	if highWaterEventTime < 0 {
+		return false
+	}
+	return time.UnixMilli(highWaterEventTime).Sub(now) > threshold
+}
+
+// GetFutureEventTimeVertices returns the vertices whose high-water event time is ahead of now by more than the threshold,
+// mapped to how far ahead of now they are. Vertices whose metrics are not available are skipped.
+func (ps *PipelineMetadataQuery) GetFutureEventTimeVertices(ctx context.Context, now time.Time, threshold time.Duration) map[string]time.Duration {
+	result := make(map[string]time.Duration)
+	for _, v := range ps.pipeline.Spec.Vertices {
+		hw, err := ps.GetVertexHighWaterEventTime(ctx, v.Name)
+		if err != nil {
+			continue
+		}
+		if isFutureEventTime(hw, now, threshold) {
+			result[v.Name] = time.UnixMilli(hw).Sub(now)
+		}
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFutureEventTime(t *testing.T) {
+	now := time.Now()
+	assert.False(t, isFutureEventTime(-1, now, time.Minute))
+	assert.False(t, isFutureEventTime(now.Add(-time.Hour).UnixMilli(), now, time.Minute))
+	assert.False(t, isFutureEventTime(now.Add(30*time.Second).UnixMilli(), now, time.Minute))
+	assert.True(t, isFutureEventTime(now.Add(2*time.Minute).UnixMilli(), now, time.Minute))
+}
+
+func TestGetFutureEventTimeVertices(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	future := now.Add(10 * time.Minute).UnixMilli()
+	past := now.Add(-10 * time.Second).UnixMilli()
+	pipelineMetricsQueryService, err := NewPipelineMetadataQuery(&mockIsbSvcClient{}, threeVertexPipeline(), nil, nil)
+	assert.NoError(t, err)
+	pipelineMetricsQueryService.httpClient = &mockHttpClient{
+		MockGet: func(url string) (*http.Response, error) {
+			var hw int64
+			switch {
+			case strings.Contains(url, "pl-in-0"):
+				hw = future
+			case strings.Contains(url, "pl-cat-0"):
+				hw = past
+			default:
+				return nil, fmt.Errorf("pod is not running")
+			}
+			body := fmt.Sprintf(`# HELP forwarder_high_water_event_time Maximum event time in milliseconds of the messages read so far
+# TYPE forwarder_high_water_event_time gauge
+forwarder_high_water_event_time{partition_name="p-0",pipeline="pl",replica="0",vertex="v",vertex_type="Source"} %d
+forwarder_high_water_event_time{partition_name="p-1",pipeline="pl",replica="0",vertex="v",vertex_type="Source"} %d
+`, hw-1000, hw)
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body)))}, nil
+		},
+	}
+
+	hw, err := pipelineMetricsQueryService.GetVertexHighWaterEventTime(context.Background(), "in")
+	assert.NoError(t, err)
+	assert.Equal(t, future, hw)
+	_, err = pipelineMetricsQueryService.GetVertexHighWaterEventTime(context.Background(), "out")
+	assert.Error(t, err)
+
+	vertices := pipelineMetricsQueryService.GetFutureEventTimeVertices(context.Background(), now, time.Minute)
+	assert.Len(t, vertices, 1)
+	assert.Equal(t, 10*time.Minute, vertices["in"])
+}
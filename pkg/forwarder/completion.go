@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// Outcome is the terminal state of a message routed to a destination.
+type Outcome string
+
+const (
+	// OutcomeWritten means the message is written to the destination.
+	OutcomeWritten Outcome = "written"
+	// OutcomeDropped means the message is intentionally dropped, e.g. the destination is full with the DiscardLatest
+	// strategy.
+	OutcomeDropped Outcome = "dropped"
+)
+
+// route is a write message routed to a destination.
+type route struct {
+	messageID   string
+	destination string
+}
+
+// CompletionTracker tracks the completion of the read messages of a batch. A read message is complete when every
+// destination its write messages are routed to has reached a terminal state, only the complete read messages can be
+// acknowledged. A read message routed to no destination, e.g. filtered out or a control message, is complete.
+//
+// Reaching a terminal state is idempotent, so a message written again on a retry is not counted twice. A nil
+// CompletionTracker is valid and tracks nothing.
+type CompletionTracker struct {
+	readOffsets []isb.Offset
+	// pending is the number of the routes not in a terminal state yet, keyed by the read offset.
+	pending map[string]int
+	// routes is the read offset of every route not in a terminal state yet.
+	routes map[route][]string
+	// counts is the number of the routes in every terminal state.
+	counts map[Outcome]int
+}
+
+// NewCompletionTracker returns a CompletionTracker of the read messages.
+func NewCompletionTracker(readMessages []*isb.ReadMessage) *CompletionTracker {
+	t := &CompletionTracker{
+		readOffsets: make([]isb.Offset, len(readMessages)),
+		pending:     make(map[string]int, len(readMessages)),
+		routes:      make(map[route][]string),
+		counts:      make(map[Outcome]int),
+	}
+	for i, m := range readMessages {
+		t.readOffsets[i] = m.ReadOffset
+		t.pending[m.ReadOffset.String()] = 0
+	}
+	return t
+}
+
+// Route records that the write message of the read offset is routed to the destination, the read offset is not
+// complete until the route reaches a terminal state.
+func (t *CompletionTracker) Route(readOffset isb.Offset, messageID string, destination string) {
+	if t == nil {
+		return
+	}
+	r := route{messageID: messageID, destination: destination}
+	t.routes[r] = append(t.routes[r], readOffset.String())
+	t.pending[readOffset.String()]++
+}
+
+// Complete records the terminal state of the write message on the destination. It returns false if the route is
+// unknown or has already reached a terminal state.
+func (t *CompletionTracker) Complete(messageID string, destination string, outcome Outcome) bool {
+	if t == nil {
+		return false
+	}
+	r := route{messageID: messageID, destination: destination}
+	readOffsets := t.routes[r]
+	if len(readOffsets) == 0 {
+		return false
+	}
+	t.pending[readOffsets[0]]--
+	if len(readOffsets) == 1 {
+		delete(t.routes, r)
+	} else {
+		t.routes[r] = readOffsets[1:]
+	}
+	t.counts[outcome]++
+	return true
+}
+
+// Count returns the number of the routes that have reached the terminal state.
+func (t *CompletionTracker) Count(outcome Outcome) int {
+	if t == nil {
+		return 0
+	}
+	return t.counts[outcome]
+}
+
+// Completed returns the complete read offsets, in the order of the read messages.
+func (t *CompletionTracker) Completed() []isb.Offset {
+	return t.filter(true)
+}
+
+// Incomplete returns the read offsets with a route not in a terminal state yet, in the order of the read messages.
+func (t *CompletionTracker) Incomplete() []isb.Offset {
+	return t.filter(false)
+}
+
+func (t *CompletionTracker) filter(complete bool) []isb.Offset {
+	if t == nil {
+		return nil
+	}
+	var offsets []isb.Offset
+	for _, offset := range t.readOffsets {
+		if (t.pending[offset.String()] == 0) == complete {
+			offsets = append(offsets, offset)
+		}
+	}
+	return offsets
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func testReadMessages(n int) []*isb.ReadMessage {
+	readMessages := make([]*isb.ReadMessage, n)
+	for i := range readMessages {
+		readMessages[i] = &isb.ReadMessage{ReadOffset: isb.NewSimpleIntPartitionOffset(int64(i), 0)}
+	}
+	return readMessages
+}
+
+func TestCompletionTracker_FanOut(t *testing.T) {
+	destinations := []string{"to1-0", "to2-0", "to3-0"}
+	// pending means the destination has not reached a terminal state.
+	const pending Outcome = ""
+	outcomes := []Outcome{OutcomeWritten, OutcomeDropped, pending}
+	for _, o1 := range outcomes {
+		for _, o2 := range outcomes {
+			for _, o3 := range outcomes {
+				results := []Outcome{o1, o2, o3}
+				t.Run(fmt.Sprintf("%v", results), func(t *testing.T) {
+					readMessages := testReadMessages(1)
+					tracker := NewCompletionTracker(readMessages)
+					for _, d := range destinations {
+						tracker.Route(readMessages[0].ReadOffset, "msg-0", d)
+					}
+					counts := make(map[Outcome]int)
+					complete := true
+					for i, result := range results {
+						if result == pending {
+							complete = false
+							continue
+						}
+						assert.True(t, tracker.Complete("msg-0", destinations[i], result))
+						// the same message written again on a retry does not count.
+						assert.False(t, tracker.Complete("msg-0", destinations[i], result))
+						counts[result]++
+					}
+					if complete {
+						assert.Equal(t, []isb.Offset{readMessages[0].ReadOffset}, tracker.Completed())
+						assert.Empty(t, tracker.Incomplete())
+					} else {
+						assert.Empty(t, tracker.Completed())
+						assert.Equal(t, []isb.Offset{readMessages[0].ReadOffset}, tracker.Incomplete())
+					}
+					assert.Equal(t, counts[OutcomeWritten], tracker.Count(OutcomeWritten))
+					assert.Equal(t, counts[OutcomeDropped], tracker.Count(OutcomeDropped))
+				})
+			}
+		}
+	}
+}
+
+func TestCompletionTracker_Batch(t *testing.T) {
+	readMessages := testReadMessages(4)
+	tracker := NewCompletionTracker(readMessages)
+	// the first message is written to two destinations, the second one is filtered out, the third one is written
+	// twice to the same destination by a flat map, and the fourth one is not written yet.
+	tracker.Route(readMessages[0].ReadOffset, "msg-0", "to1-0")
+	tracker.Route(readMessages[0].ReadOffset, "msg-0", "to2-0")
+	tracker.Route(readMessages[2].ReadOffset, "msg-2-0", "to1-0")
+	tracker.Route(readMessages[2].ReadOffset, "msg-2-1", "to1-0")
+	tracker.Route(readMessages[3].ReadOffset, "msg-3", "to1-0")
+	assert.True(t, tracker.Complete("msg-0", "to1-0", OutcomeWritten))
+	assert.True(t, tracker.Complete("msg-0", "to2-0", OutcomeDropped))
+	assert.True(t, tracker.Complete("msg-2-0", "to1-0", OutcomeWritten))
+	assert.True(t, tracker.Complete("msg-2-1", "to1-0", OutcomeWritten))
+	// an unknown route does not complete anything.
+	assert.False(t, tracker.Complete("msg-3", "to2-0", OutcomeWritten))
+
+	assert.Equal(t, []isb.Offset{readMessages[0].ReadOffset, readMessages[1].ReadOffset, readMessages[2].ReadOffset}, tracker.Completed())
+	assert.Equal(t, []isb.Offset{readMessages[3].ReadOffset}, tracker.Incomplete())
+	assert.Equal(t, 3, tracker.Count(OutcomeWritten))
+	assert.Equal(t, 1, tracker.Count(OutcomeDropped))
+}
+
+func TestCompletionTracker_Nil(t *testing.T) {
+	var tracker *CompletionTracker
+	tracker.Route(isb.NewSimpleIntPartitionOffset(0, 0), "msg-0", "to1-0")
+	assert.False(t, tracker.Complete("msg-0", "to1-0", OutcomeWritten))
+	assert.Equal(t, 0, tracker.Count(OutcomeWritten))
+	assert.Nil(t, tracker.Completed())
+	assert.Nil(t, tracker.Incomplete())
+}
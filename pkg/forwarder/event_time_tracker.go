@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// EventTimeTracker tracks the maximum event time observed in the read path of a forwarder, a.k.a. the high-water
+// event time. Watermark is the lower bound of the event times, the high-water event time is the upper bound, and
+// is used to detect upstream clock issues that make messages carry event times in the future.
+type EventTimeTracker struct {
+	// futureBound is how far ahead of the wall clock an event time can be before it is counted as a future event time.
+	futureBound time.Duration
+	// metricLabels are the labels of the exposed metrics.
+	metricLabels map[string]string
+	// highWaterEventTime is the max event time in milliseconds observed so far.
+	highWaterEventTime atomic.Int64
+}
+
+// NewEventTimeTracker returns a new EventTimeTracker, metricLabels should contain the vertex, pipeline,
+// vertex type, replica and partition name labels.
+func NewEventTimeTracker(futureBound time.Duration, metricLabels map[string]string) *EventTimeTracker {
+	t := &EventTimeTracker{
+		futureBound:  futureBound,
+		metricLabels: metricLabels,
+	}
+	t.highWaterEventTime.Store(-1)
+	return t
+}
+
+// Observe updates the high-water event time with the given data messages, it returns the number of messages
+// whose event time is more than the future bound ahead of now. For the messages carrying the ingestion time, it also
+// observes the source delay (ingestion time - event time) and the pipeline delay (now - ingestion time).
+func (t *EventTimeTracker) Observe(messages []*isb.ReadMessage, now time.Time) int {
+	if len(messages) == 0 {
+		return 0
+	}
+	futureCount := 0
+	maxEventTime := int64(-1)
+	limit := now.Add(t.futureBound)
+	sourceDelay := metrics.SourceDelay.With(t.metricLabels)
+	pipelineDelay := metrics.PipelineDelay.With(t.metricLabels)
+	for _, m := range messages {
+		if m.Kind != isb.Data {
+			continue
+		}
+		if m.EventTime.After(limit) {
+			futureCount++
+		}
+		// messages written by the older versions do not carry the ingestion time.
+		if !m.IngestionTime.IsZero() {
+			sourceDelay.Observe(float64(m.IngestionTime.Sub(m.EventTime).Microseconds()))
+			pipelineDelay.Observe(float64(now.Sub(m.IngestionTime).Microseconds()))
+		}
+		if et := m.EventTime.UnixMilli(); et > maxEventTime {
+			maxEventTime = et
+		}
+	}
+	for {
+		current := t.highWaterEventTime.Load()
+		if maxEventTime <= current || t.highWaterEventTime.CompareAndSwap(current, maxEventTime) {
+			break
+		}
+	}
+	metrics.HighWaterEventTime.With(t.metricLabels).Set(float64(t.highWaterEventTime.Load()))
+	if futureCount > 0 {
+		metrics.FutureEventTimeCount.With(t.metricLabels).Add(float64(futureCount))
+	}
+	return futureCount
+}
+
+// HighWaterEventTime returns the max event time observed so far, time.UnixMilli(-1) if nothing has been observed.
+func (t *EventTimeTracker) HighWaterEventTime() time.Time {
+	return time.UnixMilli(t.highWaterEventTime.Load())
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/sources/generator"
+)
+
+func TestEventTimeTracker_Observe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rpu := int64(20)
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU: &rpu,
+						// the event times are up to an hour in the future.
+						FutureJitter: &v1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+		},
+	}
+	vi := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestEventTimeTracker",
+		Replica:  0,
+	}
+	mGen, err := generator.NewMemGen(ctx, vi, generator.WithReadTimeout(3*time.Second))
+	assert.NoError(t, err)
+	messages, err := mGen.Read(ctx, 20)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 20)
+
+	now := time.Now()
+	bound := time.Minute
+	expectedFutureCount := 0
+	expectedHighWater := int64(-1)
+	for _, m := range messages {
+		if m.EventTime.After(now.Add(bound)) {
+			expectedFutureCount++
+		}
+		if m.EventTime.UnixMilli() > expectedHighWater {
+			expectedHighWater = m.EventTime.UnixMilli()
+		}
+	}
+
+	labels := map[string]string{
+		metrics.LabelVertex:             "testVertex",
+		metrics.LabelPipeline:           "testPipeline",
+		metrics.LabelVertexType:         string(dfv1.VertexTypeSource),
+		metrics.LabelVertexReplicaIndex: "0",
+		metrics.LabelPartitionName:      mGen.GetName(),
+	}
+	tracker := NewEventTimeTracker(bound, labels)
+	assert.Equal(t, int64(-1), tracker.HighWaterEventTime().UnixMilli())
+	assert.Equal(t, expectedFutureCount, tracker.Observe(messages, now))
+	assert.Equal(t, expectedHighWater, tracker.HighWaterEventTime().UnixMilli())
+	assert.True(t, tracker.HighWaterEventTime().After(now.Add(bound)))
+
+	// the high-water event time never goes back.
+	past := &isb.ReadMessage{Message: isb.Message{Header: isb.Header{MessageInfo: isb.MessageInfo{EventTime: now.Add(-time.Hour)}}}}
+	assert.Equal(t, 0, tracker.Observe([]*isb.ReadMessage{past}, now))
+	assert.Equal(t, expectedHighWater, tracker.HighWaterEventTime().UnixMilli())
+
+	// watermark barriers are ignored.
+	wmb := &isb.ReadMessage{Message: isb.Message{Header: isb.Header{Kind: isb.WMB, MessageInfo: isb.MessageInfo{EventTime: now.Add(10 * time.Hour)}}}}
+	assert.Equal(t, 0, tracker.Observe([]*isb.ReadMessage{wmb}, now))
+	assert.Equal(t, expectedHighWater, tracker.HighWaterEventTime().UnixMilli())
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+// Stage is a stage of forwarding a batch of messages.
+type Stage string
+
+const (
+	// StageReadWait is the time spent waiting for the read of the batch.
+	StageReadWait Stage = "readWait"
+	// StageWatermarkFetch is the time spent fetching the watermark of the batch.
+	StageWatermarkFetch Stage = "watermarkFetch"
+	// StageUDF is the time spent applying the UDF to the batch.
+	StageUDF Stage = "udf"
+	// StageWrite is the time spent writing the batch to all the destinations.
+	StageWrite Stage = "write"
+	// StageWatermarkPublish is the time spent publishing the watermarks of the batch.
+	StageWatermarkPublish Stage = "watermarkPublish"
+	// StageAck is the time spent acknowledging the batch.
+	StageAck Stage = "ack"
+)
+
+// stages is the order of the stages in the slow batch log line.
+var stages = []Stage{StageReadWait, StageWatermarkFetch, StageUDF, StageWrite, StageWatermarkPublish, StageAck}
+
+// slowBatchLogInterval is the minimum interval between two slow batch log lines.
+const slowBatchLogInterval = time.Minute
+
+// BatchTimer captures the time spent in each stage of forwarding a batch. A nil BatchTimer is valid and records
+// nothing, it is returned by a disabled SlowBatchLogger so that the forwarders do not need to check.
+type BatchTimer struct {
+	clock clock.Clock
+	start time.Time
+	// durations is the time spent in each stage.
+	durations map[Stage]time.Duration
+	// writes is the time spent writing to each destination, in the order of the first write.
+	writes       map[string]time.Duration
+	destinations []string
+}
+
+// Now returns the current time of the timer's clock, it is the start time passed to Observe.
+func (t *BatchTimer) Now() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.clock.Now()
+}
+
+// Duration returns the time spent in the stage so far.
+func (t *BatchTimer) Duration(stage Stage) time.Duration {
+	if t == nil {
+		return 0
+	}
+	return t.durations[stage]
+}
+
+// Observe adds the time since start to the stage.
+func (t *BatchTimer) Observe(stage Stage, start time.Time) {
+	if t == nil {
+		return
+	}
+	t.durations[stage] += t.clock.Now().Sub(start)
+}
+
+// ObserveWrite adds the time since start to the write stage, and to the writes of the destination.
+func (t *BatchTimer) ObserveWrite(destination string, start time.Time) {
+	if t == nil {
+		return
+	}
+	d := t.clock.Now().Sub(start)
+	t.durations[StageWrite] += d
+	if _, ok := t.writes[destination]; !ok {
+		t.destinations = append(t.destinations, destination)
+	}
+	t.writes[destination] += d
+}
+
+// SlowBatchLogger logs a breakdown of where the time went for the batches taking longer than a threshold. It logs
+// at most one line every minute, and the number of slow batches not logged in between is added to the next line.
+// It is not thread safe, every forwarder owns its own.
+type SlowBatchLogger struct {
+	logger    *zap.SugaredLogger
+	threshold time.Duration
+	interval  time.Duration
+	clock     clock.Clock
+	// lastLogged is the time of the last slow batch log line.
+	lastLogged time.Time
+	// suppressed is the number of slow batches not logged since the last log line.
+	suppressed int
+}
+
+// NewSlowBatchLogger returns a new SlowBatchLogger, a non-positive threshold disables it.
+func NewSlowBatchLogger(logger *zap.SugaredLogger, threshold time.Duration) *SlowBatchLogger {
+	return &SlowBatchLogger{
+		logger:    logger,
+		threshold: threshold,
+		interval:  slowBatchLogInterval,
+		clock:     clock.RealClock(),
+	}
+}
+
+// Start returns a BatchTimer for a new batch, it is nil if the logger is disabled.
+func (l *SlowBatchLogger) Start() *BatchTimer {
+	if l == nil || l.threshold <= 0 {
+		return nil
+	}
+	return &BatchTimer{
+		clock:     l.clock,
+		start:     l.clock.Now(),
+		durations: make(map[Stage]time.Duration, len(stages)),
+		writes:    make(map[string]time.Duration),
+	}
+}
+
+// Finish logs the breakdown of the batch if it took longer than the threshold and no line has been logged within
+// the last minute, it returns whether the line is logged.
+func (l *SlowBatchLogger) Finish(t *BatchTimer, count int) bool {
+	if l == nil || t == nil {
+		return false
+	}
+	now := l.clock.Now()
+	total := now.Sub(t.start)
+	if total < l.threshold {
+		return false
+	}
+	if !l.lastLogged.IsZero() && now.Sub(l.lastLogged) < l.interval {
+		l.suppressed++
+		return false
+	}
+	fields := make([]interface{}, 0, len(stages)+5)
+	fields = append(fields, zap.Duration("total", total), zap.Int("count", count))
+	var accounted time.Duration
+	for _, s := range stages {
+		if d, ok := t.durations[s]; ok {
+			fields = append(fields, zap.Duration(string(s), d))
+			accounted += d
+		}
+	}
+	if len(t.destinations) > 0 {
+		writes := make([]zap.Field, 0, len(t.destinations))
+		for _, dest := range t.destinations {
+			writes = append(writes, zap.Duration(dest, t.writes[dest]))
+		}
+		fields = append(fields, zap.Dict("writes", writes...))
+	}
+	// the time not spent in any of the stages, e.g. routing the messages and the callbacks.
+	fields = append(fields, zap.Duration("other", total-accounted), zap.Int("suppressed", l.suppressed))
+	l.logger.Warnw("Slow batch", fields...)
+	l.lastLogged = now
+	l.suppressed = 0
+	return true
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
+func newTestSlowBatchLogger(threshold time.Duration) (*SlowBatchLogger, *clock.FakeClock, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.InfoLevel)
+	l := NewSlowBatchLogger(zap.New(core).Sugar(), threshold)
+	fakeClock := clock.NewFakeClock(time.Unix(1700000000, 0))
+	l.clock = fakeClock
+	return l, fakeClock, logs
+}
+
+// forwardBatch mimics the stages of a forwarder, every stage takes the given duration on the fake clock.
+func forwardBatch(l *SlowBatchLogger, fakeClock *clock.FakeClock, stage time.Duration) bool {
+	timer := l.Start()
+	for _, s := range []Stage{StageReadWait, StageWatermarkFetch, StageUDF} {
+		start := timer.Now()
+		fakeClock.Step(stage)
+		timer.Observe(s, start)
+	}
+	for _, dest := range []string{"out-0", "out-1", "out-0"} {
+		start := timer.Now()
+		fakeClock.Step(stage)
+		timer.ObserveWrite(dest, start)
+	}
+	for _, s := range []Stage{StageWatermarkPublish, StageAck} {
+		start := timer.Now()
+		fakeClock.Step(stage)
+		timer.Observe(s, start)
+	}
+	// time not spent in any stage
+	fakeClock.Step(stage)
+	return l.Finish(timer, 10)
+}
+
+func TestSlowBatchLogger_Finish(t *testing.T) {
+	l, fakeClock, logs := newTestSlowBatchLogger(time.Second)
+
+	// 9 * 100ms is below the threshold
+	assert.False(t, forwardBatch(l, fakeClock, 100*time.Millisecond))
+	assert.Equal(t, 0, logs.Len())
+
+	assert.True(t, forwardBatch(l, fakeClock, time.Second))
+	entries := logs.TakeAll()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, zap.WarnLevel, entries[0].Level)
+	assert.Equal(t, "Slow batch", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{
+		"total":            9 * time.Second,
+		"count":            int64(10),
+		"readWait":         time.Second,
+		"watermarkFetch":   time.Second,
+		"udf":              time.Second,
+		"write":            3 * time.Second,
+		"writes":           map[string]interface{}{"out-0": 2 * time.Second, "out-1": time.Second},
+		"watermarkPublish": time.Second,
+		"ack":              time.Second,
+		"other":            time.Second,
+		"suppressed":       int64(0),
+	}, entries[0].ContextMap())
+}
+
+func TestSlowBatchLogger_RateLimited(t *testing.T) {
+	l, fakeClock, logs := newTestSlowBatchLogger(time.Second)
+
+	assert.True(t, forwardBatch(l, fakeClock, time.Second))
+	// the next slow batches within a minute are not logged, but counted.
+	assert.False(t, forwardBatch(l, fakeClock, time.Second))
+	assert.False(t, forwardBatch(l, fakeClock, time.Second))
+	assert.Equal(t, 1, logs.Len())
+
+	fakeClock.Step(time.Minute)
+	assert.True(t, forwardBatch(l, fakeClock, time.Second))
+	entries := logs.TakeAll()
+	assert.Len(t, entries, 2)
+	assert.Equal(t, int64(2), entries[1].ContextMap()["suppressed"])
+}
+
+func TestSlowBatchLogger_Disabled(t *testing.T) {
+	l, fakeClock, logs := newTestSlowBatchLogger(0)
+	timer := l.Start()
+	assert.Nil(t, timer)
+	// a nil timer is a no-op
+	start := timer.Now()
+	fakeClock.Step(time.Hour)
+	timer.Observe(StageUDF, start)
+	timer.ObserveWrite("out", start)
+	assert.Equal(t, time.Duration(0), timer.Duration(StageUDF))
+	assert.False(t, l.Finish(timer, 10))
+	assert.Equal(t, 0, logs.Len())
+
+	// a nil logger is a no-op too
+	var nilLogger *SlowBatchLogger
+	assert.Nil(t, nilLogger.Start())
+	assert.False(t, nilLogger.Finish(nil, 10))
+}
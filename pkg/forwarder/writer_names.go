@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// ValidateWriterNames returns an error naming the duplicates if two of the buffer writers report the same name. The
+// forwarders keep the state of every buffer partition, e.g. the idle watermark status, by the name of the partition,
+// so a duplicate name would make the partitions silently share the state. The writers of the inter-step buffers are
+// named after their partitions (see v1alpha1.GenerateBufferNames), so the partitions of an edge do not collide.
+func ValidateWriterNames(toBuffers map[string][]isb.BufferWriter) error {
+	toVertices := make([]string, 0, len(toBuffers))
+	for toVertex := range toBuffers {
+		toVertices = append(toVertices, toVertex)
+	}
+	sort.Strings(toVertices)
+	// writers is the writers of every name, in the form of toVertex[partition].
+	writers := make(map[string][]string)
+	var names []string
+	for _, toVertex := range toVertices {
+		for index, writer := range toBuffers[toVertex] {
+			name := writer.GetName()
+			if _, ok := writers[name]; !ok {
+				names = append(names, name)
+			}
+			writers[name] = append(writers[name], fmt.Sprintf("%s[%d]", toVertex, index))
+		}
+	}
+	var duplicates []string
+	for _, name := range names {
+		if len(writers[name]) > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%q is used by %s", name, strings.Join(writers[name], ", ")))
+		}
+	}
+	if len(duplicates) > 0 {
+		return fmt.Errorf("duplicate buffer writer names, %s", strings.Join(duplicates, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+)
+
+func TestValidateWriterNames(t *testing.T) {
+	// the partitions of an edge are named after the partition index.
+	toBuffers := map[string][]isb.BufferWriter{
+		"to1": {simplebuffer.NewInMemoryBuffer("to1-0", 10, 0), simplebuffer.NewInMemoryBuffer("to1-1", 10, 1)},
+		"to2": {simplebuffer.NewInMemoryBuffer("to2-0", 10, 0)},
+	}
+	assert.NoError(t, ValidateWriterNames(toBuffers))
+	assert.NoError(t, ValidateWriterNames(nil))
+
+	toBuffers = map[string][]isb.BufferWriter{
+		"to1": {simplebuffer.NewInMemoryBuffer("to1", 10, 0), simplebuffer.NewInMemoryBuffer("to1", 10, 1)},
+		"to2": {simplebuffer.NewInMemoryBuffer("shared", 10, 0)},
+		"to3": {simplebuffer.NewInMemoryBuffer("shared", 10, 0)},
+		"to4": {simplebuffer.NewInMemoryBuffer("to4", 10, 0)},
+	}
+	err := ValidateWriterNames(toBuffers)
+	assert.EqualError(t, err, `duplicate buffer writer names, "to1" is used by to1[0], to1[1]; "shared" is used by to2[0], to3[0]`)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isb
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/isb"
+)
+
+// CodecVersion is the version of the encoding of the messages in the buffers. The messages are encoded with the
+// protobuf messages in pkg/apis/proto/isb, every version only adds fields, so the messages written by any version
+// can be decoded by the later ones, and a message only using the fields of an earlier version is encoded exactly
+// the same way as that version.
+//
+//   - CodecV1: the event time and the late flag, the kind, the ID (vertex name, offset and index), the keys, the
+//     headers, and the payload.
+//   - CodecV2: adds the ingestion time.
+//   - CodecV3: adds the ID of the key the payload is encrypted with.
+//
+// The JetStream buffers store the encoded Message as the data of a stream message, and the Redis buffers store the
+// encoded Header as the field and the raw payload as the value of a stream entry.
+type CodecVersion int
+
+const (
+	CodecV1 CodecVersion = iota + 1
+	CodecV2
+	CodecV3
+
+	// CurrentCodecVersion is the version the messages are encoded with.
+	CurrentCodecVersion = CodecV3
+)
+
+func (v CodecVersion) String() string {
+	return fmt.Sprintf("v%d", int(v))
+}
+
+// CodecVersion returns the earliest codec version able to encode the header.
+func (h Header) CodecVersion() CodecVersion {
+	switch {
+	case h.EncryptionKeyID != "":
+		return CodecV3
+	case !h.IngestionTime.IsZero():
+		return CodecV2
+	default:
+		return CodecV1
+	}
+}
+
+// DecodeMessage decodes a Message encoded by any codec version, e.g., the data of a message in a JetStream buffer.
+// Unlike UnmarshalBinary, the data is not trusted, an error is returned if the required fields are missing.
+func DecodeMessage(data []byte) (*Message, error) {
+	pb := &isb.Message{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, fmt.Errorf("failed to decode the message, %w", err)
+	}
+	if pb.Header == nil {
+		return nil, errors.New("failed to decode the message, missing header")
+	}
+	if pb.Body == nil {
+		return nil, errors.New("failed to decode the message, missing body")
+	}
+	if err := validateProtoHeader(pb.Header); err != nil {
+		return nil, fmt.Errorf("failed to decode the message, %w", err)
+	}
+	m := &Message{Body: Body{Payload: pb.Body.Payload}}
+	m.Header.fromProto(pb.Header)
+	return m, nil
+}
+
+// DecodeHeader decodes a Header encoded by any codec version, e.g., the field of an entry in a Redis buffer.
+// Unlike UnmarshalBinary, the data is not trusted, an error is returned if the required fields are missing.
+func DecodeHeader(data []byte) (*Header, error) {
+	pb := &isb.Header{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, fmt.Errorf("failed to decode the header, %w", err)
+	}
+	if err := validateProtoHeader(pb); err != nil {
+		return nil, fmt.Errorf("failed to decode the header, %w", err)
+	}
+	h := &Header{}
+	h.fromProto(pb)
+	return h, nil
+}
+
+// validateProtoHeader checks the fields set by all the codec versions exist.
+func validateProtoHeader(pb *isb.Header) error {
+	if pb.MessageInfo == nil || pb.MessageInfo.EventTime == nil {
+		return errors.New("missing message info")
+	}
+	if pb.Id == nil {
+		return errors.New("missing message ID")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isb
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/isb"
+)
+
+// codecGoldenHeaders are the headers encoded by each codec version, the encoded bytes must never change so that the
+// messages in the buffers stay readable across the versions.
+var codecGoldenHeaders = []struct {
+	version CodecVersion
+	header  Header
+	message string
+	encoded string
+}{
+	{
+		version: CodecV1,
+		header: Header{
+			MessageInfo: MessageInfo{EventTime: time.UnixMilli(1676617200000).UTC(), IsLate: true},
+			Kind:        Data,
+			ID:          MessageID{VertexName: "in", Offset: "42", Index: 1},
+			Keys:        []string{"k1"},
+			Headers:     map[string]string{"h1": "v1"},
+		},
+		message: "0a260a0a0a0608f0cbbc9f0610011a0a0a02696e12023432180122026b312a080a0268311202763112090a077061796c6f6164",
+		encoded: "0a0a0a0608f0cbbc9f0610011a0a0a02696e12023432180122026b312a080a02683112027631",
+	},
+	{
+		version: CodecV2,
+		header: Header{
+			MessageInfo: MessageInfo{EventTime: time.UnixMilli(1676617200000).UTC(), IsLate: true, IngestionTime: time.UnixMilli(1676617260000).UTC()},
+			Kind:        Data,
+			ID:          MessageID{VertexName: "in", Offset: "42", Index: 1},
+			Keys:        []string{"k1"},
+			Headers:     map[string]string{"h1": "v1"},
+		},
+		message: "0a2e0a120a0608f0cbbc9f0610011a0608acccbc9f061a0a0a02696e12023432180122026b312a080a0268311202763112090a077061796c6f6164",
+		encoded: "0a120a0608f0cbbc9f0610011a0608acccbc9f061a0a0a02696e12023432180122026b312a080a02683112027631",
+	},
+	{
+		version: CodecV3,
+		header: Header{
+			MessageInfo:     MessageInfo{EventTime: time.UnixMilli(1676617200000).UTC(), IsLate: true, IngestionTime: time.UnixMilli(1676617260000).UTC()},
+			Kind:            Data,
+			ID:              MessageID{VertexName: "in", Offset: "42", Index: 1},
+			Keys:            []string{"k1"},
+			Headers:         map[string]string{"h1": "v1"},
+			EncryptionKeyID: "key-1",
+		},
+		message: "0a350a120a0608f0cbbc9f0610011a0608acccbc9f061a0a0a02696e12023432180122026b312a080a0268311202763132056b65792d3112090a077061796c6f6164",
+		encoded: "0a120a0608f0cbbc9f0610011a0608acccbc9f061a0a0a02696e12023432180122026b312a080a0268311202763132056b65792d31",
+	},
+}
+
+func TestCodecGolden(t *testing.T) {
+	for _, tt := range codecGoldenHeaders {
+		t.Run(tt.header.CodecVersion().String(), func(t *testing.T) {
+			assert.Equal(t, tt.version, tt.header.CodecVersion())
+			message := Message{Header: tt.header, Body: Body{Payload: []byte("payload")}}
+
+			data, err := hex.DecodeString(tt.message)
+			require.NoError(t, err)
+			decoded, err := DecodeMessage(data)
+			require.NoError(t, err)
+			assert.Equal(t, message, *decoded)
+			encoded, err := message.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, tt.message, hex.EncodeToString(encoded))
+
+			data, err = hex.DecodeString(tt.encoded)
+			require.NoError(t, err)
+			header, err := DecodeHeader(data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.header, *header)
+			encoded, err = tt.header.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, tt.encoded, hex.EncodeToString(encoded))
+		})
+	}
+}
+
+func TestDecodeMessage_Invalid(t *testing.T) {
+	_, err := DecodeMessage([]byte("not a message"))
+	assert.ErrorContains(t, err, "failed to decode the message")
+
+	missingBody, err := proto.Marshal(&isb.Message{Header: &isb.Header{MessageInfo: &isb.MessageInfo{}, Id: &isb.MessageID{}}})
+	require.NoError(t, err)
+	_, err = DecodeMessage(missingBody)
+	assert.ErrorContains(t, err, "missing body")
+
+	missingID, err := proto.Marshal(&isb.Message{
+		Header: &isb.Header{MessageInfo: &isb.MessageInfo{EventTime: timestamppb.Now()}},
+		Body:   &isb.Body{},
+	})
+	require.NoError(t, err)
+	_, err = DecodeMessage(missingID)
+	assert.ErrorContains(t, err, "missing message ID")
+
+	_, err = DecodeHeader(nil)
+	assert.ErrorContains(t, err, "missing message info")
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// bufferWriter encrypts the payloads of the data messages before writing them to the underlying buffer.
+// NOTE: encrypted payloads do not compress, so compression, if added to the writer, must happen before encryption.
+type bufferWriter struct {
+	isb.BufferWriter
+	keyring *Keyring
+}
+
+// NewBufferWriter returns a BufferWriter which encrypts the payloads with the primary key of the keyring.
+func NewBufferWriter(w isb.BufferWriter, keyring *Keyring) isb.BufferWriter {
+	return &bufferWriter{BufferWriter: w, keyring: keyring}
+}
+
+// Write encrypts the payloads and writes the messages to the underlying buffer. The given messages are not
+// modified, since the callers retry the failed writes with the same messages.
+func (bw *bufferWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	encrypted := make([]isb.Message, len(messages))
+	errs := make([]error, len(messages))
+	var failed bool
+	for i, m := range messages {
+		encrypted[i] = m
+		if m.Kind != isb.Data {
+			continue
+		}
+		keyID, ciphertext, err := bw.keyring.Encrypt(m.Payload)
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to encrypt the payload, %w", err)
+			failed = true
+			continue
+		}
+		encrypted[i].EncryptionKeyID = keyID
+		encrypted[i].Payload = ciphertext
+	}
+	if failed {
+		// the encryption only fails if the random nonce can not be generated, so the whole batch is retried.
+		return make([]isb.Offset, len(messages)), errs
+	}
+	return bw.BufferWriter.Write(ctx, encrypted)
+}
+
+// bufferReader decrypts the payloads of the messages read from the underlying buffer.
+type bufferReader struct {
+	isb.BufferReader
+	keyring *Keyring
+}
+
+// NewBufferReader returns a BufferReader which decrypts the payloads with the keys of the keyring.
+func NewBufferReader(r isb.BufferReader, keyring *Keyring) isb.BufferReader {
+	return &bufferReader{BufferReader: r, keyring: keyring}
+}
+
+// Read reads the messages from the underlying buffer and decrypts the payloads. The messages which can not be
+// decrypted are not returned, and they are not acknowledged so that they are redelivered, the error of the first
+// failure is returned with the decrypted messages.
+func (br *bufferReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	readMessages, err := br.BufferReader.Read(ctx, count)
+	var (
+		decrypted  = readMessages[:0]
+		failed     []isb.Offset
+		decryptErr error
+	)
+	for _, m := range readMessages {
+		if m.EncryptionKeyID == "" {
+			decrypted = append(decrypted, m)
+			continue
+		}
+		payload, dErr := br.keyring.Decrypt(m.EncryptionKeyID, m.Payload)
+		if dErr != nil {
+			logging.FromContext(ctx).Errorw("Failed to decrypt the message payload", zap.String("offset", m.ReadOffset.String()), zap.Error(dErr))
+			failed = append(failed, m.ReadOffset)
+			if decryptErr == nil {
+				decryptErr = fmt.Errorf("failed to decrypt the message at offset %s, %w", m.ReadOffset.String(), dErr)
+			}
+			continue
+		}
+		m.Payload = payload
+		m.EncryptionKeyID = ""
+		decrypted = append(decrypted, m)
+	}
+	if len(failed) > 0 {
+		br.BufferReader.NoAck(ctx, failed)
+	}
+	if err == nil {
+		err = decryptErr
+	}
+	return decrypted, err
+}
+
+// WrapReaders wraps the readers to decrypt the payloads, the readers are returned as they are if the keyring is nil.
+func WrapReaders(readers []isb.BufferReader, keyring *Keyring) []isb.BufferReader {
+	if keyring == nil {
+		return readers
+	}
+	wrapped := make([]isb.BufferReader, len(readers))
+	for i, r := range readers {
+		wrapped[i] = NewBufferReader(r, keyring)
+	}
+	return wrapped
+}
+
+// WrapWriters wraps the writers of each to vertex to encrypt the payloads, the writers are returned as they are if
+// the keyring is nil.
+func WrapWriters(writers map[string][]isb.BufferWriter, keyring *Keyring) map[string][]isb.BufferWriter {
+	if keyring == nil {
+		return writers
+	}
+	wrapped := make(map[string][]isb.BufferWriter, len(writers))
+	for toVertex, partitions := range writers {
+		wrapped[toVertex] = make([]isb.BufferWriter, len(partitions))
+		for i, w := range partitions {
+			wrapped[toVertex][i] = NewBufferWriter(w, keyring)
+		}
+	}
+	return wrapped
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestBufferReadWrite(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	k, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	require.NoError(t, err)
+
+	buffer := simplebuffer.NewInMemoryBuffer("test", 10, 0)
+	writer := NewBufferWriter(buffer, k)
+	reader := NewBufferReader(buffer, k)
+
+	messages := testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0), nil, "in")
+	messages = append(messages, isb.Message{Header: isb.Header{Kind: isb.WMB}})
+	_, errs := writer.Write(ctx, messages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	// the given messages are not modified.
+	for _, m := range messages {
+		assert.Empty(t, m.EncryptionKeyID)
+	}
+
+	// the payloads are encrypted in the buffer.
+	stored := buffer.GetMessages(4)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, "key-1", stored[i].EncryptionKeyID)
+		assert.NotEqual(t, messages[i].Payload, stored[i].Payload)
+	}
+	assert.Empty(t, stored[3].EncryptionKeyID)
+
+	readMessages, err := reader.Read(ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 4)
+	for i, m := range readMessages {
+		assert.Empty(t, m.EncryptionKeyID)
+		assert.Equal(t, messages[i].Payload, m.Payload)
+		assert.Equal(t, messages[i].Kind, m.Kind)
+	}
+}
+
+func TestBufferRead_Rotation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	old, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	require.NoError(t, err)
+	rotated, err := NewKeyring(map[string][]byte{"key-1": testKey(1), "key-2": testKey(2)}, "key-2")
+	require.NoError(t, err)
+
+	buffer := simplebuffer.NewInMemoryBuffer("test", 10, 0)
+	messages := testutils.BuildTestWriteMessages(4, time.Unix(1636470000, 0), nil, "in")
+	// the first messages are written before the rotation, and the rest are written after the rotation.
+	_, errs := NewBufferWriter(buffer, old).Write(ctx, messages[:2])
+	assert.Equal(t, []error{nil, nil}, errs)
+	_, errs = NewBufferWriter(buffer, rotated).Write(ctx, messages[2:])
+	assert.Equal(t, []error{nil, nil}, errs)
+
+	stored := buffer.GetMessages(4)
+	assert.Equal(t, []string{"key-1", "key-1", "key-2", "key-2"}, []string{stored[0].EncryptionKeyID, stored[1].EncryptionKeyID, stored[2].EncryptionKeyID, stored[3].EncryptionKeyID})
+
+	readMessages, err := NewBufferReader(buffer, rotated).Read(ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 4)
+	for i, m := range readMessages {
+		assert.Equal(t, messages[i].Payload, m.Payload)
+	}
+}
+
+func TestBufferRead_UnknownKey(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	oldKeyring, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	require.NoError(t, err)
+	writerKeyring, err := NewKeyring(map[string][]byte{"key-1": testKey(1), "key-2": testKey(2)}, "key-2")
+	require.NoError(t, err)
+
+	buffer := simplebuffer.NewInMemoryBuffer("test", 10, 0)
+	messages := testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "in")
+	_, errs := NewBufferWriter(buffer, oldKeyring).Write(ctx, messages[:1])
+	assert.Equal(t, []error{nil}, errs)
+	_, errs = NewBufferWriter(buffer, writerKeyring).Write(ctx, messages[1:])
+	assert.Equal(t, []error{nil}, errs)
+
+	// the message which can not be decrypted is not returned.
+	readMessages, err := NewBufferReader(buffer, oldKeyring).Read(ctx, 2)
+	assert.ErrorContains(t, err, `unknown key "key-2"`)
+	require.Len(t, readMessages, 1)
+	assert.Equal(t, messages[0].Payload, readMessages[0].Payload)
+}
+
+func TestWrap(t *testing.T) {
+	buffer := simplebuffer.NewInMemoryBuffer("test", 10, 0)
+	readers := []isb.BufferReader{buffer}
+	writers := map[string][]isb.BufferWriter{"out": {buffer}}
+	assert.Equal(t, readers, WrapReaders(readers, nil))
+	assert.Equal(t, writers, WrapWriters(writers, nil))
+
+	k, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	require.NoError(t, err)
+	wrappedReaders := WrapReaders(readers, k)
+	require.Len(t, wrappedReaders, 1)
+	assert.IsType(t, &bufferReader{}, wrappedReaders[0])
+	assert.Equal(t, "test", wrappedReaders[0].GetName())
+	wrappedWriters := WrapWriters(writers, k)
+	require.Len(t, wrappedWriters["out"], 1)
+	assert.IsType(t, &bufferWriter{}, wrappedWriters["out"][0])
+}
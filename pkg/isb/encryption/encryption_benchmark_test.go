@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func benchmarkMessage() isb.Message {
+	return isb.Message{
+		Header: isb.Header{
+			MessageInfo: isb.MessageInfo{EventTime: time.Unix(1636470000, 0)},
+			Kind:        isb.Data,
+			ID:          isb.MessageID{VertexName: "in", Offset: "0-0", Index: 0},
+			Keys:        []string{"key"},
+		},
+		Body: isb.Body{Payload: bytes.Repeat([]byte("a"), 1024)},
+	}
+}
+
+// BenchmarkMarshal is the baseline of BenchmarkEncryptMarshal, it marshals a message with a 1KB payload.
+func BenchmarkMarshal(b *testing.B) {
+	m := benchmarkMessage()
+	b.SetBytes(int64(len(m.Payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.MarshalBinary(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkEncryptMarshal encrypts the 1KB payload of a message and marshals it, which is what the writer does.
+func BenchmarkEncryptMarshal(b *testing.B) {
+	k, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	if err != nil {
+		b.Fatal(err)
+	}
+	m := benchmarkMessage()
+	b.SetBytes(int64(len(m.Payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		encrypted := m
+		if encrypted.EncryptionKeyID, encrypted.Payload, err = k.Encrypt(m.Payload); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := encrypted.MarshalBinary(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkUnmarshal is the baseline of BenchmarkUnmarshalDecrypt, it unmarshals a message with a 1KB payload.
+func BenchmarkUnmarshal(b *testing.B) {
+	m := benchmarkMessage()
+	data, err := m.MarshalBinary()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(m.Payload)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := new(isb.Message).UnmarshalBinary(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkUnmarshalDecrypt unmarshals a message and decrypts the 1KB payload, which is what the reader does.
+func BenchmarkUnmarshalDecrypt(b *testing.B) {
+	k, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	if err != nil {
+		b.Fatal(err)
+	}
+	m := benchmarkMessage()
+	if m.EncryptionKeyID, m.Payload, err = k.Encrypt(m.Payload); err != nil {
+		b.Fatal(err)
+	}
+	data, err := m.MarshalBinary()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		read := new(isb.Message)
+		if err := read.UnmarshalBinary(data); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := k.Decrypt(read.EncryptionKeyID, read.Payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements the envelope encryption of the message payloads in the inter-step buffers.
+// The payload of a data message is encrypted with AES-GCM by the buffer writer, and the ID of the key is recorded
+// in the message header, so that the buffer reader can pick the right key to decrypt it. Readers accept all the
+// keys in the keyring, which allows rotating the primary key while the messages encrypted with an old key are
+// still in the buffers. The keys can be wrapped with a key of a KMS, in which case they are unwrapped when they
+// are loaded, and only the wrapped keys are stored in the secret.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// keySize is the size of an AES-256 key in bytes.
+const keySize = 32
+
+// KeyUnwrapper decrypts the keys wrapped with a key encryption key, e.g. a key of a KMS.
+type KeyUnwrapper interface {
+	UnwrapKey(ctx context.Context, wrapped string) ([]byte, error)
+}
+
+type keyringOptions struct {
+	unwrapper KeyUnwrapper
+}
+
+// KeyringOption is an option of loading a Keyring.
+type KeyringOption func(*keyringOptions)
+
+// WithKeyUnwrapper sets the KeyUnwrapper of the wrapped keys in the files, the keys are base64 encoded plain keys if
+// it's not set.
+func WithKeyUnwrapper(u KeyUnwrapper) KeyringOption {
+	return func(o *keyringOptions) {
+		o.unwrapper = u
+	}
+}
+
+// Keyring holds the keys used to encrypt and decrypt the message payloads, indexed by their IDs.
+type Keyring struct {
+	lock         sync.RWMutex
+	primaryKeyID string
+	// dir is the directory the keys are loaded from, it is empty if the keys are not loaded from files.
+	dir       string
+	unwrapper KeyUnwrapper
+	aeads     map[string]cipher.AEAD
+}
+
+// NewKeyring returns a Keyring with the given keys, the primary key is used to encrypt the payloads.
+func NewKeyring(keys map[string][]byte, primaryKeyID string) (*Keyring, error) {
+	aeads, err := newAEADs(keys)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := aeads[primaryKeyID]; !ok {
+		return nil, fmt.Errorf("primary key %q not found", primaryKeyID)
+	}
+	return &Keyring{primaryKeyID: primaryKeyID, aeads: aeads}, nil
+}
+
+// LoadKeyring returns a Keyring with the keys in the given directory, each file of the directory is a key. The
+// name of the file is the key ID, and the content is the base64 encoded key, or the wrapped key if a KeyUnwrapper
+// is given.
+func LoadKeyring(dir string, primaryKeyID string, opts ...KeyringOption) (*Keyring, error) {
+	unwrapper := newKeyUnwrapper(opts)
+	keys, err := readKeys(dir, unwrapper)
+	if err != nil {
+		return nil, err
+	}
+	k, err := NewKeyring(keys, primaryKeyID)
+	if err != nil {
+		return nil, err
+	}
+	k.dir = dir
+	k.unwrapper = unwrapper
+	return k, nil
+}
+
+// LoadDecryptionKeyring returns a Keyring with the keys in the given directory which only decrypts the payloads,
+// e.g., to inspect the messages in a buffer, it has no primary key to encrypt with.
+func LoadDecryptionKeyring(dir string, opts ...KeyringOption) (*Keyring, error) {
+	keys, err := readKeys(dir, newKeyUnwrapper(opts))
+	if err != nil {
+		return nil, err
+	}
+	aeads, err := newAEADs(keys)
+	if err != nil {
+		return nil, err
+	}
+	// the keys are not reloaded, the primary key is checked on reloading.
+	return &Keyring{aeads: aeads}, nil
+}
+
+// NewVertexKeyring returns the Keyring of the vertex loaded from the mounted secret, it returns nil if the
+// encryption is not enabled for the vertex.
+func NewVertexKeyring(vertex *dfv1.Vertex) (*Keyring, error) {
+	x := vertex.Spec.Encryption
+	if x == nil {
+		return nil, nil
+	}
+	var opts []KeyringOption
+	if v := x.Vault; v != nil {
+		token, defined := os.LookupEnv(dfv1.EnvISBEncryptionVaultToken)
+		if !defined {
+			return nil, fmt.Errorf("required environment variable '%s' not defined", dfv1.EnvISBEncryptionVaultToken)
+		}
+		opts = append(opts, WithKeyUnwrapper(NewVaultTransitUnwrapper(v.Address, v.GetMountPath(), v.KeyName, token)))
+	}
+	return LoadKeyring(dfv1.PathISBEncryptionKeys, x.PrimaryKeyID, opts...)
+}
+
+// PrimaryKeyID returns the ID of the key used to encrypt the payloads.
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primaryKeyID
+}
+
+// Encrypt encrypts the plaintext with the primary key, and returns the ID of the key with the ciphertext. The
+// ciphertext is prefixed with the random nonce.
+func (k *Keyring) Encrypt(plaintext []byte) (string, []byte, error) {
+	k.lock.RLock()
+	aead, ok := k.aeads[k.primaryKeyID]
+	k.lock.RUnlock()
+	if !ok {
+		return "", nil, fmt.Errorf("no primary key to encrypt with")
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, fmt.Errorf("failed to generate nonce, %w", err)
+	}
+	// the key ID is authenticated, so a payload can not be decrypted with another key ID in the header.
+	return k.primaryKeyID, aead.Seal(nonce, nonce, plaintext, []byte(k.primaryKeyID)), nil
+}
+
+// Decrypt decrypts the ciphertext with the key of the given ID. If the key is unknown, the keys are reloaded once
+// since the mounted secret might have been updated with a new key after the Keyring was loaded.
+func (k *Keyring) Decrypt(keyID string, ciphertext []byte) ([]byte, error) {
+	aead, err := k.getAEAD(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with key %q, %w", keyID, err)
+	}
+	return plaintext, nil
+}
+
+func (k *Keyring) getAEAD(keyID string) (cipher.AEAD, error) {
+	k.lock.RLock()
+	aead, ok := k.aeads[keyID]
+	k.lock.RUnlock()
+	if ok {
+		return aead, nil
+	}
+	if k.dir == "" {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	if err := k.Reload(); err != nil {
+		return nil, err
+	}
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	if aead, ok = k.aeads[keyID]; !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	return aead, nil
+}
+
+// Reload reloads the keys from the directory. The primary key must still be present, since it is not changed
+// without restarting the vertex.
+func (k *Keyring) Reload() error {
+	if k.dir == "" {
+		return nil
+	}
+	keys, err := readKeys(k.dir, k.unwrapper)
+	if err != nil {
+		return err
+	}
+	aeads, err := newAEADs(keys)
+	if err != nil {
+		return err
+	}
+	if _, ok := aeads[k.primaryKeyID]; !ok {
+		return fmt.Errorf("primary key %q not found", k.primaryKeyID)
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.aeads = aeads
+	return nil
+}
+
+func newAEADs(keys map[string][]byte) (map[string]cipher.AEAD, error) {
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for id, key := range keys {
+		if len(key) != keySize {
+			return nil, fmt.Errorf("invalid key %q, expected %d bytes, got %d", id, keySize, len(key))
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q, %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q, %w", id, err)
+		}
+		aeads[id] = aead
+	}
+	return aeads, nil
+}
+
+// newKeyUnwrapper returns the KeyUnwrapper of the options, the unwrapped keys are cached so that reloading the
+// keys only unwraps the new ones. It returns nil if the keys are not wrapped.
+func newKeyUnwrapper(opts []KeyringOption) KeyUnwrapper {
+	o := &keyringOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.unwrapper == nil {
+		return nil
+	}
+	return &cachedKeyUnwrapper{KeyUnwrapper: o.unwrapper, keys: make(map[string][]byte)}
+}
+
+// cachedKeyUnwrapper caches the keys unwrapped by the underlying KeyUnwrapper by the wrapped keys.
+type cachedKeyUnwrapper struct {
+	KeyUnwrapper
+	lock sync.Mutex
+	keys map[string][]byte
+}
+
+func (c *cachedKeyUnwrapper) UnwrapKey(ctx context.Context, wrapped string) ([]byte, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if key, ok := c.keys[wrapped]; ok {
+		return key, nil
+	}
+	key, err := c.KeyUnwrapper.UnwrapKey(ctx, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	c.keys[wrapped] = key
+	return key, nil
+}
+
+// readKeys reads the keys from the files in the directory. The hidden files and the directories are skipped,
+// which are the symlinks kubelet uses to update the mounted secret atomically. The keys are unwrapped with the
+// unwrapper if it's not nil.
+func readKeys(dir string, unwrapper KeyUnwrapper) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the keys directory %q, %w", dir, err)
+	}
+	keys := make(map[string][]byte)
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat key file %q, %w", path, err)
+		}
+		if info.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file %q, %w", path, err)
+		}
+		if unwrapper != nil {
+			key, err := unwrapper.UnwrapKey(context.Background(), strings.TrimSpace(string(data)))
+			if err != nil {
+				return nil, fmt.Errorf("failed to unwrap key %q, %w", e.Name(), err)
+			}
+			keys[e.Name()] = key
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q, it should be base64 encoded, %w", e.Name(), err)
+		}
+		keys[e.Name()] = key
+	}
+	return keys, nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, keySize)
+}
+
+func writeKey(t *testing.T, dir, keyID string, key []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, keyID), []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+}
+
+func TestKeyring_RoundTrip(t *testing.T) {
+	k, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", k.PrimaryKeyID())
+
+	keyID, ciphertext, err := k.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", keyID)
+	assert.NotContains(t, string(ciphertext), "hello")
+
+	// every encryption uses a new nonce.
+	_, another, err := k.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, another)
+
+	plaintext, err := k.Decrypt(keyID, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), plaintext)
+
+	// an empty payload is encrypted as well.
+	keyID, ciphertext, err = k.Encrypt(nil)
+	require.NoError(t, err)
+	plaintext, err = k.Decrypt(keyID, ciphertext)
+	require.NoError(t, err)
+	assert.Empty(t, plaintext)
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	old, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	require.NoError(t, err)
+	keyID, ciphertext, err := old.Encrypt([]byte("written before the rotation"))
+	require.NoError(t, err)
+
+	rotated, err := NewKeyring(map[string][]byte{"key-1": testKey(1), "key-2": testKey(2)}, "key-2")
+	require.NoError(t, err)
+	newKeyID, newCiphertext, err := rotated.Encrypt([]byte("written after the rotation"))
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", newKeyID)
+
+	plaintext, err := rotated.Decrypt(keyID, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("written before the rotation"), plaintext)
+	plaintext, err = rotated.Decrypt(newKeyID, newCiphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("written after the rotation"), plaintext)
+}
+
+func TestKeyring_DecryptErrors(t *testing.T) {
+	k, err := NewKeyring(map[string][]byte{"key-1": testKey(1), "key-2": testKey(2)}, "key-1")
+	require.NoError(t, err)
+	keyID, ciphertext, err := k.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+
+	_, err = k.Decrypt("key-3", ciphertext)
+	assert.ErrorContains(t, err, `unknown key "key-3"`)
+
+	// the key ID is authenticated with the payload.
+	_, err = k.Decrypt("key-2", ciphertext)
+	assert.ErrorContains(t, err, "failed to decrypt")
+
+	tampered := append([]byte{}, ciphertext...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = k.Decrypt(keyID, tampered)
+	assert.ErrorContains(t, err, "failed to decrypt")
+
+	_, err = k.Decrypt(keyID, []byte("short"))
+	assert.ErrorContains(t, err, "ciphertext is too short")
+}
+
+func TestNewKeyring_Invalid(t *testing.T) {
+	_, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-2")
+	assert.ErrorContains(t, err, `primary key "key-2" not found`)
+
+	_, err = NewKeyring(map[string][]byte{"key-1": []byte("too short")}, "key-1")
+	assert.ErrorContains(t, err, "expected 32 bytes")
+}
+
+func TestLoadKeyring(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "key-1", testKey(1))
+	// the files kubelet uses to update the secret are skipped.
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..2024_01_01_00_00_00.000000000"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("not a key"), 0600))
+
+	k, err := LoadKeyring(dir, "key-1")
+	require.NoError(t, err)
+	keyID, ciphertext, err := k.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+
+	// a reader which loaded the keys before the rotation picks up the new key when it sees a message encrypted with it.
+	reader, err := LoadKeyring(dir, "key-1")
+	require.NoError(t, err)
+	writeKey(t, dir, "key-2", testKey(2))
+	rotated, err := LoadKeyring(dir, "key-2")
+	require.NoError(t, err)
+	newKeyID, newCiphertext, err := rotated.Encrypt([]byte("hello again"))
+	require.NoError(t, err)
+
+	plaintext, err := reader.Decrypt(newKeyID, newCiphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello again"), plaintext)
+	plaintext, err = rotated.Decrypt(keyID, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), plaintext)
+
+	// the primary key can not be removed from the secret.
+	require.NoError(t, os.Remove(filepath.Join(dir, "key-1")))
+	assert.ErrorContains(t, reader.Reload(), `primary key "key-1" not found`)
+
+	writeKey(t, dir, "key-3", []byte("too short"))
+	_, err = LoadKeyring(dir, "key-2")
+	assert.ErrorContains(t, err, "expected 32 bytes")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key-3"), []byte("not base64!"), 0600))
+	_, err = LoadKeyring(dir, "key-2")
+	assert.ErrorContains(t, err, "should be base64 encoded")
+
+	_, err = LoadKeyring(filepath.Join(dir, "not-found"), "key-2")
+	assert.ErrorContains(t, err, "failed to read the keys directory")
+}
+
+func TestLoadDecryptionKeyring(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "key-1", testKey(1))
+	writeKey(t, dir, "key-2", testKey(2))
+	k, err := LoadKeyring(dir, "key-2")
+	require.NoError(t, err)
+	keyID, ciphertext, err := k.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+
+	d, err := LoadDecryptionKeyring(dir)
+	require.NoError(t, err)
+	plaintext, err := d.Decrypt(keyID, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), plaintext)
+	_, err = d.Decrypt("key-3", ciphertext)
+	assert.Error(t, err)
+	_, _, err = d.Encrypt([]byte("hello"))
+	assert.ErrorContains(t, err, "no primary key")
+}
+
+type countingUnwrapper struct {
+	KeyUnwrapper
+	calls int
+}
+
+func (c *countingUnwrapper) UnwrapKey(ctx context.Context, wrapped string) ([]byte, error) {
+	c.calls++
+	return c.KeyUnwrapper.UnwrapKey(ctx, wrapped)
+}
+
+func TestLoadKeyring_WrappedKeys(t *testing.T) {
+	vault := newTestVault(t)
+	unwrapper := &countingUnwrapper{KeyUnwrapper: NewVaultTransitUnwrapper(vault.URL, "transit", "isb", "test-token")}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key-1"), []byte(wrapTestKey(testKey(1))+"\n"), 0600))
+
+	k, err := LoadKeyring(dir, "key-1", WithKeyUnwrapper(unwrapper))
+	require.NoError(t, err)
+	keyID, ciphertext, err := k.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+	plain, err := NewKeyring(map[string][]byte{"key-1": testKey(1)}, "key-1")
+	require.NoError(t, err)
+	plaintext, err := plain.Decrypt(keyID, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), plaintext)
+
+	// only the new keys are unwrapped on reloading.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key-2"), []byte(wrapTestKey(testKey(2))), 0600))
+	require.NoError(t, k.Reload())
+	assert.Equal(t, 2, unwrapper.calls)
+
+	d, err := LoadDecryptionKeyring(dir, WithKeyUnwrapper(unwrapper))
+	require.NoError(t, err)
+	plaintext, err = d.Decrypt(keyID, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), plaintext)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key-3"), []byte("not wrapped"), 0600))
+	_, err = LoadKeyring(dir, "key-1", WithKeyUnwrapper(unwrapper))
+	assert.ErrorContains(t, err, `failed to unwrap key "key-3"`)
+}
+
+func TestNewVertexKeyring(t *testing.T) {
+	k, err := NewVertexKeyring(&dfv1.Vertex{})
+	assert.NoError(t, err)
+	assert.Nil(t, k)
+
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{Encryption: &dfv1.ISBEncryption{SecretName: "isb-keys", PrimaryKeyID: "key-1",
+		Vault: &dfv1.VaultTransit{Address: "https://vault:8200", KeyName: "isb"}}}}
+	_, err = NewVertexKeyring(vertex)
+	assert.ErrorContains(t, err, dfv1.EnvISBEncryptionVaultToken)
+}
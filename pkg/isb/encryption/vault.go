@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// vaultTimeout is the timeout of a request to Vault.
+const vaultTimeout = 10 * time.Second
+
+// vaultTransit unwraps the keys with the decrypt endpoint of a HashiCorp Vault transit secrets engine key, see
+// https://developer.hashicorp.com/vault/api-docs/secret/transit#decrypt.
+type vaultTransit struct {
+	decryptURL string
+	token      string
+	client     *http.Client
+}
+
+// NewVaultTransitUnwrapper returns a KeyUnwrapper which asks Vault to decrypt the wrapped keys, e.g. "vault:v1:...",
+// with the key of the transit secrets engine mounted at the mount path.
+func NewVaultTransitUnwrapper(address, mountPath, keyName, token string) KeyUnwrapper {
+	return &vaultTransit{
+		decryptURL: fmt.Sprintf("%s/v1/%s/decrypt/%s", strings.TrimSuffix(address, "/"), strings.Trim(mountPath, "/"), url.PathEscape(keyName)),
+		token:      token,
+		client:     &http.Client{Timeout: vaultTimeout},
+	}
+}
+
+func (v *vaultTransit) UnwrapKey(ctx context.Context, wrapped string) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{"ciphertext": wrapped})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.decryptURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the vault request, %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", v.token)
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request vault, %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	var result struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+		Errors []string `json:"errors"`
+	}
+	decodeErr := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault responded %s, %s", resp.Status, strings.Join(result.Errors, "; "))
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("failed to decode the vault response, %w", decodeErr)
+	}
+	key, err := base64.StdEncoding.DecodeString(result.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plaintext in the vault response, %w", err)
+	}
+	return key, nil
+}
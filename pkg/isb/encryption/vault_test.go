@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestVault returns a fake Vault server, the wrapped keys it decrypts are "vault:v1:" followed by the base64
+// encoded key.
+func newTestVault(t *testing.T) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/transit/decrypt/isb" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":["no handler for route"]}`))
+			return
+		}
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		var req struct {
+			Ciphertext string `json:"ciphertext"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || !strings.HasPrefix(req.Ciphertext, "vault:v1:") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid ciphertext"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"plaintext":"` + strings.TrimPrefix(req.Ciphertext, "vault:v1:") + `"}}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func wrapTestKey(key []byte) string {
+	return "vault:v1:" + base64.StdEncoding.EncodeToString(key)
+}
+
+func TestVaultTransitUnwrapper(t *testing.T) {
+	ctx := context.Background()
+	vault := newTestVault(t)
+
+	u := NewVaultTransitUnwrapper(vault.URL+"/", "/transit/", "isb", "test-token")
+	key, err := u.UnwrapKey(ctx, wrapTestKey(testKey(1)))
+	require.NoError(t, err)
+	assert.Equal(t, testKey(1), key)
+
+	_, err = u.UnwrapKey(ctx, "not wrapped")
+	assert.ErrorContains(t, err, "400 Bad Request, invalid ciphertext")
+
+	_, err = u.UnwrapKey(ctx, "vault:v1:not base64!")
+	assert.ErrorContains(t, err, "invalid plaintext")
+
+	_, err = NewVaultTransitUnwrapper(vault.URL, "transit", "isb", "wrong-token").UnwrapKey(ctx, wrapTestKey(testKey(1)))
+	assert.ErrorContains(t, err, "permission denied")
+
+	_, err = NewVaultTransitUnwrapper(vault.URL, "transit", "other", "test-token").UnwrapKey(ctx, wrapTestKey(testKey(1)))
+	assert.ErrorContains(t, err, "404 Not Found")
+}
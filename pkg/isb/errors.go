@@ -21,6 +21,7 @@ import "fmt"
 var (
 	BufferFullMessage  = "Buffer full!"
 	DuplicateIDMessage = "Duplicate ID!"
+	NoLeaderMessage    = "No leader!"
 )
 
 // MessageWriteErr is associated with message write errors.
@@ -40,7 +41,10 @@ type BufferWriteErr struct {
 	Name        string
 	Full        bool
 	InternalErr bool
-	Message     string
+	// NoLeader is true if the cluster backing the buffer has no leader to accept the writes, e.g. a leader election
+	// is in progress, it's retryable.
+	NoLeader bool
+	Message  string
 }
 
 func (e BufferWriteErr) Error() string {
@@ -57,6 +61,11 @@ func (e BufferWriteErr) IsInternalErr() bool {
 	return e.InternalErr
 }
 
+// IsNoLeader returns true if writing is failing because the cluster backing the buffer has no leader.
+func (e BufferWriteErr) IsNoLeader() bool {
+	return e.NoLeader
+}
+
 // MessageAckErr is for acknowledgement errors.
 type MessageAckErr struct {
 	Name    string
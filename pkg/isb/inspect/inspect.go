@@ -0,0 +1,315 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inspect reads the raw entries of the Inter-Step Buffers, either from an export or directly from a
+// JetStream stream without consuming them, and prints the decoded messages. It's used to investigate the contents
+// of the buffers when the pipeline is down.
+package inspect
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// Format is the format of an export of the buffer entries.
+type Format string
+
+const (
+	// FormatRaw is a single encoded message, e.g., the data of a JetStream message saved to a file.
+	FormatRaw Format = "raw"
+	// FormatBase64 is one base64 encoded message per line.
+	FormatBase64 Format = "base64"
+	// FormatNATSJSON is one JSON object per line as printed by `nats stream get --json`, with the base64 encoded
+	// message in the "data" field.
+	FormatNATSJSON Format = "nats-json"
+	// FormatRedis is one Redis stream entry per line, with the base64 encoded header (the field of the entry) and the
+	// base64 encoded payload (the value of the entry) separated by a space.
+	FormatRedis Format = "redis"
+)
+
+// Formats are the supported formats of the exports.
+var Formats = []Format{FormatRaw, FormatBase64, FormatNATSJSON, FormatRedis}
+
+// Entry is an entry of a buffer.
+type Entry struct {
+	// Sequence is the sequence of the entry in the stream, or the line number in the export if it's not known.
+	Sequence uint64
+	// Subject is the subject of the JetStream message, empty if it's not known.
+	Subject string
+	// Time is the time the entry was stored, zero if it's not known.
+	Time time.Time
+	// Message is the decoded message, nil if it failed to be decoded.
+	Message *isb.Message
+	// Err is the error decoding the entry.
+	Err error
+}
+
+// natsStoredMsg is the message printed by `nats stream get --json`.
+type natsStoredMsg struct {
+	Subject  string    `json:"subject"`
+	Sequence uint64    `json:"seq"`
+	Data     []byte    `json:"data"`
+	Time     time.Time `json:"time"`
+}
+
+// ReadExport reads the entries of an export in the given format, and calls fn with each of them. The entries which
+// fail to be decoded are passed to fn with the error, the reading stops if fn returns an error.
+func ReadExport(r io.Reader, format Format, fn func(Entry) error) error {
+	if format == FormatRaw {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("failed to read the export, %w", err)
+		}
+		return fn(decodeEntry(Entry{Sequence: 1}, data))
+	}
+	scanner := bufio.NewScanner(r)
+	// the encoded messages can be large.
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	var line uint64
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		entry := Entry{Sequence: line}
+		switch format {
+		case FormatBase64:
+			data, err := base64.StdEncoding.DecodeString(text)
+			if err != nil {
+				entry.Err = fmt.Errorf("invalid base64, %w", err)
+			} else {
+				entry = decodeEntry(entry, data)
+			}
+		case FormatNATSJSON:
+			var msg natsStoredMsg
+			if err := json.Unmarshal([]byte(text), &msg); err != nil {
+				entry.Err = fmt.Errorf("invalid JSON, %w", err)
+			} else {
+				entry = decodeEntry(Entry{Sequence: msg.Sequence, Subject: msg.Subject, Time: msg.Time}, msg.Data)
+			}
+		case FormatRedis:
+			entry = decodeRedisEntry(entry, text)
+		default:
+			return fmt.Errorf("unsupported format %q", format)
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read the export, %w", err)
+	}
+	return nil
+}
+
+// ReadStream reads at most count messages of the JetStream stream starting from the sequence start, or from the
+// first message if start is 0, and calls fn with each of them. The messages are read with the direct get API, so
+// they are neither consumed nor acknowledged, and read-only credentials are enough.
+func ReadStream(ctx context.Context, js nats.JetStreamManager, stream string, start uint64, count int, fn func(Entry) error) error {
+	info, err := js.StreamInfo(stream, nats.Context(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get the information of stream %q, %w", stream, err)
+	}
+	if start < info.State.FirstSeq {
+		start = info.State.FirstSeq
+	}
+	read := 0
+	for seq := start; seq <= info.State.LastSeq && read < count; seq++ {
+		msg, err := js.GetMsg(stream, seq, nats.Context(ctx))
+		if err != nil {
+			if errors.Is(err, nats.ErrMsgNotFound) {
+				// the message has been acknowledged or deleted.
+				continue
+			}
+			return fmt.Errorf("failed to get message %d of stream %q, %w", seq, stream, err)
+		}
+		read++
+		if err := fn(decodeEntry(Entry{Sequence: msg.Sequence, Subject: msg.Subject, Time: msg.Time}, msg.Data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeEntry(entry Entry, data []byte) Entry {
+	entry.Message, entry.Err = isb.DecodeMessage(data)
+	return entry
+}
+
+func decodeRedisEntry(entry Entry, text string) Entry {
+	fields := strings.Fields(text)
+	if len(fields) != 2 {
+		entry.Err = errors.New("invalid Redis entry, expecting the header and the payload separated by a space")
+		return entry
+	}
+	headerData, err := base64.StdEncoding.DecodeString(fields[0])
+	if err != nil {
+		entry.Err = fmt.Errorf("invalid base64 header, %w", err)
+		return entry
+	}
+	payload, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		entry.Err = fmt.Errorf("invalid base64 payload, %w", err)
+		return entry
+	}
+	header, err := isb.DecodeHeader(headerData)
+	if err != nil {
+		entry.Err = err
+		return entry
+	}
+	entry.Message = &isb.Message{Header: *header, Body: isb.Body{Payload: payload}}
+	return entry
+}
+
+// Decrypter decrypts the payload encrypted with the key of the ID.
+type Decrypter interface {
+	Decrypt(keyID string, ciphertext []byte) ([]byte, error)
+}
+
+// Printer prints the metadata and a preview of the payload of the entries.
+type Printer struct {
+	w io.Writer
+	// previewBytes is the max number of the bytes of the payload to print.
+	previewBytes int
+	decrypter    Decrypter
+}
+
+// PrinterOption is the option of the Printer.
+type PrinterOption func(*Printer)
+
+// WithPreviewBytes sets the max number of the bytes of the payload to print, 64 by default.
+func WithPreviewBytes(n int) PrinterOption {
+	return func(p *Printer) {
+		p.previewBytes = n
+	}
+}
+
+// WithDecrypter sets the decrypter of the encrypted payloads, which are not previewed without it.
+func WithDecrypter(d Decrypter) PrinterOption {
+	return func(p *Printer) {
+		p.decrypter = d
+	}
+}
+
+// NewPrinter returns a Printer writing to w.
+func NewPrinter(w io.Writer, opts ...PrinterOption) *Printer {
+	p := &Printer{w: w, previewBytes: 64}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Print prints an entry.
+func (p *Printer) Print(entry Entry) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "#%d", entry.Sequence)
+	if entry.Subject != "" {
+		fmt.Fprintf(&b, " subject=%s", entry.Subject)
+	}
+	if !entry.Time.IsZero() {
+		fmt.Fprintf(&b, " stored=%s", entry.Time.UTC().Format(time.RFC3339Nano))
+	}
+	b.WriteString("\n")
+	if entry.Err != nil {
+		fmt.Fprintf(&b, "  error: %v\n", entry.Err)
+		_, err := io.WriteString(p.w, b.String())
+		return err
+	}
+	m := entry.Message
+	fmt.Fprintf(&b, "  kind: %s, codec: %s\n", m.Kind, m.Header.CodecVersion())
+	fmt.Fprintf(&b, "  id: %s\n", m.ID.String())
+	if m.Kind == isb.Data {
+		fmt.Fprintf(&b, "  event time: %s, late: %t\n", m.EventTime.UTC().Format(time.RFC3339Nano), m.IsLate)
+		if !m.IngestionTime.IsZero() {
+			fmt.Fprintf(&b, "  ingestion time: %s\n", m.IngestionTime.UTC().Format(time.RFC3339Nano))
+		}
+	}
+	if len(m.Keys) > 0 {
+		fmt.Fprintf(&b, "  keys: %q\n", m.Keys)
+	}
+	if len(m.Headers) > 0 {
+		names := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		pairs := make([]string, 0, len(names))
+		for _, k := range names {
+			pairs = append(pairs, fmt.Sprintf("%s=%q", k, m.Headers[k]))
+		}
+		fmt.Fprintf(&b, "  headers: %s\n", strings.Join(pairs, ", "))
+	}
+	payload := m.Payload
+	if m.EncryptionKeyID != "" {
+		fmt.Fprintf(&b, "  encryption key: %s\n", m.EncryptionKeyID)
+		if p.decrypter == nil {
+			payload = nil
+		} else if plaintext, err := p.decrypter.Decrypt(m.EncryptionKeyID, m.Payload); err != nil {
+			fmt.Fprintf(&b, "  error: %v\n", err)
+			payload = nil
+		} else {
+			payload = plaintext
+		}
+	}
+	fmt.Fprintf(&b, "  payload (%d bytes)", len(m.Payload))
+	if payload != nil {
+		fmt.Fprintf(&b, ": %s", p.preview(payload))
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(p.w, b.String())
+	return err
+}
+
+// preview returns the quoted text if the payload is valid UTF-8, or its base64 encoding otherwise, truncated to the
+// preview length.
+func (p *Printer) preview(payload []byte) string {
+	truncated := len(payload) > p.previewBytes
+	if truncated {
+		payload = payload[:p.previewBytes]
+	}
+	text := payload
+	if truncated {
+		// a multi-byte character cut at the end does not make the payload binary.
+		for i := 1; i < utf8.UTFMax && len(text) > 0 && !utf8.Valid(text); i++ {
+			text = text[:len(text)-1]
+		}
+	}
+	var s string
+	if utf8.Valid(text) {
+		s = fmt.Sprintf("%q", text)
+	} else {
+		s = "base64:" + base64.StdEncoding.EncodeToString(payload)
+	}
+	if truncated {
+		s += "..."
+	}
+	return s
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+)
+
+func testMessage(offset string, payload string) isb.Message {
+	return isb.Message{
+		Header: isb.Header{
+			MessageInfo: isb.MessageInfo{EventTime: time.UnixMilli(1676617200000).UTC()},
+			Kind:        isb.Data,
+			ID:          isb.MessageID{VertexName: "in", Offset: offset},
+			Keys:        []string{"k1"},
+			Headers:     map[string]string{"h2": "v2", "h1": "v1"},
+		},
+		Body: isb.Body{Payload: []byte(payload)},
+	}
+}
+
+func encode(t *testing.T, m isb.Message) []byte {
+	t.Helper()
+	data, err := m.MarshalBinary()
+	require.NoError(t, err)
+	return data
+}
+
+func collect(entries *[]Entry) func(Entry) error {
+	return func(e Entry) error {
+		*entries = append(*entries, e)
+		return nil
+	}
+}
+
+func TestReadExport(t *testing.T) {
+	m1, m2 := testMessage("1", "hello"), testMessage("2", "world")
+	b64 := base64.StdEncoding.EncodeToString
+
+	t.Run("raw", func(t *testing.T) {
+		var entries []Entry
+		require.NoError(t, ReadExport(bytes.NewReader(encode(t, m1)), FormatRaw, collect(&entries)))
+		require.Len(t, entries, 1)
+		assert.NoError(t, entries[0].Err)
+		assert.Equal(t, m1, *entries[0].Message)
+	})
+
+	t.Run("base64", func(t *testing.T) {
+		var entries []Entry
+		input := b64(encode(t, m1)) + "\n\n" + "not base64!\n" + b64(encode(t, m2)) + "\n"
+		require.NoError(t, ReadExport(strings.NewReader(input), FormatBase64, collect(&entries)))
+		require.Len(t, entries, 3)
+		assert.Equal(t, m1, *entries[0].Message)
+		assert.ErrorContains(t, entries[1].Err, "invalid base64")
+		assert.Equal(t, uint64(3), entries[1].Sequence)
+		assert.Equal(t, m2, *entries[2].Message)
+	})
+
+	t.Run("nats-json", func(t *testing.T) {
+		var entries []Entry
+		stored := time.Unix(1676617300, 0).UTC()
+		line, err := json.Marshal(natsStoredMsg{Subject: "ns-pl-out-0", Sequence: 7, Data: encode(t, m1), Time: stored})
+		require.NoError(t, err)
+		input := string(line) + "\n{\n"
+		require.NoError(t, ReadExport(strings.NewReader(input), FormatNATSJSON, collect(&entries)))
+		require.Len(t, entries, 2)
+		assert.Equal(t, uint64(7), entries[0].Sequence)
+		assert.Equal(t, "ns-pl-out-0", entries[0].Subject)
+		assert.Equal(t, stored, entries[0].Time)
+		assert.Equal(t, m1, *entries[0].Message)
+		assert.ErrorContains(t, entries[1].Err, "invalid JSON")
+	})
+
+	t.Run("redis", func(t *testing.T) {
+		var entries []Entry
+		header, err := m1.Header.MarshalBinary()
+		require.NoError(t, err)
+		input := b64(header) + " " + b64(m1.Payload) + "\n" + b64(header) + "\n"
+		require.NoError(t, ReadExport(strings.NewReader(input), FormatRedis, collect(&entries)))
+		require.Len(t, entries, 2)
+		assert.Equal(t, m1, *entries[0].Message)
+		assert.ErrorContains(t, entries[1].Err, "invalid Redis entry")
+	})
+
+	t.Run("stop on error", func(t *testing.T) {
+		input := b64(encode(t, m1)) + "\n" + b64(encode(t, m2)) + "\n"
+		calls := 0
+		err := ReadExport(strings.NewReader(input), FormatBase64, func(Entry) error {
+			calls++
+			return fmt.Errorf("stop")
+		})
+		assert.EqualError(t, err, "stop")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		err := ReadExport(strings.NewReader("x"), Format("csv"), collect(new([]Entry)))
+		assert.ErrorContains(t, err, "unsupported format")
+	})
+}
+
+func TestReadStream(t *testing.T) {
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+	nc, err := nats.Connect(s.ClientURL())
+	require.NoError(t, err)
+	defer nc.Close()
+	js, err := nc.JetStream()
+	require.NoError(t, err)
+	_, err = js.AddStream(&nats.StreamConfig{Name: "buffer", Subjects: []string{"buffer"}})
+	require.NoError(t, err)
+	for i := 1; i <= 4; i++ {
+		_, err := js.Publish("buffer", encode(t, testMessage(fmt.Sprint(i), "payload")))
+		require.NoError(t, err)
+	}
+	_, err = js.Publish("buffer", []byte("corrupted"))
+	require.NoError(t, err)
+	// an acknowledged message is skipped.
+	require.NoError(t, js.DeleteMsg("buffer", 2))
+
+	ctx := context.Background()
+	var entries []Entry
+	require.NoError(t, ReadStream(ctx, js, "buffer", 0, 10, collect(&entries)))
+	require.Len(t, entries, 4)
+	assert.Equal(t, []uint64{1, 3, 4, 5}, []uint64{entries[0].Sequence, entries[1].Sequence, entries[2].Sequence, entries[3].Sequence})
+	assert.Equal(t, "3", entries[1].Message.ID.Offset)
+	assert.Equal(t, "buffer", entries[1].Subject)
+	assert.False(t, entries[1].Time.IsZero())
+	assert.Error(t, entries[3].Err)
+
+	entries = nil
+	require.NoError(t, ReadStream(ctx, js, "buffer", 2, 2, collect(&entries)))
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(3), entries[0].Sequence)
+	assert.Equal(t, uint64(4), entries[1].Sequence)
+
+	err = ReadStream(ctx, js, "not-found", 0, 10, collect(&entries))
+	assert.ErrorContains(t, err, `failed to get the information of stream "not-found"`)
+}
+
+type testDecrypter struct{}
+
+func (testDecrypter) Decrypt(keyID string, ciphertext []byte) ([]byte, error) {
+	if keyID != "key-1" {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	return bytes.ToUpper(ciphertext), nil
+}
+
+func TestPrinter(t *testing.T) {
+	m := testMessage("1", "hello")
+	m.IngestionTime = time.UnixMilli(1676617260000).UTC()
+	var out bytes.Buffer
+	p := NewPrinter(&out)
+	require.NoError(t, p.Print(Entry{Sequence: 3, Subject: "buffer", Time: time.Unix(1676617300, 0), Message: &m}))
+	assert.Equal(t, `#3 subject=buffer stored=2023-02-17T07:01:40Z
+  kind: Data, codec: v2
+  id: in-1-0
+  event time: 2023-02-17T07:00:00Z, late: false
+  ingestion time: 2023-02-17T07:01:00Z
+  keys: ["k1"]
+  headers: h1="v1", h2="v2"
+  payload (5 bytes): "hello"
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, p.Print(Entry{Sequence: 4, Err: fmt.Errorf("failed to decode the message")}))
+	assert.Equal(t, "#4\n  error: failed to decode the message\n", out.String())
+
+	out.Reset()
+	wmb := isb.Message{Header: isb.Header{Kind: isb.WMB, ID: isb.MessageID{VertexName: "in", Offset: "2"}}}
+	require.NoError(t, p.Print(Entry{Sequence: 5, Message: &wmb}))
+	assert.Equal(t, "#5\n  kind: WMB, codec: v1\n  id: in-2-0\n  payload (0 bytes)\n", out.String())
+}
+
+func TestPrinter_Payload(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []PrinterOption
+		payload  []byte
+		keyID    string
+		expected string
+	}{
+		{name: "text", payload: []byte("hello"), expected: `payload (5 bytes): "hello"`},
+		{name: "truncated", opts: []PrinterOption{WithPreviewBytes(3)}, payload: []byte("hello"), expected: `payload (5 bytes): "hel"...`},
+		{name: "truncated multi-byte", opts: []PrinterOption{WithPreviewBytes(2)}, payload: []byte("héllo"), expected: `payload (6 bytes): "h"...`},
+		{name: "binary", payload: []byte{0xff, 0x00, 0x01}, expected: `payload (3 bytes): base64:/wAB`},
+		{name: "encrypted", payload: []byte("secret"), keyID: "key-1", expected: "encryption key: key-1\n  payload (6 bytes)"},
+		{name: "decrypted", opts: []PrinterOption{WithDecrypter(testDecrypter{})}, payload: []byte("secret"), keyID: "key-1", expected: "encryption key: key-1\n  payload (6 bytes): \"SECRET\""},
+		{name: "unknown key", opts: []PrinterOption{WithDecrypter(testDecrypter{})}, payload: []byte("secret"), keyID: "key-2", expected: "encryption key: key-2\n  error: unknown key \"key-2\"\n  payload (6 bytes)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testMessage("1", "")
+			m.Payload = tt.payload
+			m.EncryptionKeyID = tt.keyID
+			var out bytes.Buffer
+			require.NoError(t, NewPrinter(&out, tt.opts...).Print(Entry{Sequence: 1, Message: &m}))
+			assert.True(t, strings.HasSuffix(out.String(), "  "+tt.expected+"\n"), out.String())
+		})
+	}
+}
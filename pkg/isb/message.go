@@ -51,6 +51,11 @@ type MessageInfo struct {
 	// MessageKind == Data, IsLate is used to indicate if the message is a late data (assignment happens at source)
 	// MessageKind == WMB, value is ignored
 	IsLate bool
+	// IngestionTime when
+	// MessageKind == Data represents the time when the source read or created the message, it is set once at the source
+	// and carried unchanged through the pipeline. The zero value means the ingestion time is unknown.
+	// MessageKind == WMB, value is ignored
+	IngestionTime time.Time
 }
 
 // MessageMetadata is the metadata of the message
@@ -73,6 +78,9 @@ type Header struct {
 	// http headers and Numaflow platform headers like tracing headers etc.
 	//TODO: can we rename this? Gets confusing for understanding headers under header
 	Headers map[string]string
+	// EncryptionKeyID is the ID of the key the payload is encrypted with in the buffer, it is empty if the payload
+	// is not encrypted.
+	EncryptionKeyID string
 }
 
 // MessageID is the message ID of the message which is used for exactly-once-semantics.
@@ -1,6 +1,8 @@
 package isb
 
 import (
+	"time"
+
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -12,8 +14,9 @@ func (m Message) MarshalBinary() ([]byte, error) {
 	pb := &isb.Message{
 		Header: &isb.Header{
 			MessageInfo: &isb.MessageInfo{
-				EventTime: timestamppb.New(m.Header.MessageInfo.EventTime),
-				IsLate:    m.Header.MessageInfo.IsLate,
+				EventTime:     timestamppb.New(m.Header.MessageInfo.EventTime),
+				IsLate:        m.Header.MessageInfo.IsLate,
+				IngestionTime: toOptionalTimestamp(m.Header.MessageInfo.IngestionTime),
 			},
 			Kind: isb.MessageKind(m.Header.Kind),
 			Id: &isb.MessageID{
@@ -21,8 +24,9 @@ func (m Message) MarshalBinary() ([]byte, error) {
 				Offset:     m.Header.ID.Offset,
 				Index:      m.Header.ID.Index,
 			},
-			Keys:    m.Header.Keys,
-			Headers: m.Header.Headers,
+			Keys:            m.Header.Keys,
+			Headers:         m.Header.Headers,
+			EncryptionKeyId: m.Header.EncryptionKeyID,
 		},
 		Body: &isb.Body{
 			Payload: m.Body.Payload,
@@ -44,21 +48,7 @@ func (m *Message) UnmarshalBinary(data []byte) error {
 	// are completely controlled by numaflow. It is best not to do nil checks
 	// and get panics so we can fix the root cause.
 
-	m.Header = Header{
-		MessageInfo: MessageInfo{
-			EventTime: pb.Header.MessageInfo.EventTime.AsTime(),
-			IsLate:    pb.Header.MessageInfo.IsLate,
-		},
-		Kind: MessageKind(pb.Header.Kind),
-		ID: MessageID{
-			VertexName: pb.Header.Id.VertexName,
-			Offset:     pb.Header.Id.Offset,
-			Index:      pb.Header.Id.Index,
-		},
-		Keys:    pb.Header.Keys,
-		Headers: pb.Header.Headers,
-	}
-
+	m.Header.fromProto(pb.Header)
 	m.Body.Payload = pb.Body.Payload
 
 	return nil
@@ -68,13 +58,15 @@ func (m *Message) UnmarshalBinary(data []byte) error {
 func (h Header) MarshalBinary() ([]byte, error) {
 	pb := &isb.Header{
 		MessageInfo: &isb.MessageInfo{
-			EventTime: timestamppb.New(h.MessageInfo.EventTime),
-			IsLate:    h.MessageInfo.IsLate,
+			EventTime:     timestamppb.New(h.MessageInfo.EventTime),
+			IsLate:        h.MessageInfo.IsLate,
+			IngestionTime: toOptionalTimestamp(h.MessageInfo.IngestionTime),
 		},
-		Kind:    isb.MessageKind(h.Kind),
-		Id:      &isb.MessageID{VertexName: h.ID.VertexName, Offset: h.ID.Offset, Index: h.ID.Index},
-		Keys:    h.Keys,
-		Headers: h.Headers,
+		Kind:            isb.MessageKind(h.Kind),
+		Id:              &isb.MessageID{VertexName: h.ID.VertexName, Offset: h.ID.Offset, Index: h.ID.Index},
+		Keys:            h.Keys,
+		Headers:         h.Headers,
+		EncryptionKeyId: h.EncryptionKeyID,
 	}
 	return proto.Marshal(pb)
 }
@@ -90,9 +82,17 @@ func (h *Header) UnmarshalBinary(data []byte) error {
 	// are completely controlled by numaflow. It is best not to do nil checks
 	// and get panics so we can fix the root cause.
 
+	h.fromProto(pb)
+
+	return nil
+}
+
+// fromProto converts the proto header to the header.
+func (h *Header) fromProto(pb *isb.Header) {
 	h.MessageInfo = MessageInfo{
-		EventTime: pb.MessageInfo.EventTime.AsTime(),
-		IsLate:    pb.MessageInfo.IsLate,
+		EventTime:     pb.MessageInfo.EventTime.AsTime(),
+		IsLate:        pb.MessageInfo.IsLate,
+		IngestionTime: fromOptionalTimestamp(pb.MessageInfo.IngestionTime),
 	}
 
 	h.Kind = MessageKind(pb.Kind)
@@ -105,8 +105,7 @@ func (h *Header) UnmarshalBinary(data []byte) error {
 
 	h.Keys = pb.Keys
 	h.Headers = pb.Headers
-
-	return nil
+	h.EncryptionKeyID = pb.EncryptionKeyId
 }
 
 // MarshalBinary encodes MessageID to proto bytes.
@@ -132,3 +131,21 @@ func (id *MessageID) UnmarshalBinary(data []byte) error {
 
 	return nil
 }
+
+// toOptionalTimestamp converts an optional time to the proto timestamp, the zero time is left unset so that the
+// payload stays the same as the one written by the older versions.
+func toOptionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// fromOptionalTimestamp converts an optional proto timestamp to time, an unset timestamp, e.g., in a message written
+// by an older version, is converted to the zero time.
+func fromOptionalTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -20,6 +20,12 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/numaproj/numaflow/pkg/apis/proto/isb"
 )
 
 func TestHeader(t *testing.T) {
@@ -107,6 +113,37 @@ func TestHeader(t *testing.T) {
 			wantMarshalError:   false,
 			wantUnmarshalError: false,
 		},
+		{
+			name: "good_with_ingestion_time",
+			fields: fields{
+				MessageInfo: MessageInfo{
+					EventTime:     time.UnixMilli(1676617200000),
+					IngestionTime: time.UnixMilli(1676617260000),
+				},
+				Kind: Data,
+				ID: MessageID{
+					VertexName: "test-vertex",
+					Offset:     "test-offset",
+					Index:      0,
+				},
+				Key: []string{"TestKey"},
+			},
+			wantData: Header{
+				MessageInfo: MessageInfo{
+					EventTime:     time.UnixMilli(1676617200000).UTC(),
+					IngestionTime: time.UnixMilli(1676617260000).UTC(),
+				},
+				Kind: Data,
+				ID: MessageID{
+					VertexName: "test-vertex",
+					Offset:     "test-offset",
+					Index:      0,
+				},
+				Keys: []string{"TestKey"},
+			},
+			wantMarshalError:   false,
+			wantUnmarshalError: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -286,3 +323,80 @@ func TestEmptyMessage(t *testing.T) {
 		t.Errorf("MarshalBinary() gotData = %v, want %v", newM, m)
 	}
 }
+
+func TestMessage_IngestionTimeSurvivesHops(t *testing.T) {
+	eventTime := time.UnixMilli(1676617200000).UTC()
+	ingestionTime := time.UnixMilli(1676617260000).UTC()
+	source := Message{
+		Header: Header{
+			MessageInfo: MessageInfo{EventTime: eventTime, IngestionTime: ingestionTime},
+			Kind:        Data,
+			ID:          MessageID{VertexName: "in", Offset: "0", Index: 0},
+		},
+		Body: Body{Payload: []byte("payload")},
+	}
+
+	// every hop reads the message of the previous vertex and writes a new message carrying the message info.
+	current := source
+	for _, vertex := range []string{"map", "sink"} {
+		data, err := current.MarshalBinary()
+		assert.NoError(t, err)
+		read := new(Message)
+		assert.NoError(t, read.UnmarshalBinary(data))
+		current = Message{
+			Header: Header{
+				MessageInfo: read.MessageInfo,
+				Kind:        Data,
+				ID:          MessageID{VertexName: vertex, Offset: "0", Index: 0},
+			},
+			Body: read.Body,
+		}
+	}
+	assert.Equal(t, eventTime, current.EventTime)
+	assert.Equal(t, ingestionTime, current.IngestionTime)
+}
+
+func TestMessage_WithoutIngestionTime(t *testing.T) {
+	// a message written by an older version does not have the ingestion time.
+	data, err := proto.Marshal(&isb.Message{
+		Header: &isb.Header{
+			MessageInfo: &isb.MessageInfo{EventTime: timestamppb.New(time.UnixMilli(1676617200000))},
+			Kind:        isb.MessageKind_DATA,
+			Id:          &isb.MessageID{VertexName: "in", Offset: "0"},
+		},
+		Body: &isb.Body{Payload: []byte("payload")},
+	})
+	assert.NoError(t, err)
+	m := new(Message)
+	assert.NoError(t, m.UnmarshalBinary(data))
+	assert.Equal(t, time.UnixMilli(1676617200000).UTC(), m.EventTime)
+	assert.True(t, m.IngestionTime.IsZero())
+
+	// and a message without the ingestion time is encoded the same way as before.
+	newData, err := m.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, data, newData)
+}
+
+func TestMessage_EncryptionKeyID(t *testing.T) {
+	m := Message{
+		Header: Header{
+			MessageInfo:     MessageInfo{EventTime: time.UnixMilli(1676617200000).UTC()},
+			Kind:            Data,
+			ID:              MessageID{VertexName: "in", Offset: "0", Index: 0},
+			EncryptionKeyID: "key-2",
+		},
+		Body: Body{Payload: []byte("ciphertext")},
+	}
+	data, err := m.MarshalBinary()
+	assert.NoError(t, err)
+	read := new(Message)
+	assert.NoError(t, read.UnmarshalBinary(data))
+	assert.Equal(t, "key-2", read.EncryptionKeyID)
+
+	hData, err := m.Header.MarshalBinary()
+	assert.NoError(t, err)
+	h := new(Header)
+	assert.NoError(t, h.UnmarshalBinary(hData))
+	assert.Equal(t, "key-2", h.EncryptionKeyID)
+}
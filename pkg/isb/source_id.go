@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxSourceMessageIDLength is the max length of the IDs built by SourceMessageIDBuilder. An ID which would be
+	// longer is compacted by hashing the sequence, and by hashing the whole ID if it's still too long.
+	MaxSourceMessageIDLength = 128
+	// hashedSequencePrefix prefixes a hashed sequence in a compact ID.
+	hashedSequencePrefix = "~"
+	// hashedIDPrefix prefixes a fully hashed ID, which can not be parsed.
+	hashedIDPrefix = "~"
+)
+
+// SourceMessageIDBuilder builds the stable IDs of the messages read by a source vertex, in the form of
+// "<pipeline>-<vertex>-<partition>-<sequence>", where the partition is the partition of the source the message is read
+// from, e.g. the replica of a generator or the partition of a Kafka topic, and the sequence is the position of the
+// message in the partition. Reading the same message again produces the same ID, which makes the dedup of the ISB
+// writes and the correlation of the traces work across restarts. The IDs are unique across the replicas and the
+// partitions of the vertex as long as the sequences are unique in a partition.
+type SourceMessageIDBuilder struct {
+	pipeline string
+	vertex   string
+	// prefix is "<pipeline>-<vertex>-"
+	prefix string
+}
+
+// NewSourceMessageIDBuilder returns a builder of the message IDs of the source vertex of the pipeline.
+func NewSourceMessageIDBuilder(pipeline, vertex string) SourceMessageIDBuilder {
+	return SourceMessageIDBuilder{pipeline: pipeline, vertex: vertex, prefix: pipeline + "-" + vertex + "-"}
+}
+
+// ID returns the ID of the message with the sequence in the partition. It's at most MaxSourceMessageIDLength long.
+func (b SourceMessageIDBuilder) ID(partition int32, sequence string) string {
+	id := b.prefix + strconv.Itoa(int(partition)) + "-" + sequence
+	// a sequence looking like a hashed one is hashed as well, to keep the parsing unambiguous
+	if len(id) <= MaxSourceMessageIDLength && !strings.HasPrefix(sequence, hashedSequencePrefix) {
+		return id
+	}
+	id = b.prefix + strconv.Itoa(int(partition)) + "-" + hashedSequencePrefix + hash(sequence)
+	if len(id) <= MaxSourceMessageIDLength {
+		return id
+	}
+	return hashedIDPrefix + hash(id)
+}
+
+// MessageID returns the MessageID of the message with the sequence in the partition, the offset of which is the ID
+// built by the builder.
+func (b SourceMessageIDBuilder) MessageID(partition int32, sequence string) MessageID {
+	return MessageID{VertexName: b.vertex, Offset: b.ID(partition, sequence), Index: 0}
+}
+
+// ParsedSourceMessageID is the components of an ID built by SourceMessageIDBuilder.
+type ParsedSourceMessageID struct {
+	Pipeline  string
+	Vertex    string
+	Partition int32
+	// Sequence is the sequence of the message, it's the hash of the sequence if HashedSequence is true.
+	Sequence string
+	// HashedSequence is true if the sequence was hashed to compact the ID.
+	HashedSequence bool
+}
+
+// Parse parses the ID built by the builder back to the components. The fully hashed IDs and the IDs built for the
+// other vertices can not be parsed.
+func (b SourceMessageIDBuilder) Parse(id string) (ParsedSourceMessageID, error) {
+	rest, ok := strings.CutPrefix(id, b.prefix)
+	if !ok {
+		if strings.HasPrefix(id, hashedIDPrefix) {
+			return ParsedSourceMessageID{}, fmt.Errorf("failed to parse the message ID %q, the ID is hashed", id)
+		}
+		return ParsedSourceMessageID{}, fmt.Errorf("failed to parse the message ID %q, it's not built for vertex %q of pipeline %q", id, b.vertex, b.pipeline)
+	}
+	partition, sequence, ok := strings.Cut(rest, "-")
+	if !ok {
+		return ParsedSourceMessageID{}, fmt.Errorf("failed to parse the message ID %q, missing the sequence", id)
+	}
+	p, err := strconv.ParseInt(partition, 10, 32)
+	if err != nil {
+		return ParsedSourceMessageID{}, fmt.Errorf("failed to parse the partition of the message ID %q, %w", id, err)
+	}
+	parsed := ParsedSourceMessageID{Pipeline: b.pipeline, Vertex: b.vertex, Partition: int32(p), Sequence: sequence}
+	if hashed, ok := strings.CutPrefix(sequence, hashedSequencePrefix); ok {
+		parsed.Sequence, parsed.HashedSequence = hashed, true
+	}
+	return parsed, nil
+}
+
+// hash returns the first 128 bits of the SHA-256 of the string in hex.
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:16])
+}
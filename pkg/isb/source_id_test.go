@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isb
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceMessageIDBuilder_ID(t *testing.T) {
+	b := NewSourceMessageIDBuilder("simple-pipeline", "in")
+	assert.Equal(t, "simple-pipeline-in-2-1024", b.ID(2, "1024"))
+	assert.Equal(t, MessageID{VertexName: "in", Offset: "simple-pipeline-in-2-1024", Index: 0}, b.MessageID(2, "1024"))
+	assert.Equal(t, "in-simple-pipeline-in-2-1024-0", b.MessageID(2, "1024").String())
+	// the same message read again has the same ID
+	assert.Equal(t, b.ID(2, "1024"), NewSourceMessageIDBuilder("simple-pipeline", "in").ID(2, "1024"))
+}
+
+func TestSourceMessageIDBuilder_Collision(t *testing.T) {
+	ids := make(map[string]struct{})
+	// the sequences of the replicas of a generator and the partitions of a Kafka topic overlap
+	for _, b := range []SourceMessageIDBuilder{NewSourceMessageIDBuilder("pipeline", "in"), NewSourceMessageIDBuilder("pipeline", "in-2")} {
+		for partition := int32(0); partition < 12; partition++ {
+			for seq := 0; seq < 500; seq++ {
+				id := b.ID(partition, strconv.Itoa(seq))
+				_, seen := ids[id]
+				assert.False(t, seen, "duplicate ID %s", id)
+				ids[id] = struct{}{}
+			}
+		}
+	}
+	assert.Len(t, ids, 2*12*500)
+
+	// the long sequences are hashed without collisions
+	b := NewSourceMessageIDBuilder("pipeline", "in")
+	long := strings.Repeat("x", MaxSourceMessageIDLength)
+	assert.NotEqual(t, b.ID(0, long+"1"), b.ID(0, long+"2"))
+	assert.NotEqual(t, b.ID(0, long+"1"), b.ID(1, long+"1"))
+}
+
+func TestSourceMessageIDBuilder_MaxLength(t *testing.T) {
+	long := strings.Repeat("x", 2*MaxSourceMessageIDLength)
+	b := NewSourceMessageIDBuilder("pipeline", "in")
+	id := b.ID(3, long)
+	assert.LessOrEqual(t, len(id), MaxSourceMessageIDLength)
+	parsed, err := b.Parse(id)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), parsed.Partition)
+	assert.True(t, parsed.HashedSequence)
+	assert.Equal(t, hash(long), parsed.Sequence)
+
+	// the names are too long to keep the prefix, the whole ID is hashed
+	b = NewSourceMessageIDBuilder(strings.Repeat("p", 63), strings.Repeat("v", 63))
+	id = b.ID(3, "1")
+	assert.LessOrEqual(t, len(id), MaxSourceMessageIDLength)
+	_, err = b.Parse(id)
+	assert.ErrorContains(t, err, "the ID is hashed")
+	assert.Equal(t, id, b.ID(3, "1"))
+	assert.NotEqual(t, id, b.ID(4, "1"))
+}
+
+func TestSourceMessageIDBuilder_Parse(t *testing.T) {
+	b := NewSourceMessageIDBuilder("simple-pipeline", "in")
+	parsed, err := b.Parse(b.ID(7, "topic:7:100-2"))
+	assert.NoError(t, err)
+	assert.Equal(t, ParsedSourceMessageID{Pipeline: "simple-pipeline", Vertex: "in", Partition: 7, Sequence: "topic:7:100-2"}, parsed)
+
+	// a sequence looking like a hashed one is hashed
+	parsed, err = b.Parse(b.ID(0, "~abc"))
+	assert.NoError(t, err)
+	assert.True(t, parsed.HashedSequence)
+	assert.Equal(t, hash("~abc"), parsed.Sequence)
+
+	_, err = b.Parse("another-pipeline-in-0-1")
+	assert.ErrorContains(t, err, "not built for vertex")
+	_, err = b.Parse("simple-pipeline-in-0")
+	assert.ErrorContains(t, err, "missing the sequence")
+	_, err = b.Parse("simple-pipeline-in-x-1")
+	assert.ErrorContains(t, err, "failed to parse the partition")
+}
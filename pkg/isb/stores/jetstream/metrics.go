@@ -49,6 +49,14 @@ var isbWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help:      "Total number of jetstream write errors",
 }, []string{"buffer"})
 
+// isbNoLeaderErrors is used to indicate the number of jetstream write errors caused by the cluster or the stream having
+// no leader, to tell an unhealthy cluster apart from a full buffer
+var isbNoLeaderErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "write_no_leader_error_total",
+	Help:      "Total number of jetstream write errors caused by no leader",
+}, []string{"buffer"})
+
 // isbSoftUsage is used to indicate of buffer that is used up, it is calculated based on the messages in pending + ack pending
 var isbSoftUsage = promauto.NewGaugeVec(prometheus.GaugeOpts{
 	Subsystem: "isb_jetstream",
@@ -81,6 +81,10 @@ func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) WriteOption
 type readOptions struct {
 	// readTimeOut is the timeout needed for read timeout
 	readTimeOut time.Duration
+	// vertexInstance is the vertex instance reading the buffer, it is used to label the ack metrics
+	vertexInstance *dfv1.VertexInstance
+	// pauseCheckInterval is the interval of checking whether the partition is paused
+	pauseCheckInterval time.Duration
 }
 
 type ReadOption func(*readOptions) error
@@ -93,8 +97,26 @@ func WithReadTimeOut(timeout time.Duration) ReadOption {
 	}
 }
 
+// WithVertexInstance sets the vertex instance reading the buffer, the ack latency and failures are
+// only recorded per vertex when it is set
+func WithVertexInstance(vi *dfv1.VertexInstance) ReadOption {
+	return func(o *readOptions) error {
+		o.vertexInstance = vi
+		return nil
+	}
+}
+
+// WithPauseCheckInterval sets the interval of checking whether the partition is paused
+func WithPauseCheckInterval(interval time.Duration) ReadOption {
+	return func(o *readOptions) error {
+		o.pauseCheckInterval = interval
+		return nil
+	}
+}
+
 func defaultReadOptions() *readOptions {
 	return &readOptions{
-		readTimeOut: time.Second,
+		readTimeOut:        time.Second,
+		pauseCheckInterval: 5 * time.Second,
 	}
 }
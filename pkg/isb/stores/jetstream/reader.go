@@ -25,9 +25,12 @@ import (
 	"time"
 
 	"github.com/nats-io/nats.go"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
@@ -42,6 +45,12 @@ type jetStreamReader struct {
 	inProgressTickDuration time.Duration
 	partitionIdx           int32
 	log                    *zap.SugaredLogger
+	// ackMetricLabels are the labels of the vertex ack metrics, nil if the reader is not created by a vertex
+	ackMetricLabels map[string]string
+	js              nats.JetStreamContext
+	// paused is whether the partition is paused when it's checked at pauseCheckedAt
+	paused         bool
+	pauseCheckedAt time.Time
 }
 
 // NewJetStreamBufferReader is used to provide a new JetStream buffer reader connection
@@ -64,6 +73,9 @@ func NewJetStreamBufferReader(ctx context.Context, client *jsclient.Client, name
 		opts:         o,
 		log:          log,
 	}
+	if o.vertexInstance != nil {
+		reader.ackMetricLabels = metrics.ISBAckLabels(o.vertexInstance, name)
+	}
 
 	jsContext, err := reader.client.JetStreamContext()
 	if err != nil {
@@ -87,6 +99,9 @@ func NewJetStreamBufferReader(ctx context.Context, client *jsclient.Client, name
 	}
 
 	reader.sub = sub
+	reader.js = jsContext
+	reader.paused = consumer.Config.Metadata[dfv1.KeyPartitionPaused] == "true"
+	reader.pauseCheckedAt = time.Now()
 	reader.inProgressTickDuration = time.Duration(inProgressTickSeconds * int64(time.Second))
 	return reader, nil
 }
@@ -112,11 +127,38 @@ func (jr *jetStreamReader) Pending(_ context.Context) (int64, error) {
 	return jr.client.PendingForStream(jr.stream, jr.stream)
 }
 
-func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+// isPaused returns whether the partition is paused, it's checked with the metadata of the consumer at most once in the
+// pause check interval, the last known state is kept if the check fails.
+func (jr *jetStreamReader) isPaused() bool {
+	if time.Since(jr.pauseCheckedAt) < jr.opts.pauseCheckInterval {
+		return jr.paused
+	}
+	jr.pauseCheckedAt = time.Now()
+	consumer, err := jr.js.ConsumerInfo(jr.stream, jr.stream)
+	if err != nil {
+		jr.log.Warnw("Failed to get consumer info to check the pause", zap.Error(err))
+		return jr.paused
+	}
+	if paused := consumer.Config.Metadata[dfv1.KeyPartitionPaused] == "true"; paused != jr.paused {
+		jr.log.Infow("The pause of the partition is changed", zap.Bool("paused", paused))
+		jr.paused = paused
+	}
+	return jr.paused
+}
+
+func (jr *jetStreamReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
 	labels := map[string]string{"buffer": jr.GetName()}
 	defer func(t time.Time) {
 		isbReadTime.With(labels).Observe(float64(time.Since(t).Microseconds()))
 	}(time.Now())
+	if jr.isPaused() {
+		// no message is received while the partition is paused, the read times out as if it's empty
+		select {
+		case <-ctx.Done():
+		case <-time.After(jr.opts.readTimeOut):
+		}
+		return nil, nil
+	}
 	var err error
 	var result []*isb.ReadMessage
 	msgs, err := jr.sub.Fetch(int(count), nats.MaxWait(jr.opts.readTimeOut))
@@ -152,7 +194,9 @@ func (jr *jetStreamReader) Ack(_ context.Context, offsets []isb.Offset) []error
 	defer func(t time.Time) {
 		isbAckTime.With(labels).Observe(float64(time.Since(t).Microseconds()))
 	}(time.Now())
+	start := time.Now()
 	errs := make([]error, len(offsets))
+	failed := atomic.NewInt32(0)
 	done := make(chan struct{})
 	wg := &sync.WaitGroup{}
 	for idx, o := range offsets {
@@ -160,6 +204,7 @@ func (jr *jetStreamReader) Ack(_ context.Context, offsets []isb.Offset) []error
 		go func(index int, o isb.Offset) {
 			defer wg.Done()
 			if err := o.AckIt(); err != nil {
+				failed.Inc()
 				jr.log.Errorw("Failed to ack message", zap.Error(err))
 				// If the error is related to nats/jetstream, we skip it because it might end up with infinite ack retries.
 				// Skipping those errors to let the whole read/write/ack for loop to restart from reading, to pick up those
@@ -175,6 +220,9 @@ func (jr *jetStreamReader) Ack(_ context.Context, offsets []isb.Offset) []error
 		close(done)
 	}()
 	<-done
+	if jr.ackMetricLabels != nil {
+		metrics.ObserveISBAck(jr.ackMetricLabels, start, int(failed.Load()))
+	}
 	return errs
 }
 
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jetstream
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+)
+
+// benchmarkAckBatchSize is the number of offsets acknowledged per benchmark iteration, it is kept below the
+// max messages of the test stream.
+const benchmarkAckBatchSize = 50
+
+// BenchmarkJetStreamBufferRead_Ack compares the per-offset ack latency of acknowledging a batch of offsets in a
+// single Ack call against acknowledging them one Ack call at a time.
+func BenchmarkJetStreamBufferRead_Ack(b *testing.B) {
+	b.Run("batched", func(b *testing.B) {
+		benchmarkAck(b, func(ctx context.Context, reader isb.BufferReader, offsets []isb.Offset) {
+			reader.Ack(ctx, offsets)
+		})
+	})
+	b.Run("unbatched", func(b *testing.B) {
+		benchmarkAck(b, func(ctx context.Context, reader isb.BufferReader, offsets []isb.Offset) {
+			for _, o := range offsets {
+				reader.Ack(ctx, []isb.Offset{o})
+			}
+		})
+	})
+}
+
+func benchmarkAck(b *testing.B, ack func(ctx context.Context, reader isb.BufferReader, offsets []isb.Offset)) {
+	s := natstest.RunJetStreamServer(b)
+	defer natstest.ShutdownJetStreamServer(b, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := natsclient.NewTestClientWithServer(b, s)
+	defer client.Close()
+	js, err := client.JetStreamContext()
+	if err != nil {
+		b.Fatal(err)
+	}
+	streamName := "benchmarkAck"
+	addStream(b, js, streamName)
+	defer deleteStream(b, js, streamName)
+
+	writer, err := NewJetStreamBufferWriter(ctx, client, streamName, streamName, streamName, defaultPartitionIdx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer writer.Close()
+	waitForNotFull(b, ctx, writer.(*jetStreamWriter))
+	reader, err := NewJetStreamBufferReader(ctx, client, streamName, streamName, streamName, defaultPartitionIdx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer reader.Close()
+
+	var elapsed time.Duration
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		// use a different vertex name in each iteration so that the message IDs are not deduplicated.
+		messages := testutils.BuildTestWriteMessages(benchmarkAckBatchSize, time.Unix(1636470000, 0), nil, fmt.Sprintf("testVertex-%d", i))
+		if _, errs := writer.Write(ctx, messages); errs[0] != nil {
+			b.Fatal(errs[0])
+		}
+		offsets := make([]isb.Offset, 0, benchmarkAckBatchSize)
+		for len(offsets) < benchmarkAckBatchSize {
+			readMessages, err := reader.Read(ctx, int64(benchmarkAckBatchSize-len(offsets)))
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, m := range readMessages {
+				offsets = append(offsets, m.ReadOffset)
+			}
+		}
+		b.StartTimer()
+		start := time.Now()
+		ack(ctx, reader, offsets)
+		elapsed += time.Since(start)
+	}
+	b.ReportMetric(float64(elapsed.Nanoseconds())/float64(b.N*benchmarkAckBatchSize), "ns/offset")
+}
@@ -18,22 +18,28 @@ package jetstream
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"go.uber.org/goleak"
 
 	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
 )
 
 func TestMain(m *testing.M) {
-	goleak.VerifyTestMain(m)
+	// the keyring imported by the Pulsar client connects to the D-Bus session bus, if any, when it's initialized
+	goleak.VerifyTestMain(m, goleak.IgnoreAnyFunction("github.com/godbus/dbus.(*Conn).inWorker"))
 }
 
 var defaultPartitionIdx = int32(0)
@@ -131,7 +137,133 @@ func TestJetStreamBufferRead(t *testing.T) {
 
 }
 
+// failingOffset is an offset which always fails to be acked
+type failingOffset struct {
+	isb.SimpleStringOffset
+}
+
+func (f failingOffset) AckIt() error {
+	return fmt.Errorf("ack failed")
+}
+
+func TestJetStreamBufferRead_AckMetrics(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natsclient.NewTestClientWithServer(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "testAckMetrics"
+	addStream(t, js, streamName)
+	defer deleteStream(t, js, streamName)
+
+	bw, err := NewJetStreamBufferWriter(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx)
+	assert.NoError(t, err)
+	defer bw.Close()
+	waitForNotFull(t, ctx, bw.(*jetStreamWriter))
+	_, errs := bw.Write(ctx, testutils.BuildTestWriteMessages(int64(10), time.Unix(1636470000, 0), nil, "testVertex"))
+	for _, e := range errs {
+		assert.NoError(t, e)
+	}
+
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "ack-metrics-pl",
+			AbstractVertex: dfv1.AbstractVertex{Name: "ack-metrics-vertex", UDF: &dfv1.UDF{}},
+		}},
+		Replica: 1,
+	}
+	bufferReader, err := NewJetStreamBufferReader(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx, WithVertexInstance(vi))
+	assert.NoError(t, err)
+	defer bufferReader.Close()
+
+	readMessages, err := bufferReader.Read(ctx, 10)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 10)
+	offsets := make([]isb.Offset, len(readMessages))
+	for idx, m := range readMessages {
+		offsets[idx] = m.ReadOffset
+	}
+	for _, e := range bufferReader.Ack(ctx, offsets) {
+		assert.NoError(t, e)
+	}
+	// the failure is counted even though the error is not returned.
+	_ = bufferReader.Ack(ctx, []isb.Offset{failingOffset{SimpleStringOffset: func() string { return "0-0" }}})
+
+	labels := prometheus.Labels{
+		metrics.LabelVertex:             "ack-metrics-vertex",
+		metrics.LabelPipeline:           "ack-metrics-pl",
+		metrics.LabelVertexType:         string(dfv1.VertexTypeMapUDF),
+		metrics.LabelVertexReplicaIndex: "1",
+		metrics.LabelPartitionName:      streamName,
+	}
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.ISBAckFailures.With(labels)))
+
+	details, err := metrics.NewHealthDetails(vi.Vertex, prometheus.DefaultGatherer, nil)
+	assert.NoError(t, err)
+	assert.Len(t, details.Partitions, 1)
+	assert.Equal(t, streamName, details.Partitions[0].Partition)
+	assert.Equal(t, uint64(2), details.Partitions[0].AckCalls)
+	assert.Equal(t, uint64(1), details.Partitions[0].AckFailures)
+	assert.Greater(t, details.Partitions[0].AckLatencyP99, float64(0))
+}
+
 // TestGetName is used to test the GetName function
+func TestJetStreamBufferRead_Paused(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	defaultJetStreamClient := natsclient.NewTestClientWithServer(t, s)
+	defer defaultJetStreamClient.Close()
+	js, err := defaultJetStreamClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	streamName := "testJetStreamBufferReadPaused"
+	addStream(t, js, streamName)
+	defer deleteStream(t, js, streamName)
+	for _, msg := range testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "testVertex") {
+		data, err := msg.MarshalBinary()
+		assert.NoError(t, err)
+		_, err = js.Publish(streamName, data)
+		assert.NoError(t, err)
+	}
+	setPaused := func(paused bool) {
+		info, err := js.ConsumerInfo(streamName, streamName)
+		assert.NoError(t, err)
+		cfg := info.Config
+		cfg.Metadata = nil
+		if paused {
+			cfg.Metadata = map[string]string{dfv1.KeyPartitionPaused: "true"}
+		}
+		_, err = js.UpdateConsumer(streamName, &cfg)
+		assert.NoError(t, err)
+	}
+
+	setPaused(true)
+	bufferReader, err := NewJetStreamBufferReader(ctx, defaultJetStreamClient, streamName, streamName, streamName, defaultPartitionIdx,
+		WithReadTimeOut(10*time.Millisecond), WithPauseCheckInterval(time.Millisecond))
+	assert.NoError(t, err)
+	jr := bufferReader.(*jetStreamReader)
+	defer jr.Close()
+	readMessages, err := jr.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, readMessages)
+
+	setPaused(false)
+	time.Sleep(2 * time.Millisecond)
+	readMessages, err = jr.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+}
+
 func TestGetName(t *testing.T) {
 	s := natstest.RunJetStreamServer(t)
 	defer natstest.ShutdownJetStreamServer(t, s)
@@ -180,7 +312,7 @@ func TestClose(t *testing.T) {
 
 }
 
-func addStream(t *testing.T, js nats.JetStreamContext, streamName string) {
+func addStream(t testing.TB, js nats.JetStreamContext, streamName string) {
 	t.Helper()
 
 	_, err := js.AddStream(&nats.StreamConfig{
@@ -205,7 +337,20 @@ func addStream(t *testing.T, js nats.JetStreamContext, streamName string) {
 
 }
 
-func deleteStream(t *testing.T, js nats.JetStreamContext, streamName string) {
+// waitForNotFull waits until the writer refreshes its buffer info and is not full
+func waitForNotFull(t testing.TB, ctx context.Context, jw *jetStreamWriter) {
+	t.Helper()
+	for jw.isFull.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected not to be full, %s", ctx.Err())
+		default:
+			time.Sleep(1 * time.Millisecond)
+		}
+	}
+}
+
+func deleteStream(t testing.TB, js nats.JetStreamContext, streamName string) {
 	t.Helper()
 	_ = js.DeleteConsumer(streamName, streamName)
 	_ = js.DeleteStream(streamName)
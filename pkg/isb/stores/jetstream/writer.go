@@ -204,7 +204,7 @@ func (jw *jetStreamWriter) asyncWrite(_ context.Context, messages []isb.Message,
 			pubOpts = append(pubOpts, nats.MsgId(message.Header.ID.String()))
 		}
 		if future, err := jw.js.PublishMsgAsync(m, pubOpts...); err != nil { // nats.MsgId() is for exactly-once writing
-			errs[index] = err
+			errs[index] = jw.mapWriteErr(err, metricsLabels)
 		} else {
 			futures[index] = future
 		}
@@ -232,7 +232,7 @@ func (jw *jetStreamWriter) asyncWrite(_ context.Context, messages []isb.Message,
 					errs[idx] = nil
 				}
 			case err := <-fu.Err():
-				errs[idx] = err
+				errs[idx] = jw.mapWriteErr(err, metricsLabels)
 				isbWriteErrors.With(metricsLabels).Inc()
 			case <-ctx.Done():
 			}
@@ -281,7 +281,7 @@ func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message,
 				pubOpts = append(pubOpts, nats.MsgId(message.Header.ID.String()))
 			}
 			if pubAck, err := jw.js.PublishMsg(m, pubOpts...); err != nil {
-				errs[idx] = err
+				errs[idx] = jw.mapWriteErr(err, metricsLabels)
 				isbWriteErrors.With(metricsLabels).Inc()
 			} else {
 				if pubAck.Duplicate {
@@ -301,6 +301,16 @@ func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message,
 }
 
 // writeOffset is the offset of the location in the JS stream we wrote to.
+// mapWriteErr maps the no leader errors of publishing to a retryable BufferWriteErr, so that they can be told apart
+// from the other errors, e.g. a full buffer. The other errors are returned as they are.
+func (jw *jetStreamWriter) mapWriteErr(err error, metricsLabels map[string]string) error {
+	if !jsclient.IsNoLeaderErr(err) {
+		return err
+	}
+	isbNoLeaderErrors.With(metricsLabels).Inc()
+	return isb.BufferWriteErr{Name: jw.name, NoLeader: true, Message: fmt.Sprintf("%s %s", isb.NoLeaderMessage, err)}
+}
+
 type writeOffset struct {
 	seq          uint64
 	partitionIdx int32
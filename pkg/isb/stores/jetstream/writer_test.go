@@ -18,9 +18,12 @@ package jetstream
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -381,3 +384,21 @@ func TestWriteClose(t *testing.T) {
 	defer bw.Close()
 	assert.NoError(t, bw.Close())
 }
+
+func TestWriteMapWriteErr(t *testing.T) {
+	jw := &jetStreamWriter{name: "testJetStreamBuffer"}
+	labels := map[string]string{"buffer": jw.GetName()}
+	otherErr := errors.New("something went wrong")
+	assert.Equal(t, otherErr, jw.mapWriteErr(otherErr, labels))
+
+	err := jw.mapWriteErr(nats.ErrNoStreamResponse, labels)
+	var writeErr isb.BufferWriteErr
+	assert.True(t, errors.As(err, &writeErr))
+	assert.True(t, writeErr.IsNoLeader())
+	assert.False(t, writeErr.IsFull())
+	assert.Equal(t, "testJetStreamBuffer", writeErr.Name)
+	// the no leader errors are retryable
+	var nonRetryable isb.NonRetryableBufferWriteErr
+	assert.False(t, errors.As(err, &nonRetryable))
+	assert.Equal(t, float64(1), testutil.ToFloat64(isbNoLeaderErrors.With(labels)))
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// isbReadErrors is used to indicate the number of errors in the kafka READ operations
+var isbReadErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_kafka",
+	Name:      "read_error_total",
+	Help:      "Total number of kafka read errors",
+}, []string{"buffer"})
+
+// isbFullErrors is used to indicate the number of errors in the kafka isFull check
+var isbFullErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_kafka",
+	Name:      "isFull_error_total",
+	Help:      "Total number of kafka isFull errors",
+}, []string{"buffer"})
+
+// isbFull is used to indicate the counter for number of times buffer is full
+var isbFull = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_kafka",
+	Name:      "isFull_total",
+	Help:      "Total number of IsFull",
+}, []string{"buffer"})
+
+// isbWriteErrors is used to indicate the number of errors in the kafka write check
+var isbWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_kafka",
+	Name:      "write_error_total",
+	Help:      "Total number of kafka write errors",
+}, []string{"buffer"})
+
+// isbUsage is used to indicate the usage of the buffer, it is calculated based on the messages pending in the consumer group
+var isbUsage = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "isb_kafka",
+	Name:      "buffer_usage",
+	Help:      "Percentage of buffer usage",
+}, []string{"buffer"})
+
+// isbPending is used to indicate the number of messages pending in the consumer group
+var isbPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "isb_kafka",
+	Name:      "pending_messages",
+	Help:      "Number of pending messages",
+}, []string{"buffer"})
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// PartitionOffsets are the offsets of a partition of the topic backing a buffer.
+type PartitionOffsets struct {
+	Partition int32
+	// Oldest is the offset of the oldest message in the partition.
+	Oldest int64
+	// Newest is the offset of the next message written to the partition.
+	Newest int64
+	// Committed is the offset committed by the consumer group of the buffer, -1 if nothing is committed.
+	Committed int64
+}
+
+// Pending returns the number of messages in the partition not consumed by the consumer group yet. The consumer
+// group starts from the oldest message if nothing is committed, or if the committed message is deleted.
+func (p PartitionOffsets) Pending() int64 {
+	return p.Newest - max(p.Committed, p.Oldest)
+}
+
+// GetTopicOffsets returns the offsets of the partitions of the topic, with the offsets committed by the consumer group.
+func GetTopicOffsets(client sarama.Client, admin sarama.ClusterAdmin, topic, group string) ([]PartitionOffsets, error) {
+	partitions, err := client.Partitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the partitions of topic %q, %w", topic, err)
+	}
+	committed, err := admin.ListConsumerGroupOffsets(group, map[string][]int32{topic: partitions})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the offsets of consumer group %q, %w", group, err)
+	}
+	result := make([]PartitionOffsets, 0, len(partitions))
+	for _, partition := range partitions {
+		p := PartitionOffsets{Partition: partition, Committed: -1}
+		if block := committed.GetBlock(topic, partition); block != nil {
+			p.Committed = block.Offset
+		}
+		if p.Oldest, err = client.GetOffset(topic, partition, sarama.OffsetOldest); err != nil {
+			return nil, fmt.Errorf("failed to get the oldest offset of topic %q, partition %d, %w", topic, partition, err)
+		}
+		if p.Newest, err = client.GetOffset(topic, partition, sarama.OffsetNewest); err != nil {
+			return nil, fmt.Errorf("failed to get the newest offset of topic %q, partition %d, %w", topic, partition, err)
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// TotalPending returns the number of messages not consumed by the consumer group in all the partitions.
+func TotalPending(offsets []PartitionOffsets) int64 {
+	var pending int64
+	for _, p := range offsets {
+		pending += p.Pending()
+	}
+	return pending
+}
+
+// NewSaramaConfig returns the sarama config of the clients reading and writing the buffers, the consumer groups of
+// the buffers start from the oldest messages, so that nothing written before the readers start is skipped.
+func NewSaramaConfig(yamlConfig string) (*sarama.Config, error) {
+	config, err := sharedutil.GetSaramaConfigFromYAMLString(yamlConfig)
+	if err != nil {
+		return nil, err
+	}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Return.Errors = true
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRange()}
+	return config, nil
+}
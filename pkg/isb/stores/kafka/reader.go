@@ -0,0 +1,392 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type kafkaReader struct {
+	name         string
+	topic        string
+	partitionIdx int32
+	handler      *consumerHandler
+	// pending returns the number of messages pending in the consumer group of the buffer
+	pending func() (int64, error)
+	opts    *readOptions
+	log     *zap.SugaredLogger
+	// ackMetricLabels are the labels of the vertex ack metrics, nil if the reader is not created by a vertex
+	ackMetricLabels prometheus.Labels
+	// redeliveries are the messages not acknowledged, which are read again before the new messages
+	redeliveryLock sync.Mutex
+	redeliveries   []*isb.ReadMessage
+	// cancel stops the consumer group, and stopped is closed when it's stopped, it's nil if there is no consumer group
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+// NewKafkaBufferReader is used to provide a new buffer reader consuming the topic in the consumer group, the
+// partitions of the topic are balanced among the readers of the buffer. The client should be created with the
+// config of NewSaramaConfig, it is not closed when the reader is closed.
+func NewKafkaBufferReader(ctx context.Context, client sarama.Client, name, topic, group string, partitionIdx int32, opts ...ReadOption) (isb.BufferReader, error) {
+	o := defaultReadOptions()
+	for _, opt := range opts {
+		if opt != nil {
+			if err := opt(o); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// the admin is not closed, which would close the underlying client
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a Kafka admin client, %w", err)
+	}
+	consumerGroup, err := sarama.NewConsumerGroupFromClient(group, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kafka consumer group %q, %w", group, err)
+	}
+	pending := func() (int64, error) {
+		offsets, err := GetTopicOffsets(client, admin, topic, group)
+		if err != nil {
+			return isb.PendingNotAvailable, err
+		}
+		return TotalPending(offsets), nil
+	}
+	reader := newKafkaReader(ctx, name, topic, partitionIdx, pending, o)
+	consumerCtx, cancel := context.WithCancel(context.Background())
+	reader.cancel = cancel
+	reader.stopped = make(chan struct{})
+	go reader.consume(consumerCtx, consumerGroup)
+	return reader, nil
+}
+
+func newKafkaReader(ctx context.Context, name, topic string, partitionIdx int32, pending func() (int64, error), o *readOptions) *kafkaReader {
+	log := logging.FromContext(ctx).With("bufferReader", name).With("topic", topic).With("partitionIdx", partitionIdx)
+	reader := &kafkaReader{
+		name:         name,
+		topic:        topic,
+		partitionIdx: partitionIdx,
+		handler:      newConsumerHandler(topic, o.readChanSize, log),
+		pending:      pending,
+		opts:         o,
+		log:          log,
+		cancel:       func() {},
+	}
+	if o.vertexInstance != nil {
+		reader.ackMetricLabels = metrics.ISBAckLabels(o.vertexInstance, name)
+	}
+	return reader
+}
+
+// consume runs the consumer group session by session, a new session is started on rebalancing.
+func (kr *kafkaReader) consume(ctx context.Context, consumerGroup sarama.ConsumerGroup) {
+	defer close(kr.stopped)
+	defer func() {
+		if err := consumerGroup.Close(); err != nil {
+			kr.log.Errorw("Failed to close the consumer group", zap.Error(err))
+		}
+	}()
+	go func() {
+		for err := range consumerGroup.Errors() {
+			kr.log.Errorw("Kafka consumer group error", zap.Error(err))
+		}
+	}()
+	for {
+		if err := consumerGroup.Consume(ctx, []string{kr.topic}, kr.handler); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+			kr.log.Errorw("Kafka consumer group session failed, restarting", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (kr *kafkaReader) GetName() string {
+	return kr.name
+}
+
+func (kr *kafkaReader) GetPartitionIdx() int32 {
+	return kr.partitionIdx
+}
+
+// Close stops the consumer group, the offsets of the messages acknowledged are committed when the session ends.
+func (kr *kafkaReader) Close() error {
+	kr.cancel()
+	if kr.stopped == nil {
+		return nil
+	}
+	select {
+	case <-kr.stopped:
+	case <-time.After(10 * time.Second):
+		return fmt.Errorf("timed out waiting for the consumer group of topic %q to stop", kr.topic)
+	}
+	return nil
+}
+
+func (kr *kafkaReader) Pending(_ context.Context) (int64, error) {
+	return kr.pending()
+}
+
+// Read reads the messages not acknowledged first, then the messages consumed from the topic, until the count is
+// reached or the read times out.
+func (kr *kafkaReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	result := kr.takeRedeliveries(count)
+	if int64(len(result)) >= count {
+		return result, nil
+	}
+	timeout := time.After(kr.opts.readTimeOut)
+	for int64(len(result)) < count {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-timeout:
+			return result, nil
+		case msg := <-kr.handler.messages:
+			kr.handler.track(msg)
+			var m isb.Message
+			// err should be nil as we have our own marshaller/unmarshaller
+			if err := m.UnmarshalBinary(msg.Value); err != nil {
+				isbReadErrors.With(map[string]string{"buffer": kr.GetName()}).Inc()
+				// the message can never be read, it's acknowledged so that it does not block the commits
+				kr.handler.ack([]*offset{{partition: msg.Partition, offset: msg.Offset}})
+				return result, fmt.Errorf("failed to unmarshal the message at offset %d of topic %q, partition %d into isb.Message, %w", msg.Offset, kr.topic, msg.Partition, err)
+			}
+			rm := &isb.ReadMessage{
+				Message: m,
+				Metadata: isb.MessageMetadata{
+					NumDelivered: 1,
+				},
+			}
+			rm.ReadOffset = &offset{partition: msg.Partition, offset: msg.Offset, partitionIdx: kr.partitionIdx, reader: kr, msg: rm}
+			result = append(result, rm)
+		}
+	}
+	return result, nil
+}
+
+func (kr *kafkaReader) takeRedeliveries(count int64) []*isb.ReadMessage {
+	kr.redeliveryLock.Lock()
+	defer kr.redeliveryLock.Unlock()
+	n := min(int64(len(kr.redeliveries)), count)
+	result := kr.redeliveries[:n:n]
+	kr.redeliveries = kr.redeliveries[n:]
+	return result
+}
+
+// Ack marks the offsets consumed in the consumer group session, the marked offsets are committed periodically.
+func (kr *kafkaReader) Ack(_ context.Context, offsets []isb.Offset) []error {
+	start := time.Now()
+	errs := make([]error, len(offsets))
+	var acked []*offset
+	for i, o := range offsets {
+		ko, ok := o.(*offset)
+		if !ok {
+			errs[i] = fmt.Errorf("offset %s is not read from Kafka", o)
+			continue
+		}
+		acked = append(acked, ko)
+	}
+	kr.handler.ack(acked)
+	if kr.ackMetricLabels != nil {
+		failed := len(offsets) - len(acked)
+		metrics.ObserveISBAck(kr.ackMetricLabels, start, failed)
+	}
+	return errs
+}
+
+// NoAck queues the messages to be read again, Kafka does not redeliver a message consumed by the group.
+func (kr *kafkaReader) NoAck(_ context.Context, offsets []isb.Offset) {
+	for _, o := range offsets {
+		if err := o.NoAck(); err != nil {
+			kr.log.Errorw("Failed to queue the message for redelivery", zap.Error(err))
+		}
+	}
+}
+
+func (kr *kafkaReader) redeliver(rm *isb.ReadMessage) {
+	kr.redeliveryLock.Lock()
+	defer kr.redeliveryLock.Unlock()
+	rm.Metadata.NumDelivered++
+	kr.redeliveries = append(kr.redeliveries, rm)
+}
+
+// consumerHandler hands the claimed messages over to the reader, and marks the offsets acknowledged in the session.
+type consumerHandler struct {
+	topic    string
+	messages chan *sarama.ConsumerMessage
+	log      *zap.SugaredLogger
+
+	lock sync.Mutex
+	sess sarama.ConsumerGroupSession
+	// trackers are the offsets read from the partitions of the topic, they are kept across the sessions as the
+	// messages read in a session can be acknowledged after it ends
+	trackers map[int32]*partitionTracker
+}
+
+// partitionTracker tracks the offsets read from a partition, so that the offset marked in the session never passes
+// a message not acknowledged yet.
+type partitionTracker struct {
+	inflight map[int64]struct{}
+	// next is the offset right after the last message read
+	next int64
+}
+
+func newConsumerHandler(topic string, readChanSize int, log *zap.SugaredLogger) *consumerHandler {
+	return &consumerHandler{
+		topic:    topic,
+		messages: make(chan *sarama.ConsumerMessage, readChanSize),
+		log:      log,
+		trackers: make(map[int32]*partitionTracker),
+	}
+}
+
+// Setup is run at the beginning of a new session, before ConsumeClaim
+func (h *consumerHandler) Setup(sess sarama.ConsumerGroupSession) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.sess = sess
+	// the acks without a session are marked in the new session
+	for partition, t := range h.trackers {
+		h.markLocked(partition, t)
+	}
+	h.log.Infow("Kafka consumer group session started", zap.Any("claims", sess.Claims()))
+	return nil
+}
+
+// Cleanup is run at the end of a session, once all ConsumeClaim goroutines have exited
+func (h *consumerHandler) Cleanup(sess sarama.ConsumerGroupSession) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	sess.Commit()
+	h.sess = nil
+	h.log.Info("Kafka consumer group session ended")
+	return nil
+}
+
+// ConsumeClaim hands the messages of a claimed partition over to the reader until the session ends.
+func (h *consumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			select {
+			case h.messages <- msg:
+			case <-sess.Context().Done():
+				return nil
+			}
+		case <-sess.Context().Done():
+			return nil
+		}
+	}
+}
+
+// track records the message as read and not acknowledged yet.
+func (h *consumerHandler) track(msg *sarama.ConsumerMessage) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	t, ok := h.trackers[msg.Partition]
+	if !ok {
+		t = &partitionTracker{inflight: make(map[int64]struct{})}
+		h.trackers[msg.Partition] = t
+	}
+	t.inflight[msg.Offset] = struct{}{}
+	t.next = max(t.next, msg.Offset+1)
+}
+
+// ack removes the offsets from the ones not acknowledged, and marks the lowest offset not acknowledged yet of each
+// partition, or the offset right after the last message read if all of them are acknowledged.
+func (h *consumerHandler) ack(offsets []*offset) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	touched := make(map[int32]*partitionTracker)
+	for _, o := range offsets {
+		t, ok := h.trackers[o.partition]
+		if !ok {
+			continue
+		}
+		delete(t.inflight, o.offset)
+		touched[o.partition] = t
+	}
+	if h.sess == nil {
+		// the offsets are marked when the next session starts
+		return
+	}
+	for partition, t := range touched {
+		h.markLocked(partition, t)
+	}
+}
+
+// markLocked marks the lowest offset of the partition not acknowledged yet in the session, marking the offset of a
+// partition not claimed by the session, or an offset lower than the one marked, is ignored.
+func (h *consumerHandler) markLocked(partition int32, t *partitionTracker) {
+	mark := t.next
+	for inflight := range t.inflight {
+		mark = min(mark, inflight)
+	}
+	h.sess.MarkOffset(h.topic, partition, mark, "")
+}
+
+// offset implements the isb.Offset interface for Kafka.
+type offset struct {
+	partition    int32
+	offset       int64
+	partitionIdx int32
+	reader       *kafkaReader
+	msg          *isb.ReadMessage
+}
+
+func (o *offset) String() string {
+	return fmt.Sprintf("%d-%d", o.partition, o.offset)
+}
+
+func (o *offset) AckIt() error {
+	o.reader.handler.ack([]*offset{o})
+	return nil
+}
+
+func (o *offset) NoAck() error {
+	o.reader.redeliver(o.msg)
+	return nil
+}
+
+func (o *offset) Sequence() (int64, error) {
+	return o.offset, nil
+}
+
+func (o *offset) PartitionIdx() int32 {
+	return o.partitionIdx
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+// fakeSession records the offsets marked in a consumer group session
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	lock   sync.Mutex
+	marked map[int32]int64
+}
+
+func newFakeSession() *fakeSession {
+	return &fakeSession{marked: make(map[int32]int64)}
+}
+
+func (s *fakeSession) MarkOffset(_ string, partition int32, offset int64, _ string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.marked[partition] = max(s.marked[partition], offset)
+}
+
+func (s *fakeSession) Claims() map[string][]int32 {
+	return nil
+}
+
+func (s *fakeSession) Commit() {}
+
+func (s *fakeSession) markedOffset(partition int32) int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.marked[partition]
+}
+
+func produceTestMessages(t *testing.T, reader *kafkaReader, partition int32, startOffset int64, count int64) {
+	for i, m := range testutils.BuildTestWriteMessages(count, time.Unix(1636470000, 0), nil, "testVertex") {
+		value, err := m.MarshalBinary()
+		require.NoError(t, err)
+		reader.handler.messages <- &sarama.ConsumerMessage{Topic: reader.topic, Partition: partition, Offset: startOffset + int64(i), Value: value}
+	}
+}
+
+func newTestReader() *kafkaReader {
+	o := defaultReadOptions()
+	o.readTimeOut = 100 * time.Millisecond
+	return newKafkaReader(context.Background(), "test-buffer", "test-topic", 0, func() (int64, error) { return 5, nil }, o)
+}
+
+func TestKafkaReader_ReadAck(t *testing.T) {
+	ctx := context.Background()
+	reader := newTestReader()
+	sess := newFakeSession()
+	require.NoError(t, reader.handler.Setup(sess))
+	produceTestMessages(t, reader, 0, 10, 3)
+	produceTestMessages(t, reader, 1, 20, 2)
+
+	msgs, err := reader.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 5)
+	assert.Equal(t, "0-10", msgs[0].ReadOffset.String())
+	assert.Equal(t, uint64(1), msgs[0].Metadata.NumDelivered)
+
+	// the offset marked does not pass the first message not acknowledged
+	errs := reader.Ack(ctx, []isb.Offset{msgs[1].ReadOffset, msgs[2].ReadOffset, msgs[3].ReadOffset})
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(10), sess.markedOffset(0))
+	assert.Equal(t, int64(21), sess.markedOffset(1))
+
+	reader.Ack(ctx, []isb.Offset{msgs[0].ReadOffset, msgs[4].ReadOffset})
+	assert.Equal(t, int64(13), sess.markedOffset(0))
+	assert.Equal(t, int64(22), sess.markedOffset(1))
+
+	pending, err := reader.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), pending)
+	assert.NoError(t, reader.Close())
+}
+
+func TestKafkaReader_NoAck(t *testing.T) {
+	ctx := context.Background()
+	reader := newTestReader()
+	sess := newFakeSession()
+	require.NoError(t, reader.handler.Setup(sess))
+	produceTestMessages(t, reader, 0, 0, 3)
+
+	msgs, err := reader.Read(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	reader.NoAck(ctx, []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset})
+
+	// the messages not acknowledged are read again before the new ones
+	msgs, err = reader.Read(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	assert.Equal(t, "0-0", msgs[0].ReadOffset.String())
+	assert.Equal(t, uint64(2), msgs[0].Metadata.NumDelivered)
+	assert.Equal(t, "0-1", msgs[1].ReadOffset.String())
+	assert.Equal(t, "0-2", msgs[2].ReadOffset.String())
+	assert.Equal(t, uint64(1), msgs[2].Metadata.NumDelivered)
+
+	reader.Ack(ctx, []isb.Offset{msgs[2].ReadOffset})
+	assert.Equal(t, int64(0), sess.markedOffset(0))
+	reader.Ack(ctx, []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset})
+	assert.Equal(t, int64(3), sess.markedOffset(0))
+}
+
+func TestKafkaReader_AckBetweenSessions(t *testing.T) {
+	ctx := context.Background()
+	reader := newTestReader()
+	sess := newFakeSession()
+	require.NoError(t, reader.handler.Setup(sess))
+	produceTestMessages(t, reader, 0, 0, 2)
+	msgs, err := reader.Read(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	// the acks during a rebalance are marked in the next session
+	require.NoError(t, reader.handler.Cleanup(sess))
+	reader.Ack(ctx, []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset})
+	assert.Equal(t, int64(0), sess.markedOffset(0))
+	next := newFakeSession()
+	require.NoError(t, reader.handler.Setup(next))
+	assert.Equal(t, int64(2), next.markedOffset(0))
+}
+
+func TestKafkaReader_ReadTimeout(t *testing.T) {
+	reader := newTestReader()
+	msgs, err := reader.Read(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+}
+
+func TestTotalPending(t *testing.T) {
+	offsets := []PartitionOffsets{
+		{Partition: 0, Oldest: 0, Newest: 10, Committed: 4},
+		// nothing committed, the consumer group starts from the oldest message
+		{Partition: 1, Oldest: 3, Newest: 8, Committed: -1},
+		// the committed message is deleted
+		{Partition: 2, Oldest: 5, Newest: 6, Committed: 2},
+	}
+	assert.Equal(t, int64(6), offsets[0].Pending())
+	assert.Equal(t, int64(5), offsets[1].Pending())
+	assert.Equal(t, int64(1), offsets[2].Pending())
+	assert.Equal(t, int64(12), TotalPending(offsets))
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type kafkaWriter struct {
+	name         string
+	partitionIdx int32
+	topic        string
+	producer     sarama.SyncProducer
+	// pending returns the number of messages pending in the consumer group of the buffer
+	pending func() (int64, error)
+	opts    *writeOptions
+	isFull  *atomic.Bool
+	log     *zap.SugaredLogger
+}
+
+// NewKafkaBufferWriter is used to provide a new instance of a buffer writer producing to the topic, the buffer is
+// considered full when the messages pending in the consumer group reach the usage limit of the max length.
+// The client is not closed when the writer is closed.
+func NewKafkaBufferWriter(ctx context.Context, client sarama.Client, name, topic, group string, partitionIdx int32, opts ...WriteOption) (isb.BufferWriter, error) {
+	o := defaultWriteOptions()
+	for _, opt := range opts {
+		if opt != nil {
+			if err := opt(o); err != nil {
+				return nil, err
+			}
+		}
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a Kafka producer for topic %q, %w", topic, err)
+	}
+	// the admin is not closed, which would close the underlying client
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		_ = producer.Close()
+		return nil, fmt.Errorf("failed to create a Kafka admin client, %w", err)
+	}
+	pending := func() (int64, error) {
+		offsets, err := GetTopicOffsets(client, admin, topic, group)
+		if err != nil {
+			return 0, err
+		}
+		return TotalPending(offsets), nil
+	}
+	return newKafkaWriter(ctx, name, topic, partitionIdx, producer, pending, o), nil
+}
+
+func newKafkaWriter(ctx context.Context, name, topic string, partitionIdx int32, producer sarama.SyncProducer, pending func() (int64, error), o *writeOptions) *kafkaWriter {
+	result := &kafkaWriter{
+		name:         name,
+		partitionIdx: partitionIdx,
+		topic:        topic,
+		producer:     producer,
+		pending:      pending,
+		opts:         o,
+		isFull:       atomic.NewBool(true),
+		log:          logging.FromContext(ctx).With("bufferWriter", name).With("topic", topic).With("partitionIdx", partitionIdx),
+	}
+	go result.runStatusChecker(ctx)
+	return result
+}
+
+func (kw *kafkaWriter) runStatusChecker(ctx context.Context) {
+	labels := map[string]string{"buffer": kw.GetName()}
+	checkStatus := func() {
+		pending, err := kw.pending()
+		if err != nil {
+			isbFullErrors.With(labels).Inc()
+			kw.log.Errorw("Failed to get the pending messages in the writer", zap.Error(err))
+			return
+		}
+		usage := float64(pending) / float64(kw.opts.maxLength)
+		if usage >= kw.opts.bufferUsageLimit {
+			kw.log.Infow("Usage is greater than bufferUsageLimit", zap.Float64("usage", usage))
+			kw.isFull.Store(true)
+		} else {
+			kw.isFull.Store(false)
+		}
+		isbUsage.With(labels).Set(usage)
+		isbPending.With(labels).Set(float64(pending))
+		kw.log.Debugw("Consumption information", zap.Int64("pending", pending), zap.Float64("usage", usage))
+	}
+	checkStatus()
+
+	ticker := time.NewTicker(kw.opts.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			checkStatus()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (kw *kafkaWriter) GetName() string {
+	return kw.name
+}
+
+func (kw *kafkaWriter) GetPartitionIdx() int32 {
+	return kw.partitionIdx
+}
+
+// Close closes the producer, the client is closed by the caller.
+func (kw *kafkaWriter) Close() error {
+	return kw.producer.Close()
+}
+
+func (kw *kafkaWriter) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	labels := map[string]string{"buffer": kw.GetName()}
+	var errs = make([]error, len(messages))
+	if kw.isFull.Load() {
+		kw.log.Debugw("Is full")
+		isbFull.With(labels).Inc()
+		// when buffer is full, we need to decide whether to discard the message or not.
+		switch kw.opts.bufferFullWritingStrategy {
+		case v1alpha1.DiscardLatest:
+			// user explicitly wants to discard the message when buffer if full.
+			// return no retryable error as a callback to let caller know that the message is discarded.
+			for i := 0; i < len(errs); i++ {
+				errs[i] = isb.NonRetryableBufferWriteErr{Name: kw.name, Message: isb.BufferFullMessage}
+			}
+		default:
+			// Default behavior is to return a BufferWriteErr.
+			for i := 0; i < len(errs); i++ {
+				errs[i] = isb.BufferWriteErr{Name: kw.name, Full: true, Message: isb.BufferFullMessage}
+			}
+		}
+		isbWriteErrors.With(labels).Inc()
+		return nil, errs
+	}
+
+	var writeOffsets = make([]isb.Offset, len(messages))
+	// the indexes of the messages sent, the messages failed to be marshaled are not sent
+	var sent []int
+	var producerMsgs []*sarama.ProducerMessage
+	for i, message := range messages {
+		payload, err := message.MarshalBinary()
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		sent = append(sent, i)
+		producerMsgs = append(producerMsgs, &sarama.ProducerMessage{
+			Topic: kw.topic,
+			// the messages are spread over the partitions of the topic by their IDs
+			Key:   sarama.StringEncoder(message.Header.ID.String()),
+			Value: sarama.ByteEncoder(payload),
+		})
+	}
+	if len(producerMsgs) == 0 {
+		return writeOffsets, errs
+	}
+	err := kw.producer.SendMessages(producerMsgs)
+	// the producer errors tell the failed messages, the others are sent even if the error is not nil
+	failed := make(map[*sarama.ProducerMessage]error)
+	if err != nil {
+		var producerErrs sarama.ProducerErrors
+		if errors.As(err, &producerErrs) {
+			for _, pe := range producerErrs {
+				failed[pe.Msg] = pe.Err
+			}
+		} else {
+			for _, msg := range producerMsgs {
+				failed[msg] = err
+			}
+		}
+	}
+	for j, msg := range producerMsgs {
+		idx := sent[j]
+		if err, ok := failed[msg]; ok {
+			errs[idx] = err
+			isbWriteErrors.With(labels).Inc()
+			continue
+		}
+		writeOffsets[idx] = &writeOffset{partition: msg.Partition, offset: msg.Offset, partitionIdx: kw.partitionIdx}
+	}
+	return writeOffsets, errs
+}
+
+// writeOffset is the offset of the location in the Kafka topic we wrote to.
+type writeOffset struct {
+	partition    int32
+	offset       int64
+	partitionIdx int32
+}
+
+func (w *writeOffset) String() string {
+	return fmt.Sprintf("%d-%d", w.partition, w.offset)
+}
+
+func (w *writeOffset) Sequence() (int64, error) {
+	return w.offset, nil
+}
+
+func (w *writeOffset) AckIt() error {
+	return fmt.Errorf("not supported")
+}
+
+func (w *writeOffset) NoAck() error {
+	return fmt.Errorf("not supported")
+}
+
+func (w *writeOffset) PartitionIdx() int32 {
+	return w.partitionIdx
+}
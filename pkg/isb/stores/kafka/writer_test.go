@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestKafkaWriter_Write(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	producer := mocks.NewSyncProducer(t, nil)
+	messages := testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0), nil, "testVertex")
+	for range messages {
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
+			var m isb.Message
+			return m.UnmarshalBinary(val)
+		})
+	}
+	w := newKafkaWriter(ctx, "test-buffer", "test-topic", 1, producer, func() (int64, error) { return 0, nil }, defaultWriteOptions())
+	require.Eventually(t, func() bool { return !w.isFull.Load() }, 5*time.Second, 10*time.Millisecond)
+
+	offsets, errs := w.Write(ctx, messages)
+	require.Len(t, offsets, 3)
+	for i, err := range errs {
+		assert.NoError(t, err)
+		seq, _ := offsets[i].Sequence()
+		assert.Equal(t, int64(i+1), seq)
+		assert.Equal(t, int32(1), offsets[i].PartitionIdx())
+	}
+	assert.NoError(t, w.Close())
+}
+
+func TestKafkaWriter_WriteFailed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	producer := mocks.NewSyncProducer(t, nil)
+	producer.ExpectSendMessageAndFail(sarama.ErrNotLeaderForPartition)
+	w := newKafkaWriter(ctx, "test-buffer", "test-topic", 0, producer, func() (int64, error) { return 0, nil }, defaultWriteOptions())
+	require.Eventually(t, func() bool { return !w.isFull.Load() }, 5*time.Second, 10*time.Millisecond)
+
+	offsets, errs := w.Write(ctx, testutils.BuildTestWriteMessages(1, time.Unix(1636470000, 0), nil, "testVertex"))
+	assert.Nil(t, offsets[0])
+	assert.True(t, errors.Is(errs[0], sarama.ErrNotLeaderForPartition))
+	assert.NoError(t, w.Close())
+}
+
+func TestKafkaWriter_BufferFull(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy dfv1.BufferFullWritingStrategy
+		check    func(t *testing.T, err error)
+	}{
+		{
+			name:     "retry until success",
+			strategy: dfv1.RetryUntilSuccess,
+			check: func(t *testing.T, err error) {
+				var e isb.BufferWriteErr
+				require.True(t, errors.As(err, &e))
+				assert.True(t, e.Full)
+			},
+		},
+		{
+			name:     "discard latest",
+			strategy: dfv1.DiscardLatest,
+			check: func(t *testing.T, err error) {
+				var e isb.NonRetryableBufferWriteErr
+				require.True(t, errors.As(err, &e))
+				assert.Equal(t, isb.BufferFullMessage, e.Message)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			o := defaultWriteOptions()
+			o.maxLength = 10
+			o.bufferUsageLimit = 0.8
+			o.refreshInterval = 10 * time.Millisecond
+			o.bufferFullWritingStrategy = tt.strategy
+			pending := make(chan int64, 1)
+			pending <- 0
+			last := int64(0)
+			w := newKafkaWriter(ctx, "test-buffer", "test-topic", 0, mocks.NewSyncProducer(t, nil), func() (int64, error) {
+				select {
+				case last = <-pending:
+				default:
+				}
+				return last, nil
+			}, o)
+			require.Eventually(t, func() bool { return !w.isFull.Load() }, 5*time.Second, 10*time.Millisecond)
+			pending <- 8
+			require.Eventually(t, func() bool { return w.isFull.Load() }, 5*time.Second, 10*time.Millisecond)
+
+			offsets, errs := w.Write(ctx, testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "testVertex"))
+			assert.Nil(t, offsets)
+			require.Len(t, errs, 2)
+			for _, err := range errs {
+				tt.check(t, err)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
@@ -36,6 +37,8 @@ import (
 type BufferRead struct {
 	*redisclient.RedisStreamsRead
 	*BufferReadInfo
+	// ackMetricLabels are the labels of the vertex ack metrics, nil if the reader is not created by a vertex
+	ackMetricLabels map[string]string
 }
 
 // BufferReadInfo will contain the buffer information from the reader point of view.
@@ -44,6 +47,8 @@ type BufferReadInfo struct {
 	isEmpty           bool
 	lag               *atomic.Duration
 	refreshEmptyError *atomic.Uint32
+	// paused is whether the buffer is paused, the reader does not read new messages while it's paused
+	paused *atomic.Bool
 }
 
 type redisOffset struct {
@@ -115,6 +120,7 @@ func NewBufferRead(ctx context.Context, client *redisclient.RedisClient, name st
 			isEmpty:           true,
 			lag:               atomic.NewDuration(0),
 			refreshEmptyError: atomic.NewUint32(0),
+			paused:            atomic.NewBool(false),
 		},
 		// checkBackLog is set to true as on start up we need to start from the beginning
 	}
@@ -153,9 +159,14 @@ func NewBufferRead(ctx context.Context, client *redisclient.RedisClient, name st
 		return messages, nil
 	}
 
+	if options.VertexInstance != nil {
+		rqr.ackMetricLabels = metrics.ISBAckLabels(options.VertexInstance, name)
+	}
+
 	rqr.Log = logging.FromContext(ctx).With("BufferReader", rqr.GetName())
 	// updateIsEmptyFlag is used to update isEmpty flag once
 	rqr.updateIsEmptyFlag(ctx)
+	rqr.updatePausedFlag()
 
 	// refresh IsEmpty Flag  at a periodic interval
 	go rqr.refreshIsEmptyFlag(ctx)
@@ -183,8 +194,50 @@ func (br *BufferRead) refreshIsEmptyFlag(ctx context.Context) {
 			return
 		case <-ticker.C:
 			br.updateIsEmptyFlag(ctx)
+			br.updatePausedFlag()
+		}
+	}
+}
+
+// updatePausedFlag checks whether the buffer is paused, the last known state is kept if the check fails.
+func (br *BufferRead) updatePausedFlag() {
+	n, err := br.Client.Exists(redisclient.RedisContext, redisclient.GetRedisPausedKeyName(br.GetName())).Result()
+	if err != nil {
+		br.Log.Warnw("Failed to check the pause of the buffer", zap.Error(err))
+		return
+	}
+	if paused := n > 0; br.BufferReadInfo.paused.Swap(paused) != paused {
+		br.Log.Infow("The pause of the buffer is changed", zap.Bool("paused", paused))
+	}
+}
+
+// Read reads the messages from the stream, no message is read while the buffer is paused.
+func (br *BufferRead) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	if br.BufferReadInfo.paused.Load() {
+		// the read times out as if the buffer is empty
+		select {
+		case <-ctx.Done():
+		case <-time.After(br.Options.ReadTimeOut):
+		}
+		return []*isb.ReadMessage{}, nil
+	}
+	return br.RedisStreamsRead.Read(ctx, count)
+}
+
+// Ack acknowledges the offsets, the latency of the call and the failed offsets are recorded in the vertex ack metrics.
+func (br *BufferRead) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	start := time.Now()
+	errs := br.RedisStreamsRead.Ack(ctx, offsets)
+	if br.ackMetricLabels != nil {
+		failed := 0
+		for _, err := range errs {
+			if err != nil {
+				failed++
+			}
 		}
+		metrics.ObserveISBAck(br.ackMetricLabels, start, failed)
 	}
+	return errs
 }
 
 func (br *BufferRead) Close() error {
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/montanaflynn/stats"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -34,6 +35,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/udf/forward"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
@@ -82,6 +84,88 @@ func TestRedisQRead_Read(t *testing.T) {
 	assert.Len(t, readMessages, int(count))
 }
 
+func TestRedisQRead_Paused(t *testing.T) {
+	ctx := context.Background()
+	client := redisclient.NewRedisClient(redisOptions)
+	stream := "pausedstream"
+	group := "pausedgroup"
+
+	rqr, _ := NewBufferRead(ctx, client, stream, group, "con-0", defaultPartitionIdx, redisclient.WithReadTimeOut(10*time.Millisecond)).(*BufferRead)
+	err := client.CreateStreamGroup(ctx, rqr.GetStreamName(), group, redisclient.ReadFromEarliest)
+	assert.NoError(t, err)
+
+	defer func() { _ = client.DeleteStreamGroup(ctx, rqr.GetStreamName(), group) }()
+	defer func() { _ = client.DeleteKeys(ctx, rqr.GetStreamName(), redisclient.GetRedisPausedKeyName(stream)) }()
+
+	for _, msg := range testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "testVertex") {
+		err := client.Client.XAdd(ctx, &redis.XAddArgs{
+			Stream: rqr.GetStreamName(),
+			Values: []interface{}{msg.Header, msg.Body.Payload},
+		}).Err()
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, client.Client.Set(ctx, redisclient.GetRedisPausedKeyName(stream), "true", 0).Err())
+	rqr.updatePausedFlag()
+	readMessages, err := rqr.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, readMessages)
+
+	assert.NoError(t, client.Client.Del(ctx, redisclient.GetRedisPausedKeyName(stream)).Err())
+	rqr.updatePausedFlag()
+	readMessages, err = rqr.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+}
+
+func TestRedisQRead_AckMetrics(t *testing.T) {
+	ctx := context.Background()
+	client := redisclient.NewRedisClient(redisOptions)
+	stream := "ackmetricsstream"
+	group := "ackmetricsgroup"
+	consumer := "con-0"
+
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "ack-metrics-pl",
+			AbstractVertex: dfv1.AbstractVertex{Name: "ack-metrics-vertex", UDF: &dfv1.UDF{}},
+		}},
+	}
+	count := int64(10)
+	rqr, _ := NewBufferRead(ctx, client, stream, group, consumer, defaultPartitionIdx, redisclient.WithVertexInstance(vi)).(*BufferRead)
+	err := client.CreateStreamGroup(ctx, rqr.GetStreamName(), group, redisclient.ReadFromEarliest)
+	assert.NoError(t, err)
+
+	defer func() { _ = client.DeleteStreamGroup(ctx, rqr.GetStreamName(), group) }()
+	defer func() { _ = client.DeleteKeys(ctx, rqr.GetStreamName()) }()
+
+	messages := testutils.BuildTestWriteMessages(count, testStartTime, nil, "testVertex")
+	for _, msg := range messages {
+		err := client.Client.XAdd(ctx, &redis.XAddArgs{
+			Stream: rqr.GetStreamName(),
+			Values: []interface{}{msg.Header, msg.Body.Payload},
+		}).Err()
+		assert.NoError(t, err)
+	}
+
+	readMessages, err := rqr.Read(ctx, count)
+	assert.NoError(t, err)
+	offsets := make([]isb.Offset, len(readMessages))
+	for idx, m := range readMessages {
+		offsets[idx] = m.ReadOffset
+	}
+	for _, e := range rqr.Ack(ctx, offsets) {
+		assert.NoError(t, e)
+	}
+
+	details, err := metrics.NewHealthDetails(vi.Vertex, prometheus.DefaultGatherer, nil)
+	assert.NoError(t, err)
+	assert.Len(t, details.Partitions, 1)
+	assert.Equal(t, stream, details.Partitions[0].Partition)
+	assert.Equal(t, uint64(1), details.Partitions[0].AckCalls)
+	assert.Equal(t, uint64(0), details.Partitions[0].AckFailures)
+}
+
 func TestRedisCheckBacklog(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -61,6 +61,8 @@ type BufferWriteInfo struct {
 	pendingCount     *atomic.Int64
 	// hasUnprocessedData indicates if there is any unprocessed data left in the buffer
 	hasUnprocessedData *atomic.Bool
+	// retentionMaxMessages is the max messages of the buffer retention when new messages are discarded, 0 if none
+	retentionMaxMessages *atomic.Int64
 }
 
 var _ isb.BufferWriter = (*BufferWrite)(nil)
@@ -93,9 +95,10 @@ func NewBufferWrite(ctx context.Context, client *redisclient.RedisClient, name s
 			consumerLag:      atomic.NewDuration(0),
 			minId:            atomic.NewString("0-0"),
 			// During start up if we set pending count to 0 we are saying nothing is pending.
-			pendingCount:       atomic.NewInt64(options.MaxLength),
-			bufferLength:       atomic.NewInt64(options.MaxLength),
-			hasUnprocessedData: atomic.NewBool(true),
+			pendingCount:         atomic.NewInt64(options.MaxLength),
+			bufferLength:         atomic.NewInt64(options.MaxLength),
+			hasUnprocessedData:   atomic.NewBool(true),
+			retentionMaxMessages: atomic.NewInt64(0),
 		},
 		RedisClient: client,
 	}
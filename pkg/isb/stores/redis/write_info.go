@@ -26,16 +26,108 @@ import (
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 )
 
-// setWriteInfo is used to update the values of isFull flag and MINID
+// setWriteInfo is used to enforce the retention and update the values of isFull flag and MINID
 func (bw *BufferWrite) setWriteInfo(_ context.Context) {
 	ctx := redisclient.RedisContext
+	bw.applyRetention(ctx)
 	bw.updateIsFullAndLag(ctx)
 	bw.updateMinId(ctx)
 }
 
+// applyRetention enforces the retention of the buffer stored next to the stream, see
+// redisclient.GetRedisRetentionKeyName. The entries older than the max age are trimmed, so are the oldest entries over
+// the max messages if the discard policy is old, otherwise the buffer is full once it has the max messages.
+func (bw *BufferWrite) applyRetention(ctx context.Context) {
+	fields, err := bw.Client.HGetAll(ctx, redisclient.GetRedisRetentionKeyName(bw.GetName())).Result()
+	if err != nil {
+		bw.setError("HGetAll error in applyRetention", err)
+		return
+	}
+	var maxAge, maxMessages int64
+	if v, ok := fields[redisclient.RetentionFieldMaxAge]; ok {
+		if maxAge, err = strconv.ParseInt(v, 10, 64); err != nil {
+			bw.setError("Invalid max age of the retention", err)
+			return
+		}
+	}
+	if v, ok := fields[redisclient.RetentionFieldMaxMessages]; ok {
+		if maxMessages, err = strconv.ParseInt(v, 10, 64); err != nil {
+			bw.setError("Invalid max messages of the retention", err)
+			return
+		}
+	}
+	discardOld := fields[redisclient.RetentionFieldDiscard] == string(dfv1.BufferDiscardOld)
+	if discardOld {
+		bw.retentionMaxMessages.Store(0)
+	} else {
+		bw.retentionMaxMessages.Store(maxMessages)
+	}
+
+	var trimmed int64
+	if maxAge > 0 {
+		// an entry ID without the sequence part is the first entry ID of the millisecond
+		minId := strconv.FormatInt(time.Now().UnixMilli()-maxAge, 10)
+		n, err := bw.Client.XTrimMinID(ctx, bw.GetStreamName(), minId).Result()
+		if err != nil {
+			bw.setError("XTRIM MINID error in applyRetention", err)
+			return
+		}
+		trimmed += n
+	}
+	if discardOld && maxMessages > 0 {
+		n, err := bw.Client.XTrimMaxLen(ctx, bw.GetStreamName(), maxMessages).Result()
+		if err != nil {
+			bw.setError("XTRIM MAXLEN error in applyRetention", err)
+			return
+		}
+		trimmed += n
+	}
+	if trimmed > 0 {
+		bw.log.Infow("Trimmed the entries out of the retention", zap.Int64("trimmed", trimmed))
+		bw.ackTrimmed(ctx)
+	}
+}
+
+// ackTrimmed acknowledges the pending entries of the group which are trimmed, i.e. older than the oldest entry left,
+// so that they are not delivered again.
+func (bw *BufferWrite) ackTrimmed(ctx context.Context) {
+	oldest, err := bw.Client.XRangeN(ctx, bw.GetStreamName(), "-", "+", 1).Result()
+	if err != nil {
+		bw.setError("XRANGE error in ackTrimmed", err)
+		return
+	}
+	const pageSize = 100
+	start := "-"
+	for {
+		pendings, err := bw.Client.XPendingExt(ctx, &redis.XPendingExtArgs{Stream: bw.GetStreamName(), Group: bw.GetGroupName(), Start: start, End: "+", Count: pageSize}).Result()
+		if err != nil {
+			bw.setError("XPENDING error in ackTrimmed", err)
+			return
+		}
+		var ids []string
+		for _, p := range pendings {
+			if len(oldest) > 0 && !idLess(p.ID, oldest[0].ID) {
+				break
+			}
+			ids = append(ids, p.ID)
+		}
+		if len(ids) > 0 {
+			if err := bw.Client.XAck(ctx, bw.GetStreamName(), bw.GetGroupName(), ids...).Err(); err != nil {
+				bw.setError("XACK error in ackTrimmed", err)
+				return
+			}
+		}
+		if len(ids) < pageSize {
+			return
+		}
+		start = "(" + ids[len(ids)-1]
+	}
+}
+
 // updateIsFullAndLag is used to update the isFull flag using both the buffer usage and the consumer consumerLag.
 func (bw *BufferWrite) updateIsFullAndLag(ctx context.Context) {
 	labels := map[string]string{"buffer": bw.GetName()}
@@ -93,6 +185,12 @@ func (bw *BufferWrite) updateIsFullAndLag(ctx context.Context) {
 		return
 	}
 
+	if maxMessages := bw.retentionMaxMessages.Load(); maxMessages > 0 && bw.GetBufferLength() >= maxMessages {
+		bw.log.Infow("length reaches the max messages of the retention", zap.Int64("maxMessages", maxMessages))
+		bw.setIsFull(true)
+		return
+	}
+
 	bw.setIsFull(false)
 
 }
@@ -351,6 +449,19 @@ func (bw *BufferWrite) setError(errMsg string, err error) {
 	bw.BufferWriteInfo.refreshFullError.Inc()
 }
 
+// idLess returns true if the entry ID a is lower than the entry ID b, a missing sequence part is 0.
+func idLess(a, b string) bool {
+	parse := func(id string) (uint64, uint64) {
+		msPart, seqPart, _ := strings.Cut(id, "-")
+		ms, _ := strconv.ParseUint(msPart, 10, 64)
+		seq, _ := strconv.ParseUint(seqPart, 10, 64)
+		return ms, seq
+	}
+	aMs, aSeq := parse(a)
+	bMs, bSeq := parse(b)
+	return aMs < bMs || (aMs == bMs && aSeq < bSeq)
+}
+
 // splitId is used to split the Id obtained from redis.
 // An id is of the format "1434-0", where the first part is the timestamp.
 // Today we care only about the timestamp and compare just that.
@@ -43,6 +43,13 @@ type InMemoryBuffer struct {
 	partitionIdx int32
 	options      *options
 	rwlock       *sync.RWMutex
+	faults       *faultInjector
+	// writeSeq is the sequence of the last message written
+	writeSeq int64
+	// growTo is the size the buffer grows to when the write index reaches the end of the ring
+	growTo int64
+	// paused is whether the reading is paused
+	paused bool
 }
 
 var _ isb.BufferReader = (*InMemoryBuffer)(nil)
@@ -54,6 +61,9 @@ type elem struct {
 	dirty   bool
 	ack     bool
 	pending bool
+	// seq is the sequence of the message in the buffer, and writeTime is when it's written
+	seq       int64
+	writeTime time.Time
 }
 
 // NewInMemoryBuffer returns a new buffer.
@@ -78,6 +88,9 @@ func NewInMemoryBuffer(name string, size int64, partition int32, opts ...Option)
 		rwlock:       new(sync.RWMutex),
 		options:      bufferOptions,
 	}
+	if bufferOptions.faultPlan != nil {
+		sb.faults = newFaultInjector(name, *bufferOptions.faultPlan)
+	}
 	return sb
 }
 
@@ -102,9 +115,87 @@ func (b *InMemoryBuffer) GetPartitionIdx() int32 {
 	return b.partitionIdx
 }
 
+// Pending returns the number of messages not read yet.
 func (b *InMemoryBuffer) Pending(_ context.Context) (int64, error) {
-	// TODO: not implemented
-	return isb.PendingNotAvailable, nil
+	pending, _ := b.Counts()
+	return pending, nil
+}
+
+// Counts returns the number of messages not read yet, and the number of messages read but not acknowledged yet.
+func (b *InMemoryBuffer) Counts() (pending int64, ackPending int64) {
+	b.rwlock.RLock()
+	defer b.rwlock.RUnlock()
+	for _, e := range b.buffer {
+		switch {
+		case e.pending:
+			ackPending++
+		case e.dirty:
+			pending++
+		}
+	}
+	return pending, ackPending
+}
+
+// Size returns the max number of messages the buffer holds.
+func (b *InMemoryBuffer) Size() int64 {
+	b.rwlock.RLock()
+	defer b.rwlock.RUnlock()
+	return b.size
+}
+
+// Resize increases the max number of messages the buffer holds. The offsets are the indexes of the slots of the ring,
+// so the ring is only extended when the slots after the write index are empty, otherwise it's extended when the write
+// index reaches the end of the ring, the buffer keeps its size until then.
+func (b *InMemoryBuffer) Resize(size int64) error {
+	b.rwlock.Lock()
+	defer b.rwlock.Unlock()
+	if size < b.size {
+		return fmt.Errorf("failed to resize buffer %q to %d, the size %d can not be decreased", b.name, size, b.size)
+	}
+	if size == b.size {
+		return nil
+	}
+	b.growTo = size
+	// the messages not read yet must not wrap around the ring, or the reader would reach the slots appended first
+	if b.readIdx > b.writeIdx {
+		return nil
+	}
+	for i := b.writeIdx; i < b.size; i++ {
+		if b.buffer[i].dirty {
+			return nil
+		}
+	}
+	b.grow()
+	return nil
+}
+
+// grow appends the slots to the ring, the caller holds the lock.
+func (b *InMemoryBuffer) grow() {
+	b.buffer = append(b.buffer, make([]elem, b.growTo-b.size)...)
+	b.size = b.growTo
+	b.growTo = 0
+}
+
+// Pause pauses the reading of the buffer, the reads return no message until it's resumed, the messages read already
+// can still be acknowledged.
+func (b *InMemoryBuffer) Pause() {
+	b.rwlock.Lock()
+	defer b.rwlock.Unlock()
+	b.paused = true
+}
+
+// Resume resumes the reading of the buffer.
+func (b *InMemoryBuffer) Resume() {
+	b.rwlock.Lock()
+	defer b.rwlock.Unlock()
+	b.paused = false
+}
+
+// IsPaused returns whether the reading of the buffer is paused.
+func (b *InMemoryBuffer) IsPaused() bool {
+	b.rwlock.RLock()
+	defer b.rwlock.RUnlock()
+	return b.paused
 }
 
 // Close does nothing.
@@ -126,10 +217,33 @@ func (b *InMemoryBuffer) IsEmpty() bool {
 	return b.buffer[b.readIdx].pending || !b.buffer[b.readIdx].dirty
 }
 
+// IsDrained returns whether all the messages written to the queue are acknowledged.
+func (b *InMemoryBuffer) IsDrained() bool {
+	b.rwlock.RLock()
+	defer b.rwlock.RUnlock()
+	for _, e := range b.buffer {
+		if e.dirty {
+			return false
+		}
+	}
+	return true
+}
+
 func (b *InMemoryBuffer) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
 	var errs = make([]error, len(messages))
 	writeOffsets := make([]isb.Offset, len(messages))
+	faults := b.faultInjector()
 	for idx, message := range messages {
+		if faults != nil {
+			switch faults.nextWriteFault() {
+			case FaultWriteFailure:
+				errs[idx] = isb.BufferWriteErr{Name: b.name, Message: "injected write failure"}
+				continue
+			case FaultBufferFull:
+				errs[idx] = isb.BufferWriteErr{Name: b.name, Full: true, Message: isb.BufferFullMessage}
+				continue
+			}
+		}
 		if !b.IsFull() {
 			var err error
 
@@ -142,7 +256,15 @@ func (b *InMemoryBuffer) Write(_ context.Context, messages []isb.Message) ([]isb
 			}
 			errs[idx] = nil
 			b.buffer[currentIdx].dirty = true
+			b.writeSeq++
+			b.buffer[currentIdx].seq = b.writeSeq
+			b.buffer[currentIdx].writeTime = time.Now()
 			b.writeIdx = (currentIdx + 1) % b.size
+			if currentIdx == b.size-1 && b.growTo > b.size {
+				// the slots appended follow the last slot in the ring order, the next write goes to them
+				b.grow()
+				b.writeIdx = currentIdx + 1
+			}
 			writeOffsets[idx] = isb.NewSimpleIntPartitionOffset(currentIdx, b.partitionIdx)
 			// access buffer via lock
 			b.rwlock.Unlock()
@@ -160,9 +282,9 @@ func (b *InMemoryBuffer) Write(_ context.Context, messages []isb.Message) ([]isb
 
 func (b *InMemoryBuffer) blockIfEmpty(ctx context.Context) error {
 	var err error
-	// block if isEmpty
+	// block if isEmpty, or the reading is paused
 	for {
-		if !b.IsEmpty() {
+		if !b.IsEmpty() && !b.IsPaused() {
 			break
 		} else {
 			select {
@@ -179,6 +301,15 @@ func (b *InMemoryBuffer) blockIfEmpty(ctx context.Context) error {
 
 func (b *InMemoryBuffer) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
 	var readMessages = make([]*isb.ReadMessage, 0, count)
+	if faults := b.faultInjector(); faults != nil {
+		if delay := faults.nextReadDelay(); delay > 0 {
+			select {
+			case <-ctx.Done():
+				return readMessages, nil
+			case <-time.After(delay):
+			}
+		}
+	}
 	cctx, cancel := context.WithTimeout(ctx, b.options.readTimeOut)
 	defer cancel()
 	for i := int64(0); i < count; i++ {
@@ -233,20 +364,26 @@ func buildMessage(payload []byte) (msg isb.Message, err error) {
 // Ack acknowledges the given offsets
 func (b *InMemoryBuffer) Ack(_ context.Context, offsets []isb.Offset) []error {
 	errs := make([]error, len(offsets))
+	faults := b.faultInjector()
 	for index, offset := range offsets {
 		intOffset, err := strconv.Atoi(strings.Split(offset.String(), "-")[0])
 		if err != nil {
 			errs[index] = isb.MessageAckErr{Name: b.name, Message: err.Error(), Offset: isb.Offset(offset)}
 			continue
 		}
-		if int64(intOffset) >= b.size {
+		// the size is read with the lock, the buffer might be resized
+		if size := b.Size(); int64(intOffset) >= size {
 			errs[index] = isb.MessageAckErr{
 				Name:    b.name,
-				Message: fmt.Sprintf("given index (%d) >= size of the buffer (%d)", intOffset, b.size),
+				Message: fmt.Sprintf("given index (%d) >= size of the buffer (%d)", intOffset, size),
 				Offset:  offset,
 			}
 			continue
 		}
+		if faults != nil && faults.nextAckFault() == FaultAckDrop {
+			// the ack is reported as successful, but the message stays pending.
+			continue
+		}
 
 		b.rwlock.Lock()
 		b.buffer[intOffset].ack = true
@@ -272,3 +409,77 @@ func (b *InMemoryBuffer) GetMessages(num int) []*isb.Message {
 	}
 	return msgs
 }
+
+// BufferedMessage is a message in the buffer not acknowledged yet.
+type BufferedMessage struct {
+	// Sequence is the sequence of the message in the buffer, the first message written is 1.
+	Sequence int64
+	// WriteTime is when the message is written.
+	WriteTime time.Time
+	// Read is true if the message is read but not acknowledged yet.
+	Read bool
+	isb.Message
+}
+
+// Messages returns the messages in the buffer not acknowledged yet, in the order they are written.
+func (b *InMemoryBuffer) Messages() ([]BufferedMessage, error) {
+	b.rwlock.RLock()
+	defer b.rwlock.RUnlock()
+	var result []BufferedMessage
+	// the slot at the write index is the oldest one, the slots are written in the ring order
+	for i := int64(0); i < b.size; i++ {
+		e := b.buffer[(b.writeIdx+i)%b.size]
+		if !e.dirty {
+			continue
+		}
+		msg, err := buildMessage(e.payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode message %d of buffer %q, %w", e.seq, b.name, err)
+		}
+		result = append(result, BufferedMessage{Sequence: e.seq, WriteTime: e.writeTime, Read: e.pending, Message: msg})
+	}
+	return result, nil
+}
+
+// Purge drops the oldest messages not read yet, the ones written at or after the time, if it's not zero, and the
+// keepRecent most recent ones are kept. The messages read are left to be acknowledged by the readers. It returns the
+// number of messages dropped.
+func (b *InMemoryBuffer) Purge(before time.Time, keepRecent int64) int64 {
+	b.rwlock.Lock()
+	defer b.rwlock.Unlock()
+	var unread int64
+	for _, e := range b.buffer {
+		if e.dirty && !e.pending {
+			unread++
+		}
+	}
+	var dropped int64
+	// the messages not read yet are the ones from the read index up to the write index
+	for dropped < unread-keepRecent {
+		e := &b.buffer[b.readIdx]
+		if !before.IsZero() && !e.writeTime.Before(before) {
+			break
+		}
+		*e = elem{}
+		b.readIdx = (b.readIdx + 1) % b.size
+		dropped++
+	}
+	return dropped
+}
+
+// PurgeUpTo drops the messages not read yet with the sequences up to, and including, the sequence, and returns the
+// number of the dropped messages.
+func (b *InMemoryBuffer) PurgeUpTo(seq int64) int64 {
+	b.rwlock.Lock()
+	defer b.rwlock.Unlock()
+	var dropped int64
+	for {
+		e := &b.buffer[b.readIdx]
+		if !e.dirty || e.pending || e.seq > seq {
+			return dropped
+		}
+		*e = elem{}
+		b.readIdx = (b.readIdx + 1) % b.size
+		dropped++
+	}
+}
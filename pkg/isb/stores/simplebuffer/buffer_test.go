@@ -80,6 +80,27 @@ func TestNewSimpleBuffer(t *testing.T) {
 	assert.Equal(t, true, sb.IsFull())
 }
 
+func TestInMemoryBuffer_IsDrained(t *testing.T) {
+	sb := NewInMemoryBuffer("test", 10, 0)
+	ctx := context.Background()
+	assert.True(t, sb.IsDrained())
+
+	writeMessages := testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0), nil, "testVertex")
+	sb.Write(ctx, writeMessages)
+	assert.False(t, sb.IsDrained())
+
+	readMessages, err := sb.Read(ctx, 3)
+	assert.NoError(t, err)
+	// read but not acked yet
+	assert.True(t, sb.IsEmpty())
+	assert.False(t, sb.IsDrained())
+
+	sb.Ack(ctx, []isb.Offset{readMessages[0].ReadOffset, readMessages[1].ReadOffset})
+	assert.False(t, sb.IsDrained())
+	sb.Ack(ctx, []isb.Offset{readMessages[2].ReadOffset})
+	assert.True(t, sb.IsDrained())
+}
+
 func TestNewSimpleBuffer_BufferFullWritingStrategyIsDiscard(t *testing.T) {
 	count := int64(3)
 	sb := NewInMemoryBuffer("test", 2, 0, WithBufferFullWritingStrategy(v1alpha1.DiscardLatest))
@@ -100,3 +121,135 @@ func TestNewSimpleBuffer_BufferFullWritingStrategyIsDiscard(t *testing.T) {
 	// still full as we did not ack
 	assert.Equal(t, true, sb.IsFull())
 }
+
+func TestInMemoryBuffer_MessagesAndPurge(t *testing.T) {
+	ctx := context.Background()
+	sb := NewInMemoryBuffer("test", 5, 0)
+	writeMessages := testutils.BuildTestWriteMessages(7, time.Unix(1636470000, 0), nil, "testVertex")
+	_, errs := sb.Write(ctx, writeMessages[:4])
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	firstRead, err := sb.Read(ctx, 2)
+	assert.NoError(t, err)
+	sb.Ack(ctx, []isb.Offset{firstRead[0].ReadOffset})
+	// the writes wrap around the ring
+	_, errs = sb.Write(ctx, writeMessages[4:6])
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	pending, ackPending := sb.Counts()
+	assert.Equal(t, int64(4), pending)
+	assert.Equal(t, int64(1), ackPending)
+	msgs, err := sb.Messages()
+	assert.NoError(t, err)
+	var seqs []int64
+	for _, m := range msgs {
+		seqs = append(seqs, m.Sequence)
+	}
+	assert.Equal(t, []int64{2, 3, 4, 5, 6}, seqs)
+	assert.True(t, msgs[0].Read)
+	assert.Equal(t, writeMessages[1].Header.ID, msgs[0].Header.ID)
+	assert.False(t, msgs[1].Read)
+
+	// the read message is left to the reader
+	assert.Equal(t, int64(2), sb.Purge(time.Time{}, 2))
+	pending, ackPending = sb.Counts()
+	assert.Equal(t, int64(2), pending)
+	assert.Equal(t, int64(1), ackPending)
+	assert.Equal(t, int64(0), sb.Purge(time.Now().Add(-time.Hour), 0))
+	assert.Equal(t, int64(2), sb.Purge(time.Now().Add(time.Hour), 0))
+
+	readMessages, err := sb.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, readMessages)
+	sb.Ack(ctx, []isb.Offset{firstRead[1].ReadOffset})
+	_, errs = sb.Write(ctx, writeMessages[6:])
+	assert.NoError(t, errs[0])
+	readMessages, err = sb.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, writeMessages[6].Header.ID, readMessages[0].Header.ID)
+}
+
+func TestInMemoryBuffer_Resize(t *testing.T) {
+	ctx := context.Background()
+	sb := NewInMemoryBuffer("test", 3, 0)
+	assert.NoError(t, sb.Resize(4))
+	assert.Equal(t, int64(4), sb.Size())
+	assert.Error(t, sb.Resize(2))
+
+	sb = NewInMemoryBuffer("test", 3, 0, WithBufferFullWritingStrategy(v1alpha1.DiscardLatest))
+	writeMessages := testutils.BuildTestWriteMessages(8, time.Unix(1636470000, 0), nil, "testVertex")
+	_, errs := sb.Write(ctx, writeMessages[:3])
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	readMessages, err := sb.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []error{nil}, sb.Ack(ctx, []isb.Offset{readMessages[0].ReadOffset}))
+
+	// the messages not read yet wrap around the ring, the buffer grows when the write index reaches the end
+	assert.NoError(t, sb.Resize(5))
+	assert.Equal(t, int64(3), sb.Size())
+	_, errs = sb.Write(ctx, writeMessages[3:5])
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+	readMessages, err = sb.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []error{nil, nil}, sb.Ack(ctx, []isb.Offset{readMessages[0].ReadOffset, readMessages[1].ReadOffset}))
+	_, errs = sb.Write(ctx, writeMessages[4:])
+	assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+	assert.Equal(t, int64(5), sb.Size())
+
+	readMessages, err = sb.Read(ctx, 5)
+	assert.NoError(t, err)
+	var ids []string
+	for _, m := range readMessages {
+		ids = append(ids, m.ID.String())
+	}
+	var expected []string
+	for _, m := range writeMessages[3:] {
+		expected = append(expected, m.ID.String())
+	}
+	assert.Equal(t, expected, ids)
+}
+
+func TestInMemoryBuffer_Pause(t *testing.T) {
+	ctx := context.Background()
+	sb := NewInMemoryBuffer("test", 5, 0, WithReadTimeOut(10*time.Millisecond))
+	_, errs := sb.Write(ctx, testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "testVertex"))
+	assert.Equal(t, []error{nil, nil}, errs)
+
+	sb.Pause()
+	assert.True(t, sb.IsPaused())
+	readMessages, err := sb.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, readMessages)
+
+	sb.Resume()
+	readMessages, err = sb.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+}
+
+func TestInMemoryBuffer_PurgeUpTo(t *testing.T) {
+	ctx := context.Background()
+	sb := NewInMemoryBuffer("test", 5, 0, WithReadTimeOut(10*time.Millisecond))
+	writeMessages := testutils.BuildTestWriteMessages(5, time.Unix(1636470000, 0), nil, "testVertex")
+	_, errs := sb.Write(ctx, writeMessages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	readMessages, err := sb.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 1)
+
+	// the read message is left to the reader, the purge stops after the sequence
+	assert.Equal(t, int64(2), sb.PurgeUpTo(3))
+	assert.Equal(t, int64(0), sb.PurgeUpTo(3))
+	pending, ackPending := sb.Counts()
+	assert.Equal(t, int64(2), pending)
+	assert.Equal(t, int64(1), ackPending)
+	readMessages, err = sb.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, writeMessages[3].Header.ID, readMessages[0].Header.ID)
+}
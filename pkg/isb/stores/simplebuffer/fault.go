@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simplebuffer
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// Fault is a kind of fault that can be injected into an InMemoryBuffer.
+type Fault string
+
+const (
+	// NoFault means the operation is executed normally.
+	NoFault Fault = ""
+	// FaultWriteFailure fails the write of a message with a retryable write error.
+	FaultWriteFailure Fault = "WriteFailure"
+	// FaultBufferFull rejects the write of a message as if the buffer is full.
+	FaultBufferFull Fault = "BufferFull"
+	// FaultAckDrop silently drops the ack of a message, the ack is reported as successful, but the message stays pending.
+	FaultAckDrop Fault = "AckDrop"
+	// FaultReadDelay delays a read call.
+	FaultReadDelay Fault = "ReadDelay"
+)
+
+// FaultPlan describes the faults to inject into an InMemoryBuffer, it is meant for resilience testing only.
+// Scripted schedules are consumed first, one entry per operation, after which the probabilities take over.
+type FaultPlan struct {
+	// WriteSchedule is the scripted fault for each message written, the entries can be NoFault, FaultWriteFailure or FaultBufferFull.
+	WriteSchedule []Fault
+	// AckSchedule is the scripted fault for each offset acked, the entries can be NoFault or FaultAckDrop.
+	AckSchedule []Fault
+	// ReadDelaySchedule is the scripted delay for each read call.
+	ReadDelaySchedule []time.Duration
+	// WriteFailureProbability is the probability in [0, 1] of failing a message write.
+	WriteFailureProbability float64
+	// BufferFullProbability is the probability in [0, 1] of rejecting a message write as buffer full.
+	BufferFullProbability float64
+	// AckDropProbability is the probability in [0, 1] of dropping an ack.
+	AckDropProbability float64
+	// ReadDelay is the delay added to every read call once the ReadDelaySchedule is consumed.
+	ReadDelay time.Duration
+	// Seed seeds the random number generator used for the probabilities, to make the faults reproducible.
+	Seed int64
+}
+
+// faultInjector injects the faults described by a FaultPlan.
+type faultInjector struct {
+	sync.Mutex
+	plan   FaultPlan
+	rand   *rand.Rand
+	counts map[Fault]int
+	logger *zap.SugaredLogger
+}
+
+func newFaultInjector(bufferName string, plan FaultPlan) *faultInjector {
+	return &faultInjector{
+		plan:   plan,
+		rand:   rand.New(rand.NewSource(plan.Seed)),
+		counts: make(map[Fault]int),
+		logger: logging.NewLogger().Named("fault-injection").With("buffer", bufferName),
+	}
+}
+
+// nextWriteFault returns the fault to inject for the next message write.
+func (f *faultInjector) nextWriteFault() Fault {
+	f.Lock()
+	defer f.Unlock()
+	fault := NoFault
+	if len(f.plan.WriteSchedule) > 0 {
+		fault, f.plan.WriteSchedule = f.plan.WriteSchedule[0], f.plan.WriteSchedule[1:]
+	} else if f.chance(f.plan.WriteFailureProbability) {
+		fault = FaultWriteFailure
+	} else if f.chance(f.plan.BufferFullProbability) {
+		fault = FaultBufferFull
+	}
+	f.record(fault)
+	return fault
+}
+
+// nextAckFault returns the fault to inject for the next offset ack.
+func (f *faultInjector) nextAckFault() Fault {
+	f.Lock()
+	defer f.Unlock()
+	fault := NoFault
+	if len(f.plan.AckSchedule) > 0 {
+		fault, f.plan.AckSchedule = f.plan.AckSchedule[0], f.plan.AckSchedule[1:]
+	} else if f.chance(f.plan.AckDropProbability) {
+		fault = FaultAckDrop
+	}
+	f.record(fault)
+	return fault
+}
+
+// nextReadDelay returns the delay to inject for the next read call.
+func (f *faultInjector) nextReadDelay() time.Duration {
+	f.Lock()
+	defer f.Unlock()
+	delay := f.plan.ReadDelay
+	if len(f.plan.ReadDelaySchedule) > 0 {
+		delay, f.plan.ReadDelaySchedule = f.plan.ReadDelaySchedule[0], f.plan.ReadDelaySchedule[1:]
+	}
+	if delay > 0 {
+		f.counts[FaultReadDelay]++
+		f.logger.Infow("Injected fault", zap.String("fault", string(FaultReadDelay)), zap.Duration("delay", delay))
+	}
+	return delay
+}
+
+func (f *faultInjector) chance(p float64) bool {
+	return p > 0 && f.rand.Float64() < p
+}
+
+func (f *faultInjector) record(fault Fault) {
+	if fault == NoFault {
+		return
+	}
+	f.counts[fault]++
+	f.logger.Infow("Injected fault", zap.String("fault", string(fault)))
+}
+
+func (f *faultInjector) injectedCount(fault Fault) int {
+	f.Lock()
+	defer f.Unlock()
+	return f.counts[fault]
+}
+
+// SetFaultPlan replaces the fault plan of the buffer at runtime, a nil plan stops injecting faults.
+func (b *InMemoryBuffer) SetFaultPlan(plan *FaultPlan) {
+	b.rwlock.Lock()
+	defer b.rwlock.Unlock()
+	if plan == nil {
+		b.faults = nil
+		return
+	}
+	b.faults = newFaultInjector(b.name, *plan)
+}
+
+// InjectedFaults returns the number of times the given fault has been injected since the current fault plan was set.
+func (b *InMemoryBuffer) InjectedFaults(fault Fault) int {
+	if f := b.faultInjector(); f != nil {
+		return f.injectedCount(fault)
+	}
+	return 0
+}
+
+func (b *InMemoryBuffer) faultInjector() *faultInjector {
+	b.rwlock.RLock()
+	defer b.rwlock.RUnlock()
+	return b.faults
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simplebuffer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestFaultPlan_WriteSchedule(t *testing.T) {
+	ctx := context.Background()
+	sb := NewInMemoryBuffer("test", 10, 0, WithFaultPlan(FaultPlan{
+		WriteSchedule: []Fault{NoFault, FaultWriteFailure, FaultBufferFull},
+	}))
+	writeMessages := testutils.BuildTestWriteMessages(4, time.Unix(1636470000, 0), nil, "testVertex")
+	_, errs := sb.Write(ctx, writeMessages)
+	assert.NoError(t, errs[0])
+	assert.Equal(t, isb.BufferWriteErr{Name: "test", Message: "injected write failure"}, errs[1])
+	assert.Equal(t, isb.BufferWriteErr{Name: "test", Full: true, Message: isb.BufferFullMessage}, errs[2])
+	// the schedule is consumed, no more faults.
+	assert.NoError(t, errs[3])
+	assert.Equal(t, int64(2), sb.writeIdx)
+	assert.Equal(t, 1, sb.InjectedFaults(FaultWriteFailure))
+	assert.Equal(t, 1, sb.InjectedFaults(FaultBufferFull))
+}
+
+func TestFaultPlan_Probabilities(t *testing.T) {
+	ctx := context.Background()
+	sb := NewInMemoryBuffer("test", 100, 0, WithFaultPlan(FaultPlan{WriteFailureProbability: 1}))
+	writeMessages := testutils.BuildTestWriteMessages(5, time.Unix(1636470000, 0), nil, "testVertex")
+	_, errs := sb.Write(ctx, writeMessages)
+	for _, err := range errs {
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 5, sb.InjectedFaults(FaultWriteFailure))
+
+	// faults can be switched off at runtime.
+	sb.SetFaultPlan(nil)
+	_, errs = sb.Write(ctx, writeMessages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 0, sb.InjectedFaults(FaultWriteFailure))
+
+	// the same seed injects the same faults.
+	plan := &FaultPlan{AckDropProbability: 0.5, Seed: 42}
+	sb.SetFaultPlan(plan)
+	readMessages, err := sb.Read(ctx, 5)
+	assert.NoError(t, err)
+	offsets := make([]isb.Offset, 0, len(readMessages))
+	for _, m := range readMessages {
+		offsets = append(offsets, m.ReadOffset)
+	}
+	sb.Ack(ctx, offsets)
+	dropped := sb.InjectedFaults(FaultAckDrop)
+	sb.SetFaultPlan(plan)
+	sb.Ack(ctx, offsets)
+	assert.Equal(t, dropped, sb.InjectedFaults(FaultAckDrop))
+}
+
+func TestFaultPlan_AckDropAndReadDelay(t *testing.T) {
+	ctx := context.Background()
+	sb := NewInMemoryBuffer("test", 2, 0, WithFaultPlan(FaultPlan{
+		AckSchedule:       []Fault{FaultAckDrop},
+		ReadDelaySchedule: []time.Duration{50 * time.Millisecond},
+	}))
+	writeMessages := testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "testVertex")
+	_, errs := sb.Write(ctx, writeMessages)
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.True(t, sb.IsFull())
+
+	start := time.Now()
+	readMessages, err := sb.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, 1, sb.InjectedFaults(FaultReadDelay))
+
+	errs = sb.Ack(ctx, []isb.Offset{readMessages[0].ReadOffset, readMessages[1].ReadOffset})
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	// the first ack is dropped, so the first slot is still occupied.
+	assert.True(t, sb.IsFull())
+	assert.True(t, sb.buffer[0].pending)
+	assert.False(t, sb.buffer[1].dirty)
+	assert.Equal(t, 1, sb.InjectedFaults(FaultAckDrop))
+}
@@ -28,6 +28,8 @@ type options struct {
 	readTimeOut time.Duration
 	// bufferFullWritingStrategy is the writing strategy when buffer is full
 	bufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// faultPlan is the plan of the faults to inject, for resilience testing only
+	faultPlan *FaultPlan
 }
 
 type Option func(options *options) error
@@ -47,3 +49,11 @@ func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) Option {
 		return nil
 	}
 }
+
+// WithFaultPlan sets the plan of the faults to inject into the buffer, it should only be used in resilience tests.
+func WithFaultPlan(plan FaultPlan) Option {
+	return func(o *options) error {
+		o.faultPlan = &plan
+		return nil
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// ConfigMismatchError is returned when a stream is not configured as its buffer config expects, e.g. the buffer
+// config is changed after the stream is created.
+type ConfigMismatchError struct {
+	Stream   string
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (e *ConfigMismatchError) Error() string {
+	return fmt.Sprintf("stream %q is configured with %s %s, not %s as the buffer config expects", e.Stream, e.Field, e.Actual, e.Expected)
+}
+
+// IsConfigMismatch returns true if the error is caused by a stream config not matching the buffer config.
+func IsConfigMismatch(err error) bool {
+	var e *ConfigMismatchError
+	return errors.As(err, &e)
+}
+
+// viper returns the config of the buffer, i.e. the buffer config merged on top of the config. It returns the config
+// if the buffer is empty or has no buffer config.
+func (o *createOptions) viper(buffer string) (*viper.Viper, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewBufferString(o.config)); err != nil {
+		return nil, err
+	}
+	if conf, ok := o.bufferConfigs[buffer]; ok && buffer != "" {
+		if err := v.MergeConfig(bytes.NewBufferString(conf)); err != nil {
+			return nil, fmt.Errorf("failed to merge the config of buffer %q, %w", buffer, err)
+		}
+	}
+	if r, ok := o.bufferRetentions[buffer]; ok && buffer != "" {
+		applyRetention(v, r)
+	}
+	return v, nil
+}
+
+// applyRetention sets the stream limits of the retention to the config, the discard policy is not a setting of the
+// config, see discardPolicy.
+func applyRetention(v *viper.Viper, r dfv1.BufferRetention) {
+	if r.MaxAge != nil {
+		v.Set("stream.maxAge", r.MaxAge.Duration)
+	}
+	if r.MaxMessages != nil {
+		v.Set("stream.maxMsgs", *r.MaxMessages)
+	}
+	if r.MaxBytes != nil {
+		v.Set("stream.maxBytes", *r.MaxBytes)
+	}
+}
+
+// discardPolicy returns the discard policy of the buffer retention, ok is false if the retention doesn't set one.
+func (o *createOptions) discardPolicy(buffer string) (policy nats.DiscardPolicy, ok bool) {
+	r, found := o.bufferRetentions[buffer]
+	if !found || r.Discard == nil {
+		return nats.DiscardOld, false
+	}
+	if *r.Discard == dfv1.BufferDiscardNew {
+		return nats.DiscardNew, true
+	}
+	return nats.DiscardOld, true
+}
+
+// configExpectation is a setting of a buffer config, with the actual value of the stream or the consumer.
+type configExpectation struct {
+	key    string
+	actual any
+}
+
+// validateBufferConfig validates the stream and the consumer of the buffer against the settings of its buffer config
+// and retention, the settings not in them are not validated as they come from the config of the ISB Service, which can
+// change over time. The replicas are validated on their own, see replicasMismatch.
+func (jss *jetStreamSvc) validateBufferConfig(buffer string, o *createOptions) ([]*ConfigMismatchError, error) {
+	override := viper.New()
+	override.SetConfigType("yaml")
+	if err := override.ReadConfig(bytes.NewBufferString(o.bufferConfigs[buffer])); err != nil {
+		return nil, fmt.Errorf("failed to read the config of buffer %q, %w", buffer, err)
+	}
+	if r, ok := o.bufferRetentions[buffer]; ok {
+		applyRetention(override, r)
+	}
+	streamName := JetStreamName(buffer)
+	streamInfo, err := jss.js.StreamInfo(streamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query information of stream %q, %w", streamName, err)
+	}
+	sc := streamInfo.Config
+	expectations := []configExpectation{
+		{"stream.maxMsgs", sc.MaxMsgs},
+		{"stream.maxBytes", sc.MaxBytes},
+		{"stream.maxAge", sc.MaxAge},
+		{"stream.retention", int(sc.Retention)},
+		{"stream.storage", int(sc.Storage)},
+		{"stream.duplicates", sc.Duplicates},
+	}
+	if override.IsSet("consumer.ackWait") || override.IsSet("consumer.maxAckPending") {
+		// a missing consumer is diagnosed on its own, the settings of it are not validated.
+		consumerInfo, err := jss.js.ConsumerInfo(streamName, streamName)
+		if err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+			return nil, fmt.Errorf("failed to query information of consumer %q, %w", streamName, err)
+		}
+		if err == nil {
+			expectations = append(expectations,
+				configExpectation{"consumer.ackWait", consumerInfo.Config.AckWait},
+				configExpectation{"consumer.maxAckPending", consumerInfo.Config.MaxAckPending},
+			)
+		}
+	}
+	var mismatches []*ConfigMismatchError
+	if discard, ok := o.discardPolicy(buffer); ok && discard != sc.Discard {
+		mismatches = append(mismatches, &ConfigMismatchError{Stream: streamName, Field: "discard", Expected: discard.String(), Actual: sc.Discard.String()})
+	}
+	for _, e := range expectations {
+		if !override.IsSet(e.key) {
+			continue
+		}
+		var expected any
+		switch e.actual.(type) {
+		case int64:
+			expected = override.GetInt64(e.key)
+		case int:
+			expected = override.GetInt(e.key)
+		case time.Duration:
+			expected = override.GetDuration(e.key)
+		}
+		if expected != e.actual {
+			mismatches = append(mismatches, &ConfigMismatchError{Stream: streamName, Field: e.key, Expected: fmt.Sprint(expected), Actual: fmt.Sprint(e.actual)})
+		}
+	}
+	return mismatches, nil
+}
+
+// replicasMismatch returns the mismatch of the replicas of the stream, with the key of the replicas in the config,
+// nil if the replicas are not set in it. The streams created with 0 replicas have 1.
+func replicasMismatch(info *nats.StreamInfo, v *viper.Viper, key string) *ConfigMismatchError {
+	if !v.IsSet(key) {
+		return nil
+	}
+	expected := max(v.GetInt(key), 1)
+	if expected == info.Config.Replicas {
+		return nil
+	}
+	return &ConfigMismatchError{Stream: info.Config.Name, Field: key, Expected: fmt.Sprint(expected), Actual: fmt.Sprint(info.Config.Replicas)}
+}
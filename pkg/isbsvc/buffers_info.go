@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// bufferInfoConcurrency is the max number of buffer info requests issued concurrently.
+const bufferInfoConcurrency = 10
+
+// concurrentBuffersInfo gets the info of the buffers with bounded concurrency. The info is returned in the order of
+// the buffers, for the buffers succeeded only, with an error naming the failed ones.
+func concurrentBuffersInfo(ctx context.Context, buffers []string, concurrency int, get func(ctx context.Context, buffer string) (*BufferInfo, error)) ([]*BufferInfo, error) {
+	infos := make([]*BufferInfo, len(buffers))
+	errs := make([]error, len(buffers))
+	var eg errgroup.Group
+	eg.SetLimit(max(concurrency, 1))
+	for i, buffer := range buffers {
+		eg.Go(func() error {
+			infos[i], errs[i] = get(ctx, buffer)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	return collectBuffersInfo(buffers, infos, errs)
+}
+
+// collectBuffersInfo drops the info of the failed buffers, and joins the errors with the names of the buffers.
+func collectBuffersInfo(buffers []string, infos []*BufferInfo, errs []error) ([]*BufferInfo, error) {
+	result := make([]*BufferInfo, 0, len(buffers))
+	var failed []error
+	for i, buffer := range buffers {
+		if errs[i] != nil {
+			failed = append(failed, fmt.Errorf("buffer %q: %w", buffer, errs[i]))
+			continue
+		}
+		result = append(result, infos[i])
+	}
+	if len(failed) > 0 {
+		return result, fmt.Errorf("failed to get information of %d of %d buffers, %w", len(failed), len(buffers), errors.Join(failed...))
+	}
+	return result, nil
+}
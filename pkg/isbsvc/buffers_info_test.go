@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockBufferInfoJetStream mocks the stream and consumer info requests, recording the max number of the concurrent ones
+type mockBufferInfoJetStream struct {
+	nats.JetStreamContext
+	failed      map[string]bool
+	inflight    atomic.Int32
+	maxInflight atomic.Int32
+	mu          sync.Mutex
+	requested   []string
+}
+
+func (m *mockBufferInfoJetStream) StreamInfo(stream string, _ ...nats.JSOpt) (*nats.StreamInfo, error) {
+	n := m.inflight.Add(1)
+	defer m.inflight.Add(-1)
+	for {
+		cur := m.maxInflight.Load()
+		if n <= cur || m.maxInflight.CompareAndSwap(cur, n) {
+			break
+		}
+	}
+	m.mu.Lock()
+	m.requested = append(m.requested, stream)
+	m.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	if m.failed[stream] {
+		return nil, nats.ErrStreamNotFound
+	}
+	return &nats.StreamInfo{Config: nats.StreamConfig{Name: stream, Retention: nats.WorkQueuePolicy, MaxMsgs: 70}, State: nats.StreamState{Msgs: 7}}, nil
+}
+
+func (m *mockBufferInfoJetStream) ConsumerInfo(stream string, _ string, _ ...nats.JSOpt) (*nats.ConsumerInfo, error) {
+	return &nats.ConsumerInfo{Stream: stream}, nil
+}
+
+func TestJetstreamSvc_GetBuffersInfo(t *testing.T) {
+	var buffers []string
+	for i := 0; i < 50; i++ {
+		buffers = append(buffers, fmt.Sprintf("test-buffer-%d", i))
+	}
+	mockJS := &mockBufferInfoJetStream{failed: map[string]bool{"test-buffer-3": true, "test-buffer-42": true}}
+	jss := &jetStreamSvc{js: mockJS}
+	infos, err := jss.GetBuffersInfo(context.Background(), buffers)
+	assert.ErrorContains(t, err, "failed to get information of 2 of 50 buffers")
+	assert.ErrorContains(t, err, `buffer "test-buffer-3"`)
+	assert.ErrorContains(t, err, `buffer "test-buffer-42"`)
+	// the info of the succeeded buffers is returned in order
+	assert.Len(t, infos, 48)
+	assert.Equal(t, "test-buffer-0", infos[0].Name)
+	assert.Equal(t, "test-buffer-4", infos[3].Name)
+	assert.Equal(t, int64(7), infos[3].TotalMessages)
+	assert.Equal(t, int64(70), infos[3].MaxLength)
+	assert.Equal(t, 10.0, infos[3].UsagePercentage)
+	// all the buffers are requested once, with bounded concurrency
+	assert.Len(t, mockJS.requested, 50)
+	assert.LessOrEqual(t, mockJS.maxInflight.Load(), int32(bufferInfoConcurrency))
+	assert.Greater(t, mockJS.maxInflight.Load(), int32(1))
+
+	infos, err = jss.GetBuffersInfo(context.Background(), buffers[:2])
+	assert.NoError(t, err)
+	assert.Len(t, infos, 2)
+}
+
+func TestCollectBuffersInfo(t *testing.T) {
+	infos, err := collectBuffersInfo(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, infos)
+
+	infos, err = collectBuffersInfo([]string{"a", "b"}, []*BufferInfo{nil, {Name: "b"}}, []error{fmt.Errorf("timeout"), nil})
+	assert.EqualError(t, err, `failed to get information of 1 of 2 buffers, buffer "a": timeout`)
+	assert.Equal(t, []*BufferInfo{{Name: "b"}}, infos)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultDeleteConcurrency is the default number of items deleted concurrently.
+	defaultDeleteConcurrency = 10
+	// defaultDeleteItemTimeout is the default deadline of deleting a single item.
+	defaultDeleteItemTimeout = 30 * time.Second
+)
+
+// DeleteItemKind is the kind of the item to be deleted.
+type DeleteItemKind string
+
+const (
+	DeleteItemBuffer              DeleteItemKind = "buffer"
+	DeleteItemBucket              DeleteItemKind = "bucket"
+	DeleteItemSideInputsStore     DeleteItemKind = "sideInputsStore"
+	DeleteItemServingSourceStream DeleteItemKind = "servingSourceStream"
+)
+
+// errItemNotFound is returned by a delete function if the item does not exist.
+var errItemNotFound = errors.New("not found")
+
+// DeleteItem identifies an item to be deleted, the name is the one passed to DeleteBuffersAndBuckets.
+type DeleteItem struct {
+	Kind DeleteItemKind
+	Name string
+}
+
+func (i DeleteItem) String() string {
+	return fmt.Sprintf("%s %q", i.Kind, i.Name)
+}
+
+// DeleteFailure is an item failed to be deleted.
+type DeleteFailure struct {
+	DeleteItem
+	Reason string
+}
+
+// DeleteReport is the result of deleting buffers, buckets, side inputs store and serving source streams.
+type DeleteReport struct {
+	// Deleted are the items deleted successfully.
+	Deleted []DeleteItem
+	// NotFound are the items not existing, which are considered as deleted.
+	NotFound []DeleteItem
+	// Failed are the items failed to be deleted with the reasons.
+	Failed []DeleteFailure
+}
+
+// FailedItems returns the names of the failed items of the given kind, which are supposed to be retried.
+func (r *DeleteReport) FailedItems(kind DeleteItemKind) []string {
+	var names []string
+	for _, f := range r.Failed {
+		if f.Kind == kind {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// Err returns an error describing the failed items, nil if nothing failed.
+func (r *DeleteReport) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+	reasons := make([]string, 0, len(r.Failed))
+	for _, f := range r.Failed {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", f.DeleteItem, f.Reason))
+	}
+	return fmt.Errorf("failed to delete %d of %d items, %s", len(r.Failed), len(r.Deleted)+len(r.NotFound)+len(r.Failed), strings.Join(reasons, "; "))
+}
+
+// deleteTask is an item with the function to delete it.
+type deleteTask struct {
+	DeleteItem
+	// delete deletes the item, it returns an error wrapping errItemNotFound if the item does not exist.
+	delete func(ctx context.Context) error
+}
+
+// bulkDelete deletes the items with bounded concurrency, each item is deleted within the timeout so that a stuck item
+// does not block the others. It always processes all the items, and the failures are recorded in the report.
+// The progress function, if not nil, is called after each item is processed, the calls are serialized.
+func bulkDelete(ctx context.Context, tasks []deleteTask, concurrency int, timeout time.Duration, progress func(DeleteItem, error)) *DeleteReport {
+	report := &DeleteReport{}
+	var mu sync.Mutex
+	var eg errgroup.Group
+	eg.SetLimit(max(concurrency, 1))
+	for _, task := range tasks {
+		eg.Go(func() error {
+			itemCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			err := task.delete(itemCtx)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				report.Deleted = append(report.Deleted, task.DeleteItem)
+			case errors.Is(err, errItemNotFound):
+				report.NotFound = append(report.NotFound, task.DeleteItem)
+				err = nil
+			default:
+				report.Failed = append(report.Failed, DeleteFailure{DeleteItem: task.DeleteItem, Reason: err.Error()})
+			}
+			if progress != nil {
+				progress(task.DeleteItem, err)
+			}
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	sortDeleteItems(report.Deleted)
+	sortDeleteItems(report.NotFound)
+	sort.Slice(report.Failed, func(i, j int) bool {
+		return lessDeleteItem(report.Failed[i].DeleteItem, report.Failed[j].DeleteItem)
+	})
+	return report
+}
+
+func sortDeleteItems(items []DeleteItem) {
+	sort.Slice(items, func(i, j int) bool {
+		return lessDeleteItem(items[i], items[j])
+	})
+}
+
+func sortItemInfos(items []ItemInfo) {
+	sort.Slice(items, func(i, j int) bool {
+		return lessDeleteItem(items[i].DeleteItem, items[j].DeleteItem)
+	})
+}
+
+func lessDeleteItem(a, b DeleteItem) bool {
+	if a.Kind != b.Kind {
+		return a.Kind < b.Kind
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,111 @@
+package isbsvc
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubBackend deletes the items by name, failing, missing or stuck items are configured by name.
+type stubBackend struct {
+	failing  map[string]bool
+	missing  map[string]bool
+	stuck    map[string]bool
+	running  atomic.Int32
+	maxInUse atomic.Int32
+}
+
+func (b *stubBackend) task(kind DeleteItemKind, name string) deleteTask {
+	return deleteTask{
+		DeleteItem: DeleteItem{Kind: kind, Name: name},
+		delete: func(ctx context.Context) error {
+			n := b.running.Add(1)
+			defer b.running.Add(-1)
+			for {
+				if m := b.maxInUse.Load(); n <= m || b.maxInUse.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			switch {
+			case b.stuck[name]:
+				<-ctx.Done()
+				return ctx.Err()
+			case b.failing[name]:
+				return fmt.Errorf("failed to delete %q", name)
+			case b.missing[name]:
+				return fmt.Errorf("%q %w", name, errItemNotFound)
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		},
+	}
+}
+
+func TestBulkDelete(t *testing.T) {
+	b := &stubBackend{
+		failing: map[string]bool{"buffer-3": true, "bucket-1": true},
+		missing: map[string]bool{"buffer-2": true},
+	}
+	var tasks []deleteTask
+	for i := 0; i < 5; i++ {
+		tasks = append(tasks, b.task(DeleteItemBuffer, fmt.Sprintf("buffer-%d", i)))
+	}
+	tasks = append(tasks, b.task(DeleteItemBucket, "bucket-0"), b.task(DeleteItemBucket, "bucket-1"))
+
+	var processed []DeleteItem
+	report := bulkDelete(context.Background(), tasks, 2, time.Second, func(item DeleteItem, err error) {
+		processed = append(processed, item)
+	})
+	assert.Len(t, processed, len(tasks))
+	assert.LessOrEqual(t, b.maxInUse.Load(), int32(2))
+
+	assert.Equal(t, []DeleteItem{
+		{Kind: DeleteItemBucket, Name: "bucket-0"},
+		{Kind: DeleteItemBuffer, Name: "buffer-0"},
+		{Kind: DeleteItemBuffer, Name: "buffer-1"},
+		{Kind: DeleteItemBuffer, Name: "buffer-4"},
+	}, report.Deleted)
+	assert.Equal(t, []DeleteItem{{Kind: DeleteItemBuffer, Name: "buffer-2"}}, report.NotFound)
+	assert.Len(t, report.Failed, 2)
+	assert.Equal(t, []string{"buffer-3"}, report.FailedItems(DeleteItemBuffer))
+	assert.Equal(t, []string{"bucket-1"}, report.FailedItems(DeleteItemBucket))
+	assert.Empty(t, report.FailedItems(DeleteItemSideInputsStore))
+	assert.ErrorContains(t, report.Err(), "failed to delete 2 of 7 items")
+	assert.ErrorContains(t, report.Err(), `buffer "buffer-3": failed to delete "buffer-3"`)
+}
+
+func TestBulkDelete_StuckItem(t *testing.T) {
+	b := &stubBackend{stuck: map[string]bool{"buffer-0": true}}
+	tasks := []deleteTask{b.task(DeleteItemBuffer, "buffer-0"), b.task(DeleteItemBuffer, "buffer-1"), b.task(DeleteItemServingSourceStream, "stream-0")}
+
+	start := time.Now()
+	report := bulkDelete(context.Background(), tasks, 1, 100*time.Millisecond, nil)
+	// the stuck item is given up after the deadline, the others are still deleted.
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, []string{"buffer-0"}, report.FailedItems(DeleteItemBuffer))
+	assert.Contains(t, report.Failed[0].Reason, context.DeadlineExceeded.Error())
+	assert.Len(t, report.Deleted, 2)
+}
+
+func TestBulkDelete_NothingFailed(t *testing.T) {
+	b := &stubBackend{}
+	report := bulkDelete(context.Background(), []deleteTask{b.task(DeleteItemSideInputsStore, "store")}, defaultDeleteConcurrency, defaultDeleteItemTimeout, nil)
+	assert.NoError(t, report.Err())
+	assert.Equal(t, []DeleteItem{{Kind: DeleteItemSideInputsStore, Name: "store"}}, report.Deleted)
+}
+
+func TestDeleteOptions(t *testing.T) {
+	opts := defaultDeleteOptions()
+	assert.Equal(t, defaultDeleteConcurrency, opts.concurrency)
+	assert.Equal(t, defaultDeleteItemTimeout, opts.itemTimeout)
+	assert.NoError(t, WithDeleteConcurrency(3)(opts))
+	assert.NoError(t, WithDeleteItemTimeout(time.Minute)(opts))
+	assert.Equal(t, 3, opts.concurrency)
+	assert.Equal(t, time.Minute, opts.itemTimeout)
+	assert.Error(t, WithDeleteConcurrency(0)(opts))
+	assert.Error(t, WithDeleteItemTimeout(0)(opts))
+}
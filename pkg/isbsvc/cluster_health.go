@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+)
+
+// ClusterHealthChecker is implemented by the ISB Services backed by a cluster which can lose the leaders of the
+// buffers, e.g. JetStream, to report the health of the cluster.
+type ClusterHealthChecker interface {
+	// ClusterHealth returns the health of the cluster and the streams in it. A cluster which can not be reached or
+	// has no meta leader is reported as not available rather than an error.
+	ClusterHealth(ctx context.Context) (*ClusterHealth, error)
+}
+
+// ClusterHealth describes the health of the cluster backing an ISB Service.
+type ClusterHealth struct {
+	// Available is false if the cluster can not serve the management requests, e.g. it has no meta leader.
+	Available bool `json:"available"`
+	// Message explains why the cluster is not available.
+	Message string `json:"message,omitempty"`
+	// Streams is the health of the streams in the cluster, it's empty if the cluster is not available.
+	Streams []StreamHealth `json:"streams,omitempty"`
+}
+
+// StreamHealth describes the leader presence and the replica health of a stream.
+type StreamHealth struct {
+	Name string `json:"name"`
+	// Leader is the name of the server leading the stream, it's empty if the stream has no leader.
+	Leader string `json:"leader,omitempty"`
+	// HasLeader is true if the stream has a leader to serve the reads and the writes.
+	HasLeader bool `json:"hasLeader"`
+	// Replicas is the configured number of replicas of the stream.
+	Replicas int `json:"replicas"`
+	// CurrentReplicas is the number of replicas, including the leader, which are online and up to date.
+	CurrentReplicas int `json:"currentReplicas"`
+}
+
+// Healthy returns true if the stream has a leader and all the replicas are up to date.
+func (sh StreamHealth) Healthy() bool {
+	return sh.HasLeader && sh.CurrentReplicas >= sh.Replicas
+}
+
+// Filter returns a copy of the health with the streams of the given names only.
+func (ch *ClusterHealth) Filter(streamNames ...string) *ClusterHealth {
+	names := make(map[string]struct{}, len(streamNames))
+	for _, n := range streamNames {
+		names[n] = struct{}{}
+	}
+	result := &ClusterHealth{Available: ch.Available, Message: ch.Message}
+	for _, s := range ch.Streams {
+		if _, ok := names[s.Name]; ok {
+			result.Streams = append(result.Streams, s)
+		}
+	}
+	return result
+}
+
+// LeaderlessStreams returns the names of the streams without a leader.
+func (ch *ClusterHealth) LeaderlessStreams() []string {
+	var names []string
+	for _, s := range ch.Streams {
+		if !s.HasLeader {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
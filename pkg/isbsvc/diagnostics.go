@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DiagnosticKind is the kind of a problem found by the validation of the buffers and buckets.
+type DiagnosticKind string
+
+const (
+	// DiagnosticMissingStream is a stream, or a KV, which does not exist.
+	DiagnosticMissingStream DiagnosticKind = "MissingStream"
+	// DiagnosticMissingConsumer is a buffer the consumer of which does not exist, the stream group of a Redis buffer.
+	DiagnosticMissingConsumer DiagnosticKind = "MissingConsumer"
+	// DiagnosticReplicasMismatch is a stream the replica count of which is not the expected one.
+	DiagnosticReplicasMismatch DiagnosticKind = "ReplicasMismatch"
+	// DiagnosticConfigMismatch is a stream or a consumer not configured as the buffer config or retention expects.
+	DiagnosticConfigMismatch DiagnosticKind = "ConfigMismatch"
+	// DiagnosticOwnershipMismatch is a stream owned by another pipeline.
+	DiagnosticOwnershipMismatch DiagnosticKind = "OwnershipMismatch"
+)
+
+// Diagnostic is a problem found by the validation with a buffer, a bucket, the side inputs store or a serving source
+// stream.
+type Diagnostic struct {
+	Kind DiagnosticKind
+	// Item is the item validated, as passed to ValidateBuffersAndBuckets.
+	Item DeleteItem
+	// Stream is the stream the problem is found with, a bucket has a stream for each of its KVs.
+	Stream string
+	// Err describes the problem, it's a ConfigMismatchError for the config and the replicas mismatches, and an
+	// OwnershipMismatchError for the ownership mismatches.
+	Err error
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s of %s: %v", d.Kind, d.Item, d.Err)
+}
+
+// sameDiagnostic tells if two diagnostics are the same problem.
+func sameDiagnostic(a, b Diagnostic) bool {
+	return a.Kind == b.Kind && a.Item == b.Item && a.Stream == b.Stream && a.Err.Error() == b.Err.Error()
+}
+
+// ValidationError is returned by ValidateBuffersAndBuckets with all the problems found, it unwraps to the errors of
+// the diagnostics, so IsConfigMismatch and IsOwnershipMismatch apply to it.
+type ValidationError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Diagnostics))
+	for _, d := range e.Diagnostics {
+		msgs = append(msgs, d.Err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Diagnostics))
+	for _, d := range e.Diagnostics {
+		errs = append(errs, d.Err)
+	}
+	return errs
+}
+
+// newValidationError returns a ValidationError of the diagnostics, nil if there is none.
+func newValidationError(diagnostics []Diagnostic) error {
+	if len(diagnostics) == 0 {
+		return nil
+	}
+	return &ValidationError{Diagnostics: diagnostics}
+}
+
+// Diagnostics returns the diagnostics of an error returned by ValidateBuffersAndBuckets, nil if the error does not
+// carry any, e.g. the ISB Service can not be reached.
+func Diagnostics(err error) []Diagnostic {
+	var e *ValidationError
+	if errors.As(err, &e) {
+		return e.Diagnostics
+	}
+	return nil
+}
+
+// Repairer is implemented by the ISB Services which can fix the drift of the buffers and buckets found by
+// ValidateBuffersAndBuckets, so that a degraded ISB Service heals without recreating the pipeline.
+type Repairer interface {
+	// RepairBuffersAndBuckets validates the buffers and buckets with the options, as ValidateBuffersAndBuckets, and
+	// fixes the problems found: the missing streams and consumers are created, and the settings which can be changed
+	// in place are updated. The streams owned by another pipeline are left untouched. The problems remaining after the
+	// repair are returned as a ValidationError along with the report.
+	RepairBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) (*RepairReport, error)
+}
+
+// RepairReport is the result of repairing buffers and buckets.
+type RepairReport struct {
+	// Repaired are the problems fixed by the repair.
+	Repaired []Diagnostic
+	// Remaining are the problems found after the repair, e.g. a stream owned by another pipeline, or a setting which
+	// can not be changed in place, such as the storage of a stream.
+	Remaining []Diagnostic
+}
+
+// newRepairReport compares the diagnostics before and after a repair.
+func newRepairReport(before, after []Diagnostic) *RepairReport {
+	report := &RepairReport{Remaining: after}
+	for _, d := range before {
+		fixed := true
+		for _, a := range after {
+			if sameDiagnostic(d, a) {
+				fixed = false
+				break
+			}
+		}
+		if fixed {
+			report.Repaired = append(report.Repaired, d)
+		}
+	}
+	return report
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// historyPageSize is the max number of messages fetched at a time by a history read paging through a buffer.
+const historyPageSize = 100
+
+// HistoryPosition is the position in a buffer where a history read starts. At most one of the start time and the
+// start sequence can be set, the read starts from the oldest message of the buffer if none is set.
+type HistoryPosition struct {
+	// StartTime starts the read from the first message written to the buffer at or after the time.
+	StartTime time.Time
+	// StartSequence starts the read from the message with the sequence, which is the stream sequence of JetStream,
+	// the entry ID of Redis, or the partition and the offset of Kafka as "{partition}-{offset}".
+	StartSequence string
+}
+
+func (p HistoryPosition) validate() error {
+	if !p.StartTime.IsZero() && p.StartSequence != "" {
+		return fmt.Errorf("only one of the start time and the start sequence can be set")
+	}
+	return nil
+}
+
+// HistoryMessage is a message read from the history of a buffer.
+type HistoryMessage struct {
+	// Sequence is the sequence of the message in the buffer.
+	Sequence string
+	// WriteTime is the time when the message was written to the buffer.
+	WriteTime time.Time
+	isb.Message
+}
+
+// peekHistory returns at most count messages of the buffer from the offset, with a history read.
+func peekHistory(ctx context.Context, readHistory func(context.Context, string, HistoryPosition, func(*HistoryMessage) bool) error, buffer, offset string, count int) ([]*HistoryMessage, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("invalid count %d, it should be positive", count)
+	}
+	var msgs []*HistoryMessage
+	err := readHistory(ctx, buffer, HistoryPosition{StartSequence: offset}, func(msg *HistoryMessage) bool {
+		msgs = append(msgs, msg)
+		return len(msgs) < count
+	})
+	return msgs, err
+}
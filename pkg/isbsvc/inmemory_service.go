@@ -0,0 +1,437 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+// InMemorySvc is an ISB Service keeping the buffers and the watermark buckets in the memory of the process, so that a
+// pipeline can be run without an ISB Service deployed, e.g. in a unit test or a local run. The buffers are the readers
+// and the writers of themselves, see Buffer.
+type InMemorySvc struct {
+	lock    sync.RWMutex
+	buffers map[string]*simplebuffer.InMemoryBuffer
+	// buckets are the watermark stores of the buckets, shared by the publishers and the fetchers
+	buckets map[string]store.WatermarkStore
+}
+
+var _ ISBService = (*InMemorySvc)(nil)
+
+// NewISBInMemorySvc returns an empty in-memory ISB Service.
+func NewISBInMemorySvc() *InMemorySvc {
+	return &InMemorySvc{
+		buffers: make(map[string]*simplebuffer.InMemoryBuffer),
+		buckets: make(map[string]store.WatermarkStore),
+	}
+}
+
+// Buffer returns the buffer, which is both the reader and the writer of it. It returns an error wrapping
+// ErrBufferNotFound if the buffer is not created.
+func (s *InMemorySvc) Buffer(name string) (*simplebuffer.InMemoryBuffer, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	b, ok := s.buffers[name]
+	if !ok {
+		return nil, fmt.Errorf("in-memory buffer %q, %w", name, ErrBufferNotFound)
+	}
+	return b, nil
+}
+
+// Close closes the watermark stores of the buckets.
+func (s *InMemorySvc) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for bucket, wmStore := range s.buckets {
+		_ = wmStore.Close()
+		delete(s.buckets, bucket)
+	}
+	return nil
+}
+
+// CreateBuffersAndBuckets creates the buffers with the length, the read timeout and the writing strategy of the
+// "buffer" settings of the config, overridden by the buffer configs, e.g.
+//
+//	buffer:
+//	  length: 30000
+//	  readTimeout: 1s
+//	  bufferFullWritingStrategy: retryUntilSuccess
+//
+// The existing buffers and buckets are left untouched, the side inputs store and the serving source streams are not
+// supported.
+func (s *InMemorySvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error {
+	if sideInputsStore != "" || len(servingSourceStreams) > 0 {
+		return fmt.Errorf("side inputs and serving sources are not supported by the in-memory ISB Service")
+	}
+	creatOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, buffer := range buffers {
+		if _, ok := s.buffers[buffer]; ok {
+			continue
+		}
+		v, err := creatOpts.viper(buffer)
+		if err != nil {
+			return err
+		}
+		length := v.GetInt64("buffer.length")
+		if length <= 0 {
+			length = dfv1.DefaultBufferLength
+		}
+		readTimeout := v.GetDuration("buffer.readTimeout")
+		if readTimeout <= 0 {
+			readTimeout = dfv1.DefaultReadTimeout
+		}
+		bufferOpts := []simplebuffer.Option{simplebuffer.WithReadTimeOut(readTimeout)}
+		if strategy := v.GetString("buffer.bufferFullWritingStrategy"); strategy != "" {
+			bufferOpts = append(bufferOpts, simplebuffer.WithBufferFullWritingStrategy(dfv1.BufferFullWritingStrategy(strategy)))
+		}
+		s.buffers[buffer] = simplebuffer.NewInMemoryBuffer(buffer, length, bufferPartitionIdx(buffer), bufferOpts...)
+	}
+	for _, bucket := range buckets {
+		if _, ok := s.buckets[bucket]; ok {
+			continue
+		}
+		wmStore, err := store.BuildInmemWatermarkStore(ctx, bucket)
+		if err != nil {
+			return fmt.Errorf("failed to create in-memory watermark store %q, %w", bucket, err)
+		}
+		s.buckets[bucket] = wmStore
+	}
+	return nil
+}
+
+// bufferPartitionIdx returns the partition index of the buffer, which is the suffix of the buffer names generated by
+// dfv1.GenerateBufferNames, 0 if there is no such a suffix.
+func bufferPartitionIdx(buffer string) int32 {
+	if i := strings.LastIndex(buffer, "-"); i >= 0 {
+		if idx, err := strconv.ParseInt(buffer[i+1:], 10, 32); err == nil {
+			return int32(idx)
+		}
+	}
+	return 0
+}
+
+// DeleteBuffersAndBuckets deletes the buffers and the buckets, the watermark stores of the buckets are closed.
+func (s *InMemorySvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...DeleteOption) (*DeleteReport, error) {
+	deleteOpts := defaultDeleteOptions()
+	for _, opt := range opts {
+		if err := opt(deleteOpts); err != nil {
+			return nil, err
+		}
+	}
+	var tasks []deleteTask
+	for _, buffer := range buffers {
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffer},
+			delete: func(context.Context) error {
+				s.lock.Lock()
+				defer s.lock.Unlock()
+				if _, ok := s.buffers[buffer]; !ok {
+					return fmt.Errorf("buffer %q %w", buffer, errItemNotFound)
+				}
+				delete(s.buffers, buffer)
+				return nil
+			},
+		})
+	}
+	for _, bucket := range buckets {
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: bucket},
+			delete: func(context.Context) error {
+				s.lock.Lock()
+				defer s.lock.Unlock()
+				wmStore, ok := s.buckets[bucket]
+				if !ok {
+					return fmt.Errorf("bucket %q %w", bucket, errItemNotFound)
+				}
+				delete(s.buckets, bucket)
+				return wmStore.Close()
+			},
+		})
+	}
+	report := bulkDelete(ctx, tasks, deleteOpts.concurrency, deleteOpts.itemTimeout, deleteOpts.progress)
+	return report, report.Err()
+}
+
+// ValidateBuffersAndBuckets validates the buffers and the buckets exist, the length of a buffer is validated against
+// its buffer config if it's set there.
+func (s *InMemorySvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error {
+	creatOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for _, buffer := range buffers {
+		b, ok := s.buffers[buffer]
+		if !ok {
+			return fmt.Errorf("buffer %q not existing", buffer)
+		}
+		if _, ok := creatOpts.bufferConfigs[buffer]; !ok {
+			continue
+		}
+		v, err := creatOpts.viper(buffer)
+		if err != nil {
+			return err
+		}
+		if expected := v.GetInt64("buffer.length"); expected > 0 && expected != b.Size() {
+			return &ConfigMismatchError{Stream: buffer, Field: "buffer.length", Expected: fmt.Sprint(expected), Actual: fmt.Sprint(b.Size())}
+		}
+	}
+	for _, bucket := range buckets {
+		if _, ok := s.buckets[bucket]; !ok {
+			return fmt.Errorf("bucket %q not existing", bucket)
+		}
+	}
+	return nil
+}
+
+// ListBuffersAndBuckets lists the buffers and the buckets with the given prefix, the creation time and the owner of
+// them are not recorded.
+func (s *InMemorySvc) ListBuffersAndBuckets(ctx context.Context, prefix string) ([]ItemInfo, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var items []ItemInfo
+	for buffer := range s.buffers {
+		if strings.HasPrefix(buffer, prefix) {
+			items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffer}})
+		}
+	}
+	for bucket := range s.buckets {
+		if strings.HasPrefix(bucket, prefix) {
+			items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: bucket}})
+		}
+	}
+	sortItemInfos(items)
+	return items, nil
+}
+
+// GetBufferInfo returns the counts of the messages in the buffer, the length of the buffer is the max length.
+func (s *InMemorySvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
+	b, err := s.Buffer(buffer)
+	if err != nil {
+		return nil, err
+	}
+	pending, ackPending := b.Counts()
+	info := &BufferInfo{
+		Name:            buffer,
+		PendingCount:    pending,
+		AckPendingCount: ackPending,
+		TotalMessages:   pending + ackPending,
+		MaxLength:       b.Size(),
+		UsagePercentage: float64(pending+ackPending) * 100 / float64(b.Size()),
+	}
+	msgs, err := b.Messages()
+	if err != nil {
+		return nil, err
+	}
+	// the messages acknowledged are removed, the oldest message left is the oldest one not acknowledged yet
+	if len(msgs) > 0 {
+		info.OldestMessageTime = msgs[0].WriteTime
+		info.OldestPendingTime = msgs[0].WriteTime
+	}
+	return info, nil
+}
+
+// WatchPartitionInfo checks the in-memory buffer of the partition at the watch interval.
+func (s *InMemorySvc) WatchPartitionInfo(ctx context.Context, partition string) (<-chan *BufferInfo, error) {
+	return watchPartitionInfo(ctx, partition, partitionInfoWatchInterval, s.GetBufferInfo)
+}
+
+// GetBuffersInfo returns the info of the buffers one by one, there is no call to batch.
+func (s *InMemorySvc) GetBuffersInfo(ctx context.Context, buffers []string) ([]*BufferInfo, error) {
+	infos := make([]*BufferInfo, len(buffers))
+	errs := make([]error, len(buffers))
+	for i, buffer := range buffers {
+		infos[i], errs[i] = s.GetBufferInfo(ctx, buffer)
+	}
+	return collectBuffersInfo(buffers, infos, errs)
+}
+
+// ReadBufferHistory reads the messages of the buffer not acknowledged yet, the acknowledged ones are removed from the
+// in-memory buffers. The sequences are the write sequences of the messages in the buffer.
+func (s *InMemorySvc) ReadBufferHistory(ctx context.Context, buffer string, from HistoryPosition, fn func(*HistoryMessage) bool) error {
+	if err := from.validate(); err != nil {
+		return err
+	}
+	var startSeq int64
+	if from.StartSequence != "" {
+		seq, err := strconv.ParseInt(from.StartSequence, 10, 64)
+		if err != nil || seq <= 0 {
+			return fmt.Errorf("invalid start sequence %q of buffer %q, it should be a positive integer", from.StartSequence, buffer)
+		}
+		startSeq = seq
+	}
+	b, err := s.Buffer(buffer)
+	if err != nil {
+		return err
+	}
+	// the messages are copied out of the buffer, the read stops at the last message existing now
+	msgs, err := b.Messages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if m.Sequence < startSeq || m.WriteTime.Before(from.StartTime) {
+			continue
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("failed to read the history of buffer %q, %w", buffer, ctx.Err())
+		}
+		if !fn(&HistoryMessage{Sequence: strconv.FormatInt(m.Sequence, 10), WriteTime: m.WriteTime, Message: m.Message}) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// PeekMessages reads the messages of the buffer from the offset, which is the write sequence of a message.
+func (s *InMemorySvc) PeekMessages(ctx context.Context, partition, offset string, count int) ([]*HistoryMessage, error) {
+	return peekHistory(ctx, s.ReadBufferHistory, partition, offset, count)
+}
+
+// PurgeBuffers drops the messages of the buffers not read yet, the messages read are left to be acknowledged by the
+// readers. The offset timelines of the watermark buckets are reset by deleting their entries.
+func (s *InMemorySvc) PurgeBuffers(ctx context.Context, buffers []string, opts ...PurgeOption) error {
+	o, err := newPurgeOptions(opts...)
+	if err != nil {
+		return err
+	}
+	log := logging.FromContext(ctx)
+	var errs []error
+	for _, buffer := range buffers {
+		b, err := s.Buffer(buffer)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to purge buffer %q, %w", buffer, err))
+			continue
+		}
+		var dropped int64
+		if o.upTo != "" {
+			seq, err := strconv.ParseInt(o.upTo, 10, 64)
+			if err != nil || seq <= 0 {
+				errs = append(errs, fmt.Errorf("invalid offset %q of buffer %q, it should be a positive integer", o.upTo, buffer))
+				continue
+			}
+			dropped = b.PurgeUpTo(seq)
+		} else {
+			dropped = b.Purge(o.before, o.keepRecent)
+		}
+		log.Infow("Purged buffer", zap.String("buffer", buffer), zap.Int64("dropped", dropped), zap.Time("before", o.before), zap.Int64("keepRecent", o.keepRecent), zap.String("upTo", o.upTo))
+	}
+	for _, bucket := range o.watermarkBuckets {
+		s.lock.RLock()
+		wmStore, ok := s.buckets[bucket]
+		s.lock.RUnlock()
+		if !ok {
+			continue
+		}
+		// the publishers write a new timeline entry on the next write or heartbeat.
+		ot := wmStore.OffsetTimelineStore()
+		keys, _ := ot.GetAllKeys(ctx)
+		for _, key := range keys {
+			if err := ot.DeleteKey(ctx, key); err != nil {
+				errs = append(errs, fmt.Errorf("failed to reset the offset timeline of bucket %q, %w", bucket, err))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ResizePartition grows the ring of the buffer, see simplebuffer.InMemoryBuffer.Resize.
+func (s *InMemorySvc) ResizePartition(ctx context.Context, partition string, newBufferLength int64) error {
+	b, err := s.Buffer(partition)
+	if err != nil {
+		return err
+	}
+	if err := b.Resize(newBufferLength); err != nil {
+		return err
+	}
+	logging.FromContext(ctx).Infow("Resized partition", zap.String("partition", partition), zap.Int64("to", newBufferLength))
+	return nil
+}
+
+// PausePartition pauses the reading of the buffer, see simplebuffer.InMemoryBuffer.Pause.
+func (s *InMemorySvc) PausePartition(ctx context.Context, partition string) error {
+	b, err := s.Buffer(partition)
+	if err != nil {
+		return err
+	}
+	b.Pause()
+	logging.FromContext(ctx).Infow("Paused partition", zap.String("partition", partition))
+	return nil
+}
+
+// ResumePartition resumes the reading of the buffer.
+func (s *InMemorySvc) ResumePartition(ctx context.Context, partition string) error {
+	b, err := s.Buffer(partition)
+	if err != nil {
+		return err
+	}
+	b.Resume()
+	logging.FromContext(ctx).Infow("Resumed partition", zap.String("partition", partition))
+	return nil
+}
+
+// PurgePartition drops the messages of the paused buffer not read yet up to the write sequence.
+func (s *InMemorySvc) PurgePartition(ctx context.Context, partition, upToOffset string, opts ...PurgeOption) error {
+	b, err := s.Buffer(partition)
+	if err != nil {
+		return err
+	}
+	if !b.IsPaused() {
+		return fmt.Errorf("in-memory buffer %q, %w", partition, ErrPartitionNotPaused)
+	}
+	return s.PurgeBuffers(ctx, []string{partition}, append(opts, withPurgeUpTo(upToOffset))...)
+}
+
+// CreateWatermarkStores returns the watermark store of the bucket, the same store is returned for all the partitions,
+// since the in-memory stores can not be shared across the store instances. The store is closed when the bucket is
+// deleted or the service is closed, not by the callers.
+func (s *InMemorySvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]store.WatermarkStore, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	wmStore, ok := s.buckets[bucketName]
+	if !ok {
+		return nil, fmt.Errorf("in-memory bucket %q not existing", bucketName)
+	}
+	partitions := 1
+	if isReduce {
+		partitions = fromBufferPartitionCount
+	}
+	wmStores := make([]store.WatermarkStore, partitions)
+	for i := range wmStores {
+		wmStores[i] = wmStore
+	}
+	return wmStores, nil
+}
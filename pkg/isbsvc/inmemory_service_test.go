@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestInMemorySvc_Buffers(t *testing.T) {
+	ctx := context.Background()
+	svc := NewISBInMemorySvc()
+	defer func() { _ = svc.Close() }()
+	buffers := []string{"default-test-pl-p1-0", "default-test-pl-p1-1"}
+	buckets := []string{"default-test-pl-in-p1"}
+
+	assert.Error(t, svc.CreateBuffersAndBuckets(ctx, buffers, nil, "test-pl", nil))
+	require.NoError(t, svc.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil,
+		WithConfig("buffer:\n  length: 10\n  readTimeout: 10ms"),
+		WithBufferConfig(buffers[1], "buffer:\n  length: 3\n  bufferFullWritingStrategy: discardLatest")))
+	assert.NoError(t, svc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil, WithBufferConfig(buffers[1], "buffer:\n  length: 3")))
+	assert.True(t, IsConfigMismatch(svc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, WithBufferConfig(buffers[1], "buffer:\n  length: 5"))))
+	assert.Error(t, svc.ValidateBuffersAndBuckets(ctx, nil, []string{"missing"}, "", nil))
+
+	b, err := svc.Buffer(buffers[1])
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), b.GetPartitionIdx())
+	messages := testutils.BuildTestWriteMessages(4, time.Unix(1636470000, 0), nil, "testVertex")
+	_, errs := b.Write(ctx, messages)
+	assert.NoError(t, errs[2])
+	var nonRetryable isb.NonRetryableBufferWriteErr
+	assert.True(t, errors.As(errs[3], &nonRetryable))
+	readMessages, err := b.Read(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 1)
+
+	_, err = svc.WatchPartitionInfo(ctx, "missing")
+	assert.True(t, errors.Is(err, ErrBufferNotFound))
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	watched, err := svc.WatchPartitionInfo(watchCtx, buffers[1])
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), (<-watched).TotalMessages)
+	cancelWatch()
+
+	infos, err := svc.GetBuffersInfo(ctx, append(buffers, "missing"))
+	assert.True(t, errors.Is(err, ErrBufferNotFound))
+	require.Len(t, infos, 2)
+	assert.Equal(t, int64(0), infos[0].TotalMessages)
+	assert.Equal(t, int64(10), infos[0].MaxLength)
+	assert.Equal(t, int64(2), infos[1].PendingCount)
+	assert.Equal(t, int64(1), infos[1].AckPendingCount)
+	assert.Equal(t, float64(100), infos[1].UsagePercentage)
+	assert.False(t, infos[1].OldestPendingTime.IsZero())
+
+	var seqs []string
+	require.NoError(t, svc.ReadBufferHistory(ctx, buffers[1], HistoryPosition{StartSequence: "2"}, func(m *HistoryMessage) bool {
+		seqs = append(seqs, m.Sequence)
+		return true
+	}))
+	assert.Equal(t, []string{"2", "3"}, seqs)
+
+	peeked, err := svc.PeekMessages(ctx, buffers[1], "", 1)
+	require.NoError(t, err)
+	require.Len(t, peeked, 1)
+	assert.Equal(t, messages[0].Header.ID, peeked[0].Header.ID)
+	_, err = svc.PeekMessages(ctx, "missing", "", 1)
+	assert.True(t, errors.Is(err, ErrBufferNotFound))
+
+	require.NoError(t, svc.PurgeBuffers(ctx, []string{buffers[1]}, WithKeepRecent(1)))
+	info, err := svc.GetBufferInfo(ctx, buffers[1])
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), info.PendingCount)
+	assert.Equal(t, int64(1), info.AckPendingCount)
+	assert.True(t, errors.Is(svc.PurgeBuffers(ctx, []string{"missing"}), ErrBufferNotFound))
+
+	require.NoError(t, svc.ResizePartition(ctx, buffers[0], 20))
+	info, err = svc.GetBufferInfo(ctx, buffers[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), info.MaxLength)
+	assert.Error(t, svc.ResizePartition(ctx, buffers[0], 5))
+	assert.True(t, errors.Is(svc.ResizePartition(ctx, "missing", 20), ErrBufferNotFound))
+
+	require.NoError(t, svc.PausePartition(ctx, buffers[1]))
+	readMessages, err = b.Read(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, readMessages)
+	assert.True(t, errors.Is(svc.PurgePartition(ctx, buffers[0], "1"), ErrPartitionNotPaused))
+	require.NoError(t, svc.PurgePartition(ctx, buffers[1], "3"))
+	info, err = svc.GetBufferInfo(ctx, buffers[1])
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), info.PendingCount)
+	require.NoError(t, svc.ResumePartition(ctx, buffers[1]))
+	assert.False(t, b.IsPaused())
+	assert.True(t, errors.Is(svc.PausePartition(ctx, "missing"), ErrBufferNotFound))
+
+	items, err := svc.ListBuffersAndBuckets(ctx, "default-test-pl-")
+	require.NoError(t, err)
+	assert.Equal(t, []ItemInfo{
+		{DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: buckets[0]}},
+		{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffers[0]}},
+		{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffers[1]}},
+	}, items)
+
+	report, err := svc.DeleteBuffersAndBuckets(ctx, append(buffers, "missing"), buckets, "", nil)
+	require.NoError(t, err)
+	assert.Len(t, report.Deleted, 3)
+	assert.Equal(t, []DeleteItem{{Kind: DeleteItemBuffer, Name: "missing"}}, report.NotFound)
+	_, err = svc.Buffer(buffers[0])
+	assert.True(t, errors.Is(err, ErrBufferNotFound))
+}
+
+func TestInMemorySvc_WatermarkStores(t *testing.T) {
+	ctx := context.Background()
+	svc := NewISBInMemorySvc()
+	defer func() { _ = svc.Close() }()
+	_, err := svc.CreateWatermarkStores(ctx, "test-bucket", 2, true)
+	assert.Error(t, err)
+
+	require.NoError(t, svc.CreateBuffersAndBuckets(ctx, nil, []string{"test-bucket"}, "", nil))
+	wmStores, err := svc.CreateWatermarkStores(ctx, "test-bucket", 2, true)
+	require.NoError(t, err)
+	require.Len(t, wmStores, 2)
+	// the stores of the partitions share the entries
+	require.NoError(t, wmStores[0].OffsetTimelineStore().PutKV(ctx, "p1", []byte("1")))
+	value, err := wmStores[1].OffsetTimelineStore().GetValue(ctx, "p1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+
+	require.NoError(t, svc.PurgeBuffers(ctx, nil, WithPurgeWatermarkBuckets("test-bucket")))
+	keys, err := wmStores[0].OffsetTimelineStore().GetAllKeys(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -18,7 +18,11 @@ package isbsvc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
@@ -26,12 +30,53 @@ import (
 type ISBService interface {
 	// CreateBuffersAndBuckets creates buffers and buckets
 	CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error
-	// DeleteBuffersAndBuckets deletes buffers and buckets
-	DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string) error
-	// ValidateBuffersAndBuckets validates buffers and buckets
-	ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceSTreams []string) error
+	// DeleteBuffersAndBuckets deletes buffers and buckets, it returns a report of the deleted, not found and failed items,
+	// with an error if any of the items failed to be deleted.
+	DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...DeleteOption) (*DeleteReport, error)
+	// ValidateBuffersAndBuckets validates buffers and buckets, the buffers with a buffer config or retention, see
+	// WithBufferConfig and WithBufferRetention, are validated against them, a drifted config is returned as a
+	// ConfigMismatchError.
+	ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceSTreams []string, opts ...CreateOption) error
+	// ListBuffersAndBuckets lists the buffers and buckets existing in the ISB Service, the names of which start with the prefix
+	ListBuffersAndBuckets(ctx context.Context, prefix string) ([]ItemInfo, error)
 	// GetBufferInfo returns buffer info for the given buffer
 	GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error)
+	// WatchPartitionInfo watches the info of a buffer partition, as returned by GetBufferInfo, it sends the current info
+	// right away, then the info every time it changes, so that the changes of the buffer pressure are picked up without
+	// polling. The channel is closed when the context is done or the partition is deleted. It returns an error wrapping
+	// ErrBufferNotFound if the partition does not exist.
+	WatchPartitionInfo(ctx context.Context, partition string) (<-chan *BufferInfo, error)
+	// GetBuffersInfo returns buffer info for the given buffers, batching the calls to the ISB Service. On partial
+	// failures, it returns the info of the succeeded buffers in the given order, with an error naming the failed ones.
+	GetBuffersInfo(ctx context.Context, buffers []string) ([]*BufferInfo, error)
+	// ReadBufferHistory reads the messages of the buffer from the position with an ephemeral reader, and calls fn with
+	// them in order, until fn returns false, the context is done or the last message existing when the read started
+	// is reached. The reader is detached from the pipeline, the offsets and the acks of the pipeline are not affected.
+	ReadBufferHistory(ctx context.Context, buffer string, from HistoryPosition, fn func(*HistoryMessage) bool) error
+	// PeekMessages returns at most count messages of the buffer partition, from the message with the offset, or from the
+	// oldest message if the offset is empty. The offset is a sequence as the start sequence of a HistoryPosition. The
+	// messages are read without the consumer of the pipeline, the partition is left untouched.
+	PeekMessages(ctx context.Context, partition, offset string, count int) ([]*HistoryMessage, error)
+	// PurgeBuffers drops the messages of the buffers, all of them unless the options bound the purge, and acknowledges
+	// the dropped messages pending in the buffers. It returns an error wrapping ErrBufferNotFound for a buffer which
+	// does not exist, the other buffers are still purged.
+	PurgeBuffers(ctx context.Context, buffers []string, opts ...PurgeOption) error
+	// ResizePartition changes the max length of a buffer partition in place, for a running pipeline, without deleting
+	// and recreating the stream, the messages of the partition are kept. The length can only be increased, it returns
+	// an error wrapping ErrBufferNotFound if the partition does not exist. The writers of the partition keep checking
+	// the length they are configured with, which comes from the pipeline spec.
+	ResizePartition(ctx context.Context, partition string, newBufferLength int64) error
+	// PausePartition stops the readers of a buffer partition from receiving new messages, the messages are retained and
+	// the ones received already can still be acknowledged. The readers pick the pause up on their next check. It returns
+	// an error wrapping ErrBufferNotFound if the partition does not exist.
+	PausePartition(ctx context.Context, partition string) error
+	// ResumePartition lets the readers of a paused buffer partition receive the messages again.
+	ResumePartition(ctx context.Context, partition string) error
+	// PurgePartition drops the messages of a buffer partition up to, and including, the message with the offset, which
+	// is a sequence as the start sequence of a HistoryPosition. The partition must be paused first, so that no reader is
+	// in the middle of the purged messages, otherwise it returns an error wrapping ErrPartitionNotPaused. Only the
+	// watermark buckets of the options apply, the purge is bounded by the offset.
+	PurgePartition(ctx context.Context, partition, upToOffset string, opts ...PurgeOption) error
 	// CreateWatermarkStores creates watermark stores
 	CreateWatermarkStores(ctx context.Context, bucketName string, partitions int, isReduce bool) ([]store.WatermarkStore, error)
 }
@@ -40,10 +85,24 @@ type ISBService interface {
 type createOptions struct {
 	// config is configuration for the to be created buffers and buckets
 	config string
+	// bufferConfigs is the configuration of the buffers overriding the config, keyed by the buffer names
+	bufferConfigs map[string]string
+	// bufferRetentions is the retention of the buffers, keyed by the buffer names
+	bufferRetentions map[string]dfv1.BufferRetention
 }
 
 type CreateOption func(*createOptions) error
 
+func newCreateOptions(opts ...CreateOption) (*createOptions, error) {
+	o := &createOptions{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	return o, nil
+}
+
 // WithConfig sets buffer and bucket config option
 func WithConfig(conf string) CreateOption {
 	return func(o *createOptions) error {
@@ -52,10 +111,225 @@ func WithConfig(conf string) CreateOption {
 	}
 }
 
+// WithBufferConfig sets the config of a buffer, it's merged on top of the config set by WithConfig, so that only the
+// settings different from the other buffers need to be set, e.g. the max length of the buffer of a slow sink.
+func WithBufferConfig(buffer, conf string) CreateOption {
+	return func(o *createOptions) error {
+		if buffer == "" {
+			return fmt.Errorf("invalid buffer config, the buffer name should not be empty")
+		}
+		if o.bufferConfigs == nil {
+			o.bufferConfigs = make(map[string]string)
+		}
+		o.bufferConfigs[buffer] = conf
+		return nil
+	}
+}
+
+// WithBufferRetention sets the retention of a buffer, the limits set take precedence over the ones in the buffer config.
+func WithBufferRetention(buffer string, retention dfv1.BufferRetention) CreateOption {
+	return func(o *createOptions) error {
+		if buffer == "" {
+			return fmt.Errorf("invalid buffer retention, the buffer name should not be empty")
+		}
+		if o.bufferRetentions == nil {
+			o.bufferRetentions = make(map[string]dfv1.BufferRetention)
+		}
+		o.bufferRetentions[buffer] = retention
+		return nil
+	}
+}
+
+// deleteOptions describes the options for deleting buffers and buckets
+type deleteOptions struct {
+	// force deletes the buffers and buckets even if they are owned by another pipeline
+	force bool
+	// concurrency is the max number of items deleted concurrently
+	concurrency int
+	// itemTimeout is the deadline of deleting a single item
+	itemTimeout time.Duration
+	// progress is called after an item is processed, with a nil error if it's deleted or not found
+	progress func(item DeleteItem, err error)
+}
+
+func defaultDeleteOptions() *deleteOptions {
+	return &deleteOptions{
+		concurrency: defaultDeleteConcurrency,
+		itemTimeout: defaultDeleteItemTimeout,
+	}
+}
+
+type DeleteOption func(*deleteOptions) error
+
+// WithForce sets whether to delete the buffers and buckets owned by another pipeline
+func WithForce(force bool) DeleteOption {
+	return func(o *deleteOptions) error {
+		o.force = force
+		return nil
+	}
+}
+
+// WithDeleteConcurrency sets the max number of items deleted concurrently
+func WithDeleteConcurrency(concurrency int) DeleteOption {
+	return func(o *deleteOptions) error {
+		if concurrency <= 0 {
+			return fmt.Errorf("invalid delete concurrency %d, it should be greater than 0", concurrency)
+		}
+		o.concurrency = concurrency
+		return nil
+	}
+}
+
+// WithDeleteItemTimeout sets the deadline of deleting a single item
+func WithDeleteItemTimeout(timeout time.Duration) DeleteOption {
+	return func(o *deleteOptions) error {
+		if timeout <= 0 {
+			return fmt.Errorf("invalid delete item timeout %v, it should be greater than 0", timeout)
+		}
+		o.itemTimeout = timeout
+		return nil
+	}
+}
+
+// WithDeleteProgress sets the function called after each item is processed
+func WithDeleteProgress(progress func(item DeleteItem, err error)) DeleteOption {
+	return func(o *deleteOptions) error {
+		o.progress = progress
+		return nil
+	}
+}
+
+// ErrBufferNotFound is returned when an operation targets a buffer which does not exist in the ISB Service
+var ErrBufferNotFound = errors.New("buffer not found")
+
+// ErrPartitionNotPaused is returned when an operation requires a paused buffer partition
+var ErrPartitionNotPaused = errors.New("partition not paused")
+
+// purgeOptions describes the options for purging buffers
+type purgeOptions struct {
+	// before purges only the messages written before the time, it's zero if the purge is not bounded by time
+	before time.Time
+	// keepRecent is the number of the most recent messages kept in the buffer
+	keepRecent int64
+	// watermarkBuckets are the buckets whose offset timelines are reset after the purge
+	watermarkBuckets []string
+	// upTo purges only the messages up to, and including, the offset, it's set by PurgePartition
+	upTo string
+}
+
+type PurgeOption func(*purgeOptions) error
+
+func newPurgeOptions(opts ...PurgeOption) (*purgeOptions, error) {
+	o := &purgeOptions{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	if o.upTo != "" && (!o.before.IsZero() || o.keepRecent > 0) {
+		return nil, fmt.Errorf("a purge up to offset %q can not be bounded by time or recent messages", o.upTo)
+	}
+	return o, nil
+}
+
+// WithPurgeBefore purges only the messages written to the buffers before the time
+func WithPurgeBefore(t time.Time) PurgeOption {
+	return func(o *purgeOptions) error {
+		o.before = t
+		return nil
+	}
+}
+
+// WithKeepRecent keeps the n most recent messages of the buffers, combined with WithPurgeBefore,
+// the messages which are either recent or written after the time are kept
+func WithKeepRecent(n int64) PurgeOption {
+	return func(o *purgeOptions) error {
+		if n < 0 {
+			return fmt.Errorf("invalid number of messages to keep %d, it should not be negative", n)
+		}
+		o.keepRecent = n
+		return nil
+	}
+}
+
+// WithPurgeWatermarkBuckets resets the offset timelines of the buckets once the buffers are purged, so that the
+// watermarks are not computed against the offsets which do not exist anymore
+func WithPurgeWatermarkBuckets(buckets ...string) PurgeOption {
+	return func(o *purgeOptions) error {
+		o.watermarkBuckets = buckets
+		return nil
+	}
+}
+
+// withPurgeUpTo purges only the messages up to, and including, the offset
+func withPurgeUpTo(offset string) PurgeOption {
+	return func(o *purgeOptions) error {
+		if offset == "" {
+			return fmt.Errorf("the offset to purge up to should not be empty")
+		}
+		o.upTo = offset
+		return nil
+	}
+}
+
 // BufferInfo wraps the buffer state information
 type BufferInfo struct {
 	Name            string
 	PendingCount    int64
 	AckPendingCount int64
 	TotalMessages   int64
+	// TotalBytes is the storage used by the messages in the buffer, it's zero if the information is not available.
+	TotalBytes int64
+	// OldestPendingTime is the time when the oldest message not acknowledged yet was written to the buffer,
+	// it's zero if the buffer is empty or the information is not available.
+	OldestPendingTime time.Time
+	// MaxLength is the max number of messages the buffer can hold as configured in the ISB Service,
+	// it's UnknownMaxLength if the ISB Service can not determine it.
+	MaxLength int64
+	// UsagePercentage is the percentage of the max length used by the messages in the buffer,
+	// it's UnknownUsagePercentage if the max length can not be determined.
+	UsagePercentage float64
+	// OldestMessageTime is the time when the oldest message existing in the buffer was written,
+	// it's zero if the buffer is empty or the information is not available.
+	OldestMessageTime time.Time
+	// Quota is the quota of the pipeline owning the buffer with the usage of it, it's nil if no quota is enforced.
+	Quota *QuotaUsage
+	// Consumers is the lag of each consumer reading the buffer, it's nil if the information is not available.
+	Consumers []ConsumerLag
+}
+
+// ConsumerLag is the lag of a consumer reading a buffer partition, the offsets are sequences as the one of a
+// HistoryMessage, empty if they are not available. A JetStream buffer has a single durable consumer shared by the
+// replicas reading it, while each replica is a consumer of the group of a Redis buffer.
+type ConsumerLag struct {
+	Name string
+	// ProducedOffset is the offset of the last message written to the partition.
+	ProducedOffset string
+	// DeliveredOffset is the offset of the last message delivered to the consumer. The consumers of a Redis group
+	// share it, as Redis only records the last message delivered to the group.
+	DeliveredOffset string
+	// AckedOffset is the offset up to which all the messages delivered to the consumer are acknowledged.
+	AckedOffset string
+	// PendingCount is the number of messages not delivered to the consumer yet, shared by the consumers of a group.
+	PendingCount int64
+	// AckPendingCount is the number of messages delivered to the consumer and not acknowledged yet.
+	AckPendingCount int64
+	// LastActive is the time when the consumer last read the partition, zero if it's not available.
+	LastActive time.Time
+}
+
+const (
+	// UnknownMaxLength is the max length of a buffer which can not be determined, it tells "unknown" from "empty".
+	UnknownMaxLength int64 = -1
+	// UnknownUsagePercentage is the usage percentage of a buffer whose max length can not be determined.
+	UnknownUsagePercentage float64 = -1
+)
+
+// ItemInfo describes a buffer or a bucket existing in the ISB Service
+type ItemInfo struct {
+	DeleteItem
+	// Created is the time when the item was created, it's zero if it's unknown.
+	Created time.Time
+	// Owner is the UID of the pipeline owning the item, it's empty if the ownership is not recorded.
+	Owner string
 }
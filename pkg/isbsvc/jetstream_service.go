@@ -17,16 +17,21 @@ limitations under the License.
 package isbsvc
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
@@ -34,11 +39,26 @@ import (
 
 type jetStreamSvc struct {
 	pipelineName string
-	jsClient     *jsclient.Client
-	js           nats.JetStreamContext
+	// pipelineUID is the UID of the pipeline, it is recorded in the metadata of the created streams to
+	// verify the ownership on deletion and validation. The verification is skipped if it's empty.
+	pipelineUID string
+	jsClient    *jsclient.Client
+	js          nats.JetStreamContext
+	// quota is the quota of the pipeline, enforced on creating the buffers and buckets.
+	quota      PipelineQuota
+	quotaCache quotaUsageCache
 }
 
-func NewISBJetStreamSvc(pipelineName string, jsClient *jsclient.Client) (ISBService, error) {
+type JetStreamSvcOption func(*jetStreamSvc)
+
+// WithPipelineUID sets the UID of the pipeline owning the buffers and buckets
+func WithPipelineUID(uid string) JetStreamSvcOption {
+	return func(j *jetStreamSvc) {
+		j.pipelineUID = uid
+	}
+}
+
+func NewISBJetStreamSvc(pipelineName string, jsClient *jsclient.Client, opts ...JetStreamSvcOption) (ISBService, error) {
 	jsCtx, err := jsClient.JetStreamContext()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get a JetStream context from nats connection, %w", err)
@@ -49,6 +69,9 @@ func NewISBJetStreamSvc(pipelineName string, jsClient *jsclient.Client) (ISBServ
 		jsClient:     jsClient,
 		js:           jsCtx,
 	}
+	for _, opt := range opts {
+		opt(j)
+	}
 	return j, nil
 }
 
@@ -57,69 +80,42 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 		return nil
 	}
 	log := logging.FromContext(ctx)
-	creatOpts := &createOptions{}
-	for _, opt := range opts {
-		if err := opt(creatOpts); err != nil {
-			return err
-		}
+	creatOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
 	}
-	v := viper.New()
-	v.SetConfigType("yaml")
-	if err := v.ReadConfig(bytes.NewBufferString(creatOpts.config)); err != nil {
+	v, err := creatOpts.viper("")
+	if err != nil {
 		return err
 	}
-
-	if sideInputsStore != "" {
-		kvName := JetStreamSideInputsStoreKVName(sideInputsStore)
-		if _, err := jss.js.KeyValue(kvName); err != nil {
-			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
-				return fmt.Errorf("failed to query information of KV %q, %w", kvName, err)
-			}
-			if _, err := jss.js.CreateKeyValue(&nats.KeyValueConfig{
-				Bucket:       kvName,
-				MaxValueSize: 0,
-				History:      1,                   // No history
-				TTL:          time.Hour * 24 * 30, // 30 days
-				MaxBytes:     0,
-				Storage:      nats.FileStorage,
-				Replicas:     v.GetInt("stream.replicas"),
-			}); err != nil {
-				return fmt.Errorf("failed to create side inputs KV %q, %w", kvName, err)
-			}
-			log.Infow("Succeeded to create a side inputs KV", zap.String("kvName", kvName))
-		}
+	if err := jss.checkPipelineQuota(ctx, creatOpts, buffers, buckets); err != nil {
+		return err
 	}
 
-	if len(servingSourceStreams) > 0 {
-		for _, servingSourceStream := range servingSourceStreams {
-			_, err := jss.js.StreamInfo(servingSourceStream)
-			if err != nil {
-				if !errors.Is(err, nats.ErrStreamNotFound) {
-					return fmt.Errorf("failed to query information of stream %q during buffer creating, %w", servingSourceStream, err)
-				}
-				if _, err := jss.js.AddStream(&nats.StreamConfig{
-					Name:       servingSourceStream,
-					Subjects:   []string{servingSourceStream}, // Use the stream name as the only subject
-					Storage:    nats.StorageType(v.GetInt("stream.storage")),
-					Replicas:   v.GetInt("stream.replicas"),
-					Retention:  nats.WorkQueuePolicy, // we can delete the message immediately after it's consumed and acked
-					MaxMsgs:    -1,                   // unlimited messages
-					MaxBytes:   -1,                   // unlimited bytes
-					Duplicates: v.GetDuration("stream.duplicates"),
-				}); err != nil {
-					return fmt.Errorf("failed to create serving source stream %q, %w", servingSourceStream, err)
-				}
-			}
-		}
+	if err := jss.createSideInputsStore(ctx, sideInputsStore, v); err != nil {
+		return err
+	}
+	if err := jss.createServingSourceStreams(servingSourceStreams, v); err != nil {
+		return err
 	}
 
 	for _, buffer := range buffers {
 		streamName := JetStreamName(buffer)
 		_, err := jss.js.StreamInfo(streamName)
-		if err != nil {
+		if err == nil {
+			// the stream exists, make sure it's not owned by another pipeline.
+			if err := jss.verifyStreamOwner(ctx, streamName, true); err != nil {
+				return err
+			}
+		} else {
 			if !errors.Is(err, nats.ErrStreamNotFound) {
 				return fmt.Errorf("failed to query information of stream %q during buffer creating, %w", streamName, err)
 			}
+			// the buffer config overrides the config of the ISB Service.
+			v, err := creatOpts.viper(buffer)
+			if err != nil {
+				return err
+			}
 			// get the retention policy from the stream config
 			retention := nats.RetentionPolicy(v.GetInt("stream.retention"))
 			discard := nats.DiscardNew
@@ -143,6 +139,10 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 			if retention == nats.LimitsPolicy {
 				discard = nats.DiscardOld
 			}
+			// the discard policy set in the buffer retention takes precedence.
+			if d, ok := creatOpts.discardPolicy(buffer); ok {
+				discard = d
+			}
 
 			if _, err := jss.js.AddStream(&nats.StreamConfig{
 				Name:       streamName,
@@ -155,19 +155,13 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 				Storage:    nats.StorageType(v.GetInt("stream.storage")),
 				Replicas:   v.GetInt("stream.replicas"),
 				Duplicates: v.GetDuration("stream.duplicates"), // No duplication in this period
+				Metadata:   jss.ownerMetadata(),
 			}); err != nil {
 				return fmt.Errorf("failed to create stream %q and buffers, %w", streamName, err)
 			}
 			log.Infow("Succeeded to create a stream", zap.String("stream", streamName))
-			if _, err := jss.js.AddConsumer(streamName, &nats.ConsumerConfig{
-				Durable:       streamName,
-				DeliverPolicy: nats.DeliverAllPolicy,
-				AckPolicy:     nats.AckExplicitPolicy,
-				AckWait:       v.GetDuration("consumer.ackWait"),
-				MaxAckPending: v.GetInt("consumer.maxAckPending"),
-				FilterSubject: streamName,
-			}); err != nil {
-				return fmt.Errorf("failed to create a consumer for stream %q, %w", streamName, err)
+			if err := jss.addBufferConsumer(streamName, v); err != nil {
+				return err
 			}
 			log.Infow("Succeeded to create a consumer for a stream", zap.String("stream", streamName), zap.String("consumer", streamName))
 		}
@@ -176,7 +170,11 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 	for _, bucket := range buckets {
 		// Create offset-timeline KV
 		otKVName := wmstore.JetStreamOTKVName(bucket)
-		if _, err := jss.js.KeyValue(otKVName); err != nil {
+		if _, err := jss.js.KeyValue(otKVName); err == nil {
+			if err := jss.verifyStreamOwner(ctx, jetStreamKVStreamName(otKVName), true); err != nil {
+				return err
+			}
+		} else {
 			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
 				return fmt.Errorf("failed to query information of bucket %q during buffer creating, %w", otKVName, err)
 			}
@@ -192,10 +190,17 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 			}); err != nil {
 				return fmt.Errorf("failed to create offset timeline KV %q, %w", otKVName, err)
 			}
+			if err := jss.claimStream(jetStreamKVStreamName(otKVName)); err != nil {
+				return err
+			}
 		}
 		// Create processor KV
 		procKVName := wmstore.JetStreamProcessorKVName(bucket)
-		if _, err := jss.js.KeyValue(procKVName); err != nil {
+		if _, err := jss.js.KeyValue(procKVName); err == nil {
+			if err := jss.verifyStreamOwner(ctx, jetStreamKVStreamName(procKVName), true); err != nil {
+				return err
+			}
+		} else {
 			if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
 				return fmt.Errorf("failed to query information of bucket %q during buffer creating, %w", procKVName, err)
 			}
@@ -211,102 +216,520 @@ func (jss *jetStreamSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, b
 			}); err != nil {
 				return fmt.Errorf("failed to create processor KV %q, %w", procKVName, err)
 			}
+			if err := jss.claimStream(jetStreamKVStreamName(procKVName)); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string) error {
-	if len(buffers) == 0 && len(buckets) == 0 {
+// createSideInputsStore creates the KV of the side inputs store if it does not exist.
+func (jss *jetStreamSvc) createSideInputsStore(ctx context.Context, sideInputsStore string, v *viper.Viper) error {
+	if sideInputsStore == "" {
 		return nil
 	}
+	kvName := JetStreamSideInputsStoreKVName(sideInputsStore)
+	if _, err := jss.js.KeyValue(kvName); err != nil {
+		if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to query information of KV %q, %w", kvName, err)
+		}
+		if _, err := jss.js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:       kvName,
+			MaxValueSize: 0,
+			History:      1,                   // No history
+			TTL:          time.Hour * 24 * 30, // 30 days
+			MaxBytes:     0,
+			Storage:      nats.FileStorage,
+			Replicas:     v.GetInt("stream.replicas"),
+		}); err != nil {
+			return fmt.Errorf("failed to create side inputs KV %q, %w", kvName, err)
+		}
+		logging.FromContext(ctx).Infow("Succeeded to create a side inputs KV", zap.String("kvName", kvName))
+	}
+	return nil
+}
+
+// createServingSourceStreams creates the serving source streams which do not exist.
+func (jss *jetStreamSvc) createServingSourceStreams(servingSourceStreams []string, v *viper.Viper) error {
+	for _, servingSourceStream := range servingSourceStreams {
+		_, err := jss.js.StreamInfo(servingSourceStream)
+		if err != nil {
+			if !errors.Is(err, nats.ErrStreamNotFound) {
+				return fmt.Errorf("failed to query information of stream %q during buffer creating, %w", servingSourceStream, err)
+			}
+			if _, err := jss.js.AddStream(&nats.StreamConfig{
+				Name:       servingSourceStream,
+				Subjects:   []string{servingSourceStream}, // Use the stream name as the only subject
+				Storage:    nats.StorageType(v.GetInt("stream.storage")),
+				Replicas:   v.GetInt("stream.replicas"),
+				Retention:  nats.WorkQueuePolicy, // we can delete the message immediately after it's consumed and acked
+				MaxMsgs:    -1,                   // unlimited messages
+				MaxBytes:   -1,                   // unlimited bytes
+				Duplicates: v.GetDuration("stream.duplicates"),
+			}); err != nil {
+				return fmt.Errorf("failed to create serving source stream %q, %w", servingSourceStream, err)
+			}
+		}
+	}
+	return nil
+}
+
+// addBufferConsumer adds the durable consumer of the stream of a buffer, named after the stream.
+func (jss *jetStreamSvc) addBufferConsumer(streamName string, v *viper.Viper) error {
+	if _, err := jss.js.AddConsumer(streamName, &nats.ConsumerConfig{
+		Durable:       streamName,
+		DeliverPolicy: nats.DeliverAllPolicy,
+		AckPolicy:     nats.AckExplicitPolicy,
+		AckWait:       v.GetDuration("consumer.ackWait"),
+		MaxAckPending: v.GetInt("consumer.maxAckPending"),
+		FilterSubject: streamName,
+	}); err != nil {
+		return fmt.Errorf("failed to create a consumer for stream %q, %w", streamName, err)
+	}
+	return nil
+}
+
+func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...DeleteOption) (*DeleteReport, error) {
+	if len(buffers) == 0 && len(buckets) == 0 && sideInputsStore == "" && len(servingSourceStreams) == 0 {
+		return &DeleteReport{}, nil
+	}
 	log := logging.FromContext(ctx)
-	for _, buffer := range buffers {
-		streamName := JetStreamName(buffer)
-		if err := jss.js.DeleteStream(streamName); err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
-			return fmt.Errorf("failed to delete stream %q, %w", streamName, err)
+	deleteOpts := defaultDeleteOptions()
+	for _, opt := range opts {
+		if err := opt(deleteOpts); err != nil {
+			return nil, err
 		}
-		log.Infow("Succeeded to delete a stream", zap.String("stream", streamName))
 	}
-	for _, bucket := range buckets {
-		otKVName := wmstore.JetStreamOTKVName(bucket)
-		if err := jss.js.DeleteKeyValue(otKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
-			return fmt.Errorf("failed to delete offset timeline KV %q, %w", otKVName, err)
+	// nothing is deleted if any of the streams is owned by another pipeline, unless it's forced.
+	var mismatches []error
+	for _, streamName := range ownedStreams(buffers, buckets) {
+		if err := jss.verifyStreamOwner(ctx, streamName, false); err != nil {
+			if !IsOwnershipMismatch(err) {
+				return nil, err
+			}
+			mismatches = append(mismatches, err)
 		}
-		log.Infow("Succeeded to delete an offset timeline KV", zap.String("kvName", otKVName))
-		procKVName := wmstore.JetStreamProcessorKVName(bucket)
-		if err := jss.js.DeleteKeyValue(procKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
-			return fmt.Errorf("failed to delete processor KV %q, %w", procKVName, err)
+	}
+	if len(mismatches) > 0 {
+		if !deleteOpts.force {
+			return nil, fmt.Errorf("refused to delete the streams owned by another pipeline, %w", errors.Join(mismatches...))
 		}
-		log.Infow("Succeeded to delete a processor KV", zap.String("kvName", procKVName))
+		log.Warnw("Force deleting the streams owned by another pipeline", zap.Error(errors.Join(mismatches...)))
 	}
 
+	var tasks []deleteTask
+	for _, buffer := range buffers {
+		streamName := JetStreamName(buffer)
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffer},
+			delete: func(ctx context.Context) error {
+				return jss.deleteStream(ctx, streamName)
+			},
+		})
+	}
+	for _, bucket := range buckets {
+		kvNames := []string{wmstore.JetStreamOTKVName(bucket), wmstore.JetStreamProcessorKVName(bucket)}
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: bucket},
+			delete: func(ctx context.Context) error {
+				// the bucket is not found only if both the offset timeline KV and the processor KV are not found.
+				notFound := 0
+				for _, kvName := range kvNames {
+					if err := jss.deleteStream(ctx, jetStreamKVStreamName(kvName)); err != nil {
+						if !errors.Is(err, errItemNotFound) {
+							return err
+						}
+						notFound++
+					}
+				}
+				if notFound == len(kvNames) {
+					return errItemNotFound
+				}
+				return nil
+			},
+		})
+	}
 	if sideInputsStore != "" {
 		sideInputsKVName := JetStreamSideInputsStoreKVName(sideInputsStore)
-		if err := jss.js.DeleteKeyValue(sideInputsKVName); err != nil && !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
-			return fmt.Errorf("failed to delete side inputs KV %q, %w", sideInputsKVName, err)
-		}
-		log.Infow("Succeeded to delete a side inputs KV", zap.String("kvName", sideInputsKVName))
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemSideInputsStore, Name: sideInputsStore},
+			delete: func(ctx context.Context) error {
+				return jss.deleteStream(ctx, jetStreamKVStreamName(sideInputsKVName))
+			},
+		})
+	}
+	for _, servingSourceStream := range servingSourceStreams {
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemServingSourceStream, Name: servingSourceStream},
+			delete: func(ctx context.Context) error {
+				return jss.deleteStream(ctx, servingSourceStream)
+			},
+		})
 	}
 
-	if len(servingSourceStreams) > 0 {
-		for _, servingSourceStream := range servingSourceStreams {
-			if err := jss.js.DeleteStream(servingSourceStream); err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
-				return fmt.Errorf("failed to delete serving source stream %q, %w", servingSourceStream, err)
-			}
-			log.Infow("Succeeded to delete the serving source stream", zap.String("stream", servingSourceStream))
+	processed := 0
+	report := bulkDelete(ctx, tasks, deleteOpts.concurrency, deleteOpts.itemTimeout, func(item DeleteItem, err error) {
+		processed++
+		if err != nil {
+			log.Errorw("Failed to delete an item", zap.Stringer("item", item), zap.Int("processed", processed), zap.Int("total", len(tasks)), zap.Error(err))
+		} else {
+			log.Infow("Succeeded to delete an item", zap.Stringer("item", item), zap.Int("processed", processed), zap.Int("total", len(tasks)))
+		}
+		if deleteOpts.progress != nil {
+			deleteOpts.progress(item, err)
+		}
+	})
+	return report, report.Err()
+}
+
+// deleteStream deletes a stream within the deadline of the context, it returns an error wrapping errItemNotFound
+// if the stream does not exist.
+func (jss *jetStreamSvc) deleteStream(ctx context.Context, streamName string) error {
+	if err := jss.js.DeleteStream(streamName, nats.Context(ctx)); err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("stream %q %w", streamName, errItemNotFound)
 		}
+		return fmt.Errorf("failed to delete stream %q, %w", streamName, err)
 	}
 	return nil
 }
 
-func (jss *jetStreamSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string) error {
+// ValidateBuffersAndBuckets validates the buffers and buckets, all the problems found are returned as a ValidationError,
+// see diagnose.
+func (jss *jetStreamSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error {
 	if len(buffers) == 0 && len(buckets) == 0 {
 		return nil
 	}
+	validateOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
+	}
+	diagnostics, err := jss.diagnose(ctx, buffers, buckets, sideInputsStore, servingSourceStreams, validateOpts)
+	if err != nil {
+		return err
+	}
+	return newValidationError(diagnostics)
+}
+
+// diagnose returns the problems of the buffers and buckets, it only returns an error if they can not be validated.
+// The replicas of the streams are validated if they are set in the config or the buffer configs, and the other
+// settings of a buffer if it has a buffer config or retention. The streams created before the ownership is recorded
+// are adopted.
+func (jss *jetStreamSvc) diagnose(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, o *createOptions) ([]Diagnostic, error) {
+	v, err := o.viper("")
+	if err != nil {
+		return nil, err
+	}
+	var diagnostics []Diagnostic
+	// streamInfo returns the info of the stream, nil if it does not exist, which is diagnosed.
+	streamInfo := func(item DeleteItem, streamName string) (*nats.StreamInfo, error) {
+		info, err := jss.js.StreamInfo(streamName)
+		if err == nil {
+			return info, nil
+		}
+		if !errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("failed to query information of stream %q, %w", streamName, err)
+		}
+		diagnostics = append(diagnostics, Diagnostic{Kind: DiagnosticMissingStream, Item: item, Stream: streamName,
+			Err: fmt.Errorf("failed to query information of stream %q, %w", streamName, err)})
+		return nil, nil
+	}
+	verifyOwner := func(item DeleteItem, streamName string) error {
+		err := jss.verifyStreamOwner(ctx, streamName, true)
+		if IsOwnershipMismatch(err) {
+			diagnostics = append(diagnostics, Diagnostic{Kind: DiagnosticOwnershipMismatch, Item: item, Stream: streamName, Err: err})
+			return nil
+		}
+		return err
+	}
+	addMismatch := func(item DeleteItem, m *ConfigMismatchError) {
+		kind := DiagnosticConfigMismatch
+		if strings.HasSuffix(m.Field, ".replicas") {
+			kind = DiagnosticReplicasMismatch
+		}
+		diagnostics = append(diagnostics, Diagnostic{Kind: kind, Item: item, Stream: m.Stream, Err: m})
+	}
 
 	for _, buffer := range buffers {
+		item := DeleteItem{Kind: DeleteItemBuffer, Name: buffer}
 		streamName := JetStreamName(buffer)
-		if _, err := jss.js.StreamInfo(streamName); err != nil {
-			return fmt.Errorf("failed to query information of stream %q, %w", streamName, err)
+		info, err := streamInfo(item, streamName)
+		if err != nil {
+			return nil, err
+		}
+		if info == nil {
+			continue
+		}
+		if err := verifyOwner(item, streamName); err != nil {
+			return nil, err
+		}
+		if _, err := jss.js.ConsumerInfo(streamName, streamName); err != nil {
+			if !errors.Is(err, nats.ErrConsumerNotFound) {
+				return nil, fmt.Errorf("failed to query information of consumer %q, %w", streamName, err)
+			}
+			diagnostics = append(diagnostics, Diagnostic{Kind: DiagnosticMissingConsumer, Item: item, Stream: streamName,
+				Err: fmt.Errorf("failed to query information of consumer %q, %w", streamName, err)})
+		}
+		bv, err := o.viper(buffer)
+		if err != nil {
+			return nil, err
+		}
+		if m := replicasMismatch(info, bv, "stream.replicas"); m != nil {
+			addMismatch(item, m)
+		}
+		_, hasConfig := o.bufferConfigs[buffer]
+		_, hasRetention := o.bufferRetentions[buffer]
+		if !hasConfig && !hasRetention {
+			continue
+		}
+		mismatches, err := jss.validateBufferConfig(buffer, o)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mismatches {
+			addMismatch(item, m)
 		}
 	}
 	for _, bucket := range buckets {
-		otKVName := wmstore.JetStreamOTKVName(bucket)
-		if _, err := jss.js.KeyValue(otKVName); err != nil {
-			return fmt.Errorf("failed to query OT KV %q, %w", otKVName, err)
+		item := DeleteItem{Kind: DeleteItemBucket, Name: bucket}
+		kvs := []struct{ name, replicasKey string }{
+			{wmstore.JetStreamOTKVName(bucket), "otBucket.replicas"},
+			{wmstore.JetStreamProcessorKVName(bucket), "procBucket.replicas"},
 		}
-
-		procKVName := wmstore.JetStreamProcessorKVName(bucket)
-		if _, err := jss.js.KeyValue(procKVName); err != nil {
-			return fmt.Errorf("failed to query processor KV %q, %w", procKVName, err)
+		for _, kv := range kvs {
+			streamName := jetStreamKVStreamName(kv.name)
+			info, err := streamInfo(item, streamName)
+			if err != nil {
+				return nil, err
+			}
+			if info == nil {
+				continue
+			}
+			if err := verifyOwner(item, streamName); err != nil {
+				return nil, err
+			}
+			if m := replicasMismatch(info, v, kv.replicasKey); m != nil {
+				addMismatch(item, m)
+			}
 		}
 	}
 	if sideInputsStore != "" {
-		sideInputsKVName := JetStreamSideInputsStoreKVName(sideInputsStore)
-		if _, err := jss.js.KeyValue(sideInputsKVName); err != nil {
-			return fmt.Errorf("failed to query side inputs store KV %q, %w", sideInputsKVName, err)
+		item := DeleteItem{Kind: DeleteItemSideInputsStore, Name: sideInputsStore}
+		if _, err := streamInfo(item, jetStreamKVStreamName(JetStreamSideInputsStoreKVName(sideInputsStore))); err != nil {
+			return nil, err
+		}
+	}
+	for _, servingSourceStream := range servingSourceStreams {
+		item := DeleteItem{Kind: DeleteItemServingSourceStream, Name: servingSourceStream}
+		if _, err := streamInfo(item, servingSourceStream); err != nil {
+			return nil, err
 		}
 	}
-	if len(servingSourceStreams) > 0 {
-		for _, servingSourceStream := range servingSourceStreams {
-			if _, err := jss.js.StreamInfo(servingSourceStream); err != nil {
-				return fmt.Errorf("failed to query information of stream %q, %w", servingSourceStream, err)
+	return diagnostics, nil
+}
+
+var _ Repairer = (*jetStreamSvc)(nil)
+
+// RepairBuffersAndBuckets creates the missing streams, KVs and consumers as CreateBuffersAndBuckets does, and updates
+// the replicas and the settings of the streams and the consumers, except the retention and the storage of a stream,
+// which can not be changed in place.
+func (jss *jetStreamSvc) RepairBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) (*RepairReport, error) {
+	if len(buffers) == 0 && len(buckets) == 0 {
+		return &RepairReport{}, nil
+	}
+	repairOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	before, err := jss.diagnose(ctx, buffers, buckets, sideInputsStore, servingSourceStreams, repairOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(before) == 0 {
+		return &RepairReport{}, nil
+	}
+	v, err := repairOpts.viper("")
+	if err != nil {
+		return nil, err
+	}
+	log := logging.FromContext(ctx)
+	var (
+		missingBuffers, missingBuckets []string
+		errs                           []error
+	)
+	for _, d := range before {
+		var err error
+		switch d.Kind {
+		case DiagnosticMissingStream:
+			switch d.Item.Kind {
+			case DeleteItemBuffer:
+				missingBuffers = append(missingBuffers, d.Item.Name)
+			case DeleteItemBucket:
+				if !slices.Contains(missingBuckets, d.Item.Name) {
+					missingBuckets = append(missingBuckets, d.Item.Name)
+				}
+			case DeleteItemSideInputsStore:
+				err = jss.createSideInputsStore(ctx, d.Item.Name, v)
+			case DeleteItemServingSourceStream:
+				err = jss.createServingSourceStreams([]string{d.Item.Name}, v)
 			}
+		case DiagnosticMissingConsumer:
+			err = jss.repairConsumer(d, repairOpts)
+		case DiagnosticReplicasMismatch, DiagnosticConfigMismatch:
+			err = jss.repairConfig(d, repairOpts)
+		default:
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to repair %s, %w", d, err))
+		}
+	}
+	// the buffers and buckets missing streams are created with the options, the existing streams are kept.
+	if len(missingBuffers) > 0 || len(missingBuckets) > 0 {
+		if err := jss.CreateBuffersAndBuckets(ctx, missingBuffers, missingBuckets, "", nil, opts...); err != nil {
+			errs = append(errs, fmt.Errorf("failed to create the missing buffers and buckets, %w", err))
 		}
 	}
+	after, err := jss.diagnose(ctx, buffers, buckets, sideInputsStore, servingSourceStreams, repairOpts)
+	if err != nil {
+		return nil, err
+	}
+	report := newRepairReport(before, after)
+	for _, d := range report.Repaired {
+		log.Infow("Repaired a buffer or bucket", zap.Stringer("diagnostic", d))
+	}
+	return report, errors.Join(append(errs, newValidationError(after))...)
+}
+
+// repairConsumer adds the missing consumer of a buffer.
+func (jss *jetStreamSvc) repairConsumer(d Diagnostic, o *createOptions) error {
+	v, err := o.viper(d.Item.Name)
+	if err != nil {
+		return err
+	}
+	return jss.addBufferConsumer(d.Stream, v)
+}
+
+// repairConfig updates the setting of the stream or the consumer of the config mismatch to the expected value.
+func (jss *jetStreamSvc) repairConfig(d Diagnostic, o *createOptions) error {
+	var m *ConfigMismatchError
+	if !errors.As(d.Err, &m) {
+		return fmt.Errorf("no config mismatch to repair, %w", d.Err)
+	}
+	// the KVs of the buckets are configured with the config, not a buffer config.
+	buffer := ""
+	if d.Item.Kind == DeleteItemBuffer {
+		buffer = d.Item.Name
+	}
+	v, err := o.viper(buffer)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(m.Field, "consumer.") {
+		info, err := jss.js.ConsumerInfo(d.Stream, d.Stream)
+		if err != nil {
+			return fmt.Errorf("failed to query information of consumer %q, %w", d.Stream, err)
+		}
+		cfg := info.Config
+		switch m.Field {
+		case "consumer.ackWait":
+			cfg.AckWait = v.GetDuration(m.Field)
+		case "consumer.maxAckPending":
+			cfg.MaxAckPending = v.GetInt(m.Field)
+		default:
+			return fmt.Errorf("%s can not be changed in place", m.Field)
+		}
+		if _, err := jss.js.UpdateConsumer(d.Stream, &cfg); err != nil {
+			return fmt.Errorf("failed to update consumer %q, %w", d.Stream, err)
+		}
+		return nil
+	}
+	info, err := jss.js.StreamInfo(d.Stream)
+	if err != nil {
+		return fmt.Errorf("failed to query information of stream %q, %w", d.Stream, err)
+	}
+	cfg := info.Config
+	switch m.Field {
+	case "stream.maxMsgs":
+		cfg.MaxMsgs = v.GetInt64(m.Field)
+	case "stream.maxBytes":
+		cfg.MaxBytes = v.GetInt64(m.Field)
+	case "stream.maxAge":
+		cfg.MaxAge = v.GetDuration(m.Field)
+	case "stream.duplicates":
+		cfg.Duplicates = v.GetDuration(m.Field)
+	case "discard":
+		cfg.Discard, _ = o.discardPolicy(buffer)
+	case "stream.replicas", "otBucket.replicas", "procBucket.replicas":
+		cfg.Replicas = max(v.GetInt(m.Field), 1)
+	default:
+		return fmt.Errorf("%s can not be changed in place", m.Field)
+	}
+	if _, err := jss.js.UpdateStream(&cfg); err != nil {
+		return fmt.Errorf("failed to update stream %q, %w", d.Stream, err)
+	}
 	return nil
 }
 
+// ListBuffersAndBuckets lists the buffers and buckets with the given prefix. A bucket is listed if any of its KVs
+// exists, with the creation time of the oldest one. The side inputs stores and the serving source streams are
+// not listed.
+func (jss *jetStreamSvc) ListBuffersAndBuckets(ctx context.Context, prefix string) ([]ItemInfo, error) {
+	var items []ItemInfo
+	buckets := make(map[string]int)
+	for info := range jss.js.StreamsInfo(nats.Context(ctx)) {
+		name, owner := info.Config.Name, info.Config.Metadata[dfv1.KeyPipelineUID]
+		kvName, isKV := strings.CutPrefix(name, "KV_")
+		if !isKV {
+			if strings.HasPrefix(name, prefix) {
+				items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: name}, Created: info.Created, Owner: owner})
+			}
+			continue
+		}
+		// the KVs of a bucket are {bucket}_OT and {bucket}_PROCESSORS
+		bucket, ok := strings.CutSuffix(kvName, "_OT")
+		if !ok {
+			bucket, ok = strings.CutSuffix(kvName, "_PROCESSORS")
+		}
+		if !ok || !strings.HasPrefix(bucket, prefix) {
+			continue
+		}
+		if i, seen := buckets[bucket]; seen {
+			if info.Created.Before(items[i].Created) {
+				items[i].Created = info.Created
+			}
+			if items[i].Owner == "" {
+				items[i].Owner = owner
+			}
+			continue
+		}
+		buckets[bucket] = len(items)
+		items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: bucket}, Created: info.Created, Owner: owner})
+	}
+	// the listing stops silently on errors, a cancelled or expired context is the only one which can be detected
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list the streams, %w", err)
+	}
+	sortItemInfos(items)
+	return items, nil
+}
+
 func (jss *jetStreamSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
 	streamName := JetStreamName(buffer)
 	stream, err := jss.js.StreamInfo(streamName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get information of stream %q", streamName)
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return nil, fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
 	}
 	consumer, err := jss.js.ConsumerInfo(streamName, streamName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get consumer information of stream %q", streamName)
+		if errors.Is(err, nats.ErrStreamNotFound) || errors.Is(err, nats.ErrConsumerNotFound) {
+			return nil, fmt.Errorf("consumer of stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return nil, fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
 	}
 	totalMessages := int64(stream.State.Msgs)
 	if stream.Config.Retention == nats.LimitsPolicy {
@@ -317,10 +740,402 @@ func (jss *jetStreamSvc) GetBufferInfo(ctx context.Context, buffer string) (*Buf
 		PendingCount:    int64(consumer.NumPending),
 		AckPendingCount: int64(consumer.NumAckPending),
 		TotalMessages:   totalMessages,
+		TotalBytes:      int64(stream.State.Bytes),
+		MaxLength:       UnknownMaxLength,
+		UsagePercentage: UnknownUsagePercentage,
+	}
+	// the max messages of the stream is -1 if it's unlimited
+	if stream.Config.MaxMsgs > 0 {
+		bufferInfo.MaxLength = stream.Config.MaxMsgs
+		bufferInfo.UsagePercentage = float64(totalMessages) * 100 / float64(stream.Config.MaxMsgs)
+	}
+	if stream.State.Msgs > 0 {
+		bufferInfo.OldestMessageTime = stream.State.FirstTime
+	}
+	// the replicas reading the buffer share the durable consumer, the lag is of all of them.
+	consumerLag := ConsumerLag{
+		Name:            consumer.Name,
+		ProducedOffset:  strconv.FormatUint(stream.State.LastSeq, 10),
+		DeliveredOffset: strconv.FormatUint(consumer.Delivered.Stream, 10),
+		AckedOffset:     strconv.FormatUint(consumer.AckFloor.Stream, 10),
+		PendingCount:    int64(consumer.NumPending),
+		AckPendingCount: int64(consumer.NumAckPending),
+	}
+	if consumer.Delivered.Last != nil {
+		consumerLag.LastActive = *consumer.Delivered.Last
+	}
+	bufferInfo.Consumers = []ConsumerLag{consumerLag}
+	if bufferInfo.PendingCount+bufferInfo.AckPendingCount > 0 {
+		// the message right after the ack floor is the oldest one not acknowledged yet, the age is best effort,
+		// it's left empty if the message can not be retrieved.
+		if msg, err := jss.js.GetMsg(streamName, consumer.AckFloor.Stream+1); err == nil {
+			bufferInfo.OldestPendingTime = msg.Time
+		}
+	}
+	// the quota usage is best effort, it's left empty if it can not be computed.
+	if usage, err := jss.cachedQuotaUsage(ctx); err == nil {
+		bufferInfo.Quota = usage
 	}
 	return bufferInfo, nil
 }
 
+// WatchPartitionInfo checks the stream and the consumer info of the partition at the watch interval.
+func (jss *jetStreamSvc) WatchPartitionInfo(ctx context.Context, partition string) (<-chan *BufferInfo, error) {
+	return watchPartitionInfo(ctx, partition, partitionInfoWatchInterval, jss.GetBufferInfo)
+}
+
+// GetBuffersInfo issues the stream info requests of the buffers concurrently with a bounded worker pool.
+func (jss *jetStreamSvc) GetBuffersInfo(ctx context.Context, buffers []string) ([]*BufferInfo, error) {
+	return concurrentBuffersInfo(ctx, buffers, bufferInfoConcurrency, jss.GetBufferInfo)
+}
+
+var _ ClusterHealthChecker = (*jetStreamSvc)(nil)
+
+func (jss *jetStreamSvc) ClusterHealth(ctx context.Context) (*ClusterHealth, error) {
+	// the account information is served by the meta leader, it fails if the cluster has no meta leader
+	if _, err := jss.js.AccountInfo(nats.Context(ctx)); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("failed to get the account information, %w", err)
+		}
+		return &ClusterHealth{Available: false, Message: fmt.Sprintf("JetStream cluster is not available, %v", err)}, nil
+	}
+	health := &ClusterHealth{Available: true}
+	for info := range jss.js.StreamsInfo(nats.Context(ctx)) {
+		health.Streams = append(health.Streams, streamHealth(info))
+	}
+	// the listing stops silently on errors, a cancelled or expired context is the only one which can be detected
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list the streams, %w", err)
+	}
+	sort.Slice(health.Streams, func(i, j int) bool { return health.Streams[i].Name < health.Streams[j].Name })
+	return health, nil
+}
+
+func streamHealth(info *nats.StreamInfo) StreamHealth {
+	sh := StreamHealth{Name: info.Config.Name, Replicas: info.Config.Replicas}
+	if sh.Replicas < 1 {
+		sh.Replicas = 1
+	}
+	if info.Cluster == nil {
+		// not clustered, the stream is served by the only server
+		sh.HasLeader, sh.CurrentReplicas = true, sh.Replicas
+		return sh
+	}
+	sh.Leader = info.Cluster.Leader
+	if sh.Leader != "" {
+		sh.HasLeader = true
+		sh.CurrentReplicas++
+	}
+	for _, peer := range info.Cluster.Replicas {
+		if peer != nil && peer.Current && !peer.Offline {
+			sh.CurrentReplicas++
+		}
+	}
+	return sh
+}
+
+func (jss *jetStreamSvc) ReadBufferHistory(ctx context.Context, buffer string, from HistoryPosition, fn func(*HistoryMessage) bool) error {
+	if err := from.validate(); err != nil {
+		return err
+	}
+	streamName := JetStreamName(buffer)
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	if stream.Config.Retention == nats.WorkQueuePolicy {
+		// a work queue stream doesn't allow another consumer, and the acknowledged messages are already removed.
+		return fmt.Errorf("stream %q has the work queue retention policy, its history can not be read", streamName)
+	}
+	// the read stops at the last message existing now, it doesn't wait for the new ones.
+	lastSeq := stream.State.LastSeq
+	startOpt := nats.DeliverAll()
+	switch {
+	case from.StartSequence != "":
+		seq, err := strconv.ParseUint(from.StartSequence, 10, 64)
+		if err != nil || seq == 0 {
+			return fmt.Errorf("invalid start sequence %q of stream %q, it should be a positive integer", from.StartSequence, streamName)
+		}
+		if seq > lastSeq {
+			return nil
+		}
+		startOpt = nats.StartSequence(seq)
+	case !from.StartTime.IsZero():
+		if stream.State.LastTime.Before(from.StartTime) {
+			return nil
+		}
+		startOpt = nats.StartTime(from.StartTime)
+	}
+	if stream.State.Msgs == 0 {
+		return nil
+	}
+	// an ordered consumer is an ephemeral consumer without acks, it's deleted when the subscription is dropped,
+	// so the durable consumer of the pipeline is left untouched.
+	sub, err := jss.js.SubscribeSync(streamName, nats.BindStream(streamName), nats.OrderedConsumer(), startOpt)
+	if err != nil {
+		return fmt.Errorf("failed to create an ephemeral consumer of stream %q, %w", streamName, err)
+	}
+	defer func() { _ = sub.Unsubscribe() }()
+	for {
+		msg, err := sub.NextMsgWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read the history of stream %q, %w", streamName, err)
+		}
+		meta, err := msg.Metadata()
+		if err != nil {
+			return fmt.Errorf("failed to get the metadata of a message of stream %q, %w", streamName, err)
+		}
+		if meta.Sequence.Stream > lastSeq {
+			return nil
+		}
+		historyMsg := &HistoryMessage{
+			Sequence:  strconv.FormatUint(meta.Sequence.Stream, 10),
+			WriteTime: meta.Timestamp,
+		}
+		if err := historyMsg.Message.UnmarshalBinary(msg.Data); err != nil {
+			return fmt.Errorf("failed to decode message %d of stream %q, %w", meta.Sequence.Stream, streamName, err)
+		}
+		if !fn(historyMsg) || meta.Sequence.Stream == lastSeq {
+			return nil
+		}
+	}
+}
+
+// PeekMessages gets the messages of the stream of the partition by their sequences with direct reads, so that the
+// messages of a work queue stream, the history of which can not be read, can be peeked as well.
+func (jss *jetStreamSvc) PeekMessages(ctx context.Context, partition, offset string, count int) ([]*HistoryMessage, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("invalid count %d, it should be positive", count)
+	}
+	streamName := JetStreamName(partition)
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return nil, fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	seq := stream.State.FirstSeq
+	if offset != "" {
+		start, err := strconv.ParseUint(offset, 10, 64)
+		if err != nil || start == 0 {
+			return nil, fmt.Errorf("invalid offset %q of stream %q, it should be a positive integer", offset, streamName)
+		}
+		seq = max(seq, start)
+	}
+	var msgs []*HistoryMessage
+	// the sequences of the deleted messages, e.g. the acknowledged ones of a work queue stream, are skipped
+	for ; seq <= stream.State.LastSeq && len(msgs) < count; seq++ {
+		msg, err := jss.js.GetMsg(streamName, seq, nats.Context(ctx))
+		if err != nil {
+			if errors.Is(err, nats.ErrMsgNotFound) {
+				continue
+			}
+			return msgs, fmt.Errorf("failed to get message %d of stream %q, %w", seq, streamName, err)
+		}
+		historyMsg := &HistoryMessage{
+			Sequence:  strconv.FormatUint(msg.Sequence, 10),
+			WriteTime: msg.Time,
+		}
+		if err := historyMsg.Message.UnmarshalBinary(msg.Data); err != nil {
+			return msgs, fmt.Errorf("failed to decode message %d of stream %q, %w", seq, streamName, err)
+		}
+		msgs = append(msgs, historyMsg)
+	}
+	return msgs, nil
+}
+
+// PurgeBuffers purges the streams of the buffers, then the offset timeline KVs of the watermark buckets.
+func (jss *jetStreamSvc) PurgeBuffers(ctx context.Context, buffers []string, opts ...PurgeOption) error {
+	o, err := newPurgeOptions(opts...)
+	if err != nil {
+		return err
+	}
+	log := logging.FromContext(ctx)
+	var errs []error
+	for _, buffer := range buffers {
+		if err := jss.purgeStream(ctx, JetStreamName(buffer), o); err != nil {
+			errs = append(errs, fmt.Errorf("failed to purge buffer %q, %w", buffer, err))
+			continue
+		}
+		log.Infow("Purged buffer", zap.String("buffer", buffer), zap.Time("before", o.before), zap.Int64("keepRecent", o.keepRecent), zap.String("upTo", o.upTo))
+	}
+	for _, bucket := range o.watermarkBuckets {
+		// the publishers write a new timeline entry on the next write or heartbeat.
+		otStreamName := jetStreamKVStreamName(wmstore.JetStreamOTKVName(bucket))
+		if err := jss.js.PurgeStream(otStreamName, nats.Context(ctx)); err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
+			errs = append(errs, fmt.Errorf("failed to reset the offset timeline of bucket %q, %w", bucket, err))
+			continue
+		}
+		log.Infow("Reset offset timeline", zap.String("bucket", bucket))
+	}
+	return errors.Join(errs...)
+}
+
+func (jss *jetStreamSvc) purgeStream(ctx context.Context, streamName string, o *purgeOptions) error {
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	req := &nats.StreamPurgeRequest{}
+	if o.upTo != "" {
+		seq, err := strconv.ParseUint(o.upTo, 10, 64)
+		if err != nil || seq == 0 {
+			return fmt.Errorf("invalid offset %q of stream %q, it should be a positive integer", o.upTo, streamName)
+		}
+		// the messages up to, but not including, the sequence are purged.
+		if stream.State.Msgs == 0 || seq+1 <= stream.State.FirstSeq {
+			return nil
+		}
+		req.Sequence = seq + 1
+	} else if o.before.IsZero() {
+		if o.keepRecent > 0 {
+			req.Keep = uint64(o.keepRecent)
+		}
+	} else {
+		// the messages up to, but not including, the sequence are purged.
+		seq, err := jss.firstSequenceAt(ctx, streamName, stream.State, o.before)
+		if err != nil {
+			return err
+		}
+		// the sequences might have gaps left by the acknowledged messages of a work queue stream,
+		// so the recent messages are kept by the sequence, which keeps at most keepRecent messages.
+		if keep := uint64(o.keepRecent); keep > 0 {
+			seq = min(seq, stream.State.LastSeq+1-min(keep, stream.State.LastSeq))
+		}
+		if stream.State.Msgs == 0 || seq <= stream.State.FirstSeq {
+			return nil
+		}
+		req.Sequence = seq
+	}
+	if err := jss.js.PurgeStream(streamName, req, nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to purge stream %q, %w", streamName, err)
+	}
+	return nil
+}
+
+// firstSequenceAt returns the sequence following the last message of the stream written before the time,
+// it binary searches the sequences with direct reads since a work queue stream doesn't allow another consumer.
+func (jss *jetStreamSvc) firstSequenceAt(ctx context.Context, streamName string, state nats.StreamState, t time.Time) (uint64, error) {
+	if state.Msgs == 0 || !state.FirstTime.Before(t) {
+		return state.FirstSeq, nil
+	}
+	if state.LastTime.Before(t) {
+		return state.LastSeq + 1, nil
+	}
+	lo, hi := state.FirstSeq, state.LastSeq+1
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		// the time of the first message from mid is not decreasing with mid, the deleted sequences are skipped.
+		seq, found := mid, false
+		var msg *nats.RawStreamMsg
+		for ; seq < hi; seq++ {
+			var err error
+			if msg, err = jss.js.GetMsg(streamName, seq, nats.Context(ctx)); err == nil {
+				found = true
+				break
+			} else if !errors.Is(err, nats.ErrMsgNotFound) {
+				return 0, fmt.Errorf("failed to get message %d of stream %q, %w", seq, streamName, err)
+			}
+		}
+		if found && msg.Time.Before(t) {
+			lo = seq + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
+// ResizePartition updates the max messages of the stream of the partition, the stream keeps its messages.
+func (jss *jetStreamSvc) ResizePartition(ctx context.Context, partition string, newBufferLength int64) error {
+	if newBufferLength <= 0 {
+		return fmt.Errorf("invalid buffer length %d of partition %q, it should be positive", newBufferLength, partition)
+	}
+	streamName := JetStreamName(partition)
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	cfg := stream.Config
+	// the max messages of the stream is -1 if it's unlimited, any length would shrink it
+	if cfg.MaxMsgs <= 0 || newBufferLength < cfg.MaxMsgs {
+		return fmt.Errorf("failed to resize stream %q to %d, the max messages %d can not be decreased", streamName, newBufferLength, cfg.MaxMsgs)
+	}
+	if newBufferLength == cfg.MaxMsgs {
+		return nil
+	}
+	cfg.MaxMsgs = newBufferLength
+	if _, err := jss.js.UpdateStream(&cfg, nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to update the max messages of stream %q, %w", streamName, err)
+	}
+	logging.FromContext(ctx).Infow("Resized partition", zap.String("partition", partition), zap.Int64("from", stream.Config.MaxMsgs), zap.Int64("to", newBufferLength))
+	return nil
+}
+
+// PausePartition marks the consumer of the stream of the partition as paused in its metadata, the readers stop
+// fetching from the consumer while it's paused.
+func (jss *jetStreamSvc) PausePartition(ctx context.Context, partition string) error {
+	return jss.setConsumerPaused(ctx, partition, true)
+}
+
+// ResumePartition removes the pause from the metadata of the consumer of the stream of the partition.
+func (jss *jetStreamSvc) ResumePartition(ctx context.Context, partition string) error {
+	return jss.setConsumerPaused(ctx, partition, false)
+}
+
+// PurgePartition purges the stream of the partition up to the sequence, once the consumer of the stream is paused.
+func (jss *jetStreamSvc) PurgePartition(ctx context.Context, partition, upToOffset string, opts ...PurgeOption) error {
+	streamName := JetStreamName(partition)
+	consumer, err := jss.js.ConsumerInfo(streamName, streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) || errors.Is(err, nats.ErrConsumerNotFound) {
+			return fmt.Errorf("consumer of stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
+	}
+	if consumer.Config.Metadata[dfv1.KeyPartitionPaused] != "true" {
+		return fmt.Errorf("partition %q, %w", partition, ErrPartitionNotPaused)
+	}
+	return jss.PurgeBuffers(ctx, []string{partition}, append(opts, withPurgeUpTo(upToOffset))...)
+}
+
+func (jss *jetStreamSvc) setConsumerPaused(ctx context.Context, partition string, paused bool) error {
+	streamName := JetStreamName(partition)
+	consumer, err := jss.js.ConsumerInfo(streamName, streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) || errors.Is(err, nats.ErrConsumerNotFound) {
+			return fmt.Errorf("consumer of stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
+	}
+	cfg := consumer.Config
+	if (cfg.Metadata[dfv1.KeyPartitionPaused] == "true") == paused {
+		return nil
+	}
+	metadata := make(map[string]string, len(cfg.Metadata)+1)
+	for k, v := range cfg.Metadata {
+		if k != dfv1.KeyPartitionPaused {
+			metadata[k] = v
+		}
+	}
+	if paused {
+		metadata[dfv1.KeyPartitionPaused] = "true"
+	}
+	cfg.Metadata = metadata
+	if _, err := jss.js.UpdateConsumer(streamName, &cfg, nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to update the consumer of stream %q, %w", streamName, err)
+	}
+	logging.FromContext(ctx).Infow("Updated the pause of partition", zap.String("partition", partition), zap.Bool("paused", paused))
+	return nil
+}
+
 // CreateWatermarkStores is used to create watermark stores.
 func (jss *jetStreamSvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]wmstore.WatermarkStore, error) {
 	log := logging.FromContext(ctx).With("bucket", bucketName)
@@ -348,3 +1163,211 @@ func JetStreamName(bufferName string) string {
 func JetStreamSideInputsStoreKVName(sideInputStoreName string) string {
 	return fmt.Sprintf("%s_SIDE_INPUTS", sideInputStoreName)
 }
+
+var _ Snapshotter = (*jetStreamSvc)(nil)
+
+// snapshotIdleTimeout ends the read of a stream for a snapshot if no message comes in, e.g. the last messages of a work
+// queue stream are removed while it's read.
+const snapshotIdleTimeout = 5 * time.Second
+
+// SnapshotBuffersAndBuckets reads the streams of the buffers from the ack floors of their consumers with ephemeral
+// ordered consumers, and the offset timeline KVs of the buckets with their history, the consumers of the pipeline are
+// left untouched.
+func (jss *jetStreamSvc) SnapshotBuffersAndBuckets(ctx context.Context, store ObjectStore, prefix string, buffers, buckets []string) (*SnapshotManifest, error) {
+	log := logging.FromContext(ctx)
+	manifest := &SnapshotManifest{Buffers: []SnapshotItem{}, Buckets: []SnapshotItem{}}
+	for _, buffer := range buffers {
+		item, err := jss.snapshotStream(ctx, store, prefix, buffer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot buffer %q, %w", buffer, err)
+		}
+		manifest.Buffers = append(manifest.Buffers, *item)
+		log.Infow("Snapshotted buffer", zap.String("buffer", buffer), zap.Int64("messages", item.Count))
+	}
+	for _, bucket := range buckets {
+		item, err := jss.snapshotOffsetTimeline(ctx, store, prefix, bucket)
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot bucket %q, %w", bucket, err)
+		}
+		manifest.Buckets = append(manifest.Buckets, *item)
+		log.Infow("Snapshotted bucket", zap.String("bucket", bucket), zap.Int64("entries", item.Count))
+	}
+	if err := writeSnapshotManifest(ctx, store, prefix, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (jss *jetStreamSvc) snapshotStream(ctx context.Context, store ObjectStore, prefix, buffer string) (*SnapshotItem, error) {
+	streamName := JetStreamName(buffer)
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return nil, fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	consumer, err := jss.js.ConsumerInfo(streamName, streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrConsumerNotFound) {
+			return nil, fmt.Errorf("consumer of stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return nil, fmt.Errorf("failed to get consumer information of stream %q, %w", streamName, err)
+	}
+	// the messages acknowledged by the pipeline are left out, the ones in flight are delivered again once restored
+	startSeq := max(consumer.AckFloor.Stream+1, stream.State.FirstSeq)
+	lastSeq := stream.State.LastSeq
+	item := &SnapshotItem{Name: buffer, Key: snapshotBufferKey(prefix, buffer)}
+	err = writeSnapshotObject(ctx, store, item.Key, func(encoder *json.Encoder) error {
+		if stream.State.Msgs == 0 || startSeq > lastSeq {
+			return nil
+		}
+		sub, err := jss.js.SubscribeSync(streamName, nats.BindStream(streamName), nats.OrderedConsumer(), nats.StartSequence(startSeq))
+		if err != nil {
+			return fmt.Errorf("failed to create an ephemeral consumer of stream %q, %w", streamName, err)
+		}
+		defer func() { _ = sub.Unsubscribe() }()
+		for {
+			msg, err := sub.NextMsg(snapshotIdleTimeout)
+			if err != nil {
+				if errors.Is(err, nats.ErrTimeout) {
+					return nil
+				}
+				return fmt.Errorf("failed to read stream %q, %w", streamName, err)
+			}
+			meta, err := msg.Metadata()
+			if err != nil {
+				return fmt.Errorf("failed to get the metadata of a message of stream %q, %w", streamName, err)
+			}
+			if meta.Sequence.Stream > lastSeq {
+				return nil
+			}
+			if item.FirstSequence == 0 {
+				item.FirstSequence = meta.Sequence.Stream
+			}
+			if err := encoder.Encode(snapshotMessage{
+				Sequence: meta.Sequence.Stream,
+				Time:     meta.Timestamp,
+				Header:   msg.Header,
+				Data:     msg.Data,
+			}); err != nil {
+				return err
+			}
+			item.Count++
+			if meta.Sequence.Stream == lastSeq || meta.NumPending == 0 {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (jss *jetStreamSvc) snapshotOffsetTimeline(ctx context.Context, store ObjectStore, prefix, bucket string) (*SnapshotItem, error) {
+	otKVName := wmstore.JetStreamOTKVName(bucket)
+	kv, err := jss.js.KeyValue(otKVName)
+	if err != nil {
+		if errors.Is(err, nats.ErrBucketNotFound) || errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("offset timeline KV %q, %w", otKVName, ErrBufferNotFound)
+		}
+		return nil, fmt.Errorf("failed to get offset timeline KV %q, %w", otKVName, err)
+	}
+	item := &SnapshotItem{Name: bucket, Key: snapshotBucketKey(prefix, bucket)}
+	err = writeSnapshotObject(ctx, store, item.Key, func(encoder *json.Encoder) error {
+		// the history of the timeline is kept in the order of the revisions
+		watcher, err := kv.WatchAll(nats.IncludeHistory(), nats.IgnoreDeletes(), nats.Context(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to watch offset timeline KV %q, %w", otKVName, err)
+		}
+		defer func() { _ = watcher.Stop() }()
+		for entry := range watcher.Updates() {
+			// a nil entry marks the end of the existing entries
+			if entry == nil {
+				return nil
+			}
+			if err := encoder.Encode(snapshotEntry{Key: entry.Key(), Value: entry.Value()}); err != nil {
+				return err
+			}
+			item.Count++
+		}
+		return ctx.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// RestoreBuffersAndBuckets publishes the messages of the snapshot to the streams of the buffers, and puts the entries
+// of the offset timelines to the KVs of the buckets. An empty stream is purged up to the first sequence of the snapshot
+// first, the messages following a gap in the snapshot, e.g. the messages acknowledged from a work queue stream, get
+// lower sequences than the original ones, so the watermarks of the restored messages can only be held back.
+func (jss *jetStreamSvc) RestoreBuffersAndBuckets(ctx context.Context, store ObjectStore, prefix string) (*SnapshotManifest, error) {
+	log := logging.FromContext(ctx)
+	manifest, err := readSnapshotManifest(ctx, store, prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range manifest.Buffers {
+		if err := jss.restoreStream(ctx, store, item); err != nil {
+			return nil, fmt.Errorf("failed to restore buffer %q, %w", item.Name, err)
+		}
+		log.Infow("Restored buffer", zap.String("buffer", item.Name), zap.Int64("messages", item.Count))
+	}
+	for _, item := range manifest.Buckets {
+		if err := jss.restoreOffsetTimeline(ctx, store, item); err != nil {
+			return nil, fmt.Errorf("failed to restore bucket %q, %w", item.Name, err)
+		}
+		log.Infow("Restored bucket", zap.String("bucket", item.Name), zap.Int64("entries", item.Count))
+	}
+	return manifest, nil
+}
+
+func (jss *jetStreamSvc) restoreStream(ctx context.Context, store ObjectStore, item SnapshotItem) error {
+	streamName := JetStreamName(item.Name)
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("stream %q, %w", streamName, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	if stream.State.Msgs > 0 {
+		return fmt.Errorf("stream %q has %d messages, only an empty stream can be restored", streamName, stream.State.Msgs)
+	}
+	if item.FirstSequence > stream.State.LastSeq+1 {
+		// purging an empty stream up to a sequence moves the first sequence of the stream to it
+		if err := jss.js.PurgeStream(streamName, &nats.StreamPurgeRequest{Sequence: item.FirstSequence}, nats.Context(ctx)); err != nil {
+			return fmt.Errorf("failed to move the first sequence of stream %q to %d, %w", streamName, item.FirstSequence, err)
+		}
+	}
+	return readSnapshotObject(ctx, store, item.Key, func(m *snapshotMessage) error {
+		msg := &nats.Msg{Subject: streamName, Header: m.Header, Data: m.Data}
+		if _, err := jss.js.PublishMsg(msg, nats.Context(ctx)); err != nil {
+			return fmt.Errorf("failed to publish message %d to stream %q, %w", m.Sequence, streamName, err)
+		}
+		return nil
+	})
+}
+
+func (jss *jetStreamSvc) restoreOffsetTimeline(ctx context.Context, store ObjectStore, item SnapshotItem) error {
+	otKVName := wmstore.JetStreamOTKVName(item.Name)
+	kv, err := jss.js.KeyValue(otKVName)
+	if err != nil {
+		if errors.Is(err, nats.ErrBucketNotFound) || errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("offset timeline KV %q, %w", otKVName, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get offset timeline KV %q, %w", otKVName, err)
+	}
+	return readSnapshotObject(ctx, store, item.Key, func(e *snapshotEntry) error {
+		if _, err := kv.Put(e.Key, e.Value); err != nil {
+			return fmt.Errorf("failed to put key %q to offset timeline KV %q, %w", e.Key, otKVName, err)
+		}
+		return nil
+	})
+}
@@ -2,13 +2,21 @@ package isbsvc
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	nats2 "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
 func TestJetstreamSvc_CreationDeletionValidation(t *testing.T) {
@@ -37,8 +45,120 @@ func TestJetstreamSvc_CreationDeletionValidation(t *testing.T) {
 	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, servingStreams)
 	assert.NoError(t, err)
 
-	err = isbSvc.DeleteBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, servingStreams)
+	report, err := isbSvc.DeleteBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, servingStreams)
 	assert.NoError(t, err)
+	assert.Len(t, report.Deleted, 7)
+	assert.Empty(t, report.NotFound)
+	assert.Empty(t, report.Failed)
+
+	// deleting again reports all the items as not found.
+	report, err = isbSvc.DeleteBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, servingStreams)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Deleted)
+	assert.Len(t, report.NotFound, 7)
+}
+
+func TestJetstreamSvc_BufferConfig(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+
+	config := `
+stream:
+  maxMsgs: 1000
+  maxAge: 1h
+consumer:
+  maxAckPending: 100
+`
+	buffers := []string{"test-buffer-sink", "test-buffer-map"}
+	opts := []CreateOption{
+		WithConfig(config),
+		WithBufferConfig("test-buffer-sink", "stream:\n  maxMsgs: 50000\nconsumer:\n  maxAckPending: 500\n"),
+	}
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, nil, "", nil, opts...))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	sinkStream, err := jsCtx.StreamInfo(JetStreamName("test-buffer-sink"))
+	assert.NoError(t, err)
+	mapStream, err := jsCtx.StreamInfo(JetStreamName("test-buffer-map"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50000), sinkStream.Config.MaxMsgs)
+	assert.Equal(t, int64(1000), mapStream.Config.MaxMsgs)
+	// the settings not in the buffer config come from the config
+	assert.Equal(t, time.Hour, sinkStream.Config.MaxAge)
+	sinkConsumer, err := jsCtx.ConsumerInfo(JetStreamName("test-buffer-sink"), JetStreamName("test-buffer-sink"))
+	assert.NoError(t, err)
+	assert.Equal(t, 500, sinkConsumer.Config.MaxAckPending)
+
+	assert.NoError(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, opts...))
+	// the buffer config is changed after the stream is created
+	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, WithBufferConfig("test-buffer-sink", "stream:\n  maxMsgs: 60000\n"))
+	assert.True(t, IsConfigMismatch(err))
+	assert.ErrorContains(t, err, "stream.maxMsgs 50000, not 60000")
+	assert.Error(t, WithBufferConfig("", config)(&createOptions{}))
+}
+
+func TestJetstreamSvc_BufferRetention(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+
+	config := `
+stream:
+  maxMsgs: 1000
+  maxAge: 1h
+  maxBytes: -1
+`
+	buffers := []string{"test-buffer-sink", "test-buffer-map"}
+	retention := dfv1.BufferRetention{
+		MaxAge:      &metav1.Duration{Duration: 10 * time.Minute},
+		MaxMessages: ptr.To[int64](2000),
+		MaxBytes:    ptr.To[int64](1 << 20),
+		Discard:     ptr.To(dfv1.BufferDiscardNew),
+	}
+	opts := []CreateOption{
+		WithConfig(config),
+		WithBufferConfig("test-buffer-sink", "stream:\n  maxMsgs: 50000\n"),
+		WithBufferRetention("test-buffer-sink", retention),
+	}
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, nil, "", nil, opts...))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	sinkStream, err := jsCtx.StreamInfo(JetStreamName("test-buffer-sink"))
+	assert.NoError(t, err)
+	mapStream, err := jsCtx.StreamInfo(JetStreamName("test-buffer-map"))
+	assert.NoError(t, err)
+	// the retention takes precedence over the buffer config
+	assert.Equal(t, int64(2000), sinkStream.Config.MaxMsgs)
+	assert.Equal(t, 10*time.Minute, sinkStream.Config.MaxAge)
+	assert.Equal(t, int64(1<<20), sinkStream.Config.MaxBytes)
+	assert.Equal(t, nats.DiscardNew, sinkStream.Config.Discard)
+	assert.Equal(t, int64(1000), mapStream.Config.MaxMsgs)
+	assert.Equal(t, nats.DiscardOld, mapStream.Config.Discard)
+
+	assert.NoError(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, opts...))
+	// the retention is changed after the stream is created
+	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, WithBufferRetention("test-buffer-sink", dfv1.BufferRetention{Discard: ptr.To(dfv1.BufferDiscardOld)}))
+	assert.True(t, IsConfigMismatch(err))
+	assert.ErrorContains(t, err, "discard DiscardNew, not DiscardOld")
+	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, WithBufferRetention("test-buffer-map", dfv1.BufferRetention{MaxAge: &metav1.Duration{Duration: time.Minute}}))
+	assert.True(t, IsConfigMismatch(err))
+	assert.ErrorContains(t, err, "stream.maxAge 1h0m0s, not 1m0s")
+	assert.Error(t, WithBufferRetention("", retention)(&createOptions{}))
 }
 
 func TestJetstreamSvc_GetBufferInfo(t *testing.T) {
@@ -78,6 +198,203 @@ func TestJetstreamSvc_GetBufferInfo(t *testing.T) {
 	assert.Equal(t, int64(0), info.PendingCount)
 	assert.Equal(t, int64(0), info.AckPendingCount)
 	assert.Equal(t, int64(0), info.TotalMessages)
+	assert.True(t, info.OldestPendingTime.IsZero())
+	assert.True(t, info.OldestMessageTime.IsZero())
+	// the stream has no max messages configured
+	assert.Equal(t, UnknownMaxLength, info.MaxLength)
+	assert.Equal(t, UnknownUsagePercentage, info.UsagePercentage)
+
+	// the oldest pending time is the time when the first pending message was written.
+	before := time.Now().Add(-time.Second)
+	_, err = jsCtx.Publish("test-buffer", []byte("1"))
+	assert.NoError(t, err)
+	_, err = jsCtx.Publish("test-buffer", []byte("2"))
+	assert.NoError(t, err)
+	info, err = isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), info.PendingCount)
+	assert.Greater(t, info.TotalBytes, int64(0))
+	assert.True(t, info.OldestPendingTime.After(before))
+	assert.False(t, info.OldestPendingTime.After(time.Now()))
+	assert.Equal(t, info.OldestPendingTime.UnixMilli(), info.OldestMessageTime.UnixMilli())
+
+	// the usage is derived from the max messages of the stream
+	_, err = jsCtx.UpdateStream(&nats.StreamConfig{Name: "test-buffer", MaxMsgs: 8})
+	assert.NoError(t, err)
+	info, err = isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), info.MaxLength)
+	assert.Equal(t, 25.0, info.UsagePercentage)
+
+	_, err = isbSvc.GetBufferInfo(ctx, "missing")
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+}
+
+func TestJetstreamSvc_ConsumerLag(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	buffer := "test-buffer"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", nil))
+
+	info, err := isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConsumerLag{{Name: buffer, ProducedOffset: "0", DeliveredOffset: "0", AckedOffset: "0"}}, info.Consumers)
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = jsCtx.Publish(buffer, []byte("test"))
+		assert.NoError(t, err)
+	}
+	// 2 messages are delivered, 1 of which is acknowledged
+	sub, err := jsCtx.PullSubscribe(buffer, buffer, nats.Bind(buffer, buffer))
+	assert.NoError(t, err)
+	msgs, err := sub.Fetch(2, nats.MaxWait(time.Second))
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+	assert.NoError(t, msgs[0].AckSync())
+
+	info, err = isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Len(t, info.Consumers, 1)
+	lag := info.Consumers[0]
+	assert.Equal(t, "3", lag.ProducedOffset)
+	assert.Equal(t, "2", lag.DeliveredOffset)
+	assert.Equal(t, "1", lag.AckedOffset)
+	assert.Equal(t, int64(1), lag.PendingCount)
+	assert.Equal(t, int64(1), lag.AckPendingCount)
+	assert.False(t, lag.LastActive.IsZero())
+}
+
+func TestJetstreamSvc_WatchPartitionInfo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	_, err = jsCtx.AddStream(&nats.StreamConfig{Name: "test-buffer"})
+	assert.NoError(t, err)
+	_, err = jsCtx.AddConsumer("test-buffer", &nats.ConsumerConfig{Name: "test-buffer"})
+	assert.NoError(t, err)
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	_, err = isbSvc.WatchPartitionInfo(ctx, "missing")
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+
+	infos, err := isbSvc.WatchPartitionInfo(ctx, "test-buffer")
+	assert.NoError(t, err)
+	info := <-infos
+	assert.Equal(t, int64(0), info.PendingCount)
+	_, err = jsCtx.Publish("test-buffer", []byte("1"))
+	assert.NoError(t, err)
+	info = <-infos
+	assert.NotNil(t, info)
+	assert.Equal(t, int64(1), info.PendingCount)
+
+	// the watch ends once the stream is deleted
+	assert.NoError(t, jsCtx.DeleteStream("test-buffer"))
+	for range infos {
+	}
+	assert.NoError(t, ctx.Err())
+}
+
+func TestJetstreamSvc_ReadBufferHistory(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	buffer := "test-buffer"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", nil))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	publish := func(messages []isb.Message) {
+		for _, msg := range messages {
+			data, err := msg.MarshalBinary()
+			assert.NoError(t, err)
+			_, err = jsCtx.Publish(buffer, data)
+			assert.NoError(t, err)
+		}
+	}
+	startTime := time.Unix(1636470000, 0)
+	messages := testutils.BuildTestWriteMessages(10, startTime, nil, "testVertex")
+	publish(messages[:5])
+	time.Sleep(10 * time.Millisecond)
+	middle := time.Now()
+	publish(messages[5:])
+
+	// the pipeline consumed 4 messages and acknowledged 3 of them.
+	sub, err := jsCtx.PullSubscribe(buffer, buffer, nats.Bind(buffer, buffer))
+	assert.NoError(t, err)
+	fetched, err := sub.Fetch(4, nats.MaxWait(time.Second))
+	assert.NoError(t, err)
+	assert.Len(t, fetched, 4)
+	for _, msg := range fetched[:3] {
+		assert.NoError(t, msg.AckSync())
+	}
+	consumerBefore, err := jsCtx.ConsumerInfo(buffer, buffer)
+	assert.NoError(t, err)
+
+	read := func(from HistoryPosition, limit int) []string {
+		var ids []string
+		err := isbSvc.ReadBufferHistory(ctx, buffer, from, func(msg *HistoryMessage) bool {
+			assert.False(t, msg.WriteTime.IsZero())
+			// the event times of the test messages are one second apart
+			ids = append(ids, fmt.Sprintf("%s:%d", msg.Sequence, msg.EventTime.Sub(startTime)/time.Second))
+			return len(ids) < limit
+		})
+		assert.NoError(t, err)
+		return ids
+	}
+	assert.Equal(t, []string{"1:0", "2:1", "3:2", "4:3", "5:4", "6:5", "7:6", "8:7", "9:8", "10:9"}, read(HistoryPosition{}, 100))
+	assert.Equal(t, []string{"8:7", "9:8", "10:9"}, read(HistoryPosition{StartSequence: "8"}, 100))
+	assert.Equal(t, []string{"6:5", "7:6"}, read(HistoryPosition{StartTime: middle}, 2))
+	assert.Empty(t, read(HistoryPosition{StartSequence: "11"}, 100))
+	assert.Empty(t, read(HistoryPosition{StartTime: time.Now().Add(time.Minute)}, 100))
+
+	err = isbSvc.ReadBufferHistory(ctx, buffer, HistoryPosition{StartSequence: "abc"}, func(*HistoryMessage) bool { return true })
+	assert.ErrorContains(t, err, `invalid start sequence "abc"`)
+	err = isbSvc.ReadBufferHistory(ctx, buffer, HistoryPosition{StartSequence: "1", StartTime: middle}, func(*HistoryMessage) bool { return true })
+	assert.ErrorContains(t, err, "only one of the start time and the start sequence can be set")
+
+	// the consumer of the pipeline is untouched, and the ephemeral consumers are gone.
+	consumerAfter, err := jsCtx.ConsumerInfo(buffer, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, consumerBefore.Delivered, consumerAfter.Delivered)
+	assert.Equal(t, consumerBefore.AckFloor, consumerAfter.AckFloor)
+	assert.Equal(t, consumerBefore.NumAckPending, consumerAfter.NumAckPending)
+	assert.Equal(t, consumerBefore.NumRedelivered, consumerAfter.NumRedelivered)
+	assert.Equal(t, consumerBefore.NumPending, consumerAfter.NumPending)
+	assert.Equal(t, uint64(3), consumerAfter.AckFloor.Stream)
+	assert.Equal(t, 1, consumerAfter.NumAckPending)
+	assert.Eventually(t, func() bool {
+		info, err := jsCtx.StreamInfo(buffer)
+		return err == nil && info.State.Consumers == 1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// the pipeline continues from where it was.
+	fetched, err = sub.Fetch(1, nats.MaxWait(time.Second))
+	assert.NoError(t, err)
+	meta, err := fetched[0].Metadata()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), meta.Sequence.Stream)
 }
 
 func TestJetstreamSvc_CreateWatermarkStores(t *testing.T) {
@@ -104,3 +421,684 @@ func TestJetstreamSvc_CreateWatermarkStores(t *testing.T) {
 	_, err = isbSvc.CreateWatermarkStores(ctx, bucketName, partitions, false)
 	assert.NoError(t, err)
 }
+
+func TestJetstreamSvc_Ownership(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	owner, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-1"))
+	assert.NoError(t, err)
+	other, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-2"))
+	assert.NoError(t, err)
+
+	buffers := []string{"test-buffer-1"}
+	buckets := []string{"test-bucket-1"}
+	assert.NoError(t, owner.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+	assert.NoError(t, owner.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+
+	// another pipeline generating the same buffer names can neither use nor delete them.
+	err = other.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil)
+	assert.True(t, IsOwnershipMismatch(err))
+	assert.ErrorContains(t, err, `stream "test-buffer-1" is owned by pipeline "uid-1", not by pipeline "uid-2"`)
+	assert.True(t, IsOwnershipMismatch(other.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil)))
+	_, err = other.DeleteBuffersAndBuckets(ctx, buffers, buckets, "", nil)
+	assert.True(t, IsOwnershipMismatch(err))
+	assert.NoError(t, owner.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+
+	// unless it's forced.
+	_, err = other.DeleteBuffersAndBuckets(ctx, buffers, buckets, "", nil, WithForce(true))
+	assert.NoError(t, err)
+	assert.Error(t, owner.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+}
+
+func TestJetstreamSvc_PipelineQuota(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	assert.Equal(t, PipelineQuota{}, NewPipelineQuota(nil))
+	streamsQuota, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-1"), WithPipelineQuota(NewPipelineQuota(&dfv1.ISBPipelineQuota{MaxStreams: ptr.To[int32](4)})))
+	assert.NoError(t, err)
+
+	// a buffer and a bucket are 3 streams, the buffer and the offset timeline and processor KVs.
+	assert.NoError(t, streamsQuota.CreateBuffersAndBuckets(ctx, []string{"test-buffer-1"}, []string{"test-bucket-1"}, "", nil))
+	// the existing streams are not counted again.
+	assert.NoError(t, streamsQuota.CreateBuffersAndBuckets(ctx, []string{"test-buffer-1", "test-buffer-2"}, []string{"test-bucket-1"}, "", nil))
+	err = streamsQuota.CreateBuffersAndBuckets(ctx, []string{"test-buffer-3"}, nil, "", nil)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.ErrorContains(t, err, "creating 1 streams with 4 existing exceeds the max streams quota of 4")
+	_, err = streamsQuota.GetBufferInfo(ctx, "test-buffer-3")
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+
+	info, err := streamsQuota.GetBufferInfo(ctx, "test-buffer-1")
+	assert.NoError(t, err)
+	assert.Equal(t, &QuotaUsage{PipelineQuota: PipelineQuota{MaxStreams: 4}, Streams: 4}, info.Quota)
+
+	// the streams of another pipeline don't count in the quota.
+	bytesQuota, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-2"), WithPipelineQuota(PipelineQuota{MaxBytes: 2048}))
+	assert.NoError(t, err)
+	config := WithConfig("stream:\n  maxBytes: 1024\n")
+	assert.NoError(t, bytesQuota.CreateBuffersAndBuckets(ctx, []string{"test-buffer-a", "test-buffer-b"}, []string{"test-bucket-a"}, "", nil, config))
+	err = bytesQuota.CreateBuffersAndBuckets(ctx, []string{"test-buffer-c"}, nil, "", nil, config)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.ErrorContains(t, err, "creating buffers of 1024 bytes with 2048 bytes existing exceeds the max bytes quota of 2048")
+	// a buffer without max bytes is not bounded by the quota.
+	err = bytesQuota.CreateBuffersAndBuckets(ctx, []string{"test-buffer-d"}, nil, "", nil)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.ErrorContains(t, err, `buffer "test-buffer-d" has no max bytes`)
+
+	info, err = bytesQuota.GetBufferInfo(ctx, "test-buffer-a")
+	assert.NoError(t, err)
+	assert.Equal(t, &QuotaUsage{PipelineQuota: PipelineQuota{MaxBytes: 2048}, Bytes: 2048, Streams: 4}, info.Quota)
+
+	// no quota is enforced without the pipeline UID.
+	noUID, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineQuota(PipelineQuota{MaxStreams: 1}))
+	assert.NoError(t, err)
+	assert.NoError(t, noUID.CreateBuffersAndBuckets(ctx, []string{"test-buffer-x", "test-buffer-y"}, nil, "", nil))
+	info, err = noUID.GetBufferInfo(ctx, "test-buffer-x")
+	assert.NoError(t, err)
+	assert.Nil(t, info.Quota)
+}
+
+func TestJetstreamSvc_AdoptUnownedBuffers(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	// the buffers created before the ownership is recorded.
+	legacy, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	buffers := []string{"test-buffer-1"}
+	buckets := []string{"test-bucket-1"}
+	assert.NoError(t, legacy.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	info, err := jsCtx.StreamInfo("test-buffer-1")
+	assert.NoError(t, err)
+	assert.Empty(t, info.Config.Metadata[dfv1.KeyPipelineUID])
+
+	// the first pipeline validating the buffers adopts them.
+	owner, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-1"))
+	assert.NoError(t, err)
+	assert.NoError(t, owner.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+	for _, streamName := range ownedStreams(buffers, buckets) {
+		info, err := jsCtx.StreamInfo(streamName)
+		assert.NoError(t, err)
+		assert.Equal(t, "uid-1", info.Config.Metadata[dfv1.KeyPipelineUID])
+	}
+
+	other, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-2"))
+	assert.NoError(t, err)
+	assert.True(t, IsOwnershipMismatch(other.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil)))
+	_, err = owner.DeleteBuffersAndBuckets(ctx, buffers, buckets, "", nil)
+	assert.NoError(t, err)
+}
+
+func TestJetstreamSvc_ListBuffersAndBuckets(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	owned, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-1"))
+	assert.NoError(t, err)
+	before := time.Now().Add(-time.Second)
+	assert.NoError(t, owned.CreateBuffersAndBuckets(ctx, []string{"ns-pl-in-0", "ns-pl-out-0"}, []string{"ns-pl-in-out"}, "ns-pl", []string{"pl-in-serving-source"}))
+	legacy, err := NewISBJetStreamSvc("otherPipeline", client)
+	assert.NoError(t, err)
+	assert.NoError(t, legacy.CreateBuffersAndBuckets(ctx, []string{"ns-pl-x-0", "ns-other-in-0"}, []string{"ns-other-in-out"}, "", nil))
+
+	items, err := owned.ListBuffersAndBuckets(ctx, "ns-pl-")
+	assert.NoError(t, err)
+	assert.Len(t, items, 4)
+	for _, item := range items {
+		assert.True(t, item.Created.After(before))
+	}
+	assert.Equal(t, DeleteItem{Kind: DeleteItemBucket, Name: "ns-pl-in-out"}, items[0].DeleteItem)
+	assert.Equal(t, "uid-1", items[0].Owner)
+	assert.Equal(t, DeleteItem{Kind: DeleteItemBuffer, Name: "ns-pl-in-0"}, items[1].DeleteItem)
+	assert.Equal(t, "uid-1", items[1].Owner)
+	assert.Equal(t, DeleteItem{Kind: DeleteItemBuffer, Name: "ns-pl-out-0"}, items[2].DeleteItem)
+	assert.Equal(t, DeleteItem{Kind: DeleteItemBuffer, Name: "ns-pl-x-0"}, items[3].DeleteItem)
+	assert.Empty(t, items[3].Owner)
+}
+
+// mockClusterJetStream mocks the management API of a JetStream cluster
+type mockClusterJetStream struct {
+	nats.JetStreamContext
+	accountErr error
+	streams    []*nats.StreamInfo
+}
+
+func (m *mockClusterJetStream) AccountInfo(...nats.JSOpt) (*nats.AccountInfo, error) {
+	if m.accountErr != nil {
+		return nil, m.accountErr
+	}
+	return &nats.AccountInfo{}, nil
+}
+
+func (m *mockClusterJetStream) StreamsInfo(...nats.JSOpt) <-chan *nats.StreamInfo {
+	ch := make(chan *nats.StreamInfo, len(m.streams))
+	for _, s := range m.streams {
+		ch <- s
+	}
+	close(ch)
+	return ch
+}
+
+func clusteredStreamInfo(name string, leader string, replicas ...*nats.PeerInfo) *nats.StreamInfo {
+	return &nats.StreamInfo{
+		Config:  nats.StreamConfig{Name: name, Replicas: 3},
+		Cluster: &nats.ClusterInfo{Name: "isbsvc", Leader: leader, Replicas: replicas},
+	}
+}
+
+func TestJetstreamSvc_ClusterHealth(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("healthy", func(t *testing.T) {
+		jss := &jetStreamSvc{js: &mockClusterJetStream{streams: []*nats.StreamInfo{
+			clusteredStreamInfo("b-buffer", "js-0", &nats.PeerInfo{Name: "js-1", Current: true}, &nats.PeerInfo{Name: "js-2", Current: true}),
+			clusteredStreamInfo("a-buffer", "js-1", &nats.PeerInfo{Name: "js-0", Current: true}, &nats.PeerInfo{Name: "js-2", Current: true}),
+		}}}
+		health, err := jss.ClusterHealth(ctx)
+		assert.NoError(t, err)
+		assert.True(t, health.Available)
+		assert.Len(t, health.Streams, 2)
+		assert.Equal(t, "a-buffer", health.Streams[0].Name)
+		assert.Equal(t, "js-1", health.Streams[0].Leader)
+		for _, s := range health.Streams {
+			assert.True(t, s.HasLeader)
+			assert.Equal(t, 3, s.CurrentReplicas)
+			assert.True(t, s.Healthy())
+		}
+		assert.Empty(t, health.LeaderlessStreams())
+	})
+
+	t.Run("leaderless", func(t *testing.T) {
+		jss := &jetStreamSvc{js: &mockClusterJetStream{streams: []*nats.StreamInfo{
+			clusteredStreamInfo("a-buffer", "js-0", &nats.PeerInfo{Name: "js-1", Current: true}, &nats.PeerInfo{Name: "js-2", Offline: true}),
+			clusteredStreamInfo("b-buffer", "", &nats.PeerInfo{Name: "js-0", Current: false}, &nats.PeerInfo{Name: "js-2", Offline: true}),
+		}}}
+		health, err := jss.ClusterHealth(ctx)
+		assert.NoError(t, err)
+		assert.True(t, health.Available)
+		assert.True(t, health.Streams[0].HasLeader)
+		assert.Equal(t, 2, health.Streams[0].CurrentReplicas)
+		assert.False(t, health.Streams[0].Healthy())
+		assert.False(t, health.Streams[1].HasLeader)
+		assert.Equal(t, 0, health.Streams[1].CurrentReplicas)
+		assert.Equal(t, []string{"b-buffer"}, health.LeaderlessStreams())
+		assert.Empty(t, health.Filter("a-buffer").LeaderlessStreams())
+	})
+
+	t.Run("no meta leader", func(t *testing.T) {
+		jss := &jetStreamSvc{js: &mockClusterJetStream{accountErr: nats.ErrNoResponders}}
+		health, err := jss.ClusterHealth(ctx)
+		assert.NoError(t, err)
+		assert.False(t, health.Available)
+		assert.Contains(t, health.Message, nats.ErrNoResponders.Error())
+		assert.Empty(t, health.Streams)
+	})
+
+	t.Run("single server", func(t *testing.T) {
+		s := test.RunJetStreamServer(t)
+		defer test.ShutdownJetStreamServer(t, s)
+		client := nats2.NewTestClient(t, s.ClientURL())
+		defer client.Close()
+		isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+		assert.NoError(t, err)
+		assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{"test-buffer"}, nil, "", nil))
+		health, err := isbSvc.(ClusterHealthChecker).ClusterHealth(ctx)
+		assert.NoError(t, err)
+		assert.True(t, health.Available)
+		assert.Len(t, health.Streams, 1)
+		assert.True(t, health.Streams[0].Healthy())
+	})
+}
+
+func TestJetstreamSvc_PurgeBuffers(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	buffer, bucket := "test-buffer", "test-bucket"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, []string{bucket}, "", nil))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	publish := func(n int) {
+		for _, msg := range testutils.BuildTestWriteMessages(int64(n), time.Now(), nil, "testVertex") {
+			data, err := msg.MarshalBinary()
+			assert.NoError(t, err)
+			_, err = jsCtx.Publish(buffer, data)
+			assert.NoError(t, err)
+		}
+	}
+	sequences := func() []string {
+		var seqs []string
+		assert.NoError(t, isbSvc.ReadBufferHistory(ctx, buffer, HistoryPosition{}, func(msg *HistoryMessage) bool {
+			seqs = append(seqs, msg.Sequence)
+			return true
+		}))
+		return seqs
+	}
+	publish(5)
+	time.Sleep(10 * time.Millisecond)
+	middle := time.Now()
+	publish(5)
+
+	// the messages which are either recent or written after the time are kept
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(middle), WithKeepRecent(7)))
+	assert.Equal(t, []string{"4", "5", "6", "7", "8", "9", "10"}, sequences())
+	// a time-bounded purge leaves the newer messages intact
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(middle)))
+	assert.Equal(t, []string{"6", "7", "8", "9", "10"}, sequences())
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(middle.Add(-time.Minute))))
+	assert.Equal(t, []string{"6", "7", "8", "9", "10"}, sequences())
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithKeepRecent(2)))
+	assert.Equal(t, []string{"9", "10"}, sequences())
+
+	// a full purge drops all the pending messages and resets the offset timeline
+	otKV, err := jsCtx.KeyValue(wmstore.JetStreamOTKVName(bucket))
+	assert.NoError(t, err)
+	_, err = otKV.Put("processor-0", []byte("offset"))
+	assert.NoError(t, err)
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeWatermarkBuckets(bucket)))
+	info, err := isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), info.PendingCount)
+	assert.Equal(t, int64(0), info.TotalMessages)
+	_, err = otKV.Get("processor-0")
+	assert.ErrorIs(t, err, nats.ErrKeyNotFound)
+
+	err = isbSvc.PurgeBuffers(ctx, []string{"non-existing", buffer})
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+	assert.ErrorContains(t, err, `failed to purge buffer "non-existing"`)
+	assert.ErrorContains(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithKeepRecent(-1)), "invalid number of messages to keep -1")
+}
+
+func TestJetstreamSvc_ResizePartition(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	buffer := "test-buffer"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", nil, WithConfig("stream:\n  maxMsgs: 10\n")))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	for _, msg := range testutils.BuildTestWriteMessages(3, time.Now(), nil, "testVertex") {
+		data, err := msg.MarshalBinary()
+		assert.NoError(t, err)
+		_, err = jsCtx.Publish(buffer, data)
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, isbSvc.ResizePartition(ctx, buffer, 20))
+	info, err := isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), info.MaxLength)
+	// the messages are kept
+	assert.Equal(t, int64(3), info.PendingCount)
+	assert.NoError(t, isbSvc.ResizePartition(ctx, buffer, 20))
+	assert.ErrorContains(t, isbSvc.ResizePartition(ctx, buffer, 15), "can not be decreased")
+	assert.ErrorContains(t, isbSvc.ResizePartition(ctx, buffer, 0), "it should be positive")
+	assert.ErrorIs(t, isbSvc.ResizePartition(ctx, "non-existing", 20), ErrBufferNotFound)
+}
+
+func TestJetstreamSvc_PausePartition(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	buffer := "test-buffer"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", nil))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	paused := func() bool {
+		info, err := jsCtx.ConsumerInfo(JetStreamName(buffer), JetStreamName(buffer))
+		assert.NoError(t, err)
+		return info.Config.Metadata[dfv1.KeyPartitionPaused] == "true"
+	}
+	assert.NoError(t, isbSvc.PausePartition(ctx, buffer))
+	assert.True(t, paused())
+	// pausing a paused partition is a no-op
+	assert.NoError(t, isbSvc.PausePartition(ctx, buffer))
+	assert.True(t, paused())
+	assert.NoError(t, isbSvc.ResumePartition(ctx, buffer))
+	assert.False(t, paused())
+	assert.ErrorIs(t, isbSvc.PausePartition(ctx, "non-existing"), ErrBufferNotFound)
+	assert.ErrorIs(t, isbSvc.ResumePartition(ctx, "non-existing"), ErrBufferNotFound)
+}
+
+func TestJetstreamSvc_PurgeBuffers_WorkQueue(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	buffer := "test-buffer"
+	_, err = jsCtx.AddStream(&nats.StreamConfig{Name: buffer, Retention: nats.WorkQueuePolicy})
+	assert.NoError(t, err)
+	for i := 0; i < 6; i++ {
+		if i == 3 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		_, err = jsCtx.Publish(buffer, []byte("data"))
+		assert.NoError(t, err)
+	}
+	middle := time.Now().Add(-5 * time.Millisecond)
+
+	// the acknowledged messages leave gaps in the sequences
+	for _, seq := range []uint64{3, 5} {
+		assert.NoError(t, jsCtx.DeleteMsg(buffer, seq))
+	}
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(middle)))
+	stream, err := jsCtx.StreamInfo(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), stream.State.Msgs)
+	assert.Equal(t, uint64(4), stream.State.FirstSeq)
+}
+
+func TestJetstreamSvc_PeekMessages(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	buffer := "test-buffer"
+	_, err = jsCtx.AddStream(&nats.StreamConfig{Name: buffer, Retention: nats.WorkQueuePolicy})
+	assert.NoError(t, err)
+	messages := testutils.BuildTestWriteMessages(6, time.Unix(1636470000, 0), nil, "testVertex")
+	for _, msg := range messages {
+		data, err := msg.MarshalBinary()
+		assert.NoError(t, err)
+		_, err = jsCtx.Publish(buffer, data)
+		assert.NoError(t, err)
+	}
+	// the acknowledged messages leave gaps in the sequences
+	for _, seq := range []uint64{1, 3} {
+		assert.NoError(t, jsCtx.DeleteMsg(buffer, seq))
+	}
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	peeked, err := isbSvc.PeekMessages(ctx, buffer, "", 3)
+	assert.NoError(t, err)
+	assert.Len(t, peeked, 3)
+	for i, seq := range []string{"2", "4", "5"} {
+		assert.Equal(t, seq, peeked[i].Sequence)
+	}
+	assert.Equal(t, messages[1].Header.ID, peeked[0].Header.ID)
+	assert.Equal(t, messages[1].Body.Payload, peeked[0].Body.Payload)
+
+	peeked, err = isbSvc.PeekMessages(ctx, buffer, "5", 10)
+	assert.NoError(t, err)
+	assert.Len(t, peeked, 2)
+	assert.Equal(t, "6", peeked[1].Sequence)
+
+	// peeking leaves the messages in the stream
+	stream, err := jsCtx.StreamInfo(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), stream.State.Msgs)
+
+	_, err = isbSvc.PeekMessages(ctx, buffer, "", 0)
+	assert.Error(t, err)
+	_, err = isbSvc.PeekMessages(ctx, buffer, "abc", 1)
+	assert.Error(t, err)
+	_, err = isbSvc.PeekMessages(ctx, "non-existing", "", 1)
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+}
+
+func TestJetstreamSvc_PurgePartition(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	buffer := "test-buffer"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", nil))
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = jsCtx.Publish(JetStreamName(buffer), []byte("data"))
+		assert.NoError(t, err)
+	}
+
+	// the partition must be paused first
+	assert.ErrorIs(t, isbSvc.PurgePartition(ctx, buffer, "3"), ErrPartitionNotPaused)
+	assert.NoError(t, isbSvc.PausePartition(ctx, buffer))
+	assert.Error(t, isbSvc.PurgePartition(ctx, buffer, "abc"))
+	assert.Error(t, isbSvc.PurgePartition(ctx, buffer, "3", WithKeepRecent(1)))
+	assert.NoError(t, isbSvc.PurgePartition(ctx, buffer, "3"))
+	stream, err := jsCtx.StreamInfo(JetStreamName(buffer))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), stream.State.Msgs)
+	assert.Equal(t, uint64(4), stream.State.FirstSeq)
+	// purging up to a purged offset is a no-op
+	assert.NoError(t, isbSvc.PurgePartition(ctx, buffer, "2"))
+	stream, err = jsCtx.StreamInfo(JetStreamName(buffer))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), stream.State.Msgs)
+
+	assert.ErrorIs(t, isbSvc.PurgePartition(ctx, "non-existing", "1"), ErrBufferNotFound)
+}
+
+func TestJetstreamSvc_SnapshotRestore(t *testing.T) {
+	ctx := context.Background()
+	buffer, bucket := "test-buffer", "test-bucket"
+	store := NewDirObjectStore(t.TempDir())
+
+	// the source ISB Service has 6 messages, the first 2 of which are acknowledged
+	src := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, src)
+	srcClient := nats2.NewTestClient(t, src.ClientURL())
+	defer srcClient.Close()
+	srcSvc, err := NewISBJetStreamSvc("testPipeline", srcClient)
+	assert.NoError(t, err)
+	assert.NoError(t, srcSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, []string{bucket}, "", nil))
+	srcJS, err := srcClient.JetStreamContext()
+	assert.NoError(t, err)
+	var written []isb.Message
+	for _, msg := range testutils.BuildTestWriteMessages(6, time.Now(), nil, "testVertex") {
+		data, err := msg.MarshalBinary()
+		assert.NoError(t, err)
+		_, err = srcJS.PublishMsg(&nats.Msg{Subject: buffer, Data: data, Header: nats.Header{nats.MsgIdHdr: []string{msg.Header.ID.String()}}})
+		assert.NoError(t, err)
+		written = append(written, msg)
+	}
+	sub, err := srcJS.PullSubscribe(buffer, buffer, nats.Bind(buffer, buffer))
+	assert.NoError(t, err)
+	fetched, err := sub.Fetch(2, nats.MaxWait(time.Second))
+	assert.NoError(t, err)
+	for _, msg := range fetched {
+		assert.NoError(t, msg.AckSync())
+	}
+	otKV, err := srcJS.KeyValue(wmstore.JetStreamOTKVName(bucket))
+	assert.NoError(t, err)
+	for _, k := range []string{"processor-0", "processor-1"} {
+		_, err = otKV.Put(k, []byte(k))
+		assert.NoError(t, err)
+	}
+
+	snapshotter, ok := srcSvc.(Snapshotter)
+	assert.True(t, ok)
+	_, err = snapshotter.SnapshotBuffersAndBuckets(ctx, store, "snap-1", []string{"non-existing"}, nil)
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+	manifest, err := snapshotter.SnapshotBuffersAndBuckets(ctx, store, "snap-1", []string{buffer}, []string{bucket})
+	assert.NoError(t, err)
+	assert.Equal(t, []SnapshotItem{{Name: buffer, Key: "snap-1/buffers/test-buffer.jsonl", Count: 4, FirstSequence: 3}}, manifest.Buffers)
+	assert.Equal(t, []SnapshotItem{{Name: bucket, Key: "snap-1/buckets/test-bucket.jsonl", Count: 2}}, manifest.Buckets)
+	// the consumer of the pipeline is left untouched
+	srcInfo, err := srcSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), srcInfo.PendingCount)
+
+	// the snapshot is restored into the buffers and the buckets created in another ISB Service
+	dst := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, dst)
+	dstClient := nats2.NewTestClient(t, dst.ClientURL())
+	defer dstClient.Close()
+	dstSvc, err := NewISBJetStreamSvc("testPipeline", dstClient)
+	assert.NoError(t, err)
+	restorer := dstSvc.(Snapshotter)
+	_, err = restorer.RestoreBuffersAndBuckets(ctx, store, "snap-1")
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+	_, err = restorer.RestoreBuffersAndBuckets(ctx, store, "non-existing")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+	assert.NoError(t, dstSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, []string{bucket}, "", nil))
+	_, err = restorer.RestoreBuffersAndBuckets(ctx, store, "snap-1")
+	assert.NoError(t, err)
+
+	var restored []string
+	var restoredMsgs []isb.Message
+	assert.NoError(t, dstSvc.ReadBufferHistory(ctx, buffer, HistoryPosition{}, func(msg *HistoryMessage) bool {
+		restored = append(restored, msg.Sequence)
+		restoredMsgs = append(restoredMsgs, msg.Message)
+		return true
+	}))
+	assert.Equal(t, []string{"3", "4", "5", "6"}, restored)
+	for i, msg := range restoredMsgs {
+		assert.Equal(t, written[i+2].Header.ID, msg.Header.ID)
+		assert.Equal(t, written[i+2].Body.Payload, msg.Body.Payload)
+	}
+	dstInfo, err := dstSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), dstInfo.PendingCount)
+	dstJS, err := dstClient.JetStreamContext()
+	assert.NoError(t, err)
+	dstKV, err := dstJS.KeyValue(wmstore.JetStreamOTKVName(bucket))
+	assert.NoError(t, err)
+	for _, k := range []string{"processor-0", "processor-1"} {
+		entry, err := dstKV.Get(k)
+		assert.NoError(t, err)
+		assert.Equal(t, k, string(entry.Value()))
+	}
+
+	// only an empty buffer can be restored
+	_, err = restorer.RestoreBuffersAndBuckets(ctx, store, "snap-1")
+	assert.ErrorContains(t, err, "only an empty stream can be restored")
+}
+
+func TestJetstreamSvc_RepairBuffersAndBuckets(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc("testPipeline", client)
+	assert.NoError(t, err)
+	repairer := isbSvc.(Repairer)
+
+	buffers := []string{"test-buffer-1", "test-buffer-2", "test-buffer-3"}
+	buckets := []string{"test-bucket-1"}
+	opts := []CreateOption{WithBufferConfig("test-buffer-1", "stream:\n  maxMsgs: 1000\n")}
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil, opts...))
+	report, err := repairer.RepairBuffersAndBuckets(ctx, buffers, buckets, "", nil, opts...)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Repaired)
+
+	// drift the buffers and the bucket
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	info, err := jsCtx.StreamInfo(JetStreamName("test-buffer-1"))
+	assert.NoError(t, err)
+	cfg := info.Config
+	cfg.MaxMsgs = 2000
+	_, err = jsCtx.UpdateStream(&cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, jsCtx.DeleteConsumer(JetStreamName("test-buffer-2"), JetStreamName("test-buffer-2")))
+	assert.NoError(t, jsCtx.DeleteStream(JetStreamName("test-buffer-3")))
+	assert.NoError(t, jsCtx.DeleteKeyValue(JetStreamName("test-bucket-1_PROCESSORS")))
+
+	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil, opts...)
+	assert.True(t, IsConfigMismatch(err))
+	diagnostics := Diagnostics(err)
+	assert.Len(t, diagnostics, 4)
+	kinds := make(map[DeleteItem]DiagnosticKind)
+	for _, d := range diagnostics {
+		kinds[d.Item] = d.Kind
+	}
+	assert.Equal(t, map[DeleteItem]DiagnosticKind{
+		{Kind: DeleteItemBuffer, Name: "test-buffer-1"}: DiagnosticConfigMismatch,
+		{Kind: DeleteItemBuffer, Name: "test-buffer-2"}: DiagnosticMissingConsumer,
+		{Kind: DeleteItemBuffer, Name: "test-buffer-3"}: DiagnosticMissingStream,
+		{Kind: DeleteItemBucket, Name: "test-bucket-1"}: DiagnosticMissingStream,
+	}, kinds)
+
+	report, err = repairer.RepairBuffersAndBuckets(ctx, buffers, buckets, "", nil, opts...)
+	assert.NoError(t, err)
+	assert.Len(t, report.Repaired, 4)
+	assert.Empty(t, report.Remaining)
+	assert.NoError(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil, opts...))
+	info, err = jsCtx.StreamInfo(JetStreamName("test-buffer-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), info.Config.MaxMsgs)
+
+	// the replicas of the streams are updated in place
+	replicas := WithConfig("stream:\n  replicas: 3\n")
+	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers[:1], nil, "", nil, replicas)
+	assert.ErrorContains(t, err, "stream.replicas 1, not 3")
+	assert.Equal(t, DiagnosticReplicasMismatch, Diagnostics(err)[0].Kind)
+	report, err = repairer.RepairBuffersAndBuckets(ctx, buffers[:1], nil, "", nil, replicas)
+	assert.NoError(t, err)
+	assert.Len(t, report.Repaired, 1)
+
+	// the streams owned by another pipeline are not repaired
+	owner, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-1"))
+	assert.NoError(t, err)
+	other, err := NewISBJetStreamSvc("testPipeline", client, WithPipelineUID("uid-2"))
+	assert.NoError(t, err)
+	assert.NoError(t, owner.CreateBuffersAndBuckets(ctx, []string{"test-buffer-4"}, nil, "", nil))
+	report, err = other.(Repairer).RepairBuffersAndBuckets(ctx, []string{"test-buffer-4"}, nil, "", nil)
+	assert.True(t, IsOwnershipMismatch(err))
+	assert.Empty(t, report.Repaired)
+	assert.Len(t, report.Remaining, 1)
+}
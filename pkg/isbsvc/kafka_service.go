@@ -0,0 +1,495 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	kafkastore "github.com/numaproj/numaflow/pkg/isb/stores/kafka"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+type kafkaSvc struct {
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+	// newConsumer creates the consumer of the history reads, which is not in the consumer groups of the buffers
+	newConsumer func() (sarama.Consumer, error)
+}
+
+// NewISBKafkaSvc is used to return an ISB Service with the buffers backed by the topics of a Kafka cluster, the
+// client should be created with the config of kafkastore.NewSaramaConfig. The client is not closed by the service.
+func NewISBKafkaSvc(client sarama.Client) (ISBService, error) {
+	// the admin is not closed, which would close the underlying client
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a Kafka admin client, %w", err)
+	}
+	return &kafkaSvc{
+		client: client,
+		admin:  admin,
+		newConsumer: func() (sarama.Consumer, error) {
+			return sarama.NewConsumerFromClient(client)
+		},
+	}, nil
+}
+
+// CreateBuffersAndBuckets is used to create the topics of the buffers, with the number of partitions, the replication
+// factor and the topic configs of the "topic" settings of the config, overridden by the buffer configs. The existing
+// topics are left untouched. The watermarks are not supported, there are no buckets to create.
+func (ks *kafkaSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error {
+	if sideInputsStore != "" || len(servingSourceStreams) > 0 {
+		return fmt.Errorf("side inputs and serving sources are not supported by the Kafka ISB Service")
+	}
+	if len(buffers) == 0 {
+		return nil
+	}
+	log := logging.FromContext(ctx)
+	creatOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
+	}
+	topics, err := ks.admin.ListTopics()
+	if err != nil {
+		return fmt.Errorf("failed to list the Kafka topics, %w", err)
+	}
+	for _, buffer := range buffers {
+		topic := KafkaTopicName(buffer)
+		if _, ok := topics[topic]; ok {
+			log.Infow("Topic already exists", zap.String("topic", topic))
+			continue
+		}
+		v, err := creatOpts.viper(buffer)
+		if err != nil {
+			return err
+		}
+		detail := &sarama.TopicDetail{
+			NumPartitions: v.GetInt32("topic.partitions"),
+			// -1 is the default replication factor of the brokers
+			ReplicationFactor: int16(v.GetInt("topic.replicationFactor")),
+		}
+		if detail.NumPartitions <= 0 {
+			detail.NumPartitions = 1
+		}
+		if detail.ReplicationFactor <= 0 {
+			detail.ReplicationFactor = -1
+		}
+		if configs := v.GetStringMapString("topic.config"); len(configs) > 0 {
+			detail.ConfigEntries = make(map[string]*string, len(configs))
+			for k, val := range configs {
+				detail.ConfigEntries[k] = &val
+			}
+		}
+		if err := ks.admin.CreateTopic(topic, detail, false); err != nil {
+			if errors.Is(err, sarama.ErrTopicAlreadyExists) {
+				log.Infow("Topic already exists", zap.String("topic", topic))
+				continue
+			}
+			return fmt.Errorf("failed to create topic %q, %w", topic, err)
+		}
+		log.Infow("Succeeded to create a topic", zap.String("topic", topic), zap.Int32("partitions", detail.NumPartitions))
+	}
+	return nil
+}
+
+// DeleteBuffersAndBuckets is used to delete the topics and the consumer groups of the buffers.
+func (ks *kafkaSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...DeleteOption) (*DeleteReport, error) {
+	if len(buffers) == 0 {
+		return &DeleteReport{}, nil
+	}
+	log := logging.FromContext(ctx)
+	deleteOpts := defaultDeleteOptions()
+	for _, opt := range opts {
+		if err := opt(deleteOpts); err != nil {
+			return nil, err
+		}
+	}
+	var tasks []deleteTask
+	for _, buffer := range buffers {
+		topic, group := KafkaTopicName(buffer), KafkaConsumerGroupName(buffer)
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffer},
+			delete: func(ctx context.Context) error {
+				// the consumer group is deleted first, its offsets are useless without the topic
+				if err := ks.admin.DeleteConsumerGroup(group); err != nil && !errors.Is(err, sarama.ErrGroupIDNotFound) {
+					return fmt.Errorf("failed to delete consumer group %q, %w", group, err)
+				}
+				if err := ks.admin.DeleteTopic(topic); err != nil {
+					if errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+						return fmt.Errorf("topic %q %w", topic, errItemNotFound)
+					}
+					return fmt.Errorf("failed to delete topic %q, %w", topic, err)
+				}
+				return nil
+			},
+		})
+	}
+	processed := 0
+	report := bulkDelete(ctx, tasks, deleteOpts.concurrency, deleteOpts.itemTimeout, func(item DeleteItem, err error) {
+		processed++
+		if err != nil {
+			log.Errorw("Failed to delete an item", zap.Stringer("item", item), zap.Int("processed", processed), zap.Int("total", len(tasks)), zap.Error(err))
+		} else {
+			log.Infow("Succeeded to delete an item", zap.Stringer("item", item), zap.Int("processed", processed), zap.Int("total", len(tasks)))
+		}
+		if deleteOpts.progress != nil {
+			deleteOpts.progress(item, err)
+		}
+	})
+	return report, report.Err()
+}
+
+// ValidateBuffersAndBuckets is used to validate the topics of the buffers exist, the number of partitions of a topic
+// is validated against the buffer config if it's set there.
+func (ks *kafkaSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error {
+	if len(buffers) == 0 {
+		return nil
+	}
+	creatOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
+	}
+	topics, err := ks.admin.ListTopics()
+	if err != nil {
+		return fmt.Errorf("failed to list the Kafka topics, %w", err)
+	}
+	for _, buffer := range buffers {
+		topic := KafkaTopicName(buffer)
+		detail, ok := topics[topic]
+		if !ok {
+			return fmt.Errorf("topic %q of buffer %q not existing", topic, buffer)
+		}
+		if _, ok := creatOpts.bufferConfigs[buffer]; !ok {
+			continue
+		}
+		v, err := creatOpts.viper(buffer)
+		if err != nil {
+			return err
+		}
+		if expected := v.GetInt32("topic.partitions"); expected > 0 && expected != detail.NumPartitions {
+			return &ConfigMismatchError{Stream: topic, Field: "topic.partitions", Expected: fmt.Sprint(expected), Actual: fmt.Sprint(detail.NumPartitions)}
+		}
+	}
+	return nil
+}
+
+// ListBuffersAndBuckets lists the buffers with the given prefix, the creation time and the owner of them are unknown.
+func (ks *kafkaSvc) ListBuffersAndBuckets(ctx context.Context, prefix string) ([]ItemInfo, error) {
+	topics, err := ks.admin.ListTopics()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the Kafka topics, %w", err)
+	}
+	var items []ItemInfo
+	for topic := range topics {
+		if strings.HasPrefix(topic, prefix) {
+			items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: topic}})
+		}
+	}
+	sortItemInfos(items)
+	return items, nil
+}
+
+// GetBufferInfo is used to provide the pending count of the buffer, which is the number of messages in the topic not
+// committed by the consumer group, summed over the partitions.
+func (ks *kafkaSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
+	offsets, err := kafkastore.GetTopicOffsets(ks.client, ks.admin, KafkaTopicName(buffer), KafkaConsumerGroupName(buffer))
+	if err != nil {
+		if errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+			return nil, fmt.Errorf("topic %q, %w", KafkaTopicName(buffer), ErrBufferNotFound)
+		}
+		return nil, err
+	}
+	var total int64
+	for _, p := range offsets {
+		total += p.Newest - p.Oldest
+	}
+	return &BufferInfo{
+		Name:         buffer,
+		PendingCount: kafkastore.TotalPending(offsets),
+		// the messages read but not committed are counted as pending, Kafka does not tell them apart
+		AckPendingCount: 0,
+		TotalMessages:   total,
+		// the topics are bounded by the retention, not by the number of messages
+		MaxLength:       UnknownMaxLength,
+		UsagePercentage: UnknownUsagePercentage,
+	}, nil
+}
+
+// WatchPartitionInfo checks the offsets of the topic of the partition at the watch interval.
+func (ks *kafkaSvc) WatchPartitionInfo(ctx context.Context, partition string) (<-chan *BufferInfo, error) {
+	return watchPartitionInfo(ctx, partition, partitionInfoWatchInterval, ks.GetBufferInfo)
+}
+
+// GetBuffersInfo is used to get the info of the buffers concurrently, Kafka has no batch API across topics and
+// consumer groups.
+func (ks *kafkaSvc) GetBuffersInfo(ctx context.Context, buffers []string) ([]*BufferInfo, error) {
+	return concurrentBuffersInfo(ctx, buffers, bufferInfoConcurrency, ks.GetBufferInfo)
+}
+
+// ReadBufferHistory is used to read the messages of the topic with a consumer outside the consumer group, the
+// partitions are read one after another in the order of the partition IDs. The start sequence is the sequence of a
+// message, the partitions after the one of it are read from the oldest messages.
+func (ks *kafkaSvc) ReadBufferHistory(ctx context.Context, buffer string, from HistoryPosition, fn func(*HistoryMessage) bool) error {
+	if err := from.validate(); err != nil {
+		return err
+	}
+	topic := KafkaTopicName(buffer)
+	startPartition, startOffset := int32(0), sarama.OffsetOldest
+	if from.StartSequence != "" {
+		var err error
+		if startPartition, startOffset, err = parseKafkaSequence(from.StartSequence); err != nil {
+			return fmt.Errorf("invalid start sequence %q of topic %q, %w", from.StartSequence, topic, err)
+		}
+	}
+	partitions, err := ks.client.Partitions(topic)
+	if err != nil {
+		return fmt.Errorf("failed to get the partitions of topic %q, %w", topic, err)
+	}
+	consumer, err := ks.newConsumer()
+	if err != nil {
+		return fmt.Errorf("failed to create a Kafka consumer, %w", err)
+	}
+	defer func() { _ = consumer.Close() }()
+	for _, partition := range partitions {
+		if partition < startPartition {
+			continue
+		}
+		// the partitions after the one of the start sequence are read from the oldest messages
+		offset := sarama.OffsetOldest
+		if partition == startPartition && from.StartSequence != "" {
+			offset = startOffset
+		}
+		more, err := ks.readPartitionHistory(ctx, consumer, topic, partition, offset, from.StartTime, fn)
+		if err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+// readPartitionHistory reads a partition from the offset, or from the first message at or after the start time if
+// the offset is sarama.OffsetOldest, until the last message existing now. It returns false if fn stops the read.
+func (ks *kafkaSvc) readPartitionHistory(ctx context.Context, consumer sarama.Consumer, topic string, partition int32, offset int64, startTime time.Time, fn func(*HistoryMessage) bool) (bool, error) {
+	oldest, err := ks.client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the oldest offset of topic %q, partition %d, %w", topic, partition, err)
+	}
+	// the read stops at the last message existing now, it doesn't wait for the new ones.
+	newest, err := ks.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the newest offset of topic %q, partition %d, %w", topic, partition, err)
+	}
+	start := max(offset, oldest)
+	if offset == sarama.OffsetOldest && !startTime.IsZero() {
+		if start, err = ks.client.GetOffset(topic, partition, startTime.UnixMilli()); err != nil {
+			return false, fmt.Errorf("failed to get the offset of topic %q, partition %d by time, %w", topic, partition, err)
+		}
+		if start < 0 {
+			// no message is written at or after the time
+			return true, nil
+		}
+	}
+	if start >= newest {
+		return true, nil
+	}
+	pc, err := consumer.ConsumePartition(topic, partition, start)
+	if err != nil {
+		return false, fmt.Errorf("failed to consume topic %q, partition %d, %w", topic, partition, err)
+	}
+	defer func() { _ = pc.Close() }()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, fmt.Errorf("failed to read the history of topic %q, %w", topic, ctx.Err())
+		case err := <-pc.Errors():
+			return false, fmt.Errorf("failed to read the history of topic %q, partition %d, %w", topic, partition, err)
+		case msg := <-pc.Messages():
+			historyMsg := &HistoryMessage{
+				Sequence:  kafkaSequence(msg.Partition, msg.Offset),
+				WriteTime: msg.Timestamp,
+			}
+			if err := historyMsg.Message.UnmarshalBinary(msg.Value); err != nil {
+				return false, fmt.Errorf("failed to decode message %s of topic %q, %w", historyMsg.Sequence, topic, err)
+			}
+			if !fn(historyMsg) {
+				return false, nil
+			}
+			if msg.Offset >= newest-1 {
+				return true, nil
+			}
+		}
+	}
+}
+
+// PeekMessages reads the messages of the topic of the partition from the offset, which is "{partition}-{offset}".
+func (ks *kafkaSvc) PeekMessages(ctx context.Context, partition, offset string, count int) ([]*HistoryMessage, error) {
+	if err := ks.checkTopic(partition); err != nil {
+		return nil, err
+	}
+	return peekHistory(ctx, ks.ReadBufferHistory, partition, offset, count)
+}
+
+// PurgeBuffers is used to delete the records of the topics. The consumer groups need no acknowledgement, a consumer
+// group whose committed offset is deleted starts from the oldest message left. The watermarks are not supported,
+// there are no offset timelines to reset.
+func (ks *kafkaSvc) PurgeBuffers(ctx context.Context, buffers []string, opts ...PurgeOption) error {
+	o, err := newPurgeOptions(opts...)
+	if err != nil {
+		return err
+	}
+	log := logging.FromContext(ctx)
+	var errs []error
+	for _, buffer := range buffers {
+		if err := ks.purgeTopic(KafkaTopicName(buffer), o); err != nil {
+			errs = append(errs, fmt.Errorf("failed to purge buffer %q, %w", buffer, err))
+			continue
+		}
+		log.Infow("Purged buffer", zap.String("buffer", buffer), zap.Time("before", o.before), zap.Int64("keepRecent", o.keepRecent))
+	}
+	return errors.Join(errs...)
+}
+
+// ResizePartition only checks the partition, a Kafka topic has no max number of messages, the length of the buffer
+// is enforced by its writers.
+func (ks *kafkaSvc) ResizePartition(_ context.Context, partition string, newBufferLength int64) error {
+	if newBufferLength <= 0 {
+		return fmt.Errorf("invalid buffer length %d of partition %q, it should be positive", newBufferLength, partition)
+	}
+	return ks.checkTopic(partition)
+}
+
+// checkTopic returns an error wrapping ErrBufferNotFound if the topic of the buffer does not exist.
+func (ks *kafkaSvc) checkTopic(buffer string) error {
+	topic := KafkaTopicName(buffer)
+	if _, err := ks.client.Partitions(topic); err != nil {
+		if errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+			return fmt.Errorf("topic %q, %w", topic, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get the partitions of topic %q, %w", topic, err)
+	}
+	return nil
+}
+
+// PurgePartition is not supported, the partitions of Kafka can not be paused before the purge.
+func (ks *kafkaSvc) PurgePartition(_ context.Context, partition, _ string, _ ...PurgeOption) error {
+	return fmt.Errorf("failed to purge partition %q, the partitions of Kafka can not be paused, %w", partition, ErrPartitionNotPaused)
+}
+
+// PausePartition is not supported, the consumer groups of Kafka can not be paused by another client.
+func (ks *kafkaSvc) PausePartition(_ context.Context, partition string) error {
+	return fmt.Errorf("failed to pause partition %q, pausing the partitions is not supported by Kafka", partition)
+}
+
+// ResumePartition is not supported, see PausePartition.
+func (ks *kafkaSvc) ResumePartition(_ context.Context, partition string) error {
+	return fmt.Errorf("failed to resume partition %q, pausing the partitions is not supported by Kafka", partition)
+}
+
+func (ks *kafkaSvc) purgeTopic(topic string, o *purgeOptions) error {
+	partitions, err := ks.client.Partitions(topic)
+	if err != nil {
+		if errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+			return fmt.Errorf("topic %q, %w", topic, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get the partitions of topic %q, %w", topic, err)
+	}
+	// the records before the offsets are deleted, each partition keeps its own recent messages
+	deletes := make(map[int32]int64)
+	for _, partition := range partitions {
+		oldest, err := ks.client.GetOffset(topic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return fmt.Errorf("failed to get the oldest offset of topic %q, partition %d, %w", topic, partition, err)
+		}
+		newest, err := ks.client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return fmt.Errorf("failed to get the newest offset of topic %q, partition %d, %w", topic, partition, err)
+		}
+		cut := newest - o.keepRecent
+		if !o.before.IsZero() {
+			at, err := ks.client.GetOffset(topic, partition, o.before.UnixMilli())
+			if err != nil {
+				return fmt.Errorf("failed to get the offset of topic %q, partition %d by time, %w", topic, partition, err)
+			}
+			// -1 if all the messages are written before the time
+			if at >= 0 {
+				cut = min(cut, at)
+			}
+		}
+		if cut > oldest {
+			deletes[partition] = cut
+		}
+	}
+	if len(deletes) == 0 {
+		return nil
+	}
+	if err := ks.admin.DeleteRecords(topic, deletes); err != nil {
+		return fmt.Errorf("failed to delete the records of topic %q, %w", topic, err)
+	}
+	return nil
+}
+
+// CreateWatermarkStores is used to create the watermark stores.
+func (ks *kafkaSvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]store.WatermarkStore, error) {
+	// Watermark fetching is not supported for Kafka ATM. Creating noop watermark fetcher.
+	var wmStores []store.WatermarkStore
+	partitions := 1
+	if isReduce {
+		partitions = fromBufferPartitionCount
+	}
+	for i := 0; i < partitions; i++ {
+		wmStore, _ := store.BuildNoOpWatermarkStore()
+		wmStores = append(wmStores, wmStore)
+	}
+	return wmStores, nil
+}
+
+// kafkaSequence is the sequence of a message in a buffer, as "{partition}-{offset}".
+func kafkaSequence(partition int32, offset int64) string {
+	return fmt.Sprintf("%d-%d", partition, offset)
+}
+
+func parseKafkaSequence(seq string) (int32, int64, error) {
+	partitionPart, offsetPart, ok := strings.Cut(seq, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("it should be {partition}-{offset}")
+	}
+	partition, err := strconv.ParseInt(partitionPart, 10, 32)
+	if err != nil || partition < 0 {
+		return 0, 0, fmt.Errorf("invalid partition %q", partitionPart)
+	}
+	offset, err := strconv.ParseInt(offsetPart, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, 0, fmt.Errorf("invalid offset %q", offsetPart)
+	}
+	return int32(partition), offset, nil
+}
+
+func KafkaTopicName(bufferName string) string {
+	return bufferName
+}
+
+func KafkaConsumerGroupName(bufferName string) string {
+	return fmt.Sprintf("%s-group", bufferName)
+}
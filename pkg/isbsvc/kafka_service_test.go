@@ -0,0 +1,331 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+// fakeKafka is an in-memory Kafka cluster serving the sarama client and cluster admin calls of the Kafka ISB Service
+type fakeKafka struct {
+	lock sync.Mutex
+	// topics are the times when the messages are written to the partitions of the topics
+	topics map[string][][]time.Time
+	// oldest are the offsets of the oldest messages of the partitions, the records before them are deleted
+	oldest    map[string][]int64
+	committed map[string]map[int32]int64
+	created   map[string]*sarama.TopicDetail
+}
+
+func newFakeKafka() *fakeKafka {
+	return &fakeKafka{
+		topics:    make(map[string][][]time.Time),
+		oldest:    make(map[string][]int64),
+		committed: make(map[string]map[int32]int64),
+		created:   make(map[string]*sarama.TopicDetail),
+	}
+}
+
+func (k *fakeKafka) addTopic(topic string, partitions ...[]time.Time) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.topics[topic] = partitions
+	k.oldest[topic] = make([]int64, len(partitions))
+}
+
+func (k *fakeKafka) svc(consumer sarama.Consumer) *kafkaSvc {
+	return &kafkaSvc{
+		client: &fakeKafkaClient{k: k},
+		admin:  &fakeKafkaAdmin{k: k},
+		newConsumer: func() (sarama.Consumer, error) {
+			return consumer, nil
+		},
+	}
+}
+
+type fakeKafkaClient struct {
+	sarama.Client
+	k *fakeKafka
+}
+
+func (c *fakeKafkaClient) Partitions(topic string) ([]int32, error) {
+	c.k.lock.Lock()
+	defer c.k.lock.Unlock()
+	partitions, ok := c.k.topics[topic]
+	if !ok {
+		return nil, sarama.ErrUnknownTopicOrPartition
+	}
+	result := make([]int32, len(partitions))
+	for i := range partitions {
+		result[i] = int32(i)
+	}
+	return result, nil
+}
+
+func (c *fakeKafkaClient) GetOffset(topic string, partition int32, t int64) (int64, error) {
+	c.k.lock.Lock()
+	defer c.k.lock.Unlock()
+	times := c.k.topics[topic][partition]
+	oldest := c.k.oldest[topic][partition]
+	switch t {
+	case sarama.OffsetOldest:
+		return oldest, nil
+	case sarama.OffsetNewest:
+		return int64(len(times)), nil
+	}
+	for offset := oldest; offset < int64(len(times)); offset++ {
+		if times[offset].UnixMilli() >= t {
+			return offset, nil
+		}
+	}
+	return -1, nil
+}
+
+type fakeKafkaAdmin struct {
+	sarama.ClusterAdmin
+	k *fakeKafka
+}
+
+func (a *fakeKafkaAdmin) ListTopics() (map[string]sarama.TopicDetail, error) {
+	a.k.lock.Lock()
+	defer a.k.lock.Unlock()
+	result := make(map[string]sarama.TopicDetail)
+	for topic, partitions := range a.k.topics {
+		result[topic] = sarama.TopicDetail{NumPartitions: int32(len(partitions))}
+	}
+	return result, nil
+}
+
+func (a *fakeKafkaAdmin) CreateTopic(topic string, detail *sarama.TopicDetail, _ bool) error {
+	a.k.lock.Lock()
+	defer a.k.lock.Unlock()
+	if _, ok := a.k.topics[topic]; ok {
+		return &sarama.TopicError{Err: sarama.ErrTopicAlreadyExists}
+	}
+	a.k.topics[topic] = make([][]time.Time, detail.NumPartitions)
+	a.k.oldest[topic] = make([]int64, detail.NumPartitions)
+	a.k.created[topic] = detail
+	return nil
+}
+
+func (a *fakeKafkaAdmin) DeleteTopic(topic string) error {
+	a.k.lock.Lock()
+	defer a.k.lock.Unlock()
+	if _, ok := a.k.topics[topic]; !ok {
+		return sarama.ErrUnknownTopicOrPartition
+	}
+	delete(a.k.topics, topic)
+	return nil
+}
+
+func (a *fakeKafkaAdmin) DeleteConsumerGroup(group string) error {
+	a.k.lock.Lock()
+	defer a.k.lock.Unlock()
+	if _, ok := a.k.committed[group]; !ok {
+		return sarama.ErrGroupIDNotFound
+	}
+	delete(a.k.committed, group)
+	return nil
+}
+
+func (a *fakeKafkaAdmin) ListConsumerGroupOffsets(group string, topicPartitions map[string][]int32) (*sarama.OffsetFetchResponse, error) {
+	a.k.lock.Lock()
+	defer a.k.lock.Unlock()
+	resp := &sarama.OffsetFetchResponse{}
+	for topic, partitions := range topicPartitions {
+		for _, partition := range partitions {
+			offset, ok := a.k.committed[group][partition]
+			if !ok {
+				offset = -1
+			}
+			resp.AddBlock(topic, partition, &sarama.OffsetFetchResponseBlock{Offset: offset})
+		}
+	}
+	return resp, nil
+}
+
+func (a *fakeKafkaAdmin) DeleteRecords(topic string, partitionOffsets map[int32]int64) error {
+	a.k.lock.Lock()
+	defer a.k.lock.Unlock()
+	for partition, offset := range partitionOffsets {
+		a.k.oldest[topic][partition] = offset
+	}
+	return nil
+}
+
+func TestKafkaSvc_Buffers(t *testing.T) {
+	ctx := context.Background()
+	k := newFakeKafka()
+	svc := k.svc(nil)
+	buffers := []string{"test-pl-a-0", "test-pl-b-0"}
+	k.committed[KafkaConsumerGroupName(buffers[0])] = map[int32]int64{}
+
+	assert.Error(t, svc.CreateBuffersAndBuckets(ctx, buffers, nil, "test-pl", nil))
+	require.NoError(t, svc.CreateBuffersAndBuckets(ctx, buffers, []string{"test-pl-a-b"}, "", nil,
+		WithConfig("topic:\n  partitions: 2\n  config:\n    retention.ms: \"3600000\""),
+		WithBufferConfig(buffers[1], "topic:\n  partitions: 3\n  replicationFactor: 3")))
+	assert.Equal(t, int32(2), k.created[buffers[0]].NumPartitions)
+	assert.Equal(t, int16(-1), k.created[buffers[0]].ReplicationFactor)
+	assert.Equal(t, "3600000", *k.created[buffers[0]].ConfigEntries["retention.ms"])
+	assert.Equal(t, int32(3), k.created[buffers[1]].NumPartitions)
+	assert.Equal(t, int16(3), k.created[buffers[1]].ReplicationFactor)
+	// the existing topics are left untouched
+	require.NoError(t, svc.CreateBuffersAndBuckets(ctx, buffers, nil, "", nil))
+
+	assert.NoError(t, svc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, WithBufferConfig(buffers[1], "topic:\n  partitions: 3")))
+	err := svc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", nil, WithBufferConfig(buffers[1], "topic:\n  partitions: 4"))
+	assert.True(t, IsConfigMismatch(err))
+	assert.Error(t, svc.ValidateBuffersAndBuckets(ctx, []string{"test-pl-c-0"}, nil, "", nil))
+
+	assert.NoError(t, svc.ResizePartition(ctx, buffers[0], 100))
+	assert.ErrorIs(t, svc.ResizePartition(ctx, "test-pl-c-0", 100), ErrBufferNotFound)
+
+	k.addTopic("other-pl-a-0", nil)
+	items, err := svc.ListBuffersAndBuckets(ctx, "test-pl-")
+	require.NoError(t, err)
+	assert.Equal(t, []ItemInfo{
+		{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffers[0]}},
+		{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffers[1]}},
+	}, items)
+
+	report, err := svc.DeleteBuffersAndBuckets(ctx, append(buffers, "test-pl-c-0"), nil, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []DeleteItem{{Kind: DeleteItemBuffer, Name: buffers[0]}, {Kind: DeleteItemBuffer, Name: buffers[1]}}, report.Deleted)
+	assert.Equal(t, []DeleteItem{{Kind: DeleteItemBuffer, Name: "test-pl-c-0"}}, report.NotFound)
+	assert.NotContains(t, k.committed, KafkaConsumerGroupName(buffers[0]))
+}
+
+func TestKafkaSvc_GetBuffersInfo(t *testing.T) {
+	ctx := context.Background()
+	k := newFakeKafka()
+	now := time.Now()
+	k.addTopic("test-buffer", make([]time.Time, 10), make([]time.Time, 5), []time.Time{now})
+	k.oldest["test-buffer"][1] = 2
+	// nothing is committed in partition 1, the consumer group starts from the oldest message
+	k.committed[KafkaConsumerGroupName("test-buffer")] = map[int32]int64{0: 4, 2: 1}
+	svc := k.svc(nil)
+
+	infos, err := svc.GetBuffersInfo(ctx, []string{"test-buffer", "missing"})
+	assert.Error(t, err)
+	require.Len(t, infos, 1)
+	assert.Equal(t, &BufferInfo{
+		Name:            "test-buffer",
+		PendingCount:    9,
+		TotalMessages:   14,
+		MaxLength:       UnknownMaxLength,
+		UsagePercentage: UnknownUsagePercentage,
+	}, infos[0])
+}
+
+func TestKafkaSvc_ReadBufferHistory(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(1636470000, 0)
+	k := newFakeKafka()
+	k.addTopic("test-buffer",
+		[]time.Time{start, start.Add(time.Second), start.Add(2 * time.Second)},
+		[]time.Time{start.Add(time.Second), start.Add(3 * time.Second)})
+	messages := testutils.BuildTestWriteMessages(5, start, nil, "testVertex")
+
+	newConsumer := func(t *testing.T, offsets map[int32]int64) *mocks.Consumer {
+		consumer := mocks.NewConsumer(t, nil)
+		for partition, offset := range offsets {
+			pc := consumer.ExpectConsumePartition("test-buffer", partition, offset)
+			count := len(k.topics["test-buffer"][partition])
+			for i := offset; i < int64(count); i++ {
+				value, err := messages[int(partition)*3+int(i)].MarshalBinary()
+				require.NoError(t, err)
+				pc.YieldMessage(&sarama.ConsumerMessage{Value: value, Timestamp: k.topics["test-buffer"][partition][i]})
+			}
+		}
+		return consumer
+	}
+	read := func(svc *kafkaSvc, from HistoryPosition, limit int) ([]string, error) {
+		var seqs []string
+		err := svc.ReadBufferHistory(ctx, "test-buffer", from, func(m *HistoryMessage) bool {
+			seqs = append(seqs, m.Sequence)
+			return len(seqs) < limit
+		})
+		return seqs, err
+	}
+
+	seqs, err := read(k.svc(newConsumer(t, map[int32]int64{0: 0, 1: 0})), HistoryPosition{}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0-0", "0-1", "0-2", "1-0", "1-1"}, seqs)
+
+	seqs, err = read(k.svc(newConsumer(t, map[int32]int64{0: 0})), HistoryPosition{}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0-0", "0-1"}, seqs)
+
+	seqs, err = read(k.svc(newConsumer(t, map[int32]int64{0: 2, 1: 1})), HistoryPosition{StartTime: start.Add(2 * time.Second)}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0-2", "1-1"}, seqs)
+
+	seqs, err = read(k.svc(newConsumer(t, map[int32]int64{1: 1})), HistoryPosition{StartSequence: "1-1"}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1-1"}, seqs)
+
+	_, err = read(k.svc(nil), HistoryPosition{StartSequence: "1"}, 10)
+	assert.Error(t, err)
+
+	peeked, err := k.svc(newConsumer(t, map[int32]int64{0: 1})).PeekMessages(ctx, "test-buffer", "0-1", 1)
+	require.NoError(t, err)
+	require.Len(t, peeked, 1)
+	assert.Equal(t, "0-1", peeked[0].Sequence)
+	assert.Equal(t, messages[1].Header.ID, peeked[0].Header.ID)
+	_, err = k.svc(nil).PeekMessages(ctx, "missing", "", 1)
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+	assert.ErrorIs(t, k.svc(nil).PurgePartition(ctx, "test-buffer", "0-1"), ErrPartitionNotPaused)
+}
+
+func TestKafkaSvc_PurgeBuffers(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(1636470000, 0)
+	times := []time.Time{start, start.Add(time.Second), start.Add(2 * time.Second), start.Add(3 * time.Second)}
+	k := newFakeKafka()
+	svc := k.svc(nil)
+
+	k.addTopic("test-buffer", times, times[:1])
+	require.NoError(t, svc.PurgeBuffers(ctx, []string{"test-buffer"}, WithKeepRecent(1)))
+	assert.Equal(t, []int64{3, 0}, k.oldest["test-buffer"])
+
+	k.addTopic("test-buffer", times, times[:1])
+	require.NoError(t, svc.PurgeBuffers(ctx, []string{"test-buffer"}, WithPurgeBefore(start.Add(2*time.Second))))
+	assert.Equal(t, []int64{2, 1}, k.oldest["test-buffer"])
+
+	k.addTopic("test-buffer", times, times[:1])
+	// the recent messages are kept per partition
+	require.NoError(t, svc.PurgeBuffers(ctx, []string{"test-buffer"}, WithPurgeBefore(start.Add(3*time.Second)), WithKeepRecent(2)))
+	assert.Equal(t, []int64{2, 0}, k.oldest["test-buffer"])
+
+	k.addTopic("test-buffer", times)
+	require.NoError(t, svc.PurgeBuffers(ctx, []string{"test-buffer"}))
+	assert.Equal(t, []int64{4}, k.oldest["test-buffer"])
+
+	err := svc.PurgeBuffers(ctx, []string{"missing", "test-buffer"})
+	assert.True(t, errors.Is(err, ErrBufferNotFound))
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+// OwnershipMismatchError is returned when a stream is owned by another pipeline.
+type OwnershipMismatchError struct {
+	Stream   string
+	Owner    string
+	Pipeline string
+}
+
+func (e *OwnershipMismatchError) Error() string {
+	return fmt.Sprintf("stream %q is owned by pipeline %q, not by pipeline %q", e.Stream, e.Owner, e.Pipeline)
+}
+
+// IsOwnershipMismatch returns true if the error is caused by an ownership mismatch.
+func IsOwnershipMismatch(err error) bool {
+	var e *OwnershipMismatchError
+	return errors.As(err, &e)
+}
+
+// jetStreamKVStreamName returns the name of the stream backing a JetStream KV.
+func jetStreamKVStreamName(kvName string) string {
+	return "KV_" + kvName
+}
+
+// ownedStreams returns the streams of the given buffers and buckets, the ownership of which is verified.
+func ownedStreams(buffers, buckets []string) []string {
+	var streams []string
+	for _, buffer := range buffers {
+		streams = append(streams, JetStreamName(buffer))
+	}
+	for _, bucket := range buckets {
+		streams = append(streams, jetStreamKVStreamName(wmstore.JetStreamOTKVName(bucket)))
+		streams = append(streams, jetStreamKVStreamName(wmstore.JetStreamProcessorKVName(bucket)))
+	}
+	return streams
+}
+
+// ownerMetadata returns the stream metadata recording the pipeline as the owner, nil if the pipeline UID is unknown.
+func (jss *jetStreamSvc) ownerMetadata() map[string]string {
+	if jss.pipelineUID == "" {
+		return nil
+	}
+	return map[string]string{dfv1.KeyPipelineUID: jss.pipelineUID}
+}
+
+// setStreamOwner records the pipeline as the owner of an existing stream.
+func (jss *jetStreamSvc) setStreamOwner(info *nats.StreamInfo) error {
+	cfg := info.Config
+	metadata := make(map[string]string, len(cfg.Metadata)+1)
+	for k, v := range cfg.Metadata {
+		metadata[k] = v
+	}
+	metadata[dfv1.KeyPipelineUID] = jss.pipelineUID
+	cfg.Metadata = metadata
+	_, err := jss.js.UpdateStream(&cfg)
+	return err
+}
+
+// claimStream records the pipeline as the owner of a newly created stream, it's a no-op if the pipeline UID is unknown.
+func (jss *jetStreamSvc) claimStream(streamName string) error {
+	if jss.pipelineUID == "" {
+		return nil
+	}
+	info, err := jss.js.StreamInfo(streamName)
+	if err != nil {
+		return fmt.Errorf("failed to query information of stream %q, %w", streamName, err)
+	}
+	if err := jss.setStreamOwner(info); err != nil {
+		return fmt.Errorf("failed to record the owner of stream %q, %w", streamName, err)
+	}
+	return nil
+}
+
+// verifyStreamOwner verifies that the stream is owned by the pipeline. A stream created before the ownership is
+// recorded is adopted by the pipeline if adopt is true, otherwise it is considered as owned by the pipeline.
+// The verification is skipped if the pipeline UID is unknown or the stream does not exist.
+func (jss *jetStreamSvc) verifyStreamOwner(ctx context.Context, streamName string, adopt bool) error {
+	if jss.pipelineUID == "" {
+		return nil
+	}
+	info, err := jss.js.StreamInfo(streamName)
+	if err != nil {
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to query information of stream %q, %w", streamName, err)
+	}
+	switch owner := info.Config.Metadata[dfv1.KeyPipelineUID]; owner {
+	case jss.pipelineUID:
+		return nil
+	case "":
+		if !adopt {
+			return nil
+		}
+		if err := jss.setStreamOwner(info); err != nil {
+			return fmt.Errorf("failed to adopt stream %q, %w", streamName, err)
+		}
+		logging.FromContext(ctx).Infow("Adopted a stream without owner", zap.String("stream", streamName), zap.String("pipelineUID", jss.pipelineUID))
+		return nil
+	default:
+		return &OwnershipMismatchError{Stream: streamName, Owner: owner, Pipeline: jss.pipelineUID}
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// partitionInfoWatchInterval is the interval the info of a watched partition is checked at. The ISB Services don't
+// notify the changes of the state of a stream, so the watch checks it on behalf of the watchers, and only sends the
+// changes.
+const partitionInfoWatchInterval = time.Second
+
+// watchPartitionInfo gets the info of the partition right away, and returns the error if it can't be got, e.g. the
+// partition does not exist. The info is sent on the returned channel, the current one first, then every time it changes,
+// until the context is done or the partition is deleted, when the channel is closed. The errors getting the info later
+// on are logged, and the info is checked again at the next interval. The channel only holds the latest info, a slow
+// receiver skips the intermediate ones instead of holding up the watch.
+func watchPartitionInfo(ctx context.Context, partition string, interval time.Duration, get func(ctx context.Context, buffer string) (*BufferInfo, error)) (<-chan *BufferInfo, error) {
+	last, err := get(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *BufferInfo, 1)
+	ch <- last
+	go func() {
+		defer close(ch)
+		log := logging.FromContext(ctx).With(zap.String("partition", partition))
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			info, err := get(ctx, partition)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if errors.Is(err, ErrBufferNotFound) {
+					log.Infow("Partition deleted, stopped watching its info")
+					return
+				}
+				log.Warnw("Failed to get the info of the watched partition", zap.Error(err))
+				continue
+			}
+			if sameBufferInfo(info, last) {
+				continue
+			}
+			last = info
+			// drop the info not received yet, this goroutine is the only sender so the channel has room afterwards
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- info
+		}
+	}()
+	return ch, nil
+}
+
+// sameBufferInfo returns whether the two infos describe the same state of a buffer.
+func sameBufferInfo(a, b *BufferInfo) bool {
+	return a.Name == b.Name &&
+		a.PendingCount == b.PendingCount &&
+		a.AckPendingCount == b.AckPendingCount &&
+		a.TotalMessages == b.TotalMessages &&
+		a.TotalBytes == b.TotalBytes &&
+		a.OldestPendingTime.Equal(b.OldestPendingTime) &&
+		a.MaxLength == b.MaxLength &&
+		a.UsagePercentage == b.UsagePercentage &&
+		a.OldestMessageTime.Equal(b.OldestMessageTime) &&
+		sameQuotaUsage(a.Quota, b.Quota) &&
+		slices.EqualFunc(a.Consumers, b.Consumers, sameConsumerLag)
+}
+
+// sameConsumerLag tells if the lags of a consumer are the same, the last active time is not compared, as it moves
+// with every delivery, which the offsets already tell.
+func sameConsumerLag(a, b ConsumerLag) bool {
+	return a.Name == b.Name &&
+		a.ProducedOffset == b.ProducedOffset &&
+		a.DeliveredOffset == b.DeliveredOffset &&
+		a.AckedOffset == b.AckedOffset &&
+		a.PendingCount == b.PendingCount &&
+		a.AckPendingCount == b.AckPendingCount
+}
+
+func sameQuotaUsage(a, b *QuotaUsage) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
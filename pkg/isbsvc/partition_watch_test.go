@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchPartitionInfo(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the pending count is bumped every 3 checks, the partition is deleted once it reaches 3
+	var checks atomic.Int64
+	get := func(_ context.Context, buffer string) (*BufferInfo, error) {
+		n := checks.Add(1)
+		pending := n / 3
+		if pending >= 3 {
+			return nil, fmt.Errorf("stream %q, %w", buffer, ErrBufferNotFound)
+		}
+		if n == 4 {
+			return nil, errors.New("temporarily unavailable")
+		}
+		return &BufferInfo{Name: buffer, PendingCount: pending, OldestPendingTime: time.Unix(100, 0)}, nil
+	}
+	infos, err := watchPartitionInfo(ctx, "test-partition", 5*time.Millisecond, get)
+	require.NoError(t, err)
+	var pendings []int64
+	for info := range infos {
+		assert.Equal(t, "test-partition", info.Name)
+		pendings = append(pendings, info.PendingCount)
+	}
+	// the same info is sent only once, and the failed check is skipped
+	assert.Equal(t, []int64{0, 1, 2}, pendings)
+}
+
+func TestWatchPartitionInfo_NotFound(t *testing.T) {
+	get := func(_ context.Context, buffer string) (*BufferInfo, error) {
+		return nil, fmt.Errorf("stream %q, %w", buffer, ErrBufferNotFound)
+	}
+	_, err := watchPartitionInfo(context.Background(), "test-partition", time.Millisecond, get)
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+}
+
+func TestWatchPartitionInfo_LatestOnly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var checks atomic.Int64
+	get := func(_ context.Context, buffer string) (*BufferInfo, error) {
+		return &BufferInfo{Name: buffer, PendingCount: checks.Add(1)}, nil
+	}
+	infos, err := watchPartitionInfo(ctx, "test-partition", time.Millisecond, get)
+	require.NoError(t, err)
+	// the receiver falls behind, the initial info not received is replaced by the later ones
+	time.Sleep(50 * time.Millisecond)
+	first := <-infos
+	assert.Greater(t, first.PendingCount, int64(1))
+	cancel()
+	for range infos {
+	}
+}
+
+func TestSameBufferInfo(t *testing.T) {
+	info := func(lag ConsumerLag, quota *QuotaUsage) *BufferInfo {
+		return &BufferInfo{Name: "test-buffer", Consumers: []ConsumerLag{lag}, Quota: quota}
+	}
+	lag := ConsumerLag{Name: "consumer", ProducedOffset: "3", DeliveredOffset: "2", AckedOffset: "1", LastActive: time.Unix(100, 0)}
+	assert.True(t, sameBufferInfo(info(lag, nil), info(lag, nil)))
+	// the last active time moves with the offsets, it's not compared alone
+	active := lag
+	active.LastActive = time.Unix(200, 0)
+	assert.True(t, sameBufferInfo(info(lag, nil), info(active, nil)))
+	acked := lag
+	acked.AckedOffset = "2"
+	assert.False(t, sameBufferInfo(info(lag, nil), info(acked, nil)))
+	assert.False(t, sameBufferInfo(info(lag, nil), &BufferInfo{Name: "test-buffer"}))
+	assert.True(t, sameBufferInfo(info(lag, &QuotaUsage{Streams: 1}), info(lag, &QuotaUsage{Streams: 1})))
+	assert.False(t, sameBufferInfo(info(lag, &QuotaUsage{Streams: 1}), info(lag, nil)))
+}
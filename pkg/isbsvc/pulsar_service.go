@@ -0,0 +1,632 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	pulsaradmin "github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/rest"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"go.uber.org/zap"
+
+	pulsarkv "github.com/numaproj/numaflow/pkg/shared/kvs/pulsar"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+// pulsarKVCompactionThreshold is the backlog size in bytes of a watermark KV topic triggering a compaction, the table
+// views of the watermark stores replay the topics from the start, so they are kept compacted.
+const pulsarKVCompactionThreshold int64 = 1 << 20
+
+type pulsarSvc struct {
+	client pulsar.Client
+	admin  pulsaradmin.Client
+	// namespace is the Pulsar namespace of the topics, "{tenant}/{namespace}"
+	namespace string
+}
+
+// NewISBPulsarSvc is used to return an ISB Service backed by the topics of a Pulsar namespace, which is given as
+// "{tenant}/{namespace}". Each buffer is a non-partitioned topic consumed by the subscription "{buffer}-group",
+// each watermark bucket is a pair of compacted topics "{bucket}_PROCESSORS" and "{bucket}_OT". The clients are
+// closed by the caller.
+func NewISBPulsarSvc(client pulsar.Client, admin pulsaradmin.Client, namespace string) (ISBService, error) {
+	if _, err := utils.GetNamespaceName(namespace); err != nil {
+		return nil, fmt.Errorf("invalid Pulsar namespace %q, %w", namespace, err)
+	}
+	return &pulsarSvc{client: client, admin: admin, namespace: namespace}, nil
+}
+
+// PulsarSubscriptionName returns the name of the subscription the pipeline consumes the buffer with.
+func PulsarSubscriptionName(buffer string) string {
+	return fmt.Sprintf("%s-group", buffer)
+}
+
+// topicName returns the full name of the topic of a buffer or a watermark KV in the namespace.
+func (ps *pulsarSvc) topicName(name string) (*utils.TopicName, error) {
+	return utils.GetTopicName(pulsarkv.TopicName(ps.namespace, name))
+}
+
+// pulsarErrorCode returns the HTTP status code of a Pulsar admin error, 0 if it's not one.
+func pulsarErrorCode(err error) int {
+	var e rest.Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return 0
+}
+
+// CreateBuffersAndBuckets is used to create the topics and the subscriptions of the buffers, and the KV topics of
+// the buckets. The config and the buffer configs do not apply, the topics are created with the policies of the
+// namespace. The side inputs store and the serving source streams are not supported.
+func (ps *pulsarSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, _ ...CreateOption) error {
+	if sideInputsStore != "" || len(servingSourceStreams) > 0 {
+		return fmt.Errorf("side inputs and serving are not supported by the Pulsar ISB Service")
+	}
+	log := logging.FromContext(ctx)
+	for _, buffer := range buffers {
+		topic, err := ps.topicName(buffer)
+		if err != nil {
+			return fmt.Errorf("invalid topic name of buffer %q, %w", buffer, err)
+		}
+		if err := ps.createTopic(ctx, topic); err != nil {
+			return err
+		}
+		// the subscription starts from the earliest message, so the messages written before the readers are up are
+		// not skipped.
+		group := PulsarSubscriptionName(buffer)
+		if err := ps.admin.Subscriptions().Create(*topic, group, utils.Earliest); err != nil {
+			if pulsarErrorCode(err) != http.StatusConflict {
+				return fmt.Errorf("failed to create subscription %q of topic %q, %w", group, topic, err)
+			}
+			log.Infow("Subscription already exists", zap.String("topic", topic.String()), zap.String("subscription", group))
+		}
+	}
+	for _, bucket := range buckets {
+		for _, kvName := range []string{wmstore.JetStreamProcessorKVName(bucket), wmstore.JetStreamOTKVName(bucket)} {
+			topic, err := ps.topicName(kvName)
+			if err != nil {
+				return fmt.Errorf("invalid topic name of bucket %q, %w", bucket, err)
+			}
+			if err := ps.createTopic(ctx, topic); err != nil {
+				return err
+			}
+			if err := ps.admin.Topics().SetCompactionThreshold(*topic, pulsarKVCompactionThreshold); err != nil {
+				return fmt.Errorf("failed to set the compaction threshold of topic %q, %w", topic, err)
+			}
+		}
+	}
+	return nil
+}
+
+// createTopic creates a non-partitioned topic, an existing one is left as is.
+func (ps *pulsarSvc) createTopic(ctx context.Context, topic *utils.TopicName) error {
+	log := logging.FromContext(ctx)
+	if err := ps.admin.Topics().Create(*topic, 0); err != nil {
+		if pulsarErrorCode(err) == http.StatusConflict {
+			log.Infow("Topic already exists", zap.String("topic", topic.String()))
+			return nil
+		}
+		return fmt.Errorf("failed to create topic %q, %w", topic, err)
+	}
+	log.Infow("Succeeded to create a topic", zap.String("topic", topic.String()))
+	return nil
+}
+
+// DeleteBuffersAndBuckets is used to delete the topics of the buffers with their subscriptions, and the KV topics of
+// the buckets. The side inputs store and the serving source streams are never created, they are ignored.
+func (ps *pulsarSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...DeleteOption) (*DeleteReport, error) {
+	if len(buffers) == 0 && len(buckets) == 0 {
+		return &DeleteReport{}, nil
+	}
+	log := logging.FromContext(ctx)
+	deleteOpts := defaultDeleteOptions()
+	for _, opt := range opts {
+		if err := opt(deleteOpts); err != nil {
+			return nil, err
+		}
+	}
+	var tasks []deleteTask
+	for _, buffer := range buffers {
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffer},
+			delete: func(ctx context.Context) error {
+				topic, err := ps.topicName(buffer)
+				if err != nil {
+					return err
+				}
+				return ps.deleteTopic(topic)
+			},
+		})
+	}
+	for _, bucket := range buckets {
+		kvNames := []string{wmstore.JetStreamOTKVName(bucket), wmstore.JetStreamProcessorKVName(bucket)}
+		tasks = append(tasks, deleteTask{
+			DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: bucket},
+			delete: func(ctx context.Context) error {
+				// the bucket is not found only if both the offset timeline topic and the processor topic are not found.
+				notFound := 0
+				for _, kvName := range kvNames {
+					topic, err := ps.topicName(kvName)
+					if err != nil {
+						return err
+					}
+					if err := ps.deleteTopic(topic); err != nil {
+						if !errors.Is(err, errItemNotFound) {
+							return err
+						}
+						notFound++
+					}
+				}
+				if notFound == len(kvNames) {
+					return errItemNotFound
+				}
+				return nil
+			},
+		})
+	}
+	processed := 0
+	report := bulkDelete(ctx, tasks, deleteOpts.concurrency, deleteOpts.itemTimeout, func(item DeleteItem, err error) {
+		processed++
+		if err != nil {
+			log.Errorw("Failed to delete an item", zap.Stringer("item", item), zap.Int("processed", processed), zap.Int("total", len(tasks)), zap.Error(err))
+		} else {
+			log.Infow("Succeeded to delete an item", zap.Stringer("item", item), zap.Int("processed", processed), zap.Int("total", len(tasks)))
+		}
+		if deleteOpts.progress != nil {
+			deleteOpts.progress(item, err)
+		}
+	})
+	return report, report.Err()
+}
+
+// deleteTopic force deletes a non-partitioned topic, i.e. with its subscriptions, the producers and the consumers
+// connected to it are disconnected. It returns an error wrapping errItemNotFound if the topic does not exist.
+func (ps *pulsarSvc) deleteTopic(topic *utils.TopicName) error {
+	if err := ps.admin.Topics().Delete(*topic, true, true); err != nil {
+		if pulsarErrorCode(err) == http.StatusNotFound {
+			return fmt.Errorf("topic %q %w", topic, errItemNotFound)
+		}
+		return fmt.Errorf("failed to delete topic %q, %w", topic, err)
+	}
+	return nil
+}
+
+// ValidateBuffersAndBuckets is used to validate the topics and the subscriptions of the buffers, and the KV topics of
+// the buckets exist. The buffer configs do not apply to the Pulsar topics.
+func (ps *pulsarSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, _ ...CreateOption) error {
+	if sideInputsStore != "" || len(servingSourceStreams) > 0 {
+		return fmt.Errorf("side inputs and serving are not supported by the Pulsar ISB Service")
+	}
+	for _, buffer := range buffers {
+		topic, err := ps.topicName(buffer)
+		if err != nil {
+			return fmt.Errorf("invalid topic name of buffer %q, %w", buffer, err)
+		}
+		stats, err := ps.admin.Topics().GetStats(*topic)
+		if err != nil {
+			if pulsarErrorCode(err) == http.StatusNotFound {
+				return fmt.Errorf("topic %q of buffer %q not existing", topic, buffer)
+			}
+			return fmt.Errorf("failed to get the stats of topic %q, %w", topic, err)
+		}
+		if group := PulsarSubscriptionName(buffer); !hasSubscription(stats, group) {
+			return fmt.Errorf("subscription %q of topic %q not existing", group, topic)
+		}
+	}
+	for _, bucket := range buckets {
+		for _, kvName := range []string{wmstore.JetStreamProcessorKVName(bucket), wmstore.JetStreamOTKVName(bucket)} {
+			topic, err := ps.topicName(kvName)
+			if err != nil {
+				return fmt.Errorf("invalid topic name of bucket %q, %w", bucket, err)
+			}
+			if _, err := ps.admin.Topics().GetStats(*topic); err != nil {
+				if pulsarErrorCode(err) == http.StatusNotFound {
+					return fmt.Errorf("topic %q of bucket %q not existing", topic, bucket)
+				}
+				return fmt.Errorf("failed to get the stats of topic %q, %w", topic, err)
+			}
+		}
+	}
+	return nil
+}
+
+func hasSubscription(stats utils.TopicStats, group string) bool {
+	_, ok := stats.Subscriptions[group]
+	return ok
+}
+
+// ListBuffersAndBuckets lists the topics of the namespace with the given prefix, the creation time and the owner of
+// them are unknown.
+func (ps *pulsarSvc) ListBuffersAndBuckets(ctx context.Context, prefix string) ([]ItemInfo, error) {
+	ns, err := utils.GetNamespaceName(ps.namespace)
+	if err != nil {
+		return nil, err
+	}
+	_, topics, err := ps.admin.Topics().List(*ns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the topics of Pulsar namespace %q, %w", ps.namespace, err)
+	}
+	var items []ItemInfo
+	buckets := make(map[string]bool)
+	for _, fullName := range topics {
+		topic, err := utils.GetTopicName(fullName)
+		if err != nil {
+			continue
+		}
+		name := topic.GetLocalName()
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		// the KV topics of a bucket are {bucket}_OT and {bucket}_PROCESSORS
+		bucket, ok := strings.CutSuffix(name, "_OT")
+		if !ok {
+			bucket, ok = strings.CutSuffix(name, "_PROCESSORS")
+		}
+		if !ok {
+			items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: name}})
+			continue
+		}
+		if !buckets[bucket] {
+			buckets[bucket] = true
+			items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: bucket}})
+		}
+	}
+	sortItemInfos(items)
+	return items, nil
+}
+
+// GetBufferInfo is used to get the counts of the buffer from the stats of the topic and its subscription. The
+// messages delivered to the readers and not acknowledged yet are ack pending, the rest of the backlog is pending.
+func (ps *pulsarSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
+	topic, err := ps.topicName(buffer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid topic name of buffer %q, %w", buffer, err)
+	}
+	stats, err := ps.admin.Topics().GetStats(*topic)
+	if err != nil {
+		if pulsarErrorCode(err) == http.StatusNotFound {
+			return nil, fmt.Errorf("topic %q, %w", topic, ErrBufferNotFound)
+		}
+		return nil, fmt.Errorf("failed to get the stats of topic %q, %w", topic, err)
+	}
+	sub, ok := stats.Subscriptions[PulsarSubscriptionName(buffer)]
+	if !ok {
+		return nil, fmt.Errorf("subscription %q of topic %q not existing", PulsarSubscriptionName(buffer), topic)
+	}
+	internal, err := ps.admin.Topics().GetInternalStats(*topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the internal stats of topic %q, %w", topic, err)
+	}
+	return &BufferInfo{
+		Name:            buffer,
+		PendingCount:    max(sub.MsgBacklog-sub.UnAckedMessages, 0),
+		AckPendingCount: sub.UnAckedMessages,
+		// the entries retained by the topic, an entry is a batch of messages if the producers batch them
+		TotalMessages: internal.NumberOfEntries,
+		TotalBytes:    stats.StorageSize,
+		// the topics are bounded by the backlog quota of the namespace, which is in bytes or time, not in messages
+		MaxLength:       UnknownMaxLength,
+		UsagePercentage: UnknownUsagePercentage,
+	}, nil
+}
+
+// WatchPartitionInfo checks the stats of the topic of the partition at the watch interval.
+func (ps *pulsarSvc) WatchPartitionInfo(ctx context.Context, partition string) (<-chan *BufferInfo, error) {
+	return watchPartitionInfo(ctx, partition, partitionInfoWatchInterval, ps.GetBufferInfo)
+}
+
+// GetBuffersInfo issues the stats requests of the buffers concurrently with a bounded worker pool.
+func (ps *pulsarSvc) GetBuffersInfo(ctx context.Context, buffers []string) ([]*BufferInfo, error) {
+	return concurrentBuffersInfo(ctx, buffers, bufferInfoConcurrency, ps.GetBufferInfo)
+}
+
+// pulsarSequence returns the sequence of a message in a history read, "{ledger}:{entry}:{batch index}".
+func pulsarSequence(id pulsar.MessageID) string {
+	return fmt.Sprintf("%d:%d:%d", id.LedgerID(), id.EntryID(), id.BatchIdx())
+}
+
+// parsePulsarSequence parses a sequence returned by pulsarSequence, the batch index is optional.
+func parsePulsarSequence(seq string) (pulsar.MessageID, error) {
+	parts := strings.Split(seq, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid sequence %q, it should be {ledger}:{entry}[:{batch index}]", seq)
+	}
+	var ids [3]int64
+	ids[2] = -1
+	for i, p := range parts {
+		v, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sequence %q, %w", seq, err)
+		}
+		ids[i] = v
+	}
+	// the partition index of a non-partitioned topic is 0
+	return pulsar.NewMessageID(ids[0], ids[1], int32(ids[2]), 0), nil
+}
+
+// pulsarEntryAfter tells if the entry of the message ID a is after the one of b, the messages of a batch share an
+// entry.
+func pulsarEntryAfter(a, b pulsar.MessageID) bool {
+	if a.LedgerID() != b.LedgerID() {
+		return a.LedgerID() > b.LedgerID()
+	}
+	return a.EntryID() > b.EntryID()
+}
+
+// ReadBufferHistory is used to read the messages of the topic with a non-durable reader, the subscription of the
+// pipeline is not involved.
+func (ps *pulsarSvc) ReadBufferHistory(ctx context.Context, buffer string, from HistoryPosition, fn func(*HistoryMessage) bool) error {
+	if err := from.validate(); err != nil {
+		return err
+	}
+	topic, err := ps.topicName(buffer)
+	if err != nil {
+		return fmt.Errorf("invalid topic name of buffer %q, %w", buffer, err)
+	}
+	// a reader creates the topic if it does not exist and the namespace allows the auto creation, so it's checked first
+	if _, err := ps.admin.Topics().GetStats(*topic); err != nil {
+		if pulsarErrorCode(err) == http.StatusNotFound {
+			return fmt.Errorf("topic %q, %w", topic, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get the stats of topic %q, %w", topic, err)
+	}
+	start := pulsar.EarliestMessageID()
+	if from.StartSequence != "" {
+		if start, err = parsePulsarSequence(from.StartSequence); err != nil {
+			return err
+		}
+	}
+	reader, err := ps.client.CreateReader(pulsar.ReaderOptions{
+		Topic:                   topic.String(),
+		StartMessageID:          start,
+		StartMessageIDInclusive: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create a reader of topic %q, %w", topic, err)
+	}
+	defer reader.Close()
+	if !from.StartTime.IsZero() {
+		if err := reader.SeekByTime(from.StartTime); err != nil {
+			return fmt.Errorf("failed to seek topic %q to %v, %w", topic, from.StartTime, err)
+		}
+	}
+	// the read stops at the last message existing now, it doesn't wait for the new ones.
+	last, err := reader.GetLastMessageID()
+	if err != nil {
+		return fmt.Errorf("failed to get the last message ID of topic %q, %w", topic, err)
+	}
+	for reader.HasNext() {
+		msg, err := reader.Next(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read the history of topic %q, %w", topic, err)
+		}
+		if pulsarEntryAfter(msg.ID(), last) {
+			return nil
+		}
+		historyMsg := &HistoryMessage{
+			Sequence:  pulsarSequence(msg.ID()),
+			WriteTime: msg.PublishTime(),
+		}
+		if err := historyMsg.Message.UnmarshalBinary(msg.Payload()); err != nil {
+			return fmt.Errorf("failed to decode message %s of topic %q, %w", historyMsg.Sequence, topic, err)
+		}
+		if !fn(historyMsg) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// PeekMessages reads the messages of the topic of the partition from the offset, which is "{ledger}:{entry}".
+func (ps *pulsarSvc) PeekMessages(ctx context.Context, partition, offset string, count int) ([]*HistoryMessage, error) {
+	return peekHistory(ctx, ps.ReadBufferHistory, partition, offset, count)
+}
+
+// PurgeBuffers is used to skip the messages of the buffers in their subscriptions, the messages are removed by
+// Pulsar once no subscription needs them, depending on the retention policies of the namespace. The offset timelines
+// of the watermark buckets are reset by deleting their keys.
+func (ps *pulsarSvc) PurgeBuffers(ctx context.Context, buffers []string, opts ...PurgeOption) error {
+	o, err := newPurgeOptions(opts...)
+	if err != nil {
+		return err
+	}
+	log := logging.FromContext(ctx)
+	var errs []error
+	for _, buffer := range buffers {
+		if err := ps.purgeTopic(ctx, buffer, o); err != nil {
+			errs = append(errs, fmt.Errorf("failed to purge buffer %q, %w", buffer, err))
+			continue
+		}
+		log.Infow("Purged buffer", zap.String("buffer", buffer), zap.Time("before", o.before), zap.Int64("keepRecent", o.keepRecent))
+	}
+	for _, bucket := range o.watermarkBuckets {
+		// the publishers write a new timeline entry on the next write or heartbeat.
+		if err := ps.resetOffsetTimeline(ctx, bucket); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reset the offset timeline of bucket %q, %w", bucket, err))
+			continue
+		}
+		log.Infow("Reset offset timeline", zap.String("bucket", bucket))
+	}
+	return errors.Join(errs...)
+}
+
+// ResizePartition only checks the partition exists, the topics of the Pulsar ISB Service have no max length, the
+// length is checked by the buffer writers.
+func (ps *pulsarSvc) ResizePartition(_ context.Context, partition string, newBufferLength int64) error {
+	if newBufferLength <= 0 {
+		return fmt.Errorf("invalid buffer length %d of partition %q, it should be positive", newBufferLength, partition)
+	}
+	topic, err := ps.topicName(partition)
+	if err != nil {
+		return fmt.Errorf("invalid topic name of partition %q, %w", partition, err)
+	}
+	if _, err := ps.admin.Topics().GetStats(*topic); err != nil {
+		if pulsarErrorCode(err) == http.StatusNotFound {
+			return fmt.Errorf("topic %q, %w", topic, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get the stats of topic %q, %w", topic, err)
+	}
+	return nil
+}
+
+// PurgePartition is not supported, the partitions of Pulsar can not be paused before the purge.
+func (ps *pulsarSvc) PurgePartition(_ context.Context, partition, _ string, _ ...PurgeOption) error {
+	return fmt.Errorf("failed to purge partition %q, the partitions of Pulsar can not be paused, %w", partition, ErrPartitionNotPaused)
+}
+
+// PausePartition is not supported, the buffer readers of the Pulsar ISB Service are not part of it.
+func (ps *pulsarSvc) PausePartition(_ context.Context, partition string) error {
+	return fmt.Errorf("failed to pause partition %q, pausing the partitions is not supported by Pulsar", partition)
+}
+
+// ResumePartition is not supported, see PausePartition.
+func (ps *pulsarSvc) ResumePartition(_ context.Context, partition string) error {
+	return fmt.Errorf("failed to resume partition %q, pausing the partitions is not supported by Pulsar", partition)
+}
+
+func (ps *pulsarSvc) purgeTopic(ctx context.Context, buffer string, o *purgeOptions) error {
+	topic, err := ps.topicName(buffer)
+	if err != nil {
+		return err
+	}
+	group := PulsarSubscriptionName(buffer)
+	stats, err := ps.admin.Topics().GetStats(*topic)
+	if err != nil {
+		if pulsarErrorCode(err) == http.StatusNotFound {
+			return fmt.Errorf("topic %q, %w", topic, ErrBufferNotFound)
+		}
+		return fmt.Errorf("failed to get the stats of topic %q, %w", topic, err)
+	}
+	sub, ok := stats.Subscriptions[group]
+	if !ok {
+		return fmt.Errorf("subscription %q of topic %q not existing", group, topic)
+	}
+	if o.before.IsZero() && o.keepRecent == 0 {
+		if err := ps.admin.Subscriptions().ClearBacklog(*topic, group); err != nil {
+			return fmt.Errorf("failed to clear the backlog of subscription %q of topic %q, %w", group, topic, err)
+		}
+		return nil
+	}
+	// the oldest messages of the backlog are skipped, all but the recent ones
+	skip := max(sub.MsgBacklog-o.keepRecent, 0)
+	if !o.before.IsZero() && skip > 0 {
+		if skip, err = ps.countBacklogBefore(ctx, topic, group, o.before, skip); err != nil {
+			return err
+		}
+	}
+	if skip == 0 {
+		return nil
+	}
+	if err := ps.admin.Subscriptions().SkipMessages(*topic, group, skip); err != nil {
+		return fmt.Errorf("failed to skip %d messages of subscription %q of topic %q, %w", skip, group, topic, err)
+	}
+	return nil
+}
+
+// countBacklogBefore counts the messages of the backlog of the subscription written before the time, up to limit.
+// The backlog is read with a non-durable reader from the mark delete position of the cursor of the subscription.
+func (ps *pulsarSvc) countBacklogBefore(ctx context.Context, topic *utils.TopicName, group string, before time.Time, limit int64) (int64, error) {
+	internal, err := ps.admin.Topics().GetInternalStats(*topic)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the internal stats of topic %q, %w", topic, err)
+	}
+	cursor, ok := internal.Cursors[group]
+	if !ok {
+		return 0, fmt.Errorf("cursor of subscription %q of topic %q not existing", group, topic)
+	}
+	start, err := parsePulsarSequence(cursor.MarkDeletePosition)
+	if err != nil {
+		return 0, fmt.Errorf("invalid mark delete position of subscription %q of topic %q, %w", group, topic, err)
+	}
+	// the message at the mark delete position is acknowledged, the backlog starts right after it
+	reader, err := ps.client.CreateReader(pulsar.ReaderOptions{Topic: topic.String(), StartMessageID: start})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create a reader of topic %q, %w", topic, err)
+	}
+	defer reader.Close()
+	var n int64
+	for n < limit && reader.HasNext() {
+		msg, err := reader.Next(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read the backlog of topic %q, %w", topic, err)
+		}
+		if !msg.PublishTime().Before(before) {
+			break
+		}
+		n++
+	}
+	return n, nil
+}
+
+// resetOffsetTimeline deletes the keys of the offset timeline KV of the bucket.
+func (ps *pulsarSvc) resetOffsetTimeline(ctx context.Context, bucket string) error {
+	topic, err := ps.topicName(wmstore.JetStreamOTKVName(bucket))
+	if err != nil {
+		return err
+	}
+	if _, err := ps.admin.Topics().GetStats(*topic); err != nil {
+		if pulsarErrorCode(err) == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to get the stats of topic %q, %w", topic, err)
+	}
+	otStore, err := pulsarkv.NewPulsarKVStore(ctx, wmstore.JetStreamOTKVName(bucket), ps.client, ps.namespace)
+	if err != nil {
+		return err
+	}
+	defer otStore.Close()
+	keys, err := otStore.GetAllKeys(ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := otStore.DeleteKey(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete key %q, %w", key, err)
+		}
+	}
+	return nil
+}
+
+// CreateWatermarkStores is used to create the watermark stores on the KV topics of the bucket.
+func (ps *pulsarSvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]wmstore.WatermarkStore, error) {
+	log := logging.FromContext(ctx).With("bucket", bucketName)
+	ctx = logging.WithLogger(ctx, log)
+	var wmStores []wmstore.WatermarkStore
+	partitions := 1
+	if isReduce {
+		partitions = fromBufferPartitionCount
+	}
+	for i := 0; i < partitions; i++ {
+		wmStore, err := wmstore.BuildPulsarWatermarkStore(ctx, bucketName, ps.client, ps.namespace)
+		if err != nil {
+			for _, s := range wmStores {
+				_ = s.Close()
+			}
+			return nil, fmt.Errorf("failed to create new Pulsar watermark store, %w", err)
+		}
+		wmStores = append(wmStores, wmStore)
+	}
+	return wmStores, nil
+}
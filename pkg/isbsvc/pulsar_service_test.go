@@ -0,0 +1,456 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	pulsaradmin "github.com/apache/pulsar-client-go/pulsaradmin/pkg/admin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/rest"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+// fakePulsarTopic is the state of a non-partitioned topic of the fake Pulsar.
+type fakePulsarTopic struct {
+	subscriptions       map[string]utils.SubscriptionStats
+	markDeletePositions map[string]string
+	compactionThreshold int64
+	messages            []*fakePulsarMessage
+	skipped             map[string]int64
+	cleared             map[string]bool
+}
+
+// fakePulsar implements the admin API and the readers of the Pulsar ISB Service on in-memory topics.
+type fakePulsar struct {
+	pulsaradmin.Client
+	lock   sync.Mutex
+	topics map[string]*fakePulsarTopic
+}
+
+func newFakePulsar() *fakePulsar {
+	return &fakePulsar{topics: make(map[string]*fakePulsarTopic)}
+}
+
+func (f *fakePulsar) Topics() pulsaradmin.Topics {
+	return &fakePulsarTopics{fake: f}
+}
+
+func (f *fakePulsar) Subscriptions() pulsaradmin.Subscriptions {
+	return &fakePulsarSubscriptions{fake: f}
+}
+
+func (f *fakePulsar) topic(name utils.TopicName) (*fakePulsarTopic, error) {
+	t, ok := f.topics[name.String()]
+	if !ok {
+		return nil, rest.Error{Code: http.StatusNotFound, Reason: "Topic not found"}
+	}
+	return t, nil
+}
+
+type fakePulsarTopics struct {
+	pulsaradmin.Topics
+	fake *fakePulsar
+}
+
+func (t *fakePulsarTopics) Create(topic utils.TopicName, partitions int) error {
+	t.fake.lock.Lock()
+	defer t.fake.lock.Unlock()
+	if _, ok := t.fake.topics[topic.String()]; ok {
+		return rest.Error{Code: http.StatusConflict, Reason: "This topic already exists"}
+	}
+	t.fake.topics[topic.String()] = &fakePulsarTopic{
+		subscriptions:       make(map[string]utils.SubscriptionStats),
+		markDeletePositions: make(map[string]string),
+		skipped:             make(map[string]int64),
+		cleared:             make(map[string]bool),
+	}
+	return nil
+}
+
+func (t *fakePulsarTopics) Delete(topic utils.TopicName, force bool, nonPartitioned bool) error {
+	t.fake.lock.Lock()
+	defer t.fake.lock.Unlock()
+	if _, err := t.fake.topic(topic); err != nil {
+		return err
+	}
+	delete(t.fake.topics, topic.String())
+	return nil
+}
+
+func (t *fakePulsarTopics) List(ns utils.NameSpaceName) ([]string, []string, error) {
+	t.fake.lock.Lock()
+	defer t.fake.lock.Unlock()
+	var names []string
+	for name := range t.fake.topics {
+		names = append(names, name)
+	}
+	return nil, names, nil
+}
+
+func (t *fakePulsarTopics) GetStats(topic utils.TopicName) (utils.TopicStats, error) {
+	t.fake.lock.Lock()
+	defer t.fake.lock.Unlock()
+	ft, err := t.fake.topic(topic)
+	if err != nil {
+		return utils.TopicStats{}, err
+	}
+	return utils.TopicStats{StorageSize: int64(len(ft.messages)) * 100, Subscriptions: ft.subscriptions}, nil
+}
+
+func (t *fakePulsarTopics) GetInternalStats(topic utils.TopicName) (utils.PersistentTopicInternalStats, error) {
+	t.fake.lock.Lock()
+	defer t.fake.lock.Unlock()
+	ft, err := t.fake.topic(topic)
+	if err != nil {
+		return utils.PersistentTopicInternalStats{}, err
+	}
+	cursors := make(map[string]utils.CursorStats)
+	for sub, position := range ft.markDeletePositions {
+		cursors[sub] = utils.CursorStats{MarkDeletePosition: position}
+	}
+	return utils.PersistentTopicInternalStats{NumberOfEntries: int64(len(ft.messages)), Cursors: cursors}, nil
+}
+
+func (t *fakePulsarTopics) SetCompactionThreshold(topic utils.TopicName, threshold int64) error {
+	t.fake.lock.Lock()
+	defer t.fake.lock.Unlock()
+	ft, err := t.fake.topic(topic)
+	if err != nil {
+		return err
+	}
+	ft.compactionThreshold = threshold
+	return nil
+}
+
+type fakePulsarSubscriptions struct {
+	pulsaradmin.Subscriptions
+	fake *fakePulsar
+}
+
+func (s *fakePulsarSubscriptions) Create(topic utils.TopicName, sub string, _ utils.MessageID) error {
+	s.fake.lock.Lock()
+	defer s.fake.lock.Unlock()
+	ft, err := s.fake.topic(topic)
+	if err != nil {
+		return err
+	}
+	if _, ok := ft.subscriptions[sub]; ok {
+		return rest.Error{Code: http.StatusConflict, Reason: "Subscription already exists for topic"}
+	}
+	ft.subscriptions[sub] = utils.SubscriptionStats{}
+	ft.markDeletePositions[sub] = "0:-1"
+	return nil
+}
+
+func (s *fakePulsarSubscriptions) ClearBacklog(topic utils.TopicName, sub string) error {
+	s.fake.lock.Lock()
+	defer s.fake.lock.Unlock()
+	ft, err := s.fake.topic(topic)
+	if err != nil {
+		return err
+	}
+	ft.cleared[sub] = true
+	return nil
+}
+
+func (s *fakePulsarSubscriptions) SkipMessages(topic utils.TopicName, sub string, n int64) error {
+	s.fake.lock.Lock()
+	defer s.fake.lock.Unlock()
+	ft, err := s.fake.topic(topic)
+	if err != nil {
+		return err
+	}
+	ft.skipped[sub] += n
+	return nil
+}
+
+type fakePulsarMessage struct {
+	pulsar.Message
+	id          pulsar.MessageID
+	payload     []byte
+	publishTime time.Time
+}
+
+func (m *fakePulsarMessage) ID() pulsar.MessageID   { return m.id }
+func (m *fakePulsarMessage) Payload() []byte        { return m.payload }
+func (m *fakePulsarMessage) PublishTime() time.Time { return m.publishTime }
+
+// fakePulsarClient creates the readers of the topics of the fake Pulsar.
+type fakePulsarClient struct {
+	pulsar.Client
+	fake *fakePulsar
+}
+
+func (c *fakePulsarClient) CreateReader(opts pulsar.ReaderOptions) (pulsar.Reader, error) {
+	c.fake.lock.Lock()
+	defer c.fake.lock.Unlock()
+	ft, ok := c.fake.topics[opts.Topic]
+	if !ok {
+		return nil, fmt.Errorf("topic %q not found", opts.Topic)
+	}
+	r := &fakePulsarReader{messages: ft.messages}
+	if opts.StartMessageID.LedgerID() >= 0 {
+		for r.next < len(r.messages) {
+			id := r.messages[r.next].id
+			if pulsarEntryAfter(id, opts.StartMessageID) || (opts.StartMessageIDInclusive && id.EntryID() == opts.StartMessageID.EntryID()) {
+				break
+			}
+			r.next++
+		}
+	}
+	return r, nil
+}
+
+type fakePulsarReader struct {
+	pulsar.Reader
+	messages []*fakePulsarMessage
+	next     int
+}
+
+func (r *fakePulsarReader) HasNext() bool {
+	return r.next < len(r.messages)
+}
+
+func (r *fakePulsarReader) Next(context.Context) (pulsar.Message, error) {
+	msg := r.messages[r.next]
+	r.next++
+	return msg, nil
+}
+
+func (r *fakePulsarReader) SeekByTime(t time.Time) error {
+	r.next = sort.Search(len(r.messages), func(i int) bool {
+		return !r.messages[i].publishTime.Before(t)
+	})
+	return nil
+}
+
+func (r *fakePulsarReader) GetLastMessageID() (pulsar.MessageID, error) {
+	if len(r.messages) == 0 {
+		return pulsar.EarliestMessageID(), nil
+	}
+	return r.messages[len(r.messages)-1].id, nil
+}
+
+func (r *fakePulsarReader) Close() {}
+
+func newTestPulsarSvc(t *testing.T) (ISBService, *fakePulsar) {
+	fake := newFakePulsar()
+	isbSvc, err := NewISBPulsarSvc(&fakePulsarClient{fake: fake}, fake, "public/numaflow")
+	assert.NoError(t, err)
+	return isbSvc, fake
+}
+
+func TestNewISBPulsarSvc(t *testing.T) {
+	_, err := NewISBPulsarSvc(nil, nil, "numaflow")
+	assert.ErrorContains(t, err, `invalid Pulsar namespace "numaflow"`)
+}
+
+func TestPulsarSvc_CreateValidateDeleteBuffersAndBuckets(t *testing.T) {
+	ctx := context.Background()
+	isbSvc, fake := newTestPulsarSvc(t)
+	buffers := []string{"p-in-0", "p-out-0"}
+	buckets := []string{"p-in-out"}
+
+	assert.ErrorContains(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil), `topic "persistent://public/numaflow/p-in-0" of buffer "p-in-0" not existing`)
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+	// the buffers and the buckets existing already are left as is
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+	assert.NoError(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil))
+	assert.Len(t, fake.topics, 4)
+	assert.Contains(t, fake.topics["persistent://public/numaflow/p-in-0"].subscriptions, "p-in-0-group")
+	assert.Equal(t, pulsarKVCompactionThreshold, fake.topics["persistent://public/numaflow/p-in-out_OT"].compactionThreshold)
+	assert.Equal(t, pulsarKVCompactionThreshold, fake.topics["persistent://public/numaflow/p-in-out_PROCESSORS"].compactionThreshold)
+
+	err := isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, "p-side-inputs", nil)
+	assert.ErrorContains(t, err, "side inputs and serving are not supported by the Pulsar ISB Service")
+	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", []string{"p-serving-source"})
+	assert.ErrorContains(t, err, "side inputs and serving are not supported by the Pulsar ISB Service")
+
+	assert.NoError(t, isbSvc.ResizePartition(ctx, "p-in-0", 100))
+	assert.ErrorIs(t, isbSvc.ResizePartition(ctx, "p-other-0", 100), ErrBufferNotFound)
+	assert.Error(t, isbSvc.ResizePartition(ctx, "p-in-0", 0))
+	assert.ErrorContains(t, isbSvc.PausePartition(ctx, "p-in-0"), "not supported by Pulsar")
+	assert.ErrorContains(t, isbSvc.ResumePartition(ctx, "p-in-0"), "not supported by Pulsar")
+	assert.ErrorIs(t, isbSvc.PurgePartition(ctx, "p-in-0", "7:1"), ErrPartitionNotPaused)
+
+	delete(fake.topics["persistent://public/numaflow/p-out-0"].subscriptions, "p-out-0-group")
+	err = isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, "", nil)
+	assert.ErrorContains(t, err, `subscription "p-out-0-group" of topic "persistent://public/numaflow/p-out-0" not existing`)
+
+	report, err := isbSvc.DeleteBuffersAndBuckets(ctx, buffers, buckets, "", nil)
+	assert.NoError(t, err)
+	assert.Len(t, report.Deleted, 3)
+	assert.Empty(t, fake.topics)
+	report, err = isbSvc.DeleteBuffersAndBuckets(ctx, buffers, buckets, "", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Deleted)
+	assert.Len(t, report.NotFound, 3)
+}
+
+func TestPulsarSvc_ListBuffersAndBuckets(t *testing.T) {
+	ctx := context.Background()
+	isbSvc, _ := newTestPulsarSvc(t)
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{"p1-in-0", "p2-in-0"}, []string{"p1-in-out"}, "", nil))
+
+	items, err := isbSvc.ListBuffersAndBuckets(ctx, "p1-")
+	assert.NoError(t, err)
+	assert.Equal(t, []ItemInfo{
+		{DeleteItem: DeleteItem{Kind: DeleteItemBucket, Name: "p1-in-out"}},
+		{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: "p1-in-0"}},
+	}, items)
+}
+
+func TestPulsarSvc_GetBufferInfo(t *testing.T) {
+	ctx := context.Background()
+	isbSvc, fake := newTestPulsarSvc(t)
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{"p-in-0"}, nil, "", nil))
+	topic := fake.topics["persistent://public/numaflow/p-in-0"]
+	topic.subscriptions["p-in-0-group"] = utils.SubscriptionStats{MsgBacklog: 10, UnAckedMessages: 3}
+	topic.messages = make([]*fakePulsarMessage, 12)
+
+	info, err := isbSvc.GetBufferInfo(ctx, "p-in-0")
+	assert.NoError(t, err)
+	assert.Equal(t, &BufferInfo{
+		Name:            "p-in-0",
+		PendingCount:    7,
+		AckPendingCount: 3,
+		TotalMessages:   12,
+		TotalBytes:      1200,
+		MaxLength:       UnknownMaxLength,
+		UsagePercentage: UnknownUsagePercentage,
+	}, info)
+
+	_, err = isbSvc.GetBufferInfo(ctx, "p-out-0")
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+	infos, err := isbSvc.GetBuffersInfo(ctx, []string{"p-in-0", "p-out-0"})
+	assert.ErrorContains(t, err, "failed to get information of 1 of 2 buffers")
+	assert.Len(t, infos, 1)
+
+	_, err = isbSvc.WatchPartitionInfo(ctx, "p-out-0")
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	watched, err := isbSvc.WatchPartitionInfo(watchCtx, "p-in-0")
+	assert.NoError(t, err)
+	assert.Equal(t, info, <-watched)
+}
+
+// publishTestMessages writes the messages to the fake topic, one second apart from the start time.
+func publishTestMessages(t *testing.T, topic *fakePulsarTopic, messages []isb.Message, start time.Time) {
+	for i, msg := range messages {
+		data, err := msg.MarshalBinary()
+		assert.NoError(t, err)
+		topic.messages = append(topic.messages, &fakePulsarMessage{
+			id:          pulsar.NewMessageID(7, int64(len(topic.messages)), -1, 0),
+			payload:     data,
+			publishTime: start.Add(time.Duration(i) * time.Second),
+		})
+	}
+}
+
+func TestPulsarSvc_ReadBufferHistory(t *testing.T) {
+	ctx := context.Background()
+	isbSvc, fake := newTestPulsarSvc(t)
+	buffer := "p-in-0"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", nil))
+	startTime := time.Unix(1636470000, 0)
+	publishTestMessages(t, fake.topics["persistent://public/numaflow/p-in-0"], testutils.BuildTestWriteMessages(5, startTime, nil, "testVertex"), startTime)
+
+	read := func(from HistoryPosition, limit int) []string {
+		var ids []string
+		err := isbSvc.ReadBufferHistory(ctx, buffer, from, func(msg *HistoryMessage) bool {
+			ids = append(ids, fmt.Sprintf("%s@%d", msg.Sequence, msg.EventTime.Sub(startTime)/time.Second))
+			return len(ids) < limit
+		})
+		assert.NoError(t, err)
+		return ids
+	}
+	assert.Equal(t, []string{"7:0:-1@0", "7:1:-1@1", "7:2:-1@2", "7:3:-1@3", "7:4:-1@4"}, read(HistoryPosition{}, 100))
+	assert.Equal(t, []string{"7:3:-1@3", "7:4:-1@4"}, read(HistoryPosition{StartSequence: "7:3"}, 100))
+	assert.Equal(t, []string{"7:2:-1@2"}, read(HistoryPosition{StartTime: startTime.Add(2 * time.Second)}, 1))
+
+	err := isbSvc.ReadBufferHistory(ctx, buffer, HistoryPosition{StartSequence: "abc"}, func(*HistoryMessage) bool { return true })
+	assert.ErrorContains(t, err, `invalid sequence "abc"`)
+	err = isbSvc.ReadBufferHistory(ctx, "p-out-0", HistoryPosition{}, func(*HistoryMessage) bool { return true })
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+
+	peeked, err := isbSvc.PeekMessages(ctx, buffer, "7:1", 2)
+	assert.NoError(t, err)
+	require.Len(t, peeked, 2)
+	assert.Equal(t, "7:1:-1", peeked[0].Sequence)
+	assert.Equal(t, "7:2:-1", peeked[1].Sequence)
+	_, err = isbSvc.PeekMessages(ctx, "p-out-0", "", 1)
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+}
+
+func TestPulsarSvc_PurgeBuffers(t *testing.T) {
+	ctx := context.Background()
+	isbSvc, fake := newTestPulsarSvc(t)
+	buffer := "p-in-0"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", nil))
+	topic := fake.topics["persistent://public/numaflow/p-in-0"]
+	startTime := time.Unix(1636470000, 0)
+	publishTestMessages(t, topic, testutils.BuildTestWriteMessages(10, startTime, nil, "testVertex"), startTime)
+	// the first 2 messages are acknowledged
+	topic.subscriptions["p-in-0-group"] = utils.SubscriptionStats{MsgBacklog: 8}
+	topic.markDeletePositions["p-in-0-group"] = "7:1"
+
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithKeepRecent(3)))
+	assert.Equal(t, int64(5), topic.skipped["p-in-0-group"])
+
+	// the messages 2 to 5 were written before the time
+	topic.skipped["p-in-0-group"] = 0
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(startTime.Add(5*time.Second+time.Millisecond))))
+	assert.Equal(t, int64(4), topic.skipped["p-in-0-group"])
+
+	// the recent messages are kept even if they were written before the time
+	topic.skipped["p-in-0-group"] = 0
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(startTime.Add(time.Hour)), WithKeepRecent(6)))
+	assert.Equal(t, int64(2), topic.skipped["p-in-0-group"])
+
+	assert.NoError(t, isbSvc.PurgeBuffers(ctx, []string{buffer}))
+	assert.True(t, topic.cleared["p-in-0-group"])
+
+	err := isbSvc.PurgeBuffers(ctx, []string{"p-out-0", buffer})
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+	assert.ErrorContains(t, err, `failed to purge buffer "p-out-0"`)
+}
+
+func TestParsePulsarSequence(t *testing.T) {
+	id, err := parsePulsarSequence("12:34")
+	assert.NoError(t, err)
+	assert.Equal(t, "12:34:-1", pulsarSequence(id))
+	id, err = parsePulsarSequence("12:34:5")
+	assert.NoError(t, err)
+	assert.Equal(t, "12:34:5", pulsarSequence(id))
+	for _, seq := range []string{"", "12", "12:34:5:6", "a:b"} {
+		_, err := parsePulsarSequence(seq)
+		assert.Error(t, err, seq)
+	}
+}
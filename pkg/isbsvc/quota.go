@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+// ErrQuotaExceeded is returned when creating the buffers and buckets of a pipeline exceeds its quota
+var ErrQuotaExceeded = errors.New("pipeline quota exceeded")
+
+// quotaUsageCacheTTL is how long the quota usage of a pipeline is cached for the buffer info, computing it lists all
+// the streams of the ISB Service.
+const quotaUsageCacheTTL = 30 * time.Second
+
+// PipelineQuota is the quota of a pipeline on a shared ISB Service, a zero limit means no limit.
+type PipelineQuota struct {
+	// MaxBytes is the max total of the max bytes of the buffers of the pipeline
+	MaxBytes int64
+	// MaxStreams is the max number of the streams of the pipeline, the buffers and the watermark buckets
+	MaxStreams int
+}
+
+// IsSet returns true if any limit of the quota is set
+func (q PipelineQuota) IsSet() bool {
+	return q.MaxBytes > 0 || q.MaxStreams > 0
+}
+
+// NewPipelineQuota returns the quota of the ISB Service config, a zero quota if it's not configured
+func NewPipelineQuota(q *dfv1.ISBPipelineQuota) PipelineQuota {
+	if q == nil {
+		return PipelineQuota{}
+	}
+	return PipelineQuota{MaxBytes: q.GetMaxBytes(), MaxStreams: q.GetMaxStreams()}
+}
+
+// QuotaUsage is the quota of a pipeline with the usage of it
+type QuotaUsage struct {
+	PipelineQuota
+	// Bytes is the total of the max bytes of the buffers of the pipeline
+	Bytes int64
+	// Streams is the number of the streams of the pipeline
+	Streams int
+}
+
+// WithPipelineQuota sets the quota of the pipeline, it's only enforced if the pipeline UID is set, see WithPipelineUID,
+// as the streams of the pipeline are told by the owner recorded in them.
+func WithPipelineQuota(q PipelineQuota) JetStreamSvcOption {
+	return func(j *jetStreamSvc) {
+		j.quota = q
+	}
+}
+
+// quotaUsageCache caches the last computed quota usage of the pipeline.
+type quotaUsageCache struct {
+	sync.Mutex
+	usage     *QuotaUsage
+	updatedAt time.Time
+}
+
+// pipelineQuotaUsage returns the quota usage of the pipeline, with the names of all the streams of the ISB Service.
+// The bytes only count the buffers with max bytes, the KV streams of the buckets are not limited by size.
+func (jss *jetStreamSvc) pipelineQuotaUsage(ctx context.Context) (*QuotaUsage, map[string]bool, error) {
+	usage := &QuotaUsage{PipelineQuota: jss.quota}
+	streams := make(map[string]bool)
+	lister := jss.js.StreamsInfo(nats.Context(ctx))
+	for info := range lister {
+		streams[info.Config.Name] = true
+		if info.Config.Metadata[dfv1.KeyPipelineUID] != jss.pipelineUID {
+			continue
+		}
+		usage.Streams++
+		if !strings.HasPrefix(info.Config.Name, "KV_") && info.Config.MaxBytes > 0 {
+			usage.Bytes += info.Config.MaxBytes
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list the streams, %w", err)
+	}
+	return usage, streams, nil
+}
+
+// cachedQuotaUsage returns the quota usage of the pipeline, computed at most once per quotaUsageCacheTTL. It returns
+// nil if no quota is enforced.
+func (jss *jetStreamSvc) cachedQuotaUsage(ctx context.Context) (*QuotaUsage, error) {
+	if !jss.quota.IsSet() || jss.pipelineUID == "" {
+		return nil, nil
+	}
+	jss.quotaCache.Lock()
+	defer jss.quotaCache.Unlock()
+	if jss.quotaCache.usage != nil && time.Since(jss.quotaCache.updatedAt) < quotaUsageCacheTTL {
+		u := *jss.quotaCache.usage
+		return &u, nil
+	}
+	usage, _, err := jss.pipelineQuotaUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jss.quotaCache.usage = usage
+	jss.quotaCache.updatedAt = time.Now()
+	u := *usage
+	return &u, nil
+}
+
+// checkPipelineQuota checks that creating the missing streams of the buffers and buckets keeps the pipeline within its
+// quota. The existing streams are not checked again, so that a quota lowered later does not block an existing
+// pipeline. With a bytes quota, the buffers to create must have max bytes, otherwise they are not bounded by it.
+func (jss *jetStreamSvc) checkPipelineQuota(ctx context.Context, o *createOptions, buffers, buckets []string) error {
+	if !jss.quota.IsSet() || jss.pipelineUID == "" {
+		return nil
+	}
+	usage, existing, err := jss.pipelineQuotaUsage(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the quota usage of the pipeline, %w", err)
+	}
+	var newStreams int
+	var newBytes int64
+	for _, buffer := range buffers {
+		if existing[JetStreamName(buffer)] {
+			continue
+		}
+		newStreams++
+		if jss.quota.MaxBytes <= 0 {
+			continue
+		}
+		v, err := o.viper(buffer)
+		if err != nil {
+			return err
+		}
+		maxBytes := v.GetInt64("stream.maxBytes")
+		if maxBytes <= 0 {
+			return fmt.Errorf("buffer %q has no max bytes with a max bytes quota of %d, %w", buffer, jss.quota.MaxBytes, ErrQuotaExceeded)
+		}
+		newBytes += maxBytes
+	}
+	for _, bucket := range buckets {
+		for _, kvName := range []string{wmstore.JetStreamOTKVName(bucket), wmstore.JetStreamProcessorKVName(bucket)} {
+			if !existing[jetStreamKVStreamName(kvName)] {
+				newStreams++
+			}
+		}
+	}
+	if newStreams == 0 {
+		return nil
+	}
+	if jss.quota.MaxStreams > 0 && usage.Streams+newStreams > jss.quota.MaxStreams {
+		return fmt.Errorf("creating %d streams with %d existing exceeds the max streams quota of %d, %w", newStreams, usage.Streams, jss.quota.MaxStreams, ErrQuotaExceeded)
+	}
+	if jss.quota.MaxBytes > 0 && usage.Bytes+newBytes > jss.quota.MaxBytes {
+		return fmt.Errorf("creating buffers of %d bytes with %d bytes existing exceeds the max bytes quota of %d, %w", newBytes, usage.Bytes, jss.quota.MaxBytes, ErrQuotaExceeded)
+	}
+	return nil
+}
@@ -18,11 +18,20 @@ package isbsvc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	redis2 "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -38,11 +47,17 @@ func NewISBRedisSvc(client *redisclient.RedisClient) ISBService {
 	return &isbsRedisSvc{client: client}
 }
 
-// CreateBuffersAndBuckets  is used to create the inter-step redis buffers.
+// CreateBuffersAndBuckets  is used to create the inter-step redis buffers. The config and the buffer configs do not
+// apply, the Redis streams are created without settings, the max length of a buffer is enforced by the writers
+// with the buffer limits of the edge, as well as the buffer retentions, see WithBufferRetention.
 func (r *isbsRedisSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error {
 	if len(buffers) == 0 && len(buckets) == 0 {
 		return nil
 	}
+	creatOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
+	}
 	log := logging.FromContext(ctx)
 	failToCreate := false
 	for _, s := range buffers {
@@ -55,10 +70,15 @@ func (r *isbsRedisSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buc
 			} else {
 				failToCreate = true
 				log.Errorw("Failed to create Redis Stream and Group.", zap.String("group", group), zap.String("stream", stream), zap.Error(err))
+				continue
 			}
 		} else {
 			log.Infow("Redis StreamGroup created", zap.String("group", group), zap.String("stream", stream))
 		}
+		if err := r.setRetention(ctx, s, creatOpts); err != nil {
+			failToCreate = true
+			log.Errorw("Failed to set the retention of Redis Stream.", zap.String("stream", stream), zap.Error(err))
+		}
 	}
 	if failToCreate {
 		return fmt.Errorf("failed all or some Streams creation")
@@ -67,14 +87,16 @@ func (r *isbsRedisSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buc
 }
 
 // DeleteBuffersAndBuckets is used to delete the inter-step redis buffers.
-func (r *isbsRedisSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string) error {
+func (r *isbsRedisSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, _ ...DeleteOption) (*DeleteReport, error) {
+	report := &DeleteReport{}
 	if len(buffers) == 0 && len(buckets) == 0 {
-		return nil
+		return report, nil
 	}
 	// FIXME: delete the keys created by the lua script
 	log := logging.FromContext(ctx)
-	var errList error
 	for _, s := range buffers {
+		item := DeleteItem{Kind: DeleteItemBuffer, Name: s}
+		var errList error
 		stream := redisclient.GetRedisStreamName(s)
 		group := fmt.Sprintf("%s-group", s)
 		if err := r.client.DeleteStreamGroup(ctx, stream, group); err != nil {
@@ -88,42 +110,186 @@ func (r *isbsRedisSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buc
 			log.Infow("Redis StreamGroup deleted", zap.String("group", group), zap.String("stream", stream))
 		}
 
-		if err := r.client.DeleteKeys(ctx, stream); err != nil {
+		if err := r.client.DeleteKeys(ctx, stream, redisclient.GetRedisRetentionKeyName(s)); err != nil {
 			errList = multierr.Append(errList, err)
 			log.Errorw("Failed to delete Redis keys.", zap.String("stream", stream), zap.Error(err))
 		} else {
 			log.Infow("Redis keys deleted", zap.String("stream", stream))
 		}
+		if errList != nil {
+			report.Failed = append(report.Failed, DeleteFailure{DeleteItem: item, Reason: errList.Error()})
+		} else {
+			report.Deleted = append(report.Deleted, item)
+		}
 	}
-	if errList != nil {
-		return fmt.Errorf("failed to delete all or some Redis StreamGroups and keys")
+	if len(report.Failed) > 0 {
+		return report, fmt.Errorf("failed to delete all or some Redis StreamGroups and keys")
 	}
 	log.Infow("Deleted Redis StreamGroups and keys successfully")
-	return nil
+	return report, nil
 }
 
-// ValidateBuffersAndBuckets is used to validate inter-step redis buffers to see if the stream/stream group exist
-func (r *isbsRedisSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string) error {
+// ValidateBuffersAndBuckets is used to validate inter-step redis buffers to see if the stream/stream group exist and
+// the retentions of the streams are the expected ones, the buffer configs do not apply to the Redis streams. All the
+// problems found are returned as a ValidationError.
+func (r *isbsRedisSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) error {
 	if len(buffers) == 0 && len(buckets) == 0 {
 		return nil
 	}
+	validateOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return err
+	}
+	diagnostics, err := r.diagnose(ctx, buffers, validateOpts)
+	if err != nil {
+		return err
+	}
+	return newValidationError(diagnostics)
+}
+
+// diagnose returns the problems of the streams of the buffers, the stream group of a buffer is its consumer.
+func (r *isbsRedisSvc) diagnose(ctx context.Context, buffers []string, o *createOptions) ([]Diagnostic, error) {
+	var diagnostics []Diagnostic
 	for _, s := range buffers {
+		item := DeleteItem{Kind: DeleteItemBuffer, Name: s}
 		var stream = redisclient.GetRedisStreamName(s)
 		if !r.client.IsStreamExists(ctx, stream) {
-			return fmt.Errorf("s %s not existing", stream)
+			diagnostics = append(diagnostics, Diagnostic{Kind: DiagnosticMissingStream, Item: item, Stream: stream, Err: fmt.Errorf("s %s not existing", stream)})
+			continue
 		}
 		group := fmt.Sprintf("%s-group", s)
 		if !r.client.IsStreamGroupExists(ctx, stream, group) {
-			return fmt.Errorf("group %s not existing", group)
+			diagnostics = append(diagnostics, Diagnostic{Kind: DiagnosticMissingConsumer, Item: item, Stream: stream, Err: fmt.Errorf("group %s not existing", group)})
+		}
+		retention, ok := o.bufferRetentions[s]
+		if !ok {
+			continue
+		}
+		key := redisclient.GetRedisRetentionKeyName(s)
+		actual, err := r.client.Client.HGetAll(ctx, key).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the retention %q, %w", key, err)
+		}
+		expected := redisRetentionFields(retention)
+		for _, field := range []string{redisclient.RetentionFieldMaxAge, redisclient.RetentionFieldMaxMessages, redisclient.RetentionFieldDiscard} {
+			if expected[field] != actual[field] {
+				diagnostics = append(diagnostics, Diagnostic{Kind: DiagnosticConfigMismatch, Item: item, Stream: stream,
+					Err: &ConfigMismatchError{Stream: stream, Field: field, Expected: expected[field], Actual: actual[field]}})
+			}
 		}
 	}
-	return nil
+	return diagnostics, nil
+}
+
+var _ Repairer = (*isbsRedisSvc)(nil)
+
+// RepairBuffersAndBuckets recreates the missing streams and stream groups of the buffers, and sets their retentions
+// again, as CreateBuffersAndBuckets does.
+func (r *isbsRedisSvc) RepairBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStreams []string, opts ...CreateOption) (*RepairReport, error) {
+	if len(buffers) == 0 && len(buckets) == 0 {
+		return &RepairReport{}, nil
+	}
+	repairOpts, err := newCreateOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+	before, err := r.diagnose(ctx, buffers, repairOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(before) == 0 {
+		return &RepairReport{}, nil
+	}
+	var drifted []string
+	for _, d := range before {
+		if !slices.Contains(drifted, d.Item.Name) {
+			drifted = append(drifted, d.Item.Name)
+		}
+	}
+	createErr := r.CreateBuffersAndBuckets(ctx, drifted, nil, "", nil, opts...)
+	after, err := r.diagnose(ctx, buffers, repairOpts)
+	if err != nil {
+		return nil, err
+	}
+	return newRepairReport(before, after), errors.Join(createErr, newValidationError(after))
+}
+
+// setRetention stores the retention of the buffer in a hash next to the stream, the writers of the buffer enforce
+// it, see redis2.BufferWrite. The hash is deleted if the buffer has no retention.
+func (r *isbsRedisSvc) setRetention(ctx context.Context, buffer string, o *createOptions) error {
+	key := redisclient.GetRedisRetentionKeyName(buffer)
+	retention, ok := o.bufferRetentions[buffer]
+	if !ok {
+		return r.client.DeleteKeys(ctx, key)
+	}
+	if retention.MaxBytes != nil {
+		logging.FromContext(ctx).Warnw("The max bytes of the buffer retention is not supported by Redis, ignoring it.", zap.String("buffer", buffer))
+	}
+	fields := redisRetentionFields(retention)
+	if len(fields) == 0 {
+		return r.client.DeleteKeys(ctx, key)
+	}
+	// replace the hash as a whole, so that the limits removed from the retention are removed too
+	_, err := r.client.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, fields)
+		return nil
+	})
+	return err
+}
+
+// redisRetentionFields returns the fields of the retention hash of a buffer retention, the max age is in milliseconds.
+func redisRetentionFields(retention dfv1.BufferRetention) map[string]string {
+	fields := make(map[string]string)
+	if retention.MaxAge != nil {
+		fields[redisclient.RetentionFieldMaxAge] = strconv.FormatInt(retention.MaxAge.Milliseconds(), 10)
+	}
+	if retention.MaxMessages != nil {
+		fields[redisclient.RetentionFieldMaxMessages] = strconv.FormatInt(*retention.MaxMessages, 10)
+	}
+	if retention.Discard != nil {
+		fields[redisclient.RetentionFieldDiscard] = string(*retention.Discard)
+	}
+	return fields
+}
+
+// ListBuffersAndBuckets lists the buffers with the given prefix, the creation time and the owner of them are unknown.
+func (r *isbsRedisSvc) ListBuffersAndBuckets(ctx context.Context, prefix string) ([]ItemInfo, error) {
+	var (
+		lock  sync.Mutex
+		items []ItemInfo
+	)
+	// the streams of the buffers are named {buffer}
+	pattern := "{" + prefix + "*}"
+	scan := func(ctx context.Context, client redis.Cmdable) error {
+		iter := client.ScanType(ctx, 0, pattern, 0, "stream").Iterator()
+		for iter.Next(ctx) {
+			buffer := strings.TrimSuffix(strings.TrimPrefix(iter.Val(), "{"), "}")
+			lock.Lock()
+			items = append(items, ItemInfo{DeleteItem: DeleteItem{Kind: DeleteItemBuffer, Name: buffer}})
+			lock.Unlock()
+		}
+		return iter.Err()
+	}
+	var err error
+	if cluster, ok := r.client.Client.(*redis.ClusterClient); ok {
+		err = cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+			return scan(ctx, client)
+		})
+	} else {
+		err = scan(ctx, r.client.Client)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the Redis streams, %w", err)
+	}
+	sortItemInfos(items)
+	return items, nil
 }
 
 // GetBufferInfo is used to provide buffer information like pending count, buffer length, has unprocessed data etc.
 func (r *isbsRedisSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
 	group := fmt.Sprintf("%s-group", buffer)
-	rqw := redis2.NewBufferWrite(ctx, redisclient.NewInClusterRedisClient(), buffer, group, 0, redisclient.WithRefreshBufferWriteInfo(false))
+	rqw := redis2.NewBufferWrite(ctx, r.client, buffer, group, 0, redisclient.WithRefreshBufferWriteInfo(false))
 	var bufferWrite = rqw.(*redis2.BufferWrite)
 
 	bufferInfo := &BufferInfo{
@@ -131,11 +297,409 @@ func (r *isbsRedisSvc) GetBufferInfo(ctx context.Context, buffer string) (*Buffe
 		PendingCount:    bufferWrite.GetPendingCount(),
 		AckPendingCount: 0,                             // TODO: this should not be 0
 		TotalMessages:   bufferWrite.GetPendingCount(), // TODO: what should this be?
+		// the max length is applied when writing with XADD, it's not recorded in the stream
+		MaxLength:       UnknownMaxLength,
+		UsagePercentage: UnknownUsagePercentage,
+	}
+	oldest, err := r.client.Client.XRangeN(ctx, redisclient.GetRedisStreamName(buffer), "-", "+", 1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the oldest entry of buffer %q, %w", buffer, err)
+	}
+	if len(oldest) > 0 {
+		bufferInfo.OldestMessageTime = redisEntryTime(oldest[0].ID)
+	}
+	// the consumer lags are best effort, they're left empty if they can not be retrieved.
+	if lags, err := r.consumerLags(ctx, buffer); err == nil {
+		bufferInfo.Consumers = lags
 	}
 
 	return bufferInfo, nil
 }
 
+// consumerLags returns the lags of the consumers of the group of the buffer, i.e. the replicas reading the buffer.
+func (r *isbsRedisSvc) consumerLags(ctx context.Context, buffer string) ([]ConsumerLag, error) {
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := fmt.Sprintf("%s-group", buffer)
+	streamInfo, err := r.client.Client.XInfoStream(ctx, stream).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the info of Redis stream %q, %w", stream, err)
+	}
+	groups, err := r.client.Client.XInfoGroups(ctx, stream).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the groups of Redis stream %q, %w", stream, err)
+	}
+	idx := slices.IndexFunc(groups, func(g redis.XInfoGroup) bool { return g.Name == group })
+	if idx < 0 {
+		return nil, fmt.Errorf("group %q of Redis stream %q not found", group, stream)
+	}
+	groupInfo := groups[idx]
+	consumers, err := r.client.Client.XInfoConsumers(ctx, stream, group).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the consumers of group %q, %w", group, err)
+	}
+	now := time.Now()
+	lags := make([]ConsumerLag, 0, len(consumers))
+	for _, c := range consumers {
+		lag := ConsumerLag{
+			Name:            c.Name,
+			ProducedOffset:  streamInfo.LastGeneratedID,
+			DeliveredOffset: groupInfo.LastDeliveredID,
+			// all the messages delivered to the consumer are acknowledged if none is pending.
+			AckedOffset:     groupInfo.LastDeliveredID,
+			PendingCount:    groupInfo.Lag,
+			AckPendingCount: c.Pending,
+			LastActive:      now.Add(-c.Idle),
+		}
+		if c.Pending > 0 {
+			// the messages before the oldest one pending are acknowledged.
+			pending, err := r.client.Client.XPendingExt(ctx, &redis.XPendingExtArgs{
+				Stream:   stream,
+				Group:    group,
+				Start:    "-",
+				End:      "+",
+				Count:    1,
+				Consumer: c.Name,
+			}).Result()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the pending entries of consumer %q, %w", c.Name, err)
+			}
+			if len(pending) > 0 {
+				if lag.AckedOffset, err = redisPrevEntryID(pending[0].ID); err != nil {
+					return nil, err
+				}
+			}
+		}
+		lags = append(lags, lag)
+	}
+	return lags, nil
+}
+
+// WatchPartitionInfo checks the stream of the partition at the watch interval, the stream is checked to exist first,
+// as the info of a missing stream is empty rather than an error.
+func (r *isbsRedisSvc) WatchPartitionInfo(ctx context.Context, partition string) (<-chan *BufferInfo, error) {
+	return watchPartitionInfo(ctx, partition, partitionInfoWatchInterval, func(ctx context.Context, buffer string) (*BufferInfo, error) {
+		if err := r.checkStream(ctx, buffer); err != nil {
+			return nil, err
+		}
+		return r.GetBufferInfo(ctx, buffer)
+	})
+}
+
+// GetBuffersInfo is used to get the pending counts and the oldest entries of the buffers with the XPENDING and
+// the XRANGE commands in a pipeline.
+func (r *isbsRedisSvc) GetBuffersInfo(ctx context.Context, buffers []string) ([]*BufferInfo, error) {
+	pendingCmds := make([]*redis.XPendingCmd, len(buffers))
+	oldestCmds := make([]*redis.XMessageSliceCmd, len(buffers))
+	// the errors of the commands are checked one by one
+	_, _ = r.client.Client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, buffer := range buffers {
+			stream := redisclient.GetRedisStreamName(buffer)
+			pendingCmds[i] = pipe.XPending(ctx, stream, fmt.Sprintf("%s-group", buffer))
+			oldestCmds[i] = pipe.XRangeN(ctx, stream, "-", "+", 1)
+		}
+		return nil
+	})
+	infos := make([]*BufferInfo, len(buffers))
+	errs := make([]error, len(buffers))
+	for i, buffer := range buffers {
+		if errs[i] = pendingCmds[i].Err(); errs[i] != nil {
+			continue
+		}
+		if errs[i] = oldestCmds[i].Err(); errs[i] != nil {
+			continue
+		}
+		infos[i] = &BufferInfo{
+			Name:            buffer,
+			PendingCount:    pendingCmds[i].Val().Count,
+			AckPendingCount: 0,                          // TODO: this should not be 0
+			TotalMessages:   pendingCmds[i].Val().Count, // TODO: what should this be?
+			// the max length is applied when writing with XADD, it's not recorded in the stream
+			MaxLength:       UnknownMaxLength,
+			UsagePercentage: UnknownUsagePercentage,
+		}
+		if oldest := oldestCmds[i].Val(); len(oldest) > 0 {
+			infos[i].OldestMessageTime = redisEntryTime(oldest[0].ID)
+		}
+	}
+	return collectBuffersInfo(buffers, infos, errs)
+}
+
+// ReadBufferHistory is used to read the entries of the Redis stream with XRANGE, the stream group is not involved.
+func (r *isbsRedisSvc) ReadBufferHistory(ctx context.Context, buffer string, from HistoryPosition, fn func(*HistoryMessage) bool) error {
+	if err := from.validate(); err != nil {
+		return err
+	}
+	stream := redisclient.GetRedisStreamName(buffer)
+	start := "-"
+	switch {
+	case from.StartSequence != "":
+		start = from.StartSequence
+	case !from.StartTime.IsZero():
+		// an entry ID without the sequence part starts from the first entry added in the millisecond
+		start = strconv.FormatInt(from.StartTime.UnixMilli(), 10)
+	}
+	// the read stops at the last entry existing now, it doesn't wait for the new ones.
+	last, err := r.client.Client.XRevRangeN(ctx, stream, "+", "-", 1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get the last entry of Redis stream %q, %w", stream, err)
+	}
+	if len(last) == 0 {
+		return nil
+	}
+	end := last[0].ID
+	for {
+		entries, err := r.client.Client.XRangeN(ctx, stream, start, end, historyPageSize).Result()
+		if err != nil {
+			return fmt.Errorf("failed to read the history of Redis stream %q, %w", stream, err)
+		}
+		for _, entry := range entries {
+			historyMsg, err := redisHistoryMessage(entry)
+			if err != nil {
+				return fmt.Errorf("failed to decode entry %s of Redis stream %q, %w", entry.ID, stream, err)
+			}
+			if !fn(historyMsg) {
+				return nil
+			}
+		}
+		if len(entries) < historyPageSize {
+			return nil
+		}
+		// the next page starts right after the last entry of this page
+		start = "(" + entries[len(entries)-1].ID
+	}
+}
+
+// PeekMessages reads the entries of the stream of the partition from the offset, which is an entry ID.
+func (r *isbsRedisSvc) PeekMessages(ctx context.Context, partition, offset string, count int) ([]*HistoryMessage, error) {
+	if err := r.checkStream(ctx, partition); err != nil {
+		return nil, err
+	}
+	return peekHistory(ctx, r.ReadBufferHistory, partition, offset, count)
+}
+
+// PurgeBuffers is used to trim the Redis streams with XTRIM, and to acknowledge the trimmed entries pending in the
+// stream groups, since XTRIM leaves them in the pending entries lists. The watermarks of Redis are not persisted,
+// there are no offset timelines to reset.
+func (r *isbsRedisSvc) PurgeBuffers(ctx context.Context, buffers []string, opts ...PurgeOption) error {
+	o, err := newPurgeOptions(opts...)
+	if err != nil {
+		return err
+	}
+	log := logging.FromContext(ctx)
+	var errs error
+	for _, buffer := range buffers {
+		if err := r.purgeStream(ctx, buffer, o); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to purge buffer %q, %w", buffer, err))
+			continue
+		}
+		log.Infow("Purged buffer", zap.String("buffer", buffer), zap.Time("before", o.before), zap.Int64("keepRecent", o.keepRecent), zap.String("upTo", o.upTo))
+	}
+	return errs
+}
+
+// ResizePartition only checks the partition, a Redis stream has no max length, the length of the buffer is enforced
+// by its writers.
+func (r *isbsRedisSvc) ResizePartition(ctx context.Context, partition string, newBufferLength int64) error {
+	if newBufferLength <= 0 {
+		return fmt.Errorf("invalid buffer length %d of partition %q, it should be positive", newBufferLength, partition)
+	}
+	return r.checkStream(ctx, partition)
+}
+
+// PausePartition sets the key marking the stream of the partition as paused, the readers check it periodically.
+func (r *isbsRedisSvc) PausePartition(ctx context.Context, partition string) error {
+	if err := r.checkStream(ctx, partition); err != nil {
+		return err
+	}
+	key := redisclient.GetRedisPausedKeyName(partition)
+	if err := r.client.Client.Set(ctx, key, "true", 0).Err(); err != nil {
+		return fmt.Errorf("failed to set the paused key %q, %w", key, err)
+	}
+	logging.FromContext(ctx).Infow("Paused partition", zap.String("partition", partition))
+	return nil
+}
+
+// ResumePartition deletes the key marking the stream of the partition as paused.
+func (r *isbsRedisSvc) ResumePartition(ctx context.Context, partition string) error {
+	if err := r.checkStream(ctx, partition); err != nil {
+		return err
+	}
+	key := redisclient.GetRedisPausedKeyName(partition)
+	if err := r.client.Client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("failed to delete the paused key %q, %w", key, err)
+	}
+	logging.FromContext(ctx).Infow("Resumed partition", zap.String("partition", partition))
+	return nil
+}
+
+// PurgePartition trims the stream of the partition up to the entry ID, once the paused key of the partition is set.
+func (r *isbsRedisSvc) PurgePartition(ctx context.Context, partition, upToOffset string, opts ...PurgeOption) error {
+	if err := r.checkStream(ctx, partition); err != nil {
+		return err
+	}
+	key := redisclient.GetRedisPausedKeyName(partition)
+	if n, err := r.client.Client.Exists(ctx, key).Result(); err != nil {
+		return fmt.Errorf("failed to check the paused key %q, %w", key, err)
+	} else if n == 0 {
+		return fmt.Errorf("partition %q, %w", partition, ErrPartitionNotPaused)
+	}
+	return r.PurgeBuffers(ctx, []string{partition}, append(opts, withPurgeUpTo(upToOffset))...)
+}
+
+// checkStream returns an error wrapping ErrBufferNotFound if the stream of the buffer does not exist.
+func (r *isbsRedisSvc) checkStream(ctx context.Context, buffer string) error {
+	stream := redisclient.GetRedisStreamName(buffer)
+	if n, err := r.client.Client.Exists(ctx, stream).Result(); err != nil {
+		return fmt.Errorf("failed to check the existence of Redis stream %q, %w", stream, err)
+	} else if n == 0 {
+		return fmt.Errorf("redis stream %q, %w", stream, ErrBufferNotFound)
+	}
+	return nil
+}
+
+func (r *isbsRedisSvc) purgeStream(ctx context.Context, buffer string, o *purgeOptions) error {
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := fmt.Sprintf("%s-group", buffer)
+	if n, err := r.client.Client.Exists(ctx, stream).Result(); err != nil {
+		return fmt.Errorf("failed to check the existence of Redis stream %q, %w", stream, err)
+	} else if n == 0 {
+		return fmt.Errorf("redis stream %q, %w", stream, ErrBufferNotFound)
+	}
+	if o.upTo != "" {
+		minID, err := redisNextEntryID(o.upTo)
+		if err != nil {
+			return fmt.Errorf("invalid offset %q of Redis stream %q, %w", o.upTo, stream, err)
+		}
+		if err := r.client.Client.XTrimMinID(ctx, stream, minID).Err(); err != nil {
+			return fmt.Errorf("failed to trim Redis stream %q, %w", stream, err)
+		}
+	} else if o.before.IsZero() {
+		if err := r.client.Client.XTrimMaxLen(ctx, stream, o.keepRecent).Err(); err != nil {
+			return fmt.Errorf("failed to trim Redis stream %q, %w", stream, err)
+		}
+	} else {
+		// an entry ID without the sequence part is the first entry ID of the millisecond
+		minID := strconv.FormatInt(o.before.UnixMilli(), 10)
+		if o.keepRecent > 0 {
+			recent, err := r.client.Client.XRevRangeN(ctx, stream, "+", "-", o.keepRecent).Result()
+			if err != nil {
+				return fmt.Errorf("failed to get the recent entries of Redis stream %q, %w", stream, err)
+			}
+			if len(recent) > 0 && redisEntryIDLess(recent[len(recent)-1].ID, minID) {
+				minID = recent[len(recent)-1].ID
+			}
+		}
+		if err := r.client.Client.XTrimMinID(ctx, stream, minID).Err(); err != nil {
+			return fmt.Errorf("failed to trim Redis stream %q, %w", stream, err)
+		}
+	}
+	oldest, err := r.client.Client.XRangeN(ctx, stream, "-", "+", 1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get the oldest entry of Redis stream %q, %w", stream, err)
+	}
+	// the pending entries older than the oldest entry left are the trimmed ones, all of them if the stream is empty
+	start := "-"
+	for {
+		pendings, err := r.client.Client.XPendingExt(ctx, &redis.XPendingExtArgs{Stream: stream, Group: group, Start: start, End: "+", Count: historyPageSize}).Result()
+		if err != nil {
+			return fmt.Errorf("failed to get the pending entries of Redis stream %q, %w", stream, err)
+		}
+		var ids []string
+		for _, p := range pendings {
+			if len(oldest) > 0 && !redisEntryIDLess(p.ID, oldest[0].ID) {
+				break
+			}
+			ids = append(ids, p.ID)
+		}
+		if len(ids) > 0 {
+			if err := r.client.Client.XAck(ctx, stream, group, ids...).Err(); err != nil {
+				return fmt.Errorf("failed to acknowledge the trimmed entries of Redis stream %q, %w", stream, err)
+			}
+		}
+		if len(ids) < historyPageSize {
+			return nil
+		}
+		start = "(" + ids[len(ids)-1]
+	}
+}
+
+// redisNextEntryID returns the lowest entry ID greater than the ID, a missing sequence part is 0.
+func redisNextEntryID(id string) (string, error) {
+	msPart, seqPart, hasSeq := strings.Cut(id, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid entry ID %q", id)
+	}
+	var seq uint64
+	if hasSeq {
+		if seq, err = strconv.ParseUint(seqPart, 10, 64); err != nil {
+			return "", fmt.Errorf("invalid entry ID %q", id)
+		}
+	}
+	if seq == math.MaxUint64 {
+		return strconv.FormatUint(ms+1, 10) + "-0", nil
+	}
+	return strconv.FormatUint(ms, 10) + "-" + strconv.FormatUint(seq+1, 10), nil
+}
+
+// redisPrevEntryID returns the highest Redis stream entry ID lower than the ID, a missing sequence part is 0.
+func redisPrevEntryID(id string) (string, error) {
+	msPart, seqPart, hasSeq := strings.Cut(id, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid entry ID %q", id)
+	}
+	var seq uint64
+	if hasSeq {
+		if seq, err = strconv.ParseUint(seqPart, 10, 64); err != nil {
+			return "", fmt.Errorf("invalid entry ID %q", id)
+		}
+	}
+	switch {
+	case seq > 0:
+		return strconv.FormatUint(ms, 10) + "-" + strconv.FormatUint(seq-1, 10), nil
+	case ms > 0:
+		return strconv.FormatUint(ms-1, 10) + "-" + strconv.FormatUint(math.MaxUint64, 10), nil
+	default:
+		return "0-0", nil
+	}
+}
+
+// redisEntryIDLess tells if the Redis stream entry ID a is lower than b, a missing sequence part is 0.
+func redisEntryIDLess(a, b string) bool {
+	parse := func(id string) (uint64, uint64) {
+		msPart, seqPart, _ := strings.Cut(id, "-")
+		ms, _ := strconv.ParseUint(msPart, 10, 64)
+		seq, _ := strconv.ParseUint(seqPart, 10, 64)
+		return ms, seq
+	}
+	aMs, aSeq := parse(a)
+	bMs, bSeq := parse(b)
+	return aMs < bMs || (aMs == bMs && aSeq < bSeq)
+}
+
+// redisEntryTime returns the time when the Redis stream entry was added, zero if the entry ID is malformed.
+func redisEntryTime(id string) time.Time {
+	// the first part of an entry ID is the time in milliseconds when the entry was added
+	if ms, err := strconv.ParseInt(strings.SplitN(id, "-", 2)[0], 10, 64); err == nil {
+		return time.UnixMilli(ms)
+	}
+	return time.Time{}
+}
+
+// redisHistoryMessage decodes a Redis stream entry, the message header is the field and the payload is the value.
+func redisHistoryMessage(entry redis.XMessage) (*HistoryMessage, error) {
+	historyMsg := &HistoryMessage{Sequence: entry.ID, WriteTime: redisEntryTime(entry.ID)}
+	for field, value := range entry.Values {
+		if err := historyMsg.Header.UnmarshalBinary([]byte(field)); err != nil {
+			return nil, fmt.Errorf("header unmarshal error %w", err)
+		}
+		payload, _ := value.(string)
+		historyMsg.Body.Payload = []byte(payload)
+	}
+	return historyMsg, nil
+}
+
 // CreateWatermarkStores is used to create the watermark stores.
 func (r *isbsRedisSvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]store.WatermarkStore, error) {
 	// Watermark fetching is not supported for Redis ATM. Creating noop watermark fetcher.
@@ -25,7 +25,9 @@ import (
 
 	goredis "github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb/stores/redis"
 
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
@@ -48,6 +50,16 @@ func TestIsbsRedisSvc_Buffers(t *testing.T) {
 	// validate buffer
 	assert.NoError(t, isbsRedisSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", []string{}))
 
+	// the missing group is diagnosed and repaired
+	assert.NoError(t, redisClient.Client.XGroupDestroy(ctx, stream, group).Err())
+	err := isbsRedisSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", []string{})
+	assert.Len(t, Diagnostics(err), 1)
+	assert.Equal(t, DiagnosticMissingConsumer, Diagnostics(err)[0].Kind)
+	repairReport, err := isbsRedisSvc.(Repairer).RepairBuffersAndBuckets(ctx, buffers, nil, "", []string{})
+	assert.NoError(t, err)
+	assert.Len(t, repairReport.Repaired, 1)
+	assert.NoError(t, isbsRedisSvc.ValidateBuffersAndBuckets(ctx, buffers, nil, "", []string{}))
+
 	// Verify
 	// Add some data
 	startTime := time.Unix(1636470000, 0)
@@ -76,8 +88,299 @@ func TestIsbsRedisSvc_Buffers(t *testing.T) {
 		bufferInfo, err := isbsRedisSvc.GetBufferInfo(ctx, buffer)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(9), bufferInfo.PendingCount)
+		// the reader is the only consumer of the group, with the messages not acknowledged after the first one
+		assert.Len(t, bufferInfo.Consumers, 1)
+		lag := bufferInfo.Consumers[0]
+		assert.Equal(t, "consumer", lag.Name)
+		assert.Equal(t, readMessages[9].ReadOffset.String(), lag.ProducedOffset)
+		assert.Equal(t, readMessages[9].ReadOffset.String(), lag.DeliveredOffset)
+		ackedOffset, err := redisPrevEntryID(readMessages[1].ReadOffset.String())
+		assert.NoError(t, err)
+		assert.Equal(t, ackedOffset, lag.AckedOffset)
+		assert.Equal(t, int64(0), lag.PendingCount)
+		assert.Equal(t, int64(9), lag.AckPendingCount)
+		assert.False(t, lag.LastActive.IsZero())
 	}
 
+	// test GetBuffersInfo, the info of the existing buffer is returned even if the other one fails
+	bufferInfos, err := isbsRedisSvc.GetBuffersInfo(ctx, []string{"isbsRedisSvcMissingBuffer", buffer})
+	assert.ErrorContains(t, err, `buffer "isbsRedisSvcMissingBuffer"`)
+	assert.Len(t, bufferInfos, 1)
+	assert.Equal(t, buffer, bufferInfos[0].Name)
+	assert.Equal(t, int64(9), bufferInfos[0].PendingCount)
+	assert.Equal(t, UnknownMaxLength, bufferInfos[0].MaxLength)
+	assert.Equal(t, UnknownUsagePercentage, bufferInfos[0].UsagePercentage)
+	assert.False(t, bufferInfos[0].OldestMessageTime.IsZero())
+
+	assert.NoError(t, isbsRedisSvc.ResizePartition(ctx, buffer, 100))
+	assert.ErrorIs(t, isbsRedisSvc.ResizePartition(ctx, "isbsRedisSvcMissingBuffer", 100), ErrBufferNotFound)
+
+	assert.NoError(t, isbsRedisSvc.PausePartition(ctx, buffer))
+	paused, err := redisClient.Client.Exists(ctx, redisclient.GetRedisPausedKeyName(buffer)).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), paused)
+	assert.NoError(t, isbsRedisSvc.ResumePartition(ctx, buffer))
+	paused, err = redisClient.Client.Exists(ctx, redisclient.GetRedisPausedKeyName(buffer)).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), paused)
+	assert.ErrorIs(t, isbsRedisSvc.PausePartition(ctx, "isbsRedisSvcMissingBuffer"), ErrBufferNotFound)
+
 	// delete buffer
-	assert.NoError(t, isbsRedisSvc.DeleteBuffersAndBuckets(ctx, buffers, nil, "", []string{}))
+	report, err := isbsRedisSvc.DeleteBuffersAndBuckets(ctx, buffers, nil, "", []string{})
+	assert.NoError(t, err)
+	assert.Len(t, report.Deleted, len(buffers))
+}
+
+func TestIsbsRedisSvc_ReadBufferHistory(t *testing.T) {
+	ctx := context.Background()
+	redisOptions := &goredis.UniversalOptions{
+		Addrs: []string{":6379"},
+	}
+	buffer := "isbsRedisSvcHistoryBuffer"
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := buffer + "-group"
+	redisClient := redisclient.NewRedisClient(redisOptions)
+	isbsRedisSvc := NewISBRedisSvc(redisClient)
+	assert.NoError(t, isbsRedisSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{}))
+	defer func() {
+		_, _ = isbsRedisSvc.DeleteBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{})
+	}()
+
+	// more messages than a page
+	startTime := time.Unix(1636470000, 0)
+	messages := testutils.BuildTestWriteMessages(int64(250), startTime, nil, "testVertex")
+	var ids []string
+	indexes := make(map[string]int)
+	for i, msg := range messages {
+		id, err := redisClient.Client.XAdd(ctx, &goredis.XAddArgs{
+			Stream: stream,
+			Values: []interface{}{msg.Header, msg.Body.Payload},
+		}).Result()
+		assert.NoError(t, err)
+		ids = append(ids, id)
+		indexes[id] = i
+	}
+	rqr, _ := redis.NewBufferRead(ctx, redisClient, buffer, group, "consumer", 0).(*redis.BufferRead)
+	readMessages, err := rqr.Read(ctx, 10)
+	assert.NoError(t, err)
+	assert.NoError(t, redisClient.Client.XAck(ctx, stream, group, readMessages[0].ReadOffset.String()).Err())
+	groupsBefore, err := redisClient.Client.XInfoGroups(ctx, stream).Result()
+	assert.NoError(t, err)
+
+	read := func(from HistoryPosition, limit int) []string {
+		var sequences []string
+		err := isbsRedisSvc.ReadBufferHistory(ctx, buffer, from, func(msg *HistoryMessage) bool {
+			assert.Equal(t, messages[indexes[msg.Sequence]].EventTime.UnixMilli(), msg.EventTime.UnixMilli())
+			sequences = append(sequences, msg.Sequence)
+			return len(sequences) < limit
+		})
+		assert.NoError(t, err)
+		return sequences
+	}
+	assert.Equal(t, ids, read(HistoryPosition{}, 1000))
+	assert.Equal(t, ids[200:], read(HistoryPosition{StartSequence: ids[200]}, 1000))
+	assert.Equal(t, ids[100:103], read(HistoryPosition{StartSequence: ids[100]}, 3))
+
+	peeked, err := isbsRedisSvc.PeekMessages(ctx, buffer, ids[10], 2)
+	assert.NoError(t, err)
+	assert.Len(t, peeked, 2)
+	assert.Equal(t, ids[10], peeked[0].Sequence)
+	_, err = isbsRedisSvc.PeekMessages(ctx, "non-existing", "", 1)
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	watched, err := isbsRedisSvc.WatchPartitionInfo(watchCtx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, buffer, (<-watched).Name)
+	cancelWatch()
+	_, err = isbsRedisSvc.WatchPartitionInfo(ctx, "non-existing")
+	assert.ErrorIs(t, err, ErrBufferNotFound)
+
+	// the stream group of the pipeline is untouched.
+	groupsAfter, err := redisClient.Client.XInfoGroups(ctx, stream).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, groupsBefore, groupsAfter)
+}
+
+func TestIsbsRedisSvc_PurgeBuffers(t *testing.T) {
+	ctx := context.Background()
+	redisOptions := &goredis.UniversalOptions{
+		Addrs: []string{":6379"},
+	}
+	buffer := "isbsRedisSvcPurgeBuffer"
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := buffer + "-group"
+	redisClient := redisclient.NewRedisClient(redisOptions)
+	isbsRedisSvc := NewISBRedisSvc(redisClient)
+	assert.NoError(t, isbsRedisSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{}))
+	defer func() {
+		_, _ = isbsRedisSvc.DeleteBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{})
+	}()
+
+	var ids []string
+	add := func(n int) {
+		for _, msg := range testutils.BuildTestWriteMessages(int64(n), time.Now(), nil, "testVertex") {
+			id, err := redisClient.Client.XAdd(ctx, &goredis.XAddArgs{
+				Stream: stream,
+				Values: []interface{}{msg.Header, msg.Body.Payload},
+			}).Result()
+			assert.NoError(t, err)
+			ids = append(ids, id)
+		}
+	}
+	add(5)
+	time.Sleep(10 * time.Millisecond)
+	middle := time.Now()
+	add(5)
+	// all the messages are read and one of them is acknowledged
+	rqr, _ := redis.NewBufferRead(ctx, redisClient, buffer, group, "consumer", 0).(*redis.BufferRead)
+	readMessages, err := rqr.Read(ctx, 10)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 10)
+	assert.NoError(t, redisClient.Client.XAck(ctx, stream, group, readMessages[0].ReadOffset.String()).Err())
+	pending := func() int64 {
+		infos, err := isbsRedisSvc.GetBuffersInfo(ctx, []string{buffer})
+		assert.NoError(t, err)
+		return infos[0].PendingCount
+	}
+	assert.Equal(t, int64(9), pending())
+
+	// a time-bounded purge leaves the newer messages intact, and acknowledges the trimmed ones
+	assert.NoError(t, isbsRedisSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(middle), WithKeepRecent(7)))
+	entries, err := redisClient.Client.XRange(ctx, stream, "-", "+").Result()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 7)
+	assert.Equal(t, ids[3], entries[0].ID)
+	assert.Equal(t, int64(7), pending())
+	assert.NoError(t, isbsRedisSvc.PurgeBuffers(ctx, []string{buffer}, WithPurgeBefore(middle)))
+	assert.Equal(t, int64(5), pending())
+
+	// a full purge drops all the pending messages
+	assert.NoError(t, isbsRedisSvc.PurgeBuffers(ctx, []string{buffer}))
+	length, err := redisClient.Client.XLen(ctx, stream).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), length)
+	assert.Equal(t, int64(0), pending())
+
+	assert.ErrorIs(t, isbsRedisSvc.PurgeBuffers(ctx, []string{"isbsRedisSvcMissingBuffer"}), ErrBufferNotFound)
+}
+
+func TestIsbsRedisSvc_BufferRetention(t *testing.T) {
+	ctx := context.Background()
+	redisOptions := &goredis.UniversalOptions{
+		Addrs: []string{":6379"},
+	}
+	buffer := "isbsRedisSvcBufferRetentionBuffer"
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := buffer + "-group"
+	redisClient := redisclient.NewRedisClient(redisOptions)
+	isbsRedisSvc := NewISBRedisSvc(redisClient)
+	retention := dfv1.BufferRetention{MaxMessages: ptr.To[int64](2), Discard: ptr.To(dfv1.BufferDiscardOld)}
+	assert.NoError(t, isbsRedisSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{}, WithBufferRetention(buffer, retention)))
+	defer func() {
+		_, _ = isbsRedisSvc.DeleteBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{})
+	}()
+	assert.NoError(t, isbsRedisSvc.ValidateBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{}, WithBufferRetention(buffer, retention)))
+	err := isbsRedisSvc.ValidateBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{}, WithBufferRetention(buffer, dfv1.BufferRetention{MaxMessages: ptr.To[int64](3)}))
+	assert.True(t, IsConfigMismatch(err))
+
+	for _, msg := range testutils.BuildTestWriteMessages(5, time.Now(), nil, "testVertex") {
+		assert.NoError(t, redisClient.Client.XAdd(ctx, &goredis.XAddArgs{
+			Stream: stream,
+			Values: []interface{}{msg.Header, msg.Body.Payload},
+		}).Err())
+	}
+	// the writer trims the oldest entries over the max messages
+	_ = redis.NewBufferWrite(ctx, redisClient, buffer, group, 0, redisclient.WithRefreshBufferWriteInfo(false))
+	length, err := redisClient.Client.XLen(ctx, stream).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), length)
+
+	// the retention is removed along with the buffer
+	_, err = isbsRedisSvc.DeleteBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{})
+	assert.NoError(t, err)
+	n, err := redisClient.Client.Exists(ctx, redisclient.GetRedisRetentionKeyName(buffer)).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestIsbsRedisSvc_PurgePartition(t *testing.T) {
+	ctx := context.Background()
+	redisOptions := &goredis.UniversalOptions{
+		Addrs: []string{":6379"},
+	}
+	buffer := "isbsRedisSvcPurgePartitionBuffer"
+	stream := redisclient.GetRedisStreamName(buffer)
+	group := buffer + "-group"
+	redisClient := redisclient.NewRedisClient(redisOptions)
+	isbsRedisSvc := NewISBRedisSvc(redisClient)
+	assert.NoError(t, isbsRedisSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{}))
+	defer func() {
+		_ = isbsRedisSvc.ResumePartition(ctx, buffer)
+		_, _ = isbsRedisSvc.DeleteBuffersAndBuckets(ctx, []string{buffer}, nil, "", []string{})
+	}()
+
+	var ids []string
+	for _, msg := range testutils.BuildTestWriteMessages(5, time.Now(), nil, "testVertex") {
+		id, err := redisClient.Client.XAdd(ctx, &goredis.XAddArgs{
+			Stream: stream,
+			Values: []interface{}{msg.Header, msg.Body.Payload},
+		}).Result()
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	// the read messages pending in the stream group are acknowledged when they are purged
+	rqr, _ := redis.NewBufferRead(ctx, redisClient, buffer, group, "consumer", 0).(*redis.BufferRead)
+	readMessages, err := rqr.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+
+	assert.ErrorIs(t, isbsRedisSvc.PurgePartition(ctx, buffer, ids[2]), ErrPartitionNotPaused)
+	assert.NoError(t, isbsRedisSvc.PausePartition(ctx, buffer))
+	assert.NoError(t, isbsRedisSvc.PurgePartition(ctx, buffer, ids[2]))
+	entries, err := redisClient.Client.XRange(ctx, stream, "-", "+").Result()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, ids[3], entries[0].ID)
+	pendings, err := redisClient.Client.XPending(ctx, stream, group).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pendings.Count)
+
+	assert.ErrorIs(t, isbsRedisSvc.PurgePartition(ctx, "isbsRedisSvcMissingBuffer", ids[0]), ErrBufferNotFound)
+}
+
+func TestRedisEntryIDLess(t *testing.T) {
+	assert.True(t, redisEntryIDLess("1-1", "1-2"))
+	assert.True(t, redisEntryIDLess("1-9", "2-0"))
+	assert.True(t, redisEntryIDLess("1-0", "2"))
+	assert.False(t, redisEntryIDLess("2-0", "2"))
+	assert.False(t, redisEntryIDLess("2-1", "1-5"))
+}
+
+func TestRedisNextEntryID(t *testing.T) {
+	next, err := redisNextEntryID("1-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1-2", next)
+	next, err = redisNextEntryID("5")
+	assert.NoError(t, err)
+	assert.Equal(t, "5-1", next)
+	next, err = redisNextEntryID("5-18446744073709551615")
+	assert.NoError(t, err)
+	assert.Equal(t, "6-0", next)
+	_, err = redisNextEntryID("abc")
+	assert.Error(t, err)
+}
+
+func TestRedisPrevEntryID(t *testing.T) {
+	prev, err := redisPrevEntryID("1-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "1-1", prev)
+	prev, err = redisPrevEntryID("6")
+	assert.NoError(t, err)
+	assert.Equal(t, "5-18446744073709551615", prev)
+	prev, err = redisPrevEntryID("0-0")
+	assert.NoError(t, err)
+	assert.Equal(t, "0-0", prev)
+	_, err = redisPrevEntryID("abc")
+	assert.Error(t, err)
 }
@@ -0,0 +1,239 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+// Snapshotter is implemented by the ISB Services which can snapshot the buffers and the watermark buckets to an object
+// store, and restore them into another instance of the ISB Service, e.g. for disaster recovery or to migrate a pipeline
+// to another cluster.
+type Snapshotter interface {
+	// SnapshotBuffersAndBuckets writes the messages of the buffers not acknowledged yet, and the offset timelines of
+	// the watermark buckets, to the store under the prefix. The manifest of the snapshot is written last, so a snapshot
+	// without a manifest is incomplete. The pipeline should be paused while it's taken, so that the buffers and the
+	// watermarks are consistent with each other.
+	SnapshotBuffersAndBuckets(ctx context.Context, store ObjectStore, prefix string, buffers, buckets []string) (*SnapshotManifest, error)
+	// RestoreBuffersAndBuckets writes the messages and the offset timelines of the snapshot under the prefix to the
+	// buffers and the buckets of the same names, which are created beforehand with CreateBuffersAndBuckets, so that the
+	// config of the ISB Service restored into applies. The buffers must be empty. It returns an error wrapping
+	// ErrBufferNotFound if a buffer or a bucket does not exist.
+	RestoreBuffersAndBuckets(ctx context.Context, store ObjectStore, prefix string) (*SnapshotManifest, error)
+}
+
+// SnapshotManifest describes the buffers and the buckets in a snapshot.
+type SnapshotManifest struct {
+	// Created is the time when the snapshot was completed.
+	Created time.Time      `json:"created"`
+	Buffers []SnapshotItem `json:"buffers"`
+	Buckets []SnapshotItem `json:"buckets"`
+}
+
+// SnapshotItem is a buffer or a bucket in a snapshot.
+type SnapshotItem struct {
+	Name string `json:"name"`
+	// Key is the key of the object holding the messages of the buffer or the entries of the bucket.
+	Key string `json:"key"`
+	// Count is the number of the messages of the buffer, or the entries of the bucket.
+	Count int64 `json:"count"`
+	// FirstSequence is the sequence of the first message of the buffer, the restored buffer starts from it, so that the
+	// offsets in the restored offset timelines are not ahead of the restored messages.
+	FirstSequence uint64 `json:"firstSequence,omitempty"`
+}
+
+// snapshotMessage is a message of a buffer in a snapshot, one JSON object per line.
+type snapshotMessage struct {
+	Sequence uint64              `json:"sequence"`
+	Time     time.Time           `json:"time"`
+	Header   map[string][]string `json:"header,omitempty"`
+	// Data is the message as written to the buffer, base64 encoded.
+	Data []byte `json:"data"`
+}
+
+// snapshotEntry is an entry of a bucket in a snapshot, one JSON object per line.
+type snapshotEntry struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+func snapshotManifestKey(prefix string) string {
+	return path.Join(prefix, "manifest.json")
+}
+
+func snapshotBufferKey(prefix, buffer string) string {
+	return path.Join(prefix, "buffers", buffer+".jsonl")
+}
+
+func snapshotBucketKey(prefix, bucket string) string {
+	return path.Join(prefix, "buckets", bucket+".jsonl")
+}
+
+// writeSnapshotManifest writes the manifest of the snapshot, the snapshot is complete once it's written.
+func writeSnapshotManifest(ctx context.Context, store ObjectStore, prefix string, manifest *SnapshotManifest) error {
+	manifest.Created = time.Now().UTC()
+	b, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the snapshot manifest, %w", err)
+	}
+	if err := store.Put(ctx, snapshotManifestKey(prefix), bytes.NewReader(b)); err != nil {
+		return fmt.Errorf("failed to write the snapshot manifest, %w", err)
+	}
+	return nil
+}
+
+// readSnapshotManifest reads the manifest of the snapshot, it returns an error wrapping ErrObjectNotFound if the
+// snapshot does not exist or is incomplete.
+func readSnapshotManifest(ctx context.Context, store ObjectStore, prefix string) (*SnapshotManifest, error) {
+	r, err := store.Get(ctx, snapshotManifestKey(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the snapshot manifest, %w", err)
+	}
+	defer func() { _ = r.Close() }()
+	manifest := &SnapshotManifest{}
+	if err := json.NewDecoder(r).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode the snapshot manifest, %w", err)
+	}
+	return manifest, nil
+}
+
+// writeSnapshotObject streams the lines written by fn to the object of the key.
+func writeSnapshotObject(ctx context.Context, store ObjectStore, key string, fn func(*json.Encoder) error) error {
+	pr, pw := io.Pipe()
+	go func() {
+		w := bufio.NewWriter(pw)
+		err := fn(json.NewEncoder(w))
+		if err == nil {
+			err = w.Flush()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+	err := store.Put(ctx, key, pr)
+	// unblock the writer if the store stopped reading
+	_ = pr.CloseWithError(err)
+	return err
+}
+
+// readSnapshotObject decodes the lines of the object of the key, and calls fn with each of them.
+func readSnapshotObject[T any](ctx context.Context, store ObjectStore, key string, fn func(*T) error) error {
+	r, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	for {
+		v := new(T)
+		if err := decoder.Decode(v); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to decode object %q, %w", key, err)
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+}
+
+// ErrObjectNotFound is returned when an object does not exist in an object store
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectStore is the object storage the snapshots are written to, the keys are "/" separated paths.
+type ObjectStore interface {
+	// Put writes the content read from r to the object of the key, replacing the existing one.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Get returns the content of the object of the key, it returns an error wrapping ErrObjectNotFound if the object
+	// does not exist.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// dirObjectStore keeps the objects as files under a directory.
+type dirObjectStore struct {
+	dir string
+}
+
+var _ ObjectStore = (*dirObjectStore)(nil)
+
+// NewDirObjectStore returns an object store keeping the objects as files under the directory, e.g. a volume backed by
+// a bucket of a cloud object storage.
+func NewDirObjectStore(dir string) ObjectStore {
+	return &dirObjectStore{dir: dir}
+}
+
+func (s *dirObjectStore) path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(cleaned)), nil
+}
+
+// Put writes the content to a temporary file and renames it, so that a partially written object is never read.
+func (s *dirObjectStore) Put(ctx context.Context, key string, r io.Reader) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return fmt.Errorf("failed to create the directory of object %q, %w", key, err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(p), "."+filepath.Base(p)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create object %q, %w", key, err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write object %q, %w", key, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write object %q, %w", key, err)
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to write object %q, %w", key, err)
+	}
+	if err := os.Rename(f.Name(), p); err != nil {
+		return fmt.Errorf("failed to write object %q, %w", key, err)
+	}
+	return nil
+}
+
+func (s *dirObjectStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("object %q, %w", key, ErrObjectNotFound)
+		}
+		return nil, fmt.Errorf("failed to read object %q, %w", key, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirObjectStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := NewDirObjectStore(dir)
+
+	_, err := store.Get(ctx, "snap/manifest.json")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+	assert.Error(t, store.Put(ctx, "/", strings.NewReader("x")))
+
+	assert.NoError(t, store.Put(ctx, "snap/buffers/a.jsonl", strings.NewReader("hello")))
+	assert.NoError(t, store.Put(ctx, "snap/buffers/a.jsonl", strings.NewReader("world")))
+	r, err := store.Get(ctx, "snap/buffers/a.jsonl")
+	assert.NoError(t, err)
+	b, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "world", string(b))
+
+	// keys can not escape the directory
+	assert.NoError(t, store.Put(ctx, "../escaped", strings.NewReader("x")))
+	_, err = os.Stat(filepath.Join(dir, "escaped"))
+	assert.NoError(t, err)
+
+	// a failed write leaves neither the object nor a temporary file
+	assert.Error(t, store.Put(ctx, "snap/buffers/b.jsonl", io.MultiReader(strings.NewReader("x"), errReader{})))
+	entries, err := os.ReadDir(filepath.Join(dir, "snap", "buffers"))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestSnapshotObject(t *testing.T) {
+	ctx := context.Background()
+	store := NewDirObjectStore(t.TempDir())
+
+	_, err := readSnapshotManifest(ctx, store, "snap")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+
+	err = writeSnapshotObject(ctx, store, snapshotBucketKey("snap", "b"), func(encoder *json.Encoder) error {
+		for _, k := range []string{"p-0", "p-1"} {
+			if err := encoder.Encode(&snapshotEntry{Key: k, Value: []byte(k)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	var keys []string
+	assert.NoError(t, readSnapshotObject(ctx, store, "snap/buckets/b.jsonl", func(e *snapshotEntry) error {
+		keys = append(keys, e.Key)
+		assert.Equal(t, e.Key, string(e.Value))
+		return nil
+	}))
+	assert.Equal(t, []string{"p-0", "p-1"}, keys)
+
+	// an error of the writer fails the object
+	err = writeSnapshotObject(ctx, store, "snap/buckets/c.jsonl", func(*json.Encoder) error {
+		return errors.New("boom")
+	})
+	assert.ErrorContains(t, err, "boom")
+	_, err = store.Get(ctx, "snap/buckets/c.jsonl")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+
+	assert.NoError(t, writeSnapshotManifest(ctx, store, "snap", &SnapshotManifest{Buckets: []SnapshotItem{{Name: "b", Key: "snap/buckets/b.jsonl", Count: 2}}}))
+	manifest, err := readSnapshotManifest(ctx, store, "snap")
+	assert.NoError(t, err)
+	assert.False(t, manifest.Created.IsZero())
+	assert.Equal(t, []SnapshotItem{{Name: "b", Key: "snap/buckets/b.jsonl", Count: 2}}, manifest.Buckets)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read error")
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package limits reloads the limits of a running vertex without restarting its pods.
+//
+// The controller writes the resolved limits of a vertex to a ConfigMap, which is mounted in the vertex pods. The
+// vertex watches the mounted file, and applies the settings which are safe to change on the fly, while the others
+// are reported as pending until the pods are restarted.
+package limits
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// Settings are the resolved limits of a vertex.
+type Settings struct {
+	// ReadBatchSize is the read batch size of the forwarders, it is reloadable.
+	ReadBatchSize uint64 `json:"readBatchSize"`
+	// SinkWriteTimeout is the deadline of a sink write, zero means no deadline. It is reloadable.
+	SinkWriteTimeout metav1.Duration `json:"sinkWriteTimeout"`
+	// ReadTimeout is the read timeout of the buffer readers, changing it requires a restart.
+	ReadTimeout metav1.Duration `json:"readTimeout"`
+	// BufferMaxLength is the max length of the buffers, changing it requires a restart.
+	BufferMaxLength uint64 `json:"bufferMaxLength"`
+	// BufferUsageLimit is the usage limit of the buffers in percentage, changing it requires a restart.
+	BufferUsageLimit uint32 `json:"bufferUsageLimit"`
+	// Backfill is whether the pipeline is in the backfill mode, which widens the read batches and removes the sink
+	// write deadline. It is reloadable.
+	Backfill bool `json:"backfill,omitempty"`
+	// SourcePaused is whether a source vertex pauses reading, because the storage quota of the pipeline is exceeded.
+	// It is reloadable.
+	SourcePaused bool `json:"sourcePaused,omitempty"`
+}
+
+// Change is a change of a single setting.
+type Change struct {
+	Name string
+	Old  string
+	New  string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Name, c.Old, c.New)
+}
+
+// Resolve returns the settings of a vertex, the unset limits are filled with the defaults.
+func Resolve(vertex *dfv1.Vertex) Settings {
+	s := Settings{
+		ReadBatchSize:    dfv1.DefaultReadBatchSize,
+		ReadTimeout:      metav1.Duration{Duration: dfv1.DefaultReadTimeout},
+		BufferMaxLength:  dfv1.DefaultBufferLength,
+		BufferUsageLimit: uint32(100 * dfv1.DefaultBufferUsageLimit),
+	}
+	if x := vertex.Spec.Limits; x != nil {
+		if x.ReadBatchSize != nil {
+			s.ReadBatchSize = *x.ReadBatchSize
+		}
+		if x.ReadTimeout != nil {
+			s.ReadTimeout = *x.ReadTimeout
+		}
+		if x.BufferMaxLength != nil {
+			s.BufferMaxLength = *x.BufferMaxLength
+		}
+		if x.BufferUsageLimit != nil {
+			s.BufferUsageLimit = *x.BufferUsageLimit
+		}
+	}
+	if x := vertex.Spec.Sink; x != nil {
+		s.SinkWriteTimeout = metav1.Duration{Duration: x.GetWriteTimeout()}
+	}
+	s.Backfill = vertex.IsBackfilling()
+	s.SourcePaused = vertex.IsPausedByStorageQuota()
+	return s
+}
+
+// Marshal encodes the settings to be stored in the ConfigMap.
+func Marshal(s Settings) (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the limits, %w", err)
+	}
+	return string(b), nil
+}
+
+// Unmarshal decodes the settings stored in the ConfigMap.
+func Unmarshal(data []byte) (Settings, error) {
+	var s Settings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, fmt.Errorf("failed to unmarshal the limits, %w", err)
+	}
+	if s.ReadBatchSize == 0 {
+		return s, fmt.Errorf("invalid read batch size 0")
+	}
+	return s, nil
+}
+
+// Diff returns the changes between two settings, split into the reloadable ones and the ones requiring a restart.
+func Diff(old, new Settings) (reloadable []Change, restartRequired []Change) {
+	if old.ReadBatchSize != new.ReadBatchSize {
+		reloadable = append(reloadable, Change{Name: "readBatchSize", Old: fmt.Sprint(old.ReadBatchSize), New: fmt.Sprint(new.ReadBatchSize)})
+	}
+	if old.SinkWriteTimeout != new.SinkWriteTimeout {
+		reloadable = append(reloadable, Change{Name: "sinkWriteTimeout", Old: old.SinkWriteTimeout.Duration.String(), New: new.SinkWriteTimeout.Duration.String()})
+	}
+	if old.Backfill != new.Backfill {
+		reloadable = append(reloadable, Change{Name: "backfill", Old: fmt.Sprint(old.Backfill), New: fmt.Sprint(new.Backfill)})
+	}
+	if old.SourcePaused != new.SourcePaused {
+		reloadable = append(reloadable, Change{Name: "sourcePaused", Old: fmt.Sprint(old.SourcePaused), New: fmt.Sprint(new.SourcePaused)})
+	}
+	if old.ReadTimeout != new.ReadTimeout {
+		restartRequired = append(restartRequired, Change{Name: "readTimeout", Old: old.ReadTimeout.Duration.String(), New: new.ReadTimeout.Duration.String()})
+	}
+	if old.BufferMaxLength != new.BufferMaxLength {
+		restartRequired = append(restartRequired, Change{Name: "bufferMaxLength", Old: fmt.Sprint(old.BufferMaxLength), New: fmt.Sprint(new.BufferMaxLength)})
+	}
+	if old.BufferUsageLimit != new.BufferUsageLimit {
+		restartRequired = append(restartRequired, Change{Name: "bufferUsageLimit", Old: fmt.Sprint(old.BufferUsageLimit), New: fmt.Sprint(new.BufferUsageLimit)})
+	}
+	return reloadable, restartRequired
+}
+
+// reloadableFrom returns the settings with the reloadable settings taken from next, and the others from base.
+func reloadableFrom(base, next Settings) Settings {
+	base.ReadBatchSize = next.ReadBatchSize
+	base.SinkWriteTimeout = next.SinkWriteTimeout
+	base.Backfill = next.Backfill
+	base.SourcePaused = next.SourcePaused
+	return base
+}
+
+// GetReadBatchSize returns the effective read batch size, it's widened to BackfillReadBatchSize in the backfill mode.
+func (s Settings) GetReadBatchSize() uint64 {
+	if s.Backfill && s.ReadBatchSize < dfv1.BackfillReadBatchSize {
+		return dfv1.BackfillReadBatchSize
+	}
+	return s.ReadBatchSize
+}
+
+// GetSinkWriteTimeout returns the effective sink write timeout, there is no deadline in the backfill mode.
+func (s Settings) GetSinkWriteTimeout() time.Duration {
+	if s.Backfill || s.SinkWriteTimeout.Duration < 0 {
+		return 0
+	}
+	return s.SinkWriteTimeout.Duration
+}
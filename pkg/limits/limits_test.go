@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestResolve(t *testing.T) {
+	t.Run("test defaults", func(t *testing.T) {
+		s := Resolve(&dfv1.Vertex{})
+		assert.Equal(t, uint64(dfv1.DefaultReadBatchSize), s.ReadBatchSize)
+		assert.Equal(t, dfv1.DefaultReadTimeout, s.ReadTimeout.Duration)
+		assert.Equal(t, uint64(dfv1.DefaultBufferLength), s.BufferMaxLength)
+		assert.Equal(t, uint32(100*dfv1.DefaultBufferUsageLimit), s.BufferUsageLimit)
+		assert.Equal(t, time.Duration(0), s.GetSinkWriteTimeout())
+	})
+
+	t.Run("test specified", func(t *testing.T) {
+		v := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			AbstractVertex: dfv1.AbstractVertex{
+				Sink: &dfv1.Sink{WriteTimeout: &metav1.Duration{Duration: 3 * time.Second}},
+				Limits: &dfv1.VertexLimits{
+					ReadBatchSize:    ptr.To[uint64](10),
+					ReadTimeout:      &metav1.Duration{Duration: time.Second},
+					BufferMaxLength:  ptr.To[uint64](100),
+					BufferUsageLimit: ptr.To[uint32](50),
+				},
+			},
+		}}
+		s := Resolve(v)
+		assert.Equal(t, uint64(10), s.ReadBatchSize)
+		assert.Equal(t, time.Second, s.ReadTimeout.Duration)
+		assert.Equal(t, uint64(100), s.BufferMaxLength)
+		assert.Equal(t, uint32(50), s.BufferUsageLimit)
+		assert.Equal(t, 3*time.Second, s.GetSinkWriteTimeout())
+	})
+
+	t.Run("test backfill", func(t *testing.T) {
+		v := &dfv1.Vertex{}
+		v.Annotations = map[string]string{dfv1.KeyBackfill: "true"}
+		assert.True(t, Resolve(v).Backfill)
+	})
+
+	t.Run("test storage quota paused", func(t *testing.T) {
+		v := &dfv1.Vertex{}
+		assert.False(t, Resolve(v).SourcePaused)
+		v.Annotations = map[string]string{dfv1.KeyStorageQuotaPaused: "true"}
+		assert.True(t, Resolve(v).SourcePaused)
+	})
+}
+
+func TestSettings_Backfill(t *testing.T) {
+	s := Settings{ReadBatchSize: 10, SinkWriteTimeout: metav1.Duration{Duration: time.Second}}
+	assert.Equal(t, uint64(10), s.GetReadBatchSize())
+	assert.Equal(t, time.Second, s.GetSinkWriteTimeout())
+
+	s.Backfill = true
+	assert.Equal(t, uint64(dfv1.BackfillReadBatchSize), s.GetReadBatchSize())
+	assert.Equal(t, time.Duration(0), s.GetSinkWriteTimeout())
+	// a larger read batch size is kept.
+	s.ReadBatchSize = 2 * dfv1.BackfillReadBatchSize
+	assert.Equal(t, uint64(2*dfv1.BackfillReadBatchSize), s.GetReadBatchSize())
+}
+
+func TestMarshal(t *testing.T) {
+	s := Settings{ReadBatchSize: 10, SinkWriteTimeout: metav1.Duration{Duration: time.Second}, BufferMaxLength: 100}
+	data, err := Marshal(s)
+	assert.NoError(t, err)
+	got, err := Unmarshal([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, s, got)
+
+	_, err = Unmarshal([]byte(`{"readBatchSize":0}`))
+	assert.Error(t, err)
+	_, err = Unmarshal([]byte(`invalid`))
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	old := Settings{ReadBatchSize: 10, BufferMaxLength: 100}
+	reloadable, restartRequired := Diff(old, old)
+	assert.Empty(t, reloadable)
+	assert.Empty(t, restartRequired)
+
+	next := Settings{ReadBatchSize: 20, SinkWriteTimeout: metav1.Duration{Duration: time.Second}, BufferMaxLength: 200, Backfill: true, SourcePaused: true}
+	reloadable, restartRequired = Diff(old, next)
+	assert.Equal(t, []Change{
+		{Name: "readBatchSize", Old: "10", New: "20"},
+		{Name: "sinkWriteTimeout", Old: "0s", New: "1s"},
+		{Name: "backfill", Old: "false", New: "true"},
+		{Name: "sourcePaused", Old: "false", New: "true"},
+	}, reloadable)
+	assert.Equal(t, []Change{{Name: "bufferMaxLength", Old: "100", New: "200"}}, restartRequired)
+	assert.Equal(t, "bufferMaxLength: 100 -> 200", restartRequired[0].String())
+}
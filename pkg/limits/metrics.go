@@ -0,0 +1,31 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// limitsRestartPending is used to indicate the number of limit changes which require a restart to be applied.
+var limitsRestartPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "vertex",
+	Name:      "limits_restart_pending",
+	Help:      "Number of limit changes pending a restart of the vertex pod",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelVertexType, metrics.LabelVertexReplicaIndex})
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// defaultPollInterval is the interval of polling the mounted limits file. The kubelet syncs the mounted ConfigMaps
+// periodically, so polling more often does not make the reload faster.
+const defaultPollInterval = 5 * time.Second
+
+// Reloader applies the reloadable limits received from a Watcher to its subscribers, and keeps track of the
+// changes which are pending a restart.
+type Reloader struct {
+	lock sync.RWMutex
+	// startup is the settings the vertex started with.
+	startup Settings
+	// current is the effective settings, the reloadable settings are the latest ones, the others are the startup ones.
+	current     Settings
+	pending     []Change
+	subscribers []func(Settings)
+	watcher     Watcher
+	labels      map[string]string
+	logger      *zap.SugaredLogger
+}
+
+type Option func(*Reloader)
+
+// WithLogger sets the logger of the Reloader.
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(r *Reloader) {
+		r.logger = l
+	}
+}
+
+// WithMetricLabels sets the labels of the metrics exported by the Reloader.
+func WithMetricLabels(labels map[string]string) Option {
+	return func(r *Reloader) {
+		r.labels = labels
+	}
+}
+
+// NewReloader returns a Reloader starting with the given settings.
+func NewReloader(startup Settings, watcher Watcher, opts ...Option) *Reloader {
+	r := &Reloader{
+		startup: startup,
+		current: startup,
+		watcher: watcher,
+		logger:  logging.NewLogger(),
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// NewVertexReloader returns a Reloader of a vertex pod, which watches the limits file mounted from the runtime limits
+// ConfigMap of the vertex.
+func NewVertexReloader(vertexInstance *dfv1.VertexInstance, vertexType dfv1.VertexType, logger *zap.SugaredLogger) *Reloader {
+	vertex := vertexInstance.Vertex
+	watcher := NewFileWatcher(filepath.Join(dfv1.PathRuntimeLimits, dfv1.KeyRuntimeLimits), defaultPollInterval)
+	return NewReloader(Resolve(vertex), watcher, WithLogger(logger), WithMetricLabels(map[string]string{
+		metrics.LabelVertex:             vertex.Spec.Name,
+		metrics.LabelPipeline:           vertex.Spec.PipelineName,
+		metrics.LabelVertexType:         string(vertexType),
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(vertexInstance.Replica)),
+	}))
+}
+
+// Subscribe registers a function which is invoked with the effective settings every time a reloadable setting
+// changes. It must be called before Start.
+func (r *Reloader) Subscribe(fn func(Settings)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.subscribers = append(r.subscribers, fn)
+}
+
+// Current returns the effective settings.
+func (r *Reloader) Current() Settings {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.current
+}
+
+// Pending returns the changes which require a restart to be applied.
+func (r *Reloader) Pending() []Change {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return append([]Change(nil), r.pending...)
+}
+
+// Start watches the settings and applies them until the context is done, it is a blocking call.
+func (r *Reloader) Start(ctx context.Context) {
+	for next := range r.watcher.Watch(ctx) {
+		r.apply(next)
+	}
+}
+
+func (r *Reloader) apply(next Settings) {
+	r.lock.Lock()
+	reloadable, _ := Diff(r.current, next)
+	_, restartRequired := Diff(r.startup, next)
+	r.current = reloadableFrom(r.current, next)
+	r.pending = restartRequired
+	current := r.current
+	subscribers := r.subscribers
+	r.lock.Unlock()
+
+	for _, c := range reloadable {
+		r.logger.Infow("Reloading limit", zap.String("limit", c.Name), zap.String("old", c.Old), zap.String("new", c.New))
+	}
+	for _, c := range restartRequired {
+		r.logger.Warnw("Limit change requires a restart, it is pending", zap.String("limit", c.Name), zap.String("old", c.Old), zap.String("new", c.New))
+	}
+	if r.labels != nil {
+		limitsRestartPending.With(r.labels).Set(float64(len(restartRequired)))
+	}
+	if len(reloadable) == 0 {
+		return
+	}
+	for _, fn := range subscribers {
+		fn(current)
+	}
+}
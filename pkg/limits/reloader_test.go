@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := make(chan Settings)
+	startup := Settings{ReadBatchSize: 10, BufferMaxLength: 100}
+	r := NewReloader(startup, NewChanWatcher(source))
+	var lock sync.Mutex
+	var applied []Settings
+	r.Subscribe(func(s Settings) {
+		lock.Lock()
+		defer lock.Unlock()
+		applied = append(applied, s)
+	})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.Start(ctx)
+	}()
+
+	// the reloadable change is applied, the other one is pending
+	source <- Settings{ReadBatchSize: 20, BufferMaxLength: 200}
+	assert.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(applied) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, Settings{ReadBatchSize: 20, BufferMaxLength: 100}, r.Current())
+	assert.Equal(t, []Change{{Name: "bufferMaxLength", Old: "100", New: "200"}}, r.Pending())
+
+	// reverting the change which requires a restart clears the pending changes, without notifying the subscribers
+	source <- Settings{ReadBatchSize: 20, BufferMaxLength: 100}
+	assert.Eventually(t, func() bool {
+		return len(r.Pending()) == 0
+	}, time.Second, 10*time.Millisecond)
+	lock.Lock()
+	assert.Equal(t, 1, len(applied))
+	lock.Unlock()
+
+	// the backfill mode is switched on and off without a restart
+	source <- Settings{ReadBatchSize: 20, BufferMaxLength: 100, Backfill: true}
+	source <- Settings{ReadBatchSize: 20, BufferMaxLength: 100}
+	assert.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(applied) == 3
+	}, time.Second, 10*time.Millisecond)
+	lock.Lock()
+	assert.True(t, applied[1].Backfill)
+	assert.False(t, applied[2].Backfill)
+	lock.Unlock()
+	assert.Empty(t, r.Pending())
+
+	cancel()
+	<-done
+}
+
+func TestFileWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	path := filepath.Join(t.TempDir(), "limits.json")
+	ch := NewFileWatcher(path, 10*time.Millisecond).Watch(ctx)
+
+	// a missing file is ignored until it is created
+	data, err := Marshal(Settings{ReadBatchSize: 10})
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+	assert.Equal(t, uint64(10), (<-ch).ReadBatchSize)
+
+	// an invalid file is ignored
+	assert.NoError(t, os.WriteFile(path, []byte("invalid"), 0o644))
+	data, err = Marshal(Settings{ReadBatchSize: 20})
+	assert.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+	assert.Equal(t, uint64(20), (<-ch).ReadBatchSize)
+
+	cancel()
+	for range ch {
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package limits
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// Watcher watches the limits of a vertex.
+type Watcher interface {
+	// Watch returns a channel which receives the settings every time they change, the channel is closed when the
+	// context is done.
+	Watch(ctx context.Context) <-chan Settings
+}
+
+// fileWatcher watches the limits file mounted from the ConfigMap. The kubelet updates the mounted files by swapping
+// a symlink, so the file is polled rather than watched for events.
+type fileWatcher struct {
+	path     string
+	interval time.Duration
+}
+
+var _ Watcher = (*fileWatcher)(nil)
+
+// NewFileWatcher returns a Watcher polling the limits file at the given path.
+func NewFileWatcher(path string, interval time.Duration) Watcher {
+	return &fileWatcher{path: path, interval: interval}
+}
+
+func (w *fileWatcher) Watch(ctx context.Context) <-chan Settings {
+	log := logging.FromContext(ctx).With("path", w.path)
+	ch := make(chan Settings)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		var last []byte
+		for {
+			data, err := os.ReadFile(w.path)
+			if err != nil {
+				if !errors.Is(err, os.ErrNotExist) {
+					log.Warnw("Failed to read the limits file", zap.Error(err))
+				}
+			} else if !bytes.Equal(data, last) {
+				if s, err := Unmarshal(data); err != nil {
+					log.Warnw("Ignoring invalid limits file", zap.Error(err))
+				} else {
+					select {
+					case ch <- s:
+					case <-ctx.Done():
+						return
+					}
+				}
+				last = data
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return ch
+}
+
+// chanWatcher is a Watcher fed by a channel.
+type chanWatcher struct {
+	source <-chan Settings
+}
+
+var _ Watcher = (*chanWatcher)(nil)
+
+// NewChanWatcher returns a Watcher which forwards the settings sent to the given channel, it is useful for testing.
+func NewChanWatcher(source <-chan Settings) Watcher {
+	return &chanWatcher{source: source}
+}
+
+func (w *chanWatcher) Watch(ctx context.Context) <-chan Settings {
+	ch := make(chan Settings)
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case s, ok := <-w.source:
+				if !ok {
+					return
+				}
+				select {
+				case ch <- s:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// isbAckLatencyMetricName is the fully qualified name of the ISBAckLatency histogram.
+const isbAckLatencyMetricName = "isb_ack_latency"
+
+// isbAckFailuresMetricName is the fully qualified name of the ISBAckFailures counter.
+const isbAckFailuresMetricName = "isb_ack_failure_total"
+
+// ISBAckLabels returns the labels of the ISB ack metrics of a buffer partition read by the vertex instance.
+func ISBAckLabels(vi *dfv1.VertexInstance, partitionName string) prometheus.Labels {
+	return prometheus.Labels{
+		LabelVertex:             vi.Vertex.Spec.Name,
+		LabelPipeline:           vi.Vertex.Spec.PipelineName,
+		LabelVertexType:         string(vi.Vertex.GetVertexType()),
+		LabelVertexReplicaIndex: strconv.Itoa(int(vi.Replica)),
+		LabelPartitionName:      partitionName,
+	}
+}
+
+// ObserveISBAck records the latency of an Ack call started at the given time, and the number of offsets
+// that failed to be acknowledged in the call.
+func ObserveISBAck(labels prometheus.Labels, start time.Time, failed int) {
+	ISBAckLatency.With(labels).Observe(float64(time.Since(start).Microseconds()))
+	if failed > 0 {
+		ISBAckFailures.With(labels).Add(float64(failed))
+	}
+}
+
+// HealthDetails is the health of a vertex pod, exposed by the /health/details endpoint.
+type HealthDetails struct {
+	Pipeline string `json:"pipeline"`
+	Vertex   string `json:"vertex"`
+	Healthy  bool   `json:"healthy"`
+	// Message is the error of the failed health check, if any.
+	Message    string                   `json:"message,omitempty"`
+	Partitions []PartitionHealthDetails `json:"partitions,omitempty"`
+}
+
+// PartitionHealthDetails is the health of a buffer partition read by a vertex pod.
+type PartitionHealthDetails struct {
+	Partition   string `json:"partition"`
+	AckCalls    uint64 `json:"ackCalls"`
+	AckFailures uint64 `json:"ackFailures"`
+	// AckLatencyP99 is the estimated 99th percentile latency of the Ack calls since the pod started, in microseconds.
+	AckLatencyP99 float64 `json:"ackLatencyP99"`
+}
+
+// NewHealthDetails collects the health details of the vertex, the ack metrics are read from the gatherer.
+func NewHealthDetails(vertex *dfv1.Vertex, gatherer prometheus.Gatherer, healthCheckExecutors []func() error) (HealthDetails, error) {
+	details := HealthDetails{
+		Pipeline: vertex.Spec.PipelineName,
+		Vertex:   vertex.Spec.Name,
+		Healthy:  true,
+	}
+	for _, ex := range healthCheckExecutors {
+		if err := ex(); err != nil {
+			details.Healthy = false
+			details.Message = err.Error()
+			break
+		}
+	}
+	families, err := gatherer.Gather()
+	if err != nil {
+		return details, fmt.Errorf("failed to gather metrics, %w", err)
+	}
+	partitions := make(map[string]*PartitionHealthDetails)
+	getPartition := func(name string) *PartitionHealthDetails {
+		if p, ok := partitions[name]; ok {
+			return p
+		}
+		p := &PartitionHealthDetails{Partition: name}
+		partitions[name] = p
+		return p
+	}
+	for _, mf := range families {
+		switch mf.GetName() {
+		case isbAckLatencyMetricName:
+			for _, m := range mf.GetMetric() {
+				if partition, ok := vertexPartition(m, vertex); ok {
+					p := getPartition(partition)
+					p.AckCalls = m.GetHistogram().GetSampleCount()
+					if p.AckCalls > 0 {
+						p.AckLatencyP99 = HistogramQuantile(0.99, m.GetHistogram())
+					}
+				}
+			}
+		case isbAckFailuresMetricName:
+			for _, m := range mf.GetMetric() {
+				if partition, ok := vertexPartition(m, vertex); ok {
+					getPartition(partition).AckFailures = uint64(m.GetCounter().GetValue())
+				}
+			}
+		}
+	}
+	for _, p := range partitions {
+		details.Partitions = append(details.Partitions, *p)
+	}
+	sort.Slice(details.Partitions, func(i, j int) bool {
+		return details.Partitions[i].Partition < details.Partitions[j].Partition
+	})
+	return details, nil
+}
+
+// vertexPartition returns the partition name of the metric if it belongs to the vertex.
+func vertexPartition(m *dto.Metric, vertex *dfv1.Vertex) (string, bool) {
+	var v, pl, partition string
+	for _, l := range m.GetLabel() {
+		switch l.GetName() {
+		case LabelVertex:
+			v = l.GetValue()
+		case LabelPipeline:
+			pl = l.GetValue()
+		case LabelPartitionName:
+			partition = l.GetValue()
+		}
+	}
+	return partition, v == vertex.Spec.Name && pl == vertex.Spec.PipelineName
+}
+
+// HistogramQuantile estimates the q-quantile of the histogram the same way as the PromQL histogram_quantile function,
+// by linear interpolation within the bucket the quantile falls into. It returns NaN if the histogram is empty.
+func HistogramQuantile(q float64, h *dto.Histogram) float64 {
+	count := h.GetSampleCount()
+	if count == 0 {
+		return math.NaN()
+	}
+	rank := q * float64(count)
+	lowerBound, lowerCount := 0.0, uint64(0)
+	for _, b := range h.GetBucket() {
+		if float64(b.GetCumulativeCount()) >= rank {
+			if b.GetCumulativeCount() == lowerCount {
+				return b.GetUpperBound()
+			}
+			return lowerBound + (b.GetUpperBound()-lowerBound)*(rank-float64(lowerCount))/float64(b.GetCumulativeCount()-lowerCount)
+		}
+		lowerBound, lowerCount = b.GetUpperBound(), b.GetCumulativeCount()
+	}
+	// the quantile falls into the +Inf bucket, return the upper bound of the highest finite bucket.
+	return lowerBound
+}
+
+// healthDetailsHandler serves the health details of the vertex as JSON.
+func healthDetailsHandler(vertex *dfv1.Vertex, gatherer prometheus.Gatherer, healthCheckExecutors []func() error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		details, err := NewHealthDetails(vertex, gatherer, healthCheckExecutors)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		b, err := json.MarshalIndent(details, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(b)
+	}
+}
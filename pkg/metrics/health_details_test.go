@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	h := &dto.Histogram{
+		SampleCount: ptr.To[uint64](100),
+		Bucket: []*dto.Bucket{
+			{UpperBound: ptr.To[float64](100), CumulativeCount: ptr.To[uint64](50)},
+			{UpperBound: ptr.To[float64](200), CumulativeCount: ptr.To[uint64](90)},
+			{UpperBound: ptr.To[float64](1000), CumulativeCount: ptr.To[uint64](100)},
+		},
+	}
+	assert.Equal(t, float64(50), HistogramQuantile(0.25, h))
+	assert.Equal(t, float64(150), HistogramQuantile(0.7, h))
+	assert.Equal(t, float64(920), HistogramQuantile(0.99, h))
+	assert.True(t, math.IsNaN(HistogramQuantile(0.99, &dto.Histogram{})))
+
+	// the quantile falls into the +Inf bucket
+	h.SampleCount = ptr.To[uint64](200)
+	assert.Equal(t, float64(1000), HistogramQuantile(0.99, h))
+}
+
+func TestNewHealthDetails(t *testing.T) {
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "health-details-pl",
+			AbstractVertex: dfv1.AbstractVertex{Name: "health-details-vertex", UDF: &dfv1.UDF{}},
+		}},
+	}
+	start := time.Now()
+	ObserveISBAck(ISBAckLabels(vi, "health-details-pl-health-details-vertex-1"), start, 0)
+	ObserveISBAck(ISBAckLabels(vi, "health-details-pl-health-details-vertex-0"), start, 0)
+	ObserveISBAck(ISBAckLabels(vi, "health-details-pl-health-details-vertex-0"), start, 2)
+	// metrics of other vertices are not included
+	other := &dfv1.VertexInstance{Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName:   "health-details-pl",
+		AbstractVertex: dfv1.AbstractVertex{Name: "other", UDF: &dfv1.UDF{}},
+	}}}
+	ObserveISBAck(ISBAckLabels(other, "health-details-pl-other-0"), start, 1)
+
+	details, err := NewHealthDetails(vi.Vertex, prometheus.DefaultGatherer, []func() error{func() error { return nil }})
+	assert.NoError(t, err)
+	assert.True(t, details.Healthy)
+	assert.Empty(t, details.Message)
+	assert.Len(t, details.Partitions, 2)
+	assert.Equal(t, "health-details-pl-health-details-vertex-0", details.Partitions[0].Partition)
+	assert.Equal(t, uint64(2), details.Partitions[0].AckCalls)
+	assert.Equal(t, uint64(2), details.Partitions[0].AckFailures)
+	assert.Greater(t, details.Partitions[0].AckLatencyP99, float64(0))
+	assert.Equal(t, "health-details-pl-health-details-vertex-1", details.Partitions[1].Partition)
+	assert.Equal(t, uint64(1), details.Partitions[1].AckCalls)
+	assert.Equal(t, uint64(0), details.Partitions[1].AckFailures)
+
+	details, err = NewHealthDetails(vi.Vertex, prometheus.DefaultGatherer, []func() error{func() error { return fmt.Errorf("udf not ready") }})
+	assert.NoError(t, err)
+	assert.False(t, details.Healthy)
+	assert.Equal(t, "udf not ready", details.Message)
+}
+
+func TestHealthDetailsHandler(t *testing.T) {
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName:   "handler-pl",
+		AbstractVertex: dfv1.AbstractVertex{Name: "handler-vertex", Sink: &dfv1.Sink{}},
+	}}
+	ObserveISBAck(ISBAckLabels(&dfv1.VertexInstance{Vertex: vertex}, "handler-pl-handler-vertex-0"), time.Now(), 0)
+
+	rec := httptest.NewRecorder()
+	healthDetailsHandler(vertex, prometheus.DefaultGatherer, nil)(rec, httptest.NewRequest(http.MethodGet, "/health/details", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var details HealthDetails
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &details))
+	assert.Equal(t, "handler-pl", details.Pipeline)
+	assert.Equal(t, "handler-vertex", details.Vertex)
+	assert.True(t, details.Healthy)
+	assert.Len(t, details.Partitions, 1)
+	assert.Equal(t, uint64(1), details.Partitions[0].AckCalls)
+}
@@ -38,6 +38,7 @@ const (
 	LabelSDKVersion         = "version"
 	LabelSDKType            = "type" // container type, e.g sourcer, sourcetransformer, sinker, etc. see serverinfo.ContainerType
 	LabelReason             = "reason"
+	LabelOutcome            = "outcome"
 )
 
 var (
@@ -88,6 +89,38 @@ var (
 		Help:      "Total number of Read Errors",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
 
+	// HighWaterEventTime is the maximum event time in milliseconds observed in the read path
+	HighWaterEventTime = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "forwarder",
+		Name:      "high_water_event_time",
+		Help:      "Maximum event time in milliseconds of the messages read so far",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
+	// FutureEventTimeCount is used to indicate the number of messages read with an event time too far in the future
+	FutureEventTimeCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "forwarder",
+		Name:      "future_event_time_total",
+		Help:      "Total number of messages read with an event time beyond the future event time bound",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
+	// SourceDelay is a histogram to observe the delay between the event time and the ingestion time of the messages read,
+	// i.e., how late the messages arrive at the source
+	SourceDelay = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: "forwarder",
+		Name:      "source_delay",
+		Help:      "Delay between the event time and the ingestion time of the messages read (100 microseconds to 1 hour)",
+		Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*60, 10),
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
+	// PipelineDelay is a histogram to observe the delay between the ingestion time of the messages read and now,
+	// i.e., how long the messages have been in the pipeline
+	PipelineDelay = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: "forwarder",
+		Name:      "pipeline_delay",
+		Help:      "Delay between the ingestion time of the messages read and the time they are read (100 microseconds to 1 hour)",
+		Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*60, 10),
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
 	// ReadProcessingTime is a histogram to observe read operation latency
 	ReadProcessingTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Subsystem: "forwarder",
@@ -161,6 +194,29 @@ var (
 		Help:      "Total number of Acknowledged Errors",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
 
+	// InvalidEventTimeCount is used to indicate the number of messages with a missing or invalid event time, by the
+	// outcome of the configured policy
+	InvalidEventTimeCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "source",
+		Name:      "invalid_event_time_total",
+		Help:      "Total number of messages with a missing or invalid event time",
+	}, []string{LabelVertex, LabelPipeline, LabelOutcome, LabelReason})
+
+	// ISBAckLatency is a histogram to observe the latency of the Ack calls of the inter-step buffer readers
+	ISBAckLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: "isb",
+		Name:      "ack_latency",
+		Help:      "Latency of the Ack calls of the inter-step buffer readers (100 microseconds to 10 minutes)",
+		Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*10, 30),
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
+	// ISBAckFailures is used to indicate the number of offsets the inter-step buffer readers failed to acknowledge
+	ISBAckFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "isb",
+		Name:      "ack_failure_total",
+		Help:      "Total number of offsets failed to be acknowledged by the inter-step buffer readers",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
 	// UDFError is used to indicate the number of UDF errors
 	UDFError = promauto.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: "forwarder",
@@ -369,6 +425,13 @@ var (
 		Name:      "fbsink_write_error_total",
 		Help:      "Total number of Write Errors while writing to a fallback sink",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
+	// SinkWriteTimeouts is used to indicate the number of sink writes that exceeded the configured write timeout
+	SinkWriteTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "forwarder",
+		Name:      "sink_write_timeout_total",
+		Help:      "Total number of sink writes that exceeded the write timeout",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
 )
 
 // Daemon server metrics
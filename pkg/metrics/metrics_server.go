@@ -74,6 +74,10 @@ type metricsServer struct {
 	partitionPendingInfo map[string]*sharedqueue.OverflowQueue[timestampedPending]
 	// Functions that health check executes
 	healthCheckExecutors []func() error
+	// watermarkDecisions serves the watermark decision log, nil if it's disabled
+	watermarkDecisions http.Handler
+	// generatorConfig serves the runtime config of the generator source, nil if the vertex is not a generator
+	generatorConfig http.Handler
 }
 
 type Option func(*metricsServer)
@@ -106,6 +110,20 @@ func WithHealthCheckExecutor(f func() error) Option {
 	}
 }
 
+// WithWatermarkDecisions sets the handler serving the watermark decision log
+func WithWatermarkDecisions(h http.Handler) Option {
+	return func(m *metricsServer) {
+		m.watermarkDecisions = h
+	}
+}
+
+// WithGeneratorConfig sets the handler serving the runtime config of the generator source
+func WithGeneratorConfig(h http.Handler) Option {
+	return func(m *metricsServer) {
+		m.generatorConfig = h
+	}
+}
+
 // NewMetricsOptions returns a metrics option list.
 func NewMetricsOptions(ctx context.Context, vertex *dfv1.Vertex, healthCheckers []HealthChecker, readers []isb.LagReader) []Option {
 	metricsOpts := []Option{
@@ -251,6 +269,8 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 		}
 		w.WriteHeader(http.StatusNoContent)
 	})
+	mux.HandleFunc("/runtime/config", runtimeConfigHandler(ms.vertex, os.Environ))
+	mux.HandleFunc("/health/details", healthDetailsHandler(ms.vertex, prometheus.DefaultGatherer, ms.healthCheckExecutors))
 	mux.HandleFunc("/livez", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	})
@@ -265,6 +285,12 @@ func (ms *metricsServer) Start(ctx context.Context) (func(ctx context.Context) e
 		}
 		w.WriteHeader(http.StatusNoContent)
 	})
+	if ms.watermarkDecisions != nil {
+		mux.Handle("/watermark/decisions", ms.watermarkDecisions)
+	}
+	if ms.generatorConfig != nil {
+		mux.Handle("/generator/config", ms.generatorConfig)
+	}
 	pprofEnabled := os.Getenv(dfv1.EnvDebug) == "true" || os.Getenv(dfv1.EnvPPROF) == "true"
 	if pprofEnabled {
 		mux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -86,6 +86,20 @@ func Test_MetricsServer_WithHealthCheckExecutor(t *testing.T) {
 	assert.True(t, executed)
 }
 
+func Test_MetricsServer_WithWatermarkDecisions(t *testing.T) {
+	h := http.NotFoundHandler()
+	ms := NewMetricsServer(&dfv1.Vertex{}, WithWatermarkDecisions(h))
+	assert.NotNil(t, ms.watermarkDecisions)
+	assert.Nil(t, NewMetricsServer(&dfv1.Vertex{}).watermarkDecisions)
+}
+
+func Test_MetricsServer_WithGeneratorConfig(t *testing.T) {
+	h := http.NotFoundHandler()
+	ms := NewMetricsServer(&dfv1.Vertex{}, WithGeneratorConfig(h))
+	assert.NotNil(t, ms.generatorConfig)
+	assert.Nil(t, NewMetricsServer(&dfv1.Vertex{}).generatorConfig)
+}
+
 func Test_MetricsServer_NewMetricsOptions(t *testing.T) {
 	vertex := &dfv1.Vertex{
 		Spec: dfv1.VertexSpec{
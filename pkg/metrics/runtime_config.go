@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/sdkclient"
+	"github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// RedactedValue replaces the values of the secret settings in the RuntimeConfig.
+const RedactedValue = "******"
+
+// secretEnvKeywords are the keywords in the name of an env var that indicate the value is a secret.
+var secretEnvKeywords = []string{"PASSWORD", "TOKEN", "SECRET", "CREDENTIAL", "PRIVATE_KEY", "AUTH"}
+
+// excludedEnvs are the env vars not exposed in the RuntimeConfig, the vertex and pipeline objects are already
+// resolved into the other fields of the RuntimeConfig.
+var excludedEnvs = map[string]bool{
+	dfv1.EnvVertexObject:   true,
+	dfv1.EnvPipelineObject: true,
+}
+
+// RuntimeConfig is the effective configuration a vertex is running with, the spec defaults and the env overrides
+// are resolved. It is exposed by the /runtime/config endpoint for debugging.
+type RuntimeConfig struct {
+	Pipeline   string          `json:"pipeline"`
+	Vertex     string          `json:"vertex"`
+	VertexType dfv1.VertexType `json:"vertexType"`
+	ISBSvc     RuntimeISBSvc   `json:"isbsvc"`
+	// FromBuffers are the buffers the vertex reads from, and ToBuffers are the buffers it writes to.
+	FromBuffers []string         `json:"fromBuffers,omitempty"`
+	ToBuffers   []string         `json:"toBuffers,omitempty"`
+	Limits      RuntimeLimits    `json:"limits"`
+	Watermark   RuntimeWatermark `json:"watermark"`
+	ToEdges     []RuntimeEdge    `json:"toEdges,omitempty"`
+	// Features are the optional features enabled on the vertex, e.g. udTransformer or fallbackSink.
+	Features []string `json:"features,omitempty"`
+	// Env contains the NUMAFLOW_* and the user defined env vars of the container, the secret values are redacted.
+	Env map[string]string `json:"env"`
+}
+
+// RuntimeISBSvc is the inter-step buffer service a vertex is connected to.
+type RuntimeISBSvc struct {
+	Type dfv1.ISBSvcType `json:"type,omitempty"`
+	// Target is the URL of the ISB service, the user info is removed.
+	Target string `json:"target,omitempty"`
+}
+
+// RuntimeLimits is the effective read and write settings of a vertex.
+type RuntimeLimits struct {
+	ReadBatchSize    uint64 `json:"readBatchSize"`
+	ReadTimeout      string `json:"readTimeout"`
+	BufferMaxLength  uint64 `json:"bufferMaxLength"`
+	BufferUsageLimit uint32 `json:"bufferUsageLimit"`
+	// Concurrency is the number of messages processed concurrently by the UDF or the sink.
+	Concurrency          uint64 `json:"concurrency"`
+	FutureEventTimeBound string `json:"futureEventTimeBound"`
+	GRPCMaxMessageSize   int    `json:"grpcMaxMessageSize"`
+}
+
+// RuntimeWatermark is the effective watermark settings of a vertex.
+type RuntimeWatermark struct {
+	Disabled    bool               `json:"disabled"`
+	MaxDelay    string             `json:"maxDelay"`
+	IdleSource  *RuntimeIdleSource `json:"idleSource,omitempty"`
+	FromBuckets []string           `json:"fromBuckets,omitempty"`
+	ToBuckets   []string           `json:"toBuckets,omitempty"`
+}
+
+// RuntimeIdleSource is the effective idle source watermark settings of a vertex.
+type RuntimeIdleSource struct {
+	Threshold    string `json:"threshold"`
+	StepInterval string `json:"stepInterval"`
+	IncrementBy  string `json:"incrementBy"`
+}
+
+// RuntimeEdge is the effective settings of an outgoing edge of a vertex.
+type RuntimeEdge struct {
+	To               string                         `json:"to"`
+	Partitions       int                            `json:"partitions"`
+	OnFull           dfv1.BufferFullWritingStrategy `json:"onFull"`
+	BufferMaxLength  uint64                         `json:"bufferMaxLength"`
+	BufferUsageLimit uint32                         `json:"bufferUsageLimit"`
+}
+
+// NewRuntimeConfig resolves the effective configuration of the vertex, environ is the environment of the process
+// in the form of "key=value", e.g., os.Environ().
+func NewRuntimeConfig(vertex *dfv1.Vertex, environ []string) RuntimeConfig {
+	limits := resolveLimits(vertex.Spec.Limits)
+	cfg := RuntimeConfig{
+		Pipeline:    vertex.Spec.PipelineName,
+		Vertex:      vertex.Spec.Name,
+		VertexType:  vertex.GetVertexType(),
+		ISBSvc:      resolveISBSvc(environ),
+		FromBuffers: vertex.OwnedBuffers(),
+		ToBuffers:   vertex.GetToBuffers(),
+		Limits: RuntimeLimits{
+			ReadBatchSize:        *limits.ReadBatchSize,
+			ReadTimeout:          limits.ReadTimeout.Duration.String(),
+			BufferMaxLength:      *limits.BufferMaxLength,
+			BufferUsageLimit:     *limits.BufferUsageLimit,
+			Concurrency:          *limits.ReadBatchSize,
+			FutureEventTimeBound: util.LookupEnvDurationOr(dfv1.EnvFutureEventTimeBound, dfv1.DefaultFutureEventTimeBound).String(),
+			GRPCMaxMessageSize:   util.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize),
+		},
+		Watermark: RuntimeWatermark{
+			Disabled: vertex.Spec.Watermark.Disabled,
+			MaxDelay: vertex.Spec.Watermark.GetMaxDelay().String(),
+		},
+		Features: resolveFeatures(vertex),
+		Env:      resolveEnv(vertex, environ),
+	}
+	if !vertex.Spec.Watermark.Disabled {
+		cfg.Watermark.FromBuckets = vertex.GetFromBuckets()
+		cfg.Watermark.ToBuckets = vertex.GetToBuckets()
+	}
+	if is := vertex.Spec.Watermark.IdleSource; is != nil {
+		cfg.Watermark.IdleSource = &RuntimeIdleSource{
+			Threshold:    is.GetThreshold().String(),
+			StepInterval: is.GetStepInterval().String(),
+			IncrementBy:  is.GetIncrementBy().String(),
+		}
+	}
+	for _, e := range vertex.Spec.ToEdges {
+		toLimits := resolveLimits(e.ToVertexLimits)
+		cfg.ToEdges = append(cfg.ToEdges, RuntimeEdge{
+			To:               e.To,
+			Partitions:       e.GetToVertexPartitionCount(),
+			OnFull:           e.BufferFullWritingStrategy(),
+			BufferMaxLength:  *toLimits.BufferMaxLength,
+			BufferUsageLimit: *toLimits.BufferUsageLimit,
+		})
+	}
+	return cfg
+}
+
+// resolveLimits returns a copy of the limits with the unset fields filled with the defaults.
+func resolveLimits(limits *dfv1.VertexLimits) dfv1.VertexLimits {
+	var l dfv1.VertexLimits
+	if limits != nil {
+		l = *limits.DeepCopy()
+	}
+	if l.ReadBatchSize == nil {
+		l.ReadBatchSize = ptr.To[uint64](dfv1.DefaultReadBatchSize)
+	}
+	if l.ReadTimeout == nil {
+		l.ReadTimeout = &metav1.Duration{Duration: dfv1.DefaultReadTimeout}
+	}
+	if l.BufferMaxLength == nil {
+		l.BufferMaxLength = ptr.To[uint64](dfv1.DefaultBufferLength)
+	}
+	if l.BufferUsageLimit == nil {
+		l.BufferUsageLimit = ptr.To[uint32](100 * dfv1.DefaultBufferUsageLimit)
+	}
+	return l
+}
+
+// resolveISBSvc returns the ISB service from the env vars of its connection.
+func resolveISBSvc(environ []string) RuntimeISBSvc {
+	envs := make(map[string]string)
+	for _, kv := range environ {
+		key, value, _ := strings.Cut(kv, "=")
+		envs[key] = value
+	}
+	switch {
+	case envs[dfv1.EnvISBSvcJetStreamURL] != "":
+		return RuntimeISBSvc{Type: dfv1.ISBSvcTypeJetStream, Target: redactURL(envs[dfv1.EnvISBSvcJetStreamURL])}
+	case envs[dfv1.EnvISBSvcRedisURL] != "":
+		return RuntimeISBSvc{Type: dfv1.ISBSvcTypeRedis, Target: redactURL(envs[dfv1.EnvISBSvcRedisURL])}
+	case envs[dfv1.EnvISBSvcRedisSentinelURL] != "":
+		return RuntimeISBSvc{Type: dfv1.ISBSvcTypeRedis, Target: redactURL(envs[dfv1.EnvISBSvcRedisSentinelURL])}
+	case envs[dfv1.EnvISBSvcKafkaBrokers] != "":
+		return RuntimeISBSvc{Type: dfv1.ISBSvcTypeKafka, Target: envs[dfv1.EnvISBSvcKafkaBrokers]}
+	}
+	return RuntimeISBSvc{}
+}
+
+// redactURL removes the user info from the URL, the whole URL is redacted if it has user info but can not be parsed.
+func redactURL(s string) string {
+	if !strings.Contains(s, "@") {
+		return s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return RedactedValue
+	}
+	u.User = nil
+	return u.String()
+}
+
+// resolveFeatures returns the optional features enabled on the vertex.
+func resolveFeatures(vertex *dfv1.Vertex) []string {
+	var features []string
+	for _, f := range []struct {
+		name    string
+		enabled bool
+	}{
+		{"udSource", vertex.IsUDSource()},
+		{"udTransformer", vertex.HasUDTransformer()},
+		{"udSink", vertex.IsUDSink()},
+		{"fallbackSink", vertex.HasFallbackUDSink()},
+		{"sideInputs", vertex.HasSideInputs()},
+		{"backfill", vertex.IsBackfilling()},
+	} {
+		if f.enabled {
+			features = append(features, f.name)
+		}
+	}
+	return features
+}
+
+// resolveEnv returns the NUMAFLOW_* env vars and the env vars defined in the container template of the vertex,
+// the values of the env vars referring to a secret or looking like a secret are redacted.
+func resolveEnv(vertex *dfv1.Vertex, environ []string) map[string]string {
+	userEnvs := make(map[string]bool)
+	secretEnvs := make(map[string]bool)
+	if ct := vertex.Spec.ContainerTemplate; ct != nil {
+		for _, e := range ct.Env {
+			userEnvs[e.Name] = true
+			if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil {
+				secretEnvs[e.Name] = true
+			}
+		}
+	}
+	result := make(map[string]string)
+	for _, kv := range environ {
+		key, value, _ := strings.Cut(kv, "=")
+		if excludedEnvs[key] || (!strings.HasPrefix(key, "NUMAFLOW_") && !userEnvs[key]) {
+			continue
+		}
+		if secretEnvs[key] || isSecretEnvName(key) {
+			value = RedactedValue
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func isSecretEnvName(name string) bool {
+	upper := strings.ToUpper(name)
+	for _, k := range secretEnvKeywords {
+		if strings.Contains(upper, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// runtimeConfigHandler serves the effective configuration of the vertex as JSON.
+func runtimeConfigHandler(vertex *dfv1.Vertex, environ func() []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, err := json.MarshalIndent(NewRuntimeConfig(vertex, environ()), "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(b)
+	}
+}
+
+// LogRuntimeConfig logs the effective configuration of the vertex at the startup, in a single line with stable field
+// names so that it can be parsed by the log based tooling. The secret values are already redacted in the config.
+func LogRuntimeConfig(logger *zap.SugaredLogger, cfg RuntimeConfig) {
+	logger.Infow("Vertex runtime config",
+		zap.String("vertexType", string(cfg.VertexType)),
+		zap.String("isbsvcType", string(cfg.ISBSvc.Type)),
+		zap.String("isbsvcTarget", cfg.ISBSvc.Target),
+		zap.Strings("fromBuffers", cfg.FromBuffers),
+		zap.Strings("toBuffers", cfg.ToBuffers),
+		zap.Bool("watermarkDisabled", cfg.Watermark.Disabled),
+		zap.String("watermarkMaxDelay", cfg.Watermark.MaxDelay),
+		zap.Strings("fromBuckets", cfg.Watermark.FromBuckets),
+		zap.Strings("toBuckets", cfg.Watermark.ToBuckets),
+		zap.Uint64("readBatchSize", cfg.Limits.ReadBatchSize),
+		zap.String("readTimeout", cfg.Limits.ReadTimeout),
+		zap.Uint64("concurrency", cfg.Limits.Concurrency),
+		zap.Uint64("bufferMaxLength", cfg.Limits.BufferMaxLength),
+		zap.Uint32("bufferUsageLimit", cfg.Limits.BufferUsageLimit),
+		zap.String("futureEventTimeBound", cfg.Limits.FutureEventTimeBound),
+		zap.Int("grpcMaxMessageSize", cfg.Limits.GRPCMaxMessageSize),
+		zap.Strings("features", cfg.Features),
+		zap.Any("env", cfg.Env),
+	)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package rater
 
 import (
+	"cmp"
 	"math"
 	"time"
 
@@ -33,29 +34,27 @@ const (
 
 // UpdateCount updates the count for a given timestamp in the queue.
 func UpdateCount(q *sharedqueue.OverflowQueue[*TimestampedCounts], time int64, podReadCounts *PodReadCount) {
-	items := q.Items()
-
-	// find the element matching the input timestamp and update it
-	for _, i := range items {
-		if i.timestamp == time {
-			i.Update(podReadCounts)
-			return
-		}
+	// the timestamps can arrive out of order, e.g. when the scrapes fall behind, the timestamped counts are kept sorted
+	// by the timestamps so that the rate is calculated between the right windows.
+	tc, ok := q.Upsert(func(tc *TimestampedCounts) int {
+		return cmp.Compare(tc.timestamp, time)
+	}, func() *TimestampedCounts {
+		return NewTimestampedCounts(time)
+	})
+	if !ok {
+		// the timestamp is older than all the timestamped counts kept
+		return
 	}
-
-	// if we cannot find a matching element, it means we need to add a new timestamped count to the queue
-	tc := NewTimestampedCounts(time)
 	tc.Update(podReadCounts)
-	q.Append(tc)
 }
 
 // CalculateRate calculates the rate of a MonoVertex for a given lookback period.
-func CalculateRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64) float64 {
+func CalculateRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSeconds int64, now time.Time) float64 {
 	counts := q.Items()
 	if len(counts) <= 1 {
 		return rateNotAvailable
 	}
-	startIndex := findStartIndex(lookbackSeconds, counts)
+	startIndex := findStartIndex(lookbackSeconds, counts, now)
 	// we consider the last but one element as the end index because the last element might be incomplete
 	// we can be sure that the last but one element in the queue is complete.
 	endIndex := len(counts) - 2
@@ -65,9 +64,9 @@ func CalculateRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSec
 
 	// time diff in seconds.
 	timeDiff := counts[endIndex].timestamp - counts[startIndex].timestamp
-	if timeDiff == 0 {
-		// if the time difference is 0, we return 0 to avoid division by 0
-		// this should not happen in practice because we are using a 10s interval
+	if timeDiff <= 0 {
+		// if the time difference is not positive, the rate is not available to avoid division by 0 or a negative
+		// rate, this should not happen in practice because the timestamped counts are sorted with a 10s interval
 		return rateNotAvailable
 	}
 
@@ -79,11 +78,11 @@ func CalculateRate(q *sharedqueue.OverflowQueue[*TimestampedCounts], lookbackSec
 	return delta / float64(timeDiff)
 }
 
-// findStartIndex finds the index of the first element in the queue that is within the lookback seconds
-func findStartIndex(lookbackSeconds int64, counts []*TimestampedCounts) int {
+// findStartIndex finds the index of the first element in the queue that is within the lookback seconds before now
+func findStartIndex(lookbackSeconds int64, counts []*TimestampedCounts, now time.Time) int {
 	n := len(counts)
-	now := time.Now().Truncate(CountWindow).Unix()
-	if n < 2 || now-counts[n-2].timestamp > lookbackSeconds {
+	nowSeconds := now.Truncate(CountWindow).Unix()
+	if n < 2 || nowSeconds-counts[n-2].timestamp > lookbackSeconds {
 		// if the second last element is already outside the lookback window, we return indexNotFound
 		return indexNotFound
 	}
@@ -91,7 +90,7 @@ func findStartIndex(lookbackSeconds int64, counts []*TimestampedCounts) int {
 	startIndex := n - 2
 	left := 0
 	right := n - 2
-	lastTimestamp := now - lookbackSeconds
+	lastTimestamp := nowSeconds - lookbackSeconds
 	for left <= right {
 		mid := left + (right-left)/2
 		if counts[mid].timestamp >= lastTimestamp {
@@ -109,20 +109,20 @@ func TestUpdateCount(t *testing.T) {
 func TestCalculateRate(t *testing.T) {
 	t.Run("givenCollectedTimeLessThanTwo_whenCalculateRate_thenReturnRateNotAvailable", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
+		now := time.Unix(TestTime, 0)
 		// no data
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10, now))
 
 		// only one data
-		now := time.Now()
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
 		tc1.Update(&PodReadCount{"pod1", 5.0})
 		q.Append(tc1)
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 10, now))
 	})
 
 	t.Run("singlePod_givenCountIncreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 20)
 		tc1.Update(&PodReadCount{"pod1", 5.0})
@@ -135,18 +135,18 @@ func TestCalculateRate(t *testing.T) {
 		q.Append(tc3)
 
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 0.5, CalculateRate(q, 25))
+		assert.Equal(t, 0.5, CalculateRate(q, 25, now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 0.5, CalculateRate(q, 100))
+		assert.Equal(t, 0.5, CalculateRate(q, 100, now))
 	})
 
 	t.Run("singlePod_givenCountDecreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
 		tc1.Update(&PodReadCount{"pod1", 200.0})
@@ -162,20 +162,20 @@ func TestCalculateRate(t *testing.T) {
 		q.Append(tc4)
 
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, now))
 		// tc2 and tc3 are used to calculate the rate
-		assert.Equal(t, 5.0, CalculateRate(q, 25))
+		assert.Equal(t, 5.0, CalculateRate(q, 25, now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 7.5, CalculateRate(q, 35))
+		assert.Equal(t, 7.5, CalculateRate(q, 35, now))
 		// tc1, 2 and 3 are used to calculate the rate
-		assert.Equal(t, 7.5, CalculateRate(q, 100))
+		assert.Equal(t, 7.5, CalculateRate(q, 100, now))
 	})
 
 	t.Run("multiplePods_givenCountIncreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
 		tc1.Update(&PodReadCount{"pod1", 50.0})
@@ -191,18 +191,18 @@ func TestCalculateRate(t *testing.T) {
 		q.Append(tc3)
 
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25, now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 15.0, CalculateRate(q, 35))
+		assert.Equal(t, 15.0, CalculateRate(q, 35, now))
 	})
 
 	t.Run("multiplePods_givenCountDecreases_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
 		tc1.Update(&PodReadCount{"pod1", 200.0})
@@ -218,18 +218,18 @@ func TestCalculateRate(t *testing.T) {
 		q.Append(tc3)
 
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25, now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 30.0, CalculateRate(q, 35))
+		assert.Equal(t, 30.0, CalculateRate(q, 35, now))
 	})
 
 	t.Run("multiplePods_givenOnePodRestarts_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(CountWindow).Unix() - 30)
 		tc1.Update(&PodReadCount{"pod1", 50.0})
@@ -245,18 +245,18 @@ func TestCalculateRate(t *testing.T) {
 		q.Append(tc3)
 
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, now))
 		// no enough data collected within lookback seconds, expect rate 0
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 25, now))
 		// tc1 and tc2 are used to calculate the rate
-		assert.Equal(t, 25.0, CalculateRate(q, 35))
+		assert.Equal(t, 25.0, CalculateRate(q, 35, now))
 	})
 
 	t.Run("multiplePods_givenPodsComeAndGo_whenCalculateRate_thenReturnRate", func(t *testing.T) {
 		q := sharedqueue.New[*TimestampedCounts](1800)
-		now := time.Now()
+		now := time.Unix(TestTime, 0)
 
 		tc1 := NewTimestampedCounts(now.Truncate(time.Second*10).Unix() - 30)
 		tc1.Update(&PodReadCount{"pod1", 200.0})
@@ -280,11 +280,11 @@ func TestCalculateRate(t *testing.T) {
 		q.Append(tc4)
 
 		// vertex rate
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5))
-		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15))
-		assert.Equal(t, 25.0, CalculateRate(q, 25))
-		assert.Equal(t, 23.0, CalculateRate(q, 35))
-		assert.Equal(t, 23.0, CalculateRate(q, 100))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 5, now))
+		assert.Equal(t, rateNotAvailable, CalculateRate(q, 15, now))
+		assert.Equal(t, 25.0, CalculateRate(q, 25, now))
+		assert.Equal(t, 23.0, CalculateRate(q, 35, now))
+		assert.Equal(t, 23.0, CalculateRate(q, 100, now))
 	})
 }
 
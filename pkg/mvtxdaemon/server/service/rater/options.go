@@ -16,11 +16,17 @@ limitations under the License.
 
 package rater
 
+import (
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
 type options struct {
 	// Number of workers working on collecting counts of processed messages.
 	workers int
 	// Time in milliseconds, each element in the work queue will be picked up in an interval of this period of time.
 	taskInterval int
+	// clock is used to timestamp the counts, calculate the rates and pace the workers.
+	clock clock.Clock
 }
 
 type Option func(*options)
@@ -37,6 +43,7 @@ func defaultOptions() *options {
 		// if a CountWindow misses one pod, when calculating the delta with the next window, for that specific pod,
 		// we will count the total processed count as delta, which is wrong and eventually leads to incorrect high processing rate.
 		taskInterval: int(CountWindow.Milliseconds() / 2),
+		clock:        clock.RealClock(),
 	}
 }
 
@@ -51,3 +58,10 @@ func WithTaskInterval(n int) Option {
 		o.taskInterval = n
 	}
 }
+
+// WithClock sets the clock of the rater, it is meant for the tests.
+func WithClock(c clock.Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
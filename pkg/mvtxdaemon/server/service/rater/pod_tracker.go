@@ -28,6 +28,7 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/shared/util"
 )
@@ -45,6 +46,7 @@ type PodTracker struct {
 	httpClient      metricsHttpClient
 	activePods      *util.UniqueStringList
 	refreshInterval time.Duration
+	clock           clock.Clock
 }
 type PodTrackerOption func(*PodTracker)
 
@@ -60,6 +62,7 @@ func NewPodTracker(ctx context.Context, mv *v1alpha1.MonoVertex, opts ...PodTrac
 		},
 		activePods:      util.NewUniqueStringList(),
 		refreshInterval: 30 * time.Second, // Default refresh interval for updating the active pod set
+		clock:           clock.RealClock(),
 	}
 
 	for _, opt := range opts {
@@ -77,6 +80,13 @@ func WithRefreshInterval(d time.Duration) PodTrackerOption {
 	}
 }
 
+// WithPodTrackerClock sets the clock driving the refreshes of the active pod set.
+func WithPodTrackerClock(c clock.Clock) PodTrackerOption {
+	return func(r *PodTracker) {
+		r.clock = c
+	}
+}
+
 func (pt *PodTracker) Start(ctx context.Context) error {
 	pt.log.Debugf("Starting tracking active pods for MonoVertex %s...", pt.monoVertex.Name)
 	go pt.trackActivePods(ctx)
@@ -84,14 +94,14 @@ func (pt *PodTracker) Start(ctx context.Context) error {
 }
 
 func (pt *PodTracker) trackActivePods(ctx context.Context) {
-	ticker := time.NewTicker(pt.refreshInterval)
+	ticker := pt.clock.NewTicker(pt.refreshInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			pt.log.Infof("Context is cancelled. Stopping tracking active pods for MonoVertex %s...", pt.monoVertex.Name)
 			return
-		case <-ticker.C:
+		case <-ticker.C():
 			pt.updateActivePods()
 		}
 	}
@@ -33,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 )
 
 type trackerMockHttpClient struct {
@@ -77,7 +78,8 @@ func TestPodTracker_Start(t *testing.T) {
 			Scale: v1alpha1.Scale{LookbackSeconds: &lookBackSeconds},
 		},
 	}
-	tracker := NewPodTracker(ctx, pipeline, WithRefreshInterval(time.Second))
+	fakeClock := clock.NewFakeClock(time.Unix(TestTime, 0))
+	tracker := NewPodTracker(ctx, pipeline, WithRefreshInterval(time.Second), WithPodTrackerClock(fakeClock))
 	tracker.httpClient = &trackerMockHttpClient{
 		podsCount: 10,
 		lock:      &sync.RWMutex{},
@@ -91,25 +93,18 @@ func TestPodTracker_Start(t *testing.T) {
 		}
 	}()
 
-	for tracker.GetActivePodsCount() != 10 {
-		select {
-		case <-ctx.Done():
-			t.Fatalf("incorrect active pods %v", ctx.Err())
-		default:
-			time.Sleep(100 * time.Millisecond)
-		}
-	}
+	// every step triggers a refresh of the active pods.
+	assert.Eventually(t, func() bool {
+		fakeClock.Step(time.Second)
+		return tracker.GetActivePodsCount() == 10
+	}, 10*time.Second, 10*time.Millisecond, "incorrect active pods")
 
 	tracker.httpClient.(*trackerMockHttpClient).setPodsCount(5)
 
-	for tracker.GetActivePodsCount() != 5 {
-		select {
-		case <-ctx.Done():
-			t.Fatalf("incorrect active pods %v", ctx.Err())
-		default:
-			time.Sleep(100 * time.Millisecond)
-		}
-	}
+	assert.Eventually(t, func() bool {
+		fakeClock.Step(time.Second)
+		return tracker.GetActivePodsCount() == 5
+	}, 10*time.Second, 10*time.Millisecond, "incorrect active pods")
 	cancel()
 	wg.Wait()
 
@@ -31,6 +31,7 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
 )
@@ -109,7 +110,6 @@ func NewRater(ctx context.Context, mv *v1alpha1.MonoVertex, opts ...Option) *Rat
 		// load the default lookback value from the spec
 		lookBackSeconds: atomic.NewFloat64(float64(mv.Spec.Scale.GetLookbackSeconds())),
 	}
-	rater.podTracker = NewPodTracker(ctx, mv)
 	// maintain the total counts of the last 30 minutes(1800 seconds) since we support 1m, 5m, 15m lookback seconds.
 	rater.timestampedPodCounts = sharedqueue.New[*TimestampedCounts](int(1800 / CountWindow.Seconds()))
 
@@ -118,6 +118,7 @@ func NewRater(ctx context.Context, mv *v1alpha1.MonoVertex, opts ...Option) *Rat
 			opt(rater.options)
 		}
 	}
+	rater.podTracker = NewPodTracker(ctx, mv, WithPodTrackerClock(rater.options.clock))
 	// initialise the metric value for the lookback window
 	metrics.MonoVertexLookBackSecs.WithLabelValues(mv.Name).Set(rater.lookBackSeconds.Load())
 	return &rater
@@ -158,7 +159,7 @@ func (r *Rater) monitorOnePod(ctx context.Context, key string, worker int) error
 		log.Debugf("Pod %s does not exist, updating it with nil...", pInfo.podName)
 		podReadCount = nil
 	}
-	now := time.Now().Add(CountWindow).Truncate(CountWindow).Unix()
+	now := r.options.clock.Now().Add(CountWindow).Truncate(CountWindow).Unix()
 	UpdateCount(r.timestampedPodCounts, now, podReadCount)
 	return nil
 }
@@ -204,9 +205,10 @@ func (r *Rater) getPodReadCounts(podName string) *PodReadCount {
 func (r *Rater) GetRates() map[string]*wrapperspb.DoubleValue {
 	r.log.Debugf("Current timestampedPodCounts for MonoVertex %s is: %v", r.monoVertex.Name, r.timestampedPodCounts)
 	var result = make(map[string]*wrapperspb.DoubleValue)
+	now := r.options.clock.Now()
 	// calculate rates for each lookback seconds
 	for n, i := range r.buildLookbackSecondsMap() {
-		rate := CalculateRate(r.timestampedPodCounts, i)
+		rate := CalculateRate(r.timestampedPodCounts, i, now)
 		result[n] = wrapperspb.Double(rate)
 	}
 	r.log.Debugf("Got rates for MonoVertex %s: %v", r.monoVertex.Name, result)
@@ -264,7 +266,7 @@ func (r *Rater) Start(ctx context.Context) error {
 		default:
 			assign()
 			// Make sure each of the key will be assigned at least every taskInterval milliseconds.
-			sleep(ctx, time.Millisecond*time.Duration(func() int {
+			sleep(ctx, r.options.clock, time.Millisecond*time.Duration(func() int {
 				l := r.podTracker.GetActivePodsCount()
 				if l == 0 {
 					return r.options.taskInterval
@@ -281,20 +283,20 @@ func (r *Rater) Start(ctx context.Context) error {
 
 // sleep function uses a select statement to check if the context is canceled before sleeping for the given duration
 // it helps ensure the sleep will be released when the context is canceled, allowing the goroutine to exit gracefully
-func sleep(ctx context.Context, duration time.Duration) {
+func sleep(ctx context.Context, c clock.Clock, duration time.Duration) {
 	select {
 	case <-ctx.Done():
-	case <-time.After(duration):
+	case <-c.After(duration):
 	}
 }
 
 func (r *Rater) startDynamicLookBack(ctx context.Context) {
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := r.options.clock.NewTicker(30 * time.Second)
 	// Ensure the ticker is stopped to prevent a resource leak.
 	defer ticker.Stop()
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			r.updateDynamicLookbackSecs()
 		case <-ctx.Done():
 			// If the context is canceled or expires exit
@@ -313,7 +315,7 @@ func (r *Rater) updateDynamicLookbackSecs() {
 	// We will calculate the processing time for a time window = 3 * currentLookback
 	// This ensures that we have enough data to capture one complete processing
 	currentLookback := r.lookBackSeconds.Load()
-	startIndex := findStartIndex(3*int64(currentLookback), counts)
+	startIndex := findStartIndex(3*int64(currentLookback), counts, r.options.clock.Now())
 	// we consider the last but one element as the end index because the last element might be incomplete
 	// we can be sure that the last but one element in the queue is complete.
 	endIndex := len(counts) - 2
@@ -26,10 +26,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 )
 
 type raterMockHttpClient struct {
@@ -100,35 +102,21 @@ func TestRater_Start(t *testing.T) {
 			Scale: v1alpha1.Scale{LookbackSeconds: &lookBackSeconds},
 		},
 	}
-	r := NewRater(ctx, pipeline, WithTaskInterval(1000))
-	podTracker := NewPodTracker(ctx, pipeline, WithRefreshInterval(time.Second*1))
+	fakeClock := clock.NewFakeClock(time.Unix(TestTime, 0))
+	r := NewRater(ctx, pipeline, WithTaskInterval(1000), WithClock(fakeClock))
+	podTracker := NewPodTracker(ctx, pipeline, WithRefreshInterval(time.Second*1), WithPodTrackerClock(fakeClock))
 	podTracker.httpClient = &raterMockHttpClient{podOneCount: 0, podTwoCount: 0, lock: &sync.RWMutex{}}
 	r.httpClient = &raterMockHttpClient{podOneCount: 0, podTwoCount: 0, lock: &sync.RWMutex{}}
 	r.podTracker = podTracker
 
-	timer := time.NewTimer(60 * time.Second)
-	succeedChan := make(chan struct{})
 	go func() {
 		if err := r.Start(ctx); err != nil {
 			log.Fatalf("failed to start rater: %v", err)
 		}
 	}()
-	go func() {
-		for {
-			if r.GetRates()["default"].GetValue() <= 0 {
-				time.Sleep(time.Second)
-			} else {
-				succeedChan <- struct{}{}
-				break
-			}
-		}
-	}()
-	select {
-	case <-succeedChan:
-		time.Sleep(time.Second)
-		break
-	case <-timer.C:
-		t.Fatalf("timed out waiting for rate to be calculated")
-	}
-	timer.Stop()
+	// move the clock forward until the counts of enough windows are collected to calculate the rate.
+	assert.Eventually(t, func() bool {
+		fakeClock.Step(100 * time.Millisecond)
+		return r.GetRates()["default"].GetValue() > 0
+	}, 20*time.Second, time.Millisecond, "timed out waiting for rate to be calculated")
 }
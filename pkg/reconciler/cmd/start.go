@@ -25,9 +25,11 @@ import (
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -184,7 +186,7 @@ func Start(namespaced bool, managedNamespace string) {
 
 	// Pipeline controller
 	pipelineController, err := controller.New(dfv1.ControllerPipeline, mgr, controller.Options{
-		Reconciler: plctrl.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, image, logger, mgr.GetEventRecorderFor(dfv1.ControllerPipeline)),
+		Reconciler: plctrl.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, image, namespaced, logger, mgr.GetEventRecorderFor(dfv1.ControllerPipeline)),
 	})
 	if err != nil {
 		logger.Fatalw("Unable to set up Pipeline controller", zap.Error(err))
@@ -195,6 +197,8 @@ func Start(namespaced bool, managedNamespace string) {
 		predicate.Or[*dfv1.Pipeline](
 			predicate.TypedGenerationChangedPredicate[*dfv1.Pipeline]{},
 			predicate.TypedLabelChangedPredicate[*dfv1.Pipeline]{},
+			backfillChangedPredicate[*dfv1.Pipeline](),
+			storageQuotaPausedChangedPredicate(),
 		))); err != nil {
 		logger.Fatalw("Unable to watch Pipelines", zap.Error(err))
 	}
@@ -220,6 +224,13 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("Unable to watch Deployments", zap.Error(err))
 	}
 
+	// Watch RoleBindings changes
+	if err := pipelineController.Watch(source.Kind(mgr.GetCache(), &rbacv1.RoleBinding{},
+		handler.TypedEnqueueRequestForOwner[*rbacv1.RoleBinding](mgr.GetScheme(), mgr.GetRESTMapper(), &dfv1.Pipeline{}, handler.OnlyControllerOwner()),
+		predicate.TypedResourceVersionChangedPredicate[*rbacv1.RoleBinding]{})); err != nil {
+		logger.Fatalw("Unable to watch RoleBindings", zap.Error(err))
+	}
+
 	// Vertex controller
 	autoscaler := scaling.NewScaler(mgr.GetClient(), scaling.WithWorkers(20))
 	vertexController, err := controller.New(dfv1.ControllerVertex, mgr, controller.Options{
@@ -234,6 +245,8 @@ func Start(namespaced bool, managedNamespace string) {
 		predicate.Or[*dfv1.Vertex](
 			predicate.TypedGenerationChangedPredicate[*dfv1.Vertex]{},
 			predicate.TypedLabelChangedPredicate[*dfv1.Vertex]{},
+			backfillChangedPredicate[*dfv1.Vertex](),
+			storageQuotaPausedAnnotationChangedPredicate(),
 		))); err != nil {
 		logger.Fatalw("Unable to watch Vertices", zap.Error(err))
 	}
@@ -315,6 +328,45 @@ func Start(namespaced bool, managedNamespace string) {
 	}
 }
 
+// backfillChangedPredicate passes the update events which switch the backfill mode annotation on or off,
+// the backfill mode is toggled without a spec change, so it is not caught by the generation predicate.
+func backfillChangedPredicate[T client.Object]() predicate.TypedPredicate[T] {
+	return predicate.TypedFuncs[T]{
+		CreateFunc:  func(event.TypedCreateEvent[T]) bool { return false },
+		DeleteFunc:  func(event.TypedDeleteEvent[T]) bool { return false },
+		GenericFunc: func(event.TypedGenericEvent[T]) bool { return false },
+		UpdateFunc: func(e event.TypedUpdateEvent[T]) bool {
+			return e.ObjectOld.GetAnnotations()[dfv1.KeyBackfill] != e.ObjectNew.GetAnnotations()[dfv1.KeyBackfill]
+		},
+	}
+}
+
+// storageQuotaPausedChangedPredicate passes the update events of the pipelines which switch the pause of the sources
+// required by the storage quota on or off, it's set by the daemon in the status.
+func storageQuotaPausedChangedPredicate() predicate.TypedPredicate[*dfv1.Pipeline] {
+	return predicate.TypedFuncs[*dfv1.Pipeline]{
+		CreateFunc:  func(event.TypedCreateEvent[*dfv1.Pipeline]) bool { return false },
+		DeleteFunc:  func(event.TypedDeleteEvent[*dfv1.Pipeline]) bool { return false },
+		GenericFunc: func(event.TypedGenericEvent[*dfv1.Pipeline]) bool { return false },
+		UpdateFunc: func(e event.TypedUpdateEvent[*dfv1.Pipeline]) bool {
+			return e.ObjectOld.IsSourcesPausedByStorageQuota() != e.ObjectNew.IsSourcesPausedByStorageQuota()
+		},
+	}
+}
+
+// storageQuotaPausedAnnotationChangedPredicate passes the update events of the vertices which switch the storage quota
+// pause annotation on or off.
+func storageQuotaPausedAnnotationChangedPredicate() predicate.TypedPredicate[*dfv1.Vertex] {
+	return predicate.TypedFuncs[*dfv1.Vertex]{
+		CreateFunc:  func(event.TypedCreateEvent[*dfv1.Vertex]) bool { return false },
+		DeleteFunc:  func(event.TypedDeleteEvent[*dfv1.Vertex]) bool { return false },
+		GenericFunc: func(event.TypedGenericEvent[*dfv1.Vertex]) bool { return false },
+		UpdateFunc: func(e event.TypedUpdateEvent[*dfv1.Vertex]) bool {
+			return e.ObjectOld.IsPausedByStorageQuota() != e.ObjectNew.IsPausedByStorageQuota()
+		},
+	}
+}
+
 // LeaderElectionRunner is used to convert a function to be able to run as a LeaderElectionRunnable.
 type LeaderElectionRunner func(ctx context.Context) error
 
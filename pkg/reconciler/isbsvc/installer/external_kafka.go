@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installer
+
+import (
+	"context"
+	"fmt"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"go.uber.org/zap"
+)
+
+type externalKafkaInstaller struct {
+	isbSvc *dfv1.InterStepBufferService
+	logger *zap.SugaredLogger
+}
+
+func NewExternalKafkaInstaller(isbSvc *dfv1.InterStepBufferService, logger *zap.SugaredLogger) Installer {
+	return &externalKafkaInstaller{
+		isbSvc: isbSvc,
+		logger: logger.With("isbsvc", isbSvc.Name),
+	}
+}
+
+func (eki *externalKafkaInstaller) Install(ctx context.Context) (*dfv1.BufferServiceConfig, error) {
+	if eki.isbSvc.Spec.Kafka == nil || eki.isbSvc.Spec.Kafka.External == nil {
+		return nil, fmt.Errorf("invalid InterStepBufferService spec, no external config")
+	}
+	eki.isbSvc.Status.SetType(dfv1.ISBSvcTypeKafka)
+	eki.isbSvc.Status.MarkConfigured()
+	eki.isbSvc.Status.MarkDeployed()
+	eki.logger.Info("Using external kafka config")
+	return &dfv1.BufferServiceConfig{Kafka: eki.isbSvc.Spec.Kafka.External}, nil
+}
+
+func (eki *externalKafkaInstaller) Uninstall(ctx context.Context) error {
+	eki.logger.Info("Nothing to uninstall")
+	return nil
+}
+
+func (eki *externalKafkaInstaller) CheckChildrenResourceStatus(ctx context.Context) error {
+	return nil
+}
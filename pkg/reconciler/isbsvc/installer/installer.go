@@ -78,6 +78,9 @@ func getInstaller(isbSvc *dfv1.InterStepBufferService, client client.Client, kub
 	} else if js := isbSvc.Spec.JetStream; js != nil {
 		labels[dfv1.KeyISBSvcType] = string(dfv1.ISBSvcTypeJetStream)
 		return NewJetStreamInstaller(client, kubeClient, isbSvc, config, labels, logger, recorder), nil
+	} else if kafka := isbSvc.Spec.Kafka; kafka != nil && kafka.External != nil {
+		labels[dfv1.KeyISBSvcType] = string(dfv1.ISBSvcTypeKafka)
+		return NewExternalKafkaInstaller(isbSvc, logger), nil
 	}
 	return nil, fmt.Errorf("invalid isb service spec")
 }
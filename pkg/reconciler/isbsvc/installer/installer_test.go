@@ -89,6 +89,20 @@ var (
 		},
 	}
 
+	testExternalKafkaIsbSvc = &dfv1.InterStepBufferService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testISBSName,
+		},
+		Spec: dfv1.InterStepBufferServiceSpec{
+			Kafka: &dfv1.KafkaBufferService{
+				External: &dfv1.KafkaConfig{
+					Brokers: []string{"broker-0:9092"},
+				},
+			},
+		},
+	}
+
 	fakeGlobalISBSvcConfig = &reconciler.ISBSvcConfig{
 		Redis: &reconciler.RedisConfig{
 			Versions: []reconciler.RedisVersion{
@@ -147,6 +161,14 @@ func TestGetInstaller(t *testing.T) {
 		assert.True(t, ok)
 	})
 
+	t.Run("get external kafka installer", func(t *testing.T) {
+		installer, err := getInstaller(testExternalKafkaIsbSvc, nil, nil, fakeConfig, zaptest.NewLogger(t).Sugar(), nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, installer)
+		_, ok := installer.(*externalKafkaInstaller)
+		assert.True(t, ok)
+	})
+
 	t.Run("test error", func(t *testing.T) {
 		testObj := testNativeRedisIsbSvc.DeepCopy()
 		testObj.Spec.Redis = nil
@@ -130,6 +130,17 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*dfv1.BufferServiceCo
 		return nil, err
 	}
 	r.isbSvc.Status.MarkDeployed()
+	var tlsConfig *dfv1.TLS
+	if r.isbSvc.Spec.JetStream.TLS {
+		tlsConfig = &dfv1.TLS{
+			CACertSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: generateJetStreamClientAuthSecretName(r.isbSvc),
+				},
+				Key: dfv1.JetStreamClientCACertKey,
+			},
+		}
+	}
 	reconciler.JetStreamISBSvcReplicas.WithLabelValues(r.isbSvc.Namespace, r.isbSvc.Name).Set(float64(r.isbSvc.Spec.JetStream.GetReplicas()))
 	return &dfv1.BufferServiceConfig{
 		JetStream: &dfv1.JetStreamConfig{
@@ -150,8 +161,10 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*dfv1.BufferServiceCo
 					},
 				},
 			},
-			StreamConfig: string(b),
-			TLSEnabled:   r.isbSvc.Spec.JetStream.TLS,
+			StreamConfig:  string(b),
+			TLSEnabled:    r.isbSvc.Spec.JetStream.TLS,
+			TLS:           tlsConfig,
+			PipelineQuota: r.isbSvc.Spec.JetStream.PipelineQuota.DeepCopy(),
 		},
 	}, nil
 }
@@ -288,7 +301,15 @@ func (r *jetStreamInstaller) createSecrets(ctx context.Context) error {
 		}
 	}
 
-	if oldClientObjExisting && oldServerObjExisting { // Both existing, do nothing
+	if oldClientObjExisting && oldServerObjExisting { // Both existing, only add the CA cert for the clients if missing
+		if _, ok := oldCObj.Data[dfv1.JetStreamClientCACertKey]; ok || len(oldSObj.Data[dfv1.JetStreamServerCACertKey]) == 0 {
+			return nil
+		}
+		oldCObj.Data[dfv1.JetStreamClientCACertKey] = oldSObj.Data[dfv1.JetStreamServerCACertKey]
+		if _, err := r.kubeClient.CoreV1().Secrets(r.isbSvc.Namespace).Update(ctx, oldCObj, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to add the CA cert to nats client auth secret, err: %w", err)
+		}
+		r.logger.Infow("Added the CA cert to nats client auth secret successfully")
 		return nil
 	}
 
@@ -391,6 +412,7 @@ func (r *jetStreamInstaller) createSecrets(ctx context.Context) error {
 		Data: map[string][]byte{
 			dfv1.JetStreamClientAuthSecretUserKey:     []byte(jsUser),
 			dfv1.JetStreamClientAuthSecretPasswordKey: []byte(jsPass),
+			dfv1.JetStreamClientCACertKey:             caCertPEM,
 		},
 	}
 
@@ -26,10 +26,12 @@ import (
 	"go.uber.org/zap/zaptest"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -132,9 +134,10 @@ func TestJetStreamCreateObjects(t *testing.T) {
 		s = &corev1.Secret{}
 		err = cl.Get(ctx, types.NamespacedName{Namespace: testObj.Namespace, Name: generateJetStreamClientAuthSecretName(testObj)}, s)
 		assert.NoError(t, err)
-		assert.Equal(t, 2, len(s.Data))
+		assert.Equal(t, 3, len(s.Data))
 		assert.Contains(t, s.Data, dfv1.JetStreamClientAuthSecretUserKey)
 		assert.Contains(t, s.Data, dfv1.JetStreamClientAuthSecretPasswordKey)
+		assert.Contains(t, s.Data, dfv1.JetStreamClientCACertKey)
 	})
 
 	t.Run("test create configmap", func(t *testing.T) {
@@ -175,6 +178,8 @@ func Test_JetStreamInstall_Uninstall(t *testing.T) {
 		assert.True(t, testJetStreamIsbSvc.Status.IsReady())
 		assert.True(t, testJetStreamIsbSvc.Status.IsHealthy())
 		assert.False(t, c.JetStream.TLSEnabled)
+		assert.Nil(t, c.JetStream.TLS)
+		assert.Nil(t, c.JetStream.PipelineQuota)
 		events := getEvents(i.recorder)
 		assert.Contains(t, events, "Normal JetStreamConfigMap Created jetstream configmap successfully", "Normal JetStreamServiceSuccess Created jetstream service successfully", "Normal JetStreamStatefulSetSuccess Created jetstream stateful successfully")
 		svc := &corev1.Service{}
@@ -187,6 +192,46 @@ func Test_JetStreamInstall_Uninstall(t *testing.T) {
 		assert.Equal(t, 3, len(sts.Spec.Template.Spec.Containers))
 	})
 
+	t.Run("test install with tls", func(t *testing.T) {
+		testObj := testJetStreamIsbSvc.DeepCopy()
+		testObj.Spec.JetStream.TLS = true
+		ti := &jetStreamInstaller{
+			client:     fake.NewClientBuilder().Build(),
+			kubeClient: k8sfake.NewSimpleClientset(),
+			isbSvc:     testObj,
+			config:     reconciler.FakeGlobalConfig(t, fakeGlobalISBSvcConfig),
+			labels:     testLabels,
+			logger:     zaptest.NewLogger(t).Sugar(),
+			recorder:   record.NewFakeRecorder(64),
+		}
+		c, err := ti.Install(ctx)
+		assert.NoError(t, err)
+		assert.True(t, c.JetStream.TLSEnabled)
+		assert.NotNil(t, c.JetStream.TLS)
+		assert.Equal(t, generateJetStreamClientAuthSecretName(testObj), c.JetStream.TLS.CACertSecret.Name)
+		assert.Equal(t, dfv1.JetStreamClientCACertKey, c.JetStream.TLS.CACertSecret.Key)
+	})
+
+	t.Run("test install with pipeline quota", func(t *testing.T) {
+		testObj := testJetStreamIsbSvc.DeepCopy()
+		maxBytes := apiresource.MustParse("10Gi")
+		testObj.Spec.JetStream.PipelineQuota = &dfv1.ISBPipelineQuota{MaxBytes: &maxBytes, MaxStreams: ptr.To[int32](20)}
+		ti := &jetStreamInstaller{
+			client:     fake.NewClientBuilder().Build(),
+			kubeClient: k8sfake.NewSimpleClientset(),
+			isbSvc:     testObj,
+			config:     reconciler.FakeGlobalConfig(t, fakeGlobalISBSvcConfig),
+			labels:     testLabels,
+			logger:     zaptest.NewLogger(t).Sugar(),
+			recorder:   record.NewFakeRecorder(64),
+		}
+		c, err := ti.Install(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, c.JetStream.PipelineQuota)
+		assert.Equal(t, int64(10*1024*1024*1024), c.JetStream.PipelineQuota.GetMaxBytes())
+		assert.Equal(t, 20, c.JetStream.PipelineQuota.GetMaxStreams())
+	})
+
 	t.Run("test uninstall", func(t *testing.T) {
 		err := i.Uninstall(ctx)
 		assert.NoError(t, err)
@@ -28,6 +28,7 @@ import (
 	appv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -68,10 +69,13 @@ type pipelineReconciler struct {
 	image    string
 	logger   *zap.SugaredLogger
 	recorder record.EventRecorder
+	// namespaced is whether the controller is installed in namespaced scope, the daemon pods are then bound to the
+	// daemon Role instead of the ClusterRole.
+	namespaced bool
 }
 
-func NewReconciler(client client.Client, scheme *runtime.Scheme, config *reconciler.GlobalConfig, image string, logger *zap.SugaredLogger, recorder record.EventRecorder) reconcile.Reconciler {
-	return &pipelineReconciler{client: client, scheme: scheme, config: config, image: image, logger: logger, recorder: recorder}
+func NewReconciler(client client.Client, scheme *runtime.Scheme, config *reconciler.GlobalConfig, image string, namespaced bool, logger *zap.SugaredLogger, recorder record.EventRecorder) reconcile.Reconciler {
+	return &pipelineReconciler{client: client, scheme: scheme, config: config, image: image, namespaced: namespaced, logger: logger, recorder: recorder}
 }
 
 func (r *pipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -314,6 +318,12 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 		args := []string{fmt.Sprintf("--buffers=%s", strings.Join(bfs, ",")), fmt.Sprintf("--buckets=%s", strings.Join(bks, ","))}
 		args = append(args, fmt.Sprintf("--side-inputs-store=%s", pl.GetSideInputsStoreName()))
 		args = append(args, fmt.Sprintf("--serving-source-streams=%s", strings.Join(pl.GetServingSourceStreamNames(), ",")))
+		if bufferConfigs := pl.GetBufferConfigsArg(); bufferConfigs != "" {
+			args = append(args, fmt.Sprintf("--buffer-configs=%s", bufferConfigs))
+		}
+		if bufferRetentions := pl.GetBufferRetentionsArg(); bufferRetentions != "" {
+			args = append(args, fmt.Sprintf("--buffer-retentions=%s", bufferRetentions))
+		}
 		batchJob := buildISBBatchJob(pl, r.image, isbSvc.Status.Config, "isbsvc-create", args, "cre")
 		if err := r.client.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
 			r.recorder.Eventf(pl, corev1.EventTypeWarning, "CreateJobForISBCeationFailed", "Failed to create a Job: %w", err.Error())
@@ -342,7 +352,7 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 		r.recorder.Eventf(pl, corev1.EventTypeNormal, "CreateJobForISBDeletionSuccessful", "Create ISB deletion job successfully")
 	}
 
-	newObjs := buildVertices(pl)
+	newObjs := BuildVertices(pl)
 	for vertexName, newObj := range newObjs {
 		if oldObj, existing := existingObjs[vertexName]; !existing {
 			if err := r.client.Create(ctx, &newObj); err != nil {
@@ -371,12 +381,20 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 					originalReplicas = newObj.Spec.Scale.GetMaxReplicas()
 				}
 				oldObj.Annotations[dfv1.KeyHash] = newObj.GetAnnotations()[dfv1.KeyHash]
+				copyReloadableAnnotations(&newObj, &oldObj)
 				if err := r.client.Update(ctx, &oldObj); err != nil {
 					r.recorder.Eventf(pl, corev1.EventTypeWarning, "UpdateVertexFailed", "Failed to update vertex: %w", err.Error())
 					return fmt.Errorf("failed to update vertex, err: %w", err)
 				}
 				log.Infow("Updated vertex successfully", zap.String("vertex", vertexName))
 				r.recorder.Eventf(pl, corev1.EventTypeNormal, "UpdateVertexSuccess", "Updated vertex %s successfully", vertexName)
+			} else if oldObj.IsBackfilling() != newObj.IsBackfilling() || oldObj.IsPausedByStorageQuota() != newObj.IsPausedByStorageQuota() {
+				copyReloadableAnnotations(&newObj, &oldObj)
+				if err := r.client.Update(ctx, &oldObj); err != nil {
+					r.recorder.Eventf(pl, corev1.EventTypeWarning, "UpdateVertexFailed", "Failed to update vertex: %w", err.Error())
+					return fmt.Errorf("failed to update vertex, err: %w", err)
+				}
+				log.Infow("Updated the reloadable annotations of vertex successfully", zap.String("vertex", vertexName), zap.Bool("backfill", newObj.IsBackfilling()), zap.Bool("storageQuotaPaused", newObj.IsPausedByStorageQuota()))
 			}
 			delete(existingObjs, vertexName)
 		}
@@ -399,6 +417,10 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 	if err := r.createOrUpdateDaemonService(ctx, pl); err != nil {
 		return err
 	}
+	// Daemon role binding
+	if err := r.createOrUpdateDaemonRoleBinding(ctx, pl); err != nil {
+		return err
+	}
 	// Daemon deployment
 	if err := r.createOrUpdateDaemonDeployment(ctx, pl, isbSvc.Status.Config); err != nil {
 		return err
@@ -444,10 +466,53 @@ func (r *pipelineReconciler) createOrUpdateDaemonService(ctx context.Context, pl
 	return nil
 }
 
+// createOrUpdateDaemonRoleBinding binds the service account of the daemon pods to the daemon role, which grants the
+// permissions to elect a leader among the replicas, record the events and update the status of the pipeline.
+func (r *pipelineReconciler) createOrUpdateDaemonRoleBinding(ctx context.Context, pl *dfv1.Pipeline) error {
+	log := logging.FromContext(ctx)
+	rb := pl.GetDaemonRoleBindingObj(r.namespaced)
+	rbHash := sharedutil.MustHash(struct {
+		Subjects []rbacv1.Subject
+		RoleRef  rbacv1.RoleRef
+	}{rb.Subjects, rb.RoleRef})
+	rb.Annotations = map[string]string{dfv1.KeyHash: rbHash}
+	existingRB := &rbacv1.RoleBinding{}
+	needToCreate := false
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: pl.Namespace, Name: rb.Name}, existingRB); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Errorw("Failed to find existing daemon role binding", zap.String("roleBinding", rb.Name), zap.Error(err))
+			pl.Status.MarkDeployFailed("FindDaemonRoleBindingFailed", err.Error())
+			return fmt.Errorf("failed to find existing daemon role binding, %w", err)
+		}
+		needToCreate = true
+	} else if existingRB.GetAnnotations()[dfv1.KeyHash] != rbHash {
+		// Delete and recreate, the role of a role binding is immutable.
+		if err := r.client.Delete(ctx, existingRB); err != nil && !apierrors.IsNotFound(err) {
+			log.Errorw("Failed to delete the outdated daemon role binding", zap.String("roleBinding", existingRB.Name), zap.Error(err))
+			pl.Status.MarkDeployFailed("DeleteOldDaemonRoleBindingFailed", err.Error())
+			r.recorder.Eventf(pl, corev1.EventTypeWarning, "DeleteOldDaemonRoleBindingFailed", "Failed to delete the outdated daemon role binding: %w", err.Error())
+			return fmt.Errorf("failed to delete an outdated daemon role binding, %w", err)
+		}
+		needToCreate = true
+	}
+	if needToCreate {
+		if err := r.client.Create(ctx, rb); err != nil && !apierrors.IsAlreadyExists(err) {
+			log.Errorw("Failed to create a daemon role binding", zap.String("roleBinding", rb.Name), zap.Error(err))
+			pl.Status.MarkDeployFailed("CreateDaemonRoleBindingFailed", err.Error())
+			r.recorder.Eventf(pl, corev1.EventTypeWarning, "CreateDaemonRoleBindingFailed", "Failed to create a daemon role binding: %w", err.Error())
+			return fmt.Errorf("failed to create a daemon role binding, %w", err)
+		}
+		log.Infow("Succeeded to create a daemon role binding", zap.String("roleBinding", rb.Name))
+		r.recorder.Eventf(pl, corev1.EventTypeNormal, "CreateDaemonRoleBindingSuccess", "Succeeded to create daemon role binding %s", rb.Name)
+	}
+	return nil
+}
+
 func (r *pipelineReconciler) createOrUpdateDaemonDeployment(ctx context.Context, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig) error {
 	log := logging.FromContext(ctx)
 	isbSvcType, envs := sharedutil.GetIsbSvcEnvVars(isbSvcConfig)
 	envs = append(envs, corev1.EnvVar{Name: dfv1.EnvPipelineName, Value: pl.Name})
+	envs = append(envs, corev1.EnvVar{Name: dfv1.EnvPipelineUID, Value: string(pl.UID)})
 
 	req := dfv1.GetDaemonDeploymentReq{
 		ISBSvcType:       isbSvcType,
@@ -461,6 +526,7 @@ func (r *pipelineReconciler) createOrUpdateDaemonDeployment(ctx context.Context,
 		pl.Status.MarkDeployFailed("BuildDaemonDeployFailed", err.Error())
 		return fmt.Errorf("failed to build daemon deployment spec, %w", err)
 	}
+	sharedutil.AttachIsbSvcTLSVolumes(&deploy.Spec.Template.Spec, isbSvcConfig)
 	deployHash := sharedutil.MustHash(deploy.Spec)
 	deploy.Annotations = map[string]string{dfv1.KeyHash: deployHash}
 	existingDeploy := &appv1.Deployment{}
@@ -536,6 +602,7 @@ func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, p
 		return fmt.Errorf("failed to find existing Side Inputs Manager Deployments, %w", err)
 	}
 	for _, newObj := range newObjs {
+		sharedutil.AttachIsbSvcTLSVolumes(&newObj.Spec.Template.Spec, isbSvcConfig)
 		deployHash := sharedutil.MustHash(newObj.Spec)
 		if newObj.Annotations == nil {
 			newObj.Annotations = make(map[string]string)
@@ -626,7 +693,8 @@ func (r *pipelineReconciler) cleanUpBuffers(ctx context.Context, pl *dfv1.Pipeli
 	return nil
 }
 
-func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
+// BuildVertices builds the vertex objects of the pipeline the way the controller creates them, keyed by their names.
+func BuildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 	result := make(map[string]dfv1.Vertex)
 	for _, v := range pl.Spec.Vertices {
 		vertexFullName := pl.Name + "-" + v.Name
@@ -666,6 +734,7 @@ func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 			FromEdges:                  fromEdges,
 			ToEdges:                    toEdges,
 			Watermark:                  pl.Spec.Watermark,
+			Encryption:                 pl.Spec.Encryption.DeepCopy(),
 			Replicas:                   &replicas,
 			Lifecycle: dfv1.VertexLifecycle{
 				DesiredPhase: dfv1.VertexPhase(pl.GetDesiredPhase()),
@@ -690,11 +759,34 @@ func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 		if x := pl.GetAnnotations()[dfv1.KeyInstance]; x != "" {
 			obj.Annotations[dfv1.KeyInstance] = x
 		}
+		// The backfill mode is reloaded by the vertex pods, it's not a part of the spec so that switching it does not restart them
+		if pl.IsBackfilling() {
+			obj.Annotations[dfv1.KeyBackfill] = "true"
+		}
+		// The sources pause reading while the daemon reports the storage quota is exceeded, it's reloaded the same way
+		if v.IsASource() && pl.IsSourcesPausedByStorageQuota() {
+			obj.Annotations[dfv1.KeyStorageQuotaPaused] = "true"
+		}
 		result[obj.Name] = obj
 	}
 	return result
 }
 
+// copyReloadableAnnotations copies the annotations reloaded by the vertex pods, the backfill mode and the storage quota
+// pause, from the built vertex to the existing one.
+func copyReloadableAnnotations(from, to *dfv1.Vertex) {
+	for _, key := range []string{dfv1.KeyBackfill, dfv1.KeyStorageQuotaPaused} {
+		if from.GetAnnotations()[key] == "true" {
+			if to.Annotations == nil {
+				to.Annotations = make(map[string]string)
+			}
+			to.Annotations[key] = "true"
+		} else {
+			delete(to.Annotations, key)
+		}
+	}
+}
+
 func copyVertexLimits(pl *dfv1.Pipeline, v *dfv1.AbstractVertex) {
 	mergedLimits := mergeLimits(pl.GetPipelineLimits(), v.Limits)
 	v.Limits = &mergedLimits
@@ -815,6 +907,7 @@ func buildISBBatchJob(pl *dfv1.Pipeline, image string, isbSvcConfig dfv1.BufferS
 			jt.ContainerTemplate.ApplyToNumaflowContainers(spec.Template.Spec.Containers)
 		}
 	}
+	sharedutil.AttachIsbSvcTLSVolumes(&spec.Template.Spec, isbSvcConfig)
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: pl.Namespace,
@@ -27,6 +27,7 @@ import (
 	appv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -328,7 +329,7 @@ func fakeReconciler(t *testing.T, cl client.WithWatch) *pipelineReconciler {
 
 func Test_NewReconciler(t *testing.T) {
 	cl := fake.NewClientBuilder().Build()
-	r := NewReconciler(cl, scheme.Scheme, reconciler.FakeGlobalConfig(t, fakeGlobalISBSvcConfig), testFlowImage, zaptest.NewLogger(t).Sugar(), record.NewFakeRecorder(64))
+	r := NewReconciler(cl, scheme.Scheme, reconciler.FakeGlobalConfig(t, fakeGlobalISBSvcConfig), testFlowImage, false, zaptest.NewLogger(t).Sugar(), record.NewFakeRecorder(64))
 	_, ok := r.(*pipelineReconciler)
 	assert.True(t, ok)
 }
@@ -398,6 +399,92 @@ func Test_reconcile(t *testing.T) {
 		assert.Equal(t, 1, len(jobs.Items))
 	})
 
+	t.Run("test reconcile backfill", func(t *testing.T) {
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		cl := fake.NewClientBuilder().Build()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		testObj := testPipeline.DeepCopy()
+		r := fakeReconciler(t, cl)
+		listVertices := func() []dfv1.Vertex {
+			vertices := &dfv1.VertexList{}
+			selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testObj.Name)
+			err := r.client.List(ctx, vertices, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+			assert.NoError(t, err)
+			return vertices.Items
+		}
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		for _, v := range listVertices() {
+			assert.False(t, v.IsBackfilling())
+		}
+
+		// switching the backfill mode only changes the annotation of the vertices, not the spec
+		testObj.Annotations = map[string]string{dfv1.KeyBackfill: "true"}
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		vertices := listVertices()
+		assert.Equal(t, 3, len(vertices))
+		for _, v := range vertices {
+			assert.True(t, v.IsBackfilling())
+		}
+
+		delete(testObj.Annotations, dfv1.KeyBackfill)
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		for _, v := range listVertices() {
+			assert.False(t, v.IsBackfilling())
+		}
+	})
+
+	t.Run("test reconcile storage quota paused", func(t *testing.T) {
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		cl := fake.NewClientBuilder().Build()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		testObj := testPipeline.DeepCopy()
+		r := fakeReconciler(t, cl)
+		listVertices := func() []dfv1.Vertex {
+			vertices := &dfv1.VertexList{}
+			selector, _ := labels.Parse(dfv1.KeyPipelineName + "=" + testObj.Name)
+			err := r.client.List(ctx, vertices, &client.ListOptions{Namespace: testNamespace, LabelSelector: selector})
+			assert.NoError(t, err)
+			return vertices.Items
+		}
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		for _, v := range listVertices() {
+			assert.False(t, v.IsPausedByStorageQuota())
+		}
+
+		// only the sources are paused, and only when the daemon requires it
+		testObj.Status.MarkStorageQuotaExceeded(dfv1.StorageQuotaReasonExceeded, "exceeded")
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		for _, v := range listVertices() {
+			assert.False(t, v.IsPausedByStorageQuota())
+		}
+		testObj.Status.MarkStorageQuotaExceeded(dfv1.StorageQuotaReasonSourcesPaused, "exceeded")
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		vertices := listVertices()
+		assert.Equal(t, 3, len(vertices))
+		for _, v := range vertices {
+			assert.Equal(t, v.IsASource(), v.IsPausedByStorageQuota(), v.Name)
+		}
+
+		testObj.Status.MarkStorageQuotaWithin()
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		for _, v := range listVertices() {
+			assert.False(t, v.IsPausedByStorageQuota())
+		}
+	})
+
 	t.Run("test reconcile deleting", func(t *testing.T) {
 		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
 		testIsbSvc.Status.MarkConfigured()
@@ -472,18 +559,26 @@ func Test_reconcile(t *testing.T) {
 }
 
 func Test_buildVertices(t *testing.T) {
-	r := buildVertices(testPipeline)
+	r := BuildVertices(testPipeline)
 	assert.Equal(t, 3, len(r))
 	_, existing := r[testPipeline.Name+"-"+testPipeline.Spec.Vertices[0].Name]
 	assert.True(t, existing)
 	assert.Equal(t, testPipeline.Spec.Watermark.MaxDelay, r[testPipeline.Name+"-"+testPipeline.Spec.Vertices[0].Name].Spec.Watermark.MaxDelay)
+	assert.Nil(t, r[testPipeline.Name+"-"+testPipeline.Spec.Vertices[0].Name].Spec.Encryption)
+
+	pl := testPipeline.DeepCopy()
+	pl.Spec.Encryption = &dfv1.ISBEncryption{SecretName: "isb-keys", PrimaryKeyID: "key-1"}
+	r = BuildVertices(pl)
+	for _, v := range r {
+		assert.Equal(t, pl.Spec.Encryption, v.Spec.Encryption)
+	}
 }
 
 func Test_buildReducesVertices(t *testing.T) {
 	pl := testReducePipeline.DeepCopy()
 	pl.Spec.Vertices[1].UDF.GroupBy.Keyed = true
 	pl.Spec.Vertices[1].Partitions = ptr.To[int32](2)
-	r := buildVertices(pl)
+	r := BuildVertices(pl)
 	assert.Equal(t, 6, len(r))
 	_, existing := r[pl.Name+"-"+pl.Spec.Vertices[1].Name]
 	assert.True(t, existing)
@@ -782,6 +877,27 @@ func TestCreateOrUpdateDaemon(t *testing.T) {
 		assert.Len(t, deployList.Items, 1)
 		assert.Equal(t, "test-pl-daemon", deployList.Items[0].Name)
 	})
+
+	t.Run("test create or update role binding", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		err := r.createOrUpdateDaemonRoleBinding(ctx, testObj)
+		assert.NoError(t, err)
+		rbList := rbacv1.RoleBindingList{}
+		err = cl.List(context.Background(), &rbList)
+		assert.NoError(t, err)
+		assert.Len(t, rbList.Items, 1)
+		assert.Equal(t, "test-pl-daemon", rbList.Items[0].Name)
+		assert.Equal(t, "default", rbList.Items[0].Subjects[0].Name)
+
+		// the role binding is recreated when the service account changes
+		testObj.Spec.Templates = &dfv1.Templates{DaemonTemplate: &dfv1.DaemonTemplate{AbstractPodTemplate: dfv1.AbstractPodTemplate{ServiceAccountName: "daemon-sa"}}}
+		err = r.createOrUpdateDaemonRoleBinding(ctx, testObj)
+		assert.NoError(t, err)
+		err = cl.List(context.Background(), &rbList)
+		assert.NoError(t, err)
+		assert.Len(t, rbList.Items, 1)
+		assert.Equal(t, "daemon-sa", rbList.Items[0].Subjects[0].Name)
+	})
 }
 
 func Test_createOrUpdateSIMDeployments(t *testing.T) {
@@ -35,8 +35,11 @@ func ValidateInterStepBufferService(isbsvc *dfv1.InterStepBufferService) error {
 	if isbsvc.Spec.Redis != nil && isbsvc.Spec.JetStream != nil {
 		return fmt.Errorf(`invalid spec: "spec.redis" and "spec.jetstream" can not be defined together`)
 	}
-	if isbsvc.Spec.Redis == nil && isbsvc.Spec.JetStream == nil {
-		return fmt.Errorf(`invalid spec: either "spec.redis" or "spec.jetstream" needs to be specified`)
+	if isbsvc.Spec.Kafka != nil && (isbsvc.Spec.Redis != nil || isbsvc.Spec.JetStream != nil) {
+		return fmt.Errorf(`invalid spec: "spec.kafka" can not be defined together with "spec.redis" or "spec.jetstream"`)
+	}
+	if isbsvc.Spec.Redis == nil && isbsvc.Spec.JetStream == nil && isbsvc.Spec.Kafka == nil {
+		return fmt.Errorf(`invalid spec: either "spec.redis", "spec.jetstream" or "spec.kafka" needs to be specified`)
 	}
 	if isbsvc.Spec.Redis != nil {
 		if isbsvc.Spec.Redis.Native != nil && isbsvc.Spec.Redis.External != nil {
@@ -50,6 +53,11 @@ func ValidateInterStepBufferService(isbsvc *dfv1.InterStepBufferService) error {
 				return fmt.Errorf(`invalid spec: "spec.redis.native.version" is not defined`)
 			}
 		}
+		if x := isbsvc.Spec.Redis.External; x != nil && x.TLS != nil {
+			if (x.TLS.CertSecret == nil) != (x.TLS.KeySecret == nil) {
+				return fmt.Errorf(`invalid spec: "certSecret" and "keySecret" of "spec.redis.external.tls" need to be specified together`)
+			}
+		}
 	}
 	if x := isbsvc.Spec.JetStream; x != nil {
 		if x.Version == "" {
@@ -59,5 +67,28 @@ func ValidateInterStepBufferService(isbsvc *dfv1.InterStepBufferService) error {
 			return fmt.Errorf(`invalid spec: min value for "spec.jetstream.replicas" is 1 and can't be 2`)
 		}
 	}
+	if x := isbsvc.Spec.Kafka; x != nil {
+		if x.External == nil {
+			return fmt.Errorf(`invalid spec: "spec.kafka.external" is not defined`)
+		}
+		if len(x.External.Brokers) == 0 {
+			return fmt.Errorf(`invalid spec: "spec.kafka.external.brokers" is not defined`)
+		}
+		if sasl := x.External.SASL; sasl != nil {
+			if sasl.User == nil || sasl.Password == nil {
+				return fmt.Errorf(`invalid spec: both "user" and "password" are required in "spec.kafka.external.sasl"`)
+			}
+			switch sasl.GetMechanism() {
+			case dfv1.SASLTypePlaintext, dfv1.SASLTypeSCRAMSHA256, dfv1.SASLTypeSCRAMSHA512:
+			default:
+				return fmt.Errorf(`invalid spec: unsupported SASL mechanism %q of the Kafka ISB Service`, sasl.GetMechanism())
+			}
+		}
+		if x.External.TLS != nil {
+			if (x.External.TLS.CertSecret == nil) != (x.External.TLS.KeySecret == nil) {
+				return fmt.Errorf(`invalid spec: "certSecret" and "keySecret" of "spec.kafka.external.tls" need to be specified together`)
+			}
+		}
+	}
 	return nil
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -51,6 +52,20 @@ var (
 			},
 		},
 	}
+
+	testKafkaIsbs = &dfv1.InterStepBufferService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      dfv1.DefaultISBSvcName,
+		},
+		Spec: dfv1.InterStepBufferServiceSpec{
+			Kafka: &dfv1.KafkaBufferService{
+				External: &dfv1.KafkaConfig{
+					Brokers: []string{"broker-0:9092"},
+				},
+			},
+		},
+	}
 )
 
 func TestValidateInterStepBuffer(t *testing.T) {
@@ -72,7 +87,7 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		isbs.Spec.Redis = nil
 		err := ValidateInterStepBufferService(isbs)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), `either "spec.redis" or "spec.jetstream" needs to be specified`)
+		assert.Contains(t, err.Error(), `either "spec.redis", "spec.jetstream" or "spec.kafka" needs to be specified`)
 	})
 
 	t.Run("test missing version", func(t *testing.T) {
@@ -99,6 +114,29 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		assert.Contains(t, err.Error(), "must be defined")
 	})
 
+	t.Run("test external redis tls", func(t *testing.T) {
+		isbs := testRedisIsbs.DeepCopy()
+		isbs.Spec.Redis.Native = nil
+		isbs.Spec.Redis.External = &dfv1.RedisConfig{
+			URL: "redis:6379",
+			TLS: &dfv1.TLS{
+				CertSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"},
+					Key:                  "tls.crt",
+				},
+			},
+		}
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "need to be specified together")
+		isbs.Spec.Redis.External.TLS.KeySecret = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"},
+			Key:                  "tls.key",
+		}
+		err = ValidateInterStepBufferService(isbs)
+		assert.NoError(t, err)
+	})
+
 	t.Run("test missing jetstream version", func(t *testing.T) {
 		isbs := testJetStreamIsbs.DeepCopy()
 		isbs.Spec.JetStream.Version = ""
@@ -107,6 +145,69 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		assert.Contains(t, err.Error(), "is not defined")
 	})
 
+	t.Run("test good kafka isb", func(t *testing.T) {
+		err := ValidateInterStepBufferService(testKafkaIsbs)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test both kafka and redis configured", func(t *testing.T) {
+		isbs := testKafkaIsbs.DeepCopy()
+		isbs.Spec.Redis = testRedisIsbs.DeepCopy().Spec.Redis
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.kafka" can not be defined together`)
+	})
+
+	t.Run("test missing kafka external", func(t *testing.T) {
+		isbs := testKafkaIsbs.DeepCopy()
+		isbs.Spec.Kafka.External = nil
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.kafka.external" is not defined`)
+	})
+
+	t.Run("test missing kafka brokers", func(t *testing.T) {
+		isbs := testKafkaIsbs.DeepCopy()
+		isbs.Spec.Kafka.External.Brokers = nil
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.kafka.external.brokers" is not defined`)
+	})
+
+	t.Run("test kafka sasl", func(t *testing.T) {
+		isbs := testKafkaIsbs.DeepCopy()
+		isbs.Spec.Kafka.External.SASL = &dfv1.KafkaSASLAuth{User: &corev1.SecretKeySelector{Key: "user"}}
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `both "user" and "password" are required`)
+		isbs.Spec.Kafka.External.SASL.Password = &corev1.SecretKeySelector{Key: "password"}
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+		gssapi := dfv1.SASLTypeGSSAPI
+		isbs.Spec.Kafka.External.SASL.Mechanism = &gssapi
+		err = ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported SASL mechanism")
+	})
+
+	t.Run("test kafka tls", func(t *testing.T) {
+		isbs := testKafkaIsbs.DeepCopy()
+		isbs.Spec.Kafka.External.TLSEnabled = true
+		isbs.Spec.Kafka.External.TLS = &dfv1.TLS{
+			KeySecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-tls"},
+				Key:                  "tls.key",
+			},
+		}
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.kafka.external.tls" need to be specified together`)
+		isbs.Spec.Kafka.External.TLS.CertSecret = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-tls"},
+			Key:                  "tls.crt",
+		}
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+	})
+
 	t.Run("test nil ISB Service", func(t *testing.T) {
 		err := ValidateInterStepBufferService(nil)
 		assert.Error(t, err)
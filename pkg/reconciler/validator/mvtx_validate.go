@@ -38,6 +38,9 @@ func ValidateMonoVertex(mvtx *dfv1.MonoVertex) error {
 	if err := validateSource(*mvtx.Spec.Source); err != nil {
 		return fmt.Errorf("invalid source: %w", err)
 	}
+	if mvtx.Spec.Source.Replay != nil {
+		return fmt.Errorf("invalid source: replay source is not supported in a MonoVertex")
+	}
 	if mvtx.Spec.Sink == nil {
 		return fmt.Errorf("sink is not defined")
 	}
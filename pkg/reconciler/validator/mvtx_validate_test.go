@@ -112,6 +112,14 @@ func TestValidateMonoVertex(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid user-defined source spec")
 	})
 
+	t.Run("test replay source", func(t *testing.T) {
+		testObj := testMvtx.DeepCopy()
+		testObj.Spec.Source = &dfv1.Source{Replay: &dfv1.ReplaySource{Path: ptr.To("/data/records.jsonl")}}
+		err := ValidateMonoVertex(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "replay source is not supported in a MonoVertex")
+	})
+
 	t.Run("test no sink", func(t *testing.T) {
 		testObj := testMvtx.DeepCopy()
 		testObj.Spec.Sink = nil
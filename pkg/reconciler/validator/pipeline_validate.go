@@ -18,11 +18,16 @@ package validator
 
 import (
 	"fmt"
+	"math"
+	"net/url"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/sources/generator"
 )
 
 func ValidatePipeline(pl *dfv1.Pipeline) error {
@@ -74,6 +79,9 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 			if v.Source != nil || v.Sink != nil {
 				return fmt.Errorf("invalid vertex %q, only one of 'source', 'sink' and 'udf' can be specified", v.Name)
 			}
+			if v.IsPassThroughUDF() && len(pl.GetToEdges(v.Name)) == 0 {
+				return fmt.Errorf("invalid vertex %q, pass-through vertex must have at least 1 to edge", v.Name)
+			}
 			if len(pl.GetToEdges(v.Name)) == 0 || len(pl.GetFromEdges(v.Name)) == 0 {
 				return fmt.Errorf("invalid vertex %q, UDF must have to and from edges", v.Name)
 			}
@@ -158,6 +166,96 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		return err
 	}
 
+	if err := validateEncryption(*pl); err != nil {
+		return err
+	}
+
+	if err := validateBufferConfigs(*pl); err != nil {
+		return err
+	}
+
+	if err := validateBufferRetentions(*pl); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateBufferConfigs validates the buffer configs of the edges. They should be YAML objects, and the edges to the
+// same vertex should have the same buffer config as they write to the same buffers.
+func validateBufferConfigs(pl dfv1.Pipeline) error {
+	bufferConfigs := make(map[string]string)
+	for _, e := range pl.Spec.Edges {
+		if e.BufferConfig == nil {
+			continue
+		}
+		if err := yaml.Unmarshal([]byte(*e.BufferConfig), &map[string]interface{}{}); err != nil {
+			return fmt.Errorf("invalid edge from %q to %q: invalid buffer config, %w", e.From, e.To, err)
+		}
+		if conf, existing := bufferConfigs[e.To]; existing && conf != *e.BufferConfig {
+			return fmt.Errorf("invalid edge from %q to %q: the edges to vertex %q should have the same buffer config", e.From, e.To, e.To)
+		}
+		bufferConfigs[e.To] = *e.BufferConfig
+	}
+	return nil
+}
+
+// validateBufferRetentions validates the buffer retentions of the edges. The limits should be positive, and the edges
+// to the same vertex should have the same buffer retention as they write to the same buffers.
+func validateBufferRetentions(pl dfv1.Pipeline) error {
+	bufferRetentions := make(map[string]dfv1.BufferRetention)
+	for _, e := range pl.Spec.Edges {
+		if e.Retention == nil {
+			continue
+		}
+		r := *e.Retention
+		if r.MaxAge != nil && r.MaxAge.Duration <= 0 {
+			return fmt.Errorf("invalid edge from %q to %q: the max age of the retention should be positive", e.From, e.To)
+		}
+		if r.MaxMessages != nil && *r.MaxMessages <= 0 {
+			return fmt.Errorf("invalid edge from %q to %q: the max messages of the retention should be positive", e.From, e.To)
+		}
+		if r.MaxBytes != nil && *r.MaxBytes <= 0 {
+			return fmt.Errorf("invalid edge from %q to %q: the max bytes of the retention should be positive", e.From, e.To)
+		}
+		if r.Discard != nil && *r.Discard != dfv1.BufferDiscardOld && *r.Discard != dfv1.BufferDiscardNew {
+			return fmt.Errorf("invalid edge from %q to %q: unsupported discard policy %q of the retention", e.From, e.To, *r.Discard)
+		}
+		if existing, ok := bufferRetentions[e.To]; ok && !equality.Semantic.DeepEqual(existing, r) {
+			return fmt.Errorf("invalid edge from %q to %q: the edges to vertex %q should have the same buffer retention", e.From, e.To, e.To)
+		}
+		bufferRetentions[e.To] = r
+	}
+	return nil
+}
+
+// validateEncryption validates the inter-step buffer encryption config.
+// The primary key ID is a key of the secret, so it should be a valid secret key name.
+func validateEncryption(pl dfv1.Pipeline) error {
+	x := pl.Spec.Encryption
+	if x == nil {
+		return nil
+	}
+	if x.SecretName == "" {
+		return fmt.Errorf("invalid encryption config, secretName is missing")
+	}
+	if x.PrimaryKeyID == "" {
+		return fmt.Errorf("invalid encryption config, primaryKeyID is missing")
+	}
+	if errs := k8svalidation.IsConfigMapKey(x.PrimaryKeyID); len(errs) > 0 {
+		return fmt.Errorf("invalid encryption config, primaryKeyID %q is not a valid secret key, %v", x.PrimaryKeyID, errs)
+	}
+	if v := x.Vault; v != nil {
+		if u, err := url.Parse(v.Address); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid encryption config, vault address %q should be an http or https URL", v.Address)
+		}
+		if v.KeyName == "" {
+			return fmt.Errorf("invalid encryption config, vault keyName is missing")
+		}
+		if v.TokenSecret == nil || v.TokenSecret.Name == "" || v.TokenSecret.Key == "" {
+			return fmt.Errorf("invalid encryption config, vault tokenSecret is missing")
+		}
+	}
 	return nil
 }
 
@@ -260,6 +358,12 @@ func validateUDF(udf dfv1.UDF) error {
 }
 
 func validateMapUDF(udf dfv1.UDF) error {
+	if udf.PassThrough != nil {
+		if udf.Builtin != nil || (udf.Container != nil && udf.Container.Image != "") {
+			return fmt.Errorf("invalid udf, can not specify passThrough with a builtin function or a customized image")
+		}
+		return nil
+	}
 	if udf.Container != nil {
 		if udf.Container.Image == "" && udf.Builtin == nil {
 			return fmt.Errorf("invalid udf spec, either specify a builtin function, or a customized image")
@@ -278,6 +382,9 @@ func validateReduceUDF(udf dfv1.UDF) error {
 		// No builtin function supported for reduce vertices.
 		return fmt.Errorf("invalid udf, there's no buildin function support in reduce vertices")
 	}
+	if udf.PassThrough != nil {
+		return fmt.Errorf("invalid udf, passThrough is not supported in reduce vertices")
+	}
 	if udf.Container != nil {
 		if udf.Container.Image == "" {
 			return fmt.Errorf("invalid udf spec, a customized image is required")
@@ -578,9 +685,159 @@ func validateSource(source dfv1.Source) error {
 		if source.UDSource.Container == nil || source.UDSource.Container.Image == "" {
 			return fmt.Errorf("invalid user-defined source spec, a customized image is required")
 		}
-		if source.HTTP != nil || source.Kafka != nil || source.Nats != nil || source.Generator != nil {
-			return fmt.Errorf("invalid user-defined source spec, only one of 'http', 'kafka', 'nats', 'generator' and 'udSource' can be specified")
+		if source.HTTP != nil || source.Kafka != nil || source.Nats != nil || source.Generator != nil || source.Replay != nil {
+			return fmt.Errorf("invalid user-defined source spec, only one of 'http', 'kafka', 'nats', 'generator', 'replay' and 'udSource' can be specified")
+		}
+	}
+	if source.Generator != nil && source.Generator.EmitEvery != nil && *source.Generator.EmitEvery < 1 {
+		return fmt.Errorf("invalid generator source spec, emitEvery must be greater than 0")
+	}
+	if source.Generator != nil && source.Generator.TombstonePercentage != nil && (*source.Generator.TombstonePercentage < 0 || *source.Generator.TombstonePercentage > 100) {
+		return fmt.Errorf("invalid generator source spec, tombstonePercentage must be between 0 and 100")
+	}
+	if source.Generator != nil && source.Generator.Jitter != nil && source.Generator.Jitter.Duration < 0 {
+		return fmt.Errorf("invalid generator source spec, jitter must not be negative")
+	}
+	if source.Generator != nil && source.Generator.FutureJitter != nil && source.Generator.FutureJitter.Duration < 0 {
+		return fmt.Errorf("invalid generator source spec, futureJitter must not be negative")
+	}
+	if source.Generator != nil && source.Generator.RateJitterPercentage != nil && (*source.Generator.RateJitterPercentage < 0 || *source.Generator.RateJitterPercentage > 100) {
+		return fmt.Errorf("invalid generator source spec, rateJitterPercentage must be between 0 and 100")
+	}
+	if source.Generator != nil && source.Generator.RampUp != nil && source.Generator.RampUp.Duration < 0 {
+		return fmt.Errorf("invalid generator source spec, rampUp must not be negative")
+	}
+	if source.Generator != nil && len(source.Generator.RateSchedule) > 0 {
+		if source.Generator.RampUp != nil {
+			return fmt.Errorf("invalid generator source spec, rampUp and rateSchedule can not be both specified")
+		}
+		for i, step := range source.Generator.RateSchedule {
+			if step.RPU < 0 {
+				return fmt.Errorf("invalid generator source spec, rpu of rateSchedule step %d must not be negative", i)
+			}
+			if step.Duration == nil || step.Duration.Duration <= 0 {
+				return fmt.Errorf("invalid generator source spec, duration of rateSchedule step %d must be greater than 0", i)
+			}
+		}
+	}
+	if source.Generator != nil && source.Generator.LatePercentage != nil {
+		if *source.Generator.LatePercentage < 0 || *source.Generator.LatePercentage > 100 {
+			return fmt.Errorf("invalid generator source spec, latePercentage must be between 0 and 100")
+		}
+		if *source.Generator.LatePercentage > 0 && (source.Generator.LateBy == nil || source.Generator.LateBy.Duration <= 0) {
+			return fmt.Errorf("invalid generator source spec, lateBy must be greater than 0 when latePercentage is set")
+		}
+	}
+	if source.Generator != nil && source.Generator.DuplicatePercentage != nil && (*source.Generator.DuplicatePercentage < 0 || *source.Generator.DuplicatePercentage > 100) {
+		return fmt.Errorf("invalid generator source spec, duplicatePercentage must be between 0 and 100")
+	}
+	if source.Generator != nil && source.Generator.KeyDistribution != nil {
+		switch *source.Generator.KeyDistribution {
+		case dfv1.KeyDistributionRoundRobin, dfv1.KeyDistributionUniform, dfv1.KeyDistributionZipf, dfv1.KeyDistributionHotKey:
+		default:
+			return fmt.Errorf("invalid generator source spec, unsupported keyDistribution %q", *source.Generator.KeyDistribution)
+		}
+	}
+	if source.Generator != nil && source.Generator.ZipfExponent != nil {
+		if s, err := source.Generator.GetZipfExponent(); err != nil || s <= 1 {
+			return fmt.Errorf("invalid generator source spec, zipfExponent must be a decimal greater than 1")
+		}
+	}
+	if source.Generator != nil && source.Generator.HotKeyPercentage != nil && (*source.Generator.HotKeyPercentage < 0 || *source.Generator.HotKeyPercentage > 100) {
+		return fmt.Errorf("invalid generator source spec, hotKeyPercentage must be between 0 and 100")
+	}
+	if source.Generator != nil && source.Generator.RuntimeUpdate != nil {
+		if x := source.Generator.RuntimeUpdate.MaxRPU; x != nil && (*x < 0 || *x > math.MaxInt32) {
+			return fmt.Errorf("invalid generator source spec, runtimeUpdate.maxRpu must be between 0 and %d", math.MaxInt32)
+		}
+		if x := source.Generator.RuntimeUpdate.MaxKeyCount; x != nil && *x <= 0 {
+			return fmt.Errorf("invalid generator source spec, runtimeUpdate.maxKeyCount must be greater than 0")
+		}
+	}
+	if source.Generator != nil && source.Generator.IdleThreshold != nil && source.Generator.IdleThreshold.Duration < 0 {
+		return fmt.Errorf("invalid generator source spec, idleThreshold must not be negative")
+	}
+	if source.Generator != nil && source.Generator.EventTimeField != nil && *source.Generator.EventTimeField == "" {
+		return fmt.Errorf("invalid generator source spec, eventTimeField must not be empty")
+	}
+	if source.Generator != nil && source.Generator.EventTimeUnit != nil {
+		switch *source.Generator.EventTimeUnit {
+		case dfv1.EventTimeUnitNanos, dfv1.EventTimeUnitMillis, dfv1.EventTimeUnitSeconds, dfv1.EventTimeUnitRFC3339:
+		default:
+			return fmt.Errorf("invalid generator source spec, unsupported eventTimeUnit %q", *source.Generator.EventTimeUnit)
+		}
+	}
+	if source.Generator != nil && source.Generator.GetPayloadFormat() != dfv1.PayloadFormatJSON {
+		if source.Generator.ValueBlob != nil || source.Generator.ValueTemplate != nil || source.Generator.EventTimeField != nil {
+			return fmt.Errorf("invalid generator source spec, valueBlob, valueTemplate and eventTimeField can only be used with the json payloadFormat")
 		}
+		var schema, message string
+		if source.Generator.PayloadSchema != nil {
+			schema = *source.Generator.PayloadSchema
+		}
+		if source.Generator.PayloadMessage != nil {
+			message = *source.Generator.PayloadMessage
+		}
+		if err := generator.ParsePayloadSchema(source.Generator.GetPayloadFormat(), schema, message); err != nil {
+			return fmt.Errorf("invalid generator source spec, failed to parse payloadSchema, %w", err)
+		}
+	}
+	if source.Generator != nil && source.Generator.ValueTemplate != nil {
+		if source.Generator.ValueBlob != nil {
+			return fmt.Errorf("invalid generator source spec, valueBlob and valueTemplate can not be both specified")
+		}
+		if _, err := generator.ParseValueTemplate(*source.Generator.ValueTemplate); err != nil {
+			return fmt.Errorf("invalid generator source spec, failed to parse valueTemplate, %w", err)
+		}
+	}
+	if source.Generator != nil {
+		for name, value := range source.Generator.Headers {
+			if name == "" {
+				return fmt.Errorf("invalid generator source spec, the name of a header must not be empty")
+			}
+			if _, err := generator.ParseValueTemplate(value); err != nil {
+				return fmt.Errorf("invalid generator source spec, failed to parse the template of the header %s, %w", name, err)
+			}
+		}
+	}
+	if source.Replay != nil {
+		if err := validateReplaySource(*source.Replay); err != nil {
+			return fmt.Errorf("invalid replay source spec, %w", err)
+		}
+	}
+	return nil
+}
+
+// validateReplaySource validates the spec of a replay source.
+func validateReplaySource(replay dfv1.ReplaySource) error {
+	if (replay.ConfigMap == nil) == (replay.Path == nil) {
+		return fmt.Errorf("exactly one of configMap and path must be specified")
+	}
+	if replay.Path != nil && *replay.Path == "" {
+		return fmt.Errorf("path must not be empty")
+	}
+	if replay.Format != nil {
+		switch *replay.Format {
+		case dfv1.ReplayFormatJSONL, dfv1.ReplayFormatCSV:
+		default:
+			return fmt.Errorf("unsupported format %q", *replay.Format)
+		}
+	}
+	if replay.EventTimeField != nil && *replay.EventTimeField == "" {
+		return fmt.Errorf("eventTimeField must not be empty")
+	}
+	if replay.EventTimeUnit != nil {
+		switch *replay.EventTimeUnit {
+		case dfv1.EventTimeUnitNanos, dfv1.EventTimeUnitMillis, dfv1.EventTimeUnitSeconds, dfv1.EventTimeUnitRFC3339:
+		default:
+			return fmt.Errorf("unsupported eventTimeUnit %q", *replay.EventTimeUnit)
+		}
+	}
+	if replay.KeyField != nil && *replay.KeyField == "" {
+		return fmt.Errorf("keyField must not be empty")
+	}
+	if speed, err := replay.GetSpeed(); err != nil || speed < 0 || math.IsInf(speed, 0) || math.IsNaN(speed) {
+		return fmt.Errorf("speed must be a non-negative decimal")
 	}
 	return nil
 }
@@ -334,6 +334,250 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "only one of")
 	})
 
+	t.Run("generator source with invalid emitEvery", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{EmitEvery: ptr.To[int32](0)}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "emitEvery must be greater than 0")
+	})
+
+	t.Run("generator source with invalid tombstonePercentage", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{TombstonePercentage: ptr.To[int32](101)}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "tombstonePercentage must be between 0 and 100")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{TombstonePercentage: ptr.To[int32](10)}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with negative jitter", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{Jitter: &metav1.Duration{Duration: -time.Second}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "jitter must not be negative")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{FutureJitter: &metav1.Duration{Duration: -time.Second}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "futureJitter must not be negative")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{
+			Jitter:       &metav1.Duration{Duration: time.Second},
+			FutureJitter: &metav1.Duration{Duration: time.Hour},
+		}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid runtimeUpdate", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RuntimeUpdate: &dfv1.GeneratorRuntimeUpdate{MaxRPU: ptr.To[int64](-1)}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "runtimeUpdate.maxRpu must be between 0")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RuntimeUpdate: &dfv1.GeneratorRuntimeUpdate{MaxKeyCount: ptr.To[int32](0)}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "runtimeUpdate.maxKeyCount must be greater than 0")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RuntimeUpdate: &dfv1.GeneratorRuntimeUpdate{MaxRPU: ptr.To[int64](100), MaxKeyCount: ptr.To[int32](4)}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid rate variation", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RateJitterPercentage: ptr.To[int32](-1)}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rateJitterPercentage must be between 0 and 100")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RampUp: &metav1.Duration{Duration: -time.Second}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rampUp must not be negative")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RateJitterPercentage: ptr.To[int32](20), RampUp: &metav1.Duration{Duration: time.Minute}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid rate schedule", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{
+			RampUp:       &metav1.Duration{Duration: time.Minute},
+			RateSchedule: []dfv1.GeneratorRateStep{{RPU: 100, Duration: &metav1.Duration{Duration: time.Minute}}},
+		}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rampUp and rateSchedule can not be both specified")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RateSchedule: []dfv1.GeneratorRateStep{{RPU: -1, Duration: &metav1.Duration{Duration: time.Minute}}}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rpu of rateSchedule step 0 must not be negative")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RateSchedule: []dfv1.GeneratorRateStep{{RPU: 100, Duration: &metav1.Duration{Duration: time.Minute}}, {RPU: 10}}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duration of rateSchedule step 1 must be greater than 0")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{RateSchedule: []dfv1.GeneratorRateStep{{RPU: 100, Duration: &metav1.Duration{Duration: time.Minute}}, {RPU: 10, Duration: &metav1.Duration{Duration: time.Minute}}}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("invalid replay source", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source = &dfv1.Source{Replay: &dfv1.ReplaySource{}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of configMap and path must be specified")
+		testObj.Spec.Vertices[0].Source.Replay = &dfv1.ReplaySource{Path: ptr.To("/data/records.jsonl"), ConfigMap: &corev1.ConfigMapKeySelector{Key: "records.jsonl"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of configMap and path must be specified")
+		testObj.Spec.Vertices[0].Source.Replay = &dfv1.ReplaySource{Path: ptr.To("/data/records"), Format: ptr.To[dfv1.ReplayFormat]("xml")}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported format "xml"`)
+		testObj.Spec.Vertices[0].Source.Replay = &dfv1.ReplaySource{Path: ptr.To("/data/records.jsonl"), EventTimeField: ptr.To("")}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "eventTimeField must not be empty")
+		testObj.Spec.Vertices[0].Source.Replay = &dfv1.ReplaySource{Path: ptr.To("/data/records.jsonl"), EventTimeUnit: ptr.To[dfv1.EventTimeUnit]("hours")}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported eventTimeUnit "hours"`)
+		for _, speed := range []string{"-1", "fast", "NaN"} {
+			testObj.Spec.Vertices[0].Source.Replay = &dfv1.ReplaySource{Path: ptr.To("/data/records.jsonl"), Speed: ptr.To(speed)}
+			err = ValidatePipeline(testObj)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "speed must be a non-negative decimal")
+		}
+		testObj.Spec.Vertices[0].Source.Replay = &dfv1.ReplaySource{
+			ConfigMap:      &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "records"}, Key: "records.csv"},
+			EventTimeField: ptr.To("ts"),
+			EventTimeUnit:  ptr.To(dfv1.EventTimeUnitMillis),
+			Speed:          ptr.To("10"),
+		}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid late records", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{LatePercentage: ptr.To[int32](101), LateBy: &metav1.Duration{Duration: time.Minute}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "latePercentage must be between 0 and 100")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{LatePercentage: ptr.To[int32](5)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "lateBy must be greater than 0 when latePercentage is set")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{LatePercentage: ptr.To[int32](5), LateBy: &metav1.Duration{Duration: 2 * time.Minute}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid duplicate percentage", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{DuplicatePercentage: ptr.To[int32](-1)}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicatePercentage must be between 0 and 100")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{DuplicatePercentage: ptr.To[int32](10)}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid key distribution", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{KeyDistribution: ptr.To[dfv1.KeyDistribution]("pareto")}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported keyDistribution")
+		for _, s := range []string{"1", "abc"} {
+			testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{KeyDistribution: ptr.To(dfv1.KeyDistributionZipf), ZipfExponent: ptr.To(s)}
+			err = ValidatePipeline(testObj)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "zipfExponent must be a decimal greater than 1")
+		}
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{KeyDistribution: ptr.To(dfv1.KeyDistributionHotKey), HotKeyPercentage: ptr.To[int32](101)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "hotKeyPercentage must be between 0 and 100")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{KeyDistribution: ptr.To(dfv1.KeyDistributionZipf), ZipfExponent: ptr.To("1.2")}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid payload format", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{PayloadFormat: ptr.To(dfv1.PayloadFormatRawBytes), ValueBlob: ptr.To("YQ==")}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can only be used with the json payloadFormat")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{PayloadFormat: ptr.To(dfv1.PayloadFormatAvro), PayloadSchema: ptr.To(`{"type": "record"}`)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse payloadSchema")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{PayloadFormat: ptr.To(dfv1.PayloadFormatProtobuf)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse payloadSchema")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{
+			PayloadFormat: ptr.To(dfv1.PayloadFormatAvro),
+			PayloadSchema: ptr.To(`{"type": "record", "name": "Order", "fields": [{"name": "createdts", "type": "long"}]}`),
+		}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid idle threshold", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{IdleThreshold: &metav1.Duration{Duration: -time.Second}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "idleThreshold must not be negative")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{IdleThreshold: &metav1.Duration{Duration: 5 * time.Second}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid event time field", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{EventTimeField: ptr.To("")}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "eventTimeField must not be empty")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{EventTimeField: ptr.To("meta.ts"), EventTimeUnit: ptr.To[dfv1.EventTimeUnit]("hours")}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported eventTimeUnit")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{EventTimeField: ptr.To("meta.ts"), EventTimeUnit: ptr.To(dfv1.EventTimeUnitMillis)}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid valueTemplate", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{ValueTemplate: ptr.To(`{"id": {{.Sequence}`)}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse valueTemplate")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{ValueTemplate: ptr.To(`{"id": {{.Sequence}}}`), ValueBlob: ptr.To("e30=")}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "valueBlob and valueTemplate can not be both specified")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{ValueTemplate: ptr.To(`{"id": "{{uuid}}", "ts": {{now.UnixMilli}}}`)}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{ValueTemplate: ptr.To(`{"id": "{{uuid4}}"}`)}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse valueTemplate")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{ValueTemplate: ptr.To(`{"id": {{.Sequence}}}`)}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("generator source with invalid headers", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{Headers: map[string]string{"trace-id": "{{uuid"}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse the template of the header trace-id")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{Headers: map[string]string{"": "v"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the name of a header must not be empty")
+		testObj.Spec.Vertices[0].Source.Generator = &dfv1.GeneratorSource{Headers: map[string]string{"trace-id": "{{uuid}}", "tenant": "a"}}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
 	t.Run("udf no image and builtin specified", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		testObj.Spec.Vertices[1].UDF.Builtin = nil
@@ -444,6 +688,22 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "UDF must have to and from edges")
 	})
 
+	t.Run("pass-through vertex", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF = &dfv1.UDF{PassThrough: &dfv1.PassThrough{TagsHeader: "X-Tags"}}
+		err := ValidatePipeline(testObj)
+		assert.NoError(t, err)
+	})
+
+	t.Run("pass-through vertex without to edges", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices = append(testObj.Spec.Vertices, dfv1.AbstractVertex{Name: "router", UDF: &dfv1.UDF{PassThrough: &dfv1.PassThrough{}}})
+		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "p1", To: "router"})
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "pass-through vertex must have at least 1 to edge")
+	})
+
 	t.Run("pipeline has not source", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		testObj.Spec.Vertices[0].Source = nil
@@ -790,6 +1050,30 @@ func TestValidateUDF(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"timeout" is missing`)
 	})
+
+	t.Run("pass-through with a builtin function", func(t *testing.T) {
+		udf := dfv1.UDF{
+			Builtin:     &dfv1.Function{Name: "cat"},
+			PassThrough: &dfv1.PassThrough{},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not specify passThrough with a builtin function or a customized image")
+	})
+
+	t.Run("pass-through in a reduce vertex", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Fixed: &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}},
+				},
+			},
+			PassThrough: &dfv1.PassThrough{},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "passThrough is not supported in reduce vertices")
+	})
 }
 
 func Test_validateSideInputs(t *testing.T) {
@@ -1128,6 +1412,92 @@ func Test_validateIdleSource(t *testing.T) {
 	assert.Contains(t, err.Error(), `invalid idle source watermark config, threshold should be greater than or equal to incrementBy`)
 }
 
+func Test_validateEncryption(t *testing.T) {
+	testObj := testPipeline.DeepCopy()
+	assert.NoError(t, validateEncryption(*testObj))
+
+	testObj.Spec.Encryption = &dfv1.ISBEncryption{SecretName: "isb-keys", PrimaryKeyID: "key-1"}
+	assert.NoError(t, validateEncryption(*testObj))
+
+	testObj.Spec.Encryption = &dfv1.ISBEncryption{PrimaryKeyID: "key-1"}
+	err := validateEncryption(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `secretName is missing`)
+
+	testObj.Spec.Encryption = &dfv1.ISBEncryption{SecretName: "isb-keys"}
+	err = validateEncryption(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `primaryKeyID is missing`)
+
+	testObj.Spec.Encryption = &dfv1.ISBEncryption{SecretName: "isb-keys", PrimaryKeyID: "key/1"}
+	err = validateEncryption(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `is not a valid secret key`)
+
+	tokenSecret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "vault"}, Key: "token"}
+	testObj.Spec.Encryption = &dfv1.ISBEncryption{SecretName: "isb-keys", PrimaryKeyID: "key-1",
+		Vault: &dfv1.VaultTransit{Address: "https://vault:8200", KeyName: "isb", TokenSecret: tokenSecret}}
+	assert.NoError(t, validateEncryption(*testObj))
+
+	testObj.Spec.Encryption.Vault.Address = "vault:8200"
+	err = validateEncryption(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `should be an http or https URL`)
+
+	testObj.Spec.Encryption.Vault.Address = "http://vault:8200"
+	testObj.Spec.Encryption.Vault.KeyName = ""
+	err = validateEncryption(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `vault keyName is missing`)
+
+	testObj.Spec.Encryption.Vault.KeyName = "isb"
+	testObj.Spec.Encryption.Vault.TokenSecret = nil
+	err = validateEncryption(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `vault tokenSecret is missing`)
+}
+
+func Test_validateBufferConfigs(t *testing.T) {
+	testObj := testPipeline.DeepCopy()
+	testObj.Spec.Edges[1].BufferConfig = ptr.To("stream:\n  maxMsgs: 1000000\n")
+	assert.NoError(t, validateBufferConfigs(*testObj))
+
+	testObj.Spec.Edges[1].BufferConfig = ptr.To("stream: [")
+	err := validateBufferConfigs(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid buffer config`)
+
+	// a join vertex
+	testObj.Spec.Edges[1].BufferConfig = ptr.To("stream:\n  maxMsgs: 1000000\n")
+	testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "input", To: "output", BufferConfig: ptr.To("stream:\n  maxMsgs: 1000\n")})
+	err = validateBufferConfigs(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `should have the same buffer config`)
+}
+
+func Test_validateBufferRetentions(t *testing.T) {
+	testObj := testPipeline.DeepCopy()
+	testObj.Spec.Edges[1].Retention = &dfv1.BufferRetention{MaxAge: &metav1.Duration{Duration: time.Hour}, MaxMessages: ptr.To[int64](1000), Discard: ptr.To(dfv1.BufferDiscardOld)}
+	assert.NoError(t, validateBufferRetentions(*testObj))
+
+	testObj.Spec.Edges[1].Retention = &dfv1.BufferRetention{MaxMessages: ptr.To[int64](0)}
+	err := validateBufferRetentions(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `max messages of the retention should be positive`)
+
+	testObj.Spec.Edges[1].Retention = &dfv1.BufferRetention{Discard: ptr.To(dfv1.BufferDiscardPolicy("all"))}
+	err = validateBufferRetentions(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported discard policy "all"`)
+
+	// a join vertex
+	testObj.Spec.Edges[1].Retention = &dfv1.BufferRetention{MaxMessages: ptr.To[int64](1000)}
+	testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "input", To: "output", Retention: &dfv1.BufferRetention{MaxMessages: ptr.To[int64](100)}})
+	err = validateBufferRetentions(*testObj)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `should have the same buffer retention`)
+}
+
 // TestValidateSink tests the validateSink function with different sink configurations.
 func TestValidateSink(t *testing.T) {
 	onFailFallback := dfv1.OnFailureFallback
@@ -34,11 +34,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/env"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/reconciler"
 	"github.com/numaproj/numaflow/pkg/reconciler/vertex/scaling"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -150,6 +152,13 @@ func (r *vertexReconciler) reconcile(ctx context.Context, vertex *dfv1.Vertex) (
 		return ctrl.Result{}, err
 	}
 
+	// Create the runtime limits ConfigMap
+	if err := r.createOrUpdateRuntimeLimits(ctx, vertex); err != nil {
+		vertex.Status.MarkDeployFailed("CreateOrUpdateRuntimeLimitsFailed", err.Error())
+		r.recorder.Eventf(vertex, corev1.EventTypeWarning, "CreateOrUpdateRuntimeLimitsFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+
 	pipeline := &dfv1.Pipeline{}
 	if err := r.client.Get(ctx, types.NamespacedName{Namespace: vertex.Namespace, Name: vertex.Spec.PipelineName}, pipeline); err != nil {
 		log.Errorw("Failed to get pipeline object", zap.Error(err))
@@ -205,8 +214,14 @@ func (r *vertexReconciler) orchestratePods(ctx context.Context, vertex *dfv1.Ver
 		reconciler.VertexMaxReplicas.WithLabelValues(vertex.Namespace, vertex.Spec.PipelineName, vertex.Spec.Name).Set(float64(vertex.Spec.Scale.GetMaxReplicas()))
 	}()
 
-	// Build pod spec of the 1st replica to calculate the hash, which is used to determine whether the pod spec is changed
-	tmpSpec, err := r.buildPodSpec(vertex, pipeline, isbSvc.Status.Config, 0)
+	// Build pod spec of the 1st replica to calculate the hash, which is used to determine whether the pod spec is changed.
+	// The limits reloaded by the running pods are excluded, so that changing them does not restart the pods, except
+	// for the rust runtime, which does not reload them.
+	hashVertex := vertex.WithoutReloadableLimits()
+	if executeRustBinary, _ := env.GetBool(dfv1.EnvExecuteRustBinary, false); executeRustBinary {
+		hashVertex = vertex
+	}
+	tmpSpec, err := r.buildPodSpec(hashVertex, pipeline, isbSvc.Status.Config, 0)
 	if err != nil {
 		return fmt.Errorf("failed to build a pod spec: %w", err)
 	}
@@ -357,7 +372,10 @@ func (r *vertexReconciler) orchestratePodsFromTo(ctx context.Context, vertex *df
 			labels[dfv1.KeyVertexName] = vertex.Spec.Name
 			annotations[dfv1.KeyHash] = newHash
 			annotations[dfv1.KeyReplica] = strconv.Itoa(replica)
-			if vertex.IsMapUDF() || vertex.IsReduceUDF() {
+			if vertex.IsPassThroughUDF() {
+				// there's no udf container in a pass-through vertex, the main container is the default one
+				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrMain
+			} else if vertex.IsMapUDF() || vertex.IsReduceUDF() {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdf
 			} else if vertex.IsUDSink() {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdsink
@@ -522,6 +540,52 @@ func (r *vertexReconciler) createOrUpdateServices(ctx context.Context, vertex *d
 	return nil
 }
 
+// createOrUpdateRuntimeLimits writes the resolved limits of the vertex to the ConfigMap mounted in the vertex pods,
+// which reload the limits from it at runtime.
+func (r *vertexReconciler) createOrUpdateRuntimeLimits(ctx context.Context, vertex *dfv1.Vertex) error {
+	log := logging.FromContext(ctx)
+	data, err := limits.Marshal(limits.Resolve(vertex))
+	if err != nil {
+		return fmt.Errorf("failed to marshal the runtime limits: %w", err)
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: vertex.Namespace,
+			Name:      vertex.GetRuntimeLimitsConfigMapName(),
+			Labels: map[string]string{
+				dfv1.KeyPartOf:       dfv1.Project,
+				dfv1.KeyManagedBy:    dfv1.ControllerVertex,
+				dfv1.KeyComponent:    dfv1.ComponentVertex,
+				dfv1.KeyVertexName:   vertex.Spec.Name,
+				dfv1.KeyPipelineName: vertex.Spec.PipelineName,
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vertex.GetObjectMeta(), dfv1.VertexGroupVersionKind)},
+		},
+		Data: map[string]string{dfv1.KeyRuntimeLimits: data},
+	}
+	existingCM := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}, existingCM); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get the runtime limits ConfigMap: %w", err)
+		}
+		if err := r.client.Create(ctx, cm); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create the runtime limits ConfigMap: %w", err)
+		}
+		log.Infow("Succeeded to create the runtime limits ConfigMap", zap.String("configMap", cm.Name))
+		return nil
+	}
+	if equality.Semantic.DeepEqual(existingCM.Data, cm.Data) {
+		return nil
+	}
+	existingCM.Data = cm.Data
+	if err := r.client.Update(ctx, existingCM); err != nil {
+		return fmt.Errorf("failed to update the runtime limits ConfigMap: %w", err)
+	}
+	log.Infow("Succeeded to update the runtime limits ConfigMap", zap.String("configMap", cm.Name))
+	r.recorder.Eventf(vertex, corev1.EventTypeNormal, "UpdateRuntimeLimitsSuccess", "Succeeded to update the runtime limits ConfigMap %s", cm.Name)
+	return nil
+}
+
 func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline, isbSvcConfig dfv1.BufferServiceConfig, replicaIndex int) (*corev1.PodSpec, error) {
 	isbSvcType, envs := sharedutil.GetIsbSvcEnvVars(isbSvcConfig)
 	podSpec, err := vertex.GetPodSpec(dfv1.GetVertexPodSpecReq{
@@ -542,6 +606,7 @@ func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline,
 	vols, volMounts := sharedutil.VolumesFromSecretsAndConfigMaps(vertex)
 	podSpec.Volumes = append(podSpec.Volumes, vols...)
 	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, volMounts...)
+	sharedutil.AttachIsbSvcTLSVolumes(podSpec, isbSvcConfig)
 
 	if vertex.IsReduceUDF() && vertex.Spec.UDF.GroupBy.Storage.NoStore == nil {
 		// Add pvc for reduce vertex pods
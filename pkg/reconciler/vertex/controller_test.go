@@ -38,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/reconciler"
 	"github.com/numaproj/numaflow/pkg/reconciler/vertex/scaling"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
@@ -578,6 +579,53 @@ func Test_reconcile(t *testing.T) {
 		assert.Equal(t, 2, len(pods.Items[0].Spec.InitContainers))
 	})
 
+	t.Run("test reconcile runtime limits", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		testPl := testPipeline.DeepCopy()
+		err = cl.Create(ctx, testPl)
+		assert.Nil(t, err)
+		r := fakeReconciler(t, cl)
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.UDF = &dfv1.UDF{
+			Builtin: &dfv1.Function{
+				Name: "cat",
+			},
+		}
+		testObj.Spec.Limits = &dfv1.VertexLimits{ReadBatchSize: ptr.To[uint64](100)}
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		cm := &corev1.ConfigMap{}
+		err = r.client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testObj.GetRuntimeLimitsConfigMapName()}, cm)
+		assert.NoError(t, err)
+		s, err := limits.Unmarshal([]byte(cm.Data[dfv1.KeyRuntimeLimits]))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), s.ReadBatchSize)
+		hash := testObj.Status.UpdateHash
+
+		// changing a reloadable limit updates the ConfigMap without changing the pod spec hash
+		testObj.Spec.Limits.ReadBatchSize = ptr.To[uint64](10)
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		err = r.client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testObj.GetRuntimeLimitsConfigMapName()}, cm)
+		assert.NoError(t, err)
+		s, err = limits.Unmarshal([]byte(cm.Data[dfv1.KeyRuntimeLimits]))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(10), s.ReadBatchSize)
+		assert.Equal(t, hash, testObj.Status.UpdateHash)
+
+		// changing a limit which is not reloadable changes the hash
+		testObj.Spec.Limits.BufferMaxLength = ptr.To[uint64](1000)
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		assert.NotEqual(t, hash, testObj.Status.UpdateHash)
+	})
+
 	t.Run("test reconcile reduce udf", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
 		ctx := context.TODO()
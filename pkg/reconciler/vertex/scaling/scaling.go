@@ -137,6 +137,9 @@ func (s *Scaler) scale(ctx context.Context, id int, keyCh <-chan string) {
 //	singleReplicaContribution = (totalAvailableBufferLength - pending) / currentReplicas
 //	desiredReplicas = targetAvailableBufferLength / singleReplicaContribution
 //
+// If the target pending age is configured for UDF and sinks, the desired replicas are weighted by the age of the oldest
+// pending message, see weightByPendingAge().
+//
 // Back pressure factor
 // When desiredReplicas > currentReplicas:
 // If there's back pressure in the directly connected vertices, desiredReplicas = currentReplicas-1;
@@ -275,6 +278,7 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	// the max desired replicas among all the partitions.
 	partitionRates := make([]float64, 0)
 	partitionPending := make([]int64, 0)
+	partitionPendingAges := make([]int64, 0)
 	totalRate := float64(0)
 	totalPending := int64(0)
 	for _, m := range vMetrics {
@@ -295,6 +299,13 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 		}
 		totalPending += pending.GetValue()
 		partitionPending = append(partitionPending, pending.GetValue())
+
+		// The age of the oldest pending message is optional, -1 means not available.
+		pendingAge := int64(-1)
+		if age := m.GetOldestPendingAge(); age != nil {
+			pendingAge = age.GetValue()
+		}
+		partitionPendingAges = append(partitionPendingAges, pendingAge)
 	}
 
 	// Add pending information to cache for back pressure calculation, if there is a backpressure it will impact all the partitions.
@@ -311,7 +322,7 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	if totalPending == 0 && totalRate == 0 {
 		desired = 0
 	} else {
-		desired = s.desiredReplicas(ctx, vertex, partitionRates, partitionPending, partitionBufferLengths, partitionAvailableBufferLengths)
+		desired = s.desiredReplicas(ctx, vertex, partitionRates, partitionPending, partitionBufferLengths, partitionAvailableBufferLengths, partitionPendingAges)
 	}
 	log.Infof("Calculated desired replica number of vertex %q is: %d.", vertex.Name, desired)
 	max := vertex.Spec.Scale.GetMaxReplicas()
@@ -368,7 +379,7 @@ func (s *Scaler) scaleOneVertex(ctx context.Context, key string, worker int) err
 	return nil
 }
 
-func (s *Scaler) desiredReplicas(_ context.Context, vertex *dfv1.Vertex, partitionProcessingRate []float64, partitionPending []int64, partitionBufferLengths []int64, partitionAvailableBufferLengths []int64) int32 {
+func (s *Scaler) desiredReplicas(_ context.Context, vertex *dfv1.Vertex, partitionProcessingRate []float64, partitionPending []int64, partitionBufferLengths []int64, partitionAvailableBufferLengths []int64, partitionPendingAges []int64) int32 {
 	maxDesired := int32(1)
 	// We calculate the max desired replicas based on the pending messages and processing rate for each partition.
 	for i := 0; i < len(partitionPending); i++ {
@@ -396,6 +407,9 @@ func (s *Scaler) desiredReplicas(_ context.Context, vertex *dfv1.Vertex, partiti
 			} else {
 				singleReplicaContribution := float64(partitionBufferLengths[i]-pending) / float64(vertex.Status.ReadyReplicas)
 				desired = int32(math.Round(float64(partitionAvailableBufferLengths[i]) / singleReplicaContribution))
+				if i < len(partitionPendingAges) {
+					desired = weightByPendingAge(desired, int32(vertex.Status.Replicas), int32(vertex.Status.ReadyReplicas), partitionPendingAges[i], vertex.Spec.Scale.GetTargetPendingAgeSeconds())
+				}
 			}
 		}
 		// we only scale down to zero when the total pending and total rate are both zero.
@@ -413,6 +427,31 @@ func (s *Scaler) desiredReplicas(_ context.Context, vertex *dfv1.Vertex, partiti
 	return maxDesired
 }
 
+// weightByPendingAge weights the desired replicas calculated from the buffer availability with the age of the oldest
+// pending message (in milliseconds), against the target pending age (in seconds).
+//
+//	ageFactor = pendingAge / targetPendingAge
+//
+// If ageFactor < 1, the pending messages are fresh, a scale up is damped by the factor,
+// desiredReplicas = currentReplicas + (desiredReplicas - currentReplicas) * ageFactor.
+// If ageFactor > 1, the pending messages are too old, the desired replicas are at least readyReplicas * ageFactor.
+// The desired replicas are returned as is if the target is not configured, or the age is not available.
+func weightByPendingAge(desired, current, readyReplicas int32, pendingAgeMillis int64, targetPendingAgeSeconds int) int32 {
+	if targetPendingAgeSeconds <= 0 || pendingAgeMillis < 0 {
+		return desired
+	}
+	ageFactor := float64(pendingAgeMillis) / float64(targetPendingAgeSeconds*1000)
+	if ageFactor < 1 && desired > current {
+		return current + int32(math.Round(float64(desired-current)*ageFactor))
+	}
+	if ageFactor > 1 {
+		if x := int32(math.Ceil(float64(max(readyReplicas, 1)) * ageFactor)); x > desired {
+			return x
+		}
+	}
+	return desired
+}
+
 // Start function starts the autoscaling worker group.
 // Each worker keeps picking up scaling tasks (which contains vertex keys) to calculate the desired replicas,
 // and patch the vertex spec with the new replica number if needed.
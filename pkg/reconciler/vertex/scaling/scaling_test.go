@@ -64,14 +64,14 @@ func Test_desiredReplicasSinglePartition(t *testing.T) {
 		src.Spec.Source = &dfv1.Source{
 			Kafka: &dfv1.KafkaSource{},
 		}
-		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{0}, []int64{0}, []int64{10000}, []int64{5000}))
-		assert.Equal(t, int32(8), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{10010}, []int64{30000}, []int64{20000}))
-		assert.Equal(t, int32(8), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{9950}, []int64{30000}, []int64{20000}))
-		assert.Equal(t, int32(7), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{8751}, []int64{30000}, []int64{20000}))
-		assert.Equal(t, int32(7), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{8749}, []int64{30000}, []int64{20000}))
-		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{0}, []int64{9950}, []int64{30000}, []int64{20000}))
-		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{2}, []int64{30000}, []int64{20000}))
-		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{0}, []int64{30000}, []int64{20000}))
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{0}, []int64{0}, []int64{10000}, []int64{5000}, nil))
+		assert.Equal(t, int32(8), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{10010}, []int64{30000}, []int64{20000}, nil))
+		assert.Equal(t, int32(8), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{9950}, []int64{30000}, []int64{20000}, nil))
+		assert.Equal(t, int32(7), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{8751}, []int64{30000}, []int64{20000}, nil))
+		assert.Equal(t, int32(7), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{8749}, []int64{30000}, []int64{20000}, nil))
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{0}, []int64{9950}, []int64{30000}, []int64{20000}, nil))
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{2}, []int64{30000}, []int64{20000}, nil))
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), src, []float64{2500}, []int64{0}, []int64{30000}, []int64{20000}, nil))
 
 	})
 
@@ -80,14 +80,14 @@ func Test_desiredReplicasSinglePartition(t *testing.T) {
 		s := NewScaler(cl)
 		udf := fakeVertex.DeepCopy()
 		udf.Spec.UDF = &dfv1.UDF{}
-		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{0}, []int64{0}, []int64{10000}, []int64{5000}))
-		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{5000}))
-		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{6000}))
-		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{7500}))
-		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{7900}))
-		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{10000}))
-		assert.Equal(t, int32(3), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{12500}))
-		assert.Equal(t, int32(3), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{12550}))
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{0}, []int64{0}, []int64{10000}, []int64{5000}, nil))
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{5000}, nil))
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{6000}, nil))
+		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{7500}, nil))
+		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{7900}, nil))
+		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{10000}, nil))
+		assert.Equal(t, int32(3), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{12500}, nil))
+		assert.Equal(t, int32(3), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{10000}, []int64{20000}, []int64{12550}, nil))
 	})
 
 }
@@ -108,9 +108,69 @@ func Test_desiredReplicasMultiplePartitions(t *testing.T) {
 		},
 	}
 
-	assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{0, 0, 1}, []int64{0, 0, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
-	assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 10000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
-	assert.Equal(t, int32(30), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 23000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
-	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 30000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
-	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{1000, 3000, 1000}, []int64{0, 27000, 3000}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}))
+	assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{0, 0, 1}, []int64{0, 0, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}, nil))
+	assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 10000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}, nil))
+	assert.Equal(t, int32(30), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 23000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}, nil))
+	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{5000, 3000, 5000}, []int64{0, 30000, 1}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}, nil))
+	assert.Equal(t, int32(4), s.desiredReplicas(context.TODO(), udf, []float64{1000, 3000, 1000}, []int64{0, 27000, 3000}, []int64{24000, 24000, 24000}, []int64{15000, 15000, 15000}, nil))
+}
+
+func Test_desiredReplicasWithPendingAge(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	s := NewScaler(cl)
+	udf := &dfv1.Vertex{
+		Spec: dfv1.VertexSpec{
+			Replicas: ptr.To[int32](2),
+			AbstractVertex: dfv1.AbstractVertex{
+				UDF: &dfv1.UDF{},
+				Scale: dfv1.Scale{
+					TargetPendingAgeSeconds: ptr.To[uint32](60),
+				},
+			},
+		},
+		Status: dfv1.VertexStatus{
+			Replicas:      uint32(2),
+			ReadyReplicas: uint32(2),
+		},
+	}
+
+	t.Run("big fresh backlog", func(t *testing.T) {
+		assert.Equal(t, int32(6), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{15000}, []int64{20000}, []int64{15000}, nil))
+		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{250}, []int64{15000}, []int64{20000}, []int64{15000}, []int64{6000}))
+	})
+
+	t.Run("small old backlog", func(t *testing.T) {
+		assert.Equal(t, int32(2), s.desiredReplicas(context.TODO(), udf, []float64{1}, []int64{10}, []int64{20000}, []int64{15000}, nil))
+		assert.Equal(t, int32(6), s.desiredReplicas(context.TODO(), udf, []float64{1}, []int64{10}, []int64{20000}, []int64{15000}, []int64{180000}))
+	})
+
+	t.Run("empty buffer", func(t *testing.T) {
+		assert.Equal(t, int32(1), s.desiredReplicas(context.TODO(), udf, []float64{0}, []int64{0}, []int64{20000}, []int64{15000}, []int64{-1}))
+	})
+}
+
+func Test_weightByPendingAge(t *testing.T) {
+	tests := []struct {
+		name             string
+		desired          int32
+		current          int32
+		readyReplicas    int32
+		pendingAge       int64
+		targetPendingAge int
+		expected         int32
+	}{
+		{name: "target not configured", desired: 5, current: 2, readyReplicas: 2, pendingAge: 1000, targetPendingAge: 0, expected: 5},
+		{name: "age not available", desired: 5, current: 2, readyReplicas: 2, pendingAge: -1, targetPendingAge: 60, expected: 5},
+		{name: "fresh backlog damps scaling up", desired: 10, current: 2, readyReplicas: 2, pendingAge: 6000, targetPendingAge: 60, expected: 3},
+		{name: "fresh backlog keeps scaling down", desired: 1, current: 2, readyReplicas: 2, pendingAge: 1000, targetPendingAge: 60, expected: 1},
+		{name: "age at target", desired: 4, current: 2, readyReplicas: 2, pendingAge: 60000, targetPendingAge: 60, expected: 4},
+		{name: "old backlog scales up", desired: 1, current: 2, readyReplicas: 2, pendingAge: 180000, targetPendingAge: 60, expected: 6},
+		{name: "old backlog without ready replicas", desired: 1, current: 0, readyReplicas: 0, pendingAge: 120000, targetPendingAge: 60, expected: 2},
+		{name: "old backlog keeps bigger desired", desired: 10, current: 2, readyReplicas: 2, pendingAge: 90000, targetPendingAge: 60, expected: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, weightByPendingAge(tt.desired, tt.current, tt.readyReplicas, tt.pendingAge, tt.targetPendingAge))
+		})
+	}
 }
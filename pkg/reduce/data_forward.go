@@ -99,6 +99,9 @@ func NewDataForward(ctx context.Context,
 			return nil, err
 		}
 	}
+	if err := forwarder.ValidateWriterNames(toBuffers); err != nil {
+		return nil, err
+	}
 
 	rl := &DataForward{
 		ctx:                 ctx,
@@ -90,6 +90,10 @@ func (e *EventTypeWMProgressor) PublishIdleWatermark(wmb.Watermark, isb.Offset,
 	// TODO
 }
 
+func (e *EventTypeWMProgressor) PublishSkippedWatermark(wmb.Watermark, isb.Offset, int32) bool {
+	return false
+}
+
 func (e *EventTypeWMProgressor) GetLatestWatermark() wmb.Watermark {
 	return wmb.Watermark{}
 }
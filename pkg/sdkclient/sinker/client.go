@@ -37,7 +37,13 @@ type client struct {
 	conn       *grpc.ClientConn
 	grpcClt    sinkpb.SinkClient
 	sinkStream sinkpb.Sink_SinkFnClient
-	log        *zap.SugaredLogger
+	// streamCtx is the parent context of every sink stream created by the client.
+	streamCtx context.Context
+	// cancelStream cancels the current sink stream, it is invoked when a SinkFn call runs past its deadline.
+	cancelStream context.CancelFunc
+	// handshake indicates whether a handshake has to be performed on a newly created sink stream.
+	handshake bool
+	log       *zap.SugaredLogger
 }
 
 var _ Client = (*client)(nil)
@@ -76,28 +82,11 @@ waitUntilReady:
 		}
 	}
 
-	// Create the sink stream
-	c.sinkStream, err = c.grpcClt.SinkFn(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create sink stream: %v", err)
-	}
-
-	// Perform handshake
-	handshakeRequest := &sinkpb.SinkRequest{
-		Handshake: &sinkpb.Handshake{
-			Sot: true,
-		},
-	}
-	if err := c.sinkStream.Send(handshakeRequest); err != nil {
-		return nil, fmt.Errorf("failed to send handshake request: %v", err)
-	}
-
-	handshakeResponse, err := c.sinkStream.Recv()
-	if err != nil {
-		return nil, fmt.Errorf("failed to receive handshake response: %v", err)
-	}
-	if handshakeResponse.GetHandshake() == nil || !handshakeResponse.GetHandshake().GetSot() {
-		return nil, fmt.Errorf("invalid handshake response")
+	// Create the sink stream and perform the handshake
+	c.streamCtx = ctx
+	c.handshake = true
+	if err := c.createStream(); err != nil {
+		return nil, err
 	}
 
 	return c, nil
@@ -114,16 +103,67 @@ func NewFromClient(ctx context.Context, sinkClient sinkpb.SinkClient, inputOptio
 
 	c := new(client)
 	c.grpcClt = sinkClient
+	c.log = logging.FromContext(ctx)
 
 	// Create the sink stream
-	c.sinkStream, err = c.grpcClt.SinkFn(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create sink stream: %v", err)
+	c.streamCtx = ctx
+	if err = c.createStream(); err != nil {
+		return nil, err
 	}
 
 	return c, nil
 }
 
+// createStream creates a new sink stream, and performs the handshake if required.
+func (c *client) createStream() error {
+	streamCtx, cancel := context.WithCancel(c.streamCtx)
+	sinkStream, err := c.grpcClt.SinkFn(streamCtx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to create sink stream: %v", err)
+	}
+
+	if c.handshake {
+		// Perform handshake
+		handshakeRequest := &sinkpb.SinkRequest{
+			Handshake: &sinkpb.Handshake{
+				Sot: true,
+			},
+		}
+		if err := sinkStream.Send(handshakeRequest); err != nil {
+			cancel()
+			return fmt.Errorf("failed to send handshake request: %v", err)
+		}
+
+		handshakeResponse, err := sinkStream.Recv()
+		if err != nil {
+			cancel()
+			return fmt.Errorf("failed to receive handshake response: %v", err)
+		}
+		if handshakeResponse.GetHandshake() == nil || !handshakeResponse.GetHandshake().GetSot() {
+			cancel()
+			return fmt.Errorf("invalid handshake response")
+		}
+	}
+
+	c.sinkStream = sinkStream
+	c.cancelStream = cancel
+	return nil
+}
+
+// abort is invoked when a SinkFn call fails after its context is done. The in-flight stream is in an unknown
+// state (the server may still be processing the batch), so it is discarded and a new one is created for the
+// next call.
+func (c *client) abort(ctx context.Context, err error) error {
+	if c.cancelStream != nil {
+		c.cancelStream()
+	}
+	if createErr := c.createStream(); createErr != nil {
+		c.log.Errorw("Failed to recreate the sink stream", zap.Error(createErr))
+	}
+	return fmt.Errorf("sink request aborted, %v: %w", err, ctx.Err())
+}
+
 // CloseConn closes the grpc client connection.
 func (c *client) CloseConn(ctx context.Context) error {
 	if c.conn == nil {
@@ -145,11 +185,18 @@ func (c *client) IsReady(ctx context.Context, in *emptypb.Empty) (bool, error) {
 	return resp.GetReady(), nil
 }
 
-// SinkFn applies a function to a list of requests.
+// SinkFn applies a function to a list of requests. The deadline of ctx is propagated to the server
+// by cancelling the sink stream when ctx is done before all the responses are received.
 func (c *client) SinkFn(ctx context.Context, requests []*sinkpb.SinkRequest) ([]*sinkpb.SinkResponse, error) {
+	stop := context.AfterFunc(ctx, c.cancelStream)
+	defer stop()
+
 	// Stream the array of sink requests
 	for _, req := range requests {
 		if err := c.sinkStream.Send(req); err != nil {
+			if ctx.Err() != nil {
+				return nil, c.abort(ctx, err)
+			}
 			return nil, fmt.Errorf("failed to send sink request: %v", err)
 		}
 	}
@@ -162,6 +209,9 @@ func (c *client) SinkFn(ctx context.Context, requests []*sinkpb.SinkRequest) ([]
 	}
 
 	if err := c.sinkStream.Send(eotRequest); err != nil {
+		if ctx.Err() != nil {
+			return nil, c.abort(ctx, err)
+		}
 		return nil, fmt.Errorf("failed to send eot request: %v", err)
 	}
 
@@ -171,6 +221,9 @@ func (c *client) SinkFn(ctx context.Context, requests []*sinkpb.SinkRequest) ([]
 	for {
 		resp, err := c.sinkStream.Recv()
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, c.abort(ctx, err)
+			}
 			return nil, fmt.Errorf("failed to receive sink response: %v", err)
 		}
 		if resp.GetStatus() != nil && resp.GetStatus().GetEot() {
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafka provides the client to connect the Kafka ISB Service.
+//
+// Function NewInClusterKafkaClient() assumes the invoker is in a Kubernetes cluster, and there are several environment
+// variables are available, which are used to connect to the Kafka cluster. Those environment variables include:
+//
+// NUMAFLOW_ISBSVC_KAFKA_BROKERS, NUMAFLOW_ISBSVC_KAFKA_TLS_ENABLED (optional), NUMAFLOW_ISBSVC_KAFKA_TLS (optional),
+// NUMAFLOW_ISBSVC_KAFKA_SASL_MECHANISM (optional), NUMAFLOW_ISBSVC_KAFKA_SASL_USER (optional),
+// NUMAFLOW_ISBSVC_KAFKA_SASL_PASSWORD (optional) and NUMAFLOW_ISBSVC_KAFKA_CONFIG (optional)
+package kafka
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM/sarama"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	kafkastore "github.com/numaproj/numaflow/pkg/isb/stores/kafka"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// NewInClusterKafkaClient returns a new Kafka client, it assumes it's in a vertex, daemon or job pod, where those
+// required environment variables are available.
+func NewInClusterKafkaClient() (sarama.Client, error) {
+	brokers, config, err := configFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the Kafka brokers %v, %w", brokers, err)
+	}
+	return client, nil
+}
+
+// configFromEnv returns the brokers and the Sarama config of the Kafka ISB Service from the environment variables.
+func configFromEnv() ([]string, *sarama.Config, error) {
+	v, existing := os.LookupEnv(dfv1.EnvISBSvcKafkaBrokers)
+	if !existing || v == "" {
+		return nil, nil, fmt.Errorf("environment variable %q not found", dfv1.EnvISBSvcKafkaBrokers)
+	}
+	brokers := strings.Split(v, ",")
+	config, err := kafkastore.NewSaramaConfig(os.Getenv(dfv1.EnvISBSvcKafkaConfig))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the Kafka config, %w", err)
+	}
+	if sharedutil.LookupEnvStringOr(dfv1.EnvISBSvcKafkaTLSEnabled, "false") == "true" {
+		tlsConfig, err := sharedutil.GetTLSConfigFromEnv(dfv1.EnvISBSvcKafkaTLS)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get the TLS config, %w", err)
+		}
+		if tlsConfig == nil {
+			// the broker certificates are verified with the system CAs
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	if user, existing := os.LookupEnv(dfv1.EnvISBSvcKafkaSASLUser); existing {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = user
+		config.Net.SASL.Password = os.Getenv(dfv1.EnvISBSvcKafkaSASLPassword)
+		switch mechanism := dfv1.SASLType(sharedutil.LookupEnvStringOr(dfv1.EnvISBSvcKafkaSASLMechanism, string(dfv1.SASLTypePlaintext))); mechanism {
+		case dfv1.SASLTypePlaintext:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case dfv1.SASLTypeSCRAMSHA256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &sharedutil.XDGSCRAMClient{HashGeneratorFcn: sharedutil.SHA256}
+			}
+		case dfv1.SASLTypeSCRAMSHA512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &sharedutil.XDGSCRAMClient{HashGeneratorFcn: sharedutil.SHA512}
+			}
+		default:
+			return nil, nil, fmt.Errorf("SASL mechanism not supported: %s", mechanism)
+		}
+	}
+	return brokers, config, nil
+}
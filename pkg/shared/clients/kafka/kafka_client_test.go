@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("no brokers", func(t *testing.T) {
+		_, _, err := configFromEnv()
+		assert.ErrorContains(t, err, dfv1.EnvISBSvcKafkaBrokers)
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		t.Setenv(dfv1.EnvISBSvcKafkaBrokers, "broker-0:9092,broker-1:9092")
+		t.Setenv(dfv1.EnvISBSvcKafkaConfig, "consumer:\n  fetch:\n    min: 10\n")
+		brokers, config, err := configFromEnv()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"broker-0:9092", "broker-1:9092"}, brokers)
+		assert.Equal(t, int32(10), config.Consumer.Fetch.Min)
+		assert.Equal(t, sarama.OffsetOldest, config.Consumer.Offsets.Initial)
+		assert.False(t, config.Net.TLS.Enable)
+		assert.False(t, config.Net.SASL.Enable)
+	})
+
+	t.Run("tls and sasl", func(t *testing.T) {
+		t.Setenv(dfv1.EnvISBSvcKafkaBrokers, "broker-0:9092")
+		t.Setenv(dfv1.EnvISBSvcKafkaTLSEnabled, "true")
+		t.Setenv(dfv1.EnvISBSvcKafkaSASLMechanism, string(dfv1.SASLTypeSCRAMSHA512))
+		t.Setenv(dfv1.EnvISBSvcKafkaSASLUser, "user")
+		t.Setenv(dfv1.EnvISBSvcKafkaSASLPassword, "password")
+		_, config, err := configFromEnv()
+		assert.NoError(t, err)
+		assert.True(t, config.Net.TLS.Enable)
+		assert.NotNil(t, config.Net.TLS.Config)
+		assert.True(t, config.Net.SASL.Enable)
+		assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), config.Net.SASL.Mechanism)
+		assert.Equal(t, "user", config.Net.SASL.User)
+		assert.Equal(t, "password", config.Net.SASL.Password)
+		assert.NotNil(t, config.Net.SASL.SCRAMClientGeneratorFunc)
+	})
+
+	t.Run("invalid tls config", func(t *testing.T) {
+		t.Setenv(dfv1.EnvISBSvcKafkaBrokers, "broker-0:9092")
+		t.Setenv(dfv1.EnvISBSvcKafkaTLSEnabled, "true")
+		t.Setenv(dfv1.EnvISBSvcKafkaTLS, "{")
+		_, _, err := configFromEnv()
+		assert.ErrorContains(t, err, "failed to get the TLS config")
+	})
+
+	t.Run("unsupported mechanism", func(t *testing.T) {
+		t.Setenv(dfv1.EnvISBSvcKafkaBrokers, "broker-0:9092")
+		t.Setenv(dfv1.EnvISBSvcKafkaSASLMechanism, string(dfv1.SASLTypeGSSAPI))
+		t.Setenv(dfv1.EnvISBSvcKafkaSASLUser, "user")
+		_, _, err := configFromEnv()
+		assert.ErrorContains(t, err, "SASL mechanism not supported")
+	})
+}
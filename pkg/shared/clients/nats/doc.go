@@ -23,7 +23,8 @@ limitations under the License.
 // there are several environment variables are available, which are used to connect to the Nats
 // JetStream server. Those environment variables include:
 //
-// NUMAFLOW_ISBSVC_JETSTREAM_URL, NUMAFLOW_ISBSVC_JETSTREAM_USER, NUMAFLOW_ISBSVC_JETSTREAM_PASSWORD, NUMAFLOW_ISBSVC_JETSTREAM_TLS_ENABLED (optional)
+// NUMAFLOW_ISBSVC_JETSTREAM_URL, NUMAFLOW_ISBSVC_JETSTREAM_USER, NUMAFLOW_ISBSVC_JETSTREAM_PASSWORD, NUMAFLOW_ISBSVC_JETSTREAM_TLS_ENABLED (optional),
+// NUMAFLOW_ISBSVC_JETSTREAM_TLS (optional, the JSON encoded TLS config of the client)
 //
 
 package nats
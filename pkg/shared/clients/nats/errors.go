@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"errors"
+
+	"github.com/nats-io/nats.go"
+)
+
+// The JetStream API error codes of the server indicating the cluster or the stream can not serve the request because
+// there's no leader, see https://github.com/nats-io/nats-server/blob/main/server/errors.json
+const (
+	// jsErrCodeClusterNotAvailable is "JetStream system temporarily unavailable", e.g. the meta leader is down.
+	jsErrCodeClusterNotAvailable nats.ErrorCode = 10008
+	// jsErrCodeClusterNotLeader is "JetStream cluster can not handle request".
+	jsErrCodeClusterNotLeader nats.ErrorCode = 10009
+	// jsErrCodeStreamOffline is "stream is offline".
+	jsErrCodeStreamOffline nats.ErrorCode = 10118
+)
+
+// IsNoLeaderErr returns true if the error is caused by the JetStream meta leader or the stream leader being absent,
+// the request can be retried once a new leader is elected.
+func IsNoLeaderErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	// a stream without a leader doesn't respond to the publishing requests
+	if errors.Is(err, nats.ErrNoStreamResponse) || errors.Is(err, nats.ErrNoResponders) {
+		return true
+	}
+	var apiErr *nats.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode {
+		case jsErrCodeClusterNotAvailable, jsErrCodeClusterNotLeader, jsErrCodeStreamOffline:
+			return true
+		}
+	}
+	return false
+}
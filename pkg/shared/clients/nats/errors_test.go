@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNoLeaderErr(t *testing.T) {
+	assert.False(t, IsNoLeaderErr(nil))
+	assert.False(t, IsNoLeaderErr(errors.New("something went wrong")))
+	assert.False(t, IsNoLeaderErr(nats.ErrTimeout))
+	assert.True(t, IsNoLeaderErr(nats.ErrNoStreamResponse))
+	assert.True(t, IsNoLeaderErr(fmt.Errorf("failed to publish, %w", nats.ErrNoResponders)))
+	assert.True(t, IsNoLeaderErr(&nats.APIError{Code: 503, ErrorCode: jsErrCodeClusterNotAvailable, Description: "JetStream system temporarily unavailable"}))
+	assert.True(t, IsNoLeaderErr(fmt.Errorf("failed, %w", &nats.APIError{Code: 500, ErrorCode: jsErrCodeStreamOffline, Description: "stream is offline"})))
+	assert.False(t, IsNoLeaderErr(&nats.APIError{Code: 404, ErrorCode: nats.JSErrCodeStreamNotFound, Description: "stream not found"}))
+}
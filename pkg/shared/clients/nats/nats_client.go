@@ -99,9 +99,15 @@ func NewNATSClient(ctx context.Context, natsOptions ...nats.Option) (*Client, er
 	// Pass nats options for username password
 	opts = append(opts, nats.UserInfo(user, password))
 	if sharedutil.LookupEnvStringOr(dfv1.EnvISBSvcJetStreamTLSEnabled, "false") == "true" {
-		opts = append(opts, nats.Secure(&tls.Config{
-			InsecureSkipVerify: true,
-		}))
+		tlsConfig, err := sharedutil.GetTLSConfigFromEnv(dfv1.EnvISBSvcJetStreamTLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the TLS config, %w", err)
+		}
+		if tlsConfig == nil {
+			// the server certificate is not verified without a TLS config
+			tlsConfig = &tls.Config{InsecureSkipVerify: true}
+		}
+		opts = append(opts, nats.Secure(tlsConfig))
 	}
 
 	opts = append(opts, natsOptions...)
@@ -175,7 +181,7 @@ func (c *Client) Close() {
 
 // NewTestClient creates a new NATS client for testing
 // only use this for testing
-func NewTestClient(t *testing.T, url string) *Client {
+func NewTestClient(t testing.TB, url string) *Client {
 	nc, err := nats.Connect(url)
 	if err != nil {
 		panic(err)
@@ -184,6 +190,6 @@ func NewTestClient(t *testing.T, url string) *Client {
 }
 
 // JetStreamClient is used to get a testing JetStream client instance
-func NewTestClientWithServer(t *testing.T, s *server.Server) *Client {
+func NewTestClientWithServer(t testing.TB, s *server.Server) *Client {
 	return NewTestClient(t, s.ClientURL())
 }
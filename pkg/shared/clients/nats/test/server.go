@@ -32,7 +32,7 @@ func RunNatsServer(t *testing.T) *server.Server {
 }
 
 // RunJetStreamServer starts a jetstream server
-func RunJetStreamServer(t *testing.T) *server.Server {
+func RunJetStreamServer(t testing.TB) *server.Server {
 	t.Helper()
 	opts := natstestserver.DefaultTestOptions
 	opts.Port = -1 // Use random port to avoid conflicts
@@ -46,7 +46,7 @@ func RunJetStreamServer(t *testing.T) *server.Server {
 }
 
 // ShutdownJetStreamServer shuts down the jetstream server and clean up resources
-func ShutdownJetStreamServer(t *testing.T, s *server.Server) {
+func ShutdownJetStreamServer(t testing.TB, s *server.Server) {
 	t.Helper()
 	var sd string
 	if config := s.JetStreamConfig(); config != nil {
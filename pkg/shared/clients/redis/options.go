@@ -42,6 +42,8 @@ type Options struct {
 	RefreshBufferWriteInfo bool
 	// BufferFullWritingStrategy is the writing strategy when buffer is full
 	BufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// VertexInstance is the vertex instance reading the buffer, it is used to label the ack metrics
+	VertexInstance *dfv1.VertexInstance
 }
 
 // Option to apply different options
@@ -156,3 +158,17 @@ func (s bufferFullWritingStrategy) Apply(o *Options) {
 func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) Option {
 	return bufferFullWritingStrategy(s)
 }
+
+// vertexInstance option
+type vertexInstance struct {
+	vi *dfv1.VertexInstance
+}
+
+func (v vertexInstance) Apply(o *Options) {
+	o.VertexInstance = v.vi
+}
+
+// WithVertexInstance sets the vertex instance reading the buffer
+func WithVertexInstance(vi *dfv1.VertexInstance) Option {
+	return vertexInstance{vi: vi}
+}
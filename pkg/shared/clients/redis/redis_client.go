@@ -26,6 +26,7 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 )
 
 const ReadFromEarliest = "0-0"
@@ -50,7 +51,7 @@ func NewRedisClient(options *redis.UniversalOptions) *RedisClient {
 
 // NewInClusterRedisClient returns a new Redis Client, it assumes it's in a vertex pod,
 // where those required environment variables are available.
-func NewInClusterRedisClient() *RedisClient {
+func NewInClusterRedisClient() (*RedisClient, error) {
 	opts := &redis.UniversalOptions{
 		Username:   os.Getenv(v1alpha1.EnvISBSvcRedisUser),
 		Password:   os.Getenv(v1alpha1.EnvISBSvcRedisPassword),
@@ -76,8 +77,13 @@ func NewInClusterRedisClient() *RedisClient {
 	if i, e := strconv.Atoi(os.Getenv(v1alpha1.EnvISBSvcRedisClusterMaxRedirects)); e == nil {
 		opts.MaxRedirects = i
 	}
+	tlsConfig, err := sharedutil.GetTLSConfigFromEnv(v1alpha1.EnvISBSvcRedisTLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the TLS config, %w", err)
+	}
+	opts.TLSConfig = tlsConfig
 
-	return NewRedisClient(opts)
+	return NewRedisClient(opts), nil
 }
 
 // CreateStreamGroup creates a redis stream group and creates an empty stream if it does not exist.
@@ -153,3 +159,22 @@ func NotFoundError(err error) bool {
 func GetRedisStreamName(s string) string {
 	return fmt.Sprintf("{%s}", s)
 }
+
+// GetRedisPausedKeyName returns the name of the key marking the stream of the buffer as paused, it's in the same hash
+// slot as the stream.
+func GetRedisPausedKeyName(s string) string {
+	return fmt.Sprintf("{%s}-paused", s)
+}
+
+// GetRedisRetentionKeyName returns the name of the hash holding the retention of the stream of the buffer, it's in the
+// same hash slot as the stream.
+func GetRedisRetentionKeyName(s string) string {
+	return fmt.Sprintf("{%s}-retention", s)
+}
+
+// The fields of the retention hash, see GetRedisRetentionKeyName.
+const (
+	RetentionFieldMaxAge      = "maxAge"
+	RetentionFieldMaxMessages = "maxMessages"
+	RetentionFieldDiscard     = "discard"
+)
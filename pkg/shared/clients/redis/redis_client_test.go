@@ -76,14 +76,27 @@ func TestNewInClusterRedisClient(t *testing.T) {
 	setEnv(v1alpha1.EnvISBSvcRedisURL, ":6379")
 	setEnv(v1alpha1.EnvISBSvcRedisClusterMaxRedirects, "5")
 
-	client := NewInClusterRedisClient()
+	client, err := NewInClusterRedisClient()
+	assert.NoError(t, err)
 	assert.NotNil(t, client)
+	assert.Nil(t, client.Client.(*redis.Client).Options().TLSConfig)
+
+	setEnv(v1alpha1.EnvISBSvcRedisTLS, `{"insecureSkipVerify":true}`)
+	client, err = NewInClusterRedisClient()
+	assert.NoError(t, err)
+	assert.True(t, client.Client.(*redis.Client).Options().TLSConfig.InsecureSkipVerify)
+
+	// the secrets of the TLS config are not mounted
+	setEnv(v1alpha1.EnvISBSvcRedisTLS, `{"caCertSecret":{"name":"redis-tls","key":"ca.crt"}}`)
+	_, err = NewInClusterRedisClient()
+	assert.ErrorContains(t, err, "failed to get the TLS config")
 
 	// Cleanup environment variables
 	unsetEnv(v1alpha1.EnvISBSvcRedisUser)
 	unsetEnv(v1alpha1.EnvISBSvcRedisPassword)
 	unsetEnv(v1alpha1.EnvISBSvcRedisURL)
 	unsetEnv(v1alpha1.EnvISBSvcRedisClusterMaxRedirects)
+	unsetEnv(v1alpha1.EnvISBSvcRedisTLS)
 }
 
 func TestErrorHelpers(t *testing.T) {
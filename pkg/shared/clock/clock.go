@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clock provides the clock abstraction used by the time sensitive components, so that they can be driven by
+// a fake clock in the tests.
+package clock
+
+import (
+	"time"
+
+	k8sclock "k8s.io/utils/clock"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+// Ticker is a ticker created by a Clock, the ticks are delivered on C().
+type Ticker = k8sclock.Ticker
+
+// Clock is the source of the current time, tickers and timers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTicker returns a new Ticker firing every d.
+	NewTicker(d time.Duration) Ticker
+	// After returns a channel receiving the current time after d.
+	After(d time.Duration) <-chan time.Time
+}
+
+// FakeClock is a Clock which only moves forward when it is stepped, it is meant for the tests.
+type FakeClock = testingclock.FakeClock
+
+var _ Clock = k8sclock.RealClock{}
+var _ Clock = (*FakeClock)(nil)
+
+// RealClock returns the Clock backed by the time package.
+func RealClock() Clock {
+	return k8sclock.RealClock{}
+}
+
+// NewFakeClock returns a FakeClock set to the given time.
+func NewFakeClock(t time.Time) *FakeClock {
+	return testingclock.NewFakeClock(t)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Unix(1636470000, 0)
+	var c Clock = NewFakeClock(start)
+	ticker := c.NewTicker(time.Second)
+	defer ticker.Stop()
+	after := c.After(3 * time.Second)
+
+	fc := c.(*FakeClock)
+	fc.Step(time.Second)
+	assert.Equal(t, start.Add(time.Second), <-ticker.C())
+	assert.Len(t, after, 0)
+	fc.Step(2 * time.Second)
+	assert.Equal(t, start.Add(3*time.Second), <-after)
+	assert.Equal(t, start.Add(3*time.Second), c.Now())
+}
+
+func TestRealClock(t *testing.T) {
+	c := RealClock()
+	assert.WithinDuration(t, time.Now(), c.Now(), time.Second)
+	ticker := c.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	<-ticker.C()
+	<-c.After(time.Millisecond)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventtime extracts the event time from the message payloads, and applies the configured policy to the
+// messages whose event time is missing or can not be parsed.
+package eventtime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/araddon/dateparse"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+)
+
+const (
+	// ReasonMissing indicates that the event time attribute is missing.
+	ReasonMissing = "missing"
+	// ReasonMalformed indicates that the payload or the event time attribute can not be parsed.
+	ReasonMalformed = "malformed"
+)
+
+// nilValue is the string representation of an expression evaluated to nil, e.g. an attribute missing from the payload.
+const nilValue = "<nil>"
+
+// InvalidEventTimeErr is returned when the event time of a message is missing or can not be parsed.
+type InvalidEventTimeErr struct {
+	Reason string
+	Err    error
+}
+
+func (e *InvalidEventTimeErr) Error() string {
+	return fmt.Sprintf("%s event time, %v", e.Reason, e.Err)
+}
+
+func (e *InvalidEventTimeErr) Unwrap() error {
+	return e.Err
+}
+
+// Extract evaluates the expression against the payload and parses the result as the event time. With the format,
+// the time string is parsed by time.Parse, otherwise dateparse is used to find the format based on the time string.
+// An InvalidEventTimeErr is returned if the event time is missing or can not be parsed.
+func Extract(expression string, format string, payload []byte) (time.Time, error) {
+	timeStr, err := expr.EvalStr(expression, payload)
+	if err != nil {
+		return time.Time{}, &InvalidEventTimeErr{Reason: ReasonMalformed, Err: err}
+	}
+	if timeStr == "" || timeStr == nilValue {
+		return time.Time{}, &InvalidEventTimeErr{Reason: ReasonMissing, Err: fmt.Errorf("expression %q evaluated to nothing", expression)}
+	}
+	var et time.Time
+	time.Local, _ = time.LoadLocation("UTC")
+	if format != "" {
+		et, err = time.Parse(format, timeStr)
+	} else {
+		et, err = dateparse.ParseStrict(timeStr)
+	}
+	if err != nil {
+		return time.Time{}, &InvalidEventTimeErr{Reason: ReasonMalformed, Err: err}
+	}
+	return et, nil
+}
+
+// ParsePolicy parses the name of an InvalidEventTimePolicy, the default policy is returned if the name is empty.
+func ParsePolicy(name string, defaultPolicy dfv1.InvalidEventTimePolicy) (dfv1.InvalidEventTimePolicy, error) {
+	switch p := dfv1.InvalidEventTimePolicy(name); p {
+	case "":
+		return defaultPolicy, nil
+	case dfv1.InvalidEventTimeFallbackToNow, dfv1.InvalidEventTimeFallbackToIngestionTime, dfv1.InvalidEventTimeDrop, dfv1.InvalidEventTimeError:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unsupported invalid event time policy %q, supported policies are %q, %q, %q and %q", name,
+			dfv1.InvalidEventTimeFallbackToNow, dfv1.InvalidEventTimeFallbackToIngestionTime, dfv1.InvalidEventTimeDrop, dfv1.InvalidEventTimeError)
+	}
+}
+
+// Policy applies an InvalidEventTimePolicy to the messages whose event time is missing or can not be parsed, and
+// counts the outcomes.
+type Policy struct {
+	onInvalid dfv1.InvalidEventTimePolicy
+	vertex    string
+	pipeline  string
+}
+
+// NewPolicy returns a Policy of the vertex.
+func NewPolicy(onInvalid dfv1.InvalidEventTimePolicy, vertex, pipeline string) *Policy {
+	return &Policy{onInvalid: onInvalid, vertex: vertex, pipeline: pipeline}
+}
+
+// OnInvalid returns the InvalidEventTimePolicy applied.
+func (p *Policy) OnInvalid() dfv1.InvalidEventTimePolicy {
+	return p.onInvalid
+}
+
+// Apply returns the event time of a message whose event time is invalid because of the cause. The message should be
+// dropped if drop is true, and an error is returned to fail the read batch with the error policy.
+func (p *Policy) Apply(cause error, ingestionTime time.Time, now time.Time) (eventTime time.Time, drop bool, err error) {
+	reason := ReasonMalformed
+	var invalidErr *InvalidEventTimeErr
+	if errors.As(cause, &invalidErr) {
+		reason = invalidErr.Reason
+	}
+	metrics.InvalidEventTimeCount.With(map[string]string{
+		metrics.LabelVertex:   p.vertex,
+		metrics.LabelPipeline: p.pipeline,
+		metrics.LabelOutcome:  string(p.onInvalid),
+		metrics.LabelReason:   reason,
+	}).Inc()
+	switch p.onInvalid {
+	case dfv1.InvalidEventTimeFallbackToIngestionTime:
+		return ingestionTime, false, nil
+	case dfv1.InvalidEventTimeDrop:
+		return time.Time{}, true, nil
+	case dfv1.InvalidEventTimeError:
+		return time.Time{}, false, fmt.Errorf("failed to get the event time, %w", cause)
+	default:
+		return now, false, nil
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+func TestExtract(t *testing.T) {
+	et, err := Extract("json(payload).item[0].time", "", []byte(`{"item": [{"time": "2022-02-18T21:54:42.123Z"}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 2, 18, 21, 54, 42, 123000000, time.UTC), et)
+
+	et, err = Extract("json(payload).time", time.RFC3339, []byte(`{"time": "2022-02-18T21:54:42Z"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 2, 18, 21, 54, 42, 0, time.UTC), et)
+
+	tests := []struct {
+		name    string
+		format  string
+		payload string
+		reason  string
+	}{
+		{name: "missing attribute", payload: `{"other": "2022-02-18T21:54:42Z"}`, reason: ReasonMissing},
+		{name: "invalid json", payload: `{"time": `, reason: ReasonMalformed},
+		{name: "number", payload: `{"time": 123}`, reason: ReasonMalformed},
+		{name: "boolean", payload: `{"time": true}`, reason: ReasonMalformed},
+		{name: "object", payload: `{"time": {"a": 1}}`, reason: ReasonMalformed},
+		{name: "format mismatch", format: time.RFC3339, payload: `{"time": "2022/02/18"}`, reason: ReasonMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Extract("json(payload).time", tt.format, []byte(tt.payload))
+			var invalidErr *InvalidEventTimeErr
+			assert.ErrorAs(t, err, &invalidErr)
+			assert.Equal(t, tt.reason, invalidErr.Reason)
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy("", dfv1.InvalidEventTimeFallbackToIngestionTime)
+	assert.NoError(t, err)
+	assert.Equal(t, dfv1.InvalidEventTimeFallbackToIngestionTime, p)
+
+	p, err = ParsePolicy("drop", dfv1.InvalidEventTimeFallbackToIngestionTime)
+	assert.NoError(t, err)
+	assert.Equal(t, dfv1.InvalidEventTimeDrop, p)
+
+	_, err = ParsePolicy("ignore", dfv1.InvalidEventTimeFallbackToIngestionTime)
+	assert.Error(t, err)
+}
+
+func TestPolicy_Apply(t *testing.T) {
+	now := time.Unix(1636470000, 0)
+	ingestionTime := now.Add(-time.Second)
+	cause := &InvalidEventTimeErr{Reason: ReasonMissing, Err: assert.AnError}
+
+	count := func(p dfv1.InvalidEventTimePolicy) float64 {
+		return testutil.ToFloat64(metrics.InvalidEventTimeCount.With(map[string]string{
+			metrics.LabelVertex:   "test-vertex",
+			metrics.LabelPipeline: "test-pipeline",
+			metrics.LabelOutcome:  string(p),
+			metrics.LabelReason:   ReasonMissing,
+		}))
+	}
+
+	tests := []struct {
+		policy    dfv1.InvalidEventTimePolicy
+		eventTime time.Time
+		drop      bool
+		wantErr   bool
+	}{
+		{policy: dfv1.InvalidEventTimeFallbackToNow, eventTime: now},
+		{policy: dfv1.InvalidEventTimeFallbackToIngestionTime, eventTime: ingestionTime},
+		{policy: dfv1.InvalidEventTimeDrop, drop: true},
+		{policy: dfv1.InvalidEventTimeError, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			p := NewPolicy(tt.policy, "test-vertex", "test-pipeline")
+			assert.Equal(t, tt.policy, p.OnInvalid())
+			before := count(tt.policy)
+			eventTime, drop, err := p.Apply(cause, ingestionTime, now)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, assert.AnError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.eventTime, eventTime)
+			assert.Equal(t, tt.drop, drop)
+			assert.Equal(t, before+1, count(tt.policy))
+		})
+	}
+}
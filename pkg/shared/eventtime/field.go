@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// FromField returns the time in the field of the fields of a record, e.g. a JSON object decoded with UseNumber, the
+// field is the path of the nested fields. The numbers can also be strings, as the ones of a csv record. An
+// InvalidEventTimeErr is returned if the field is missing or can not be parsed in the unit.
+func FromField(fields map[string]interface{}, field []string, unit dfv1.EventTimeUnit) (time.Time, error) {
+	var v interface{} = fields
+	for _, f := range field {
+		obj, ok := v.(map[string]interface{})
+		if ok {
+			v, ok = obj[f]
+		}
+		if !ok || v == nil {
+			return time.Time{}, &InvalidEventTimeErr{
+				Reason: ReasonMissing,
+				Err:    fmt.Errorf("field %q not found", strings.Join(field, ".")),
+			}
+		}
+	}
+	t, err := parseTime(v, unit)
+	if err != nil {
+		return time.Time{}, &InvalidEventTimeErr{Reason: ReasonMalformed, Err: err}
+	}
+	if t.UnixNano() <= 0 {
+		return time.Time{}, &InvalidEventTimeErr{
+			Reason: ReasonMissing,
+			Err:    fmt.Errorf("invalid time %v in field %q", v, strings.Join(field, ".")),
+		}
+	}
+	return t, nil
+}
+
+// parseTime parses the value of a field in the unit, the seconds can have a fraction, which is rounded to
+// microseconds as a float64 can not hold more precision for the current times.
+func parseTime(v interface{}, unit dfv1.EventTimeUnit) (time.Time, error) {
+	var str string
+	switch x := v.(type) {
+	case string:
+		str = x
+	case json.Number:
+		if unit == dfv1.EventTimeUnitRFC3339 {
+			return time.Time{}, fmt.Errorf("%v is not a string", v)
+		}
+		str = x.String()
+	default:
+		return time.Time{}, fmt.Errorf("%v is neither a string nor a number", v)
+	}
+	switch unit {
+	case dfv1.EventTimeUnitRFC3339:
+		return time.Parse(time.RFC3339Nano, str)
+	case dfv1.EventTimeUnitSeconds:
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(math.Round(frac*1e6))*int64(time.Microsecond)), nil
+	default:
+		i, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if unit == dfv1.EventTimeUnitMillis {
+			return time.UnixMilli(i), nil
+		}
+		return time.Unix(0, i), nil
+	}
+}
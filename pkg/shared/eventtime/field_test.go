@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestFromField(t *testing.T) {
+	et := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	tests := []struct {
+		name    string
+		payload string
+		field   string
+		unit    dfv1.EventTimeUnit
+		reason  string
+	}{
+		{name: "nanos", payload: fmt.Sprintf(`{"eventTime": %d}`, et.UnixNano()), field: "eventTime", unit: dfv1.EventTimeUnitNanos},
+		{name: "millis", payload: fmt.Sprintf(`{"eventTime": %d}`, et.UnixMilli()), field: "eventTime", unit: dfv1.EventTimeUnitMillis},
+		{name: "seconds", payload: `{"eventTime": 1714979289.123}`, field: "eventTime", unit: dfv1.EventTimeUnitSeconds},
+		{name: "RFC3339", payload: `{"eventTime": "2024-05-06T07:08:09.123Z"}`, field: "eventTime", unit: dfv1.EventTimeUnitRFC3339},
+		{name: "nested", payload: fmt.Sprintf(`{"meta": {"ts": %d}}`, et.UnixMilli()), field: "meta.ts", unit: dfv1.EventTimeUnitMillis},
+		{name: "string in millis", payload: `{"eventTime": "1714979289123"}`, field: "eventTime", unit: dfv1.EventTimeUnitMillis},
+		{name: "string in seconds", payload: `{"eventTime": "1714979289.123"}`, field: "eventTime", unit: dfv1.EventTimeUnitSeconds},
+		{name: "missing field", payload: `{"other": 1}`, field: "eventTime", unit: dfv1.EventTimeUnitNanos, reason: ReasonMissing},
+		{name: "missing nested field", payload: `{"meta": 1}`, field: "meta.ts", unit: dfv1.EventTimeUnitNanos, reason: ReasonMissing},
+		{name: "null field", payload: `{"eventTime": null}`, field: "eventTime", unit: dfv1.EventTimeUnitNanos, reason: ReasonMissing},
+		{name: "zero", payload: `{"eventTime": 0}`, field: "eventTime", unit: dfv1.EventTimeUnitMillis, reason: ReasonMissing},
+		{name: "garbled string in millis", payload: `{"eventTime": "yesterday"}`, field: "eventTime", unit: dfv1.EventTimeUnitMillis, reason: ReasonMalformed},
+		{name: "boolean in millis", payload: `{"eventTime": true}`, field: "eventTime", unit: dfv1.EventTimeUnitMillis, reason: ReasonMalformed},
+		{name: "fraction in nanos", payload: `{"eventTime": 1.5}`, field: "eventTime", unit: dfv1.EventTimeUnitNanos, reason: ReasonMalformed},
+		{name: "number in RFC3339", payload: `{"eventTime": 1714979289}`, field: "eventTime", unit: dfv1.EventTimeUnitRFC3339, reason: ReasonMalformed},
+		{name: "garbled RFC3339", payload: `{"eventTime": "2024/05/06"}`, field: "eventTime", unit: dfv1.EventTimeUnitRFC3339, reason: ReasonMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fields map[string]interface{}
+			d := json.NewDecoder(bytes.NewReader([]byte(tt.payload)))
+			d.UseNumber()
+			assert.NoError(t, d.Decode(&fields))
+			got, err := FromField(fields, strings.Split(tt.field, "."), tt.unit)
+			if tt.reason == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, et.UnixMilli(), got.UnixMilli())
+				return
+			}
+			var invalidErr *InvalidEventTimeErr
+			assert.ErrorAs(t, err, &invalidErr)
+			assert.Equal(t, tt.reason, invalidErr.Reason)
+		})
+	}
+}
@@ -66,6 +66,11 @@ func (m *MockPublisher) PublishIdleWatermark(wm wmb.Watermark, offset isb.Offset
 	m.Called(wm, offset, partition)
 }
 
+func (m *MockPublisher) PublishSkippedWatermark(wm wmb.Watermark, offset isb.Offset, partition int32) bool {
+	args := m.Called(wm, offset, partition)
+	return args.Bool(0)
+}
+
 func (m *MockPublisher) Close() error {
 	args := m.Called()
 	return args.Error(0)
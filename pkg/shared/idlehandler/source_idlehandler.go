@@ -33,6 +33,8 @@ import (
 // What to publish as the idle watermark?
 //   The current watermark + WatermarkConfig.IncrementBy (provided by user). We will ensure that the
 //   increment will never cross (time.Now() - maxDelay).
+//   For the sources reporting their own idleness (e.g. generator), (time.Now() - maxDelay - margin) is
+//   published instead, see WithWallClockIdleWatermark.
 
 // SourceIdleHandler handles operations related to idle watermarks for source.
 type SourceIdleHandler struct {
@@ -42,11 +44,28 @@ type SourceIdleHandler struct {
 	updatedTS               time.Time
 	wmFetcher               fetch.SourceFetcher
 	srcPublisher            publish.SourcePublisher
+	// wallClock is whether the idle watermark is derived from the current time instead of the current watermark.
+	wallClock bool
+	// wallClockMargin is subtracted from the current time, so that the messages produced right before the idle
+	// watermark is published are not late.
+	wallClockMargin time.Duration
+}
+
+// SourceIdleHandlerOption sets an option of the SourceIdleHandler.
+type SourceIdleHandlerOption func(*SourceIdleHandler)
+
+// WithWallClockIdleWatermark publishes (time.Now() - maxDelay - margin) as the idle watermark, instead of incrementing
+// the current watermark by IncrementBy.
+func WithWallClockIdleWatermark(margin time.Duration) SourceIdleHandlerOption {
+	return func(iw *SourceIdleHandler) {
+		iw.wallClock = true
+		iw.wallClockMargin = margin
+	}
 }
 
 // NewSourceIdleHandler creates a new instance of SrcIdleHandler.
-func NewSourceIdleHandler(config *dfv1.Watermark, fetcher fetch.SourceFetcher, publisher publish.SourcePublisher) *SourceIdleHandler {
-	return &SourceIdleHandler{
+func NewSourceIdleHandler(config *dfv1.Watermark, fetcher fetch.SourceFetcher, publisher publish.SourcePublisher, opts ...SourceIdleHandlerOption) *SourceIdleHandler {
+	iw := &SourceIdleHandler{
 		config:                  config,
 		wmFetcher:               fetcher,
 		srcPublisher:            publisher,
@@ -54,6 +73,10 @@ func NewSourceIdleHandler(config *dfv1.Watermark, fetcher fetch.SourceFetcher, p
 		lastIdleWmPublishedTime: time.UnixMilli(-1),
 		lastPublishedIdleWm:     time.UnixMilli(-1),
 	}
+	for _, o := range opts {
+		o(iw)
+	}
+	return iw
 }
 
 // IsSourceIdling will return true if source has been idling and the step interval has passed.
@@ -95,18 +118,24 @@ func (iw *SourceIdleHandler) hasStepIntervalPassed() bool {
 func (iw *SourceIdleHandler) PublishSourceIdleWatermark(partitions []int32) {
 	var nextIdleWM time.Time
 
-	// compute the next idle watermark
-	computedWm := iw.wmFetcher.ComputeWatermark()
-
-	// check if the computed watermark is -1
-	// last computed watermark can be -1, when the pod is restarted or when the processor entity is not created yet.
-	if computedWm.UnixMilli() == -1 {
-		// if the computed watermark is -1, it means that the source is not able to compute the watermark.
-		// in this case, we can publish the idle watermark as the last published idle watermark + the increment by value.
-		nextIdleWM = iw.lastPublishedIdleWm.Add(iw.config.IdleSource.GetIncrementBy())
+	if iw.wallClock {
+		// the source knows that it has no data, so the watermark can progress up to the current time. the publisher
+		// skips it if it's older than the published watermark, so the watermark never regresses.
+		nextIdleWM = time.Now().Add(-1 * iw.config.GetMaxDelay()).Add(-1 * iw.wallClockMargin)
 	} else {
-		// if it's not -1, then we can publish the idle watermark as the computed watermark + the increment by value.
-		nextIdleWM = computedWm.Add(iw.config.IdleSource.GetIncrementBy())
+		// compute the next idle watermark
+		computedWm := iw.wmFetcher.ComputeWatermark()
+
+		// check if the computed watermark is -1
+		// last computed watermark can be -1, when the pod is restarted or when the processor entity is not created yet.
+		if computedWm.UnixMilli() == -1 {
+			// if the computed watermark is -1, it means that the source is not able to compute the watermark.
+			// in this case, we can publish the idle watermark as the last published idle watermark + the increment by value.
+			nextIdleWM = iw.lastPublishedIdleWm.Add(iw.config.IdleSource.GetIncrementBy())
+		} else {
+			// if it's not -1, then we can publish the idle watermark as the computed watermark + the increment by value.
+			nextIdleWM = computedWm.Add(iw.config.IdleSource.GetIncrementBy())
+		}
 	}
 
 	// if the next idle watermark is after the current time, then set the next idle watermark to the current time.
@@ -140,6 +140,10 @@ func (m *MockSourcePublisher) PublishSourceWatermarks(in []*isb.ReadMessage) {
 	m.Called(in)
 }
 
+func (m *MockSourcePublisher) Close() error {
+	return nil
+}
+
 func TestSourceIdleHandler_Reset(t *testing.T) {
 	config := &dfv1.Watermark{}
 	mockFetcher := new(MockSourceFetcher)
@@ -153,3 +157,24 @@ func TestSourceIdleHandler_Reset(t *testing.T) {
 	assert.WithinDuration(t, time.Now(), handler.updatedTS, time.Second)
 	assert.Equal(t, time.UnixMilli(-1), handler.lastIdleWmPublishedTime)
 }
+
+func TestSourceIdleHandler_PublishSourceIdleWatermark_WallClock(t *testing.T) {
+	config := &dfv1.Watermark{
+		MaxDelay:   &metav1.Duration{Duration: 2 * time.Second},
+		IdleSource: &dfv1.IdleSource{Threshold: &metav1.Duration{Duration: time.Second}},
+	}
+	mockFetcher := new(MockSourceFetcher)
+	mockPublisher := new(MockSourcePublisher)
+	var published time.Time
+	mockPublisher.On("PublishIdleWatermarks", mock.Anything, []int32{0}).Run(func(args mock.Arguments) {
+		published = args.Get(0).(time.Time)
+	})
+
+	handler := NewSourceIdleHandler(config, mockFetcher, mockPublisher, WithWallClockIdleWatermark(time.Second))
+	handler.PublishSourceIdleWatermark([]int32{0})
+
+	// the current watermark is not used, the idle watermark is the current time minus the max delay and the margin.
+	mockFetcher.AssertNotCalled(t, "ComputeWatermark")
+	assert.WithinDuration(t, time.Now().Add(-3*time.Second), published, 100*time.Millisecond)
+	assert.Equal(t, published, handler.lastPublishedIdleWm)
+}
@@ -23,6 +23,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -38,9 +39,13 @@ type jetStreamStore struct {
 	ctx               context.Context
 	kvName            string
 	client            *jsclient.Client
-	kv                nats.KeyValue
 	previousFetchTime time.Time
 	doneCh            chan struct{}
+	closeOnce         sync.Once
+
+	// kv is re-bound when the watcher is recreated, so that a recycled connection is picked up.
+	kv     nats.KeyValue
+	kvLock sync.RWMutex
 
 	log  *zap.SugaredLogger
 	opts *options
@@ -77,6 +82,27 @@ func NewKVJetStreamKVStore(ctx context.Context, kvName string, client *jsclient.
 	return jsStore, nil
 }
 
+// getKV returns the KV store the store is bound to.
+func (jss *jetStreamStore) getKV() nats.KeyValue {
+	jss.kvLock.RLock()
+	defer jss.kvLock.RUnlock()
+	return jss.kv
+}
+
+// rebindKV binds the KV store again through the client, so that the watchers created afterward use the current
+// connection of the client instead of the one the KV store was first bound with. The previous binding is kept if
+// it fails.
+func (jss *jetStreamStore) rebindKV() {
+	kv, err := jss.client.BindKVStore(jss.kvName)
+	if err != nil {
+		jss.log.Warnw("Failed to rebind the kv store, keeping the previous binding", zap.String("watcher", jss.GetKVName()), zap.Error(err))
+		return
+	}
+	jss.kvLock.Lock()
+	jss.kv = kv
+	jss.kvLock.Unlock()
+}
+
 // kvEntry is each key-value entry in the store and the operation associated with the kv pair.
 type kvEntry struct {
 	key   string
@@ -101,7 +127,7 @@ func (k kvEntry) Operation() kvs.KVWatchOp {
 
 // GetAllKeys returns all the keys in the key-value store.
 func (jss *jetStreamStore) GetAllKeys(_ context.Context) ([]string, error) {
-	keyLister, err := jss.kv.ListKeys()
+	keyLister, err := jss.getKV().ListKeys()
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +144,7 @@ func (jss *jetStreamStore) GetAllKeys(_ context.Context) ([]string, error) {
 
 // GetValue returns the value for a given key.
 func (jss *jetStreamStore) GetValue(_ context.Context, k string) ([]byte, error) {
-	keyValueEntry, err := jss.kv.Get(k)
+	keyValueEntry, err := jss.getKV().Get(k)
 	if err != nil {
 		return []byte(""), err
 	}
@@ -129,19 +155,19 @@ func (jss *jetStreamStore) GetValue(_ context.Context, k string) ([]byte, error)
 
 // GetStoreName returns the store name.
 func (jss *jetStreamStore) GetStoreName() string {
-	return jss.kv.Bucket()
+	return jss.getKV().Bucket()
 }
 
 // DeleteKey deletes the key from the JS key-value store.
 func (jss *jetStreamStore) DeleteKey(_ context.Context, k string) error {
 	// will return error if nats connection is closed
-	return jss.kv.Delete(k)
+	return jss.getKV().Delete(k)
 }
 
 // PutKV puts an element to the JS key-value store.
 func (jss *jetStreamStore) PutKV(_ context.Context, k string, v []byte) error {
 	// will return error if nats connection is closed
-	_, err := jss.kv.Put(k, v)
+	_, err := jss.getKV().Put(k, v)
 	return err
 }
 
@@ -187,6 +213,7 @@ func (jss *jetStreamStore) Watch(ctx context.Context) <-chan kvs.KVEntry {
 					// meaning: there could be an auto reconnection to JetStream while the service is still running
 					// therefore, recreate the kvWatcher using the new JetStream context
 					tempWatcher := kvWatcher
+					jss.rebindKV()
 					kvWatcher = jss.newWatcher(ctx)
 					err = tempWatcher.Stop()
 					if err != nil {
@@ -231,6 +258,7 @@ func (jss *jetStreamStore) Watch(ctx context.Context) <-chan kvs.KVEntry {
 					// therefore, we have to recreate the watcher
 					jss.log.Warn("The watcher is not receiving any updates, recreating the watcher", zap.String("watcher", jss.GetKVName()), zap.Time("lastUpdateKVTime", kvLastUpdatedTime), zap.Time("previousFetchTime", jss.previousFetchTime))
 					tempWatcher := kvWatcher
+					jss.rebindKV()
 					kvWatcher = jss.newWatcher(ctx)
 					err = tempWatcher.Stop()
 				}
@@ -239,26 +267,37 @@ func (jss *jetStreamStore) Watch(ctx context.Context) <-chan kvs.KVEntry {
 
 			case <-jss.doneCh:
 				jss.log.Infow("Stopping WatchAll", zap.String("watcher", jss.GetKVName()))
+				// stop the JetStream watcher as well, otherwise its subscription is leaked
+				if err = kvWatcher.Stop(); err != nil {
+					jss.log.Errorw("Failed to stop", zap.String("watcher", jss.GetKVName()), zap.Error(err))
+				}
 				close(updates)
 				return
 			}
 		}
+		// the watcher could not be (re)created because the context is done or the store is closed
+		close(updates)
 	}()
 	return updates
 }
 
 // newWatcher creates a new watcher for the key-value store.
 func (jss *jetStreamStore) newWatcher(ctx context.Context) nats.KeyWatcher {
-	kvWatcher, err := jss.kv.WatchAll(nats.Context(ctx))
-	// keep looping because the watermark won't work without a watcher
+	kvWatcher, err := jss.getKV().WatchAll(nats.Context(ctx))
+	// keep looping because the watermark won't work without a watcher, until the store is closed
 	for err != nil {
 		select {
 		case <-jss.ctx.Done():
 			return nil
+		case <-ctx.Done():
+			return nil
+		case <-jss.doneCh:
+			return nil
 		default:
 			jss.log.Errorw("Creating watcher failed", zap.String("watcher", jss.GetKVName()), zap.Error(err))
-			kvWatcher, err = jss.kv.WatchAll(nats.Context(ctx))
 			time.Sleep(100 * time.Millisecond)
+			jss.rebindKV()
+			kvWatcher, err = jss.getKV().WatchAll(nats.Context(ctx))
 		}
 	}
 	jss.log.Infow("Successfully created watcher", zap.String("watcher", jss.GetKVName()))
@@ -298,7 +337,7 @@ retryLoop:
 
 outer:
 	for _, key := range keys {
-		value, err = jss.kv.Get(key)
+		value, err = jss.getKV().Get(key)
 		for err != nil {
 			// keys can be deleted when the previous vertex pod is deleted/restarted.
 			if errors.Is(err, nats.ErrKeyNotFound) {
@@ -311,7 +350,7 @@ outer:
 			default:
 				jss.log.Errorw("Failed to get value", zap.String("watcher", jss.GetKVName()), zap.String("key", key), zap.Error(err))
 				time.Sleep(100 * time.Millisecond)
-				value, err = jss.kv.Get(key)
+				value, err = jss.getKV().Get(key)
 			}
 		}
 		if value.Created().After(lastUpdate) {
@@ -323,7 +362,9 @@ outer:
 }
 
 // Close we don't need to close the JetStream connection. It will be closed by the caller.
-// give the signal to watchers to stop watching
+// give the signal to watchers to stop watching, it's safe to call Close more than once.
 func (jss *jetStreamStore) Close() {
-	close(jss.doneCh)
+	jss.closeOnce.Do(func() {
+		close(jss.doneCh)
+	})
 }
@@ -418,3 +418,45 @@ func TestJetStreamKVStoreWithContextDone(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, kvStore)
 }
+
+func TestJetStreamKVStoreCloseStopsWatcher(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	kvName := "testJetStreamKVStore"
+
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	testClient := natsclient.NewTestClientWithServer(t, s)
+	defer testClient.Close()
+
+	js, err := testClient.JetStreamContext()
+	assert.NoError(t, err)
+
+	_, err = js.CreateKeyValue(&nats.KeyValueConfig{
+		Bucket: kvName,
+	})
+	assert.NoError(t, err)
+
+	defer func() {
+		err = js.DeleteKeyValue(kvName)
+		assert.NoError(t, err)
+	}()
+
+	baseline := s.NumSubscriptions()
+
+	kvStore, err := NewKVJetStreamKVStore(ctx, kvName, testClient)
+	assert.NoError(t, err)
+
+	// the context of the watch is not done, closing the store must stop the watcher.
+	kvCh := kvStore.Watch(ctx)
+	assert.Eventually(t, func() bool { return s.NumSubscriptions() > baseline }, 5*time.Second, 10*time.Millisecond)
+	kvStore.Close()
+	// closing the store twice is a no-op.
+	kvStore.Close()
+
+	_, ok := <-kvCh
+	assert.False(t, ok)
+	assert.Eventually(t, func() bool { return s.NumSubscriptions() == baseline }, 5*time.Second, 10*time.Millisecond)
+}
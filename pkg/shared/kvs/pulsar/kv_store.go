@@ -0,0 +1,257 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package pulsar implements the kv store and watcher using a compacted Pulsar topic, the messages are keyed by the keys
+of the store and a message with an empty payload deletes its key.
+*/
+package pulsar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// ErrKeyNotFound is returned by GetValue when the key does not exist in the store.
+var ErrKeyNotFound = errors.New("key not found")
+
+// TopicName returns the name of the topic backing the kv store in the Pulsar namespace, which is "{tenant}/{namespace}".
+func TopicName(namespace, kvName string) string {
+	return fmt.Sprintf("persistent://%s/%s", namespace, kvName)
+}
+
+// pulsarStore implements the KV store backed up by a Pulsar topic, the writes go through a producer and the reads
+// are served by a table view of the topic.
+type pulsarStore struct {
+	kvName    string
+	producer  pulsar.Producer
+	tableView pulsar.TableView
+	doneCh    chan struct{}
+	closeOnce sync.Once
+	log       *zap.SugaredLogger
+}
+
+var _ kvs.KVStorer = (*pulsarStore)(nil)
+
+// NewPulsarKVStore returns a KV store on the topic of the kv name in the Pulsar namespace. The topic should have
+// the compaction enabled, otherwise the table view replays every update of the store when it's created.
+func NewPulsarKVStore(ctx context.Context, kvName string, client pulsar.Client, namespace string) (kvs.KVStorer, error) {
+	topic := TopicName(namespace, kvName)
+	producer, err := client.CreateProducer(pulsar.ProducerOptions{Topic: topic})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a producer of topic %q, %w", topic, err)
+	}
+	tableView, err := client.CreateTableView(pulsar.TableViewOptions{
+		Topic:           topic,
+		Schema:          pulsar.NewBytesSchema(nil),
+		SchemaValueType: reflect.TypeOf([]byte{}),
+	})
+	if err != nil {
+		producer.Close()
+		return nil, fmt.Errorf("failed to create a table view of topic %q, %w", topic, err)
+	}
+	return newPulsarStore(ctx, kvName, producer, tableView), nil
+}
+
+func newPulsarStore(ctx context.Context, kvName string, producer pulsar.Producer, tableView pulsar.TableView) *pulsarStore {
+	return &pulsarStore{
+		kvName:    kvName,
+		producer:  producer,
+		tableView: tableView,
+		doneCh:    make(chan struct{}),
+		log:       logging.FromContext(ctx).With("kvName", kvName),
+	}
+}
+
+// kvEntry is each key-value entry in the store and the operation associated with the kv pair.
+type kvEntry struct {
+	key   string
+	value []byte
+	op    kvs.KVWatchOp
+}
+
+// Key returns the key
+func (k kvEntry) Key() string {
+	return k.key
+}
+
+// Value returns the value.
+func (k kvEntry) Value() []byte {
+	return k.value
+}
+
+// Operation returns the operation on that key-value pair.
+func (k kvEntry) Operation() kvs.KVWatchOp {
+	return k.op
+}
+
+// newKVEntry converts a value of the table view into an entry, an empty value is a deleted key.
+func newKVEntry(key string, value interface{}) kvEntry {
+	b, _ := value.([]byte)
+	if len(b) == 0 {
+		return kvEntry{key: key, op: kvs.KVDelete}
+	}
+	return kvEntry{key: key, value: b, op: kvs.KVPut}
+}
+
+// GetAllKeys returns all the keys in the key-value store.
+func (ps *pulsarStore) GetAllKeys(_ context.Context) ([]string, error) {
+	return ps.tableView.Keys(), nil
+}
+
+// GetValue returns the value for a given key.
+func (ps *pulsarStore) GetValue(_ context.Context, k string) ([]byte, error) {
+	b, _ := ps.tableView.Get(k).([]byte)
+	if len(b) == 0 {
+		return []byte(""), fmt.Errorf("key %q of kv store %q, %w", k, ps.kvName, ErrKeyNotFound)
+	}
+	return b, nil
+}
+
+// GetStoreName returns the store name.
+func (ps *pulsarStore) GetStoreName() string {
+	return ps.kvName
+}
+
+// DeleteKey deletes the key from the store by writing a tombstone, i.e. a message of the key without payload.
+func (ps *pulsarStore) DeleteKey(ctx context.Context, k string) error {
+	_, err := ps.producer.Send(ctx, &pulsar.ProducerMessage{Key: k})
+	return err
+}
+
+// PutKV puts an element to the store.
+func (ps *pulsarStore) PutKV(ctx context.Context, k string, v []byte) error {
+	_, err := ps.producer.Send(ctx, &pulsar.ProducerMessage{Key: k, Payload: v})
+	return err
+}
+
+// Watch watches the store and returns the updates channel to read the updates on the store, the existing entries are
+// sent first. The channel is closed when the context is done or the store is closed.
+func (ps *pulsarStore) Watch(ctx context.Context) <-chan kvs.KVEntry {
+	updates := make(chan kvs.KVEntry)
+	select {
+	case <-ps.doneCh:
+		close(updates)
+		return updates
+	default:
+	}
+	queue := newEntryQueue()
+	// the listener is called by the table view with its lock held, it must not wait for the updates to be read,
+	// the entries are queued and sent to the channel from another goroutine.
+	if err := ps.tableView.ForEachAndListen(func(key string, value interface{}) error {
+		queue.push(newKVEntry(key, value))
+		return nil
+	}); err != nil {
+		ps.log.Errorw("Failed to listen to the table view", zap.Error(err))
+		close(updates)
+		return updates
+	}
+	go func() {
+		// the listeners of a table view can not be removed, the queue drops the entries once the watch is stopped
+		defer queue.stop()
+		defer close(updates)
+		for {
+			entry, ok := queue.pop(ctx, ps.doneCh)
+			if !ok {
+				ps.log.Infow("Stopping WatchAll")
+				return
+			}
+			select {
+			case updates <- entry:
+			case <-ctx.Done():
+				return
+			case <-ps.doneCh:
+				return
+			}
+		}
+	}()
+	return updates
+}
+
+// Close closes the producer and the table view, the Pulsar client is closed by the caller. It's safe to call Close
+// more than once.
+func (ps *pulsarStore) Close() {
+	ps.closeOnce.Do(func() {
+		close(ps.doneCh)
+		ps.producer.Close()
+		ps.tableView.Close()
+	})
+}
+
+// entryQueue is an unbounded queue of the entries received by a watcher.
+type entryQueue struct {
+	lock    sync.Mutex
+	entries []kvEntry
+	stopped bool
+	// notify has a pending signal when entries are pushed
+	notify chan struct{}
+}
+
+func newEntryQueue() *entryQueue {
+	return &entryQueue{notify: make(chan struct{}, 1)}
+}
+
+func (q *entryQueue) push(entry kvEntry) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.stopped {
+		return
+	}
+	q.entries = append(q.entries, entry)
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the oldest entry of the queue, waiting for one if it's empty. It returns false if the context is done
+// or the done channel is closed.
+func (q *entryQueue) pop(ctx context.Context, doneCh <-chan struct{}) (kvEntry, bool) {
+	for {
+		q.lock.Lock()
+		if len(q.entries) > 0 {
+			entry := q.entries[0]
+			q.entries = q.entries[1:]
+			q.lock.Unlock()
+			return entry, true
+		}
+		q.lock.Unlock()
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+			return kvEntry{}, false
+		case <-doneCh:
+			return kvEntry{}, false
+		}
+	}
+}
+
+// stop drops the queued entries and the ones pushed afterward.
+func (q *entryQueue) stop() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.stopped = true
+	q.entries = nil
+}
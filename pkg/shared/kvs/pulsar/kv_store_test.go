@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+// fakeTopic is a compacted topic, the producer writes to it and the table view reads it like Pulsar does, the
+// listeners are called with the lock held.
+type fakeTopic struct {
+	lock      sync.Mutex
+	data      map[string][]byte
+	listeners []func(string, interface{}) error
+	closed    bool
+}
+
+func (f *fakeTopic) write(key string, value []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if len(value) == 0 {
+		delete(f.data, key)
+	} else {
+		f.data[key] = value
+	}
+	for _, l := range f.listeners {
+		_ = l(key, value)
+	}
+}
+
+type fakeProducer struct {
+	pulsar.Producer
+	topic *fakeTopic
+}
+
+func (p *fakeProducer) Send(_ context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	p.topic.write(msg.Key, msg.Payload)
+	return pulsar.EarliestMessageID(), nil
+}
+
+func (p *fakeProducer) Close() {}
+
+type fakeTableView struct {
+	pulsar.TableView
+	topic *fakeTopic
+}
+
+func (tv *fakeTableView) Keys() []string {
+	tv.topic.lock.Lock()
+	defer tv.topic.lock.Unlock()
+	var keys []string
+	for k := range tv.topic.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (tv *fakeTableView) Get(key string) interface{} {
+	tv.topic.lock.Lock()
+	defer tv.topic.lock.Unlock()
+	if v, ok := tv.topic.data[key]; ok {
+		return v
+	}
+	return nil
+}
+
+func (tv *fakeTableView) ForEachAndListen(action func(string, interface{}) error) error {
+	tv.topic.lock.Lock()
+	defer tv.topic.lock.Unlock()
+	for k, v := range tv.topic.data {
+		if err := action(k, v); err != nil {
+			return err
+		}
+	}
+	tv.topic.listeners = append(tv.topic.listeners, action)
+	return nil
+}
+
+func (tv *fakeTableView) Close() {
+	tv.topic.lock.Lock()
+	defer tv.topic.lock.Unlock()
+	tv.topic.closed = true
+}
+
+func newTestStore(ctx context.Context, topic *fakeTopic) *pulsarStore {
+	return newPulsarStore(ctx, "test_OT", &fakeProducer{topic: topic}, &fakeTableView{topic: topic})
+}
+
+func TestPulsarKVStoreOperations(t *testing.T) {
+	ctx := context.Background()
+	topic := &fakeTopic{data: map[string][]byte{}}
+	kvStore := newTestStore(ctx, topic)
+	defer kvStore.Close()
+
+	assert.Equal(t, "test_OT", kvStore.GetStoreName())
+	assert.NoError(t, kvStore.PutKV(ctx, "key1", []byte("value1")))
+	assert.NoError(t, kvStore.PutKV(ctx, "key2", []byte("value2")))
+
+	keys, err := kvStore.GetAllKeys(ctx)
+	assert.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"key1", "key2"}, keys)
+
+	value, err := kvStore.GetValue(ctx, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	assert.NoError(t, kvStore.DeleteKey(ctx, "key1"))
+	_, err = kvStore.GetValue(ctx, "key1")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	keys, err = kvStore.GetAllKeys(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key2"}, keys)
+}
+
+func TestPulsarKVStoreWatch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	topic := &fakeTopic{data: map[string][]byte{"key1": []byte("value1")}}
+	kvStore := newTestStore(ctx, topic)
+
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	updates := kvStore.Watch(watchCtx)
+
+	// the writes must not block on the watcher which is not reading
+	assert.NoError(t, kvStore.PutKV(ctx, "key2", []byte("value2")))
+	assert.NoError(t, kvStore.DeleteKey(ctx, "key1"))
+
+	var entries []kvs.KVEntry
+	for i := 0; i < 3; i++ {
+		select {
+		case entry := <-updates:
+			entries = append(entries, entry)
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for the updates")
+		}
+	}
+	assert.Equal(t, "key1", entries[0].Key())
+	assert.Equal(t, kvs.KVPut, entries[0].Operation())
+	assert.Equal(t, []byte("value1"), entries[0].Value())
+	assert.Equal(t, "key2", entries[1].Key())
+	assert.Equal(t, kvs.KVPut, entries[1].Operation())
+	assert.Equal(t, "key1", entries[2].Key())
+	assert.Equal(t, kvs.KVDelete, entries[2].Operation())
+
+	stopWatch()
+	for range updates {
+	}
+	// the listener left in the table view drops the updates once the watch is stopped
+	assert.NoError(t, kvStore.PutKV(ctx, "key3", []byte("value3")))
+
+	kvStore.Close()
+	kvStore.Close()
+	assert.True(t, topic.closed)
+	_, ok := <-kvStore.Watch(ctx)
+	assert.False(t, ok)
+}
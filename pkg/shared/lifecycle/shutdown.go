@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lifecycle defines the shutdown sequence shared by the data processing components.
+//
+// The components are shut down stage by stage, in the order below:
+//
+//  1. StageStopProducing - stop producing new messages, e.g., the generator stops generating.
+//  2. StageDrainForwarder - stop the forwarder and wait for the in-flight chunk to finish.
+//  3. StageFlushAcks - make sure the acks already issued are committed.
+//  4. StageCloseWatermarkPublishers - stop the heartbeats and close the watermark publishers, so no watermark is
+//     published after the final ack.
+//  5. StageCloseReadersWriters - close the readers and the writers.
+//  6. StageCloseStores - close the watermark stores.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Stage is a stage of the shutdown sequence.
+type Stage int
+
+const (
+	StageStopProducing Stage = iota
+	StageDrainForwarder
+	StageFlushAcks
+	StageCloseWatermarkPublishers
+	StageCloseReadersWriters
+	StageCloseStores
+	numStages
+)
+
+func (s Stage) String() string {
+	switch s {
+	case StageStopProducing:
+		return "StopProducing"
+	case StageDrainForwarder:
+		return "DrainForwarder"
+	case StageFlushAcks:
+		return "FlushAcks"
+	case StageCloseWatermarkPublishers:
+		return "CloseWatermarkPublishers"
+	case StageCloseReadersWriters:
+		return "CloseReadersWriters"
+	case StageCloseStores:
+		return "CloseStores"
+	default:
+		return fmt.Sprintf("Stage(%d)", int(s))
+	}
+}
+
+// Hook is a shutdown step of a component.
+type Hook func(ctx context.Context) error
+
+type namedHook struct {
+	name string
+	fn   Hook
+}
+
+// ShutdownSequence runs the registered hooks stage by stage. The hooks of the same stage run in the order they are
+// registered. A failing hook does not stop the sequence, all the errors are returned together.
+type ShutdownSequence struct {
+	lock   sync.Mutex
+	hooks  [numStages][]namedHook
+	once   sync.Once
+	err    error
+	logger *zap.SugaredLogger
+}
+
+// NewShutdownSequence returns an empty ShutdownSequence.
+func NewShutdownSequence(logger *zap.SugaredLogger) *ShutdownSequence {
+	return &ShutdownSequence{logger: logger}
+}
+
+// Register registers a hook to run at the given stage.
+func (s *ShutdownSequence) Register(stage Stage, name string, fn Hook) {
+	if stage < 0 || stage >= numStages {
+		panic(fmt.Sprintf("invalid shutdown stage %d", stage))
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.hooks[stage] = append(s.hooks[stage], namedHook{name: name, fn: fn})
+}
+
+// RegisterCloser registers a hook that closes the given closer at the given stage.
+func (s *ShutdownSequence) RegisterCloser(stage Stage, name string, c io.Closer) {
+	s.Register(stage, name, func(context.Context) error {
+		return c.Close()
+	})
+}
+
+// Shutdown runs the shutdown sequence, it only runs once, the subsequent calls return the result of the first run.
+func (s *ShutdownSequence) Shutdown(ctx context.Context) error {
+	s.once.Do(func() {
+		s.lock.Lock()
+		hooks := s.hooks
+		s.lock.Unlock()
+		var errs []error
+		for stage, stageHooks := range hooks {
+			for _, h := range stageHooks {
+				if err := h.fn(ctx); err != nil {
+					s.logger.Errorw("Shutdown hook failed, continuing the shutdown", zap.String("stage", Stage(stage).String()), zap.String("hook", h.name), zap.Error(err))
+					errs = append(errs, fmt.Errorf("%s %s: %w", Stage(stage), h.name, err))
+					continue
+				}
+				s.logger.Debugw("Shutdown hook done", zap.String("stage", Stage(stage).String()), zap.String("hook", h.name))
+			}
+		}
+		s.err = errors.Join(errs...)
+	})
+	return s.err
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) hook(name string, err error) Hook {
+	return func(context.Context) error {
+		r.calls = append(r.calls, name)
+		return err
+	}
+}
+
+type fakeCloser struct {
+	name string
+	r    *recorder
+}
+
+func (f *fakeCloser) Close() error {
+	f.r.calls = append(f.r.calls, f.name)
+	return nil
+}
+
+func TestShutdownSequence_Order(t *testing.T) {
+	r := &recorder{}
+	s := NewShutdownSequence(logging.NewLogger())
+	// register out of order, the stages decide the order.
+	s.RegisterCloser(StageCloseStores, "store", &fakeCloser{name: "store", r: r})
+	s.RegisterCloser(StageCloseReadersWriters, "reader", &fakeCloser{name: "reader", r: r})
+	s.RegisterCloser(StageCloseReadersWriters, "writer", &fakeCloser{name: "writer", r: r})
+	s.RegisterCloser(StageCloseWatermarkPublishers, "publisher", &fakeCloser{name: "publisher", r: r})
+	s.Register(StageFlushAcks, "acks", r.hook("acks", nil))
+	s.Register(StageDrainForwarder, "forwarder", r.hook("forwarder", nil))
+	s.Register(StageStopProducing, "generator", r.hook("generator", nil))
+
+	assert.NoError(t, s.Shutdown(context.Background()))
+	assert.Equal(t, []string{"generator", "forwarder", "acks", "publisher", "reader", "writer", "store"}, r.calls)
+
+	// the sequence only runs once.
+	assert.NoError(t, s.Shutdown(context.Background()))
+	assert.Len(t, r.calls, 7)
+}
+
+func TestShutdownSequence_ContinueOnError(t *testing.T) {
+	r := &recorder{}
+	s := NewShutdownSequence(logging.NewLogger())
+	s.Register(StageCloseWatermarkPublishers, "publisher", r.hook("publisher", errors.New("publisher failure")))
+	s.Register(StageCloseStores, "store", r.hook("store", errors.New("store failure")))
+	s.Register(StageCloseReadersWriters, "reader", r.hook("reader", nil))
+
+	err := s.Shutdown(context.Background())
+	assert.Equal(t, []string{"publisher", "reader", "store"}, r.calls)
+	assert.ErrorContains(t, err, "CloseWatermarkPublishers publisher: publisher failure")
+	assert.ErrorContains(t, err, "CloseStores store: store failure")
+}
+
+func TestShutdownSequence_InvalidStage(t *testing.T) {
+	s := NewShutdownSequence(logging.NewLogger())
+	assert.Panics(t, func() {
+		s.Register(numStages, "invalid", nil)
+	})
+}
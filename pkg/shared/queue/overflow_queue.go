@@ -18,6 +18,7 @@ package queue
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 )
 
@@ -46,6 +47,33 @@ func (q *OverflowQueue[T]) Append(value T) {
 	q.elements = append(q.elements, value)
 }
 
+// Upsert finds the element for which compare returns 0 with a binary search, or inserts the element returned by
+// newElement at its position in order if there is none. The elements must be sorted in the order of compare, which
+// returns a negative number for the elements before the one looked for, and a positive number for those after it.
+// The oldest element overflows if the queue is full, unless the new element is before all the elements, then it is
+// not inserted and false is returned.
+func (q *OverflowQueue[T]) Upsert(compare func(T) int, newElement func() T) (T, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	i, found := slices.BinarySearchFunc(q.elements, compare, func(e T, compare func(T) int) int {
+		return compare(e)
+	})
+	if found {
+		return q.elements[i], true
+	}
+	if len(q.elements) >= q.maxSize {
+		if i == 0 {
+			var zero T
+			return zero, false
+		}
+		q.elements = q.elements[1:]
+		i--
+	}
+	value := newElement()
+	q.elements = slices.Insert(q.elements, i, value)
+	return value, true
+}
+
 // Items returns a copy of the elements in the queue
 func (q *OverflowQueue[T]) Items() []T {
 	q.lock.RLock()
@@ -17,6 +17,7 @@ limitations under the License.
 package queue
 
 import (
+	"cmp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -42,6 +43,27 @@ func TestAppend(t *testing.T) {
 	assert.Equal(t, 5, q.ReversedItems()[1])
 }
 
+func TestUpsert(t *testing.T) {
+	q := New[int](3)
+	upsert := func(v int) (int, bool) {
+		return q.Upsert(func(e int) int { return cmp.Compare(e, v) }, func() int { return v })
+	}
+	for _, v := range []int{2, 4, 3, 4} {
+		e, ok := upsert(v)
+		assert.True(t, ok)
+		assert.Equal(t, v, e)
+	}
+	assert.Equal(t, []int{2, 3, 4}, q.Items())
+	// the queue is full, the oldest element overflows
+	_, ok := upsert(5)
+	assert.True(t, ok)
+	assert.Equal(t, []int{3, 4, 5}, q.Items())
+	// an element before all the elements of a full queue is not inserted
+	_, ok = upsert(1)
+	assert.False(t, ok)
+	assert.Equal(t, []int{3, 4, 5}, q.Items())
+}
+
 func TestReverse(t *testing.T) {
 	l := []int{1, 2, 3}
 	l1 := reverse(l)
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 func LookupEnvStringOr(key, defaultValue string) string {
@@ -53,3 +54,15 @@ func LookupEnvBoolOr(key string, defaultValue bool) bool {
 		return defaultValue
 	}
 }
+
+func LookupEnvDurationOr(key string, defaultValue time.Duration) time.Duration {
+	if valStr, existing := os.LookupEnv(key); existing && valStr != "" {
+		val, err := time.ParseDuration(valStr)
+		if err != nil {
+			panic(fmt.Errorf("invalid value for env variable %q, value %q", key, valStr))
+		}
+		return val
+	} else {
+		return defaultValue
+	}
+}
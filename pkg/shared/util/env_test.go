@@ -19,6 +19,7 @@ package util
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -47,3 +48,11 @@ func TestLookupEnvBoolOr(t *testing.T) {
 	os.Setenv("fake_bool_env", "5")
 	assert.Panics(t, func() { LookupEnvBoolOr("fake_bool_env", false) })
 }
+
+func TestLookupEnvDurationOr(t *testing.T) {
+	assert.Equal(t, LookupEnvDurationOr("fake_duration_env", time.Second), time.Second)
+	os.Setenv("fake_duration_env", "2m")
+	assert.Equal(t, LookupEnvDurationOr("fake_duration_env", time.Second), 2*time.Minute)
+	os.Setenv("fake_duration_env", "5")
+	assert.Panics(t, func() { LookupEnvDurationOr("fake_duration_env", time.Second) })
+}
@@ -19,7 +19,11 @@ package util
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
 	"strconv"
+	"strings"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -61,6 +65,10 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 				},
 			})
 		}
+		if x.TLS != nil {
+			tlsConfig, _ := json.Marshal(x.TLS)
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLS, Value: string(tlsConfig)})
+		}
 		if x.SentinelPassword != nil {
 			env = append(env, corev1.EnvVar{
 				Name: dfv1.EnvISBSvcRedisSentinelPassword, ValueFrom: &corev1.EnvVarSource{
@@ -77,6 +85,10 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 	} else if x := isbSvcConfig.JetStream; x != nil {
 		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamURL, Value: x.URL})
 		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamTLSEnabled, Value: strconv.FormatBool(x.TLSEnabled)})
+		if x.TLSEnabled && x.TLS != nil {
+			tlsConfig, _ := json.Marshal(x.TLS)
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamTLS, Value: string(tlsConfig)})
+		}
 		if x.Auth != nil && x.Auth.Basic != nil && x.Auth.Basic.User != nil && x.Auth.Basic.Password != nil {
 			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamUser, ValueFrom: &corev1.EnvVarSource{
 				SecretKeyRef: &corev1.SecretKeySelector{
@@ -96,6 +108,100 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 			}})
 		}
 		isbSvcType = dfv1.ISBSvcTypeJetStream
+	} else if x := isbSvcConfig.Kafka; x != nil {
+		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcKafkaBrokers, Value: strings.Join(x.Brokers, ",")})
+		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcKafkaTLSEnabled, Value: strconv.FormatBool(x.TLSEnabled)})
+		if x.TLSEnabled && x.TLS != nil {
+			tlsConfig, _ := json.Marshal(x.TLS)
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcKafkaTLS, Value: string(tlsConfig)})
+		}
+		if x.Config != "" {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcKafkaConfig, Value: x.Config})
+		}
+		if x.SASL != nil && x.SASL.User != nil && x.SASL.Password != nil {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcKafkaSASLMechanism, Value: string(x.SASL.GetMechanism())})
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcKafkaSASLUser, ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: x.SASL.User.Name,
+					},
+					Key: x.SASL.User.Key,
+				},
+			}})
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcKafkaSASLPassword, ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: x.SASL.Password.Name,
+					},
+					Key: x.SASL.Password.Key,
+				},
+			}})
+		}
+		isbSvcType = dfv1.ISBSvcTypeKafka
 	}
 	return isbSvcType, env
 }
+
+// GetIsbSvcConfigFromEnv decodes the ISB Service config set in the environment variables by GetIsbSvcEnvVars, it returns
+// an empty config if the environment variable is not set.
+func GetIsbSvcConfigFromEnv() (*dfv1.BufferServiceConfig, error) {
+	isbSvcConfig := &dfv1.BufferServiceConfig{}
+	encoded := os.Getenv(dfv1.EnvISBSvcConfig)
+	if len(encoded) == 0 {
+		return isbSvcConfig, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ISB Svc config string, %w", err)
+	}
+	if err := json.Unmarshal(decoded, isbSvcConfig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ISB Svc config, %w", err)
+	}
+	return isbSvcConfig, nil
+}
+
+// getIsbSvcTLS returns the TLS config of the ISB Service clients, nil if there isn't one.
+func getIsbSvcTLS(isbSvcConfig dfv1.BufferServiceConfig) *dfv1.TLS {
+	if x := isbSvcConfig.Redis; x != nil {
+		return x.TLS
+	}
+	if x := isbSvcConfig.JetStream; x != nil && x.TLSEnabled {
+		return x.TLS
+	}
+	if x := isbSvcConfig.Kafka; x != nil && x.TLSEnabled {
+		return x.TLS
+	}
+	return nil
+}
+
+// AttachIsbSvcTLSVolumes mounts the secrets of the TLS config of the ISB Service clients to the numaflow containers of
+// the pod, which connect to the ISB Service. The volumes already in the pod, e.g. a secret also used by a source, are
+// not added again.
+func AttachIsbSvcTLSVolumes(podSpec *corev1.PodSpec, isbSvcConfig dfv1.BufferServiceConfig) {
+	tlsConfig := getIsbSvcTLS(isbSvcConfig)
+	if tlsConfig == nil {
+		return
+	}
+	vols, volMounts := VolumesFromSecretsAndConfigMaps(tlsConfig)
+	for _, vol := range vols {
+		if !slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == vol.Name }) {
+			podSpec.Volumes = append(podSpec.Volumes, vol)
+		}
+	}
+	mount := func(containers []corev1.Container) {
+		for i := range containers {
+			switch containers[i].Name {
+			case dfv1.CtrMain, dfv1.CtrInit, dfv1.CtrInitSideInputs, dfv1.CtrSideInputsWatcher:
+			default:
+				continue
+			}
+			for _, vm := range volMounts {
+				if !slices.ContainsFunc(containers[i].VolumeMounts, func(m corev1.VolumeMount) bool { return m.Name == vm.Name }) {
+					containers[i].VolumeMounts = append(containers[i].VolumeMounts, vm)
+				}
+			}
+		}
+	}
+	mount(podSpec.InitContainers)
+	mount(podSpec.Containers)
+}
@@ -110,3 +110,131 @@ func TestGetJSIsbSvcEnvVars(t *testing.T) {
 	assert.Contains(t, eNames, dfv1.EnvISBSvcJetStreamPassword)
 	assert.Contains(t, eNames, dfv1.EnvISBSvcConfig)
 }
+
+func TestGetKafkaIsbSvcEnvVars(t *testing.T) {
+	fakeIsbsConfig := dfv1.BufferServiceConfig{
+		Kafka: &dfv1.KafkaConfig{
+			Brokers:    []string{"broker-0:9092", "broker-1:9092"},
+			TLSEnabled: true,
+			Config:     "consumer:\n  fetch:\n    min: 10\n",
+			SASL: &dfv1.KafkaSASLAuth{
+				User: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "test-user",
+					},
+					Key: "test-key",
+				},
+				Password: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "test-pass",
+					},
+					Key: "test-key",
+				},
+			},
+		},
+	}
+	tp, env := GetIsbSvcEnvVars(fakeIsbsConfig)
+	assert.Equal(t, dfv1.ISBSvcTypeKafka, tp)
+	envs := map[string]corev1.EnvVar{}
+	for _, e := range env {
+		envs[e.Name] = e
+	}
+	assert.Equal(t, "broker-0:9092,broker-1:9092", envs[dfv1.EnvISBSvcKafkaBrokers].Value)
+	assert.Equal(t, "true", envs[dfv1.EnvISBSvcKafkaTLSEnabled].Value)
+	assert.Equal(t, fakeIsbsConfig.Kafka.Config, envs[dfv1.EnvISBSvcKafkaConfig].Value)
+	assert.Equal(t, string(dfv1.SASLTypePlaintext), envs[dfv1.EnvISBSvcKafkaSASLMechanism].Value)
+	assert.Equal(t, "test-user", envs[dfv1.EnvISBSvcKafkaSASLUser].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "test-pass", envs[dfv1.EnvISBSvcKafkaSASLPassword].ValueFrom.SecretKeyRef.Name)
+	assert.Contains(t, envs, dfv1.EnvISBSvcConfig)
+}
+
+func TestGetIsbSvcEnvVars_TLS(t *testing.T) {
+	tlsConfig := &dfv1.TLS{
+		CACertSecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "ca-secret"},
+			Key:                  "ca",
+		},
+	}
+	_, env := GetIsbSvcEnvVars(dfv1.BufferServiceConfig{Redis: &dfv1.RedisConfig{URL: "xxx", TLS: tlsConfig}})
+	eNames := []string{}
+	for _, e := range env {
+		eNames = append(eNames, e.Name)
+	}
+	assert.Contains(t, eNames, dfv1.EnvISBSvcRedisTLS)
+
+	_, env = GetIsbSvcEnvVars(dfv1.BufferServiceConfig{JetStream: &dfv1.JetStreamConfig{URL: "xxx", TLS: tlsConfig}})
+	eNames = []string{}
+	for _, e := range env {
+		eNames = append(eNames, e.Name)
+	}
+	assert.NotContains(t, eNames, dfv1.EnvISBSvcJetStreamTLS)
+
+	_, env = GetIsbSvcEnvVars(dfv1.BufferServiceConfig{JetStream: &dfv1.JetStreamConfig{URL: "xxx", TLSEnabled: true, TLS: tlsConfig}})
+	eNames = []string{}
+	for _, e := range env {
+		eNames = append(eNames, e.Name)
+	}
+	assert.Contains(t, eNames, dfv1.EnvISBSvcJetStreamTLS)
+
+	_, env = GetIsbSvcEnvVars(dfv1.BufferServiceConfig{Kafka: &dfv1.KafkaConfig{Brokers: []string{"xxx"}, TLSEnabled: true, TLS: tlsConfig}})
+	eNames = []string{}
+	for _, e := range env {
+		eNames = append(eNames, e.Name)
+	}
+	assert.Contains(t, eNames, dfv1.EnvISBSvcKafkaTLS)
+}
+
+func TestAttachIsbSvcTLSVolumes(t *testing.T) {
+	podSpec := &corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: dfv1.CtrInit}},
+		Containers:     []corev1.Container{{Name: dfv1.CtrMain}, {Name: dfv1.CtrUdf}},
+	}
+	AttachIsbSvcTLSVolumes(podSpec, dfv1.BufferServiceConfig{Redis: &dfv1.RedisConfig{URL: "xxx"}})
+	assert.Empty(t, podSpec.Volumes)
+
+	isbSvcConfig := dfv1.BufferServiceConfig{
+		Redis: &dfv1.RedisConfig{
+			URL: "xxx",
+			TLS: &dfv1.TLS{
+				CACertSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "tls-secret"},
+					Key:                  "ca",
+				},
+				CertSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "tls-secret"},
+					Key:                  "cert",
+				},
+				KeySecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "tls-secret"},
+					Key:                  "key",
+				},
+			},
+		},
+	}
+	AttachIsbSvcTLSVolumes(podSpec, isbSvcConfig)
+	AttachIsbSvcTLSVolumes(podSpec, isbSvcConfig)
+	assert.Len(t, podSpec.Volumes, 1)
+	assert.Len(t, podSpec.InitContainers[0].VolumeMounts, 1)
+	assert.Len(t, podSpec.Containers[0].VolumeMounts, 1)
+	assert.Empty(t, podSpec.Containers[1].VolumeMounts)
+}
+
+func TestGetIsbSvcConfigFromEnv(t *testing.T) {
+	c, err := GetIsbSvcConfigFromEnv()
+	assert.NoError(t, err)
+	assert.Nil(t, c.JetStream)
+
+	maxStreams := int32(10)
+	_, envs := GetIsbSvcEnvVars(dfv1.BufferServiceConfig{
+		JetStream: &dfv1.JetStreamConfig{URL: "nats://xxx", PipelineQuota: &dfv1.ISBPipelineQuota{MaxStreams: &maxStreams}},
+	})
+	t.Setenv(dfv1.EnvISBSvcConfig, envs[0].Value)
+	c, err = GetIsbSvcConfigFromEnv()
+	assert.NoError(t, err)
+	assert.Equal(t, "nats://xxx", c.JetStream.URL)
+	assert.Equal(t, 10, c.JetStream.PipelineQuota.GetMaxStreams())
+
+	t.Setenv(dfv1.EnvISBSvcConfig, "not-base64")
+	_, err = GetIsbSvcConfigFromEnv()
+	assert.ErrorContains(t, err, "failed to decode ISB Svc config string")
+}
@@ -19,6 +19,7 @@ package util
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -81,3 +82,17 @@ func GetTLSConfig(config *dfv1.TLS) (*tls.Config, error) {
 	}
 	return c, nil
 }
+
+// GetTLSConfigFromEnv returns the TLS config of the JSON encoded dfv1.TLS in the environment variable, nil if the
+// environment variable doesn't exist.
+func GetTLSConfigFromEnv(env string) (*tls.Config, error) {
+	value, existing := os.LookupEnv(env)
+	if !existing {
+		return nil, nil
+	}
+	config := &dfv1.TLS{}
+	if err := json.Unmarshal([]byte(value), config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the TLS config in environment variable %q, %w", env, err)
+	}
+	return GetTLSConfig(config)
+}
@@ -21,6 +21,22 @@ func TestGetTLSConfig_NilConfig(t *testing.T) {
 	assert.Nil(t, config)
 }
 
+func TestGetTLSConfigFromEnv(t *testing.T) {
+	config, err := GetTLSConfigFromEnv("TEST_TLS_CONFIG_NOT_SET")
+	assert.NoError(t, err)
+	assert.Nil(t, config)
+
+	t.Setenv("TEST_TLS_CONFIG", `{"insecureSkipVerify":true}`)
+	config, err = GetTLSConfigFromEnv("TEST_TLS_CONFIG")
+	assert.NoError(t, err)
+	assert.NotNil(t, config)
+	assert.True(t, config.InsecureSkipVerify)
+
+	t.Setenv("TEST_TLS_CONFIG", "xxx")
+	_, err = GetTLSConfigFromEnv("TEST_TLS_CONFIG")
+	assert.Error(t, err)
+}
+
 func MockTLSObject() *dfv1.TLS {
 	return &dfv1.TLS{
 		InsecureSkipVerify: true,
@@ -67,7 +67,7 @@ func (sii *sideInputsInitializer) Run(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	switch sii.isbSvcType {
-	case dfv1.ISBSvcTypeRedis:
+	case dfv1.ISBSvcTypeRedis, dfv1.ISBSvcTypeKafka:
 		return fmt.Errorf("unsupported isbsvc type %q", sii.isbSvcType)
 	case dfv1.ISBSvcTypeJetStream:
 		natsClient, err = jsclient.NewNATSClient(ctx)
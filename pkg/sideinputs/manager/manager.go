@@ -63,7 +63,7 @@ func (sim *sideInputsManager) Start(ctx context.Context) error {
 	var err error
 	var siStore kvs.KVStorer
 	switch sim.isbSvcType {
-	case dfv1.ISBSvcTypeRedis:
+	case dfv1.ISBSvcTypeRedis, dfv1.ISBSvcTypeKafka:
 		return fmt.Errorf("unsupported isbsvc type %q", sim.isbSvcType)
 	case dfv1.ISBSvcTypeJetStream:
 		natsClient, err = jsclient.NewNATSClient(ctx)
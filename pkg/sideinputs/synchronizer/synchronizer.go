@@ -68,7 +68,7 @@ func (sis *sideInputsSynchronizer) Start(ctx context.Context) error {
 	defer cancel()
 
 	switch sis.isbSvcType {
-	case dfv1.ISBSvcTypeRedis:
+	case dfv1.ISBSvcTypeRedis, dfv1.ISBSvcTypeKafka:
 		return fmt.Errorf("unsupported isbsvc type %q", sis.isbSvcType)
 	case dfv1.ISBSvcTypeJetStream:
 		natsClient, err = jsclient.NewNATSClient(ctx)
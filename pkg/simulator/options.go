@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/udf/forward/applier"
+)
+
+type options struct {
+	// mapAppliers are the functions applied by the map vertices, keyed by the vertex names. The vertices without one
+	// forward the messages as they are.
+	mapAppliers map[string]applier.MapApplier
+	// watermarkSampleInterval is the interval the watermarks of the edges are sampled at.
+	watermarkSampleInterval time.Duration
+	// drainTimeout is the max time to wait for a vertex to drain its buffers at the end of the simulation.
+	drainTimeout time.Duration
+	// logger is the logger of the simulated vertices.
+	logger *zap.SugaredLogger
+}
+
+// Option sets an option of the simulator.
+type Option func(*options) error
+
+func defaultOptions() *options {
+	return &options{
+		mapAppliers:             make(map[string]applier.MapApplier),
+		watermarkSampleInterval: time.Second,
+		drainTimeout:            10 * time.Second,
+		logger:                  zap.NewNop().Sugar(),
+	}
+}
+
+// WithMapApplier sets the function applied by a map vertex instead of forwarding the messages as they are.
+func WithMapApplier(vertex string, f applier.MapApplier) Option {
+	return func(o *options) error {
+		if f == nil {
+			return fmt.Errorf("nil map applier for vertex %q", vertex)
+		}
+		o.mapAppliers[vertex] = f
+		return nil
+	}
+}
+
+// WithWatermarkSampleInterval sets the interval the watermarks of the edges are sampled at.
+func WithWatermarkSampleInterval(d time.Duration) Option {
+	return func(o *options) error {
+		if d <= 0 {
+			return fmt.Errorf("invalid watermark sample interval %v, it should be positive", d)
+		}
+		o.watermarkSampleInterval = d
+		return nil
+	}
+}
+
+// WithDrainTimeout sets the max time to wait for a vertex to drain its buffers at the end of the simulation.
+func WithDrainTimeout(d time.Duration) Option {
+	return func(o *options) error {
+		if d <= 0 {
+			return fmt.Errorf("invalid drain timeout %v, it should be positive", d)
+		}
+		o.drainTimeout = d
+		return nil
+	}
+}
+
+// WithLogger sets the logger of the simulated vertices, they do not log by default.
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *options) error {
+		o.logger = l
+		return nil
+	}
+}
@@ -0,0 +1,635 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator runs a pipeline in-process against the in-memory inter-step buffers and watermark stores, so that
+// the authors of a pipeline spec get a fast feedback before deploying it to a cluster. The map UDFs are replaced by
+// functions, forwarding the messages as they are by default, and the sinks other than log are replaced by blackholes.
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forwarder"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/reconciler/pipeline"
+	"github.com/numaproj/numaflow/pkg/reconciler/validator"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/shuffle"
+	"github.com/numaproj/numaflow/pkg/sinks/blackhole"
+	sinkforward "github.com/numaproj/numaflow/pkg/sinks/forward"
+	"github.com/numaproj/numaflow/pkg/sinks/logger"
+	"github.com/numaproj/numaflow/pkg/sinks/sinker"
+	sourceforward "github.com/numaproj/numaflow/pkg/sources/forward"
+	"github.com/numaproj/numaflow/pkg/sources/generator"
+	"github.com/numaproj/numaflow/pkg/udf/forward"
+	"github.com/numaproj/numaflow/pkg/udf/forward/applier"
+	"github.com/numaproj/numaflow/pkg/watermark/entity"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// heartbeatRate is the rate in seconds of the heartbeats of the watermark processors, it's lower than the default one so
+// that the watermarks progress within short runs.
+const heartbeatRate = 1
+
+// Simulator runs a pipeline in-process.
+type Simulator struct {
+	pipeline *dfv1.Pipeline
+	// vertices are the vertex objects of the pipeline in topological order, the sources first.
+	vertices []*dfv1.Vertex
+	opts     *options
+}
+
+// NewSimulator returns a simulator of the pipeline. It returns an error if the pipeline is invalid or has vertices
+// which can't be simulated: the sources other than generator, the source transformers, the reduce vertices and the
+// cycles.
+func NewSimulator(pl *dfv1.Pipeline, opts ...Option) (*Simulator, error) {
+	o := defaultOptions()
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	pl = pl.DeepCopy()
+	if pl.Namespace == "" {
+		pl.Namespace = "default"
+	}
+	if err := validator.ValidatePipeline(pl); err != nil {
+		return nil, fmt.Errorf("invalid pipeline, %w", err)
+	}
+	for _, v := range pl.Spec.Vertices {
+		switch {
+		case v.IsASource():
+			if v.Source.Generator == nil {
+				return nil, fmt.Errorf("vertex %q: only the generator sources can be simulated", v.Name)
+			}
+			if v.HasUDTransformer() {
+				return nil, fmt.Errorf("vertex %q: the source transformers can't be simulated", v.Name)
+			}
+		case v.IsReduceUDF():
+			return nil, fmt.Errorf("vertex %q: the reduce vertices can't be simulated", v.Name)
+		}
+	}
+	for name := range o.mapAppliers {
+		if v := pl.GetVertex(name); v == nil || !v.IsMapUDF() {
+			return nil, fmt.Errorf("map applier set for %q, which is not a map vertex", name)
+		}
+	}
+	order, err := topologicalOrder(pl)
+	if err != nil {
+		return nil, err
+	}
+	built := pipeline.BuildVertices(pl)
+	var vertices []*dfv1.Vertex
+	for _, name := range order {
+		v := built[pl.Name+"-"+name]
+		vertices = append(vertices, &v)
+	}
+	return &Simulator{pipeline: pl, vertices: vertices, opts: o}, nil
+}
+
+// LoadPipeline loads a pipeline spec from a YAML or JSON file.
+func LoadPipeline(path string) (*dfv1.Pipeline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the pipeline spec, %w", err)
+	}
+	pl := &dfv1.Pipeline{}
+	if err := yaml.UnmarshalStrict(data, pl); err != nil {
+		return nil, fmt.Errorf("failed to parse the pipeline spec, %w", err)
+	}
+	if pl.Kind != "" && pl.Kind != dfv1.PipelineGroupVersionKind.Kind {
+		return nil, fmt.Errorf("the spec is a %s, not a %s", pl.Kind, dfv1.PipelineGroupVersionKind.Kind)
+	}
+	return pl, nil
+}
+
+// topologicalOrder returns the names of the vertices in topological order, it returns an error if there is a cycle.
+func topologicalOrder(pl *dfv1.Pipeline) ([]string, error) {
+	inDegrees := make(map[string]int)
+	for _, e := range pl.Spec.Edges {
+		inDegrees[e.To]++
+	}
+	var order, next []string
+	for _, v := range pl.Spec.Vertices {
+		if inDegrees[v.Name] == 0 {
+			next = append(next, v.Name)
+		}
+	}
+	for len(next) > 0 {
+		name := next[0]
+		next = next[1:]
+		order = append(order, name)
+		for _, e := range pl.GetToEdges(name) {
+			if inDegrees[e.To]--; inDegrees[e.To] == 0 {
+				next = append(next, e.To)
+			}
+		}
+	}
+	if len(order) != len(pl.Spec.Vertices) {
+		return nil, fmt.Errorf("the pipelines with cycles can't be simulated")
+	}
+	return order, nil
+}
+
+// simulation is a run of the simulator.
+type simulation struct {
+	*Simulator
+	// isbSvc keeps the inter-step buffers and the watermark stores in memory.
+	isbSvc *isbsvc.InMemorySvc
+	// buffers are the inter-step buffers, keyed by the buffer names.
+	buffers map[string]*simplebuffer.InMemoryBuffer
+	// wmStores are the watermark stores, keyed by the bucket names.
+	wmStores map[string]store.WatermarkStore
+	// runners are the forwarders of the vertices, keyed by the vertex names.
+	runners map[string][]*runner
+	// closers are closed in order once the forwarders are stopped, the watermark publishers first and then the
+	// watermark fetchers.
+	closers []io.Closer
+	// failed receives the errors the forwarders stop with.
+	failed chan error
+	log    *zap.SugaredLogger
+}
+
+// runner runs a forwarder.
+type runner struct {
+	forwarder forwarder.StarterStopper
+	// exited is closed once the forwarder exits.
+	exited chan struct{}
+}
+
+// start starts the forwarder, the error it stops with is sent to failed.
+func (r *runner) start(failed chan<- error) {
+	stopped := r.forwarder.Start()
+	go func() {
+		defer close(r.exited)
+		if err := <-stopped; err != nil {
+			select {
+			case failed <- err:
+			default:
+			}
+		}
+	}()
+}
+
+// Run runs the pipeline for the given duration, or until the context is done or a vertex fails, and returns the
+// summary of the run. The buffers are drained before the vertices are stopped, one after the other in topological
+// order.
+func (s *Simulator) Run(ctx context.Context, duration time.Duration) (*Summary, error) {
+	ctx, cancel := context.WithCancel(logging.WithLogger(ctx, s.opts.logger))
+	defer cancel()
+
+	countsBefore, err := gatherCounts(s.pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+	sim := &simulation{
+		Simulator: s,
+		isbSvc:    isbsvc.NewISBInMemorySvc(),
+		buffers:   make(map[string]*simplebuffer.InMemoryBuffer),
+		wmStores:  make(map[string]store.WatermarkStore),
+		runners:   make(map[string][]*runner),
+		failed:    make(chan error, 1),
+		log:       s.opts.logger,
+	}
+	defer sim.closeStores()
+	if err := sim.build(ctx); err != nil {
+		sim.close()
+		return nil, err
+	}
+	sampler := newWatermarkSampler(ctx, s.pipeline, sim.wmStores)
+
+	start := time.Now()
+	for _, v := range s.vertices {
+		for _, r := range sim.runners[v.Spec.Name] {
+			r.start(sim.failed)
+		}
+	}
+	samplerCtx, stopSampler := context.WithCancel(ctx)
+	samplerDone := make(chan struct{})
+	go func() {
+		defer close(samplerDone)
+		sampler.run(samplerCtx, s.opts.watermarkSampleInterval)
+	}()
+
+	var runErr error
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	case runErr = <-sim.failed:
+		runErr = fmt.Errorf("a vertex failed, %w", runErr)
+	}
+	if err := sim.stop(); err != nil {
+		runErr = errors.Join(runErr, err)
+	}
+	elapsed := time.Since(start)
+	stopSampler()
+	<-samplerDone
+	sampler.sample()
+	sim.close()
+	_ = sampler.Close()
+
+	countsAfter, err := gatherCounts(s.pipeline.Name)
+	if err != nil {
+		return nil, errors.Join(runErr, err)
+	}
+	return newSummary(s.pipeline, s.vertices, elapsed, countsAfter.sub(countsBefore), sampler.edges()), runErr
+}
+
+// build creates the buffers, the watermark stores and the forwarders of all the vertices.
+func (sim *simulation) build(ctx context.Context) error {
+	var buffers, buckets []string
+	var createOpts []isbsvc.CreateOption
+	for _, v := range sim.vertices {
+		for _, name := range v.OwnedBuffers() {
+			buffers = append(buffers, name)
+			createOpts = append(createOpts, isbsvc.WithBufferConfig(name, bufferConfig(sim.pipeline, v)))
+		}
+		if !sim.pipeline.Spec.Watermark.Disabled {
+			for _, bucket := range append(v.GetFromBuckets(), v.GetToBuckets()...) {
+				if !slices.Contains(buckets, bucket) {
+					buckets = append(buckets, bucket)
+				}
+			}
+		}
+	}
+	if err := sim.isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, "", nil, createOpts...); err != nil {
+		return fmt.Errorf("failed to create the buffers and the buckets, %w", err)
+	}
+	for _, name := range buffers {
+		b, err := sim.isbSvc.Buffer(name)
+		if err != nil {
+			return err
+		}
+		sim.buffers[name] = b
+	}
+	for _, bucket := range buckets {
+		wmStores, err := sim.isbSvc.CreateWatermarkStores(ctx, bucket, 1, false)
+		if err != nil {
+			return fmt.Errorf("failed to create the watermark store %q, %w", bucket, err)
+		}
+		sim.wmStores[bucket] = wmStores[0]
+	}
+	for _, v := range sim.vertices {
+		var err error
+		switch {
+		case v.IsASource():
+			err = sim.buildSource(ctx, v)
+		case v.IsASink():
+			err = sim.buildSink(ctx, v)
+		default:
+			err = sim.buildMap(ctx, v)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to build vertex %q, %w", v.Spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// bufferConfig returns the config of the in-memory buffers of a vertex, the length is the usable length of the buffer.
+func bufferConfig(pl *dfv1.Pipeline, v *dfv1.Vertex) string {
+	limits := pl.GetPipelineLimits()
+	length, usageLimit := *limits.BufferMaxLength, *limits.BufferUsageLimit
+	if x := v.Spec.Limits; x != nil {
+		if x.BufferMaxLength != nil {
+			length = *x.BufferMaxLength
+		}
+		if x.BufferUsageLimit != nil {
+			usageLimit = *x.BufferUsageLimit
+		}
+	}
+	conf := fmt.Sprintf("buffer:\n  length: %d\n  readTimeout: %s\n", max(int64(length)*int64(usageLimit)/100, 1), readTimeout(v))
+	// the buffer is shared by the incoming edges, the writing strategy of the first one is used.
+	if len(v.Spec.FromEdges) > 0 {
+		conf += fmt.Sprintf("  bufferFullWritingStrategy: %s\n", v.Spec.FromEdges[0].BufferFullWritingStrategy())
+	}
+	return conf
+}
+
+// readTimeout returns the read timeout of a vertex.
+func readTimeout(v *dfv1.Vertex) time.Duration {
+	if x := v.Spec.Limits; x != nil && x.ReadTimeout != nil {
+		return x.ReadTimeout.Duration
+	}
+	return dfv1.DefaultReadTimeout
+}
+
+// readBatchSize returns the read batch size of a vertex.
+func readBatchSize(v *dfv1.Vertex) int64 {
+	if x := v.Spec.Limits; x != nil && x.ReadBatchSize != nil {
+		return int64(*x.ReadBatchSize)
+	}
+	return dfv1.DefaultReadBatchSize
+}
+
+// writers returns the writers of the buffers of the to vertices of a vertex, keyed by the to vertex names.
+func (sim *simulation) writers(v *dfv1.Vertex) map[string][]isb.BufferWriter {
+	writers := make(map[string][]isb.BufferWriter)
+	for _, e := range v.Spec.ToEdges {
+		for _, name := range dfv1.GenerateBufferNames(v.Namespace, v.Spec.PipelineName, e.To, e.GetToVertexPartitionCount()) {
+			writers[e.To] = append(writers[e.To], sim.buffers[name])
+		}
+	}
+	return writers
+}
+
+// fromVertexWmStores returns the watermark stores of the incoming edges of a vertex, keyed by the from vertex names.
+func (sim *simulation) fromVertexWmStores(v *dfv1.Vertex) map[string]store.WatermarkStore {
+	wmStores := make(map[string]store.WatermarkStore)
+	for _, e := range v.Spec.FromEdges {
+		wmStores[e.From] = sim.wmStores[dfv1.GenerateEdgeBucketName(v.Namespace, v.Spec.PipelineName, e.From, e.To)]
+	}
+	return wmStores
+}
+
+// toVertexWmStores returns the watermark stores of the outgoing edges of a vertex, keyed by the to vertex names.
+func (sim *simulation) toVertexWmStores(v *dfv1.Vertex) map[string]store.WatermarkStore {
+	wmStores := make(map[string]store.WatermarkStore)
+	for _, e := range v.Spec.ToEdges {
+		wmStores[e.To] = sim.wmStores[dfv1.GenerateEdgeBucketName(v.Namespace, v.Spec.PipelineName, e.From, e.To)]
+	}
+	return wmStores
+}
+
+func (sim *simulation) addRunner(v *dfv1.Vertex, f forwarder.StarterStopper) {
+	sim.runners[v.Spec.Name] = append(sim.runners[v.Spec.Name], &runner{forwarder: f, exited: make(chan struct{})})
+}
+
+func (sim *simulation) buildSource(ctx context.Context, v *dfv1.Vertex) error {
+	vi := &dfv1.VertexInstance{Vertex: v, Hostname: v.Name + "-0", Replica: 0}
+	writers := sim.writers(v)
+	var (
+		fetchWatermark      fetch.SourceFetcher
+		srcPublisherStore   store.WatermarkStore
+		toVertexWmStores    map[string]store.WatermarkStore
+		idleManager         wmb.IdleManager
+		sourcePublishBucket = v.GetFromBuckets()[0]
+	)
+	if sim.pipeline.Spec.Watermark.Disabled {
+		fetchWatermark, _ = generic.BuildNoOpSourceWatermarkProgressors(v.GetToBuffers())
+		srcPublisherStore, _ = store.BuildNoOpWatermarkStore()
+		toVertexWmStores = make(map[string]store.WatermarkStore)
+		for _, e := range v.Spec.ToEdges {
+			toVertexWmStores[e.To], _ = store.BuildNoOpWatermarkStore()
+		}
+		idleManager = wmb.NewNoOpIdleManager()
+	} else {
+		srcPublisherStore = sim.wmStores[sourcePublishBucket]
+		fetchWatermark = fetch.NewSourceFetcher(ctx, srcPublisherStore, fetch.WithIsSource(true))
+		sim.closers = append(sim.closers, fetchWatermark.(io.Closer))
+		toVertexWmStores = sim.toVertexWmStores(v)
+		idleManager, _ = wmb.NewIdleManager(1, len(writers))
+	}
+
+	reader, err := generator.NewMemGen(ctx, vi, generator.WithReadTimeout(readTimeout(v)))
+	if err != nil {
+		return err
+	}
+	df, err := sourceforward.NewDataForward(vi, reader, writers, newToWhichStepDecider(v, false), fetchWatermark,
+		publish.NewVertexSourcePublish(ctx, vi, srcPublisherStore, publish.WithPodHeartbeatRate(heartbeatRate)), toVertexWmStores, idleManager,
+		sourceforward.WithLogger(sim.log), sourceforward.WithReadBatchSize(readBatchSize(v)),
+		sourceforward.WithWatermarkPublishOptions(publish.WithPodHeartbeatRate(heartbeatRate)))
+	if err != nil {
+		return err
+	}
+	sim.addRunner(v, df)
+	return nil
+}
+
+func (sim *simulation) buildMap(ctx context.Context, v *dfv1.Vertex) error {
+	vi := &dfv1.VertexInstance{Vertex: v, Hostname: v.Name + "-0", Replica: 0}
+	writers := sim.writers(v)
+	var (
+		fetchWatermark   fetch.Fetcher
+		publishWatermark map[string]publish.Publisher
+		idleManager      wmb.IdleManager
+	)
+	if sim.pipeline.Spec.Watermark.Disabled {
+		fetchWatermark, publishWatermark = generic.BuildNoOpWatermarkProgressorsFromBufferMap(writers)
+		idleManager = wmb.NewNoOpIdleManager()
+	} else {
+		publishWatermark = sim.buildPublishers(ctx, vi, sim.toVertexWmStores(v))
+		fetchWatermark = fetch.NewEdgeFetcherSet(ctx, vi, sim.fromVertexWmStores(v), fetch.WithVertexReplica(vi.Replica))
+		sim.closers = append(sim.closers, fetchWatermark.(io.Closer))
+		idleManager, _ = wmb.NewIdleManager(len(writers), len(writers))
+	}
+
+	mapApplier, ok := sim.opts.mapAppliers[v.Spec.Name]
+	if !ok {
+		mapApplier = applier.NewPassThroughMap(v.Spec.Name, "")
+	}
+	for _, name := range v.OwnedBuffers() {
+		df, err := forward.NewInterStepDataForward(vi, sim.buffers[name], writers, newToWhichStepDecider(v, true), fetchWatermark, publishWatermark, idleManager,
+			forward.WithUDFMap(mapApplier), forward.WithLogger(sim.log), forward.WithReadBatchSize(readBatchSize(v)))
+		if err != nil {
+			return err
+		}
+		sim.addRunner(v, df)
+	}
+	return nil
+}
+
+func (sim *simulation) buildSink(ctx context.Context, v *dfv1.Vertex) error {
+	vi := &dfv1.VertexInstance{Vertex: v, Hostname: v.Name + "-0", Replica: 0}
+	ownedBuffers := v.OwnedBuffers()
+	var (
+		fetchWatermark   fetch.Fetcher
+		publishWatermark publish.Publisher
+		idleManager      wmb.IdleManager
+	)
+	if sim.pipeline.Spec.Watermark.Disabled {
+		fetchWatermark, _ = generic.BuildNoOpWatermarkProgressorsFromBufferList(nil)
+		publishWatermark = generic.NewNoOpWMProgressor()
+		idleManager = wmb.NewNoOpIdleManager()
+	} else {
+		publishWatermark = sim.buildPublishers(ctx, vi, map[string]store.WatermarkStore{v.Spec.Name: sim.wmStores[v.GetToBuckets()[0]]})[v.Spec.Name]
+		fetchWatermark = fetch.NewEdgeFetcherSet(ctx, vi, sim.fromVertexWmStores(v))
+		sim.closers = append(sim.closers, fetchWatermark.(io.Closer))
+		idleManager, _ = wmb.NewIdleManager(len(ownedBuffers), 1)
+	}
+
+	for _, name := range ownedBuffers {
+		var (
+			sinkWriter sinker.SinkWriter
+			err        error
+		)
+		if v.Spec.Sink.Log != nil {
+			sinkWriter, err = logger.NewToLog(ctx, vi)
+		} else {
+			sim.log.Infow("The sink is replaced by a blackhole", zap.String("vertex", v.Spec.Name))
+			sinkWriter, err = blackhole.NewBlackhole(ctx, vi)
+		}
+		if err != nil {
+			return err
+		}
+		df, err := sinkforward.NewDataForward(vi, sim.buffers[name], sinkWriter, fetchWatermark, publishWatermark, idleManager,
+			sinkforward.WithLogger(sim.log), sinkforward.WithReadBatchSize(readBatchSize(v)))
+		if err != nil {
+			return err
+		}
+		sim.addRunner(v, df)
+	}
+	return nil
+}
+
+// buildPublishers returns the watermark publishers of a vertex to the given stores, the publishers are closed before
+// the fetchers at the end of the run. The publishers of the sinks publish to the sink bucket.
+func (sim *simulation) buildPublishers(ctx context.Context, vi *dfv1.VertexInstance, wmStores map[string]store.WatermarkStore) map[string]publish.Publisher {
+	publishEntity := entity.NewProcessorEntity(fmt.Sprintf("%s-%d", vi.Vertex.Name, vi.Replica))
+	publishers := make(map[string]publish.Publisher)
+	for to, wmStore := range wmStores {
+		var p publish.Publisher
+		if vi.Vertex.IsASink() {
+			p = publish.NewPublish(ctx, publishEntity, wmStore, 1, publish.IsSink(), publish.WithPodHeartbeatRate(heartbeatRate))
+		} else {
+			p = publish.NewPublish(ctx, publishEntity, wmStore, int32(vi.Vertex.Spec.ToEdges[indexOfToEdge(vi.Vertex, to)].GetToVertexPartitionCount()), publish.WithPodHeartbeatRate(heartbeatRate))
+		}
+		publishers[to] = p
+		// the publishers are closed first.
+		sim.closers = append([]io.Closer{p}, sim.closers...)
+	}
+	return publishers
+}
+
+// indexOfToEdge returns the index of the outgoing edge of a vertex to the given vertex.
+func indexOfToEdge(v *dfv1.Vertex, to string) int {
+	for i, e := range v.Spec.ToEdges {
+		if e.To == to {
+			return i
+		}
+	}
+	return -1
+}
+
+// stop stops the vertices in topological order, the buffers of a vertex are drained before it's stopped, as long as
+// the drain timeout allows.
+func (sim *simulation) stop() error {
+	var errs []error
+	for _, v := range sim.vertices {
+		if !v.IsASource() {
+			if !sim.waitForDrain(v) {
+				sim.log.Warnw("The buffers of the vertex are not drained before stopping it", zap.String("vertex", v.Spec.Name))
+			}
+		}
+		for _, r := range sim.runners[v.Spec.Name] {
+			r.forwarder.Stop()
+		}
+		for _, r := range sim.runners[v.Spec.Name] {
+			select {
+			case <-r.exited:
+			case <-time.After(sim.opts.drainTimeout):
+				errs = append(errs, fmt.Errorf("vertex %q did not stop within %v", v.Spec.Name, sim.opts.drainTimeout))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// waitForDrain waits until the messages in the buffers of the vertex are all acknowledged, it returns false if the
+// drain timeout is reached.
+func (sim *simulation) waitForDrain(v *dfv1.Vertex) bool {
+	deadline := time.Now().Add(sim.opts.drainTimeout)
+	for {
+		drained := true
+		for _, name := range v.OwnedBuffers() {
+			drained = drained && sim.buffers[name].IsDrained()
+		}
+		if drained {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// close closes the watermark publishers and then the watermark fetchers.
+func (sim *simulation) close() {
+	for _, c := range sim.closers {
+		if err := c.Close(); err != nil {
+			sim.log.Warnw("Failed to close", zap.Error(err))
+		}
+	}
+	sim.closers = nil
+}
+
+// closeStores closes the watermark stores.
+func (sim *simulation) closeStores() {
+	_ = sim.isbSvc.Close()
+}
+
+// newToWhichStepDecider returns the decider of the buffers the messages of a vertex are written to, the way the vertex
+// processors decide it. The conditions of the edges are only checked for the map vertices, since the messages of the
+// sources without transformers do not have tags.
+func newToWhichStepDecider(v *dfv1.Vertex, checkConditions bool) forwarder.GoWhere {
+	shuffleFuncMap := make(map[string]*shuffle.Shuffle)
+	for _, edge := range v.Spec.ToEdges {
+		if edge.GetToVertexPartitionCount() > 1 {
+			shuffleFuncMap[edge.From+":"+edge.To] = shuffle.NewShuffle(edge.To, edge.GetToVertexPartitionCount())
+		}
+	}
+	return func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+		var result []forwarder.VertexBuffer
+		if checkConditions && sharedutil.StringSliceContains(tags, dfv1.MessageTagDrop) {
+			metrics.UserDroppedMessages.With(map[string]string{
+				metrics.LabelVertex:             v.Spec.Name,
+				metrics.LabelPipeline:           v.Spec.PipelineName,
+				metrics.LabelVertexType:         string(dfv1.VertexTypeMapUDF),
+				metrics.LabelVertexReplicaIndex: "0",
+			}).Inc()
+			return result, nil
+		}
+		for _, edge := range v.Spec.ToEdges {
+			if checkConditions && !(edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 ||
+				sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values)) {
+				continue
+			}
+			partitionIdx := isb.DefaultPartitionIdx
+			if edge.GetToVertexPartitionCount() > 1 {
+				partitionIdx = shuffleFuncMap[edge.From+":"+edge.To].ShuffleOnId(msgId)
+			}
+			result = append(result, forwarder.VertexBuffer{ToVertexName: edge.To, ToVertexPartitionIdx: partitionIdx})
+		}
+		return result, nil
+	}
+}
+
+// vertexTypeName returns the name of the type of a vertex in the summary.
+func vertexTypeName(v *dfv1.Vertex) string {
+	return strings.ToLower(string(v.GetVertexType()))
+}
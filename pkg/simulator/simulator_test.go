@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/udf/forward/applier"
+)
+
+func loadPipeline(t *testing.T, path string) *dfv1.Pipeline {
+	t.Helper()
+	pl, err := LoadPipeline(path)
+	require.NoError(t, err)
+	return pl
+}
+
+func vertexSummary(t *testing.T, s *Summary, name string) VertexSummary {
+	t.Helper()
+	for _, v := range s.Vertices {
+		if v.Name == name {
+			return v
+		}
+	}
+	t.Fatalf("no summary of vertex %q", name)
+	return VertexSummary{}
+}
+
+func TestSimulator_Linear(t *testing.T) {
+	sim, err := NewSimulator(loadPipeline(t, "../../examples/1-simple-pipeline.yaml"))
+	require.NoError(t, err)
+	summary, err := sim.Run(context.Background(), 3*time.Second)
+	require.NoError(t, err)
+
+	assert.Equal(t, "simple-pipeline", summary.Pipeline)
+	require.Len(t, summary.Vertices, 3)
+	assert.Equal(t, []string{"in", "cat", "out"}, []string{summary.Vertices[0].Name, summary.Vertices[1].Name, summary.Vertices[2].Name})
+	in, cat, out := vertexSummary(t, summary, "in"), vertexSummary(t, summary, "cat"), vertexSummary(t, summary, "out")
+	assert.Equal(t, "source", in.Type)
+	assert.Greater(t, in.Read, int64(0))
+	assert.Greater(t, in.ReadRate, float64(0))
+	// the buffers are drained before the vertices are stopped.
+	assert.Equal(t, in.Written, cat.Read)
+	assert.Equal(t, cat.Written, out.Read)
+	assert.Equal(t, in.Written, out.Read)
+
+	require.Len(t, summary.Edges, 2)
+
+	var buf bytes.Buffer
+	require.NoError(t, summary.Print(&buf))
+	assert.Contains(t, buf.String(), "cat")
+	assert.Contains(t, buf.String(), "WATERMARK")
+}
+
+func TestSimulator_FanOut(t *testing.T) {
+	pl := loadPipeline(t, "testdata/fan-out-pipeline.yaml")
+	dropAll := applier.ApplyMapFunc(func(_ context.Context, messages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, error) {
+		results := make([]isb.ReadWriteMessagePair, len(messages))
+		for i, m := range messages {
+			results[i] = isb.ReadWriteMessagePair{ReadMessage: m, WriteMessages: []*isb.WriteMessage{{Message: m.Message, Tags: []string{dfv1.MessageTagDrop}}}}
+		}
+		return results, nil
+	})
+	sim, err := NewSimulator(pl, WithMapApplier("cat-partitioned", dropAll), WithWatermarkSampleInterval(100*time.Millisecond))
+	require.NoError(t, err)
+	summary, err := sim.Run(context.Background(), 4*time.Second)
+	require.NoError(t, err)
+
+	in, cat, catPartitioned := vertexSummary(t, summary, "in"), vertexSummary(t, summary, "cat"), vertexSummary(t, summary, "cat-partitioned")
+	out, outPartitioned := vertexSummary(t, summary, "out"), vertexSummary(t, summary, "out-partitioned")
+	assert.Greater(t, in.Read, int64(0))
+	// every message is written to both the map vertices.
+	assert.Equal(t, in.Written, cat.Read+catPartitioned.Read)
+	assert.Equal(t, cat.Read, catPartitioned.Read)
+	assert.Equal(t, cat.Written, out.Read)
+	assert.Greater(t, out.Read, int64(0))
+	// the messages are all dropped by the user, only the idle watermarks get to the sink.
+	assert.Equal(t, int64(0), catPartitioned.Written)
+	assert.Equal(t, catPartitioned.Read, catPartitioned.Dropped)
+	assert.Equal(t, int64(0), outPartitioned.Written)
+
+	require.Len(t, summary.Edges, 4)
+	for _, e := range summary.Edges {
+		assert.True(t, e.Progressed(), "watermark of edge %s-%s did not progress", e.From, e.To)
+	}
+}
+
+func TestSimulator_WatermarkDisabled(t *testing.T) {
+	pl := loadPipeline(t, "../../examples/1-simple-pipeline-wm-disabled.yaml")
+	sim, err := NewSimulator(pl)
+	require.NoError(t, err)
+	summary, err := sim.Run(context.Background(), 3*time.Second)
+	require.NoError(t, err)
+	assert.Greater(t, vertexSummary(t, summary, "out").Read, int64(0))
+	for _, e := range summary.Edges {
+		assert.Equal(t, int64(-1), e.FirstWatermark)
+	}
+}
+
+func TestNewSimulator_Unsupported(t *testing.T) {
+	pl := loadPipeline(t, "../../examples/1-simple-pipeline.yaml")
+	pl.Spec.Vertices[0].Source = &dfv1.Source{HTTP: &dfv1.HTTPSource{}}
+	_, err := NewSimulator(pl)
+	assert.ErrorContains(t, err, "only the generator sources can be simulated")
+
+	pl = loadPipeline(t, "../../examples/1-simple-pipeline.yaml")
+	pl.Spec.Vertices[1].UDF = &dfv1.UDF{
+		Container: &dfv1.Container{Image: "my-reduce"},
+		GroupBy: &dfv1.GroupBy{
+			Window:  dfv1.Window{Fixed: &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}}},
+			Storage: &dfv1.PBQStorage{NoStore: &dfv1.NoStore{}},
+		},
+	}
+	_, err = NewSimulator(pl)
+	assert.ErrorContains(t, err, "reduce vertices can't be simulated")
+
+	pl = loadPipeline(t, "../../examples/1-simple-pipeline.yaml")
+	pl.Spec.Edges = append(pl.Spec.Edges, dfv1.Edge{From: "cat", To: "cat", Conditions: &dfv1.ForwardConditions{Tags: &dfv1.TagConditions{Values: []string{"retry"}}}})
+	_, err = NewSimulator(pl)
+	assert.ErrorContains(t, err, "cycles")
+
+	_, err = NewSimulator(loadPipeline(t, "../../examples/1-simple-pipeline.yaml"), WithMapApplier("out", applier.NewPassThroughMap("out", "")))
+	assert.ErrorContains(t, err, "not a map vertex")
+
+	_, err = LoadPipeline("../../examples/0-isbsvc-jetstream.yaml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// Summary is the summary of a run of the simulator.
+type Summary struct {
+	Pipeline string
+	// Duration is how long the vertices ran.
+	Duration time.Duration
+	// Vertices are the summaries of the vertices in topological order.
+	Vertices []VertexSummary
+	// Edges are the summaries of the edges, in the order of the pipeline spec.
+	Edges []EdgeSummary
+}
+
+// VertexSummary is the summary of a vertex in a run of the simulator.
+type VertexSummary struct {
+	Name string
+	Type string
+	// Read is the number of messages read by the vertex.
+	Read int64
+	// Written is the number of messages written by the vertex to the buffers of the next vertices.
+	Written int64
+	// Dropped is the number of messages dropped by the vertex, because the buffers were full or by the user.
+	Dropped int64
+	// ReadRate is the number of messages read per second.
+	ReadRate float64
+}
+
+// EdgeSummary is the summary of the watermark progression of an edge in a run of the simulator.
+type EdgeSummary struct {
+	From string
+	To   string
+	// FirstWatermark is the first valid watermark of the edge, or -1 if the watermark never progressed.
+	FirstWatermark int64
+	// LastWatermark is the last watermark of the edge, or -1 if the watermark never progressed.
+	LastWatermark int64
+}
+
+// Progressed returns true if the watermark of the edge progressed during the run.
+func (e EdgeSummary) Progressed() bool {
+	return e.FirstWatermark >= 0 && e.LastWatermark > e.FirstWatermark
+}
+
+// Print prints the summary in a table.
+func (s *Summary) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Pipeline %q ran for %v.\n\n", s.Pipeline, s.Duration.Round(time.Millisecond))
+	fmt.Fprintln(tw, "VERTEX\tTYPE\tREAD\tWRITTEN\tDROPPED\tREAD RATE")
+	for _, v := range s.Vertices {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%.2f/s\n", v.Name, v.Type, v.Read, v.Written, v.Dropped, v.ReadRate)
+	}
+	if len(s.Edges) > 0 {
+		fmt.Fprintln(tw, "\nFROM\tTO\tFIRST WATERMARK\tLAST WATERMARK\tPROGRESSION")
+		for _, e := range s.Edges {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%v\n", e.From, e.To, formatWatermark(e.FirstWatermark), formatWatermark(e.LastWatermark),
+				time.Duration(max(e.LastWatermark-e.FirstWatermark, 0))*time.Millisecond)
+		}
+	}
+	return tw.Flush()
+}
+
+func formatWatermark(wm int64) string {
+	if wm < 0 {
+		return "-"
+	}
+	return time.UnixMilli(wm).UTC().Format(time.RFC3339Nano)
+}
+
+func newSummary(pl *dfv1.Pipeline, vertices []*dfv1.Vertex, duration time.Duration, c counts, edges []EdgeSummary) *Summary {
+	s := &Summary{Pipeline: pl.Name, Duration: duration, Edges: edges}
+	for _, v := range vertices {
+		vs := VertexSummary{
+			Name:    v.Spec.Name,
+			Type:    vertexTypeName(v),
+			Read:    c.read[v.Spec.Name],
+			Written: c.written[v.Spec.Name],
+			Dropped: c.dropped[v.Spec.Name],
+		}
+		if duration > 0 {
+			vs.ReadRate = float64(vs.Read) / duration.Seconds()
+		}
+		s.Vertices = append(s.Vertices, vs)
+	}
+	return s
+}
+
+// counts are the message counts of the forwarders of a pipeline, keyed by the vertex names.
+type counts struct {
+	read, written, dropped map[string]int64
+}
+
+// sub returns the counts minus the given ones, the counters of the forwarders are global to the process.
+func (c counts) sub(o counts) counts {
+	diff := func(a, b map[string]int64) map[string]int64 {
+		r := make(map[string]int64)
+		for k, v := range a {
+			r[k] = v - b[k]
+		}
+		return r
+	}
+	return counts{read: diff(c.read, o.read), written: diff(c.written, o.written), dropped: diff(c.dropped, o.dropped)}
+}
+
+// gatherCounts gathers the message counts of the forwarders of a pipeline from the default prometheus registry.
+func gatherCounts(pipeline string) (counts, error) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		return counts{}, fmt.Errorf("failed to gather the metrics, %w", err)
+	}
+	c := counts{read: make(map[string]int64), written: make(map[string]int64), dropped: make(map[string]int64)}
+	for _, family := range families {
+		var target map[string]int64
+		switch family.GetName() {
+		case "forwarder_read_total":
+			target = c.read
+		case "forwarder_write_total":
+			target = c.written
+		case "forwarder_drop_total", "forwarder_ud_drop_total":
+			target = c.dropped
+		default:
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			labels := labelValues(m)
+			if labels[metrics.LabelPipeline] != pipeline {
+				continue
+			}
+			target[labels[metrics.LabelVertex]] += int64(m.GetCounter().GetValue())
+		}
+	}
+	return c, nil
+}
+
+func labelValues(m *dto.Metric) map[string]string {
+	labels := make(map[string]string)
+	for _, l := range m.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
+
+// watermarkSampler samples the head watermarks of the edges of a pipeline.
+type watermarkSampler struct {
+	edgeList []dfv1.Edge
+	fetchers []fetch.HeadFetcher
+	// partitions are the partition counts of the to vertices of the edges.
+	partitions []int
+	mu         sync.Mutex
+	first      []wmb.Watermark
+	last       []wmb.Watermark
+}
+
+func newWatermarkSampler(ctx context.Context, pl *dfv1.Pipeline, wmStores map[string]store.WatermarkStore) *watermarkSampler {
+	s := &watermarkSampler{}
+	for _, e := range pl.Spec.Edges {
+		s.edgeList = append(s.edgeList, e)
+		s.first = append(s.first, wmb.InitialWatermark)
+		s.last = append(s.last, wmb.InitialWatermark)
+		wmStore, ok := wmStores[dfv1.GenerateEdgeBucketName(pl.Namespace, pl.Name, e.From, e.To)]
+		if !ok {
+			// the watermark is disabled.
+			s.fetchers = append(s.fetchers, nil)
+			s.partitions = append(s.partitions, 0)
+			continue
+		}
+		partitions := pl.GetVertex(e.To).GetPartitionCount()
+		s.fetchers = append(s.fetchers, fetch.NewEdgeFetcher(ctx, wmStore, partitions, fetch.WithFromVtxPartitions(pl.GetVertex(e.From).GetPartitionCount())))
+		s.partitions = append(s.partitions, partitions)
+	}
+	return s
+}
+
+// run samples the watermarks at the given interval until the context is done.
+func (s *watermarkSampler) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sample()
+		}
+	}
+}
+
+// sample samples the watermarks of the edges, the watermark of an edge is the smallest one of its partitions.
+func (s *watermarkSampler) sample() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, f := range s.fetchers {
+		if f == nil {
+			continue
+		}
+		var wm wmb.Watermark
+		for p := 0; p < s.partitions[i]; p++ {
+			if w := f.ComputeHeadWatermark(int32(p)); p == 0 || w.BeforeWatermark(wm) {
+				wm = w
+			}
+		}
+		if wm.UnixMilli() < 0 {
+			continue
+		}
+		if s.first[i].UnixMilli() < 0 {
+			s.first[i] = wm
+		}
+		s.last[i] = wm
+	}
+}
+
+// edges returns the summaries of the edges.
+func (s *watermarkSampler) edges() []EdgeSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var summaries []EdgeSummary
+	for i, e := range s.edgeList {
+		summaries = append(summaries, EdgeSummary{From: e.From, To: e.To, FirstWatermark: s.first[i].UnixMilli(), LastWatermark: s.last[i].UnixMilli()})
+	}
+	return summaries
+}
+
+// Close stops the watchers of the fetchers.
+func (s *watermarkSampler) Close() error {
+	for _, f := range s.fetchers {
+		if c, ok := f.(io.Closer); ok {
+			_ = c.Close()
+		}
+	}
+	return nil
+}
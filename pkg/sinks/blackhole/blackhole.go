@@ -24,6 +24,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/validator"
 )
 
 // Blackhole is a sink to emulate /dev/null
@@ -31,15 +32,33 @@ type Blackhole struct {
 	name         string
 	pipelineName string
 	logger       *zap.SugaredLogger
+	// validator validates the per-key ordering of the messages, it is nil if the validation is not enabled.
+	validator *validator.SequenceValidator
+}
+
+type Option func(*Blackhole) error
+
+// WithSequenceValidator sets the sequence validator of the blackhole sink.
+func WithSequenceValidator(v *validator.SequenceValidator) Option {
+	return func(o *Blackhole) error {
+		o.validator = v
+		return nil
+	}
 }
 
 // NewBlackhole returns a new Blackhole sink.
-func NewBlackhole(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*Blackhole, error) {
-	return &Blackhole{
+func NewBlackhole(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (*Blackhole, error) {
+	b := &Blackhole{
 		name:         vertexInstance.Vertex.Spec.Name,
 		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
 		logger:       logging.FromContext(ctx),
-	}, nil
+	}
+	for _, o := range opts {
+		if err := o(b); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
 }
 
 // GetName returns the name.
@@ -61,6 +80,9 @@ func (b *Blackhole) IsFull() bool {
 
 // Write writes to the blackhole.
 func (b *Blackhole) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	if b.validator != nil {
+		b.validator.Validate(messages)
+	}
 	return nil, make([]error, len(messages))
 }
 
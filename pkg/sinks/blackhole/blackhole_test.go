@@ -18,13 +18,16 @@ package blackhole
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/sinks/validator"
 )
 
 func TestBlackhole_Start(t *testing.T) {
@@ -58,3 +61,44 @@ func TestBlackhole_Start(t *testing.T) {
 	_, errs = s.Write(ctx, writeMessages[5:20])
 	assert.Equal(t, make([]error, 15), errs)
 }
+
+func TestBlackhole_SequenceValidation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "sinks.blackhole",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Blackhole: &dfv1.Blackhole{SequenceValidation: &dfv1.SequenceValidation{}},
+				},
+			},
+		},
+	}}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex:  vertex,
+		Replica: 0,
+	}
+	v := validator.NewSequenceValidator(ctx, vertexInstance, vertex.Spec.Sink.Blackhole.SequenceValidation)
+	s, err := NewBlackhole(ctx, vertexInstance, WithSequenceValidator(v))
+	assert.NoError(t, err)
+
+	message := func(seq int) isb.Message {
+		return isb.Message{
+			Header: isb.Header{Keys: []string{"key-0-0"}},
+			Body:   isb.Body{Payload: []byte(fmt.Sprintf(`{"Seq":%d}`, seq))},
+		}
+	}
+	_, errs := s.Write(ctx, []isb.Message{message(1), message(2)})
+	assert.Equal(t, make([]error, 2), errs)
+	assert.NoError(t, v.IsHealthy(ctx))
+
+	// the messages are written regardless of the violation
+	_, errs = s.Write(ctx, []isb.Message{message(4), message(3)})
+	assert.Equal(t, make([]error, 2), errs)
+	outOfOrder, missing := v.Violations()
+	assert.Equal(t, int64(1), outOfOrder)
+	assert.Equal(t, int64(1), missing)
+	assert.Error(t, v.IsHealthy(ctx))
+}
@@ -23,13 +23,16 @@ import (
 	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -55,10 +58,19 @@ type DataForward struct {
 	pipelineName        string
 	vertexReplica       int32
 	sinkRetryStrategy   dfv1.RetryStrategy
+	// readBatchSize is the number of messages read in a batch, it can be changed at runtime by ApplyLimits.
+	readBatchSize atomic.Int64
+	// sinkWriteTimeout is the deadline of a single write to the sink, zero means no deadline. It can be changed
+	// at runtime by ApplyLimits.
+	sinkWriteTimeout atomic.Int64
 	// idleManager manages the idle watermark status.
 	idleManager wmb.IdleManager
 	// wmbChecker checks if the idle watermark is valid.
 	wmbChecker wmb.WMBChecker
+	// eventTimeTracker tracks the high-water event time of the read messages.
+	eventTimeTracker *forwarder.EventTimeTracker
+	// slowBatchLogger logs the stage breakdown of the slow batches.
+	slowBatchLogger *forwarder.SlowBatchLogger
 	Shutdown
 }
 
@@ -94,6 +106,14 @@ func NewDataForward(
 		vertexReplica: vertexInstance.Replica,
 		idleManager:   idleManager,
 		wmbChecker:    wmb.NewWMBChecker(2), // TODO: make configurable
+		eventTimeTracker: forwarder.NewEventTimeTracker(dOpts.futureEventTimeBound, map[string]string{
+			metrics.LabelVertex:             vertexInstance.Vertex.Spec.Name,
+			metrics.LabelPipeline:           vertexInstance.Vertex.Spec.PipelineName,
+			metrics.LabelVertexType:         string(dfv1.VertexTypeSink),
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(vertexInstance.Replica)),
+			metrics.LabelPartitionName:      fromStep.GetName(),
+		}),
+		slowBatchLogger: forwarder.NewSlowBatchLogger(dOpts.logger, dOpts.slowBatchThreshold),
 		Shutdown: Shutdown{
 			rwlock: new(sync.RWMutex),
 		},
@@ -102,8 +122,11 @@ func NewDataForward(
 	// add the sink retry strategy to the forward
 	if vertexInstance.Vertex.Spec.Sink != nil {
 		df.sinkRetryStrategy = vertexInstance.Vertex.Spec.Sink.RetryStrategy
+		df.sinkWriteTimeout.Store(int64(vertexInstance.Vertex.Spec.Sink.GetWriteTimeout()))
 	}
 
+	df.readBatchSize.Store(dOpts.readBatchSize)
+
 	// Add logger from parent ctx to child context.
 	df.ctx = logging.WithLogger(ctx, dOpts.logger)
 
@@ -203,7 +226,10 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
-	readMessages, err := df.fromBufferPartition.Read(ctx, df.opts.readBatchSize)
+	timer := df.slowBatchLogger.Start()
+	stageStart := timer.Now()
+	readMessages, err := df.fromBufferPartition.Read(ctx, df.readBatchSize.Load())
+	timer.Observe(forwarder.StageReadWait, stageStart)
 	df.opts.logger.Debugw("Read from buffer", zap.String("bufferFrom", df.fromBufferPartition.GetName()), zap.Int64("length", int64(len(readMessages))))
 	if err != nil {
 		df.opts.logger.Warnw("failed to read fromBufferPartition", zap.Error(err))
@@ -256,22 +282,30 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	metrics.ReadBytesCount.With(metricLabelsWithPartition).Add(float64(totalBytes))
 
 	metrics.ReadDataBytesCount.With(metricLabelsWithPartition).Add(float64(dataBytes))
+	if n := df.eventTimeTracker.Observe(dataMessages, time.Now()); n > 0 {
+		df.opts.logger.Warnw("Read messages with event time in the future", zap.Int("count", n), zap.Time("highWaterEventTime", df.eventTimeTracker.HighWaterEventTime()))
+	}
 
 	// fetch watermark if available
 	// TODO: make it async (concurrent and wait later)
-	// let's track only the first element's watermark. This is important because we reassign the watermark we fetch
-	// to all the elements in the batch. If we were to assign last element's watermark, we will wrongly mark on-time data as late.
+	// the watermark of the batch is the first element's watermark, it's the one we publish. Each element gets the
+	// watermark of its own offset, resolved with a single lookup of the timelines for the whole batch. Assigning the
+	// last element's watermark to the whole batch would wrongly mark on-time data as late.
 	// we fetch the watermark for the partition from which we read the message.
-	processorWM := df.wmFetcher.ComputeWatermark(readMessages[0].ReadOffset, df.fromBufferPartition.GetPartitionIdx())
+	stageStart = timer.Now()
+	processorWM, resolveWM := fetch.ComputeBatchWatermark(df.wmFetcher, readMessages[0].ReadOffset, readMessages[len(readMessages)-1].ReadOffset, df.fromBufferPartition.GetPartitionIdx())
+	timer.Observe(forwarder.StageWatermarkFetch, stageStart)
 
 	writeMessages := make([]isb.Message, 0, len(dataMessages))
 	for _, m := range dataMessages {
-		m.Watermark = time.Time(processorWM)
+		m.Watermark = time.Time(resolveWM(m.ReadOffset))
 		writeMessages = append(writeMessages, m.Message)
 	}
 
 	// write the messages to the sink
+	stageStart = timer.Now()
 	_, fallbackMessages, err := df.writeToSink(ctx, df.sinkWriter, writeMessages, false)
+	timer.ObserveWrite("sink", stageStart)
 	// error will not be nil only when we get ctx.Done()
 	if err != nil {
 		df.opts.logger.Errorw("failed to write to sink", zap.Error(err))
@@ -284,7 +318,10 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 		df.opts.logger.Infow("Writing messages to fallback sink", zap.Int("count", len(fallbackMessages)))
 		// write to sink is an infinite loop; it will return only if writes are successful or
 		// ctx.Done happens due to shutdown.
+		stageStart = timer.Now()
 		_, _, err = df.writeToSink(ctx, df.opts.fbSinkWriter, fallbackMessages, true)
+		// the fallback sink writer has the same name as the sink writer.
+		timer.ObserveWrite("fallback", stageStart)
 		if err != nil {
 			df.opts.logger.Errorw("Failed to write to fallback sink", zap.Error(err))
 			df.fromBufferPartition.NoAck(ctx, readOffsets)
@@ -294,14 +331,18 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 
 	// Always publish the watermark to SINK OT even though we do not use it today.
 	// There's no offset returned from sink writer.
+	stageStart = timer.Now()
 	df.wmPublisher.PublishWatermark(processorWM, nil, int32(0))
+	timer.Observe(forwarder.StageWatermarkPublish, stageStart)
 	// reset because the toBuffer is no longer idling
 	df.idleManager.MarkActive(df.fromBufferPartition.GetPartitionIdx(), df.sinkWriter.GetName())
 
 	df.opts.logger.Debugw("Write to sink completed")
 
 	ackStart := time.Now()
+	stageStart = timer.Now()
 	err = df.ackFromBuffer(ctx, readOffsets)
+	timer.Observe(forwarder.StageAck, stageStart)
 	// implicit return for posterity :-)
 	if err != nil {
 		df.opts.logger.Errorw("Failed to ack from buffer", zap.Error(err))
@@ -320,6 +361,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	}
 	// ProcessingTimes of the entire forwardAChunk
 	metrics.ForwardAChunkProcessingTime.With(metricLabels).Observe(float64(time.Since(start).Microseconds()))
+	df.slowBatchLogger.Finish(timer, len(readMessages))
 	return nil
 }
 
@@ -398,11 +440,27 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 			// since using failedMessages is an unlikely path.
 			var failedMessages []isb.Message
 			needRetry := false
-			_writeOffsets, errs := sinkWriter.Write(ctx, messagesToTry)
+			_writeOffsets, errs, timedOut := df.writeWithTimeout(ctx, sinkWriter, messagesToTry)
+			if timedOut {
+				df.opts.logger.Warnw("Sink write exceeded the write timeout",
+					zap.Duration("writeTimeout", time.Duration(df.sinkWriteTimeout.Load())),
+					zap.Int("messages", len(messagesToTry)),
+					zap.String(metrics.LabelPartitionName, sinkWriter.GetName()),
+				)
+				metrics.SinkWriteTimeouts.With(map[string]string{
+					metrics.LabelVertex:             df.vertexName,
+					metrics.LabelPipeline:           df.pipelineName,
+					metrics.LabelVertexType:         string(dfv1.VertexTypeSink),
+					metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+					metrics.LabelPartitionName:      sinkWriter.GetName(),
+				}).Inc()
+			}
 			for idx, msg := range messagesToTry {
 				if err = errs[idx]; err != nil {
 					var udsinkErr = new(udsink.ApplyUDSinkErr)
-					if errors.As(err, &udsinkErr) {
+					// a write which timed out is a failure of the batch, it is handled by the retry strategy
+					// rather than being treated as an internal error.
+					if !timedOut && errors.As(err, &udsinkErr) {
 						if udsinkErr.IsInternalErr() {
 							return false, err
 						}
@@ -477,6 +535,36 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 	return writeOffsets, fallbackMessages, nil
 }
 
+// ApplyLimits applies the reloadable limits, they take effect from the next batch so that the in-flight batch
+// completes with the previous settings.
+func (df *DataForward) ApplyLimits(s limits.Settings) {
+	df.readBatchSize.Store(int64(s.GetReadBatchSize()))
+	df.sinkWriteTimeout.Store(int64(s.GetSinkWriteTimeout()))
+}
+
+// writeWithTimeout writes the messages to the sink, bounding the write with the configured write timeout.
+// It returns whether the write ran past the deadline.
+func (df *DataForward) writeWithTimeout(ctx context.Context, sinkWriter sinker.SinkWriter, messages []isb.Message) ([]isb.Offset, []error, bool) {
+	writeTimeout := time.Duration(df.sinkWriteTimeout.Load())
+	if writeTimeout <= 0 {
+		offsets, errs := sinkWriter.Write(ctx, messages)
+		return offsets, errs, false
+	}
+	writeCtx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+	offsets, errs := sinkWriter.Write(writeCtx, messages)
+	// only the expiry of the write deadline counts as a timeout, not the cancellation of the parent context.
+	if ctx.Err() != nil || !errors.Is(writeCtx.Err(), context.DeadlineExceeded) {
+		return offsets, errs, false
+	}
+	for _, err := range errs {
+		if err != nil {
+			return offsets, errs, true
+		}
+	}
+	return offsets, errs, false
+}
+
 // handlePostRetryFailures deals with the scenarios after retries are exhausted.
 // It returns true if we need to continue retrying else returns false when no further writes are required
 func (df *DataForward) handlePostRetryFailures(messagesToTry *[]isb.Message, failStrategy dfv1.OnFailureRetryStrategy, fallbackMessages *[]isb.Message,
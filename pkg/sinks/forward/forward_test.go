@@ -28,11 +28,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/goleak"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
@@ -50,7 +52,8 @@ var (
 )
 
 func TestMain(m *testing.M) {
-	goleak.VerifyTestMain(m)
+	// the keyring imported by the Pulsar client connects to the D-Bus session bus, if any, when it's initialized
+	goleak.VerifyTestMain(m, goleak.IgnoreAnyFunction("github.com/godbus/dbus.(*Conn).inWorker"))
 }
 
 // testForwarderPublisher is for data_forward_test.go only
@@ -79,6 +82,10 @@ func (t *testForwarderPublisher) PublishIdleWatermark(_ wmb.Watermark, _ isb.Off
 	t.idle = true
 }
 
+func (t *testForwarderPublisher) PublishSkippedWatermark(_ wmb.Watermark, _ isb.Offset, _ int32) bool {
+	return false
+}
+
 func (t *testForwarderPublisher) GetLatestWatermark() wmb.Watermark {
 	return wmb.InitialWatermark
 }
@@ -356,6 +363,116 @@ func TestWriteToBuffer(t *testing.T) {
 	}
 }
 
+// slowSink is a sink writer which blocks on its first write until the write context is done.
+type slowSink struct {
+	*simplebuffer.InMemoryBuffer
+	calls int
+}
+
+func (s *slowSink) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	s.calls++
+	if s.calls == 1 {
+		<-ctx.Done()
+		errs := make([]error, len(messages))
+		for i := range errs {
+			errs[i] = ctx.Err()
+		}
+		return nil, errs
+	}
+	return s.InMemoryBuffer.Write(ctx, messages)
+}
+
+func TestWriteToSinkWithTimeout(t *testing.T) {
+	batchSize := int64(5)
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 5*batchSize, 0)
+	sink := &slowSink{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("slow-sink", 5*batchSize, 0)}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: testPipelineName,
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: testVertexName,
+			Sink: &dfv1.Sink{
+				WriteTimeout: &metav1.Duration{Duration: 50 * time.Millisecond},
+			},
+		},
+	}}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex:  vertex,
+		Replica: 0,
+	}
+
+	idleManager, _ := wmb.NewIdleManager(1, 1)
+	f, err := NewDataForward(vertexInstance, fromStep, sink, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50*time.Millisecond), f.sinkWriteTimeout.Load())
+
+	writeMessages := testutils.BuildTestWriteMessages(batchSize, testStartTime, nil, testVertexName)
+	start := time.Now()
+	_, fallbackMessages, err := f.writeToSink(ctx, sink, writeMessages, false)
+	assert.NoError(t, err)
+	assert.Empty(t, fallbackMessages)
+	// the slow write is abandoned at the deadline and the retry writes the whole batch.
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, 2, sink.calls)
+	readMessages, err := sink.Read(ctx, batchSize)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, int(batchSize))
+
+	timeoutMetadata := `
+		# HELP forwarder_sink_write_timeout_total Total number of sink writes that exceeded the write timeout
+		# TYPE forwarder_sink_write_timeout_total counter
+		`
+	timeoutExpected := `
+		forwarder_sink_write_timeout_total{partition_name="slow-sink",pipeline="testPipeline",replica="0",vertex="testVertex",vertex_type="Sink"} 1
+	`
+	err = testutil.CollectAndCompare(metrics.SinkWriteTimeouts, strings.NewReader(timeoutMetadata+timeoutExpected), "forwarder_sink_write_timeout_total")
+	assert.NoError(t, err)
+}
+
+func TestApplyLimits(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+	sink := simplebuffer.NewInMemoryBuffer("sink", 25, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: testPipelineName,
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: testVertexName,
+			Sink: &dfv1.Sink{},
+		},
+	}}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex:  vertex,
+		Replica: 0,
+	}
+
+	idleManager, _ := wmb.NewIdleManager(1, 1)
+	f, err := NewDataForward(vertexInstance, fromStep, sink, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager, WithReadBatchSize(5))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), f.readBatchSize.Load())
+	assert.Equal(t, int64(0), f.sinkWriteTimeout.Load())
+
+	source := make(chan limits.Settings)
+	reloader := limits.NewReloader(limits.Resolve(vertex), limits.NewChanWatcher(source))
+	reloader.Subscribe(f.ApplyLimits)
+	go reloader.Start(ctx)
+	source <- limits.Settings{ReadBatchSize: 10, SinkWriteTimeout: metav1.Duration{Duration: time.Second}}
+	assert.Eventually(t, func() bool {
+		return f.readBatchSize.Load() == 10 && f.sinkWriteTimeout.Load() == int64(time.Second)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the backfill mode widens the read batches and removes the write deadline, until it's turned off.
+	source <- limits.Settings{ReadBatchSize: 10, SinkWriteTimeout: metav1.Duration{Duration: time.Second}, Backfill: true}
+	assert.Eventually(t, func() bool {
+		return f.readBatchSize.Load() == dfv1.BackfillReadBatchSize && f.sinkWriteTimeout.Load() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	source <- limits.Settings{ReadBatchSize: 10, SinkWriteTimeout: metav1.Duration{Duration: time.Second}}
+	assert.Eventually(t, func() bool {
+		return f.readBatchSize.Load() == 10 && f.sinkWriteTimeout.Load() == int64(time.Second)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func validateMetrics(batchSize int64) (err error) {
 	metadata := `
 		# HELP forwarder_data_read_total Total number of Data Messages Read
@@ -17,6 +17,8 @@ limitations under the License.
 package forward
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -37,15 +39,21 @@ type options struct {
 	logger *zap.SugaredLogger
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// futureEventTimeBound is how far ahead of the wall clock an event time can be before it is counted as a future event time
+	futureEventTimeBound time.Duration
+	// slowBatchThreshold is how long forwarding a batch can take before its stage breakdown is logged
+	slowBatchThreshold time.Duration
 }
 
 type Option func(*options) error
 
 func DefaultOptions() *options {
 	return &options{
-		readBatchSize:   dfv1.DefaultReadBatchSize,
-		sinkConcurrency: dfv1.DefaultReadBatchSize,
-		logger:          logging.NewLogger(),
+		readBatchSize:        dfv1.DefaultReadBatchSize,
+		sinkConcurrency:      dfv1.DefaultReadBatchSize,
+		logger:               logging.NewLogger(),
+		futureEventTimeBound: dfv1.DefaultFutureEventTimeBound,
+		slowBatchThreshold:   dfv1.DefaultSlowBatchThreshold,
 	}
 }
 
@@ -88,3 +96,20 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithFutureEventTimeBound sets how far ahead of the wall clock an event time can be before it is counted as a future event time
+func WithFutureEventTimeBound(d time.Duration) Option {
+	return func(o *options) error {
+		o.futureEventTimeBound = d
+		return nil
+	}
+}
+
+// WithSlowBatchThreshold sets how long forwarding a batch can take before its stage breakdown is logged, a non-positive
+// value disables the logging
+func WithSlowBatchThreshold(d time.Duration) Option {
+	return func(o *options) error {
+		o.slowBatchThreshold = d
+		return nil
+	}
+}
@@ -108,7 +108,7 @@ func (tk *ToKafka) GetPartitionIdx() int32 {
 }
 
 // Write writes to the kafka topic.
-func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+func (tk *ToKafka) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
 	errs := make([]error, len(messages))
 	for i := 0; i < len(errs); i++ {
 		errs[i] = fmt.Errorf("unknown error")
@@ -125,6 +125,7 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 		tk.connected = true
 	}
 	done := make(chan struct{})
+	acked := make([]bool, len(messages))
 	timeout := time.After(5 * time.Second)
 	go func() {
 		sent := 0
@@ -137,10 +138,12 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 			case err := <-tk.producer.Errors():
 				idx := err.Msg.Metadata.(int)
 				errs[idx] = err.Err
+				acked[idx] = true
 				sent++
 			case m := <-tk.producer.Successes():
 				idx := m.Metadata.(int)
 				errs[idx] = nil
+				acked[idx] = true
 				sent++
 			case <-timeout:
 				// Need to close and recreate later because the successes and errors channels might be unclean
@@ -149,6 +152,17 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 				kafkaSinkWriteTimeouts.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
 				close(done)
 				return
+			case <-ctx.Done():
+				// the write deadline of the sink expired, messages without an ack are reported as failed
+				_ = tk.producer.Close()
+				tk.connected = false
+				for i := range errs {
+					if !acked[i] {
+						errs[i] = ctx.Err()
+					}
+				}
+				close(done)
+				return
 			default:
 			}
 		}
@@ -189,7 +203,7 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 		message := &sarama.ProducerMessage{
 			Key:      kafkaKey,
 			Topic:    tk.topic,
-			Value:    sarama.ByteEncoder(msg.Payload),
+			Value:    recordValue(msg),
 			Headers:  headers,
 			Metadata: index, // Use metadata to identify if it succeeds or fails in the async return.
 		}
@@ -199,6 +213,15 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 	return nil, errs
 }
 
+// recordValue returns the value of the Kafka record of a message. A message with keys and an empty payload is the
+// tombstone of its keys, which is written with a null value so that the keys are removed from compacted topics.
+func recordValue(msg isb.Message) sarama.Encoder {
+	if len(msg.Payload) == 0 && len(msg.Keys) > 0 {
+		return nil
+	}
+	return sarama.ByteEncoder(msg.Payload)
+}
+
 func (tk *ToKafka) Close() error {
 	tk.log.Info("Closing kafka producer...")
 	return tk.producer.Close()
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/IBM/sarama"
 	mock "github.com/IBM/sarama/mocks"
 	"github.com/stretchr/testify/assert"
 
@@ -117,3 +118,58 @@ func TestWriteFailureToKafka(t *testing.T) {
 	assert.Equal(t, "test1", errs[1].Error())
 
 }
+
+func TestWriteTombstonesToKafka(t *testing.T) {
+	toKafka := new(ToKafka)
+	toKafka.name = "Test"
+	toKafka.topic = "topic-1"
+	toKafka.setKey = true
+	toKafka.log = logging.NewLogger()
+	conf := mock.NewTestConfig()
+	conf.Producer.Return.Successes = true
+	conf.Producer.Return.Errors = true
+	producer := mock.NewAsyncProducer(t, conf)
+	toKafka.producer = producer
+	toKafka.connected = true
+
+	// every 10th message is a tombstone, and an empty payload without keys is not a tombstone.
+	var msgs []isb.Message
+	var values []sarama.Encoder
+	for i := 0; i < 20; i++ {
+		msg := isb.Message{
+			Header: isb.Header{Keys: []string{fmt.Sprintf("key-%d", i%2)}},
+			Body:   isb.Body{Payload: []byte(fmt.Sprintf("payload-%d", i))},
+		}
+		value := sarama.Encoder(sarama.ByteEncoder(msg.Payload))
+		if i%10 == 9 {
+			msg.Payload = []byte{}
+			value = nil
+		}
+		msgs = append(msgs, msg)
+		values = append(values, value)
+	}
+	msgs = append(msgs, isb.Message{Body: isb.Body{Payload: []byte{}}})
+	values = append(values, sarama.ByteEncoder{})
+
+	for i := range msgs {
+		expected := values[i]
+		producer.ExpectInputWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+			if (msg.Value == nil) != (expected == nil) {
+				return fmt.Errorf("unexpected value %v, expected %v", msg.Value, expected)
+			}
+			if expected == nil {
+				return nil
+			}
+			actual, _ := msg.Value.Encode()
+			want, _ := expected.Encode()
+			if string(actual) != string(want) {
+				return fmt.Errorf("unexpected value %q, expected %q", actual, want)
+			}
+			return nil
+		})
+	}
+	_, errs := toKafka.Write(context.Background(), msgs)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+}
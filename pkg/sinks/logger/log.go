@@ -27,6 +27,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/validator"
 )
 
 // ToLog prints the output to a log sinks.
@@ -34,15 +35,33 @@ type ToLog struct {
 	name         string
 	pipelineName string
 	logger       *zap.SugaredLogger
+	// validator validates the per-key ordering of the messages, it is nil if the validation is not enabled.
+	validator *validator.SequenceValidator
+}
+
+type Option func(*ToLog) error
+
+// WithSequenceValidator sets the sequence validator of the log sink.
+func WithSequenceValidator(v *validator.SequenceValidator) Option {
+	return func(o *ToLog) error {
+		o.validator = v
+		return nil
+	}
 }
 
 // NewToLog returns ToLog type.
-func NewToLog(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*ToLog, error) {
-	return &ToLog{
+func NewToLog(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (*ToLog, error) {
+	t := &ToLog{
 		name:         vertexInstance.Vertex.Spec.Name,
 		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
 		logger:       logging.FromContext(ctx),
-	}, nil
+	}
+	for _, o := range opts {
+		if err := o(t); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
 }
 
 // GetName returns the name.
@@ -64,6 +83,9 @@ func (t *ToLog) IsFull() bool {
 
 // Write writes to the log.
 func (t *ToLog) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	if t.validator != nil {
+		t.validator.Validate(messages)
+	}
 	prefix := "(" + t.GetName() + ")"
 	for _, message := range messages {
 		var hStr strings.Builder
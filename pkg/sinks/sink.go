@@ -19,6 +19,7 @@ package sinks
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"sync"
 
@@ -27,14 +28,18 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
+	kafkaisb "github.com/numaproj/numaflow/pkg/isb/stores/kafka"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	sinkclient "github.com/numaproj/numaflow/pkg/sdkclient/sinker"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -45,6 +50,7 @@ import (
 	logsink "github.com/numaproj/numaflow/pkg/sinks/logger"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
+	"github.com/numaproj/numaflow/pkg/sinks/validator"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
@@ -80,11 +86,15 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	// For sinks, the buffer name is the vertex name
 	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferList([]string{vertexName})
 	idleManager = wmb.NewNoOpIdleManager()
+	var decisionLog *fetch.DecisionLog
 
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		redisClient := redisclient.NewInClusterRedisClient()
-		var readOptions []redisclient.Option
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a new Redis client: %w", err)
+		}
+		readOptions := []redisclient.Option{redisclient.WithVertexInstance(u.VertexInstance)}
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 			readOptions = append(readOptions, redisclient.WithReadTimeOut(x.ReadTimeout.Duration))
 		}
@@ -104,7 +114,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}
 		defer natsClientPool.CloseAll()
 
-		var readOptions []jetstreamisb.ReadOption
+		readOptions := []jetstreamisb.ReadOption{jetstreamisb.WithVertexInstance(u.VertexInstance)}
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 			readOptions = append(readOptions, jetstreamisb.WithReadTimeOut(x.ReadTimeout.Duration))
 		}
@@ -130,7 +140,8 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			}
 
 			// create watermark fetcher using watermark stores
-			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores)
+			decisionLog = fetch.BuildDecisionLog()
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithDecisionLog(decisionLog))
 
 			// create watermark stores
 			sinkWmStores, err = jetstream.BuildToVertexWatermarkStores(ctx, u.VertexInstance, natsClientPool.NextAvailableClient())
@@ -143,10 +154,36 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			// sink vertex has only one toBuffer, so the length is 1
 			idleManager, _ = wmb.NewIdleManager(len(readers), 1)
 		}
+	case dfv1.ISBSvcTypeKafka:
+		kafkaClient, err := kafkaclient.NewInClusterKafkaClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a new Kafka client: %w", err)
+		}
+		defer func() { _ = kafkaClient.Close() }()
+
+		readOptions := []kafkaisb.ReadOption{kafkaisb.WithVertexInstance(u.VertexInstance)}
+		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
+			readOptions = append(readOptions, kafkaisb.WithReadTimeOut(x.ReadTimeout.Duration))
+		}
+		// create reader for each partition. Each partition is a topic in kafka, the watermarks are not supported
+		for index, bufferPartition := range u.VertexInstance.Vertex.OwnedBuffers() {
+			reader, err := kafkaisb.NewKafkaBufferReader(ctx, kafkaClient, bufferPartition, isbsvc.KafkaTopicName(bufferPartition), isbsvc.KafkaConsumerGroupName(bufferPartition), int32(index), readOptions...)
+			if err != nil {
+				return err
+			}
+			readers = append(readers, reader)
+		}
 
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", u.ISBSvcType)
 	}
+
+	keyring, err := encryption.NewVertexKeyring(u.VertexInstance.Vertex)
+	if err != nil {
+		return fmt.Errorf("failed to load the isb encryption keys: %w", err)
+	}
+	readers = encryption.WrapReaders(readers, keyring)
+
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize)
 	if udSink := u.VertexInstance.Vertex.Spec.Sink.UDSink; udSink != nil {
 		// Wait for server info to be ready
@@ -208,11 +245,28 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}()
 	}
 
+	// the sequence validators are shared by the sink writers of all the partitions, so that the ordering of a key is
+	// validated regardless of the partition it is read from.
+	sinkValidator := u.newSequenceValidator(ctx, &u.VertexInstance.Vertex.Spec.Sink.AbstractSink)
+	if sinkValidator != nil {
+		healthCheckers = append(healthCheckers, sinkValidator)
+	}
+	var fbSinkValidator *validator.SequenceValidator
+	if fbSink := u.VertexInstance.Vertex.Spec.Sink.Fallback; fbSink != nil {
+		fbSinkValidator = u.newSequenceValidator(ctx, fbSink)
+		if fbSinkValidator != nil {
+			healthCheckers = append(healthCheckers, fbSinkValidator)
+		}
+	}
+
+	// the reloader applies the limits updated in the runtime limits ConfigMap to the forwarders of all the partitions.
+	reloader := limits.NewVertexReloader(u.VertexInstance, dfv1.VertexTypeSink, log)
+
 	var finalWg sync.WaitGroup
 	for index := range u.VertexInstance.Vertex.OwnedBuffers() {
 		finalWg.Add(1)
 
-		forwardOpts := []sinkforward.Option{sinkforward.WithLogger(log)}
+		forwardOpts := []sinkforward.Option{sinkforward.WithLogger(log), sinkforward.WithFutureEventTimeBound(sharedutil.LookupEnvDurationOr(dfv1.EnvFutureEventTimeBound, dfv1.DefaultFutureEventTimeBound)), sinkforward.WithSlowBatchThreshold(sharedutil.LookupEnvDurationOr(dfv1.EnvSlowBatchThreshold, dfv1.DefaultSlowBatchThreshold))}
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil {
 			if x.ReadBatchSize != nil {
 				forwardOpts = append(forwardOpts, sinkforward.WithReadBatchSize(int64(*x.ReadBatchSize)))
@@ -220,7 +274,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}
 
 		// create the main sink writer
-		sinkWriter, err := u.createSinkWriter(ctx, &u.VertexInstance.Vertex.Spec.Sink.AbstractSink, sinkHandler)
+		sinkWriter, err := u.createSinkWriter(ctx, &u.VertexInstance.Vertex.Spec.Sink.AbstractSink, sinkHandler, sinkValidator)
 		if err != nil {
 			return fmt.Errorf("failed to find a sink, error: %w", err)
 		}
@@ -228,7 +282,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		// create the fallback sink writer if fallback sink is present
 		fbSink := u.VertexInstance.Vertex.Spec.Sink.Fallback
 		if fbSink != nil && fbSink.IsAnySinkSpecified() {
-			fbSinkWriter, err := u.createSinkWriter(ctx, u.VertexInstance.Vertex.Spec.Sink.Fallback, fbSinkHandler)
+			fbSinkWriter, err := u.createSinkWriter(ctx, u.VertexInstance.Vertex.Spec.Sink.Fallback, fbSinkHandler, fbSinkValidator)
 			if err != nil {
 				return fmt.Errorf("failed to find a sink, error: %w", err)
 			}
@@ -252,6 +306,7 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to create data forward, error: %w", err)
 		}
+		reloader.Subscribe(df.ApplyLimits)
 
 		// start a forwarder using a goroutine for each partition.
 		go func(sinkForwarder forwarder.StarterStopper, fromBufferPartitionName string) {
@@ -275,6 +330,8 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		}(df, readers[index].GetName())
 	}
 
+	go reloader.Start(ctx)
+
 	// create lag readers from buffer readers
 	var lagReaders []isb.LagReader
 	for _, reader := range readers {
@@ -283,6 +340,9 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 
 	// start metrics server and pass the sinkHandler to it, so that it can be used to check the readiness of the sink
 	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, healthCheckers, lagReaders)
+	if decisionLog != nil {
+		metricsOpts = append(metricsOpts, metrics.WithWatermarkDecisions(decisionLog))
+	}
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -293,6 +353,18 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	// wait for all the forwarders to exit
 	finalWg.Wait()
 
+	// flush the watermark decision log if it's enabled
+	if decisionLog != nil {
+		if err := decisionLog.Close(); err != nil {
+			log.Errorw("Failed to flush the watermark decision log", zap.Error(err))
+		}
+	}
+
+	// close the watermark fetcher, so that it stops watching the from vertex wm stores before they are closed
+	if closer, ok := fetchWatermark.(io.Closer); ok {
+		_ = closer.Close()
+	}
+
 	// close the fromVertex wm stores
 	// since we created the stores, we can close them
 	for _, wmStore := range fromVertexWmStores {
@@ -310,16 +382,35 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 }
 
 // createSinkWriter creates a sink writer based on the sink spec
-func (u *SinkProcessor) createSinkWriter(ctx context.Context, abstractSink *dfv1.AbstractSink, sinkHandler udsink.SinkApplier) (sinker.SinkWriter, error) {
+func (u *SinkProcessor) createSinkWriter(ctx context.Context, abstractSink *dfv1.AbstractSink, sinkHandler udsink.SinkApplier, sequenceValidator *validator.SequenceValidator) (sinker.SinkWriter, error) {
 	if x := abstractSink.Log; x != nil {
-		return logsink.NewToLog(ctx, u.VertexInstance)
+		var opts []logsink.Option
+		if sequenceValidator != nil {
+			opts = append(opts, logsink.WithSequenceValidator(sequenceValidator))
+		}
+		return logsink.NewToLog(ctx, u.VertexInstance, opts...)
 	} else if x := abstractSink.Kafka; x != nil {
 		return kafkasink.NewToKafka(ctx, u.VertexInstance)
 	} else if x := abstractSink.Blackhole; x != nil {
-		return blackhole.NewBlackhole(ctx, u.VertexInstance)
+		var opts []blackhole.Option
+		if sequenceValidator != nil {
+			opts = append(opts, blackhole.WithSequenceValidator(sequenceValidator))
+		}
+		return blackhole.NewBlackhole(ctx, u.VertexInstance, opts...)
 	} else if x := abstractSink.UDSink; x != nil {
 		// if the sink is a user-defined sink, then we need to pass the sinkHandler to it which will be used to invoke the user-defined sink
 		return udsink.NewUserDefinedSink(ctx, u.VertexInstance, sinkHandler)
 	}
 	return nil, fmt.Errorf("invalid sink spec")
 }
+
+// newSequenceValidator returns a sequence validator if the sequence validation is enabled on the log or blackhole sink,
+// otherwise it returns nil.
+func (u *SinkProcessor) newSequenceValidator(ctx context.Context, abstractSink *dfv1.AbstractSink) *validator.SequenceValidator {
+	if x := abstractSink.Log; x != nil && x.SequenceValidation != nil {
+		return validator.NewSequenceValidator(ctx, u.VertexInstance, x.SequenceValidation)
+	} else if x := abstractSink.Blackhole; x != nil && x.SequenceValidation != nil {
+		return validator.NewSequenceValidator(ctx, u.VertexInstance, x.SequenceValidation)
+	}
+	return nil
+}
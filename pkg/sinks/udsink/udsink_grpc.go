@@ -18,6 +18,7 @@ package udsink
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -42,6 +43,10 @@ var (
 		UserUDSinkErr: true,
 		Message:       "not found in response",
 	}
+	WriteTimeoutErr error = &ApplyUDSinkErr{
+		UserUDSinkErr: true,
+		Message:       "write timeout exceeded",
+	}
 )
 
 // SinkApplier applies the sink on the read message and gives back a response. Any UserError will be retried here, while
@@ -93,6 +98,14 @@ func (u *UDSgRPCBasedUDSink) ApplySink(ctx context.Context, requests []*sinkpb.S
 
 	responses, err := u.client.SinkFn(ctx, requests)
 	if err != nil {
+		// the write deadline expired before the udsink responded, this is treated as a failure of the
+		// batch which is subject to the sink retry strategy rather than an internal error.
+		if errors.Is(err, context.DeadlineExceeded) {
+			for i := range requests {
+				errs[i] = WriteTimeoutErr
+			}
+			return errs
+		}
 		for i := range requests {
 			errs[i] = &ApplyUDSinkErr{
 				UserUDSinkErr: false,
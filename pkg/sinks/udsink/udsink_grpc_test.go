@@ -191,4 +191,43 @@ func Test_gRPCBasedUDSink_ApplyWithMockClient(t *testing.T) {
 		assert.Equal(t, 2, len(gotErrList))
 		assert.Equal(t, expectedErrList, gotErrList)
 	})
+	t.Run("test write timeout", func(t *testing.T) {
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		testDatumList := []*sinkpb.SinkRequest{
+			{
+				Request: &sinkpb.SinkRequest_Request{
+					Id:        "test_id_0",
+					Value:     []byte(`sink_message_slow`),
+					EventTime: timestamppb.New(time.Unix(1661169660, 0)),
+					Watermark: timestamppb.New(time.Time{}),
+				},
+			},
+		}
+
+		// the stream never responds, Recv only returns once the stream is cancelled.
+		mockClient := sinkmock.NewMockSinkClient(ctrl)
+		mockClient.EXPECT().SinkFn(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(streamCtx context.Context, _ ...interface{}) (sinkpb.Sink_SinkFnClient, error) {
+				mockSinkClient := sinkmock.NewMockSink_SinkFnClient(ctrl)
+				mockSinkClient.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+				mockSinkClient.EXPECT().Recv().DoAndReturn(func() (*sinkpb.SinkResponse, error) {
+					<-streamCtx.Done()
+					return nil, streamCtx.Err()
+				}).AnyTimes()
+				return mockSinkClient, nil
+			}).Times(2)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		u := NewMockUDSgRPCBasedUDSink(ctx, mockClient)
+		writeCtx, writeCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer writeCancel()
+		gotErrList := u.ApplySink(writeCtx, testDatumList)
+		assert.Equal(t, []error{WriteTimeoutErr}, gotErrList)
+		assert.NoError(t, ctx.Err())
+	})
 }
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// sequenceOutOfOrder is used to indicate the number of messages received with a sequence number lower than or
+// equal to the last one seen for the key.
+var sequenceOutOfOrder = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "sink",
+	Name:      "sequence_out_of_order_total",
+	Help:      "Total number of out of order sequence numbers observed by the sequence validation",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// sequenceMissing is used to indicate the number of sequence numbers skipped for a key.
+var sequenceMissing = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "sink",
+	Name:      "sequence_missing_total",
+	Help:      "Total number of missing sequence numbers observed by the sequence validation",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
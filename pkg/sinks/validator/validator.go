@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validator validates the messages written to a sink, it is meant for end-to-end tests.
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/generator"
+)
+
+// SequenceValidator tracks, per key, the last sequence number embedded by the generator source and counts the
+// out-of-order and missing observations. It is safe to share a SequenceValidator among the partitions of a sink.
+type SequenceValidator struct {
+	sync.Mutex
+	// lastSeqs is the highest sequence number seen for every key.
+	lastSeqs      map[string]uint64
+	outOfOrder    int64
+	missing       int64
+	maxViolations int64
+	labels        map[string]string
+	logger        *zap.SugaredLogger
+}
+
+var _ metrics.HealthChecker = (*SequenceValidator)(nil)
+
+// NewSequenceValidator returns a SequenceValidator for the given spec.
+func NewSequenceValidator(ctx context.Context, vertexInstance *dfv1.VertexInstance, spec *dfv1.SequenceValidation) *SequenceValidator {
+	return &SequenceValidator{
+		lastSeqs:      make(map[string]uint64),
+		maxViolations: spec.GetMaxViolations(),
+		labels: map[string]string{
+			metrics.LabelVertex:   vertexInstance.Vertex.Spec.Name,
+			metrics.LabelPipeline: vertexInstance.Vertex.Spec.PipelineName,
+		},
+		logger: logging.FromContext(ctx),
+	}
+}
+
+// Validate observes the sequence numbers of the messages. The first observation of a key is accepted as is, after
+// that a sequence number lower than or equal to the last seen one is out of order, and a gap is counted as missing.
+// Messages without a sequence number are ignored.
+func (v *SequenceValidator) Validate(messages []isb.Message) {
+	v.Lock()
+	defer v.Unlock()
+	for _, msg := range messages {
+		seq, ok := generator.Sequence(msg.Payload)
+		if !ok {
+			continue
+		}
+		key := strings.Join(msg.Keys, dfv1.KeysDelimitter)
+		last, seen := v.lastSeqs[key]
+		switch {
+		case !seen:
+			v.lastSeqs[key] = seq
+		case seq <= last:
+			v.outOfOrder++
+			sequenceOutOfOrder.With(v.labels).Inc()
+			v.logger.Warnw("Out of order sequence", zap.String("key", key), zap.Uint64("sequence", seq), zap.Uint64("last", last))
+		default:
+			if gap := seq - last - 1; gap > 0 {
+				v.missing += int64(gap)
+				sequenceMissing.With(v.labels).Add(float64(gap))
+				v.logger.Warnw("Missing sequence", zap.String("key", key), zap.Uint64("sequence", seq), zap.Uint64("last", last))
+			}
+			v.lastSeqs[key] = seq
+		}
+	}
+}
+
+// Violations returns the number of out-of-order and missing observations.
+func (v *SequenceValidator) Violations() (outOfOrder int64, missing int64) {
+	v.Lock()
+	defer v.Unlock()
+	return v.outOfOrder, v.missing
+}
+
+// IsHealthy returns an error once the violations exceed the configured threshold, which fails the readiness
+// of the sink.
+func (v *SequenceValidator) IsHealthy(_ context.Context) error {
+	outOfOrder, missing := v.Violations()
+	if outOfOrder+missing > v.maxViolations {
+		return fmt.Errorf("sequence validation failed, out of order: %d, missing: %d, max violations: %d", outOfOrder, missing, v.maxViolations)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func newTestValidator(name string, maxViolations *int64) *SequenceValidator {
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: name,
+			},
+		}},
+	}
+	return NewSequenceValidator(context.Background(), vertexInstance, &dfv1.SequenceValidation{MaxViolations: maxViolations})
+}
+
+// buildSequencedMessages builds count messages per key, the payload carries the per-key sequence number the same
+// way the generator source does.
+func buildSequencedMessages(keys int, count int) []isb.Message {
+	var messages []isb.Message
+	for seq := 1; seq <= count; seq++ {
+		for k := 0; k < keys; k++ {
+			messages = append(messages, isb.Message{
+				Header: isb.Header{Keys: []string{fmt.Sprintf("key-0-%d", k)}},
+				Body:   isb.Body{Payload: []byte(fmt.Sprintf(`{"Data":{"value":1},"Createdts":1,"Seq":%d}`, seq))},
+			})
+		}
+	}
+	return messages
+}
+
+// shufflingApplier reorders the messages before handing them over in batches, it emulates a pipeline which does
+// not preserve the per-key ordering.
+func shufflingApplier(seed int64, batchSize int, messages []isb.Message, apply func([]isb.Message)) {
+	shuffled := make([]isb.Message, len(messages))
+	copy(shuffled, messages)
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	for i := 0; i < len(shuffled); i += batchSize {
+		apply(shuffled[i:min(i+batchSize, len(shuffled))])
+	}
+}
+
+func TestSequenceValidator_InOrder(t *testing.T) {
+	v := newTestValidator("in-order", nil)
+	messages := buildSequencedMessages(3, 20)
+	for i := 0; i < len(messages); i += 7 {
+		v.Validate(messages[i:min(i+7, len(messages))])
+	}
+	outOfOrder, missing := v.Violations()
+	assert.Equal(t, int64(0), outOfOrder)
+	assert.Equal(t, int64(0), missing)
+	assert.NoError(t, v.IsHealthy(context.Background()))
+}
+
+func TestSequenceValidator_Shuffled(t *testing.T) {
+	v := newTestValidator("shuffled", nil)
+	shufflingApplier(1, 7, buildSequencedMessages(3, 20), v.Validate)
+	outOfOrder, missing := v.Violations()
+	assert.Greater(t, outOfOrder, int64(0))
+	assert.Greater(t, missing, int64(0))
+	assert.Error(t, v.IsHealthy(context.Background()))
+
+	labels := map[string]string{"vertex": "shuffled", "pipeline": "testPipeline"}
+	assert.Equal(t, float64(outOfOrder), testutil.ToFloat64(sequenceOutOfOrder.With(labels)))
+	assert.Equal(t, float64(missing), testutil.ToFloat64(sequenceMissing.With(labels)))
+}
+
+func TestSequenceValidator_Missing(t *testing.T) {
+	v := newTestValidator("missing", ptr.To[int64](1))
+	messages := buildSequencedMessages(1, 5)
+	// drop the third message of the key
+	v.Validate(append(messages[:2:2], messages[3:]...))
+	outOfOrder, missing := v.Violations()
+	assert.Equal(t, int64(0), outOfOrder)
+	assert.Equal(t, int64(1), missing)
+	// a single violation is tolerated
+	assert.NoError(t, v.IsHealthy(context.Background()))
+
+	// a replay of a message is out of order
+	v.Validate(messages[4:5])
+	outOfOrder, _ = v.Violations()
+	assert.Equal(t, int64(1), outOfOrder)
+	assert.Error(t, v.IsHealthy(context.Background()))
+}
+
+func TestSequenceValidator_IgnoresUnsequenced(t *testing.T) {
+	v := newTestValidator("unsequenced", nil)
+	v.Validate([]isb.Message{
+		{Header: isb.Header{Keys: []string{"k"}}, Body: isb.Body{Payload: []byte("plain text")}},
+		{Header: isb.Header{Keys: []string{"k"}}, Body: isb.Body{Payload: []byte(`{"Data":{"value":1}}`)}},
+	})
+	outOfOrder, missing := v.Violations()
+	assert.Equal(t, int64(0), outOfOrder+missing)
+}
@@ -22,15 +22,19 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
+	"github.com/numaproj/numaflow/pkg/shared/lifecycle"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	errors2 "github.com/numaproj/numaflow/pkg/sources/errors"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
@@ -41,6 +45,13 @@ import (
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
 
+// pausedCheckInterval is how often a paused forwarder checks whether the reading is resumed.
+const pausedCheckInterval = time.Second
+
+// idleWatermarkMargin is how far the idle watermark of a sourcer.IdleReporter lags behind the current time, so that
+// the messages produced right before the source turns idle are not late.
+const idleWatermarkMargin = time.Second
+
 // DataForward reads data from source and forwards to inter-step buffers
 type DataForward struct {
 	// I have my reasons for overriding the default principle https://github.com/golang/go/issues/22602
@@ -54,14 +65,17 @@ type DataForward struct {
 	wmFetcher            fetch.SourceFetcher
 	toVertexWMStores     map[string]store.WatermarkStore
 	toVertexWMPublishers map[string]map[int32]publish.Publisher // toVertexWMPublishers stores the toVertex to publisher mapping.
-	srcWMPublisher       publish.SourcePublisher                // srcWMPublisher is used to publish source watermark.
+	srcWMPublisher       publish.SourcePublisher                // srcWMPublisher is used to publish source watermark, it is closed by the forwarder.
 	opts                 options
+	readBatchSize        atomic.Int64 // readBatchSize is the number of messages read in a batch, it can be changed at runtime by ApplyLimits.
+	paused               atomic.Bool  // paused is whether the reading is paused by the storage quota of the pipeline, it's changed by ApplyLimits.
 	vertexName           string
 	pipelineName         string
 	vertexReplica        int32
 	watermarkConfig      dfv1.Watermark
 	idleManager          wmb.IdleManager // idleManager manages the idle watermark status.
 	srcIdleHandler       *idlehandler.SourceIdleHandler
+	eventTimeTracker     *forwarder.EventTimeTracker // eventTimeTracker tracks the high-water event time of the read messages.
 	Shutdown
 }
 
@@ -82,14 +96,25 @@ func NewDataForward(
 			return nil, err
 		}
 	}
+	if err := forwarder.ValidateWriterNames(toSteps); err != nil {
+		return nil, err
+	}
 
 	var toVertexWMPublishers = make(map[string]map[int32]publish.Publisher)
 	for k := range toVertexWmStores {
 		toVertexWMPublishers[k] = make(map[int32]publish.Publisher)
 	}
 
-	// create a source idle handler
-	srcIdleHandler := idlehandler.NewSourceIdleHandler(&vertexInstance.Vertex.Spec.Watermark, fetchWatermark, srcWMPublisher)
+	// create a source idle handler, the sources reporting their idleness publish the current time as the idle
+	// watermark, unless the idle source watermark is configured.
+	idleConfig := &vertexInstance.Vertex.Spec.Watermark
+	var idleOpts []idlehandler.SourceIdleHandlerOption
+	if r, ok := reader.(sourcer.IdleReporter); ok && idleConfig.IdleSource == nil && r.IdleThreshold() > 0 {
+		idleConfig = idleConfig.DeepCopy()
+		idleConfig.IdleSource = &dfv1.IdleSource{Threshold: &metav1.Duration{Duration: r.IdleThreshold()}}
+		idleOpts = append(idleOpts, idlehandler.WithWallClockIdleWatermark(idleWatermarkMargin))
+	}
+	srcIdleHandler := idlehandler.NewSourceIdleHandler(idleConfig, fetchWatermark, srcWMPublisher, idleOpts...)
 
 	// creating a context here which is managed by the forwarder's lifecycle
 	ctx, cancel := context.WithCancel(context.Background())
@@ -109,16 +134,36 @@ func NewDataForward(
 		watermarkConfig:      vertexInstance.Vertex.Spec.Watermark,
 		idleManager:          idleManager,
 		srcIdleHandler:       srcIdleHandler,
+		eventTimeTracker: forwarder.NewEventTimeTracker(dOpts.futureEventTimeBound, map[string]string{
+			metrics.LabelVertex:             vertexInstance.Vertex.Spec.Name,
+			metrics.LabelPipeline:           vertexInstance.Vertex.Spec.PipelineName,
+			metrics.LabelVertexType:         string(dfv1.VertexTypeSource),
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(vertexInstance.Replica)),
+			metrics.LabelPartitionName:      reader.GetName(),
+		}),
 		Shutdown: Shutdown{
 			rwlock: new(sync.RWMutex),
 		},
 		opts: *dOpts,
 	}
+	isdf.readBatchSize.Store(dOpts.readBatchSize)
 	// add logger from parent ctx to child context.
 	isdf.ctx = logging.WithLogger(ctx, dOpts.logger)
 	return &isdf, nil
 }
 
+// ApplyLimits applies the reloadable limits, they take effect from the next batch.
+func (df *DataForward) ApplyLimits(s limits.Settings) {
+	df.readBatchSize.Store(int64(s.GetReadBatchSize()))
+	if df.paused.Swap(s.SourcePaused) != s.SourcePaused {
+		if s.SourcePaused {
+			df.opts.logger.Warnw("Pausing reading from source, the storage quota of the pipeline is exceeded")
+		} else {
+			df.opts.logger.Infow("Resuming reading from source, the storage quota of the pipeline is recovered")
+		}
+	}
+}
+
 // Start starts reading from source and forwards to the next buffers. Call `Stop` to stop.
 func (df *DataForward) Start() <-chan error {
 	log := logging.FromContext(df.ctx)
@@ -150,38 +195,46 @@ func (df *DataForward) Start() <-chan error {
 				stopped <- err
 				return
 			}
+			df.stopIfDrained()
 		}
 	}()
 
 	go func() {
 		wg.Wait()
-		// clean up resources for source reader and all the writers if any.
-		if err := df.reader.Close(); err != nil {
-			log.Errorw("Failed to close source reader, shutdown anyways...", zap.Error(err))
-		} else {
-			log.Infow("Closed source reader", zap.String("sourceFrom", df.reader.GetName()))
+		// the forwarder is drained, clean up the resources following the shutdown sequence: flush the acks, close
+		// the watermark publishers, and then close the source reader and all the writers.
+		sequence := lifecycle.NewShutdownSequence(log)
+		if flusher, ok := df.reader.(sourcer.AckFlusher); ok {
+			sequence.Register(lifecycle.StageFlushAcks, "source reader", flusher.FlushAcks)
+		}
+		// the to vertex publishers were created by the forwarder, and the ownership of the source publisher is
+		// transferred to the forwarder, so they should be closed by the forwarder.
+		sequence.RegisterCloser(lifecycle.StageCloseWatermarkPublishers, "source watermark publisher", df.srcWMPublisher)
+		for toVertex, toVertexPublishers := range df.toVertexWMPublishers {
+			for _, pub := range toVertexPublishers {
+				sequence.RegisterCloser(lifecycle.StageCloseWatermarkPublishers, toVertex+" watermark publisher", pub)
+			}
 		}
+		sequence.Register(lifecycle.StageCloseReadersWriters, df.reader.GetName(), func(context.Context) error {
+			if err := df.reader.Close(); err != nil {
+				return err
+			}
+			log.Infow("Closed source reader", zap.String("sourceFrom", df.reader.GetName()))
+			return nil
+		})
 		for _, buffer := range df.toBuffers {
 			for _, partition := range buffer {
-				if err := partition.Close(); err != nil {
-					log.Errorw("Failed to close partition writer, shutdown anyways...",
-						zap.Error(err),
-						zap.String("bufferTo", partition.GetName()),
-					)
-				} else {
+				sequence.Register(lifecycle.StageCloseReadersWriters, partition.GetName(), func(context.Context) error {
+					if err := partition.Close(); err != nil {
+						return err
+					}
 					log.Infow("Closed partition writer", zap.String("bufferTo", partition.GetName()))
-				}
-			}
-		}
-
-		// the publisher was created by the forwarder, so it should be closed by the forwarder.
-		for _, toVertexPublishers := range df.toVertexWMPublishers {
-			for _, pub := range toVertexPublishers {
-				if err := pub.Close(); err != nil {
-					log.Errorw("Failed to close publisher, shutdown anyways...", zap.Error(err))
-				}
+					return nil
+				})
 			}
 		}
+		// the errors have been logged, shutdown anyways.
+		_ = sequence.Shutdown(context.Background())
 
 		close(stopped)
 	}()
@@ -210,13 +263,21 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 		metrics.LabelVertexReplicaIndex: replicaIndex,
 		metrics.LabelPartitionName:      df.reader.GetName(),
 	}
+	// nothing is read while the reading is paused, the messages read before have been forwarded and acked.
+	if df.paused.Load() {
+		select {
+		case <-ctx.Done():
+		case <-time.After(pausedCheckInterval):
+		}
+		return nil
+	}
 	// Initialize forwardAChunk and read start times
 	start := time.Now()
 	readStart := time.Now()
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during the restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
-	readMessages, err := df.reader.Read(ctx, df.opts.readBatchSize)
+	readMessages, err := df.reader.Read(ctx, df.readBatchSize.Load())
 	if err != nil {
 		df.opts.logger.Warnw("failed to read from source", zap.Error(err))
 		metrics.ReadMessagesError.With(metricLabelsWithPartition).Inc()
@@ -277,9 +338,14 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	df.srcIdleHandler.Reset()
 	// store the offsets of the messages we read from source
 	var readOffsets = make([]isb.Offset, len(readMessages))
+	// the messages of the sources which do not set the ingestion time are ingested when they are read.
+	ingestionTime := time.Now()
 	for idx, m := range readMessages {
 		totalBytes += len(m.Payload)
 		readOffsets[idx] = m.ReadOffset
+		if m.IngestionTime.IsZero() {
+			m.IngestionTime = ingestionTime
+		}
 	}
 	metrics.ReadBytesCount.With(metricLabelsWithPartition).Add(float64(totalBytes))
 	metrics.ReadDataBytesCount.With(metricLabelsWithPartition).Add(float64(totalBytes))
@@ -289,6 +355,8 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	processorWM := wmb.Watermark(time.UnixMilli(-1))
 
 	var writeOffsets map[string][][]isb.Offset
+	// droppedCounts is the number of messages dropped while writing to each of the toBuffer partitions.
+	var droppedCounts map[string][]int
 	// create space for writeMessages specific to each step as we could forward to all the steps too.
 	var messageToStep = make(map[string][][]isb.Message)
 	for toVertex := range df.toBuffers {
@@ -331,6 +399,9 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	// publish source watermark and assign IsLate attribute based on new event time.
 	var writeMessages []*isb.WriteMessage
 	var transformedReadMessages []*isb.ReadMessage
+	// the messages marked as late by the source, e.g. the late records injected by the generator, do not take part
+	// in the source watermark, so that they stay behind it.
+	var onTimeReadMessages []*isb.ReadMessage
 	latestEtMap := make(map[int32]int64)
 
 	for _, m := range readWriteMessagePairs {
@@ -345,20 +416,26 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 				latestEtMap[m.ReadMessage.ReadOffset.PartitionIdx()] = message.EventTime.UnixNano()
 			}
 			transformedReadMessages = append(transformedReadMessages, message.ToReadMessage(m.ReadMessage.ReadOffset, time.UnixMilli(-1)))
+			if !message.IsLate {
+				onTimeReadMessages = append(onTimeReadMessages, transformedReadMessages[len(transformedReadMessages)-1])
+			}
 		}
 	}
 
+	// track the high-water event time using the event times assigned at the source.
+	if n := df.eventTimeTracker.Observe(transformedReadMessages, time.Now()); n > 0 {
+		df.opts.logger.Warnw("Read messages with event time in the future", zap.Int("count", n), zap.Time("highWaterEventTime", df.eventTimeTracker.HighWaterEventTime()))
+	}
+
 	// publish source watermark
-	df.srcWMPublisher.PublishSourceWatermarks(transformedReadMessages)
+	df.srcWMPublisher.PublishSourceWatermarks(onTimeReadMessages)
 	// update the watermark configs for lastTimestampSrcWMUpdated, lastFetchedSrcWatermark and lastTimestampIdleWMFound.
 	// fetch the source watermark again, we might not get the latest watermark because of publishing delay,
 	// but ideally we should use the latest to determine the IsLate attribute.
 	processorWM = df.wmFetcher.ComputeWatermark()
-	// assign isLate
+	// assign isLate, the messages marked as late by the source are only late if they are behind the watermark.
 	for _, m := range writeMessages {
-		if processorWM.After(m.EventTime) { // Set late data at source level
-			m.IsLate = true
-		}
+		m.IsLate = processorWM.After(m.EventTime) // Set late data at source level
 	}
 
 	var sourcePartitionsIndices = make(map[int32]bool)
@@ -378,7 +455,7 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 
 	// forward the messages to the edge buffer (could be multiple edges)
 	writeStart := time.Now()
-	writeOffsets, err = df.writeToBuffers(ctx, messageToStep)
+	writeOffsets, droppedCounts, err = df.writeToBuffers(ctx, messageToStep)
 	if err != nil {
 		df.opts.logger.Errorw("failed to write to toBuffers", zap.Error(err))
 		return err
@@ -413,8 +490,23 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 						// reset because the toBuffer partition is no longer idling
 						df.idleManager.MarkActive(wmb.PARTITION_0, df.toBuffers[toVertexName][index].GetName())
 					}
+				} else if droppedCounts[toVertexName][index] > 0 {
+					// all the messages to the partition were dropped, advance the watermark through the gap rather
+					// than treating the partition as idling, which needs a ctrl message to be written to a buffer
+					// that might be full.
+					for sp := range sourcePartitionsIndices {
+						var publisher, ok = vertexPublishers[sp]
+						if !ok {
+							publisher = df.createToVertexWatermarkPublisher(toVertexName, sp)
+							vertexPublishers[sp] = publisher
+						}
+						if publisher.PublishSkippedWatermark(processorWM, nil, int32(index)) {
+							activeWatermarkBuffers[toVertexName][index] = true
+							df.idleManager.MarkActive(wmb.PARTITION_0, df.toBuffers[toVertexName][index].GetName())
+						}
+					}
 				}
-				// This (len(offsets) == 0) happens at conditional forwarding, there's no data written to the buffer
+				// This (len(offsets) == 0) also happens at conditional forwarding, there's no data written to the buffer
 			}
 		}
 	}
@@ -484,30 +576,34 @@ func (df *DataForward) ackFromSource(ctx context.Context, offsets []isb.Offset)
 }
 
 // writeToBuffers is a blocking call until all the messages have been forwarded to all the toBuffers, or a shutdown
-// has been initiated while we are stuck looping on an InternalError.
+// has been initiated while we are stuck looping on an InternalError. Along with the write offsets, it returns the
+// number of messages dropped for each of the toBuffer partitions.
 func (df *DataForward) writeToBuffers(
 	ctx context.Context, messageToStep map[string][][]isb.Message,
-) (writeOffsets map[string][][]isb.Offset, err error) {
+) (writeOffsets map[string][][]isb.Offset, droppedCounts map[string][]int, err error) {
 	// messageToStep contains all the to buffers, so the messages could be empty (conditional forwarding).
 	// So writeOffsets also contains all the to buffers, but the returned offsets might be empty.
 	writeOffsets = make(map[string][][]isb.Offset)
+	droppedCounts = make(map[string][]int)
 	for toVertexName, toVertexMessages := range messageToStep {
 		writeOffsets[toVertexName] = make([][]isb.Offset, len(toVertexMessages))
+		droppedCounts[toVertexName] = make([]int, len(toVertexMessages))
 	}
 
 	for toVertexName, toVertexBuffer := range df.toBuffers {
 		for index, partition := range toVertexBuffer {
-			writeOffsets[toVertexName][index], err = df.writeToBuffer(ctx, partition, messageToStep[toVertexName][index])
+			writeOffsets[toVertexName][index], droppedCounts[toVertexName][index], err = df.writeToBuffer(ctx, partition, messageToStep[toVertexName][index])
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 	}
-	return writeOffsets, nil
+	return writeOffsets, droppedCounts, nil
 }
 
 // writeToBuffer forwards an array of messages to a single buffer and is a blocking call or until shutdown has been initiated.
-func (df *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.BufferWriter, messages []isb.Message) (writeOffsets []isb.Offset, err error) {
+// It returns the offsets of the written messages, and the number of messages dropped due to non retryable errors.
+func (df *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.BufferWriter, messages []isb.Message) (writeOffsets []isb.Offset, dropped int, err error) {
 	var (
 		totalCount int
 		writeCount int
@@ -550,6 +646,7 @@ func (df *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.
 						metrics.LabelPartitionName:      toBufferPartition.GetName(),
 						metrics.LabelReason:             err.Error(),
 					}
+					dropped++
 					metrics.DropMessagesCount.With(metricLabelWithReason).Inc()
 					metrics.DropBytesCount.With(metricLabelWithReason).Add(float64(len(msg.Payload)))
 					df.opts.logger.Infow("Dropped message",
@@ -568,7 +665,7 @@ func (df *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.
 					if ok, _ := df.IsShuttingDown(); ok {
 						metrics.PlatformError.With(metricLabels).Inc()
 
-						return writeOffsets, fmt.Errorf("writeToBuffer failed, Stop called while stuck on an internal error with failed messages:%d, %v", len(failedMessages), errs)
+						return writeOffsets, dropped, fmt.Errorf("writeToBuffer failed, Stop called while stuck on an internal error with failed messages:%d, %v", len(failedMessages), errs)
 					}
 				}
 			} else {
@@ -599,7 +696,7 @@ func (df *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.
 
 	metrics.WriteMessagesCount.With(metricLabelsWithPartition).Add(float64(writeCount))
 	metrics.WriteBytesCount.With(metricLabelsWithPartition).Add(writeBytes)
-	return writeOffsets, nil
+	return writeOffsets, dropped, nil
 }
 
 // applyTransformer applies the transformer and will block if there is any InternalErr. On the other hand, if this is a UserError
@@ -662,7 +759,7 @@ func (df *DataForward) createToVertexWatermarkPublisher(toVertexName string, par
 	processorEntity := entity.NewProcessorEntity(entityName)
 
 	// if watermark is disabled, wmStore here is a no op store
-	publisher := publish.NewPublish(df.ctx, processorEntity, wmStore, int32(len(df.toBuffers[toVertexName])))
+	publisher := publish.NewPublish(df.ctx, processorEntity, wmStore, int32(len(df.toBuffers[toVertexName])), df.opts.publishOpts...)
 	df.toVertexWMPublishers[toVertexName][partition] = publisher
 	return publisher
 }
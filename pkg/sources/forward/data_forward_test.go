@@ -34,6 +34,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	udfapplier "github.com/numaproj/numaflow/pkg/udf/rpc"
@@ -94,7 +95,8 @@ type testForwardFetcher struct {
 }
 
 func TestMain(m *testing.M) {
-	goleak.VerifyTestMain(m)
+	// the keyring imported by the Pulsar client connects to the D-Bus session bus, if any, when it's initialized
+	goleak.VerifyTestMain(m, goleak.IgnoreAnyFunction("github.com/godbus/dbus.(*Conn).inWorker"))
 }
 
 // ComputeWatermark uses current time as the watermark because we want to make sure
@@ -190,7 +192,9 @@ func TestNewDataForward(t *testing.T) {
 			assert.NoError(t, err, "expected no error")
 			assert.Len(t, readMessages, updatedBatchSize)
 			for i, j := 0, 0; i < updatedBatchSize; i, j = i+1, j+2 {
-				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+				assert.Equal(t, []interface{}{writeMessages[j].EventTime, writeMessages[j].IsLate}, []interface{}{readMessages[i].EventTime, readMessages[i].IsLate})
+				// the ingestion time is stamped by the source forwarder
+				assert.False(t, readMessages[i].IngestionTime.IsZero())
 				assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 				assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 				assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -201,7 +205,9 @@ func TestNewDataForward(t *testing.T) {
 				assert.NoError(t, err, "expected no error")
 				assert.Len(t, readMessages, updatedBatchSize)
 				for i, j := 0, 1; i < updatedBatchSize; i, j = i+1, j+2 {
-					assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+					assert.Equal(t, []interface{}{writeMessages[j].EventTime, writeMessages[j].IsLate}, []interface{}{readMessages[i].EventTime, readMessages[i].IsLate})
+					// the ingestion time is stamped by the source forwarder
+					assert.False(t, readMessages[i].IngestionTime.IsZero())
 					assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 					assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 					assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -290,7 +296,9 @@ func TestNewDataForward(t *testing.T) {
 			assert.NoError(t, err, "expected no error")
 			assert.Len(t, readMessages, updatedBatchSize)
 			for i, j := 0, 0; i < updatedBatchSize; i, j = i+1, j+2 {
-				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+				assert.Equal(t, []interface{}{writeMessages[j].EventTime, writeMessages[j].IsLate}, []interface{}{readMessages[i].EventTime, readMessages[i].IsLate})
+				// the ingestion time is stamped by the source forwarder
+				assert.False(t, readMessages[i].IngestionTime.IsZero())
 				assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 				assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 				assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -302,7 +310,9 @@ func TestNewDataForward(t *testing.T) {
 				assert.NoError(t, err, "expected no error")
 				assert.Len(t, readMessages, updatedBatchSize)
 				for i, j := 0, 1; i < updatedBatchSize; i, j = i+1, j+2 {
-					assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+					assert.Equal(t, []interface{}{writeMessages[j].EventTime, writeMessages[j].IsLate}, []interface{}{readMessages[i].EventTime, readMessages[i].IsLate})
+					// the ingestion time is stamped by the source forwarder
+					assert.False(t, readMessages[i].IngestionTime.IsZero())
 					assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 					assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 					assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -608,7 +618,9 @@ func TestNewDataForward(t *testing.T) {
 
 			assert.Len(t, readMessages, updatedBatchSize)
 			for i, j := 0, 0; i < updatedBatchSize; i, j = i+1, j+2 {
-				assert.Equal(t, []interface{}{writeMessages[j].MessageInfo}, []interface{}{readMessages[i].MessageInfo})
+				assert.Equal(t, []interface{}{writeMessages[j].EventTime, writeMessages[j].IsLate}, []interface{}{readMessages[i].EventTime, readMessages[i].IsLate})
+				// the ingestion time is stamped by the source forwarder
+				assert.False(t, readMessages[i].IngestionTime.IsZero())
 				assert.Equal(t, []interface{}{writeMessages[j].Kind}, []interface{}{readMessages[i].Kind})
 				assert.Equal(t, []interface{}{writeMessages[j].Keys}, []interface{}{readMessages[i].Keys})
 				assert.Equal(t, []interface{}{writeMessages[j].Body}, []interface{}{readMessages[i].Body})
@@ -895,6 +907,10 @@ func (p TestSourceWatermarkPublisher) PublishSourceWatermarks([]*isb.ReadMessage
 	// PublishSourceWatermarks is not tested in data_forwarder_test.go
 }
 
+func (p TestSourceWatermarkPublisher) Close() error {
+	return nil
+}
+
 func TestDataForwardSinglePartition(t *testing.T) {
 	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
 	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
@@ -1129,7 +1145,7 @@ func TestWriteToBuffer(t *testing.T) {
 			messageToStep["to1"] = make([][]isb.Message, 1)
 			writeMessages := testutils.BuildTestWriteMessages(4*value.batchSize, testStartTime, nil, "testVertex")
 			messageToStep["to1"][0] = append(messageToStep["to1"][0], writeMessages[0:value.batchSize+1]...)
-			_, err = f.writeToBuffers(ctx, messageToStep)
+			_, _, err = f.writeToBuffers(ctx, messageToStep)
 
 			assert.Equal(t, value.throwError, err != nil)
 			if value.throwError {
@@ -1298,3 +1314,46 @@ func buildNoOpToVertexStores(toBuffers map[string][]isb.BufferWriter) map[string
 	}
 	return toVertexStores
 }
+
+func TestDataForward_PausedByStorageQuota(t *testing.T) {
+	fromStep := NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 25, 0))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "receivingVertex"},
+		}},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewDataForward(vertexInstance, fromStep, toSteps, mySourceForwardTest{}, &testForwardFetcher{}, TestSourceWatermarkPublisher{}, buildNoOpToVertexStores(toSteps), idleManager, WithReadBatchSize(5), WithTransformer(mySourceForwardTest{}))
+	assert.NoError(t, err)
+	writeMessages := testutils.BuildTestWriteMessages(int64(2), testStartTime, nil, "testVertex")
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 2), errs)
+
+	// nothing is read while paused
+	f.ApplyLimits(limits.Settings{ReadBatchSize: 5, SourcePaused: true})
+	assert.NoError(t, f.forwardAChunk(ctx))
+	assert.True(t, to1.IsEmpty())
+	assert.False(t, fromStep.buffer.IsEmpty())
+
+	// the reading continues once resumed
+	f.ApplyLimits(limits.Settings{ReadBatchSize: 5})
+	assert.NoError(t, f.forwardAChunk(ctx))
+	readMessages, err := to1.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 2)
+
+	// the forwarder is not started, close the publishers it created
+	for _, publishers := range f.toVertexWMPublishers {
+		for _, p := range publishers {
+			assert.NoError(t, p.Close())
+		}
+	}
+}
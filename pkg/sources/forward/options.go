@@ -17,6 +17,7 @@ limitations under the License.
 package forward
 
 import (
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
@@ -25,6 +26,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sources/forward/applier"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
 )
 
 // options for forwarding the message
@@ -41,10 +43,19 @@ type options struct {
 	logger *zap.SugaredLogger
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// futureEventTimeBound is how far ahead of the wall clock an event time can be before it is counted as a future event time
+	futureEventTimeBound time.Duration
+	// publishOpts are the options of the watermark publishers to the to vertices
+	publishOpts []publish.PublishOption
+	// drainTimeout is how long the forwarder keeps forwarding the messages held by a draining source after Stop
+	drainTimeout time.Duration
 }
 
 type Option func(*options) error
 
+// defaultDrainTimeout is the default drain timeout of the sources holding the produced messages.
+const defaultDrainTimeout = 10 * time.Second
+
 func defaultOptions() *options {
 	return &options{
 		readBatchSize:          dfv1.DefaultReadBatchSize,
@@ -52,6 +63,8 @@ func defaultOptions() *options {
 		transformerConcurrency: dfv1.DefaultReadBatchSize,
 		retryInterval:          time.Millisecond,
 		logger:                 logging.NewLogger(),
+		futureEventTimeBound:   dfv1.DefaultFutureEventTimeBound,
+		drainTimeout:           defaultDrainTimeout,
 	}
 }
 
@@ -102,3 +115,31 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithFutureEventTimeBound sets how far ahead of the wall clock an event time can be before it is counted as a future event time
+func WithFutureEventTimeBound(d time.Duration) Option {
+	return func(o *options) error {
+		o.futureEventTimeBound = d
+		return nil
+	}
+}
+
+// WithWatermarkPublishOptions sets the options of the watermark publishers to the to vertices
+func WithWatermarkPublishOptions(opts ...publish.PublishOption) Option {
+	return func(o *options) error {
+		o.publishOpts = opts
+		return nil
+	}
+}
+
+// WithDrainTimeout sets how long the forwarder keeps forwarding the messages held by the source after Stop, if the
+// source is a sourcer.Drainer. The source is not drained if it's 0.
+func WithDrainTimeout(d time.Duration) Option {
+	return func(o *options) error {
+		if d < 0 {
+			return fmt.Errorf("drain timeout should not be negative, got %v", d)
+		}
+		o.drainTimeout = d
+		return nil
+	}
+}
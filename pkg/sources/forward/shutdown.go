@@ -20,6 +20,10 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
 
 // Shutdown tracks and enforces the shutdown activity.
@@ -28,7 +32,9 @@ type Shutdown struct {
 	forceShutdown      bool
 	initiateTime       time.Time
 	shutdownRequestCtr int
-	rwlock             *sync.RWMutex
+	// drainDeadline is when the forwarder stops draining the source, it's zero if the source is not drained.
+	drainDeadline time.Time
+	rwlock        *sync.RWMutex
 }
 
 // IsShuttingDown returns whether we can stop processing.
@@ -50,7 +56,9 @@ func (s *Shutdown) String() string {
 		s.startShutdown, s.forceShutdown, s.shutdownRequestCtr, s.initiateTime)
 }
 
-// Stop stops the processing.
+// Stop stops the processing. If the source holds the produced messages, i.e. it's a sourcer.Drainer, it stops
+// producing and the forwarder keeps forwarding until the source is empty or the drain timeout elapses, see
+// stopIfDrained.
 func (df *DataForward) Stop() {
 	df.Shutdown.rwlock.Lock()
 	defer df.Shutdown.rwlock.Unlock()
@@ -59,16 +67,48 @@ func (df *DataForward) Stop() {
 	}
 	df.Shutdown.startShutdown = true
 	df.Shutdown.shutdownRequestCtr++
+	if _, ok := df.reader.(sourcer.Drainer); ok && df.opts.drainTimeout > 0 && !df.Shutdown.forceShutdown {
+		if df.Shutdown.drainDeadline.IsZero() {
+			if stopper, ok := df.reader.(sourcer.ProducerStopper); ok {
+				stopper.StopProducing()
+			}
+			df.Shutdown.drainDeadline = df.Shutdown.initiateTime.Add(df.opts.drainTimeout)
+			df.opts.logger.Infow("Draining the source", zap.Duration("drainTimeout", df.opts.drainTimeout))
+		}
+		return
+	}
 	// call cancel
 	df.cancelFn()
 }
 
-// ForceStop sets up the force shutdown flag.
+// ForceStop stops the processing immediately, without draining the source.
 func (df *DataForward) ForceStop() {
-	// call stop (what if we have an enthusiastic shutdown that forces first)
 	// e.g., I know I have written a wrong source transformer, so shutdown ASAP
-	df.Stop()
 	df.Shutdown.rwlock.Lock()
 	defer df.Shutdown.rwlock.Unlock()
+	if df.Shutdown.initiateTime.IsZero() {
+		df.Shutdown.initiateTime = time.Now()
+	}
+	df.Shutdown.startShutdown = true
 	df.Shutdown.forceShutdown = true
+	df.Shutdown.shutdownRequestCtr++
+	df.cancelFn()
+}
+
+// stopIfDrained stops the processing once the draining source is empty or the drain timeout elapses. It's called
+// between the chunks, so that the chunk in flight is forwarded before stopping.
+func (df *DataForward) stopIfDrained() {
+	df.Shutdown.rwlock.RLock()
+	deadline := df.Shutdown.drainDeadline
+	df.Shutdown.rwlock.RUnlock()
+	if deadline.IsZero() {
+		return
+	}
+	if df.reader.(sourcer.Drainer).IsEmpty() {
+		df.opts.logger.Info("The source is drained")
+		df.cancelFn()
+	} else if time.Now().After(deadline) {
+		df.opts.logger.Warnw("The source is not drained within the drain timeout, stopping", zap.Duration("drainTimeout", df.opts.drainTimeout))
+		df.cancelFn()
+	}
 }
@@ -150,3 +150,60 @@ func TestInterStepDataForward(t *testing.T) {
 		})
 	}
 }
+
+// neverEmptySource is a draining source that is never drained.
+type neverEmptySource struct {
+	*SimpleSource
+}
+
+func (s neverEmptySource) IsEmpty() bool {
+	return false
+}
+
+func TestStop_Drain(t *testing.T) {
+	newForwarder := func(t *testing.T, drainTimeout time.Duration) *DataForward {
+		fromStep := neverEmptySource{NewSimpleSource(simplebuffer.NewInMemoryBuffer("from", 10, 0))}
+		toSteps := map[string][]isb.BufferWriter{
+			"to1": {simplebuffer.NewInMemoryBuffer("to1", 10, 0)},
+		}
+		vertexInstance := &dfv1.VertexInstance{
+			Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+				PipelineName: "testPipeline",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name: "testVertex",
+				},
+			}},
+			Replica: 0,
+		}
+		fetchWatermark, _ := generic.BuildNoOpSourceWatermarkProgressorsFromBufferMap(toSteps)
+		f, err := NewDataForward(vertexInstance, fromStep, toSteps, myShutdownTest{}, fetchWatermark, TestSourceWatermarkPublisher{}, buildNoOpToVertexStores(toSteps), wmb.NewNoOpIdleManager(), WithReadBatchSize(5), WithDrainTimeout(drainTimeout))
+		assert.NoError(t, err)
+		return f
+	}
+
+	t.Run("drain_timeout", func(t *testing.T) {
+		f := newForwarder(t, 100*time.Millisecond)
+		stopped := f.Start()
+		start := time.Now()
+		f.Stop()
+		<-stopped
+		assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("forceStop", func(t *testing.T) {
+		f := newForwarder(t, time.Hour)
+		stopped := f.Start()
+		f.Stop()
+		select {
+		case <-stopped:
+			t.Fatal("the forwarder stopped before the source is drained")
+		case <-time.After(100 * time.Millisecond):
+		}
+		f.ForceStop()
+		<-stopped
+	})
+}
+
+func TestWithDrainTimeout_Invalid(t *testing.T) {
+	assert.Error(t, WithDrainTimeout(-time.Second)(defaultOptions()))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	rand2 "math/rand"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// keyGenerator generates the keys of the records, either for every key in turn or drawn from a key distribution, and
+// tracks the sequence number of the last record generated for every key. It's only used by the worker.
+type keyGenerator struct {
+	// count is the number of the unique keys.
+	count int32
+	// distribution is the distribution the keys of the records are drawn from.
+	distribution dfv1.KeyDistribution
+	// zipfExponent is the exponent of the zipf key distribution.
+	zipfExponent float64
+	// hotKeyPct is the percentage of the records generated with the first key in the hot key distribution.
+	hotKeyPct int
+	// rnd draws the keys from the key distribution, it's the seeded source in the deterministic mode.
+	rnd *rand2.Rand
+	// pick draws the index of the key of a record from the key distribution, it's nil if the records are generated
+	// for every key in turn.
+	pick func() int32
+	// seqs is the sequence number of the last generated record of every key.
+	seqs []uint64
+}
+
+// resize sets the number of the unique keys, the sequence numbers of the existing keys are kept and the key picker is
+// rebuilt for the new key count.
+func (g *keyGenerator) resize(count int32) {
+	g.count = count
+	for int32(len(g.seqs)) < count {
+		g.seqs = append(g.seqs, 0)
+	}
+	g.pick = g.newPicker()
+}
+
+// next returns the index of the key of the k-th record generated for the keys on a tick, it's k if the records are
+// generated for every key in turn.
+func (g *keyGenerator) next(k int32) int32 {
+	if g.pick != nil {
+		return g.pick()
+	}
+	return k
+}
+
+// newPicker returns the function drawing the index of the key of a record from the key distribution, it's nil for the
+// round robin distribution.
+func (g *keyGenerator) newPicker() func() int32 {
+	rnd := g.rnd
+	n := int(g.count)
+	switch g.distribution {
+	case dfv1.KeyDistributionUniform:
+		return func() int32 { return int32(rnd.Intn(n)) }
+	case dfv1.KeyDistributionZipf:
+		// the i-th key is drawn with a probability proportional to 1/i^zipfExponent.
+		z := rand2.NewZipf(rnd, g.zipfExponent, 1, uint64(n-1))
+		return func() int32 { return int32(z.Uint64()) }
+	case dfv1.KeyDistributionHotKey:
+		return func() int32 {
+			if n == 1 || rnd.Intn(100) < g.hotKeyPct {
+				return 0
+			}
+			return int32(1 + rnd.Intn(n-1))
+		}
+	default:
+		return nil
+	}
+}
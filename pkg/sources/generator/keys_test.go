@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	rand2 "math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func newTestKeyGenerator(dist dfv1.KeyDistribution, count int32) *keyGenerator {
+	g := &keyGenerator{distribution: dist, zipfExponent: 1.5, hotKeyPct: 80, rnd: rand2.New(rand2.NewSource(42))}
+	g.resize(count)
+	return g
+}
+
+func TestKeyGenerator_Picker(t *testing.T) {
+	draw := func(dist dfv1.KeyDistribution) []int {
+		g := newTestKeyGenerator(dist, 10)
+		counts := make([]int, g.count)
+		for i := 0; i < 10000; i++ {
+			counts[g.next(0)]++
+		}
+		return counts
+	}
+
+	assert.Nil(t, newTestKeyGenerator(dfv1.KeyDistributionRoundRobin, 10).pick)
+	for _, c := range draw(dfv1.KeyDistributionUniform) {
+		assert.InDelta(t, 1000, c, 200)
+	}
+	zipf := draw(dfv1.KeyDistributionZipf)
+	for i := 1; i < 3; i++ {
+		// the first keys are the most frequent ones.
+		assert.Greater(t, zipf[i-1], zipf[i])
+	}
+	assert.Greater(t, zipf[0], 4000)
+	hot := draw(dfv1.KeyDistributionHotKey)
+	assert.InDelta(t, 8000, hot[0], 300)
+	for _, c := range hot[1:] {
+		assert.InDelta(t, 2000/9, c, 100)
+	}
+}
+
+func TestKeyGenerator_RoundRobin(t *testing.T) {
+	g := newTestKeyGenerator(dfv1.KeyDistributionRoundRobin, 3)
+	for k := int32(0); k < 3; k++ {
+		assert.Equal(t, k, g.next(k))
+	}
+}
+
+func TestKeyGenerator_Resize(t *testing.T) {
+	g := newTestKeyGenerator(dfv1.KeyDistributionUniform, 2)
+	assert.Equal(t, []uint64{0, 0}, g.seqs)
+	g.seqs[1] = 5
+	g.resize(4)
+	// the sequences of the existing keys are kept.
+	assert.Equal(t, []uint64{0, 5, 0, 0}, g.seqs)
+	seen := make(map[int32]bool)
+	for i := 0; i < 1000; i++ {
+		seen[g.next(0)] = true
+	}
+	assert.Len(t, seen, 4)
+}
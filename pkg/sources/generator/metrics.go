@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// tickgenPending is used to indicate the number of the generated records pending to be read, including the records
+// queued for the redelivery
+var tickgenPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "tickgen_source",
+	Name:      "pending",
+	Help:      "Number of generated records pending to be read",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelVertexReplicaIndex})
+
+// tickgenSkipped is used to indicate the number of the records not generated because srcChan was full, i.e. the
+// generated records were not read as fast as they were generated, or because the worker was behind on the ticks
+var tickgenSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "tickgen_source",
+	Name:      "skipped",
+	Help:      "Total number of records skipped because the generated records were not read fast enough",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelVertexReplicaIndex})
+
+// tickgenDuplicated is used to indicate the number of the records written again as duplicates
+var tickgenDuplicated = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "tickgen_source",
+	Name:      "duplicated",
+	Help:      "Total number of duplicate records generated",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, metrics.LabelVertexReplicaIndex})
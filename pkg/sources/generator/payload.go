@@ -0,0 +1,450 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	rand2 "math/rand"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+const (
+	// createdTSField is the name of the integer field carrying the generation time in nanoseconds.
+	createdTSField = "createdts"
+	// seqField is the name of the integer field carrying the per-key sequence number.
+	seqField = "seq"
+	// maxPayloadDepth is the depth of the nested messages the recursive schemas are cut at.
+	maxPayloadDepth = 8
+)
+
+// payloadEncoder generates the payloads of the records in a binary format.
+type payloadEncoder interface {
+	// encode returns the payload of a record generated at createdTS with the per-key sequence number seq, the random
+	// values are drawn from rnd.
+	encode(rnd *rand2.Rand, size int32, createdTS int64, seq uint64) ([]byte, error)
+}
+
+// newPayloadEncoder returns the encoder of the payloads in the format, it's nil for the JSON format.
+func newPayloadEncoder(format dfv1.PayloadFormat, schema, message string) (payloadEncoder, error) {
+	switch format {
+	case dfv1.PayloadFormatJSON:
+		return nil, nil
+	case dfv1.PayloadFormatRawBytes:
+		return rawBytesEncoder{}, nil
+	case dfv1.PayloadFormatProtobuf:
+		return newProtobufEncoder(schema, message)
+	case dfv1.PayloadFormatAvro:
+		return newAvroEncoder(schema)
+	default:
+		return nil, fmt.Errorf("unsupported payload format %q", format)
+	}
+}
+
+// ParsePayloadSchema parses the schema of the payloads in the format, the message is the name of the protobuf message.
+func ParsePayloadSchema(format dfv1.PayloadFormat, schema, message string) error {
+	_, err := newPayloadEncoder(format, schema, message)
+	return err
+}
+
+// fieldGenerator generates the values of the fields of a payload.
+type fieldGenerator struct {
+	rnd       *rand2.Rand
+	size      int32
+	createdTS int64
+	seq       uint64
+}
+
+// integer returns the value of the integer field, the createdts and seq fields carry the generation time and the
+// sequence number, the other ones are random.
+func (g fieldGenerator) integer(name string) int64 {
+	switch strings.ToLower(name) {
+	case createdTSField:
+		return g.createdTS
+	case seqField:
+		return int64(g.seq)
+	default:
+		return g.rnd.Int63n(math.MaxInt32)
+	}
+}
+
+// randBytes returns size random bytes.
+func (g fieldGenerator) randBytes() []byte {
+	b := make([]byte, max(g.size, 0))
+	_, _ = g.rnd.Read(b)
+	return b
+}
+
+// randString returns a random alphanumeric string.
+func (g fieldGenerator) randString() string {
+	return valueTemplateData{intn: g.rnd.Intn}.RandString(8)
+}
+
+// rawBytesEncoder generates the payloads of msgSize random bytes.
+type rawBytesEncoder struct{}
+
+func (rawBytesEncoder) encode(rnd *rand2.Rand, size int32, createdTS int64, seq uint64) ([]byte, error) {
+	return fieldGenerator{rnd: rnd, size: size}.randBytes(), nil
+}
+
+// protobufEncoder generates the payloads as a protobuf message.
+type protobufEncoder struct {
+	desc protoreflect.MessageDescriptor
+}
+
+// newProtobufEncoder returns the encoder of the message in the base64 encoded FileDescriptorSet.
+func newProtobufEncoder(schema, message string) (*protobufEncoder, error) {
+	raw, err := base64.StdEncoding.DecodeString(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the protobuf descriptor set, %w", err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(raw, set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the protobuf descriptor set, %w", err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protobuf descriptor set, %w", err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the protobuf message %q, %w", message, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a protobuf message", message)
+	}
+	return &protobufEncoder{desc: md}, nil
+}
+
+func (e *protobufEncoder) encode(rnd *rand2.Rand, size int32, createdTS int64, seq uint64) ([]byte, error) {
+	msg := dynamicpb.NewMessage(e.desc)
+	fieldGenerator{rnd: rnd, size: size, createdTS: createdTS, seq: seq}.fillProto(msg, 0)
+	// the fields of a dynamic message are marshaled in a random order unless it's deterministic.
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
+
+// fillProto sets all the fields of the message, only the first field of a oneof is set and the nested messages
+// deeper than maxPayloadDepth are left empty.
+func (g fieldGenerator) fillProto(msg protoreflect.Message, depth int) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() && od.Fields().Get(0) != fd {
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil && depth >= maxPayloadDepth {
+				continue
+			}
+			m := msg.Mutable(fd).Map()
+			m.Set(g.protoValue(fd.MapKey(), nil, depth).MapKey(), g.protoValue(fd.MapValue(), m.NewValue, depth))
+		case fd.IsList():
+			if fd.Message() != nil && depth >= maxPayloadDepth {
+				continue
+			}
+			l := msg.Mutable(fd).List()
+			for n := 1 + g.rnd.Intn(3); n > 0; n-- {
+				l.Append(g.protoValue(fd, l.NewElement, depth))
+			}
+		default:
+			if fd.Message() != nil && depth >= maxPayloadDepth {
+				continue
+			}
+			msg.Set(fd, g.protoValue(fd, func() protoreflect.Value { return msg.NewField(fd) }, depth))
+		}
+	}
+}
+
+// protoValue returns a value of the field, newMessage returns an empty value of a message field.
+func (g fieldGenerator) protoValue(fd protoreflect.FieldDescriptor, newMessage func() protoreflect.Value, depth int) protoreflect.Value {
+	name := string(fd.Name())
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(g.rnd.Intn(2) == 1)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(g.rnd.Intn(values.Len())).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(g.integer(name)))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(g.integer(name))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(g.integer(name)))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(g.integer(name)))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(g.rnd.Float32())
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(g.rnd.Float64())
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(g.randString())
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(g.randBytes())
+	default:
+		v := newMessage()
+		g.fillProto(v.Message(), depth+1)
+		return v
+	}
+}
+
+// avroSchema is a parsed Avro schema.
+type avroSchema struct {
+	// typ is the primitive type, or record, enum, array, map, union or fixed.
+	typ string
+	// fields is the fields of a record.
+	fields []avroField
+	// symbols is the symbols of an enum.
+	symbols []string
+	// items is the schema of the items of an array, or of the values of a map.
+	items *avroSchema
+	// branches is the schemas of the branches of a union.
+	branches []*avroSchema
+	// size is the size of a fixed.
+	size int
+}
+
+// avroField is a field of an Avro record.
+type avroField struct {
+	name   string
+	schema *avroSchema
+}
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true, "float": true, "double": true, "bytes": true, "string": true,
+}
+
+// avroEncoder generates the payloads as an Avro datum in the binary encoding, without the schema.
+type avroEncoder struct {
+	schema *avroSchema
+}
+
+// newAvroEncoder returns the encoder of the Avro schema in JSON.
+func newAvroEncoder(schema string) (*avroEncoder, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(schema), &v); err != nil {
+		return nil, fmt.Errorf("failed to parse the avro schema, %w", err)
+	}
+	s, err := (&avroParser{named: make(map[string]*avroSchema)}).parse(v, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid avro schema, %w", err)
+	}
+	return &avroEncoder{schema: s}, nil
+}
+
+func (e *avroEncoder) encode(rnd *rand2.Rand, size int32, createdTS int64, seq uint64) ([]byte, error) {
+	return fieldGenerator{rnd: rnd, size: size, createdTS: createdTS, seq: seq}.appendAvro(nil, e.schema, "", 0)
+}
+
+// avroParser parses an Avro schema, it keeps the named types so that they can be referred to by name.
+type avroParser struct {
+	named map[string]*avroSchema
+}
+
+func (p *avroParser) parse(v interface{}, namespace string) (*avroSchema, error) {
+	switch t := v.(type) {
+	case string:
+		if avroPrimitives[t] {
+			return &avroSchema{typ: t}, nil
+		}
+		if s, ok := p.named[t]; ok {
+			return s, nil
+		}
+		if s, ok := p.named[namespace+"."+t]; ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("unknown type %q", t)
+	case []interface{}:
+		if len(t) == 0 {
+			return nil, fmt.Errorf("empty union")
+		}
+		s := &avroSchema{typ: "union"}
+		for _, b := range t {
+			bs, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.branches = append(s.branches, bs)
+		}
+		return s, nil
+	case map[string]interface{}:
+		typ, ok := t["type"].(string)
+		if !ok {
+			// the type is a nested schema.
+			return p.parse(t["type"], namespace)
+		}
+		switch typ {
+		case "record", "error", "enum", "fixed":
+			return p.parseNamed(t, typ, namespace)
+		case "array":
+			items, err := p.parse(t["items"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroSchema{typ: "array", items: items}, nil
+		case "map":
+			values, err := p.parse(t["values"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroSchema{typ: "map", items: values}, nil
+		default:
+			// a primitive type with attributes, e.g. a logical type.
+			return p.parse(typ, namespace)
+		}
+	default:
+		return nil, fmt.Errorf("invalid schema %v", v)
+	}
+}
+
+// parseNamed parses a record, an enum or a fixed, they are registered by their full names.
+func (p *avroParser) parseNamed(t map[string]interface{}, typ string, namespace string) (*avroSchema, error) {
+	name, _ := t["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("missing the name of a %s", typ)
+	}
+	if ns, ok := t["namespace"].(string); ok {
+		namespace = ns
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		namespace = name[:i]
+	} else if namespace != "" {
+		name = namespace + "." + name
+	}
+	s := &avroSchema{typ: typ}
+	p.named[name] = s
+	switch typ {
+	case "record", "error":
+		s.typ = "record"
+		fields, ok := t["fields"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("missing the fields of the record %q", name)
+		}
+		for _, f := range fields {
+			fm, ok := f.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid field %v of the record %q", f, name)
+			}
+			fname, _ := fm["name"].(string)
+			fs, err := p.parse(fm["type"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("invalid field %q of the record %q, %w", fname, name, err)
+			}
+			s.fields = append(s.fields, avroField{name: fname, schema: fs})
+		}
+	case "enum":
+		symbols, _ := t["symbols"].([]interface{})
+		for _, sym := range symbols {
+			str, ok := sym.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid symbol %v of the enum %q", sym, name)
+			}
+			s.symbols = append(s.symbols, str)
+		}
+		if len(s.symbols) == 0 {
+			return nil, fmt.Errorf("missing the symbols of the enum %q", name)
+		}
+	case "fixed":
+		size, ok := t["size"].(float64)
+		if !ok || size < 0 {
+			return nil, fmt.Errorf("invalid size of the fixed %q", name)
+		}
+		s.size = int(size)
+	}
+	return s, nil
+}
+
+// appendAvro appends the binary encoding of a value of the schema, name is the name of the field of the value. The
+// unions take their first branch other than null, or null for the nested records deeper than maxPayloadDepth.
+func (g fieldGenerator) appendAvro(b []byte, s *avroSchema, name string, depth int) ([]byte, error) {
+	var err error
+	switch s.typ {
+	case "null":
+	case "boolean":
+		b = append(b, byte(g.rnd.Intn(2)))
+	case "int", "long":
+		b = binary.AppendVarint(b, g.integer(name))
+	case "float":
+		b = binary.LittleEndian.AppendUint32(b, math.Float32bits(g.rnd.Float32()))
+	case "double":
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(g.rnd.Float64()))
+	case "bytes":
+		v := g.randBytes()
+		b = append(binary.AppendVarint(b, int64(len(v))), v...)
+	case "string":
+		v := g.randString()
+		b = append(binary.AppendVarint(b, int64(len(v))), v...)
+	case "fixed":
+		b = append(b, fieldGenerator{rnd: g.rnd, size: int32(s.size)}.randBytes()...)
+	case "enum":
+		b = binary.AppendVarint(b, int64(g.rnd.Intn(len(s.symbols))))
+	case "record":
+		if depth >= maxPayloadDepth {
+			return nil, fmt.Errorf("the records are nested deeper than %d", maxPayloadDepth)
+		}
+		for _, f := range s.fields {
+			if b, err = g.appendAvro(b, f.schema, f.name, depth+1); err != nil {
+				return nil, err
+			}
+		}
+	case "array", "map":
+		// the arrays and the maps are encoded as a block of items followed by an empty block.
+		n := 1 + g.rnd.Intn(3)
+		if depth >= maxPayloadDepth {
+			n = 0
+		}
+		if n > 0 {
+			b = binary.AppendVarint(b, int64(n))
+		}
+		for i := 0; i < n; i++ {
+			if s.typ == "map" {
+				key := g.randString()
+				b = append(binary.AppendVarint(b, int64(len(key))), key...)
+			}
+			if b, err = g.appendAvro(b, s.items, name, depth+1); err != nil {
+				return nil, err
+			}
+		}
+		b = binary.AppendVarint(b, 0)
+	case "union":
+		branch := -1
+		for i, bs := range s.branches {
+			if (bs.typ == "null") == (depth >= maxPayloadDepth) {
+				branch = i
+				break
+			}
+		}
+		if branch < 0 {
+			branch = 0
+		}
+		b = binary.AppendVarint(b, int64(branch))
+		return g.appendAvro(b, s.branches[branch], name, depth)
+	}
+	return b, nil
+}
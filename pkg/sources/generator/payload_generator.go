@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"fmt"
+	rand2 "math/rand"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// payloadGenerator generates the payloads and the headers of the records, from the value blob, the value template, the
+// payload encoder or the default JSON payload. It's only used by the worker.
+type payloadGenerator struct {
+	// value is the optional uint64 number that can be set in the payload.
+	value *uint64
+	// genFn generates a payload as a byte array.
+	genFn func(int32, *uint64, int64, uint64) ([]byte, error)
+	// customPayload is whether the payloads come from the value blob or the value template.
+	customPayload bool
+	// valueTemplate is the template the payloads are rendered from, if it's set.
+	valueTemplate *template.Template
+	// createdTSFromPayload is whether the event time is read from the Createdts field of the payload rendered from
+	// the value template.
+	createdTSFromPayload bool
+	// encoder generates the payloads in a binary format, the payloads are in JSON if it's nil.
+	encoder payloadEncoder
+	// rnd generates the random values of the payloads from the payload encoder.
+	rnd *rand2.Rand
+	// renderTime is the generation time of the payload or the headers being rendered from the templates.
+	renderTime time.Time
+	// intn returns a random integer in [0, n) for the templates, it's drawn from the seed in the deterministic mode.
+	intn func(int) int
+	// staticHeaders is the headers of the records whose values do not change.
+	staticHeaders map[string]string
+	// headerTemplates is the templates of the values of the headers rendered for every record, sorted by the names so
+	// that the random values are drawn in the same order in the deterministic mode.
+	headerTemplates []headerTemplate
+}
+
+// headerTemplate is the template of the value of a header.
+type headerTemplate struct {
+	name string
+	tmpl *template.Template
+}
+
+// generate returns the payload of a record of the size generated at createdTS with the per-key sequence seq.
+func (p *payloadGenerator) generate(size int32, createdTS int64, seq uint64) ([]byte, error) {
+	return p.genFn(size, p.value, createdTS, seq)
+}
+
+// renderedRecord generates the payload of a record by rendering the value template.
+func (p *payloadGenerator) renderedRecord(_ int32, _ *uint64, createdTS int64, seq uint64) ([]byte, error) {
+	var b strings.Builder
+	p.renderTime = time.Unix(0, createdTS)
+	if err := p.valueTemplate.Execute(&b, valueTemplateData{Timestamp: createdTS, Sequence: seq, intn: p.intn}); err != nil {
+		return nil, err
+	}
+	return []byte(b.String()), nil
+}
+
+// encodedRecord generates the payload of a record with the payload encoder.
+func (p *payloadGenerator) encodedRecord(size int32, _ *uint64, createdTS int64, seq uint64) ([]byte, error) {
+	return p.encoder.encode(p.rnd, size, createdTS, seq)
+}
+
+// headers returns the headers of a record generated at createdTS with the per-key sequence seq. The static headers are
+// shared by all the records if there is no header template.
+func (p *payloadGenerator) headers(createdTS int64, seq uint64) (map[string]string, error) {
+	if len(p.headerTemplates) == 0 {
+		return p.staticHeaders, nil
+	}
+	headers := make(map[string]string, len(p.staticHeaders)+len(p.headerTemplates))
+	for name, value := range p.staticHeaders {
+		headers[name] = value
+	}
+	p.renderTime = time.Unix(0, createdTS)
+	for _, h := range p.headerTemplates {
+		var b strings.Builder
+		if err := h.tmpl.Execute(&b, valueTemplateData{Timestamp: createdTS, Sequence: seq, intn: p.intn}); err != nil {
+			return nil, fmt.Errorf("failed to render the header %s, %w", h.name, err)
+		}
+		headers[h.name] = b.String()
+	}
+	return headers, nil
+}
+
+// templateFuncs sets the functions of the value template and the header templates.
+func (p *payloadGenerator) templateFuncs(funcs template.FuncMap) {
+	if p.valueTemplate != nil {
+		p.valueTemplate.Funcs(funcs)
+	}
+	for _, h := range p.headerTemplates {
+		h.tmpl.Funcs(funcs)
+	}
+}
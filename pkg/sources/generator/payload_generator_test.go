@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	rand2 "math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestPayloadGenerator_Default(t *testing.T) {
+	p := &payloadGenerator{value: ptr.To[uint64](7), genFn: recordGenerator}
+	b, err := p.generate(20, 100, 3)
+	require.NoError(t, err)
+	var r payload
+	require.NoError(t, json.Unmarshal(b, &r))
+	assert.Equal(t, uint64(7), r.Data.Value)
+	assert.Len(t, r.Data.Padding, 12)
+	assert.Equal(t, int64(100), r.Createdts)
+	assert.Equal(t, uint64(3), r.Seq)
+}
+
+func TestPayloadGenerator_ValueTemplate(t *testing.T) {
+	m := &memGen{}
+	assert.NoError(t, WithValueTemplate(`{"seq": {{.Sequence}}, "Createdts": {{.Timestamp}}, "id": "{{.RandString 4}}"}`)(m))
+	p := &m.payload
+	assert.True(t, p.customPayload)
+	assert.True(t, p.createdTSFromPayload)
+	p.intn = func(int) int { return 0 }
+	b, err := p.generate(0, 100, 3)
+	require.NoError(t, err)
+	assert.Equal(t, `{"seq": 3, "Createdts": 100, "id": "aaaa"}`, string(b))
+	assert.Equal(t, int64(100), p.renderTime.UnixNano())
+}
+
+func TestPayloadGenerator_Encoder(t *testing.T) {
+	m := &memGen{}
+	assert.NoError(t, WithPayloadFormat(dfv1.PayloadFormatRawBytes, "", "")(m))
+	p := &m.payload
+	p.rnd = rand2.New(rand2.NewSource(42))
+	p.genFn = p.encodedRecord
+	b, err := p.generate(16, 100, 3)
+	require.NoError(t, err)
+	assert.Len(t, b, 16)
+}
+
+func TestPayloadGenerator_Headers(t *testing.T) {
+	p := &payloadGenerator{staticHeaders: map[string]string{"a": "v"}}
+	headers, err := p.headers(100, 1)
+	require.NoError(t, err)
+	// the static headers are shared by the records.
+	assert.Equal(t, map[string]string{"a": "v"}, headers)
+
+	m := &memGen{}
+	assert.NoError(t, WithHeaders(map[string]string{"a": "v", "seq": "{{.Sequence}}", "ts": "{{.Timestamp}}"})(m))
+	p = &m.payload
+	headers, err = p.headers(100, 2)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "v", "seq": "2", "ts": "100"}, headers)
+	assert.Equal(t, map[string]string{"a": "v"}, p.staticHeaders)
+	assert.Equal(t, int64(100), p.renderTime.UnixNano())
+}
+
+func TestPayloadGenerator_TemplateFuncs(t *testing.T) {
+	m := &memGen{}
+	assert.NoError(t, WithValueTemplate(`{{now.Unix}}`)(m))
+	assert.NoError(t, WithHeaders(map[string]string{"now": "{{now.Unix}}"})(m))
+	p := &m.payload
+	p.templateFuncs(valueTemplateFuncs(nil, func() time.Time { return p.renderTime }))
+	b, err := p.generate(0, 5e9, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "5", strings.TrimSpace(string(b)))
+	headers, err := p.headers(7e9, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "7", headers["now"])
+}
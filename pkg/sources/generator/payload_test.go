@@ -0,0 +1,196 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	rand2 "math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// orderDescriptorSet returns the base64 encoded FileDescriptorSet of the example.Order message, which has the
+// scalar, repeated, map, enum and recursive message fields.
+func orderDescriptorSet(t *testing.T) string {
+	field := func(name string, num int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{Name: proto.String(name), Number: proto.Int32(num), Type: typ.Enum(), Label: label.Enum()}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	opt, rep := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("order.proto"),
+		Package: proto.String("example"),
+		Syntax:  proto.String("proto3"),
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Status"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("NEW"), Number: proto.Int32(0)},
+				{Name: proto.String("PAID"), Number: proto.Int32(1)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Order"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("createdts", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, opt, ""),
+				field("seq", 2, descriptorpb.FieldDescriptorProto_TYPE_UINT64, opt, ""),
+				field("id", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, opt, ""),
+				field("blob", 4, descriptorpb.FieldDescriptorProto_TYPE_BYTES, opt, ""),
+				field("status", 5, descriptorpb.FieldDescriptorProto_TYPE_ENUM, opt, ".example.Status"),
+				field("prices", 6, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, rep, ""),
+				field("counts", 7, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, rep, ".example.Order.CountsEntry"),
+				field("parent", 8, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, opt, ".example.Order"),
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("CountsEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, opt, ""),
+					field("value", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, opt, ""),
+				},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+		}},
+	}
+	b, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestProtobufEncoder(t *testing.T) {
+	schema := orderDescriptorSet(t)
+	enc, err := newPayloadEncoder(dfv1.PayloadFormatProtobuf, schema, "example.Order")
+	require.NoError(t, err)
+	createdTS := time.Now().UnixNano()
+	b, err := enc.encode(rand2.New(rand2.NewSource(42)), 16, createdTS, 7)
+	require.NoError(t, err)
+
+	raw, _ := base64.StdEncoding.DecodeString(schema)
+	set := &descriptorpb.FileDescriptorSet{}
+	require.NoError(t, proto.Unmarshal(raw, set))
+	files, err := protodesc.NewFiles(set)
+	require.NoError(t, err)
+	d, err := files.FindDescriptorByName("example.Order")
+	require.NoError(t, err)
+	md := d.(protoreflect.MessageDescriptor)
+	msg := dynamicpb.NewMessage(md)
+	require.NoError(t, proto.Unmarshal(b, msg))
+	fields := md.Fields()
+	assert.Equal(t, createdTS, msg.Get(fields.ByName("createdts")).Int())
+	assert.Equal(t, uint64(7), msg.Get(fields.ByName("seq")).Uint())
+	assert.Len(t, msg.Get(fields.ByName("id")).String(), 8)
+	assert.Len(t, msg.Get(fields.ByName("blob")).Bytes(), 16)
+	assert.NotZero(t, msg.Get(fields.ByName("prices")).List().Len())
+	assert.Equal(t, 1, msg.Get(fields.ByName("counts")).Map().Len())
+	// the recursive message is cut at the max depth.
+	depth := 0
+	for m := msg.Get(fields.ByName("parent")).Message(); m.Has(fields.ByName("createdts")); m = m.Get(fields.ByName("parent")).Message() {
+		depth++
+	}
+	assert.Equal(t, maxPayloadDepth, depth)
+
+	// the same random source generates the same payload.
+	again, err := enc.encode(rand2.New(rand2.NewSource(42)), 16, createdTS, 7)
+	require.NoError(t, err)
+	assert.Equal(t, b, again)
+}
+
+func TestAvroEncoder(t *testing.T) {
+	schema := `{
+		"type": "record", "name": "Order", "namespace": "example",
+		"fields": [
+			{"name": "createdts", "type": {"type": "long", "logicalType": "timestamp-nanos"}},
+			{"name": "seq", "type": "long"},
+			{"name": "blob", "type": "bytes"},
+			{"name": "id", "type": "string"},
+			{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "PAID"]}},
+			{"name": "hash", "type": {"type": "fixed", "name": "Hash", "size": 4}},
+			{"name": "prices", "type": {"type": "array", "items": "double"}},
+			{"name": "counts", "type": {"type": "map", "values": "int"}},
+			{"name": "note", "type": ["null", "string"]},
+			{"name": "parent", "type": ["null", "Order"]}
+		]
+	}`
+	enc, err := newPayloadEncoder(dfv1.PayloadFormatAvro, schema, "")
+	require.NoError(t, err)
+	createdTS := time.Now().UnixNano()
+	payload, err := enc.encode(rand2.New(rand2.NewSource(42)), 16, createdTS, 7)
+	require.NoError(t, err)
+
+	// the fields are encoded in order, the longs with the zig-zag encoding.
+	b := payload
+	v, n := binary.Varint(b)
+	assert.Equal(t, createdTS, v)
+	b = b[n:]
+	v, n = binary.Varint(b)
+	assert.Equal(t, int64(7), v)
+	b = b[n:]
+	v, n = binary.Varint(b)
+	assert.Equal(t, int64(16), v)
+	assert.Greater(t, len(b), n+16)
+
+	// the same random source generates the same payload.
+	again, err := enc.encode(rand2.New(rand2.NewSource(42)), 16, createdTS, 7)
+	require.NoError(t, err)
+	assert.Equal(t, payload, again)
+}
+
+func TestRawBytesEncoder(t *testing.T) {
+	enc, err := newPayloadEncoder(dfv1.PayloadFormatRawBytes, "", "")
+	require.NoError(t, err)
+	b, err := enc.encode(rand2.New(rand2.NewSource(42)), 32, 1, 1)
+	require.NoError(t, err)
+	assert.Len(t, b, 32)
+	enc, err = newPayloadEncoder(dfv1.PayloadFormatJSON, "", "")
+	assert.NoError(t, err)
+	assert.Nil(t, enc)
+}
+
+func TestParsePayloadSchema_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  dfv1.PayloadFormat
+		schema  string
+		message string
+	}{
+		{name: "unknown format", format: "xml"},
+		{name: "protobuf not base64", format: dfv1.PayloadFormatProtobuf, schema: "!", message: "example.Order"},
+		{name: "protobuf unknown message", format: dfv1.PayloadFormatProtobuf, schema: orderDescriptorSet(t), message: "example.Unknown"},
+		{name: "protobuf not a message", format: dfv1.PayloadFormatProtobuf, schema: orderDescriptorSet(t), message: "example.Status"},
+		{name: "avro not json", format: dfv1.PayloadFormatAvro, schema: "{"},
+		{name: "avro unknown type", format: dfv1.PayloadFormatAvro, schema: `{"type": "record", "name": "A", "fields": [{"name": "b", "type": "B"}]}`},
+		{name: "avro enum without symbols", format: dfv1.PayloadFormatAvro, schema: `{"type": "enum", "name": "E", "symbols": []}`},
+		{name: "avro empty union", format: dfv1.PayloadFormatAvro, schema: `[]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, ParsePayloadSchema(tt.format, tt.schema, tt.message))
+		})
+	}
+}
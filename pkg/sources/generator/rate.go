@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"math"
+	"time"
+)
+
+// RateStep is a step of the rate schedule of the generator.
+type RateStep struct {
+	// RPU is the number of the records generated for every key on a tick at the end of the step.
+	RPU int
+	// Duration is the duration over which the rate changes linearly from the rate at the end of the previous step.
+	Duration time.Duration
+}
+
+// rateShaper shapes the number of the records generated for every key on a tick, from rpu, the ramp-up or the rate
+// schedule, and the rate jitter.
+type rateShaper struct {
+	// rpu is the number of the records generated for every key on a tick.
+	rpu int
+	// jitter is the fraction of rpu the number of the records generated on a tick is randomized within.
+	jitter float64
+	// rampUp is the duration over which the number of the records generated on a tick increases from 0 to rpu.
+	rampUp time.Duration
+	// schedule is the steps the number of the records generated on a tick follows, it replaces the ramp-up.
+	schedule []RateStep
+	// startTime is the time the generator starts, the ramp-up and the schedule start from it.
+	startTime time.Time
+	// randFloat64 returns a random number in [0.0, 1.0) to randomize the rate with the jitter.
+	randFloat64 func() float64
+}
+
+// tickRate returns the number of the records generated for every key on the tick at ts. It is the base rate of the
+// tick randomized within ±jitter of it. The event times of the records are the tick times, they stay monotonically
+// non-decreasing whatever the number of the records on every tick is.
+func (s *rateShaper) tickRate(ts time.Time) int {
+	rate := s.baseRate(ts)
+	if s.jitter > 0 {
+		rate *= 1 + s.jitter*(2*s.randFloat64()-1)
+	}
+	return int(math.Round(rate))
+}
+
+// baseRate returns the number of the records generated for every key on the tick at ts before the rate jitter. It
+// follows the rate schedule, or ramps up linearly from 0 to rpu over the ramp-up duration.
+func (s *rateShaper) baseRate(ts time.Time) float64 {
+	rate := float64(s.rpu)
+	if elapsed := ts.Sub(s.startTime); len(s.schedule) > 0 {
+		rate = s.scheduledRate(elapsed)
+	} else if s.rampUp > 0 && elapsed < s.rampUp {
+		rate = rate * math.Max(float64(elapsed), 0) / float64(s.rampUp)
+	}
+	return rate
+}
+
+// scheduledRate returns the number of the records generated for every key on a tick at the elapsed time of the rate
+// schedule, before the rate jitter.
+func (s *rateShaper) scheduledRate(elapsed time.Duration) float64 {
+	from := float64(s.rpu)
+	for _, step := range s.schedule {
+		to := float64(step.RPU)
+		if elapsed < step.Duration {
+			return from + (to-from)*math.Max(float64(elapsed), 0)/float64(step.Duration)
+		}
+		elapsed -= step.Duration
+		from = to
+	}
+	return from
+}
+
+// maxRPU returns the highest number of the records generated for every key on a tick, before the rate jitter.
+func (s *rateShaper) maxRPU() int {
+	r := s.rpu
+	for _, step := range s.schedule {
+		r = max(r, step.RPU)
+	}
+	return r
+}
+
+// maxTickRecords returns the highest number of the records generated on a tick at the rpu with the key count,
+// including the rate jitter.
+func (s *rateShaper) maxTickRecords(rpu int, keyCount int32) float64 {
+	return math.Ceil(float64(rpu)*(1+s.jitter)) * float64(keyCount)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rateStart = time.Unix(1636470000, 0)
+
+func TestRateShaper_Constant(t *testing.T) {
+	s := &rateShaper{rpu: 10, startTime: rateStart}
+	assert.Equal(t, 10, s.tickRate(rateStart))
+	assert.Equal(t, 10, s.tickRate(rateStart.Add(time.Hour)))
+	assert.Equal(t, 10, s.maxRPU())
+}
+
+func TestRateShaper_RampUp(t *testing.T) {
+	s := &rateShaper{rpu: 100, rampUp: 10 * time.Second, startTime: rateStart}
+	assert.Equal(t, 0, s.tickRate(rateStart))
+	// a tick before the start does not generate a negative number of the records.
+	assert.Equal(t, 0, s.tickRate(rateStart.Add(-time.Second)))
+	assert.Equal(t, 50, s.tickRate(rateStart.Add(5*time.Second)))
+	assert.Equal(t, 100, s.tickRate(rateStart.Add(10*time.Second)))
+	assert.Equal(t, 100, s.tickRate(rateStart.Add(time.Minute)))
+}
+
+func TestRateShaper_Schedule(t *testing.T) {
+	s := &rateShaper{rpu: 10, startTime: rateStart, rampUp: time.Hour, schedule: []RateStep{
+		{RPU: 100, Duration: 10 * time.Second},
+		{RPU: 20, Duration: 4 * time.Second},
+	}}
+	// the schedule replaces the ramp-up.
+	assert.Equal(t, 10, s.tickRate(rateStart))
+	assert.Equal(t, 55, s.tickRate(rateStart.Add(5*time.Second)))
+	assert.Equal(t, 100, s.tickRate(rateStart.Add(10*time.Second)))
+	assert.Equal(t, 60, s.tickRate(rateStart.Add(12*time.Second)))
+	// the last step is held.
+	assert.Equal(t, 20, s.tickRate(rateStart.Add(time.Hour)))
+	assert.Equal(t, 100, s.maxRPU())
+}
+
+func TestRateShaper_Jitter(t *testing.T) {
+	draws := []float64{0, 0.5, 0.999999}
+	i := 0
+	s := &rateShaper{rpu: 100, jitter: 0.2, startTime: rateStart, randFloat64: func() float64 {
+		d := draws[i%len(draws)]
+		i++
+		return d
+	}}
+	// the rate is randomized within ±jitter of the base rate.
+	assert.Equal(t, 80, s.tickRate(rateStart))
+	assert.Equal(t, 100, s.tickRate(rateStart))
+	assert.Equal(t, 120, s.tickRate(rateStart))
+	// the base rate is not randomized.
+	assert.Equal(t, float64(100), s.baseRate(rateStart))
+}
+
+func TestRateShaper_MaxTickRecords(t *testing.T) {
+	s := &rateShaper{jitter: 0.25}
+	assert.Equal(t, float64(13*4), s.maxTickRecords(10, 4))
+	s.jitter = 0
+	assert.Equal(t, float64(40), s.maxTickRecords(10, 4))
+}
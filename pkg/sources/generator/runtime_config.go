@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+// ErrRuntimeUpdateDisabled is returned by UpdateRuntimeConfig when the runtime updates are not enabled in the spec of
+// the generator source.
+var ErrRuntimeUpdateDisabled = errors.New("the runtime updates are not enabled, set runtimeUpdate in the generator source")
+
+// RuntimeConfig is the part of the generator configuration which can be changed at runtime, without restarting the
+// vertex. The fields not set in an update are left unchanged.
+type RuntimeConfig struct {
+	// RPU is the number of the records generated for every key on a tick.
+	RPU *int64 `json:"rpu,omitempty"`
+	// MsgSize is the size of each generated message.
+	MsgSize *int32 `json:"msgSize,omitempty"`
+	// KeyCount is the number of the unique keys.
+	KeyCount *int32 `json:"keyCount,omitempty"`
+}
+
+// Validate validates the fields set in the runtime config.
+func (c RuntimeConfig) Validate() error {
+	if c.RPU != nil && (*c.RPU < 0 || *c.RPU > math.MaxInt32) {
+		return fmt.Errorf("invalid rpu %d, it should be between 0 and %d", *c.RPU, math.MaxInt32)
+	}
+	if c.MsgSize != nil && *c.MsgSize < 0 {
+		return fmt.Errorf("invalid msgSize %d, it should not be negative", *c.MsgSize)
+	}
+	if c.KeyCount != nil && *c.KeyCount <= 0 {
+		return fmt.Errorf("invalid keyCount %d, it should be positive", *c.KeyCount)
+	}
+	return nil
+}
+
+// RuntimeConfig returns the current runtime config of the generator.
+func (mg *memGen) RuntimeConfig() RuntimeConfig {
+	mg.configLock.Lock()
+	defer mg.configLock.Unlock()
+	rpu, msgSize, keyCount := int64(mg.rate.rpu), mg.msgSize, mg.keyCount
+	return RuntimeConfig{RPU: &rpu, MsgSize: &msgSize, KeyCount: &keyCount}
+}
+
+// UpdateRuntimeConfig applies the fields set in the update, the worker picks them up from the next tick. It's only
+// allowed if the runtime updates are enabled, and the rpu and the key count can not exceed the maxima the pending
+// records are sized for when the generator starts.
+func (mg *memGen) UpdateRuntimeConfig(update RuntimeConfig) error {
+	if !mg.runtimeUpdate {
+		return ErrRuntimeUpdateDisabled
+	}
+	if err := update.Validate(); err != nil {
+		return err
+	}
+	mg.configLock.Lock()
+	defer mg.configLock.Unlock()
+	rpu, keyCount := mg.rate.rpu, mg.keyCount
+	if update.RPU != nil {
+		if len(mg.rate.schedule) > 0 {
+			return fmt.Errorf("the rpu can not be changed when the rate schedule is set")
+		}
+		rpu = int(*update.RPU)
+	}
+	if update.KeyCount != nil {
+		keyCount = *update.KeyCount
+	}
+	if rpu > mg.maxRuntimeRPU {
+		return fmt.Errorf("the rpu %d exceeds the maximum %d of the runtime updates", rpu, mg.maxRuntimeRPU)
+	}
+	if keyCount > mg.maxRuntimeKeyCount {
+		return fmt.Errorf("the keyCount %d exceeds the maximum %d of the runtime updates", keyCount, mg.maxRuntimeKeyCount)
+	}
+	if records := mg.rate.maxTickRecords(rpu, keyCount); records > float64(cap(mg.srcChan)) {
+		return fmt.Errorf("the %v records of a tick exceed the %d pending records sized when the generator starts", records, cap(mg.srcChan))
+	}
+	mg.rate.rpu = rpu
+	if update.MsgSize != nil {
+		mg.msgSize = *update.MsgSize
+	}
+	if keyCount != mg.keyCount {
+		mg.keyCount = keyCount
+		mg.keyCountChanged = true
+	}
+	mg.logger.Infow("Updated the runtime config of the generator", zap.Int("rpu", mg.rate.rpu), zap.Int32("msgSize", mg.msgSize), zap.Int32("keyCount", mg.keyCount))
+	return nil
+}
+
+// RuntimeConfigHandler returns the handler serving the runtime config of the generator source, GET returns the current
+// config and POST updates it, which is forbidden if the runtime updates are not enabled. It returns nil if the source
+// is not a generator.
+func RuntimeConfigHandler(src sourcer.SourceReader) http.Handler {
+	mg, ok := src.(*memGen)
+	if !ok {
+		return nil
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			var update RuntimeConfig
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				http.Error(w, fmt.Sprintf("failed to decode the runtime config, %v", err), http.StatusBadRequest)
+				return
+			}
+			if err := mg.UpdateRuntimeConfig(update); errors.Is(err, ErrRuntimeUpdateDisabled) {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			} else if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(mg.RuntimeConfig())
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestUpdateRuntimeConfig(t *testing.T) {
+	mGen := newStoppedMemGen(t, 5, WithRuntimeUpdate(5, 4))
+	assert.Equal(t, RuntimeConfig{RPU: ptr.To[int64](5), MsgSize: ptr.To[int32](8), KeyCount: ptr.To[int32](1)}, mGen.RuntimeConfig())
+
+	assert.NoError(t, mGen.UpdateRuntimeConfig(RuntimeConfig{RPU: ptr.To[int64](3), KeyCount: ptr.To[int32](4)}))
+	assert.Equal(t, RuntimeConfig{RPU: ptr.To[int64](3), MsgSize: ptr.To[int32](8), KeyCount: ptr.To[int32](4)}, mGen.RuntimeConfig())
+	tick := time.Unix(1636470000, 0)
+	assert.Equal(t, 12, runTicks(t, mGen, []time.Time{tick})[tick])
+	assert.Len(t, mGen.keys.seqs, 4)
+
+	// the fields not set are left unchanged.
+	assert.NoError(t, mGen.UpdateRuntimeConfig(RuntimeConfig{MsgSize: ptr.To[int32](100)}))
+	assert.Equal(t, RuntimeConfig{RPU: ptr.To[int64](3), MsgSize: ptr.To[int32](100), KeyCount: ptr.To[int32](4)}, mGen.RuntimeConfig())
+}
+
+func TestUpdateRuntimeConfig_KeyDistribution(t *testing.T) {
+	mGen := newStoppedMemGen(t, 1, WithKeyCount(2), WithKeyDistribution(dfv1.KeyDistributionUniform), WithRuntimeUpdate(1, 8))
+	assert.NoError(t, mGen.UpdateRuntimeConfig(RuntimeConfig{KeyCount: ptr.To[int32](8)}))
+	_, _, keyCount := mGen.tickConfig(time.Now())
+	assert.Equal(t, int32(8), keyCount)
+	assert.Len(t, mGen.keys.seqs, 8)
+	// the keys are drawn from the new key count.
+	seen := make(map[int32]bool)
+	for i := 0; i < 1000; i++ {
+		seen[mGen.keys.pick()] = true
+	}
+	assert.Len(t, seen, 8)
+}
+
+func TestUpdateRuntimeConfig_Disabled(t *testing.T) {
+	mGen := newStoppedMemGen(t, 5)
+	assert.ErrorIs(t, mGen.UpdateRuntimeConfig(RuntimeConfig{MsgSize: ptr.To[int32](16)}), ErrRuntimeUpdateDisabled)
+	assert.Equal(t, RuntimeConfig{RPU: ptr.To[int64](5), MsgSize: ptr.To[int32](8), KeyCount: ptr.To[int32](1)}, mGen.RuntimeConfig())
+	assert.Equal(t, 25, cap(mGen.srcChan))
+}
+
+func TestUpdateRuntimeConfig_Invalid(t *testing.T) {
+	mGen := newStoppedMemGen(t, 5, WithRuntimeUpdate(10, 2))
+	// the pending records are sized for 5 ticks of the maxima.
+	assert.Equal(t, 100, cap(mGen.srcChan))
+	assert.Error(t, mGen.UpdateRuntimeConfig(RuntimeConfig{RPU: ptr.To[int64](-1)}))
+	assert.Error(t, mGen.UpdateRuntimeConfig(RuntimeConfig{MsgSize: ptr.To[int32](-1)}))
+	assert.Error(t, mGen.UpdateRuntimeConfig(RuntimeConfig{KeyCount: ptr.To[int32](0)}))
+	assert.ErrorContains(t, mGen.UpdateRuntimeConfig(RuntimeConfig{RPU: ptr.To[int64](11)}), "the rpu 11 exceeds the maximum 10")
+	assert.ErrorContains(t, mGen.UpdateRuntimeConfig(RuntimeConfig{KeyCount: ptr.To[int32](3)}), "the keyCount 3 exceeds the maximum 2")
+	assert.Equal(t, RuntimeConfig{RPU: ptr.To[int64](5), MsgSize: ptr.To[int32](8), KeyCount: ptr.To[int32](1)}, mGen.RuntimeConfig())
+	assert.NoError(t, mGen.UpdateRuntimeConfig(RuntimeConfig{RPU: ptr.To[int64](10), KeyCount: ptr.To[int32](2)}))
+
+	// the maxima are never below the rate the generator starts with.
+	lowered := newStoppedMemGen(t, 5, WithRuntimeUpdate(1, 1))
+	assert.NoError(t, lowered.UpdateRuntimeConfig(RuntimeConfig{RPU: ptr.To[int64](5)}))
+	assert.Error(t, WithRuntimeUpdate(-1, 1)(&memGen{}))
+	assert.Error(t, WithRuntimeUpdate(1, 0)(&memGen{}))
+
+	scheduled := newStoppedMemGen(t, 5, WithRateSchedule(RateStep{RPU: 10, Duration: time.Minute}), WithRuntimeUpdate(5, 1))
+	assert.ErrorContains(t, scheduled.UpdateRuntimeConfig(RuntimeConfig{RPU: ptr.To[int64](1)}), "rate schedule")
+	assert.NoError(t, scheduled.UpdateRuntimeConfig(RuntimeConfig{MsgSize: ptr.To[int32](16)}))
+}
+
+func TestRuntimeConfigHandler(t *testing.T) {
+	assert.Nil(t, RuntimeConfigHandler(nil))
+	mGen := newStoppedMemGen(t, 5, WithRuntimeUpdate(5, 3))
+	h := RuntimeConfigHandler(mGen)
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, "/generator/config", strings.NewReader(body)))
+		return w
+	}
+	w := serve(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"rpu":5,"msgSize":8,"keyCount":1}`, w.Body.String())
+
+	w = serve(http.MethodPost, `{"rpu":2,"keyCount":3}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"rpu":2,"msgSize":8,"keyCount":3}`, w.Body.String())
+
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, `{"keyCount":0}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, `{"rpu":`).Code)
+	w = serve(http.MethodPut, `{}`)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "GET, POST", w.Header().Get("Allow"))
+
+	// the updates are forbidden unless they are enabled.
+	h = RuntimeConfigHandler(newStoppedMemGen(t, 5))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "").Code)
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, `{"rpu":2}`).Code)
+}
@@ -15,22 +15,33 @@ limitations under the License.
 */
 
 // Package generator contains an implementation of an in-memory generator that generates
-// payloads in json format.
+// payloads in json format, or in protobuf, avro or raw bytes.
 package generator
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math"
 	rand2 "math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	sharedeventtime "github.com/numaproj/numaflow/pkg/shared/eventtime"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
@@ -46,8 +57,45 @@ type Data struct {
 type payload struct {
 	Data      Data
 	Createdts int64
+	// Seq is the per-key sequence number of the payload, it starts at 1 for every key.
+	Seq uint64 `json:"Seq,omitempty"`
+	// Sequence is the sequence number of the record across the keys in the deterministic mode, it starts at 1.
+	Sequence uint64 `json:"Sequence,omitempty"`
 }
 
+// Sequence returns the per-key sequence number embedded in a payload generated by the generator. It returns false
+// if the payload does not carry a sequence number.
+func Sequence(b []byte) (uint64, bool) {
+	var p payload
+	if err := json.Unmarshal(b, &p); err != nil || p.Seq == 0 {
+		return 0, false
+	}
+	return p.Seq, true
+}
+
+// defaultRedeliveryTimeout is the time after which an unacknowledged record is redelivered with the ack tracking.
+const defaultRedeliveryTimeout = 30 * time.Second
+
+// defaultEventTimeStep is the difference of the event times of two consecutive records in the deterministic mode.
+const defaultEventTimeStep = time.Millisecond
+
+// defaultZipfExponent is the exponent of the zipf key distribution.
+const defaultZipfExponent = 1.1
+
+// defaultHotKeyPercentage is the percentage of the records generated with the first key in the hot key distribution.
+const defaultHotKeyPercentage = 50
+
+// defaultEpoch is the event time of the first record in the deterministic mode.
+var defaultEpoch = time.Unix(1640995200, 0) // 2022-01-01T00:00:00Z
+
+// pendingTicks is the number of the ticks of records which can be pending, i.e. generated but not read yet, and also
+// the number of the ticks which can wait for the worker.
+const pendingTicks = 5
+
+// maxPendingRecords bounds the number of the pending records, so that the memory used by a generator with a high rate
+// stays bounded.
+const maxPendingRecords = 1 << 20
+
 // record is payload with offset
 // internal construct of this package
 type record struct {
@@ -55,9 +103,138 @@ type record struct {
 	offset int64
 	key    string
 	ts     int64
+	// ingestionTime is the time when the record is written to the channel.
+	ingestionTime time.Time
+	// duplicate is whether the record is the duplicate of the previous record written to the channel.
+	duplicate bool
+	// headers is the headers of the record.
+	headers map[string]string
+}
+
+// unackedMessage is a message that has been read but not acknowledged yet, it is only kept with the ack tracking.
+type unackedMessage struct {
+	msg *isb.ReadMessage
+	// readAt is the time of the last delivery of the message.
+	readAt time.Time
+	// queued is whether the message is queued for the redelivery.
+	queued bool
+}
+
+// valueTemplateData is the data the value template is rendered with.
+type valueTemplateData struct {
+	// Timestamp is the generation time of the payload in nanoseconds.
+	Timestamp int64
+	// Sequence is the per-key sequence number of the payload, it starts at 1 for every key.
+	Sequence uint64
+	// intn returns the random integers of RandString, the global source is used if it's nil.
+	intn func(int) int
+}
+
+const randStringLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// RandString returns a random alphanumeric string of length n.
+func (d valueTemplateData) RandString(n int) string {
+	intn := rand2.Intn
+	if d.intn != nil {
+		intn = d.intn
+	}
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = randStringLetters[intn(len(randStringLetters))]
+	}
+	return string(b)
+}
+
+// valueTemplateFuncs returns the functions available to the value template, the random values are drawn from rnd, or
+// from the global source if it's nil, and now returns the current time.
+func valueTemplateFuncs(rnd *rand2.Rand, now func() time.Time) template.FuncMap {
+	intn, float64n, newUUID := rand2.Intn, rand2.Float64, uuid.NewString
+	if rnd != nil {
+		intn, float64n = rnd.Intn, rnd.Float64
+		newUUID = func() string {
+			return uuid.Must(uuid.NewRandomFromReader(rnd)).String()
+		}
+	}
+	// walks keeps the current value of every random walk, by its arguments.
+	walks := make(map[[2]float64]float64)
+	return template.FuncMap{
+		// uuid returns a random UUID.
+		"uuid": newUUID,
+		// randInt returns a random integer between min and max, both inclusive.
+		"randInt": func(lo, hi int) (int, error) {
+			if hi < lo {
+				return 0, fmt.Errorf("invalid randInt range, max %d is less than min %d", hi, lo)
+			}
+			return lo + intn(hi-lo+1), nil
+		},
+		"now": now,
+		// sine returns offset + amplitude * sin(2π * t / period) at the current time t, e.g. {{sine "1m" 10 50}}
+		// oscillates between 40 and 60 every minute.
+		"sine": func(period string, amplitude, offset float64) (float64, error) {
+			phase, err := periodPhase(period, now())
+			if err != nil {
+				return 0, fmt.Errorf("invalid sine period, %w", err)
+			}
+			return offset + amplitude*math.Sin(2*math.Pi*phase), nil
+		},
+		// sawtooth returns the value rising linearly from min to max over every period at the current time, e.g.
+		// {{sawtooth "10s" 0 100}} rises by 10 every second and drops back to 0 every 10 seconds.
+		"sawtooth": func(period string, lo, hi float64) (float64, error) {
+			phase, err := periodPhase(period, now())
+			if err != nil {
+				return 0, fmt.Errorf("invalid sawtooth period, %w", err)
+			}
+			return lo + (hi-lo)*phase, nil
+		},
+		// randomWalk returns start on the first call, and then the previous value moved randomly within ±step, e.g.
+		// {{randomWalk 100 1}}. The calls with the same arguments share the walk.
+		"randomWalk": func(start, step float64) float64 {
+			k := [2]float64{start, step}
+			v, ok := walks[k]
+			if !ok {
+				v = start
+			} else {
+				v += step * (2*float64n() - 1)
+			}
+			walks[k] = v
+			return v
+		},
+	}
+}
+
+// periodPhase returns the position of t within the period, which is a duration such as 1m, in [0, 1).
+func periodPhase(period string, t time.Time) (float64, error) {
+	d, err := time.ParseDuration(period)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("period %s must be greater than 0", period)
+	}
+	phase := t.UnixNano() % int64(d)
+	if phase < 0 {
+		phase += int64(d)
+	}
+	return float64(phase) / float64(d), nil
+}
+
+// ParseValueTemplate parses a value template of the generator, with the functions available to it.
+func ParseValueTemplate(text string) (*template.Template, error) {
+	return template.New("valueTemplate").Funcs(valueTemplateFuncs(nil, time.Now)).Parse(text)
+}
+
+// createdTimestamp returns the Createdts field of a payload in JSON, it returns false if there is no such field.
+func createdTimestamp(b []byte) (int64, bool) {
+	var p struct {
+		Createdts *int64
+	}
+	if err := json.Unmarshal(b, &p); err != nil || p.Createdts == nil {
+		return 0, false
+	}
+	return *p.Createdts, true
 }
 
-var recordGenerator = func(size int32, value *uint64, createdTS int64) ([]byte, error) {
+var recordGenerator = func(size int32, value *uint64, createdTS int64, seq uint64) ([]byte, error) {
 	data := Data{}
 	if value != nil {
 		data.Value = *value
@@ -72,26 +249,98 @@ var recordGenerator = func(size int32, value *uint64, createdTS int64) ([]byte,
 		data.Padding = b
 	}
 
-	r := payload{Data: data, Createdts: createdTS}
+	r := payload{Data: data, Createdts: createdTS, Seq: seq}
 	return json.Marshal(r)
 }
 
 type memGen struct {
-	srcChan        chan record                                 // srcChan provides a go channel that supplies generated data
-	rpu            int                                         // rpu - records per time unit
-	keyCount       int32                                       // keyCount is the number of unique keys in the payload
-	value          *uint64                                     // value is the optional uint64 number that can be set in the payload
-	msgSize        int32                                       // msgSize is the size of each generated message
-	timeunit       time.Duration                               // timeunit - ticker will fire once per timeunit
-	genFn          func(int32, *uint64, int64) ([]byte, error) // genFn function that generates a payload as a byte array
-	vertexName     string                                      // name is the name of the source vertex
-	pipelineName   string                                      // pipelineName is the name of the pipeline
-	readTimeout    time.Duration                               // read timeout for the reader
-	vertexInstance *dfv1.VertexInstance                        // vertex instance
+	srcChan        chan record                // srcChan provides a go channel that supplies generated data
+	keyCount       int32                      // keyCount is the number of unique keys in the payload
+	msgSize        int32                      // msgSize is the size of each generated message
+	timeunit       time.Duration              // timeunit - ticker will fire once per timeunit
+	emitEvery      int64                      // emitEvery - records are generated once every emitEvery ticks
+	vertexName     string                     // name is the name of the source vertex
+	pipelineName   string                     // pipelineName is the name of the pipeline
+	idBuilder      isb.SourceMessageIDBuilder // idBuilder builds the stable IDs of the messages
+	readTimeout    time.Duration              // read timeout for the reader
+	vertexInstance *dfv1.VertexInstance       // vertex instance
 	jitter         time.Duration
+	futureJitter   time.Duration
+	stopGenerating context.CancelFunc // stopGenerating stops the generator
+	clock          clock.Clock        // clock drives the ticks, the read timeout and the offsets
+	lastOffset     int64              // lastOffset is the offset of the last generated record
+	tombstonePct   int64              // tombstonePct is the percentage of the records generated as tombstones
+	generated      int64              // generated is the number of the generated records, including the tombstones
 	logger         *zap.SugaredLogger
+	// timePolicy is applied to the records with an invalid event time
+	timePolicy *sharedeventtime.Policy
+	// rate shapes the number of the records generated for every key on a tick, its rpu is guarded by configLock.
+	rate rateShaper
+	// keys generates the keys of the records.
+	keys keyGenerator
+	// payload generates the payloads and the headers of the records.
+	payload payloadGenerator
+	// timeField is the path of the field of the JSON payload the event time is read from, the event time is the
+	// generation time if it is empty.
+	timeField []string
+	// timeUnit is the unit of the time field.
+	timeUnit dfv1.EventTimeUnit
+	// ackTracking is whether the unacknowledged messages are redelivered.
+	ackTracking bool
+	// redeliveryTimeout is the time after which an unacknowledged message is redelivered.
+	redeliveryTimeout time.Duration
+	// unackedLock guards unacked and redeliveries.
+	unackedLock sync.Mutex
+	// unacked is the messages read but not acknowledged yet, keyed by the offsets.
+	unacked map[int64]*unackedMessage
+	// redeliveries is the offsets of the unacked messages queued for the redelivery, in the order of the offsets.
+	redeliveries []int64
+	// stopRedelivering stops the redelivery loop.
+	stopRedelivering context.CancelFunc
+	// deterministic is whether the records are generated in the deterministic mode, in which the keys, the offsets,
+	// the event times and the payloads are derived from the sequence and the seed, so that they are reproducible.
+	deterministic bool
+	// seed is the seed of the deterministic mode.
+	seed int64
+	// seededRand generates the padding of the payloads and the random values of the value template in the
+	// deterministic mode.
+	seededRand *rand2.Rand
+	// sequence is the sequence number of the last generated record in the deterministic mode.
+	sequence atomic.Uint64
+	// epoch is the event time of the first record in the deterministic mode.
+	epoch time.Time
+	// eventTimeStep is the difference of the event times of two consecutive records in the deterministic mode.
+	eventTimeStep time.Duration
+	// idleThreshold is the duration without any record read after which the generator is idle, 0 means never.
+	idleThreshold time.Duration
+	// latePct is the percentage of the records whose event time is moved back by lateBy.
+	latePct int64
+	// lateBy is the duration the event time of the late records is moved back by.
+	lateBy time.Duration
+	// read is the number of the records read with a valid event time, the late records are spread evenly among them.
+	read int64
+	// duplicatePct is the percentage of the records written to the channel again as duplicates.
+	duplicatePct int64
+	// written is the number of the records written to the channel, the duplicates are spread evenly among them.
+	written int64
+	// lastRead is the message of the last record read, the duplicate records are read as copies of it.
+	lastRead *isb.ReadMessage
+	// configLock guards the rpu of the rate, msgSize, keyCount and keyCountChanged, which can be updated at runtime.
+	configLock sync.Mutex
+	// keyCountChanged is whether the key count is updated at runtime, the worker resizes the keys on the next tick.
+	keyCountChanged bool
+	// runtimeUpdate is whether the runtime config can be updated.
+	runtimeUpdate bool
+	// maxRuntimeRPU is the highest rpu the runtime config can be updated to.
+	maxRuntimeRPU int
+	// maxRuntimeKeyCount is the highest key count the runtime config can be updated to.
+	maxRuntimeKeyCount int32
 }
 
+var _ sourcer.ProducerStopper = (*memGen)(nil)
+var _ sourcer.Drainer = (*memGen)(nil)
+var _ sourcer.IdleReporter = (*memGen)(nil)
+
 type Option func(*memGen) error
 
 // WithReadTimeout sets the read timeout for the reader.
@@ -102,6 +351,315 @@ func WithReadTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithClock sets the clock of the generator, it is meant for the tests.
+func WithClock(c clock.Clock) Option {
+	return func(o *memGen) error {
+		o.clock = c
+		return nil
+	}
+}
+
+// WithRateJitter randomizes the number of the records generated on every tick within ±fraction of rpu, the fraction
+// should be between 0 and 1.
+func WithRateJitter(fraction float64) Option {
+	return func(o *memGen) error {
+		if fraction < 0 || fraction > 1 {
+			return fmt.Errorf("invalid rate jitter %v, it should be between 0 and 1", fraction)
+		}
+		o.rate.jitter = fraction
+		return nil
+	}
+}
+
+// WithRampUp linearly increases the number of the records generated on every tick from 0 to rpu over the given
+// duration after the generator starts.
+func WithRampUp(d time.Duration) Option {
+	return func(o *memGen) error {
+		if d < 0 {
+			return fmt.Errorf("invalid ramp-up %v, it should not be negative", d)
+		}
+		o.rate.rampUp = d
+		return nil
+	}
+}
+
+// WithRateSchedule changes the number of the records generated on every tick over time, following the steps after the
+// generator starts. Over the duration of a step, the rate changes linearly from the rate at the end of the previous
+// step, or rpu for the first step, to the rpu of the step, a step with an unchanged rpu holds the rate. The rate of
+// the last step is held after the schedule. It replaces the ramp-up.
+func WithRateSchedule(steps ...RateStep) Option {
+	return func(o *memGen) error {
+		for i, step := range steps {
+			if step.RPU < 0 {
+				return fmt.Errorf("invalid rpu %d of the rate schedule step %d, it should not be negative", step.RPU, i)
+			}
+			if step.Duration <= 0 {
+				return fmt.Errorf("invalid duration %v of the rate schedule step %d, it should be positive", step.Duration, i)
+			}
+		}
+		o.rate.schedule = steps
+		return nil
+	}
+}
+
+// WithIdleThreshold sets the duration without any record read after which the generator is idle, and the idle
+// watermarks are published. The idle watermarks are not published if it's 0.
+func WithIdleThreshold(d time.Duration) Option {
+	return func(o *memGen) error {
+		if d < 0 {
+			return fmt.Errorf("invalid idle threshold %v, it should not be negative", d)
+		}
+		o.idleThreshold = d
+		return nil
+	}
+}
+
+// WithLateRecords moves the event time of the given percentage of the records back by lateBy, which simulates the out
+// of order and late data. The late records are spread evenly, and they are marked as late so that they do not hold the
+// source watermark back, i.e. they are behind the watermark unless lateBy is within the max delay of the watermark.
+func WithLateRecords(percentage int, lateBy time.Duration) Option {
+	return func(o *memGen) error {
+		if percentage < 0 || percentage > 100 {
+			return fmt.Errorf("invalid late percentage %d, it should be between 0 and 100", percentage)
+		}
+		if percentage > 0 && lateBy <= 0 {
+			return fmt.Errorf("invalid late duration %v, it should be positive", lateBy)
+		}
+		o.latePct = int64(percentage)
+		o.lateBy = lateBy
+		return nil
+	}
+}
+
+// WithDuplicates writes the given percentage of the records again right after them, with the same offsets, payloads,
+// keys and event times, i.e. the same message IDs, which simulates the duplicates of an at-least-once upstream to
+// validate the deduplication and the exactly-once forwarding. The duplicates are spread evenly.
+func WithDuplicates(percentage int) Option {
+	return func(o *memGen) error {
+		if percentage < 0 || percentage > 100 {
+			return fmt.Errorf("invalid duplicate percentage %d, it should be between 0 and 100", percentage)
+		}
+		o.duplicatePct = int64(percentage)
+		return nil
+	}
+}
+
+// WithHeaders sets the headers of the records. The values are Go templates rendered for every record with the data
+// and the functions of the value template, a value without any action is static. The templates are rendered once to
+// validate them, like the value template.
+func WithHeaders(headers map[string]string) Option {
+	return func(o *memGen) error {
+		for name, value := range headers {
+			if name == "" {
+				return fmt.Errorf("invalid header, the name should not be empty")
+			}
+			tmpl, err := ParseValueTemplate(value)
+			if err != nil {
+				return fmt.Errorf("failed to parse the template of the header %s, %w", name, err)
+			}
+			if err := tmpl.Execute(&strings.Builder{}, valueTemplateData{Timestamp: 1, Sequence: 1}); err != nil {
+				return fmt.Errorf("failed to render the template of the header %s, %w", name, err)
+			}
+			if !strings.Contains(value, "{{") {
+				if o.payload.staticHeaders == nil {
+					o.payload.staticHeaders = make(map[string]string)
+				}
+				o.payload.staticHeaders[name] = value
+				continue
+			}
+			o.payload.headerTemplates = append(o.payload.headerTemplates, headerTemplate{name: name, tmpl: tmpl})
+		}
+		sort.Slice(o.payload.headerTemplates, func(i, j int) bool { return o.payload.headerTemplates[i].name < o.payload.headerTemplates[j].name })
+		return nil
+	}
+}
+
+// WithAckTracking enables the at-least-once delivery of the records. The records read but not acknowledged within
+// the redelivery timeout are read again, with the same offsets and event times so that the message IDs stay stable.
+func WithAckTracking(enabled bool) Option {
+	return func(o *memGen) error {
+		o.ackTracking = enabled
+		return nil
+	}
+}
+
+// WithRedeliveryTimeout sets the time after which an unacknowledged record is redelivered, it only applies with the
+// ack tracking.
+func WithRedeliveryTimeout(timeout time.Duration) Option {
+	return func(o *memGen) error {
+		if timeout <= 0 {
+			return fmt.Errorf("invalid redelivery timeout %v, it should be positive", timeout)
+		}
+		o.redeliveryTimeout = timeout
+		return nil
+	}
+}
+
+// WithDeterministicSeed generates the records in the deterministic mode, so that two generators with the same seed
+// and configuration generate the same records. Every record takes the next number of a sequence starting at 1, the
+// sequence is the offset of the record, i.e. it is part of the message ID, and is embedded in the payload as the
+// Sequence field. The key of a record is key-<sequence % key count>, and the event time of a record is the epoch
+// plus the event time step for every record before it. The padding of the payloads, the random values of the value
+// template, the event time jitter and the keys drawn from a key distribution are generated from the seed, and now of
+// the value template returns the event time of the record. The payloads from the value blob are used as is.
+func WithDeterministicSeed(seed int64) Option {
+	return func(o *memGen) error {
+		o.deterministic = true
+		o.seed = seed
+		o.seededRand = rand2.New(rand2.NewSource(seed))
+		return nil
+	}
+}
+
+// WithRuntimeUpdate enables the updates of the runtime config, the rpu and the key count can be raised up to the
+// maxima, which the pending records are sized for. The maxima are never below the rpu and the key count the generator
+// starts with.
+func WithRuntimeUpdate(maxRPU int, maxKeyCount int32) Option {
+	return func(o *memGen) error {
+		if maxRPU < 0 {
+			return fmt.Errorf("invalid max rpu %d, it should not be negative", maxRPU)
+		}
+		if maxKeyCount <= 0 {
+			return fmt.Errorf("invalid max key count %d, it should be positive", maxKeyCount)
+		}
+		o.runtimeUpdate = true
+		o.maxRuntimeRPU = maxRPU
+		o.maxRuntimeKeyCount = maxKeyCount
+		return nil
+	}
+}
+
+// WithKeyCount sets the number of the unique keys, it overrides the key count of the generator source.
+func WithKeyCount(n int) Option {
+	return func(o *memGen) error {
+		if n <= 0 || n > math.MaxInt32 {
+			return fmt.Errorf("invalid key count %d, it should be positive", n)
+		}
+		o.keyCount = int32(n)
+		return nil
+	}
+}
+
+// WithEventTimeStep sets the difference of the event times of two consecutive records in the deterministic mode, it
+// is 1ms by default.
+func WithEventTimeStep(d time.Duration) Option {
+	return func(o *memGen) error {
+		if d <= 0 {
+			return fmt.Errorf("invalid event time step %v, it should be positive", d)
+		}
+		o.eventTimeStep = d
+		return nil
+	}
+}
+
+// WithEpoch sets the event time of the first record in the deterministic mode, it is 2022-01-01T00:00:00Z by default.
+func WithEpoch(t time.Time) Option {
+	return func(o *memGen) error {
+		if t.UnixNano() <= 0 {
+			return fmt.Errorf("invalid epoch %v, it should be after the Unix epoch", t)
+		}
+		o.epoch = t
+		return nil
+	}
+}
+
+// WithTimeField reads the event time from the field of the JSON payload, the nested fields are separated by dots,
+// e.g. meta.ts. The time policy is applied if the field is missing or can not be parsed.
+func WithTimeField(name string) Option {
+	return func(o *memGen) error {
+		if name == "" {
+			return fmt.Errorf("invalid time field, it should not be empty")
+		}
+		o.timeField = strings.Split(name, ".")
+		return nil
+	}
+}
+
+// WithTimeUnit sets the unit of the time field, it is nanoseconds by default.
+func WithTimeUnit(unit dfv1.EventTimeUnit) Option {
+	return func(o *memGen) error {
+		switch unit {
+		case dfv1.EventTimeUnitNanos, dfv1.EventTimeUnitMillis, dfv1.EventTimeUnitSeconds, dfv1.EventTimeUnitRFC3339:
+			o.timeUnit = unit
+			return nil
+		default:
+			return fmt.Errorf("unsupported time unit %q", unit)
+		}
+	}
+}
+
+// WithKeyDistribution draws the key of every record from the distribution instead of generating the records for every
+// key in turn, the number of the records generated on a tick stays the same.
+func WithKeyDistribution(dist dfv1.KeyDistribution) Option {
+	return func(o *memGen) error {
+		switch dist {
+		case dfv1.KeyDistributionRoundRobin, dfv1.KeyDistributionUniform, dfv1.KeyDistributionZipf, dfv1.KeyDistributionHotKey:
+			o.keys.distribution = dist
+			return nil
+		default:
+			return fmt.Errorf("unsupported key distribution %q", dist)
+		}
+	}
+}
+
+// WithZipfExponent sets the exponent of the zipf key distribution, it is 1.1 by default. The larger it is, the more
+// the records are skewed to the first keys.
+func WithZipfExponent(s float64) Option {
+	return func(o *memGen) error {
+		if s <= 1 {
+			return fmt.Errorf("invalid zipf exponent %v, it should be greater than 1", s)
+		}
+		o.keys.zipfExponent = s
+		return nil
+	}
+}
+
+// WithHotKeyPercentage sets the percentage of the records generated with the first key in the hot key distribution,
+// it is 50 by default.
+func WithHotKeyPercentage(percentage int) Option {
+	return func(o *memGen) error {
+		if percentage < 0 || percentage > 100 {
+			return fmt.Errorf("invalid hot key percentage %d, it should be between 0 and 100", percentage)
+		}
+		o.keys.hotKeyPct = percentage
+		return nil
+	}
+}
+
+// WithPayloadFormat generates the payloads in the format, see GeneratorSource.PayloadFormat for the values of the
+// fields. The schema is the JSON of the Avro schema for avro, or the base64 encoded FileDescriptorSet for protobuf, in
+// which case the message is the full name of the protobuf message. It overrides the value blob and the value template.
+func WithPayloadFormat(format dfv1.PayloadFormat, schema, message string) Option {
+	return func(o *memGen) error {
+		enc, err := newPayloadEncoder(format, schema, message)
+		if err != nil {
+			return fmt.Errorf("failed to parse the payload schema, %w", err)
+		}
+		o.payload.encoder = enc
+		return nil
+	}
+}
+
+// WithValueTemplate generates the payloads by rendering a Go template, see GeneratorSource.ValueTemplate for the data
+// available to the template. The template is rendered once to validate it, an invalid template is returned as an
+// error instead of failing the generation of every payload.
+func WithValueTemplate(text string) Option {
+	return func(o *memGen) error {
+		tmpl, err := ParseValueTemplate(text)
+		if err != nil {
+			return fmt.Errorf("failed to parse the value template, %w", err)
+		}
+		if err := tmpl.Execute(&strings.Builder{}, valueTemplateData{Timestamp: 1, Sequence: 1}); err != nil {
+			return fmt.Errorf("failed to render the value template, %w", err)
+		}
+		o.payload.valueTemplate = tmpl
+		o.payload.genFn = o.payload.renderedRecord
+		o.payload.createdTSFromPayload = strings.Contains(text, "Createdts")
+		o.payload.customPayload = true
+		return nil
+	}
+}
+
 // NewMemGen function creates an instance of generator source reader.
 func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
 
@@ -124,6 +682,10 @@ func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ..
 	if vertexInstance.Vertex.Spec.Source.Generator.KeyCount != nil {
 		keyCount = *(vertexInstance.Vertex.Spec.Source.Generator.KeyCount)
 	}
+	emitEvery := int64(1)
+	if vertexInstance.Vertex.Spec.Source.Generator.EmitEvery != nil && *vertexInstance.Vertex.Spec.Source.Generator.EmitEvery > 1 {
+		emitEvery = int64(*vertexInstance.Vertex.Spec.Source.Generator.EmitEvery)
+	}
 	var value *uint64
 	if vertexInstance.Vertex.Spec.Source.Generator.Value != nil {
 		value = vertexInstance.Vertex.Spec.Source.Generator.Value
@@ -133,6 +695,18 @@ func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ..
 	if vertexInstance.Vertex.Spec.Source.Generator.Jitter != nil {
 		jitter = vertexInstance.Vertex.Spec.Source.Generator.Jitter.Duration
 	}
+	var futureJitter time.Duration
+	if vertexInstance.Vertex.Spec.Source.Generator.FutureJitter != nil {
+		futureJitter = vertexInstance.Vertex.Spec.Source.Generator.FutureJitter.Duration
+	}
+
+	var tombstonePct int64
+	if vertexInstance.Vertex.Spec.Source.Generator.TombstonePercentage != nil {
+		tombstonePct = int64(*vertexInstance.Vertex.Spec.Source.Generator.TombstonePercentage)
+	}
+
+	timePolicy := sharedeventtime.NewPolicy(vertexInstance.Vertex.Spec.Source.Generator.GetOnInvalidEventTime(),
+		vertexInstance.Vertex.Spec.Name, vertexInstance.Vertex.Spec.PipelineName)
 
 	var genFunction = recordGenerator
 	if vertexInstance.Vertex.Spec.Source.Generator.ValueBlob != nil {
@@ -145,25 +719,119 @@ func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ..
 
 		// Custom generator function to return provided value. Input arguments are not used, but are preserved
 		// to maintain consistent interface with recordGenerator
-		genFunction = func(size int32, value *uint64, createdTS int64) ([]byte, error) {
+		genFunction = func(size int32, value *uint64, createdTS int64, seq uint64) ([]byte, error) {
 			return emitBytes, nil
 		}
 	}
 
+	if vertexInstance.Vertex.Spec.Source.Generator.ValueTemplate != nil {
+		logger.Info("ValueTemplate was set, rendering the template instead of randomly generated data")
+		opts = append([]Option{WithValueTemplate(*vertexInstance.Vertex.Spec.Source.Generator.ValueTemplate)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.EventTimeField; x != nil {
+		opts = append([]Option{WithTimeField(*x), WithTimeUnit(vertexInstance.Vertex.Spec.Source.Generator.GetEventTimeUnit())}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.RuntimeUpdate; x != nil {
+		maxRPU, maxKeyCount := rpu, keyCount
+		if x.MaxRPU != nil {
+			maxRPU = int(*x.MaxRPU)
+		}
+		if x.MaxKeyCount != nil {
+			maxKeyCount = *x.MaxKeyCount
+		}
+		opts = append([]Option{WithRuntimeUpdate(maxRPU, maxKeyCount)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.RateJitterPercentage; x != nil {
+		opts = append([]Option{WithRateJitter(float64(*x) / 100)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.RampUp; x != nil {
+		opts = append([]Option{WithRampUp(x.Duration)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.RateSchedule; len(x) > 0 {
+		steps := make([]RateStep, 0, len(x))
+		for _, step := range x {
+			var d time.Duration
+			if step.Duration != nil {
+				d = step.Duration.Duration
+			}
+			steps = append(steps, RateStep{RPU: int(step.RPU), Duration: d})
+		}
+		opts = append([]Option{WithRateSchedule(steps...)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.IdleThreshold; x != nil {
+		opts = append([]Option{WithIdleThreshold(x.Duration)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.LatePercentage; x != nil {
+		var lateBy time.Duration
+		if vertexInstance.Vertex.Spec.Source.Generator.LateBy != nil {
+			lateBy = vertexInstance.Vertex.Spec.Source.Generator.LateBy.Duration
+		}
+		opts = append([]Option{WithLateRecords(int(*x), lateBy)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.Headers; len(x) > 0 {
+		opts = append([]Option{WithHeaders(x)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.DuplicatePercentage; x != nil {
+		opts = append([]Option{WithDuplicates(int(*x))}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.PayloadFormat; x != nil {
+		var schema, message string
+		if vertexInstance.Vertex.Spec.Source.Generator.PayloadSchema != nil {
+			schema = *vertexInstance.Vertex.Spec.Source.Generator.PayloadSchema
+		}
+		if vertexInstance.Vertex.Spec.Source.Generator.PayloadMessage != nil {
+			message = *vertexInstance.Vertex.Spec.Source.Generator.PayloadMessage
+		}
+		opts = append([]Option{WithPayloadFormat(*x, schema, message)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.KeyDistribution; x != nil {
+		opts = append([]Option{WithKeyDistribution(*x)}, opts...)
+	}
+	if vertexInstance.Vertex.Spec.Source.Generator.ZipfExponent != nil {
+		s, err := vertexInstance.Vertex.Spec.Source.Generator.GetZipfExponent()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the zipf exponent, %w", err)
+		}
+		opts = append([]Option{WithZipfExponent(s)}, opts...)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.HotKeyPercentage; x != nil {
+		opts = append([]Option{WithHotKeyPercentage(int(*x))}, opts...)
+	}
+
 	genSrc := &memGen{
-		rpu:            rpu,
 		keyCount:       keyCount,
-		value:          value,
 		msgSize:        msgSize,
 		timeunit:       timeunit,
+		emitEvery:      emitEvery,
 		vertexName:     vertexInstance.Vertex.Spec.Name,
 		pipelineName:   vertexInstance.Vertex.Spec.PipelineName,
-		genFn:          genFunction,
+		idBuilder:      isb.NewSourceMessageIDBuilder(vertexInstance.Vertex.Spec.PipelineName, vertexInstance.Vertex.Spec.Name),
 		vertexInstance: vertexInstance,
-		srcChan:        make(chan record, rpu*int(keyCount)*5),
+		tombstonePct:   tombstonePct,
+		timePolicy:     timePolicy,
 		readTimeout:    3 * time.Second, // default timeout
 		jitter:         jitter,
+		futureJitter:   futureJitter,
+		timeUnit:       dfv1.EventTimeUnitNanos,
+		clock:          clock.RealClock(),
 		logger:         logger,
+		epoch:          defaultEpoch,
+		eventTimeStep:  defaultEventTimeStep,
+
+		rate: rateShaper{rpu: rpu},
+		keys: keyGenerator{
+			distribution: dfv1.KeyDistributionRoundRobin,
+			zipfExponent: defaultZipfExponent,
+			hotKeyPct:    defaultHotKeyPercentage,
+		},
+		payload: payloadGenerator{
+			value:         value,
+			genFn:         genFunction,
+			customPayload: vertexInstance.Vertex.Spec.Source.Generator.ValueBlob != nil,
+		},
+
+		redeliveryTimeout: defaultRedeliveryTimeout,
+		unacked:           make(map[int64]*unackedMessage),
 	}
 
 	for _, o := range opts {
@@ -171,9 +839,39 @@ func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ..
 			return nil, err
 		}
 	}
+	if genSrc.runtimeUpdate {
+		genSrc.maxRuntimeRPU = max(genSrc.maxRuntimeRPU, genSrc.rate.maxRPU())
+		genSrc.maxRuntimeKeyCount = max(genSrc.maxRuntimeKeyCount, genSrc.keyCount)
+	}
+	// the pending records are sized for the highest rate, including the one the runtime config can be updated to.
+	pending := genSrc.rate.maxTickRecords(max(genSrc.rate.maxRPU(), genSrc.maxRuntimeRPU), max(genSrc.keyCount, genSrc.maxRuntimeKeyCount)) * pendingTicks
+	genSrc.srcChan = make(chan record, int(math.Min(pending, maxPendingRecords)))
+	// the random sources depend on the deterministic mode, which might be set by the later options.
+	genSrc.rate.randFloat64 = genSrc.randFloat64
+	genSrc.keys.rnd = genSrc.newRand()
+	genSrc.keys.resize(genSrc.keyCount)
+	genSrc.payload.intn = genSrc.randIntn
+	if genSrc.deterministic && !genSrc.payload.customPayload {
+		genSrc.payload.genFn = genSrc.deterministicRecord
+	}
+	// the functions depend on the clock and the deterministic mode, which might be set by the later options.
+	genSrc.payload.templateFuncs(genSrc.valueTemplateFuncs())
+	if genSrc.payload.encoder != nil {
+		genSrc.payload.rnd = genSrc.newRand()
+		genSrc.payload.genFn = genSrc.payload.encodedRecord
+	}
 
 	// start the generator
-	go genSrc.generator(ctx, genSrc.rpu, genSrc.timeunit)
+	genCtx, cancel := context.WithCancel(ctx)
+	genSrc.stopGenerating = cancel
+	genSrc.rate.startTime = genSrc.clock.Now()
+	go genSrc.generator(genCtx, genSrc.timeunit)
+	if genSrc.ackTracking {
+		// the unacknowledged records are redelivered even after stopping producing, until the generator is closed.
+		redeliveryCtx, cancel := context.WithCancel(ctx)
+		genSrc.stopRedelivering = cancel
+		go genSrc.redeliveryLoop(redeliveryCtx)
+	}
 
 	return genSrc, nil
 }
@@ -188,12 +886,22 @@ func (mg *memGen) Partitions(context.Context) []int32 {
 	return []int32{mg.vertexInstance.Replica}
 }
 
+// Read reads the generated records. With the ack tracking, the records queued for the redelivery are read first, and
+// the records read are tracked until they are acknowledged, so that they are reprocessed if the forwarder fails to
+// write them.
 func (mg *memGen) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
 	msgs := make([]*isb.ReadMessage, 0, count)
+	if mg.ackTracking {
+		msgs = append(msgs, mg.redeliver(count)...)
+		if int64(len(msgs)) == count {
+			return msgs, nil
+		}
+	}
+	redelivered := len(msgs)
 	// timeout should not be re-triggered for every run of the for loop. it is for the entire Read() call.
-	timeout := time.After(mg.readTimeout)
+	timeout := mg.clock.After(mg.readTimeout)
 loop:
-	for i := int64(0); i < count; i++ {
+	for i := int64(redelivered); i < count; i++ {
 		// since the Read call is blocking, and runs in an infinite loop,
 		// we implement Read With Wait semantics
 		select {
@@ -202,28 +910,173 @@ loop:
 				mg.logger.Info("All the messages have been read. returning.")
 				break loop
 			}
-			msgs = append(msgs, mg.newReadMessage(r.key, r.data, r.offset, r.ts))
+			if r.duplicate {
+				// the duplicate is read right after its original record, it's the same message unless the original
+				// one was dropped.
+				if mg.lastRead != nil {
+					dup := *mg.lastRead
+					msgs = append(msgs, &dup)
+				}
+				continue
+			}
+			msg, err := mg.newReadMessage(r.key, r.data, r.offset, r.ts, r.ingestionTime)
+			if err != nil {
+				// the error policy of the invalid event time fails the read batch.
+				return nil, err
+			}
+			mg.lastRead = msg
+			if msg != nil {
+				msg.Headers = r.headers
+				msgs = append(msgs, msg)
+			}
 		case <-timeout:
 			break loop
 		}
 	}
+	if mg.ackTracking {
+		mg.track(msgs[redelivered:])
+	}
 	return msgs, nil
 }
 
+// Pending returns the number of the generated records not read yet, and the records queued for the redelivery with
+// the ack tracking, so that the generator vertices can be autoscaled.
 func (mg *memGen) Pending(_ context.Context) (int64, error) {
-	return isb.PendingNotAvailable, nil
+	pending := int64(len(mg.srcChan))
+	if mg.ackTracking {
+		mg.unackedLock.Lock()
+		for _, offset := range mg.redeliveries {
+			// the messages acknowledged after being queued are not redelivered.
+			if _, ok := mg.unacked[offset]; ok {
+				pending++
+			}
+		}
+		mg.unackedLock.Unlock()
+	}
+	tickgenPending.WithLabelValues(mg.vertexName, mg.pipelineName, strconv.Itoa(int(mg.vertexInstance.Replica))).Set(float64(pending))
+	return pending, nil
 }
 
-// Ack acknowledges an array of offset.
+// Ack acknowledges an array of offset. It is a no-op without the ack tracking.
 func (mg *memGen) Ack(_ context.Context, offsets []isb.Offset) []error {
-	return make([]error, len(offsets))
+	errs := make([]error, len(offsets))
+	if !mg.ackTracking {
+		return errs
+	}
+	mg.unackedLock.Lock()
+	defer mg.unackedLock.Unlock()
+	for i, offset := range offsets {
+		seq, err := offset.Sequence()
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to get the sequence of the offset %s, %w", offset.String(), err)
+			continue
+		}
+		// a message queued for the redelivery is skipped when it is dequeued.
+		delete(mg.unacked, seq)
+	}
+	return errs
+}
+
+// IsEmpty returns whether all the generated records have been read and, with the ack tracking, acknowledged.
+func (mg *memGen) IsEmpty() bool {
+	mg.unackedLock.Lock()
+	defer mg.unackedLock.Unlock()
+	return len(mg.srcChan) == 0 && len(mg.unacked) == 0
+}
+
+// IdleThreshold returns the duration without any record read after which the generator is idle.
+func (mg *memGen) IdleThreshold() time.Duration {
+	return mg.idleThreshold
+}
+
+// track keeps the messages read for the first time until they are acknowledged.
+func (mg *memGen) track(msgs []*isb.ReadMessage) {
+	now := mg.clock.Now()
+	mg.unackedLock.Lock()
+	defer mg.unackedLock.Unlock()
+	for _, msg := range msgs {
+		seq, err := msg.ReadOffset.Sequence()
+		if err != nil {
+			// the offsets of the generator are always sequences.
+			mg.logger.Errorw("Failed to track a message, it will not be redelivered", zap.String("offset", msg.ReadOffset.String()), zap.Error(err))
+			continue
+		}
+		mg.unacked[seq] = &unackedMessage{msg: msg, readAt: now}
+	}
+}
+
+// redeliver returns up to count messages queued for the redelivery. The messages keep their original offsets and
+// event times.
+func (mg *memGen) redeliver(count int64) []*isb.ReadMessage {
+	now := mg.clock.Now()
+	mg.unackedLock.Lock()
+	defer mg.unackedLock.Unlock()
+	var msgs []*isb.ReadMessage
+	for len(mg.redeliveries) > 0 && int64(len(msgs)) < count {
+		offset := mg.redeliveries[0]
+		mg.redeliveries = mg.redeliveries[1:]
+		u, ok := mg.unacked[offset]
+		if !ok {
+			// acknowledged after being queued.
+			continue
+		}
+		u.queued = false
+		u.readAt = now
+		// the forwarder can update the message it reads, every delivery gets its own copy.
+		msg := *u.msg
+		msgs = append(msgs, &msg)
+	}
+	return msgs
+}
+
+// redeliveryLoop periodically queues the messages not acknowledged within the redelivery timeout for the redelivery.
+func (mg *memGen) redeliveryLoop(ctx context.Context) {
+	ticker := mg.clock.NewTicker(mg.redeliveryTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			mg.queueExpired()
+		}
+	}
+}
+
+// queueExpired queues the messages not acknowledged within the redelivery timeout for the redelivery.
+func (mg *memGen) queueExpired() {
+	now := mg.clock.Now()
+	mg.unackedLock.Lock()
+	defer mg.unackedLock.Unlock()
+	var expired []int64
+	for offset, u := range mg.unacked {
+		if !u.queued && now.Sub(u.readAt) >= mg.redeliveryTimeout {
+			u.queued = true
+			expired = append(expired, offset)
+		}
+	}
+	if len(expired) == 0 {
+		return
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i] < expired[j] })
+	mg.redeliveries = append(mg.redeliveries, expired...)
+	mg.logger.Infow("Redelivering the unacknowledged messages", zap.Int("count", len(expired)), zap.Duration("redeliveryTimeout", mg.redeliveryTimeout))
+}
+
+// StopProducing stops generating new records, the records already generated can still be read.
+func (mg *memGen) StopProducing() {
+	mg.stopGenerating()
 }
 
 func (mg *memGen) Close() error {
+	mg.stopGenerating()
+	if mg.stopRedelivering != nil {
+		mg.stopRedelivering()
+	}
 	return nil
 }
 
-func (mg *memGen) newWorker(ctx context.Context, rate int) func(chan time.Time, chan struct{}) {
+func (mg *memGen) newWorker(ctx context.Context) func(chan time.Time, chan struct{}) {
 	return func(tickChan chan time.Time, done chan struct{}) {
 		defer func() {
 			// empty any pending ticks
@@ -237,55 +1090,238 @@ func (mg *memGen) newWorker(ctx context.Context, rate int) func(chan time.Time,
 		defer close(done)
 		defer close(mg.srcChan)
 
+		// the records are generated on the first tick, and then on every emitEvery-th tick.
+		ticks := int64(0)
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case ts := <-tickChan:
+				ticks++
+				if (ticks-1)%mg.emitEvery != 0 {
+					continue
+				}
 				// we would generate all the keys in a round robin fashion
 				// even if there are multiple pods, all the pods will generate same keys in the same order.
 				// TODO: alternatively, we could also think about generating a subset of keys per pod.
 				t := ts.UnixNano()
+				rate, msgSize, keyCount := mg.tickConfig(ts)
+				skipped := 0
 				for i := 0; i < rate; i++ {
-					for k := int32(0); k < mg.keyCount; k++ {
-						key := fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, k)
-						d, err := mg.genFn(mg.msgSize, mg.value, t)
+					for k := int32(0); k < keyCount; k++ {
+						// the worker is the only writer of srcChan, a record is skipped rather than blocking the
+						// worker when srcChan is full, i.e. when the records are not read as fast as they are
+						// generated. the record is skipped before it takes a sequence number or an offset.
+						if len(mg.srcChan) == cap(mg.srcChan) {
+							skipped++
+							continue
+						}
+						keyIdx := mg.keys.next(k)
+						key, offset, ts := fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, keyIdx), int64(0), t
+						if mg.deterministic {
+							// every record, including a tombstone, takes a sequence number.
+							seq := mg.sequence.Add(1)
+							if mg.keys.pick == nil {
+								keyIdx = int32(seq % uint64(keyCount))
+							}
+							key = fmt.Sprintf("key-%d", keyIdx)
+							offset = int64(seq)
+							ts = mg.epoch.Add(time.Duration(seq-1) * mg.eventTimeStep).UnixNano()
+						}
+						// a tombstone has an empty payload, it does not take a sequence number so that the
+						// sequences of the keys stay contiguous.
+						d := []byte{}
+						if !mg.nextIsTombstone() {
+							var err error
+							d, err = mg.payload.generate(msgSize, ts, mg.keys.seqs[keyIdx]+1)
+							if err != nil {
+								mg.logger.Errorw("Error while generating the record, skipping the record", zap.Error(err))
+								continue
+							}
+							mg.keys.seqs[keyIdx]++
+						}
+						headers, err := mg.payload.headers(ts, mg.keys.seqs[keyIdx])
 						if err != nil {
-							mg.logger.Errorw("Error while generating the record, skipping the record", zap.Error(err))
+							mg.logger.Errorw("Error while rendering the headers of the record, skipping the record", zap.Error(err))
 							continue
 						}
-						r := record{data: d, offset: time.Now().UTC().UnixNano(), key: key, ts: t}
-						select {
-						case <-ctx.Done():
-							mg.logger.Info("Context.Done is called. returning from the inner function")
-							return
-						case mg.srcChan <- r:
+						if mg.payload.createdTSFromPayload {
+							if createdTS, ok := createdTimestamp(d); ok {
+								ts = createdTS
+							}
+						}
+						now := mg.clock.Now().UTC()
+						if !mg.deterministic {
+							offset = mg.nextOffset(now)
+						}
+						r := record{data: d, offset: offset, key: key, ts: ts, ingestionTime: now, headers: headers}
+						mg.srcChan <- r
+						if mg.nextIsDuplicated() {
+							// the duplicate is skipped like the other records when srcChan is full.
+							if len(mg.srcChan) == cap(mg.srcChan) {
+								skipped++
+								continue
+							}
+							r.duplicate = true
+							mg.srcChan <- r
+							tickgenDuplicated.WithLabelValues(mg.vertexName, mg.pipelineName, strconv.Itoa(int(mg.vertexInstance.Replica))).Inc()
 						}
 					}
 				}
+				if skipped > 0 {
+					mg.logger.Debugw("The generated records are not read fast enough, skipped records", zap.Int("skipped", skipped), zap.Time("tick", ts))
+					tickgenSkipped.WithLabelValues(mg.vertexName, mg.pipelineName, strconv.Itoa(int(mg.vertexInstance.Replica))).Add(float64(skipped))
+				}
 			}
 		}
 	}
 }
 
+// tickConfig returns the number of the records generated for every key on the tick at ts, the message size and the key
+// count, as of the tick. If the key count is updated at runtime, the keys are resized before the tick.
+func (mg *memGen) tickConfig(ts time.Time) (int, int32, int32) {
+	mg.configLock.Lock()
+	defer mg.configLock.Unlock()
+	if mg.keyCountChanged {
+		mg.keyCountChanged = false
+		mg.keys.resize(mg.keyCount)
+	}
+	return mg.rate.tickRate(ts), mg.msgSize, mg.keyCount
+}
+
+// deterministicRecord generates the payload of the current record in the deterministic mode, the padding is generated
+// from the seed and the payload carries the sequence number of the record.
+func (mg *memGen) deterministicRecord(size int32, value *uint64, createdTS int64, seq uint64) ([]byte, error) {
+	data := Data{}
+	if value != nil {
+		data.Value = *value
+	} else {
+		data.Value = uint64(createdTS)
+	}
+	if size = size - 8; size > 0 {
+		data.Padding = make([]byte, size)
+		_, _ = mg.seededRand.Read(data.Padding)
+	}
+	return json.Marshal(payload{Data: data, Createdts: createdTS, Seq: seq, Sequence: mg.sequence.Load()})
+}
+
+// randIntn returns a random integer in [0, n), it's drawn from the seed in the deterministic mode. It's only called by
+// the worker, the seeded source is not safe for the concurrent use.
+func (mg *memGen) randIntn(n int) int {
+	if mg.deterministic {
+		return mg.seededRand.Intn(n)
+	}
+	return rand2.Intn(n)
+}
+
+// randFloat64 returns a random number in [0.0, 1.0), it's drawn from the seed in the deterministic mode. It's only
+// called by the worker, the seeded source is not safe for the concurrent use.
+func (mg *memGen) randFloat64() float64 {
+	if mg.deterministic {
+		return mg.seededRand.Float64()
+	}
+	return rand2.Float64()
+}
+
+// jitterIntn returns the random source of the event time jitter of the record at the offset. In the deterministic mode
+// it's derived from the seed, the offset and the number of the draws, so that the jitter does not depend on the order
+// the records are read in.
+func (mg *memGen) jitterIntn(offset int64) func(int) int {
+	if !mg.deterministic {
+		return rand2.Intn
+	}
+	var draws uint64
+	return func(n int) int {
+		d := int(splitMix64(uint64(mg.seed)^uint64(offset)^draws) % uint64(n))
+		draws++
+		return d
+	}
+}
+
+// newRand returns a random source for the worker, it's the seeded one in the deterministic mode.
+func (mg *memGen) newRand() *rand2.Rand {
+	if mg.deterministic {
+		return mg.seededRand
+	}
+	return rand2.New(rand2.NewSource(time.Now().UnixNano()))
+}
+
+// splitMix64 returns a well mixed hash of x.
+func splitMix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// valueTemplateFuncs returns the functions of the value template. now follows the clock, in the deterministic mode the
+// random values are drawn from the seed and now returns the generation time of the record.
+func (mg *memGen) valueTemplateFuncs() template.FuncMap {
+	if mg.deterministic {
+		return valueTemplateFuncs(mg.seededRand, func() time.Time { return mg.payload.renderTime })
+	}
+	return valueTemplateFuncs(nil, mg.clock.Now)
+}
+
+// nextIsTombstone returns whether the next record is a tombstone. The tombstones are spread evenly, every 100
+// consecutive records have exactly the configured percentage of tombstones.
+func (mg *memGen) nextIsTombstone() bool {
+	n := mg.generated
+	mg.generated++
+	return spreadEvenly(n, mg.tombstonePct)
+}
+
+// nextIsLate returns whether the next record read is late, the late records are spread evenly like the tombstones.
+func (mg *memGen) nextIsLate() bool {
+	n := mg.read
+	mg.read++
+	return spreadEvenly(n, mg.latePct)
+}
+
+// nextIsDuplicated returns whether the record just written to the channel is duplicated, the duplicates are spread
+// evenly like the tombstones.
+func (mg *memGen) nextIsDuplicated() bool {
+	n := mg.written
+	mg.written++
+	return spreadEvenly(n, mg.duplicatePct)
+}
+
+// spreadEvenly returns whether the n-th item is picked, so that every 100 consecutive items have exactly pct items
+// picked.
+func spreadEvenly(n int64, pct int64) bool {
+	return (n+1)*pct/100 > n*pct/100
+}
+
+// nextOffset returns the offset of a record generated at the given time. The offsets are strictly increasing even if
+// the clock does not move between two records.
+func (mg *memGen) nextOffset(now time.Time) int64 {
+	offset := now.UnixNano()
+	if offset <= mg.lastOffset {
+		offset = mg.lastOffset + 1
+	}
+	mg.lastOffset = offset
+	return offset
+}
+
 // generator fires once per time unit and generates records and writes them to the channel
-func (mg *memGen) generator(ctx context.Context, rate int, timeunit time.Duration) {
-	// capping the rate to 10000 msgs/sec
-	if rate > 10000 {
-		mg.logger.Infow("Capping the rate to 10000 msg/sec. rate has been changed from %d to 10000", rate)
-		rate = 10000
+func (mg *memGen) generator(ctx context.Context, timeunit time.Duration) {
+	mg.configLock.Lock()
+	if mg.rate.maxTickRecords(mg.rate.maxRPU(), mg.keyCount) > float64(cap(mg.srcChan)) {
+		mg.logger.Warnw("The records of a tick might not fit in the pending records, they are skipped unless they are read as fast as they are generated",
+			zap.Int("rpu", mg.rate.maxRPU()), zap.Int32("keyCount", mg.keyCount), zap.Int("maxPending", cap(mg.srcChan)))
 	}
+	mg.configLock.Unlock()
 
-	tickChan := make(chan time.Time, 1000)
+	tickChan := make(chan time.Time, pendingTicks)
 	doneChan := make(chan struct{})
 
 	// make sure that there is only one worker all the time.
-	// even when there is back pressure, max number of go routines inflight should be 1.
-	// at the same time, we don't want to miss any ticks that cannot be processed.
-	worker := mg.newWorker(ctx, rate)
+	// even when there is back pressure, max number of go routines inflight should be 1, and the worker does not block
+	// on it: the records which do not fit in srcChan are skipped, so the ticks keep being processed.
+	worker := mg.newWorker(ctx)
 	go worker(tickChan, doneChan)
 
-	ticker := time.NewTicker(timeunit)
+	ticker := mg.clock.NewTicker(timeunit)
 	defer ticker.Stop()
 
 	for {
@@ -296,23 +1332,53 @@ func (mg *memGen) generator(ctx context.Context, rate int, timeunit time.Duratio
 			mg.logger.Info("Context.Done is called. exiting generator loop.")
 			<-doneChan
 			return
-		case ts := <-ticker.C:
-			tickChan <- ts
+		case ts := <-ticker.C():
+			mg.dispatchTick(tickChan, ts)
 		}
 	}
 }
 
-func (mg *memGen) newReadMessage(key string, payload []byte, offset int64, et int64) *isb.ReadMessage {
+// dispatchTick hands the tick at ts to the worker. The tick is skipped rather than queued when the worker is behind by
+// pendingTicks ticks, i.e. when generating the records of a tick takes longer than the time unit, so that the ticks
+// waiting for the worker stay bounded at any rate.
+func (mg *memGen) dispatchTick(tickChan chan time.Time, ts time.Time) {
+	select {
+	case tickChan <- ts:
+	default:
+		mg.configLock.Lock()
+		skipped := math.Round(mg.rate.baseRate(ts)) * float64(mg.keyCount)
+		mg.configLock.Unlock()
+		mg.logger.Debugw("The worker is behind, skipped the tick", zap.Time("tick", ts), zap.Float64("skipped", skipped))
+		tickgenSkipped.WithLabelValues(mg.vertexName, mg.pipelineName, strconv.Itoa(int(mg.vertexInstance.Replica))).Add(skipped)
+	}
+}
+
+// newReadMessage returns the read message of a record, the message is nil if the record is dropped because of an
+// invalid event time.
+func (mg *memGen) newReadMessage(key string, payload []byte, offset int64, et int64, ingestionTime time.Time) (*isb.ReadMessage, error) {
+	eventTime, err := mg.eventTime(payload, et, offset)
+	if err != nil {
+		var drop bool
+		if eventTime, drop, err = mg.timePolicy.Apply(err, ingestionTime, mg.clock.Now()); err != nil {
+			return nil, err
+		}
+		if drop {
+			mg.logger.Warnw("Dropping a record with an invalid event time", zap.Int64("eventTime", et), zap.Int64("offset", offset))
+			return nil, nil
+		}
+	}
+	// the late records are marked so that the source watermark is computed from the other records.
+	late := mg.nextIsLate()
+	if late {
+		eventTime = eventTime.Add(-mg.lateBy)
+	}
 	readOffset := isb.NewSimpleIntPartitionOffset(offset, mg.vertexInstance.Replica)
 	msg := isb.Message{
 		Header: isb.Header{
 			// TODO: insert the right time based on the generator
-			MessageInfo: isb.MessageInfo{EventTime: timeFromNanos(et, mg.jitter)},
-			ID: isb.MessageID{
-				VertexName: mg.vertexName,
-				Offset:     readOffset.String(),
-				Index:      mg.vertexInstance.Replica,
-			},
+			MessageInfo: isb.MessageInfo{EventTime: eventTime, IngestionTime: ingestionTime, IsLate: late},
+			// each replica generates its own sequence of offsets, the replica is the partition of the ID
+			ID:   mg.idBuilder.MessageID(mg.vertexInstance.Replica, strconv.FormatInt(offset, 10)),
 			Keys: []string{key},
 		},
 		Body: isb.Body{Payload: payload},
@@ -321,18 +1387,42 @@ func (mg *memGen) newReadMessage(key string, payload []byte, offset int64, et in
 	return &isb.ReadMessage{
 		ReadOffset: readOffset,
 		Message:    msg,
+	}, nil
+}
+
+// eventTime returns the event time of a record, it is read from the time field of the payload if there is one,
+// otherwise it is the generation time carried along with the record. The tombstones have no payload, they keep the
+// generation time.
+func (mg *memGen) eventTime(payload []byte, et int64, offset int64) (time.Time, error) {
+	if len(mg.timeField) == 0 || len(payload) == 0 {
+		return timeFromNanos(et, mg.jitter, mg.futureJitter, mg.jitterIntn(offset))
+	}
+	var fields map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(payload))
+	d.UseNumber()
+	if err := d.Decode(&fields); err != nil {
+		return time.Time{}, &sharedeventtime.InvalidEventTimeErr{Reason: sharedeventtime.ReasonMalformed, Err: err}
 	}
+	return sharedeventtime.FromField(fields, mg.timeField, mg.timeUnit)
 }
 
-func timeFromNanos(etime int64, jitter time.Duration) time.Time {
-	// un-parseable json or invalid time format will be substituted with current time.
-	if etime > 0 {
-		updatedTs := time.Unix(0, etime)
-		if jitter.Seconds() == 0 {
-			return updatedTs
+// timeFromNanos returns the event time of a record from the generation time carried along with it, so that it does
+// not depend on the payload, which is empty for the tombstones. An InvalidEventTimeErr is returned for an invalid time.
+// The jitter moves the time back and the future jitter moves it forward, both are drawn from intn.
+func timeFromNanos(etime int64, jitter, futureJitter time.Duration, intn func(int) int) (time.Time, error) {
+	if etime <= 0 {
+		return time.Time{}, &sharedeventtime.InvalidEventTimeErr{
+			Reason: sharedeventtime.ReasonMissing,
+			Err:    fmt.Errorf("invalid generation time %d", etime),
 		}
-		d := rand2.Intn(int(jitter.Seconds()))
-		return updatedTs.Add(time.Duration(-d) * time.Second)
 	}
-	return time.Now()
+	updatedTs := time.Unix(0, etime)
+	// the jitters are applied in whole seconds, a sub-second jitter is ignored.
+	if s := int(jitter / time.Second); s > 0 {
+		updatedTs = updatedTs.Add(-time.Duration(intn(s)) * time.Second)
+	}
+	if s := int(futureJitter / time.Second); s > 0 {
+		updatedTs = updatedTs.Add(time.Duration(intn(s)) * time.Second)
+	}
+	return updatedTs, nil
 }
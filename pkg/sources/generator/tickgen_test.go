@@ -19,13 +19,39 @@ package generator
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+	"text/template"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/forwarder"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	sharedeventtime "github.com/numaproj/numaflow/pkg/shared/eventtime"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sourceforward "github.com/numaproj/numaflow/pkg/sources/forward"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+	"github.com/numaproj/numaflow/pkg/watermark/fetch"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/publish"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+	"github.com/numaproj/numaflow/pkg/window/strategy/fixed"
 )
 
 func TestRead(t *testing.T) {
@@ -50,23 +76,402 @@ func TestRead(t *testing.T) {
 		Replica:  0,
 	}
 
-	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second))
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
 	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	// wait for the ticker to be created before firing the first tick.
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
 	messages, err := mGen.Read(ctx, 5)
 	assert.NoError(t, err)
 	assert.Equal(t, 5, len(messages))
+	offsets := make(map[string]struct{})
+	for _, msg := range messages {
+		// the records are created on the tick, the clock does not move in between.
+		assert.Equal(t, fakeClock.Now(), msg.EventTime)
+		assert.Equal(t, fakeClock.Now().UTC(), msg.IngestionTime)
+		offsets[msg.ReadOffset.String()] = struct{}{}
+		// the ID is built from the replica and the offset
+		assert.Equal(t, "testVertex", msg.ID.VertexName)
+		assert.True(t, strings.HasPrefix(msg.ID.Offset, "testPipeline-testVertex-0-"))
+	}
+	// the offsets are unique even if the records are generated at the same time.
+	assert.Len(t, offsets, 5)
+}
+
+func TestReadSequence(t *testing.T) {
+	ctx := context.Background()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:      ptr.To[int64](2),
+						KeyCount: ptr.To[int32](3),
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestReadSequence",
+		Replica:  0,
+	}
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	messages, err := mGen.Read(ctx, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, len(messages))
+	// every key has its own sequence starting at 1.
+	seqs := make(map[string][]uint64)
+	for _, msg := range messages {
+		seq, ok := Sequence(msg.Payload)
+		assert.True(t, ok)
+		seqs[msg.Keys[0]] = append(seqs[msg.Keys[0]], seq)
+	}
+	assert.Equal(t, map[string][]uint64{
+		"key-0-0": {1, 2},
+		"key-0-1": {1, 2},
+		"key-0-2": {1, 2},
+	}, seqs)
+
+	_, ok := Sequence([]byte("not-a-generator-payload"))
+	assert.False(t, ok)
+}
+
+func TestReadTombstones(t *testing.T) {
+	ctx := context.Background()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:                 ptr.To[int64](50),
+						KeyCount:            ptr.To[int32](2),
+						TombstonePercentage: ptr.To[int32](10),
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestReadTombstones",
+		Replica:  0,
+	}
+
+	start := time.Unix(1636470000, 0)
+	fakeClock := clock.NewFakeClock(start)
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	messages, err := mGen.Read(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, len(messages))
+
+	tombstones := 0
+	seqs := make(map[string][]uint64)
+	for _, msg := range messages {
+		// the tombstones keep the keys and the event time of the tick.
+		assert.Len(t, msg.Keys, 1)
+		assert.Equal(t, start.Add(time.Second), msg.EventTime)
+		if len(msg.Payload) == 0 {
+			tombstones++
+			continue
+		}
+		seq, ok := Sequence(msg.Payload)
+		assert.True(t, ok)
+		seqs[msg.Keys[0]] = append(seqs[msg.Keys[0]], seq)
+	}
+	assert.Equal(t, 10, tombstones)
+	// the tombstones do not leave gaps in the sequences of the keys.
+	assert.Len(t, seqs, 2)
+	for _, keySeqs := range seqs {
+		for i, seq := range keySeqs {
+			assert.Equal(t, uint64(i+1), seq)
+		}
+	}
+}
+
+func TestNextIsTombstone(t *testing.T) {
+	for _, pct := range []int64{0, 1, 10, 33, 100} {
+		mg := &memGen{tombstonePct: pct}
+		tombstones := int64(0)
+		for i := 0; i < 1000; i++ {
+			if mg.nextIsTombstone() {
+				tombstones++
+			}
+		}
+		assert.Equal(t, pct*10, tombstones)
+	}
+}
+
+func TestStopProducing(t *testing.T) {
+	ctx := context.Background()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						Duration: &v1.Duration{Duration: 10 * time.Millisecond},
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestStopProducing",
+		Replica:  0,
+	}
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(100*time.Millisecond), WithClock(fakeClock))
+	assert.NoError(t, err)
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(10 * time.Millisecond)
+	messages, err := mGen.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(messages))
+
+	mGen.(sourcer.ProducerStopper).StopProducing()
+	// the records generated before stopping can still be read, nothing is generated after that.
+	remaining := 0
+	for {
+		messages, err = mGen.Read(ctx, 100)
+		assert.NoError(t, err)
+		if len(messages) == 0 {
+			break
+		}
+		remaining += len(messages)
+	}
+	assert.Equal(t, 4, remaining)
+	assert.NoError(t, mGen.Close())
+}
+
+func TestStop_DrainsGeneratedRecords(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:      ptr.To[int64](50),
+						Duration: &v1.Duration{Duration: 10 * time.Millisecond},
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestStop_DrainsGeneratedRecords",
+		Replica:  0,
+	}
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	src, err := NewMemGen(ctx, m, WithReadTimeout(100*time.Millisecond), WithClock(fakeClock))
+	assert.NoError(t, err)
+	mGen := src.(*memGen)
+	for i := 0; i < 4; i++ {
+		assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+		fakeClock.Step(10 * time.Millisecond)
+	}
+	assert.Eventually(t, func() bool { return len(mGen.srcChan) == 200 }, time.Second, time.Millisecond)
+
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 1000, 0)
+	toSteps := map[string][]isb.BufferWriter{"to1": {to1}}
+	fetchWatermark, _ := generic.BuildNoOpSourceWatermarkProgressorsFromBufferMap(toSteps)
+	noOpStore, _ := wmstore.BuildNoOpWatermarkStore()
+	srcPublisher := publish.NewVertexSourcePublish(ctx, m, noOpStore)
+	toWhichStep := forwarder.GoWhere(func([]string, []string, string) ([]forwarder.VertexBuffer, error) {
+		return []forwarder.VertexBuffer{{ToVertexName: "to1", ToVertexPartitionIdx: 0}}, nil
+	})
+	f, err := sourceforward.NewDataForward(m, src, toSteps, toWhichStep, fetchWatermark, srcPublisher,
+		map[string]wmstore.WatermarkStore{"to1": noOpStore}, wmb.NewNoOpIdleManager(),
+		sourceforward.WithReadBatchSize(10), sourceforward.WithDrainTimeout(10*time.Second))
+	assert.NoError(t, err)
+
+	stopped := f.Start()
+	f.Stop()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		t.Fatal("the forwarder did not stop")
+	}
+	// every record generated before stopping is written to the ISB.
+	assert.True(t, mGen.IsEmpty())
+	assert.Len(t, to1.GetMessages(1000), int(mGen.generated))
+	assert.Equal(t, int64(200), mGen.generated)
+}
+
+func TestEmitEvery(t *testing.T) {
+	ctx := context.Background()
+	emitEvery := int32(30)
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:       ptr.To[int64](1),
+						Duration:  &v1.Duration{Duration: time.Hour},
+						EmitEvery: &emitEvery,
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestEmitEvery",
+		Replica:  0,
+	}
+
+	src, err := NewMemGen(ctx, m, WithReadTimeout(100*time.Millisecond))
+	assert.NoError(t, err)
+	mGen := src.(*memGen)
+	assert.Equal(t, int64(30), mGen.emitEvery)
+	// stop the ticker based generator, and drive a worker with one tick per second instead.
+	mGen.StopProducing()
+	for range mGen.srcChan {
+	}
+	mGen.srcChan = make(chan record, 10)
+
+	tickChan := make(chan time.Time, 90)
+	done := make(chan struct{})
+	start := time.Unix(1636470000, 0)
+	for i := 0; i < 90; i++ {
+		tickChan <- start.Add(time.Duration(i) * time.Second)
+	}
+	workerCtx, cancel := context.WithCancel(ctx)
+	go mGen.newWorker(workerCtx)(tickChan, done)
+	assert.Eventually(t, func() bool { return len(tickChan) == 0 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	// one record every 30 seconds.
+	var eventTimes []time.Time
+	for r := range mGen.srcChan {
+		eventTimes = append(eventTimes, time.Unix(0, r.ts))
+	}
+	assert.Equal(t, []time.Time{start, start.Add(30 * time.Second), start.Add(60 * time.Second)}, eventTimes)
 }
 
 func TestTimeForValidTime(t *testing.T) {
 	nanotime := time.Now().UnixNano()
-	parsedtime := timeFromNanos(nanotime, 0)
+	parsedtime, err := timeFromNanos(nanotime, 0, 0, rand.Intn)
+	assert.NoError(t, err)
 	assert.Equal(t, nanotime, parsedtime.UnixNano())
 }
 
+func TestTimeForJitter(t *testing.T) {
+	nanotime := time.Now().UnixNano()
+	for i := 0; i < 100; i++ {
+		pastTime, err := timeFromNanos(nanotime, 10*time.Second, 0, rand.Intn)
+		assert.NoError(t, err)
+		assert.False(t, pastTime.After(time.Unix(0, nanotime)))
+		assert.True(t, time.Unix(0, nanotime).Sub(pastTime) < 10*time.Second)
+		futureTime, err := timeFromNanos(nanotime, 0, 10*time.Second, rand.Intn)
+		assert.NoError(t, err)
+		assert.False(t, futureTime.Before(time.Unix(0, nanotime)))
+		assert.True(t, futureTime.Sub(time.Unix(0, nanotime)) < 10*time.Second)
+		// a negative jitter is invalid, it's ignored
+		parsedTime, err := timeFromNanos(nanotime, -10*time.Second, 0, rand.Intn)
+		assert.NoError(t, err)
+		assert.Equal(t, nanotime, parsedTime.UnixNano())
+	}
+}
+
+func TestTimeForSubSecondJitter(t *testing.T) {
+	nanotime := time.Now().UnixNano()
+	for _, jitter := range []time.Duration{500 * time.Millisecond, 999 * time.Millisecond} {
+		parsedtime, err := timeFromNanos(nanotime, jitter, jitter, rand.Intn)
+		assert.NoError(t, err)
+		assert.Equal(t, nanotime, parsedtime.UnixNano())
+	}
+}
+
 func TestTimeForInvalidTime(t *testing.T) {
-	nanotime := int64(-1)
-	parsedtime := timeFromNanos(nanotime, 0)
-	assert.True(t, parsedtime.UnixNano() > 0)
+	_, err := timeFromNanos(int64(-1), 0, 0, rand.Intn)
+	var invalidErr *sharedeventtime.InvalidEventTimeErr
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, sharedeventtime.ReasonMissing, invalidErr.Reason)
+}
+
+func TestNewReadMessage_InvalidEventTime(t *testing.T) {
+	now := time.Unix(1636470000, 0)
+	ingestionTime := now.Add(-time.Second)
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{ObjectMeta: v1.ObjectMeta{Name: "memgen"}, Spec: dfv1.VertexSpec{
+			PipelineName:   "test-pl",
+			AbstractVertex: dfv1.AbstractVertex{Name: "memgen"},
+		}},
+	}
+	newMemGen := func(policy dfv1.InvalidEventTimePolicy) *memGen {
+		return &memGen{
+			vertexName:     "memgen",
+			vertexInstance: vi,
+			timePolicy:     sharedeventtime.NewPolicy(policy, "memgen", "test-pl"),
+			clock:          clock.NewFakeClock(now),
+			logger:         logging.NewLogger(),
+		}
+	}
+
+	msg, err := newMemGen(dfv1.InvalidEventTimeFallbackToNow).newReadMessage("key", nil, 1, 0, ingestionTime)
+	assert.NoError(t, err)
+	assert.Equal(t, now, msg.EventTime)
+
+	msg, err = newMemGen(dfv1.InvalidEventTimeFallbackToIngestionTime).newReadMessage("key", nil, 1, 0, ingestionTime)
+	assert.NoError(t, err)
+	assert.Equal(t, ingestionTime, msg.EventTime)
+
+	msg, err = newMemGen(dfv1.InvalidEventTimeDrop).newReadMessage("key", nil, 1, 0, ingestionTime)
+	assert.NoError(t, err)
+	assert.Nil(t, msg)
+
+	msg, err = newMemGen(dfv1.InvalidEventTimeError).newReadMessage("key", nil, 1, 0, ingestionTime)
+	assert.Error(t, err)
+	assert.Nil(t, msg)
+
+	// a valid event time is not subject to the policy.
+	msg, err = newMemGen(dfv1.InvalidEventTimeError).newReadMessage("key", nil, 1, now.UnixNano(), ingestionTime)
+	assert.NoError(t, err)
+	assert.Equal(t, now, msg.EventTime)
 }
 
 // Demonstrates testing when provided an explicit message in ValueBlob
@@ -98,8 +503,12 @@ func TestReadProvidedMessageBlob(t *testing.T) {
 		Replica:  0,
 	}
 
-	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second))
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
 	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
 	messages, err := mGen.Read(ctx, 5)
 	assert.NoError(t, err)
 	assert.Equal(t, 5, len(messages))
@@ -109,3 +518,1036 @@ func TestReadProvidedMessageBlob(t *testing.T) {
 		assert.Equal(t, testVal, msg.Body.Payload)
 	}
 }
+
+func newTemplateVertexInstance(valueTemplate string) *dfv1.VertexInstance {
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:           ptr.To[int64](3),
+						MsgSize:       ptr.To[int32](1024),
+						ValueTemplate: &valueTemplate,
+					},
+				},
+			},
+		},
+	}
+	return &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestReadValueTemplate",
+		Replica:  0,
+	}
+}
+
+func TestReadValueTemplate(t *testing.T) {
+	ctx := context.Background()
+	m := newTemplateVertexInstance(`{"id": "{{.RandString 16}}", "Seq": {{.Sequence}}, "ts": {{.Timestamp}}}`)
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	messages, err := mGen.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(messages))
+	for i, msg := range messages {
+		// the message size is ignored
+		var p struct {
+			ID  string
+			Seq uint64
+			TS  int64
+		}
+		assert.NoError(t, json.Unmarshal(msg.Payload, &p))
+		assert.Len(t, p.ID, 16)
+		assert.Equal(t, uint64(i+1), p.Seq)
+		assert.Equal(t, fakeClock.Now().UnixNano(), p.TS)
+		// the event time falls back to the generation time without a Createdts field
+		assert.Equal(t, fakeClock.Now(), msg.EventTime)
+		seq, ok := Sequence(msg.Payload)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(i+1), seq)
+	}
+}
+
+func TestReadValueTemplate_Funcs(t *testing.T) {
+	ctx := context.Background()
+	m := newTemplateVertexInstance(`{"id": "{{uuid}}", "amount": {{randInt 5 7}}, "ts": {{now.UnixMilli}}}`)
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	messages, err := mGen.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(messages))
+	ids := make(map[string]bool)
+	for _, msg := range messages {
+		var p struct {
+			ID     string
+			Amount int
+			TS     int64
+		}
+		assert.NoError(t, json.Unmarshal(msg.Payload, &p))
+		_, err := uuid.Parse(p.ID)
+		assert.NoError(t, err)
+		ids[p.ID] = true
+		assert.GreaterOrEqual(t, p.Amount, 5)
+		assert.LessOrEqual(t, p.Amount, 7)
+		// now follows the clock of the generator
+		assert.Equal(t, fakeClock.Now().UnixMilli(), p.TS)
+	}
+	assert.Len(t, ids, 3)
+}
+
+func TestValueTemplateFuncs_Metrics(t *testing.T) {
+	now := time.Unix(1636470000, 0)
+	funcs := valueTemplateFuncs(rand.New(rand.NewSource(1)), func() time.Time { return now })
+	render := func(text string) float64 {
+		tmpl, err := template.New("test").Funcs(funcs).Parse(text)
+		assert.NoError(t, err)
+		var b strings.Builder
+		assert.NoError(t, tmpl.Execute(&b, nil))
+		v, err := strconv.ParseFloat(b.String(), 64)
+		assert.NoError(t, err)
+		return v
+	}
+
+	// 1636470000 is a multiple of 1m, a quarter of the period later the sine is at its peak.
+	assert.InDelta(t, 50, render(`{{sine "1m" 10 50}}`), 1e-9)
+	now = now.Add(15 * time.Second)
+	assert.InDelta(t, 60, render(`{{sine "1m" 10 50}}`), 1e-9)
+	now = now.Add(30 * time.Second)
+	assert.InDelta(t, 40, render(`{{sine "1m" 10 50}}`), 1e-9)
+
+	now = time.Unix(1636470000, 0)
+	for i := 0; i < 20; i++ {
+		assert.InDelta(t, float64(i%10)*10, render(`{{sawtooth "10s" 0 100}}`), 1e-9)
+		now = now.Add(time.Second)
+	}
+
+	assert.Equal(t, float64(100), render(`{{randomWalk 100 1}}`))
+	prev := float64(100)
+	moved := false
+	for i := 0; i < 100; i++ {
+		v := render(`{{randomWalk 100 1}}`)
+		assert.InDelta(t, prev, v, 1)
+		moved = moved || v != prev
+		prev = v
+	}
+	assert.True(t, moved)
+	// a walk with other arguments starts from its own start.
+	assert.Equal(t, float64(5), render(`{{randomWalk 5 1}}`))
+}
+
+func TestReadValueTemplate_Createdts(t *testing.T) {
+	ctx := context.Background()
+	createdTS := time.Unix(1636460000, 0)
+	m := newTemplateVertexInstance(fmt.Sprintf(`{"Createdts": %d, "Seq": {{.Sequence}}}`, createdTS.UnixNano()))
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	messages, err := mGen.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(messages))
+	for _, msg := range messages {
+		assert.Equal(t, createdTS, msg.EventTime)
+	}
+}
+
+func TestNewMemGen_InvalidValueTemplate(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueTemplate string
+		wantErr       string
+	}{
+		{name: "parse error", valueTemplate: `{"id": {{.Sequence}`, wantErr: "failed to parse the value template"},
+		{name: "unknown field", valueTemplate: `{"id": {{.ID}}}`, wantErr: "failed to render the value template"},
+		{name: "invalid argument", valueTemplate: `{"id": "{{.RandString "x"}}"}`, wantErr: "failed to render the value template"},
+		{name: "unknown function", valueTemplate: `{"id": "{{uuid4}}"}`, wantErr: "failed to parse the value template"},
+		{name: "invalid range", valueTemplate: `{"amount": {{randInt 7 5}}}`, wantErr: "failed to render the value template"},
+		{name: "invalid period", valueTemplate: `{"cpu": {{sine "1x" 10 50}}}`, wantErr: "invalid sine period"},
+		{name: "zero period", valueTemplate: `{"cpu": {{sawtooth "0s" 0 100}}}`, wantErr: "invalid sawtooth period"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMemGen(context.Background(), newTemplateVertexInstance(tt.valueTemplate))
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+// newStoppedMemGen returns a memGen whose ticker based generator is stopped, so that a worker can be driven with the
+// given ticks by runTicks instead.
+func newStoppedMemGen(t *testing.T, rpu int64, opts ...Option) *memGen {
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:      ptr.To[int64](rpu),
+						Duration: &v1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestRateVariation",
+		Replica:  0,
+	}
+	src, err := NewMemGen(context.Background(), m, append([]Option{WithReadTimeout(100 * time.Millisecond)}, opts...)...)
+	assert.NoError(t, err)
+	mGen := src.(*memGen)
+	mGen.StopProducing()
+	for range mGen.srcChan {
+	}
+	return mGen
+}
+
+// runTicks drives a worker of the memGen with the ticks, and returns the number of the records generated on every
+// tick. It also asserts the event times of the records are monotonically non-decreasing.
+func runTicks(t *testing.T, mGen *memGen, ticks []time.Time) map[time.Time]int {
+	mGen.srcChan = make(chan record, (mGen.rate.maxRPU()*2+1)*(len(ticks)+1))
+	// the worker taking the extra tick means all the records of the given ticks have been generated.
+	tickChan := make(chan time.Time, len(ticks)+1)
+	for _, tick := range ticks {
+		tickChan <- tick
+	}
+	tickChan <- ticks[len(ticks)-1].Add(time.Hour)
+	done := make(chan struct{})
+	workerCtx, cancel := context.WithCancel(context.Background())
+	go mGen.newWorker(workerCtx)(tickChan, done)
+	assert.Eventually(t, func() bool { return len(tickChan) == 0 }, 5*time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	counts := make(map[time.Time]int)
+	lastTS := int64(0)
+	for r := range mGen.srcChan {
+		assert.GreaterOrEqual(t, r.ts, lastTS)
+		lastTS = r.ts
+		counts[time.Unix(0, r.ts)]++
+	}
+	return counts
+}
+
+func TestBackpressure_SkipsRecords(t *testing.T) {
+	mGen := newStoppedMemGen(t, 5)
+	skippedMetric := tickgenSkipped.WithLabelValues("testVertex", "testPipeline", "0")
+	skippedBefore := testutil.ToFloat64(skippedMetric)
+	// nothing is read, srcChan only has room for the records of the first 2 ticks.
+	mGen.srcChan = make(chan record, 10)
+	goroutines := runtime.NumGoroutine()
+
+	start := time.Unix(1636470000, 0)
+	tickChan := make(chan time.Time, 100)
+	for i := 0; i < 100; i++ {
+		tickChan <- start.Add(time.Duration(i) * time.Second)
+	}
+	done := make(chan struct{})
+	workerCtx, cancel := context.WithCancel(context.Background())
+	go mGen.newWorker(workerCtx)(tickChan, done)
+	// the worker keeps taking the ticks instead of blocking, and no goroutine is spawned for them.
+	assert.Eventually(t, func() bool { return len(tickChan) == 0 }, 5*time.Second, time.Millisecond)
+	assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines+1)
+	cancel()
+	<-done
+
+	var eventTimes []time.Time
+	for r := range mGen.srcChan {
+		eventTimes = append(eventTimes, time.Unix(0, r.ts))
+	}
+	assert.Len(t, eventTimes, 10)
+	assert.Equal(t, start.Add(time.Second), eventTimes[9])
+	// the skipped records do not take a sequence number.
+	assert.Equal(t, []uint64{10}, mGen.keys.seqs)
+	assert.Equal(t, float64(490), testutil.ToFloat64(skippedMetric)-skippedBefore)
+}
+
+func TestRateJitter(t *testing.T) {
+	mGen := newStoppedMemGen(t, 100, WithRateJitter(0.2))
+	start := time.Unix(1636470000, 0)
+	mGen.rate.startTime = start
+	var ticks []time.Time
+	for i := 1; i <= 200; i++ {
+		ticks = append(ticks, start.Add(time.Duration(i)*time.Second))
+	}
+	counts := runTicks(t, mGen, ticks)
+	distinct := make(map[int]bool)
+	for _, tick := range ticks {
+		assert.GreaterOrEqual(t, counts[tick], 80)
+		assert.LessOrEqual(t, counts[tick], 120)
+		distinct[counts[tick]] = true
+	}
+	// the rate is not a flat line.
+	assert.Greater(t, len(distinct), 1)
+}
+
+func TestRampUp(t *testing.T) {
+	mGen := newStoppedMemGen(t, 100, WithRampUp(10*time.Second))
+	start := time.Unix(1636470000, 0)
+	mGen.rate.startTime = start
+	var ticks []time.Time
+	for i := 1; i <= 15; i++ {
+		ticks = append(ticks, start.Add(time.Duration(i)*time.Second))
+	}
+	counts := runTicks(t, mGen, ticks)
+	for i, tick := range ticks {
+		// 10 more records on every tick, until rpu is reached.
+		assert.Equal(t, min(10*(i+1), 100), counts[tick])
+	}
+}
+
+func TestRateSchedule(t *testing.T) {
+	mGen := newStoppedMemGen(t, 10, WithRateSchedule(
+		RateStep{RPU: 100, Duration: 9 * time.Second},
+		RateStep{RPU: 100, Duration: 5 * time.Second},
+		RateStep{RPU: 10, Duration: 9 * time.Second},
+	))
+	start := time.Unix(1636470000, 0)
+	mGen.rate.startTime = start
+	var ticks []time.Time
+	for i := 0; i <= 30; i++ {
+		ticks = append(ticks, start.Add(time.Duration(i)*time.Second))
+	}
+	counts := runTicks(t, mGen, ticks)
+	for i, tick := range ticks {
+		var want int
+		switch {
+		case i < 9:
+			// ramps up by 10 records on every tick.
+			want = 10 + 10*i
+		case i < 14:
+			want = 100
+		case i < 23:
+			// ramps down by 10 records on every tick.
+			want = 100 - 10*(i-14)
+		default:
+			// the last step is held.
+			want = 10
+		}
+		assert.Equal(t, want, counts[tick], "tick %d", i)
+	}
+}
+
+func TestWithRateSchedule_Invalid(t *testing.T) {
+	mGen := &memGen{}
+	assert.Error(t, WithRateSchedule(RateStep{RPU: -1, Duration: time.Second})(mGen))
+	assert.Error(t, WithRateSchedule(RateStep{RPU: 10, Duration: time.Second}, RateStep{RPU: 10})(mGen))
+	assert.NoError(t, WithRateSchedule(RateStep{RPU: 10, Duration: time.Second})(mGen))
+	assert.Len(t, mGen.rate.schedule, 1)
+}
+
+func TestRampUpWithRateJitter(t *testing.T) {
+	mGen := newStoppedMemGen(t, 100, WithRampUp(20*time.Second), WithRateJitter(0.5))
+	start := time.Unix(1636470000, 0)
+	mGen.rate.startTime = start
+	var ticks []time.Time
+	for i := 1; i <= 30; i++ {
+		ticks = append(ticks, start.Add(time.Duration(i)*time.Second))
+	}
+	counts := runTicks(t, mGen, ticks)
+	for i, tick := range ticks {
+		base := math.Min(100*float64(i+1)/20, 100)
+		assert.GreaterOrEqual(t, counts[tick], int(math.Floor(base*0.5)))
+		assert.LessOrEqual(t, counts[tick], int(math.Ceil(base*1.5)))
+	}
+}
+
+func TestTickRate_HighRate(t *testing.T) {
+	mGen := newStoppedMemGen(t, 50000, WithRateJitter(0.5))
+	for i := 0; i < 100; i++ {
+		rate := mGen.rate.tickRate(mGen.rate.startTime.Add(time.Second))
+		assert.GreaterOrEqual(t, rate, 25000)
+		assert.LessOrEqual(t, rate, 75000)
+	}
+	mGen.rate.jitter = 0
+	assert.Equal(t, 50000, mGen.rate.tickRate(mGen.rate.startTime.Add(time.Second)))
+	// the records of a tick are all generated at a rate beyond 10000.
+	tick := time.Unix(1636470000, 0)
+	counts := runTicks(t, mGen, []time.Time{tick})
+	assert.Equal(t, 50000, counts[tick])
+}
+
+func TestNewMemGen_PendingRecordsBounded(t *testing.T) {
+	mGen := newStoppedMemGen(t, 100, WithRateJitter(0.2))
+	assert.Equal(t, 120*pendingTicks, cap(mGen.srcChan))
+	mGen = newStoppedMemGen(t, 10000000)
+	assert.Equal(t, maxPendingRecords, cap(mGen.srcChan))
+}
+
+func TestDispatchTick_WorkerBehind(t *testing.T) {
+	mGen := newStoppedMemGen(t, 5)
+	skippedMetric := tickgenSkipped.WithLabelValues("testVertex", "testPipeline", "0")
+	skippedBefore := testutil.ToFloat64(skippedMetric)
+	start := time.Unix(1636470000, 0)
+	// no worker takes the ticks, the ticks beyond pendingTicks are skipped instead of blocking the generator loop.
+	tickChan := make(chan time.Time, pendingTicks)
+	for i := 0; i < pendingTicks+3; i++ {
+		mGen.dispatchTick(tickChan, start.Add(time.Duration(i)*time.Second))
+	}
+	assert.Len(t, tickChan, pendingTicks)
+	assert.Equal(t, start, <-tickChan)
+	assert.Equal(t, float64(15), testutil.ToFloat64(skippedMetric)-skippedBefore)
+}
+
+func TestNewMemGen_RateVariation(t *testing.T) {
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:                  ptr.To[int64](10),
+						RateJitterPercentage: ptr.To[int32](20),
+						RampUp:               &v1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestNewMemGen_RateVariation",
+		Replica:  0,
+	}
+	src, err := NewMemGen(context.Background(), m)
+	assert.NoError(t, err)
+	defer func() { _ = src.Close() }()
+	mGen := src.(*memGen)
+	assert.Equal(t, 0.2, mGen.rate.jitter)
+	assert.Equal(t, time.Minute, mGen.rate.rampUp)
+
+	_, err = NewMemGen(context.Background(), m, WithRateJitter(1.5))
+	assert.ErrorContains(t, err, "invalid rate jitter")
+	_, err = NewMemGen(context.Background(), m, WithRampUp(-time.Second))
+	assert.ErrorContains(t, err, "invalid ramp-up")
+}
+
+// readDeterministic reads the records generated from the seed on two ticks.
+func readDeterministic(t *testing.T, generator *dfv1.GeneratorSource, seed int64, epoch time.Time) []*isb.ReadMessage {
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name:   "testVertex",
+				Source: &dfv1.Source{Generator: generator},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestDeterministicSeed",
+		Replica:  0,
+	}
+	fakeClock := clock.NewFakeClock(time.Now())
+	mGen, err := NewMemGen(context.Background(), m, WithReadTimeout(100*time.Millisecond), WithClock(fakeClock),
+		WithDeterministicSeed(seed), WithKeyCount(3), WithEpoch(epoch), WithEventTimeStep(time.Second))
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+		fakeClock.Step(10 * time.Millisecond)
+	}
+	assert.Eventually(t, func() bool { return len(mGen.(*memGen).srcChan) == 30 }, time.Second, time.Millisecond)
+	mGen.(sourcer.ProducerStopper).StopProducing()
+	var messages []*isb.ReadMessage
+	for {
+		msgs, err := mGen.Read(context.Background(), 100)
+		assert.NoError(t, err)
+		if len(msgs) == 0 {
+			break
+		}
+		messages = append(messages, msgs...)
+	}
+	assert.NoError(t, mGen.Close())
+	return messages
+}
+
+func TestDeterministicSeed(t *testing.T) {
+	epoch := time.Unix(1636470000, 0)
+	read := func(seed int64) []*isb.ReadMessage {
+		return readDeterministic(t, &dfv1.GeneratorSource{
+			RPU:      ptr.To[int64](5),
+			MsgSize:  ptr.To[int32](32),
+			Duration: &v1.Duration{Duration: 10 * time.Millisecond},
+		}, seed, epoch)
+	}
+
+	first, second := read(42), read(42)
+	assert.Len(t, first, 30)
+	assert.Len(t, second, 30)
+	for i := range first {
+		seq := uint64(i + 1)
+		assert.Equal(t, first[i].Payload, second[i].Payload)
+		assert.Equal(t, first[i].ID, second[i].ID)
+		assert.Equal(t, isb.NewSourceMessageIDBuilder("testPipeline", "testVertex").MessageID(0, strconv.FormatUint(seq, 10)), first[i].ID)
+		assert.Equal(t, []string{fmt.Sprintf("key-%d", seq%3)}, first[i].Keys)
+		assert.Equal(t, epoch.Add(time.Duration(i)*time.Second), first[i].EventTime)
+		var p payload
+		assert.NoError(t, json.Unmarshal(first[i].Payload, &p))
+		assert.Equal(t, seq, p.Sequence)
+		assert.Equal(t, uint64(i/3+1), p.Seq)
+		assert.Len(t, p.Data.Padding, 24)
+	}
+	// the padding is generated from the seed.
+	assert.NotEqual(t, first[0].Payload, read(43)[0].Payload)
+}
+
+func TestDeterministicSeed_ValueTemplateAndJitter(t *testing.T) {
+	epoch := time.Unix(1636470000, 0)
+	read := func(seed int64) []*isb.ReadMessage {
+		return readDeterministic(t, &dfv1.GeneratorSource{
+			RPU:           ptr.To[int64](5),
+			Duration:      &v1.Duration{Duration: 10 * time.Millisecond},
+			Jitter:        &v1.Duration{Duration: time.Hour},
+			ValueTemplate: ptr.To(`{"id": "{{uuid}}", "user": "{{.RandString 8}}", "amount": {{randInt 1 1000000}}, "ts": {{now.UnixNano}}}`),
+		}, seed, epoch)
+	}
+
+	first, second := read(42), read(42)
+	assert.Len(t, first, 30)
+	assert.Len(t, second, 30)
+	jittered := false
+	for i := range first {
+		assert.Equal(t, first[i].Payload, second[i].Payload)
+		assert.Equal(t, first[i].EventTime, second[i].EventTime)
+		jittered = jittered || !first[i].EventTime.Equal(epoch.Add(time.Duration(i)*time.Second))
+		// now is the generation time of the record.
+		var p struct{ TS int64 }
+		assert.NoError(t, json.Unmarshal(first[i].Payload, &p))
+		assert.Equal(t, epoch.Add(time.Duration(i)*time.Second).UnixNano(), p.TS)
+	}
+	assert.True(t, jittered)
+	// the random values and the jitter are generated from the seed.
+	third := read(43)
+	assert.NotEqual(t, first[0].Payload, third[0].Payload)
+}
+
+func TestDeterministicSeed_SubSecondJitter(t *testing.T) {
+	epoch := time.Unix(1636470000, 0)
+	messages := readDeterministic(t, &dfv1.GeneratorSource{
+		RPU:      ptr.To[int64](5),
+		Duration: &v1.Duration{Duration: 10 * time.Millisecond},
+		Jitter:   &v1.Duration{Duration: 500 * time.Millisecond},
+	}, 42, epoch)
+	assert.Len(t, messages, 30)
+	for i, m := range messages {
+		assert.Equal(t, epoch.Add(time.Duration(i)*time.Second), m.EventTime)
+	}
+}
+
+func TestLateRecords(t *testing.T) {
+	epoch := time.Unix(1636470000, 0)
+	messages := readDeterministic(t, &dfv1.GeneratorSource{
+		RPU:            ptr.To[int64](5),
+		Duration:       &v1.Duration{Duration: 10 * time.Millisecond},
+		LatePercentage: ptr.To[int32](10),
+		LateBy:         &v1.Duration{Duration: 2 * time.Minute},
+	}, 42, epoch)
+	assert.Len(t, messages, 30)
+	for i, m := range messages {
+		et := epoch.Add(time.Duration(i) * time.Second)
+		// every 10th record is late.
+		if (i+1)%10 == 0 {
+			assert.True(t, m.IsLate, "record %d", i)
+			assert.Equal(t, et.Add(-2*time.Minute), m.EventTime)
+		} else {
+			assert.False(t, m.IsLate, "record %d", i)
+			assert.Equal(t, et, m.EventTime)
+		}
+	}
+}
+
+func TestWithLateRecords_Invalid(t *testing.T) {
+	assert.Error(t, WithLateRecords(-1, time.Minute)(&memGen{}))
+	assert.Error(t, WithLateRecords(101, time.Minute)(&memGen{}))
+	assert.Error(t, WithLateRecords(5, 0)(&memGen{}))
+	assert.NoError(t, WithLateRecords(0, 0)(&memGen{}))
+}
+
+func TestDuplicates(t *testing.T) {
+	ctx := context.Background()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:                 ptr.To[int64](10),
+						Duration:            &v1.Duration{Duration: time.Second},
+						DuplicatePercentage: ptr.To[int32](20),
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestDuplicates",
+		Replica:  0,
+	}
+	duplicatedMetric := tickgenDuplicated.WithLabelValues("testVertex", "testPipeline", "0")
+	duplicatedBefore := testutil.ToFloat64(duplicatedMetric)
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(100*time.Millisecond), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	assert.Eventually(t, func() bool { return len(mGen.(*memGen).srcChan) == 12 }, time.Second, time.Millisecond)
+	messages, err := mGen.Read(ctx, 12)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 12)
+	// every 5th record is read twice, the duplicate is the same message.
+	for _, i := range []int{5, 11} {
+		assert.NotSame(t, messages[i-1], messages[i])
+		assert.Equal(t, messages[i-1].ID, messages[i].ID)
+		assert.Equal(t, messages[i-1].ReadOffset.String(), messages[i].ReadOffset.String())
+		assert.Equal(t, messages[i-1].Payload, messages[i].Payload)
+		assert.Equal(t, messages[i-1].EventTime, messages[i].EventTime)
+	}
+	ids := make(map[string]bool)
+	for _, msg := range messages {
+		ids[msg.ID.String()] = true
+	}
+	assert.Len(t, ids, 10)
+	assert.Equal(t, float64(2), testutil.ToFloat64(duplicatedMetric)-duplicatedBefore)
+}
+
+func TestWithDuplicates_Invalid(t *testing.T) {
+	assert.Error(t, WithDuplicates(-1)(&memGen{}))
+	assert.Error(t, WithDuplicates(101)(&memGen{}))
+	assert.NoError(t, WithDuplicates(0)(&memGen{}))
+}
+
+func TestHeaders(t *testing.T) {
+	ctx := context.Background()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:      ptr.To[int64](3),
+						Duration: &v1.Duration{Duration: time.Second},
+						Headers: map[string]string{
+							"tenant":   "acme",
+							"trace-id": "{{uuid}}",
+							"seq":      "{{.Sequence}}",
+						},
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestHeaders",
+		Replica:  0,
+	}
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(100*time.Millisecond), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	messages, err := mGen.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 3)
+	traceIDs := make(map[string]bool)
+	for i, msg := range messages {
+		assert.Len(t, msg.Headers, 3)
+		assert.Equal(t, "acme", msg.Headers["tenant"])
+		assert.Equal(t, strconv.Itoa(i+1), msg.Headers["seq"])
+		_, err := uuid.Parse(msg.Headers["trace-id"])
+		assert.NoError(t, err)
+		traceIDs[msg.Headers["trace-id"]] = true
+	}
+	assert.Len(t, traceIDs, 3)
+}
+
+func TestDeterministicSeed_Headers(t *testing.T) {
+	epoch := time.Unix(1636470000, 0)
+	read := func(seed int64) []map[string]string {
+		messages := readDeterministic(t, &dfv1.GeneratorSource{
+			RPU:      ptr.To[int64](5),
+			Duration: &v1.Duration{Duration: 10 * time.Millisecond},
+			Headers:  map[string]string{"a": "{{randInt 1 1000000}}", "b": "{{uuid}}", "ts": "{{now.UnixNano}}"},
+		}, seed, epoch)
+		var headers []map[string]string
+		for _, m := range messages {
+			headers = append(headers, m.Headers)
+		}
+		return headers
+	}
+
+	headers := read(42)
+	assert.Len(t, headers, 30)
+	for i, h := range headers {
+		// now is the event time of the record in the deterministic mode.
+		assert.Equal(t, strconv.FormatInt(epoch.Add(time.Duration(i)*time.Second).UnixNano(), 10), h["ts"])
+	}
+	assert.Equal(t, headers, read(42))
+	assert.NotEqual(t, headers, read(7))
+}
+
+func TestWithHeaders_Invalid(t *testing.T) {
+	assert.Error(t, WithHeaders(map[string]string{"": "v"})(&memGen{}))
+	assert.Error(t, WithHeaders(map[string]string{"a": "{{uuid"})(&memGen{}))
+	assert.Error(t, WithHeaders(map[string]string{"a": "{{.ID}}"})(&memGen{}))
+	mGen := &memGen{}
+	assert.NoError(t, WithHeaders(map[string]string{"b": "{{uuid}}", "a": "{{uuid}}", "c": "v"})(mGen))
+	assert.Equal(t, map[string]string{"c": "v"}, mGen.payload.staticHeaders)
+	assert.Len(t, mGen.payload.headerTemplates, 2)
+	assert.Equal(t, "a", mGen.payload.headerTemplates[0].name)
+}
+
+func TestDeterministicSeed_KeyDistribution(t *testing.T) {
+	epoch := time.Unix(1636470000, 0)
+	read := func(seed int64) []*isb.ReadMessage {
+		return readDeterministic(t, &dfv1.GeneratorSource{
+			RPU:              ptr.To[int64](5),
+			Duration:         &v1.Duration{Duration: 10 * time.Millisecond},
+			KeyDistribution:  ptr.To(dfv1.KeyDistributionHotKey),
+			HotKeyPercentage: ptr.To[int32](100),
+		}, seed, epoch)
+	}
+
+	messages := read(42)
+	assert.Len(t, messages, 30)
+	for i, m := range messages {
+		assert.Equal(t, []string{"key-0"}, m.Keys)
+		// the sequences of the keys stay contiguous.
+		var p payload
+		assert.NoError(t, json.Unmarshal(m.Payload, &p))
+		assert.Equal(t, uint64(i+1), p.Seq)
+	}
+}
+
+func TestDeterministicSeed_PayloadFormat(t *testing.T) {
+	epoch := time.Unix(1636470000, 0)
+	read := func(seed int64) []*isb.ReadMessage {
+		return readDeterministic(t, &dfv1.GeneratorSource{
+			RPU:           ptr.To[int64](5),
+			MsgSize:       ptr.To[int32](16),
+			Duration:      &v1.Duration{Duration: 10 * time.Millisecond},
+			PayloadFormat: ptr.To(dfv1.PayloadFormatRawBytes),
+		}, seed, epoch)
+	}
+
+	first, second := read(42), read(42)
+	assert.Len(t, first, 30)
+	for i := range first {
+		assert.Len(t, first[i].Payload, 16)
+		assert.Equal(t, first[i].Payload, second[i].Payload)
+		// the event time is the generation time.
+		assert.Equal(t, epoch.Add(time.Duration(i)*time.Second), first[i].EventTime)
+	}
+	assert.NotEqual(t, first[0].Payload, read(43)[0].Payload)
+}
+
+func TestWithPayloadFormat_Invalid(t *testing.T) {
+	assert.Error(t, WithPayloadFormat(dfv1.PayloadFormatAvro, "{", "")(&memGen{}))
+	assert.Error(t, WithPayloadFormat(dfv1.PayloadFormatProtobuf, "", "example.Order")(&memGen{}))
+}
+
+func TestKeyDistributionOptions_Invalid(t *testing.T) {
+	assert.Error(t, WithKeyDistribution("pareto")(&memGen{}))
+	assert.Error(t, WithZipfExponent(1)(&memGen{}))
+	assert.Error(t, WithHotKeyPercentage(101)(&memGen{}))
+}
+
+func TestDeterministicOptions_Invalid(t *testing.T) {
+	assert.Error(t, WithKeyCount(0)(&memGen{}))
+	assert.Error(t, WithEventTimeStep(0)(&memGen{}))
+	assert.Error(t, WithEpoch(time.Unix(0, 0))(&memGen{}))
+}
+
+func TestEventTimeFromField(t *testing.T) {
+	et := time.Date(2024, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	mg := &memGen{timeField: []string{"meta", "ts"}, timeUnit: dfv1.EventTimeUnitMillis}
+	got, err := mg.eventTime([]byte(fmt.Sprintf(`{"meta": {"ts": %d}}`, et.UnixMilli())), 1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, et, got.UTC())
+
+	// the tombstones keep the generation time.
+	got, err = mg.eventTime(nil, et.UnixNano(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, et.UnixNano(), got.UnixNano())
+
+	_, err = mg.eventTime([]byte(`{"meta": `), 1, 0)
+	var invalidErr *sharedeventtime.InvalidEventTimeErr
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, sharedeventtime.ReasonMalformed, invalidErr.Reason)
+}
+
+func TestReadTimeField(t *testing.T) {
+	ctx := context.Background()
+	et := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	// every 3rd message misses the time field.
+	m := newTemplateVertexInstance(fmt.Sprintf(`{"Seq": {{.Sequence}}{{if ne .Sequence 3}}, "meta": {"ts": %d}{{end}}}`, et.UnixMilli()))
+	m.Vertex.Spec.Source.Generator.EventTimeField = ptr.To("meta.ts")
+	m.Vertex.Spec.Source.Generator.EventTimeUnit = ptr.To(dfv1.EventTimeUnitMillis)
+	fallbackCount := func() float64 {
+		return testutil.ToFloat64(metrics.InvalidEventTimeCount.With(map[string]string{
+			metrics.LabelVertex:   "testVertex",
+			metrics.LabelPipeline: "testPipeline",
+			metrics.LabelOutcome:  string(dfv1.InvalidEventTimeFallbackToNow),
+			metrics.LabelReason:   sharedeventtime.ReasonMissing,
+		}))
+	}
+	before := fallbackCount()
+
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen, err := NewMemGen(ctx, m, WithReadTimeout(3*time.Second), WithClock(fakeClock))
+	assert.NoError(t, err)
+	defer func() { _ = mGen.Close() }()
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+	messages, err := mGen.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(messages))
+	assert.Equal(t, et, messages[0].EventTime.UTC())
+	assert.Equal(t, et, messages[1].EventTime.UTC())
+	// the message missing the time field falls back to the wall clock time, and is counted.
+	assert.Equal(t, fakeClock.Now(), messages[2].EventTime)
+	assert.Equal(t, before+1, fallbackCount())
+}
+
+// newAckTrackingMemGen returns a stopped memGen with the ack tracking, which reads the given number of the records.
+func newAckTrackingMemGen(t *testing.T, fakeClock *clock.FakeClock, records int) *memGen {
+	mGen := newStoppedMemGen(t, 1, WithClock(fakeClock), WithAckTracking(true), WithRedeliveryTimeout(10*time.Second))
+	mGen.srcChan = make(chan record, records)
+	for i := 0; i < records; i++ {
+		mGen.srcChan <- record{data: []byte("test"), offset: int64(i + 1), key: "key-0-0", ts: fakeClock.Now().Add(time.Duration(i) * time.Millisecond).UnixNano(), ingestionTime: fakeClock.Now()}
+	}
+	close(mGen.srcChan)
+	return mGen
+}
+
+func TestAckTracking_Redelivery(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen := newAckTrackingMemGen(t, fakeClock, 10)
+	defer func() { _ = mGen.Close() }()
+
+	messages, err := mGen.Read(ctx, 10)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 10)
+	// the writer fails to write every other message, only the written messages are acknowledged.
+	var acked []isb.Offset
+	failed := make(map[string]time.Time)
+	for i, msg := range messages {
+		if i%2 == 0 {
+			acked = append(acked, msg.ReadOffset)
+		} else {
+			failed[msg.ReadOffset.String()] = msg.EventTime
+		}
+	}
+	assert.Equal(t, make([]error, 5), mGen.Ack(ctx, acked))
+	assert.False(t, mGen.IsEmpty())
+
+	// the failed messages are read again with the same offsets and event times once the redelivery timeout elapses.
+	redelivered := make(map[string]time.Time)
+	var offsets []isb.Offset
+	assert.Eventually(t, func() bool {
+		fakeClock.Step(5 * time.Second)
+		messages, err := mGen.Read(ctx, 10)
+		assert.NoError(t, err)
+		for _, msg := range messages {
+			redelivered[msg.ReadOffset.String()] = msg.EventTime
+			offsets = append(offsets, msg.ReadOffset)
+		}
+		return len(redelivered) == len(failed)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, failed, redelivered)
+
+	assert.Equal(t, make([]error, 5), mGen.Ack(ctx, offsets))
+	assert.True(t, mGen.IsEmpty())
+}
+
+func TestAckTracking_AckedAfterQueued(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen := newAckTrackingMemGen(t, fakeClock, 3)
+	defer func() { _ = mGen.Close() }()
+
+	messages, err := mGen.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 3)
+
+	fakeClock.SetTime(fakeClock.Now().Add(10 * time.Second))
+	mGen.queueExpired()
+	assert.Len(t, mGen.redeliveries, 3)
+	// a message acknowledged late is not redelivered.
+	assert.Equal(t, make([]error, 1), mGen.Ack(ctx, []isb.Offset{messages[1].ReadOffset}))
+	redelivered, err := mGen.Read(ctx, 3)
+	assert.NoError(t, err)
+	assert.Len(t, redelivered, 2)
+	assert.Equal(t, messages[0].ReadOffset.String(), redelivered[0].ReadOffset.String())
+	assert.Equal(t, messages[2].ReadOffset.String(), redelivered[1].ReadOffset.String())
+	// the redelivered messages are tracked again from the redelivery.
+	mGen.queueExpired()
+	assert.Empty(t, mGen.redeliveries)
+}
+
+func TestWithRedeliveryTimeout_Invalid(t *testing.T) {
+	assert.Error(t, WithRedeliveryTimeout(0)(&memGen{}))
+}
+
+func TestPending(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	mGen := newAckTrackingMemGen(t, fakeClock, 10)
+	defer func() { _ = mGen.Close() }()
+	pendingMetric := tickgenPending.WithLabelValues("testVertex", "testPipeline", "0")
+
+	pending, err := mGen.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), pending)
+	assert.Equal(t, float64(10), testutil.ToFloat64(pendingMetric))
+
+	// the pending count drops as the records are read.
+	messages, err := mGen.Read(ctx, 4)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 4)
+	pending, err = mGen.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), pending)
+	assert.Equal(t, float64(6), testutil.ToFloat64(pendingMetric))
+
+	// the unacknowledged records queued for the redelivery are pending again.
+	assert.Equal(t, make([]error, 1), mGen.Ack(ctx, []isb.Offset{messages[0].ReadOffset}))
+	fakeClock.SetTime(fakeClock.Now().Add(10 * time.Second))
+	mGen.queueExpired()
+	pending, err = mGen.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), pending)
+
+	messages, err = mGen.Read(ctx, 9)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 9)
+	pending, err = mGen.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pending)
+	assert.Equal(t, float64(0), testutil.ToFloat64(pendingMetric))
+}
+
+func TestIdleThreshold_ClosesWindows(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	vertex := &dfv1.Vertex{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "memGen",
+		},
+		Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+				Source: &dfv1.Source{
+					Generator: &dfv1.GeneratorSource{
+						RPU:           ptr.To[int64](0),
+						Duration:      &v1.Duration{Duration: 10 * time.Millisecond},
+						IdleThreshold: &v1.Duration{Duration: 200 * time.Millisecond},
+					},
+				},
+			},
+		},
+	}
+	m := &dfv1.VertexInstance{
+		Vertex:   vertex,
+		Hostname: "TestIdleThreshold_ClosesWindows",
+		Replica:  0,
+	}
+
+	src, err := NewMemGen(ctx, m, WithReadTimeout(50*time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, 200*time.Millisecond, src.(sourcer.IdleReporter).IdleThreshold())
+
+	srcStore, err := wmstore.BuildInmemWatermarkStore(ctx, "source-testVertex")
+	assert.NoError(t, err)
+	fetchWatermark := fetch.NewSourceFetcher(ctx, srcStore, fetch.WithIsSource(true))
+	srcPublisher := publish.NewVertexSourcePublish(ctx, m, srcStore, publish.WithPodHeartbeatRate(1))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 100, 0)
+	toSteps := map[string][]isb.BufferWriter{"to1": {to1}}
+	noOpStore, _ := wmstore.BuildNoOpWatermarkStore()
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	toWhichStep := forwarder.GoWhere(func([]string, []string, string) ([]forwarder.VertexBuffer, error) {
+		return []forwarder.VertexBuffer{{ToVertexName: "to1", ToVertexPartitionIdx: 0}}, nil
+	})
+	f, err := sourceforward.NewDataForward(m, src, toSteps, toWhichStep, fetchWatermark, srcPublisher,
+		map[string]wmstore.WatermarkStore{"to1": noOpStore}, idleManager, sourceforward.WithReadBatchSize(10))
+	assert.NoError(t, err)
+
+	// a downstream fixed window holding a message of the current time.
+	windower := fixed.NewWindower(time.Second, m)
+	windower.AssignWindows(&isb.ReadMessage{Message: isb.Message{Header: isb.Header{
+		MessageInfo: isb.MessageInfo{EventTime: time.Now()},
+		Keys:        []string{"key-0"},
+	}}})
+
+	stopped := f.Start()
+	// the generator has no data, the idle watermark closes the window.
+	assert.Eventually(t, func() bool {
+		return len(windower.CloseWindows(time.Time(fetchWatermark.ComputeWatermark()))) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the watermark does not regress when an older message is read.
+	idleWM := fetchWatermark.ComputeWatermark()
+	srcPublisher.PublishSourceWatermarks([]*isb.ReadMessage{{
+		Message:    isb.Message{Header: isb.Header{MessageInfo: isb.MessageInfo{EventTime: time.Time(idleWM).Add(-10 * time.Second)}}},
+		ReadOffset: isb.NewSimpleIntPartitionOffset(1, 0),
+	}})
+	assert.Never(t, func() bool {
+		return fetchWatermark.ComputeWatermark().BeforeWatermark(idleWM)
+	}, 300*time.Millisecond, 10*time.Millisecond)
+
+	f.Stop()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		t.Fatal("the forwarder did not stop")
+	}
+}
+
+func TestWithIdleThreshold_Invalid(t *testing.T) {
+	assert.Error(t, WithIdleThreshold(-time.Second)(&memGen{}))
+}
@@ -132,7 +132,8 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		if id == "" {
 			id = uuid.New().String()
 		}
-		eventTime := time.Now()
+		ingestionTime := time.Now()
+		eventTime := ingestionTime
 		if x := r.Header.Get(dfv1.KeyMetaEventTime); x != "" {
 			i, err := strconv.ParseInt(x, 10, 64)
 			if err != nil {
@@ -155,7 +156,7 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		m := &isb.ReadMessage{
 			Message: isb.Message{
 				Header: isb.Header{
-					MessageInfo: isb.MessageInfo{EventTime: eventTime},
+					MessageInfo: isb.MessageInfo{EventTime: eventTime, IngestionTime: ingestionTime},
 					ID: isb.MessageID{
 						VertexName: h.vertexName,
 						Offset:     id,
@@ -228,6 +229,11 @@ func (h *httpSource) Ack(_ context.Context, offsets []isb.Offset) []error {
 	return make([]error, len(offsets))
 }
 
+// StopProducing stops accepting new messages, the messages already accepted can still be read.
+func (h *httpSource) StopProducing() {
+	h.ready.Store(false)
+}
+
 func (h *httpSource) Close() error {
 	h.logger.Info("Shutting down http source server...")
 	close(h.messages)
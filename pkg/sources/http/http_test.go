@@ -67,3 +67,10 @@ func Test_NewHTTP(t *testing.T) {
 	assert.NotNil(t, h.(*httpSource).shutdown)
 	assert.True(t, h.(*httpSource).ready.Load())
 }
+
+func TestStopProducing(t *testing.T) {
+	h := &httpSource{}
+	h.ready.Store(true)
+	h.StopProducing()
+	assert.False(t, h.ready.Load())
+}
@@ -61,6 +61,7 @@ func TestMessageHandling(t *testing.T) {
 		pipelineName: vi.Vertex.Spec.PipelineName,
 		handler:      NewConsumerHandler(100),
 		logger:       logging.FromContext(ctx),
+		idBuilder:    isb.NewSourceMessageIDBuilder(vi.Vertex.Spec.PipelineName, vi.Vertex.Spec.Name),
 	}
 
 	msg := &sarama.ConsumerMessage{
@@ -71,10 +72,11 @@ func TestMessageHandling(t *testing.T) {
 		Value:     []byte(value),
 	}
 
+	// the ID is built from the partition and the offset of the Kafka message
 	expectedID := isb.MessageID{
-		VertexName: vi.Vertex.Name,
-		Offset:     fmt.Sprintf("%v", offset),
-		Index:      partition,
+		VertexName: "testVertex",
+		Offset:     "testPipeline-testVertex-1-1",
+		Index:      0,
 	}
 	// push one message
 	ks.handler.messages <- msg
@@ -89,5 +91,5 @@ func TestMessageHandling(t *testing.T) {
 	assert.Equal(t, expectedID, readmsg.ID)
 	assert.Equal(t, []byte(value), readmsg.Body.Payload)
 	assert.Equal(t, keys, readmsg.Header.Keys)
-	assert.Equal(t, expectedID.Offset, readmsg.ReadOffset.String())
+	assert.Equal(t, fmt.Sprintf("%v", offset), readmsg.ReadOffset.String())
 }
@@ -50,6 +50,8 @@ type kafkaSource struct {
 	readTimeout   time.Duration       // read timeout for the from buffer
 	adminClient   sarama.ClusterAdmin // client used to calculate pending messages
 	saramaClient  sarama.Client       // sarama client
+
+	idBuilder isb.SourceMessageIDBuilder // builds the stable IDs of the messages
 }
 
 // NewKafkaSource returns a kafkaSource reader based on Kafka Consumer Group.
@@ -59,6 +61,7 @@ func NewKafkaSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, ha
 	ks := &kafkaSource{
 		vertexName:    vertexInstance.Vertex.Spec.Name,
 		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		idBuilder:     isb.NewSourceMessageIDBuilder(vertexInstance.Vertex.Spec.PipelineName, vertexInstance.Vertex.Spec.Name),
 		topic:         source.Topic,
 		brokers:       source.Brokers,
 		readTimeout:   1 * time.Second, // default timeout
@@ -207,6 +210,14 @@ func (ks *kafkaSource) Ack(_ context.Context, offsets []isb.Offset) []error {
 	return make([]error, len(offsets))
 }
 
+// FlushAcks commits the offsets marked by the acks.
+func (ks *kafkaSource) FlushAcks(_ context.Context) error {
+	if ks.handler.sess != nil {
+		ks.handler.sess.Commit()
+	}
+	return nil
+}
+
 func (ks *kafkaSource) Close() error {
 	ks.logger.Info("Closing kafka reader...")
 	// finally, shut down the client
@@ -345,13 +356,9 @@ func (ks *kafkaSource) toReadMessage(m *sarama.ConsumerMessage) *isb.ReadMessage
 	msg := isb.Message{
 		Header: isb.Header{
 			MessageInfo: isb.MessageInfo{EventTime: m.Timestamp},
-			ID: isb.MessageID{
-				VertexName: ks.vertexName,
-				Offset:     readOffset.String(),
-				Index:      readOffset.PartitionIdx(),
-			},
-			Keys:    []string{string(m.Key)},
-			Headers: headers,
+			ID:          ks.idBuilder.MessageID(m.Partition, strconv.FormatInt(m.Offset, 10)),
+			Keys:        []string{string(m.Key)},
+			Headers:     headers,
 		},
 		Body: body,
 	}
@@ -0,0 +1,379 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replay contains a source replaying the records captured in a file, in the order of the file, pacing them by
+// their event times.
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	sharedeventtime "github.com/numaproj/numaflow/pkg/shared/eventtime"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+// record is a record read from the file.
+type record struct {
+	payload []byte
+	// fields are the fields of the record, used to read the event time and the key, nil if they are not needed.
+	fields map[string]interface{}
+}
+
+type replaySource struct {
+	vertexName    string
+	pipelineName  string
+	vertexReplica int32
+	path          string
+	format        dfv1.ReplayFormat
+	timeField     []string
+	timeUnit      dfv1.EventTimeUnit
+	keyField      []string
+	speed         float64
+	readTimeout   time.Duration
+	bufferSize    int
+	clock         clock.Clock
+	idBuilder     isb.SourceMessageIDBuilder
+	timePolicy    *sharedeventtime.Policy
+	messages      chan *isb.ReadMessage
+	cancel        context.CancelFunc
+	logger        *zap.SugaredLogger
+}
+
+type Option func(*replaySource) error
+
+// WithReadTimeout is used to set the read timeout for the from buffer
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *replaySource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+// WithBufferSize sets the number of the records read ahead of the forwarder.
+func WithBufferSize(s int) Option {
+	return func(o *replaySource) error {
+		if s <= 0 {
+			return fmt.Errorf("invalid buffer size %d, it should be positive", s)
+		}
+		o.bufferSize = s
+		return nil
+	}
+}
+
+// WithClock sets the clock the records are paced with, it is meant for the tests.
+func WithClock(c clock.Clock) Option {
+	return func(o *replaySource) error {
+		o.clock = c
+		return nil
+	}
+}
+
+// New returns a source replaying the records of the file of the replay source spec. The records are replayed by the
+// replica 0 only, the other replicas do not read any record. A restarted source replays the records from the start.
+func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
+	spec := vertexInstance.Vertex.Spec.Source.Replay
+	r := &replaySource{
+		vertexName:    vertexInstance.Vertex.Spec.Name,
+		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica: vertexInstance.Replica,
+		format:        spec.GetFormat(),
+		timeUnit:      spec.GetEventTimeUnit(),
+		bufferSize:    1000,            // default size
+		readTimeout:   1 * time.Second, // default timeout
+		clock:         clock.RealClock(),
+		idBuilder:     isb.NewSourceMessageIDBuilder(vertexInstance.Vertex.Spec.PipelineName, vertexInstance.Vertex.Spec.Name),
+		// the records with an invalid event time can not be paced, they are dropped.
+		timePolicy: sharedeventtime.NewPolicy(dfv1.InvalidEventTimeDrop, vertexInstance.Vertex.Spec.Name, vertexInstance.Vertex.Spec.PipelineName),
+		logger:     logging.FromContext(ctx),
+	}
+	switch {
+	case spec.Path != nil:
+		r.path = *spec.Path
+	case spec.ConfigMap != nil:
+		p, err := sharedutil.GetConfigMapVolumePath(spec.ConfigMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the path of the configmap of the records, %w", err)
+		}
+		r.path = p
+	default:
+		return nil, fmt.Errorf("neither the path nor the configmap of the records is specified")
+	}
+	if x := spec.EventTimeField; x != nil {
+		r.timeField = r.fieldPath(*x)
+	}
+	if x := spec.KeyField; x != nil {
+		r.keyField = r.fieldPath(*x)
+	}
+	speed, err := spec.GetSpeed()
+	if err != nil || speed < 0 || math.IsInf(speed, 0) || math.IsNaN(speed) {
+		return nil, fmt.Errorf("invalid speed %q, it should be a non-negative decimal", *spec.Speed)
+	}
+	r.speed = speed
+
+	for _, o := range opts {
+		if err := o(r); err != nil {
+			return nil, err
+		}
+	}
+
+	r.messages = make(chan *isb.ReadMessage, r.bufferSize)
+	if r.vertexReplica != 0 {
+		r.logger.Infow("The records are replayed by the replica 0 only, not reading any record", zap.Int32("replica", r.vertexReplica))
+		r.cancel = func() {}
+		return r, nil
+	}
+	f, err := os.Open(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the file of the records, %w", err)
+	}
+	next, err := r.newRecordReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	var replayCtx context.Context
+	replayCtx, r.cancel = context.WithCancel(ctx)
+	go func() {
+		defer func() { _ = f.Close() }()
+		r.replay(replayCtx, next)
+	}()
+	return r, nil
+}
+
+// fieldPath returns the path of a field, the nested fields of a jsonl record are separated by dots, a csv column is
+// a single field.
+func (r *replaySource) fieldPath(field string) []string {
+	if r.format == dfv1.ReplayFormatCSV {
+		return []string{field}
+	}
+	return strings.Split(field, ".")
+}
+
+// newRecordReader returns a function returning the next record of the file, io.EOF at the end of the file.
+func (r *replaySource) newRecordReader(f io.Reader) (func() (*record, error), error) {
+	needFields := len(r.timeField) > 0 || len(r.keyField) > 0
+	if r.format == dfv1.ReplayFormatCSV {
+		cr := csv.NewReader(f)
+		header, err := cr.Read()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the header of the csv records, %w", err)
+		}
+		return func() (*record, error) {
+			row, err := cr.Read()
+			if err != nil {
+				return nil, err
+			}
+			fields := make(map[string]interface{}, len(header))
+			for i, name := range header {
+				if i < len(row) {
+					fields[name] = row[i]
+				}
+			}
+			payload, err := json.Marshal(fields)
+			if err != nil {
+				return nil, err
+			}
+			return &record{payload: payload, fields: fields}, nil
+		}, nil
+	}
+	br := bufio.NewReader(f)
+	return func() (*record, error) {
+		for {
+			line, err := br.ReadBytes('\n')
+			if len(line) == 0 && err != nil {
+				return nil, err
+			}
+			line = bytes.TrimSpace(line)
+			if len(line) == 0 {
+				// the blank lines are skipped.
+				continue
+			}
+			rec := &record{payload: line}
+			if needFields {
+				// a record which is not a JSON object has no fields, its event time is invalid.
+				d := json.NewDecoder(bytes.NewReader(line))
+				d.UseNumber()
+				_ = d.Decode(&rec.fields)
+			}
+			return rec, nil
+		}
+	}, nil
+}
+
+// replay reads the records of the file and sends them to the messages channel, pacing them by their event times until
+// the end of the file or the context is done.
+func (r *replaySource) replay(ctx context.Context, next func() (*record, error)) {
+	var firstEventTime, startTime time.Time
+	var offset int64
+	for {
+		rec, err := next()
+		if errors.Is(err, io.EOF) {
+			r.logger.Infow("Replayed all the records", zap.String("path", r.path), zap.Int64("records", offset))
+			return
+		}
+		if err != nil {
+			r.logger.Errorw("Failed to read a record, stop replaying", zap.String("path", r.path), zap.Int64("offset", offset), zap.Error(err))
+			return
+		}
+		readTime := r.clock.Now()
+		eventTime := readTime
+		if len(r.timeField) > 0 {
+			if eventTime, err = sharedeventtime.FromField(rec.fields, r.timeField, r.timeUnit); err != nil {
+				// the drop policy never returns an error.
+				if _, drop, _ := r.timePolicy.Apply(err, readTime, readTime); drop {
+					r.logger.Warnw("Dropping a record with an invalid event time", zap.Int64("offset", offset), zap.Error(err))
+					offset++
+					continue
+				}
+			}
+			if r.speed > 0 {
+				if startTime.IsZero() {
+					firstEventTime, startTime = eventTime, readTime
+				}
+				// the out of order records are sent immediately.
+				due := startTime.Add(time.Duration(float64(eventTime.Sub(firstEventTime)) / r.speed))
+				if wait := due.Sub(readTime); wait > 0 {
+					select {
+					case <-r.clock.After(wait):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+		var keys []string
+		if len(r.keyField) > 0 {
+			if key, ok := lookup(rec.fields, r.keyField); ok {
+				keys = []string{stringOf(key)}
+			}
+		}
+		msg := &isb.ReadMessage{
+			Message: isb.Message{
+				Header: isb.Header{
+					MessageInfo: isb.MessageInfo{EventTime: eventTime, IngestionTime: r.clock.Now()},
+					ID:          r.idBuilder.MessageID(r.vertexReplica, strconv.FormatInt(offset, 10)),
+					Keys:        keys,
+				},
+				Body: isb.Body{Payload: rec.payload},
+			},
+			ReadOffset: isb.NewSimpleIntPartitionOffset(offset, r.vertexReplica),
+		}
+		select {
+		case r.messages <- msg:
+		case <-ctx.Done():
+			return
+		}
+		offset++
+	}
+}
+
+// lookup returns the value of a nested field of the record fields.
+func lookup(fields map[string]interface{}, path []string) (interface{}, bool) {
+	var v interface{} = fields
+	for _, f := range path {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[f]; !ok || v == nil {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// stringOf returns the string of a field value, the strings and the numbers as they are, the other values in JSON.
+func stringOf(v interface{}) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case json.Number:
+		return x.String()
+	default:
+		b, _ := json.Marshal(x)
+		return string(b)
+	}
+}
+
+// GetName returns the name of the source.
+func (r *replaySource) GetName() string {
+	return r.vertexName
+}
+
+// Partitions returns the partitions for the source.
+func (r *replaySource) Partitions(context.Context) []int32 {
+	return []int32{r.vertexReplica}
+}
+
+// Read reads the records replayed, waiting up to the read timeout for them.
+func (r *replaySource) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
+	var msgs []*isb.ReadMessage
+	timeout := r.clock.After(r.readTimeout)
+loop:
+	for i := int64(0); i < count; i++ {
+		select {
+		case m := <-r.messages:
+			msgs = append(msgs, m)
+		case <-timeout:
+			r.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", r.readTimeout), zap.Int("read", len(msgs)))
+			break loop
+		}
+	}
+	return msgs, nil
+}
+
+func (r *replaySource) Pending(_ context.Context) (int64, error) {
+	return isb.PendingNotAvailable, nil
+}
+
+// Ack is a no-op, the records are replayed from the start after a restart.
+func (r *replaySource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	return make([]error, len(offsets))
+}
+
+// IsEmpty returns whether all the records sent have been read.
+func (r *replaySource) IsEmpty() bool {
+	return len(r.messages) == 0
+}
+
+// StopProducing stops replaying the records, the records already sent can still be read.
+func (r *replaySource) StopProducing() {
+	r.cancel()
+}
+
+func (r *replaySource) Close() error {
+	r.cancel()
+	return nil
+}
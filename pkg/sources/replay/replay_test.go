@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+func newVertexInstance(t *testing.T, fileName, content string, spec dfv1.ReplaySource, replica int32) *dfv1.VertexInstance {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), fileName)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	spec.Path = &path
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			Spec: dfv1.VertexSpec{
+				PipelineName: "test-pl",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name:   "test-v",
+					Source: &dfv1.Source{Replay: &spec},
+				},
+			},
+		},
+		Replica: replica,
+	}
+}
+
+// next returns the next record replayed, nil if none is replayed in a while.
+func next(r *replaySource) *isb.ReadMessage {
+	select {
+	case m := <-r.messages:
+		return m
+	case <-time.After(200 * time.Millisecond):
+		return nil
+	}
+}
+
+func TestReplay_Paced(t *testing.T) {
+	content := `{"ts": "2024-01-01T00:00:00Z", "user": {"id": "a"}}
+
+{"ts": "2024-01-01T00:00:01Z", "user": {"id": "b"}}
+{"ts": "2024-01-01T00:00:00.5Z", "user": {"id": 3}}
+{"ts": "2024-01-01T00:00:03Z"}
+`
+	vi := newVertexInstance(t, "records.jsonl", content, dfv1.ReplaySource{
+		EventTimeField: ptr.To("ts"),
+		KeyField:       ptr.To("user.id"),
+		Speed:          ptr.To("2"),
+	}, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fc := clock.NewFakeClock(time.Now())
+	src, err := New(ctx, vi, WithClock(fc))
+	require.NoError(t, err)
+	defer func() { _ = src.Close() }()
+	r := src.(*replaySource)
+	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	m := next(r)
+	require.NotNil(t, m)
+	assert.Equal(t, []byte(`{"ts": "2024-01-01T00:00:00Z", "user": {"id": "a"}}`), m.Payload)
+	assert.True(t, epoch.Equal(m.EventTime))
+	assert.Equal(t, []string{"a"}, m.Keys)
+	assert.Equal(t, "0-0", m.ReadOffset.String())
+
+	// the second record is 1s later, replayed 0.5s later at 2x.
+	assert.Nil(t, next(r))
+	assert.Eventually(t, fc.HasWaiters, time.Second, 10*time.Millisecond)
+	fc.Step(500 * time.Millisecond)
+	m = next(r)
+	require.NotNil(t, m)
+	assert.True(t, epoch.Add(time.Second).Equal(m.EventTime))
+	assert.Equal(t, []string{"b"}, m.Keys)
+	assert.Equal(t, "1-0", m.ReadOffset.String())
+
+	// the out of order record is replayed immediately.
+	m = next(r)
+	require.NotNil(t, m)
+	assert.True(t, epoch.Add(500*time.Millisecond).Equal(m.EventTime))
+	assert.Equal(t, []string{"3"}, m.Keys)
+
+	assert.Nil(t, next(r))
+	assert.Eventually(t, fc.HasWaiters, time.Second, 10*time.Millisecond)
+	fc.Step(time.Second)
+	m = next(r)
+	require.NotNil(t, m)
+	assert.True(t, epoch.Add(3*time.Second).Equal(m.EventTime))
+	assert.Nil(t, m.Keys)
+	assert.True(t, r.IsEmpty())
+}
+
+func TestReplay_CSV(t *testing.T) {
+	content := "ts,key,value\n1704067200000,k1,10\nbad,k2,20\n1704067201000,k3,30\n"
+	vi := newVertexInstance(t, "records.CSV", content, dfv1.ReplaySource{
+		EventTimeField: ptr.To("ts"),
+		EventTimeUnit:  ptr.To(dfv1.EventTimeUnitMillis),
+		KeyField:       ptr.To("key"),
+		Speed:          ptr.To("0"),
+	}, 0)
+	src, err := New(context.Background(), vi, WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = src.Close() }()
+
+	msgs, err := src.Read(context.Background(), 10)
+	require.NoError(t, err)
+	// the record with an invalid event time is dropped.
+	require.Len(t, msgs, 2)
+	assert.Equal(t, []byte(`{"key":"k1","ts":"1704067200000","value":"10"}`), msgs[0].Payload)
+	assert.Equal(t, []string{"k1"}, msgs[0].Keys)
+	assert.True(t, time.UnixMilli(1704067200000).Equal(msgs[0].EventTime))
+	assert.Equal(t, []string{"k3"}, msgs[1].Keys)
+	assert.Equal(t, "2-0", msgs[1].ReadOffset.String())
+	assert.True(t, time.UnixMilli(1704067201000).Equal(msgs[1].EventTime))
+}
+
+func TestReplay_AsFastAsPossible(t *testing.T) {
+	content := "a\nb\nc\n"
+	vi := newVertexInstance(t, "records.txt", content, dfv1.ReplaySource{}, 0)
+	src, err := New(context.Background(), vi, WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = src.Close() }()
+
+	msgs, err := src.Read(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	for i, p := range []string{"a", "b", "c"} {
+		assert.Equal(t, []byte(p), msgs[i].Payload)
+		assert.Nil(t, msgs[i].Keys)
+		assert.False(t, msgs[i].EventTime.IsZero())
+	}
+}
+
+func TestReplay_OtherReplicas(t *testing.T) {
+	vi := newVertexInstance(t, "records.jsonl", "{}\n", dfv1.ReplaySource{}, 1)
+	src, err := New(context.Background(), vi, WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	msgs, err := src.Read(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+	assert.NoError(t, src.Close())
+}
+
+func TestReplay_StopProducing(t *testing.T) {
+	vi := newVertexInstance(t, "records.jsonl", "{\"ts\":1}\n{\"ts\":2}\n", dfv1.ReplaySource{
+		EventTimeField: ptr.To("ts"),
+		EventTimeUnit:  ptr.To(dfv1.EventTimeUnitSeconds),
+	}, 0)
+	fc := clock.NewFakeClock(time.Now())
+	src, err := New(context.Background(), vi, WithClock(fc))
+	require.NoError(t, err)
+	r := src.(*replaySource)
+	require.NotNil(t, next(r))
+	assert.Eventually(t, fc.HasWaiters, time.Second, 10*time.Millisecond)
+	src.(sourcer.ProducerStopper).StopProducing()
+	fc.Step(time.Second)
+	assert.Nil(t, next(r))
+	assert.NoError(t, src.Close())
+}
+
+func TestNew_Invalid(t *testing.T) {
+	vi := newVertexInstance(t, "records.jsonl", "{}\n", dfv1.ReplaySource{Speed: ptr.To("-1")}, 0)
+	_, err := New(context.Background(), vi)
+	assert.ErrorContains(t, err, "invalid speed")
+
+	vi = newVertexInstance(t, "records.jsonl", "{}\n", dfv1.ReplaySource{}, 0)
+	vi.Vertex.Spec.Source.Replay.Path = ptr.To(filepath.Join(t.TempDir(), "missing.jsonl"))
+	_, err = New(context.Background(), vi)
+	assert.ErrorContains(t, err, "failed to open the file of the records")
+
+	vi = newVertexInstance(t, "records.csv", "", dfv1.ReplaySource{}, 0)
+	_, err = New(context.Background(), vi)
+	assert.ErrorContains(t, err, "failed to read the header of the csv records")
+}
@@ -19,6 +19,7 @@ package sources
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"time"
@@ -28,17 +29,22 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
+	kafkaisb "github.com/numaproj/numaflow/pkg/isb/stores/kafka"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	sourceclient "github.com/numaproj/numaflow/pkg/sdkclient/source"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sourcetransformer"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/lifecycle"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
@@ -48,6 +54,7 @@ import (
 	jetstreamsrc "github.com/numaproj/numaflow/pkg/sources/jetstream"
 	"github.com/numaproj/numaflow/pkg/sources/kafka"
 	"github.com/numaproj/numaflow/pkg/sources/nats"
+	"github.com/numaproj/numaflow/pkg/sources/replay"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 	"github.com/numaproj/numaflow/pkg/sources/transformer"
 	"github.com/numaproj/numaflow/pkg/sources/udsource"
@@ -108,7 +115,10 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			// create a writer for each partition.
 			for partitionIdx, partition := range partitionedBuffers {
 				group := partition + "-group"
-				redisClient := redisclient.NewInClusterRedisClient()
+				redisClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return fmt.Errorf("failed to create a new Redis client: %w", err)
+				}
 				writer := redisisb.NewBufferWrite(ctx, redisClient, partition, group, int32(partitionIdx), writeOpts...)
 				bufferWriters = append(bufferWriters, writer)
 			}
@@ -173,10 +183,45 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			}
 			idleManager, _ = wmb.NewIdleManager(1, len(writersMap))
 		}
+	case dfv1.ISBSvcTypeKafka:
+		kafkaClient, err := kafkaclient.NewInClusterKafkaClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a new Kafka client: %w", err)
+		}
+		defer func() { _ = kafkaClient.Close() }()
+
+		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			writeOpts := []kafkaisb.WriteOption{
+				kafkaisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+			}
+			if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
+				writeOpts = append(writeOpts, kafkaisb.WithMaxLength(int64(*x.BufferMaxLength)))
+			}
+			if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
+				writeOpts = append(writeOpts, kafkaisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
+			}
+			var bufferWriters []isb.BufferWriter
+			partitionedBuffers := dfv1.GenerateBufferNames(sp.VertexInstance.Vertex.Namespace, sp.VertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+			// create a writer for each partition, the watermarks are not supported.
+			for partitionIdx, partition := range partitionedBuffers {
+				writer, err := kafkaisb.NewKafkaBufferWriter(ctx, kafkaClient, partition, isbsvc.KafkaTopicName(partition), isbsvc.KafkaConsumerGroupName(partition), int32(partitionIdx), writeOpts...)
+				if err != nil {
+					return err
+				}
+				bufferWriters = append(bufferWriters, writer)
+			}
+			writersMap[e.To] = bufferWriters
+		}
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", sp.ISBSvcType)
 	}
 
+	keyring, err := encryption.NewVertexKeyring(sp.VertexInstance.Vertex)
+	if err != nil {
+		return fmt.Errorf("failed to load the isb encryption keys: %w", err)
+	}
+	writersMap = encryption.WrapWriters(writersMap, keyring)
+
 	// Populate the shuffle function map
 	// we need to shuffle the messages, because we can have a reduce vertex immediately after a sourceReader vertex.
 	var toVertexPartitionMap = make(map[string]int)
@@ -225,7 +270,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		healthCheckers = append(healthCheckers, udsGRPCClient)
 	}
 
-	var forwardOpts []sourceforward.Option
+	forwardOpts := []sourceforward.Option{sourceforward.WithFutureEventTimeBound(sharedutil.LookupEnvDurationOr(dfv1.EnvFutureEventTimeBound, dfv1.DefaultFutureEventTimeBound))}
 
 	if x := sp.VertexInstance.Vertex.Spec.Limits; x != nil {
 		if x.ReadBatchSize != nil {
@@ -265,13 +310,13 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		forwardOpts = append(forwardOpts, sourceforward.WithTransformer(srcTransformerGRPCClient))
 	}
 
-	sourceReader, err := sp.createSourceReader(ctx, udsGRPCClient)
+	sourceReader, err = sp.createSourceReader(ctx, udsGRPCClient)
 	if err != nil {
 		return fmt.Errorf("failed to create source, error: %w", err)
 	}
 
 	// create a source watermark publisher
-	sourceWmPublisher := publish.NewSourcePublish(ctx, pipelineName, vertexName, sourcePublisherStores, publish.WithDelay(sp.VertexInstance.Vertex.Spec.Watermark.GetMaxDelay()))
+	sourceWmPublisher := publish.NewVertexSourcePublish(ctx, sp.VertexInstance, sourcePublisherStores)
 
 	// if the callback is enabled, create a callback publisher
 	cbEnabled := sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false)
@@ -296,7 +341,16 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to create source forwarder, error: %w", err)
 	}
 
+	// the reloader applies the limits updated in the runtime limits ConfigMap to the forwarder.
+	reloader := limits.NewVertexReloader(sp.VertexInstance, dfv1.VertexTypeSource, log)
+	reloader.Subscribe(sourceForwarder.ApplyLimits)
+	go reloader.Start(ctx)
+
 	metricsOpts := metrics.NewMetricsOptions(ctx, sp.VertexInstance.Vertex, healthCheckers, []isb.LagReader{sourceReader})
+	if h := generator.RuntimeConfigHandler(sourceReader); h != nil {
+		// the rate, the message size and the key count of the generator can be changed at runtime through the daemon.
+		metricsOpts = append(metricsOpts, metrics.WithGeneratorConfig(h))
+	}
 	ms := metrics.NewMetricsServer(sp.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -306,38 +360,66 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 
 	log.Infow("Start processing source messages", zap.String("isbs", string(sp.ISBSvcType)), zap.Any("to", sp.VertexInstance.Vertex.GetToBuffers()))
 	stopped := sourceForwarder.Start()
+	var wmStores = make(map[string]store.WatermarkStore)
+	for name, wmStore := range sourceWmStores {
+		wmStores["source "+name] = wmStore
+	}
+	for name, wmStore := range toVertexWatermarkStores {
+		wmStores["to vertex "+name] = wmStore
+	}
+	wmStores["source publisher"] = sourcePublisherStores
+	shutdownSequence := buildShutdownSequence(log, sourceReader, sourceForwarder, stopped, wmStores)
+	if closer, ok := fetchWatermark.(io.Closer); ok {
+		shutdownSequence.RegisterCloser(lifecycle.StageCloseStores, "source watermark fetcher", closer)
+	}
 	select {
 	case <-ctx.Done(): // context cancelled case
-		log.Info("Context cancelled, stopping forwarder for partition...")
-		sourceForwarder.Stop()
-		if err := <-stopped; err != nil {
-			log.Errorw("Source forwarder stopped with error", zap.Error(err))
-		}
-		log.Info("Exited source forwarder...")
+		log.Info("Context cancelled, shutting down the source...")
 	case err := <-stopped: // critical error case
 		if err != nil {
 			log.Errorw("Source forwarder stopped with error", zap.Error(err))
 			cancel()
 		}
 	}
-
-	// close all the sourceReader wm stores
-	for _, wmStore := range sourceWmStores {
-		_ = wmStore.Close()
-	}
-
-	// close all the to vertex wm stores
-	for _, ws := range toVertexWatermarkStores {
-		_ = ws.Close()
+	if err := shutdownSequence.Shutdown(context.Background()); err != nil {
+		log.Errorw("Source shutdown completed with errors", zap.Error(err))
 	}
 
-	// close all the sourceReader publisher stores
-	_ = sourcePublisherStores.Close()
-
 	log.Info("Exited...")
 	return nil
 }
 
+// forwarderStopper stops a forwarder.
+type forwarderStopper interface {
+	Stop()
+}
+
+// buildShutdownSequence builds the shutdown sequence of the source vertex: stop producing, drain the forwarder, and
+// close the watermark stores. Once drained, the forwarder flushes the acks, closes the watermark publishers, the source
+// reader and the writers by itself, see sourceforward.DataForward.Start.
+func buildShutdownSequence(log *zap.SugaredLogger, sourceReader sourcer.SourceReader, sourceForwarder forwarderStopper, stopped <-chan error, wmStores map[string]store.WatermarkStore) *lifecycle.ShutdownSequence {
+	sequence := lifecycle.NewShutdownSequence(log)
+	if stopper, ok := sourceReader.(sourcer.ProducerStopper); ok {
+		sequence.Register(lifecycle.StageStopProducing, sourceReader.GetName(), func(context.Context) error {
+			stopper.StopProducing()
+			return nil
+		})
+	}
+	sequence.Register(lifecycle.StageDrainForwarder, "source forwarder", func(context.Context) error {
+		sourceForwarder.Stop()
+		// stopped is closed once the forwarder exits, it only returns an error if the forwarder hits a critical error.
+		if err := <-stopped; err != nil {
+			return err
+		}
+		log.Info("Exited source forwarder...")
+		return nil
+	})
+	for name, wmStore := range wmStores {
+		sequence.RegisterCloser(lifecycle.StageCloseStores, name+" watermark store", wmStore)
+	}
+	return sequence
+}
+
 // createSourceReader is used to send the sourcer information
 func (sp *SourceProcessor) createSourceReader(ctx context.Context, udsGRPCClient *udsource.GRPCBasedUDSource) (sourcer.SourceReader, error) {
 	var readTimeout time.Duration
@@ -358,6 +440,8 @@ func (sp *SourceProcessor) createSourceReader(ctx context.Context, udsGRPCClient
 		return nats.New(ctx, sp.VertexInstance, nats.WithReadTimeout(readTimeout))
 	} else if x := src.JetStream; x != nil {
 		return jetstreamsrc.New(ctx, sp.VertexInstance, jetstreamsrc.WithReadTimeout(readTimeout))
+	} else if x := src.Replay; x != nil {
+		return replay.New(ctx, sp.VertexInstance, replay.WithReadTimeout(readTimeout))
 	} else if x := src.Serving; x != nil {
 		return jetstreamsrc.New(ctx, sp.VertexInstance, jetstreamsrc.WithReadTimeout(readTimeout), jetstreamsrc.WithServingEnabled())
 	}
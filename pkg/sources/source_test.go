@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+type callRecorder struct {
+	calls []string
+}
+
+func (r *callRecorder) record(call string) {
+	r.calls = append(r.calls, call)
+}
+
+type fakeSourceReader struct {
+	r *callRecorder
+}
+
+func (f *fakeSourceReader) GetName() string { return "fake-source" }
+func (f *fakeSourceReader) Read(context.Context, int64) ([]*isb.ReadMessage, error) {
+	return nil, nil
+}
+func (f *fakeSourceReader) Ack(_ context.Context, offsets []isb.Offset) []error {
+	return make([]error, len(offsets))
+}
+func (f *fakeSourceReader) Partitions(context.Context) []int32 { return []int32{0} }
+func (f *fakeSourceReader) Pending(context.Context) (int64, error) {
+	return isb.PendingNotAvailable, nil
+}
+func (f *fakeSourceReader) StopProducing() { f.r.record("stop producing") }
+func (f *fakeSourceReader) Close() error {
+	f.r.record("close reader")
+	return nil
+}
+
+// fakeForwarder mimics the source forwarder, which cleans up its own resources once it is drained.
+type fakeForwarder struct {
+	r       *callRecorder
+	err     error
+	stopped chan error
+}
+
+func (f *fakeForwarder) Stop() {
+	f.r.record("stop forwarder")
+	go func() {
+		f.r.record("flush acks")
+		f.r.record("close publishers")
+		f.r.record("close reader")
+		if f.err != nil {
+			f.stopped <- f.err
+		}
+		close(f.stopped)
+	}()
+}
+
+type fakeWatermarkStore struct {
+	name string
+	r    *callRecorder
+}
+
+func (f *fakeWatermarkStore) HeartbeatStore() kvs.KVStorer      { return nil }
+func (f *fakeWatermarkStore) OffsetTimelineStore() kvs.KVStorer { return nil }
+func (f *fakeWatermarkStore) Close() error {
+	f.r.record("close " + f.name)
+	return nil
+}
+
+func TestBuildShutdownSequence(t *testing.T) {
+	r := &callRecorder{}
+	reader := &fakeSourceReader{r: r}
+	fwd := &fakeForwarder{r: r, stopped: make(chan error)}
+	wmStores := map[string]store.WatermarkStore{
+		"source publisher": &fakeWatermarkStore{name: "store", r: r},
+	}
+	sequence := buildShutdownSequence(logging.NewLogger(), reader, fwd, fwd.stopped, wmStores)
+	assert.NoError(t, sequence.Shutdown(context.Background()))
+	assert.Equal(t, []string{"stop producing", "stop forwarder", "flush acks", "close publishers", "close reader", "close store"}, r.calls)
+}
+
+func TestBuildShutdownSequence_ForwarderError(t *testing.T) {
+	r := &callRecorder{}
+	reader := &fakeSourceReader{r: r}
+	fwd := &fakeForwarder{r: r, err: errors.New("critical error"), stopped: make(chan error)}
+	wmStores := map[string]store.WatermarkStore{
+		"source publisher": &fakeWatermarkStore{name: "store", r: r},
+	}
+	sequence := buildShutdownSequence(logging.NewLogger(), reader, fwd, fwd.stopped, wmStores)
+	// the stores are closed even if the forwarder fails.
+	assert.ErrorContains(t, sequence.Shutdown(context.Background()), "critical error")
+	assert.Equal(t, []string{"stop producing", "stop forwarder", "flush acks", "close publishers", "close reader", "close store"}, r.calls)
+}
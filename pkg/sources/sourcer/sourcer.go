@@ -19,6 +19,7 @@ package sourcer
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/isb"
 )
@@ -43,5 +44,34 @@ type SourceReader interface {
 	Pending(context.Context) (int64, error)
 }
 
+// ProducerStopper is implemented by the sources that can stop producing new messages, while the messages already
+// produced can still be read and acked. It is called at the first stage of the shutdown sequence.
+type ProducerStopper interface {
+	// StopProducing stops producing new messages.
+	StopProducing()
+}
+
+// Drainer is implemented by the sources that hold the produced messages until they are read, e.g., the generator. On
+// Stop, the forwarder stops producing and keeps forwarding until the source is empty or the drain timeout elapses.
+type Drainer interface {
+	// IsEmpty returns whether all the produced messages have been read.
+	IsEmpty() bool
+}
+
+// IdleReporter is implemented by the sources that report themselves as idle when no message is read for a threshold,
+// e.g., the generator. If the idle source watermark is not configured, the forwarder publishes the current time minus
+// the max delay as the idle watermark of such a source.
+type IdleReporter interface {
+	// IdleThreshold returns the duration without any message after which the source is idle, 0 means never.
+	IdleThreshold() time.Duration
+}
+
+// AckFlusher is implemented by the sources that commit the acks asynchronously. It is called after the forwarder is
+// drained, and before the watermark publishers and the source are closed.
+type AckFlusher interface {
+	// FlushAcks commits the acks that have been issued.
+	FlushAcks(context.Context) error
+}
+
 // SourceReader can be used as LagReader.
 var _ isb.LagReader = (SourceReader)(nil)
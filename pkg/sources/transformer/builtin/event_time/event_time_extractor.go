@@ -19,12 +19,13 @@ package eventtime
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
-	"github.com/araddon/dateparse"
 	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
 
-	"github.com/numaproj/numaflow/pkg/shared/expr"
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedeventtime "github.com/numaproj/numaflow/pkg/shared/eventtime"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
@@ -36,6 +37,9 @@ type eventTimeExtractor struct {
 	// with format, eventTimeExtractor uses the time.Parse function to translate the event time string representation to time.Time object.
 	// otherwise if format is not specified, eventTimeExtractor uses dateparse to find format based on the time string.
 	format string
+	// policy is applied when the event time is missing or can not be parsed, by default the message keeps the event
+	// time assigned by the source.
+	policy *sharedeventtime.Policy
 }
 
 func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
@@ -49,39 +53,42 @@ func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error)
 		format = ""
 	}
 
+	onInvalid, err := sharedeventtime.ParsePolicy(args["onInvalidEventTime"], dfv1.InvalidEventTimeFallbackToIngestionTime)
+	if err != nil {
+		return nil, err
+	}
+
 	e := eventTimeExtractor{
 		expression: expr,
 		format:     format,
+		policy:     sharedeventtime.NewPolicy(onInvalid, os.Getenv(dfv1.EnvVertexName), os.Getenv(dfv1.EnvPipelineName)),
 	}
 
 	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
 		log := logging.FromContext(ctx)
 		resultMsg, err := e.apply(datum.Value(), datum.EventTime(), keys)
 		if err != nil {
-			log.Warnf("event time extractor got an error: %v, skip updating event time...", err)
+			log.Warnf("event time extractor got an error: %v, applied the %q policy...", err, e.policy.OnInvalid())
 		}
 		return sourcetransformer.MessagesBuilder().Append(resultMsg)
 	}, nil
 }
 
-// apply compiles the payload to extract the new event time. If there is any error during extraction,
-// we pass on the original input event time. Otherwise, we assign the new event time to the message.
+// apply compiles the payload to extract the new event time. If there is any error during extraction, the event time
+// or the drop of the message is decided by the policy, the message is dropped with the error policy because a source
+// transformer can not return an error, and it's counted in the invalid event time metric. Otherwise, we assign the new
+// event time to the message.
 func (e eventTimeExtractor) apply(payload []byte, et time.Time, keys []string) (sourcetransformer.Message, error) {
-	timeStr, err := expr.EvalStr(e.expression, payload)
-	if err != nil {
-		return sourcetransformer.NewMessage(payload, et).WithKeys(keys), err
+	newEventTime, err := sharedeventtime.Extract(e.expression, e.format, payload)
+	if err == nil {
+		return sourcetransformer.NewMessage(payload, newEventTime).WithKeys(keys), nil
 	}
-
-	var newEventTime time.Time
-	time.Local, _ = time.LoadLocation("UTC")
-	if e.format != "" {
-		newEventTime, err = time.Parse(e.format, timeStr)
-	} else {
-		newEventTime, err = dateparse.ParseStrict(timeStr)
+	newEventTime, drop, policyErr := e.policy.Apply(err, et, time.Now())
+	if policyErr != nil {
+		return sourcetransformer.MessageToDrop(et), policyErr
 	}
-	if err != nil {
-		return sourcetransformer.NewMessage(payload, et).WithKeys(keys), err
-	} else {
-		return sourcetransformer.NewMessage(payload, newEventTime).WithKeys(keys), nil
+	if drop {
+		return sourcetransformer.MessageToDrop(et), err
 	}
+	return sourcetransformer.NewMessage(payload, newEventTime).WithKeys(keys), err
 }
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -228,4 +229,53 @@ func TestEventTimeExtractor(t *testing.T) {
 		// Verify the keys remain unchanged.
 		assert.Equal(t, _keys, result.Items()[0].Keys())
 	})
+
+	t.Run("Unsupported invalid event time policy, return error", func(t *testing.T) {
+		_, err := New(map[string]string{"expression": "json(payload).time", "onInvalidEventTime": "ignore"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing event time with the drop policy, drop the message", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": "json(payload).time", "onInvalidEventTime": "drop"})
+		assert.NoError(t, err)
+
+		testInputEventTime := time.Date(2022, 1, 4, 2, 3, 4, 5, time.UTC)
+		result := handle(context.Background(), _keys, &testDatum{
+			value:     []byte(`{"test": 21}`),
+			eventTime: testInputEventTime,
+			watermark: time.Time{},
+		})
+
+		assert.Equal(t, sourcetransformer.MessageToDrop(testInputEventTime), result.Items()[0])
+	})
+
+	t.Run("Malformed event time with the fallbackToNow policy, assign the current time", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": "json(payload).time", "onInvalidEventTime": "fallbackToNow"})
+		assert.NoError(t, err)
+
+		testInputEventTime := time.Date(2022, 1, 4, 2, 3, 4, 5, time.UTC)
+		before := time.Now()
+		result := handle(context.Background(), _keys, &testDatum{
+			value:     []byte(`{"time": true}`),
+			eventTime: testInputEventTime,
+			watermark: time.Time{},
+		})
+
+		assert.False(t, result.Items()[0].EventTime().Before(before))
+		assert.Equal(t, _keys, result.Items()[0].Keys())
+	})
+
+	t.Run("Invalid event time with the error policy, drop the message", func(t *testing.T) {
+		handle, err := New(map[string]string{"expression": "json(payload).time", "onInvalidEventTime": "error"})
+		assert.NoError(t, err)
+
+		testInputEventTime := time.Date(2022, 1, 4, 2, 3, 4, 5, time.UTC)
+		result := handle(context.Background(), _keys, &testDatum{
+			value:     []byte(`{"time": `),
+			eventTime: testInputEventTime,
+			watermark: time.Time{},
+		})
+
+		assert.Equal(t, sourcetransformer.MessageToDrop(testInputEventTime), result.Items()[0])
+	})
 }
@@ -19,11 +19,13 @@ package timeextractionfilter
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
-	"github.com/araddon/dateparse"
 	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedeventtime "github.com/numaproj/numaflow/pkg/shared/eventtime"
 	"github.com/numaproj/numaflow/pkg/shared/expr"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
@@ -32,6 +34,9 @@ type expressions struct {
 	filterExpr      string
 	eventTimeExpr   string
 	eventTimeFormat string
+	// policy is applied when the event time is missing or can not be parsed, by default the message keeps the event
+	// time assigned by the source.
+	policy *sharedeventtime.Policy
 }
 
 func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
@@ -51,10 +56,16 @@ func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error)
 		eventTimeFormat = ""
 	}
 
+	onInvalid, err := sharedeventtime.ParsePolicy(args["onInvalidEventTime"], dfv1.InvalidEventTimeFallbackToIngestionTime)
+	if err != nil {
+		return nil, err
+	}
+
 	e := expressions{
 		filterExpr:      filterExpr,
 		eventTimeExpr:   eventTimeExpr,
 		eventTimeFormat: eventTimeFormat,
+		policy:          sharedeventtime.NewPolicy(onInvalid, os.Getenv(dfv1.EnvVertexName), os.Getenv(dfv1.EnvPipelineName)),
 	}
 
 	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
@@ -74,22 +85,20 @@ func (e expressions) apply(et time.Time, payload []byte, keys []string) (sourcet
 		return sourcetransformer.MessageToDrop(et), err
 	}
 	if result {
-		timeStr, err := expr.EvalStr(e.eventTimeExpr, payload)
-		if err != nil {
-			return sourcetransformer.NewMessage(payload, et).WithKeys(keys), err
+		newEventTime, err := sharedeventtime.Extract(e.eventTimeExpr, e.eventTimeFormat, payload)
+		if err == nil {
+			return sourcetransformer.NewMessage(payload, newEventTime).WithKeys(keys), nil
 		}
-		var newEventTime time.Time
-		time.Local, _ = time.LoadLocation("UTC")
-		if e.eventTimeFormat != "" {
-			newEventTime, err = time.Parse(e.eventTimeFormat, timeStr)
-		} else {
-			newEventTime, err = dateparse.ParseStrict(timeStr)
+		newEventTime, drop, policyErr := e.policy.Apply(err, et, time.Now())
+		if policyErr != nil {
+			// a source transformer can not return an error, the message is dropped and counted in the invalid event
+			// time metric.
+			return sourcetransformer.MessageToDrop(et), policyErr
 		}
-		if err != nil {
-			return sourcetransformer.NewMessage(payload, et).WithKeys(keys), err
-		} else {
-			return sourcetransformer.NewMessage(payload, newEventTime).WithKeys(keys), nil
+		if drop {
+			return sourcetransformer.MessageToDrop(et), err
 		}
+		return sourcetransformer.NewMessage(payload, newEventTime).WithKeys(keys), err
 	}
 	return sourcetransformer.MessageToDrop(et), nil
 }
@@ -139,4 +139,32 @@ func TestFilterEventTime(t *testing.T) {
 		// check that keys have not been added
 		assert.Equal(t, _keys, result.Items()[0].Keys())
 	})
+
+	t.Run("Valid JSON expression for filter, missing eventTime with the drop policy", func(t *testing.T) {
+		handle, err := New(map[string]string{"filterExpr": "int(json(payload).item[1].id) == 2", "eventTimeExpr": "json(payload).item[1].missing", "onInvalidEventTime": "drop"})
+		assert.NoError(t, err)
+
+		testInputEventTime := time.Date(2022, 1, 4, 2, 3, 4, 5, time.UTC)
+		result := handle(context.Background(), _keys, &testDatum{
+			value:     []byte(testJsonMsg),
+			eventTime: testInputEventTime,
+			watermark: time.Time{},
+		})
+
+		assert.Equal(t, sourcetransformer.MessageToDrop(testInputEventTime), result.Items()[0])
+	})
+
+	t.Run("Valid JSON expression for filter, incorrect format to eventTime with the error policy", func(t *testing.T) {
+		handle, err := New(map[string]string{"filterExpr": "int(json(payload).item[1].id) == 2", "eventTimeExpr": "json(payload).item[1].time", "eventTimeFormat": time.ANSIC, "onInvalidEventTime": "error"})
+		assert.NoError(t, err)
+
+		testInputEventTime := time.Date(2022, 1, 4, 2, 3, 4, 5, time.UTC)
+		result := handle(context.Background(), _keys, &testDatum{
+			value:     []byte(testJsonMsg),
+			eventTime: testInputEventTime,
+			watermark: time.Time{},
+		})
+
+		assert.Equal(t, sourcetransformer.MessageToDrop(testInputEventTime), result.Items()[0])
+	})
 }
@@ -21,9 +21,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/IBM/sarama"
+
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/encryption"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
+	kafkaisb "github.com/numaproj/numaflow/pkg/isb/stores/kafka"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
@@ -32,8 +36,11 @@ import (
 
 func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
 	var readers []isb.BufferReader
-	redisClient := redisclient.NewInClusterRedisClient()
-	var readerOpts []redisclient.Option
+	redisClient, err := redisclient.NewInClusterRedisClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create a new Redis client: %w", err)
+	}
+	readerOpts := []redisclient.Option{redisclient.WithVertexInstance(vertexInstance)}
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 		readerOpts = append(readerOpts, redisclient.WithReadTimeOut(x.ReadTimeout.Duration))
 	}
@@ -90,14 +97,85 @@ func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance
 		writers[e.To] = edgeBuffers
 	}
 
-	return readers, writers, nil
+	return encryptBufferIO(vertexInstance, readers, writers)
+}
+
+func buildKafkaBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance, client sarama.Client) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
+
+	// create readers for owned buffer partitions.
+	var readers []isb.BufferReader
+	readOptions := []kafkaisb.ReadOption{kafkaisb.WithVertexInstance(vertexInstance)}
+	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
+		readOptions = append(readOptions, kafkaisb.WithReadTimeOut(x.ReadTimeout.Duration))
+	}
+
+	// create readers for owned buffer partitions.
+	// For reduce vertex, we only need to read from one buffer partition.
+	if vertexInstance.Vertex.GetVertexType() == dfv1.VertexTypeReduceUDF {
+		var fromBufferPartition string
+		// find the buffer partition owned by this replica.
+		for _, b := range vertexInstance.Vertex.OwnedBuffers() {
+			if strings.HasSuffix(b, fmt.Sprintf("-%d", vertexInstance.Replica)) {
+				fromBufferPartition = b
+				break
+			}
+		}
+		if len(fromBufferPartition) == 0 {
+			return nil, nil, fmt.Errorf("can not find from buffer")
+		}
+
+		// since we read from one buffer partition, fromPartitionIdx is 0.
+		reader, err := kafkaisb.NewKafkaBufferReader(ctx, client, fromBufferPartition, isbsvc.KafkaTopicName(fromBufferPartition), isbsvc.KafkaConsumerGroupName(fromBufferPartition), 0, readOptions...)
+		if err != nil {
+			return nil, nil, err
+		}
+		readers = append(readers, reader)
+	} else {
+		// for map vertex, we need to read from all buffer partitions. So create readers for all buffer partitions.
+		for index, bufferPartition := range vertexInstance.Vertex.OwnedBuffers() {
+			reader, err := kafkaisb.NewKafkaBufferReader(ctx, client, bufferPartition, isbsvc.KafkaTopicName(bufferPartition), isbsvc.KafkaConsumerGroupName(bufferPartition), int32(index), readOptions...)
+			if err != nil {
+				return nil, nil, err
+			}
+			readers = append(readers, reader)
+		}
+	}
+
+	// create writers for toVertex's buffer partitions.
+	// we create a map of toVertex -> []BufferWriter(writer for each partition)
+	writers := make(map[string][]isb.BufferWriter)
+	for _, e := range vertexInstance.Vertex.Spec.ToEdges {
+		writeOpts := []kafkaisb.WriteOption{
+			kafkaisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+		}
+		if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
+			writeOpts = append(writeOpts, kafkaisb.WithMaxLength(int64(*x.BufferMaxLength)))
+		}
+		if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
+			writeOpts = append(writeOpts, kafkaisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
+		}
+
+		partitionedBuffers := dfv1.GenerateBufferNames(vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+		var edgeBuffers []isb.BufferWriter
+		for partitionIdx, partition := range partitionedBuffers {
+			writer, err := kafkaisb.NewKafkaBufferWriter(ctx, client, partition, isbsvc.KafkaTopicName(partition), isbsvc.KafkaConsumerGroupName(partition), int32(partitionIdx), writeOpts...)
+			if err != nil {
+				return nil, nil, err
+			}
+			edgeBuffers = append(edgeBuffers, writer)
+		}
+
+		writers[e.To] = edgeBuffers
+	}
+
+	return encryptBufferIO(vertexInstance, readers, writers)
 }
 
 func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance, clientPool *jsclient.ClientPool) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
 
 	// create readers for owned buffer partitions.
 	var readers []isb.BufferReader
-	var readOptions []jetstreamisb.ReadOption
+	readOptions := []jetstreamisb.ReadOption{jetstreamisb.WithVertexInstance(vertexInstance)}
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 		readOptions = append(readOptions, jetstreamisb.WithReadTimeOut(x.ReadTimeout.Duration))
 	}
@@ -167,5 +245,15 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 
 		writers[e.To] = edgeBuffers
 	}
-	return readers, writers, nil
+	return encryptBufferIO(vertexInstance, readers, writers)
+}
+
+// encryptBufferIO wraps the readers and writers to decrypt and encrypt the message payloads, if the encryption of
+// the inter-step buffers is enabled.
+func encryptBufferIO(vertexInstance *dfv1.VertexInstance, readers []isb.BufferReader, writers map[string][]isb.BufferWriter) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
+	keyring, err := encryption.NewVertexKeyring(vertexInstance.Vertex)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the isb encryption keys: %w", err)
+	}
+	return encryption.WrapReaders(readers, keyring), encryption.WrapWriters(writers, keyring), nil
 }
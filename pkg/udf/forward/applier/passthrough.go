@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applier
+
+import (
+	"context"
+	"strings"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// PassThroughMap is a MapApplier forwarding the read messages as they are, it's used by the pass-through vertices which
+// don't have a UDF container.
+type PassThroughMap struct {
+	vertexName string
+	// tagsHeader is the name of the header carrying the comma separated tags for conditional forwarding.
+	tagsHeader string
+}
+
+var _ MapApplier = (*PassThroughMap)(nil)
+
+// NewPassThroughMap returns a new PassThroughMap, the tags of the messages are read from the tagsHeader if it's not empty.
+func NewPassThroughMap(vertexName, tagsHeader string) *PassThroughMap {
+	return &PassThroughMap{
+		vertexName: vertexName,
+		tagsHeader: tagsHeader,
+	}
+}
+
+// ApplyMap returns one write message for each read message with the same keys, payload and headers.
+func (p *PassThroughMap) ApplyMap(_ context.Context, messages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, error) {
+	results := make([]isb.ReadWriteMessagePair, len(messages))
+	for i, message := range messages {
+		writeMessage := &isb.WriteMessage{
+			Message: isb.Message{
+				Header: isb.Header{
+					MessageInfo: message.MessageInfo,
+					ID: isb.MessageID{
+						VertexName: p.vertexName,
+						Offset:     message.ReadOffset.String(),
+						Index:      0,
+					},
+					Keys:    message.Keys,
+					Headers: message.Headers,
+				},
+				Body: message.Body,
+			},
+			Tags: p.tags(message),
+		}
+		results[i] = isb.ReadWriteMessagePair{
+			ReadMessage:   message,
+			WriteMessages: []*isb.WriteMessage{writeMessage},
+		}
+	}
+	return results, nil
+}
+
+// tags returns the non-empty tags in the tags header of the message.
+func (p *PassThroughMap) tags(message *isb.ReadMessage) []string {
+	if p.tagsHeader == "" {
+		return nil
+	}
+	value, ok := message.Headers[p.tagsHeader]
+	if !ok {
+		return nil
+	}
+	var tags []string
+	for _, t := range strings.Split(value, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
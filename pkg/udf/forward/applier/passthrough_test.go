@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applier
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestPassThroughMap_ApplyMap(t *testing.T) {
+	readMessage := func(offset int64, headers map[string]string) *isb.ReadMessage {
+		return &isb.ReadMessage{
+			Message: isb.Message{
+				Header: isb.Header{
+					MessageInfo: isb.MessageInfo{EventTime: time.Unix(60, 0)},
+					Keys:        []string{"key"},
+					Headers:     headers,
+				},
+				Body: isb.Body{Payload: []byte("payload")},
+			},
+			ReadOffset: isb.NewSimpleIntPartitionOffset(offset, 0),
+		}
+	}
+	messages := []*isb.ReadMessage{
+		readMessage(1, map[string]string{"X-Tags": "even, , small"}),
+		readMessage(2, map[string]string{"X-Other": "odd"}),
+		readMessage(3, nil),
+	}
+
+	results, err := NewPassThroughMap("router", "X-Tags").ApplyMap(context.Background(), messages)
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	for i, r := range results {
+		assert.Equal(t, messages[i], r.ReadMessage)
+		assert.Len(t, r.WriteMessages, 1)
+		wm := r.WriteMessages[0]
+		assert.Equal(t, isb.MessageID{VertexName: "router", Offset: messages[i].ReadOffset.String()}, wm.ID)
+		assert.Equal(t, messages[i].Keys, wm.Keys)
+		assert.Equal(t, messages[i].Headers, wm.Headers)
+		assert.Equal(t, messages[i].EventTime, wm.EventTime)
+		assert.Equal(t, []byte("payload"), wm.Payload)
+	}
+	assert.Equal(t, []string{"even", "small"}, results[0].WriteMessages[0].Tags)
+	assert.Nil(t, results[1].WriteMessages[0].Tags)
+	assert.Nil(t, results[2].WriteMessages[0].Tags)
+
+	// no tags without the tags header
+	results, err = NewPassThroughMap("router", "").ApplyMap(context.Background(), messages[:1])
+	assert.NoError(t, err)
+	assert.Nil(t, results[0].WriteMessages[0].Tags)
+}
@@ -26,6 +26,7 @@ import (
 	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -35,6 +36,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -56,8 +58,10 @@ type InterStepDataForward struct {
 	fsd       forwarder.ToWhichStepDecider
 	wmFetcher fetch.Fetcher
 	// wmPublishers stores the vertex to publisher mapping
-	wmPublishers  map[string]publish.Publisher
-	opts          options
+	wmPublishers map[string]publish.Publisher
+	opts         options
+	// readBatchSize is the number of messages read in a batch, it can be changed at runtime by ApplyLimits.
+	readBatchSize atomic.Int64
 	vertexName    string
 	pipelineName  string
 	vertexReplica int32
@@ -65,6 +69,15 @@ type InterStepDataForward struct {
 	idleManager wmb.IdleManager
 	// wmbChecker checks if the idle watermark is valid when the len(readMessage) is 0.
 	wmbChecker wmb.WMBChecker
+	// eventTimeTracker tracks the high-water event time of the read messages.
+	eventTimeTracker *forwarder.EventTimeTracker
+	// slowBatchLogger logs the stage breakdown of the slow batches.
+	slowBatchLogger *forwarder.SlowBatchLogger
+	// batchTimer captures the stage timings of the batch being forwarded, it is nil when the slow batch logging is disabled.
+	batchTimer *forwarder.BatchTimer
+	// completions tracks the destinations the read messages of the batch being forwarded are routed to, a read
+	// message is acked only after all of them have reached a terminal state.
+	completions *forwarder.CompletionTracker
 	Shutdown
 }
 
@@ -77,6 +90,9 @@ func NewInterStepDataForward(vertexInstance *dfv1.VertexInstance, fromStep isb.B
 			return nil, err
 		}
 	}
+	if err := forwarder.ValidateWriterNames(toSteps); err != nil {
+		return nil, err
+	}
 
 	// creating a context here which is managed by the forwarder's lifecycle
 	ctx, cancel := context.WithCancel(context.Background())
@@ -95,11 +111,20 @@ func NewInterStepDataForward(vertexInstance *dfv1.VertexInstance, fromStep isb.B
 		vertexReplica: vertexInstance.Replica,
 		idleManager:   idleManager,
 		wmbChecker:    wmb.NewWMBChecker(2), // TODO: make configurable
+		eventTimeTracker: forwarder.NewEventTimeTracker(options.futureEventTimeBound, map[string]string{
+			metrics.LabelVertex:             vertexInstance.Vertex.Spec.Name,
+			metrics.LabelPipeline:           vertexInstance.Vertex.Spec.PipelineName,
+			metrics.LabelVertexType:         string(dfv1.VertexTypeMapUDF),
+			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(vertexInstance.Replica)),
+			metrics.LabelPartitionName:      fromStep.GetName(),
+		}),
+		slowBatchLogger: forwarder.NewSlowBatchLogger(options.logger, options.slowBatchThreshold),
 		Shutdown: Shutdown{
 			rwlock: new(sync.RWMutex),
 		},
 		opts: *options,
 	}
+	isdf.readBatchSize.Store(options.readBatchSize)
 
 	// Add logger from parent ctx to child context.
 	isdf.ctx = logging.WithLogger(ctx, options.logger)
@@ -111,6 +136,15 @@ func NewInterStepDataForward(vertexInstance *dfv1.VertexInstance, fromStep isb.B
 	return &isdf, nil
 }
 
+// ApplyLimits applies the reloadable limits, they take effect from the next batch. The read batch size of a
+// streaming map UDF is always 1, so the update is ignored for it.
+func (isdf *InterStepDataForward) ApplyLimits(s limits.Settings) {
+	if isdf.opts.streamMapUdfApplier != nil {
+		return
+	}
+	isdf.readBatchSize.Store(int64(s.GetReadBatchSize()))
+}
+
 // Start starts reading the buffer and forwards to the next buffers. Call `Stop` to stop.
 func (isdf *InterStepDataForward) Start() <-chan error {
 	log := logging.FromContext(isdf.ctx)
@@ -195,8 +229,12 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	// There is a chance that we have read the message and the container got forcefully terminated before processing. To provide
 	// at-least-once semantics for reading, during restart we will have to reprocess all unacknowledged messages. It is the
 	// responsibility of the Read function to do that.
+	isdf.batchTimer = isdf.slowBatchLogger.Start()
+	timer := isdf.batchTimer
 	readStart := time.Now()
-	readMessages, err := isdf.fromBufferPartition.Read(ctx, isdf.opts.readBatchSize)
+	stageStart := timer.Now()
+	readMessages, err := isdf.fromBufferPartition.Read(ctx, isdf.readBatchSize.Load())
+	timer.Observe(forwarder.StageReadWait, stageStart)
 	isdf.opts.logger.Debugw("Read from buffer", zap.String("bufferFrom", isdf.fromBufferPartition.GetName()), zap.Int64("length", int64(len(readMessages))))
 	if err != nil {
 		isdf.opts.logger.Warnw("failed to read fromBufferPartition", zap.Error(err))
@@ -235,6 +273,7 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	}
 
 	var dataMessages = make([]*isb.ReadMessage, 0, len(readMessages))
+	isdf.completions = forwarder.NewCompletionTracker(readMessages)
 
 	// store the offsets of the messages we read from ISB
 	var readOffsets = make([]isb.Offset, len(readMessages))
@@ -262,25 +301,37 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	metrics.ReadMessagesCount.With(metricLabelsWithPartition).Add(float64(len(readMessages)))
 	metrics.ReadBytesCount.With(metricLabelsWithPartition).Add(float64(totalBytes))
 	metrics.ReadDataBytesCount.With(metricLabelsWithPartition).Add(float64(dataBytes))
+	if n := isdf.eventTimeTracker.Observe(dataMessages, time.Now()); n > 0 {
+		isdf.opts.logger.Warnw("Read messages with event time in the future", zap.Int("count", n), zap.Time("highWaterEventTime", isdf.eventTimeTracker.HighWaterEventTime()))
+	}
 
 	// fetch watermark if available
 	// TODO: make it async (concurrent and wait later)
-	// let's track only the first element's watermark. This is important because we reassign the watermark we fetch
-	// to all the elements in the batch. If we were to assign last element's watermark, we will wrongly mark on-time data as late.
+	// the watermark of the batch is the first element's watermark, it's the one we publish. Each element gets the
+	// watermark of its own offset, resolved with a single lookup of the timelines for the whole batch. Assigning the
+	// last element's watermark to the whole batch would wrongly mark on-time data as late.
 	// we fetch the watermark for the partition from which we read the message.
-	processorWM := isdf.wmFetcher.ComputeWatermark(readMessages[0].ReadOffset, isdf.fromBufferPartition.GetPartitionIdx())
+	stageStart = timer.Now()
+	processorWM, resolveWM := fetch.ComputeBatchWatermark(isdf.wmFetcher, readMessages[0].ReadOffset, readMessages[len(readMessages)-1].ReadOffset, isdf.fromBufferPartition.GetPartitionIdx())
+	timer.Observe(forwarder.StageWatermarkFetch, stageStart)
 
 	// assign watermark to data messages
 	for _, msg := range dataMessages {
-		msg.Watermark = time.Time(processorWM)
+		msg.Watermark = time.Time(resolveWM(msg.ReadOffset))
 		// emit message size metric
 	}
 
 	var udfResults []isb.ReadWriteMessagePair
 	var writeOffsets map[string][][]isb.Offset
+	// droppedCounts is the number of messages dropped while writing to each of the toBuffer partitions.
+	var droppedCounts map[string][]int
 	// Check if map streaming mode is enabled, if the applier is not nil that means we have enabled the required mode
 	if isdf.opts.streamMapUdfApplier != nil {
-		writeOffsets, err = isdf.streamMessage(ctx, dataMessages)
+		stageStart = timer.Now()
+		writeBefore := timer.Duration(forwarder.StageWrite)
+		writeOffsets, droppedCounts, err = isdf.streamMessage(ctx, dataMessages)
+		// the writes are interleaved with the UDF while streaming, they are excluded from the UDF time.
+		timer.Observe(forwarder.StageUDF, stageStart.Add(timer.Duration(forwarder.StageWrite)-writeBefore))
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to streamMessage", zap.Error(err))
 			// As there's no partial failure, non-ack all the readOffsets
@@ -297,7 +348,9 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 		// Trigger the UDF processing based on the mode enabled for map
 		// ie Batch Map or unary map
 		// This will be a blocking call until the all the UDF results for the batch are received.
+		stageStart = timer.Now()
 		udfResults, err = isdf.applyUDF(ctx, dataMessages)
+		timer.Observe(forwarder.StageUDF, stageStart)
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to applyUDF", zap.Error(err))
 			// As there's no partial failure, non-ack all the readOffsets
@@ -319,7 +372,7 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 		}
 
 		// forward the message to the edge buffer (could be multiple edges)
-		writeOffsets, err = isdf.writeToBuffers(ctx, messageToStep)
+		writeOffsets, droppedCounts, err = isdf.writeToBuffers(ctx, messageToStep)
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to write to toBuffers", zap.Error(err))
 			isdf.fromBufferPartition.NoAck(ctx, readOffsets)
@@ -333,6 +386,7 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	// it's used to determine which buffers should receive an idle watermark.
 	// It is created as a slice because it tracks per partition activity info.
 	var activeWatermarkBuffers = make(map[string][]bool)
+	stageStart = timer.Now()
 	// forward the highest watermark to all the edges to avoid idle edge problem
 	// TODO: sort and get the highest value
 	for toVertexName, toVertexBufferOffsets := range writeOffsets {
@@ -344,8 +398,16 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 					activeWatermarkBuffers[toVertexName][index] = true
 					// reset because the toBuffer partition is no longer idling
 					isdf.idleManager.MarkActive(isdf.fromBufferPartition.GetPartitionIdx(), isdf.toBuffers[toVertexName][index].GetName())
+				} else if droppedCounts[toVertexName][index] > 0 {
+					// all the messages to the partition were dropped, their offsets are acked without being forwarded.
+					// advance the watermark through the gap rather than treating the partition as idling, which needs
+					// a ctrl message to be written to a buffer that might be full.
+					if publisher.PublishSkippedWatermark(processorWM, nil, int32(index)) {
+						activeWatermarkBuffers[toVertexName][index] = true
+						isdf.idleManager.MarkActive(isdf.fromBufferPartition.GetPartitionIdx(), isdf.toBuffers[toVertexName][index].GetName())
+					}
 				}
-				// This (len(offsets) == 0) happens at conditional forwarding, there's no data written to the buffer
+				// This (len(offsets) == 0) also happens at conditional forwarding, there's no data written to the buffer
 			}
 		}
 	}
@@ -371,19 +433,28 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 			}
 		}
 	}
+	timer.Observe(forwarder.StageWatermarkPublish, stageStart)
 
-	// when we apply udf, we don't handle partial errors (it's either non or all, non will return early),
-	// so we should be able to ack all the readOffsets including data messages and control messages
+	// when we apply udf, we don't handle partial errors (it's either non or all, non will return early), so all the
+	// destinations of the data messages have reached a terminal state by now. Still, only the complete read messages,
+	// including the control messages, are acked, a message not written to all its destinations is redelivered.
+	ackOffsets := isdf.completions.Completed()
+	if incomplete := isdf.completions.Incomplete(); len(incomplete) > 0 {
+		isdf.opts.logger.Errorw("Messages not forwarded to all the destinations, not acking them", zap.Int("count", len(incomplete)))
+		isdf.fromBufferPartition.NoAck(ctx, incomplete)
+	}
 	ackStart := time.Now()
-	err = isdf.ackFromBuffer(ctx, readOffsets)
+	stageStart = timer.Now()
+	err = isdf.ackFromBuffer(ctx, ackOffsets)
+	timer.Observe(forwarder.StageAck, stageStart)
 	// implicit return for posterity :-)
 	if err != nil {
 		isdf.opts.logger.Errorw("Failed to ack from buffer", zap.Error(err))
-		metrics.AckMessageError.With(metricLabelsWithPartition).Add(float64(len(readOffsets)))
+		metrics.AckMessageError.With(metricLabelsWithPartition).Add(float64(len(ackOffsets)))
 		return err
 	}
 	metrics.AckProcessingTime.With(metricLabelsWithPartition).Observe(float64(time.Since(ackStart).Microseconds()))
-	metrics.AckMessagesCount.With(metricLabelsWithPartition).Add(float64(len(readOffsets)))
+	metrics.AckMessagesCount.With(metricLabelsWithPartition).Add(float64(len(ackOffsets)))
 
 	if isdf.opts.cbPublisher != nil {
 		// Publish the callback for the vertex
@@ -393,14 +464,16 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	}
 	// ProcessingTimes of the entire forwardAChunk
 	metrics.ForwardAChunkProcessingTime.With(metricLabels).Observe(float64(time.Since(start).Microseconds()))
+	isdf.slowBatchLogger.Finish(timer, len(readMessages))
 	return nil
 }
 
 // streamMessage streams the data messages to the next step.
-func (isdf *InterStepDataForward) streamMessage(ctx context.Context, dataMessages []*isb.ReadMessage) (map[string][][]isb.Offset, error) {
-	// Initialize maps for messages and offsets
+func (isdf *InterStepDataForward) streamMessage(ctx context.Context, dataMessages []*isb.ReadMessage) (map[string][][]isb.Offset, map[string][]int, error) {
+	// Initialize maps for messages, offsets and dropped counts
 	messageToStep := make(map[string][][]isb.Message)
 	writeOffsets := make(map[string][][]isb.Offset)
+	droppedCounts := make(map[string][]int)
 
 	metricLabels := map[string]string{
 		metrics.LabelVertex:             isdf.vertexName,
@@ -419,13 +492,14 @@ func (isdf *InterStepDataForward) streamMessage(ctx context.Context, dataMessage
 	for toVertex := range isdf.toBuffers {
 		messageToStep[toVertex] = make([][]isb.Message, len(isdf.toBuffers[toVertex]))
 		writeOffsets[toVertex] = make([][]isb.Offset, len(isdf.toBuffers[toVertex]))
+		droppedCounts[toVertex] = make([]int, len(isdf.toBuffers[toVertex]))
 	}
 
 	// Ensure dataMessages length is 1 for streaming
 	if len(dataMessages) != 1 {
 		errMsg := "data message size is not 1 with map UDF streaming"
 		isdf.opts.logger.Errorw(errMsg, zap.Int("dataMessagesSize", len(dataMessages)))
-		return nil, errors.New(errMsg)
+		return nil, nil, errors.New(errMsg)
 	}
 
 	// Process the single data message
@@ -445,18 +519,19 @@ func (isdf *InterStepDataForward) streamMessage(ctx context.Context, dataMessage
 
 		// Determine where to step and write to buffers
 		if err := isdf.whereToStep(&writeMessage, messageToStep, dataMessages[0]); err != nil {
-			return nil, fmt.Errorf("failed at whereToStep, error: %w", err)
+			return nil, nil, fmt.Errorf("failed at whereToStep, error: %w", err)
 		}
 
-		curWriteOffsets, err := isdf.writeToBuffers(ctx, messageToStep)
+		curWriteOffsets, curDroppedCounts, err := isdf.writeToBuffers(ctx, messageToStep)
 		if err != nil {
-			return nil, fmt.Errorf("failed to write to toBuffers, error: %w", err)
+			return nil, nil, fmt.Errorf("failed to write to toBuffers, error: %w", err)
 		}
 
-		// Merge current write offsets into the main writeOffsets map
+		// Merge current write offsets and dropped counts into the main maps
 		for vertexName, toVertexBufferOffsets := range curWriteOffsets {
 			for index, offsets := range toVertexBufferOffsets {
 				writeOffsets[vertexName][index] = append(writeOffsets[vertexName][index], offsets...)
+				droppedCounts[vertexName][index] += curDroppedCounts[vertexName][index]
 			}
 		}
 
@@ -473,12 +548,12 @@ func (isdf *InterStepDataForward) streamMessage(ctx context.Context, dataMessage
 			isdf.opts.logger.Errorw("mapUDF.Apply, Stop called while stuck on an internal error", zap.Error(err))
 			metrics.PlatformError.With(metricLabels).Inc()
 		}
-		return nil, fmt.Errorf("failed to applyUDF, error: %w", err)
+		return nil, nil, fmt.Errorf("failed to applyUDF, error: %w", err)
 	}
 
 	metrics.UDFProcessingTime.With(metricLabels).Observe(float64(time.Since(start).Microseconds()))
 
-	return writeOffsets, nil
+	return writeOffsets, droppedCounts, nil
 }
 
 // ackFromBuffer acknowledges an array of offsets back to fromBufferPartition and is a blocking call or until shutdown has been initiated.
@@ -531,29 +606,35 @@ func (isdf *InterStepDataForward) ackFromBuffer(ctx context.Context, offsets []i
 }
 
 // writeToBuffers is a blocking call until all the messages have be forwarded to all the toBuffers, or a shutdown
-// has been initiated while we are stuck looping on an InternalError.
+// has been initiated while we are stuck looping on an InternalError. Along with the write offsets, it returns the
+// number of messages dropped for each of the toBuffer partitions.
 func (isdf *InterStepDataForward) writeToBuffers(
 	ctx context.Context, messageToStep map[string][][]isb.Message,
-) (writeOffsets map[string][][]isb.Offset, err error) {
+) (writeOffsets map[string][][]isb.Offset, droppedCounts map[string][]int, err error) {
 	// messageToStep contains all the to buffers, so the messages could be empty (conditional forwarding).
 	// So writeOffsets also contains all the to buffers, but the returned offsets might be empty.
 	writeOffsets = make(map[string][][]isb.Offset)
+	droppedCounts = make(map[string][]int)
 	for toVertexName, toVertexMessages := range messageToStep {
 		writeOffsets[toVertexName] = make([][]isb.Offset, len(toVertexMessages))
+		droppedCounts[toVertexName] = make([]int, len(toVertexMessages))
 	}
 	for toVertexName, toVertexBuffer := range isdf.toBuffers {
 		for index, partition := range toVertexBuffer {
-			writeOffsets[toVertexName][index], err = isdf.writeToBuffer(ctx, partition, messageToStep[toVertexName][index])
+			writeStart := isdf.batchTimer.Now()
+			writeOffsets[toVertexName][index], droppedCounts[toVertexName][index], err = isdf.writeToBuffer(ctx, partition, messageToStep[toVertexName][index])
+			isdf.batchTimer.ObserveWrite(partition.GetName(), writeStart)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 	}
-	return writeOffsets, nil
+	return writeOffsets, droppedCounts, nil
 }
 
 // writeToBuffer forwards an array of messages to a single buffer and is a blocking call or until shutdown has been initiated.
-func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.BufferWriter, messages []isb.Message) (writeOffsets []isb.Offset, err error) {
+// It returns the offsets of the written messages, and the number of messages dropped due to non retryable errors.
+func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.BufferWriter, messages []isb.Message) (writeOffsets []isb.Offset, dropped int, err error) {
 	var (
 		totalCount int
 		writeCount int
@@ -597,6 +678,8 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 						metrics.LabelPartitionName:      toBufferPartition.GetName(),
 						metrics.LabelReason:             err.Error(),
 					}
+					dropped++
+					isdf.completions.Complete(msg.ID.String(), toBufferPartition.GetName(), forwarder.OutcomeDropped)
 					metrics.DropMessagesCount.With(metricLabelWithReason).Inc()
 					metrics.DropBytesCount.With(metricLabelWithReason).Add(float64(len(msg.Payload)))
 					isdf.opts.logger.Infow("Dropped message", zap.String("reason", err.Error()), zap.String("partition", toBufferPartition.GetName()), zap.String("vertex", isdf.vertexName), zap.String("pipeline", isdf.pipelineName), zap.String("msg_id", msg.ID.String()))
@@ -608,12 +691,13 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 					// a shutdown can break the blocking loop caused due to InternalErr
 					if ok, _ := isdf.IsShuttingDown(); ok {
 						metrics.PlatformError.With(metricLabels).Inc()
-						return writeOffsets, fmt.Errorf("writeToBuffer failed, Stop called while stuck on an internal error with failed messages:%d, %v", len(failedMessages), errs)
+						return writeOffsets, dropped, fmt.Errorf("writeToBuffer failed, Stop called while stuck on an internal error with failed messages:%d, %v", len(failedMessages), errs)
 					}
 				}
 			} else {
 				writeCount++
 				writeBytes += float64(len(msg.Payload))
+				isdf.completions.Complete(msg.ID.String(), toBufferPartition.GetName(), forwarder.OutcomeWritten)
 				// we support write offsets only for jetstream
 				if _writeOffsets != nil {
 					writeOffsets = append(writeOffsets, _writeOffsets[idx])
@@ -640,7 +724,7 @@ func (isdf *InterStepDataForward) writeToBuffer(ctx context.Context, toBufferPar
 	metrics.WriteProcessingTime.With(metricLabelsWithPartition).Observe(float64(time.Since(writeStart).Microseconds()))
 	metrics.WriteMessagesCount.With(metricLabelsWithPartition).Add(float64(writeCount))
 	metrics.WriteBytesCount.With(metricLabelsWithPartition).Add(writeBytes)
-	return writeOffsets, nil
+	return writeOffsets, dropped, nil
 }
 
 // applyUDF applies the map UDF and will block if there is any InternalErr. On the other hand, if this is a UserError
@@ -675,6 +759,7 @@ func (isdf *InterStepDataForward) whereToStep(writeMessage *isb.WriteMessage, me
 			isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.fromBufferPartition.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("no such destination (%s)", t.ToVertexName)}))
 		}
 		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], writeMessage.Message)
+		isdf.completions.Route(readMessage.ReadOffset, writeMessage.ID.String(), isdf.toBuffers[t.ToVertexName][t.ToVertexPartitionIdx].GetName())
 	}
 	return nil
 }
@@ -22,18 +22,22 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/goleak"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -62,7 +66,8 @@ type testForwardFetcher struct {
 }
 
 func TestMain(m *testing.M) {
-	goleak.VerifyTestMain(m)
+	// the keyring imported by the Pulsar client connects to the D-Bus session bus, if any, when it's initialized
+	goleak.VerifyTestMain(m, goleak.IgnoreAnyFunction("github.com/godbus/dbus.(*Conn).inWorker"))
 }
 
 func (t *testForwardFetcher) ComputeWatermark(isb.Offset, int32) wmb.Watermark {
@@ -1688,7 +1693,7 @@ func TestWriteToBuffer(t *testing.T) {
 			messageToStep["to1"] = make([][]isb.Message, 1)
 			writeMessages := testutils.BuildTestWriteMessages(4*value.batchSize, testStartTime, nil, "testVertex")
 			messageToStep["to1"][0] = append(messageToStep["to1"][0], writeMessages[0:value.batchSize+1]...)
-			_, err = f.writeToBuffers(ctx, messageToStep)
+			_, _, err = f.writeToBuffers(ctx, messageToStep)
 
 			assert.Equal(t, value.throwError, err != nil)
 			if value.throwError {
@@ -1700,6 +1705,102 @@ func TestWriteToBuffer(t *testing.T) {
 	}
 }
 
+// testDropFetcher returns the watermark set by the test, so that every batch gets a newer watermark.
+type testDropFetcher struct {
+	watermark atomic.Int64
+}
+
+func (t *testDropFetcher) ComputeWatermark(isb.Offset, int32) wmb.Watermark {
+	return wmb.Watermark(time.UnixMilli(t.watermark.Load()))
+}
+
+func (t *testDropFetcher) ComputeHeadIdleWMB(int32) wmb.WMB {
+	// won't be used
+	return wmb.WMB{}
+}
+
+// TestInterStepDataForwardDroppedMessagesWatermark tests that the watermark keeps advancing through the offsets of the
+// dropped messages, when most or all the messages of a batch are dropped because the toBuffer is full.
+func TestInterStepDataForwardDroppedMessagesWatermark(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0, simplebuffer.WithReadTimeOut(time.Second))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 11, 0, simplebuffer.WithBufferFullWritingStrategy(dfv1.DiscardLatest))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+			},
+		}},
+		Replica: 0,
+	}
+
+	fetchWatermark := &testDropFetcher{}
+	toVertexWmStores := buildWatermarkStores(toSteps)
+	publishWatermark, otStores := buildPublisherMapAndOTStoreFromWmStores(toSteps, toVertexWmStores)
+	defer func() {
+		for _, p := range publishWatermark {
+			_ = p.Close()
+		}
+		for _, store := range toVertexWmStores {
+			_ = store.Close()
+		}
+	}()
+
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, myForwardTest{}, fetchWatermark, publishWatermark, idleManager, WithReadBatchSize(10), WithUDFMap(myForwardTest{}))
+	assert.NoError(t, err)
+	stopped := f.Start()
+
+	headWMB := func() wmb.WMB {
+		otKeys, _ := otStores["to1"].GetAllKeys(ctx)
+		if len(otKeys) == 0 {
+			return wmb.WMB{}
+		}
+		otValue, _ := otStores["to1"].GetValue(ctx, otKeys[0])
+		otDecode, _ := wmb.DecodeToWMB(otValue)
+		return otDecode
+	}
+	writeBatch := func(watermark int64, messages []isb.Message) {
+		fetchWatermark.watermark.Store(watermark)
+		_, errs := fromStep.Write(ctx, messages)
+		assert.Equal(t, make([]error, len(messages)), errs)
+		assert.Eventually(t, fromStep.IsEmpty, 5*time.Second, time.Millisecond)
+	}
+	writeMessages := testutils.BuildTestWriteMessages(int64(30), testStartTime, nil, "testVertex")
+
+	// 1st batch: all the messages are written.
+	writeBatch(1636440000000, writeMessages[:10])
+	assert.Eventually(t, func() bool {
+		return headWMB() == wmb.WMB{Offset: 9, Watermark: 1636440000000}
+	}, 5*time.Second, time.Millisecond)
+
+	// 2nd batch: 90% of the messages are dropped, the watermark is published at the only written offset.
+	writeBatch(1636450000000, writeMessages[10:20])
+	assert.Eventually(t, func() bool {
+		return headWMB() == wmb.WMB{Offset: 10, Watermark: 1636450000000}
+	}, 5*time.Second, time.Millisecond)
+	assert.True(t, to1.IsFull())
+
+	// 3rd batch: all the messages are dropped, the watermark still advances at the last written offset without
+	// being idle, and no ctrl message is needed.
+	writeBatch(1636460000000, writeMessages[20:30])
+	assert.Eventually(t, func() bool {
+		return headWMB() == wmb.WMB{Offset: 10, Watermark: 1636460000000}
+	}, 5*time.Second, time.Millisecond)
+	for _, msg := range to1.GetMessages(11) {
+		assert.Equal(t, isb.Data, msg.Kind)
+	}
+
+	f.Stop()
+	<-stopped
+}
+
 type myForwardDropTest struct {
 }
 
@@ -1916,3 +2017,204 @@ func buildPublisherMapAndOTStoreFromWmStores(toBuffers map[string][]isb.BufferWr
 	}
 	return publishers, otStores
 }
+
+func TestInterStepDataForwardApplyLimits(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 25, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "testVertex",
+			},
+		}},
+		Replica: 0,
+	}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, myForwardTest{}, fetchWatermark, publishWatermark, idleManager, WithReadBatchSize(10), WithUDFMap(myForwardTest{}))
+	assert.NoError(t, err)
+
+	f.ApplyLimits(limits.Settings{ReadBatchSize: 20})
+	assert.Equal(t, int64(20), f.readBatchSize.Load())
+	// the backfill mode widens the read batches, until it's turned off.
+	f.ApplyLimits(limits.Settings{ReadBatchSize: 20, Backfill: true})
+	assert.Equal(t, int64(dfv1.BackfillReadBatchSize), f.readBatchSize.Load())
+	f.ApplyLimits(limits.Settings{ReadBatchSize: 20})
+	assert.Equal(t, int64(20), f.readBatchSize.Load())
+}
+
+// mySlowForwardTest is a map UDF taking the delay to apply a batch.
+type mySlowForwardTest struct {
+	mySourceForwardTest
+	delay time.Duration
+}
+
+func (f mySlowForwardTest) ApplyMap(ctx context.Context, readMessages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, error) {
+	time.Sleep(f.delay)
+	return f.mySourceForwardTest.ApplyMap(ctx, readMessages)
+}
+
+func TestInterStepDataForwardSlowBatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold time.Duration
+		logged    bool
+	}{
+		{name: "above the threshold", threshold: 10 * time.Millisecond, logged: true},
+		{name: "below the threshold", threshold: time.Minute, logged: false},
+		{name: "disabled", threshold: 0, logged: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+			to1 := simplebuffer.NewInMemoryBuffer("to1", 25, 0)
+			toSteps := map[string][]isb.BufferWriter{
+				"to1": {to1},
+			}
+			vertexInstance := &dfv1.VertexInstance{
+				Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+					PipelineName: "testPipeline",
+					AbstractVertex: dfv1.AbstractVertex{
+						Name: "test-vertex",
+					},
+				}},
+				Replica: 0,
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+			idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+			core, logs := observer.New(zap.InfoLevel)
+			udf := mySlowForwardTest{delay: 50 * time.Millisecond}
+			f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, udf, fetchWatermark, publishWatermark, idleManager,
+				WithReadBatchSize(5), WithUDFMap(udf), WithLogger(zap.New(core).Sugar()), WithSlowBatchThreshold(tt.threshold))
+			assert.NoError(t, err)
+
+			writeMessages := testutils.BuildTestWriteMessages(int64(5), testStartTime, nil, "test-vertex")
+			_, errs := fromStep.Write(ctx, writeMessages)
+			assert.Equal(t, make([]error, 5), errs)
+			assert.NoError(t, f.forwardAChunk(ctx))
+
+			entries := logs.FilterMessage("Slow batch").All()
+			if !tt.logged {
+				assert.Empty(t, entries)
+				return
+			}
+			assert.Len(t, entries, 1)
+			fields := entries[0].ContextMap()
+			assert.Equal(t, int64(5), fields["count"])
+			assert.GreaterOrEqual(t, fields["udf"], udf.delay)
+			assert.GreaterOrEqual(t, fields["total"], fields["udf"])
+			for _, stage := range []string{"readWait", "watermarkFetch", "write", "watermarkPublish", "ack", "other"} {
+				assert.Contains(t, fields, stage)
+			}
+			assert.Contains(t, fields["writes"], "to1")
+		})
+	}
+}
+
+func TestNewInterStepDataForward_DuplicateWriterNames(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {simplebuffer.NewInMemoryBuffer("to", 25, 0)},
+		"to2": {simplebuffer.NewInMemoryBuffer("to", 25, 0)},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-vertex",
+			},
+		}},
+		Replica: 0,
+	}
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	_, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, myForwardTest{}, fetchWatermark, publishWatermark, idleManager, WithUDFMap(myForwardTest{}))
+	assert.EqualError(t, err, `duplicate buffer writer names, "to" is used by to1[0], to2[0]`)
+}
+
+// myForwardToThreeTest forwards every message to the three edges.
+type myForwardToThreeTest struct {
+}
+
+func (f myForwardToThreeTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+	return []forwarder.VertexBuffer{{ToVertexName: "to1"}, {ToVertexName: "to2"}, {ToVertexName: "to3"}}, nil
+}
+
+func (f myForwardToThreeTest) ApplyMap(ctx context.Context, messages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, error) {
+	return testutils.CopyUDFTestApply(ctx, "", messages)
+}
+
+// ackCountingBuffer counts the acks of every offset read from the buffer.
+type ackCountingBuffer struct {
+	*simplebuffer.InMemoryBuffer
+	lock sync.Mutex
+	acks map[string]int
+}
+
+func (b *ackCountingBuffer) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	b.lock.Lock()
+	for _, offset := range offsets {
+		b.acks[offset.String()]++
+	}
+	b.lock.Unlock()
+	return b.InMemoryBuffer.Ack(ctx, offsets)
+}
+
+// TestInterStepDataForwardFanOutCompletion tests that a message forwarded to three edges with mixed outcomes is acked
+// exactly once, after all the edges have reached a terminal state.
+func TestInterStepDataForwardFanOutCompletion(t *testing.T) {
+	fromStep := &ackCountingBuffer{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("from", 25, 0), acks: make(map[string]int)}
+	// to1 writes the message, to2 fails to write it twice before writing it, and to3 is full and drops it.
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 25, 0)
+	to2 := simplebuffer.NewInMemoryBuffer("to2", 25, 0, simplebuffer.WithFaultPlan(simplebuffer.FaultPlan{
+		WriteSchedule: []simplebuffer.Fault{simplebuffer.FaultWriteFailure, simplebuffer.FaultWriteFailure},
+	}))
+	to3 := simplebuffer.NewInMemoryBuffer("to3", 1, 0, simplebuffer.WithBufferFullWritingStrategy(dfv1.DiscardLatest))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+		"to2": {to2},
+		"to3": {to3},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-vertex",
+			},
+		}},
+		Replica: 0,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	_, errs := to3.Write(ctx, testutils.BuildTestWriteMessages(int64(1), testStartTime, nil, "test-vertex"))
+	assert.Equal(t, make([]error, 1), errs)
+	assert.True(t, to3.IsFull())
+
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+	f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, myForwardToThreeTest{}, fetchWatermark, publishWatermark, idleManager,
+		WithReadBatchSize(5), WithUDFMap(myForwardToThreeTest{}), WithRetryInterval(time.Millisecond))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(int64(1), testStartTime, nil, "test-vertex")
+	_, errs = fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, 1), errs)
+	assert.NoError(t, f.forwardAChunk(ctx))
+
+	assert.Equal(t, map[string]int{"0-0": 1}, fromStep.acks)
+	assert.True(t, fromStep.IsEmpty())
+	assert.Equal(t, 2, f.completions.Count(forwarder.OutcomeWritten))
+	assert.Equal(t, 1, f.completions.Count(forwarder.OutcomeDropped))
+	assert.Empty(t, f.completions.Incomplete())
+	assert.Equal(t, 2, to2.InjectedFaults(simplebuffer.FaultWriteFailure))
+	assert.Len(t, to1.GetMessages(1), 1)
+	assert.Len(t, to2.GetMessages(1), 1)
+}
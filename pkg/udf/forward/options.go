@@ -44,18 +44,24 @@ type options struct {
 	unaryMapUdfApplier applier.MapApplier
 	// streamMapUdfApplier is the UDF applier for a server streaming map mode
 	streamMapUdfApplier applier.MapStreamApplier
+	// futureEventTimeBound is how far ahead of the wall clock an event time can be before it is counted as a future event time
+	futureEventTimeBound time.Duration
+	// slowBatchThreshold is how long forwarding a batch can take before its stage breakdown is logged
+	slowBatchThreshold time.Duration
 }
 
 type Option func(*options) error
 
 func DefaultOptions() *options {
 	return &options{
-		readBatchSize:       dfv1.DefaultReadBatchSize,
-		udfConcurrency:      dfv1.DefaultReadBatchSize,
-		retryInterval:       time.Millisecond,
-		logger:              logging.NewLogger(),
-		unaryMapUdfApplier:  nil,
-		streamMapUdfApplier: nil,
+		readBatchSize:        dfv1.DefaultReadBatchSize,
+		udfConcurrency:       dfv1.DefaultReadBatchSize,
+		retryInterval:        time.Millisecond,
+		logger:               logging.NewLogger(),
+		unaryMapUdfApplier:   nil,
+		streamMapUdfApplier:  nil,
+		futureEventTimeBound: dfv1.DefaultFutureEventTimeBound,
+		slowBatchThreshold:   dfv1.DefaultSlowBatchThreshold,
 	}
 }
 
@@ -134,3 +140,20 @@ func WithUDFStreamingMap(f applier.MapStreamApplier) Option {
 		return fmt.Errorf("invalid option")
 	}
 }
+
+// WithFutureEventTimeBound sets how far ahead of the wall clock an event time can be before it is counted as a future event time
+func WithFutureEventTimeBound(d time.Duration) Option {
+	return func(o *options) error {
+		o.futureEventTimeBound = d
+		return nil
+	}
+}
+
+// WithSlowBatchThreshold sets how long forwarding a batch can take before its stage breakdown is logged, a non-positive
+// value disables the logging
+func WithSlowBatchThreshold(d time.Duration) Option {
+	return func(o *options) error {
+		o.slowBatchThreshold = d
+		return nil
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forward
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+func testResilienceVertexInstance() *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "test-vertex",
+			},
+		}},
+		Replica: 0,
+	}
+}
+
+// TestInterStepDataForward_WriteFailureStorm verifies that the forwarder keeps retrying the writes failing with
+// retryable errors, and that no message is lost.
+func TestInterStepDataForward_WriteFailureStorm(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 25, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 25, 0, simplebuffer.WithReadTimeOut(time.Second*10), simplebuffer.WithFaultPlan(simplebuffer.FaultPlan{
+		// fail the whole first batch, then keep failing half of the writes.
+		WriteSchedule:           []simplebuffer.Fault{simplebuffer.FaultWriteFailure, simplebuffer.FaultBufferFull, simplebuffer.FaultWriteFailure, simplebuffer.FaultBufferFull, simplebuffer.FaultWriteFailure},
+		WriteFailureProbability: 0.5,
+		Seed:                    1,
+	}))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	count := int64(20)
+	writeMessages := testutils.BuildTestWriteMessages(count, testStartTime, nil, "test-vertex")
+	_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+
+	f, err := NewInterStepDataForward(testResilienceVertexInstance(), fromStep, toSteps, mySourceForwardTest{}, &testForwardFetcher{}, publishWatermark, idleManager, WithReadBatchSize(5), WithUDFMap(mySourceForwardTest{}))
+	assert.NoError(t, err)
+	stopped := f.Start()
+
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, count), errs)
+
+	received := make(map[string]struct{})
+	for int64(len(received)) < count {
+		readMessages, err := to1.Read(ctx, count)
+		assert.NoError(t, err)
+		if ctx.Err() != nil {
+			break
+		}
+		for _, m := range readMessages {
+			received[m.Header.ID.String()] = struct{}{}
+		}
+	}
+	// every message is delivered exactly once in spite of the failures.
+	assert.Len(t, received, int(count))
+	assert.Greater(t, to1.InjectedFaults(simplebuffer.FaultWriteFailure), 3)
+	assert.Equal(t, 2, to1.InjectedFaults(simplebuffer.FaultBufferFull))
+
+	f.Stop()
+	<-stopped
+}
+
+// TestInterStepDataForward_AckBlackhole verifies that the forwarder does not fail when the acks are silently dropped,
+// the messages are forwarded, but the from buffer never frees up.
+func TestInterStepDataForward_AckBlackhole(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 5, 0, simplebuffer.WithFaultPlan(simplebuffer.FaultPlan{AckDropProbability: 1}))
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 10, 0, simplebuffer.WithReadTimeOut(time.Second*10))
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	count := int64(5)
+	writeMessages := testutils.BuildTestWriteMessages(count, testStartTime, nil, "test-vertex")
+	_, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+
+	f, err := NewInterStepDataForward(testResilienceVertexInstance(), fromStep, toSteps, mySourceForwardTest{}, &testForwardFetcher{}, publishWatermark, idleManager, WithReadBatchSize(5), WithUDFMap(mySourceForwardTest{}))
+	assert.NoError(t, err)
+	stopped := f.Start()
+
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, count), errs)
+
+	readMessages, err := to1.Read(ctx, count)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, int(count))
+
+	// wait for the forwarder to ack the batch.
+	for fromStep.InjectedFaults(simplebuffer.FaultAckDrop) < int(count) {
+		select {
+		case <-ctx.Done():
+			assert.Fail(t, "timed out waiting for the acks")
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+	// the acks are lost, so there is no room for new messages.
+	assert.True(t, fromStep.IsFull())
+	_, errs = fromStep.Write(ctx, writeMessages[0:1])
+	assert.Equal(t, []error{isb.BufferWriteErr{Name: "from", Full: true, Message: isb.BufferFullMessage}}, errs)
+
+	f.Stop()
+	time.Sleep(1 * time.Millisecond)
+	// only for shutdown will work as from buffer is not empty
+	f.ForceStop()
+	<-stopped
+}
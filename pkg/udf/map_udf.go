@@ -29,16 +29,19 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/limits"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/sdkclient/mapper"
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/udf/forward"
+	"github.com/numaproj/numaflow/pkg/udf/forward/applier"
 	"github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
@@ -77,6 +80,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	// watermark variables
 	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferList(u.VertexInstance.Vertex.GetToBuffers())
 	idleManager = wmb.NewNoOpIdleManager()
+	var decisionLog *fetch.DecisionLog
 
 	var err error
 
@@ -112,8 +116,9 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 			}
 
 			// create watermark fetcher using watermark stores
+			decisionLog = fetch.BuildDecisionLog()
 			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
-				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()), fetch.WithDecisionLog(decisionLog))
 
 			// create to vertex watermark stores
 			toVertexWmStores, err = jetstream.BuildToVertexWatermarkStores(ctx, u.VertexInstance, natsClientPool.NextAvailableClient())
@@ -126,24 +131,48 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 
 			idleManager, _ = wmb.NewIdleManager(len(writers), len(writers))
 		}
+	case dfv1.ISBSvcTypeKafka:
+		kafkaClient, err := kafkaclient.NewInClusterKafkaClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a new Kafka client: %w", err)
+		}
+		defer func() { _ = kafkaClient.Close() }()
+
+		// the watermarks are not supported by the Kafka ISB Service, no op will be used
+		readers, writers, err = buildKafkaBufferIO(ctx, u.VertexInstance, kafkaClient)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", u.ISBSvcType)
 	}
 
-	opts := []forward.Option{forward.WithLogger(log)}
+	opts := []forward.Option{forward.WithLogger(log), forward.WithFutureEventTimeBound(sharedutil.LookupEnvDurationOr(dfv1.EnvFutureEventTimeBound, dfv1.DefaultFutureEventTimeBound)), forward.WithSlowBatchThreshold(sharedutil.LookupEnvDurationOr(dfv1.EnvSlowBatchThreshold, dfv1.DefaultSlowBatchThreshold))}
 	enableMapUdfStream := false
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize)
 
-	// Wait for map server info to be ready, we use the same info file for all the map modes
-	serverInfo, err := serverinfo.SDKServerInfo(serverinfo.WithServerInfoFilePath(sdkclient.MapServerInfoFile))
-	if err != nil {
-		return err
+	// a pass-through vertex doesn't have a UDF container to wait for, the messages are forwarded as they are
+	passThrough := u.VertexInstance.Vertex.IsPassThroughUDF()
+	var (
+		serverInfo *serverinfo.ServerInfo
+		mapMode    string
+		ok         bool
+	)
+	if !passThrough {
+		// Wait for map server info to be ready, we use the same info file for all the map modes
+		serverInfo, err = serverinfo.SDKServerInfo(serverinfo.WithServerInfoFilePath(sdkclient.MapServerInfoFile))
+		if err != nil {
+			return err
+		}
+		metrics.SDKInfo.WithLabelValues(dfv1.ComponentVertex, fmt.Sprintf("%s-%s", pipelineName, vertexName), string(serverinfo.ContainerTypeMapper), serverInfo.Version, string(serverInfo.Language)).Set(1)
+		mapMode, ok = serverInfo.Metadata[serverinfo.MapModeKey]
 	}
-	metrics.SDKInfo.WithLabelValues(dfv1.ComponentVertex, fmt.Sprintf("%s-%s", pipelineName, vertexName), string(serverinfo.ContainerTypeMapper), serverInfo.Version, string(serverInfo.Language)).Set(1)
 
 	// track all the resources that need to be closed
 	var resourcesToClose []io.Closer
-	mapMode, ok := serverInfo.Metadata[serverinfo.MapModeKey]
+
+	// the reloader applies the limits updated in the runtime limits ConfigMap to the forwarders of all the partitions.
+	reloader := limits.NewVertexReloader(u.VertexInstance, dfv1.VertexTypeMapUDF, log)
 
 	for index, bufferPartition := range fromBuffer {
 		// Read the server info file to read which map mode is enabled
@@ -152,7 +181,11 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		// the client is not thread safe since we use one common gRPC Bidirectional stream
 		// to communicate with the server
 
-		if ok && (serverinfo.MapMode(mapMode) == serverinfo.StreamMap) {
+		if passThrough {
+			log.Info("Map mode enabled: Pass Through")
+			opts = append(opts, forward.WithUDFMap(applier.NewPassThroughMap(vertexName, u.VertexInstance.Vertex.Spec.UDF.PassThrough.TagsHeader)))
+
+		} else if ok && (serverinfo.MapMode(mapMode) == serverinfo.StreamMap) {
 			log.Info("Map mode enabled: Stream Map")
 			// Map Stream mode
 			enableMapUdfStream = true
@@ -286,6 +319,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		reloader.Subscribe(df.ApplyLimits)
 		finalWg.Add(1)
 
 		// start the df for each partition using a go routine
@@ -309,6 +343,8 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 			}
 		}(bufferPartition, df)
 	}
+	go reloader.Start(ctx)
+
 	// create lag readers from buffer readers
 	var lagReaders []isb.LagReader
 	for _, reader := range readers {
@@ -319,9 +355,15 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	// Add the correct client handler for the metrics server, based on the mode being used.
 	if enableMapUdfStream {
 		metricsOpts = metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{mapStreamHandler}, lagReaders)
+	} else if passThrough {
+		// there's no UDF container to check the health of
+		metricsOpts = metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, nil, lagReaders)
 	} else {
 		metricsOpts = metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{mapHandler}, lagReaders)
 	}
+	if decisionLog != nil {
+		metricsOpts = append(metricsOpts, metrics.WithWatermarkDecisions(decisionLog))
+	}
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -332,6 +374,13 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	// wait for all the forwarders to exit
 	finalWg.Wait()
 
+	// flush the watermark decision log if it's enabled
+	if decisionLog != nil {
+		if err := decisionLog.Close(); err != nil {
+			log.Errorw("Failed to flush the watermark decision log", zap.Error(err))
+		}
+	}
+
 	// closing the publisher will only delete the keys from the store, but not the store itself
 	// we cannot close the store inside publisher because in some cases stores are shared between publishers
 	// and store itself is a separate entity that can be used by other components
@@ -342,6 +391,11 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		}
 	}
 
+	// close the watermark fetcher, so that it stops watching the from vertex wm stores before they are closed
+	if closer, ok := fetchWatermark.(io.Closer); ok {
+		_ = closer.Close()
+	}
+
 	// close the fromVertex wm stores
 	// since we created the stores, we can close them
 	for _, wmStore := range fromVertexWmStores {
@@ -19,6 +19,7 @@ package udf
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"sync"
@@ -41,6 +42,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/sdkclient/reducer"
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sessionreducer"
+	kafkaclient "github.com/numaproj/numaflow/pkg/shared/clients/kafka"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
@@ -187,6 +189,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	// watermark variables
 	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferList(u.VertexInstance.Vertex.GetToBuffers())
 	idleManager = wmb.NewNoOpIdleManager()
+	var decisionLog *fetch.DecisionLog
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
 		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance)
@@ -216,8 +219,9 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 			}
 
 			// create watermark fetcher using watermark stores
+			decisionLog = fetch.BuildDecisionLog()
 			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
-				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()), fetch.WithDecisionLog(decisionLog))
 
 			// create to vertex watermark stores
 			toVertexWmStores, err = jetstream.BuildToVertexWatermarkStores(ctx, u.VertexInstance, natsClientPool.NextAvailableClient())
@@ -230,6 +234,18 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 			idleManager, _ = wmb.NewIdleManager(1, len(writers))
 		}
+	case dfv1.ISBSvcTypeKafka:
+		kafkaClient, err := kafkaclient.NewInClusterKafkaClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a new Kafka client: %w", err)
+		}
+		defer func() { _ = kafkaClient.Close() }()
+
+		// the watermarks are not supported by the Kafka ISB Service, no op will be used
+		readers, writers, err = buildKafkaBufferIO(ctx, u.VertexInstance, kafkaClient)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", u.ISBSvcType)
 	}
@@ -297,6 +313,9 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 	// start metrics server
 	metricsOpts := metrics.NewMetricsOptions(ctx, u.VertexInstance.Vertex, []metrics.HealthChecker{healthChecker}, lagReaders)
+	if decisionLog != nil {
+		metricsOpts = append(metricsOpts, metrics.WithWatermarkDecisions(decisionLog))
+	}
 	ms := metrics.NewMetricsServer(u.VertexInstance.Vertex, metricsOpts...)
 	if shutdown, err := ms.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start metrics server, error: %w", err)
@@ -403,6 +422,13 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	log.Info("SIGTERM, exiting...")
 	wg.Wait()
 
+	// flush the watermark decision log if it's enabled
+	if decisionLog != nil {
+		if err := decisionLog.Close(); err != nil {
+			log.Errorw("Failed to flush the watermark decision log", zap.Error(err))
+		}
+	}
+
 	// closing the publisher will only delete the keys from the store, but not the store itself
 	// we cannot close the store inside publisher because in some cases stores are shared between publishers
 	// and store itself is a separate entity that can be used by other components
@@ -413,6 +439,11 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		}
 	}
 
+	// close the watermark fetcher, so that it stops watching the from vertex wm stores before they are closed
+	if closer, ok := fetchWatermark.(io.Closer); ok {
+		_ = closer.Close()
+	}
+
 	// close the from vertex wm stores
 	// since we created the stores, we can close them
 	for _, wmStore := range fromVertexWmStores {
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"math"
+	"time"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/watermark/timeline"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// ComputeBatchWatermark computes the watermark of the min offset of a read batch, and returns a resolver of the
+// watermarks of the offsets of the batch. A fetcher which is not a BatchFetcher assigns the watermark of the min
+// offset to the whole batch.
+func ComputeBatchWatermark(f Fetcher, minOffset, maxOffset isb.Offset, fromPartitionIdx int32) (wmb.Watermark, WatermarkResolver) {
+	if bf, ok := f.(BatchFetcher); ok {
+		return bf.ComputeBatchWatermark(minOffset, maxOffset, fromPartitionIdx)
+	}
+	wm := f.ComputeWatermark(minOffset, fromPartitionIdx)
+	return wm, func(isb.Offset) wmb.Watermark { return wm }
+}
+
+// ComputeBatchWatermark computes the watermark of the min offset, and returns a resolver giving the same watermark
+// as ComputeWatermark for each offset between the min and the max offsets, with the processors and the timelines
+// existing now. The timelines are looked up once per processor for the whole batch.
+func (efs *edgeFetcherSet) ComputeBatchWatermark(minOffset, maxOffset isb.Offset, fromPartitionIdx int32) (wmb.Watermark, WatermarkResolver) {
+	wm := efs.ComputeWatermark(minOffset, fromPartitionIdx)
+	minSeq, err := minOffset.Sequence()
+	if err != nil {
+		return wm, func(isb.Offset) wmb.Watermark { return wm }
+	}
+	maxSeq, err := maxOffset.Sequence()
+	if err != nil {
+		return wm, func(isb.Offset) wmb.Watermark { return wm }
+	}
+	resolvers := make([]func(isb.Offset) int64, 0, len(efs.edgeFetchers))
+	for _, fetcher := range efs.edgeFetchers {
+		resolvers = append(resolvers, fetcher.batchResolver(minSeq, maxSeq, fromPartitionIdx))
+	}
+	return wm, func(offset isb.Offset) wmb.Watermark {
+		overall := int64(math.MaxInt64)
+		for _, resolve := range resolvers {
+			overall = min(overall, resolve(offset))
+		}
+		return wmb.Watermark(time.UnixMilli(overall))
+	}
+}
+
+// batchResolver returns a resolver of the watermarks of the offsets between the min and the max offsets, in
+// milliseconds. It must be called after the watermark of the min offset is updated, it gives the same watermark as
+// updateWatermark followed by getWatermark, without updating the last processed watermark.
+func (e *edgeFetcher) batchResolver(minOffset, maxOffset int64, fromPartitionIdx int32) func(isb.Offset) int64 {
+	current := e.getWatermark().UnixMilli()
+	allProcessors := e.processorManager.getAllProcessors()
+	// the watermark is not updated until all the processors of the reduce vertex are found.
+	if e.opts.isFromVtxReduce && e.opts.fromVtxPartitions != len(allProcessors) {
+		return func(isb.Offset) int64 { return current }
+	}
+	// the watermark of the edge is the smallest of the last processed watermarks of the other partitions,
+	// and the watermark of the offset on the partition
+	others := int64(math.MaxInt64)
+	e.RLock()
+	for i, wm := range e.lastProcessedWm {
+		if i != int(fromPartitionIdx) {
+			others = min(others, wm)
+		}
+	}
+	e.RUnlock()
+
+	type processorRange struct {
+		tl    *timeline.OffsetTimeline
+		nodes []wmb.WMB
+	}
+	var ranges []processorRange
+	for _, p := range allProcessors {
+		if tls := p.GetOffsetTimelines(); int(fromPartitionIdx) < len(tls) {
+			tl := tls[fromPartitionIdx]
+			ranges = append(ranges, processorRange{tl: tl, nodes: tl.GetEventTimeRange(minOffset, maxOffset)})
+		}
+	}
+	epochOf := func(offset int64) int64 {
+		epoch := int64(math.MaxInt64)
+		for _, r := range ranges {
+			var t int64
+			if offset >= minOffset && offset <= maxOffset {
+				t = timeline.EventTimeOf(r.nodes, offset)
+			} else {
+				t = r.tl.GetEventTimeFromInt64(offset)
+			}
+			// the watermark can not be computed if it's unknown to any of the processors
+			if t == -1 {
+				epoch = -1
+			} else if t < epoch {
+				epoch = t
+			}
+		}
+		if epoch == math.MaxInt64 {
+			epoch = -1
+		}
+		return min(others, epoch)
+	}
+	// the watermark is not decreasing with the offset, the whole batch has the same watermark if both ends do
+	if lo := epochOf(minOffset); lo == epochOf(maxOffset) {
+		return func(offset isb.Offset) int64 {
+			seq, err := offset.Sequence()
+			if err != nil {
+				return current
+			}
+			if seq >= minOffset && seq <= maxOffset {
+				return lo
+			}
+			return epochOf(seq)
+		}
+	}
+	return func(offset isb.Offset) int64 {
+		seq, err := offset.Sequence()
+		if err != nil {
+			return current
+		}
+		return epochOf(seq)
+	}
+}
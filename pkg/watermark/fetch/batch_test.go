@@ -0,0 +1,172 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	"github.com/numaproj/numaflow/pkg/watermark/entity"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+// newRandomEdgeFetcherSet builds a fetcher set of edges with active processors, the timelines of which have random
+// increasing offsets and watermarks up to maxOffset.
+func newRandomEdgeFetcherSet(rng *rand.Rand, edges, processors, partitions int, maxOffset int64) *edgeFetcherSet {
+	ctx := context.Background()
+	efs := &edgeFetcherSet{
+		edgeFetchers: make(map[string]*edgeFetcher),
+		log:          zap.NewNop().Sugar(),
+		clock:        clock.RealClock(),
+	}
+	for i := 0; i < edges; i++ {
+		pm := &processorManager{
+			ctx:                      ctx,
+			heartbeat:                newProcessorHeartbeat(),
+			processors:               make(map[string]*ProcessorToFetch),
+			fromBufferPartitionCount: int32(partitions),
+			log:                      zap.NewNop().Sugar(),
+			opts:                     defaultOptions(),
+		}
+		for j := 0; j < processors; j++ {
+			name := fmt.Sprintf("edge-%d-processor-%d", i, j)
+			p := NewProcessorToFetch(ctx, entity.NewProcessorEntity(name), 10+rng.Intn(50), int32(partitions))
+			for _, tl := range p.GetOffsetTimelines() {
+				offset, watermark := int64(rng.Intn(10)), int64(1000+rng.Intn(100))
+				for offset < maxOffset {
+					tl.Put(wmb.WMB{Offset: offset, Watermark: watermark})
+					offset += int64(1 + rng.Intn(30))
+					watermark += int64(rng.Intn(3) * rng.Intn(500))
+				}
+			}
+			pm.addProcessor(name, p)
+		}
+		lastProcessedWm := make([]int64, partitions)
+		for k := range lastProcessedWm {
+			lastProcessedWm[k] = []int64{-1, 1500, 3000, 100000}[rng.Intn(4)]
+		}
+		efs.edgeFetchers[fmt.Sprintf("edge-%d", i)] = &edgeFetcher{
+			processorManager: pm,
+			lastProcessedWm:  lastProcessedWm,
+			log:              zap.NewNop().Sugar(),
+			opts:             defaultOptions(),
+		}
+	}
+	return efs
+}
+
+func TestComputeBatchWatermark_MatchesPerOffset(t *testing.T) {
+	for seed := int64(0); seed < 200; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+		partitions := 1 + rng.Intn(3)
+		efs := newRandomEdgeFetcherSet(rng, 1+rng.Intn(3), 1+rng.Intn(4), partitions, 2000)
+		partition := int32(rng.Intn(partitions))
+		minOffset := int64(rng.Intn(2000))
+		maxOffset := minOffset + int64(rng.Intn(600))
+
+		wm, resolve := efs.ComputeBatchWatermark(isb.SimpleIntOffset(func() int64 { return minOffset }), isb.SimpleIntOffset(func() int64 { return maxOffset }), partition)
+		assert.Equal(t, efs.ComputeWatermark(isb.SimpleIntOffset(func() int64 { return minOffset }), partition), wm, "seed %d", seed)
+		// the offsets around the batch are resolved too, with the full timelines
+		for offset := minOffset - 5; offset <= maxOffset+5; offset++ {
+			o := isb.SimpleIntOffset(func() int64 { return offset })
+			got := resolve(o)
+			want := efs.ComputeWatermark(o, partition)
+			if !assert.Equal(t, want.UnixMilli(), got.UnixMilli(), "seed %d, offset %d in [%d, %d]", seed, offset, minOffset, maxOffset) {
+				return
+			}
+		}
+	}
+}
+
+func TestComputeBatchWatermark(t *testing.T) {
+	efs := newRandomEdgeFetcherSet(rand.New(rand.NewSource(1)), 1, 1, 1, 0)
+	p := NewProcessorToFetch(context.Background(), entity.NewProcessorEntity("p"), 10, 1)
+	for _, w := range []wmb.WMB{{Offset: 10, Watermark: 100}, {Offset: 20, Watermark: 200}, {Offset: 30, Watermark: 300}} {
+		p.GetOffsetTimelines()[0].Put(w)
+	}
+	fetcher := efs.edgeFetchers["edge-0"]
+	fetcher.processorManager.processors = map[string]*ProcessorToFetch{"p": p}
+	fetcher.lastProcessedWm = []int64{-1}
+
+	offset := func(o int64) isb.Offset { return isb.SimpleIntOffset(func() int64 { return o }) }
+	wm, resolve := ComputeBatchWatermark(efs, offset(15), offset(35), 0)
+	assert.Equal(t, int64(100), wm.UnixMilli())
+	// the watermark of the min offset is recorded as the last processed one
+	assert.Equal(t, []int64{100}, fetcher.lastProcessedWm)
+	for o, want := range map[int64]int64{15: 100, 20: 100, 21: 200, 30: 200, 31: 300, 35: 300, 5: -1} {
+		assert.Equal(t, want, resolve(offset(o)).UnixMilli(), "offset %d", o)
+	}
+	// an offset without a sequence gets the current watermark
+	assert.Equal(t, int64(100), resolve(isb.SimpleStringOffset(func() string { return "abc" })).UnixMilli())
+
+	// a fetcher without the batch support assigns the watermark of the min offset to the whole batch
+	wm, resolve = ComputeBatchWatermark(&constantFetcher{}, offset(15), offset(35), 0)
+	assert.Equal(t, int64(15), wm.UnixMilli())
+	assert.Equal(t, int64(15), resolve(offset(35)).UnixMilli())
+}
+
+// constantFetcher is a Fetcher without the batch support, the watermark of an offset is the offset.
+type constantFetcher struct{}
+
+func (c *constantFetcher) ComputeWatermark(offset isb.Offset, _ int32) wmb.Watermark {
+	seq, _ := offset.Sequence()
+	return wmb.Watermark(time.UnixMilli(seq))
+}
+
+func (c *constantFetcher) ComputeHeadIdleWMB(int32) wmb.WMB {
+	return wmb.WMB{}
+}
+
+func benchmarkOffsets(n int) []isb.Offset {
+	offsets := make([]isb.Offset, n)
+	for i := range offsets {
+		offsets[i] = isb.SimpleStringOffset(func() string { return strconv.Itoa(5000 + i) })
+	}
+	return offsets
+}
+
+func BenchmarkComputeWatermark_PerOffset(b *testing.B) {
+	efs := newRandomEdgeFetcherSet(rand.New(rand.NewSource(1)), 1, 5, 1, 10000)
+	offsets := benchmarkOffsets(500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, o := range offsets {
+			_ = efs.ComputeWatermark(o, 0)
+		}
+	}
+}
+
+func BenchmarkComputeBatchWatermark(b *testing.B) {
+	efs := newRandomEdgeFetcherSet(rand.New(rand.NewSource(1)), 1, 5, 1, 10000)
+	offsets := benchmarkOffsets(500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, resolve := efs.ComputeBatchWatermark(offsets[0], offsets[len(offsets)-1], 0)
+		for _, o := range offsets {
+			_ = resolve(o)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedqueue "github.com/numaproj/numaflow/pkg/shared/queue"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// Decision is a merged watermark computation of the fetcher, it records the inputs of the computation so that the
+// watermark at a given time can be reconstructed after the fact.
+type Decision struct {
+	// Time is when the watermark is computed.
+	Time time.Time `json:"time"`
+	// Offset is the offset the watermark is computed for.
+	Offset string `json:"offset"`
+	// Partition is the index of the partition the offset is read from.
+	Partition int32 `json:"partition"`
+	// Edges are the decisions of the incoming edges.
+	Edges []EdgeDecision `json:"edges"`
+	// Watermark is the watermark returned by the fetcher in milliseconds, the smallest one of the edges.
+	Watermark int64 `json:"watermark"`
+}
+
+// EdgeDecision is the watermark computation of an incoming edge.
+type EdgeDecision struct {
+	// FromVertex is the name of the vertex the edge is from.
+	FromVertex string `json:"fromVertex"`
+	// Processors are the inputs of the processors for the partition of the offset.
+	Processors []ProcessorInput `json:"processors"`
+	// PartitionWatermarks are the last processed watermarks of all the partitions after the computation.
+	PartitionWatermarks []int64 `json:"partitionWatermarks"`
+	// Watermark is the watermark of the edge in milliseconds, the smallest one of the partition watermarks.
+	Watermark int64 `json:"watermark"`
+	// Reason explains why the watermark of the partition is not updated, empty if it is.
+	Reason string `json:"reason,omitempty"`
+}
+
+// ProcessorInput is the input of a processor to the watermark computation.
+type ProcessorInput struct {
+	// Processor is the name of the processor.
+	Processor string `json:"processor"`
+	// Status is the status of the processor, e.g. active, inactive or deleted.
+	Status string `json:"status"`
+	// Watermark is the event time of the offset in the offset timeline of the processor in milliseconds, -1 if unknown.
+	Watermark int64 `json:"watermark"`
+	// HeadOffset is the highest head offset of the offset timelines of the processor.
+	HeadOffset int64 `json:"headOffset"`
+	// Excluded is true if the processor is not considered in the computation.
+	Excluded bool `json:"excluded"`
+	// Reason explains why the processor is excluded, or what else happens to it in the computation.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DecisionLog is a size bounded in-memory log of the watermark decisions of a fetcher, the oldest decisions are dropped
+// when it's full.
+type DecisionLog struct {
+	decisions *sharedqueue.OverflowQueue[Decision]
+	// file is the path the decisions are flushed to when the log is closed, no flushing if empty.
+	file string
+}
+
+// NewDecisionLog returns a decision log keeping the latest size decisions, which are flushed to the file when it's
+// closed if the file is not empty.
+func NewDecisionLog(size int, file string) *DecisionLog {
+	return &DecisionLog{
+		decisions: sharedqueue.New[Decision](size),
+		file:      file,
+	}
+}
+
+// BuildDecisionLog returns the decision log configured by the environment variables, nil if it's disabled.
+func BuildDecisionLog() *DecisionLog {
+	size := sharedutil.LookupEnvIntOr(dfv1.EnvWatermarkDecisionLogSize, 0)
+	if size <= 0 {
+		return nil
+	}
+	return NewDecisionLog(size, os.Getenv(dfv1.EnvWatermarkDecisionLogFile))
+}
+
+func (l *DecisionLog) record(d Decision) {
+	for _, e := range d.Edges {
+		sort.Slice(e.Processors, func(i, j int) bool {
+			return e.Processors[i].Processor < e.Processors[j].Processor
+		})
+	}
+	sort.Slice(d.Edges, func(i, j int) bool {
+		return d.Edges[i].FromVertex < d.Edges[j].FromVertex
+	})
+	l.decisions.Append(d)
+}
+
+// Decisions returns the recorded decisions, the oldest first.
+func (l *DecisionLog) Decisions() []Decision {
+	return l.decisions.Items()
+}
+
+// ServeHTTP serves the recorded decisions in JSON.
+func (l *DecisionLog) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(l.Decisions())
+}
+
+// Close flushes the recorded decisions to the file in JSON lines if the file is configured.
+func (l *DecisionLog) Close() error {
+	if l.file == "" {
+		return nil
+	}
+	f, err := os.Create(l.file)
+	if err != nil {
+		return fmt.Errorf("failed to create the watermark decision log file, %w", err)
+	}
+	encoder := json.NewEncoder(f)
+	for _, d := range l.Decisions() {
+		if err := encoder.Encode(d); err != nil {
+			_ = f.Close()
+			return fmt.Errorf("failed to write the watermark decision log file, %w", err)
+		}
+	}
+	return f.Close()
+}
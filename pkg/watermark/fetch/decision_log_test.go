@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+	"github.com/numaproj/numaflow/pkg/watermark/entity"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+func TestDecisionLog_ComputeWatermark(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	partitionCount := int32(2)
+	timelines := map[string]map[string][]wmb.WMB{
+		"vertex-0": {
+			"pod-0": {
+				{Watermark: 11, Offset: 9, Partition: 0},
+				{Watermark: 12, Offset: 20, Partition: 1},
+				{Watermark: 14, Offset: 22, Partition: 0},
+				{Watermark: 26, Offset: 31, Partition: 0},
+			},
+			"pod-1": {
+				{Watermark: 8, Offset: 13, Partition: 0},
+				{Watermark: 9, Offset: 16, Partition: 1},
+				{Watermark: 17, Offset: 26, Partition: 0},
+				{Watermark: 27, Offset: 29, Partition: 1},
+			},
+		},
+		"vertex-1": {
+			"pod-0": {
+				{Watermark: 10, Offset: 14, Partition: 0},
+				{Watermark: 12, Offset: 17, Partition: 1},
+				{Watermark: 17, Offset: 24, Partition: 0},
+				{Watermark: 25, Offset: 35, Partition: 1},
+			},
+		},
+	}
+
+	fakeClock := clock.NewFakeClock(time.Unix(1700000000, 0))
+	decisionLog := NewDecisionLog(10, filepath.Join(t.TempDir(), "decisions.jsonl"))
+	efs := &edgeFetcherSet{
+		edgeFetchers: map[string]*edgeFetcher{},
+		log:          zaptest.NewLogger(t).Sugar(),
+		decisionLog:  decisionLog,
+		clock:        fakeClock,
+	}
+	for vertex, pods := range timelines {
+		manager := createProcessorManager(ctx, partitionCount)
+		for pod, timeline := range pods {
+			p := NewProcessorToFetch(ctx, entity.NewProcessorEntity(pod), 5, partitionCount)
+			for _, w := range timeline {
+				p.GetOffsetTimelines()[w.Partition].Put(w)
+			}
+			manager.addProcessor(pod, p)
+		}
+		efs.edgeFetchers[vertex] = &edgeFetcher{
+			processorManager: manager,
+			log:              zaptest.NewLogger(t).Sugar(),
+			lastProcessedWm:  []int64{-1, -1},
+			opts:             defaultOptions(),
+		}
+	}
+
+	// the scripted sequence of the offsets and their partitions
+	script := []struct {
+		offset    int64
+		partition int32
+	}{
+		{10, 0}, {15, 0}, {18, 1}, {23, 0}, {30, 1}, {32, 0}, {36, 1},
+	}
+	var returned []int64
+	for _, s := range script {
+		fakeClock.Step(time.Second)
+		wm := efs.ComputeWatermark(isb.SimpleStringOffset(func() string { return strconv.FormatInt(s.offset, 10) }), s.partition)
+		returned = append(returned, wm.UnixMilli())
+	}
+
+	decisions := decisionLog.Decisions()
+	require.Len(t, decisions, len(script))
+	for i, d := range decisions {
+		assert.Equal(t, time.Unix(1700000001+int64(i), 0), d.Time)
+		assert.Equal(t, strconv.FormatInt(script[i].offset, 10), d.Offset)
+		assert.Equal(t, script[i].partition, d.Partition)
+		// the recorded decision matches the returned watermark
+		assert.Equal(t, returned[i], d.Watermark)
+		require.Len(t, d.Edges, 2)
+		assert.Equal(t, "vertex-0", d.Edges[0].FromVertex)
+		assert.Equal(t, "vertex-1", d.Edges[1].FromVertex)
+		minWm := d.Edges[0].Watermark
+		for _, e := range d.Edges {
+			require.Len(t, e.Processors, len(timelines[e.FromVertex]))
+			assert.Equal(t, min(e.PartitionWatermarks[0], e.PartitionWatermarks[1]), e.Watermark)
+			// the partition watermark is the smallest one of the processors
+			partitionWm := e.Processors[0].Watermark
+			for _, p := range e.Processors {
+				assert.Equal(t, "active", p.Status)
+				assert.False(t, p.Excluded)
+				assert.Equal(t, p.Watermark == -1, p.Reason != "")
+				partitionWm = min(partitionWm, p.Watermark)
+			}
+			assert.Equal(t, partitionWm, e.PartitionWatermarks[d.Partition])
+			minWm = min(minWm, e.Watermark)
+		}
+		assert.Equal(t, minWm, d.Watermark)
+	}
+
+	// offset 10 is before the timeline of pod-1 of vertex-0, its head offset is the highest of the partitions
+	assert.Equal(t, ProcessorInput{Processor: "pod-1", Status: "active", Watermark: -1, HeadOffset: 29, Reason: "the watermark of the offset is unknown to the processor"}, decisions[0].Edges[0].Processors[1])
+	assert.Equal(t, int64(-1), decisions[0].Watermark)
+	// offset 32 on partition 0: vertex-0 gets min(26, 17) and vertex-1 gets 17, while the last processed watermarks of
+	// partition 1 are 12 from offset 30
+	assert.Equal(t, []int64{17, 12}, decisions[5].Edges[0].PartitionWatermarks)
+	assert.Equal(t, []int64{17, 12}, decisions[5].Edges[1].PartitionWatermarks)
+	assert.Equal(t, int64(12), decisions[5].Watermark)
+
+	// the decisions are served in JSON
+	rec := httptest.NewRecorder()
+	decisionLog.ServeHTTP(rec, httptest.NewRequest("GET", "/watermark/decisions", nil))
+	var served []Decision
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+	assert.Equal(t, len(decisions), len(served))
+	assert.Equal(t, decisions[3].Edges, served[3].Edges)
+
+	// the decisions are flushed to the file in JSON lines when closed
+	require.NoError(t, decisionLog.Close())
+	f, err := os.Open(decisionLog.file)
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var flushed []Decision
+	for scanner.Scan() {
+		var d Decision
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &d))
+		flushed = append(flushed, d)
+	}
+	require.Len(t, flushed, len(decisions))
+	for i := range decisions {
+		assert.True(t, decisions[i].Time.Equal(flushed[i].Time))
+		assert.Equal(t, decisions[i].Watermark, flushed[i].Watermark)
+	}
+}
+
+func TestDecisionLog_SizeBounded(t *testing.T) {
+	l := NewDecisionLog(3, "")
+	for i := 0; i < 5; i++ {
+		l.record(Decision{Offset: fmt.Sprint(i)})
+	}
+	decisions := l.Decisions()
+	require.Len(t, decisions, 3)
+	assert.Equal(t, "2", decisions[0].Offset)
+	assert.Equal(t, "4", decisions[2].Offset)
+	// no file to flush
+	assert.NoError(t, l.Close())
+}
+
+func TestDecisionLog_ReduceProcessorsExcluded(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	manager := createProcessorManager(ctx, 1)
+	p := NewProcessorToFetch(ctx, entity.NewProcessorEntity("pod-0"), 5, 1)
+	p.GetOffsetTimelines()[0].Put(wmb.WMB{Watermark: 10, Offset: 5})
+	manager.addProcessor("pod-0", p)
+
+	opts := defaultOptions()
+	WithIsFromVtxReduce(true)(opts)
+	WithFromVtxPartitions(2)(opts)
+	decisionLog := NewDecisionLog(10, "")
+	efs := &edgeFetcherSet{
+		edgeFetchers: map[string]*edgeFetcher{
+			"reduce": {processorManager: manager, log: zaptest.NewLogger(t).Sugar(), lastProcessedWm: []int64{-1}, opts: opts},
+		},
+		log:         zaptest.NewLogger(t).Sugar(),
+		decisionLog: decisionLog,
+		clock:       clock.NewFakeClock(time.Unix(1700000000, 0)),
+	}
+	wm := efs.ComputeWatermark(isb.SimpleStringOffset(func() string { return "6" }), 0)
+	assert.Equal(t, int64(-1), wm.UnixMilli())
+
+	decisions := decisionLog.Decisions()
+	require.Len(t, decisions, 1)
+	e := decisions[0].Edges[0]
+	assert.Equal(t, "1 of the 2 processors of the reduce vertex are found", e.Reason)
+	assert.Equal(t, []ProcessorInput{{Processor: "pod-0", Status: "active", Watermark: -1, HeadOffset: -1, Excluded: true, Reason: "waiting for all the processors of the reduce vertex"}}, e.Processors)
+	assert.Equal(t, int64(-1), decisions[0].Watermark)
+}
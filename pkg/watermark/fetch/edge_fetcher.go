@@ -23,6 +23,8 @@ package fetch
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"math"
 	"sync"
 	"time"
@@ -44,6 +46,8 @@ type edgeFetcher struct {
 	sync.RWMutex
 }
 
+var _ io.Closer = (*edgeFetcher)(nil)
+
 // NewEdgeFetcher returns a new edge fetcher. This could have been private, except that UI uses it.
 func NewEdgeFetcher(ctx context.Context, wmStore store.WatermarkStore, fromBufferPartitionCount int, opts ...Option) *edgeFetcher {
 	dOpts := defaultOptions()
@@ -74,9 +78,24 @@ func NewEdgeFetcher(ctx context.Context, wmStore store.WatermarkStore, fromBuffe
 // updateWatermark updates the lastProcessedWm of the given partition based on the given offset,
 // deletes any inactive processor, and returns the updated watermark.
 func (e *edgeFetcher) updateWatermark(inputOffset isb.Offset, fromPartitionIdx int32) wmb.Watermark {
+	return e.updateWatermarkWithDecision(inputOffset, fromPartitionIdx, nil)
+}
+
+// updateWatermarkWithDecision is updateWatermark recording the inputs of the computation in the decision if it's not nil.
+func (e *edgeFetcher) updateWatermarkWithDecision(inputOffset isb.Offset, fromPartitionIdx int32, decision *EdgeDecision) wmb.Watermark {
+	if decision != nil {
+		defer func() {
+			e.RLock()
+			decision.PartitionWatermarks = append([]int64(nil), e.lastProcessedWm...)
+			e.RUnlock()
+		}()
+	}
 	var offset, err = inputOffset.Sequence()
 	if err != nil {
 		e.log.Errorw("Unable to get offset from isb.Offset.Sequence()", zap.Error(err))
+		if decision != nil {
+			decision.Reason = fmt.Sprintf("invalid offset, %v", err)
+		}
 		return wmb.InitialWatermark
 	}
 	var epoch int64 = math.MaxInt64
@@ -86,17 +105,31 @@ func (e *edgeFetcher) updateWatermark(inputOffset isb.Offset, fromPartitionIdx i
 	// The reason is each pod reads from a unique ISB buffer and reduce has a persistent state.
 	// Not considering all the processors might result in incorrect computation of the watermark.
 	if e.opts.isFromVtxReduce && e.opts.fromVtxPartitions != len(allProcessors) {
+		if decision != nil {
+			decision.Reason = fmt.Sprintf("%d of the %d processors of the reduce vertex are found", len(allProcessors), e.opts.fromVtxPartitions)
+			for _, p := range allProcessors {
+				decision.Processors = append(decision.Processors, ProcessorInput{
+					Processor:  p.GetEntity().GetName(),
+					Status:     p.getStatus().String(),
+					Watermark:  -1,
+					HeadOffset: -1,
+					Excluded:   true,
+					Reason:     "waiting for all the processors of the reduce vertex",
+				})
+			}
+		}
 		return wmb.InitialWatermark
 	}
 
 	for _, p := range allProcessors {
 		// headOffset is used to check whether this pod can be deleted.
 		headOffset := int64(-1)
+		t := int64(-1)
 		// iterate over all the timelines of the processor and get the smallest watermark
 		for index, tl := range p.GetOffsetTimelines() {
 			// we only need to check the timelines of the partition we are reading from
 			if index == int(fromPartitionIdx) {
-				var t = tl.GetEventTime(inputOffset)
+				t = tl.GetEventTime(inputOffset)
 				if t == -1 { // watermark cannot be computed, perhaps a new processing unit was added or offset fell off the timeline
 					epoch = t
 				} else if t < epoch {
@@ -109,6 +142,19 @@ func (e *edgeFetcher) updateWatermark(inputOffset isb.Offset, fromPartitionIdx i
 				headOffset = tl.GetHeadOffset()
 			}
 		}
+		var input *ProcessorInput
+		if decision != nil {
+			decision.Processors = append(decision.Processors, ProcessorInput{
+				Processor:  p.GetEntity().GetName(),
+				Status:     p.getStatus().String(),
+				Watermark:  t,
+				HeadOffset: headOffset,
+			})
+			input = &decision.Processors[len(decision.Processors)-1]
+			if t == -1 {
+				input.Reason = "the watermark of the offset is unknown to the processor"
+			}
+		}
 
 		// if the pod is not active and the head offset of all the timelines is less than the input offset, delete the processor
 		// (this means we are processing data later than what the stale processor has processed)
@@ -119,6 +165,9 @@ func (e *edgeFetcher) updateWatermark(inputOffset isb.Offset, fromPartitionIdx i
 		if p.IsDeleted() && (offset > headOffset) && !e.opts.isFromVtxReduce {
 			e.log.Infow("Deleting processor because it's stale", zap.String("processor", p.GetEntity().GetName()))
 			e.processorManager.deleteProcessor(p.GetEntity().GetName())
+			if input != nil {
+				input.Reason = "the processor is stale and removed from the later computations, its head offset is behind the offset"
+			}
 		}
 	}
 	// if there are no processors
@@ -218,3 +267,9 @@ func (e *edgeFetcher) getWatermark() wmb.Watermark {
 	e.RUnlock()
 	return wmb.Watermark(time.UnixMilli(minWm))
 }
+
+// Close stops watching the watermark store of the edge, the store itself is not closed.
+func (e *edgeFetcher) Close() error {
+	e.processorManager.close()
+	return nil
+}
@@ -18,6 +18,7 @@ package fetch
 
 import (
 	"context"
+	"io"
 	"math"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -35,9 +37,15 @@ import (
 type edgeFetcherSet struct {
 	edgeFetchers map[string]*edgeFetcher // key = name of From Vertex
 	log          *zap.SugaredLogger
+	// decisionLog records the merged watermark computations, nil if disabled.
+	decisionLog *DecisionLog
+	clock       clock.Clock
 }
 
-// NewEdgeFetcherSet creates a new edgeFetcherSet object which implements the Fetcher interface.
+var _ io.Closer = (*edgeFetcherSet)(nil)
+
+// NewEdgeFetcherSet creates a new edgeFetcherSet object which implements the Fetcher interface. It also implements
+// io.Closer, which must be called to stop watching the watermark stores once the fetcher is no longer used.
 func NewEdgeFetcherSet(ctx context.Context, vertexInstance *dfv1.VertexInstance, wmStores map[string]store.WatermarkStore, opts ...Option) Fetcher {
 	var edgeFetchers = make(map[string]*edgeFetcher)
 	for _, e := range vertexInstance.Vertex.Spec.FromEdges {
@@ -55,9 +63,15 @@ func NewEdgeFetcherSet(ctx context.Context, vertexInstance *dfv1.VertexInstance,
 
 		edgeFetchers[e.From] = NewEdgeFetcher(ctx, wmStores[e.From], fromBufferPartitionCount, opts...)
 	}
+	dOpts := defaultOptions()
+	for _, opt := range opts {
+		opt(dOpts)
+	}
 	return &edgeFetcherSet{
 		edgeFetchers,
 		logging.FromContext(ctx),
+		dOpts.decisionLog,
+		dOpts.clock,
 	}
 }
 
@@ -67,17 +81,33 @@ func (efs *edgeFetcherSet) ComputeWatermark(inputOffset isb.Offset, fromPartitio
 	var (
 		wm               wmb.Watermark
 		overallWatermark = wmb.Watermark(time.UnixMilli(math.MaxInt64))
+		decision         *Decision
+		edgeDecision     *EdgeDecision
 	)
+	if efs.decisionLog != nil {
+		decision = &Decision{Time: efs.clock.Now(), Offset: inputOffset.String(), Partition: fromPartitionIdx}
+	}
 	for fromVertex, fetcher := range efs.edgeFetchers {
+		if decision != nil {
+			edgeDecision = &EdgeDecision{FromVertex: fromVertex}
+		}
 		// we don't need to use the returned updated watermark here
 		// because we do getWatermark afterwards to get
 		// the overall watermark from all partitions
-		_ = fetcher.updateWatermark(inputOffset, fromPartitionIdx)
+		_ = fetcher.updateWatermarkWithDecision(inputOffset, fromPartitionIdx, edgeDecision)
 		wm = fetcher.getWatermark()
 		efs.log.Debugf("Got Edge watermark from vertex=%q: %v", fromVertex, wm.UnixMilli())
 		if wm.BeforeWatermark(overallWatermark) {
 			overallWatermark = wm
 		}
+		if decision != nil {
+			edgeDecision.Watermark = wm.UnixMilli()
+			decision.Edges = append(decision.Edges, *edgeDecision)
+		}
+	}
+	if decision != nil {
+		decision.Watermark = overallWatermark.UnixMilli()
+		efs.decisionLog.record(*decision)
 	}
 	return overallWatermark
 }
@@ -123,3 +153,11 @@ func (efs *edgeFetcherSet) ComputeHeadIdleWMB(fromPartitionIdx int32) wmb.WMB {
 	return overallHeadWMB
 
 }
+
+// Close stops watching the watermark stores of all the incoming edges, the stores themselves are not closed.
+func (efs *edgeFetcherSet) Close() error {
+	for _, fetcher := range efs.edgeFetchers {
+		_ = fetcher.Close()
+	}
+	return nil
+}
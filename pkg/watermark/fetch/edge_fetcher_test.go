@@ -32,6 +32,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb"
 	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
@@ -840,11 +841,13 @@ func TestFetcherWithSameOTBucket_InMem(t *testing.T) {
 
 	epoch += 60000
 
-	var fetcher = NewEdgeFetcher(ctx, wmStore, 1)
+	// the processors time out only when the clock is stepped.
+	fakeClock := clock.NewFakeClock(time.Unix(1651161600, 0))
+	var fetcher = NewEdgeFetcher(ctx, wmStore, 1, WithClock(fakeClock))
 
 	var heartBeatManagerMap = make(map[string]*heartBeatManager)
-	heartBeatManagerMap["p1"] = manageHeartbeat(ctx, "p1", wmStore.HeartbeatStore())
-	heartBeatManagerMap["p2"] = manageHeartbeat(ctx, "p2", wmStore.HeartbeatStore())
+	heartBeatManagerMap["p1"] = manageHeartbeat(ctx, "p1", wmStore.HeartbeatStore(), fakeClock)
+	heartBeatManagerMap["p2"] = manageHeartbeat(ctx, "p2", wmStore.HeartbeatStore(), fakeClock)
 
 	heartBeatManagerMap["p1"].start()
 	heartBeatManagerMap["p2"].start()
@@ -965,7 +968,8 @@ func TestFetcherWithSameOTBucket_InMem(t *testing.T) {
 				t.Fatalf("expected p1 to be inactive: %s", ctx.Err())
 			}
 		default:
-			time.Sleep(100 * time.Millisecond)
+			fakeClock.Step(time.Second)
+			time.Sleep(1 * time.Millisecond)
 			allProcessors = fetcher.processorManager.getAllProcessors()
 		}
 	}
@@ -1070,11 +1074,13 @@ func TestFetcherWithSameOTBucketWithSinglePartition(t *testing.T) {
 	// create wm stores
 	wmStore, err := store.BuildJetStreamWatermarkStore(ctx, keyspace, defaultJetStreamClient)
 	assert.NoError(t, err)
-	fetcher := NewEdgeFetcher(ctx, wmStore, 1)
+	// the processors time out only when the clock is stepped.
+	fakeClock := clock.NewFakeClock(time.Unix(1651161600, 0))
+	fetcher := NewEdgeFetcher(ctx, wmStore, 1, WithClock(fakeClock))
 
 	var heartBeatManagerMap = make(map[string]*heartBeatManager)
-	heartBeatManagerMap["p1"] = manageHeartbeat(ctx, "p1", hbStore)
-	heartBeatManagerMap["p2"] = manageHeartbeat(ctx, "p2", hbStore)
+	heartBeatManagerMap["p1"] = manageHeartbeat(ctx, "p1", hbStore, fakeClock)
+	heartBeatManagerMap["p2"] = manageHeartbeat(ctx, "p2", hbStore, fakeClock)
 
 	// start the heartbeats for p1 and p2
 	heartBeatManagerMap["p1"].start()
@@ -1214,6 +1220,7 @@ func TestFetcherWithSameOTBucketWithSinglePartition(t *testing.T) {
 				t.Fatalf("expected p1 to be inactive: %s", ctx.Err())
 			}
 		default:
+			fakeClock.Step(time.Second)
 			time.Sleep(1 * time.Millisecond)
 			allProcessors = fetcher.processorManager.getAllProcessors()
 		}
@@ -1367,11 +1374,13 @@ func TestFetcherWithSameOTBucketWithMultiplePartition(t *testing.T) {
 	// create watchers for heartbeat and offset timeline
 	wmStore, err := store.BuildJetStreamWatermarkStore(ctx, keyspace, defaultJetStreamClient)
 	assert.NoError(t, err)
-	fetcher := NewEdgeFetcher(ctx, wmStore, 3)
+	// the processors time out only when the clock is stepped.
+	fakeClock := clock.NewFakeClock(time.Unix(1651161600, 0))
+	fetcher := NewEdgeFetcher(ctx, wmStore, 3, WithClock(fakeClock))
 
 	var heartBeatManagerMap = make(map[string]*heartBeatManager)
-	heartBeatManagerMap["p1"] = manageHeartbeat(ctx, "p1", hbStore)
-	heartBeatManagerMap["p2"] = manageHeartbeat(ctx, "p2", hbStore)
+	heartBeatManagerMap["p1"] = manageHeartbeat(ctx, "p1", hbStore, fakeClock)
+	heartBeatManagerMap["p2"] = manageHeartbeat(ctx, "p2", hbStore, fakeClock)
 
 	// start the heartbeats for p1 and p2
 	heartBeatManagerMap["p1"].start()
@@ -1549,6 +1558,7 @@ func TestFetcherWithSameOTBucketWithMultiplePartition(t *testing.T) {
 				t.Fatalf("expected p1 to be inactive: %s", ctx.Err())
 			}
 		default:
+			fakeClock.Step(time.Second)
 			time.Sleep(1 * time.Millisecond)
 			allProcessors = fetcher.processorManager.getAllProcessors()
 		}
@@ -1678,7 +1688,7 @@ func (h *heartBeatManager) resume() {
 	h.heartBeatCh <- 2
 }
 
-func manageHeartbeat(ctx context.Context, entityName string, hbStore kvs.KVStorer) *heartBeatManager {
+func manageHeartbeat(ctx context.Context, entityName string, hbStore kvs.KVStorer, c clock.Clock) *heartBeatManager {
 	hbManager := &heartBeatManager{
 		heartBeatCh: make(chan int),
 	}
@@ -1695,7 +1705,7 @@ func manageHeartbeat(ctx context.Context, entityName string, hbStore kvs.KVStore
 				start = !start
 			default:
 				if start {
-					hb := wmbpb.Heartbeat{Heartbeat: time.Now().Unix()}
+					hb := wmbpb.Heartbeat{Heartbeat: c.Now().Unix()}
 					marshal, err := proto.Marshal(&hb)
 					if err != nil {
 						return
@@ -1708,3 +1718,35 @@ func manageHeartbeat(ctx context.Context, entityName string, hbStore kvs.KVStore
 	}()
 	return hbManager
 }
+
+func TestEdgeFetcher_Close(t *testing.T) {
+	const keyspace = "fetcherTestClose"
+
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	nc := natsclient.NewTestClientWithServer(t, s)
+	defer nc.Close()
+	js, err := nc.JetStreamContext()
+	assert.NoError(t, err)
+	for _, bucket := range []string{keyspace + "_PROCESSORS", keyspace + "_OT"} {
+		_, err = js.CreateKeyValue(&nats.KeyValueConfig{Bucket: bucket, Storage: nats.MemoryStorage})
+		assert.NoError(t, err)
+	}
+
+	baseline := s.NumSubscriptions()
+	for i := 0; i < 100; i++ {
+		wmStore, err := store.BuildJetStreamWatermarkStore(ctx, keyspace, nc)
+		assert.NoError(t, err)
+		fetcher := NewEdgeFetcher(ctx, wmStore, 1)
+		// wait for the heartbeat and the offset timeline watchers.
+		assert.Eventually(t, func() bool { return s.NumSubscriptions() >= baseline+2 }, 5*time.Second, time.Millisecond)
+		assert.NoError(t, fetcher.Close())
+		assert.NoError(t, wmStore.Close())
+	}
+	// the watchers are stopped asynchronously.
+	assert.Eventually(t, func() bool { return s.NumSubscriptions() == baseline }, 5*time.Second, 10*time.Millisecond)
+}
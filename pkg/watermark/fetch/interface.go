@@ -29,6 +29,18 @@ type Fetcher interface {
 	ComputeHeadIdleWMB(fromPartitionIdx int32) wmb.WMB
 }
 
+// WatermarkResolver returns the watermark of an offset of a read batch.
+type WatermarkResolver func(offset isb.Offset) wmb.Watermark
+
+// BatchFetcher is a Fetcher computing the watermarks of a whole read batch with a single timeline lookup per processor,
+// instead of one per offset.
+type BatchFetcher interface {
+	Fetcher
+	// ComputeBatchWatermark computes the watermark of the min offset of the batch like ComputeWatermark does, and
+	// returns a resolver of the watermarks of the offsets between the min and the max offsets, valid for the batch.
+	ComputeBatchWatermark(minOffset, maxOffset isb.Offset, fromPartitionIdx int32) (wmb.Watermark, WatermarkResolver)
+}
+
 // SourceFetcher fetches watermark data for source vertex.
 type SourceFetcher interface {
 	// ComputeWatermark computes the watermark, it will return the minimum of all the watermarks of the processors.
@@ -16,6 +16,10 @@ limitations under the License.
 
 package fetch
 
+import (
+	"github.com/numaproj/numaflow/pkg/shared/clock"
+)
+
 type options struct {
 	// podHeartbeatRate uses second as time unit
 	podHeartbeatRate int64
@@ -32,6 +36,10 @@ type options struct {
 	isFromVtxReduce bool
 	// fromVtxPartitions is the number of partitions in from vertex.
 	fromVtxPartitions int
+	// clock is used to check the heartbeats of the processors.
+	clock clock.Clock
+	// decisionLog records the merged watermark computations, nil if disabled.
+	decisionLog *DecisionLog
 }
 
 // Option set options for FromVertex.
@@ -43,6 +51,7 @@ func defaultOptions() *options {
 		refreshingProcessorsRate: 5,
 		vertexReplica:            0,
 		fromVtxPartitions:        1,
+		clock:                    clock.RealClock(),
 	}
 }
 
@@ -94,3 +103,17 @@ func WithFromVtxPartitions(partitions int) Option {
 		opts.fromVtxPartitions = partitions
 	}
 }
+
+// WithClock sets the clock used to check the heartbeats of the processors, it is meant for the tests.
+func WithClock(c clock.Clock) Option {
+	return func(opts *options) {
+		opts.clock = c
+	}
+}
+
+// WithDecisionLog sets the log to record the merged watermark computations.
+func WithDecisionLog(l *DecisionLog) Option {
+	return func(opts *options) {
+		opts.decisionLog = l
+	}
+}
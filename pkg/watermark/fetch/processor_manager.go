@@ -43,7 +43,9 @@ import (
 // processorManager manages the point of view of Vn-1 from Vn vertex processors (or source processor). The code is running on Vn vertex.
 // It has the mapping of all the processors which in turn has all the information about each processor timelines.
 type processorManager struct {
-	ctx            context.Context
+	ctx context.Context
+	// cancel stops the goroutines watching the watermark store, and hence the watchers of the KV stores.
+	cancel         context.CancelFunc
 	watermarkStore store.WatermarkStore
 	// heartbeat just tracks the heartbeat of each processing unit. we use it to mark a processing unit's status (e.g, inactive)
 	heartbeat *processorHeartbeat
@@ -67,8 +69,10 @@ func newProcessorManager(ctx context.Context, wmStore store.WatermarkStore, from
 		opt(opts)
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
 	v := &processorManager{
 		ctx:                      ctx,
+		cancel:                   cancel,
 		watermarkStore:           wmStore,
 		heartbeat:                newProcessorHeartbeat(),
 		processors:               make(map[string]*ProcessorToFetch),
@@ -84,7 +88,7 @@ func newProcessorManager(ctx context.Context, wmStore store.WatermarkStore, from
 	return v
 }
 
-// init initializes few go-routines which exit on ctx.Done.
+// init initializes few go-routines which exit on ctx.Done or close.
 func (v *processorManager) init() {
 	// start refreshing processors goroutine
 	go v.startRefreshingProcessors()
@@ -96,6 +100,12 @@ func (v *processorManager) init() {
 	go v.startTimeLineWatcher()
 }
 
+// close stops watching the watermark store, the watchers of the heartbeat and offset timeline KV stores are stopped,
+// while the store itself is left open because it's owned by the caller.
+func (v *processorManager) close() {
+	v.cancel()
+}
+
 // addProcessor adds a new processor. If the given processor already exists, the value will be updated.
 func (v *processorManager) addProcessor(processor string, p *ProcessorToFetch) {
 	v.lock.Lock()
@@ -133,14 +143,14 @@ func (v *processorManager) getAllProcessors() map[string]*ProcessorToFetch {
 }
 
 func (v *processorManager) startRefreshingProcessors() {
-	ticker := time.NewTicker(time.Duration(v.opts.refreshingProcessorsRate) * time.Second)
+	ticker := v.opts.clock.NewTicker(time.Duration(v.opts.refreshingProcessorsRate) * time.Second)
 	defer ticker.Stop()
 	v.log.Infow("Refreshing ActiveProcessors ticker started")
 	for {
 		select {
 		case <-v.ctx.Done():
 			return
-		case <-ticker.C:
+		case <-ticker.C():
 			v.refreshingProcessors()
 		}
 	}
@@ -149,6 +159,7 @@ func (v *processorManager) startRefreshingProcessors() {
 // refreshingProcessors keeps the v.ActivePods to be a map of live ActivePods
 func (v *processorManager) refreshingProcessors() {
 	var debugStr strings.Builder
+	now := v.opts.clock.Now().Unix()
 	for pName, pTime := range v.heartbeat.getAll() {
 		p := v.getProcessor(pName)
 		if p == nil {
@@ -158,7 +169,7 @@ func (v *processorManager) refreshingProcessors() {
 		}
 		// default heartbeat rate is every 5 seconds
 		// TODO: tolerance?
-		if time.Now().Unix()-pTime > 10*v.opts.podHeartbeatRate {
+		if now-pTime > 10*v.opts.podHeartbeatRate {
 			// if the pod doesn't come back after 10 heartbeats,
 			// it's possible the pod has exited unexpectedly, so we need to delete the pod
 			// NOTE: the pod entry still remains in the heartbeat store (bucket)
@@ -166,7 +177,7 @@ func (v *processorManager) refreshingProcessors() {
 			v.log.Infow("Processor has been inactive for 10 heartbeats, deleting...", zap.String("key", pName), zap.String(pName, p.String()))
 			p.setStatus(_deleted)
 			v.heartbeat.delete(pName)
-		} else if time.Now().Unix()-pTime > v.opts.podHeartbeatRate {
+		} else if now-pTime > v.opts.podHeartbeatRate {
 			// if the pod's last heartbeat is greater than podHeartbeatRate
 			// then the pod is not considered as live
 			p.setStatus(_inactive)
@@ -26,7 +26,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/goleak"
+	"google.golang.org/protobuf/proto"
 
+	wmbpb "github.com/numaproj/numaflow/pkg/apis/proto/watermark"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 )
@@ -43,7 +46,8 @@ func otValueToBytes(offset int64, watermark int64, idle bool, partition int32) (
 }
 
 func TestMain(m *testing.M) {
-	goleak.VerifyTestMain(m)
+	// the keyring imported by the Pulsar client connects to the D-Bus session bus, if any, when it's initialized
+	goleak.VerifyTestMain(m, goleak.IgnoreAnyFunction("github.com/godbus/dbus.(*Conn).inWorker"))
 }
 
 func TestProcessorManager(t *testing.T) {
@@ -56,7 +60,9 @@ func TestProcessorManager(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.NoError(t, err)
-	var processorManager = newProcessorManager(ctx, wmStore, 1)
+	// the processors are only refreshed when the clock is stepped, so none of them times out in this test.
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	var processorManager = newProcessorManager(ctx, wmStore, 1, WithClock(fakeClock))
 	var wg sync.WaitGroup
 
 	wg.Add(1)
@@ -65,9 +71,9 @@ func TestProcessorManager(t *testing.T) {
 		defer wg.Done()
 		var err error
 		for i := 0; i < 3; i++ {
-			err = wmStore.HeartbeatStore().PutKV(ctx, "p1", []byte(fmt.Sprintf("%d", time.Now().Unix())))
+			err = wmStore.HeartbeatStore().PutKV(ctx, "p1", []byte(fmt.Sprintf("%d", fakeClock.Now().Unix())))
 			assert.NoError(t, err)
-			time.Sleep(1 * time.Second)
+			time.Sleep(10 * time.Millisecond)
 		}
 		err = wmStore.HeartbeatStore().DeleteKey(ctx, "p1")
 		assert.NoError(t, err)
@@ -79,9 +85,12 @@ func TestProcessorManager(t *testing.T) {
 		defer wg.Done()
 		for {
 			select {
-			case <-time.After(1 * time.Second):
-				err := wmStore.HeartbeatStore().PutKV(ctx, "p2", []byte(fmt.Sprintf("%d", time.Now().Unix())))
-				assert.NoError(t, err)
+			case <-time.After(10 * time.Millisecond):
+				err := wmStore.HeartbeatStore().PutKV(ctx, "p2", []byte(fmt.Sprintf("%d", fakeClock.Now().Unix())))
+				// the put fails if the test is done in the meantime.
+				if ctx.Err() == nil {
+					assert.NoError(t, err)
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -130,6 +139,40 @@ func TestProcessorManager(t *testing.T) {
 	_ = wmStore.Close()
 }
 
+func TestProcessorManager_HeartbeatTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	wmStore, err := store.BuildInmemWatermarkStore(ctx, "heartbeatTimeoutTest")
+	assert.NoError(t, err)
+	defer func() { _ = wmStore.Close() }()
+	fakeClock := clock.NewFakeClock(time.Unix(1636470000, 0))
+	processorManager := newProcessorManager(ctx, wmStore, 1, WithClock(fakeClock))
+
+	hb, err := proto.Marshal(&wmbpb.Heartbeat{Heartbeat: fakeClock.Now().Unix()})
+	assert.NoError(t, err)
+	assert.NoError(t, wmStore.HeartbeatStore().PutKV(ctx, "p1", hb))
+	assert.Eventually(t, func() bool {
+		return processorManager.heartbeat.get("p1") == fakeClock.Now().Unix()
+	}, 5*time.Second, time.Millisecond)
+	p1 := processorManager.getProcessor("p1")
+	assert.True(t, p1.IsActive())
+	// wait for the refreshing ticker to be created.
+	assert.Eventually(t, fakeClock.HasWaiters, 5*time.Second, time.Millisecond)
+
+	// the heartbeat is within the heartbeat rate of 5 seconds.
+	fakeClock.Step(5 * time.Second)
+	assert.Never(t, func() bool { return !p1.IsActive() }, 50*time.Millisecond, time.Millisecond)
+
+	// the heartbeat is missed.
+	fakeClock.Step(5 * time.Second)
+	assert.Eventually(t, p1.IsInactive, 5*time.Second, time.Millisecond)
+
+	// no heartbeat for 10 heartbeat rates, the processor is deleted.
+	fakeClock.Step(45 * time.Second)
+	assert.Eventually(t, p1.IsDeleted, 5*time.Second, time.Millisecond)
+	assert.Equal(t, int64(-1), processorManager.heartbeat.get("p1"))
+}
+
 func TestProcessorManagerWatchForMapWithOnePartition(t *testing.T) {
 	var (
 		err        error
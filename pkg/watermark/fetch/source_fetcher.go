@@ -18,6 +18,7 @@ package fetch
 
 import (
 	"context"
+	"io"
 	"math"
 	"time"
 
@@ -34,8 +35,10 @@ type sourceFetcher struct {
 	log              *zap.SugaredLogger
 }
 
+var _ io.Closer = (*sourceFetcher)(nil)
+
 // NewSourceFetcher returns a new source fetcher, pm has the details about the processors responsible for writing to the
-// buckets of the source buffer.
+// buckets of the source buffer. The returned fetcher implements io.Closer to stop watching the store.
 func NewSourceFetcher(ctx context.Context, store store.WatermarkStore, opts ...Option) SourceFetcher {
 	log := logging.FromContext(ctx)
 	log.Info("Creating a new source watermark fetcher")
@@ -92,3 +95,9 @@ func (e *sourceFetcher) ComputeHeadWatermark(fromPartitionIdx int32) wmb.Waterma
 	}
 	return wmb.Watermark(time.UnixMilli(epoch))
 }
+
+// Close stops watching the watermark store of the source, the store itself is not closed.
+func (e *sourceFetcher) Close() error {
+	e.processorManager.close()
+	return nil
+}
@@ -49,6 +49,11 @@ func (n NoOpWMProgressor) PublishWatermark(wmb.Watermark, isb.Offset, int32) {
 func (n NoOpWMProgressor) PublishIdleWatermark(wmb.Watermark, isb.Offset, int32) {
 }
 
+// PublishSkippedWatermark does a no-op skipped watermark publish, the partition is treated as idling.
+func (n NoOpWMProgressor) PublishSkippedWatermark(wmb.Watermark, isb.Offset, int32) bool {
+	return false
+}
+
 // GetLatestWatermark returns the default watermark as the latest watermark.
 func (n NoOpWMProgressor) GetLatestWatermark() wmb.Watermark {
 	return wmb.Watermark{}
@@ -90,6 +95,11 @@ func (n NoOpSourceWMProgressor) PublishWatermark(_ wmb.Watermark, _ isb.Offset,
 // PublishIdleWatermark does a no-op idle watermark publish.
 func (n NoOpSourceWMProgressor) PublishIdleWatermark(_ wmb.Watermark, _ isb.Offset, _ int32) {}
 
+// PublishSkippedWatermark does a no-op skipped watermark publish, the partition is treated as idling.
+func (n NoOpSourceWMProgressor) PublishSkippedWatermark(_ wmb.Watermark, _ isb.Offset, _ int32) bool {
+	return false
+}
+
 // GetLatestWatermark returns the default watermark as the latest watermark.
 func (n NoOpSourceWMProgressor) GetLatestWatermark() wmb.Watermark {
 	return wmb.Watermark{}
@@ -18,6 +18,8 @@ package publish
 
 import (
 	"time"
+
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 )
 
 type publishOptions struct {
@@ -34,6 +36,8 @@ type publishOptions struct {
 	// Whether it is sink publisher or not
 	// isSource and isSink should not be both true
 	isSink bool
+	// clock is used to timestamp the heartbeats and the source and sink watermarks.
+	clock clock.Clock
 }
 
 type PublishOption func(*publishOptions)
@@ -70,3 +74,10 @@ func IsSink() PublishOption {
 		opts.isSink = true
 	}
 }
+
+// WithClock sets the clock of the publisher, it is meant for the tests.
+func WithClock(c clock.Clock) PublishOption {
+	return func(opts *publishOptions) {
+		opts.clock = c
+	}
+}
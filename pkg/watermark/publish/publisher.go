@@ -25,6 +25,7 @@ import (
 	"context"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -32,6 +33,7 @@ import (
 
 	wmbpb "github.com/numaproj/numaflow/pkg/apis/proto/watermark"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
@@ -46,6 +48,9 @@ type Publisher interface {
 	PublishWatermark(w wmb.Watermark, o isb.Offset, toVertexPartitionIdx int32)
 	// PublishIdleWatermark publishes the idle watermark.
 	PublishIdleWatermark(wm wmb.Watermark, o isb.Offset, toVertexPartitionIdx int32)
+	// PublishSkippedWatermark advances the watermark of a partition to which nothing was written because the messages
+	// were dropped, it returns false if the watermark can not be advanced without an offset.
+	PublishSkippedWatermark(wm wmb.Watermark, o isb.Offset, toVertexPartitionIdx int32) bool
 	// GetLatestWatermark returns the latest published watermark.
 	GetLatestWatermark() wmb.Watermark
 }
@@ -60,9 +65,15 @@ type publish struct {
 	heartbeatStore         kvs.KVStorer
 	log                    *zap.SugaredLogger
 	headWatermarks         []wmb.Watermark
+	lastOffsets            []int64 // lastOffsets is the offset of the last message written to each partition
 	headWMLock             sync.RWMutex
 	toVertexPartitionCount int32
 	opts                   *publishOptions
+	// stopHeartbeat stops the heartbeat publishing, heartbeatDone is closed once it is stopped.
+	stopHeartbeat context.CancelFunc
+	heartbeatDone chan struct{}
+	// closed is set once the publisher is closed, nothing is published after that.
+	closed atomic.Bool
 }
 
 // NewPublish returns `Publish`.
@@ -76,6 +87,7 @@ func NewPublish(ctx context.Context, processorEntity entity.ProcessorEntitier, w
 		podHeartbeatRate:     5,
 		isSource:             false,
 		delay:                0,
+		clock:                clock.RealClock(),
 	}
 	for _, opt := range inputOpts {
 		opt(opts)
@@ -89,12 +101,17 @@ func NewPublish(ctx context.Context, processorEntity entity.ProcessorEntitier, w
 		toVertexPartitionCount: toVertexPartitionCount,
 		log:                    log,
 		opts:                   opts,
+		heartbeatDone:          make(chan struct{}),
 	}
 
 	p.initialSetup()
 
+	var hbCtx context.Context
+	hbCtx, p.stopHeartbeat = context.WithCancel(ctx)
 	if opts.autoRefreshHeartbeat {
-		go p.publishHeartbeat()
+		go p.publishHeartbeat(hbCtx)
+	} else {
+		close(p.heartbeatDone)
 	}
 	return p
 }
@@ -113,20 +130,50 @@ func (p *publish) SetHeadWM(wm wmb.Watermark, toVertexPartitionIdx int32) {
 	p.headWatermarks[toVertexPartitionIdx] = wm
 }
 
+// getLastOffset gets the offset of the last message written to the given partition, -1 if there is none.
+func (p *publish) getLastOffset(toVertexPartitionIdx int32) int64 {
+	p.headWMLock.RLock()
+	defer p.headWMLock.RUnlock()
+	return p.lastOffsets[toVertexPartitionIdx]
+}
+
+// setLastOffset sets the offset of the last message written to the given partition, the offset never goes back.
+func (p *publish) setLastOffset(offset int64, toVertexPartitionIdx int32) {
+	p.headWMLock.Lock()
+	defer p.headWMLock.Unlock()
+	if offset > p.lastOffsets[toVertexPartitionIdx] {
+		p.lastOffsets[toVertexPartitionIdx] = offset
+	}
+}
+
 // initialSetup inserts the default values as the ProcessorEntitier starts emitting watermarks.
 // We will be initializing all to -1
 // TODO: we could ideally resume from where we left off, but this will introduce a new key.
 func (p *publish) initialSetup() {
 	var headWms []wmb.Watermark
+	var lastOffsets []int64
 	for i := 0; i < int(p.toVertexPartitionCount); i++ {
 		headWms = append(headWms, wmb.InitialWatermark)
+		lastOffsets = append(lastOffsets, -1)
 	}
 	p.headWatermarks = headWms
+	p.lastOffsets = lastOffsets
 }
 
 // PublishWatermark publishes watermark and will retry until it can succeed. It will not publish if the new-watermark
 // is less than the current head watermark.
 func (p *publish) PublishWatermark(wm wmb.Watermark, offset isb.Offset, toVertexPartitionIdx int32) {
+	if p.closed.Load() {
+		p.log.Debugw("Skip publishing watermark, the publisher is closed", zap.Int64("watermark", wm.UnixMilli()))
+		return
+	}
+	if !p.opts.isSource && !p.opts.isSink && offset != nil {
+		// track the written offset even if the watermark is not published, a skipped watermark published later
+		// must not be associated with an offset before it.
+		if seq, err := offset.Sequence(); err == nil {
+			p.setLastOffset(seq, toVertexPartitionIdx)
+		}
+	}
 	// if its a source, we need to add the delay to the watermark
 	if p.opts.isSource && p.opts.delay.Nanoseconds() > 0 && !time.Time(wm).IsZero() {
 		wm = wmb.Watermark(time.Time(wm).Add(-p.opts.delay))
@@ -143,7 +190,7 @@ func (p *publish) PublishWatermark(wm wmb.Watermark, offset isb.Offset, toVertex
 	var seq int64
 	if p.opts.isSource || p.opts.isSink {
 		// For source and sink publisher, we don't care about the offset, also the sequence of the offset might not be integer.
-		seq = time.Now().UnixNano()
+		seq = p.opts.clock.Now().UnixNano()
 	} else {
 		seq, _ = offset.Sequence()
 	}
@@ -194,6 +241,10 @@ func (p *publish) validateWatermark(wm wmb.Watermark, toVertexPartitionIdx int32
 // is the current watermark + the increment by value.
 // TODO: merge with PublishWatermark
 func (p *publish) PublishIdleWatermark(wm wmb.Watermark, offset isb.Offset, toVertexPartitionIdx int32) {
+	if p.closed.Load() {
+		p.log.Debugw("Skip publishing idle watermark, the publisher is closed", zap.Int64("watermark", wm.UnixMilli()))
+		return
+	}
 	var key = p.entity.GetName()
 	validWM, skipWM := p.validateWatermark(wm, toVertexPartitionIdx)
 	if skipWM {
@@ -203,7 +254,7 @@ func (p *publish) PublishIdleWatermark(wm wmb.Watermark, offset isb.Offset, toVe
 	var seq int64
 	if p.opts.isSource || p.opts.isSink {
 		// for source and sink publisher, we don't care about the offset, also the sequence of the offset might not be integer.
-		seq = time.Now().UnixNano()
+		seq = p.opts.clock.Now().UnixNano()
 	} else {
 		seq, _ = offset.Sequence()
 	}
@@ -227,11 +278,74 @@ func (p *publish) PublishIdleWatermark(wm wmb.Watermark, offset isb.Offset, toVe
 			time.Sleep(time.Millisecond * 250)
 		} else {
 			p.log.Debugw("New idle watermark published", zap.Int32("toVertexPartitionIdx", toVertexPartitionIdx), zap.String("HB", p.heartbeatStore.GetStoreName()), zap.String("OT", p.otStore.GetStoreName()), zap.String("key", key), zap.Int64("offset", seq), zap.Int64("watermark", validWM.UnixMilli()))
+			if !p.opts.isSource && !p.opts.isSink {
+				p.setLastOffset(seq, toVertexPartitionIdx)
+			}
 			break
 		}
 	}
 }
 
+// PublishSkippedWatermark advances the watermark of a partition to which nothing was written in a batch, because all
+// the messages to it were dropped (e.g. duplicates, or a full buffer with DiscardLatest). The offsets of the dropped
+// messages are acked without being forwarded, so there is no new offset to associate the watermark with. The
+// watermark is published as an active watermark at the given offset, or at the offset of the last message written to
+// the partition if the offset is nil, so that the fetchers keep advancing through the offset gap without a ctrl
+// message written to the buffer. It returns false if there is no offset to publish at, the caller should treat the
+// partition as idling then.
+func (p *publish) PublishSkippedWatermark(wm wmb.Watermark, offset isb.Offset, toVertexPartitionIdx int32) bool {
+	if p.closed.Load() {
+		p.log.Debugw("Skip publishing skipped watermark, the publisher is closed", zap.Int64("watermark", wm.UnixMilli()))
+		return false
+	}
+	if p.opts.isSource || p.opts.isSink {
+		// the offset is not used by the source and sink publishers.
+		p.PublishWatermark(wm, offset, toVertexPartitionIdx)
+		return true
+	}
+	seq := p.getLastOffset(toVertexPartitionIdx)
+	if offset != nil {
+		if s, err := offset.Sequence(); err == nil && s > seq {
+			seq = s
+		}
+	}
+	if seq < 0 {
+		// nothing was ever written to the partition, there is no offset the watermark can be associated with.
+		return false
+	}
+
+	validWM, skipWM := p.validateWatermark(wm, toVertexPartitionIdx)
+	if skipWM {
+		// the head watermark is already ahead, the partition has been advanced.
+		return true
+	}
+
+	var key = p.entity.GetName()
+	var otValue = wmb.WMB{
+		Offset:    seq,
+		Watermark: validWM.UnixMilli(),
+		Partition: toVertexPartitionIdx,
+	}
+	value, err := otValue.EncodeToBytes()
+	if err != nil {
+		p.log.Errorw("Unable to publish skipped watermark", zap.Int32("toVertexPartitionIdx", toVertexPartitionIdx), zap.String("OT", p.otStore.GetStoreName()), zap.String("key", key), zap.Error(err))
+		return false
+	}
+
+	for {
+		err := p.otStore.PutKV(p.ctx, key, value)
+		if err != nil {
+			p.log.Errorw("Unable to publish skipped watermark", zap.Int32("toVertexPartitionIdx", toVertexPartitionIdx), zap.String("OT", p.otStore.GetStoreName()), zap.String("key", key), zap.Error(err))
+			// TODO: better exponential backoff
+			time.Sleep(time.Millisecond * 250)
+		} else {
+			p.log.Debugw("New watermark published for skipped offsets", zap.Int32("toVertexPartitionIdx", toVertexPartitionIdx), zap.Int64("watermark", validWM.UnixMilli()), zap.Int64("offset", seq))
+			p.setLastOffset(seq, toVertexPartitionIdx)
+			return true
+		}
+	}
+}
+
 // loadLatestFromStore loads the latest watermark stored in the watermark store.
 // TODO: how to repopulate if the processing unit is down for a really long time?
 func (p *publish) loadLatestFromStore() wmb.Watermark {
@@ -265,23 +379,24 @@ func (p *publish) GetLatestWatermark() wmb.Watermark {
 	return latestWatermark
 }
 
-func (p *publish) publishHeartbeat() {
-	ticker := time.NewTicker(time.Second * time.Duration(p.opts.podHeartbeatRate))
+func (p *publish) publishHeartbeat(ctx context.Context) {
+	defer close(p.heartbeatDone)
+	ticker := p.opts.clock.NewTicker(time.Second * time.Duration(p.opts.podHeartbeatRate))
 	defer ticker.Stop()
 	p.log.Infow("Publishing Heartbeat ticker started")
 	for {
 		select {
-		case <-p.ctx.Done():
+		case <-ctx.Done():
 			return
-		case <-ticker.C:
+		case <-ticker.C():
 			hbValue, err := proto.Marshal(&wmbpb.Heartbeat{
-				Heartbeat: time.Now().Unix(),
+				Heartbeat: p.opts.clock.Now().Unix(),
 			})
 			if err != nil {
 				p.log.Errorw("Unable to marshal heartbeat", zap.Error(err))
 				continue
 			}
-			err = p.heartbeatStore.PutKV(p.ctx, p.entity.GetName(), hbValue)
+			err = p.heartbeatStore.PutKV(ctx, p.entity.GetName(), hbValue)
 			if err != nil {
 				p.log.Errorw("put to bucket failed", zap.String("bucket", p.heartbeatStore.GetStoreName()), zap.Error(err))
 			}
@@ -289,9 +404,13 @@ func (p *publish) publishHeartbeat() {
 	}
 }
 
-// Close stops the publisher and cleans up the data associated with key.
+// Close stops the publisher and cleans up the data associated with key. The heartbeat is stopped before the keys
+// are deleted, so that the heartbeat is not published again after the cleanup.
 func (p *publish) Close() error {
 	p.log.Info("Closing watermark publisher")
+	p.closed.Store(true)
+	p.stopHeartbeat()
+	<-p.heartbeatDone
 
 	// delete the entry from the heartbeat bucket
 	if err := p.heartbeatStore.DeleteKey(p.ctx, p.entity.GetName()); err != nil {
@@ -24,8 +24,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 
+	wmbpb "github.com/numaproj/numaflow/pkg/apis/proto/watermark"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/clock"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -65,3 +68,77 @@ func TestPublisherWithSharedOTBuckets_InMem(t *testing.T) {
 	assert.Equal(t, fmt.Errorf("key publisherTestPod1 not found"), err)
 
 }
+
+func TestPublisherHeartbeat_InMem(t *testing.T) {
+	var ctx = context.Background()
+	wmstore, err := store.BuildInmemWatermarkStore(ctx, "heartbeatTest")
+	assert.NoError(t, err)
+	publishEntity := entity.NewProcessorEntity("publisherTestPod1")
+	fakeClock := clock.NewFakeClock(time.Unix(1651161600, 0))
+
+	p := NewPublish(ctx, publishEntity, wmstore, 1, IsSource(), WithPodHeartbeatRate(5), WithClock(fakeClock)).(*publish)
+	defer func() { _ = p.Close() }()
+
+	// the heartbeat is published on every tick of the heartbeat rate.
+	assert.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(5 * time.Second)
+	assert.Eventually(t, func() bool {
+		value, err := p.heartbeatStore.GetValue(ctx, publishEntity.GetName())
+		if err != nil {
+			return false
+		}
+		var hb wmbpb.Heartbeat
+		return proto.Unmarshal(value, &hb) == nil && hb.Heartbeat == fakeClock.Now().Unix()
+	}, time.Second, time.Millisecond)
+
+	// the offset of a source watermark is the publishing time.
+	p.PublishWatermark(wmb.Watermark(time.UnixMilli(1651161600000)), nil, 0)
+	value, err := p.otStore.GetValue(ctx, publishEntity.GetName())
+	assert.NoError(t, err)
+	otValue, err := wmb.DecodeToWMB(value)
+	assert.NoError(t, err)
+	assert.Equal(t, fakeClock.Now().UnixNano(), otValue.Offset)
+}
+
+func TestPublisherSkippedWatermark_InMem(t *testing.T) {
+	var ctx = context.Background()
+	wmstore, err := store.BuildInmemWatermarkStore(ctx, "skippedTest")
+	assert.NoError(t, err)
+	publishEntity := entity.NewProcessorEntity("publisherTestPod1")
+
+	p := NewPublish(ctx, publishEntity, wmstore, 2, WithAutoRefreshHeartbeatDisabled()).(*publish)
+
+	latestWMB := func() wmb.WMB {
+		value, err := p.otStore.GetValue(ctx, publishEntity.GetName())
+		assert.NoError(t, err)
+		otValue, err := wmb.DecodeToWMB(value)
+		assert.NoError(t, err)
+		return otValue
+	}
+
+	// nothing was written to the partition yet, there is no offset to publish at.
+	assert.False(t, p.PublishSkippedWatermark(wmb.Watermark(time.UnixMilli(1000)), nil, 0))
+
+	p.PublishWatermark(wmb.Watermark(time.UnixMilli(1000)), isb.SimpleIntOffset(func() int64 { return 10 }), 0)
+	// the same watermark is not published, but the written offset is tracked.
+	p.PublishWatermark(wmb.Watermark(time.UnixMilli(1000)), isb.SimpleIntOffset(func() int64 { return 12 }), 0)
+	assert.Equal(t, wmb.WMB{Offset: 10, Watermark: 1000, Partition: 0}, latestWMB())
+
+	// the skipped watermark is published at the offset of the last written message.
+	assert.True(t, p.PublishSkippedWatermark(wmb.Watermark(time.UnixMilli(2000)), nil, 0))
+	assert.Equal(t, wmb.WMB{Offset: 12, Watermark: 2000, Partition: 0}, latestWMB())
+
+	// an explicit offset advances the offset.
+	assert.True(t, p.PublishSkippedWatermark(wmb.Watermark(time.UnixMilli(3000)), isb.SimpleIntOffset(func() int64 { return 15 }), 0))
+	assert.Equal(t, wmb.WMB{Offset: 15, Watermark: 3000, Partition: 0}, latestWMB())
+
+	// an older watermark is not published, but the partition is still advanced.
+	assert.True(t, p.PublishSkippedWatermark(wmb.Watermark(time.UnixMilli(2500)), nil, 0))
+	assert.Equal(t, wmb.WMB{Offset: 15, Watermark: 3000, Partition: 0}, latestWMB())
+
+	// the other partition has no offset yet.
+	assert.False(t, p.PublishSkippedWatermark(wmb.Watermark(time.UnixMilli(3000)), nil, 1))
+
+	_ = p.Close()
+	assert.False(t, p.PublishSkippedWatermark(wmb.Watermark(time.UnixMilli(4000)), nil, 0))
+}
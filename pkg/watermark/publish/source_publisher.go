@@ -18,9 +18,13 @@ package publish
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"sync"
 	"time"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/watermark/entity"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
@@ -33,6 +37,7 @@ import (
 // Publish within the source itself (huh? :-D). Also, when the source boots up, it has to load
 // the watermark information so it can know about the global source WM state.
 type SourcePublisher interface {
+	io.Closer
 	// PublishSourceWatermarks publishes source watermarks.
 	PublishSourceWatermarks([]*isb.ReadMessage)
 	// PublishIdleWatermarks publishes idle watermarks for the given partitions.
@@ -45,29 +50,34 @@ type sourcePublish struct {
 	vertexName         string
 	srcPublishWMStores store.WatermarkStore
 	sourcePublishWMs   map[int32]Publisher
-	opts               *publishOptions
+	// opts are the options of the publishers of the partitions.
+	opts   []PublishOption
+	lock   sync.Mutex
+	closed bool
 }
 
-// NewSourcePublish returns a new source publisher.
+// NewSourcePublish returns a new source publisher. The options are applied to the publisher of each partition, which
+// publishes the watermarks of the partition as a source processor entity named {pipeline}-{vertex}-{partition}.
 func NewSourcePublish(ctx context.Context, pipelineName, vertexName string, srcPublishWMStores store.WatermarkStore, opts ...PublishOption) SourcePublisher {
-	options := &publishOptions{
-		delay: 0,
-	}
-	for _, opt := range opts {
-		opt(options)
-	}
-
 	sp := &sourcePublish{
 		ctx:                ctx,
 		pipelineName:       pipelineName,
 		vertexName:         vertexName,
 		srcPublishWMStores: srcPublishWMStores,
 		sourcePublishWMs:   make(map[int32]Publisher),
-		opts:               options,
+		opts:               append(append([]PublishOption{}, opts...), IsSource()),
 	}
 	return sp
 }
 
+// NewVertexSourcePublish returns a new source publisher of a source vertex, the names of the processor entities and
+// the watermark delay are derived from the vertex spec. The given options are applied after them.
+func NewVertexSourcePublish(ctx context.Context, vertexInstance *dfv1.VertexInstance, srcPublishWMStores store.WatermarkStore, opts ...PublishOption) SourcePublisher {
+	vertex := vertexInstance.Vertex
+	vertexOpts := append([]PublishOption{WithDelay(vertex.Spec.Watermark.GetMaxDelay())}, opts...)
+	return NewSourcePublish(ctx, vertex.Spec.PipelineName, vertex.Spec.Name, srcPublishWMStores, vertexOpts...)
+}
+
 // PublishSourceWatermarks publishes source watermarks for a list of isb.ReadMessage.
 // it publishes for the partitions to which the messages belong, it publishes the oldest timestamp
 // seen for that partition in the list of messages.
@@ -83,6 +93,9 @@ func (df *sourcePublish) PublishSourceWatermarks(readMessages []*isb.ReadMessage
 	}
 	for p, t := range oldestTimestamps {
 		publisher := df.loadSourceWatermarkPublisher(p)
+		if publisher == nil {
+			return
+		}
 		// toVertexPartitionIdx is 0 because we publish watermarks within the source itself.
 		publisher.PublishWatermark(wmb.Watermark(t), nil, 0) // we don't care about the offset while publishing source watermark
 	}
@@ -92,19 +105,44 @@ func (df *sourcePublish) PublishSourceWatermarks(readMessages []*isb.ReadMessage
 func (df *sourcePublish) PublishIdleWatermarks(wm time.Time, partitions []int32) {
 	for _, partitionId := range partitions {
 		publisher := df.loadSourceWatermarkPublisher(partitionId)
+		if publisher == nil {
+			return
+		}
 		publisher.PublishIdleWatermark(wmb.Watermark(wm), nil, 0) // while publishing idle watermark at source, we don't care about the offset
 	}
 }
 
-// loadSourceWatermarkPublisher does a lazy load on the watermark publisher
+// loadSourceWatermarkPublisher does a lazy load on the watermark publisher, it returns nil if the source publisher is closed.
 func (df *sourcePublish) loadSourceWatermarkPublisher(partitionID int32) Publisher {
+	df.lock.Lock()
+	defer df.lock.Unlock()
+	if df.closed {
+		return nil
+	}
 	if p, ok := df.sourcePublishWMs[partitionID]; ok {
 		return p
 	}
 	entityName := fmt.Sprintf("%s-%s-%d", df.pipelineName, df.vertexName, partitionID)
 	processorEntity := entity.NewProcessorEntity(entityName)
 	// toVertexPartitionCount is 1 because we publish watermarks within the source itself.
-	sourcePublishWM := NewPublish(df.ctx, processorEntity, df.srcPublishWMStores, 1, IsSource(), WithDelay(df.opts.delay))
+	sourcePublishWM := NewPublish(df.ctx, processorEntity, df.srcPublishWMStores, 1, df.opts...)
 	df.sourcePublishWMs[partitionID] = sourcePublishWM
 	return sourcePublishWM
 }
+
+// Close closes the watermark publishers of all the partitions, no source watermark is published after that.
+func (df *sourcePublish) Close() error {
+	df.lock.Lock()
+	defer df.lock.Unlock()
+	if df.closed {
+		return nil
+	}
+	df.closed = true
+	var errs []error
+	for _, p := range df.sourcePublishWMs {
+		if err := p.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
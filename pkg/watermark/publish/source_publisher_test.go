@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publish
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/watermark/store"
+	"github.com/numaproj/numaflow/pkg/watermark/wmb"
+)
+
+func newTestSourcePublish(t *testing.T, name string, maxDelay time.Duration) (*sourcePublish, store.WatermarkStore) {
+	ctx := context.Background()
+	wmStore, err := store.BuildInmemWatermarkStore(ctx, name)
+	assert.NoError(t, err)
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "test-pl",
+			AbstractVertex: dfv1.AbstractVertex{Name: "in"},
+			Watermark:      dfv1.Watermark{MaxDelay: &metav1.Duration{Duration: maxDelay}},
+		}},
+	}
+	p := NewVertexSourcePublish(ctx, vertexInstance, wmStore, WithAutoRefreshHeartbeatDisabled()).(*sourcePublish)
+	t.Cleanup(func() { _ = p.Close() })
+	return p, wmStore
+}
+
+func readMessage(partition int32, eventTime int64) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{Header: isb.Header{
+			MessageInfo: isb.MessageInfo{EventTime: time.UnixMilli(eventTime)},
+		}},
+		ReadOffset: isb.NewSimpleIntPartitionOffset(0, partition),
+	}
+}
+
+func latestSourceWMB(t *testing.T, wmStore store.WatermarkStore, entity string) wmb.WMB {
+	value, err := wmStore.OffsetTimelineStore().GetValue(context.Background(), entity)
+	assert.NoError(t, err)
+	otValue, err := wmb.DecodeToWMB(value)
+	assert.NoError(t, err)
+	return otValue
+}
+
+func TestSourcePublish_Partitions(t *testing.T) {
+	p, wmStore := newTestSourcePublish(t, "partitions", 0)
+
+	// the oldest event time of each partition is published by the publisher of the partition
+	p.PublishSourceWatermarks([]*isb.ReadMessage{readMessage(0, 3000), readMessage(1, 5000), readMessage(0, 2000), readMessage(1, 4000)})
+	keys, err := wmStore.OffsetTimelineStore().GetAllKeys(context.Background())
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"test-pl-in-0", "test-pl-in-1"}, keys)
+	assert.Equal(t, int64(2000), latestSourceWMB(t, wmStore, "test-pl-in-0").Watermark)
+	assert.Equal(t, int64(4000), latestSourceWMB(t, wmStore, "test-pl-in-1").Watermark)
+}
+
+func TestSourcePublish_Delay(t *testing.T) {
+	p, wmStore := newTestSourcePublish(t, "delay", 500*time.Millisecond)
+
+	p.PublishSourceWatermarks([]*isb.ReadMessage{readMessage(0, 3000)})
+	assert.Equal(t, int64(2500), latestSourceWMB(t, wmStore, "test-pl-in-0").Watermark)
+
+	// the idle watermarks are not delayed
+	p.PublishIdleWatermarks(time.UnixMilli(4000), []int32{0})
+	otValue := latestSourceWMB(t, wmStore, "test-pl-in-0")
+	assert.Equal(t, int64(4000), otValue.Watermark)
+	assert.True(t, otValue.Idle)
+}
+
+func TestSourcePublish_Idle(t *testing.T) {
+	p, wmStore := newTestSourcePublish(t, "idle", 0)
+
+	p.PublishIdleWatermarks(time.UnixMilli(1000), []int32{0, 1})
+	for _, e := range []string{"test-pl-in-0", "test-pl-in-1"} {
+		otValue := latestSourceWMB(t, wmStore, e)
+		assert.Equal(t, int64(1000), otValue.Watermark)
+		assert.True(t, otValue.Idle)
+	}
+
+	// a partition becomes active again
+	p.PublishSourceWatermarks([]*isb.ReadMessage{readMessage(1, 2000)})
+	otValue := latestSourceWMB(t, wmStore, "test-pl-in-1")
+	assert.Equal(t, int64(2000), otValue.Watermark)
+	assert.False(t, otValue.Idle)
+}
+
+func TestSourcePublish_Monotonic(t *testing.T) {
+	p, wmStore := newTestSourcePublish(t, "monotonic", 0)
+
+	p.PublishSourceWatermarks([]*isb.ReadMessage{readMessage(0, 3000)})
+	// the older watermarks are not published, neither active nor idle ones
+	p.PublishSourceWatermarks([]*isb.ReadMessage{readMessage(0, 2000)})
+	assert.Equal(t, int64(3000), latestSourceWMB(t, wmStore, "test-pl-in-0").Watermark)
+	p.PublishIdleWatermarks(time.UnixMilli(1000), []int32{0})
+	otValue := latestSourceWMB(t, wmStore, "test-pl-in-0")
+	assert.Equal(t, int64(3000), otValue.Watermark)
+	assert.False(t, otValue.Idle)
+
+	p.PublishSourceWatermarks([]*isb.ReadMessage{readMessage(0, 4000)})
+	assert.Equal(t, int64(4000), latestSourceWMB(t, wmStore, "test-pl-in-0").Watermark)
+
+	// nothing is published after the publisher is closed
+	_ = p.Close()
+	p.PublishSourceWatermarks([]*isb.ReadMessage{readMessage(0, 5000)})
+	p.PublishIdleWatermarks(time.UnixMilli(6000), []int32{0})
+	assert.Equal(t, int64(4000), latestSourceWMB(t, wmStore, "test-pl-in-0").Watermark)
+}
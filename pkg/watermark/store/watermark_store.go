@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/apache/pulsar-client-go/pulsar"
+
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	noopkv "github.com/numaproj/numaflow/pkg/shared/kvs/noop"
+	pulsarkv "github.com/numaproj/numaflow/pkg/shared/kvs/pulsar"
 )
 
 // watermarkStore wraps a pair of heartbeatStore and offsetTimelineStore,
@@ -90,6 +93,26 @@ func BuildJetStreamWatermarkStore(ctx context.Context, bucket string, client *js
 	}, nil
 }
 
+// BuildPulsarWatermarkStore returns a Pulsar WatermarkStore instance, the stores are on the compacted topics
+// "{bucket}_PROCESSORS" and "{bucket}_OT" of the namespace.
+func BuildPulsarWatermarkStore(ctx context.Context, bucket string, client pulsar.Client, namespace string) (WatermarkStore, error) {
+	hbKVName := bucket + "_PROCESSORS"
+	hbStore, err := pulsarkv.NewPulsarKVStore(ctx, hbKVName, client, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed at new Pulsar HB KV store %q, %w", hbKVName, err)
+	}
+	otKVName := bucket + "_OT"
+	otStore, err := pulsarkv.NewPulsarKVStore(ctx, otKVName, client, namespace)
+	if err != nil {
+		hbStore.Close()
+		return nil, fmt.Errorf("failed at new Pulsar OT KV store %q, %w", otKVName, err)
+	}
+	return &watermarkStore{
+		heartbeatStore:      hbStore,
+		offsetTimelineStore: otStore,
+	}, nil
+}
+
 func JetStreamProcessorKVName(bucketName string) string {
 	return fmt.Sprintf("%s_PROCESSORS", bucketName)
 }
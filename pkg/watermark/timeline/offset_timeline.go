@@ -236,6 +236,40 @@ func (t *OffsetTimeline) GetEventTimeFromInt64(inputOffsetInt64 int64) int64 {
 	return eventTime
 }
 
+// GetEventTimeRange returns the nodes needed to get the event-time of any offset between the min and the max
+// offsets, from the highest offset to the lowest, so that a batch of offsets is looked up with a single walk of the
+// timeline. Use EventTimeOf to get the event-time of an offset from the returned nodes.
+func (t *OffsetTimeline) GetEventTimeRange(minOffset, maxOffset int64) []wmb.WMB {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	var nodes []wmb.WMB
+	for e := t.watermarks.Front(); e != nil; e = e.Next() {
+		node := e.Value.(wmb.WMB)
+		if node.Offset >= maxOffset {
+			continue
+		}
+		nodes = append(nodes, node)
+		// the node has the closest offset to the min offset, the lower ones are not needed
+		if node.Offset < minOffset {
+			break
+		}
+	}
+	return nodes
+}
+
+// EventTimeOf returns the event-time of the offset from the nodes returned by GetEventTimeRange, it's the same as
+// GetEventTimeFromInt64 as long as the offset is between the min and the max offsets of the range.
+func EventTimeOf(nodes []wmb.WMB, offset int64) int64 {
+	for _, node := range nodes {
+		// exclude the same offset because this offset may not finish processing yet
+		if node.Offset < offset {
+			return node.Watermark
+		}
+	}
+	return -1
+}
+
 // Dump dumps the in-memory representation of the OffsetTimeline. Could get very ugly if the list is large, like > 100 elements.
 // I am assuming we will have it in 10K+ (86400 seconds are there in a day).
 func (t *OffsetTimeline) Dump() string {
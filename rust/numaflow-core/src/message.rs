@@ -251,11 +251,13 @@ impl TryFrom<Message> for BytesMut {
                 message_info: Some(numaflow_pb::objects::isb::MessageInfo {
                     event_time: Some(prost_timestamp_from_utc(message.event_time)),
                     is_late: false, // Set this according to your logic
+                    ingestion_time: None,
                 }),
                 kind: message.typ.into(),
                 id: Some(message.id.into()),
                 keys: message.keys.to_vec(),
                 headers: message.headers,
+                encryption_key_id: String::new(),
             }),
             body: Some(numaflow_pb::objects::isb::Body {
                 payload: message.value.to_vec(),
@@ -331,11 +333,13 @@ mod tests {
                 message_info: Some(MessageInfo {
                     event_time: Some(prost_timestamp_from_utc(message.event_time)),
                     is_late: false,
+                    ingestion_time: None,
                 }),
                 kind: numaflow_pb::objects::isb::MessageKind::Data as i32,
                 id: Some(message.id.into()),
                 keys: message.keys.to_vec(),
                 headers: message.headers,
+                encryption_key_id: String::new(),
             }),
             body: Some(Body {
                 payload: message.value.clone().into(),
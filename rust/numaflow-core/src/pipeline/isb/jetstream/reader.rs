@@ -81,6 +81,13 @@ impl TryFrom<JSWrappedMessage> for Message {
         let header = proto_message
             .header
             .ok_or(Error::Proto("Missing header".to_string()))?;
+        // the payloads encrypted by the Go runtime can not be decrypted here.
+        if !header.encryption_key_id.is_empty() {
+            return Err(Error::Proto(format!(
+                "Message is encrypted with key {}, ISB encryption is not supported",
+                header.encryption_key_id
+            )));
+        }
         let body = proto_message
             .body
             .ok_or(Error::Proto("Missing body".to_string()))?;
@@ -19,11 +19,16 @@ limitations under the License.
 /// Blackhole : Blackhole is a sink to emulate /dev/null
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
-pub struct Blackhole {}
+pub struct Blackhole {
+    #[serde(rename = "sequenceValidation", skip_serializing_if = "Option::is_none")]
+    pub sequence_validation: Option<Box<crate::models::SequenceValidation>>,
+}
 
 impl Blackhole {
     /// Blackhole is a sink to emulate /dev/null
     pub fn new() -> Blackhole {
-        Blackhole {}
+        Blackhole {
+            sequence_validation: None,
+        }
     }
 }
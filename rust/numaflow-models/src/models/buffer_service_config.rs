@@ -20,6 +20,8 @@ limitations under the License.
 pub struct BufferServiceConfig {
     #[serde(rename = "jetstream", skip_serializing_if = "Option::is_none")]
     pub jetstream: Option<Box<crate::models::JetStreamConfig>>,
+    #[serde(rename = "kafka", skip_serializing_if = "Option::is_none")]
+    pub kafka: Option<Box<crate::models::KafkaConfig>>,
     #[serde(rename = "redis", skip_serializing_if = "Option::is_none")]
     pub redis: Option<Box<crate::models::RedisConfig>>,
 }
@@ -28,6 +30,7 @@ impl BufferServiceConfig {
     pub fn new() -> BufferServiceConfig {
         BufferServiceConfig {
             jetstream: None,
+            kafka: None,
             redis: None,
         }
     }
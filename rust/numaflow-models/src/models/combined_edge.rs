@@ -20,6 +20,9 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct CombinedEdge {
+    /// BufferConfig overrides the config of the inter step buffers the edge writes to, e.g. a larger max length for the edge to a slow sink. It's in the same format as the buffer config of the ISB Service, e.g. the stream config of JetStream, and is merged on top of it. The edges to the same vertex must have the same buffer config. It only applies to JetStream.
+    #[serde(rename = "bufferConfig", skip_serializing_if = "Option::is_none")]
+    pub buffer_config: Option<String>,
     #[serde(rename = "conditions", skip_serializing_if = "Option::is_none")]
     pub conditions: Option<Box<crate::models::ForwardConditions>>,
     #[serde(rename = "from")]
@@ -38,6 +41,8 @@ pub struct CombinedEdge {
     /// OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"
     #[serde(rename = "onFull", skip_serializing_if = "Option::is_none")]
     pub on_full: Option<String>,
+    #[serde(rename = "retention", skip_serializing_if = "Option::is_none")]
+    pub retention: Option<Box<crate::models::BufferRetention>>,
     #[serde(rename = "to")]
     pub to: String,
     #[serde(rename = "toVertexLimits", skip_serializing_if = "Option::is_none")]
@@ -62,12 +67,14 @@ impl CombinedEdge {
         to_vertex_type: String,
     ) -> CombinedEdge {
         CombinedEdge {
+            buffer_config: None,
             conditions: None,
             from,
             from_vertex_limits: None,
             from_vertex_partition_count: None,
             from_vertex_type,
             on_full: None,
+            retention: None,
             to,
             to_vertex_limits: None,
             to_vertex_partition_count: None,
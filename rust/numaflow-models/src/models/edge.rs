@@ -18,6 +18,9 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct Edge {
+    /// BufferConfig overrides the config of the inter step buffers the edge writes to, e.g. a larger max length for the edge to a slow sink. It's in the same format as the buffer config of the ISB Service, e.g. the stream config of JetStream, and is merged on top of it. The edges to the same vertex must have the same buffer config. It only applies to JetStream.
+    #[serde(rename = "bufferConfig", skip_serializing_if = "Option::is_none")]
+    pub buffer_config: Option<String>,
     #[serde(rename = "conditions", skip_serializing_if = "Option::is_none")]
     pub conditions: Option<Box<crate::models::ForwardConditions>>,
     #[serde(rename = "from")]
@@ -25,6 +28,8 @@ pub struct Edge {
     /// OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"
     #[serde(rename = "onFull", skip_serializing_if = "Option::is_none")]
     pub on_full: Option<String>,
+    #[serde(rename = "retention", skip_serializing_if = "Option::is_none")]
+    pub retention: Option<Box<crate::models::BufferRetention>>,
     #[serde(rename = "to")]
     pub to: String,
 }
@@ -32,9 +37,11 @@ pub struct Edge {
 impl Edge {
     pub fn new(from: String, to: String) -> Edge {
         Edge {
+            buffer_config: None,
             conditions: None,
             from,
             on_full: None,
+            retention: None,
             to,
         }
     }
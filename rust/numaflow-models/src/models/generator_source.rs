@@ -18,36 +18,125 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct GeneratorSource {
+    /// DuplicatePercentage is the percentage of the generated messages emitted again right after them, with the same offsets, i.e. the same message IDs, payloads, keys and event times, which simulates the duplicates of an at-least-once upstream to validate the deduplication and the exactly-once forwarding end to end. The duplicates are spread evenly, for example 10 emits every 10th message twice. It should be between 0 and 100.
+    #[serde(rename = "duplicatePercentage", skip_serializing_if = "Option::is_none")]
+    pub duplicate_percentage: Option<i32>,
     #[serde(rename = "duration", skip_serializing_if = "Option::is_none")]
     pub duration: Option<kube::core::Duration>,
+    /// EmitEvery generates the RPU records on every EmitEvery-th time unit instead of every time unit, which allows rates lower than one record per time unit without changing the tick granularity. For example, rpu 1, duration 1s and emitEvery 30 generate one record every 30 seconds. Configure the idle source watermark to keep the watermark progressing between the records.
+    #[serde(rename = "emitEvery", skip_serializing_if = "Option::is_none")]
+    pub emit_every: Option<i32>,
+    /// EventTimeField is the field of the JSON payload the event time is read from, the nested fields are separated by dots, e.g. meta.ts. If the field is missing or can not be parsed, OnInvalidEventTime is applied. If not set, the event time is the generation time, or the Createdts field of the payload rendered from ValueTemplate.
+    #[serde(rename = "eventTimeField", skip_serializing_if = "Option::is_none")]
+    pub event_time_field: Option<String>,
+    /// EventTimeUnit is the unit of the EventTimeField, one of nanos, millis, seconds and RFC3339. if not provided, the default value is set to \"nanos\"
+    #[serde(rename = "eventTimeUnit", skip_serializing_if = "Option::is_none")]
+    pub event_time_unit: Option<String>,
+    #[serde(rename = "futureJitter", skip_serializing_if = "Option::is_none")]
+    pub future_jitter: Option<kube::core::Duration>,
+    /// Headers are the headers of the generated messages, which can be used to test the header based routing and the propagation of the headers by the UDFs. The values are Go templates rendered for every message with the data and the functions of ValueTemplate, e.g. {{uuid}}, a value without any action is static.
+    #[serde(rename = "headers", skip_serializing_if = "Option::is_none")]
+    pub headers: Option<::std::collections::HashMap<String, String>>,
+    /// HotKeyPercentage is the percentage of the messages generated with the first key in the hotKey key distribution. It should be between 0 and 100. if not provided, the default value is set to 50
+    #[serde(rename = "hotKeyPercentage", skip_serializing_if = "Option::is_none")]
+    pub hot_key_percentage: Option<i32>,
+    #[serde(rename = "idleThreshold", skip_serializing_if = "Option::is_none")]
+    pub idle_threshold: Option<kube::core::Duration>,
     #[serde(rename = "jitter", skip_serializing_if = "Option::is_none")]
     pub jitter: Option<kube::core::Duration>,
     /// KeyCount is the number of unique keys in the payload
     #[serde(rename = "keyCount", skip_serializing_if = "Option::is_none")]
     pub key_count: Option<i32>,
+    /// KeyDistribution is the distribution the keys of the generated messages are drawn from, one of roundRobin, uniform, zipf and hotKey. roundRobin generates RPU messages for every one of the KeyCount keys on every time unit, the others generate the same number of messages but draw the key of every message: uniform with the same probability for all the keys, zipf with the probability of the i-th key proportional to 1/i^ZipfExponent, and hotKey with HotKeyPercentage of the messages on the first key and the others spread uniformly on the other keys. if not provided, the default value is set to \"roundRobin\"
+    #[serde(rename = "keyDistribution", skip_serializing_if = "Option::is_none")]
+    pub key_distribution: Option<String>,
+    #[serde(rename = "lateBy", skip_serializing_if = "Option::is_none")]
+    pub late_by: Option<kube::core::Duration>,
+    /// LatePercentage is the percentage of the generated messages whose event time is moved back by LateBy, which simulates the out of order and late data. The late messages are spread evenly, for example 5 moves every 20th message back, and they do not hold the source watermark back, so they are behind the watermark unless LateBy is within the max delay of the watermark. It should be between 0 and 100.
+    #[serde(rename = "latePercentage", skip_serializing_if = "Option::is_none")]
+    pub late_percentage: Option<i32>,
     /// Size of each generated message
     #[serde(rename = "msgSize", skip_serializing_if = "Option::is_none")]
     pub msg_size: Option<i32>,
+    /// OnInvalidEventTime specifies the behaviour when the event time of a generated message is missing or invalid. There are currently four options, fallbackToNow, fallbackToIngestionTime, drop and error. if not provided, the default value is set to \"fallbackToNow\"
+    #[serde(rename = "onInvalidEventTime", skip_serializing_if = "Option::is_none")]
+    pub on_invalid_event_time: Option<String>,
+    /// PayloadFormat is the encoding of the generated payloads, one of json, protobuf, avro and raw-bytes. json generates the JSON payloads, or the ValueBlob or ValueTemplate ones. protobuf and avro generate a message of the PayloadSchema: an integer field named createdts is the generation time in nanoseconds, an integer field named seq is the per-key sequence number, a bytes field has MsgSize random bytes and the other fields are random. raw-bytes generates MsgSize random bytes. if not provided, the default value is set to \"json\"
+    #[serde(rename = "payloadFormat", skip_serializing_if = "Option::is_none")]
+    pub payload_format: Option<String>,
+    /// PayloadMessage is the full name of the protobuf message of the payloads in the PayloadSchema, e.g. example.v1.Order. It is required for the protobuf format.
+    #[serde(rename = "payloadMessage", skip_serializing_if = "Option::is_none")]
+    pub payload_message: Option<String>,
+    /// PayloadSchema is the schema of the payloads in the protobuf and avro formats. It is the JSON of an Avro schema for avro, the payloads are the binary encoding of the Avro datum without the schema. It is the base64 encoding of a serialized protobuf FileDescriptorSet including the imports, e.g. generated by protoc --include_imports --descriptor_set_out, for protobuf.
+    #[serde(rename = "payloadSchema", skip_serializing_if = "Option::is_none")]
+    pub payload_schema: Option<String>,
+    #[serde(rename = "rampUp", skip_serializing_if = "Option::is_none")]
+    pub ramp_up: Option<kube::core::Duration>,
+    /// RateJitterPercentage randomizes the number of the records generated on every time unit within the given percentage of RPU, for example 20 generates between 80% and 120% of RPU on every time unit. It is applied on top of RampUp. It should be between 0 and 100.
+    #[serde(
+        rename = "rateJitterPercentage",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub rate_jitter_percentage: Option<i32>,
+    /// RateSchedule changes the number of the records generated on every time unit over time after the generator starts, e.g. from 100 to 10000 over 10 minutes, then hold for 30 minutes, then back to 100 over 10 minutes. It starts from RPU, and the RPU of the last step is held after the schedule. It can not be set together with RampUp, and RateJitterPercentage is applied on top of it.
+    #[serde(rename = "rateSchedule", skip_serializing_if = "Option::is_none")]
+    pub rate_schedule: Option<Vec<crate::models::GeneratorRateStep>>,
     #[serde(rename = "rpu", skip_serializing_if = "Option::is_none")]
     pub rpu: Option<i64>,
+    #[serde(rename = "runtimeUpdate", skip_serializing_if = "Option::is_none")]
+    pub runtime_update: Option<Box<crate::models::GeneratorRuntimeUpdate>>,
+    /// TombstonePercentage is the percentage of the generated messages emitted with an empty payload, which simulates the deletion events of the keys. The tombstones keep the keys and the event time of the messages, they are spread evenly, for example 10 generates a tombstone for every 10th message.
+    #[serde(
+        rename = "tombstonePercentage",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub tombstone_percentage: Option<i32>,
     /// Value is an optional uint64 value to be written in to the payload
     #[serde(rename = "value", skip_serializing_if = "Option::is_none")]
     pub value: Option<i64>,
     /// ValueBlob is an optional string which is the base64 encoding of direct payload to send. This is useful for attaching a GeneratorSource to a true pipeline to test load behavior with true messages without requiring additional work to generate messages through the external source if present, the Value and MsgSize fields will be ignored.
     #[serde(rename = "valueBlob", skip_serializing_if = "Option::is_none")]
     pub value_blob: Option<String>,
+    /// ValueTemplate is an optional Go template of the payload to send, which is rendered for every generated message. The template can refer to {{.Timestamp}}, the generation time in nanoseconds, {{.Sequence}}, the per-key sequence number, and {{.RandString n}}, a random alphanumeric string of length n. The functions uuid, randInt min max and now return a random UUID, a random integer between min and max inclusive, and the current time. The functions sine period amplitude offset, sawtooth period min max and randomWalk start step return the values of a synthetic metric following a sine wave, a sawtooth wave or a random walk over time. If the rendered payload is a JSON object with a Createdts field in nanoseconds, it is used as the event time, otherwise the generation time is. If present, the Value and MsgSize fields will be ignored. It can not be set together with ValueBlob.
+    #[serde(rename = "valueTemplate", skip_serializing_if = "Option::is_none")]
+    pub value_template: Option<String>,
+    /// ZipfExponent is the exponent of the zipf key distribution, a decimal greater than 1, the larger it is, the more the messages are skewed to the first keys. if not provided, the default value is set to \"1.1\"
+    #[serde(rename = "zipfExponent", skip_serializing_if = "Option::is_none")]
+    pub zipf_exponent: Option<String>,
 }
 
 impl GeneratorSource {
     pub fn new() -> GeneratorSource {
         GeneratorSource {
+            duplicate_percentage: None,
             duration: None,
+            emit_every: None,
+            event_time_field: None,
+            event_time_unit: None,
+            future_jitter: None,
+            headers: None,
+            hot_key_percentage: None,
+            idle_threshold: None,
             jitter: None,
             key_count: None,
+            key_distribution: None,
+            late_by: None,
+            late_percentage: None,
             msg_size: None,
+            on_invalid_event_time: None,
+            payload_format: None,
+            payload_message: None,
+            payload_schema: None,
+            ramp_up: None,
+            rate_jitter_percentage: None,
+            rate_schedule: None,
             rpu: None,
+            runtime_update: None,
+            tombstone_percentage: None,
             value: None,
             value_blob: None,
+            value_template: None,
+            zipf_exponent: None,
         }
     }
 }
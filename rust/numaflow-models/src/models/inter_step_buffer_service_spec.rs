@@ -20,6 +20,8 @@ limitations under the License.
 pub struct InterStepBufferServiceSpec {
     #[serde(rename = "jetstream", skip_serializing_if = "Option::is_none")]
     pub jetstream: Option<Box<crate::models::JetStreamBufferService>>,
+    #[serde(rename = "kafka", skip_serializing_if = "Option::is_none")]
+    pub kafka: Option<Box<crate::models::KafkaBufferService>>,
     #[serde(rename = "redis", skip_serializing_if = "Option::is_none")]
     pub redis: Option<Box<crate::models::RedisBufferService>>,
 }
@@ -28,6 +30,7 @@ impl InterStepBufferServiceSpec {
     pub fn new() -> InterStepBufferServiceSpec {
         InterStepBufferServiceSpec {
             jetstream: None,
+            kafka: None,
             redis: None,
         }
     }
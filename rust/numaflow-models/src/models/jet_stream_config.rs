@@ -22,6 +22,8 @@ pub struct JetStreamConfig {
     pub auth: Option<Box<crate::models::NatsAuth>>,
     #[serde(rename = "streamConfig", skip_serializing_if = "Option::is_none")]
     pub stream_config: Option<String>,
+    #[serde(rename = "tls", skip_serializing_if = "Option::is_none")]
+    pub tls: Option<Box<crate::models::Tls>>,
     /// TLS enabled or not
     #[serde(rename = "tlsEnabled", skip_serializing_if = "Option::is_none")]
     pub tls_enabled: Option<bool>,
@@ -35,6 +37,7 @@ impl JetStreamConfig {
         JetStreamConfig {
             auth: None,
             stream_config: None,
+            tls: None,
             tls_enabled: None,
             url: None,
         }
@@ -17,10 +17,15 @@ limitations under the License.
 // Code generated by Openapi Generator. DO NOT EDIT.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
-pub struct Log {}
+pub struct Log {
+    #[serde(rename = "sequenceValidation", skip_serializing_if = "Option::is_none")]
+    pub sequence_validation: Option<Box<crate::models::SequenceValidation>>,
+}
 
 impl Log {
     pub fn new() -> Log {
-        Log {}
+        Log {
+            sequence_validation: None,
+        }
     }
 }
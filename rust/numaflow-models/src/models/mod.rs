@@ -12,6 +12,8 @@ pub mod basic_auth;
 pub use self::basic_auth::BasicAuth;
 pub mod blackhole;
 pub use self::blackhole::Blackhole;
+pub mod buffer_retention;
+pub use self::buffer_retention::BufferRetention;
 pub mod buffer_service_config;
 pub use self::buffer_service_config::BufferServiceConfig;
 pub mod combined_edge;
@@ -32,6 +34,10 @@ pub mod forward_conditions;
 pub use self::forward_conditions::ForwardConditions;
 pub mod function;
 pub use self::function::Function;
+pub mod generator_rate_step;
+pub use self::generator_rate_step::GeneratorRateStep;
+pub mod generator_runtime_update;
+pub use self::generator_runtime_update::GeneratorRuntimeUpdate;
 pub mod generator_source;
 pub use self::generator_source::GeneratorSource;
 pub mod get_container_req;
@@ -70,6 +76,8 @@ pub mod inter_step_buffer_service_spec;
 pub use self::inter_step_buffer_service_spec::InterStepBufferServiceSpec;
 pub mod inter_step_buffer_service_status;
 pub use self::inter_step_buffer_service_status::InterStepBufferServiceStatus;
+pub mod isb_encryption;
+pub use self::isb_encryption::IsbEncryption;
 pub mod jet_stream_buffer_service;
 pub use self::jet_stream_buffer_service::JetStreamBufferService;
 pub mod jet_stream_config;
@@ -78,6 +86,12 @@ pub mod jet_stream_source;
 pub use self::jet_stream_source::JetStreamSource;
 pub mod job_template;
 pub use self::job_template::JobTemplate;
+pub mod kafka_buffer_service;
+pub use self::kafka_buffer_service::KafkaBufferService;
+pub mod kafka_config;
+pub use self::kafka_config::KafkaConfig;
+pub mod kafka_sasl_auth;
+pub use self::kafka_sasl_auth::KafkaSaslAuth;
 pub mod kafka_sink;
 pub use self::kafka_sink::KafkaSink;
 pub mod kafka_source;
@@ -110,6 +124,8 @@ pub mod no_store;
 pub use self::no_store::NoStore;
 pub mod pbq_storage;
 pub use self::pbq_storage::PbqStorage;
+pub mod pass_through;
+pub use self::pass_through::PassThrough;
 pub mod persistence_strategy;
 pub use self::persistence_strategy::PersistenceStrategy;
 pub mod pipeline;
@@ -134,6 +150,8 @@ pub mod redis_config;
 pub use self::redis_config::RedisConfig;
 pub mod redis_settings;
 pub use self::redis_settings::RedisSettings;
+pub mod replay_source;
+pub use self::replay_source::ReplaySource;
 pub mod retry_strategy;
 pub use self::retry_strategy::RetryStrategy;
 pub mod rolling_update_strategy;
@@ -146,6 +164,8 @@ pub mod saslo_auth;
 pub use self::saslo_auth::SasloAuth;
 pub mod scale;
 pub use self::scale::Scale;
+pub mod sequence_validation;
+pub use self::sequence_validation::SequenceValidation;
 pub mod serving_source;
 pub use self::serving_source::ServingSource;
 pub mod serving_store;
@@ -28,6 +28,8 @@ pub struct RedisConfig {
     /// Sentinel URL, will be ignored if Redis URL is provided
     #[serde(rename = "sentinelUrl", skip_serializing_if = "Option::is_none")]
     pub sentinel_url: Option<String>,
+    #[serde(rename = "tls", skip_serializing_if = "Option::is_none")]
+    pub tls: Option<Box<crate::models::Tls>>,
     /// Redis URL
     #[serde(rename = "url", skip_serializing_if = "Option::is_none")]
     pub url: Option<String>,
@@ -43,6 +45,7 @@ impl RedisConfig {
             password: None,
             sentinel_password: None,
             sentinel_url: None,
+            tls: None,
             url: None,
             user: None,
         }
@@ -62,6 +62,12 @@ pub struct Scale {
         skip_serializing_if = "Option::is_none"
     )]
     pub target_buffer_availability: Option<i64>,
+    /// TargetPendingAgeSeconds defines the target maximum age of the oldest pending message in seconds. When it's set, the desired replicas are weighted by how far the age of the oldest pending message is from the target, so that a small but old backlog scales up, while a big but fresh backlog does not scale up too aggressively. It only applies to UDF and Sink vertices because only they have buffers to read. It's disabled if not set or set to 0.
+    #[serde(
+        rename = "targetPendingAgeSeconds",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub target_pending_age_seconds: Option<i64>,
     /// TargetProcessingSeconds is used to tune the aggressiveness of autoscaling for source vertices, it measures how fast you want the vertex to process all the pending messages. Typically increasing the value, which leads to lower processing rate, thus less replicas. It's only effective for source vertices.
     #[serde(
         rename = "targetProcessingSeconds",
@@ -90,6 +96,7 @@ impl Scale {
             scale_down_cooldown_seconds: None,
             scale_up_cooldown_seconds: None,
             target_buffer_availability: None,
+            target_pending_age_seconds: None,
             target_processing_seconds: None,
             zero_replica_sleep_seconds: None,
         }
@@ -30,6 +30,8 @@ pub struct Sink {
     pub retry_strategy: Option<Box<crate::models::RetryStrategy>>,
     #[serde(rename = "udsink", skip_serializing_if = "Option::is_none")]
     pub udsink: Option<Box<crate::models::UdSink>>,
+    #[serde(rename = "writeTimeout", skip_serializing_if = "Option::is_none")]
+    pub write_timeout: Option<kube::core::Duration>,
 }
 
 impl Sink {
@@ -41,6 +43,7 @@ impl Sink {
             log: None,
             retry_strategy: None,
             udsink: None,
+            write_timeout: None,
         }
     }
 }
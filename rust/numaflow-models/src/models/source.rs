@@ -30,6 +30,8 @@ pub struct Source {
     pub nats: Option<Box<crate::models::NatsSource>>,
     #[serde(rename = "pulsar", skip_serializing_if = "Option::is_none")]
     pub pulsar: Option<Box<crate::models::PulsarSource>>,
+    #[serde(rename = "replay", skip_serializing_if = "Option::is_none")]
+    pub replay: Option<Box<crate::models::ReplaySource>>,
     #[serde(rename = "serving", skip_serializing_if = "Option::is_none")]
     pub serving: Option<Box<crate::models::ServingSource>>,
     #[serde(rename = "transformer", skip_serializing_if = "Option::is_none")]
@@ -47,6 +49,7 @@ impl Source {
             kafka: None,
             nats: None,
             pulsar: None,
+            replay: None,
             serving: None,
             transformer: None,
             udsource: None,
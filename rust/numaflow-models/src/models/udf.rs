@@ -24,6 +24,9 @@ pub struct Udf {
     pub container: Option<Box<crate::models::Container>>,
     #[serde(rename = "groupBy", skip_serializing_if = "Option::is_none")]
     pub group_by: Option<Box<crate::models::GroupBy>>,
+    /// PassThrough forwards the messages as they are without a UDF container, e.g. for a vertex only routing the messages to the conditional edges.
+    #[serde(rename = "passThrough", skip_serializing_if = "Option::is_none")]
+    pub pass_through: Option<Box<crate::models::PassThrough>>,
 }
 
 impl Udf {
@@ -32,6 +35,7 @@ impl Udf {
             builtin: None,
             container: None,
             group_by: None,
+            pass_through: None,
         }
     }
 }
@@ -33,6 +33,8 @@ pub struct VertexSpec {
     /// Set DNS policy for the pod. Defaults to \"ClusterFirst\". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.
     #[serde(rename = "dnsPolicy", skip_serializing_if = "Option::is_none")]
     pub dns_policy: Option<String>,
+    #[serde(rename = "encryption", skip_serializing_if = "Option::is_none")]
+    pub encryption: Option<Box<crate::models::IsbEncryption>>,
     #[serde(rename = "fromEdges", skip_serializing_if = "Option::is_none")]
     pub from_edges: Option<Vec<crate::models::CombinedEdge>>,
     /// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images used by this PodSpec. If specified, these secrets will be passed to individual puller implementations for them to use. For example, in the case of docker, only DockerConfig type secrets are honored. More info: https://kubernetes.io/docs/concepts/containers/images#specifying-imagepullsecrets-on-a-pod
@@ -126,6 +128,7 @@ impl VertexSpec {
             container_template: None,
             dns_config: None,
             dns_policy: None,
+            encryption: None,
             from_edges: None,
             image_pull_secrets: None,
             init_container_template: None,
@@ -8,6 +8,9 @@ pub struct MessageInfo {
     /// IsLate is used to indicate if the message is a late data
     #[prost(bool, tag = "2")]
     pub is_late: bool,
+    /// IngestionTime is the time when the source read or created the message
+    #[prost(message, optional, tag = "3")]
+    pub ingestion_time: ::core::option::Option<::prost_types::Timestamp>,
 }
 /// MessageMetadata is the metadata of the message
 #[derive(Clone, Copy, PartialEq, ::prost::Message)]
@@ -37,6 +40,9 @@ pub struct Header {
         ::prost::alloc::string::String,
         ::prost::alloc::string::String,
     >,
+    /// EncryptionKeyID is the ID of the key the payload is encrypted with, it is empty if the payload is not encrypted.
+    #[prost(string, tag = "6")]
+    pub encryption_key_id: ::prost::alloc::string::String,
 }
 /// MessageID is the message ID of the message which is used for exactly-once-semantics.
 #[derive(Clone, PartialEq, ::prost::Message)]
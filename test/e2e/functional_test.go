@@ -177,6 +177,31 @@ func (s *FunctionalSuite) TestUDFFiltering() {
 	w.Expect().RedisSinkNotContains("udf-filtering-out", expect2)
 }
 
+func (s *FunctionalSuite) TestPassThroughRouting() {
+	w := s.Given().Pipeline("@testdata/pass-through-routing.yaml").
+		When().
+		CreatePipelineAndWait()
+	defer w.DeletePipelineAndWait()
+	pipelineName := "pass-through-routing"
+
+	// wait for all the pods to come up
+	w.Expect().VertexPodsRunning()
+
+	w.SendMessageTo(pipelineName, "in", NewHttpPostRequest().WithBody([]byte("2")).WithHeader("X-Tags", "even")).
+		SendMessageTo(pipelineName, "in", NewHttpPostRequest().WithBody([]byte("3")).WithHeader("X-Tags", "odd")).
+		SendMessageTo(pipelineName, "in", NewHttpPostRequest().WithBody([]byte("4")).WithHeader("X-Tags", "even")).
+		SendMessageTo(pipelineName, "in", NewHttpPostRequest().WithBody([]byte("5")))
+
+	w.Expect().RedisSinkContains("pass-through-routing-even", "2")
+	w.Expect().RedisSinkContains("pass-through-routing-even", "4")
+	w.Expect().RedisSinkContains("pass-through-routing-odd", "3")
+	w.Expect().RedisSinkNotContains("pass-through-routing-even", "3")
+	w.Expect().RedisSinkNotContains("pass-through-routing-odd", "2")
+	// the message without tags doesn't match any of the conditional edges
+	w.Expect().RedisSinkNotContains("pass-through-routing-even", "5")
+	w.Expect().RedisSinkNotContains("pass-through-routing-odd", "5")
+}
+
 func (s *FunctionalSuite) TestDropOnFull() {
 
 	// the drop on full feature is not supported with redis ISBSVC